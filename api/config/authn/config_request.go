@@ -1,6 +1,8 @@
 package authn
 
 import (
+	"crypto/x509"
+
 	config "github.com/chef/automate/api/config/shared"
 	w "github.com/chef/automate/api/config/shared/wrappers"
 )
@@ -44,7 +46,27 @@ func DefaultConfigRequest() *ConfigRequest {
 // instance of config.InvalidConfigError that has the missing keys and invalid
 // fields populated.
 func (c *ConfigRequest) Validate() error {
-	return nil
+	cfgErr := config.NewInvalidConfigError()
+
+	if clientCert := c.GetV1().GetSys().GetClientCert(); clientCert != nil {
+		rootCert := clientCert.GetRootCert().GetValue()
+		if rootCert == "" && len(clientCert.GetTeams()) > 0 {
+			cfgErr.AddMissingKey("auth_n.v1.sys.client_cert.root_cert")
+		} else if rootCert != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(rootCert)) {
+			cfgErr.AddInvalidValue("auth_n.v1.sys.client_cert.root_cert", "no PEM encoded certificates found")
+		}
+
+		for _, m := range clientCert.GetTeams() {
+			if m.GetName().GetValue() == "" {
+				cfgErr.AddMissingKey("auth_n.v1.sys.client_cert.teams.name")
+			}
+		}
+	}
+
+	if cfgErr.IsEmpty() {
+		return nil
+	}
+	return cfgErr
 }
 
 // PrepareSystemConfig returns a system configuration that can be used
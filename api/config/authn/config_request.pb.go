@@ -32,7 +32,7 @@ func (m *ConfigRequest) Reset()         { *m = ConfigRequest{} }
 func (m *ConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest) ProtoMessage()    {}
 func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0}
 }
 func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest.Unmarshal(m, b)
@@ -71,7 +71,7 @@ func (m *ConfigRequest_V1) Reset()         { *m = ConfigRequest_V1{} }
 func (m *ConfigRequest_V1) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1) ProtoMessage()    {}
 func (*ConfigRequest_V1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0}
 }
 func (m *ConfigRequest_V1) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1.Unmarshal(m, b)
@@ -106,24 +106,25 @@ func (m *ConfigRequest_V1) GetSvc() *ConfigRequest_V1_Service {
 }
 
 type ConfigRequest_V1_System struct {
-	Mlsa                 *shared.Mlsa                     `protobuf:"bytes,1,opt,name=mlsa,proto3" json:"mlsa,omitempty" toml:"mlsa,omitempty" mapstructure:"mlsa,omitempty"`
-	Tls                  *shared.TLSCredentials           `protobuf:"bytes,2,opt,name=tls,proto3" json:"tls,omitempty" toml:"tls,omitempty" mapstructure:"tls,omitempty"`
-	Service              *ConfigRequest_V1_System_Service `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty" toml:"service,omitempty" mapstructure:"service,omitempty"`
-	Oidc                 *ConfigRequest_V1_System_Oidc    `protobuf:"bytes,4,opt,name=oidc,proto3" json:"oidc,omitempty" toml:"oidc,omitempty" mapstructure:"oidc,omitempty"`
-	Storage              *ConfigRequest_V1_System_Storage `protobuf:"bytes,6,opt,name=storage,proto3" json:"storage,omitempty" toml:"storage,omitempty" mapstructure:"storage,omitempty"`
-	Logger               *ConfigRequest_V1_System_Logger  `protobuf:"bytes,7,opt,name=logger,proto3" json:"logger,omitempty" toml:"logger,omitempty" mapstructure:"logger,omitempty"`
-	Proxy                *shared.Proxy                    `protobuf:"bytes,8,opt,name=proxy,proto3" json:"proxy,omitempty" toml:"proxy,omitempty" mapstructure:"proxy,omitempty"`
-	Http1                *ConfigRequest_V1_System_Http1   `protobuf:"bytes,9,opt,name=http1,proto3" json:"http1,omitempty" toml:"http1,omitempty" mapstructure:"http1,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte                           `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	Mlsa                 *shared.Mlsa                        `protobuf:"bytes,1,opt,name=mlsa,proto3" json:"mlsa,omitempty" toml:"mlsa,omitempty" mapstructure:"mlsa,omitempty"`
+	Tls                  *shared.TLSCredentials              `protobuf:"bytes,2,opt,name=tls,proto3" json:"tls,omitempty" toml:"tls,omitempty" mapstructure:"tls,omitempty"`
+	Service              *ConfigRequest_V1_System_Service    `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty" toml:"service,omitempty" mapstructure:"service,omitempty"`
+	Oidc                 *ConfigRequest_V1_System_Oidc       `protobuf:"bytes,4,opt,name=oidc,proto3" json:"oidc,omitempty" toml:"oidc,omitempty" mapstructure:"oidc,omitempty"`
+	Storage              *ConfigRequest_V1_System_Storage    `protobuf:"bytes,6,opt,name=storage,proto3" json:"storage,omitempty" toml:"storage,omitempty" mapstructure:"storage,omitempty"`
+	Logger               *ConfigRequest_V1_System_Logger     `protobuf:"bytes,7,opt,name=logger,proto3" json:"logger,omitempty" toml:"logger,omitempty" mapstructure:"logger,omitempty"`
+	Proxy                *shared.Proxy                       `protobuf:"bytes,8,opt,name=proxy,proto3" json:"proxy,omitempty" toml:"proxy,omitempty" mapstructure:"proxy,omitempty"`
+	Http1                *ConfigRequest_V1_System_Http1      `protobuf:"bytes,9,opt,name=http1,proto3" json:"http1,omitempty" toml:"http1,omitempty" mapstructure:"http1,omitempty"`
+	ClientCert           *ConfigRequest_V1_System_ClientCert `protobuf:"bytes,10,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty" toml:"client_cert,omitempty" mapstructure:"client_cert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                               `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
 func (m *ConfigRequest_V1_System) Reset()         { *m = ConfigRequest_V1_System{} }
 func (m *ConfigRequest_V1_System) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System) ProtoMessage()    {}
 func (*ConfigRequest_V1_System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0, 0}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0}
 }
 func (m *ConfigRequest_V1_System) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System.Unmarshal(m, b)
@@ -199,6 +200,13 @@ func (m *ConfigRequest_V1_System) GetHttp1() *ConfigRequest_V1_System_Http1 {
 	return nil
 }
 
+func (m *ConfigRequest_V1_System) GetClientCert() *ConfigRequest_V1_System_ClientCert {
+	if m != nil {
+		return m.ClientCert
+	}
+	return nil
+}
+
 type ConfigRequest_V1_System_Service struct {
 	Host                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty" toml:"host,omitempty" mapstructure:"host,omitempty"`
 	Port                 *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty" toml:"port,omitempty" mapstructure:"port,omitempty"`
@@ -214,7 +222,7 @@ func (m *ConfigRequest_V1_System_Service) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0, 0, 0}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0, 0}
 }
 func (m *ConfigRequest_V1_System_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Service.Unmarshal(m, b)
@@ -282,7 +290,7 @@ func (m *ConfigRequest_V1_System_Oidc) Reset()         { *m = ConfigRequest_V1_S
 func (m *ConfigRequest_V1_System_Oidc) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Oidc) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Oidc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0, 0, 1}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0, 1}
 }
 func (m *ConfigRequest_V1_System_Oidc) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Oidc.Unmarshal(m, b)
@@ -335,7 +343,7 @@ func (m *ConfigRequest_V1_System_Http1) Reset()         { *m = ConfigRequest_V1_
 func (m *ConfigRequest_V1_System_Http1) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Http1) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Http1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0, 0, 2}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0, 2}
 }
 func (m *ConfigRequest_V1_System_Http1) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Http1.Unmarshal(m, b)
@@ -382,7 +390,7 @@ func (m *ConfigRequest_V1_System_Storage) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Storage) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Storage) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Storage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0, 0, 3}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0, 3}
 }
 func (m *ConfigRequest_V1_System_Storage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Storage.Unmarshal(m, b)
@@ -435,7 +443,7 @@ func (m *ConfigRequest_V1_System_Logger) Reset()         { *m = ConfigRequest_V1
 func (m *ConfigRequest_V1_System_Logger) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Logger) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Logger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0, 0, 4}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0, 4}
 }
 func (m *ConfigRequest_V1_System_Logger) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Logger.Unmarshal(m, b)
@@ -469,6 +477,107 @@ func (m *ConfigRequest_V1_System_Logger) GetLevel() *wrappers.StringValue {
 	return nil
 }
 
+// ClientCert configures authenticating requests by the X.509
+// certificates their clients present. It is enabled by setting
+// root_cert, the PEM of the CA the certificates are issued by.
+type ConfigRequest_V1_System_ClientCert struct {
+	RootCert             *wrappers.StringValue                             `protobuf:"bytes,1,opt,name=root_cert,json=rootCert,proto3" json:"root_cert,omitempty" toml:"root_cert,omitempty" mapstructure:"root_cert,omitempty"`
+	Teams                []*ConfigRequest_V1_System_ClientCert_TeamMapping `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty" toml:"teams,omitempty" mapstructure:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                          `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                                             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_ClientCert) Reset()         { *m = ConfigRequest_V1_System_ClientCert{} }
+func (m *ConfigRequest_V1_System_ClientCert) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest_V1_System_ClientCert) ProtoMessage()    {}
+func (*ConfigRequest_V1_System_ClientCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0, 5}
+}
+func (m *ConfigRequest_V1_System_ClientCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_ClientCert.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_ClientCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_ClientCert.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_ClientCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_ClientCert.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_ClientCert) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_ClientCert.Size(m)
+}
+func (m *ConfigRequest_V1_System_ClientCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_ClientCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_ClientCert proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_ClientCert) GetRootCert() *wrappers.StringValue {
+	if m != nil {
+		return m.RootCert
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_ClientCert) GetTeams() []*ConfigRequest_V1_System_ClientCert_TeamMapping {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+// TeamMapping assigns the requestor authenticated by the
+// certificate issued for name to teams.
+type ConfigRequest_V1_System_ClientCert_TeamMapping struct {
+	Name                 *wrappers.StringValue   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" toml:"name,omitempty" mapstructure:"name,omitempty"`
+	Teams                []*wrappers.StringValue `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty" toml:"teams,omitempty" mapstructure:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                  `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) Reset() {
+	*m = ConfigRequest_V1_System_ClientCert_TeamMapping{}
+}
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ConfigRequest_V1_System_ClientCert_TeamMapping) ProtoMessage() {}
+func (*ConfigRequest_V1_System_ClientCert_TeamMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 0, 5, 0}
+}
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_ClientCert_TeamMapping.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_ClientCert_TeamMapping.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_ClientCert_TeamMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_ClientCert_TeamMapping.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_ClientCert_TeamMapping.Size(m)
+}
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_ClientCert_TeamMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_ClientCert_TeamMapping proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) GetName() *wrappers.StringValue {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_ClientCert_TeamMapping) GetTeams() []*wrappers.StringValue {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
 type ConfigRequest_V1_Service struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -479,7 +588,7 @@ func (m *ConfigRequest_V1_Service) Reset()         { *m = ConfigRequest_V1_Servi
 func (m *ConfigRequest_V1_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cbce4a5341f81b09, []int{0, 0, 1}
+	return fileDescriptor_config_request_9ce2a80f619b32e3, []int{0, 0, 1}
 }
 func (m *ConfigRequest_V1_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_Service.Unmarshal(m, b)
@@ -508,65 +617,73 @@ func init() {
 	proto.RegisterType((*ConfigRequest_V1_System_Http1)(nil), "chef.automate.domain.authn.ConfigRequest.V1.System.Http1")
 	proto.RegisterType((*ConfigRequest_V1_System_Storage)(nil), "chef.automate.domain.authn.ConfigRequest.V1.System.Storage")
 	proto.RegisterType((*ConfigRequest_V1_System_Logger)(nil), "chef.automate.domain.authn.ConfigRequest.V1.System.Logger")
+	proto.RegisterType((*ConfigRequest_V1_System_ClientCert)(nil), "chef.automate.domain.authn.ConfigRequest.V1.System.ClientCert")
+	proto.RegisterType((*ConfigRequest_V1_System_ClientCert_TeamMapping)(nil), "chef.automate.domain.authn.ConfigRequest.V1.System.ClientCert.TeamMapping")
 	proto.RegisterType((*ConfigRequest_V1_Service)(nil), "chef.automate.domain.authn.ConfigRequest.V1.Service")
 }
 
 func init() {
-	proto.RegisterFile("api/config/authn/config_request.proto", fileDescriptor_config_request_cbce4a5341f81b09)
-}
-
-var fileDescriptor_config_request_cbce4a5341f81b09 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xdf, 0x8e, 0xdb, 0x44,
-	0x14, 0xc6, 0x95, 0xc4, 0xc9, 0x66, 0xa7, 0xbb, 0x6d, 0x34, 0x54, 0x60, 0xb9, 0xa8, 0x2a, 0x48,
-	0x48, 0xfc, 0x8b, 0xdd, 0x64, 0x57, 0xa2, 0x2d, 0x95, 0x10, 0xbb, 0x6d, 0xa1, 0xab, 0x45, 0x8b,
-	0x9c, 0x76, 0x2f, 0xb8, 0xb1, 0x26, 0xe3, 0x13, 0xc7, 0x62, 0x3c, 0xe3, 0x9d, 0x19, 0x87, 0xdd,
-	0x3b, 0x78, 0x23, 0xc4, 0x35, 0x37, 0xac, 0xc4, 0x2d, 0x12, 0xcf, 0xb2, 0x2f, 0x80, 0x3c, 0x33,
-	0x0e, 0xd0, 0x15, 0x21, 0x4d, 0xef, 0x62, 0xcd, 0xf9, 0x7e, 0xfe, 0xce, 0xf1, 0x77, 0xec, 0xa0,
-	0x0f, 0x48, 0x99, 0x47, 0x54, 0xf0, 0x59, 0x9e, 0x45, 0xa4, 0xd2, 0x73, 0xee, 0x2e, 0x12, 0x09,
-	0x67, 0x15, 0x28, 0x1d, 0x96, 0x52, 0x68, 0x81, 0x03, 0x3a, 0x87, 0x59, 0x48, 0x2a, 0x2d, 0x0a,
-	0xa2, 0x21, 0x4c, 0x45, 0x41, 0x72, 0x1e, 0x1a, 0x41, 0x70, 0xf7, 0x1f, 0x08, 0x35, 0x27, 0x12,
-	0xd2, 0x28, 0x63, 0x62, 0x4a, 0x98, 0xd5, 0x06, 0x77, 0xae, 0x9f, 0x6b, 0xa6, 0xdc, 0xe1, 0x11,
-	0x15, 0x45, 0x29, 0x38, 0x70, 0xad, 0xa2, 0x06, 0x3f, 0xcc, 0x64, 0x49, 0x23, 0x73, 0x4e, 0x87,
-	0x19, 0xf0, 0x21, 0x19, 0x0f, 0x1b, 0x8b, 0x65, 0x1e, 0x91, 0x71, 0x7d, 0x11, 0x11, 0xce, 0x85,
-	0x26, 0x3a, 0x17, 0xbc, 0x61, 0xdd, 0xcd, 0x84, 0xc8, 0x18, 0x58, 0xe5, 0xb4, 0x9a, 0x45, 0x3f,
-	0x48, 0x52, 0x96, 0x20, 0xdd, 0xf9, 0xfb, 0xbf, 0xde, 0x44, 0xbb, 0x87, 0x86, 0x13, 0xdb, 0xe6,
-	0xf0, 0x63, 0xd4, 0x5e, 0x8c, 0xfc, 0xce, 0xbd, 0xd6, 0x87, 0x37, 0xc6, 0x9f, 0x86, 0xff, 0xdd,
-	0x63, 0xf8, 0x2f, 0x59, 0x78, 0x3a, 0x8a, 0xdb, 0x8b, 0x51, 0xf0, 0xe7, 0x2e, 0x6a, 0x9f, 0x8e,
-	0xf0, 0x53, 0xd4, 0x51, 0x17, 0xca, 0x6f, 0x19, 0xca, 0xde, 0xeb, 0x50, 0xc2, 0xc9, 0x85, 0xd2,
-	0x50, 0xc4, 0xb5, 0x1e, 0x3f, 0x43, 0x1d, 0xb5, 0xa0, 0x7e, 0xdb, 0x60, 0xf6, 0x5f, 0x0f, 0x03,
-	0x72, 0x91, 0x53, 0x88, 0x6b, 0x40, 0xf0, 0xf3, 0x0e, 0xea, 0x59, 0x2e, 0xde, 0x47, 0x5e, 0xc1,
-	0x14, 0x71, 0xd6, 0xee, 0xbd, 0xc2, 0xcc, 0xf9, 0x4c, 0x92, 0xd0, 0x0e, 0x36, 0xfc, 0x86, 0x29,
-	0x12, 0x9b, 0x6a, 0xfc, 0x18, 0x75, 0x34, 0x53, 0xce, 0xc8, 0xc7, 0xab, 0x44, 0x2f, 0x8e, 0x27,
-	0x87, 0x12, 0x52, 0xe0, 0x3a, 0x27, 0x4c, 0xc5, 0xb5, 0x0c, 0xbf, 0x44, 0x5b, 0xca, 0xda, 0x71,
-	0x73, 0xfd, 0x7c, 0x83, 0x89, 0x2c, 0x3b, 0x6a, 0x58, 0xf8, 0x18, 0x79, 0x22, 0x4f, 0xa9, 0xef,
-	0x19, 0xe6, 0x83, 0x4d, 0x98, 0x27, 0x79, 0x4a, 0x63, 0x43, 0x31, 0x26, 0xb5, 0x90, 0x24, 0x03,
-	0xbf, 0xf7, 0x06, 0x26, 0x2d, 0x22, 0x6e, 0x58, 0x38, 0x46, 0x3d, 0x26, 0xb2, 0x0c, 0xa4, 0xbf,
-	0x65, 0xa8, 0x8f, 0x36, 0xa1, 0x1e, 0x1b, 0x42, 0xec, 0x48, 0xf8, 0x33, 0xd4, 0x2d, 0xa5, 0x38,
-	0xbf, 0xf0, 0xfb, 0x06, 0xf9, 0xde, 0xaa, 0xe7, 0xf1, 0x6d, 0x5d, 0x18, 0xdb, 0x7a, 0x7c, 0x82,
-	0xba, 0x73, 0xad, 0xcb, 0x91, 0xbf, 0x6d, 0x84, 0x0f, 0x37, 0xf1, 0xf2, 0x75, 0x0d, 0x88, 0x2d,
-	0x27, 0xf8, 0xa3, 0x8d, 0xb6, 0xdc, 0x73, 0xc1, 0xf7, 0x91, 0x37, 0x17, 0x4a, 0xbb, 0x64, 0xbd,
-	0x1b, 0xda, 0xcd, 0x0b, 0x9b, 0xcd, 0x0b, 0x27, 0x5a, 0xe6, 0x3c, 0x3b, 0x25, 0xac, 0x82, 0xd8,
-	0x54, 0xe2, 0xaf, 0x90, 0x57, 0x0a, 0xa9, 0x5d, 0xac, 0xee, 0x5c, 0x53, 0x3c, 0xe7, 0x7a, 0x6f,
-	0x6c, 0x04, 0x07, 0x6f, 0x5f, 0x5e, 0xf9, 0x78, 0x19, 0xa3, 0xc1, 0x4f, 0x27, 0x81, 0x57, 0xbf,
-	0x11, 0x62, 0x03, 0xc0, 0xf7, 0x51, 0x37, 0x85, 0x69, 0x95, 0xb9, 0x78, 0x05, 0xd7, 0x48, 0x07,
-	0x42, 0x30, 0x7b, 0x67, 0x5b, 0x88, 0xbf, 0x44, 0xbb, 0x70, 0xae, 0x41, 0x72, 0xc2, 0x92, 0xd9,
-	0x59, 0xca, 0x5d, 0x88, 0x56, 0xbb, 0xde, 0x69, 0x24, 0xcf, 0xce, 0x52, 0x8e, 0x27, 0xe8, 0x1d,
-	0x32, 0x4a, 0x52, 0xa2, 0x49, 0x42, 0x05, 0x63, 0x40, 0xb5, 0x90, 0x89, 0x16, 0xdf, 0x03, 0xf7,
-	0xbb, 0x6b, 0xc0, 0x6e, 0x93, 0xd1, 0x13, 0xa2, 0xc9, 0x61, 0x23, 0x7d, 0x51, 0x2b, 0x83, 0xdf,
-	0x5b, 0xc8, 0xab, 0x43, 0x89, 0x1f, 0xa2, 0x6d, 0xca, 0x72, 0xe0, 0x3a, 0xc9, 0xd3, 0xb5, 0x46,
-	0xda, 0xb7, 0xe5, 0xcf, 0xd3, 0xba, 0x37, 0x27, 0x55, 0x40, 0x25, 0x34, 0xf3, 0xfd, 0x9f, 0xde,
-	0xac, 0x64, 0x62, 0x14, 0xf8, 0x0b, 0xb4, 0x23, 0x21, 0xcd, 0x25, 0x50, 0x9d, 0x54, 0x92, 0xb9,
-	0xb9, 0xae, 0x26, 0xdc, 0x68, 0x14, 0x2f, 0x25, 0x0b, 0x7e, 0x6c, 0xa1, 0xae, 0x49, 0xca, 0x06,
-	0xb1, 0x78, 0xba, 0x7e, 0x2c, 0x6e, 0x5f, 0x5e, 0xf9, 0x03, 0x17, 0xea, 0xc1, 0x2f, 0x27, 0x81,
-	0x57, 0xff, 0xb2, 0xa1, 0x08, 0x7e, 0x6b, 0xa1, 0x2d, 0xb7, 0x8e, 0xf8, 0x01, 0xea, 0xd7, 0x0f,
-	0x6a, 0x4a, 0x14, 0xac, 0x37, 0xcc, 0xa6, 0xba, 0xb6, 0x5f, 0x29, 0x90, 0x6b, 0xcd, 0xd0, 0x54,
-	0xe2, 0x27, 0xe8, 0x56, 0x41, 0xce, 0x13, 0x2a, 0x38, 0x07, 0x6a, 0xbe, 0x45, 0x2e, 0x0f, 0xab,
-	0x3a, 0x89, 0x6f, 0x16, 0xe4, 0xfc, 0xf0, 0x6f, 0xc9, 0x91, 0xd7, 0xef, 0x0c, 0xba, 0x81, 0x44,
-	0x3d, 0xbb, 0xfb, 0x78, 0x1f, 0xf5, 0x66, 0x42, 0x16, 0x64, 0xbd, 0x41, 0xba, 0x5a, 0x3c, 0x46,
-	0x5d, 0x06, 0x0b, 0x60, 0x6b, 0xd9, 0xb7, 0xa5, 0x47, 0x5e, 0xbf, 0x3b, 0xe8, 0x05, 0xdb, 0xcb,
-	0xc5, 0x7e, 0xf4, 0xd6, 0xe5, 0x95, 0x7f, 0x0b, 0xed, 0x9a, 0xd7, 0xc2, 0xd0, 0x6d, 0xe0, 0x91,
-	0xd7, 0x6f, 0x0d, 0x3a, 0x07, 0x9f, 0x7c, 0xf7, 0x51, 0x96, 0xeb, 0x79, 0x35, 0x0d, 0xa9, 0x28,
-	0xa2, 0xfa, 0x6d, 0xb2, 0xfc, 0x62, 0x47, 0xaf, 0xfe, 0x8b, 0x98, 0xf6, 0xcc, 0x5d, 0xf7, 0xfe,
-	0x0a, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xa0, 0x48, 0x8f, 0x60, 0x08, 0x00, 0x00,
+	proto.RegisterFile("api/config/authn/config_request.proto", fileDescriptor_config_request_9ce2a80f619b32e3)
+}
+
+var fileDescriptor_config_request_9ce2a80f619b32e3 = []byte{
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xdf, 0x6e, 0xdb, 0x36,
+	0x14, 0xc6, 0x61, 0x5b, 0x72, 0x9c, 0xe3, 0x66, 0xf1, 0xb8, 0x62, 0x13, 0xd4, 0xa1, 0xe8, 0x06,
+	0x0c, 0xe8, 0xfe, 0x58, 0xaa, 0x9d, 0x00, 0x6b, 0xbb, 0x62, 0xc3, 0xe2, 0xb6, 0x5b, 0x8d, 0x14,
+	0x19, 0xe4, 0x34, 0x17, 0xbb, 0xf1, 0x68, 0x8a, 0x96, 0x85, 0x51, 0xa4, 0x42, 0x51, 0x5e, 0x72,
+	0xb7, 0xbd, 0xc8, 0x1e, 0xa2, 0x0f, 0x30, 0x60, 0x01, 0x76, 0xbb, 0x9b, 0x3d, 0x4a, 0x5e, 0x60,
+	0x20, 0x25, 0xb9, 0x59, 0x83, 0x35, 0xaa, 0x73, 0x27, 0x89, 0xdf, 0xf7, 0xd3, 0x39, 0x87, 0x87,
+	0x47, 0x82, 0x4f, 0x70, 0x1a, 0xfb, 0x44, 0xf0, 0x79, 0x1c, 0xf9, 0x38, 0x57, 0x0b, 0x5e, 0xde,
+	0x4c, 0x25, 0x3d, 0xce, 0x69, 0xa6, 0xbc, 0x54, 0x0a, 0x25, 0x90, 0x4b, 0x16, 0x74, 0xee, 0xe1,
+	0x5c, 0x89, 0x04, 0x2b, 0xea, 0x85, 0x22, 0xc1, 0x31, 0xf7, 0x8c, 0xc1, 0xbd, 0x7d, 0x01, 0x91,
+	0x2d, 0xb0, 0xa4, 0xa1, 0x1f, 0x31, 0x31, 0xc3, 0xac, 0xf0, 0xba, 0xb7, 0x2e, 0xaf, 0x2b, 0x96,
+	0x95, 0x8b, 0x63, 0x22, 0x92, 0x54, 0x70, 0xca, 0x55, 0xe6, 0x57, 0xf8, 0x7e, 0x24, 0x53, 0xe2,
+	0x9b, 0x75, 0xd2, 0x8f, 0x28, 0xef, 0xe3, 0x61, 0xbf, 0x0a, 0x31, 0x8d, 0x7d, 0x3c, 0xd4, 0x37,
+	0x3e, 0xe6, 0x5c, 0x28, 0xac, 0x62, 0xc1, 0x2b, 0xd6, 0xed, 0x48, 0x88, 0x88, 0xd1, 0xc2, 0x39,
+	0xcb, 0xe7, 0xfe, 0x2f, 0x12, 0xa7, 0x29, 0x95, 0xe5, 0xfa, 0xc7, 0x7f, 0xbc, 0x0b, 0x5b, 0x23,
+	0xc3, 0x09, 0x8a, 0xe4, 0xd0, 0x23, 0x68, 0x2e, 0x07, 0x4e, 0xeb, 0x4e, 0xe3, 0x6e, 0x77, 0xf8,
+	0x85, 0xf7, 0xff, 0x39, 0x7a, 0xff, 0xb1, 0x79, 0x47, 0x83, 0xa0, 0xb9, 0x1c, 0xb8, 0xff, 0xf4,
+	0xa0, 0x79, 0x34, 0x40, 0x4f, 0xa0, 0x95, 0x9d, 0x66, 0x4e, 0xc3, 0x50, 0x76, 0xde, 0x86, 0xe2,
+	0x4d, 0x4e, 0x33, 0x45, 0x93, 0x40, 0xfb, 0xd1, 0x53, 0x68, 0x65, 0x4b, 0xe2, 0x34, 0x0d, 0x66,
+	0xf7, 0xed, 0x30, 0x54, 0x2e, 0x63, 0x42, 0x03, 0x0d, 0x70, 0x5f, 0x6e, 0x43, 0xbb, 0xe0, 0xa2,
+	0x5d, 0xb0, 0x12, 0x96, 0xe1, 0x32, 0xb4, 0x3b, 0xaf, 0x31, 0x63, 0x3e, 0x97, 0xd8, 0x2b, 0x0a,
+	0xeb, 0x3d, 0x67, 0x19, 0x0e, 0x8c, 0x1a, 0x3d, 0x82, 0x96, 0x62, 0x59, 0x19, 0xc8, 0x67, 0x6f,
+	0x32, 0x1d, 0xee, 0x4f, 0x46, 0x92, 0x86, 0x94, 0xab, 0x18, 0xb3, 0x2c, 0xd0, 0x36, 0xf4, 0x02,
+	0x36, 0xb2, 0x22, 0x9c, 0xb2, 0xae, 0x5f, 0xad, 0x51, 0x91, 0x55, 0x46, 0x15, 0x0b, 0xed, 0x83,
+	0x25, 0xe2, 0x90, 0x38, 0x96, 0x61, 0xde, 0x5f, 0x87, 0x79, 0x10, 0x87, 0x24, 0x30, 0x14, 0x13,
+	0xa4, 0x12, 0x12, 0x47, 0xd4, 0x69, 0x5f, 0x23, 0xc8, 0x02, 0x11, 0x54, 0x2c, 0x14, 0x40, 0x9b,
+	0x89, 0x28, 0xa2, 0xd2, 0xd9, 0x30, 0xd4, 0x87, 0xeb, 0x50, 0xf7, 0x0d, 0x21, 0x28, 0x49, 0xe8,
+	0x4b, 0xb0, 0x53, 0x29, 0x4e, 0x4e, 0x9d, 0x8e, 0x41, 0x7e, 0xf4, 0xa6, 0xfd, 0xf8, 0x41, 0x0b,
+	0x83, 0x42, 0x8f, 0x0e, 0xc0, 0x5e, 0x28, 0x95, 0x0e, 0x9c, 0x4d, 0x63, 0x7c, 0xb0, 0x4e, 0x2c,
+	0xdf, 0x6b, 0x40, 0x50, 0x70, 0xd0, 0x14, 0xba, 0x84, 0xc5, 0x94, 0xab, 0x29, 0xa1, 0x52, 0x39,
+	0x60, 0xb0, 0x5f, 0xaf, 0x83, 0x1d, 0x19, 0xcc, 0x88, 0x4a, 0x15, 0x00, 0x59, 0x5d, 0xbb, 0x7f,
+	0x37, 0x61, 0xa3, 0xdc, 0x78, 0x74, 0x0f, 0xac, 0x85, 0xc8, 0x54, 0xd9, 0xba, 0x1f, 0x7a, 0xc5,
+	0xd1, 0xf6, 0xaa, 0xa3, 0xed, 0x4d, 0x94, 0x8c, 0x79, 0x74, 0x84, 0x59, 0x4e, 0x03, 0xa3, 0x44,
+	0xdf, 0x81, 0x95, 0x0a, 0xa9, 0xca, 0xbe, 0xbd, 0x75, 0xc9, 0xf1, 0x8c, 0xab, 0x9d, 0xa1, 0x31,
+	0xec, 0xbd, 0x7f, 0x76, 0xee, 0xa0, 0x55, 0x9f, 0xf6, 0x7e, 0x3b, 0x70, 0x2d, 0x3d, 0x72, 0x02,
+	0x03, 0x40, 0xf7, 0xc0, 0x0e, 0xe9, 0x2c, 0x8f, 0xca, 0xfe, 0x75, 0x2f, 0x91, 0xf6, 0x84, 0x60,
+	0xc5, 0x9b, 0x0b, 0x21, 0xfa, 0x16, 0xb6, 0xe8, 0x89, 0xa2, 0x92, 0x63, 0x36, 0x9d, 0x1f, 0x87,
+	0xdc, 0xb1, 0x6a, 0x44, 0x7d, 0xa3, 0xb2, 0x3c, 0x3d, 0x0e, 0x39, 0x9a, 0xc0, 0x07, 0x78, 0x30,
+	0x0d, 0xb1, 0xc2, 0x53, 0x22, 0x18, 0xa3, 0x44, 0x09, 0x39, 0x55, 0xe2, 0x67, 0xca, 0x1d, 0xbb,
+	0x06, 0xec, 0x26, 0x1e, 0x3c, 0xc6, 0x0a, 0x8f, 0x2a, 0xeb, 0xa1, 0x76, 0xba, 0x7f, 0x35, 0xc0,
+	0xd2, 0x5d, 0x8f, 0x1e, 0xc0, 0x66, 0xb9, 0x75, 0x71, 0x58, 0xab, 0xa4, 0x9d, 0x42, 0xfe, 0x2c,
+	0xd4, 0xb9, 0x95, 0xd6, 0x8c, 0x12, 0x49, 0xab, 0xfa, 0x5e, 0x91, 0x5b, 0x61, 0x99, 0x18, 0x07,
+	0xfa, 0x06, 0x6e, 0x48, 0x1a, 0xc6, 0x92, 0x12, 0x35, 0xcd, 0x25, 0x73, 0x5a, 0x35, 0x08, 0xdd,
+	0xca, 0xf1, 0x42, 0x32, 0xf7, 0xd7, 0x06, 0xd8, 0xa6, 0x15, 0xd7, 0x68, 0x8b, 0x27, 0xf5, 0xdb,
+	0xe2, 0xe6, 0xd9, 0xb9, 0xd3, 0x2b, 0x4f, 0x4d, 0xef, 0xe5, 0x81, 0x6b, 0xe9, 0xab, 0xa2, 0x29,
+	0xdc, 0x3f, 0x1b, 0xb0, 0x51, 0x9e, 0x77, 0x74, 0x1f, 0x3a, 0x7a, 0xa3, 0x66, 0x38, 0xa3, 0xf5,
+	0x8a, 0x59, 0xa9, 0x75, 0xf8, 0x79, 0x46, 0x65, 0xad, 0x1a, 0x1a, 0x25, 0x7a, 0x0c, 0xdb, 0x09,
+	0x3e, 0x99, 0x12, 0xc1, 0x39, 0x25, 0xe6, 0x63, 0xe7, 0xd8, 0x57, 0x66, 0x12, 0xbc, 0x93, 0xe0,
+	0x93, 0xd1, 0x2b, 0xcb, 0xd8, 0xea, 0xb4, 0x7a, 0xb6, 0x2b, 0xa1, 0x5d, 0x0c, 0x17, 0xb4, 0x0b,
+	0xed, 0xb9, 0x90, 0x09, 0xae, 0x57, 0xc8, 0x52, 0x8b, 0x86, 0x60, 0x33, 0xba, 0xa4, 0xac, 0x56,
+	0xf8, 0x85, 0xd4, 0xfd, 0xbd, 0x09, 0xf0, 0xea, 0xb8, 0xeb, 0x46, 0x94, 0x42, 0x94, 0x13, 0xa4,
+	0x56, 0xed, 0xb4, 0xdc, 0x58, 0x7f, 0x02, 0x5b, 0x51, 0x9c, 0xe8, 0x0f, 0x53, 0xeb, 0x6e, 0x77,
+	0x38, 0xbe, 0xde, 0xe0, 0xf1, 0x0e, 0x29, 0x4e, 0x9e, 0xe3, 0x34, 0x8d, 0x79, 0x14, 0x14, 0x60,
+	0x37, 0x83, 0xee, 0x85, 0xa7, 0x7a, 0xb3, 0x38, 0x4e, 0xea, 0x6d, 0xb1, 0x51, 0xea, 0x02, 0x5d,
+	0x0c, 0xf1, 0x8a, 0x02, 0x19, 0xe9, 0xd8, 0xea, 0xd8, 0xbd, 0xb6, 0xbb, 0xb9, 0x9a, 0x7c, 0x0f,
+	0xdf, 0x3b, 0x3b, 0x77, 0xb6, 0x61, 0xcb, 0x24, 0xd2, 0x2f, 0x47, 0xd4, 0xd8, 0xea, 0x34, 0x7a,
+	0xad, 0xbd, 0xcf, 0x7f, 0xfc, 0x34, 0x8a, 0xd5, 0x22, 0x9f, 0x79, 0x44, 0x24, 0xbe, 0xce, 0x7f,
+	0xf5, 0xcf, 0xe4, 0xbf, 0xfe, 0x1f, 0x37, 0x6b, 0x9b, 0xb7, 0xee, 0xfc, 0x3b, 0x00, 0x7a, 0xad,
+	0xee, 0x10, 0xe2, 0x09, 0x00, 0x00,
 }
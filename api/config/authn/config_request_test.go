@@ -3,6 +3,7 @@ package authn_test
 import (
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/chef/automate/api/config/authn"
@@ -37,3 +38,56 @@ func TestDefaultConfigRequest(t *testing.T) {
 	assert.EqualValues(t, 10113, c.V1.Sys.Service.Port.Value)
 	assert.Equal(t, "info", c.V1.Sys.Logger.Level.Value)
 }
+
+func TestValidateClientCert(t *testing.T) {
+	rootCert := `-----BEGIN CERTIFICATE-----
+MIIBfDCCASGgAwIBAgIUWAwwwYiStE9Xz5/0ra9aIcpPRDEwCgYIKoZIzj0EAwIw
+EjEQMA4GA1UEAwwHVGVzdCBDQTAgFw0yNjEwMTgxMTQwNTdaGA8yMTI2MDkyNDEx
+NDA1N1owEjEQMA4GA1UEAwwHVGVzdCBDQTBZMBMGByqGSM49AgEGCCqGSM49AwEH
+A0IABKA/mn5Yws1c7yvOvvYdJttGZu+jGeHa7kzfUuEkYF2J2JSa/GFcX7FPtNCR
+Ny8LEibe4InQa/fONj4g8RPu5uyjUzBRMB0GA1UdDgQWBBScd/Ipmx3YzaIAyvel
+cur6Xt8OrjAfBgNVHSMEGDAWgBScd/Ipmx3YzaIAyvelcur6Xt8OrjAPBgNVHRMB
+Af8EBTADAQH/MAoGCCqGSM49BAMCA0kAMEYCIQC9BgtJNBG2sYLcZmmM++ObGcT7
+dgfMFhKM6BM9JXrKYAIhALIe12UuzF1+B+oor6awOI1LLdvprUPB1lYpQCcpYdmI
+-----END CERTIFICATE-----`
+
+	t.Run("it accepts a root cert with team mappings", func(t *testing.T) {
+		c := authn.DefaultConfigRequest()
+		c.V1.Sys.ClientCert = &authn.ConfigRequest_V1_System_ClientCert{
+			RootCert: w.String(rootCert),
+			Teams: []*authn.ConfigRequest_V1_System_ClientCert_TeamMapping{
+				{Name: w.String("ci-agent"), Teams: []*wrappers.StringValue{w.String("ci")}},
+			},
+		}
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("it requires the root cert when teams are mapped", func(t *testing.T) {
+		c := authn.DefaultConfigRequest()
+		c.V1.Sys.ClientCert = &authn.ConfigRequest_V1_System_ClientCert{
+			Teams: []*authn.ConfigRequest_V1_System_ClientCert_TeamMapping{
+				{Name: w.String("ci-agent"), Teams: []*wrappers.StringValue{w.String("ci")}},
+			},
+		}
+		assert.Error(t, c.Validate())
+	})
+
+	t.Run("it rejects a root cert that isn't PEM", func(t *testing.T) {
+		c := authn.DefaultConfigRequest()
+		c.V1.Sys.ClientCert = &authn.ConfigRequest_V1_System_ClientCert{
+			RootCert: w.String("not a cert"),
+		}
+		assert.Error(t, c.Validate())
+	})
+
+	t.Run("it requires names for team mappings", func(t *testing.T) {
+		c := authn.DefaultConfigRequest()
+		c.V1.Sys.ClientCert = &authn.ConfigRequest_V1_System_ClientCert{
+			RootCert: w.String(rootCert),
+			Teams: []*authn.ConfigRequest_V1_System_ClientCert_TeamMapping{
+				{Teams: []*wrappers.StringValue{w.String("ci")}},
+			},
+		}
+		assert.Error(t, c.Validate())
+	})
+}
@@ -1,6 +1,8 @@
 package authz
 
 import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+
 	config "github.com/chef/automate/api/config/shared"
 	w "github.com/chef/automate/api/config/shared/wrappers"
 )
@@ -15,6 +17,7 @@ func NewConfigRequest() *ConfigRequest {
 				Service: &ConfigRequest_V1_System_Service{},
 				Logger:  &ConfigRequest_V1_System_Logger{},
 				Storage: &ConfigRequest_V1_System_Storage{},
+				Audit:   &ConfigRequest_V1_System_Audit{},
 			},
 			Svc: &ConfigRequest_V1_Service{},
 		},
@@ -31,6 +34,13 @@ func DefaultConfigRequest() *ConfigRequest {
 	c.V1.Sys.Logger.Format = w.String("text")
 	c.V1.Sys.Storage.Database = w.String("chef_authz_service")
 	c.V1.Sys.Storage.User = w.String("authz")
+	c.V1.Sys.Audit.Enabled = w.Bool(false)
+	c.V1.Sys.Audit.Store = w.String("file")
+	c.V1.Sys.Audit.MaxSizeMb = w.Int32(100)
+	c.V1.Sys.Audit.MaxBackups = w.Int32(10)
+	c.V1.Sys.Audit.RetentionDays = w.Int32(90)
+	c.V1.Sys.Audit.AllowedSampleRate = w.Double(1)
+	c.V1.Sys.Audit.DeniedSampleRate = w.Double(1)
 
 	return c
 }
@@ -40,7 +50,37 @@ func DefaultConfigRequest() *ConfigRequest {
 // instance of config.InvalidConfigError that has the missing keys and invalid
 // fields populated.
 func (c *ConfigRequest) Validate() error {
-	return nil
+	cfgErr := config.NewInvalidConfigError()
+
+	if a := c.GetV1().GetSys().GetAudit(); a != nil {
+		switch store := a.GetStore(); {
+		case store == nil, store.GetValue() == "file", store.GetValue() == "postgresql":
+		default:
+			cfgErr.AddInvalidValue("auth_z.v1.sys.audit.store", "must be one of 'file', 'postgresql'")
+		}
+
+		checkRate := func(key string, rate *wrappers.DoubleValue) {
+			if rate != nil && (rate.GetValue() < 0 || rate.GetValue() > 1) {
+				cfgErr.AddInvalidValue(key, "must be between 0 and 1")
+			}
+		}
+		checkRate("auth_z.v1.sys.audit.allowed_sample_rate", a.GetAllowedSampleRate())
+		checkRate("auth_z.v1.sys.audit.denied_sample_rate", a.GetDeniedSampleRate())
+
+		checkNonNegative := func(key string, n *wrappers.Int32Value) {
+			if n != nil && n.GetValue() < 0 {
+				cfgErr.AddInvalidValue(key, "must not be negative")
+			}
+		}
+		checkNonNegative("auth_z.v1.sys.audit.max_size_mb", a.GetMaxSizeMb())
+		checkNonNegative("auth_z.v1.sys.audit.max_backups", a.GetMaxBackups())
+		checkNonNegative("auth_z.v1.sys.audit.retention_days", a.GetRetentionDays())
+	}
+
+	if cfgErr.IsEmpty() {
+		return nil
+	}
+	return cfgErr
 }
 
 // PrepareSystemConfig returns a system configuration that can be used
@@ -32,7 +32,7 @@ func (m *ConfigRequest) Reset()         { *m = ConfigRequest{} }
 func (m *ConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest) ProtoMessage()    {}
 func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_f3fcfbd6463951fe, []int{0}
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0}
 }
 func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest.Unmarshal(m, b)
@@ -71,7 +71,7 @@ func (m *ConfigRequest_V1) Reset()         { *m = ConfigRequest_V1{} }
 func (m *ConfigRequest_V1) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1) ProtoMessage()    {}
 func (*ConfigRequest_V1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_f3fcfbd6463951fe, []int{0, 0}
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0, 0}
 }
 func (m *ConfigRequest_V1) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1.Unmarshal(m, b)
@@ -111,6 +111,7 @@ type ConfigRequest_V1_System struct {
 	Service              *ConfigRequest_V1_System_Service `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty" toml:"service,omitempty" mapstructure:"service,omitempty"`
 	Logger               *ConfigRequest_V1_System_Logger  `protobuf:"bytes,4,opt,name=logger,proto3" json:"logger,omitempty" toml:"logger,omitempty" mapstructure:"logger,omitempty"`
 	Storage              *ConfigRequest_V1_System_Storage `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty" toml:"storage,omitempty" mapstructure:"storage,omitempty"`
+	Audit                *ConfigRequest_V1_System_Audit   `protobuf:"bytes,6,opt,name=audit,proto3" json:"audit,omitempty" toml:"audit,omitempty" mapstructure:"audit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                         `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte                           `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -120,7 +121,7 @@ func (m *ConfigRequest_V1_System) Reset()         { *m = ConfigRequest_V1_System
 func (m *ConfigRequest_V1_System) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System) ProtoMessage()    {}
 func (*ConfigRequest_V1_System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_f3fcfbd6463951fe, []int{0, 0, 0}
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0, 0, 0}
 }
 func (m *ConfigRequest_V1_System) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System.Unmarshal(m, b)
@@ -175,6 +176,13 @@ func (m *ConfigRequest_V1_System) GetStorage() *ConfigRequest_V1_System_Storage
 	return nil
 }
 
+func (m *ConfigRequest_V1_System) GetAudit() *ConfigRequest_V1_System_Audit {
+	if m != nil {
+		return m.Audit
+	}
+	return nil
+}
+
 type ConfigRequest_V1_System_Service struct {
 	Host                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty" toml:"host,omitempty" mapstructure:"host,omitempty"`
 	Port                 *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty" toml:"port,omitempty" mapstructure:"port,omitempty"`
@@ -187,7 +195,7 @@ func (m *ConfigRequest_V1_System_Service) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_f3fcfbd6463951fe, []int{0, 0, 0, 0}
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0, 0, 0, 0}
 }
 func (m *ConfigRequest_V1_System_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Service.Unmarshal(m, b)
@@ -233,7 +241,7 @@ func (m *ConfigRequest_V1_System_Logger) Reset()         { *m = ConfigRequest_V1
 func (m *ConfigRequest_V1_System_Logger) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Logger) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Logger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_f3fcfbd6463951fe, []int{0, 0, 0, 1}
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0, 0, 0, 1}
 }
 func (m *ConfigRequest_V1_System_Logger) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Logger.Unmarshal(m, b)
@@ -279,7 +287,7 @@ func (m *ConfigRequest_V1_System_Storage) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Storage) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Storage) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Storage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_f3fcfbd6463951fe, []int{0, 0, 0, 2}
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0, 0, 0, 2}
 }
 func (m *ConfigRequest_V1_System_Storage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Storage.Unmarshal(m, b)
@@ -313,6 +321,104 @@ func (m *ConfigRequest_V1_System_Storage) GetUser() *wrappers.StringValue {
 	return nil
 }
 
+// Audit configures the audit log of IAM v2 authorization decisions.
+// store is either "file" or "postgresql"; path, max_size_mb and
+// max_backups apply to the former, retention_days to the latter.
+// Sample rates are between 0 (record none) and 1 (record all).
+type ConfigRequest_V1_System_Audit struct {
+	Enabled              *wrappers.BoolValue   `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty" toml:"enabled,omitempty" mapstructure:"enabled,omitempty"`
+	Store                *wrappers.StringValue `protobuf:"bytes,2,opt,name=store,proto3" json:"store,omitempty" toml:"store,omitempty" mapstructure:"store,omitempty"`
+	Path                 *wrappers.StringValue `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty" toml:"path,omitempty" mapstructure:"path,omitempty"`
+	MaxSizeMb            *wrappers.Int32Value  `protobuf:"bytes,4,opt,name=max_size_mb,json=maxSizeMb,proto3" json:"max_size_mb,omitempty" toml:"max_size_mb,omitempty" mapstructure:"max_size_mb,omitempty"`
+	MaxBackups           *wrappers.Int32Value  `protobuf:"bytes,5,opt,name=max_backups,json=maxBackups,proto3" json:"max_backups,omitempty" toml:"max_backups,omitempty" mapstructure:"max_backups,omitempty"`
+	RetentionDays        *wrappers.Int32Value  `protobuf:"bytes,6,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty" toml:"retention_days,omitempty" mapstructure:"retention_days,omitempty"`
+	AllowedSampleRate    *wrappers.DoubleValue `protobuf:"bytes,7,opt,name=allowed_sample_rate,json=allowedSampleRate,proto3" json:"allowed_sample_rate,omitempty" toml:"allowed_sample_rate,omitempty" mapstructure:"allowed_sample_rate,omitempty"`
+	DeniedSampleRate     *wrappers.DoubleValue `protobuf:"bytes,8,opt,name=denied_sample_rate,json=deniedSampleRate,proto3" json:"denied_sample_rate,omitempty" toml:"denied_sample_rate,omitempty" mapstructure:"denied_sample_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                 `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_Audit) Reset()         { *m = ConfigRequest_V1_System_Audit{} }
+func (m *ConfigRequest_V1_System_Audit) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest_V1_System_Audit) ProtoMessage()    {}
+func (*ConfigRequest_V1_System_Audit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0, 0, 0, 3}
+}
+func (m *ConfigRequest_V1_System_Audit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_Audit.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_Audit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_Audit.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_Audit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_Audit.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_Audit) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_Audit.Size(m)
+}
+func (m *ConfigRequest_V1_System_Audit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_Audit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_Audit proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_Audit) GetEnabled() *wrappers.BoolValue {
+	if m != nil {
+		return m.Enabled
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Audit) GetStore() *wrappers.StringValue {
+	if m != nil {
+		return m.Store
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Audit) GetPath() *wrappers.StringValue {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Audit) GetMaxSizeMb() *wrappers.Int32Value {
+	if m != nil {
+		return m.MaxSizeMb
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Audit) GetMaxBackups() *wrappers.Int32Value {
+	if m != nil {
+		return m.MaxBackups
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Audit) GetRetentionDays() *wrappers.Int32Value {
+	if m != nil {
+		return m.RetentionDays
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Audit) GetAllowedSampleRate() *wrappers.DoubleValue {
+	if m != nil {
+		return m.AllowedSampleRate
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Audit) GetDeniedSampleRate() *wrappers.DoubleValue {
+	if m != nil {
+		return m.DeniedSampleRate
+	}
+	return nil
+}
+
 type ConfigRequest_V1_Service struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -323,7 +429,7 @@ func (m *ConfigRequest_V1_Service) Reset()         { *m = ConfigRequest_V1_Servi
 func (m *ConfigRequest_V1_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_f3fcfbd6463951fe, []int{0, 0, 1}
+	return fileDescriptor_config_request_f46885a3e8dd543a, []int{0, 0, 1}
 }
 func (m *ConfigRequest_V1_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_Service.Unmarshal(m, b)
@@ -350,49 +456,61 @@ func init() {
 	proto.RegisterType((*ConfigRequest_V1_System_Service)(nil), "chef.automate.domain.authz.ConfigRequest.V1.System.Service")
 	proto.RegisterType((*ConfigRequest_V1_System_Logger)(nil), "chef.automate.domain.authz.ConfigRequest.V1.System.Logger")
 	proto.RegisterType((*ConfigRequest_V1_System_Storage)(nil), "chef.automate.domain.authz.ConfigRequest.V1.System.Storage")
+	proto.RegisterType((*ConfigRequest_V1_System_Audit)(nil), "chef.automate.domain.authz.ConfigRequest.V1.System.Audit")
 	proto.RegisterType((*ConfigRequest_V1_Service)(nil), "chef.automate.domain.authz.ConfigRequest.V1.Service")
 }
 
 func init() {
-	proto.RegisterFile("api/config/authz/config_request.proto", fileDescriptor_config_request_f3fcfbd6463951fe)
-}
-
-var fileDescriptor_config_request_f3fcfbd6463951fe = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x5d, 0x6b, 0xd4, 0x40,
-	0x14, 0x86, 0xd9, 0x4d, 0xf6, 0xc3, 0x91, 0x62, 0x19, 0x41, 0x42, 0x2a, 0xa5, 0x08, 0x82, 0x5f,
-	0x3b, 0x71, 0x3f, 0x2e, 0xa4, 0xf6, 0xaa, 0x45, 0xc5, 0xa5, 0x22, 0x64, 0x75, 0x2f, 0xbc, 0x91,
-	0xb3, 0xd9, 0xd9, 0xd9, 0xc0, 0x64, 0x26, 0xce, 0x4c, 0x56, 0xaa, 0x77, 0xe2, 0x2f, 0xf0, 0xc6,
-	0xff, 0xd3, 0xbf, 0x54, 0xf0, 0x5a, 0x32, 0x99, 0x2c, 0xda, 0x62, 0xd9, 0xd6, 0xcb, 0x70, 0xce,
-	0xfb, 0xcc, 0x7b, 0xde, 0x33, 0x19, 0x74, 0x1f, 0xf2, 0x34, 0x4a, 0xa4, 0x58, 0xa4, 0x2c, 0x82,
-	0xc2, 0x2c, 0xbf, 0xb8, 0x8f, 0x8f, 0x8a, 0x7e, 0x2a, 0xa8, 0x36, 0x24, 0x57, 0xd2, 0x48, 0x1c,
-	0x26, 0x4b, 0xba, 0x20, 0x50, 0x18, 0x99, 0x81, 0xa1, 0x64, 0x2e, 0x33, 0x48, 0x05, 0xb1, 0x82,
-	0x70, 0xf7, 0x0f, 0x84, 0x5e, 0x82, 0xa2, 0xf3, 0x88, 0x71, 0x39, 0x03, 0x5e, 0x69, 0xc3, 0x9d,
-	0x8b, 0x75, 0xc3, 0xb5, 0x2b, 0x8e, 0x13, 0x99, 0xe5, 0x52, 0x50, 0x61, 0x74, 0x54, 0xe3, 0x7b,
-	0x4c, 0xe5, 0x49, 0x64, 0xeb, 0x49, 0x8f, 0x51, 0xd1, 0x83, 0x41, 0xaf, 0xb6, 0x98, 0xa7, 0x11,
-	0x0c, 0xca, 0x8f, 0x08, 0x84, 0x90, 0x06, 0x4c, 0x2a, 0x45, 0xcd, 0xda, 0x65, 0x52, 0x32, 0x4e,
-	0x2b, 0xe5, 0xac, 0x58, 0x44, 0x9f, 0x15, 0xe4, 0x39, 0x55, 0xae, 0x7e, 0xef, 0x5b, 0x17, 0x6d,
-	0x1d, 0x59, 0x4e, 0x5c, 0x0d, 0x87, 0x0f, 0x50, 0x73, 0xd5, 0x0f, 0xbc, 0xbd, 0xc6, 0x83, 0x9b,
-	0x83, 0x27, 0xe4, 0xdf, 0x33, 0x92, 0xbf, 0x64, 0x64, 0xda, 0x8f, 0x9b, 0xab, 0x7e, 0xf8, 0xb3,
-	0x83, 0x9a, 0xd3, 0x3e, 0x7e, 0x81, 0x3c, 0x7d, 0xa2, 0x83, 0x86, 0xa5, 0x0c, 0xaf, 0x42, 0x21,
-	0x93, 0x13, 0x6d, 0x68, 0x16, 0x97, 0x7a, 0xfc, 0x12, 0x79, 0x7a, 0x95, 0x04, 0x4d, 0x8b, 0x19,
-	0x5d, 0x0d, 0x43, 0xd5, 0x2a, 0x4d, 0x68, 0x5c, 0x02, 0xc2, 0x5f, 0x2d, 0xd4, 0xae, 0xb8, 0x78,
-	0x84, 0xfc, 0x8c, 0x6b, 0x70, 0xd6, 0xf6, 0xce, 0x31, 0x53, 0xb1, 0x50, 0x40, 0xaa, 0x60, 0xc9,
-	0x1b, 0xae, 0x21, 0xb6, 0xdd, 0xf8, 0x00, 0x79, 0x86, 0x6b, 0x67, 0xe4, 0xd1, 0x65, 0xa2, 0x77,
-	0xc7, 0x93, 0x23, 0x45, 0xe7, 0x54, 0x98, 0x14, 0xb8, 0x8e, 0x4b, 0x19, 0x7e, 0x8f, 0x3a, 0xba,
-	0xb2, 0xe3, 0x72, 0x7d, 0x7e, 0x8d, 0x44, 0xd6, 0x13, 0xd5, 0x2c, 0x1c, 0xa3, 0x36, 0x97, 0x8c,
-	0x51, 0x15, 0xf8, 0x96, 0xba, 0x7f, 0x1d, 0xea, 0xb1, 0x25, 0xc4, 0x8e, 0x64, 0xad, 0x1a, 0xa9,
-	0x80, 0xd1, 0xa0, 0xf5, 0x1f, 0x56, 0x2b, 0x44, 0x5c, 0xb3, 0xc2, 0xef, 0x0d, 0xd4, 0x71, 0xfe,
-	0xf1, 0x53, 0xe4, 0x2f, 0xa5, 0x36, 0x6e, 0x03, 0x77, 0x49, 0x75, 0x43, 0x49, 0x7d, 0x43, 0xc9,
-	0xc4, 0xa8, 0x54, 0xb0, 0x29, 0xf0, 0x82, 0xc6, 0xb6, 0x13, 0xbf, 0x42, 0x7e, 0x2e, 0x95, 0x71,
-	0xf1, 0xef, 0x5c, 0x50, 0xbc, 0x16, 0x66, 0x38, 0xb0, 0x82, 0xc3, 0x3b, 0xa7, 0x67, 0x01, 0x5e,
-	0xc7, 0xbd, 0xfd, 0xe3, 0x6d, 0xe8, 0x97, 0x7f, 0x4e, 0x6c, 0x01, 0xa1, 0x42, 0xed, 0x6a, 0x5e,
-	0x3c, 0x42, 0xed, 0x85, 0x54, 0x19, 0x6c, 0x66, 0xc3, 0xf5, 0xe2, 0x01, 0x6a, 0x71, 0xba, 0xa2,
-	0xdc, 0x39, 0xb9, 0x5c, 0x54, 0xb5, 0x86, 0x5f, 0x51, 0xc7, 0xc5, 0x81, 0x9f, 0xa1, 0xee, 0x1c,
-	0x0c, 0xcc, 0x40, 0xd3, 0x8d, 0x8e, 0x5d, 0x77, 0x97, 0x99, 0x15, 0x9a, 0xaa, 0x8d, 0xce, 0xb5,
-	0x9d, 0x63, 0xbf, 0xeb, 0x6d, 0xb7, 0xc2, 0x1b, 0xeb, 0xd8, 0xf7, 0x6f, 0x9f, 0x9e, 0x05, 0xb7,
-	0xd0, 0x96, 0x5d, 0x5c, 0xcf, 0xe5, 0x33, 0xf6, 0xbb, 0x8d, 0x6d, 0xef, 0xf0, 0xf1, 0x87, 0x87,
-	0x2c, 0x35, 0xcb, 0x62, 0x46, 0x12, 0x99, 0x45, 0xe5, 0xbe, 0xd7, 0xef, 0x4e, 0x74, 0xfe, 0x2d,
-	0x9c, 0xb5, 0xed, 0xa1, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x76, 0x4c, 0xac, 0x1c, 0x26,
-	0x05, 0x00, 0x00,
+	proto.RegisterFile("api/config/authz/config_request.proto", fileDescriptor_config_request_f46885a3e8dd543a)
+}
+
+var fileDescriptor_config_request_f46885a3e8dd543a = []byte{
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6e, 0xeb, 0x44,
+	0x14, 0xc6, 0x95, 0xd8, 0xf9, 0x73, 0xa7, 0x14, 0xc2, 0x5c, 0x09, 0x59, 0xbe, 0xe8, 0xaa, 0x42,
+	0x42, 0xe2, 0x5f, 0x6c, 0x92, 0x66, 0x01, 0x6d, 0x37, 0xa4, 0x05, 0x44, 0xd4, 0xaa, 0x92, 0x03,
+	0x5d, 0xb0, 0x89, 0x8e, 0xed, 0x13, 0xc7, 0x62, 0xec, 0x31, 0x33, 0xe3, 0xb4, 0x29, 0x5b, 0x9e,
+	0x80, 0x47, 0xea, 0x82, 0x07, 0xe0, 0x25, 0x78, 0x80, 0x6e, 0x59, 0x20, 0xcf, 0x38, 0x01, 0x5a,
+	0x95, 0xa6, 0xbd, 0x4b, 0x6b, 0xbe, 0xef, 0x37, 0xdf, 0x39, 0x67, 0x8e, 0x4c, 0x3e, 0x84, 0x22,
+	0xf5, 0x23, 0x9e, 0xcf, 0xd3, 0xc4, 0x87, 0x52, 0x2d, 0xae, 0xeb, 0x8f, 0x99, 0xc0, 0x9f, 0x4b,
+	0x94, 0xca, 0x2b, 0x04, 0x57, 0x9c, 0xba, 0xd1, 0x02, 0xe7, 0x1e, 0x94, 0x8a, 0x67, 0xa0, 0xd0,
+	0x8b, 0x79, 0x06, 0x69, 0xee, 0x69, 0x83, 0xfb, 0xfa, 0x5f, 0x08, 0xb9, 0x00, 0x81, 0xb1, 0x9f,
+	0x30, 0x1e, 0x02, 0x33, 0x5e, 0xf7, 0xd5, 0xfd, 0x73, 0xc5, 0x64, 0x7d, 0x38, 0x89, 0x78, 0x56,
+	0xf0, 0x1c, 0x73, 0x25, 0xfd, 0x35, 0xbe, 0x9f, 0x88, 0x22, 0xf2, 0xf5, 0x79, 0xd4, 0x4f, 0x30,
+	0xef, 0xc3, 0xb0, 0xbf, 0x8e, 0x58, 0xa4, 0x3e, 0x0c, 0xab, 0x0f, 0x1f, 0xf2, 0x9c, 0x2b, 0x50,
+	0x29, 0xcf, 0xd7, 0xac, 0xd7, 0x09, 0xe7, 0x09, 0x43, 0xe3, 0x0c, 0xcb, 0xb9, 0x7f, 0x29, 0xa0,
+	0x28, 0x50, 0xd4, 0xe7, 0x1f, 0xfc, 0xf1, 0x16, 0xd9, 0x3d, 0xd6, 0x9c, 0xc0, 0x14, 0x47, 0x8f,
+	0x48, 0x73, 0x39, 0x70, 0xac, 0xbd, 0xc6, 0x47, 0x3b, 0xc3, 0xcf, 0xbc, 0x87, 0x6b, 0xf4, 0xfe,
+	0x63, 0xf3, 0x2e, 0x06, 0x41, 0x73, 0x39, 0x70, 0xff, 0xdc, 0x21, 0xcd, 0x8b, 0x01, 0xfd, 0x9a,
+	0x58, 0x72, 0x25, 0x9d, 0x86, 0xa6, 0xec, 0x3f, 0x85, 0xe2, 0x4d, 0x57, 0x52, 0x61, 0x16, 0x54,
+	0x7e, 0xfa, 0x0d, 0xb1, 0xe4, 0x32, 0x72, 0x9a, 0x1a, 0x33, 0x7a, 0x1a, 0x06, 0xc5, 0x32, 0x8d,
+	0x30, 0xa8, 0x00, 0xee, 0xef, 0x84, 0xb4, 0x0d, 0x97, 0x8e, 0x88, 0x9d, 0x31, 0x09, 0x75, 0xb4,
+	0xbd, 0x3b, 0xcc, 0x34, 0x9f, 0x0b, 0xf0, 0x4c, 0x63, 0xbd, 0x33, 0x26, 0x21, 0xd0, 0x6a, 0x7a,
+	0x44, 0x2c, 0xc5, 0x64, 0x1d, 0xe4, 0x93, 0xff, 0x33, 0x7d, 0x7f, 0x3a, 0x3d, 0x16, 0x18, 0x63,
+	0xae, 0x52, 0x60, 0x32, 0xa8, 0x6c, 0xf4, 0x07, 0xd2, 0x91, 0x26, 0x4e, 0xdd, 0xd7, 0xc3, 0x67,
+	0x74, 0x64, 0x53, 0xd1, 0x9a, 0x45, 0x03, 0xd2, 0x66, 0x3c, 0x49, 0x50, 0x38, 0xb6, 0xa6, 0x1e,
+	0x3c, 0x87, 0x7a, 0xaa, 0x09, 0x41, 0x4d, 0xd2, 0x51, 0x15, 0x17, 0x90, 0xa0, 0xd3, 0x7a, 0x83,
+	0xa8, 0x06, 0x11, 0xac, 0x59, 0xf4, 0x9c, 0xb4, 0xa0, 0x8c, 0x53, 0xe5, 0xb4, 0x35, 0xf4, 0xcb,
+	0xe7, 0x40, 0xbf, 0xaa, 0x00, 0x81, 0xe1, 0xb8, 0xbf, 0x36, 0x48, 0xa7, 0x6e, 0x08, 0xfd, 0x9c,
+	0xd8, 0x0b, 0x2e, 0x55, 0x3d, 0xd2, 0xf7, 0x3d, 0xf3, 0xe4, 0xbd, 0xf5, 0x93, 0xf7, 0xa6, 0x4a,
+	0xa4, 0x79, 0x72, 0x01, 0xac, 0xc4, 0x40, 0x2b, 0xe9, 0xb7, 0xc4, 0x2e, 0xb8, 0x50, 0xf5, 0x3c,
+	0x5f, 0xdd, 0x73, 0x7c, 0x97, 0xab, 0xfd, 0xa1, 0x36, 0x8c, 0xdf, 0xbb, 0xb9, 0x75, 0xe8, 0x66,
+	0x7e, 0xbd, 0xdf, 0xce, 0x5d, 0xbb, 0x5a, 0xc5, 0x40, 0x03, 0x5c, 0x41, 0xda, 0xa6, 0x81, 0x74,
+	0x44, 0xda, 0x73, 0x2e, 0x32, 0xd8, 0x2e, 0x46, 0xad, 0xa5, 0x43, 0xd2, 0x62, 0xb8, 0x44, 0xe6,
+	0x34, 0xb7, 0x30, 0x19, 0xa9, 0xfb, 0x0b, 0xe9, 0xd4, 0xfd, 0xa5, 0x5f, 0x90, 0x6e, 0x0c, 0x0a,
+	0x42, 0x90, 0xb8, 0xd5, 0xb5, 0x1b, 0x75, 0xd5, 0xb3, 0x52, 0xa2, 0xd8, 0xea, 0x5e, 0xad, 0x9c,
+	0xd8, 0x5d, 0xab, 0xd7, 0x72, 0xff, 0xb2, 0x48, 0x4b, 0x0f, 0x82, 0x8e, 0x48, 0x07, 0x73, 0x08,
+	0x19, 0xc6, 0xf5, 0xd5, 0xee, 0x3d, 0xc8, 0x98, 0x73, 0x66, 0x10, 0x6b, 0x69, 0x55, 0x70, 0xf5,
+	0x26, 0x70, 0xbb, 0x82, 0xb5, 0xb4, 0xca, 0x5a, 0x80, 0x5a, 0x38, 0xd6, 0x16, 0x16, 0xad, 0xa4,
+	0x87, 0x64, 0x27, 0x83, 0xab, 0x99, 0x4c, 0xaf, 0x71, 0x96, 0x85, 0x8e, 0xfd, 0xe8, 0x98, 0x83,
+	0x17, 0x19, 0x5c, 0x4d, 0xd3, 0x6b, 0x3c, 0x0b, 0xe9, 0x91, 0x31, 0x87, 0x10, 0xfd, 0x54, 0x16,
+	0xd2, 0x69, 0x3d, 0x6e, 0x26, 0x19, 0x5c, 0x8d, 0x8d, 0x9c, 0x8e, 0xc9, 0xdb, 0x02, 0x55, 0xb5,
+	0xfe, 0x3c, 0x9f, 0xc5, 0xb0, 0x92, 0x4e, 0xfb, 0x71, 0xc0, 0xee, 0xc6, 0x72, 0x02, 0x2b, 0x49,
+	0x4f, 0xc9, 0x4b, 0x60, 0x8c, 0x5f, 0x62, 0x3c, 0x93, 0x90, 0x15, 0x0c, 0x67, 0x02, 0x14, 0x3a,
+	0x9d, 0x07, 0xea, 0x3f, 0xe1, 0x65, 0xc8, 0xd0, 0x90, 0xde, 0xad, 0x8d, 0x53, 0xed, 0x0b, 0x40,
+	0x21, 0x9d, 0x10, 0x1a, 0x63, 0x9e, 0xde, 0x81, 0x75, 0xb7, 0x80, 0xf5, 0x8c, 0xef, 0x1f, 0x96,
+	0xfb, 0x62, 0xb3, 0x75, 0x07, 0x2f, 0x6f, 0x6e, 0x9d, 0x77, 0xc8, 0xae, 0xde, 0xd9, 0x7e, 0xbd,
+	0x1e, 0x13, 0xbb, 0xdb, 0xe8, 0x59, 0xe3, 0x4f, 0x7f, 0xfc, 0x38, 0x49, 0xd5, 0xa2, 0x0c, 0xbd,
+	0x88, 0x67, 0x7e, 0xb5, 0xea, 0x9b, 0xff, 0x98, 0x7f, 0xf7, 0xdf, 0x1a, 0xb6, 0xf5, 0xd5, 0xfb,
+	0x7f, 0x0f, 0x00, 0x4f, 0xea, 0xd9, 0x0f, 0x76, 0x07, 0x00, 0x00,
 }
@@ -6,6 +6,7 @@ import (
 	shared "github.com/chef/automate/api/config/shared"
 	w "github.com/chef/automate/api/config/shared/wrappers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateConfigRequestValid(t *testing.T) {
@@ -28,3 +29,43 @@ func TestSetGlobalConfig(t *testing.T) {
 		},
 	)
 }
+
+func TestValidateAudit(t *testing.T) {
+	t.Run("the default config is valid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("postgresql store is valid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Audit.Enabled = w.Bool(true)
+		c.V1.Sys.Audit.Store = w.String("postgresql")
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("unknown store is invalid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Audit.Store = w.String("syslog")
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auth_z.v1.sys.audit.store")
+	})
+
+	t.Run("sample rates out of range are invalid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Audit.AllowedSampleRate = w.Double(1.5)
+		c.V1.Sys.Audit.DeniedSampleRate = w.Double(-0.1)
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auth_z.v1.sys.audit.allowed_sample_rate")
+		assert.Contains(t, err.Error(), "auth_z.v1.sys.audit.denied_sample_rate")
+	})
+
+	t.Run("negative retention is invalid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Audit.RetentionDays = w.Int32(-1)
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auth_z.v1.sys.audit.retention_days")
+	})
+}
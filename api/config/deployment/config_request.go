@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/robfig/cron"
+
 	config "github.com/chef/automate/api/config/shared"
 	w "github.com/chef/automate/api/config/shared/wrappers"
 	"github.com/chef/automate/lib/stringutils"
@@ -93,6 +95,25 @@ func (c *ConfigRequest) Validate() error {
 		}
 	}
 
+	if v := c.V1.Sys.GetBackup().GetSchedule().GetCron().GetValue(); v != "" {
+		if _, parseErr := cron.ParseStandard(v); parseErr != nil {
+			err.AddInvalidValue("deployment.v1.sys.backup.schedule.cron", parseErr.Error())
+		}
+	}
+
+	retention := c.V1.Sys.GetBackup().GetRetention()
+	if retention.GetKeepDaily().GetValue() < 0 {
+		err.AddInvalidValue("deployment.v1.sys.backup.retention.keep_daily", "must not be negative")
+	}
+	if retention.GetKeepWeekly().GetValue() < 0 {
+		err.AddInvalidValue("deployment.v1.sys.backup.retention.keep_weekly", "must not be negative")
+	}
+	if v := retention.GetMaxAge().GetValue(); v != "" {
+		if _, parseErr := time.ParseDuration(v); parseErr != nil {
+			err.AddInvalidValue("deployment.v1.sys.backup.retention.max_age", parseErr.Error())
+		}
+	}
+
 	if err.IsEmpty() {
 		return nil
 	}
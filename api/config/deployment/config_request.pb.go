@@ -32,7 +32,7 @@ func (m *ConfigRequest) Reset()         { *m = ConfigRequest{} }
 func (m *ConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest) ProtoMessage()    {}
 func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0}
 }
 func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest.Unmarshal(m, b)
@@ -71,7 +71,7 @@ func (m *ConfigRequest_V1) Reset()         { *m = ConfigRequest_V1{} }
 func (m *ConfigRequest_V1) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1) ProtoMessage()    {}
 func (*ConfigRequest_V1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0}
 }
 func (m *ConfigRequest_V1) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1.Unmarshal(m, b)
@@ -121,7 +121,7 @@ func (m *ConfigRequest_V1_System) Reset()         { *m = ConfigRequest_V1_System
 func (m *ConfigRequest_V1_System) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System) ProtoMessage()    {}
 func (*ConfigRequest_V1_System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 0}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0}
 }
 func (m *ConfigRequest_V1_System) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System.Unmarshal(m, b)
@@ -195,7 +195,7 @@ func (m *ConfigRequest_V1_System_Service) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 0, 0}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 0}
 }
 func (m *ConfigRequest_V1_System_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Service.Unmarshal(m, b)
@@ -240,7 +240,7 @@ func (m *ConfigRequest_V1_System_Log) Reset()         { *m = ConfigRequest_V1_Sy
 func (m *ConfigRequest_V1_System_Log) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Log) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 0, 1}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 1}
 }
 func (m *ConfigRequest_V1_System_Log) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Log.Unmarshal(m, b)
@@ -278,7 +278,7 @@ func (m *ConfigRequest_V1_System_GatherLogs) Reset()         { *m = ConfigReques
 func (m *ConfigRequest_V1_System_GatherLogs) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_GatherLogs) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_GatherLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 0, 2}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 2}
 }
 func (m *ConfigRequest_V1_System_GatherLogs) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_GatherLogs.Unmarshal(m, b)
@@ -317,7 +317,7 @@ func (m *ConfigRequest_V1_System_Proxy) Reset()         { *m = ConfigRequest_V1_
 func (m *ConfigRequest_V1_System_Proxy) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Proxy) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Proxy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 0, 3}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 3}
 }
 func (m *ConfigRequest_V1_System_Proxy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Proxy.Unmarshal(m, b)
@@ -353,6 +353,8 @@ func (m *ConfigRequest_V1_System_Proxy) GetNoProxyString() *wrappers.StringValue
 
 type ConfigRequest_V1_System_Backup struct {
 	Filesystem           *ConfigRequest_V1_System_Backup_Filesystem `protobuf:"bytes,1,opt,name=filesystem,proto3" json:"filesystem,omitempty" toml:"filesystem,omitempty" mapstructure:"filesystem,omitempty"`
+	Schedule             *ConfigRequest_V1_System_Backup_Schedule   `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty" toml:"schedule,omitempty" mapstructure:"schedule,omitempty"`
+	Retention            *ConfigRequest_V1_System_Backup_Retention  `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty" toml:"retention,omitempty" mapstructure:"retention,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte                                     `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32                                      `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -362,7 +364,7 @@ func (m *ConfigRequest_V1_System_Backup) Reset()         { *m = ConfigRequest_V1
 func (m *ConfigRequest_V1_System_Backup) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Backup) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Backup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 0, 4}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 4}
 }
 func (m *ConfigRequest_V1_System_Backup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Backup.Unmarshal(m, b)
@@ -389,6 +391,20 @@ func (m *ConfigRequest_V1_System_Backup) GetFilesystem() *ConfigRequest_V1_Syste
 	return nil
 }
 
+func (m *ConfigRequest_V1_System_Backup) GetSchedule() *ConfigRequest_V1_System_Backup_Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Backup) GetRetention() *ConfigRequest_V1_System_Backup_Retention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
 type ConfigRequest_V1_System_Backup_Filesystem struct {
 	Path                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty" toml:"path,omitempty" mapstructure:"path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -399,10 +415,12 @@ type ConfigRequest_V1_System_Backup_Filesystem struct {
 func (m *ConfigRequest_V1_System_Backup_Filesystem) Reset() {
 	*m = ConfigRequest_V1_System_Backup_Filesystem{}
 }
-func (m *ConfigRequest_V1_System_Backup_Filesystem) String() string { return proto.CompactTextString(m) }
-func (*ConfigRequest_V1_System_Backup_Filesystem) ProtoMessage()    {}
+func (m *ConfigRequest_V1_System_Backup_Filesystem) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ConfigRequest_V1_System_Backup_Filesystem) ProtoMessage() {}
 func (*ConfigRequest_V1_System_Backup_Filesystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 0, 4, 0}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 4, 0}
 }
 func (m *ConfigRequest_V1_System_Backup_Filesystem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Backup_Filesystem.Unmarshal(m, b)
@@ -429,6 +447,113 @@ func (m *ConfigRequest_V1_System_Backup_Filesystem) GetPath() *wrappers.StringVa
 	return nil
 }
 
+type ConfigRequest_V1_System_Backup_Schedule struct {
+	// cron is a five field cron expression or a descriptor
+	// like @daily. Backups are only scheduled when it is set.
+	Cron                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty" toml:"cron,omitempty" mapstructure:"cron,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                 `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_Backup_Schedule) Reset() {
+	*m = ConfigRequest_V1_System_Backup_Schedule{}
+}
+func (m *ConfigRequest_V1_System_Backup_Schedule) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest_V1_System_Backup_Schedule) ProtoMessage()    {}
+func (*ConfigRequest_V1_System_Backup_Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 4, 1}
+}
+func (m *ConfigRequest_V1_System_Backup_Schedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_Backup_Schedule.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_Backup_Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_Backup_Schedule.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_Backup_Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_Backup_Schedule.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_Backup_Schedule) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_Backup_Schedule.Size(m)
+}
+func (m *ConfigRequest_V1_System_Backup_Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_Backup_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_Backup_Schedule proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_Backup_Schedule) GetCron() *wrappers.StringValue {
+	if m != nil {
+		return m.Cron
+	}
+	return nil
+}
+
+// Retention rules are applied after every successful
+// scheduled backup. Backups that aren't kept by any rule are
+// deleted, the most recent backup is always kept.
+type ConfigRequest_V1_System_Backup_Retention struct {
+	// keep_daily is the number of days to keep the most
+	// recent backup of
+	KeepDaily *wrappers.Int32Value `protobuf:"bytes,1,opt,name=keep_daily,json=keepDaily,proto3" json:"keep_daily,omitempty" toml:"keep_daily,omitempty" mapstructure:"keep_daily,omitempty"`
+	// keep_weekly is the number of weeks to keep the most
+	// recent backup of
+	KeepWeekly *wrappers.Int32Value `protobuf:"bytes,2,opt,name=keep_weekly,json=keepWeekly,proto3" json:"keep_weekly,omitempty" toml:"keep_weekly,omitempty" mapstructure:"keep_weekly,omitempty"`
+	// max_age is the duration after which backups are
+	// deleted, e.g. 720h
+	MaxAge               *wrappers.StringValue `protobuf:"bytes,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty" toml:"max_age,omitempty" mapstructure:"max_age,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                 `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_Backup_Retention) Reset() {
+	*m = ConfigRequest_V1_System_Backup_Retention{}
+}
+func (m *ConfigRequest_V1_System_Backup_Retention) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest_V1_System_Backup_Retention) ProtoMessage()    {}
+func (*ConfigRequest_V1_System_Backup_Retention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 0, 4, 2}
+}
+func (m *ConfigRequest_V1_System_Backup_Retention) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_Backup_Retention.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_Backup_Retention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_Backup_Retention.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_Backup_Retention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_Backup_Retention.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_Backup_Retention) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_Backup_Retention.Size(m)
+}
+func (m *ConfigRequest_V1_System_Backup_Retention) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_Backup_Retention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_Backup_Retention proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_Backup_Retention) GetKeepDaily() *wrappers.Int32Value {
+	if m != nil {
+		return m.KeepDaily
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Backup_Retention) GetKeepWeekly() *wrappers.Int32Value {
+	if m != nil {
+		return m.KeepWeekly
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Backup_Retention) GetMaxAge() *wrappers.StringValue {
+	if m != nil {
+		return m.MaxAge
+	}
+	return nil
+}
+
 type ConfigRequest_V1_Service struct {
 	Name                            *wrappers.StringValue       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" toml:"name,omitempty" mapstructure:"name,omitempty"`
 	Origin                          *wrappers.StringValue       `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty" toml:"origin,omitempty" mapstructure:"origin,omitempty"`
@@ -455,7 +580,7 @@ func (m *ConfigRequest_V1_Service) Reset()         { *m = ConfigRequest_V1_Servi
 func (m *ConfigRequest_V1_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 1}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 1}
 }
 func (m *ConfigRequest_V1_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_Service.Unmarshal(m, b)
@@ -603,7 +728,7 @@ func (m *ConfigRequest_V1_AdminUser) Reset()         { *m = ConfigRequest_V1_Adm
 func (m *ConfigRequest_V1_AdminUser) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_AdminUser) ProtoMessage()    {}
 func (*ConfigRequest_V1_AdminUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3799ee30fbfa1658, []int{0, 0, 2}
+	return fileDescriptor_config_request_d721b64e3247f68f, []int{0, 0, 2}
 }
 func (m *ConfigRequest_V1_AdminUser) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_AdminUser.Unmarshal(m, b)
@@ -662,83 +787,92 @@ func init() {
 	proto.RegisterType((*ConfigRequest_V1_System_Proxy)(nil), "chef.automate.domain.deployment.ConfigRequest.V1.System.Proxy")
 	proto.RegisterType((*ConfigRequest_V1_System_Backup)(nil), "chef.automate.domain.deployment.ConfigRequest.V1.System.Backup")
 	proto.RegisterType((*ConfigRequest_V1_System_Backup_Filesystem)(nil), "chef.automate.domain.deployment.ConfigRequest.V1.System.Backup.Filesystem")
+	proto.RegisterType((*ConfigRequest_V1_System_Backup_Schedule)(nil), "chef.automate.domain.deployment.ConfigRequest.V1.System.Backup.Schedule")
+	proto.RegisterType((*ConfigRequest_V1_System_Backup_Retention)(nil), "chef.automate.domain.deployment.ConfigRequest.V1.System.Backup.Retention")
 	proto.RegisterType((*ConfigRequest_V1_Service)(nil), "chef.automate.domain.deployment.ConfigRequest.V1.Service")
 	proto.RegisterType((*ConfigRequest_V1_AdminUser)(nil), "chef.automate.domain.deployment.ConfigRequest.V1.AdminUser")
 }
 
 func init() {
-	proto.RegisterFile("api/config/deployment/config_request.proto", fileDescriptor_config_request_3799ee30fbfa1658)
-}
-
-var fileDescriptor_config_request_3799ee30fbfa1658 = []byte{
-	// 1093 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x80, 0xa1, 0x1f, 0xcb, 0xf6, 0xb8, 0xb1, 0x9c, 0xcd, 0x4f, 0x09, 0xb6, 0x48, 0x82, 0xf6,
-	0x52, 0x14, 0x30, 0x15, 0xdb, 0x41, 0x90, 0xf4, 0x27, 0x6d, 0x2c, 0x3b, 0x6e, 0xfc, 0x0f, 0x2a,
-	0x75, 0x00, 0x5f, 0x88, 0x15, 0x39, 0xa2, 0x58, 0x93, 0xbb, 0xec, 0xee, 0x4a, 0x8e, 0x6e, 0x7d,
-	0x8b, 0xf6, 0x09, 0x7a, 0x6d, 0x0b, 0xf4, 0x09, 0xf2, 0x36, 0x3d, 0xfb, 0x05, 0x0a, 0x2e, 0x97,
-	0x94, 0xdb, 0x14, 0x36, 0xa3, 0xde, 0x24, 0x6a, 0xbe, 0x8f, 0xb3, 0x33, 0xc3, 0xa1, 0x0d, 0x9f,
-	0xd3, 0x34, 0xea, 0xf8, 0x9c, 0x0d, 0xa2, 0xb0, 0x13, 0x60, 0x1a, 0xf3, 0x49, 0x82, 0x4c, 0x99,
-	0x2b, 0x9e, 0xc0, 0x1f, 0x47, 0x28, 0x95, 0x93, 0x0a, 0xae, 0x38, 0xb9, 0xef, 0x0f, 0x71, 0xe0,
-	0xd0, 0x91, 0xe2, 0x09, 0x55, 0xe8, 0x04, 0x3c, 0xa1, 0x11, 0x73, 0xa6, 0x94, 0x7d, 0xef, 0x92,
-	0x4c, 0x0e, 0xa9, 0xc0, 0xa0, 0x13, 0xc6, 0xbc, 0x4f, 0xe3, 0x5c, 0x60, 0xdf, 0x0b, 0x39, 0x0f,
-	0x63, 0xec, 0xe8, 0x6f, 0xfd, 0xd1, 0xa0, 0x73, 0x2e, 0x68, 0x9a, 0xa2, 0x90, 0xe6, 0xf7, 0x5d,
-	0x9f, 0x27, 0x29, 0x67, 0xc8, 0x94, 0xec, 0x14, 0xb7, 0x59, 0x0d, 0x45, 0xea, 0xe7, 0x84, 0xbf,
-	0x1a, 0x22, 0x5b, 0xa5, 0xeb, 0xab, 0xe6, 0x16, 0xd9, 0xdd, 0xe8, 0x7a, 0xf6, 0xa5, 0x43, 0x19,
-	0xe3, 0x8a, 0xaa, 0x88, 0x33, 0xe3, 0xfa, 0xe4, 0xd7, 0xbb, 0x70, 0xa3, 0xab, 0xe3, 0xdc, 0xfc,
-	0x10, 0xe4, 0x39, 0xd4, 0xc7, 0x6b, 0x56, 0xe3, 0x41, 0xed, 0xb3, 0xa5, 0xf5, 0x35, 0xe7, 0x9a,
-	0xb3, 0x38, 0xff, 0x60, 0x9d, 0x93, 0x35, 0xb7, 0x3e, 0x5e, 0xb3, 0xff, 0xb8, 0x03, 0xf5, 0x93,
-	0x35, 0xb2, 0x0b, 0x0d, 0x39, 0x91, 0x56, 0x4d, 0xab, 0x9e, 0xbc, 0xb7, 0xca, 0xe9, 0x4d, 0xa4,
-	0xc2, 0xc4, 0xcd, 0x24, 0x64, 0x0f, 0x1a, 0x72, 0xec, 0x5b, 0x75, 0xed, 0x7a, 0x3a, 0x83, 0x0b,
-	0xc5, 0x38, 0xf2, 0xd1, 0xcd, 0x2c, 0xf6, 0xc5, 0x02, 0xb4, 0x72, 0x39, 0x79, 0x04, 0xcd, 0x24,
-	0x96, 0xd4, 0x24, 0xf9, 0xe0, 0x5f, 0xe2, 0x88, 0x0d, 0x04, 0x75, 0xf2, 0x3a, 0x3a, 0x07, 0xb1,
-	0xa4, 0xae, 0x8e, 0x26, 0xa7, 0x30, 0x2f, 0x73, 0xa1, 0xc9, 0xe8, 0xdb, 0x59, 0x4f, 0x57, 0x26,
-	0x56, 0x08, 0xc9, 0x21, 0x34, 0x62, 0x1e, 0x9a, 0x06, 0x7c, 0x35, 0xb3, 0x77, 0x9f, 0x87, 0x6e,
-	0x26, 0x22, 0x01, 0x2c, 0x85, 0x54, 0x0d, 0x51, 0x78, 0x31, 0x0f, 0xa5, 0xd5, 0xd4, 0xde, 0xee,
-	0xcc, 0xde, 0x1d, 0xed, 0xda, 0xe7, 0xa1, 0x74, 0x21, 0x2c, 0x3f, 0x93, 0x57, 0x30, 0x97, 0x0a,
-	0xfe, 0x66, 0x62, 0xcd, 0x69, 0xff, 0xb3, 0x99, 0xfd, 0xc7, 0x99, 0xc5, 0xcd, 0x65, 0xe4, 0x35,
-	0xb4, 0xfa, 0xd4, 0x3f, 0x1b, 0xa5, 0x56, 0x4b, 0x6b, 0xbf, 0x99, 0x59, 0xbb, 0xa9, 0x35, 0xae,
-	0xd1, 0xd9, 0x3f, 0xd7, 0x60, 0xde, 0x54, 0x9e, 0x74, 0x61, 0x39, 0x8e, 0xa4, 0x42, 0xe6, 0xd1,
-	0x20, 0x10, 0x28, 0x8b, 0x89, 0xfd, 0xd8, 0xc9, 0x9f, 0x43, 0xa7, 0x78, 0x0e, 0x9d, 0x9e, 0x12,
-	0x11, 0x0b, 0x4f, 0x68, 0x3c, 0x42, 0xf7, 0x46, 0xce, 0x3c, 0xcf, 0x11, 0xb2, 0x03, 0xcd, 0x94,
-	0x0b, 0x65, 0xc6, 0xe1, 0xa3, 0x77, 0xd0, 0x97, 0x4c, 0x6d, 0xac, 0x6b, 0x72, 0xf3, 0xee, 0xdb,
-	0x0b, 0x8b, 0x94, 0x03, 0xb4, 0xf2, 0xfb, 0x91, 0xdd, 0xcc, 0x1e, 0x5e, 0x57, 0x0b, 0xec, 0xa7,
-	0xd0, 0xd8, 0xe7, 0x21, 0x59, 0x87, 0xb9, 0x18, 0xc7, 0x18, 0x57, 0xca, 0x25, 0x0f, 0xb5, 0xf7,
-	0x00, 0xa6, 0xdd, 0x21, 0x5f, 0xc3, 0x92, 0x54, 0x34, 0x8c, 0x58, 0xe8, 0x05, 0x91, 0xa8, 0xe4,
-	0x01, 0x03, 0x6c, 0x45, 0xc2, 0xfe, 0xa5, 0x06, 0x73, 0xba, 0x17, 0xe4, 0x25, 0xdc, 0xf4, 0x39,
-	0x63, 0xe8, 0x67, 0x7b, 0xc3, 0x93, 0x3a, 0xbe, 0x92, 0x6e, 0x65, 0x8a, 0xe5, 0x97, 0xc9, 0x16,
-	0xb4, 0x19, 0xf7, 0x74, 0x6f, 0x0b, 0x51, 0xbd, 0x4a, 0xad, 0x19, 0xd7, 0xa9, 0xe4, 0xd7, 0xec,
-	0x3f, 0x6b, 0xd0, 0xca, 0xfb, 0x49, 0x7e, 0x00, 0x18, 0x44, 0x31, 0x4a, 0xdd, 0x64, 0x93, 0xd4,
-	0xee, 0xff, 0x1c, 0x12, 0xe7, 0x45, 0x69, 0x74, 0x2f, 0xd9, 0xed, 0x67, 0x00, 0xd3, 0x5f, 0xc8,
-	0x43, 0x68, 0xa6, 0x54, 0x0d, 0x2b, 0x15, 0x42, 0x47, 0xda, 0xbf, 0x2d, 0x4e, 0x67, 0xee, 0x21,
-	0x34, 0x19, 0x4d, 0xb0, 0x1a, 0x9d, 0x45, 0x92, 0x47, 0xd0, 0xe2, 0x22, 0x0a, 0x23, 0x56, 0xa9,
-	0x62, 0x26, 0x96, 0x3c, 0x86, 0x79, 0x7f, 0x48, 0x19, 0xc3, 0xd8, 0x2c, 0x94, 0xab, 0xb1, 0x22,
-	0x98, 0xec, 0xc0, 0xca, 0x28, 0x0d, 0x05, 0x0d, 0x30, 0xeb, 0x13, 0x55, 0x18, 0x4e, 0xcc, 0xe6,
-	0xb8, 0x5a, 0xd0, 0x36, 0x54, 0xcf, 0x40, 0x64, 0x1b, 0xda, 0xd3, 0xc2, 0x7b, 0x6a, 0x92, 0xa2,
-	0xd9, 0x10, 0x57, 0x7b, 0x96, 0xa7, 0xd0, 0xab, 0x49, 0x8a, 0x99, 0x86, 0x8f, 0x51, 0x88, 0x28,
-	0x40, 0xcf, 0x94, 0xa1, 0x55, 0x45, 0x53, 0x40, 0x47, 0x79, 0x39, 0xb6, 0xa1, 0x3d, 0xa4, 0x42,
-	0x45, 0x03, 0xea, 0x2b, 0xe9, 0xe9, 0xfe, 0xcd, 0x57, 0xd1, 0x4c, 0xa1, 0x63, 0xaa, 0x86, 0xe4,
-	0x14, 0x80, 0x06, 0x49, 0xc4, 0xbc, 0x91, 0x44, 0x61, 0x2d, 0x68, 0xc3, 0x97, 0xef, 0x3f, 0x75,
-	0xcf, 0x33, 0xc7, 0xf7, 0x12, 0x85, 0xbb, 0x48, 0x8b, 0x8f, 0xe4, 0x18, 0xee, 0x24, 0x94, 0x45,
-	0x03, 0x94, 0xca, 0xf3, 0xa9, 0x3f, 0x44, 0x0f, 0xdf, 0xa4, 0x91, 0x98, 0x58, 0x8b, 0x15, 0x12,
-	0xbd, 0x55, 0xa0, 0xdd, 0x8c, 0xdc, 0xd6, 0x20, 0xd9, 0x03, 0x52, 0x1a, 0x83, 0x48, 0xa0, 0xaf,
-	0xb8, 0x98, 0x58, 0x50, 0x41, 0x77, 0xb3, 0xe0, 0xb6, 0x0a, 0x8c, 0x7c, 0x07, 0x04, 0x19, 0xed,
-	0xc7, 0xe8, 0x65, 0xc7, 0xf5, 0xb2, 0x25, 0x86, 0xc2, 0x5a, 0xd2, 0x32, 0xfb, 0x1d, 0xd9, 0x26,
-	0xe7, 0xb1, 0xd9, 0x05, 0x39, 0xd5, 0x1d, 0xe2, 0xa0, 0xa7, 0x19, 0x32, 0x84, 0x4f, 0x8d, 0xe9,
-	0xd2, 0x80, 0x70, 0x11, 0xa0, 0xc8, 0x46, 0x0e, 0xa5, 0xf4, 0x12, 0x1e, 0xa0, 0xf5, 0xc1, 0xb5,
-	0xea, 0xfb, 0xb9, 0x66, 0xab, 0xb4, 0x1c, 0x65, 0x92, 0x9e, 0x76, 0x1c, 0xf0, 0x00, 0x89, 0x0b,
-	0x1f, 0x0e, 0x90, 0xaa, 0x91, 0x40, 0x6f, 0x10, 0xd3, 0xd0, 0x93, 0x1b, 0x5e, 0xfe, 0x1a, 0x90,
-	0xd6, 0x4f, 0xcd, 0x6b, 0xf5, 0xb7, 0x0d, 0xfb, 0x22, 0xa6, 0x61, 0x6f, 0x23, 0x5f, 0x11, 0x92,
-	0x74, 0xa1, 0x6d, 0xb2, 0x3f, 0xe7, 0xe2, 0x6c, 0x10, 0xf3, 0x73, 0xeb, 0xc6, 0xb5, 0xaa, 0xe5,
-	0x1c, 0x79, 0x6d, 0x08, 0x72, 0x08, 0xb7, 0x53, 0xea, 0x9f, 0xd1, 0x10, 0x3d, 0x3f, 0x46, 0xca,
-	0x46, 0x69, 0x7e, 0xe6, 0xe5, 0x0a, 0xbd, 0x21, 0x86, 0xec, 0xe6, 0xa0, 0x3e, 0xe8, 0x21, 0xdc,
-	0x29, 0x4b, 0x3a, 0xf6, 0x12, 0xce, 0x22, 0xc5, 0xf5, 0x92, 0x6d, 0x5f, 0x9b, 0xda, 0xad, 0xa2,
-	0x88, 0xe3, 0x83, 0x12, 0xb3, 0xff, 0xaa, 0xc1, 0x62, 0x39, 0xa4, 0xe4, 0x31, 0xcc, 0x61, 0x42,
-	0xa3, 0x4a, 0xaf, 0xa4, 0xcd, 0xba, 0x55, 0x73, 0xf3, 0x70, 0xf2, 0x04, 0x16, 0xb2, 0xe7, 0x44,
-	0xef, 0xbb, 0x2a, 0xbb, 0xab, 0x8c, 0x2e, 0xb7, 0x64, 0xb3, 0xf2, 0x96, 0x7c, 0x02, 0x0b, 0x29,
-	0x95, 0xf2, 0x9c, 0x8b, 0xa0, 0xd2, 0xc2, 0x2b, 0xa3, 0xbf, 0xb0, 0xde, 0x5e, 0x58, 0xb7, 0x81,
-	0x4c, 0x67, 0x71, 0xd5, 0xbc, 0xa0, 0x77, 0x9b, 0x0b, 0xb5, 0x95, 0xc6, 0xe6, 0xc3, 0x53, 0x27,
-	0x8c, 0xd4, 0x70, 0xd4, 0x77, 0x7c, 0x9e, 0x74, 0xb2, 0xe1, 0x2f, 0xff, 0xf6, 0xee, 0xfc, 0xe7,
-	0x3f, 0x07, 0xfd, 0x96, 0xbe, 0xe3, 0xc6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xdd, 0x05,
-	0x25, 0x3c, 0x0c, 0x00, 0x00,
+	proto.RegisterFile("api/config/deployment/config_request.proto", fileDescriptor_config_request_d721b64e3247f68f)
+}
+
+var fileDescriptor_config_request_d721b64e3247f68f = []byte{
+	// 1202 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xdd, 0x6e, 0xd4, 0x46,
+	0x1b, 0xc7, 0xb5, 0xd9, 0x8f, 0x64, 0x9f, 0x40, 0x12, 0x06, 0x78, 0x5f, 0xd7, 0xad, 0x00, 0xb5,
+	0x27, 0x55, 0xa5, 0x78, 0x49, 0x42, 0x11, 0x50, 0x4a, 0x9b, 0x6c, 0xf8, 0x0a, 0x9f, 0xf2, 0x52,
+	0x90, 0x38, 0xb1, 0x26, 0xf6, 0xb3, 0x63, 0x37, 0xf6, 0x8c, 0x3b, 0x33, 0xbb, 0xc9, 0x9e, 0x71,
+	0x17, 0xed, 0x5d, 0xb4, 0x17, 0x50, 0xa9, 0x12, 0x77, 0xd3, 0xd3, 0x72, 0x5c, 0xa9, 0xf2, 0x78,
+	0xec, 0xa5, 0xa5, 0x6a, 0x4c, 0x38, 0x5b, 0x7b, 0x9f, 0xff, 0x6f, 0x9e, 0x6f, 0xdb, 0xf0, 0x05,
+	0xcd, 0x93, 0x41, 0x28, 0xf8, 0x38, 0x61, 0x83, 0x08, 0xf3, 0x54, 0xcc, 0x32, 0xe4, 0xda, 0xde,
+	0x09, 0x24, 0xfe, 0x30, 0x41, 0xa5, 0xbd, 0x5c, 0x0a, 0x2d, 0xc8, 0xc5, 0x30, 0xc6, 0xb1, 0x47,
+	0x27, 0x5a, 0x64, 0x54, 0xa3, 0x17, 0x89, 0x8c, 0x26, 0xdc, 0x9b, 0xab, 0xdc, 0x0b, 0x6f, 0xc1,
+	0x54, 0x4c, 0x25, 0x46, 0x03, 0x96, 0x8a, 0x7d, 0x9a, 0x96, 0x00, 0xf7, 0x02, 0x13, 0x82, 0xa5,
+	0x38, 0x30, 0x57, 0xfb, 0x93, 0xf1, 0xe0, 0x50, 0xd2, 0x3c, 0x47, 0xa9, 0xec, 0xff, 0x7b, 0xa1,
+	0xc8, 0x72, 0xc1, 0x91, 0x6b, 0x35, 0xa8, 0x8e, 0x59, 0x67, 0x32, 0x0f, 0x4b, 0x45, 0xb8, 0xce,
+	0x90, 0xaf, 0xd3, 0xcd, 0x75, 0x7b, 0x44, 0x71, 0x1a, 0xdd, 0x2c, 0x2e, 0x06, 0x94, 0x73, 0xa1,
+	0xa9, 0x4e, 0x04, 0xb7, 0xac, 0x4f, 0xff, 0xf8, 0x08, 0x4e, 0x0f, 0x8d, 0x9d, 0x5f, 0x06, 0x41,
+	0xb6, 0x61, 0x61, 0xba, 0xe1, 0xb4, 0x2f, 0xb5, 0x3e, 0x5f, 0xde, 0xdc, 0xf0, 0x8e, 0x89, 0xc5,
+	0xfb, 0x9b, 0xd6, 0x7b, 0xbe, 0xe1, 0x2f, 0x4c, 0x37, 0xdc, 0x3f, 0x1d, 0x58, 0x78, 0xbe, 0x41,
+	0xf6, 0xa0, 0xad, 0x66, 0xca, 0x69, 0x19, 0xd4, 0xb5, 0xf7, 0x46, 0x79, 0xa3, 0x99, 0xd2, 0x98,
+	0xf9, 0x05, 0x84, 0x3c, 0x80, 0xb6, 0x9a, 0x86, 0xce, 0x82, 0x61, 0x5d, 0x3f, 0x01, 0x0b, 0xe5,
+	0x34, 0x09, 0xd1, 0x2f, 0x28, 0xee, 0x6f, 0xa7, 0xa0, 0x57, 0xc2, 0xc9, 0x15, 0xe8, 0x64, 0xa9,
+	0xa2, 0xd6, 0xc9, 0x4b, 0xff, 0x00, 0x27, 0x7c, 0x2c, 0xa9, 0x57, 0xe6, 0xd1, 0x7b, 0x94, 0x2a,
+	0xea, 0x1b, 0x6b, 0xf2, 0x12, 0x16, 0x55, 0x09, 0xb4, 0x1e, 0x7d, 0x7b, 0xd2, 0xe8, 0x6a, 0xc7,
+	0x2a, 0x20, 0x79, 0x0c, 0xed, 0x54, 0x30, 0x5b, 0x80, 0x9b, 0x27, 0xe6, 0x3e, 0x14, 0xcc, 0x2f,
+	0x40, 0x24, 0x82, 0x65, 0x46, 0x75, 0x8c, 0x32, 0x48, 0x05, 0x53, 0x4e, 0xc7, 0x70, 0x87, 0x27,
+	0xe6, 0xde, 0x35, 0xac, 0x87, 0x82, 0x29, 0x1f, 0x58, 0xfd, 0x9b, 0x3c, 0x83, 0x6e, 0x2e, 0xc5,
+	0xd1, 0xcc, 0xe9, 0x1a, 0xfe, 0xad, 0x13, 0xf3, 0x9f, 0x16, 0x14, 0xbf, 0x84, 0x91, 0x17, 0xd0,
+	0xdb, 0xa7, 0xe1, 0xc1, 0x24, 0x77, 0x7a, 0x06, 0xfb, 0xcd, 0x89, 0xb1, 0x3b, 0x06, 0xe3, 0x5b,
+	0x9c, 0xfb, 0x63, 0x0b, 0x16, 0x6d, 0xe6, 0xc9, 0x10, 0x56, 0xd2, 0x44, 0x69, 0xe4, 0x01, 0x8d,
+	0x22, 0x89, 0xaa, 0xea, 0xd8, 0x4f, 0xbc, 0x72, 0x0e, 0xbd, 0x6a, 0x0e, 0xbd, 0x91, 0x96, 0x09,
+	0x67, 0xcf, 0x69, 0x3a, 0x41, 0xff, 0x74, 0xa9, 0xd9, 0x2e, 0x25, 0xe4, 0x2e, 0x74, 0x72, 0x21,
+	0xb5, 0x6d, 0x87, 0x8f, 0xdf, 0x91, 0xde, 0xe7, 0x7a, 0x6b, 0xd3, 0x28, 0x77, 0xfe, 0xf7, 0xfa,
+	0x8d, 0x43, 0xea, 0x06, 0x5a, 0xfb, 0xe5, 0x89, 0xdb, 0x29, 0x86, 0xd7, 0x37, 0x00, 0xf7, 0x3a,
+	0xb4, 0x1f, 0x0a, 0x46, 0x36, 0xa1, 0x9b, 0xe2, 0x14, 0xd3, 0x46, 0xbe, 0x94, 0xa6, 0xee, 0x03,
+	0x80, 0x79, 0x75, 0xc8, 0xd7, 0xb0, 0xac, 0x34, 0x65, 0x09, 0x67, 0x41, 0x94, 0xc8, 0x46, 0x1c,
+	0xb0, 0x82, 0xdd, 0x44, 0xba, 0x3f, 0xb5, 0xa0, 0x6b, 0x6a, 0x41, 0xee, 0xc3, 0x99, 0x50, 0x70,
+	0x8e, 0x61, 0xb1, 0x37, 0x02, 0x65, 0xec, 0x1b, 0xe1, 0xd6, 0xe6, 0xb2, 0xf2, 0x36, 0xd9, 0x85,
+	0x55, 0x2e, 0x02, 0x53, 0xdb, 0x0a, 0xb4, 0xd0, 0x24, 0xd7, 0x5c, 0x18, 0x57, 0xca, 0x7b, 0xee,
+	0xab, 0x2e, 0xf4, 0xca, 0x7a, 0x92, 0xef, 0x01, 0xc6, 0x49, 0x8a, 0xca, 0x14, 0xd9, 0x3a, 0xb5,
+	0xf7, 0x81, 0x4d, 0xe2, 0xdd, 0xa9, 0x89, 0xfe, 0x5b, 0x74, 0x12, 0xc1, 0x92, 0x0a, 0x63, 0x8c,
+	0x26, 0x69, 0x35, 0xf5, 0xf7, 0x3e, 0xf4, 0xa4, 0x91, 0xe5, 0xf9, 0x35, 0x99, 0x30, 0xe8, 0x4b,
+	0xd4, 0xc8, 0x8b, 0xac, 0xd9, 0x25, 0x70, 0xff, 0x43, 0x8f, 0xf1, 0x2b, 0xa0, 0x3f, 0x67, 0xbb,
+	0xb7, 0x00, 0xe6, 0x81, 0x92, 0xcb, 0xd0, 0xc9, 0xa9, 0x8e, 0x1b, 0xd5, 0xd5, 0x58, 0xba, 0x37,
+	0x61, 0xa9, 0x72, 0xbf, 0x50, 0x87, 0x52, 0xf0, 0x66, 0xea, 0xc2, 0xd2, 0xfd, 0xb5, 0x05, 0xfd,
+	0xda, 0x2d, 0x72, 0x03, 0xe0, 0x00, 0x31, 0x0f, 0x22, 0x9a, 0xa4, 0x33, 0x4b, 0xf9, 0xaf, 0x19,
+	0xf2, 0xfb, 0x85, 0xf9, 0x6e, 0x61, 0x4d, 0x6e, 0xc2, 0xb2, 0xd1, 0x1e, 0x22, 0x1e, 0xa4, 0xb3,
+	0x06, 0x03, 0xe8, 0x9b, 0xb3, 0x5e, 0x18, 0x73, 0xf2, 0x25, 0x2c, 0x66, 0xf4, 0x28, 0xa0, 0x0c,
+	0x9d, 0x76, 0x03, 0xe7, 0x7b, 0x19, 0x3d, 0xda, 0x66, 0xe8, 0xfe, 0xdc, 0x9f, 0xef, 0x8f, 0xcb,
+	0xd0, 0xe1, 0x34, 0xc3, 0x66, 0xc1, 0x17, 0x96, 0xe4, 0x0a, 0xf4, 0x84, 0x4c, 0x58, 0xc2, 0x1b,
+	0x75, 0xbf, 0xb5, 0x25, 0x57, 0x61, 0x31, 0x8c, 0x29, 0xe7, 0x98, 0x36, 0x72, 0xb5, 0x32, 0x26,
+	0x77, 0x61, 0x6d, 0x92, 0x33, 0x49, 0x23, 0x2c, 0x66, 0x8e, 0x6a, 0x64, 0x33, 0xa7, 0xd3, 0x00,
+	0xb0, 0x6a, 0x55, 0x23, 0x2b, 0x22, 0xb7, 0x61, 0x75, 0xde, 0x73, 0x81, 0x9e, 0xe5, 0xe8, 0x74,
+	0x1b, 0x70, 0x56, 0xe6, 0xa2, 0x67, 0xb3, 0x1c, 0x0b, 0x8c, 0x98, 0xa2, 0x94, 0x49, 0x84, 0x81,
+	0x4d, 0x43, 0xaf, 0x09, 0xa6, 0x12, 0x3d, 0x29, 0xd3, 0x71, 0x1b, 0x56, 0x63, 0x2a, 0x75, 0x32,
+	0xa6, 0xa1, 0x56, 0x81, 0x69, 0xde, 0xc5, 0x26, 0x98, 0xb9, 0xe8, 0x29, 0xd5, 0x31, 0x79, 0x09,
+	0x40, 0xa3, 0x2c, 0xe1, 0xc1, 0x44, 0xa1, 0x74, 0x96, 0x0c, 0xe1, 0xab, 0xf7, 0x1f, 0xb8, 0xed,
+	0x82, 0xf1, 0x9d, 0x42, 0xe9, 0xf7, 0x69, 0xf5, 0x93, 0x3c, 0x85, 0xf3, 0x19, 0xe5, 0xc9, 0x18,
+	0x95, 0x0e, 0x42, 0x1a, 0xc6, 0x18, 0xe0, 0x51, 0x9e, 0xc8, 0x99, 0xd3, 0x6f, 0xe0, 0xe8, 0xd9,
+	0x4a, 0x3a, 0x2c, 0x94, 0xb7, 0x8d, 0x90, 0x3c, 0x00, 0x52, 0x13, 0xa3, 0x44, 0x62, 0xa8, 0x85,
+	0x9c, 0x39, 0xd0, 0x00, 0x77, 0xa6, 0xd2, 0xed, 0x56, 0x32, 0x72, 0x0f, 0x08, 0x72, 0xba, 0x9f,
+	0x62, 0x50, 0x84, 0x1b, 0x14, 0x0f, 0x24, 0x94, 0xce, 0xb2, 0x81, 0xb9, 0xef, 0xc0, 0x76, 0x84,
+	0x48, 0xed, 0x5e, 0x2f, 0x55, 0xc3, 0x18, 0xc7, 0x23, 0xa3, 0x21, 0x31, 0x7c, 0x66, 0x49, 0x6f,
+	0x35, 0x88, 0x90, 0x11, 0xca, 0xa2, 0xe5, 0x50, 0xa9, 0x20, 0x13, 0x11, 0x3a, 0xa7, 0x8e, 0x45,
+	0x5f, 0x2c, 0x31, 0xbb, 0x35, 0xe5, 0x49, 0x01, 0x19, 0x19, 0xc6, 0x23, 0x11, 0x21, 0xf1, 0xe1,
+	0xff, 0x63, 0xa4, 0x7a, 0x22, 0x31, 0x18, 0xa7, 0x94, 0x05, 0x6a, 0x2b, 0x28, 0x1f, 0xe9, 0xca,
+	0x79, 0xd5, 0x39, 0x16, 0x7f, 0xce, 0x6a, 0xef, 0xa4, 0x94, 0x8d, 0xb6, 0xca, 0xed, 0xa8, 0xc8,
+	0x10, 0x56, 0xad, 0xf7, 0x87, 0x42, 0x1e, 0x8c, 0x53, 0x71, 0xe8, 0x9c, 0x3e, 0x16, 0xb5, 0x52,
+	0x4a, 0x5e, 0x58, 0x05, 0x79, 0x0c, 0xe7, 0x72, 0x1a, 0x1e, 0x50, 0x86, 0x41, 0x98, 0x22, 0xe5,
+	0x93, 0xbc, 0x8c, 0x79, 0xa5, 0x41, 0x6d, 0x88, 0x55, 0x0e, 0x4b, 0xa1, 0x09, 0xf4, 0x31, 0x9c,
+	0xaf, 0x53, 0x3a, 0x0d, 0x32, 0xc1, 0x13, 0x2d, 0xcc, 0x03, 0x73, 0xf5, 0x58, 0xd7, 0xce, 0x56,
+	0x49, 0x9c, 0x3e, 0xaa, 0x65, 0xee, 0xef, 0x2d, 0xe8, 0xd7, 0x4d, 0x4a, 0xae, 0x42, 0x17, 0x33,
+	0x9a, 0x34, 0x7a, 0xbd, 0xd8, 0x59, 0x70, 0x5a, 0x7e, 0x69, 0x4e, 0xae, 0xc1, 0x52, 0x31, 0x27,
+	0x66, 0xdf, 0x35, 0xd9, 0x5d, 0xb5, 0x75, 0xbd, 0x25, 0x3b, 0x8d, 0xb7, 0xe4, 0x35, 0x58, 0xca,
+	0xa9, 0x52, 0x87, 0x42, 0x46, 0x8d, 0x16, 0x5e, 0x6d, 0x7d, 0xc3, 0x79, 0xfd, 0xc6, 0x39, 0x07,
+	0x64, 0xde, 0x8b, 0xeb, 0xf6, 0x65, 0x6b, 0xaf, 0xb3, 0xd4, 0x5a, 0x6b, 0xef, 0x5c, 0x7e, 0xe9,
+	0xb1, 0x44, 0xc7, 0x93, 0x7d, 0x2f, 0x14, 0xd9, 0xa0, 0x68, 0xfe, 0xfa, 0x3b, 0x6a, 0xf0, 0xaf,
+	0x1f, 0x7a, 0xfb, 0x3d, 0x73, 0xe2, 0xd6, 0x5f, 0x03, 0x00, 0x48, 0x63, 0x2d, 0x84, 0x08, 0x0e,
+	0x00, 0x00,
 }
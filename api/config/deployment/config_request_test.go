@@ -94,6 +94,45 @@ func TestValidateConfigRequestMissingUpgradeStrategy(t *testing.T) {
 	}
 }
 
+func TestValidateConfigRequestBackupSchedule(t *testing.T) {
+	tests := map[string]bool{
+		"0 2 * * *":    true,
+		"@daily":       true,
+		"*/15 * * * *": true,
+		"0 2 * *":      false,
+		"whenever":     false,
+	}
+
+	for cronExpr, pass := range tests {
+		c := newValidTestConfigRequest()
+		c.V1.Sys.Backup.Schedule = &ConfigRequest_V1_System_Backup_Schedule{Cron: w.String(cronExpr)}
+
+		if pass {
+			assert.Nil(t, c.Validate(), cronExpr)
+		} else {
+			assert.Error(t, c.Validate(), cronExpr)
+		}
+	}
+}
+
+func TestValidateConfigRequestBackupRetention(t *testing.T) {
+	c := newValidTestConfigRequest()
+	c.V1.Sys.Backup.Retention = &ConfigRequest_V1_System_Backup_Retention{
+		KeepDaily:  w.Int32(7),
+		KeepWeekly: w.Int32(4),
+		MaxAge:     w.String("2160h"),
+	}
+	assert.Nil(t, c.Validate())
+
+	c.V1.Sys.Backup.Retention = &ConfigRequest_V1_System_Backup_Retention{KeepDaily: w.Int32(-1)}
+	expected := config.NewInvalidConfigError()
+	expected.AddInvalidValue("deployment.v1.sys.backup.retention.keep_daily", "must not be negative")
+	assert.EqualError(t, c.Validate(), expected.Error(), "")
+
+	c.V1.Sys.Backup.Retention = &ConfigRequest_V1_System_Backup_Retention{MaxAge: w.String("90d")}
+	assert.Error(t, c.Validate())
+}
+
 func TestValidateConfigRequestMissingAdminUsername(t *testing.T) {
 	c := newValidTestConfigRequest()
 	c.V1.Svc.AdminUser.Username = nil
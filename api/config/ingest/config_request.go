@@ -10,9 +10,10 @@ func NewConfigRequest() *ConfigRequest {
 	return &ConfigRequest{
 		V1: &ConfigRequest_V1{
 			Sys: &ConfigRequest_V1_System{
-				Mlsa:    &ac.Mlsa{},
-				Service: &ConfigRequest_V1_System_Service{},
-				Log:     &ConfigRequest_V1_System_Log{},
+				Mlsa:       &ac.Mlsa{},
+				Service:    &ConfigRequest_V1_System_Service{},
+				Log:        &ConfigRequest_V1_System_Log{},
+				Enrichment: &ConfigRequest_V1_System_Enrichment{},
 			},
 			Svc: &ConfigRequest_V1_Service{},
 		},
@@ -28,6 +29,7 @@ func DefaultConfigRequest() *ConfigRequest {
 	c.V1.Sys.Service.PurgeActionsAfterDays = w.Int32(30)
 	c.V1.Sys.Log.Level = w.String("info")
 	c.V1.Sys.Log.Format = w.String("text")
+	c.V1.Sys.Enrichment.Key = w.String("node_name")
 	return c
 }
 
@@ -36,7 +38,18 @@ func DefaultConfigRequest() *ConfigRequest {
 // instance of config.InvalidConfigError that has the missing keys and invalid
 // fields populated.
 func (c *ConfigRequest) Validate() error {
-	return nil
+	cfgErr := ac.NewInvalidConfigError()
+
+	switch key := c.GetV1().GetSys().GetEnrichment().GetKey().GetValue(); key {
+	case "", "node_name", "fqdn":
+	default:
+		cfgErr.AddInvalidValue("ingest.v1.sys.enrichment.key", "must be node_name or fqdn")
+	}
+
+	if cfgErr.IsEmpty() {
+		return nil
+	}
+	return cfgErr
 }
 
 // PrepareSystemConfig returns a system configuration that can be used
@@ -32,7 +32,7 @@ func (m *ConfigRequest) Reset()         { *m = ConfigRequest{} }
 func (m *ConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest) ProtoMessage()    {}
 func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cd45976b35aa1cbb, []int{0}
+	return fileDescriptor_config_request_4a71b9476e696975, []int{0}
 }
 func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest.Unmarshal(m, b)
@@ -71,7 +71,7 @@ func (m *ConfigRequest_V1) Reset()         { *m = ConfigRequest_V1{} }
 func (m *ConfigRequest_V1) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1) ProtoMessage()    {}
 func (*ConfigRequest_V1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cd45976b35aa1cbb, []int{0, 0}
+	return fileDescriptor_config_request_4a71b9476e696975, []int{0, 0}
 }
 func (m *ConfigRequest_V1) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1.Unmarshal(m, b)
@@ -106,20 +106,21 @@ func (m *ConfigRequest_V1) GetSvc() *ConfigRequest_V1_Service {
 }
 
 type ConfigRequest_V1_System struct {
-	Mlsa                 *shared.Mlsa                     `protobuf:"bytes,1,opt,name=mlsa,proto3" json:"mlsa,omitempty" toml:"mlsa,omitempty" mapstructure:"mlsa,omitempty"`
-	Service              *ConfigRequest_V1_System_Service `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty" toml:"service,omitempty" mapstructure:"service,omitempty"`
-	Tls                  *shared.TLSCredentials           `protobuf:"bytes,3,opt,name=tls,proto3" json:"tls,omitempty" toml:"tls,omitempty" mapstructure:"tls,omitempty"`
-	Log                  *ConfigRequest_V1_System_Log     `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty" toml:"log,omitempty" mapstructure:"log,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte                           `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	Mlsa                 *shared.Mlsa                        `protobuf:"bytes,1,opt,name=mlsa,proto3" json:"mlsa,omitempty" toml:"mlsa,omitempty" mapstructure:"mlsa,omitempty"`
+	Service              *ConfigRequest_V1_System_Service    `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty" toml:"service,omitempty" mapstructure:"service,omitempty"`
+	Tls                  *shared.TLSCredentials              `protobuf:"bytes,3,opt,name=tls,proto3" json:"tls,omitempty" toml:"tls,omitempty" mapstructure:"tls,omitempty"`
+	Log                  *ConfigRequest_V1_System_Log        `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty" toml:"log,omitempty" mapstructure:"log,omitempty"`
+	Enrichment           *ConfigRequest_V1_System_Enrichment `protobuf:"bytes,5,opt,name=enrichment,proto3" json:"enrichment,omitempty" toml:"enrichment,omitempty" mapstructure:"enrichment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                               `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
 func (m *ConfigRequest_V1_System) Reset()         { *m = ConfigRequest_V1_System{} }
 func (m *ConfigRequest_V1_System) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System) ProtoMessage()    {}
 func (*ConfigRequest_V1_System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cd45976b35aa1cbb, []int{0, 0, 0}
+	return fileDescriptor_config_request_4a71b9476e696975, []int{0, 0, 0}
 }
 func (m *ConfigRequest_V1_System) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System.Unmarshal(m, b)
@@ -167,6 +168,13 @@ func (m *ConfigRequest_V1_System) GetLog() *ConfigRequest_V1_System_Log {
 	return nil
 }
 
+func (m *ConfigRequest_V1_System) GetEnrichment() *ConfigRequest_V1_System_Enrichment {
+	if m != nil {
+		return m.Enrichment
+	}
+	return nil
+}
+
 type ConfigRequest_V1_System_Service struct {
 	Host                          *wrappers.StringValue `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty" toml:"host,omitempty" mapstructure:"host,omitempty"`
 	Port                          *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty" toml:"port,omitempty" mapstructure:"port,omitempty"`
@@ -181,7 +189,7 @@ func (m *ConfigRequest_V1_System_Service) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cd45976b35aa1cbb, []int{0, 0, 0, 0}
+	return fileDescriptor_config_request_4a71b9476e696975, []int{0, 0, 0, 0}
 }
 func (m *ConfigRequest_V1_System_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Service.Unmarshal(m, b)
@@ -241,7 +249,7 @@ func (m *ConfigRequest_V1_System_Log) Reset()         { *m = ConfigRequest_V1_Sy
 func (m *ConfigRequest_V1_System_Log) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Log) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cd45976b35aa1cbb, []int{0, 0, 0, 1}
+	return fileDescriptor_config_request_4a71b9476e696975, []int{0, 0, 0, 1}
 }
 func (m *ConfigRequest_V1_System_Log) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Log.Unmarshal(m, b)
@@ -275,6 +283,52 @@ func (m *ConfigRequest_V1_System_Log) GetLevel() *wrappers.StringValue {
 	return nil
 }
 
+type ConfigRequest_V1_System_Enrichment struct {
+	TablePath            *wrappers.StringValue `protobuf:"bytes,1,opt,name=table_path,json=tablePath,proto3" json:"table_path,omitempty" toml:"table_path,omitempty" mapstructure:"table_path,omitempty"`
+	Key                  *wrappers.StringValue `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty" toml:"key,omitempty" mapstructure:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                 `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_Enrichment) Reset()         { *m = ConfigRequest_V1_System_Enrichment{} }
+func (m *ConfigRequest_V1_System_Enrichment) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest_V1_System_Enrichment) ProtoMessage()    {}
+func (*ConfigRequest_V1_System_Enrichment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_4a71b9476e696975, []int{0, 0, 0, 2}
+}
+func (m *ConfigRequest_V1_System_Enrichment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_Enrichment.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_Enrichment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_Enrichment.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_Enrichment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_Enrichment.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_Enrichment) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_Enrichment.Size(m)
+}
+func (m *ConfigRequest_V1_System_Enrichment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_Enrichment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_Enrichment proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_Enrichment) GetTablePath() *wrappers.StringValue {
+	if m != nil {
+		return m.TablePath
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Enrichment) GetKey() *wrappers.StringValue {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 type ConfigRequest_V1_Service struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -285,7 +339,7 @@ func (m *ConfigRequest_V1_Service) Reset()         { *m = ConfigRequest_V1_Servi
 func (m *ConfigRequest_V1_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_cd45976b35aa1cbb, []int{0, 0, 1}
+	return fileDescriptor_config_request_4a71b9476e696975, []int{0, 0, 1}
 }
 func (m *ConfigRequest_V1_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_Service.Unmarshal(m, b)
@@ -311,50 +365,54 @@ func init() {
 	proto.RegisterType((*ConfigRequest_V1_System)(nil), "chef.automate.domain.ingest.ConfigRequest.V1.System")
 	proto.RegisterType((*ConfigRequest_V1_System_Service)(nil), "chef.automate.domain.ingest.ConfigRequest.V1.System.Service")
 	proto.RegisterType((*ConfigRequest_V1_System_Log)(nil), "chef.automate.domain.ingest.ConfigRequest.V1.System.Log")
+	proto.RegisterType((*ConfigRequest_V1_System_Enrichment)(nil), "chef.automate.domain.ingest.ConfigRequest.V1.System.Enrichment")
 	proto.RegisterType((*ConfigRequest_V1_Service)(nil), "chef.automate.domain.ingest.ConfigRequest.V1.Service")
 }
 
 func init() {
-	proto.RegisterFile("api/config/ingest/config_request.proto", fileDescriptor_config_request_cd45976b35aa1cbb)
-}
-
-var fileDescriptor_config_request_cd45976b35aa1cbb = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xb5, 0x26, 0xeb, 0x86, 0x11, 0xa8, 0xb2, 0x00, 0x45, 0x19, 0x4c, 0x83, 0x03, 0x42,
-	0x13, 0x71, 0x68, 0x37, 0x24, 0x84, 0xc6, 0x61, 0x1b, 0x62, 0x50, 0x15, 0x21, 0xa5, 0x53, 0x0f,
-	0x5c, 0x2a, 0x37, 0x75, 0xdc, 0x48, 0x8e, 0x1d, 0x6c, 0x27, 0xa8, 0xaf, 0xc0, 0x63, 0xed, 0xc2,
-	0x43, 0xf0, 0x08, 0x1c, 0x77, 0x47, 0x28, 0xb6, 0x5b, 0x0d, 0x2a, 0x15, 0xb6, 0x5b, 0xac, 0xef,
-	0xfb, 0xff, 0xfc, 0xf7, 0xdf, 0x5f, 0x0c, 0x9e, 0xe2, 0x32, 0x8f, 0x53, 0xc1, 0xb3, 0x9c, 0xc6,
-	0x39, 0xa7, 0x44, 0x69, 0xb7, 0x1a, 0x4b, 0xf2, 0xa5, 0x22, 0x4a, 0xa3, 0x52, 0x0a, 0x2d, 0xe0,
-	0x4e, 0x3a, 0x23, 0x19, 0xc2, 0x95, 0x16, 0x05, 0xd6, 0x04, 0x4d, 0x45, 0x81, 0x73, 0x8e, 0xac,
-	0x22, 0xdc, 0xbd, 0x02, 0x51, 0x33, 0x2c, 0xc9, 0x34, 0xa6, 0x4c, 0x4c, 0x30, 0xb3, 0xe2, 0x70,
-	0x67, 0xb5, 0xae, 0x99, 0x72, 0xc5, 0x7e, 0x2a, 0x8a, 0x52, 0x70, 0xc2, 0xb5, 0x8a, 0x17, 0xfc,
-	0x88, 0xca, 0x32, 0x8d, 0x4d, 0x3d, 0x8d, 0x28, 0xe1, 0x11, 0xee, 0x45, 0x4e, 0xdf, 0xa0, 0x70,
-	0xaf, 0x59, 0xc4, 0x98, 0x73, 0xa1, 0xb1, 0xce, 0x05, 0x5f, 0xb0, 0x76, 0xa9, 0x10, 0x94, 0x11,
-	0xab, 0x9c, 0x54, 0x59, 0xfc, 0x55, 0xe2, 0xb2, 0x24, 0xd2, 0xd5, 0x9f, 0xfc, 0xdc, 0x02, 0x77,
-	0x4e, 0x0d, 0x27, 0xb1, 0xa7, 0x83, 0x6f, 0x40, 0xab, 0xee, 0x06, 0xde, 0xde, 0xc6, 0xb3, 0xdb,
-	0xbd, 0x08, 0xad, 0x39, 0x24, 0xfa, 0x43, 0x87, 0x46, 0xdd, 0xa4, 0x55, 0x77, 0xc3, 0x5f, 0x6d,
-	0xd0, 0x1a, 0x75, 0xe1, 0x3b, 0xe0, 0xa9, 0xb9, 0x0a, 0x36, 0x0c, 0xe6, 0xf0, 0x5a, 0x18, 0x34,
-	0x9c, 0x2b, 0x4d, 0x8a, 0xa4, 0x01, 0xc0, 0x33, 0xe0, 0xa9, 0x3a, 0x0d, 0x5a, 0x86, 0xf3, 0xf2,
-	0x9a, 0x1c, 0x22, 0xeb, 0x3c, 0x25, 0x49, 0x43, 0x08, 0x7f, 0x6c, 0x82, 0xb6, 0x05, 0xc3, 0x43,
-	0xe0, 0x17, 0x4c, 0x61, 0x67, 0x6e, 0xef, 0x2f, 0x68, 0xce, 0x33, 0x89, 0x91, 0xcd, 0x16, 0x7d,
-	0x64, 0x0a, 0x27, 0xa6, 0x1b, 0x8e, 0xc0, 0x96, 0xb2, 0x40, 0xe7, 0xe6, 0xe8, 0x26, 0xa7, 0x5a,
-	0x9a, 0x5a, 0xc0, 0xe0, 0x11, 0xf0, 0x34, 0x53, 0x2e, 0xf0, 0xfd, 0x75, 0x66, 0xce, 0x07, 0xc3,
-	0x53, 0x49, 0xa6, 0x84, 0xeb, 0x1c, 0x33, 0x95, 0x34, 0x32, 0xd8, 0x07, 0x1e, 0x13, 0x34, 0xf0,
-	0x8d, 0xfa, 0xd5, 0x8d, 0x1c, 0x0d, 0x04, 0x4d, 0x1a, 0x48, 0xf8, 0xbd, 0x05, 0xb6, 0x9c, 0x3d,
-	0xf8, 0x02, 0xf8, 0x33, 0xa1, 0xb4, 0xcb, 0xe8, 0x21, 0xb2, 0x63, 0x84, 0x16, 0x63, 0x84, 0x86,
-	0x5a, 0xe6, 0x9c, 0x8e, 0x30, 0xab, 0x48, 0x62, 0x3a, 0xe1, 0x19, 0xf0, 0x4b, 0x21, 0xb5, 0x0b,
-	0x67, 0x67, 0x45, 0xf1, 0x81, 0xeb, 0x83, 0x9e, 0x11, 0x9c, 0x3c, 0xb8, 0xb8, 0x0c, 0xe0, 0x32,
-	0xce, 0xce, 0xb7, 0x4f, 0xa1, 0xdf, 0x8c, 0x77, 0x62, 0x00, 0x90, 0x80, 0xc7, 0x65, 0x25, 0x29,
-	0x19, 0xa7, 0x82, 0xd7, 0xa4, 0xf9, 0x98, 0xe5, 0x4a, 0x0b, 0x39, 0x1f, 0xe3, 0x4c, 0x13, 0x39,
-	0x9e, 0xe2, 0xf9, 0x22, 0xae, 0x75, 0xbb, 0x24, 0x8f, 0x0c, 0xe5, 0xd4, 0x41, 0xde, 0x5b, 0xc6,
-	0x71, 0x83, 0x78, 0x8b, 0xe7, 0x0a, 0x9e, 0x83, 0xc0, 0x6e, 0x83, 0x53, 0xf3, 0xc3, 0x5c, 0xa5,
-	0xfb, 0xff, 0xa6, 0xdf, 0x37, 0xe2, 0x63, 0xab, 0x5d, 0x52, 0xfb, 0xfe, 0xf6, 0x66, 0xa7, 0x1d,
-	0x0a, 0xe0, 0x0d, 0x04, 0x85, 0x87, 0xa0, 0x9d, 0x09, 0x59, 0xe0, 0xff, 0x8b, 0xd1, 0xf5, 0xc2,
-	0x1e, 0xd8, 0x64, 0xa4, 0x26, 0xcc, 0x25, 0xb9, 0x5e, 0x64, 0x5b, 0xc3, 0x5b, 0xcb, 0x9b, 0x7b,
-	0x7d, 0xef, 0xe2, 0x32, 0xe8, 0x80, 0xbb, 0xf6, 0xd2, 0x23, 0x97, 0x71, 0xdf, 0xdf, 0xde, 0xe8,
-	0x78, 0x27, 0xcf, 0x3f, 0xef, 0xd3, 0x5c, 0xcf, 0xaa, 0x09, 0x4a, 0x45, 0x11, 0x37, 0xc3, 0xb2,
-	0x7c, 0x60, 0xe2, 0x95, 0x67, 0x6f, 0xd2, 0x36, 0x3b, 0x1e, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0x4d, 0xdb, 0xda, 0x29, 0x12, 0x05, 0x00, 0x00,
+	proto.RegisterFile("api/config/ingest/config_request.proto", fileDescriptor_config_request_4a71b9476e696975)
+}
+
+var fileDescriptor_config_request_4a71b9476e696975 = []byte{
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xd5, 0xd8, 0x4d, 0xdb, 0x73, 0x75, 0xaf, 0xa2, 0xd1, 0x05, 0x59, 0x2e, 0x54, 0x85,
+	0x05, 0x42, 0x15, 0x19, 0x93, 0xb4, 0x48, 0x08, 0x8a, 0x50, 0x5b, 0xa0, 0x10, 0x15, 0x81, 0xdc,
+	0x2a, 0x0b, 0x36, 0xd1, 0xc4, 0x39, 0xb1, 0x2d, 0xec, 0x19, 0x33, 0x33, 0x09, 0xf2, 0x2b, 0xf0,
+	0x42, 0xec, 0xbb, 0xe9, 0x3b, 0xf0, 0x1a, 0x7d, 0x01, 0xe4, 0xf1, 0x24, 0x14, 0x2a, 0x95, 0xb4,
+	0xbb, 0x19, 0x9d, 0xf3, 0xff, 0x9d, 0xcf, 0x19, 0x78, 0xc0, 0x8a, 0x34, 0x88, 0x04, 0x1f, 0xa7,
+	0x71, 0x90, 0xf2, 0x18, 0x95, 0xb6, 0xb7, 0x81, 0xc4, 0x2f, 0x13, 0x54, 0x9a, 0x16, 0x52, 0x68,
+	0x41, 0xd6, 0xa3, 0x04, 0xc7, 0x94, 0x4d, 0xb4, 0xc8, 0x99, 0x46, 0x3a, 0x12, 0x39, 0x4b, 0x39,
+	0xad, 0x15, 0xfe, 0xc6, 0x05, 0x88, 0x4a, 0x98, 0xc4, 0x51, 0x10, 0x67, 0x62, 0xc8, 0xb2, 0x5a,
+	0xec, 0xaf, 0x5f, 0xb6, 0xeb, 0x4c, 0x59, 0x63, 0x2f, 0x12, 0x79, 0x21, 0x38, 0x72, 0xad, 0x82,
+	0x19, 0xbf, 0x1d, 0xcb, 0x22, 0x0a, 0x8c, 0x3d, 0x6a, 0xc7, 0xc8, 0xdb, 0xac, 0xdb, 0xb6, 0xfa,
+	0x0a, 0xc5, 0xba, 0xd5, 0x25, 0x60, 0x9c, 0x0b, 0xcd, 0x74, 0x2a, 0xf8, 0x8c, 0xb5, 0x11, 0x0b,
+	0x11, 0x67, 0x58, 0x2b, 0x87, 0x93, 0x71, 0xf0, 0x55, 0xb2, 0xa2, 0x40, 0x69, 0xed, 0xf7, 0xcf,
+	0xd6, 0xe0, 0xdf, 0x03, 0xc3, 0x09, 0xeb, 0xea, 0xc8, 0x0b, 0x68, 0x4c, 0x3b, 0x9e, 0xb3, 0xb9,
+	0xf4, 0xf0, 0x9f, 0x6e, 0x9b, 0x5e, 0x51, 0x24, 0xfd, 0x4d, 0x47, 0xfb, 0x9d, 0xb0, 0x31, 0xed,
+	0xf8, 0x3f, 0x56, 0xa1, 0xd1, 0xef, 0x90, 0x37, 0xe0, 0xa8, 0x52, 0x79, 0x4b, 0x06, 0xb3, 0x73,
+	0x2d, 0x0c, 0x3d, 0x2e, 0x95, 0xc6, 0x3c, 0xac, 0x00, 0xe4, 0x10, 0x1c, 0x35, 0x8d, 0xbc, 0x86,
+	0xe1, 0x3c, 0xb9, 0x26, 0x07, 0xe5, 0x34, 0x8d, 0x30, 0xac, 0x08, 0xfe, 0xf7, 0x15, 0x68, 0xd6,
+	0x60, 0xb2, 0x03, 0x6e, 0x9e, 0x29, 0x66, 0x93, 0xdb, 0xfc, 0x03, 0x9a, 0xf2, 0xb1, 0x64, 0xb4,
+	0xee, 0x2d, 0x7d, 0x9f, 0x29, 0x16, 0x1a, 0x6f, 0xd2, 0x87, 0x15, 0x55, 0x03, 0x6d, 0x36, 0xbb,
+	0x37, 0xa9, 0x6a, 0x9e, 0xd4, 0x0c, 0x46, 0x76, 0xc1, 0xd1, 0x99, 0xb2, 0x0d, 0xdf, 0xba, 0x2a,
+	0x99, 0x93, 0xa3, 0xe3, 0x03, 0x89, 0x23, 0xe4, 0x3a, 0x65, 0x99, 0x0a, 0x2b, 0x19, 0xe9, 0x81,
+	0x93, 0x89, 0xd8, 0x73, 0x8d, 0xfa, 0xe9, 0x8d, 0x32, 0x3a, 0x12, 0x71, 0x58, 0x41, 0xc8, 0x00,
+	0x00, 0xb9, 0x4c, 0xa3, 0x24, 0x47, 0xae, 0xbd, 0x65, 0x83, 0x7c, 0x79, 0x23, 0xe4, 0xeb, 0x39,
+	0x26, 0xbc, 0x80, 0xf4, 0xcf, 0x1a, 0xb0, 0x62, 0xeb, 0x27, 0x8f, 0xc1, 0x4d, 0x84, 0xd2, 0x76,
+	0x08, 0x77, 0x68, 0xbd, 0xa7, 0x74, 0xb6, 0xa7, 0xf4, 0x58, 0xcb, 0x94, 0xc7, 0x7d, 0x96, 0x4d,
+	0x30, 0x34, 0x9e, 0xe4, 0x10, 0xdc, 0x42, 0x48, 0x6d, 0xbb, 0xbf, 0x7e, 0x49, 0xf1, 0x8e, 0xeb,
+	0xed, 0xae, 0x11, 0xec, 0xdf, 0x3e, 0x3d, 0xf7, 0xc8, 0x7c, 0x5e, 0xad, 0x6f, 0x1f, 0x7c, 0xb7,
+	0x7a, 0x3f, 0xa1, 0x01, 0x10, 0x84, 0x7b, 0xc5, 0x44, 0xc6, 0x38, 0x88, 0x04, 0x9f, 0x62, 0x75,
+	0x48, 0x52, 0xa5, 0x85, 0x2c, 0x07, 0x6c, 0xac, 0x51, 0x0e, 0x46, 0xac, 0x9c, 0xcd, 0xe3, 0xaa,
+	0x28, 0xe1, 0x5d, 0x43, 0x39, 0xb0, 0x90, 0xb7, 0x35, 0x63, 0xaf, 0x42, 0xbc, 0x62, 0xa5, 0x22,
+	0x27, 0xe0, 0xd5, 0x61, 0x58, 0x64, 0x5e, 0xe4, 0x45, 0xba, 0xfb, 0x77, 0xfa, 0x2d, 0x23, 0xde,
+	0xab, 0xb5, 0x73, 0x6a, 0xcf, 0x5d, 0x5d, 0x6e, 0x35, 0x7d, 0x01, 0xce, 0x91, 0x88, 0xc9, 0x0e,
+	0x34, 0xc7, 0x42, 0xe6, 0x6c, 0xb1, 0x36, 0x5a, 0x5f, 0xd2, 0x85, 0xe5, 0x0c, 0xa7, 0x98, 0x79,
+	0x8d, 0x05, 0x44, 0xb5, 0xab, 0x5f, 0x02, 0xfc, 0x1a, 0x2a, 0x79, 0x0e, 0xa0, 0xd9, 0x30, 0xc3,
+	0x41, 0xc1, 0x74, 0xb2, 0x50, 0xec, 0x35, 0xe3, 0xff, 0x91, 0xe9, 0x84, 0x50, 0x70, 0x3e, 0x63,
+	0xb9, 0x50, 0xf0, 0xca, 0xd1, 0x5f, 0x9b, 0x2f, 0xcd, 0xb3, 0xff, 0x4f, 0xcf, 0xbd, 0x16, 0xfc,
+	0x57, 0x6f, 0x5f, 0xdb, 0x8e, 0xb7, 0xe7, 0xae, 0x2e, 0xb5, 0x9c, 0xfd, 0x47, 0x9f, 0xb6, 0xe2,
+	0x54, 0x27, 0x93, 0x21, 0x8d, 0x44, 0x1e, 0x54, 0x5b, 0x3b, 0xff, 0x3c, 0x83, 0x4b, 0x5f, 0xfa,
+	0xb0, 0x69, 0xe2, 0x6d, 0xff, 0x1c, 0x00, 0xe2, 0xd7, 0x80, 0x0d, 0xee, 0x05, 0x00, 0x00,
 }
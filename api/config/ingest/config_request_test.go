@@ -29,3 +29,15 @@ func TestSetGlobalConfig(t *testing.T) {
 		},
 	)
 }
+
+func TestValidateEnrichmentKey(t *testing.T) {
+	c := DefaultConfigRequest()
+	c.V1.Sys.Enrichment.TablePath = w.String("/hab/svc/ingest-service/data/owners.csv")
+	assert.Nil(t, c.Validate())
+
+	c.V1.Sys.Enrichment.Key = w.String("fqdn")
+	assert.Nil(t, c.Validate())
+
+	c.V1.Sys.Enrichment.Key = w.String("ipaddress")
+	assert.Error(t, c.Validate())
+}
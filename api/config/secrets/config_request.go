@@ -10,10 +10,12 @@ func NewConfigRequest() *ConfigRequest {
 	return &ConfigRequest{
 		V1: &ConfigRequest_V1{
 			Sys: &ConfigRequest_V1_System{
-				Mlsa:    &ac.Mlsa{},
-				Service: &ConfigRequest_V1_System_Service{},
-				Storage: &ConfigRequest_V1_System_Storage{},
-				Log:     &ConfigRequest_V1_System_Log{},
+				Mlsa:     &ac.Mlsa{},
+				Service:  &ConfigRequest_V1_System_Service{},
+				Storage:  &ConfigRequest_V1_System_Storage{},
+				Log:      &ConfigRequest_V1_System_Log{},
+				Versions: &ConfigRequest_V1_System_Versions{},
+				Vault:    &ConfigRequest_V1_System_Vault{},
 			},
 			Svc: &ConfigRequest_V1_Service{},
 		},
@@ -29,6 +31,11 @@ func DefaultConfigRequest() *ConfigRequest {
 	c.V1.Sys.Log.Format = w.String("text")
 	c.V1.Sys.Storage.Database = w.String("secrets_service")
 	c.V1.Sys.Storage.User = w.String("secrets")
+	c.V1.Sys.Versions.Retention = w.Int32(10)
+	c.V1.Sys.Vault.Mount = w.String("secret")
+	c.V1.Sys.Vault.KvVersion = w.Int32(2)
+	c.V1.Sys.Vault.AuthMethod = w.String("token")
+	c.V1.Sys.Vault.ApproleMount = w.String("approle")
 	return c
 }
 
@@ -37,7 +44,37 @@ func DefaultConfigRequest() *ConfigRequest {
 // instance of config.InvalidConfigError that has the missing keys and invalid
 // fields populated.
 func (c *ConfigRequest) Validate() error {
-	return nil
+	cfgErr := ac.NewInvalidConfigError()
+
+	if r := c.GetV1().GetSys().GetVersions().GetRetention(); r != nil && r.GetValue() < 0 {
+		cfgErr.AddInvalidValue("secrets.v1.sys.versions.retention", "must not be negative")
+	}
+
+	if vault := c.GetV1().GetSys().GetVault(); vault.GetAddress().GetValue() != "" {
+		if v := vault.GetKvVersion(); v != nil && v.GetValue() != 1 && v.GetValue() != 2 {
+			cfgErr.AddInvalidValue("secrets.v1.sys.vault.kv_version", "must be 1 or 2")
+		}
+		switch vault.GetAuthMethod().GetValue() {
+		case "token":
+			if vault.GetToken().GetValue() == "" {
+				cfgErr.AddMissingKey("secrets.v1.sys.vault.token")
+			}
+		case "approle":
+			if vault.GetRoleId().GetValue() == "" {
+				cfgErr.AddMissingKey("secrets.v1.sys.vault.role_id")
+			}
+			if vault.GetSecretId().GetValue() == "" {
+				cfgErr.AddMissingKey("secrets.v1.sys.vault.secret_id")
+			}
+		default:
+			cfgErr.AddInvalidValue("secrets.v1.sys.vault.auth_method", "must be token or approle")
+		}
+	}
+
+	if cfgErr.IsEmpty() {
+		return nil
+	}
+	return cfgErr
 }
 
 // PrepareSystemConfig returns a system configuration that can be used
@@ -32,7 +32,7 @@ func (m *ConfigRequest) Reset()         { *m = ConfigRequest{} }
 func (m *ConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest) ProtoMessage()    {}
 func (*ConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3a5335000645691d, []int{0}
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0}
 }
 func (m *ConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest.Unmarshal(m, b)
@@ -71,7 +71,7 @@ func (m *ConfigRequest_V1) Reset()         { *m = ConfigRequest_V1{} }
 func (m *ConfigRequest_V1) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1) ProtoMessage()    {}
 func (*ConfigRequest_V1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3a5335000645691d, []int{0, 0}
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0}
 }
 func (m *ConfigRequest_V1) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1.Unmarshal(m, b)
@@ -106,22 +106,24 @@ func (m *ConfigRequest_V1) GetSvc() *ConfigRequest_V1_Service {
 }
 
 type ConfigRequest_V1_System struct {
-	Mlsa                 *shared.Mlsa                     `protobuf:"bytes,1,opt,name=mlsa,proto3" json:"mlsa,omitempty" toml:"mlsa,omitempty" mapstructure:"mlsa,omitempty"`
-	Service              *ConfigRequest_V1_System_Service `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty" toml:"service,omitempty" mapstructure:"service,omitempty"`
-	Tls                  *shared.TLSCredentials           `protobuf:"bytes,3,opt,name=tls,proto3" json:"tls,omitempty" toml:"tls,omitempty" mapstructure:"tls,omitempty"`
-	SecretsKey           *wrappers.StringValue            `protobuf:"bytes,4,opt,name=secrets_key,json=secretsKey,proto3" json:"secrets_key,omitempty" toml:"secrets_key,omitempty" mapstructure:"secrets_key,omitempty"`
-	Storage              *ConfigRequest_V1_System_Storage `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty" toml:"storage,omitempty" mapstructure:"storage,omitempty"`
-	Log                  *ConfigRequest_V1_System_Log     `protobuf:"bytes,6,opt,name=log,proto3" json:"log,omitempty" toml:"log,omitempty" mapstructure:"log,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte                           `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	Mlsa                 *shared.Mlsa                      `protobuf:"bytes,1,opt,name=mlsa,proto3" json:"mlsa,omitempty" toml:"mlsa,omitempty" mapstructure:"mlsa,omitempty"`
+	Service              *ConfigRequest_V1_System_Service  `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty" toml:"service,omitempty" mapstructure:"service,omitempty"`
+	Tls                  *shared.TLSCredentials            `protobuf:"bytes,3,opt,name=tls,proto3" json:"tls,omitempty" toml:"tls,omitempty" mapstructure:"tls,omitempty"`
+	SecretsKey           *wrappers.StringValue             `protobuf:"bytes,4,opt,name=secrets_key,json=secretsKey,proto3" json:"secrets_key,omitempty" toml:"secrets_key,omitempty" mapstructure:"secrets_key,omitempty"`
+	Storage              *ConfigRequest_V1_System_Storage  `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty" toml:"storage,omitempty" mapstructure:"storage,omitempty"`
+	Log                  *ConfigRequest_V1_System_Log      `protobuf:"bytes,6,opt,name=log,proto3" json:"log,omitempty" toml:"log,omitempty" mapstructure:"log,omitempty"`
+	Versions             *ConfigRequest_V1_System_Versions `protobuf:"bytes,7,opt,name=versions,proto3" json:"versions,omitempty" toml:"versions,omitempty" mapstructure:"versions,omitempty"`
+	Vault                *ConfigRequest_V1_System_Vault    `protobuf:"bytes,8,opt,name=vault,proto3" json:"vault,omitempty" toml:"vault,omitempty" mapstructure:"vault,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                             `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
 func (m *ConfigRequest_V1_System) Reset()         { *m = ConfigRequest_V1_System{} }
 func (m *ConfigRequest_V1_System) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System) ProtoMessage()    {}
 func (*ConfigRequest_V1_System) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3a5335000645691d, []int{0, 0, 0}
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0, 0}
 }
 func (m *ConfigRequest_V1_System) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System.Unmarshal(m, b)
@@ -183,6 +185,20 @@ func (m *ConfigRequest_V1_System) GetLog() *ConfigRequest_V1_System_Log {
 	return nil
 }
 
+func (m *ConfigRequest_V1_System) GetVersions() *ConfigRequest_V1_System_Versions {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System) GetVault() *ConfigRequest_V1_System_Vault {
+	if m != nil {
+		return m.Vault
+	}
+	return nil
+}
+
 type ConfigRequest_V1_System_Service struct {
 	Host                 *wrappers.StringValue `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty" toml:"host,omitempty" mapstructure:"host,omitempty"`
 	Port                 *wrappers.Int32Value  `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty" toml:"port,omitempty" mapstructure:"port,omitempty"`
@@ -195,7 +211,7 @@ func (m *ConfigRequest_V1_System_Service) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3a5335000645691d, []int{0, 0, 0, 0}
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0, 0, 0}
 }
 func (m *ConfigRequest_V1_System_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Service.Unmarshal(m, b)
@@ -241,7 +257,7 @@ func (m *ConfigRequest_V1_System_Log) Reset()         { *m = ConfigRequest_V1_Sy
 func (m *ConfigRequest_V1_System_Log) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Log) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3a5335000645691d, []int{0, 0, 0, 1}
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0, 0, 1}
 }
 func (m *ConfigRequest_V1_System_Log) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Log.Unmarshal(m, b)
@@ -287,7 +303,7 @@ func (m *ConfigRequest_V1_System_Storage) Reset()         { *m = ConfigRequest_V
 func (m *ConfigRequest_V1_System_Storage) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_System_Storage) ProtoMessage()    {}
 func (*ConfigRequest_V1_System_Storage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3a5335000645691d, []int{0, 0, 0, 2}
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0, 0, 2}
 }
 func (m *ConfigRequest_V1_System_Storage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_System_Storage.Unmarshal(m, b)
@@ -321,6 +337,162 @@ func (m *ConfigRequest_V1_System_Storage) GetUser() *wrappers.StringValue {
 	return nil
 }
 
+// Versions configures how many versions of each secret are kept;
+// 0 keeps all of them.
+type ConfigRequest_V1_System_Versions struct {
+	Retention            *wrappers.Int32Value `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty" toml:"retention,omitempty" mapstructure:"retention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_Versions) Reset()         { *m = ConfigRequest_V1_System_Versions{} }
+func (m *ConfigRequest_V1_System_Versions) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest_V1_System_Versions) ProtoMessage()    {}
+func (*ConfigRequest_V1_System_Versions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0, 0, 3}
+}
+func (m *ConfigRequest_V1_System_Versions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_Versions.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_Versions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_Versions.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_Versions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_Versions.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_Versions) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_Versions.Size(m)
+}
+func (m *ConfigRequest_V1_System_Versions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_Versions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_Versions proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_Versions) GetRetention() *wrappers.Int32Value {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+// Vault configures the Vault backend that secrets with a
+// reference are read from. It is disabled unless address is set.
+type ConfigRequest_V1_System_Vault struct {
+	Address   *wrappers.StringValue `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" toml:"address,omitempty" mapstructure:"address,omitempty"`
+	Namespace *wrappers.StringValue `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" toml:"namespace,omitempty" mapstructure:"namespace,omitempty"`
+	// KV secrets engine holding the secrets
+	Mount     *wrappers.StringValue `protobuf:"bytes,3,opt,name=mount,proto3" json:"mount,omitempty" toml:"mount,omitempty" mapstructure:"mount,omitempty"`
+	KvVersion *wrappers.Int32Value  `protobuf:"bytes,4,opt,name=kv_version,json=kvVersion,proto3" json:"kv_version,omitempty" toml:"kv_version,omitempty" mapstructure:"kv_version,omitempty"`
+	// "token" or "approle"
+	AuthMethod   *wrappers.StringValue `protobuf:"bytes,5,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty" toml:"auth_method,omitempty" mapstructure:"auth_method,omitempty"`
+	Token        *wrappers.StringValue `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty" toml:"token,omitempty" mapstructure:"token,omitempty"`
+	ApproleMount *wrappers.StringValue `protobuf:"bytes,7,opt,name=approle_mount,json=approleMount,proto3" json:"approle_mount,omitempty" toml:"approle_mount,omitempty" mapstructure:"approle_mount,omitempty"`
+	RoleId       *wrappers.StringValue `protobuf:"bytes,8,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty" toml:"role_id,omitempty" mapstructure:"role_id,omitempty"`
+	SecretId     *wrappers.StringValue `protobuf:"bytes,9,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty" toml:"secret_id,omitempty" mapstructure:"secret_id,omitempty"`
+	// PEM encoded CA certificate to verify Vault's certificate
+	// with, instead of the system's
+	RootCaCert           *wrappers.StringValue `protobuf:"bytes,10,opt,name=root_ca_cert,json=rootCaCert,proto3" json:"root_ca_cert,omitempty" toml:"root_ca_cert,omitempty" mapstructure:"root_ca_cert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                 `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ConfigRequest_V1_System_Vault) Reset()         { *m = ConfigRequest_V1_System_Vault{} }
+func (m *ConfigRequest_V1_System_Vault) String() string { return proto.CompactTextString(m) }
+func (*ConfigRequest_V1_System_Vault) ProtoMessage()    {}
+func (*ConfigRequest_V1_System_Vault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0, 0, 4}
+}
+func (m *ConfigRequest_V1_System_Vault) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigRequest_V1_System_Vault.Unmarshal(m, b)
+}
+func (m *ConfigRequest_V1_System_Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigRequest_V1_System_Vault.Marshal(b, m, deterministic)
+}
+func (dst *ConfigRequest_V1_System_Vault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRequest_V1_System_Vault.Merge(dst, src)
+}
+func (m *ConfigRequest_V1_System_Vault) XXX_Size() int {
+	return xxx_messageInfo_ConfigRequest_V1_System_Vault.Size(m)
+}
+func (m *ConfigRequest_V1_System_Vault) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRequest_V1_System_Vault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRequest_V1_System_Vault proto.InternalMessageInfo
+
+func (m *ConfigRequest_V1_System_Vault) GetAddress() *wrappers.StringValue {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetNamespace() *wrappers.StringValue {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetMount() *wrappers.StringValue {
+	if m != nil {
+		return m.Mount
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetKvVersion() *wrappers.Int32Value {
+	if m != nil {
+		return m.KvVersion
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetAuthMethod() *wrappers.StringValue {
+	if m != nil {
+		return m.AuthMethod
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetToken() *wrappers.StringValue {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetApproleMount() *wrappers.StringValue {
+	if m != nil {
+		return m.ApproleMount
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetRoleId() *wrappers.StringValue {
+	if m != nil {
+		return m.RoleId
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetSecretId() *wrappers.StringValue {
+	if m != nil {
+		return m.SecretId
+	}
+	return nil
+}
+
+func (m *ConfigRequest_V1_System_Vault) GetRootCaCert() *wrappers.StringValue {
+	if m != nil {
+		return m.RootCaCert
+	}
+	return nil
+}
+
 type ConfigRequest_V1_Service struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -331,7 +503,7 @@ func (m *ConfigRequest_V1_Service) Reset()         { *m = ConfigRequest_V1_Servi
 func (m *ConfigRequest_V1_Service) String() string { return proto.CompactTextString(m) }
 func (*ConfigRequest_V1_Service) ProtoMessage()    {}
 func (*ConfigRequest_V1_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_config_request_3a5335000645691d, []int{0, 0, 1}
+	return fileDescriptor_config_request_ee19d39722eac644, []int{0, 0, 1}
 }
 func (m *ConfigRequest_V1_Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigRequest_V1_Service.Unmarshal(m, b)
@@ -358,50 +530,65 @@ func init() {
 	proto.RegisterType((*ConfigRequest_V1_System_Service)(nil), "chef.automate.domain.secrets.ConfigRequest.V1.System.Service")
 	proto.RegisterType((*ConfigRequest_V1_System_Log)(nil), "chef.automate.domain.secrets.ConfigRequest.V1.System.Log")
 	proto.RegisterType((*ConfigRequest_V1_System_Storage)(nil), "chef.automate.domain.secrets.ConfigRequest.V1.System.Storage")
+	proto.RegisterType((*ConfigRequest_V1_System_Versions)(nil), "chef.automate.domain.secrets.ConfigRequest.V1.System.Versions")
+	proto.RegisterType((*ConfigRequest_V1_System_Vault)(nil), "chef.automate.domain.secrets.ConfigRequest.V1.System.Vault")
 	proto.RegisterType((*ConfigRequest_V1_Service)(nil), "chef.automate.domain.secrets.ConfigRequest.V1.Service")
 }
 
 func init() {
-	proto.RegisterFile("api/config/secrets/config_request.proto", fileDescriptor_config_request_3a5335000645691d)
-}
-
-var fileDescriptor_config_request_3a5335000645691d = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xd1, 0x6a, 0x13, 0x4f,
-	0x14, 0xc6, 0x69, 0x76, 0x9b, 0xe4, 0x3f, 0xe5, 0x8f, 0x75, 0x40, 0x59, 0xb6, 0xa5, 0x14, 0x6f,
-	0x14, 0x65, 0x67, 0x4d, 0x5a, 0x45, 0xc5, 0x7a, 0xd1, 0x5e, 0x54, 0xdb, 0x88, 0xb0, 0x91, 0x08,
-	0xde, 0x94, 0xc9, 0xe6, 0x64, 0xb2, 0x38, 0x3b, 0xb3, 0xce, 0xcc, 0x46, 0xf2, 0x0a, 0xde, 0xfa,
-	0x20, 0x3e, 0x81, 0x37, 0x7d, 0x06, 0xdf, 0xa4, 0x2f, 0x20, 0x3b, 0x3b, 0x09, 0x6a, 0x20, 0xa4,
-	0xf5, 0x72, 0x39, 0xe7, 0xfb, 0x9d, 0x73, 0xbe, 0x8f, 0x1d, 0x74, 0x9f, 0x16, 0x59, 0x9c, 0x4a,
-	0x31, 0xce, 0x58, 0xac, 0x21, 0x55, 0x60, 0xb4, 0xfb, 0xbc, 0x50, 0xf0, 0xb9, 0x04, 0x6d, 0x48,
-	0xa1, 0xa4, 0x91, 0x78, 0x37, 0x9d, 0xc0, 0x98, 0xd0, 0xd2, 0xc8, 0x9c, 0x1a, 0x20, 0x23, 0x99,
-	0xd3, 0x4c, 0x10, 0x27, 0x09, 0xf7, 0x7e, 0xc7, 0x4c, 0xa8, 0x82, 0x51, 0xcc, 0xb8, 0x1c, 0x52,
-	0x5e, 0xab, 0xc3, 0x9d, 0xe5, 0xba, 0xe1, 0xda, 0x15, 0xcf, 0x52, 0x99, 0x17, 0x52, 0x80, 0x30,
-	0x3a, 0x9e, 0x0f, 0x88, 0x98, 0x2a, 0xd2, 0xd8, 0xd6, 0xd3, 0x88, 0x81, 0x88, 0x68, 0x37, 0x72,
-	0xfa, 0x0a, 0x45, 0xbb, 0xd5, 0x47, 0x4c, 0x85, 0x90, 0x86, 0x9a, 0x4c, 0x8a, 0x39, 0x6b, 0x8f,
-	0x49, 0xc9, 0x38, 0xd4, 0xca, 0x61, 0x39, 0x8e, 0xbf, 0x28, 0x5a, 0x14, 0xa0, 0x5c, 0xfd, 0xde,
-	0x8f, 0x36, 0xfa, 0xff, 0xc4, 0x72, 0x92, 0xfa, 0x3c, 0xfc, 0x0a, 0x35, 0xa6, 0x9d, 0xc0, 0xdb,
-	0xdf, 0x78, 0xb0, 0xd5, 0x25, 0x64, 0xd5, 0x95, 0xe4, 0x0f, 0x21, 0x19, 0x74, 0x92, 0xc6, 0xb4,
-	0x13, 0xfe, 0x6c, 0xa1, 0xc6, 0xa0, 0x83, 0x4f, 0x91, 0xa7, 0x67, 0x3a, 0xd8, 0xb0, 0x9c, 0x27,
-	0xd7, 0xe3, 0x90, 0xfe, 0x4c, 0x1b, 0xc8, 0x93, 0x8a, 0x80, 0x5f, 0x23, 0x4f, 0x4f, 0xd3, 0xa0,
-	0x61, 0x41, 0x4f, 0xaf, 0x0b, 0x02, 0x35, 0xcd, 0x52, 0x48, 0x2a, 0x44, 0xf8, 0xbd, 0x89, 0x9a,
-	0x35, 0x19, 0x1f, 0x22, 0x3f, 0xe7, 0x9a, 0xba, 0xf5, 0xf6, 0xff, 0xa2, 0x66, 0x62, 0xac, 0x28,
-	0xa9, 0xed, 0x25, 0x6f, 0xb9, 0xa6, 0x89, 0xed, 0xc6, 0x1f, 0x50, 0x4b, 0xd7, 0x40, 0xb7, 0xce,
-	0xd1, 0x8d, 0xee, 0x5a, 0x6c, 0x35, 0xa7, 0xe1, 0x97, 0xc8, 0x33, 0x5c, 0x3b, 0xd3, 0x1f, 0xae,
-	0xda, 0xe6, 0x7d, 0xaf, 0x7f, 0xa2, 0x60, 0x04, 0xc2, 0x64, 0x94, 0xeb, 0xa4, 0x92, 0xe1, 0x23,
-	0xb4, 0xe5, 0x26, 0x5e, 0x7c, 0x82, 0x59, 0xe0, 0x5b, 0xca, 0x2e, 0xa9, 0x93, 0x27, 0xf3, 0xe4,
-	0x49, 0xdf, 0xa8, 0x4c, 0xb0, 0x01, 0xe5, 0x25, 0x24, 0xc8, 0x09, 0xce, 0x61, 0x66, 0xaf, 0x32,
-	0x52, 0x51, 0x06, 0xc1, 0xe6, 0x3f, 0x5d, 0x55, 0x43, 0x92, 0x39, 0x0d, 0x9f, 0x23, 0x8f, 0x4b,
-	0x16, 0x34, 0x2d, 0xf4, 0xf9, 0xcd, 0xa0, 0x3d, 0xc9, 0x92, 0x8a, 0x12, 0x7e, 0xdd, 0x40, 0x2d,
-	0xe7, 0x1b, 0x7e, 0x8c, 0xfc, 0x89, 0xd4, 0xc6, 0xa5, 0xb7, 0xfa, 0x52, 0xdb, 0x89, 0x4f, 0x91,
-	0x5f, 0x48, 0x65, 0x5c, 0x6c, 0x3b, 0x4b, 0x8a, 0x37, 0xc2, 0x1c, 0x74, 0xad, 0xe0, 0xf8, 0xee,
-	0xe5, 0x55, 0x80, 0x17, 0x41, 0x6f, 0x7f, 0x7b, 0x17, 0xfa, 0xd5, 0xbf, 0x97, 0x58, 0xc0, 0x99,
-	0xdf, 0xf6, 0xb6, 0xfd, 0x50, 0x22, 0xaf, 0x27, 0x19, 0x3e, 0x44, 0xcd, 0xb1, 0x54, 0x39, 0x5d,
-	0x6f, 0x13, 0xd7, 0x8b, 0xbb, 0x68, 0x93, 0xc3, 0x14, 0xb8, 0x5b, 0x66, 0xb5, 0xa8, 0x6e, 0x0d,
-	0x4b, 0xd4, 0x72, 0xf6, 0xe2, 0x67, 0xa8, 0x3d, 0xa2, 0x86, 0x0e, 0xa9, 0x86, 0xb5, 0xc6, 0x2e,
-	0xba, 0x2b, 0xdb, 0x4a, 0x0d, 0x6a, 0xad, 0xb9, 0xb6, 0x33, 0xfc, 0x6f, 0xe1, 0xf9, 0x8b, 0x3b,
-	0x97, 0x57, 0xc1, 0x6d, 0x74, 0xcb, 0xe5, 0x15, 0x39, 0x7b, 0x8e, 0xa3, 0x8f, 0x8f, 0x58, 0x66,
-	0x26, 0xe5, 0x90, 0xa4, 0x32, 0x8f, 0xab, 0x88, 0x17, 0x4f, 0x56, 0xbc, 0xfc, 0x94, 0x0e, 0x9b,
-	0x76, 0xd8, 0xc1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xc1, 0xeb, 0x20, 0x67, 0x05, 0x00,
-	0x00,
+	proto.RegisterFile("api/config/secrets/config_request.proto", fileDescriptor_config_request_ee19d39722eac644)
+}
+
+var fileDescriptor_config_request_ee19d39722eac644 = []byte{
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xc7, 0x95, 0xd8, 0xf9, 0xd8, 0xd3, 0x2d, 0x2d, 0x23, 0x81, 0x46, 0x6e, 0x55, 0x55, 0xdc,
+	0x80, 0x40, 0xb1, 0xd9, 0xf4, 0x43, 0x74, 0xa1, 0x2b, 0xd1, 0x15, 0x2a, 0xdb, 0xee, 0x0a, 0xe1,
+	0x45, 0x41, 0xea, 0x4d, 0x34, 0xb1, 0x4f, 0x1c, 0x2b, 0xf6, 0x8c, 0x99, 0x19, 0x1b, 0xe5, 0x11,
+	0xe0, 0x96, 0x17, 0xe0, 0x59, 0xfa, 0x0a, 0x3c, 0x4a, 0x2f, 0xb9, 0x41, 0x33, 0x9e, 0xa4, 0xc0,
+	0x4a, 0x8b, 0x1b, 0x2e, 0xad, 0xf9, 0xff, 0x7f, 0x73, 0xbe, 0xe6, 0x24, 0xf0, 0x31, 0xab, 0xf2,
+	0x28, 0x11, 0x7c, 0x99, 0x67, 0x91, 0xc2, 0x44, 0xa2, 0x56, 0xee, 0x73, 0x2e, 0xf1, 0xa7, 0x1a,
+	0x95, 0x0e, 0x2b, 0x29, 0xb4, 0x20, 0x77, 0x93, 0x15, 0x2e, 0x43, 0x56, 0x6b, 0x51, 0x32, 0x8d,
+	0x61, 0x2a, 0x4a, 0x96, 0xf3, 0xd0, 0x59, 0x82, 0x7b, 0x7f, 0xc7, 0xac, 0x98, 0xc4, 0x34, 0xca,
+	0x0a, 0xb1, 0x60, 0x45, 0xeb, 0x0e, 0xee, 0x5c, 0x3d, 0xd7, 0x85, 0x72, 0x87, 0x2f, 0x12, 0x51,
+	0x56, 0x82, 0x23, 0xd7, 0x2a, 0xda, 0x5e, 0x30, 0xc9, 0x64, 0x95, 0x44, 0xf6, 0x3c, 0x99, 0x64,
+	0xc8, 0x27, 0x6c, 0x3a, 0x71, 0x7e, 0x83, 0x62, 0x53, 0xf3, 0x11, 0x31, 0xce, 0x85, 0x66, 0x3a,
+	0x17, 0x7c, 0xcb, 0xba, 0x97, 0x09, 0x91, 0x15, 0xd8, 0x3a, 0x17, 0xf5, 0x32, 0xfa, 0x59, 0xb2,
+	0xaa, 0x42, 0xe9, 0xce, 0x3f, 0xfa, 0xe5, 0x16, 0xdc, 0x3c, 0xb5, 0x9c, 0xb8, 0x4d, 0x8f, 0x9c,
+	0x40, 0xbf, 0x39, 0xa2, 0xde, 0xfd, 0xde, 0x27, 0x37, 0xa6, 0x61, 0x78, 0x5d, 0x96, 0xe1, 0x3f,
+	0x8c, 0xe1, 0xec, 0x28, 0xee, 0x37, 0x47, 0xc1, 0xef, 0xef, 0x41, 0x7f, 0x76, 0x44, 0x9e, 0x83,
+	0xa7, 0x36, 0x8a, 0xf6, 0x2c, 0xe7, 0xd1, 0xbb, 0x71, 0xc2, 0xcb, 0x8d, 0xd2, 0x58, 0xc6, 0x86,
+	0x40, 0xbe, 0x05, 0x4f, 0x35, 0x09, 0xed, 0x5b, 0xd0, 0xe3, 0x77, 0x05, 0xa1, 0x6c, 0xf2, 0x04,
+	0x63, 0x83, 0x08, 0xfe, 0x3c, 0x84, 0x61, 0x4b, 0x26, 0x0f, 0xc1, 0x2f, 0x0b, 0xc5, 0x5c, 0x78,
+	0xf7, 0xff, 0x45, 0xcd, 0xf9, 0x52, 0xb2, 0xb0, 0x2d, 0x6f, 0x78, 0x51, 0x28, 0x16, 0x5b, 0x35,
+	0xf9, 0x11, 0x46, 0xaa, 0x05, 0xba, 0x70, 0x9e, 0xee, 0x95, 0xd7, 0x2e, 0xaa, 0x2d, 0x8d, 0x7c,
+	0x05, 0x9e, 0x2e, 0x94, 0x2b, 0xfa, 0xa7, 0xd7, 0x45, 0xf3, 0xc3, 0xf9, 0xe5, 0xa9, 0xc4, 0x14,
+	0xb9, 0xce, 0x59, 0xa1, 0x62, 0x63, 0x23, 0x4f, 0xe1, 0x86, 0xbb, 0x71, 0xbe, 0xc6, 0x0d, 0xf5,
+	0x2d, 0xe5, 0x6e, 0xd8, 0x76, 0x3e, 0xdc, 0x76, 0x3e, 0xbc, 0xd4, 0x32, 0xe7, 0xd9, 0x8c, 0x15,
+	0x35, 0xc6, 0xe0, 0x0c, 0x2f, 0x71, 0x63, 0xb3, 0xd2, 0x42, 0xb2, 0x0c, 0xe9, 0xe0, 0x7f, 0x65,
+	0xd5, 0x42, 0xe2, 0x2d, 0x8d, 0xbc, 0x04, 0xaf, 0x10, 0x19, 0x1d, 0x5a, 0xe8, 0x93, 0xfd, 0xa0,
+	0xe7, 0x22, 0x8b, 0x0d, 0x85, 0xbc, 0x82, 0x71, 0x83, 0x52, 0x99, 0xd1, 0xa6, 0x23, 0x4b, 0x3c,
+	0xd9, 0x8f, 0x38, 0x73, 0x94, 0x78, 0xc7, 0x23, 0xdf, 0xc3, 0xa0, 0x61, 0x75, 0xa1, 0xe9, 0xd8,
+	0x82, 0xbf, 0xdc, 0x13, 0x6c, 0x10, 0x71, 0x4b, 0x0a, 0x7e, 0xed, 0xc1, 0xc8, 0xb5, 0x99, 0x7c,
+	0x0e, 0xfe, 0x4a, 0x28, 0x4d, 0x7b, 0x1d, 0x1a, 0x63, 0x95, 0xe4, 0x39, 0xf8, 0x95, 0x90, 0xda,
+	0x4d, 0xd9, 0x9d, 0x2b, 0x8e, 0x33, 0xae, 0x1f, 0x4c, 0xad, 0xe1, 0xd9, 0x87, 0xaf, 0xdf, 0x50,
+	0xb2, 0x9b, 0xcb, 0xdb, 0xbf, 0x7d, 0x17, 0xf8, 0x66, 0x55, 0xc4, 0x16, 0xf0, 0xc2, 0x1f, 0x7b,
+	0xb7, 0xfd, 0x40, 0x80, 0x77, 0x2e, 0x32, 0xf2, 0x10, 0x86, 0x4b, 0x21, 0x4b, 0xd6, 0x2d, 0x12,
+	0xa7, 0x25, 0x53, 0x18, 0x14, 0xd8, 0x60, 0x41, 0xfb, 0x1d, 0x4c, 0xad, 0x34, 0xa8, 0x61, 0xe4,
+	0xa6, 0x81, 0x7c, 0x01, 0xe3, 0x94, 0x69, 0xb6, 0x60, 0x0a, 0x3b, 0x5d, 0xbb, 0x53, 0x9b, 0xb2,
+	0xd5, 0x0a, 0x65, 0xa7, 0x7b, 0xad, 0x32, 0xf8, 0x06, 0xc6, 0xdb, 0xee, 0x92, 0x27, 0x70, 0x20,
+	0x51, 0x9b, 0x77, 0x22, 0x38, 0xed, 0xfd, 0x67, 0x1d, 0xe3, 0xb7, 0xea, 0xe0, 0x0f, 0x1f, 0x06,
+	0xb6, 0x99, 0xe4, 0x31, 0x8c, 0x58, 0x9a, 0x4a, 0x54, 0xaa, 0x53, 0xec, 0x5b, 0x31, 0x39, 0x86,
+	0x03, 0xce, 0x4a, 0x54, 0x15, 0xdb, 0xad, 0x8a, 0xeb, 0x9d, 0x6f, 0xe5, 0xa6, 0xde, 0xa5, 0xa8,
+	0xb9, 0xa6, 0x5e, 0x07, 0x5f, 0x2b, 0x25, 0xc7, 0x00, 0xeb, 0x66, 0xee, 0xe6, 0x99, 0xfa, 0x1d,
+	0xb2, 0x5d, 0x37, 0xae, 0x52, 0x66, 0x7b, 0xb0, 0x5a, 0xaf, 0xe6, 0x25, 0xea, 0x95, 0x48, 0xe9,
+	0xa0, 0xc3, 0xad, 0x60, 0x0c, 0x17, 0x56, 0x6f, 0xc2, 0xd5, 0x62, 0x8d, 0x9c, 0x0e, 0x3b, 0x18,
+	0x5b, 0x29, 0xf9, 0x1a, 0x6e, 0xb2, 0xaa, 0x92, 0xa2, 0xc0, 0x79, 0x9b, 0xea, 0xa8, 0x83, 0xf7,
+	0xd0, 0x59, 0x2e, 0x6c, 0xc6, 0x8f, 0x60, 0x64, 0xfd, 0x79, 0x4a, 0xc7, 0x1d, 0xcc, 0x43, 0x23,
+	0x3e, 0x4b, 0xcd, 0x54, 0xb4, 0xcf, 0xd8, 0x18, 0x0f, 0xba, 0x8c, 0x63, 0x2b, 0x3f, 0x4b, 0xc9,
+	0x09, 0x1c, 0x4a, 0x21, 0xf4, 0x3c, 0x61, 0xf3, 0x04, 0xa5, 0xa6, 0xd0, 0xa5, 0x50, 0xc6, 0x71,
+	0xca, 0x4e, 0x51, 0xea, 0xe0, 0x60, 0xb7, 0x10, 0x8e, 0x3f, 0x78, 0xfd, 0x86, 0xbe, 0x0f, 0xb7,
+	0xdc, 0x42, 0x99, 0xb8, 0xb7, 0xfb, 0x6c, 0xf2, 0xea, 0xb3, 0x2c, 0xd7, 0xab, 0x7a, 0x11, 0x26,
+	0xa2, 0x8c, 0xcc, 0x0e, 0xda, 0xfd, 0xfc, 0x47, 0x57, 0xff, 0x96, 0x2c, 0x86, 0xf6, 0xca, 0x07,
+	0x7f, 0x0d, 0x00, 0x0a, 0x2b, 0x23, 0x9a, 0xb3, 0x08, 0x00, 0x00,
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	shared "github.com/chef/automate/api/config/shared"
 	w "github.com/chef/automate/api/config/shared/wrappers"
@@ -29,3 +30,66 @@ func TestSetGlobalConfig(t *testing.T) {
 		},
 	)
 }
+
+func TestValidateVersions(t *testing.T) {
+	t.Run("the default config is valid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("keeping all versions is valid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Versions.Retention = w.Int32(0)
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("negative retention is invalid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Versions.Retention = w.Int32(-1)
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "secrets.v1.sys.versions.retention")
+	})
+}
+
+func TestValidateVault(t *testing.T) {
+	t.Run("vault is disabled by default", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("token auth requires a token", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Vault.Address = w.String("https://vault.example.com:8200")
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "secrets.v1.sys.vault.token")
+
+		c.V1.Sys.Vault.Token = w.String("s.token")
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("approle auth requires a role and secret ID", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Vault.Address = w.String("https://vault.example.com:8200")
+		c.V1.Sys.Vault.AuthMethod = w.String("approle")
+		c.V1.Sys.Vault.RoleId = w.String("role")
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "secrets.v1.sys.vault.secret_id")
+
+		c.V1.Sys.Vault.SecretId = w.String("secret")
+		assert.NoError(t, c.Validate())
+	})
+
+	t.Run("unknown auth methods and kv versions are invalid", func(t *testing.T) {
+		c := DefaultConfigRequest()
+		c.V1.Sys.Vault.Address = w.String("https://vault.example.com:8200")
+		c.V1.Sys.Vault.AuthMethod = w.String("userpass")
+		c.V1.Sys.Vault.KvVersion = w.Int32(3)
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "secrets.v1.sys.vault.auth_method")
+		assert.Contains(t, err.Error(), "secrets.v1.sys.vault.kv_version")
+	})
+}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api/external/event_history/event_history.proto
+
+package event_history // import "github.com/chef/automate/api/external/event_history"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/chef/automate/components/automate-grpc/protoc-gen-policy/api"
+import _ "github.com/chef/automate/components/automate-grpc/protoc-gen-policy/iam"
+import _struct "github.com/golang/protobuf/ptypes/struct"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Empty fields match every event. producer matches the producer ID or name;
+// start and end bound the time the events were published.
+type ListEventsRequest struct {
+	EventType            string               `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Producer             string               `protobuf:"bytes,2,opt,name=producer,proto3" json:"producer,omitempty"`
+	Start                *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Page                 int32                `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              int32                `protobuf:"varint,6,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListEventsRequest) Reset()         { *m = ListEventsRequest{} }
+func (m *ListEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventsRequest) ProtoMessage()    {}
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_history_01e36b17e4f20157, []int{0}
+}
+func (m *ListEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsRequest.Unmarshal(m, b)
+}
+func (m *ListEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsRequest.Merge(dst, src)
+}
+func (m *ListEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEventsRequest.Size(m)
+}
+func (m *ListEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsRequest proto.InternalMessageInfo
+
+func (m *ListEventsRequest) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetProducer() string {
+	if m != nil {
+		return m.Producer
+	}
+	return ""
+}
+
+func (m *ListEventsRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *ListEventsRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *ListEventsRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *ListEventsRequest) GetPerPage() int32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	Events               []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total                int64    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEventsResponse) Reset()         { *m = ListEventsResponse{} }
+func (m *ListEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResponse) ProtoMessage()    {}
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_history_01e36b17e4f20157, []int{1}
+}
+func (m *ListEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResponse.Unmarshal(m, b)
+}
+func (m *ListEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResponse.Merge(dst, src)
+}
+func (m *ListEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResponse.Size(m)
+}
+func (m *ListEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsResponse proto.InternalMessageInfo
+
+func (m *ListEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ListEventsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type Event struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType            string               `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Producer             *Entity              `protobuf:"bytes,3,opt,name=producer,proto3" json:"producer,omitempty"`
+	Tags                 []string             `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Published            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=published,proto3" json:"published,omitempty"`
+	Actor                *Entity              `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	Verb                 string               `protobuf:"bytes,7,opt,name=verb,proto3" json:"verb,omitempty"`
+	Object               *Entity              `protobuf:"bytes,8,opt,name=object,proto3" json:"object,omitempty"`
+	Target               *Entity              `protobuf:"bytes,9,opt,name=target,proto3" json:"target,omitempty"`
+	Data                 *_struct.Struct      `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	Received             *timestamp.Timestamp `protobuf:"bytes,11,opt,name=received,proto3" json:"received,omitempty"`
+	Deliveries           []*Delivery          `protobuf:"bytes,12,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_history_01e36b17e4f20157, []int{2}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (dst *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(dst, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Event) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *Event) GetProducer() *Entity {
+	if m != nil {
+		return m.Producer
+	}
+	return nil
+}
+
+func (m *Event) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Event) GetPublished() *timestamp.Timestamp {
+	if m != nil {
+		return m.Published
+	}
+	return nil
+}
+
+func (m *Event) GetActor() *Entity {
+	if m != nil {
+		return m.Actor
+	}
+	return nil
+}
+
+func (m *Event) GetVerb() string {
+	if m != nil {
+		return m.Verb
+	}
+	return ""
+}
+
+func (m *Event) GetObject() *Entity {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *Event) GetTarget() *Entity {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *Event) GetData() *_struct.Struct {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Event) GetReceived() *timestamp.Timestamp {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
+func (m *Event) GetDeliveries() []*Delivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+type Entity struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ObjectType           string   `protobuf:"bytes,3,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Entity) Reset()         { *m = Entity{} }
+func (m *Entity) String() string { return proto.CompactTextString(m) }
+func (*Entity) ProtoMessage()    {}
+func (*Entity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_history_01e36b17e4f20157, []int{3}
+}
+func (m *Entity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Entity.Unmarshal(m, b)
+}
+func (m *Entity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Entity.Marshal(b, m, deterministic)
+}
+func (dst *Entity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entity.Merge(dst, src)
+}
+func (m *Entity) XXX_Size() int {
+	return xxx_messageInfo_Entity.Size(m)
+}
+func (m *Entity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Entity proto.InternalMessageInfo
+
+func (m *Entity) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Entity) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Entity) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
+}
+
+// Delivery is the outcome of handing the event to a subscribed service
+type Delivery struct {
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// delivered or dead_lettered
+	Status               string               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts             int32                `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Completed            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Delivery) Reset()         { *m = Delivery{} }
+func (m *Delivery) String() string { return proto.CompactTextString(m) }
+func (*Delivery) ProtoMessage()    {}
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_history_01e36b17e4f20157, []int{4}
+}
+func (m *Delivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Delivery.Unmarshal(m, b)
+}
+func (m *Delivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Delivery.Marshal(b, m, deterministic)
+}
+func (dst *Delivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delivery.Merge(dst, src)
+}
+func (m *Delivery) XXX_Size() int {
+	return xxx_messageInfo_Delivery.Size(m)
+}
+func (m *Delivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delivery proto.InternalMessageInfo
+
+func (m *Delivery) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *Delivery) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Delivery) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Delivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Delivery) GetCompleted() *timestamp.Timestamp {
+	if m != nil {
+		return m.Completed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListEventsRequest)(nil), "chef.automate.api.event_history.ListEventsRequest")
+	proto.RegisterType((*ListEventsResponse)(nil), "chef.automate.api.event_history.ListEventsResponse")
+	proto.RegisterType((*Event)(nil), "chef.automate.api.event_history.Event")
+	proto.RegisterType((*Entity)(nil), "chef.automate.api.event_history.Entity")
+	proto.RegisterType((*Delivery)(nil), "chef.automate.api.event_history.Delivery")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventHistoryClient is the client API for EventHistory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventHistoryClient interface {
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+}
+
+type eventHistoryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventHistoryClient(cc *grpc.ClientConn) EventHistoryClient {
+	return &eventHistoryClient{cc}
+}
+
+func (c *eventHistoryClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.event_history.EventHistory/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventHistoryServer is the server API for EventHistory service.
+type EventHistoryServer interface {
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+}
+
+func RegisterEventHistoryServer(s *grpc.Server, srv EventHistoryServer) {
+	s.RegisterService(&_EventHistory_serviceDesc, srv)
+}
+
+func _EventHistory_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventHistoryServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.event_history.EventHistory/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventHistoryServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventHistory_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chef.automate.api.event_history.EventHistory",
+	HandlerType: (*EventHistoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListEvents",
+			Handler:    _EventHistory_ListEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/external/event_history/event_history.proto",
+}
+
+func init() {
+	proto.RegisterFile("api/external/event_history/event_history.proto", fileDescriptor_event_history_01e36b17e4f20157)
+}
+
+var fileDescriptor_event_history_01e36b17e4f20157 = []byte{
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0xab, 0x23, 0x45,
+	0x10, 0x67, 0x92, 0x99, 0x6c, 0x52, 0x6f, 0x7d, 0x6a, 0xe3, 0x9f, 0x36, 0x28, 0x2f, 0x44, 0x70,
+	0x23, 0xfa, 0x26, 0x92, 0x87, 0x22, 0x0b, 0xfe, 0x41, 0x5d, 0x70, 0x41, 0x41, 0xc6, 0xc5, 0x83,
+	0x97, 0xd0, 0x99, 0xa9, 0x9d, 0xf4, 0x32, 0x33, 0xdd, 0x76, 0xd7, 0x04, 0x73, 0xdd, 0xa3, 0x57,
+	0x3f, 0xcb, 0xde, 0xfd, 0x02, 0x7a, 0xf1, 0xac, 0x27, 0x3f, 0x88, 0x4c, 0xf7, 0x24, 0x2f, 0x7f,
+	0x90, 0x18, 0xd8, 0x5b, 0x57, 0xd5, 0xef, 0x57, 0x5d, 0xf5, 0xa3, 0xba, 0x1a, 0x62, 0xa1, 0xe5,
+	0x14, 0x7f, 0x26, 0x34, 0x95, 0x28, 0xa6, 0xb8, 0xc2, 0x8a, 0xe6, 0x4b, 0x69, 0x49, 0x99, 0xf5,
+	0xbe, 0x15, 0x6b, 0xa3, 0x48, 0xb1, 0xab, 0x74, 0x89, 0x8f, 0x63, 0x51, 0x93, 0x2a, 0x05, 0x61,
+	0xc3, 0x8e, 0xf7, 0x60, 0xc3, 0x37, 0x73, 0xa5, 0xf2, 0x02, 0xa7, 0x0e, 0xbe, 0xa8, 0x1f, 0x4f,
+	0x2d, 0x99, 0x3a, 0x25, 0x4f, 0x1f, 0x5e, 0x1d, 0x46, 0x49, 0x96, 0x68, 0x49, 0x94, 0xba, 0x05,
+	0x6c, 0xe8, 0x4d, 0x59, 0xa2, 0xaa, 0x14, 0x09, 0x92, 0xaa, 0xb2, 0x6d, 0xf4, 0xf3, 0x54, 0x95,
+	0x5a, 0x55, 0x58, 0x91, 0x9d, 0x6e, 0x6a, 0xb8, 0xce, 0x8d, 0x4e, 0x7d, 0xc2, 0xf4, 0x3a, 0xc7,
+	0xea, 0x5a, 0xab, 0x42, 0xa6, 0xeb, 0xe7, 0x90, 0x41, 0x8a, 0xf2, 0x38, 0xc3, 0xf8, 0xaf, 0x00,
+	0x5e, 0xfe, 0x46, 0x5a, 0x7a, 0xd0, 0xb4, 0x6d, 0x13, 0xfc, 0xa9, 0x46, 0x4b, 0xec, 0x2d, 0x00,
+	0xaf, 0x03, 0xad, 0x35, 0xf2, 0x60, 0x14, 0x4c, 0x06, 0xc9, 0xc0, 0x79, 0x1e, 0xad, 0x35, 0xb2,
+	0x21, 0xf4, 0xb5, 0x51, 0x59, 0x9d, 0xa2, 0xe1, 0x1d, 0x17, 0xdc, 0xda, 0xec, 0x03, 0x88, 0x2c,
+	0x09, 0x43, 0xbc, 0x3b, 0x0a, 0x26, 0x17, 0xb3, 0x61, 0xec, 0x25, 0x88, 0x37, 0x1a, 0xc5, 0x8f,
+	0x36, 0x1a, 0x25, 0x1e, 0xc8, 0xde, 0x87, 0x2e, 0x56, 0x19, 0x0f, 0x4f, 0xe2, 0x1b, 0x18, 0x63,
+	0x10, 0x6a, 0x91, 0x23, 0x8f, 0x46, 0xc1, 0x24, 0x4a, 0xdc, 0x99, 0xbd, 0x01, 0x7d, 0x8d, 0x66,
+	0xee, 0xfc, 0x3d, 0xe7, 0xbf, 0xa3, 0xd1, 0x7c, 0x27, 0x72, 0x1c, 0x3f, 0x01, 0xb6, 0xdb, 0x9e,
+	0xd5, 0xaa, 0xb2, 0xc8, 0x3e, 0x85, 0x9e, 0xeb, 0xc6, 0xf2, 0x60, 0xd4, 0x9d, 0x5c, 0xcc, 0xde,
+	0x89, 0x4f, 0x0c, 0x42, 0xec, 0x12, 0x24, 0x2d, 0x8b, 0xbd, 0x02, 0x11, 0x29, 0x12, 0x85, 0xeb,
+	0xbe, 0x9b, 0x78, 0x63, 0xfc, 0x47, 0x08, 0x91, 0xc3, 0xb1, 0x4b, 0xe8, 0xc8, 0xac, 0xd5, 0xad,
+	0x23, 0xb3, 0x03, 0x3d, 0x3b, 0x87, 0x7a, 0x7e, 0xb9, 0xa3, 0xa7, 0x97, 0xed, 0xde, 0xe9, 0x82,
+	0x2a, 0x92, 0xb4, 0xde, 0x11, 0x9e, 0x41, 0x48, 0x22, 0xb7, 0x3c, 0x1c, 0x75, 0x27, 0x83, 0xc4,
+	0x9d, 0xd9, 0xc7, 0x30, 0xd0, 0xf5, 0xa2, 0x90, 0x76, 0x89, 0x19, 0x8f, 0x4e, 0x0a, 0x7c, 0x0b,
+	0x66, 0x9f, 0x40, 0x24, 0x52, 0x52, 0x86, 0xf7, 0xce, 0xab, 0xc7, 0xb3, 0x9a, 0x62, 0x56, 0x68,
+	0x16, 0xfc, 0x8e, 0x6b, 0xd5, 0x9d, 0xd9, 0x67, 0xd0, 0x53, 0x8b, 0x27, 0x98, 0x12, 0xef, 0x9f,
+	0x97, 0xb3, 0xa5, 0x35, 0x09, 0x48, 0x98, 0x1c, 0x89, 0x0f, 0xce, 0x4c, 0xe0, 0x69, 0xec, 0x3d,
+	0x08, 0x33, 0x41, 0x82, 0x83, 0xa3, 0xbf, 0x7e, 0xa4, 0xc4, 0xf7, 0xee, 0x71, 0x27, 0x0e, 0xc4,
+	0x3e, 0x82, 0xbe, 0xc1, 0x14, 0xe5, 0x0a, 0x33, 0x7e, 0x71, 0x52, 0xba, 0x2d, 0x96, 0x3d, 0x04,
+	0xc8, 0xb0, 0x90, 0x2b, 0x34, 0x12, 0x2d, 0xbf, 0xeb, 0xe6, 0xeb, 0xdd, 0x93, 0x95, 0x7e, 0xe5,
+	0x29, 0xeb, 0x64, 0x87, 0x3c, 0xfe, 0x16, 0x7a, 0xbe, 0x83, 0xa3, 0x81, 0x62, 0x10, 0x56, 0xa2,
+	0xdc, 0x8c, 0x92, 0x3b, 0xb3, 0x2b, 0xb8, 0xf0, 0x42, 0xf9, 0x29, 0xeb, 0xba, 0x10, 0x78, 0x57,
+	0x33, 0x66, 0xe3, 0xdf, 0x02, 0xe8, 0x6f, 0xee, 0x61, 0xf7, 0xe0, 0x45, 0x5b, 0x2f, 0x6c, 0x6a,
+	0xa4, 0x6e, 0xf6, 0xc1, 0x7c, 0x9b, 0xfe, 0x72, 0xd7, 0xfd, 0x30, 0x63, 0xaf, 0x41, 0xcf, 0x92,
+	0xa0, 0xda, 0xb6, 0x97, 0xb5, 0x56, 0xb3, 0x04, 0x04, 0x11, 0x96, 0x9a, 0xac, 0xbb, 0x2b, 0x4a,
+	0xb6, 0x76, 0x33, 0xef, 0x85, 0xb0, 0x34, 0x47, 0x63, 0x94, 0x71, 0x2f, 0x7b, 0x90, 0x0c, 0x1a,
+	0xcf, 0x83, 0xc6, 0xd1, 0x8c, 0x65, 0xb3, 0xb8, 0x0a, 0xa4, 0xff, 0x37, 0x96, 0x5b, 0xf0, 0xec,
+	0xef, 0x00, 0xee, 0xba, 0x27, 0xf6, 0xb5, 0xd7, 0x8d, 0xfd, 0x1e, 0x00, 0xdc, 0x3e, 0x70, 0x36,
+	0x3b, 0x29, 0xf4, 0xd1, 0xb2, 0x1b, 0xde, 0x9c, 0xc5, 0xf1, 0x1b, 0x64, 0xfc, 0xc3, 0xd3, 0x67,
+	0xfc, 0x55, 0x78, 0xc1, 0xae, 0x2d, 0x61, 0x79, 0xbf, 0x5d, 0x0b, 0xa1, 0x41, 0x91, 0xfd, 0xf2,
+	0x8c, 0xbf, 0x7d, 0x18, 0x60, 0x7b, 0xe6, 0xfd, 0x42, 0x5a, 0x7a, 0xfa, 0xe7, 0x3f, 0xbf, 0x76,
+	0x5e, 0x62, 0x97, 0xfb, 0xff, 0xd2, 0x17, 0x1f, 0xfe, 0x78, 0x93, 0x4b, 0x5a, 0xd6, 0x8b, 0x38,
+	0x55, 0xe5, 0xb4, 0x29, 0x6c, 0xbb, 0xd8, 0xa7, 0xff, 0xfd, 0xb9, 0x2d, 0x7a, 0x4e, 0xb6, 0x9b,
+	0x7f, 0x07, 0x00, 0x1e, 0x3a, 0x6f, 0xb5, 0x01, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: api/external/event_history/event_history.proto
+
+/*
+Package event_history is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package event_history
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+
+var (
+	filter_EventHistory_ListEvents_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+)
+
+func request_EventHistory_ListEvents_0(ctx context.Context, marshaler runtime.Marshaler, client EventHistoryClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq ListEventsRequest
+	var metadata runtime.ServerMetadata
+
+	if err := runtime.PopulateQueryParameters(&protoReq, req.URL.Query(), filter_EventHistory_ListEvents_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.ListEvents(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+// RegisterEventHistoryHandlerFromEndpoint is same as RegisterEventHistoryHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterEventHistoryHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterEventHistoryHandler(ctx, mux, conn)
+}
+
+// RegisterEventHistoryHandler registers the http handlers for service EventHistory to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterEventHistoryHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterEventHistoryHandlerClient(ctx, mux, NewEventHistoryClient(conn))
+}
+
+// RegisterEventHistoryHandlerClient registers the http handlers for service EventHistory
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "EventHistoryClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "EventHistoryClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "EventHistoryClient" to call the correct interceptors.
+func RegisterEventHistoryHandlerClient(ctx context.Context, mux *runtime.ServeMux, client EventHistoryClient) error {
+
+	mux.Handle("GET", pattern_EventHistory_ListEvents_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_EventHistory_ListEvents_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_EventHistory_ListEvents_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_EventHistory_ListEvents_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0}, []string{"event_history"}, ""))
+)
+
+var (
+	forward_EventHistory_ListEvents_0 = runtime.ForwardResponseMessage
+)
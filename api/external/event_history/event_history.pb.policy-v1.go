@@ -0,0 +1,24 @@
+// Code generated by protoc-gen-policy. DO NOT EDIT.
+// source: api/external/event_history/event_history.proto
+
+package event_history
+
+import policy "github.com/chef/automate/components/automate-gateway/api/authz/policy"
+
+func init() {
+	policy.MapMethodTo("/chef.automate.api.event_history.EventHistory/ListEvents", "system:events", "read", "GET", "/event_history", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*ListEventsRequest); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "event_type":
+					return m.EventType
+				case "producer":
+					return m.Producer
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+}
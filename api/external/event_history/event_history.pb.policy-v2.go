@@ -0,0 +1,24 @@
+// Code generated by protoc-gen-policy. DO NOT EDIT.
+// source: api/external/event_history/event_history.proto
+
+package event_history
+
+import policyv2 "github.com/chef/automate/components/automate-gateway/authz/policy_v2"
+
+func init() {
+	policyv2.MapMethodTo("/chef.automate.api.event_history.EventHistory/ListEvents", "system:events", "system:events:list", "GET", "/event_history", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*ListEventsRequest); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "event_type":
+					return m.EventType
+				case "producer":
+					return m.Producer
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+}
@@ -2,6 +2,7 @@ package secrets
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/chef/automate/components/secrets-service/types"
 	"github.com/chef/automate/components/secrets-service/utils"
@@ -24,6 +25,10 @@ const (
 	requiredAzureClientSecretError         = "Invalid data content for secret type 'azure'. AZURE_CLIENT_SECRET not provided"
 	requiredAzureTenantIDError             = "Invalid data content for secret type 'azure'. AZURE_TENANT_ID not provided"
 	requiredGcpCredentialsJsonError        = "Invalid data content for secret type 'gcp'. GOOGLE_CREDENTIALS_JSON not provided"
+	requiredReferenceBackendError          = "Invalid reference, 'backend' is a required parameter"
+	requiredReferencePathError             = "Invalid reference, 'path' is a required parameter"
+	invalidReferencePathError              = "Invalid reference, 'path' must be relative and must not contain '..'"
+	exclusiveReferenceDataError            = "Invalid secret, 'data' and 'reference' are mutually exclusive"
 )
 
 // Validate validates a Secret and returns the first validation error encountered.
@@ -31,6 +36,24 @@ func (s *Secret) Validate() error {
 	errors := make([]*error, 0)
 	errors = requiredField(s.Name, requiredNameError, errors)
 
+	if s.Reference != nil {
+		// the data is in the backend, we can't validate it here
+		errors = requiredField(s.Reference.Backend, requiredReferenceBackendError, errors)
+		errors = requiredField(s.Reference.Path, requiredReferencePathError, errors)
+		if !validReferencePath(s.Reference.Path) {
+			err := utils.ProcessInvalid(nil, invalidReferencePathError)
+			errors = append(errors, &err)
+		}
+		if len(s.Data) > 0 {
+			err := utils.ProcessInvalid(nil, exclusiveReferenceDataError)
+			errors = append(errors, &err)
+		}
+		if len(errors) > 0 {
+			return *errors[0]
+		}
+		return nil
+	}
+
 	kvMap := make(map[string]string, len(s.Data))
 	for _, kv := range s.Data {
 		kvMap[kv.Key] = kv.Value
@@ -91,6 +114,16 @@ func (s *Secret) Merge(newSecret *Secret) {
 		s.Tags = newSecret.Tags
 	}
 
+	// A reference replaces the data, and data replaces the reference
+	if newSecret.Reference != nil {
+		s.Reference = newSecret.Reference
+		s.Data = nil
+		return
+	}
+	if len(newSecret.Data) > 0 {
+		s.Reference = nil
+	}
+
 	oldData := kvsToMap(s.Data)
 	newData := kvsToMap(newSecret.Data)
 
@@ -127,6 +160,20 @@ func (s *Secret) Merge(newSecret *Secret) {
 	}).Debug("existing secret merged with new secret")
 }
 
+// validReferencePath returns whether a reference path stays within the
+// backend's configured location
+func validReferencePath(path string) bool {
+	if strings.HasPrefix(path, "/") {
+		return false
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
 func kvsToMap(kvs []*Kv) map[string]string {
 	m := make(map[string]string)
 	for _, kv := range kvs {
@@ -47,7 +47,7 @@ func (x Query_OrderType) String() string {
 	return proto.EnumName(Query_OrderType_name, int32(x))
 }
 func (Query_OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{3, 0}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{3, 0}
 }
 
 type UpdateResponse struct {
@@ -60,7 +60,7 @@ func (m *UpdateResponse) Reset()         { *m = UpdateResponse{} }
 func (m *UpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateResponse) ProtoMessage()    {}
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{0}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{0}
 }
 func (m *UpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateResponse.Unmarshal(m, b)
@@ -90,7 +90,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{1}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{1}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
@@ -111,7 +111,9 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
 type Id struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the version to read; the latest version if unset
+	Version              int32    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -121,7 +123,7 @@ func (m *Id) Reset()         { *m = Id{} }
 func (m *Id) String() string { return proto.CompactTextString(m) }
 func (*Id) ProtoMessage()    {}
 func (*Id) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{2}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{2}
 }
 func (m *Id) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Id.Unmarshal(m, b)
@@ -148,6 +150,13 @@ func (m *Id) GetId() string {
 	return ""
 }
 
+func (m *Id) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type Query struct {
 	Filters              []*Filter       `protobuf:"bytes,20,rep,name=filters,proto3" json:"filters,omitempty"`
 	Order                Query_OrderType `protobuf:"varint,21,opt,name=order,proto3,enum=chef.automate.api.secrets.Query_OrderType" json:"order,omitempty"`
@@ -163,7 +172,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{3}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{3}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -219,22 +228,27 @@ func (m *Query) GetPerPage() int32 {
 }
 
 type Secret struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type                 string               `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	LastModified         *timestamp.Timestamp `protobuf:"bytes,20,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
-	Tags                 []*Kv                `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`
-	Data                 []*Kv                `protobuf:"bytes,22,rep,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id           string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type         string               `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	LastModified *timestamp.Timestamp `protobuf:"bytes,20,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	Tags         []*Kv                `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`
+	Data         []*Kv                `protobuf:"bytes,22,rep,name=data,proto3" json:"data,omitempty"`
+	// set on read; every update creates a new version
+	Version int32 `protobuf:"varint,23,opt,name=version,proto3" json:"version,omitempty"`
+	// secret held by an external backend instead of data; its data is read
+	// from the backend whenever the secret is read
+	Reference            *Reference `protobuf:"bytes,24,opt,name=reference,proto3" json:"reference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{4}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{4}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Secret.Unmarshal(m, b)
@@ -296,6 +310,69 @@ func (m *Secret) GetData() []*Kv {
 	return nil
 }
 
+func (m *Secret) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Secret) GetReference() *Reference {
+	if m != nil {
+		return m.Reference
+	}
+	return nil
+}
+
+type Reference struct {
+	// the backend holding the secret, e.g. "vault"
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// the path of the secret in the backend, e.g. "ssh/production" for Vault,
+	// relative to the configured KV mount
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Reference) Reset()         { *m = Reference{} }
+func (m *Reference) String() string { return proto.CompactTextString(m) }
+func (*Reference) ProtoMessage()    {}
+func (*Reference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{5}
+}
+func (m *Reference) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reference.Unmarshal(m, b)
+}
+func (m *Reference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reference.Marshal(b, m, deterministic)
+}
+func (dst *Reference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reference.Merge(dst, src)
+}
+func (m *Reference) XXX_Size() int {
+	return xxx_messageInfo_Reference.Size(m)
+}
+func (m *Reference) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reference proto.InternalMessageInfo
+
+func (m *Reference) GetBackend() string {
+	if m != nil {
+		return m.Backend
+	}
+	return ""
+}
+
+func (m *Reference) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 type Secrets struct {
 	Secrets              []*Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
 	Total                int32     `protobuf:"varint,20,opt,name=total,proto3" json:"total,omitempty"`
@@ -308,7 +385,7 @@ func (m *Secrets) Reset()         { *m = Secrets{} }
 func (m *Secrets) String() string { return proto.CompactTextString(m) }
 func (*Secrets) ProtoMessage()    {}
 func (*Secrets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{5}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{6}
 }
 func (m *Secrets) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Secrets.Unmarshal(m, b)
@@ -355,7 +432,7 @@ func (m *Filter) Reset()         { *m = Filter{} }
 func (m *Filter) String() string { return proto.CompactTextString(m) }
 func (*Filter) ProtoMessage()    {}
 func (*Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{6}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{7}
 }
 func (m *Filter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Filter.Unmarshal(m, b)
@@ -408,7 +485,7 @@ func (m *Kv) Reset()         { *m = Kv{} }
 func (m *Kv) String() string { return proto.CompactTextString(m) }
 func (*Kv) ProtoMessage()    {}
 func (*Kv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_secrets_5da71549348d4103, []int{7}
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{8}
 }
 func (m *Kv) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Kv.Unmarshal(m, b)
@@ -442,15 +519,207 @@ func (m *Kv) GetValue() string {
 	return ""
 }
 
+type SecretVersion struct {
+	Version              int32                `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string               `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,20,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SecretVersion) Reset()         { *m = SecretVersion{} }
+func (m *SecretVersion) String() string { return proto.CompactTextString(m) }
+func (*SecretVersion) ProtoMessage()    {}
+func (*SecretVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{9}
+}
+func (m *SecretVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SecretVersion.Unmarshal(m, b)
+}
+func (m *SecretVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SecretVersion.Marshal(b, m, deterministic)
+}
+func (dst *SecretVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretVersion.Merge(dst, src)
+}
+func (m *SecretVersion) XXX_Size() int {
+	return xxx_messageInfo_SecretVersion.Size(m)
+}
+func (m *SecretVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretVersion proto.InternalMessageInfo
+
+func (m *SecretVersion) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SecretVersion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SecretVersion) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *SecretVersion) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+type SecretVersions struct {
+	// the retained versions, latest first
+	Versions             []*SecretVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SecretVersions) Reset()         { *m = SecretVersions{} }
+func (m *SecretVersions) String() string { return proto.CompactTextString(m) }
+func (*SecretVersions) ProtoMessage()    {}
+func (*SecretVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{10}
+}
+func (m *SecretVersions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SecretVersions.Unmarshal(m, b)
+}
+func (m *SecretVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SecretVersions.Marshal(b, m, deterministic)
+}
+func (dst *SecretVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretVersions.Merge(dst, src)
+}
+func (m *SecretVersions) XXX_Size() int {
+	return xxx_messageInfo_SecretVersions.Size(m)
+}
+func (m *SecretVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretVersions proto.InternalMessageInfo
+
+func (m *SecretVersions) GetVersions() []*SecretVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type RollbackRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the version to restore; it becomes the latest version again
+	Version              int32    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackRequest) Reset()         { *m = RollbackRequest{} }
+func (m *RollbackRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackRequest) ProtoMessage()    {}
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{11}
+}
+func (m *RollbackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackRequest.Unmarshal(m, b)
+}
+func (m *RollbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackRequest.Marshal(b, m, deterministic)
+}
+func (dst *RollbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackRequest.Merge(dst, src)
+}
+func (m *RollbackRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackRequest.Size(m)
+}
+func (m *RollbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackRequest proto.InternalMessageInfo
+
+func (m *RollbackRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RollbackRequest) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type RollbackResponse struct {
+	// the version created by the rollback
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackResponse) Reset()         { *m = RollbackResponse{} }
+func (m *RollbackResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackResponse) ProtoMessage()    {}
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_secrets_d8ce49c5b66ba5a5, []int{12}
+}
+func (m *RollbackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackResponse.Unmarshal(m, b)
+}
+func (m *RollbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackResponse.Marshal(b, m, deterministic)
+}
+func (dst *RollbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackResponse.Merge(dst, src)
+}
+func (m *RollbackResponse) XXX_Size() int {
+	return xxx_messageInfo_RollbackResponse.Size(m)
+}
+func (m *RollbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackResponse proto.InternalMessageInfo
+
+func (m *RollbackResponse) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*UpdateResponse)(nil), "chef.automate.api.secrets.UpdateResponse")
 	proto.RegisterType((*DeleteResponse)(nil), "chef.automate.api.secrets.DeleteResponse")
 	proto.RegisterType((*Id)(nil), "chef.automate.api.secrets.Id")
 	proto.RegisterType((*Query)(nil), "chef.automate.api.secrets.Query")
 	proto.RegisterType((*Secret)(nil), "chef.automate.api.secrets.Secret")
+	proto.RegisterType((*Reference)(nil), "chef.automate.api.secrets.Reference")
 	proto.RegisterType((*Secrets)(nil), "chef.automate.api.secrets.Secrets")
 	proto.RegisterType((*Filter)(nil), "chef.automate.api.secrets.Filter")
 	proto.RegisterType((*Kv)(nil), "chef.automate.api.secrets.Kv")
+	proto.RegisterType((*SecretVersion)(nil), "chef.automate.api.secrets.SecretVersion")
+	proto.RegisterType((*SecretVersions)(nil), "chef.automate.api.secrets.SecretVersions")
+	proto.RegisterType((*RollbackRequest)(nil), "chef.automate.api.secrets.RollbackRequest")
+	proto.RegisterType((*RollbackResponse)(nil), "chef.automate.api.secrets.RollbackResponse")
 	proto.RegisterEnum("chef.automate.api.secrets.Query_OrderType", Query_OrderType_name, Query_OrderType_value)
 }
 
@@ -471,6 +740,8 @@ type SecretsServiceClient interface {
 	Update(ctx context.Context, in *Secret, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Delete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DeleteResponse, error)
 	List(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Secrets, error)
+	ListVersions(ctx context.Context, in *Id, opts ...grpc.CallOption) (*SecretVersions, error)
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
 }
 
 type secretsServiceClient struct {
@@ -526,6 +797,24 @@ func (c *secretsServiceClient) List(ctx context.Context, in *Query, opts ...grpc
 	return out, nil
 }
 
+func (c *secretsServiceClient) ListVersions(ctx context.Context, in *Id, opts ...grpc.CallOption) (*SecretVersions, error) {
+	out := new(SecretVersions)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.secrets.SecretsService/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsServiceClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.secrets.SecretsService/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecretsServiceServer is the server API for SecretsService service.
 type SecretsServiceServer interface {
 	Create(context.Context, *Secret) (*Id, error)
@@ -533,6 +822,8 @@ type SecretsServiceServer interface {
 	Update(context.Context, *Secret) (*UpdateResponse, error)
 	Delete(context.Context, *Id) (*DeleteResponse, error)
 	List(context.Context, *Query) (*Secrets, error)
+	ListVersions(context.Context, *Id) (*SecretVersions, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
 }
 
 func RegisterSecretsServiceServer(s *grpc.Server, srv SecretsServiceServer) {
@@ -629,6 +920,42 @@ func _SecretsService_List_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecretsService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.secrets.SecretsService/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServiceServer).ListVersions(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretsService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.secrets.SecretsService/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServiceServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SecretsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chef.automate.api.secrets.SecretsService",
 	HandlerType: (*SecretsServiceServer)(nil),
@@ -653,66 +980,87 @@ var _SecretsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _SecretsService_List_Handler,
 		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _SecretsService_ListVersions_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _SecretsService_Rollback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/external/secrets/secrets.proto",
 }
 
 func init() {
-	proto.RegisterFile("api/external/secrets/secrets.proto", fileDescriptor_secrets_5da71549348d4103)
-}
-
-var fileDescriptor_secrets_5da71549348d4103 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x66, 0xf6, 0xc7, 0x9b, 0x9c, 0xb6, 0xe9, 0x32, 0x6c, 0xb6, 0xd3, 0x15, 0x3f, 0x8b, 0x6f,
-	0xd8, 0x06, 0x62, 0x8b, 0xe5, 0x2e, 0x5c, 0x50, 0x48, 0x41, 0xaa, 0x5a, 0x04, 0x38, 0xed, 0x4d,
-	0x85, 0x14, 0x4d, 0xec, 0x13, 0x67, 0x84, 0xd7, 0x63, 0x79, 0x66, 0xa3, 0xae, 0x10, 0x37, 0xb9,
-	0x42, 0xb9, 0xe5, 0x09, 0xb8, 0x83, 0x07, 0x58, 0x89, 0x3b, 0x1e, 0x82, 0x57, 0x40, 0x42, 0xbc,
-	0x05, 0x9a, 0x19, 0x3b, 0x54, 0xde, 0x76, 0x37, 0x48, 0x5c, 0x79, 0xe6, 0xcc, 0x77, 0xbe, 0xf9,
-	0xe6, 0x3b, 0x47, 0xc7, 0xe0, 0xf3, 0x42, 0x84, 0xf8, 0x5c, 0x63, 0x99, 0xf3, 0x2c, 0x54, 0x18,
-	0x97, 0xa8, 0x55, 0xfd, 0x0d, 0x8a, 0x52, 0x6a, 0x49, 0xef, 0xc6, 0x67, 0x78, 0x1a, 0xf0, 0xb9,
-	0x96, 0x33, 0xae, 0x31, 0xe0, 0x85, 0x08, 0x2a, 0xc0, 0xe8, 0x9d, 0x54, 0xca, 0x34, 0xc3, 0xd0,
-	0x02, 0x4f, 0xe6, 0xa7, 0xa1, 0x16, 0x33, 0x54, 0x9a, 0xcf, 0x0a, 0x97, 0x3b, 0x7a, 0xb3, 0x02,
-	0x98, 0x6b, 0x78, 0x9e, 0x4b, 0xcd, 0xb5, 0x90, 0x79, 0xc5, 0x3c, 0xba, 0x1f, 0xcb, 0x59, 0x21,
-	0x73, 0xcc, 0xb5, 0x0a, 0x6b, 0xfe, 0xfd, 0xb4, 0x2c, 0x62, 0x47, 0x18, 0xef, 0xa7, 0x98, 0xef,
-	0x17, 0x32, 0x13, 0xf1, 0xe2, 0x7f, 0x60, 0x10, 0x7c, 0xb6, 0xca, 0xe0, 0xf7, 0x61, 0xe7, 0x69,
-	0x91, 0x70, 0x8d, 0x11, 0xaa, 0x42, 0xe6, 0x0a, 0x4d, 0xe4, 0x01, 0x66, 0xf8, 0x42, 0x64, 0x00,
-	0xad, 0x87, 0x09, 0xdd, 0x81, 0x96, 0x48, 0x18, 0x19, 0x93, 0xc9, 0x76, 0xd4, 0x12, 0x89, 0xff,
-	0x37, 0x81, 0xee, 0x37, 0x73, 0x2c, 0x17, 0xf4, 0x63, 0xe8, 0x9d, 0x8a, 0x4c, 0x63, 0xa9, 0xd8,
-	0x60, 0xdc, 0x9e, 0xdc, 0x98, 0xbe, 0x1b, 0xbc, 0xd2, 0xb3, 0xe0, 0x0b, 0x8b, 0x8c, 0xea, 0x0c,
-	0x7a, 0x1f, 0xba, 0xb2, 0x4c, 0xb0, 0x64, 0xbb, 0x63, 0x32, 0xd9, 0x99, 0xee, 0xad, 0x49, 0xb5,
-	0xb7, 0x05, 0x5f, 0x19, 0xf4, 0x93, 0x45, 0x81, 0x91, 0x4b, 0xa4, 0x14, 0x3a, 0x4a, 0x96, 0x9a,
-	0x0d, 0xad, 0x34, 0xbb, 0x36, 0xb1, 0x82, 0xa7, 0xc8, 0xee, 0x8c, 0xc9, 0xa4, 0x1b, 0xd9, 0x35,
-	0xbd, 0x0b, 0x5b, 0x05, 0x96, 0xc7, 0x36, 0xce, 0x6c, 0xbc, 0x57, 0x60, 0xf9, 0x35, 0x4f, 0xd1,
-	0x7f, 0x1b, 0xb6, 0xaf, 0x68, 0x69, 0x0f, 0xda, 0x9f, 0x1e, 0x1d, 0xf6, 0x5f, 0xa3, 0x5b, 0xd0,
-	0x79, 0xf0, 0xf9, 0xd1, 0x61, 0x9f, 0xf8, 0x7f, 0x11, 0xf0, 0x8e, 0xac, 0x8a, 0xa6, 0x0d, 0xe6,
-	0xa6, 0x9c, 0xcf, 0x90, 0xb5, 0xdc, 0xed, 0x66, 0x6d, 0x62, 0x7a, 0x51, 0x20, 0x6b, 0xbb, 0x98,
-	0x59, 0xd3, 0x4f, 0xe0, 0x56, 0xc6, 0x95, 0x3e, 0x9e, 0xc9, 0x44, 0x9c, 0x0a, 0x4c, 0xd8, 0x60,
-	0x4c, 0x26, 0x37, 0xa6, 0xa3, 0xc0, 0xb5, 0x48, 0x50, 0xf7, 0x50, 0xf0, 0xa4, 0xee, 0xa1, 0xe8,
-	0xa6, 0x49, 0xf8, 0xb2, 0xc2, 0xd3, 0x0f, 0xa1, 0xa3, 0x79, 0xaa, 0xd8, 0xae, 0xb5, 0xf8, 0xad,
-	0x35, 0x3e, 0x3d, 0x3a, 0x8f, 0x2c, 0xd4, 0xa4, 0x24, 0x5c, 0x73, 0x36, 0xbc, 0x56, 0x8a, 0x81,
-	0xfa, 0xdf, 0x42, 0xcf, 0x3d, 0x54, 0x99, 0xb2, 0x56, 0xc7, 0x8c, 0x6c, 0x2c, 0xab, 0x4b, 0x8a,
-	0xea, 0x0c, 0x3a, 0x80, 0xae, 0x96, 0x9a, 0x67, 0xf6, 0x99, 0xdd, 0xc8, 0x6d, 0xfc, 0xc7, 0xe0,
-	0xb9, 0xfa, 0xd3, 0x3e, 0xb4, 0xbf, 0xc3, 0x85, 0x3d, 0xdd, 0x8e, 0xcc, 0x92, 0x32, 0xe8, 0xe1,
-	0xf3, 0x38, 0x9b, 0x27, 0x68, 0x2b, 0xb9, 0x15, 0xd5, 0x5b, 0x3a, 0x04, 0xef, 0x9c, 0x67, 0x73,
-	0x54, 0xec, 0xce, 0xb8, 0x3d, 0xd9, 0x8e, 0xaa, 0x9d, 0xff, 0x01, 0xb4, 0x1e, 0x9d, 0xd7, 0x4c,
-	0xe4, 0x5f, 0xa6, 0x01, 0x74, 0x2d, 0xa2, 0xaa, 0x89, 0xdb, 0x4c, 0x7f, 0xec, 0xc1, 0x4e, 0xf5,
-	0xb4, 0x23, 0x2c, 0xcf, 0x45, 0x8c, 0xf4, 0x67, 0x02, 0xde, 0x61, 0x89, 0x5c, 0x23, 0xdd, 0xfc,
-	0xb6, 0xd1, 0x3a, 0xff, 0x1e, 0x26, 0xfe, 0xd3, 0x8b, 0x25, 0x7b, 0xfd, 0xca, 0x32, 0xea, 0xc5,
-	0x96, 0xf8, 0x72, 0xc9, 0xee, 0xc1, 0xed, 0x2a, 0x78, 0x50, 0x1f, 0x0e, 0x1b, 0x81, 0x03, 0x07,
-	0xbe, 0xf8, 0xe3, 0xcf, 0x9f, 0x5a, 0xb7, 0xfc, 0xad, 0x7a, 0x02, 0x1d, 0x90, 0x3d, 0xfa, 0x2b,
-	0x81, 0x4e, 0x84, 0x3c, 0xa1, 0xeb, 0xaf, 0x1f, 0x6d, 0x7e, 0x80, 0x7f, 0x7c, 0xb1, 0x64, 0x03,
-	0xb8, 0x59, 0x93, 0x7f, 0x2f, 0x92, 0x1f, 0x68, 0xa7, 0x44, 0x9e, 0x5c, 0x2e, 0xd9, 0xfb, 0x30,
-	0x68, 0x6a, 0xb2, 0xe7, 0x6f, 0x34, 0xa3, 0x29, 0x6a, 0x2b, 0x93, 0xd2, 0xfe, 0xd5, 0xc0, 0x14,
-	0x49, 0x68, 0xc1, 0xbf, 0x13, 0xf0, 0xdc, 0x34, 0xb9, 0x8e, 0x9f, 0xf7, 0xd6, 0x40, 0x1a, 0x33,
-	0x29, 0xbd, 0x58, 0xb2, 0x61, 0x43, 0xb9, 0x37, 0xb7, 0x98, 0xcb, 0x25, 0x0b, 0x5e, 0xa1, 0x7d,
-	0xc5, 0x65, 0x97, 0x61, 0xe5, 0xef, 0x4e, 0x57, 0xe4, 0x1b, 0xb7, 0x7f, 0x23, 0xe0, 0xb9, 0xe9,
-	0xb7, 0xc9, 0xef, 0x75, 0xea, 0x1b, 0xf3, 0x33, 0x79, 0x99, 0xfa, 0xc4, 0x62, 0xfe, 0x8b, 0x7a,
-	0x97, 0xe1, 0xcc, 0xdf, 0x5b, 0x35, 0xff, 0x17, 0x02, 0x9d, 0xc7, 0x42, 0x69, 0x3a, 0xde, 0x34,
-	0x42, 0x47, 0xfe, 0xc6, 0xe2, 0x28, 0xff, 0x59, 0xa3, 0x9d, 0x15, 0xf2, 0x32, 0x3e, 0xbb, 0x5c,
-	0xb2, 0xf7, 0x56, 0xdb, 0x79, 0xe5, 0x01, 0x99, 0x50, 0xae, 0x4b, 0x06, 0xfe, 0xed, 0x17, 0x7e,
-	0xab, 0x86, 0xe1, 0x80, 0xec, 0x7d, 0x16, 0x3e, 0xdb, 0x4f, 0x85, 0x3e, 0x9b, 0x9f, 0x04, 0xb1,
-	0x9c, 0x85, 0x46, 0xcb, 0xd5, 0xdf, 0x2b, 0x7c, 0xd9, 0x1f, 0xf9, 0xc4, 0xb3, 0xd3, 0xf1, 0xa3,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x00, 0xfc, 0x37, 0xb0, 0x07, 0x00, 0x00,
+	proto.RegisterFile("api/external/secrets/secrets.proto", fileDescriptor_secrets_d8ce49c5b66ba5a5)
+}
+
+var fileDescriptor_secrets_d8ce49c5b66ba5a5 = []byte{
+	// 1013 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x67, 0x1d, 0xdb, 0xb1, 0x5f, 0xf3, 0xc7, 0x0c, 0x4e, 0x32, 0x31, 0x50, 0xcc, 0x08, 0x09,
+	0x37, 0x6d, 0xd6, 0xc2, 0x70, 0x21, 0x3d, 0x50, 0x9a, 0x80, 0x54, 0xb5, 0x08, 0xd8, 0xb4, 0x3d,
+	0x54, 0x48, 0xd1, 0x64, 0xf7, 0x65, 0xb3, 0xea, 0x7a, 0x77, 0xd9, 0x19, 0x47, 0x8d, 0x10, 0x97,
+	0x5c, 0x90, 0x72, 0xe5, 0x13, 0x70, 0x40, 0x82, 0x0f, 0x60, 0x89, 0x1b, 0x12, 0x12, 0x9f, 0x00,
+	0xf1, 0x0d, 0xb8, 0xf0, 0x2d, 0xd0, 0xcc, 0xec, 0x38, 0x89, 0xdd, 0xd8, 0xa9, 0xc8, 0xc9, 0x33,
+	0x6f, 0x7f, 0xef, 0xcd, 0x6f, 0x7e, 0xf3, 0xf3, 0xbc, 0x01, 0xc6, 0xb3, 0xa8, 0x8b, 0x2f, 0x24,
+	0xe6, 0x09, 0x8f, 0xbb, 0x02, 0xfd, 0x1c, 0xa5, 0xb0, 0xbf, 0x6e, 0x96, 0xa7, 0x32, 0x25, 0xeb,
+	0xfe, 0x21, 0x1e, 0xb8, 0x7c, 0x20, 0xd3, 0x3e, 0x97, 0xe8, 0xf2, 0x2c, 0x72, 0x0b, 0x40, 0xeb,
+	0x9d, 0x30, 0x4d, 0xc3, 0x18, 0xbb, 0x1a, 0xb8, 0x3f, 0x38, 0xe8, 0xca, 0xa8, 0x8f, 0x42, 0xf2,
+	0x7e, 0x66, 0x72, 0x5b, 0x6f, 0x15, 0x00, 0xb5, 0x0c, 0x4f, 0x92, 0x54, 0x72, 0x19, 0xa5, 0x49,
+	0x51, 0xb9, 0x75, 0xcf, 0x4f, 0xfb, 0x59, 0x9a, 0x60, 0x22, 0x45, 0xd7, 0xd6, 0xdf, 0x0c, 0xf3,
+	0xcc, 0x37, 0x05, 0xfd, 0xcd, 0x10, 0x93, 0xcd, 0x2c, 0x8d, 0x23, 0xff, 0xf8, 0x1a, 0x2a, 0x44,
+	0xbc, 0x3f, 0x59, 0x81, 0x35, 0x60, 0xe9, 0x49, 0x16, 0x70, 0x89, 0x1e, 0x8a, 0x2c, 0x4d, 0x04,
+	0xaa, 0xc8, 0x0e, 0xc6, 0x78, 0x2e, 0xe2, 0x42, 0xe9, 0x41, 0x40, 0x96, 0xa0, 0x14, 0x05, 0xd4,
+	0x69, 0x3b, 0x9d, 0xba, 0x57, 0x8a, 0x02, 0x42, 0x61, 0xfe, 0x08, 0x73, 0x11, 0xa5, 0x09, 0x2d,
+	0xb5, 0x9d, 0x4e, 0xc5, 0xb3, 0x53, 0xf6, 0xaf, 0x03, 0x95, 0xaf, 0x07, 0x98, 0x1f, 0x93, 0xbb,
+	0x30, 0x7f, 0x10, 0xc5, 0x12, 0x73, 0x41, 0x9b, 0xed, 0xb9, 0xce, 0x8d, 0xde, 0xbb, 0xee, 0xa5,
+	0x6a, 0xba, 0x9f, 0x6b, 0xa4, 0x67, 0x33, 0xc8, 0x3d, 0xa8, 0xa4, 0x79, 0x80, 0x39, 0x5d, 0x69,
+	0x3b, 0x9d, 0xa5, 0xde, 0xc6, 0x94, 0x54, 0xbd, 0x9a, 0xfb, 0xa5, 0x42, 0x3f, 0x3e, 0xce, 0xd0,
+	0x33, 0x89, 0x84, 0x40, 0x59, 0xa4, 0xb9, 0xa4, 0xab, 0x9a, 0xb4, 0x1e, 0xab, 0x58, 0xc6, 0x43,
+	0xa4, 0x6b, 0x9a, 0xb3, 0x1e, 0x93, 0x75, 0xa8, 0x65, 0x98, 0xef, 0xe9, 0x38, 0x35, 0x7b, 0xc9,
+	0x30, 0xff, 0x8a, 0x87, 0xc8, 0x6e, 0x42, 0x7d, 0x54, 0x96, 0xcc, 0xc3, 0xdc, 0xa7, 0xbb, 0xdb,
+	0x8d, 0xd7, 0x48, 0x0d, 0xca, 0x3b, 0x9f, 0xed, 0x6e, 0x37, 0x1c, 0xf6, 0x67, 0x09, 0xaa, 0xbb,
+	0x9a, 0xc5, 0x84, 0x40, 0x04, 0xca, 0x09, 0xef, 0xa3, 0x56, 0xa7, 0xee, 0xe9, 0xb1, 0x8a, 0xc9,
+	0xe3, 0x0c, 0xe9, 0x9c, 0x89, 0xa9, 0x31, 0xf9, 0x04, 0x16, 0x63, 0x2e, 0xe4, 0x5e, 0x3f, 0x0d,
+	0xa2, 0x83, 0x08, 0x03, 0xda, 0x6c, 0x3b, 0x9d, 0x1b, 0xbd, 0x96, 0x6b, 0xcc, 0xe3, 0x5a, 0x77,
+	0xb9, 0x8f, 0xad, 0xbb, 0xbc, 0x05, 0x95, 0xf0, 0x45, 0x81, 0x27, 0x1f, 0x40, 0x59, 0xf2, 0x50,
+	0xd0, 0x15, 0x2d, 0xf1, 0xdb, 0x53, 0x74, 0x7a, 0x78, 0xe4, 0x69, 0xa8, 0x4a, 0x09, 0xb8, 0xe4,
+	0x74, 0xf5, 0x4a, 0x29, 0x0a, 0x7a, 0xfe, 0xbc, 0xd7, 0x2e, 0x9c, 0x37, 0xb9, 0x0f, 0xf5, 0x1c,
+	0x0f, 0x30, 0xc7, 0xc4, 0x37, 0xfa, 0xdd, 0xe8, 0xbd, 0x37, 0xa5, 0xa2, 0x67, 0xb1, 0xde, 0x59,
+	0x1a, 0xfb, 0x18, 0xea, 0xa3, 0xb8, 0x5a, 0x6a, 0x9f, 0xfb, 0xcf, 0x31, 0xb1, 0x72, 0xda, 0xa9,
+	0x39, 0x3d, 0x79, 0x68, 0x35, 0x55, 0x63, 0xf6, 0x0d, 0xcc, 0x9b, 0x13, 0x10, 0xca, 0x6f, 0xc5,
+	0x2a, 0xd4, 0x99, 0xe9, 0x37, 0x93, 0xe4, 0xd9, 0x0c, 0xd2, 0x84, 0x8a, 0x4c, 0x25, 0x8f, 0xb5,
+	0xfe, 0x15, 0xcf, 0x4c, 0xd8, 0x23, 0xa8, 0x1a, 0x63, 0x92, 0x06, 0xcc, 0x3d, 0xc7, 0x63, 0xfd,
+	0xb5, 0xee, 0xa9, 0xa1, 0xe2, 0x89, 0x2f, 0xfc, 0x78, 0x10, 0xa0, 0xb6, 0x58, 0xcd, 0xb3, 0x53,
+	0xb2, 0x0a, 0xd5, 0x23, 0x1e, 0x0f, 0x50, 0xd0, 0xb5, 0xf6, 0x5c, 0xa7, 0xee, 0x15, 0x33, 0x76,
+	0x07, 0x4a, 0x0f, 0x8f, 0x6c, 0x25, 0xe7, 0xac, 0x52, 0x13, 0x2a, 0x1a, 0x51, 0x6c, 0xcc, 0x4c,
+	0xd8, 0x0f, 0x0e, 0x2c, 0x1a, 0x96, 0x4f, 0x0b, 0xa9, 0xcf, 0x1d, 0x82, 0x73, 0xf1, 0x10, 0xae,
+	0xea, 0xb6, 0x8f, 0x60, 0xde, 0xcf, 0x91, 0xcb, 0x2b, 0xf9, 0xcc, 0x42, 0xd9, 0x53, 0x58, 0xba,
+	0x40, 0x44, 0x90, 0x1d, 0xa8, 0x15, 0x4b, 0x5b, 0xad, 0x3b, 0x33, 0xb5, 0x2e, 0x92, 0xbd, 0x51,
+	0x26, 0xbb, 0x0b, 0xcb, 0x5e, 0x1a, 0xc7, 0xea, 0x78, 0x3d, 0xfc, 0x76, 0x80, 0x42, 0xbe, 0xc2,
+	0x3d, 0x73, 0x07, 0x1a, 0x67, 0xc9, 0xe6, 0xae, 0xba, 0x5c, 0xa0, 0xde, 0xcf, 0x60, 0xf7, 0x20,
+	0x76, 0x31, 0x3f, 0x8a, 0x7c, 0x24, 0x3f, 0x39, 0x50, 0xdd, 0xd6, 0x3b, 0x24, 0xb3, 0x8d, 0xd2,
+	0x9a, 0xf6, 0x2f, 0x79, 0x10, 0xb0, 0x27, 0x27, 0x43, 0xfa, 0xfa, 0xc8, 0x7f, 0xa4, 0x6a, 0xa4,
+	0x3b, 0x1d, 0xd2, 0x5b, 0xb0, 0x5c, 0x04, 0xb7, 0xec, 0xc7, 0xd5, 0xb1, 0xc0, 0x96, 0x01, 0x9f,
+	0xfc, 0xf5, 0xcf, 0x8f, 0xa5, 0x45, 0x56, 0xb3, 0x1d, 0x68, 0xcb, 0xd9, 0x20, 0xbf, 0x3a, 0x50,
+	0xf6, 0x90, 0x07, 0x64, 0xfa, 0xf2, 0xad, 0xd9, 0x1b, 0x60, 0x7b, 0x27, 0x43, 0xda, 0x84, 0x05,
+	0x5b, 0xfc, 0xbb, 0x28, 0xf8, 0x9e, 0x94, 0x73, 0xe4, 0xc1, 0xe9, 0x90, 0xde, 0x86, 0xe6, 0x38,
+	0x27, 0xfd, 0xfd, 0x8d, 0xf1, 0x68, 0x88, 0x52, 0xd3, 0x24, 0xa4, 0x31, 0x6a, 0x98, 0x51, 0xd0,
+	0xd5, 0xe0, 0xdf, 0x1d, 0xa8, 0x9a, 0x6e, 0x72, 0x15, 0x3d, 0x6f, 0x4d, 0x81, 0x8c, 0xf5, 0xa4,
+	0xf0, 0x64, 0x48, 0x57, 0xc7, 0x98, 0x57, 0x07, 0x1a, 0x73, 0x3a, 0xa4, 0xee, 0x25, 0xdc, 0x27,
+	0x54, 0x36, 0x19, 0x9a, 0xfe, 0x4a, 0x6f, 0x82, 0xbe, 0x52, 0xfb, 0x37, 0x07, 0xaa, 0xa6, 0xfb,
+	0xcd, 0xd2, 0x7b, 0x1a, 0xfb, 0xb1, 0xfe, 0x19, 0xbc, 0x8c, 0x7d, 0xa0, 0x31, 0xaf, 0xc2, 0xde,
+	0x64, 0x18, 0xf1, 0x37, 0x26, 0xc5, 0xff, 0xc5, 0x81, 0xf2, 0xa3, 0x48, 0x48, 0xd2, 0x9e, 0xd5,
+	0x28, 0x5b, 0x6c, 0xe6, 0xe1, 0x08, 0xf6, 0x6c, 0xcc, 0xce, 0x02, 0x79, 0xee, 0x1f, 0x9e, 0x0e,
+	0xe9, 0xfb, 0x93, 0x76, 0x9e, 0xd8, 0x40, 0x1c, 0x09, 0xe3, 0x92, 0x26, 0x5b, 0x3e, 0xf7, 0xac,
+	0x52, 0x15, 0x94, 0xca, 0x7f, 0x38, 0xb0, 0xa0, 0xa8, 0x8e, 0x2e, 0x93, 0xff, 0xa1, 0xf5, 0xc5,
+	0x6b, 0x89, 0x1d, 0x5e, 0xa7, 0xc7, 0xdf, 0x24, 0xeb, 0xe3, 0x32, 0x77, 0xed, 0xd5, 0x45, 0xfe,
+	0x76, 0xa0, 0x66, 0xaf, 0x1f, 0x32, 0xed, 0x71, 0x32, 0x76, 0xc1, 0xb5, 0x6e, 0x5f, 0x09, 0x5b,
+	0x78, 0x27, 0xbb, 0x76, 0xe7, 0xdf, 0x64, 0x93, 0x9b, 0xca, 0x8b, 0x65, 0xb7, 0x9c, 0x8d, 0xfb,
+	0xdd, 0x67, 0x9b, 0x61, 0x24, 0x0f, 0x07, 0xfb, 0xae, 0x9f, 0xf6, 0xbb, 0x8a, 0xea, 0xe8, 0x69,
+	0xd9, 0x7d, 0xd9, 0x73, 0x79, 0xbf, 0xaa, 0x1b, 0xc7, 0x87, 0xff, 0x0d, 0x00, 0x00, 0xa3, 0x21,
+	0xde, 0x4d, 0x0b, 0x00, 0x00,
 }
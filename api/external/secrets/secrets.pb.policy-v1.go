@@ -79,4 +79,30 @@ func init() {
 		}
 		return ""
 	})
+	policy.MapMethodTo("/chef.automate.api.secrets.SecretsService/ListVersions", "secrets:{id}", "read", "GET", "/secrets/id/{id}/versions", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Id); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policy.MapMethodTo("/chef.automate.api.secrets.SecretsService/Rollback", "secrets:{id}", "update", "POST", "/secrets/id/{id}/rollback", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*RollbackRequest); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
 }
@@ -79,4 +79,30 @@ func init() {
 		}
 		return ""
 	})
+	policyv2.MapMethodTo("/chef.automate.api.secrets.SecretsService/ListVersions", "secrets:secrets:{id}", "secrets:secrets:get", "GET", "/secrets/id/{id}/versions", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Id); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policyv2.MapMethodTo("/chef.automate.api.secrets.SecretsService/Rollback", "secrets:secrets:{id}", "secrets:secrets:update", "POST", "/secrets/id/{id}/rollback", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*RollbackRequest); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
 }
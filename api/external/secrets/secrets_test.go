@@ -114,6 +114,38 @@ var exampleValidationFailures = []struct {
 		Data: appendKvs(&Kv{Key: "AZURE_CLIENT_SECRET", Value: "AZURE_CLIENT_SECRET"}, &Kv{Key: "AZURE_TENANT_ID", Value: "AZURE_TENANT_ID"})},
 		"Invalid data content for secret type 'azure'. AZURE_CLIENT_ID not provided",
 	},
+	//reference
+	{Secret{
+		Name:      "name",
+		Type:      "ssh",
+		Reference: &Reference{Path: "ssh/production"}},
+		"Invalid reference, 'backend' is a required parameter",
+	},
+	{Secret{
+		Name:      "name",
+		Type:      "ssh",
+		Reference: &Reference{Backend: "vault"}},
+		"Invalid reference, 'path' is a required parameter",
+	},
+	{Secret{
+		Name:      "name",
+		Type:      "ssh",
+		Reference: &Reference{Backend: "vault", Path: "ssh/../../sys/policy"}},
+		"Invalid reference, 'path' must be relative and must not contain '..'",
+	},
+	{Secret{
+		Name:      "name",
+		Type:      "ssh",
+		Reference: &Reference{Backend: "vault", Path: "/ssh/production"}},
+		"Invalid reference, 'path' must be relative and must not contain '..'",
+	},
+	{Secret{
+		Name:      "name",
+		Type:      "ssh",
+		Reference: &Reference{Backend: "vault", Path: "ssh/production"},
+		Data:      appendKvs(&Kv{Key: "username", Value: "name"})},
+		"Invalid secret, 'data' and 'reference' are mutually exclusive",
+	},
 }
 
 var exampleValidationSuccesses = []struct {
@@ -160,6 +192,11 @@ var exampleValidationSuccesses = []struct {
 		Name: "name",
 		Type: "azure",
 		Data: appendKvs(&Kv{Key: "AZURE_CLIENT_ID", Value: "AZURE_CLIENT_ID"}, &Kv{Key: "AZURE_CLIENT_SECRET", Value: "AZURE_CLIENT_SECRET"}, &Kv{Key: "AZURE_TENANT_ID", Value: "AZURE_TENANT_ID"})}},
+	//reference
+	{Secret{
+		Name:      "name",
+		Type:      "ssh",
+		Reference: &Reference{Backend: "vault", Path: "ssh/production"}}},
 }
 
 func appendKvs(kvs ...*Kv) []*Kv {
@@ -442,3 +479,30 @@ func TestMergeReplacesPasswordWithKeyInData(t *testing.T) {
 	assert.Equal(t, expected.Name, existing.Name)
 	assert.Equal(t, expected.Type, existing.Type)
 }
+
+func TestMergeReplacesDataWithReference(t *testing.T) {
+	existing := Secret{
+		Name: "Name",
+		Type: "ssh",
+		Data: appendKvs(&Kv{Key: "username", Value: "user"}, &Kv{Key: "password", Value: "pass"}),
+	}
+
+	existing.Merge(&Secret{Reference: &Reference{Backend: "vault", Path: "ssh/production"}})
+
+	assert.Equal(t, &Reference{Backend: "vault", Path: "ssh/production"}, existing.Reference)
+	assert.Empty(t, existing.Data)
+	assert.Equal(t, "Name", existing.Name)
+}
+
+func TestMergeReplacesReferenceWithData(t *testing.T) {
+	existing := Secret{
+		Name:      "Name",
+		Type:      "ssh",
+		Reference: &Reference{Backend: "vault", Path: "ssh/production"},
+	}
+
+	existing.Merge(&Secret{Data: appendKvs(&Kv{Key: "username", Value: "user"}, &Kv{Key: "password", Value: "pass"})})
+
+	assert.Nil(t, existing.Reference)
+	assert.ElementsMatch(t, appendKvs(&Kv{Key: "username", Value: "user"}, &Kv{Key: "password", Value: "pass"}), existing.Data)
+}
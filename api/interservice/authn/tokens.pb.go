@@ -27,10 +27,13 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type CreateTokenReq struct {
 	// Match either a completely empty string or a valid id.
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" toml:"description,omitempty" mapstructure:"description,omitempty"`
-	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty" toml:"active,omitempty" mapstructure:"active,omitempty"`
-	Projects             []string `protobuf:"bytes,4,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" toml:"description,omitempty" mapstructure:"description,omitempty"`
+	Active      bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty" toml:"active,omitempty" mapstructure:"active,omitempty"`
+	Projects    []string `protobuf:"bytes,4,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	// RFC 3339 timestamp after which the token is no longer accepted. Tokens
+	// without one don't expire.
+	Expires              string   `protobuf:"bytes,5,opt,name=expires,proto3" json:"expires,omitempty" toml:"expires,omitempty" mapstructure:"expires,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -40,7 +43,7 @@ func (m *CreateTokenReq) Reset()         { *m = CreateTokenReq{} }
 func (m *CreateTokenReq) String() string { return proto.CompactTextString(m) }
 func (*CreateTokenReq) ProtoMessage()    {}
 func (*CreateTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{0}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{0}
 }
 func (m *CreateTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateTokenReq.Unmarshal(m, b)
@@ -88,12 +91,22 @@ func (m *CreateTokenReq) GetProjects() []string {
 	return nil
 }
 
+func (m *CreateTokenReq) GetExpires() string {
+	if m != nil {
+		return m.Expires
+	}
+	return ""
+}
+
 type CreateTokenWithValueReq struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" toml:"description,omitempty" mapstructure:"description,omitempty"`
-	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty" toml:"active,omitempty" mapstructure:"active,omitempty"`
-	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty" toml:"value,omitempty" mapstructure:"value,omitempty"`
-	Projects             []string `protobuf:"bytes,5,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" toml:"description,omitempty" mapstructure:"description,omitempty"`
+	Active      bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty" toml:"active,omitempty" mapstructure:"active,omitempty"`
+	Value       string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty" toml:"value,omitempty" mapstructure:"value,omitempty"`
+	Projects    []string `protobuf:"bytes,5,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	// RFC 3339 timestamp after which the token is no longer accepted. Tokens
+	// without one don't expire.
+	Expires              string   `protobuf:"bytes,6,opt,name=expires,proto3" json:"expires,omitempty" toml:"expires,omitempty" mapstructure:"expires,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -103,7 +116,7 @@ func (m *CreateTokenWithValueReq) Reset()         { *m = CreateTokenWithValueReq
 func (m *CreateTokenWithValueReq) String() string { return proto.CompactTextString(m) }
 func (*CreateTokenWithValueReq) ProtoMessage()    {}
 func (*CreateTokenWithValueReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{1}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{1}
 }
 func (m *CreateTokenWithValueReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateTokenWithValueReq.Unmarshal(m, b)
@@ -158,6 +171,13 @@ func (m *CreateTokenWithValueReq) GetProjects() []string {
 	return nil
 }
 
+func (m *CreateTokenWithValueReq) GetExpires() string {
+	if m != nil {
+		return m.Expires
+	}
+	return ""
+}
+
 type UpdateTokenReq struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
 	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty" toml:"active,omitempty" mapstructure:"active,omitempty"`
@@ -172,7 +192,7 @@ func (m *UpdateTokenReq) Reset()         { *m = UpdateTokenReq{} }
 func (m *UpdateTokenReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateTokenReq) ProtoMessage()    {}
 func (*UpdateTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{2}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{2}
 }
 func (m *UpdateTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateTokenReq.Unmarshal(m, b)
@@ -221,13 +241,20 @@ func (m *UpdateTokenReq) GetProjects() []string {
 }
 
 type Token struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" toml:"description,omitempty" mapstructure:"description,omitempty"`
-	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty" toml:"value,omitempty" mapstructure:"value,omitempty"`
-	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty" toml:"active,omitempty" mapstructure:"active,omitempty"`
-	Created              string   `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty" toml:"created,omitempty" mapstructure:"created,omitempty"`
-	Updated              string   `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty" toml:"updated,omitempty" mapstructure:"updated,omitempty"`
-	Projects             []string `protobuf:"bytes,7,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" toml:"description,omitempty" mapstructure:"description,omitempty"`
+	// value is only returned when the token is created
+	Value    string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty" toml:"value,omitempty" mapstructure:"value,omitempty"`
+	Active   bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty" toml:"active,omitempty" mapstructure:"active,omitempty"`
+	Created  string   `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty" toml:"created,omitempty" mapstructure:"created,omitempty"`
+	Updated  string   `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty" toml:"updated,omitempty" mapstructure:"updated,omitempty"`
+	Projects []string `protobuf:"bytes,7,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	// expires, last_used and last_used_ip are empty when unset
+	Expires    string `protobuf:"bytes,8,opt,name=expires,proto3" json:"expires,omitempty" toml:"expires,omitempty" mapstructure:"expires,omitempty"`
+	LastUsed   string `protobuf:"bytes,9,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty" toml:"last_used,omitempty" mapstructure:"last_used,omitempty"`
+	LastUsedIp string `protobuf:"bytes,10,opt,name=last_used_ip,json=lastUsedIp,proto3" json:"last_used_ip,omitempty" toml:"last_used_ip,omitempty" mapstructure:"last_used_ip,omitempty"`
+	// value_prefix is the start of the value, for telling tokens apart
+	ValuePrefix          string   `protobuf:"bytes,11,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty" toml:"value_prefix,omitempty" mapstructure:"value_prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -237,7 +264,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{3}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{3}
 }
 func (m *Token) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Token.Unmarshal(m, b)
@@ -306,6 +333,34 @@ func (m *Token) GetProjects() []string {
 	return nil
 }
 
+func (m *Token) GetExpires() string {
+	if m != nil {
+		return m.Expires
+	}
+	return ""
+}
+
+func (m *Token) GetLastUsed() string {
+	if m != nil {
+		return m.LastUsed
+	}
+	return ""
+}
+
+func (m *Token) GetLastUsedIp() string {
+	if m != nil {
+		return m.LastUsedIp
+	}
+	return ""
+}
+
+func (m *Token) GetValuePrefix() string {
+	if m != nil {
+		return m.ValuePrefix
+	}
+	return ""
+}
+
 type Tokens struct {
 	Tokens               []*Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty" toml:"tokens,omitempty" mapstructure:"tokens,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -317,7 +372,7 @@ func (m *Tokens) Reset()         { *m = Tokens{} }
 func (m *Tokens) String() string { return proto.CompactTextString(m) }
 func (*Tokens) ProtoMessage()    {}
 func (*Tokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{4}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{4}
 }
 func (m *Tokens) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tokens.Unmarshal(m, b)
@@ -355,7 +410,7 @@ func (m *Value) Reset()         { *m = Value{} }
 func (m *Value) String() string { return proto.CompactTextString(m) }
 func (*Value) ProtoMessage()    {}
 func (*Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{5}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{5}
 }
 func (m *Value) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Value.Unmarshal(m, b)
@@ -393,7 +448,7 @@ func (m *GetTokenReq) Reset()         { *m = GetTokenReq{} }
 func (m *GetTokenReq) String() string { return proto.CompactTextString(m) }
 func (*GetTokenReq) ProtoMessage()    {}
 func (*GetTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{6}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{6}
 }
 func (m *GetTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTokenReq.Unmarshal(m, b)
@@ -430,7 +485,7 @@ func (m *GetTokensReq) Reset()         { *m = GetTokensReq{} }
 func (m *GetTokensReq) String() string { return proto.CompactTextString(m) }
 func (*GetTokensReq) ProtoMessage()    {}
 func (*GetTokensReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{7}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{7}
 }
 func (m *GetTokensReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTokensReq.Unmarshal(m, b)
@@ -461,7 +516,7 @@ func (m *DeleteTokenReq) Reset()         { *m = DeleteTokenReq{} }
 func (m *DeleteTokenReq) String() string { return proto.CompactTextString(m) }
 func (*DeleteTokenReq) ProtoMessage()    {}
 func (*DeleteTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{8}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{8}
 }
 func (m *DeleteTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteTokenReq.Unmarshal(m, b)
@@ -498,7 +553,7 @@ func (m *DeleteTokenResp) Reset()         { *m = DeleteTokenResp{} }
 func (m *DeleteTokenResp) String() string { return proto.CompactTextString(m) }
 func (*DeleteTokenResp) ProtoMessage()    {}
 func (*DeleteTokenResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_525681bfbebe3fe1, []int{9}
+	return fileDescriptor_tokens_3b89dcd06a4d7d87, []int{9}
 }
 func (m *DeleteTokenResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteTokenResp.Unmarshal(m, b)
@@ -769,46 +824,50 @@ var _TokensMgmt_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("api/interservice/authn/tokens.proto", fileDescriptor_tokens_525681bfbebe3fe1)
-}
-
-var fileDescriptor_tokens_525681bfbebe3fe1 = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xe3, 0x24, 0x4d, 0xc6, 0x28, 0xa8, 0x4b, 0x68, 0x8d, 0xd5, 0x48, 0xae, 0xa9, 0x44,
-	0x04, 0xc4, 0xa6, 0x29, 0x42, 0xea, 0x81, 0x4b, 0x8a, 0xc4, 0x89, 0x8b, 0xc5, 0x9f, 0xa8, 0x88,
-	0xb4, 0xb5, 0x97, 0x64, 0x21, 0xf1, 0xba, 0xf6, 0x26, 0x07, 0x7e, 0x5e, 0x82, 0x1b, 0x4f, 0x02,
-	0xe2, 0xc4, 0x9b, 0x70, 0xe6, 0x2d, 0xd0, 0xda, 0x71, 0xb2, 0x6e, 0x9b, 0xd4, 0xd0, 0x5b, 0x36,
-	0x33, 0xdf, 0xcc, 0xf7, 0x7d, 0xb3, 0xe3, 0x85, 0xdb, 0x38, 0xa4, 0x0e, 0x0d, 0x38, 0x89, 0x62,
-	0x12, 0xcd, 0xa8, 0x47, 0x1c, 0x3c, 0xe5, 0xa3, 0xc0, 0xe1, 0xec, 0x03, 0x09, 0x62, 0x3b, 0x8c,
-	0x18, 0x67, 0xc8, 0xf0, 0x46, 0xe4, 0x9d, 0x8d, 0xa7, 0x9c, 0x4d, 0x30, 0x27, 0xb6, 0xcf, 0x26,
-	0x98, 0x06, 0x76, 0x92, 0x68, 0xec, 0x0c, 0x19, 0x1b, 0x8e, 0x89, 0x23, 0xea, 0xe0, 0x20, 0x60,
-	0x1c, 0x73, 0xca, 0x32, 0xa4, 0xb1, 0x3d, 0xc3, 0x63, 0xea, 0x63, 0x4e, 0x9c, 0xec, 0x47, 0x1a,
-	0xb0, 0x7e, 0x28, 0xd0, 0x3c, 0x8a, 0x08, 0xe6, 0xe4, 0xb9, 0xe8, 0xe4, 0x92, 0x53, 0xd4, 0x85,
-	0x32, 0xf5, 0x75, 0xc5, 0x54, 0x3a, 0x8d, 0x7e, 0xfb, 0xe7, 0x9f, 0x5f, 0xaa, 0x1e, 0x6d, 0xf5,
-	0x5a, 0x83, 0x63, 0xdc, 0xfd, 0xf8, 0xa0, 0x7b, 0xd8, 0x7d, 0xfb, 0x69, 0xff, 0xfe, 0xa3, 0x87,
-	0x5f, 0xf6, 0x3e, 0x0f, 0xf6, 0xdc, 0x32, 0xf5, 0x91, 0x09, 0x9a, 0x4f, 0x62, 0x2f, 0xa2, 0xa1,
-	0x68, 0xa8, 0x97, 0x05, 0xce, 0x95, 0xff, 0x42, 0x5b, 0x50, 0xc3, 0x1e, 0xa7, 0x33, 0xa2, 0xab,
-	0xa6, 0xd2, 0xa9, 0xbb, 0xf3, 0x13, 0x7a, 0x0c, 0xf5, 0x30, 0x62, 0xef, 0x89, 0xc7, 0x63, 0xbd,
-	0x62, 0xaa, 0x9d, 0x46, 0x7f, 0x57, 0xb4, 0xdb, 0xf9, 0xaa, 0xdc, 0xd2, 0x15, 0xeb, 0x66, 0x74,
-	0xa3, 0xb7, 0x79, 0xae, 0xab, 0xbb, 0x80, 0x08, 0xea, 0xdb, 0x12, 0xf5, 0x57, 0x94, 0x8f, 0x5e,
-	0xe2, 0xf1, 0x94, 0x08, 0x0d, 0xcd, 0xa5, 0x86, 0x2b, 0x92, 0x6c, 0x41, 0x75, 0x26, 0xaa, 0xea,
-	0x95, 0x04, 0x93, 0x1e, 0x72, 0xd4, 0xab, 0xff, 0x4e, 0xfd, 0x9b, 0x02, 0xcd, 0x17, 0xa1, 0x2f,
-	0xbb, 0x7e, 0x96, 0xf1, 0x92, 0x4f, 0x39, 0xc7, 0xe7, 0x8c, 0x12, 0xf5, 0xbc, 0x92, 0x2b, 0xda,
-	0xfa, 0x5d, 0x81, 0x6a, 0xc2, 0xea, 0x3f, 0x4c, 0x5c, 0x98, 0xa5, 0xca, 0x66, 0x2d, 0xa5, 0x54,
-	0x72, 0x52, 0x74, 0xd8, 0xf0, 0x92, 0xf9, 0xf9, 0x7a, 0x35, 0xc9, 0xcf, 0x8e, 0x22, 0x32, 0x4d,
-	0xec, 0xf1, 0xf5, 0x5a, 0x1a, 0x99, 0x1f, 0x91, 0x21, 0x89, 0xdb, 0x10, 0xe2, 0x24, 0xe6, 0x47,
-	0x50, 0x4b, 0x88, 0xc7, 0xe8, 0x10, 0x6a, 0xe9, 0xe2, 0xe8, 0x8a, 0xa9, 0x76, 0xb4, 0xde, 0xae,
-	0xbd, 0x7a, 0x73, 0xec, 0x74, 0x04, 0x73, 0x80, 0xd5, 0x86, 0x6a, 0x72, 0x8b, 0x96, 0x5a, 0x14,
-	0x49, 0x8b, 0xd5, 0x06, 0xed, 0x29, 0xe1, 0xab, 0xa6, 0x66, 0x35, 0xe1, 0x5a, 0x16, 0x8e, 0x5d,
-	0x72, 0x6a, 0x99, 0xd0, 0x7c, 0x42, 0xc6, 0x64, 0xf5, 0x9c, 0xad, 0x4d, 0xb8, 0x9e, 0xcb, 0x88,
-	0xc3, 0xde, 0xef, 0x0a, 0x40, 0x5a, 0xe2, 0xd9, 0x70, 0xc2, 0xd1, 0x31, 0x34, 0x16, 0x35, 0x51,
-	0x67, 0x9d, 0x12, 0xb9, 0xb5, 0x61, 0x5d, 0xaa, 0x39, 0xb6, 0x4a, 0x68, 0x00, 0x9a, 0xb4, 0x43,
-	0xe8, 0xee, 0x3a, 0x50, 0xfe, 0x3b, 0x61, 0x5c, 0x6e, 0xaa, 0x55, 0x42, 0x21, 0xb4, 0x2e, 0xda,
-	0x51, 0x74, 0x50, 0xb0, 0x91, 0xbc, 0xd5, 0xc5, 0x3a, 0x0e, 0x40, 0x93, 0x56, 0x6b, 0xbd, 0xa2,
-	0xfc, 0x0e, 0x16, 0xab, 0xff, 0x1a, 0xea, 0x99, 0xcf, 0xe8, 0x4e, 0x91, 0x69, 0x14, 0xae, 0x3c,
-	0x02, 0x4d, 0xba, 0x0a, 0xeb, 0x99, 0xe7, 0x6f, 0x95, 0x71, 0xaf, 0x70, 0x6e, 0x1c, 0x5a, 0xa5,
-	0xfe, 0xfe, 0x1b, 0x67, 0x48, 0xf9, 0x68, 0x7a, 0x62, 0x7b, 0x6c, 0xe2, 0x08, 0xa8, 0x93, 0x41,
-	0x9d, 0x8b, 0x1f, 0xa2, 0x93, 0x5a, 0xf2, 0x5e, 0x1c, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x44,
-	0xbb, 0x48, 0xa1, 0xa9, 0x06, 0x00, 0x00,
+	proto.RegisterFile("api/interservice/authn/tokens.proto", fileDescriptor_tokens_3b89dcd06a4d7d87)
+}
+
+var fileDescriptor_tokens_3b89dcd06a4d7d87 = []byte{
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xdb, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe5, 0x9c, 0x9a, 0x8c, 0xab, 0x7c, 0xea, 0x7e, 0xa5, 0x5d, 0x4c, 0x2b, 0xa5, 0xa6,
+	0x12, 0x11, 0x10, 0x9b, 0xa6, 0x08, 0xa9, 0x17, 0xdc, 0xb4, 0x48, 0x88, 0x0b, 0x24, 0x14, 0xd1,
+	0x22, 0xa0, 0xb4, 0xda, 0xda, 0xd3, 0x64, 0x21, 0xb1, 0x5d, 0xef, 0x26, 0xaa, 0x38, 0xbc, 0x04,
+	0x77, 0x3c, 0x07, 0x77, 0x5c, 0xf1, 0x0c, 0xf0, 0x14, 0xbc, 0x05, 0xda, 0x4d, 0x9c, 0xd8, 0x3d,
+	0xa4, 0x11, 0xbd, 0xf3, 0xee, 0xec, 0xcc, 0xec, 0xef, 0x3f, 0x33, 0x6b, 0xb8, 0xcd, 0x22, 0xee,
+	0xf2, 0x40, 0x62, 0x2c, 0x30, 0x1e, 0x70, 0x0f, 0x5d, 0xd6, 0x97, 0x9d, 0xc0, 0x95, 0xe1, 0x07,
+	0x0c, 0x84, 0x13, 0xc5, 0xa1, 0x0c, 0x89, 0xe5, 0x75, 0xf0, 0xd8, 0x61, 0x7d, 0x19, 0xf6, 0x98,
+	0x44, 0xc7, 0x0f, 0x7b, 0x8c, 0x07, 0x8e, 0x3e, 0x68, 0xad, 0xb4, 0xc3, 0xb0, 0xdd, 0x45, 0x57,
+	0xc5, 0x61, 0x41, 0x10, 0x4a, 0x26, 0x79, 0x98, 0x78, 0x5a, 0xcb, 0x03, 0xd6, 0xe5, 0x3e, 0x93,
+	0xe8, 0x26, 0x1f, 0x43, 0x83, 0xfd, 0xcb, 0x80, 0xea, 0x4e, 0x8c, 0x4c, 0xe2, 0x4b, 0x95, 0xa9,
+	0x85, 0x27, 0xa4, 0x01, 0x39, 0xee, 0x53, 0xa3, 0x66, 0xd4, 0x2b, 0xdb, 0xab, 0x3f, 0xfe, 0xfc,
+	0xcc, 0xd3, 0x78, 0xa9, 0xb9, 0x78, 0xf0, 0x96, 0x35, 0x3e, 0x3e, 0x68, 0x6c, 0x35, 0xde, 0x7d,
+	0xda, 0xb8, 0xff, 0xe8, 0xe1, 0x97, 0xf5, 0xcf, 0x07, 0xeb, 0xad, 0x1c, 0xf7, 0x49, 0x0d, 0x4c,
+	0x1f, 0x85, 0x17, 0xf3, 0x48, 0x25, 0xa4, 0x39, 0xe5, 0xd7, 0x4a, 0x6f, 0x91, 0x25, 0x28, 0x31,
+	0x4f, 0xf2, 0x01, 0xd2, 0x7c, 0xcd, 0xa8, 0x97, 0x5b, 0xa3, 0x15, 0x79, 0x0c, 0xe5, 0x28, 0x0e,
+	0xdf, 0xa3, 0x27, 0x05, 0x2d, 0xd4, 0xf2, 0xf5, 0xca, 0xf6, 0x9a, 0x4a, 0xb7, 0xf2, 0xd5, 0xb8,
+	0x49, 0x0d, 0xfb, 0x46, 0xfc, 0x7f, 0x73, 0xe1, 0x5c, 0xd6, 0xd6, 0xd8, 0x85, 0x50, 0x98, 0xc3,
+	0xd3, 0x88, 0xc7, 0x28, 0x68, 0x51, 0x27, 0x4d, 0x96, 0x0a, 0x6a, 0x39, 0x05, 0xf5, 0x8a, 0xcb,
+	0xce, 0x1e, 0xeb, 0xf6, 0x51, 0xd1, 0x55, 0x27, 0x74, 0xd7, 0xbc, 0xfe, 0x22, 0x14, 0x07, 0x2a,
+	0x2a, 0x2d, 0x68, 0x9f, 0xe1, 0x22, 0x03, 0x55, 0xbc, 0x16, 0x54, 0x29, 0x0b, 0xf5, 0xcd, 0x80,
+	0xea, 0x6e, 0xe4, 0xa7, 0x2b, 0x75, 0x96, 0x65, 0x72, 0xd3, 0x5c, 0xe6, 0xa6, 0x67, 0x18, 0xf3,
+	0xe7, 0x19, 0xaf, 0x57, 0x0a, 0xfb, 0x7b, 0x0e, 0x8a, 0xfa, 0x56, 0xff, 0x20, 0xef, 0x58, 0xc6,
+	0x7c, 0x5a, 0xc6, 0x09, 0x4a, 0x21, 0x83, 0x42, 0x61, 0xce, 0xd3, 0x95, 0xf5, 0x93, 0xa2, 0x8f,
+	0x96, 0xca, 0xd2, 0xd7, 0xf2, 0xf8, 0x89, 0x72, 0xa3, 0x25, 0xb1, 0x52, 0x70, 0x73, 0x0a, 0xee,
+	0x62, 0xbd, 0xcb, 0x19, 0xbd, 0xc9, 0x2d, 0xa8, 0x74, 0x99, 0x90, 0x87, 0x7d, 0x81, 0x3e, 0xad,
+	0x68, 0x5b, 0x59, 0x6d, 0xec, 0x0a, 0x54, 0x58, 0xf3, 0x63, 0xe3, 0x21, 0x8f, 0x28, 0x68, 0x3b,
+	0x24, 0xf6, 0x67, 0x11, 0x59, 0x83, 0x79, 0x4d, 0x72, 0x18, 0xc5, 0x78, 0xcc, 0x4f, 0xa9, 0x39,
+	0x24, 0xd7, 0x7b, 0x2f, 0xf4, 0x96, 0xbd, 0x03, 0x25, 0x2d, 0x9a, 0x20, 0x5b, 0x50, 0x1a, 0x0e,
+	0x3a, 0x35, 0x6a, 0xf9, 0xba, 0xd9, 0x5c, 0x73, 0x2e, 0x9f, 0x74, 0x67, 0x58, 0xfe, 0x91, 0x83,
+	0xbd, 0x0a, 0x45, 0xdd, 0xdb, 0x13, 0x1d, 0x8d, 0x94, 0x8e, 0xf6, 0x2a, 0x98, 0x4f, 0x51, 0x5e,
+	0xd6, 0x31, 0x76, 0x15, 0xe6, 0x13, 0xb3, 0x68, 0xe1, 0x89, 0x5d, 0x83, 0xea, 0x13, 0xec, 0xe2,
+	0xe5, 0x3d, 0x66, 0x2f, 0xc0, 0x7f, 0x99, 0x13, 0x22, 0x6a, 0xfe, 0x2e, 0x00, 0x0c, 0x43, 0x3c,
+	0x6f, 0xf7, 0x24, 0x79, 0x0d, 0x95, 0x71, 0x4c, 0x52, 0x9f, 0x46, 0x92, 0x4e, 0x6d, 0xd9, 0x57,
+	0x32, 0x0b, 0xb2, 0x0f, 0x66, 0x6a, 0xae, 0xc9, 0xdd, 0x69, 0x2e, 0xd9, 0x57, 0xcd, 0xba, 0x5a,
+	0x52, 0x12, 0xc0, 0xe2, 0x45, 0xaf, 0x06, 0xd9, 0x9c, 0x31, 0x4d, 0xfa, 0x9d, 0x99, 0x25, 0xdf,
+	0x3e, 0x98, 0xa9, 0x81, 0x9e, 0x4e, 0x93, 0x9d, 0xfc, 0x59, 0xa2, 0xef, 0x41, 0x39, 0xd1, 0x97,
+	0xdc, 0x99, 0xa5, 0x0a, 0x33, 0xc6, 0x3d, 0x06, 0x33, 0xd5, 0x00, 0xd3, 0x6f, 0x9d, 0xed, 0x25,
+	0xeb, 0xde, 0xcc, 0x67, 0x45, 0xb4, 0xbd, 0xf1, 0xc6, 0x6d, 0x73, 0xd9, 0xe9, 0x1f, 0x39, 0x5e,
+	0xd8, 0x73, 0x95, 0xa3, 0x9b, 0x38, 0xba, 0x17, 0xff, 0x2c, 0x8f, 0x4a, 0xfa, 0x9f, 0xb6, 0xf9,
+	0x77, 0x00, 0x49, 0x1b, 0x7a, 0xea, 0x4d, 0x07, 0x00, 0x00,
 }
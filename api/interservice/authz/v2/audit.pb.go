@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api/interservice/authz/v2/audit.proto
+
+package v2 // import "github.com/chef/automate/api/interservice/authz/v2"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "github.com/lyft/protoc-gen-validate/validate"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ListDecisionsReq_Result int32
+
+const (
+	ListDecisionsReq_ANY     ListDecisionsReq_Result = 0
+	ListDecisionsReq_ALLOWED ListDecisionsReq_Result = 1
+	ListDecisionsReq_DENIED  ListDecisionsReq_Result = 2
+)
+
+var ListDecisionsReq_Result_name = map[int32]string{
+	0: "ANY",
+	1: "ALLOWED",
+	2: "DENIED",
+}
+var ListDecisionsReq_Result_value = map[string]int32{
+	"ANY":     0,
+	"ALLOWED": 1,
+	"DENIED":  2,
+}
+
+func (x ListDecisionsReq_Result) String() string {
+	return proto.EnumName(ListDecisionsReq_Result_name, int32(x))
+}
+func (ListDecisionsReq_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_audit_9f401e14f0be46d9, []int{2, 0}
+}
+
+// Decision is an authorization decision recorded in the audit log.
+type Decision struct {
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty" toml:"time,omitempty" mapstructure:"time,omitempty"`
+	// method is the Authorization service method the decision was made in
+	Method   string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty" toml:"method,omitempty" mapstructure:"method,omitempty"`
+	Subjects []string `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty" toml:"subjects,omitempty" mapstructure:"subjects,omitempty"`
+	Action   string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty" toml:"action,omitempty" mapstructure:"action,omitempty"`
+	Resource string   `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty" toml:"resource,omitempty" mapstructure:"resource,omitempty"`
+	// projects are the projects requested (ProjectsAuthorized)
+	Projects []string `protobuf:"bytes,6,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	Allowed  bool     `protobuf:"varint,7,opt,name=allowed,proto3" json:"allowed,omitempty" toml:"allowed,omitempty" mapstructure:"allowed,omitempty"`
+	// authorized_projects are the projects the decision allowed
+	// (ProjectsAuthorized, FilterAuthorizedProjects)
+	AuthorizedProjects []string `protobuf:"bytes,8,rep,name=authorized_projects,json=authorizedProjects,proto3" json:"authorized_projects,omitempty" toml:"authorized_projects,omitempty" mapstructure:"authorized_projects,omitempty"`
+	// matches are the policy statements the decision was based on
+	Matches              []*Match `protobuf:"bytes,9,rep,name=matches,proto3" json:"matches,omitempty" toml:"matches,omitempty" mapstructure:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *Decision) Reset()         { *m = Decision{} }
+func (m *Decision) String() string { return proto.CompactTextString(m) }
+func (*Decision) ProtoMessage()    {}
+func (*Decision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_9f401e14f0be46d9, []int{0}
+}
+func (m *Decision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Decision.Unmarshal(m, b)
+}
+func (m *Decision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Decision.Marshal(b, m, deterministic)
+}
+func (dst *Decision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Decision.Merge(dst, src)
+}
+func (m *Decision) XXX_Size() int {
+	return xxx_messageInfo_Decision.Size(m)
+}
+func (m *Decision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Decision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Decision proto.InternalMessageInfo
+
+func (m *Decision) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Decision) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Decision) GetSubjects() []string {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+func (m *Decision) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *Decision) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *Decision) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *Decision) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *Decision) GetAuthorizedProjects() []string {
+	if m != nil {
+		return m.AuthorizedProjects
+	}
+	return nil
+}
+
+func (m *Decision) GetMatches() []*Match {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+type Match struct {
+	Effect               string   `protobuf:"bytes,1,opt,name=effect,proto3" json:"effect,omitempty" toml:"effect,omitempty" mapstructure:"effect,omitempty"`
+	PolicyId             string   `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty" toml:"policy_id,omitempty" mapstructure:"policy_id,omitempty"`
+	StatementId          string   `protobuf:"bytes,3,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty" toml:"statement_id,omitempty" mapstructure:"statement_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *Match) Reset()         { *m = Match{} }
+func (m *Match) String() string { return proto.CompactTextString(m) }
+func (*Match) ProtoMessage()    {}
+func (*Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_9f401e14f0be46d9, []int{1}
+}
+func (m *Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Match.Unmarshal(m, b)
+}
+func (m *Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Match.Marshal(b, m, deterministic)
+}
+func (dst *Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Match.Merge(dst, src)
+}
+func (m *Match) XXX_Size() int {
+	return xxx_messageInfo_Match.Size(m)
+}
+func (m *Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Match proto.InternalMessageInfo
+
+func (m *Match) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+func (m *Match) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *Match) GetStatementId() string {
+	if m != nil {
+		return m.StatementId
+	}
+	return ""
+}
+
+type ListDecisionsReq struct {
+	// subject only lists decisions made for requests including this subject
+	Subject  string                  `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty" toml:"subject,omitempty" mapstructure:"subject,omitempty"`
+	Action   string                  `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty" toml:"action,omitempty" mapstructure:"action,omitempty"`
+	Resource string                  `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty" toml:"resource,omitempty" mapstructure:"resource,omitempty"`
+	Result   ListDecisionsReq_Result `protobuf:"varint,4,opt,name=result,proto3,enum=chef.automate.domain.authz.v2.ListDecisionsReq_Result" json:"result,omitempty" toml:"result,omitempty" mapstructure:"result,omitempty"`
+	Since    *timestamp.Timestamp    `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty" toml:"since,omitempty" mapstructure:"since,omitempty"`
+	Until    *timestamp.Timestamp    `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty" toml:"until,omitempty" mapstructure:"until,omitempty"`
+	// limit is the maximum number of decisions returned, most recent first;
+	// defaults to 100
+	Limit                int32    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty" toml:"limit,omitempty" mapstructure:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListDecisionsReq) Reset()         { *m = ListDecisionsReq{} }
+func (m *ListDecisionsReq) String() string { return proto.CompactTextString(m) }
+func (*ListDecisionsReq) ProtoMessage()    {}
+func (*ListDecisionsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_9f401e14f0be46d9, []int{2}
+}
+func (m *ListDecisionsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDecisionsReq.Unmarshal(m, b)
+}
+func (m *ListDecisionsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDecisionsReq.Marshal(b, m, deterministic)
+}
+func (dst *ListDecisionsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDecisionsReq.Merge(dst, src)
+}
+func (m *ListDecisionsReq) XXX_Size() int {
+	return xxx_messageInfo_ListDecisionsReq.Size(m)
+}
+func (m *ListDecisionsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDecisionsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDecisionsReq proto.InternalMessageInfo
+
+func (m *ListDecisionsReq) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ListDecisionsReq) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ListDecisionsReq) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *ListDecisionsReq) GetResult() ListDecisionsReq_Result {
+	if m != nil {
+		return m.Result
+	}
+	return ListDecisionsReq_ANY
+}
+
+func (m *ListDecisionsReq) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *ListDecisionsReq) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *ListDecisionsReq) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListDecisionsResp struct {
+	Decisions            []*Decision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty" toml:"decisions,omitempty" mapstructure:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte      `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListDecisionsResp) Reset()         { *m = ListDecisionsResp{} }
+func (m *ListDecisionsResp) String() string { return proto.CompactTextString(m) }
+func (*ListDecisionsResp) ProtoMessage()    {}
+func (*ListDecisionsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_9f401e14f0be46d9, []int{3}
+}
+func (m *ListDecisionsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDecisionsResp.Unmarshal(m, b)
+}
+func (m *ListDecisionsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDecisionsResp.Marshal(b, m, deterministic)
+}
+func (dst *ListDecisionsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDecisionsResp.Merge(dst, src)
+}
+func (m *ListDecisionsResp) XXX_Size() int {
+	return xxx_messageInfo_ListDecisionsResp.Size(m)
+}
+func (m *ListDecisionsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDecisionsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDecisionsResp proto.InternalMessageInfo
+
+func (m *ListDecisionsResp) GetDecisions() []*Decision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Decision)(nil), "chef.automate.domain.authz.v2.Decision")
+	proto.RegisterType((*Match)(nil), "chef.automate.domain.authz.v2.Match")
+	proto.RegisterType((*ListDecisionsReq)(nil), "chef.automate.domain.authz.v2.ListDecisionsReq")
+	proto.RegisterType((*ListDecisionsResp)(nil), "chef.automate.domain.authz.v2.ListDecisionsResp")
+	proto.RegisterEnum("chef.automate.domain.authz.v2.ListDecisionsReq_Result", ListDecisionsReq_Result_name, ListDecisionsReq_Result_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditClient interface {
+	ListDecisions(ctx context.Context, in *ListDecisionsReq, opts ...grpc.CallOption) (*ListDecisionsResp, error)
+}
+
+type auditClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditClient(cc *grpc.ClientConn) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) ListDecisions(ctx context.Context, in *ListDecisionsReq, opts ...grpc.CallOption) (*ListDecisionsResp, error) {
+	out := new(ListDecisionsResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Audit/ListDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+type AuditServer interface {
+	ListDecisions(context.Context, *ListDecisionsReq) (*ListDecisionsResp, error)
+}
+
+func RegisterAuditServer(s *grpc.Server, srv AuditServer) {
+	s.RegisterService(&_Audit_serviceDesc, srv)
+}
+
+func _Audit_ListDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDecisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).ListDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Audit/ListDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).ListDecisions(ctx, req.(*ListDecisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Audit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chef.automate.domain.authz.v2.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDecisions",
+			Handler:    _Audit_ListDecisions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/interservice/authz/v2/audit.proto",
+}
+
+func init() {
+	proto.RegisterFile("api/interservice/authz/v2/audit.proto", fileDescriptor_audit_9f401e14f0be46d9)
+}
+
+var fileDescriptor_audit_9f401e14f0be46d9 = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xd7, 0x66, 0x49, 0xda, 0xd7, 0x81, 0x8a, 0x91, 0x20, 0x2a, 0x42, 0x94, 0x08, 0x44,
+	0xc4, 0xc1, 0x9e, 0x02, 0xe2, 0x88, 0xb4, 0xa9, 0x3d, 0x4c, 0x1a, 0x03, 0x45, 0x48, 0x88, 0x5d,
+	0x26, 0x37, 0x71, 0x57, 0xa3, 0x24, 0x0e, 0xb1, 0x53, 0xc4, 0xf8, 0x66, 0x9c, 0xb8, 0xf2, 0x3d,
+	0x38, 0xc0, 0xb7, 0x40, 0x76, 0xe2, 0x0e, 0x26, 0x6d, 0xd3, 0x6e, 0xfd, 0xbf, 0xf7, 0x7e, 0x7f,
+	0xdb, 0x7f, 0xbb, 0x81, 0xa7, 0xb4, 0xe2, 0x84, 0x97, 0x8a, 0xd5, 0x92, 0xd5, 0x6b, 0x9e, 0x32,
+	0x42, 0x1b, 0xb5, 0x3a, 0x23, 0xeb, 0x98, 0xd0, 0x26, 0xe3, 0x0a, 0x57, 0xb5, 0x50, 0x02, 0x3d,
+	0x4c, 0x57, 0x6c, 0x89, 0x69, 0xa3, 0x44, 0x41, 0x15, 0xc3, 0x99, 0x28, 0x28, 0x2f, 0xb1, 0x19,
+	0xc5, 0xeb, 0x78, 0x72, 0x7f, 0x4d, 0x73, 0x9e, 0x51, 0xc5, 0x88, 0xfd, 0xd1, 0x72, 0x93, 0x47,
+	0xa7, 0x42, 0x9c, 0xe6, 0x8c, 0x18, 0xb5, 0x68, 0x96, 0x44, 0xf1, 0x82, 0x49, 0x45, 0x8b, 0xaa,
+	0x1d, 0x08, 0x7f, 0xf6, 0x61, 0x30, 0x63, 0x29, 0x97, 0x5c, 0x94, 0x08, 0xc3, 0xb6, 0xee, 0x07,
+	0xbd, 0x69, 0x2f, 0x1a, 0xc5, 0x13, 0xdc, 0xc2, 0xd8, 0xc2, 0xf8, 0xbd, 0x85, 0x13, 0x33, 0x87,
+	0xee, 0x81, 0x57, 0x30, 0xb5, 0x12, 0x59, 0xd0, 0x9f, 0xf6, 0xa2, 0x61, 0xd2, 0x29, 0x34, 0x81,
+	0x81, 0x6c, 0x16, 0x9f, 0x58, 0xaa, 0x64, 0xe0, 0x4c, 0x9d, 0x68, 0x98, 0x6c, 0xb4, 0x66, 0x68,
+	0xaa, 0xb8, 0x28, 0x83, 0xed, 0x96, 0x69, 0x95, 0x66, 0x6a, 0x26, 0x45, 0x53, 0xa7, 0x2c, 0x70,
+	0x4d, 0x67, 0xa3, 0x75, 0xaf, 0xaa, 0x45, 0xeb, 0xe7, 0xb5, 0x7e, 0x56, 0xa3, 0x00, 0x7c, 0x9a,
+	0xe7, 0xe2, 0x0b, 0xcb, 0x02, 0x7f, 0xda, 0x8b, 0x06, 0x89, 0x95, 0x88, 0xc0, 0x5d, 0x1d, 0x90,
+	0xa8, 0xf9, 0x19, 0xcb, 0x4e, 0x36, 0x06, 0x03, 0x63, 0x80, 0xce, 0x5b, 0xef, 0xac, 0xd5, 0x6b,
+	0xf0, 0x0b, 0xaa, 0xd2, 0x15, 0x93, 0xc1, 0x70, 0xea, 0x44, 0xa3, 0xf8, 0x09, 0xbe, 0x32, 0x76,
+	0xfc, 0x46, 0x4f, 0x27, 0x16, 0x0a, 0x4f, 0xc0, 0x35, 0x15, 0x7d, 0x46, 0xb6, 0x5c, 0xb2, 0x54,
+	0x99, 0x24, 0x87, 0x49, 0xa7, 0xd0, 0x03, 0x18, 0x56, 0x22, 0xe7, 0xe9, 0xd7, 0x13, 0x6e, 0x23,
+	0x1b, 0xb4, 0x85, 0x83, 0x0c, 0x3d, 0x86, 0x1d, 0xa9, 0xa8, 0x62, 0x05, 0x2b, 0x95, 0xee, 0x3b,
+	0xa6, 0x3f, 0xda, 0xd4, 0x0e, 0xb2, 0xf0, 0x57, 0x1f, 0xc6, 0x87, 0x5c, 0x2a, 0x7b, 0x61, 0x32,
+	0x61, 0x9f, 0x75, 0x00, 0x5d, 0xb8, 0xdd, 0x6a, 0x56, 0xfe, 0x13, 0x75, 0xff, 0xd2, 0xa8, 0x9d,
+	0x0b, 0x51, 0x1f, 0x81, 0x57, 0x33, 0xd9, 0xe4, 0xca, 0x5c, 0xcf, 0xed, 0xf8, 0xd5, 0x35, 0x11,
+	0x5c, 0xdc, 0x0e, 0x4e, 0x0c, 0x9d, 0x74, 0x2e, 0x68, 0x17, 0x5c, 0xc9, 0xcb, 0xee, 0x4e, 0xaf,
+	0x7e, 0x53, 0xed, 0xa0, 0x26, 0x9a, 0x52, 0xf1, 0x3c, 0xf0, 0xae, 0x27, 0xcc, 0x20, 0x0a, 0xc1,
+	0xcd, 0x79, 0xc1, 0x95, 0x79, 0x00, 0xee, 0xfe, 0xce, 0xf7, 0x3f, 0x3f, 0x1c, 0x7f, 0xe2, 0x06,
+	0xbf, 0xfd, 0x68, 0x2b, 0x69, 0x5b, 0xe1, 0x73, 0xf0, 0xda, 0x9d, 0x21, 0x1f, 0x9c, 0xbd, 0xa3,
+	0x8f, 0xe3, 0x2d, 0x34, 0x02, 0x7f, 0xef, 0xf0, 0xf0, 0xed, 0x87, 0xf9, 0x6c, 0xdc, 0x43, 0x00,
+	0xde, 0x6c, 0x7e, 0x74, 0x30, 0x9f, 0x8d, 0xfb, 0xe1, 0x31, 0xdc, 0xb9, 0x70, 0x2c, 0x59, 0xa1,
+	0x39, 0x0c, 0x33, 0x5b, 0x08, 0x7a, 0xe6, 0x79, 0x3c, 0xbb, 0x26, 0x1b, 0x6b, 0x90, 0x9c, 0x93,
+	0xf1, 0x37, 0x70, 0xf7, 0xf4, 0xff, 0x1a, 0xd5, 0x70, 0xeb, 0xbf, 0x45, 0x10, 0xb9, 0x61, 0xd2,
+	0x93, 0xdd, 0x9b, 0x01, 0xb2, 0xda, 0x7f, 0x79, 0x1c, 0x9f, 0x72, 0xb5, 0x6a, 0x16, 0x38, 0x15,
+	0x05, 0xd1, 0x34, 0xb1, 0x34, 0xb9, 0xf4, 0x3b, 0xb4, 0xf0, 0x4c, 0xf2, 0x2f, 0xfe, 0x0e, 0x00,
+	0xde, 0x97, 0xb0, 0xa3, 0xab, 0x04, 0x00, 0x00,
+}
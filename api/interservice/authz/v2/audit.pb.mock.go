@@ -0,0 +1,50 @@
+// Code generated by protoc-gen-grpc-mock. DO NOT EDIT.
+// source: api/interservice/authz/v2/audit.proto
+
+package v2
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// verify that the mock satisfies the AuditServer interface (at compile time)
+var _ AuditServer = &AuditServerMock{}
+
+// NewAuditServerMock gives you a fresh instance of AuditServerMock.
+func NewAuditServerMock() *AuditServerMock {
+	return &AuditServerMock{validateRequests: true}
+}
+
+// NewAuditServerMockWithoutValidation gives you a fresh instance of
+// AuditServerMock which does not attempt to validate requests before passing
+// them to their respective '*Func'.
+func NewAuditServerMockWithoutValidation() *AuditServerMock {
+	return &AuditServerMock{}
+}
+
+// AuditServerMock is the mock-what-you-want struct that stubs all not-overridden
+// methods with "not implemented" returns
+type AuditServerMock struct {
+	validateRequests  bool
+	ListDecisionsFunc func(context.Context, *ListDecisionsReq) (*ListDecisionsResp, error)
+}
+
+func (m *AuditServerMock) ListDecisions(ctx context.Context, req *ListDecisionsReq) (*ListDecisionsResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ListDecisionsFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ListDecisions' not implemented")
+}
+
+// Reset resets all overridden functions
+func (m *AuditServerMock) Reset() {
+	m.ListDecisionsFunc = nil
+}
@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/interservice/authz/v2/audit.proto
+
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// Validate checks the field values on Decision with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Decision) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DecisionValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Method
+
+	// no validation rules for Action
+
+	// no validation rules for Resource
+
+	// no validation rules for Allowed
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DecisionValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// DecisionValidationError is the validation error returned by
+// Decision.Validate if the designated constraints aren't met.
+type DecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecisionValidationError) ErrorName() string { return "DecisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecisionValidationError{}
+
+// Validate checks the field values on Match with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Match) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Effect
+
+	// no validation rules for PolicyId
+
+	// no validation rules for StatementId
+
+	return nil
+}
+
+// MatchValidationError is the validation error returned by Match.Validate if
+// the designated constraints aren't met.
+type MatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MatchValidationError) ErrorName() string { return "MatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MatchValidationError{}
+
+// Validate checks the field values on ListDecisionsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListDecisionsReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Subject
+
+	// no validation rules for Action
+
+	// no validation rules for Resource
+
+	// no validation rules for Result
+
+	if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDecisionsReqValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDecisionsReqValidationError{
+				field:  "Until",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		return ListDecisionsReqValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+	}
+
+	return nil
+}
+
+// ListDecisionsReqValidationError is the validation error returned by
+// ListDecisionsReq.Validate if the designated constraints aren't met.
+type ListDecisionsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDecisionsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDecisionsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDecisionsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDecisionsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDecisionsReqValidationError) ErrorName() string { return "ListDecisionsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListDecisionsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDecisionsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDecisionsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDecisionsReqValidationError{}
+
+// Validate checks the field values on ListDecisionsResp with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListDecisionsResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetDecisions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDecisionsRespValidationError{
+					field:  fmt.Sprintf("Decisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListDecisionsRespValidationError is the validation error returned by
+// ListDecisionsResp.Validate if the designated constraints aren't met.
+type ListDecisionsRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDecisionsRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDecisionsRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDecisionsRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDecisionsRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDecisionsRespValidationError) ErrorName() string {
+	return "ListDecisionsRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDecisionsRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDecisionsResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDecisionsRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDecisionsRespValidationError{}
@@ -6,6 +6,7 @@ package v2 // import "github.com/chef/automate/api/interservice/authz/v2"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "github.com/lyft/protoc-gen-validate/validate"
 
 import (
@@ -28,9 +29,12 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 // a) it takes time
 // b) bad input will with certainty lead to an "unauthorized" response
 type IsAuthorizedReq struct {
-	Subjects             []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty" toml:"subjects,omitempty" mapstructure:"subjects,omitempty"`
-	Resource             string   `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty" toml:"resource,omitempty" mapstructure:"resource,omitempty"`
-	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty" toml:"action,omitempty" mapstructure:"action,omitempty"`
+	Subjects []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty" toml:"subjects,omitempty" mapstructure:"subjects,omitempty"`
+	Resource string   `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty" toml:"resource,omitempty" mapstructure:"resource,omitempty"`
+	Action   string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty" toml:"action,omitempty" mapstructure:"action,omitempty"`
+	// the address of the client the request was made by, checked against
+	// the source IP conditions of policy statements
+	SourceIp             string   `protobuf:"bytes,4,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty" toml:"source_ip,omitempty" mapstructure:"source_ip,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -40,7 +44,7 @@ func (m *IsAuthorizedReq) Reset()         { *m = IsAuthorizedReq{} }
 func (m *IsAuthorizedReq) String() string { return proto.CompactTextString(m) }
 func (*IsAuthorizedReq) ProtoMessage()    {}
 func (*IsAuthorizedReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{0}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{0}
 }
 func (m *IsAuthorizedReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsAuthorizedReq.Unmarshal(m, b)
@@ -81,6 +85,13 @@ func (m *IsAuthorizedReq) GetAction() string {
 	return ""
 }
 
+func (m *IsAuthorizedReq) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return ""
+}
+
 type IsAuthorizedResp struct {
 	Authorized           bool     `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty" toml:"authorized,omitempty" mapstructure:"authorized,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -92,7 +103,7 @@ func (m *IsAuthorizedResp) Reset()         { *m = IsAuthorizedResp{} }
 func (m *IsAuthorizedResp) String() string { return proto.CompactTextString(m) }
 func (*IsAuthorizedResp) ProtoMessage()    {}
 func (*IsAuthorizedResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{1}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{1}
 }
 func (m *IsAuthorizedResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IsAuthorizedResp.Unmarshal(m, b)
@@ -124,6 +135,7 @@ type ProjectsAuthorizedReq struct {
 	Resource             string   `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty" toml:"resource,omitempty" mapstructure:"resource,omitempty"`
 	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty" toml:"action,omitempty" mapstructure:"action,omitempty"`
 	ProjectsFilter       []string `protobuf:"bytes,4,rep,name=projects_filter,json=projectsFilter,proto3" json:"projects_filter,omitempty" toml:"projects_filter,omitempty" mapstructure:"projects_filter,omitempty"`
+	SourceIp             string   `protobuf:"bytes,5,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty" toml:"source_ip,omitempty" mapstructure:"source_ip,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -133,7 +145,7 @@ func (m *ProjectsAuthorizedReq) Reset()         { *m = ProjectsAuthorizedReq{} }
 func (m *ProjectsAuthorizedReq) String() string { return proto.CompactTextString(m) }
 func (*ProjectsAuthorizedReq) ProtoMessage()    {}
 func (*ProjectsAuthorizedReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{2}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{2}
 }
 func (m *ProjectsAuthorizedReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectsAuthorizedReq.Unmarshal(m, b)
@@ -181,6 +193,13 @@ func (m *ProjectsAuthorizedReq) GetProjectsFilter() []string {
 	return nil
 }
 
+func (m *ProjectsAuthorizedReq) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return ""
+}
+
 type ProjectsAuthorizedResp struct {
 	Projects             []string `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -192,7 +211,7 @@ func (m *ProjectsAuthorizedResp) Reset()         { *m = ProjectsAuthorizedResp{}
 func (m *ProjectsAuthorizedResp) String() string { return proto.CompactTextString(m) }
 func (*ProjectsAuthorizedResp) ProtoMessage()    {}
 func (*ProjectsAuthorizedResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{3}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{3}
 }
 func (m *ProjectsAuthorizedResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectsAuthorizedResp.Unmarshal(m, b)
@@ -223,6 +242,7 @@ func (m *ProjectsAuthorizedResp) GetProjects() []string {
 type FilterAuthorizedPairsReq struct {
 	Subjects             []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty" toml:"subjects,omitempty" mapstructure:"subjects,omitempty"`
 	Pairs                []*Pair  `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs,omitempty" toml:"pairs,omitempty" mapstructure:"pairs,omitempty"`
+	SourceIp             string   `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty" toml:"source_ip,omitempty" mapstructure:"source_ip,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -232,7 +252,7 @@ func (m *FilterAuthorizedPairsReq) Reset()         { *m = FilterAuthorizedPairsR
 func (m *FilterAuthorizedPairsReq) String() string { return proto.CompactTextString(m) }
 func (*FilterAuthorizedPairsReq) ProtoMessage()    {}
 func (*FilterAuthorizedPairsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{4}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{4}
 }
 func (m *FilterAuthorizedPairsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilterAuthorizedPairsReq.Unmarshal(m, b)
@@ -266,6 +286,13 @@ func (m *FilterAuthorizedPairsReq) GetPairs() []*Pair {
 	return nil
 }
 
+func (m *FilterAuthorizedPairsReq) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return ""
+}
+
 type FilterAuthorizedPairsResp struct {
 	Pairs                []*Pair  `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty" toml:"pairs,omitempty" mapstructure:"pairs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -277,7 +304,7 @@ func (m *FilterAuthorizedPairsResp) Reset()         { *m = FilterAuthorizedPairs
 func (m *FilterAuthorizedPairsResp) String() string { return proto.CompactTextString(m) }
 func (*FilterAuthorizedPairsResp) ProtoMessage()    {}
 func (*FilterAuthorizedPairsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{5}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{5}
 }
 func (m *FilterAuthorizedPairsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilterAuthorizedPairsResp.Unmarshal(m, b)
@@ -315,7 +342,7 @@ func (m *FilterAuthorizedProjectsResp) Reset()         { *m = FilterAuthorizedPr
 func (m *FilterAuthorizedProjectsResp) String() string { return proto.CompactTextString(m) }
 func (*FilterAuthorizedProjectsResp) ProtoMessage()    {}
 func (*FilterAuthorizedProjectsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{6}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{6}
 }
 func (m *FilterAuthorizedProjectsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FilterAuthorizedProjectsResp.Unmarshal(m, b)
@@ -354,7 +381,7 @@ func (m *Pair) Reset()         { *m = Pair{} }
 func (m *Pair) String() string { return proto.CompactTextString(m) }
 func (*Pair) ProtoMessage()    {}
 func (*Pair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_authz_f1d9420b5d8a6335, []int{7}
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{7}
 }
 func (m *Pair) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pair.Unmarshal(m, b)
@@ -388,6 +415,206 @@ func (m *Pair) GetAction() string {
 	return ""
 }
 
+type ExplainAuthorizationReq struct {
+	Subjects []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty" toml:"subjects,omitempty" mapstructure:"subjects,omitempty"`
+	Resource string   `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty" toml:"resource,omitempty" mapstructure:"resource,omitempty"`
+	Action   string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty" toml:"action,omitempty" mapstructure:"action,omitempty"`
+	Projects []string `protobuf:"bytes,4,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	// policies that are not stored: they are evaluated in place of the stored
+	// policies with the same IDs, and in addition to all others
+	Policies []*Policy `protobuf:"bytes,5,rep,name=policies,proto3" json:"policies,omitempty" toml:"policies,omitempty" mapstructure:"policies,omitempty"`
+	SourceIp string    `protobuf:"bytes,6,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty" toml:"source_ip,omitempty" mapstructure:"source_ip,omitempty"`
+	// the time to evaluate time window conditions at; defaults to now
+	Time                 *timestamp.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty" toml:"time,omitempty" mapstructure:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ExplainAuthorizationReq) Reset()         { *m = ExplainAuthorizationReq{} }
+func (m *ExplainAuthorizationReq) String() string { return proto.CompactTextString(m) }
+func (*ExplainAuthorizationReq) ProtoMessage()    {}
+func (*ExplainAuthorizationReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{8}
+}
+func (m *ExplainAuthorizationReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainAuthorizationReq.Unmarshal(m, b)
+}
+func (m *ExplainAuthorizationReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainAuthorizationReq.Marshal(b, m, deterministic)
+}
+func (dst *ExplainAuthorizationReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainAuthorizationReq.Merge(dst, src)
+}
+func (m *ExplainAuthorizationReq) XXX_Size() int {
+	return xxx_messageInfo_ExplainAuthorizationReq.Size(m)
+}
+func (m *ExplainAuthorizationReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainAuthorizationReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainAuthorizationReq proto.InternalMessageInfo
+
+func (m *ExplainAuthorizationReq) GetSubjects() []string {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationReq) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationReq) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationReq) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationReq) GetPolicies() []*Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationReq) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationReq) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type ExplainAuthorizationResp struct {
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty" toml:"authorized,omitempty" mapstructure:"authorized,omitempty"`
+	// the requested projects that are allowed, or all allowed projects if
+	// none were requested
+	Projects []string `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	// the statements that matched the request, allowing or denying it
+	Statements           []*ExplainedStatement `protobuf:"bytes,3,rep,name=statements,proto3" json:"statements,omitempty" toml:"statements,omitempty" mapstructure:"statements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                 `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ExplainAuthorizationResp) Reset()         { *m = ExplainAuthorizationResp{} }
+func (m *ExplainAuthorizationResp) String() string { return proto.CompactTextString(m) }
+func (*ExplainAuthorizationResp) ProtoMessage()    {}
+func (*ExplainAuthorizationResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{9}
+}
+func (m *ExplainAuthorizationResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainAuthorizationResp.Unmarshal(m, b)
+}
+func (m *ExplainAuthorizationResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainAuthorizationResp.Marshal(b, m, deterministic)
+}
+func (dst *ExplainAuthorizationResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainAuthorizationResp.Merge(dst, src)
+}
+func (m *ExplainAuthorizationResp) XXX_Size() int {
+	return xxx_messageInfo_ExplainAuthorizationResp.Size(m)
+}
+func (m *ExplainAuthorizationResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainAuthorizationResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainAuthorizationResp proto.InternalMessageInfo
+
+func (m *ExplainAuthorizationResp) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
+	}
+	return false
+}
+
+func (m *ExplainAuthorizationResp) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationResp) GetStatements() []*ExplainedStatement {
+	if m != nil {
+		return m.Statements
+	}
+	return nil
+}
+
+type ExplainedStatement struct {
+	PolicyId             string     `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty" toml:"policy_id,omitempty" mapstructure:"policy_id,omitempty"`
+	StatementId          string     `protobuf:"bytes,2,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty" toml:"statement_id,omitempty" mapstructure:"statement_id,omitempty"`
+	Statement            *Statement `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty" toml:"statement,omitempty" mapstructure:"statement,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte     `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32      `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ExplainedStatement) Reset()         { *m = ExplainedStatement{} }
+func (m *ExplainedStatement) String() string { return proto.CompactTextString(m) }
+func (*ExplainedStatement) ProtoMessage()    {}
+func (*ExplainedStatement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_authz_ecc6ff788a4727be, []int{10}
+}
+func (m *ExplainedStatement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainedStatement.Unmarshal(m, b)
+}
+func (m *ExplainedStatement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainedStatement.Marshal(b, m, deterministic)
+}
+func (dst *ExplainedStatement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainedStatement.Merge(dst, src)
+}
+func (m *ExplainedStatement) XXX_Size() int {
+	return xxx_messageInfo_ExplainedStatement.Size(m)
+}
+func (m *ExplainedStatement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainedStatement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainedStatement proto.InternalMessageInfo
+
+func (m *ExplainedStatement) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *ExplainedStatement) GetStatementId() string {
+	if m != nil {
+		return m.StatementId
+	}
+	return ""
+}
+
+func (m *ExplainedStatement) GetStatement() *Statement {
+	if m != nil {
+		return m.Statement
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*IsAuthorizedReq)(nil), "chef.automate.domain.authz.v2.IsAuthorizedReq")
 	proto.RegisterType((*IsAuthorizedResp)(nil), "chef.automate.domain.authz.v2.IsAuthorizedResp")
@@ -397,6 +624,9 @@ func init() {
 	proto.RegisterType((*FilterAuthorizedPairsResp)(nil), "chef.automate.domain.authz.v2.FilterAuthorizedPairsResp")
 	proto.RegisterType((*FilterAuthorizedProjectsResp)(nil), "chef.automate.domain.authz.v2.FilterAuthorizedProjectsResp")
 	proto.RegisterType((*Pair)(nil), "chef.automate.domain.authz.v2.Pair")
+	proto.RegisterType((*ExplainAuthorizationReq)(nil), "chef.automate.domain.authz.v2.ExplainAuthorizationReq")
+	proto.RegisterType((*ExplainAuthorizationResp)(nil), "chef.automate.domain.authz.v2.ExplainAuthorizationResp")
+	proto.RegisterType((*ExplainedStatement)(nil), "chef.automate.domain.authz.v2.ExplainedStatement")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -415,6 +645,7 @@ type AuthorizationClient interface {
 	FilterAuthorizedPairs(ctx context.Context, in *FilterAuthorizedPairsReq, opts ...grpc.CallOption) (*FilterAuthorizedPairsResp, error)
 	FilterAuthorizedProjects(ctx context.Context, in *FilterAuthorizedPairsReq, opts ...grpc.CallOption) (*FilterAuthorizedProjectsResp, error)
 	ProjectsAuthorized(ctx context.Context, in *ProjectsAuthorizedReq, opts ...grpc.CallOption) (*ProjectsAuthorizedResp, error)
+	ExplainAuthorization(ctx context.Context, in *ExplainAuthorizationReq, opts ...grpc.CallOption) (*ExplainAuthorizationResp, error)
 }
 
 type authorizationClient struct {
@@ -461,12 +692,22 @@ func (c *authorizationClient) ProjectsAuthorized(ctx context.Context, in *Projec
 	return out, nil
 }
 
+func (c *authorizationClient) ExplainAuthorization(ctx context.Context, in *ExplainAuthorizationReq, opts ...grpc.CallOption) (*ExplainAuthorizationResp, error) {
+	out := new(ExplainAuthorizationResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Authorization/ExplainAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthorizationServer is the server API for Authorization service.
 type AuthorizationServer interface {
 	IsAuthorized(context.Context, *IsAuthorizedReq) (*IsAuthorizedResp, error)
 	FilterAuthorizedPairs(context.Context, *FilterAuthorizedPairsReq) (*FilterAuthorizedPairsResp, error)
 	FilterAuthorizedProjects(context.Context, *FilterAuthorizedPairsReq) (*FilterAuthorizedProjectsResp, error)
 	ProjectsAuthorized(context.Context, *ProjectsAuthorizedReq) (*ProjectsAuthorizedResp, error)
+	ExplainAuthorization(context.Context, *ExplainAuthorizationReq) (*ExplainAuthorizationResp, error)
 }
 
 func RegisterAuthorizationServer(s *grpc.Server, srv AuthorizationServer) {
@@ -545,6 +786,24 @@ func _Authorization_ProjectsAuthorized_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Authorization_ExplainAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAuthorizationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).ExplainAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Authorization/ExplainAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).ExplainAuthorization(ctx, req.(*ExplainAuthorizationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Authorization_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chef.automate.domain.authz.v2.Authorization",
 	HandlerType: (*AuthorizationServer)(nil),
@@ -565,57 +824,78 @@ var _Authorization_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProjectsAuthorized",
 			Handler:    _Authorization_ProjectsAuthorized_Handler,
 		},
+		{
+			MethodName: "ExplainAuthorization",
+			Handler:    _Authorization_ExplainAuthorization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/interservice/authz/v2/authz.proto",
 }
 
 func init() {
-	proto.RegisterFile("api/interservice/authz/v2/authz.proto", fileDescriptor_authz_f1d9420b5d8a6335)
-}
-
-var fileDescriptor_authz_f1d9420b5d8a6335 = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xee, 0x24, 0xbd, 0x55, 0x3a, 0xf7, 0x5e, 0x0a, 0x53, 0x85, 0xba, 0xe9, 0x8f, 0xc2, 0x34,
-	0x20, 0x27, 0x10, 0x1b, 0x86, 0xf2, 0x53, 0x23, 0xa8, 0xda, 0x05, 0x55, 0x05, 0x12, 0x95, 0x25,
-	0xa8, 0x68, 0x95, 0xc0, 0xd4, 0x99, 0x12, 0x83, 0x13, 0xbb, 0x33, 0xe3, 0x2c, 0xd2, 0x74, 0x81,
-	0xd8, 0x22, 0x21, 0xe5, 0x01, 0x78, 0x02, 0x9e, 0x80, 0x15, 0x6b, 0xde, 0x84, 0x2d, 0x4f, 0x80,
-	0xec, 0xd8, 0x69, 0xda, 0x24, 0x44, 0xed, 0x8a, 0x05, 0xbb, 0xf1, 0xf1, 0x7c, 0xdf, 0x39, 0xe7,
-	0xfb, 0xce, 0xcc, 0xc0, 0xab, 0xd4, 0xb3, 0x75, 0xbb, 0x2e, 0x19, 0x17, 0x8c, 0x37, 0x6c, 0x8b,
-	0xe9, 0xd4, 0x97, 0xd5, 0xa6, 0xde, 0x20, 0x9d, 0x85, 0xe6, 0x71, 0x57, 0xba, 0x68, 0xc1, 0xaa,
-	0xb2, 0x7d, 0x8d, 0xfa, 0xd2, 0xad, 0x51, 0xc9, 0xb4, 0x8a, 0x5b, 0xa3, 0x76, 0x5d, 0xeb, 0xec,
-	0x68, 0x90, 0xcc, 0x4c, 0x83, 0x3a, 0x76, 0x85, 0x4a, 0xa6, 0xc7, 0x8b, 0x0e, 0x0e, 0x7f, 0x4e,
-	0xc0, 0xa9, 0x4d, 0xb1, 0xe6, 0xcb, 0xaa, 0xcb, 0xed, 0x26, 0xab, 0x98, 0xec, 0x00, 0xbd, 0x07,
-	0x30, 0x25, 0xfc, 0xbd, 0xb7, 0xcc, 0x92, 0x42, 0x01, 0xd9, 0xa4, 0x3a, 0xb9, 0xce, 0xbe, 0xfe,
-	0xf8, 0x96, 0x7c, 0xdd, 0x06, 0xa5, 0x14, 0xc0, 0x2f, 0xf9, 0x36, 0x79, 0x5e, 0x56, 0x57, 0x0d,
-	0xc9, 0x68, 0xad, 0xe5, 0x0b, 0xc6, 0xf3, 0x86, 0xba, 0x6a, 0x38, 0xae, 0x45, 0x9d, 0x96, 0x53,
-	0xa1, 0x5e, 0x4b, 0xd0, 0x9a, 0x93, 0x37, 0x76, 0xcb, 0x46, 0xa1, 0x74, 0x3d, 0xd7, 0x2a, 0x4b,
-	0xf7, 0x1d, 0xab, 0xf7, 0x7c, 0x3a, 0xc2, 0x88, 0x7a, 0x89, 0x82, 0xf1, 0x3f, 0xb3, 0x9b, 0x16,
-	0x3d, 0x82, 0x29, 0xce, 0x84, 0xeb, 0x73, 0x8b, 0x29, 0x89, 0x2c, 0x50, 0x27, 0xd7, 0x71, 0x50,
-	0xc2, 0x02, 0x9f, 0x23, 0xb3, 0xe5, 0x5d, 0x5a, 0x6c, 0x96, 0x42, 0x4c, 0x41, 0x5d, 0x35, 0x22,
-	0x74, 0xbe, 0x90, 0x33, 0xbb, 0x18, 0xb4, 0x01, 0x27, 0xa8, 0x25, 0x6d, 0xb7, 0xae, 0x24, 0x43,
-	0xb4, 0x1e, 0xa0, 0x0b, 0x5c, 0x25, 0xd7, 0x22, 0x34, 0x2d, 0x36, 0xd7, 0x8a, 0x3b, 0x11, 0xc1,
-	0x89, 0x48, 0xfe, 0x90, 0x1c, 0xe5, 0xcc, 0x08, 0x8e, 0x09, 0xbc, 0x78, 0x52, 0x1f, 0xe1, 0xa1,
-	0x45, 0x08, 0x69, 0x37, 0xa2, 0x80, 0x2c, 0x50, 0x53, 0x66, 0x4f, 0x04, 0xff, 0x4c, 0xc0, 0xf4,
-	0x16, 0x77, 0xc3, 0x4e, 0xfe, 0x4a, 0x3b, 0x4c, 0x5a, 0xf4, 0x14, 0x4e, 0x79, 0x91, 0x4a, 0xaf,
-	0xf6, 0x6d, 0x47, 0x32, 0xae, 0x8c, 0x87, 0x92, 0x2c, 0x05, 0x8c, 0x8b, 0x6d, 0x30, 0xa7, 0x00,
-	0x3c, 0xc3, 0xd3, 0x64, 0x3a, 0x24, 0xbe, 0x59, 0x5c, 0x51, 0xf3, 0xc5, 0xd2, 0xe1, 0xad, 0x1b,
-	0x77, 0x97, 0x8f, 0x72, 0xe6, 0x85, 0x18, 0xfb, 0x38, 0x84, 0xe2, 0x6d, 0x78, 0x79, 0x90, 0xe6,
-	0xc2, 0x43, 0x0f, 0x61, 0x2a, 0xde, 0x1b, 0x69, 0x7e, 0x25, 0x48, 0x30, 0xdf, 0x06, 0xb3, 0x0a,
-	0xc0, 0x69, 0x3e, 0x4d, 0x2e, 0xc5, 0x09, 0x8e, 0xe9, 0xbb, 0x10, 0xfc, 0x1d, 0x40, 0xa5, 0x93,
-	0xe3, 0x98, 0x77, 0x8b, 0xda, 0x5c, 0x04, 0x86, 0x8a, 0x3e, 0x3f, 0xb7, 0x03, 0x6e, 0xb3, 0x0d,
-	0x9e, 0xa5, 0x00, 0x7e, 0xc2, 0x37, 0xc9, 0x46, 0xe0, 0xe7, 0x48, 0x4b, 0x5b, 0xa1, 0x93, 0xad,
-	0x7e, 0x03, 0xf3, 0x03, 0x1c, 0x5c, 0x81, 0xff, 0x78, 0x41, 0x01, 0x4a, 0x22, 0x9b, 0x54, 0xff,
-	0x25, 0x4b, 0xda, 0x6f, 0x0f, 0xbf, 0x16, 0x14, 0x6b, 0x76, 0x10, 0xf8, 0x05, 0x9c, 0x1d, 0xd2,
-	0x8b, 0xf0, 0x8e, 0x79, 0xc1, 0x99, 0x79, 0x0d, 0x38, 0xdf, 0xc7, 0x1b, 0x09, 0x18, 0x52, 0x67,
-	0x4e, 0x7b, 0xd0, 0x23, 0xf0, 0x27, 0x00, 0xc7, 0x03, 0xae, 0x3f, 0x66, 0x32, 0xc9, 0x97, 0x71,
-	0xf8, 0x7f, 0xdc, 0x08, 0x0d, 0x67, 0xf5, 0x00, 0xfe, 0xd7, 0x7b, 0x0d, 0x20, 0x6d, 0x84, 0x36,
-	0xa7, 0xee, 0xd4, 0x8c, 0x7e, 0xa6, 0xfd, 0xc2, 0xc3, 0x63, 0xe8, 0x23, 0x80, 0xe9, 0x81, 0x5e,
-	0xa1, 0x7b, 0x23, 0xc8, 0x86, 0x4d, 0x6b, 0xe6, 0xfe, 0xf9, 0x80, 0x61, 0x39, 0xed, 0x41, 0xc7,
-	0x20, 0xb2, 0xf0, 0xfc, 0x15, 0x3d, 0x38, 0x2b, 0xb0, 0x67, 0xa8, 0xf0, 0x18, 0xfa, 0x00, 0x20,
-	0xea, 0x3f, 0xf5, 0x68, 0x79, 0xd4, 0xe4, 0x0e, 0xba, 0x9c, 0x33, 0x77, 0xce, 0x81, 0x0a, 0xaa,
-	0x58, 0x5f, 0xde, 0x21, 0x6f, 0x6c, 0x59, 0xf5, 0xf7, 0x34, 0xcb, 0xad, 0xe9, 0x01, 0x89, 0x1e,
-	0x93, 0xe8, 0x43, 0x9f, 0xef, 0xbd, 0x89, 0xf0, 0x05, 0xbe, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xce, 0x06, 0x4f, 0xc8, 0xe2, 0x07, 0x00, 0x00,
+	proto.RegisterFile("api/interservice/authz/v2/authz.proto", fileDescriptor_authz_ecc6ff788a4727be)
+}
+
+var fileDescriptor_authz_ecc6ff788a4727be = []byte{
+	// 930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xd6, 0xc4, 0x4e, 0xb0, 0x27, 0x85, 0xc2, 0x94, 0x90, 0x8d, 0xd3, 0x16, 0x77, 0x9b, 0x56,
+	0xeb, 0x80, 0x77, 0xe9, 0x12, 0x5a, 0xba, 0x08, 0xa2, 0x44, 0xa2, 0x28, 0x12, 0x87, 0xb2, 0xa0,
+	0x82, 0x52, 0xc5, 0xd1, 0x64, 0x3d, 0x49, 0x16, 0xd6, 0xde, 0xe9, 0xcc, 0x6c, 0x44, 0xd2, 0xed,
+	0xa1, 0x5c, 0x90, 0xb8, 0x80, 0x22, 0x8e, 0x88, 0x3b, 0x12, 0xe2, 0x07, 0x70, 0xe2, 0x67, 0xf0,
+	0x17, 0xf8, 0x05, 0x5c, 0xd1, 0xcc, 0x7e, 0xd8, 0xeb, 0x0f, 0x8c, 0x7d, 0xe2, 0x90, 0x9b, 0xf7,
+	0xdd, 0xf7, 0x79, 0xe6, 0x9d, 0xf7, 0x79, 0x3f, 0xd6, 0xf0, 0x16, 0xa6, 0xbe, 0xe5, 0x77, 0x05,
+	0x61, 0x9c, 0xb0, 0x13, 0xdf, 0x23, 0x16, 0x8e, 0xc4, 0xf1, 0x99, 0x75, 0x62, 0x27, 0x3f, 0x4c,
+	0xca, 0x42, 0x11, 0xa2, 0x6b, 0xde, 0x31, 0x39, 0x34, 0x71, 0x24, 0xc2, 0x0e, 0x16, 0xc4, 0x6c,
+	0x87, 0x1d, 0xec, 0x77, 0xcd, 0xc4, 0xe3, 0xc4, 0xae, 0x2d, 0x9f, 0xe0, 0xc0, 0x6f, 0x63, 0x41,
+	0xac, 0xec, 0x47, 0x82, 0xab, 0xdd, 0x1e, 0x4f, 0x4f, 0xc3, 0xc0, 0xf7, 0x4e, 0x53, 0xbf, 0xd7,
+	0x8f, 0xc2, 0xf0, 0x28, 0x20, 0x96, 0x7a, 0x3a, 0x88, 0x0e, 0x2d, 0xe1, 0x77, 0x08, 0x17, 0xb8,
+	0x43, 0x13, 0x07, 0xfd, 0xcf, 0x39, 0x78, 0x79, 0x87, 0x6f, 0x45, 0xe2, 0x38, 0x64, 0xfe, 0x19,
+	0x69, 0xbb, 0xe4, 0x09, 0xfa, 0x19, 0xc0, 0x0a, 0x8f, 0x0e, 0xbe, 0x24, 0x9e, 0xe0, 0x1a, 0xa8,
+	0x97, 0x8c, 0xea, 0xf6, 0x73, 0xf0, 0xfb, 0x5f, 0x7f, 0x94, 0xe2, 0x73, 0x70, 0x5a, 0x01, 0x7a,
+	0xc4, 0xb8, 0xfd, 0xa4, 0x65, 0x6c, 0x3a, 0x82, 0xe0, 0x4e, 0x1c, 0x71, 0xc2, 0x1a, 0x8e, 0xb1,
+	0xe9, 0x04, 0xa1, 0x87, 0x83, 0x38, 0x68, 0x63, 0x1a, 0x73, 0xdc, 0x09, 0x1a, 0xce, 0xe3, 0x96,
+	0xb3, 0xbe, 0xf7, 0xc6, 0x5a, 0xdc, 0x92, 0x7e, 0x8e, 0x47, 0x98, 0xe8, 0x99, 0x24, 0x3a, 0xfc,
+	0x8a, 0x74, 0x63, 0x69, 0xee, 0x77, 0x0d, 0xb8, 0x93, 0x5e, 0x29, 0x35, 0x66, 0xef, 0xdc, 0x3c,
+	0x26, 0xf4, 0x01, 0xac, 0x30, 0xc2, 0xc3, 0x88, 0x79, 0x44, 0x9b, 0xab, 0x03, 0xa3, 0xba, 0xad,
+	0xcb, 0xf0, 0xae, 0xb1, 0x55, 0x7b, 0xa5, 0xf5, 0x18, 0x37, 0xcf, 0xf6, 0x14, 0x66, 0xdd, 0xd8,
+	0x74, 0x52, 0x74, 0x63, 0x7d, 0xcd, 0xcd, 0x31, 0xe8, 0x23, 0xb8, 0x80, 0x3d, 0xe1, 0x87, 0x5d,
+	0xad, 0xa4, 0xd0, 0x96, 0x44, 0xaf, 0x33, 0xc3, 0xbe, 0x9d, 0xa2, 0x71, 0xf3, 0x6c, 0xab, 0xb9,
+	0x9b, 0x12, 0x14, 0x2c, 0x8d, 0xa7, 0xf6, 0xb3, 0x35, 0x37, 0x85, 0xa3, 0x55, 0x58, 0x4d, 0x28,
+	0xf7, 0x7d, 0xaa, 0x95, 0x25, 0x97, 0x5b, 0x49, 0x0c, 0x3b, 0x54, 0xb7, 0xe1, 0xcb, 0xc5, 0xcc,
+	0x72, 0x8a, 0xae, 0x43, 0x88, 0x73, 0x8b, 0x06, 0xea, 0xc0, 0xa8, 0xb8, 0x7d, 0x16, 0xfd, 0xd7,
+	0x12, 0x5c, 0x7a, 0xc8, 0x42, 0x75, 0xcd, 0x0b, 0x51, 0x66, 0x13, 0xe5, 0x63, 0x78, 0x99, 0xa6,
+	0x29, 0xdc, 0x3f, 0xf4, 0x03, 0x41, 0x98, 0x56, 0x56, 0xf9, 0xba, 0x29, 0x19, 0xaf, 0x9f, 0x83,
+	0x55, 0x0d, 0xe8, 0xcb, 0x6c, 0xc9, 0xbe, 0xa2, 0x88, 0xdf, 0x6a, 0xde, 0x37, 0x1a, 0xcd, 0xbd,
+	0xa7, 0x77, 0xde, 0xbc, 0xbb, 0xf1, 0x6c, 0xcd, 0x7d, 0x29, 0xc3, 0x3e, 0x50, 0xd0, 0xa2, 0xc4,
+	0xf3, 0x03, 0x12, 0x7f, 0x0e, 0x5f, 0x1b, 0xa5, 0x16, 0xa7, 0xe8, 0x7d, 0x58, 0xc9, 0x88, 0x52,
+	0xb5, 0x6e, 0xc8, 0xd3, 0xaf, 0x9e, 0x83, 0x15, 0x0d, 0xe8, 0x4b, 0xec, 0x8a, 0xfd, 0x4a, 0x76,
+	0x7a, 0xef, 0xec, 0x1c, 0xa2, 0xff, 0x0d, 0xa0, 0x96, 0x04, 0xd0, 0xe3, 0x7d, 0x88, 0x7d, 0xc6,
+	0x65, 0x29, 0x7c, 0x33, 0x5c, 0x0a, 0x87, 0x92, 0x1c, 0x9f, 0x83, 0x56, 0x05, 0xe8, 0xbb, 0xec,
+	0x0b, 0xfb, 0x91, 0xd4, 0x72, 0x62, 0x31, 0xc4, 0x79, 0x0d, 0xc4, 0x3d, 0xdd, 0xe3, 0x61, 0xb5,
+	0x1b, 0x23, 0xe4, 0xbe, 0x0f, 0xe7, 0xa9, 0x0c, 0x48, 0x9b, 0xab, 0x97, 0x8c, 0x45, 0xfb, 0xa6,
+	0xf9, 0xaf, 0x93, 0xcc, 0x94, 0xc1, 0xbb, 0x09, 0xa2, 0x98, 0xd2, 0xd2, 0x40, 0x4a, 0x1f, 0xc1,
+	0x95, 0x31, 0x17, 0xe7, 0xb4, 0x77, 0x28, 0x98, 0xf6, 0x50, 0xdd, 0x81, 0x57, 0x87, 0x78, 0xd3,
+	0x6c, 0x2b, 0xea, 0xda, 0xa0, 0x60, 0x7d, 0x6a, 0x7c, 0x0f, 0x60, 0x59, 0x72, 0xfd, 0x6f, 0x6a,
+	0x5c, 0xff, 0xb1, 0x0c, 0x97, 0x3f, 0xfc, 0x9a, 0x06, 0xd8, 0xef, 0x66, 0xf7, 0xc1, 0xf2, 0xc5,
+	0xc5, 0xa4, 0x98, 0x66, 0x52, 0x6c, 0xf6, 0x69, 0x3e, 0xc5, 0x88, 0xc8, 0x41, 0x68, 0x0b, 0x56,
+	0xd4, 0xba, 0xf5, 0x09, 0xd7, 0xe6, 0x55, 0x49, 0xde, 0x9a, 0x54, 0x92, 0x6a, 0x3b, 0xbb, 0x39,
+	0xac, 0xd8, 0x0c, 0x0b, 0xc5, 0x66, 0x40, 0x26, 0x2c, 0xcb, 0x85, 0xad, 0xbd, 0x50, 0x07, 0xc6,
+	0xa2, 0x5d, 0x33, 0x93, 0x6d, 0x6e, 0x66, 0xdb, 0xdc, 0xfc, 0x2c, 0xdb, 0xe6, 0xae, 0xf2, 0xd3,
+	0x7f, 0x01, 0x50, 0x1b, 0x5d, 0x16, 0x93, 0x77, 0x4f, 0xa1, 0x03, 0xe6, 0x8a, 0x1d, 0x80, 0x3e,
+	0x81, 0x90, 0x0b, 0x2c, 0x48, 0x87, 0x74, 0x05, 0xd7, 0x4a, 0xea, 0xaa, 0x77, 0x26, 0x5c, 0x35,
+	0x0d, 0x84, 0xb4, 0x3f, 0xcd, 0x90, 0x6e, 0x1f, 0x89, 0xfe, 0x13, 0x80, 0x68, 0xd8, 0x45, 0xe6,
+	0x23, 0xf9, 0x82, 0xd9, 0xf7, 0x93, 0x20, 0xab, 0x69, 0xb2, 0x4e, 0x77, 0xda, 0xe8, 0x06, 0xbc,
+	0x94, 0x33, 0xc8, 0xf7, 0xaa, 0x7a, 0xdc, 0xc5, 0xdc, 0xb6, 0xd3, 0x46, 0x0f, 0x60, 0x35, 0x7f,
+	0x54, 0xf5, 0xb1, 0x68, 0x1b, 0x13, 0x02, 0xed, 0xc5, 0xd7, 0x83, 0xda, 0xbf, 0xcd, 0xc3, 0x17,
+	0x0b, 0x39, 0x44, 0x21, 0xbc, 0xd4, 0xbf, 0xcf, 0x91, 0x39, 0x81, 0x76, 0xe0, 0xb3, 0xaa, 0x66,
+	0x4d, 0xe5, 0xcf, 0x29, 0xfa, 0x0e, 0xc0, 0xa5, 0x91, 0xb3, 0x10, 0xdd, 0x9b, 0x40, 0x35, 0x6e,
+	0x75, 0xd4, 0xde, 0x9d, 0x0d, 0xc8, 0x29, 0xfa, 0x61, 0xd4, 0x46, 0xca, 0xca, 0x63, 0xe6, 0x78,
+	0xde, 0x9b, 0x16, 0xd8, 0x3f, 0xb2, 0x9f, 0x03, 0x88, 0x86, 0xd7, 0x2f, 0xda, 0x98, 0xd4, 0x82,
+	0xa3, 0xbe, 0xaf, 0x6a, 0xef, 0xcc, 0x80, 0xe2, 0x14, 0x7d, 0x0b, 0xe0, 0xab, 0xa3, 0x3a, 0x0e,
+	0xdd, 0xfd, 0x6f, 0xdd, 0x31, 0x38, 0xbd, 0x6b, 0xf7, 0x66, 0xc2, 0x71, 0xba, 0xbd, 0xb1, 0x6b,
+	0x1f, 0xf9, 0xe2, 0x38, 0x3a, 0x30, 0xbd, 0xb0, 0x63, 0x49, 0x12, 0x2b, 0x23, 0xb1, 0xc6, 0xfe,
+	0x5b, 0x38, 0x58, 0x50, 0xb3, 0xe4, 0xed, 0x7f, 0x06, 0x00, 0x9c, 0xbd, 0x3e, 0x02, 0xb0, 0x0c,
+	0x00, 0x00,
 }
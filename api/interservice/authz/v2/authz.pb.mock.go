@@ -33,6 +33,7 @@ type AuthorizationServerMock struct {
 	FilterAuthorizedPairsFunc    func(context.Context, *FilterAuthorizedPairsReq) (*FilterAuthorizedPairsResp, error)
 	FilterAuthorizedProjectsFunc func(context.Context, *FilterAuthorizedPairsReq) (*FilterAuthorizedProjectsResp, error)
 	ProjectsAuthorizedFunc       func(context.Context, *ProjectsAuthorizedReq) (*ProjectsAuthorizedResp, error)
+	ExplainAuthorizationFunc     func(context.Context, *ExplainAuthorizationReq) (*ExplainAuthorizationResp, error)
 }
 
 func (m *AuthorizationServerMock) IsAuthorized(ctx context.Context, req *IsAuthorizedReq) (*IsAuthorizedResp, error) {
@@ -83,10 +84,23 @@ func (m *AuthorizationServerMock) ProjectsAuthorized(ctx context.Context, req *P
 	return nil, status.Error(codes.Internal, "mock: 'ProjectsAuthorized' not implemented")
 }
 
+func (m *AuthorizationServerMock) ExplainAuthorization(ctx context.Context, req *ExplainAuthorizationReq) (*ExplainAuthorizationResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ExplainAuthorizationFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ExplainAuthorization' not implemented")
+}
+
 // Reset resets all overridden functions
 func (m *AuthorizationServerMock) Reset() {
 	m.IsAuthorizedFunc = nil
 	m.FilterAuthorizedPairsFunc = nil
 	m.FilterAuthorizedProjectsFunc = nil
 	m.ProjectsAuthorizedFunc = nil
+	m.ExplainAuthorizationFunc = nil
 }
@@ -54,7 +54,7 @@ func (m *IsAuthorizedReq) Validate() error {
 		if !_IsAuthorizedReq_Subjects_Pattern.MatchString(item) {
 			return IsAuthorizedReqValidationError{
 				field:  fmt.Sprintf("Subjects[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):[^:*]+$|^token:[^:*]+$|^tls:service:[^:*]+:[^:*]+$\"",
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):[^:*]+$|^team:cert:[^:*]+$|^(?:token|cert):[^:*]+$|^tls:service:[^:*]+:[^:*]+$\"",
 			}
 		}
 
@@ -74,6 +74,8 @@ func (m *IsAuthorizedReq) Validate() error {
 		}
 	}
 
+	// no validation rules for SourceIp
+
 	return nil
 }
 
@@ -131,7 +133,7 @@ var _ interface {
 	ErrorName() string
 } = IsAuthorizedReqValidationError{}
 
-var _IsAuthorizedReq_Subjects_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):[^:*]+$|^token:[^:*]+$|^tls:service:[^:*]+:[^:*]+$")
+var _IsAuthorizedReq_Subjects_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):[^:*]+$|^team:cert:[^:*]+$|^(?:token|cert):[^:*]+$|^tls:service:[^:*]+:[^:*]+$")
 
 var _IsAuthorizedReq_Resource_Pattern = regexp.MustCompile("^[a-z][^:*]*(?::[^:*]+)*$")
 
@@ -225,7 +227,7 @@ func (m *ProjectsAuthorizedReq) Validate() error {
 		if !_ProjectsAuthorizedReq_Subjects_Pattern.MatchString(item) {
 			return ProjectsAuthorizedReqValidationError{
 				field:  fmt.Sprintf("Subjects[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):[^:*]+$|^token:[^:*]+$|^tls:service:[^:*]+:[^:*]+$\"",
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):[^:*]+$|^team:cert:[^:*]+$|^(?:token|cert):[^:*]+$|^tls:service:[^:*]+:[^:*]+$\"",
 			}
 		}
 
@@ -268,6 +270,8 @@ func (m *ProjectsAuthorizedReq) Validate() error {
 
 	}
 
+	// no validation rules for SourceIp
+
 	return nil
 }
 
@@ -327,7 +331,7 @@ var _ interface {
 	ErrorName() string
 } = ProjectsAuthorizedReqValidationError{}
 
-var _ProjectsAuthorizedReq_Subjects_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):[^:*]+$|^token:[^:*]+$|^tls:service:[^:*]+:[^:*]+$")
+var _ProjectsAuthorizedReq_Subjects_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):[^:*]+$|^team:cert:[^:*]+$|^(?:token|cert):[^:*]+$|^tls:service:[^:*]+:[^:*]+$")
 
 var _ProjectsAuthorizedReq_Resource_Pattern = regexp.MustCompile("^[a-z][^:*]*(?::[^:*]+)*$")
 
@@ -448,7 +452,7 @@ func (m *FilterAuthorizedPairsReq) Validate() error {
 		if !_FilterAuthorizedPairsReq_Subjects_Pattern.MatchString(item) {
 			return FilterAuthorizedPairsReqValidationError{
 				field:  fmt.Sprintf("Subjects[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:(?:team|user):(?:local|ldap|saml)|token|tls:service:[^:*]+):[^:*]+$\"",
+				reason: "value does not match regex pattern \"^(?:(?:team|user):(?:local|ldap|saml)|team:cert|token|cert|tls:service:[^:*]+):[^:*]+$\"",
 			}
 		}
 
@@ -469,6 +473,8 @@ func (m *FilterAuthorizedPairsReq) Validate() error {
 
 	}
 
+	// no validation rules for SourceIp
+
 	return nil
 }
 
@@ -528,7 +534,7 @@ var _ interface {
 	ErrorName() string
 } = FilterAuthorizedPairsReqValidationError{}
 
-var _FilterAuthorizedPairsReq_Subjects_Pattern = regexp.MustCompile("^(?:(?:team|user):(?:local|ldap|saml)|token|tls:service:[^:*]+):[^:*]+$")
+var _FilterAuthorizedPairsReq_Subjects_Pattern = regexp.MustCompile("^(?:(?:team|user):(?:local|ldap|saml)|team:cert|token|cert|tls:service:[^:*]+):[^:*]+$")
 
 // Validate checks the field values on FilterAuthorizedPairsResp with the rules
 // defined in the proto definition for this message. If any rules are
@@ -761,3 +767,326 @@ var _ interface {
 var _Pair_Resource_Pattern = regexp.MustCompile("^[a-z][^:*]*(?::[^:*]+)*$")
 
 var _Pair_Action_Pattern = regexp.MustCompile("^[a-z][a-zA-Z]*(?::[a-z][a-zA-Z]*){2}$")
+
+// Validate checks the field values on ExplainAuthorizationReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExplainAuthorizationReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetSubjects()) < 1 {
+		return ExplainAuthorizationReqValidationError{
+			field:  "Subjects",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if !_ExplainAuthorizationReq_Subjects_Pattern.MatchString(item) {
+			return ExplainAuthorizationReqValidationError{
+				field:  fmt.Sprintf("Subjects[%v]", idx),
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):[^:*]+$|^team:cert:[^:*]+$|^(?:token|cert):[^:*]+$|^tls:service:[^:*]+:[^:*]+$\"",
+			}
+		}
+
+	}
+
+	if !_ExplainAuthorizationReq_Resource_Pattern.MatchString(m.GetResource()) {
+		return ExplainAuthorizationReqValidationError{
+			field:  "Resource",
+			reason: "value does not match regex pattern \"^[a-z][^:*]*(?::[^:*]+)*$\"",
+		}
+	}
+
+	if !_ExplainAuthorizationReq_Action_Pattern.MatchString(m.GetAction()) {
+		return ExplainAuthorizationReqValidationError{
+			field:  "Action",
+			reason: "value does not match regex pattern \"^[a-z][a-zA-Z]*(?::[a-z][a-zA-Z]*){2}$\"",
+		}
+	}
+
+	_ExplainAuthorizationReq_Projects_Unique := make(map[string]struct{}, len(m.GetProjects()))
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if _, exists := _ExplainAuthorizationReq_Projects_Unique[item]; exists {
+			return ExplainAuthorizationReqValidationError{
+				field:  fmt.Sprintf("Projects[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_ExplainAuthorizationReq_Projects_Unique[item] = struct{}{}
+		}
+
+		if !_ExplainAuthorizationReq_Projects_Pattern.MatchString(item) {
+			return ExplainAuthorizationReqValidationError{
+				field:  fmt.Sprintf("Projects[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-z0-9()-]{1,64}$\"",
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplainAuthorizationReqValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SourceIp
+
+	if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainAuthorizationReqValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExplainAuthorizationReqValidationError is the validation error returned by
+// ExplainAuthorizationReq.Validate if the designated constraints aren't met.
+type ExplainAuthorizationReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainAuthorizationReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainAuthorizationReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainAuthorizationReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainAuthorizationReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainAuthorizationReqValidationError) ErrorName() string {
+	return "ExplainAuthorizationReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainAuthorizationReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainAuthorizationReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainAuthorizationReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainAuthorizationReqValidationError{}
+
+var _ExplainAuthorizationReq_Subjects_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):[^:*]+$|^team:cert:[^:*]+$|^(?:token|cert):[^:*]+$|^tls:service:[^:*]+:[^:*]+$")
+
+var _ExplainAuthorizationReq_Resource_Pattern = regexp.MustCompile("^[a-z][^:*]*(?::[^:*]+)*$")
+
+var _ExplainAuthorizationReq_Action_Pattern = regexp.MustCompile("^[a-z][a-zA-Z]*(?::[a-z][a-zA-Z]*){2}$")
+
+var _ExplainAuthorizationReq_Projects_Pattern = regexp.MustCompile("^[a-z0-9()-]{1,64}$")
+
+// Validate checks the field values on ExplainAuthorizationResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExplainAuthorizationResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Authorized
+
+	for idx, item := range m.GetStatements() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExplainAuthorizationRespValidationError{
+					field:  fmt.Sprintf("Statements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ExplainAuthorizationRespValidationError is the validation error returned by
+// ExplainAuthorizationResp.Validate if the designated constraints aren't met.
+type ExplainAuthorizationRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainAuthorizationRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainAuthorizationRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainAuthorizationRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainAuthorizationRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainAuthorizationRespValidationError) ErrorName() string {
+	return "ExplainAuthorizationRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainAuthorizationRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainAuthorizationResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainAuthorizationRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainAuthorizationRespValidationError{}
+
+// Validate checks the field values on ExplainedStatement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExplainedStatement) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for PolicyId
+
+	// no validation rules for StatementId
+
+	if v, ok := interface{}(m.GetStatement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExplainedStatementValidationError{
+				field:  "Statement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ExplainedStatementValidationError is the validation error returned by
+// ExplainedStatement.Validate if the designated constraints aren't met.
+type ExplainedStatementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExplainedStatementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExplainedStatementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExplainedStatementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExplainedStatementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExplainedStatementValidationError) ErrorName() string {
+	return "ExplainedStatementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExplainedStatementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExplainedStatement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExplainedStatementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExplainedStatementValidationError{}
@@ -6,6 +6,7 @@ package v2 // import "github.com/chef/automate/api/interservice/authz/v2"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "github.com/lyft/protoc-gen-validate/validate"
 
 import (
@@ -44,7 +45,7 @@ func (x Flag) String() string {
 	return proto.EnumName(Flag_name, int32(x))
 }
 func (Flag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{0}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{0}
 }
 
 type Statement_Effect int32
@@ -67,7 +68,7 @@ func (x Statement_Effect) String() string {
 	return proto.EnumName(Statement_Effect_name, int32(x))
 }
 func (Statement_Effect) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{5, 0}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{5, 0}
 }
 
 type Version_VersionNumber int32
@@ -93,7 +94,37 @@ func (x Version_VersionNumber) String() string {
 	return proto.EnumName(Version_VersionNumber_name, int32(x))
 }
 func (Version_VersionNumber) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{14, 0}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{16, 0}
+}
+
+type StateChange_Operation int32
+
+const (
+	StateChange_CREATE StateChange_Operation = 0
+	StateChange_UPDATE StateChange_Operation = 1
+	StateChange_DELETE StateChange_Operation = 2
+	// the change was not applied since the object is chef-managed
+	StateChange_SKIP StateChange_Operation = 3
+)
+
+var StateChange_Operation_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "DELETE",
+	3: "SKIP",
+}
+var StateChange_Operation_value = map[string]int32{
+	"CREATE": 0,
+	"UPDATE": 1,
+	"DELETE": 2,
+	"SKIP":   3,
+}
+
+func (x StateChange_Operation) String() string {
+	return proto.EnumName(StateChange_Operation_name, int32(x))
+}
+func (StateChange_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{40, 0}
 }
 
 type Policy struct {
@@ -112,7 +143,7 @@ func (m *Policy) Reset()         { *m = Policy{} }
 func (m *Policy) String() string { return proto.CompactTextString(m) }
 func (*Policy) ProtoMessage()    {}
 func (*Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{0}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{0}
 }
 func (m *Policy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Policy.Unmarshal(m, b)
@@ -189,7 +220,7 @@ func (m *Role) Reset()         { *m = Role{} }
 func (m *Role) String() string { return proto.CompactTextString(m) }
 func (*Role) ProtoMessage()    {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{1}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{1}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Role.Unmarshal(m, b)
@@ -259,7 +290,7 @@ func (m *CreatePolicyReq) Reset()         { *m = CreatePolicyReq{} }
 func (m *CreatePolicyReq) String() string { return proto.CompactTextString(m) }
 func (*CreatePolicyReq) ProtoMessage()    {}
 func (*CreatePolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{2}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{2}
 }
 func (m *CreatePolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreatePolicyReq.Unmarshal(m, b)
@@ -325,7 +356,7 @@ func (m *DeletePolicyReq) Reset()         { *m = DeletePolicyReq{} }
 func (m *DeletePolicyReq) String() string { return proto.CompactTextString(m) }
 func (*DeletePolicyReq) ProtoMessage()    {}
 func (*DeletePolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{3}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{3}
 }
 func (m *DeletePolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeletePolicyReq.Unmarshal(m, b)
@@ -362,7 +393,7 @@ func (m *DeletePolicyResp) Reset()         { *m = DeletePolicyResp{} }
 func (m *DeletePolicyResp) String() string { return proto.CompactTextString(m) }
 func (*DeletePolicyResp) ProtoMessage()    {}
 func (*DeletePolicyResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{4}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{4}
 }
 func (m *DeletePolicyResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeletePolicyResp.Unmarshal(m, b)
@@ -389,18 +420,20 @@ type Statement struct {
 	// this RE means:  * OR *:verb OR svc:type:verb OR svc:* OR svc:*:verb OR svc:type:*
 	Actions []string `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty" toml:"actions,omitempty" mapstructure:"actions,omitempty"`
 	// references
-	Role                 string   `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty" toml:"role,omitempty" mapstructure:"role,omitempty"`
-	Projects             []string `protobuf:"bytes,5,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	Role     string   `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty" toml:"role,omitempty" mapstructure:"role,omitempty"`
+	Projects []string `protobuf:"bytes,5,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	// optional restrictions on when the statement applies
+	Conditions           *Conditions `protobuf:"bytes,6,opt,name=conditions,proto3" json:"conditions,omitempty" toml:"conditions,omitempty" mapstructure:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte      `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32       `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
 func (m *Statement) Reset()         { *m = Statement{} }
 func (m *Statement) String() string { return proto.CompactTextString(m) }
 func (*Statement) ProtoMessage()    {}
 func (*Statement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{5}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{5}
 }
 func (m *Statement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Statement.Unmarshal(m, b)
@@ -455,6 +488,118 @@ func (m *Statement) GetProjects() []string {
 	return nil
 }
 
+func (m *Statement) GetConditions() *Conditions {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+// Conditions restrict when a statement applies: it only applies if all of them
+// are met. An empty list means no restriction.
+type Conditions struct {
+	// the request has to be made in one of these
+	TimeWindows []*TimeWindow `protobuf:"bytes,1,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows,omitempty" toml:"time_windows,omitempty" mapstructure:"time_windows,omitempty"`
+	// CIDRs; the request's client address has to be in one of these
+	SourceIps []string `protobuf:"bytes,2,rep,name=source_ips,json=sourceIps,proto3" json:"source_ips,omitempty" toml:"source_ips,omitempty" mapstructure:"source_ips,omitempty"`
+	// the request has to include a subject of one of these types
+	SubjectTypes         []string `protobuf:"bytes,3,rep,name=subject_types,json=subjectTypes,proto3" json:"subject_types,omitempty" toml:"subject_types,omitempty" mapstructure:"subject_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *Conditions) Reset()         { *m = Conditions{} }
+func (m *Conditions) String() string { return proto.CompactTextString(m) }
+func (*Conditions) ProtoMessage()    {}
+func (*Conditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{6}
+}
+func (m *Conditions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Conditions.Unmarshal(m, b)
+}
+func (m *Conditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Conditions.Marshal(b, m, deterministic)
+}
+func (dst *Conditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Conditions.Merge(dst, src)
+}
+func (m *Conditions) XXX_Size() int {
+	return xxx_messageInfo_Conditions.Size(m)
+}
+func (m *Conditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Conditions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Conditions proto.InternalMessageInfo
+
+func (m *Conditions) GetTimeWindows() []*TimeWindow {
+	if m != nil {
+		return m.TimeWindows
+	}
+	return nil
+}
+
+func (m *Conditions) GetSourceIps() []string {
+	if m != nil {
+		return m.SourceIps
+	}
+	return nil
+}
+
+func (m *Conditions) GetSubjectTypes() []string {
+	if m != nil {
+		return m.SubjectTypes
+	}
+	return nil
+}
+
+type TimeWindow struct {
+	Start                *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty" toml:"start,omitempty" mapstructure:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty" toml:"end,omitempty" mapstructure:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{7}
+}
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeWindow.Unmarshal(m, b)
+}
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+}
+func (dst *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(dst, src)
+}
+func (m *TimeWindow) XXX_Size() int {
+	return xxx_messageInfo_TimeWindow.Size(m)
+}
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
+func (m *TimeWindow) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *TimeWindow) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
 type ListPoliciesReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -465,7 +610,7 @@ func (m *ListPoliciesReq) Reset()         { *m = ListPoliciesReq{} }
 func (m *ListPoliciesReq) String() string { return proto.CompactTextString(m) }
 func (*ListPoliciesReq) ProtoMessage()    {}
 func (*ListPoliciesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{6}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{8}
 }
 func (m *ListPoliciesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPoliciesReq.Unmarshal(m, b)
@@ -496,7 +641,7 @@ func (m *ListPoliciesResp) Reset()         { *m = ListPoliciesResp{} }
 func (m *ListPoliciesResp) String() string { return proto.CompactTextString(m) }
 func (*ListPoliciesResp) ProtoMessage()    {}
 func (*ListPoliciesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{7}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{9}
 }
 func (m *ListPoliciesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPoliciesResp.Unmarshal(m, b)
@@ -534,7 +679,7 @@ func (m *GetPolicyReq) Reset()         { *m = GetPolicyReq{} }
 func (m *GetPolicyReq) String() string { return proto.CompactTextString(m) }
 func (*GetPolicyReq) ProtoMessage()    {}
 func (*GetPolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{8}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{10}
 }
 func (m *GetPolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPolicyReq.Unmarshal(m, b)
@@ -578,7 +723,7 @@ func (m *UpdatePolicyReq) Reset()         { *m = UpdatePolicyReq{} }
 func (m *UpdatePolicyReq) String() string { return proto.CompactTextString(m) }
 func (*UpdatePolicyReq) ProtoMessage()    {}
 func (*UpdatePolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{9}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{11}
 }
 func (m *UpdatePolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePolicyReq.Unmarshal(m, b)
@@ -645,7 +790,7 @@ func (m *ReplacePolicyMembersReq) Reset()         { *m = ReplacePolicyMembersReq
 func (m *ReplacePolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*ReplacePolicyMembersReq) ProtoMessage()    {}
 func (*ReplacePolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{10}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{12}
 }
 func (m *ReplacePolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplacePolicyMembersReq.Unmarshal(m, b)
@@ -690,7 +835,7 @@ func (m *ReplacePolicyMembersResp) Reset()         { *m = ReplacePolicyMembersRe
 func (m *ReplacePolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*ReplacePolicyMembersResp) ProtoMessage()    {}
 func (*ReplacePolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{11}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{13}
 }
 func (m *ReplacePolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplacePolicyMembersResp.Unmarshal(m, b)
@@ -729,7 +874,7 @@ func (m *AddPolicyMembersReq) Reset()         { *m = AddPolicyMembersReq{} }
 func (m *AddPolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*AddPolicyMembersReq) ProtoMessage()    {}
 func (*AddPolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{12}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{14}
 }
 func (m *AddPolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddPolicyMembersReq.Unmarshal(m, b)
@@ -774,7 +919,7 @@ func (m *AddPolicyMembersResp) Reset()         { *m = AddPolicyMembersResp{} }
 func (m *AddPolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*AddPolicyMembersResp) ProtoMessage()    {}
 func (*AddPolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{13}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{15}
 }
 func (m *AddPolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddPolicyMembersResp.Unmarshal(m, b)
@@ -814,7 +959,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{14}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{16}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Version.Unmarshal(m, b)
@@ -858,7 +1003,7 @@ func (m *GetPolicyVersionReq) Reset()         { *m = GetPolicyVersionReq{} }
 func (m *GetPolicyVersionReq) String() string { return proto.CompactTextString(m) }
 func (*GetPolicyVersionReq) ProtoMessage()    {}
 func (*GetPolicyVersionReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{15}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{17}
 }
 func (m *GetPolicyVersionReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPolicyVersionReq.Unmarshal(m, b)
@@ -889,7 +1034,7 @@ func (m *GetPolicyVersionResp) Reset()         { *m = GetPolicyVersionResp{} }
 func (m *GetPolicyVersionResp) String() string { return proto.CompactTextString(m) }
 func (*GetPolicyVersionResp) ProtoMessage()    {}
 func (*GetPolicyVersionResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{16}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{18}
 }
 func (m *GetPolicyVersionResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPolicyVersionResp.Unmarshal(m, b)
@@ -926,7 +1071,7 @@ func (m *ListRolesReq) Reset()         { *m = ListRolesReq{} }
 func (m *ListRolesReq) String() string { return proto.CompactTextString(m) }
 func (*ListRolesReq) ProtoMessage()    {}
 func (*ListRolesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{17}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{19}
 }
 func (m *ListRolesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRolesReq.Unmarshal(m, b)
@@ -957,7 +1102,7 @@ func (m *ListRolesResp) Reset()         { *m = ListRolesResp{} }
 func (m *ListRolesResp) String() string { return proto.CompactTextString(m) }
 func (*ListRolesResp) ProtoMessage()    {}
 func (*ListRolesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{18}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{20}
 }
 func (m *ListRolesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRolesResp.Unmarshal(m, b)
@@ -995,7 +1140,7 @@ func (m *DeleteRoleReq) Reset()         { *m = DeleteRoleReq{} }
 func (m *DeleteRoleReq) String() string { return proto.CompactTextString(m) }
 func (*DeleteRoleReq) ProtoMessage()    {}
 func (*DeleteRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{19}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{21}
 }
 func (m *DeleteRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRoleReq.Unmarshal(m, b)
@@ -1032,7 +1177,7 @@ func (m *DeleteRoleResp) Reset()         { *m = DeleteRoleResp{} }
 func (m *DeleteRoleResp) String() string { return proto.CompactTextString(m) }
 func (*DeleteRoleResp) ProtoMessage()    {}
 func (*DeleteRoleResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{20}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{22}
 }
 func (m *DeleteRoleResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRoleResp.Unmarshal(m, b)
@@ -1066,7 +1211,7 @@ func (m *UpdateRoleReq) Reset()         { *m = UpdateRoleReq{} }
 func (m *UpdateRoleReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateRoleReq) ProtoMessage()    {}
 func (*UpdateRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{21}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{23}
 }
 func (m *UpdateRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRoleReq.Unmarshal(m, b)
@@ -1125,7 +1270,7 @@ func (m *ListPolicyMembersReq) Reset()         { *m = ListPolicyMembersReq{} }
 func (m *ListPolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyMembersReq) ProtoMessage()    {}
 func (*ListPolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{22}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{24}
 }
 func (m *ListPolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPolicyMembersReq.Unmarshal(m, b)
@@ -1163,7 +1308,7 @@ func (m *ListPolicyMembersResp) Reset()         { *m = ListPolicyMembersResp{} }
 func (m *ListPolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyMembersResp) ProtoMessage()    {}
 func (*ListPolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{23}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{25}
 }
 func (m *ListPolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPolicyMembersResp.Unmarshal(m, b)
@@ -1202,7 +1347,7 @@ func (m *RemovePolicyMembersReq) Reset()         { *m = RemovePolicyMembersReq{}
 func (m *RemovePolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*RemovePolicyMembersReq) ProtoMessage()    {}
 func (*RemovePolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{24}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{26}
 }
 func (m *RemovePolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemovePolicyMembersReq.Unmarshal(m, b)
@@ -1247,7 +1392,7 @@ func (m *RemovePolicyMembersResp) Reset()         { *m = RemovePolicyMembersResp
 func (m *RemovePolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*RemovePolicyMembersResp) ProtoMessage()    {}
 func (*RemovePolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{25}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{27}
 }
 func (m *RemovePolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemovePolicyMembersResp.Unmarshal(m, b)
@@ -1285,7 +1430,7 @@ func (m *MigrateToV2Req) Reset()         { *m = MigrateToV2Req{} }
 func (m *MigrateToV2Req) String() string { return proto.CompactTextString(m) }
 func (*MigrateToV2Req) ProtoMessage()    {}
 func (*MigrateToV2Req) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{26}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{28}
 }
 func (m *MigrateToV2Req) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MigrateToV2Req.Unmarshal(m, b)
@@ -1323,7 +1468,7 @@ func (m *MigrateToV2Resp) Reset()         { *m = MigrateToV2Resp{} }
 func (m *MigrateToV2Resp) String() string { return proto.CompactTextString(m) }
 func (*MigrateToV2Resp) ProtoMessage()    {}
 func (*MigrateToV2Resp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{27}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{29}
 }
 func (m *MigrateToV2Resp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MigrateToV2Resp.Unmarshal(m, b)
@@ -1360,7 +1505,7 @@ func (m *ResetToV1Req) Reset()         { *m = ResetToV1Req{} }
 func (m *ResetToV1Req) String() string { return proto.CompactTextString(m) }
 func (*ResetToV1Req) ProtoMessage()    {}
 func (*ResetToV1Req) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{28}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{30}
 }
 func (m *ResetToV1Req) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetToV1Req.Unmarshal(m, b)
@@ -1390,7 +1535,7 @@ func (m *ResetToV1Resp) Reset()         { *m = ResetToV1Resp{} }
 func (m *ResetToV1Resp) String() string { return proto.CompactTextString(m) }
 func (*ResetToV1Resp) ProtoMessage()    {}
 func (*ResetToV1Resp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{29}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{31}
 }
 func (m *ResetToV1Resp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetToV1Resp.Unmarshal(m, b)
@@ -1421,7 +1566,7 @@ func (m *GetRoleReq) Reset()         { *m = GetRoleReq{} }
 func (m *GetRoleReq) String() string { return proto.CompactTextString(m) }
 func (*GetRoleReq) ProtoMessage()    {}
 func (*GetRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{30}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{32}
 }
 func (m *GetRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRoleReq.Unmarshal(m, b)
@@ -1462,7 +1607,7 @@ func (m *CreateRoleReq) Reset()         { *m = CreateRoleReq{} }
 func (m *CreateRoleReq) String() string { return proto.CompactTextString(m) }
 func (*CreateRoleReq) ProtoMessage()    {}
 func (*CreateRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{31}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{33}
 }
 func (m *CreateRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRoleReq.Unmarshal(m, b)
@@ -1523,7 +1668,7 @@ func (m *PurgeSubjectFromPoliciesReq) Reset()         { *m = PurgeSubjectFromPol
 func (m *PurgeSubjectFromPoliciesReq) String() string { return proto.CompactTextString(m) }
 func (*PurgeSubjectFromPoliciesReq) ProtoMessage()    {}
 func (*PurgeSubjectFromPoliciesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{32}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{34}
 }
 func (m *PurgeSubjectFromPoliciesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurgeSubjectFromPoliciesReq.Unmarshal(m, b)
@@ -1561,7 +1706,7 @@ func (m *PurgeSubjectFromPoliciesResp) Reset()         { *m = PurgeSubjectFromPo
 func (m *PurgeSubjectFromPoliciesResp) String() string { return proto.CompactTextString(m) }
 func (*PurgeSubjectFromPoliciesResp) ProtoMessage()    {}
 func (*PurgeSubjectFromPoliciesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_18b305e788cf9d33, []int{33}
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{35}
 }
 func (m *PurgeSubjectFromPoliciesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurgeSubjectFromPoliciesResp.Unmarshal(m, b)
@@ -1588,6 +1733,257 @@ func (m *PurgeSubjectFromPoliciesResp) GetIds() []string {
 	return nil
 }
 
+type ExportStateReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ExportStateReq) Reset()         { *m = ExportStateReq{} }
+func (m *ExportStateReq) String() string { return proto.CompactTextString(m) }
+func (*ExportStateReq) ProtoMessage()    {}
+func (*ExportStateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{36}
+}
+func (m *ExportStateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportStateReq.Unmarshal(m, b)
+}
+func (m *ExportStateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportStateReq.Marshal(b, m, deterministic)
+}
+func (dst *ExportStateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportStateReq.Merge(dst, src)
+}
+func (m *ExportStateReq) XXX_Size() int {
+	return xxx_messageInfo_ExportStateReq.Size(m)
+}
+func (m *ExportStateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportStateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportStateReq proto.InternalMessageInfo
+
+type ExportStateResp struct {
+	Policies             []*Policy  `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty" toml:"policies,omitempty" mapstructure:"policies,omitempty"`
+	Roles                []*Role    `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty" toml:"roles,omitempty" mapstructure:"roles,omitempty"`
+	Projects             []*Project `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte     `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32      `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ExportStateResp) Reset()         { *m = ExportStateResp{} }
+func (m *ExportStateResp) String() string { return proto.CompactTextString(m) }
+func (*ExportStateResp) ProtoMessage()    {}
+func (*ExportStateResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{37}
+}
+func (m *ExportStateResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportStateResp.Unmarshal(m, b)
+}
+func (m *ExportStateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportStateResp.Marshal(b, m, deterministic)
+}
+func (dst *ExportStateResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportStateResp.Merge(dst, src)
+}
+func (m *ExportStateResp) XXX_Size() int {
+	return xxx_messageInfo_ExportStateResp.Size(m)
+}
+func (m *ExportStateResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportStateResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportStateResp proto.InternalMessageInfo
+
+func (m *ExportStateResp) GetPolicies() []*Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ExportStateResp) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ExportStateResp) GetProjects() []*Project {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+type ImportStateReq struct {
+	// the complete desired state: custom objects that are not included are
+	// deleted
+	Policies []*Policy  `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty" toml:"policies,omitempty" mapstructure:"policies,omitempty"`
+	Roles    []*Role    `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty" toml:"roles,omitempty" mapstructure:"roles,omitempty"`
+	Projects []*Project `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty" toml:"projects,omitempty" mapstructure:"projects,omitempty"`
+	// only report the changes, don't apply them
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty" toml:"dry_run,omitempty" mapstructure:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ImportStateReq) Reset()         { *m = ImportStateReq{} }
+func (m *ImportStateReq) String() string { return proto.CompactTextString(m) }
+func (*ImportStateReq) ProtoMessage()    {}
+func (*ImportStateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{38}
+}
+func (m *ImportStateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportStateReq.Unmarshal(m, b)
+}
+func (m *ImportStateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportStateReq.Marshal(b, m, deterministic)
+}
+func (dst *ImportStateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportStateReq.Merge(dst, src)
+}
+func (m *ImportStateReq) XXX_Size() int {
+	return xxx_messageInfo_ImportStateReq.Size(m)
+}
+func (m *ImportStateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportStateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportStateReq proto.InternalMessageInfo
+
+func (m *ImportStateReq) GetPolicies() []*Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ImportStateReq) GetRoles() []*Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ImportStateReq) GetProjects() []*Project {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ImportStateReq) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ImportStateResp struct {
+	Changes              []*StateChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty" toml:"changes,omitempty" mapstructure:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte         `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32          `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ImportStateResp) Reset()         { *m = ImportStateResp{} }
+func (m *ImportStateResp) String() string { return proto.CompactTextString(m) }
+func (*ImportStateResp) ProtoMessage()    {}
+func (*ImportStateResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{39}
+}
+func (m *ImportStateResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportStateResp.Unmarshal(m, b)
+}
+func (m *ImportStateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportStateResp.Marshal(b, m, deterministic)
+}
+func (dst *ImportStateResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportStateResp.Merge(dst, src)
+}
+func (m *ImportStateResp) XXX_Size() int {
+	return xxx_messageInfo_ImportStateResp.Size(m)
+}
+func (m *ImportStateResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportStateResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportStateResp proto.InternalMessageInfo
+
+func (m *ImportStateResp) GetChanges() []*StateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type StateChange struct {
+	Operation StateChange_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=chef.automate.domain.authz.v2.StateChange_Operation" json:"operation,omitempty" toml:"operation,omitempty" mapstructure:"operation,omitempty"`
+	// one of "project", "role", "policy"
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty" toml:"kind,omitempty" mapstructure:"kind,omitempty"`
+	Id   string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	// set for skipped changes
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty" toml:"reason,omitempty" mapstructure:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *StateChange) Reset()         { *m = StateChange{} }
+func (m *StateChange) String() string { return proto.CompactTextString(m) }
+func (*StateChange) ProtoMessage()    {}
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8e6a4756beec8d73, []int{40}
+}
+func (m *StateChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateChange.Unmarshal(m, b)
+}
+func (m *StateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateChange.Marshal(b, m, deterministic)
+}
+func (dst *StateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChange.Merge(dst, src)
+}
+func (m *StateChange) XXX_Size() int {
+	return xxx_messageInfo_StateChange.Size(m)
+}
+func (m *StateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChange proto.InternalMessageInfo
+
+func (m *StateChange) GetOperation() StateChange_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return StateChange_CREATE
+}
+
+func (m *StateChange) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *StateChange) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StateChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Policy)(nil), "chef.automate.domain.authz.v2.Policy")
 	proto.RegisterType((*Role)(nil), "chef.automate.domain.authz.v2.Role")
@@ -1595,6 +1991,8 @@ func init() {
 	proto.RegisterType((*DeletePolicyReq)(nil), "chef.automate.domain.authz.v2.DeletePolicyReq")
 	proto.RegisterType((*DeletePolicyResp)(nil), "chef.automate.domain.authz.v2.DeletePolicyResp")
 	proto.RegisterType((*Statement)(nil), "chef.automate.domain.authz.v2.Statement")
+	proto.RegisterType((*Conditions)(nil), "chef.automate.domain.authz.v2.Conditions")
+	proto.RegisterType((*TimeWindow)(nil), "chef.automate.domain.authz.v2.TimeWindow")
 	proto.RegisterType((*ListPoliciesReq)(nil), "chef.automate.domain.authz.v2.ListPoliciesReq")
 	proto.RegisterType((*ListPoliciesResp)(nil), "chef.automate.domain.authz.v2.ListPoliciesResp")
 	proto.RegisterType((*GetPolicyReq)(nil), "chef.automate.domain.authz.v2.GetPolicyReq")
@@ -1623,9 +2021,15 @@ func init() {
 	proto.RegisterType((*CreateRoleReq)(nil), "chef.automate.domain.authz.v2.CreateRoleReq")
 	proto.RegisterType((*PurgeSubjectFromPoliciesReq)(nil), "chef.automate.domain.authz.v2.PurgeSubjectFromPoliciesReq")
 	proto.RegisterType((*PurgeSubjectFromPoliciesResp)(nil), "chef.automate.domain.authz.v2.PurgeSubjectFromPoliciesResp")
+	proto.RegisterType((*ExportStateReq)(nil), "chef.automate.domain.authz.v2.ExportStateReq")
+	proto.RegisterType((*ExportStateResp)(nil), "chef.automate.domain.authz.v2.ExportStateResp")
+	proto.RegisterType((*ImportStateReq)(nil), "chef.automate.domain.authz.v2.ImportStateReq")
+	proto.RegisterType((*ImportStateResp)(nil), "chef.automate.domain.authz.v2.ImportStateResp")
+	proto.RegisterType((*StateChange)(nil), "chef.automate.domain.authz.v2.StateChange")
 	proto.RegisterEnum("chef.automate.domain.authz.v2.Flag", Flag_name, Flag_value)
 	proto.RegisterEnum("chef.automate.domain.authz.v2.Statement_Effect", Statement_Effect_name, Statement_Effect_value)
 	proto.RegisterEnum("chef.automate.domain.authz.v2.Version_VersionNumber", Version_VersionNumber_name, Version_VersionNumber_value)
+	proto.RegisterEnum("chef.automate.domain.authz.v2.StateChange_Operation", StateChange_Operation_name, StateChange_Operation_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1658,6 +2062,8 @@ type PoliciesClient interface {
 	RemovePolicyMembers(ctx context.Context, in *RemovePolicyMembersReq, opts ...grpc.CallOption) (*RemovePolicyMembersResp, error)
 	AddPolicyMembers(ctx context.Context, in *AddPolicyMembersReq, opts ...grpc.CallOption) (*AddPolicyMembersResp, error)
 	PurgeSubjectFromPolicies(ctx context.Context, in *PurgeSubjectFromPoliciesReq, opts ...grpc.CallOption) (*PurgeSubjectFromPoliciesResp, error)
+	ExportState(ctx context.Context, in *ExportStateReq, opts ...grpc.CallOption) (*ExportStateResp, error)
+	ImportState(ctx context.Context, in *ImportStateReq, opts ...grpc.CallOption) (*ImportStateResp, error)
 }
 
 type policiesClient struct {
@@ -1830,6 +2236,24 @@ func (c *policiesClient) PurgeSubjectFromPolicies(ctx context.Context, in *Purge
 	return out, nil
 }
 
+func (c *policiesClient) ExportState(ctx context.Context, in *ExportStateReq, opts ...grpc.CallOption) (*ExportStateResp, error) {
+	out := new(ExportStateResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Policies/ExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policiesClient) ImportState(ctx context.Context, in *ImportStateReq, opts ...grpc.CallOption) (*ImportStateResp, error) {
+	out := new(ImportStateResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Policies/ImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PoliciesServer is the server API for Policies service.
 type PoliciesServer interface {
 	ReplacePolicyMembers(context.Context, *ReplacePolicyMembersReq) (*ReplacePolicyMembersResp, error)
@@ -1850,6 +2274,8 @@ type PoliciesServer interface {
 	RemovePolicyMembers(context.Context, *RemovePolicyMembersReq) (*RemovePolicyMembersResp, error)
 	AddPolicyMembers(context.Context, *AddPolicyMembersReq) (*AddPolicyMembersResp, error)
 	PurgeSubjectFromPolicies(context.Context, *PurgeSubjectFromPoliciesReq) (*PurgeSubjectFromPoliciesResp, error)
+	ExportState(context.Context, *ExportStateReq) (*ExportStateResp, error)
+	ImportState(context.Context, *ImportStateReq) (*ImportStateResp, error)
 }
 
 func RegisterPoliciesServer(s *grpc.Server, srv PoliciesServer) {
@@ -2180,6 +2606,42 @@ func _Policies_PurgeSubjectFromPolicies_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Policies_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoliciesServer).ExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Policies/ExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoliciesServer).ExportState(ctx, req.(*ExportStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Policies_ImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoliciesServer).ImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Policies/ImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoliciesServer).ImportState(ctx, req.(*ImportStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Policies_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chef.automate.domain.authz.v2.Policies",
 	HandlerType: (*PoliciesServer)(nil),
@@ -2256,113 +2718,147 @@ var _Policies_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeSubjectFromPolicies",
 			Handler:    _Policies_PurgeSubjectFromPolicies_Handler,
 		},
+		{
+			MethodName: "ExportState",
+			Handler:    _Policies_ExportState_Handler,
+		},
+		{
+			MethodName: "ImportState",
+			Handler:    _Policies_ImportState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/interservice/authz/v2/policy.proto",
 }
 
 func init() {
-	proto.RegisterFile("api/interservice/authz/v2/policy.proto", fileDescriptor_policy_18b305e788cf9d33)
-}
-
-var fileDescriptor_policy_18b305e788cf9d33 = []byte{
-	// 1560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x5b, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xac, 0x9d, 0x8b, 0x4f, 0x2e, 0x76, 0x27, 0x29, 0x5d, 0x96, 0x56, 0x84, 0x25, 0x14,
-	0xdb, 0x8d, 0xed, 0x64, 0x13, 0x7a, 0x71, 0x55, 0x85, 0x84, 0x5e, 0x68, 0xd5, 0x9b, 0x36, 0x6d,
-	0x80, 0x06, 0xa7, 0xda, 0xd8, 0x13, 0x67, 0xdb, 0xb5, 0x77, 0xb3, 0xbb, 0x8e, 0xd4, 0xe2, 0x4a,
-	0x20, 0x01, 0x12, 0x0f, 0x48, 0x10, 0x40, 0x5c, 0x04, 0x2f, 0x48, 0xa8, 0x12, 0x12, 0x2f, 0x3c,
-	0x20, 0x84, 0x40, 0xe2, 0x99, 0x77, 0x04, 0x7f, 0x80, 0x07, 0x7e, 0x00, 0xef, 0x68, 0x66, 0xd7,
-	0xf6, 0xae, 0x63, 0x67, 0x6d, 0x17, 0x90, 0xa0, 0x3c, 0x79, 0x67, 0x32, 0xdf, 0x99, 0x39, 0xe7,
-	0xcc, 0xf9, 0xce, 0x39, 0x13, 0x38, 0xac, 0x18, 0x6a, 0x46, 0x2d, 0xdb, 0xc4, 0xb4, 0x88, 0xb9,
-	0xad, 0xe6, 0x49, 0x46, 0xa9, 0xd8, 0x9b, 0x77, 0x33, 0xdb, 0x52, 0xc6, 0xd0, 0x35, 0x35, 0x7f,
-	0x27, 0x6d, 0x98, 0xba, 0xad, 0xe3, 0x43, 0xf9, 0x4d, 0xb2, 0x91, 0x56, 0x2a, 0xb6, 0x5e, 0x52,
-	0x6c, 0x92, 0x2e, 0xe8, 0x25, 0x45, 0x2d, 0xa7, 0xd9, 0xda, 0xf4, 0xb6, 0x24, 0x1c, 0xd8, 0x56,
-	0x34, 0xb5, 0xa0, 0xd8, 0x24, 0x53, 0xfb, 0x70, 0x70, 0xc2, 0x54, 0x7b, 0xf9, 0xf6, 0x1d, 0xc3,
-	0x5d, 0x25, 0xfe, 0x86, 0x60, 0xe0, 0x2a, 0xdb, 0x0e, 0x63, 0x08, 0x97, 0x95, 0x12, 0xe1, 0xd1,
-	0x24, 0x8a, 0x47, 0x64, 0xf6, 0x8d, 0xc7, 0x80, 0x53, 0x0b, 0x3c, 0xc7, 0x66, 0x38, 0xb5, 0x80,
-	0x8f, 0x41, 0x98, 0x82, 0xf9, 0xd0, 0x24, 0x8a, 0x8f, 0x49, 0x4f, 0xa6, 0xf7, 0x3c, 0x5b, 0xfa,
-	0xda, 0x1d, 0x83, 0xc8, 0x0c, 0x80, 0x79, 0x18, 0x2c, 0x91, 0xd2, 0x3a, 0x31, 0x2d, 0x3e, 0x3c,
-	0x19, 0x8a, 0x47, 0xe4, 0xda, 0x10, 0x3f, 0x0f, 0x60, 0xd9, 0x8a, 0x4d, 0x4a, 0xa4, 0x6c, 0x5b,
-	0x7c, 0xff, 0x64, 0x28, 0x3e, 0x2c, 0xc5, 0x03, 0x04, 0x2f, 0xd7, 0x00, 0xb2, 0x07, 0x8b, 0x05,
-	0x18, 0x32, 0x4c, 0xfd, 0x16, 0xc9, 0xdb, 0x16, 0x3f, 0xc0, 0x36, 0xa9, 0x8f, 0xc5, 0x8f, 0x11,
-	0x84, 0x65, 0x5d, 0x23, 0x7f, 0xbb, 0x96, 0x4a, 0xde, 0x56, 0xf5, 0x72, 0x5d, 0x4b, 0x77, 0xe8,
-	0x3b, 0x5b, 0x7f, 0xd3, 0xd9, 0xbe, 0x08, 0x41, 0xf4, 0x39, 0x93, 0x28, 0x36, 0x71, 0x3c, 0x21,
-	0x93, 0x2d, 0x9c, 0x64, 0x47, 0x62, 0x87, 0x5c, 0x12, 0xbe, 0xfb, 0xfd, 0xc7, 0xd0, 0x7e, 0x73,
-	0x5c, 0xda, 0xb7, 0xb6, 0xaa, 0xa4, 0xee, 0xce, 0xa4, 0x4e, 0xa4, 0x72, 0xaf, 0xcc, 0x4e, 0x1f,
-	0x9d, 0xbf, 0x37, 0xc5, 0x8e, 0x7b, 0xc8, 0x55, 0x89, 0x29, 0xb0, 0x14, 0xa1, 0xab, 0xc3, 0x26,
-	0x17, 0x43, 0xae, 0x76, 0x3f, 0xa0, 0x86, 0xed, 0x43, 0x74, 0xeb, 0xa5, 0xcf, 0x11, 0x5d, 0xf3,
-	0x29, 0xda, 0x41, 0x1f, 0x21, 0x1e, 0x89, 0xef, 0x21, 0xf3, 0x1d, 0x24, 0xbd, 0x8d, 0xd6, 0xe2,
-	0x0b, 0x59, 0x9b, 0x28, 0xa5, 0x6a, 0xc5, 0x22, 0x66, 0x22, 0x1b, 0x5f, 0xc8, 0x6a, 0x7a, 0x5e,
-	0xd1, 0xaa, 0x5a, 0x41, 0x31, 0xaa, 0x96, 0x52, 0xd2, 0xd8, 0xdc, 0xea, 0x5a, 0x36, 0x99, 0x3b,
-	0x52, 0x5d, 0x4d, 0xe6, 0x12, 0x53, 0x55, 0x0a, 0xf1, 0xa2, 0xaa, 0xb6, 0x7e, 0x9b, 0x94, 0xab,
-	0xb6, 0x66, 0x25, 0xb2, 0x89, 0x85, 0xd5, 0x64, 0x6e, 0xaa, 0xba, 0xc6, 0xa6, 0x5c, 0x18, 0x1d,
-	0x6a, 0x56, 0xd6, 0xbd, 0x91, 0x0d, 0x71, 0xd9, 0xc4, 0x42, 0x93, 0xe8, 0x76, 0x17, 0x24, 0xfc,
-	0x00, 0x17, 0xe4, 0x54, 0xb3, 0x13, 0x96, 0x9e, 0xa0, 0x86, 0x38, 0xb8, 0x83, 0x1e, 0xe5, 0x91,
-	0xd8, 0xc6, 0xc2, 0x0d, 0x3f, 0x9d, 0x82, 0xe8, 0x69, 0xa2, 0x91, 0x1e, 0xdd, 0x24, 0x62, 0x88,
-	0xf9, 0xe1, 0x96, 0x21, 0xfe, 0x1c, 0x82, 0x48, 0xfd, 0xac, 0xf8, 0x1c, 0x0c, 0x90, 0x8d, 0x0d,
-	0x92, 0xb7, 0x99, 0xc4, 0x31, 0x29, 0xd3, 0xa9, 0x96, 0xe9, 0x33, 0x0c, 0x26, 0xbb, 0x70, 0xbc,
-	0x02, 0x11, 0x93, 0x58, 0x7a, 0xc5, 0xcc, 0x13, 0x8b, 0xe7, 0x98, 0xa6, 0xc7, 0xe9, 0xe9, 0xe6,
-	0x76, 0xd0, 0x0c, 0x8f, 0xc4, 0x69, 0x33, 0x29, 0xc5, 0xd9, 0x21, 0x73, 0xcc, 0xe4, 0xc9, 0xf8,
-	0x42, 0xd6, 0x35, 0x7e, 0xc2, 0xf9, 0x4e, 0xe6, 0x12, 0x0b, 0x53, 0xd5, 0x35, 0xea, 0x40, 0xb9,
-	0x21, 0x0a, 0xff, 0x82, 0x1a, 0x17, 0xdc, 0xb9, 0x4a, 0xdf, 0xb3, 0xab, 0xf4, 0x0d, 0xda, 0x41,
-	0x5f, 0xd3, 0xab, 0xf4, 0x15, 0x32, 0xbf, 0x44, 0xd2, 0x7d, 0xb4, 0xe6, 0xb8, 0x7e, 0x35, 0x99,
-	0xcb, 0x3a, 0xdb, 0xa4, 0x94, 0xd4, 0xdd, 0xc5, 0xd4, 0x8d, 0x5c, 0x92, 0xce, 0xb2, 0x99, 0xda,
-	0x44, 0x76, 0xcf, 0x61, 0x8b, 0xe5, 0x8e, 0xe4, 0x5d, 0x93, 0xc1, 0xc0, 0xdd, 0x72, 0x1a, 0xf1,
-	0x89, 0x21, 0x6c, 0xea, 0x1a, 0xe1, 0xc3, 0x0e, 0x2d, 0xd0, 0xef, 0x3d, 0x63, 0xf6, 0x10, 0x0c,
-	0x38, 0x36, 0xc7, 0x11, 0xe8, 0x5f, 0xbc, 0x78, 0xf1, 0xca, 0x0b, 0xb1, 0x3e, 0x3c, 0x04, 0xe1,
-	0xd3, 0x67, 0x2e, 0xbf, 0x14, 0x43, 0xe2, 0x3e, 0x88, 0x5e, 0x54, 0x2d, 0x9b, 0x79, 0x5a, 0x25,
-	0x96, 0x4c, 0xb6, 0xc4, 0xeb, 0x10, 0xf3, 0x4f, 0x59, 0x06, 0x5e, 0x84, 0x21, 0xc3, 0x1d, 0xf3,
-	0x88, 0x5d, 0xec, 0xa7, 0x02, 0x5c, 0xee, 0xde, 0x9d, 0x3a, 0x4c, 0xcc, 0xc2, 0xc8, 0x39, 0x62,
-	0xf7, 0x76, 0x23, 0x3f, 0x09, 0x41, 0xf4, 0xba, 0x51, 0xe8, 0x99, 0x78, 0xbc, 0xcc, 0xc2, 0xfd,
-	0xeb, 0x99, 0x25, 0xf4, 0x00, 0xcc, 0x52, 0xcb, 0x2a, 0x43, 0x9e, 0xac, 0xe2, 0x65, 0x9b, 0x48,
-	0xf7, 0x6c, 0xf3, 0x06, 0x07, 0x07, 0x64, 0x62, 0x68, 0x4a, 0xde, 0xf5, 0xce, 0x25, 0xe7, 0xd4,
-	0x0f, 0x99, 0x93, 0xc4, 0x79, 0xe0, 0x5b, 0x9b, 0xc1, 0x32, 0xbc, 0x55, 0x05, 0xf2, 0x55, 0x15,
-	0xe2, 0x6b, 0x1c, 0x8c, 0x2f, 0x16, 0x0a, 0x0f, 0xb5, 0xe5, 0x66, 0x60, 0x62, 0xb7, 0x09, 0xfc,
-	0x56, 0xe3, 0xfc, 0x56, 0xfb, 0x09, 0xc1, 0xe0, 0x0a, 0x31, 0x2d, 0x55, 0x2f, 0xe3, 0x0b, 0xd0,
-	0x5f, 0x52, 0x6e, 0xe9, 0xa6, 0x9b, 0x8b, 0xe6, 0x03, 0xe2, 0xc2, 0x85, 0xd5, 0x7e, 0x2f, 0x57,
-	0xa8, 0x44, 0xd9, 0x11, 0xc1, 0x64, 0xa9, 0x65, 0xdd, 0x64, 0x25, 0x4a, 0xef, 0xb2, 0xa8, 0x08,
-	0xf1, 0x69, 0x18, 0xf5, 0xcd, 0xe3, 0x01, 0xe0, 0x56, 0x66, 0x62, 0x7d, 0xec, 0x77, 0x36, 0x86,
-	0xd8, 0xaf, 0x14, 0xe3, 0xc4, 0xfd, 0x30, 0x5e, 0x67, 0x46, 0x17, 0x41, 0x79, 0xf8, 0x45, 0x98,
-	0xd8, 0x3d, 0x6d, 0x19, 0xf8, 0x59, 0x18, 0xdc, 0x76, 0x86, 0x4c, 0xe3, 0x61, 0xe9, 0x70, 0x67,
-	0xa7, 0x94, 0x6b, 0x30, 0x71, 0x0c, 0x46, 0x28, 0xc3, 0xd3, 0x32, 0x93, 0x31, 0xfe, 0x05, 0x18,
-	0xf5, 0x8c, 0x2d, 0x03, 0x9f, 0x80, 0x7e, 0x9a, 0x58, 0x6a, 0x5c, 0x1f, 0x54, 0x58, 0x52, 0xa0,
-	0xec, 0x20, 0xc4, 0x93, 0x30, 0xea, 0x14, 0x0f, 0x6c, 0xb2, 0x4b, 0x9e, 0x8f, 0xc1, 0x98, 0x17,
-	0x6c, 0x19, 0xe2, 0x1f, 0x1c, 0x8c, 0x3a, 0xcc, 0xdf, 0x83, 0x3c, 0xfc, 0xb8, 0xaf, 0xe0, 0x1c,
-	0xa6, 0xab, 0x07, 0xcc, 0xb0, 0xc4, 0xbd, 0xbc, 0xec, 0x52, 0xdf, 0x7f, 0xb7, 0x4e, 0xf0, 0x92,
-	0x7a, 0xb8, 0x7b, 0x52, 0x5f, 0x82, 0x89, 0x7a, 0x11, 0xd0, 0x23, 0x2d, 0x89, 0xb3, 0xb0, 0xbf,
-	0x85, 0x8c, 0x3d, 0xd9, 0xf0, 0x75, 0x0e, 0x1e, 0x91, 0x49, 0x49, 0xdf, 0x7e, 0xb8, 0x53, 0xc9,
-	0x1c, 0xcd, 0xa8, 0x2d, 0xac, 0xb0, 0x27, 0x27, 0x9e, 0x87, 0xb1, 0x4b, 0x6a, 0xd1, 0x54, 0x6c,
-	0x72, 0x4d, 0x5f, 0x91, 0xa8, 0xc9, 0x8e, 0x41, 0x78, 0x43, 0x53, 0x8a, 0x2e, 0x31, 0x06, 0x45,
-	0xf1, 0x59, 0x4d, 0x29, 0xca, 0x0c, 0x20, 0x1e, 0x81, 0xa8, 0x4f, 0x94, 0xb3, 0xaf, 0x49, 0x0c,
-	0xdd, 0xb4, 0xeb, 0x3e, 0x73, 0x87, 0x94, 0x4d, 0x64, 0x62, 0x11, 0xfb, 0x9a, 0xbe, 0x32, 0x4b,
-	0xd9, 0x24, 0x0a, 0xa3, 0x9e, 0xb1, 0x65, 0x88, 0xc7, 0x01, 0xce, 0x11, 0xbb, 0x17, 0x3e, 0xa0,
-	0xd1, 0xef, 0x34, 0x9c, 0xff, 0x47, 0xff, 0x3f, 0x19, 0xfd, 0xbf, 0x22, 0x78, 0xec, 0x6a, 0xc5,
-	0x2c, 0x92, 0xe5, 0xca, 0x3a, 0x9d, 0x39, 0x6b, 0xea, 0x25, 0x4f, 0x8b, 0x80, 0xbf, 0x45, 0x30,
-	0x68, 0x39, 0x7f, 0x72, 0x7d, 0xf1, 0x19, 0x33, 0xdc, 0x87, 0xc8, 0x7c, 0x1f, 0x49, 0xef, 0xfe,
-	0x85, 0x61, 0xe5, 0x86, 0xcd, 0x83, 0x87, 0x96, 0x7b, 0x5c, 0x71, 0x06, 0x0e, 0xb6, 0xd7, 0xcc,
-	0x32, 0x70, 0x0c, 0x42, 0x6a, 0xa1, 0x76, 0xc7, 0xe9, 0x67, 0x32, 0x0e, 0x61, 0x1a, 0x1a, 0x38,
-	0x0a, 0xc3, 0x2b, 0x67, 0xe4, 0xe5, 0xf3, 0x57, 0x2e, 0xdf, 0x94, 0x6e, 0xd2, 0x3c, 0xee, 0x9b,
-	0x98, 0x8d, 0x21, 0xe9, 0x7e, 0x0c, 0x86, 0x6a, 0xc2, 0xf0, 0x5b, 0x08, 0x26, 0x5a, 0xd5, 0x83,
-	0xf8, 0x68, 0x50, 0x36, 0x6d, 0x5d, 0x4b, 0x0b, 0xc7, 0x7a, 0xc2, 0x59, 0x86, 0xd8, 0x87, 0x8b,
-	0x30, 0xe2, 0x7d, 0xb7, 0xc1, 0xe9, 0x00, 0x51, 0x4d, 0x8f, 0x3c, 0x42, 0x67, 0xcd, 0x9e, 0xd8,
-	0x87, 0xb7, 0x60, 0xc4, 0xfb, 0x74, 0x10, 0xb8, 0x51, 0xd3, 0x33, 0x85, 0x90, 0xe9, 0x6a, 0x3d,
-	0xd3, 0x6d, 0xcb, 0x29, 0x66, 0xea, 0x76, 0x0f, 0xda, 0xb2, 0xa9, 0xdd, 0x0d, 0xdc, 0xb2, 0xb9,
-	0x17, 0x16, 0xfb, 0xb0, 0x02, 0x91, 0x7a, 0x65, 0x86, 0x8f, 0x04, 0xe0, 0xbd, 0x4d, 0x6f, 0xe7,
-	0x86, 0x2c, 0xc2, 0x88, 0xb7, 0xe1, 0x0d, 0xd4, 0xaa, 0xa9, 0x3b, 0xee, 0x7c, 0xa3, 0x32, 0x0c,
-	0x7b, 0xa8, 0x1e, 0xa7, 0x02, 0x70, 0xfe, 0x0c, 0x23, 0xa4, 0xbb, 0x59, 0xce, 0x6c, 0x77, 0x0f,
-	0x62, 0xcd, 0x55, 0x2d, 0x96, 0x3a, 0x35, 0x61, 0xa3, 0x3a, 0x16, 0xe6, 0xba, 0xc6, 0xb0, 0xed,
-	0x37, 0x21, 0x52, 0x4f, 0x4e, 0x81, 0xae, 0xf3, 0xa6, 0x35, 0x61, 0xba, 0xf3, 0xc5, 0xee, 0x25,
-	0x81, 0x46, 0xea, 0xc2, 0xd3, 0x1d, 0x45, 0x9c, 0x9b, 0xe5, 0x84, 0x4e, 0x0a, 0x6e, 0x47, 0x99,
-	0x7a, 0xdd, 0x1e, 0xa8, 0x8c, 0xb7, 0xe2, 0x0f, 0x54, 0xc6, 0xd7, 0x0e, 0x88, 0x7d, 0x78, 0x15,
-	0x06, 0xdd, 0x14, 0x8e, 0x13, 0xc1, 0x86, 0xef, 0x52, 0x8d, 0xdb, 0x00, 0x8d, 0xaa, 0x3f, 0xd0,
-	0x52, 0xbe, 0xee, 0x42, 0x48, 0x75, 0xb1, 0xba, 0xe6, 0x96, 0x46, 0x3f, 0x11, 0xb8, 0x99, 0xaf,
-	0xf5, 0xe8, 0x54, 0x9f, 0x57, 0x11, 0xec, 0xdb, 0x55, 0xf8, 0xe2, 0xb9, 0x4e, 0x79, 0xc6, 0xcb,
-	0xf9, 0xf3, 0xdd, 0x83, 0x98, 0x96, 0x6f, 0x22, 0x18, 0x6f, 0x51, 0x41, 0xe2, 0x67, 0x02, 0x2f,
-	0x71, 0xab, 0xda, 0x5b, 0x38, 0xda, 0x0b, 0xac, 0x16, 0xee, 0xcd, 0xad, 0x7d, 0x60, 0xb8, 0xb7,
-	0x78, 0x0e, 0x09, 0x0c, 0xf7, 0x56, 0xef, 0x07, 0x62, 0x1f, 0xfe, 0x00, 0x01, 0xdf, 0x2e, 0xdd,
-	0xe3, 0x6c, 0x10, 0x47, 0xb6, 0xaf, 0x80, 0x84, 0x93, 0x3d, 0x63, 0xe9, 0xb9, 0x96, 0xe6, 0x6f,
-	0x48, 0x45, 0xd5, 0xde, 0xac, 0xac, 0xa7, 0xf3, 0x7a, 0x29, 0x43, 0x45, 0x65, 0x6a, 0xa2, 0x32,
-	0x6d, 0xff, 0x1d, 0xb6, 0x3e, 0xc0, 0xfe, 0x15, 0x36, 0xf7, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x18, 0xbb, 0xae, 0xe4, 0x92, 0x1b, 0x00, 0x00,
+	proto.RegisterFile("api/interservice/authz/v2/policy.proto", fileDescriptor_policy_8e6a4756beec8d73)
+}
+
+var fileDescriptor_policy_8e6a4756beec8d73 = []byte{
+	// 1984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6c, 0x1b, 0x59,
+	0x19, 0xef, 0xb3, 0x1d, 0x27, 0xfe, 0xf2, 0xc7, 0xee, 0x6b, 0xbb, 0x1d, 0x86, 0x2d, 0x9b, 0x1d,
+	0x4a, 0xd7, 0x76, 0x13, 0x3b, 0x99, 0x84, 0x76, 0xeb, 0xd5, 0x2a, 0x24, 0x8d, 0xb7, 0x64, 0x37,
+	0xdb, 0x46, 0x93, 0x34, 0x65, 0x37, 0x38, 0xd1, 0xc4, 0x7e, 0x49, 0x67, 0x77, 0xc6, 0x33, 0x3b,
+	0x6f, 0x9c, 0x25, 0x5d, 0xc3, 0x09, 0x89, 0x33, 0x01, 0x09, 0x71, 0x40, 0xe2, 0x86, 0xb4, 0xd2,
+	0x72, 0xe0, 0xb0, 0x02, 0xd4, 0x03, 0x08, 0xc1, 0x01, 0x24, 0x2e, 0x1c, 0xe0, 0xc4, 0x0d, 0x24,
+	0xc4, 0x99, 0x3b, 0x7a, 0xef, 0xcd, 0xd8, 0x63, 0xc7, 0xc9, 0xd8, 0x2e, 0x20, 0x01, 0x3d, 0x65,
+	0xde, 0xf3, 0xf7, 0xfb, 0xde, 0xfb, 0xbe, 0xef, 0x7d, 0x7f, 0x5b, 0xb8, 0xa1, 0x3b, 0x46, 0xd1,
+	0xa8, 0x7b, 0xc4, 0xa5, 0xc4, 0x3d, 0x32, 0xaa, 0xa4, 0xa8, 0x37, 0xbc, 0xc7, 0x4f, 0x8a, 0x47,
+	0x6a, 0xd1, 0xb1, 0x4d, 0xa3, 0x7a, 0x5c, 0x70, 0x5c, 0xdb, 0xb3, 0xf1, 0xb5, 0xea, 0x63, 0x72,
+	0x50, 0xd0, 0x1b, 0x9e, 0x6d, 0xe9, 0x1e, 0x29, 0xd4, 0x6c, 0x4b, 0x37, 0xea, 0x05, 0x4e, 0x5b,
+	0x38, 0x52, 0xe5, 0xab, 0x47, 0xba, 0x69, 0xd4, 0x74, 0x8f, 0x14, 0x83, 0x0f, 0x81, 0x93, 0xaf,
+	0x9f, 0xcd, 0xdf, 0x3b, 0x76, 0x02, 0xaa, 0x57, 0xce, 0xb9, 0x85, 0x6b, 0xbf, 0x47, 0xaa, 0x9e,
+	0x4f, 0xf8, 0xd2, 0xa1, 0x6d, 0x1f, 0x9a, 0xa4, 0xc8, 0x57, 0xfb, 0x8d, 0x83, 0xa2, 0x67, 0x58,
+	0x84, 0x7a, 0xba, 0xe5, 0x08, 0x02, 0xe5, 0x2f, 0x08, 0x92, 0x1b, 0xfc, 0xe2, 0x18, 0x43, 0xa2,
+	0xae, 0x5b, 0x44, 0x42, 0xd3, 0x28, 0x9b, 0xd2, 0xf8, 0x37, 0x9e, 0x82, 0x98, 0x51, 0x93, 0x62,
+	0x7c, 0x27, 0x66, 0xd4, 0xf0, 0x6d, 0x48, 0xb0, 0x6b, 0x48, 0xf1, 0x69, 0x94, 0x9d, 0x52, 0x3f,
+	0x5f, 0x38, 0x57, 0xca, 0xc2, 0xd6, 0xb1, 0x43, 0x34, 0x0e, 0xc0, 0x12, 0x8c, 0x5a, 0xc4, 0xda,
+	0x27, 0x2e, 0x95, 0x12, 0xd3, 0xf1, 0x6c, 0x4a, 0x0b, 0x96, 0xf8, 0xcb, 0x00, 0xd4, 0xd3, 0x3d,
+	0x62, 0x91, 0xba, 0x47, 0xa5, 0x91, 0xe9, 0x78, 0x76, 0x5c, 0xcd, 0x46, 0x30, 0xde, 0x0c, 0x00,
+	0x5a, 0x08, 0x8b, 0x65, 0x18, 0xf3, 0xa5, 0xa7, 0x52, 0x92, 0x1f, 0xd2, 0x5a, 0x2b, 0xdf, 0x47,
+	0x90, 0xd0, 0x6c, 0x93, 0xfc, 0xdb, 0xa5, 0xd4, 0xab, 0x9e, 0x61, 0xd7, 0x5b, 0x52, 0xfa, 0xcb,
+	0x8e, 0xbb, 0x8d, 0x74, 0xdd, 0xed, 0xf7, 0x71, 0x48, 0xdf, 0x75, 0x89, 0xee, 0x11, 0x61, 0x09,
+	0x8d, 0x7c, 0x80, 0xf3, 0xfc, 0x4a, 0xfc, 0x92, 0x2b, 0xf2, 0xcf, 0xff, 0xf6, 0x8b, 0xf8, 0x15,
+	0xf7, 0x92, 0x7a, 0x71, 0x77, 0x47, 0x9f, 0x7d, 0x32, 0x37, 0x7b, 0x67, 0xb6, 0xf2, 0xd1, 0xfc,
+	0xcc, 0xad, 0xc5, 0xaf, 0x5f, 0xe7, 0xd7, 0xbd, 0xe6, 0x8b, 0xc4, 0x05, 0x58, 0x49, 0x31, 0xea,
+	0x84, 0x1b, 0xcb, 0x20, 0x5f, 0xba, 0xbf, 0xa2, 0xb6, 0xee, 0xe3, 0xec, 0xe8, 0x95, 0xdf, 0x21,
+	0x46, 0xf3, 0x6b, 0x74, 0x82, 0x7e, 0x89, 0x24, 0xa4, 0x3c, 0x45, 0xee, 0xcf, 0x90, 0xfa, 0x29,
+	0xda, 0xcd, 0x2e, 0x95, 0x3c, 0xa2, 0x5b, 0xcd, 0x06, 0x25, 0x6e, 0xae, 0x94, 0x5d, 0x2a, 0x99,
+	0x76, 0x55, 0x37, 0x9b, 0x66, 0x4d, 0x77, 0x9a, 0x54, 0xb7, 0x4c, 0xbe, 0xb7, 0xb3, 0x5b, 0xca,
+	0x57, 0x6e, 0x36, 0x77, 0xf2, 0x95, 0xdc, 0xf5, 0xe6, 0x2e, 0xa3, 0x2f, 0x55, 0x89, 0xeb, 0x9d,
+	0xfe, 0x29, 0xbb, 0x54, 0x0a, 0x33, 0x6c, 0x7a, 0xf6, 0xfb, 0xa4, 0xde, 0xf4, 0x4c, 0xda, 0x64,
+	0xf4, 0xb9, 0x52, 0x6e, 0x69, 0x27, 0x5f, 0x11, 0x74, 0xe2, 0x27, 0xb1, 0x2d, 0x98, 0x30, 0xd6,
+	0x26, 0x2d, 0xf9, 0xcf, 0xbb, 0xcd, 0xbc, 0x94, 0x5b, 0xea, 0x3a, 0xe8, 0xac, 0x97, 0x94, 0x78,
+	0x86, 0x97, 0xf4, 0x7a, 0xb7, 0xb5, 0x56, 0x5e, 0x66, 0x1a, 0x7b, 0xf1, 0x04, 0x7d, 0x46, 0x42,
+	0xca, 0x19, 0xa6, 0x68, 0x1b, 0xf4, 0x75, 0x48, 0xaf, 0x12, 0x93, 0x0c, 0x69, 0x4f, 0x05, 0x43,
+	0xa6, 0x13, 0x4e, 0x1d, 0xe5, 0x87, 0x09, 0x48, 0xb5, 0xee, 0x8a, 0xef, 0x41, 0x92, 0x1c, 0x1c,
+	0x90, 0xaa, 0xc7, 0x39, 0x4e, 0xa9, 0xc5, 0x7e, 0xa5, 0x2c, 0x94, 0x39, 0x4c, 0xf3, 0xe1, 0x78,
+	0x1b, 0x52, 0x2e, 0xa1, 0x76, 0xc3, 0xad, 0x12, 0x2a, 0xc5, 0xb8, 0xa4, 0xaf, 0xb2, 0xdb, 0x2d,
+	0x9c, 0xa0, 0x39, 0x09, 0x29, 0x33, 0x6e, 0x5e, 0xcd, 0xf2, 0x4b, 0x56, 0xb8, 0xca, 0xf3, 0xd9,
+	0xa5, 0x92, 0xaf, 0xfc, 0x9c, 0xf8, 0xce, 0x57, 0x72, 0x4b, 0xd7, 0x9b, 0xbb, 0xcc, 0x92, 0x5a,
+	0x9b, 0x15, 0xfe, 0x23, 0x6a, 0x7b, 0x82, 0x78, 0x73, 0x4f, 0xf9, 0x9b, 0xfb, 0x14, 0x9d, 0xa0,
+	0x9f, 0xb0, 0x37, 0xf7, 0x09, 0x72, 0x3f, 0x46, 0xea, 0x8f, 0xd0, 0xae, 0x78, 0x03, 0x3b, 0xf9,
+	0x4a, 0x49, 0x1c, 0x33, 0xab, 0xcf, 0x3e, 0x59, 0x9e, 0x7d, 0xb7, 0x92, 0x67, 0xbb, 0x7c, 0x27,
+	0xd8, 0x28, 0x9d, 0xbb, 0xec, 0x41, 0x9e, 0xaf, 0xf4, 0xdc, 0x8c, 0x06, 0x9e, 0xe6, 0xd3, 0x76,
+	0x64, 0x0c, 0x09, 0xd7, 0x36, 0x89, 0x94, 0x10, 0xf1, 0x83, 0x7d, 0x9f, 0xe7, 0xdc, 0x78, 0x0d,
+	0xa0, 0x6a, 0xd7, 0x6b, 0x86, 0xd0, 0x45, 0x72, 0x1a, 0x65, 0xc7, 0xd5, 0x5c, 0x84, 0xb9, 0xee,
+	0xb6, 0x00, 0x5a, 0x08, 0xac, 0x5c, 0x83, 0xa4, 0x30, 0x1f, 0x4e, 0xc1, 0xc8, 0xf2, 0xfa, 0xfa,
+	0x83, 0x47, 0x99, 0x0b, 0x78, 0x0c, 0x12, 0xab, 0xe5, 0xfb, 0xef, 0x64, 0x90, 0xf2, 0x1b, 0x04,
+	0xd0, 0x46, 0xe2, 0x75, 0x98, 0x60, 0xc1, 0x7e, 0xef, 0x43, 0xa3, 0x5e, 0xb3, 0x3f, 0xa4, 0x12,
+	0x9a, 0x8e, 0xf7, 0x71, 0xf4, 0x96, 0x61, 0x91, 0x47, 0x1c, 0xa1, 0x8d, 0x7b, 0xad, 0x6f, 0x8a,
+	0xaf, 0x01, 0x08, 0xdb, 0xee, 0x19, 0x8e, 0xff, 0x52, 0xb4, 0x94, 0xd8, 0x59, 0x73, 0x28, 0x7e,
+	0x0b, 0x26, 0x69, 0x63, 0x9f, 0x49, 0xbc, 0xc7, 0x02, 0x61, 0x60, 0xf4, 0x1b, 0xcc, 0xe6, 0x2f,
+	0x9f, 0xa0, 0xcf, 0x49, 0x48, 0x91, 0x5d, 0x49, 0x4b, 0xb0, 0x40, 0xa0, 0x8d, 0x70, 0x77, 0xd7,
+	0xe2, 0x9e, 0x49, 0xb5, 0x04, 0x73, 0x7a, 0x6d, 0xc2, 0x07, 0xb3, 0x50, 0x4a, 0x15, 0x13, 0xa0,
+	0x7d, 0x0d, 0x3c, 0x07, 0x23, 0xd4, 0xd3, 0x5d, 0xf1, 0xd4, 0xc7, 0x55, 0xb9, 0x20, 0x52, 0x5a,
+	0x21, 0x48, 0x69, 0x85, 0xad, 0x20, 0xa5, 0x69, 0x82, 0x10, 0xcf, 0x40, 0x9c, 0xd4, 0x45, 0x3c,
+	0x3f, 0x9f, 0x9e, 0x91, 0x29, 0x17, 0x21, 0xbd, 0x6e, 0x50, 0x8f, 0xfb, 0x9a, 0x41, 0xa8, 0x46,
+	0x3e, 0x50, 0x1e, 0x42, 0xa6, 0x73, 0x8b, 0x3a, 0x78, 0x19, 0xc6, 0x1c, 0x7f, 0xed, 0xab, 0xf2,
+	0x0b, 0x11, 0xaa, 0xf4, 0xbd, 0xb7, 0x05, 0x53, 0x4a, 0x30, 0x71, 0x8f, 0x78, 0xc3, 0xc5, 0x84,
+	0x5f, 0xc5, 0x21, 0xfd, 0xd0, 0xa9, 0x0d, 0x9d, 0x23, 0xc2, 0x49, 0x20, 0xf6, 0xff, 0x93, 0x04,
+	0xe2, 0xcf, 0x90, 0x04, 0x82, 0x4a, 0x61, 0x2c, 0x54, 0x29, 0x84, 0x13, 0x43, 0x6a, 0xf0, 0xc4,
+	0xf0, 0xe3, 0x18, 0x5c, 0xd5, 0x88, 0x63, 0xea, 0x55, 0xdf, 0x8c, 0x6f, 0x8b, 0x5b, 0x3f, 0xb7,
+	0x66, 0x6f, 0x6b, 0x2a, 0x8b, 0x20, 0xf5, 0xd6, 0x17, 0x75, 0xc2, 0x25, 0x25, 0xea, 0x28, 0x29,
+	0x95, 0x8f, 0x63, 0x70, 0x69, 0xb9, 0x56, 0x7b, 0xae, 0xe2, 0x7e, 0x54, 0x3c, 0x07, 0x97, 0x4f,
+	0xeb, 0xaa, 0x53, 0xbd, 0xb1, 0x4e, 0xf5, 0xfe, 0x16, 0xc1, 0xe8, 0x36, 0x71, 0xa9, 0x61, 0xd7,
+	0xf1, 0x9b, 0x30, 0x62, 0xe9, 0xef, 0xd9, 0xae, 0x5f, 0x88, 0x2c, 0x46, 0x78, 0x9a, 0x0f, 0x0b,
+	0xfe, 0xde, 0x6f, 0x30, 0x8e, 0x9a, 0x60, 0xc1, 0x79, 0x19, 0x75, 0xdb, 0x95, 0x62, 0xcf, 0xc4,
+	0x8b, 0xb1, 0x50, 0x5e, 0x81, 0xc9, 0x8e, 0x7d, 0x9c, 0x84, 0xd8, 0xf6, 0x5c, 0xe6, 0x02, 0xff,
+	0x3b, 0x9f, 0x41, 0xfc, 0xaf, 0x9a, 0x89, 0x29, 0x57, 0xe0, 0x52, 0x2b, 0x28, 0xfb, 0x08, 0x96,
+	0x02, 0xbe, 0x02, 0x97, 0x4f, 0x6f, 0x53, 0x07, 0x7f, 0x09, 0x46, 0x8f, 0xc4, 0xd2, 0xcf, 0x47,
+	0x37, 0xfa, 0xbb, 0xa5, 0x16, 0xc0, 0x94, 0x29, 0x98, 0x60, 0xc9, 0x85, 0x35, 0x23, 0x3c, 0xd9,
+	0xbc, 0x09, 0x93, 0xa1, 0x35, 0x75, 0xf0, 0x1d, 0x18, 0x61, 0x55, 0x45, 0x90, 0x66, 0xa2, 0xda,
+	0x0f, 0x06, 0xd4, 0x04, 0x42, 0x79, 0x0d, 0x26, 0x45, 0xe5, 0xc8, 0x37, 0x07, 0x4c, 0x31, 0x19,
+	0x98, 0x0a, 0x83, 0xa9, 0xa3, 0xfc, 0x23, 0x06, 0x93, 0x22, 0xe9, 0x0c, 0xc1, 0x0f, 0xbf, 0xd4,
+	0xd1, 0x96, 0x8c, 0x33, 0xea, 0xa4, 0x9b, 0x50, 0x63, 0x5f, 0xdd, 0xf4, 0x83, 0xe9, 0xff, 0x6e,
+	0x91, 0x18, 0x4e, 0x13, 0x89, 0xc1, 0xd3, 0xc4, 0x0a, 0x5c, 0x6e, 0xd5, 0x1f, 0x43, 0xc6, 0x2f,
+	0x65, 0x1e, 0xae, 0xf4, 0xe0, 0x71, 0x6e, 0xd8, 0xfc, 0x24, 0x06, 0x2f, 0x68, 0xc4, 0xb2, 0x8f,
+	0x9e, 0x27, 0xa7, 0xbe, 0x22, 0xe7, 0x02, 0x5c, 0xed, 0xa9, 0xae, 0x73, 0x83, 0xe7, 0x1a, 0x4c,
+	0xbd, 0x6d, 0x1c, 0xba, 0xba, 0x47, 0xb6, 0xec, 0x6d, 0x95, 0xe9, 0xf6, 0x36, 0x24, 0x0e, 0x4c,
+	0xfd, 0xd0, 0x8f, 0xa0, 0x51, 0xee, 0xfe, 0x86, 0xa9, 0x1f, 0x6a, 0x1c, 0xa0, 0xdc, 0x84, 0x74,
+	0x07, 0x2b, 0x71, 0xae, 0x4b, 0x1c, 0xdb, 0xf5, 0x5a, 0xc6, 0xf5, 0x97, 0x2c, 0xec, 0x68, 0x84,
+	0x12, 0x6f, 0xcb, 0xde, 0x9e, 0x67, 0x61, 0x27, 0x0d, 0x93, 0xa1, 0x35, 0x75, 0x94, 0x57, 0x01,
+	0xee, 0x11, 0x6f, 0x98, 0xc0, 0xc1, 0xc2, 0x84, 0x98, 0x5f, 0x3c, 0x0f, 0x13, 0xff, 0xc9, 0x30,
+	0xf1, 0x27, 0x04, 0x9f, 0xdd, 0x68, 0xb8, 0x87, 0x64, 0x53, 0x74, 0x4f, 0x6f, 0xb8, 0xb6, 0x15,
+	0x6a, 0x63, 0xf0, 0x4f, 0x11, 0x8c, 0xfa, 0x8d, 0x95, 0x6f, 0x8b, 0x1f, 0x70, 0xc5, 0x7d, 0x0f,
+	0xb9, 0xdf, 0x45, 0xea, 0xb7, 0x87, 0xf2, 0xbf, 0xde, 0x4e, 0xe6, 0xbb, 0x4d, 0xdb, 0xc7, 0xf8,
+	0xf6, 0x10, 0xae, 0xe5, 0x5f, 0x57, 0x99, 0x83, 0x17, 0xcf, 0x96, 0x8c, 0x3a, 0x38, 0x03, 0x71,
+	0xa3, 0x16, 0xbc, 0x71, 0xf6, 0xc9, 0xb2, 0x57, 0xf9, 0x6b, 0xec, 0xa9, 0xf3, 0x62, 0x9e, 0xbd,
+	0xf0, 0x3f, 0x20, 0x48, 0x77, 0x6c, 0xfd, 0x4b, 0xba, 0xb8, 0x76, 0x7a, 0x8e, 0x0d, 0x9a, 0x9e,
+	0xf1, 0x4a, 0xc8, 0xde, 0xa2, 0x33, 0x89, 0xaa, 0x1e, 0x36, 0x04, 0x79, 0xc8, 0xe8, 0x7f, 0x47,
+	0x30, 0xb5, 0x66, 0x85, 0x05, 0xfd, 0xef, 0x17, 0x0a, 0x5f, 0x85, 0xd1, 0x9a, 0x7b, 0xbc, 0xe7,
+	0x36, 0xea, 0x7c, 0xae, 0x32, 0xa6, 0x25, 0x6b, 0xee, 0xb1, 0xd6, 0xa8, 0x2b, 0x8f, 0x20, 0xdd,
+	0x21, 0x2c, 0x75, 0xf0, 0x2a, 0x8c, 0x56, 0x1f, 0xeb, 0xf5, 0xc3, 0x96, 0xb0, 0xf9, 0x7e, 0xba,
+	0xbb, 0xbb, 0x1c, 0xa2, 0x05, 0x50, 0xe6, 0x3b, 0xe3, 0xa1, 0x1f, 0xb0, 0x06, 0x29, 0xdb, 0x21,
+	0xae, 0xee, 0x05, 0x95, 0x5d, 0x74, 0xfd, 0x19, 0x82, 0x17, 0x1e, 0x04, 0x58, 0xad, 0xcd, 0x86,
+	0x35, 0x90, 0xef, 0x1b, 0xf5, 0x60, 0xb0, 0xcc, 0xbf, 0xfd, 0x51, 0x73, 0xbc, 0x35, 0x6a, 0x7e,
+	0x01, 0x92, 0x2e, 0xd1, 0xa9, 0x5d, 0xf7, 0x07, 0x4a, 0xfe, 0x4a, 0xb9, 0x03, 0xa9, 0x16, 0x4f,
+	0x0c, 0x90, 0xbc, 0xab, 0x95, 0x97, 0xb7, 0xca, 0x99, 0x0b, 0xec, 0xfb, 0xe1, 0xc6, 0x2a, 0xfb,
+	0x46, 0xec, 0x7b, 0xb5, 0xbc, 0x5e, 0xde, 0x2a, 0x67, 0x62, 0x6c, 0x0e, 0xb4, 0xf9, 0xd6, 0xda,
+	0x46, 0x26, 0x9e, 0xcf, 0x42, 0x82, 0x25, 0x09, 0x9c, 0x86, 0xf1, 0xed, 0xb2, 0xb6, 0xb9, 0xf6,
+	0xe0, 0xfe, 0x9e, 0xba, 0xc7, 0x4a, 0xdf, 0x8e, 0x8d, 0xf9, 0x0c, 0x52, 0xff, 0x7c, 0x11, 0xc6,
+	0x02, 0xb7, 0xc2, 0xdf, 0x42, 0x70, 0xb9, 0x57, 0xaf, 0x85, 0x6f, 0x45, 0x3d, 0x86, 0xde, 0x0d,
+	0xad, 0x7c, 0x7b, 0x28, 0x1c, 0x75, 0x30, 0x81, 0x89, 0xf0, 0x38, 0x1c, 0x17, 0xa2, 0xc6, 0x65,
+	0x9d, 0xb3, 0x73, 0xb9, 0xbf, 0xd7, 0x8f, 0x6d, 0x98, 0x08, 0x8f, 0x59, 0x23, 0x8f, 0xe9, 0x1a,
+	0xe9, 0xca, 0xc5, 0x81, 0xe8, 0xa9, 0xc3, 0x0e, 0x0c, 0x8f, 0x95, 0x22, 0x0f, 0xec, 0x1a, 0x4b,
+	0xc9, 0xc5, 0x81, 0xe8, 0xa9, 0x83, 0xf7, 0x20, 0xd5, 0x6a, 0x62, 0xf0, 0xcd, 0x08, 0x74, 0x78,
+	0x34, 0xd5, 0xaf, 0x0a, 0x09, 0x4c, 0x84, 0x87, 0x52, 0x91, 0x12, 0x75, 0x4d, 0xb0, 0xfa, 0x3d,
+	0xc6, 0x84, 0xf1, 0x50, 0xa1, 0x83, 0x67, 0x23, 0x50, 0x9d, 0xf5, 0x95, 0x5c, 0x18, 0x84, 0x9c,
+	0x3a, 0xf8, 0x23, 0xc8, 0x74, 0xb7, 0x7e, 0x58, 0xed, 0x57, 0x79, 0xed, 0x16, 0x52, 0x5e, 0x18,
+	0x18, 0x43, 0x1d, 0x7c, 0x00, 0xa9, 0x56, 0x59, 0x16, 0x69, 0xb2, 0x70, 0x41, 0x27, 0xcf, 0xf4,
+	0x4f, 0xcc, 0x9f, 0x06, 0xb4, 0x4b, 0x36, 0x3c, 0xd3, 0x97, 0x87, 0xf9, 0xd5, 0x9d, 0xdc, 0x4f,
+	0x76, 0x60, 0x82, 0xb4, 0xda, 0xda, 0x48, 0x41, 0xc2, 0x0d, 0xb1, 0x3c, 0xd3, 0x3f, 0x31, 0x75,
+	0xf0, 0x3b, 0x30, 0xea, 0x97, 0xad, 0x38, 0x17, 0xad, 0xf0, 0x81, 0x44, 0x30, 0x00, 0xda, 0x0d,
+	0x71, 0xa4, 0x8e, 0x3a, 0x1a, 0x6f, 0x79, 0x76, 0x00, 0x6a, 0x61, 0x8e, 0x76, 0xa3, 0x1d, 0x79,
+	0x54, 0x47, 0x4f, 0xde, 0x9f, 0x2c, 0xdf, 0x80, 0x8b, 0xa7, 0xda, 0x41, 0xbc, 0xd0, 0x6f, 0x40,
+	0x09, 0x87, 0xf5, 0xc5, 0xc1, 0x41, 0xd4, 0xc1, 0xdf, 0x44, 0x70, 0xa9, 0x47, 0xb3, 0x84, 0xbf,
+	0x18, 0xf9, 0x6a, 0x7b, 0xf5, 0xa3, 0xf2, 0xad, 0x61, 0x60, 0xc2, 0xb7, 0xbb, 0x87, 0x5d, 0x91,
+	0xbe, 0xdd, 0x63, 0x92, 0x28, 0x2f, 0x0c, 0x8c, 0xa1, 0x0e, 0xfe, 0x0e, 0x02, 0xe9, 0xac, 0xaa,
+	0x16, 0x97, 0xa2, 0x42, 0xe1, 0xd9, 0x85, 0xbe, 0xfc, 0xda, 0xd0, 0x58, 0xea, 0xb0, 0xe0, 0x1a,
+	0xaa, 0x92, 0x23, 0x83, 0x6b, 0x67, 0x91, 0x2d, 0x17, 0x06, 0x21, 0x17, 0xa7, 0xad, 0x59, 0xfd,
+	0x9f, 0xb6, 0x66, 0x0d, 0x74, 0x5a, 0x57, 0xad, 0xb8, 0xb2, 0xf8, 0xae, 0x7a, 0x68, 0x78, 0x8f,
+	0x1b, 0xfb, 0x85, 0xaa, 0x6d, 0x15, 0x19, 0xb6, 0x18, 0x60, 0x8b, 0x67, 0xfe, 0x17, 0x8a, 0xfd,
+	0x24, 0xff, 0xa7, 0xa2, 0x85, 0x7f, 0x0e, 0x00, 0x0d, 0x67, 0xf4, 0xd4, 0xec, 0x21, 0x00, 0x00,
 }
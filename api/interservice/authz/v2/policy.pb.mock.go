@@ -47,6 +47,8 @@ type PoliciesServerMock struct {
 	RemovePolicyMembersFunc      func(context.Context, *RemovePolicyMembersReq) (*RemovePolicyMembersResp, error)
 	AddPolicyMembersFunc         func(context.Context, *AddPolicyMembersReq) (*AddPolicyMembersResp, error)
 	PurgeSubjectFromPoliciesFunc func(context.Context, *PurgeSubjectFromPoliciesReq) (*PurgeSubjectFromPoliciesResp, error)
+	ExportStateFunc              func(context.Context, *ExportStateReq) (*ExportStateResp, error)
+	ImportStateFunc              func(context.Context, *ImportStateReq) (*ImportStateResp, error)
 }
 
 func (m *PoliciesServerMock) ReplacePolicyMembers(ctx context.Context, req *ReplacePolicyMembersReq) (*ReplacePolicyMembersResp, error) {
@@ -265,6 +267,30 @@ func (m *PoliciesServerMock) PurgeSubjectFromPolicies(ctx context.Context, req *
 	return nil, status.Error(codes.Internal, "mock: 'PurgeSubjectFromPolicies' not implemented")
 }
 
+func (m *PoliciesServerMock) ExportState(ctx context.Context, req *ExportStateReq) (*ExportStateResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ExportStateFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ExportState' not implemented")
+}
+
+func (m *PoliciesServerMock) ImportState(ctx context.Context, req *ImportStateReq) (*ImportStateResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ImportStateFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ImportState' not implemented")
+}
+
 // Reset resets all overridden functions
 func (m *PoliciesServerMock) Reset() {
 	m.ReplacePolicyMembersFunc = nil
@@ -285,4 +311,6 @@ func (m *PoliciesServerMock) Reset() {
 	m.RemovePolicyMembersFunc = nil
 	m.AddPolicyMembersFunc = nil
 	m.PurgeSubjectFromPoliciesFunc = nil
+	m.ExportStateFunc = nil
+	m.ImportStateFunc = nil
 }
@@ -227,7 +227,7 @@ func (m *CreatePolicyReq) Validate() error {
 		if !_CreatePolicyReq_Members_Pattern.MatchString(item) {
 			return CreatePolicyReqValidationError{
 				field:  fmt.Sprintf("Members[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
 			}
 		}
 
@@ -330,7 +330,7 @@ var _ interface {
 
 var _CreatePolicyReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
 
-var _CreatePolicyReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
+var _CreatePolicyReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
 
 var _CreatePolicyReq_Projects_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
 
@@ -530,6 +530,16 @@ func (m *Statement) Validate() error {
 
 	// no validation rules for Role
 
+	if v, ok := interface{}(m.GetConditions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatementValidationError{
+				field:  "Conditions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -591,6 +601,199 @@ var _Statement_Resources_Pattern = regexp.MustCompile("^[a-z][^:*]*(?::[^:*]+)*(
 
 var _Statement_Actions_Pattern = regexp.MustCompile("^[*]$|^[*]:[a-z][-a-zA-Z]*$|^[a-z][a-zA-Z]*:[a-z][a-zA-Z]*:[a-z][a-zA-Z]*$|^[a-z][a-zA-Z]*:[*]$|^[a-z][a-zA-Z]*:[*]:[a-z][a-zA-Z]*$|^[a-z][a-zA-Z]*:[a-z][a-zA-Z]*:[*]$")
 
+// Validate checks the field values on Conditions with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Conditions) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTimeWindows() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConditionsValidationError{
+					field:  fmt.Sprintf("TimeWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	_Conditions_SubjectTypes_Unique := make(map[string]struct{}, len(m.GetSubjectTypes()))
+
+	for idx, item := range m.GetSubjectTypes() {
+		_, _ = idx, item
+
+		if _, exists := _Conditions_SubjectTypes_Unique[item]; exists {
+			return ConditionsValidationError{
+				field:  fmt.Sprintf("SubjectTypes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+		} else {
+			_Conditions_SubjectTypes_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _Conditions_SubjectTypes_InLookup[item]; !ok {
+			return ConditionsValidationError{
+				field:  fmt.Sprintf("SubjectTypes[%v]", idx),
+				reason: "value must be in list [user token tls cert]",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ConditionsValidationError is the validation error returned by
+// Conditions.Validate if the designated constraints aren't met.
+type ConditionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConditionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConditionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConditionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConditionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConditionsValidationError) ErrorName() string { return "ConditionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConditionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConditions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConditionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConditionsValidationError{}
+
+var _Conditions_SubjectTypes_InLookup = map[string]struct{}{
+	"user":  {},
+	"token": {},
+	"tls":   {},
+	"cert":  {},
+}
+
+// Validate checks the field values on TimeWindow with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TimeWindow) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeWindowValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeWindowValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// TimeWindowValidationError is the validation error returned by
+// TimeWindow.Validate if the designated constraints aren't met.
+type TimeWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeWindowValidationError) ErrorName() string { return "TimeWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeWindowValidationError{}
+
 // Validate checks the field values on ListPoliciesReq with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
@@ -842,7 +1045,7 @@ func (m *UpdatePolicyReq) Validate() error {
 		if !_UpdatePolicyReq_Members_Pattern.MatchString(item) {
 			return UpdatePolicyReqValidationError{
 				field:  fmt.Sprintf("Members[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
 			}
 		}
 
@@ -947,7 +1150,7 @@ var _ interface {
 
 var _UpdatePolicyReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
 
-var _UpdatePolicyReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
+var _UpdatePolicyReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
 
 var _UpdatePolicyReq_Projects_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
 
@@ -983,7 +1186,7 @@ func (m *ReplacePolicyMembersReq) Validate() error {
 		if !_ReplacePolicyMembersReq_Members_Pattern.MatchString(item) {
 			return ReplacePolicyMembersReqValidationError{
 				field:  fmt.Sprintf("Members[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
 			}
 		}
 
@@ -1050,7 +1253,7 @@ var _ interface {
 
 var _ReplacePolicyMembersReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
 
-var _ReplacePolicyMembersReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
+var _ReplacePolicyMembersReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
 
 // Validate checks the field values on ReplacePolicyMembersResp with the rules
 // defined in the proto definition for this message. If any rules are
@@ -1151,7 +1354,7 @@ func (m *AddPolicyMembersReq) Validate() error {
 		if !_AddPolicyMembersReq_Members_Pattern.MatchString(item) {
 			return AddPolicyMembersReqValidationError{
 				field:  fmt.Sprintf("Members[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
 			}
 		}
 
@@ -1218,7 +1421,7 @@ var _ interface {
 
 var _AddPolicyMembersReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
 
-var _AddPolicyMembersReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
+var _AddPolicyMembersReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
 
 // Validate checks the field values on AddPolicyMembersResp with the rules
 // defined in the proto definition for this message. If any rules are
@@ -2091,7 +2294,7 @@ func (m *RemovePolicyMembersReq) Validate() error {
 		if !_RemovePolicyMembersReq_Members_Pattern.MatchString(item) {
 			return RemovePolicyMembersReqValidationError{
 				field:  fmt.Sprintf("Members[%v]", idx),
-				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
+				reason: "value does not match regex pattern \"^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$\"",
 			}
 		}
 
@@ -2158,7 +2361,7 @@ var _ interface {
 
 var _RemovePolicyMembersReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
 
-var _RemovePolicyMembersReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls):)?[*]$|^token:[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
+var _RemovePolicyMembersReq_Members_Pattern = regexp.MustCompile("^(?:team|user):(?:local|ldap|saml):(?:[^:*]+|[*])$|^team:cert:(?:[^:*]+|[*])$|^(?:(?:team|user|token|tls|cert):)?[*]$|^(?:token|cert):[^:*]+$|^tls:service:(?:[^:*]+:)?(?:[^:*]+|[*])$")
 
 // Validate checks the field values on RemovePolicyMembersResp with the rules
 // defined in the proto definition for this message. If any rules are
@@ -2839,3 +3042,443 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PurgeSubjectFromPoliciesRespValidationError{}
+
+// Validate checks the field values on ExportStateReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ExportStateReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ExportStateReqValidationError is the validation error returned by
+// ExportStateReq.Validate if the designated constraints aren't met.
+type ExportStateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportStateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportStateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportStateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportStateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportStateReqValidationError) ErrorName() string { return "ExportStateReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportStateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportStateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportStateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportStateReqValidationError{}
+
+// Validate checks the field values on ExportStateResp with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ExportStateResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportStateRespValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportStateRespValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportStateRespValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ExportStateRespValidationError is the validation error returned by
+// ExportStateResp.Validate if the designated constraints aren't met.
+type ExportStateRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportStateRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportStateRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportStateRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportStateRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportStateRespValidationError) ErrorName() string { return "ExportStateRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportStateRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportStateResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportStateRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportStateRespValidationError{}
+
+// Validate checks the field values on ImportStateReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ImportStateReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportStateReqValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportStateReqValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetProjects() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportStateReqValidationError{
+					field:  fmt.Sprintf("Projects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	return nil
+}
+
+// ImportStateReqValidationError is the validation error returned by
+// ImportStateReq.Validate if the designated constraints aren't met.
+type ImportStateReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportStateReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportStateReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportStateReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportStateReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportStateReqValidationError) ErrorName() string { return "ImportStateReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportStateReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportStateReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportStateReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportStateReqValidationError{}
+
+// Validate checks the field values on ImportStateResp with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ImportStateResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportStateRespValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ImportStateRespValidationError is the validation error returned by
+// ImportStateResp.Validate if the designated constraints aren't met.
+type ImportStateRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportStateRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportStateRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportStateRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportStateRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportStateRespValidationError) ErrorName() string { return "ImportStateRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportStateRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportStateResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportStateRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportStateRespValidationError{}
+
+// Validate checks the field values on StateChange with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *StateChange) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Operation
+
+	// no validation rules for Kind
+
+	// no validation rules for Id
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// StateChangeValidationError is the validation error returned by
+// StateChange.Validate if the designated constraints aren't met.
+type StateChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StateChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StateChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StateChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StateChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StateChangeValidationError) ErrorName() string { return "StateChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StateChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStateChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StateChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StateChangeValidationError{}
@@ -135,6 +135,126 @@ func (mr *MockProjectsClientMockRecorder) ListProjects(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectsClient)(nil).ListProjects), varargs...)
 }
 
+// CreateRule mocks base method
+func (m *MockProjectsClient) CreateRule(ctx context.Context, in *CreateRuleReq, opts ...grpc.CallOption) (*CreateRuleResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRule", varargs...)
+	ret0, _ := ret[0].(*CreateRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule
+func (mr *MockProjectsClientMockRecorder) CreateRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockProjectsClient)(nil).CreateRule), varargs...)
+}
+
+// UpdateRule mocks base method
+func (m *MockProjectsClient) UpdateRule(ctx context.Context, in *UpdateRuleReq, opts ...grpc.CallOption) (*UpdateRuleResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRule", varargs...)
+	ret0, _ := ret[0].(*UpdateRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRule indicates an expected call of UpdateRule
+func (mr *MockProjectsClientMockRecorder) UpdateRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockProjectsClient)(nil).UpdateRule), varargs...)
+}
+
+// GetRule mocks base method
+func (m *MockProjectsClient) GetRule(ctx context.Context, in *GetRuleReq, opts ...grpc.CallOption) (*GetRuleResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRule", varargs...)
+	ret0, _ := ret[0].(*GetRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRule indicates an expected call of GetRule
+func (mr *MockProjectsClientMockRecorder) GetRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRule", reflect.TypeOf((*MockProjectsClient)(nil).GetRule), varargs...)
+}
+
+// ListRulesForProject mocks base method
+func (m *MockProjectsClient) ListRulesForProject(ctx context.Context, in *ListRulesForProjectReq, opts ...grpc.CallOption) (*ListRulesForProjectResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRulesForProject", varargs...)
+	ret0, _ := ret[0].(*ListRulesForProjectResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRulesForProject indicates an expected call of ListRulesForProject
+func (mr *MockProjectsClientMockRecorder) ListRulesForProject(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesForProject", reflect.TypeOf((*MockProjectsClient)(nil).ListRulesForProject), varargs...)
+}
+
+// DeleteRule mocks base method
+func (m *MockProjectsClient) DeleteRule(ctx context.Context, in *DeleteRuleReq, opts ...grpc.CallOption) (*DeleteRuleResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRule", varargs...)
+	ret0, _ := ret[0].(*DeleteRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRule indicates an expected call of DeleteRule
+func (mr *MockProjectsClientMockRecorder) DeleteRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockProjectsClient)(nil).DeleteRule), varargs...)
+}
+
+// ApplyRulesStart mocks base method
+func (m *MockProjectsClient) ApplyRulesStart(ctx context.Context, in *ApplyRulesStartReq, opts ...grpc.CallOption) (*ApplyRulesStartResp, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyRulesStart", varargs...)
+	ret0, _ := ret[0].(*ApplyRulesStartResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyRulesStart indicates an expected call of ApplyRulesStart
+func (mr *MockProjectsClientMockRecorder) ApplyRulesStart(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyRulesStart", reflect.TypeOf((*MockProjectsClient)(nil).ApplyRulesStart), varargs...)
+}
+
 // ListProjectRules mocks base method
 func (m *MockProjectsClient) ListProjectRules(ctx context.Context, in *ListProjectRulesReq, opts ...grpc.CallOption) (*ProjectCollectionRulesResp, error) {
 	m.ctrl.T.Helper()
@@ -313,6 +433,96 @@ func (mr *MockProjectsServerMockRecorder) ListProjects(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectsServer)(nil).ListProjects), arg0, arg1)
 }
 
+// CreateRule mocks base method
+func (m *MockProjectsServer) CreateRule(arg0 context.Context, arg1 *CreateRuleReq) (*CreateRuleResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", arg0, arg1)
+	ret0, _ := ret[0].(*CreateRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule
+func (mr *MockProjectsServerMockRecorder) CreateRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockProjectsServer)(nil).CreateRule), arg0, arg1)
+}
+
+// UpdateRule mocks base method
+func (m *MockProjectsServer) UpdateRule(arg0 context.Context, arg1 *UpdateRuleReq) (*UpdateRuleResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRule", arg0, arg1)
+	ret0, _ := ret[0].(*UpdateRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRule indicates an expected call of UpdateRule
+func (mr *MockProjectsServerMockRecorder) UpdateRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRule", reflect.TypeOf((*MockProjectsServer)(nil).UpdateRule), arg0, arg1)
+}
+
+// GetRule mocks base method
+func (m *MockProjectsServer) GetRule(arg0 context.Context, arg1 *GetRuleReq) (*GetRuleResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRule", arg0, arg1)
+	ret0, _ := ret[0].(*GetRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRule indicates an expected call of GetRule
+func (mr *MockProjectsServerMockRecorder) GetRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRule", reflect.TypeOf((*MockProjectsServer)(nil).GetRule), arg0, arg1)
+}
+
+// ListRulesForProject mocks base method
+func (m *MockProjectsServer) ListRulesForProject(arg0 context.Context, arg1 *ListRulesForProjectReq) (*ListRulesForProjectResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRulesForProject", arg0, arg1)
+	ret0, _ := ret[0].(*ListRulesForProjectResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRulesForProject indicates an expected call of ListRulesForProject
+func (mr *MockProjectsServerMockRecorder) ListRulesForProject(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesForProject", reflect.TypeOf((*MockProjectsServer)(nil).ListRulesForProject), arg0, arg1)
+}
+
+// DeleteRule mocks base method
+func (m *MockProjectsServer) DeleteRule(arg0 context.Context, arg1 *DeleteRuleReq) (*DeleteRuleResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", arg0, arg1)
+	ret0, _ := ret[0].(*DeleteRuleResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRule indicates an expected call of DeleteRule
+func (mr *MockProjectsServerMockRecorder) DeleteRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockProjectsServer)(nil).DeleteRule), arg0, arg1)
+}
+
+// ApplyRulesStart mocks base method
+func (m *MockProjectsServer) ApplyRulesStart(arg0 context.Context, arg1 *ApplyRulesStartReq) (*ApplyRulesStartResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyRulesStart", arg0, arg1)
+	ret0, _ := ret[0].(*ApplyRulesStartResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyRulesStart indicates an expected call of ApplyRulesStart
+func (mr *MockProjectsServerMockRecorder) ApplyRulesStart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyRulesStart", reflect.TypeOf((*MockProjectsServer)(nil).ApplyRulesStart), arg0, arg1)
+}
+
 // ListProjectRules mocks base method
 func (m *MockProjectsServer) ListProjectRules(arg0 context.Context, arg1 *ListProjectRulesReq) (*ProjectCollectionRulesResp, error) {
 	m.ctrl.T.Helper()
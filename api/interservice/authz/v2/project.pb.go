@@ -40,7 +40,7 @@ func (m *Project) Reset()         { *m = Project{} }
 func (m *Project) String() string { return proto.CompactTextString(m) }
 func (*Project) ProtoMessage()    {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{0}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{0}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Project.Unmarshal(m, b)
@@ -100,7 +100,7 @@ func (m *CreateProjectReq) Reset()         { *m = CreateProjectReq{} }
 func (m *CreateProjectReq) String() string { return proto.CompactTextString(m) }
 func (*CreateProjectReq) ProtoMessage()    {}
 func (*CreateProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{1}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{1}
 }
 func (m *CreateProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateProjectReq.Unmarshal(m, b)
@@ -145,7 +145,7 @@ func (m *CreateProjectResp) Reset()         { *m = CreateProjectResp{} }
 func (m *CreateProjectResp) String() string { return proto.CompactTextString(m) }
 func (*CreateProjectResp) ProtoMessage()    {}
 func (*CreateProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{2}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{2}
 }
 func (m *CreateProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateProjectResp.Unmarshal(m, b)
@@ -183,7 +183,7 @@ func (m *GetProjectReq) Reset()         { *m = GetProjectReq{} }
 func (m *GetProjectReq) String() string { return proto.CompactTextString(m) }
 func (*GetProjectReq) ProtoMessage()    {}
 func (*GetProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{3}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{3}
 }
 func (m *GetProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectReq.Unmarshal(m, b)
@@ -221,7 +221,7 @@ func (m *GetProjectResp) Reset()         { *m = GetProjectResp{} }
 func (m *GetProjectResp) String() string { return proto.CompactTextString(m) }
 func (*GetProjectResp) ProtoMessage()    {}
 func (*GetProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{4}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{4}
 }
 func (m *GetProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectResp.Unmarshal(m, b)
@@ -258,7 +258,7 @@ func (m *ListProjectsReq) Reset()         { *m = ListProjectsReq{} }
 func (m *ListProjectsReq) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsReq) ProtoMessage()    {}
 func (*ListProjectsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{5}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{5}
 }
 func (m *ListProjectsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsReq.Unmarshal(m, b)
@@ -289,7 +289,7 @@ func (m *ListProjectsResp) Reset()         { *m = ListProjectsResp{} }
 func (m *ListProjectsResp) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsResp) ProtoMessage()    {}
 func (*ListProjectsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{6}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{6}
 }
 func (m *ListProjectsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsResp.Unmarshal(m, b)
@@ -328,7 +328,7 @@ func (m *UpdateProjectReq) Reset()         { *m = UpdateProjectReq{} }
 func (m *UpdateProjectReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateProjectReq) ProtoMessage()    {}
 func (*UpdateProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{7}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{7}
 }
 func (m *UpdateProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateProjectReq.Unmarshal(m, b)
@@ -373,7 +373,7 @@ func (m *UpdateProjectResp) Reset()         { *m = UpdateProjectResp{} }
 func (m *UpdateProjectResp) String() string { return proto.CompactTextString(m) }
 func (*UpdateProjectResp) ProtoMessage()    {}
 func (*UpdateProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{8}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{8}
 }
 func (m *UpdateProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateProjectResp.Unmarshal(m, b)
@@ -411,7 +411,7 @@ func (m *DeleteProjectReq) Reset()         { *m = DeleteProjectReq{} }
 func (m *DeleteProjectReq) String() string { return proto.CompactTextString(m) }
 func (*DeleteProjectReq) ProtoMessage()    {}
 func (*DeleteProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{9}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{9}
 }
 func (m *DeleteProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteProjectReq.Unmarshal(m, b)
@@ -448,7 +448,7 @@ func (m *DeleteProjectResp) Reset()         { *m = DeleteProjectResp{} }
 func (m *DeleteProjectResp) String() string { return proto.CompactTextString(m) }
 func (*DeleteProjectResp) ProtoMessage()    {}
 func (*DeleteProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{10}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{10}
 }
 func (m *DeleteProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteProjectResp.Unmarshal(m, b)
@@ -478,7 +478,7 @@ func (m *ProjectUpdateStatusReq) Reset()         { *m = ProjectUpdateStatusReq{}
 func (m *ProjectUpdateStatusReq) String() string { return proto.CompactTextString(m) }
 func (*ProjectUpdateStatusReq) ProtoMessage()    {}
 func (*ProjectUpdateStatusReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{11}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{11}
 }
 func (m *ProjectUpdateStatusReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectUpdateStatusReq.Unmarshal(m, b)
@@ -511,7 +511,7 @@ func (m *ProjectUpdateStatusResp) Reset()         { *m = ProjectUpdateStatusResp
 func (m *ProjectUpdateStatusResp) String() string { return proto.CompactTextString(m) }
 func (*ProjectUpdateStatusResp) ProtoMessage()    {}
 func (*ProjectUpdateStatusResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{12}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{12}
 }
 func (m *ProjectUpdateStatusResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectUpdateStatusResp.Unmarshal(m, b)
@@ -562,7 +562,7 @@ func (m *ListProjectRulesReq) Reset()         { *m = ListProjectRulesReq{} }
 func (m *ListProjectRulesReq) String() string { return proto.CompactTextString(m) }
 func (*ListProjectRulesReq) ProtoMessage()    {}
 func (*ListProjectRulesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{13}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{13}
 }
 func (m *ListProjectRulesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectRulesReq.Unmarshal(m, b)
@@ -593,7 +593,7 @@ func (m *ProjectCollectionRulesResp) Reset()         { *m = ProjectCollectionRul
 func (m *ProjectCollectionRulesResp) String() string { return proto.CompactTextString(m) }
 func (*ProjectCollectionRulesResp) ProtoMessage()    {}
 func (*ProjectCollectionRulesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{14}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{14}
 }
 func (m *ProjectCollectionRulesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectCollectionRulesResp.Unmarshal(m, b)
@@ -631,7 +631,7 @@ func (m *GetProjectRulesReq) Reset()         { *m = GetProjectRulesReq{} }
 func (m *GetProjectRulesReq) String() string { return proto.CompactTextString(m) }
 func (*GetProjectRulesReq) ProtoMessage()    {}
 func (*GetProjectRulesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{15}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{15}
 }
 func (m *GetProjectRulesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectRulesReq.Unmarshal(m, b)
@@ -669,7 +669,7 @@ func (m *GetProjectRulesResp) Reset()         { *m = GetProjectRulesResp{} }
 func (m *GetProjectRulesResp) String() string { return proto.CompactTextString(m) }
 func (*GetProjectRulesResp) ProtoMessage()    {}
 func (*GetProjectRulesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{16}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{16}
 }
 func (m *GetProjectRulesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectRulesResp.Unmarshal(m, b)
@@ -707,7 +707,7 @@ func (m *ProjectRules) Reset()         { *m = ProjectRules{} }
 func (m *ProjectRules) String() string { return proto.CompactTextString(m) }
 func (*ProjectRules) ProtoMessage()    {}
 func (*ProjectRules) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{17}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{17}
 }
 func (m *ProjectRules) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectRules.Unmarshal(m, b)
@@ -735,17 +735,25 @@ func (m *ProjectRules) GetRules() []*ProjectRule {
 }
 
 type ProjectRule struct {
-	Conditions           []*Condition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty" toml:"conditions,omitempty" mapstructure:"conditions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte       `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	Conditions []*Condition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty" toml:"conditions,omitempty" mapstructure:"conditions,omitempty"`
+	Id         string       `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	ProjectId  string       `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" toml:"project_id,omitempty" mapstructure:"project_id,omitempty"`
+	Name       string       `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty" toml:"name,omitempty" mapstructure:"name,omitempty"`
+	// "staged" if the rule has changes that have not been applied yet,
+	// "applied" otherwise
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty" toml:"status,omitempty" mapstructure:"status,omitempty"`
+	// set on staged rules: applying them deletes the rule
+	Deleted              bool     `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty" toml:"deleted,omitempty" mapstructure:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
 func (m *ProjectRule) Reset()         { *m = ProjectRule{} }
 func (m *ProjectRule) String() string { return proto.CompactTextString(m) }
 func (*ProjectRule) ProtoMessage()    {}
 func (*ProjectRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{18}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{18}
 }
 func (m *ProjectRule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProjectRule.Unmarshal(m, b)
@@ -772,6 +780,41 @@ func (m *ProjectRule) GetConditions() []*Condition {
 	return nil
 }
 
+func (m *ProjectRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProjectRule) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *ProjectRule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProjectRule) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ProjectRule) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 // type = "ChefServers"
 // values = ['chef_server_1.org', 'chef_server_2.org', 'chef_*']
 type Condition struct {
@@ -786,7 +829,7 @@ func (m *Condition) Reset()         { *m = Condition{} }
 func (m *Condition) String() string { return proto.CompactTextString(m) }
 func (*Condition) ProtoMessage()    {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_project_23423a13b105513b, []int{19}
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{19}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Condition.Unmarshal(m, b)
@@ -820,6 +863,502 @@ func (m *Condition) GetValues() []string {
 	return nil
 }
 
+type CreateRuleReq struct {
+	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	ProjectId            string       `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" toml:"project_id,omitempty" mapstructure:"project_id,omitempty"`
+	Name                 string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" toml:"name,omitempty" mapstructure:"name,omitempty"`
+	Conditions           []*Condition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty" toml:"conditions,omitempty" mapstructure:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *CreateRuleReq) Reset()         { *m = CreateRuleReq{} }
+func (m *CreateRuleReq) String() string { return proto.CompactTextString(m) }
+func (*CreateRuleReq) ProtoMessage()    {}
+func (*CreateRuleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{20}
+}
+func (m *CreateRuleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRuleReq.Unmarshal(m, b)
+}
+func (m *CreateRuleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRuleReq.Marshal(b, m, deterministic)
+}
+func (dst *CreateRuleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRuleReq.Merge(dst, src)
+}
+func (m *CreateRuleReq) XXX_Size() int {
+	return xxx_messageInfo_CreateRuleReq.Size(m)
+}
+func (m *CreateRuleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRuleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRuleReq proto.InternalMessageInfo
+
+func (m *CreateRuleReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateRuleReq) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *CreateRuleReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateRuleReq) GetConditions() []*Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+type CreateRuleResp struct {
+	Rule                 *ProjectRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty" toml:"rule,omitempty" mapstructure:"rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *CreateRuleResp) Reset()         { *m = CreateRuleResp{} }
+func (m *CreateRuleResp) String() string { return proto.CompactTextString(m) }
+func (*CreateRuleResp) ProtoMessage()    {}
+func (*CreateRuleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{21}
+}
+func (m *CreateRuleResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRuleResp.Unmarshal(m, b)
+}
+func (m *CreateRuleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRuleResp.Marshal(b, m, deterministic)
+}
+func (dst *CreateRuleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRuleResp.Merge(dst, src)
+}
+func (m *CreateRuleResp) XXX_Size() int {
+	return xxx_messageInfo_CreateRuleResp.Size(m)
+}
+func (m *CreateRuleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRuleResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRuleResp proto.InternalMessageInfo
+
+func (m *CreateRuleResp) GetRule() *ProjectRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+type UpdateRuleReq struct {
+	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	ProjectId            string       `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" toml:"project_id,omitempty" mapstructure:"project_id,omitempty"`
+	Name                 string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty" toml:"name,omitempty" mapstructure:"name,omitempty"`
+	Conditions           []*Condition `protobuf:"bytes,4,rep,name=conditions,proto3" json:"conditions,omitempty" toml:"conditions,omitempty" mapstructure:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *UpdateRuleReq) Reset()         { *m = UpdateRuleReq{} }
+func (m *UpdateRuleReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateRuleReq) ProtoMessage()    {}
+func (*UpdateRuleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{22}
+}
+func (m *UpdateRuleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRuleReq.Unmarshal(m, b)
+}
+func (m *UpdateRuleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRuleReq.Marshal(b, m, deterministic)
+}
+func (dst *UpdateRuleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRuleReq.Merge(dst, src)
+}
+func (m *UpdateRuleReq) XXX_Size() int {
+	return xxx_messageInfo_UpdateRuleReq.Size(m)
+}
+func (m *UpdateRuleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRuleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRuleReq proto.InternalMessageInfo
+
+func (m *UpdateRuleReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateRuleReq) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *UpdateRuleReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateRuleReq) GetConditions() []*Condition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+type UpdateRuleResp struct {
+	Rule                 *ProjectRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty" toml:"rule,omitempty" mapstructure:"rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *UpdateRuleResp) Reset()         { *m = UpdateRuleResp{} }
+func (m *UpdateRuleResp) String() string { return proto.CompactTextString(m) }
+func (*UpdateRuleResp) ProtoMessage()    {}
+func (*UpdateRuleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{23}
+}
+func (m *UpdateRuleResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRuleResp.Unmarshal(m, b)
+}
+func (m *UpdateRuleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRuleResp.Marshal(b, m, deterministic)
+}
+func (dst *UpdateRuleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRuleResp.Merge(dst, src)
+}
+func (m *UpdateRuleResp) XXX_Size() int {
+	return xxx_messageInfo_UpdateRuleResp.Size(m)
+}
+func (m *UpdateRuleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRuleResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRuleResp proto.InternalMessageInfo
+
+func (m *UpdateRuleResp) GetRule() *ProjectRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+type GetRuleReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	ProjectId            string   `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" toml:"project_id,omitempty" mapstructure:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *GetRuleReq) Reset()         { *m = GetRuleReq{} }
+func (m *GetRuleReq) String() string { return proto.CompactTextString(m) }
+func (*GetRuleReq) ProtoMessage()    {}
+func (*GetRuleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{24}
+}
+func (m *GetRuleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRuleReq.Unmarshal(m, b)
+}
+func (m *GetRuleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRuleReq.Marshal(b, m, deterministic)
+}
+func (dst *GetRuleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRuleReq.Merge(dst, src)
+}
+func (m *GetRuleReq) XXX_Size() int {
+	return xxx_messageInfo_GetRuleReq.Size(m)
+}
+func (m *GetRuleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRuleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRuleReq proto.InternalMessageInfo
+
+func (m *GetRuleReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetRuleReq) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+type GetRuleResp struct {
+	Rule                 *ProjectRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty" toml:"rule,omitempty" mapstructure:"rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *GetRuleResp) Reset()         { *m = GetRuleResp{} }
+func (m *GetRuleResp) String() string { return proto.CompactTextString(m) }
+func (*GetRuleResp) ProtoMessage()    {}
+func (*GetRuleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{25}
+}
+func (m *GetRuleResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRuleResp.Unmarshal(m, b)
+}
+func (m *GetRuleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRuleResp.Marshal(b, m, deterministic)
+}
+func (dst *GetRuleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRuleResp.Merge(dst, src)
+}
+func (m *GetRuleResp) XXX_Size() int {
+	return xxx_messageInfo_GetRuleResp.Size(m)
+}
+func (m *GetRuleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRuleResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRuleResp proto.InternalMessageInfo
+
+func (m *GetRuleResp) GetRule() *ProjectRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+type ListRulesForProjectReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListRulesForProjectReq) Reset()         { *m = ListRulesForProjectReq{} }
+func (m *ListRulesForProjectReq) String() string { return proto.CompactTextString(m) }
+func (*ListRulesForProjectReq) ProtoMessage()    {}
+func (*ListRulesForProjectReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{26}
+}
+func (m *ListRulesForProjectReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRulesForProjectReq.Unmarshal(m, b)
+}
+func (m *ListRulesForProjectReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRulesForProjectReq.Marshal(b, m, deterministic)
+}
+func (dst *ListRulesForProjectReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRulesForProjectReq.Merge(dst, src)
+}
+func (m *ListRulesForProjectReq) XXX_Size() int {
+	return xxx_messageInfo_ListRulesForProjectReq.Size(m)
+}
+func (m *ListRulesForProjectReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRulesForProjectReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRulesForProjectReq proto.InternalMessageInfo
+
+func (m *ListRulesForProjectReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListRulesForProjectResp struct {
+	Rules                []*ProjectRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty" toml:"rules,omitempty" mapstructure:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte         `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32          `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListRulesForProjectResp) Reset()         { *m = ListRulesForProjectResp{} }
+func (m *ListRulesForProjectResp) String() string { return proto.CompactTextString(m) }
+func (*ListRulesForProjectResp) ProtoMessage()    {}
+func (*ListRulesForProjectResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{27}
+}
+func (m *ListRulesForProjectResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRulesForProjectResp.Unmarshal(m, b)
+}
+func (m *ListRulesForProjectResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRulesForProjectResp.Marshal(b, m, deterministic)
+}
+func (dst *ListRulesForProjectResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRulesForProjectResp.Merge(dst, src)
+}
+func (m *ListRulesForProjectResp) XXX_Size() int {
+	return xxx_messageInfo_ListRulesForProjectResp.Size(m)
+}
+func (m *ListRulesForProjectResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRulesForProjectResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRulesForProjectResp proto.InternalMessageInfo
+
+func (m *ListRulesForProjectResp) GetRules() []*ProjectRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type DeleteRuleReq struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	ProjectId            string   `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" toml:"project_id,omitempty" mapstructure:"project_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *DeleteRuleReq) Reset()         { *m = DeleteRuleReq{} }
+func (m *DeleteRuleReq) String() string { return proto.CompactTextString(m) }
+func (*DeleteRuleReq) ProtoMessage()    {}
+func (*DeleteRuleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{28}
+}
+func (m *DeleteRuleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRuleReq.Unmarshal(m, b)
+}
+func (m *DeleteRuleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRuleReq.Marshal(b, m, deterministic)
+}
+func (dst *DeleteRuleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRuleReq.Merge(dst, src)
+}
+func (m *DeleteRuleReq) XXX_Size() int {
+	return xxx_messageInfo_DeleteRuleReq.Size(m)
+}
+func (m *DeleteRuleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRuleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRuleReq proto.InternalMessageInfo
+
+func (m *DeleteRuleReq) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteRuleReq) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+type DeleteRuleResp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *DeleteRuleResp) Reset()         { *m = DeleteRuleResp{} }
+func (m *DeleteRuleResp) String() string { return proto.CompactTextString(m) }
+func (*DeleteRuleResp) ProtoMessage()    {}
+func (*DeleteRuleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{29}
+}
+func (m *DeleteRuleResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRuleResp.Unmarshal(m, b)
+}
+func (m *DeleteRuleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRuleResp.Marshal(b, m, deterministic)
+}
+func (dst *DeleteRuleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRuleResp.Merge(dst, src)
+}
+func (m *DeleteRuleResp) XXX_Size() int {
+	return xxx_messageInfo_DeleteRuleResp.Size(m)
+}
+func (m *DeleteRuleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRuleResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRuleResp proto.InternalMessageInfo
+
+type ApplyRulesStartReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ApplyRulesStartReq) Reset()         { *m = ApplyRulesStartReq{} }
+func (m *ApplyRulesStartReq) String() string { return proto.CompactTextString(m) }
+func (*ApplyRulesStartReq) ProtoMessage()    {}
+func (*ApplyRulesStartReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{30}
+}
+func (m *ApplyRulesStartReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyRulesStartReq.Unmarshal(m, b)
+}
+func (m *ApplyRulesStartReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyRulesStartReq.Marshal(b, m, deterministic)
+}
+func (dst *ApplyRulesStartReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyRulesStartReq.Merge(dst, src)
+}
+func (m *ApplyRulesStartReq) XXX_Size() int {
+	return xxx_messageInfo_ApplyRulesStartReq.Size(m)
+}
+func (m *ApplyRulesStartReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyRulesStartReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyRulesStartReq proto.InternalMessageInfo
+
+type ApplyRulesStartResp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ApplyRulesStartResp) Reset()         { *m = ApplyRulesStartResp{} }
+func (m *ApplyRulesStartResp) String() string { return proto.CompactTextString(m) }
+func (*ApplyRulesStartResp) ProtoMessage()    {}
+func (*ApplyRulesStartResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_project_77f69eaaf8ebc0d5, []int{31}
+}
+func (m *ApplyRulesStartResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyRulesStartResp.Unmarshal(m, b)
+}
+func (m *ApplyRulesStartResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyRulesStartResp.Marshal(b, m, deterministic)
+}
+func (dst *ApplyRulesStartResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyRulesStartResp.Merge(dst, src)
+}
+func (m *ApplyRulesStartResp) XXX_Size() int {
+	return xxx_messageInfo_ApplyRulesStartResp.Size(m)
+}
+func (m *ApplyRulesStartResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyRulesStartResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyRulesStartResp proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Project)(nil), "chef.automate.domain.authz.v2.Project")
 	proto.RegisterType((*CreateProjectReq)(nil), "chef.automate.domain.authz.v2.CreateProjectReq")
@@ -842,6 +1381,18 @@ func init() {
 	proto.RegisterType((*ProjectRules)(nil), "chef.automate.domain.authz.v2.ProjectRules")
 	proto.RegisterType((*ProjectRule)(nil), "chef.automate.domain.authz.v2.ProjectRule")
 	proto.RegisterType((*Condition)(nil), "chef.automate.domain.authz.v2.Condition")
+	proto.RegisterType((*CreateRuleReq)(nil), "chef.automate.domain.authz.v2.CreateRuleReq")
+	proto.RegisterType((*CreateRuleResp)(nil), "chef.automate.domain.authz.v2.CreateRuleResp")
+	proto.RegisterType((*UpdateRuleReq)(nil), "chef.automate.domain.authz.v2.UpdateRuleReq")
+	proto.RegisterType((*UpdateRuleResp)(nil), "chef.automate.domain.authz.v2.UpdateRuleResp")
+	proto.RegisterType((*GetRuleReq)(nil), "chef.automate.domain.authz.v2.GetRuleReq")
+	proto.RegisterType((*GetRuleResp)(nil), "chef.automate.domain.authz.v2.GetRuleResp")
+	proto.RegisterType((*ListRulesForProjectReq)(nil), "chef.automate.domain.authz.v2.ListRulesForProjectReq")
+	proto.RegisterType((*ListRulesForProjectResp)(nil), "chef.automate.domain.authz.v2.ListRulesForProjectResp")
+	proto.RegisterType((*DeleteRuleReq)(nil), "chef.automate.domain.authz.v2.DeleteRuleReq")
+	proto.RegisterType((*DeleteRuleResp)(nil), "chef.automate.domain.authz.v2.DeleteRuleResp")
+	proto.RegisterType((*ApplyRulesStartReq)(nil), "chef.automate.domain.authz.v2.ApplyRulesStartReq")
+	proto.RegisterType((*ApplyRulesStartResp)(nil), "chef.automate.domain.authz.v2.ApplyRulesStartResp")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -861,8 +1412,16 @@ type ProjectsClient interface {
 	GetProject(ctx context.Context, in *GetProjectReq, opts ...grpc.CallOption) (*GetProjectResp, error)
 	DeleteProject(ctx context.Context, in *DeleteProjectReq, opts ...grpc.CallOption) (*DeleteProjectResp, error)
 	ListProjects(ctx context.Context, in *ListProjectsReq, opts ...grpc.CallOption) (*ListProjectsResp, error)
-	// Note: POC functions that are not ready to expose outside of our
-	// spike (aka keep them out of the gateway for now).
+	// Changes to ingest rules are staged; they take effect when applied
+	// using ApplyRulesStart.
+	CreateRule(ctx context.Context, in *CreateRuleReq, opts ...grpc.CallOption) (*CreateRuleResp, error)
+	UpdateRule(ctx context.Context, in *UpdateRuleReq, opts ...grpc.CallOption) (*UpdateRuleResp, error)
+	GetRule(ctx context.Context, in *GetRuleReq, opts ...grpc.CallOption) (*GetRuleResp, error)
+	ListRulesForProject(ctx context.Context, in *ListRulesForProjectReq, opts ...grpc.CallOption) (*ListRulesForProjectResp, error)
+	DeleteRule(ctx context.Context, in *DeleteRuleReq, opts ...grpc.CallOption) (*DeleteRuleResp, error)
+	ApplyRulesStart(ctx context.Context, in *ApplyRulesStartReq, opts ...grpc.CallOption) (*ApplyRulesStartResp, error)
+	// The rules in effect, used by the domain services for tagging their
+	// resources with projects.
 	ListProjectRules(ctx context.Context, in *ListProjectRulesReq, opts ...grpc.CallOption) (*ProjectCollectionRulesResp, error)
 	GetProjectRules(ctx context.Context, in *GetProjectRulesReq, opts ...grpc.CallOption) (*GetProjectRulesResp, error)
 	HandleEvent(ctx context.Context, in *event.EventMsg, opts ...grpc.CallOption) (*event.EventResponse, error)
@@ -922,6 +1481,60 @@ func (c *projectsClient) ListProjects(ctx context.Context, in *ListProjectsReq,
 	return out, nil
 }
 
+func (c *projectsClient) CreateRule(ctx context.Context, in *CreateRuleReq, opts ...grpc.CallOption) (*CreateRuleResp, error) {
+	out := new(CreateRuleResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Projects/CreateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) UpdateRule(ctx context.Context, in *UpdateRuleReq, opts ...grpc.CallOption) (*UpdateRuleResp, error) {
+	out := new(UpdateRuleResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Projects/UpdateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) GetRule(ctx context.Context, in *GetRuleReq, opts ...grpc.CallOption) (*GetRuleResp, error) {
+	out := new(GetRuleResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Projects/GetRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) ListRulesForProject(ctx context.Context, in *ListRulesForProjectReq, opts ...grpc.CallOption) (*ListRulesForProjectResp, error) {
+	out := new(ListRulesForProjectResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Projects/ListRulesForProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) DeleteRule(ctx context.Context, in *DeleteRuleReq, opts ...grpc.CallOption) (*DeleteRuleResp, error) {
+	out := new(DeleteRuleResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Projects/DeleteRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) ApplyRulesStart(ctx context.Context, in *ApplyRulesStartReq, opts ...grpc.CallOption) (*ApplyRulesStartResp, error) {
+	out := new(ApplyRulesStartResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Projects/ApplyRulesStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectsClient) ListProjectRules(ctx context.Context, in *ListProjectRulesReq, opts ...grpc.CallOption) (*ProjectCollectionRulesResp, error) {
 	out := new(ProjectCollectionRulesResp)
 	err := c.cc.Invoke(ctx, "/chef.automate.domain.authz.v2.Projects/ListProjectRules", in, out, opts...)
@@ -965,8 +1578,16 @@ type ProjectsServer interface {
 	GetProject(context.Context, *GetProjectReq) (*GetProjectResp, error)
 	DeleteProject(context.Context, *DeleteProjectReq) (*DeleteProjectResp, error)
 	ListProjects(context.Context, *ListProjectsReq) (*ListProjectsResp, error)
-	// Note: POC functions that are not ready to expose outside of our
-	// spike (aka keep them out of the gateway for now).
+	// Changes to ingest rules are staged; they take effect when applied
+	// using ApplyRulesStart.
+	CreateRule(context.Context, *CreateRuleReq) (*CreateRuleResp, error)
+	UpdateRule(context.Context, *UpdateRuleReq) (*UpdateRuleResp, error)
+	GetRule(context.Context, *GetRuleReq) (*GetRuleResp, error)
+	ListRulesForProject(context.Context, *ListRulesForProjectReq) (*ListRulesForProjectResp, error)
+	DeleteRule(context.Context, *DeleteRuleReq) (*DeleteRuleResp, error)
+	ApplyRulesStart(context.Context, *ApplyRulesStartReq) (*ApplyRulesStartResp, error)
+	// The rules in effect, used by the domain services for tagging their
+	// resources with projects.
 	ListProjectRules(context.Context, *ListProjectRulesReq) (*ProjectCollectionRulesResp, error)
 	GetProjectRules(context.Context, *GetProjectRulesReq) (*GetProjectRulesResp, error)
 	HandleEvent(context.Context, *event.EventMsg) (*event.EventResponse, error)
@@ -1067,6 +1688,114 @@ func _Projects_ListProjects_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Projects_CreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).CreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Projects/CreateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).CreateRule(ctx, req.(*CreateRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_UpdateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).UpdateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Projects/UpdateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).UpdateRule(ctx, req.(*UpdateRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_GetRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).GetRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Projects/GetRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).GetRule(ctx, req.(*GetRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_ListRulesForProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRulesForProjectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).ListRulesForProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Projects/ListRulesForProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).ListRulesForProject(ctx, req.(*ListRulesForProjectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_DeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).DeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Projects/DeleteRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).DeleteRule(ctx, req.(*DeleteRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_ApplyRulesStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRulesStartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).ApplyRulesStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.authz.v2.Projects/ApplyRulesStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).ApplyRulesStart(ctx, req.(*ApplyRulesStartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Projects_ListProjectRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectRulesReq)
 	if err := dec(in); err != nil {
@@ -1163,6 +1892,30 @@ var _Projects_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListProjects",
 			Handler:    _Projects_ListProjects_Handler,
 		},
+		{
+			MethodName: "CreateRule",
+			Handler:    _Projects_CreateRule_Handler,
+		},
+		{
+			MethodName: "UpdateRule",
+			Handler:    _Projects_UpdateRule_Handler,
+		},
+		{
+			MethodName: "GetRule",
+			Handler:    _Projects_GetRule_Handler,
+		},
+		{
+			MethodName: "ListRulesForProject",
+			Handler:    _Projects_ListRulesForProject_Handler,
+		},
+		{
+			MethodName: "DeleteRule",
+			Handler:    _Projects_DeleteRule_Handler,
+		},
+		{
+			MethodName: "ApplyRulesStart",
+			Handler:    _Projects_ApplyRulesStart_Handler,
+		},
 		{
 			MethodName: "ListProjectRules",
 			Handler:    _Projects_ListProjectRules_Handler,
@@ -1185,66 +1938,83 @@ var _Projects_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("api/interservice/authz/v2/project.proto", fileDescriptor_project_23423a13b105513b)
-}
-
-var fileDescriptor_project_23423a13b105513b = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5f, 0x8f, 0xdb, 0x44,
-	0x10, 0x3f, 0x27, 0x77, 0xed, 0x65, 0x72, 0x7f, 0x92, 0x0d, 0xbd, 0x46, 0x96, 0x2a, 0xa2, 0xa5,
-	0x82, 0xa8, 0x34, 0x76, 0xeb, 0x96, 0x96, 0x82, 0x84, 0xca, 0x85, 0x42, 0x11, 0x20, 0x55, 0xe6,
-	0x4a, 0x25, 0x10, 0x44, 0x7b, 0xf6, 0x26, 0x67, 0xea, 0xd8, 0x7b, 0xde, 0x4d, 0xa4, 0x1c, 0xe2,
-	0x05, 0xf8, 0x4c, 0x3c, 0x20, 0x21, 0xf1, 0x75, 0xf8, 0x0e, 0x3c, 0x20, 0xaf, 0xbd, 0x77, 0xb6,
-	0x93, 0xd4, 0xc9, 0xe9, 0x5e, 0xa2, 0xec, 0xec, 0xfc, 0x7e, 0xf3, 0xdb, 0xd9, 0x9d, 0x19, 0xc3,
-	0x7b, 0x84, 0x79, 0xa6, 0x17, 0x08, 0x1a, 0x71, 0x1a, 0x4d, 0x3d, 0x87, 0x9a, 0x64, 0x22, 0x4e,
-	0xce, 0xcc, 0xa9, 0x65, 0xb2, 0x28, 0xfc, 0x99, 0x3a, 0xc2, 0x60, 0x51, 0x28, 0x42, 0x74, 0xcb,
-	0x39, 0xa1, 0x43, 0x83, 0x4c, 0x44, 0x38, 0x26, 0x82, 0x1a, 0x6e, 0x38, 0x26, 0x5e, 0x60, 0x48,
-	0x67, 0x63, 0x6a, 0xe9, 0x37, 0xa7, 0xc4, 0xf7, 0x5c, 0x22, 0xa8, 0xa9, 0xfe, 0x24, 0x38, 0xfd,
-	0xf6, 0xf2, 0x00, 0x62, 0xc6, 0x94, 0x17, 0x9e, 0xf3, 0xa2, 0x53, 0x1a, 0x88, 0xe4, 0x37, 0xf5,
-	0x79, 0x7b, 0x14, 0x86, 0x23, 0x9f, 0x9a, 0x72, 0x75, 0x3c, 0x19, 0x9a, 0xc2, 0x1b, 0x53, 0x2e,
-	0xc8, 0x98, 0x25, 0x0e, 0xf8, 0x37, 0x0d, 0xae, 0xbf, 0x48, 0x44, 0x23, 0x04, 0x9b, 0x01, 0x19,
-	0xd3, 0xb6, 0xd6, 0xd1, 0xba, 0x35, 0x5b, 0xfe, 0x47, 0x7b, 0x50, 0xf1, 0xdc, 0x76, 0x45, 0x5a,
-	0x2a, 0x9e, 0x8b, 0x1e, 0xc3, 0x66, 0x2c, 0xa1, 0x5d, 0xed, 0x68, 0xdd, 0x3d, 0xeb, 0x1d, 0xe3,
-	0x8d, 0x27, 0x34, 0x8e, 0x66, 0x8c, 0xda, 0x12, 0x80, 0x74, 0xd8, 0x4e, 0x93, 0xc3, 0xdb, 0x9b,
-	0x9d, 0x6a, 0xb7, 0x66, 0x9f, 0xaf, 0xb1, 0x0d, 0x8d, 0x7e, 0x44, 0x89, 0xa0, 0xa9, 0x12, 0x9b,
-	0x9e, 0x2e, 0x14, 0x73, 0xe7, 0x42, 0xcc, 0xa1, 0xfe, 0xd7, 0xbf, 0xff, 0x54, 0x6f, 0x44, 0x2d,
-	0xab, 0xf9, 0xd3, 0x0f, 0xa4, 0x77, 0x76, 0xaf, 0xf7, 0xa4, 0xf7, 0xe3, 0x2f, 0xf7, 0xef, 0x3e,
-	0x7a, 0xf8, 0xeb, 0xed, 0x58, 0x28, 0x7e, 0x09, 0xcd, 0x02, 0x27, 0x67, 0xe8, 0x29, 0x5c, 0x4f,
-	0x83, 0x4a, 0xde, 0xba, 0xf5, 0x6e, 0xc9, 0x01, 0x14, 0x58, 0xc1, 0xf0, 0xc7, 0xb0, 0xfb, 0x05,
-	0x15, 0x19, 0x9d, 0x89, 0x26, 0x6d, 0x25, 0x4d, 0x36, 0xec, 0x65, 0xc1, 0x57, 0x22, 0xa8, 0x09,
-	0xfb, 0x5f, 0x7b, 0x5c, 0x91, 0x72, 0x9b, 0x9e, 0xe2, 0xef, 0xa0, 0x91, 0x37, 0x71, 0x86, 0x0e,
-	0x33, 0xe9, 0xd7, 0x3a, 0xd5, 0x35, 0x22, 0xe5, 0xae, 0xe9, 0x25, 0x73, 0xaf, 0xfc, 0x9a, 0x0a,
-	0x9c, 0x57, 0x92, 0x95, 0x4f, 0xa0, 0xf1, 0x19, 0xf5, 0x69, 0x4e, 0xea, 0x3a, 0x37, 0xd5, 0x82,
-	0x66, 0x01, 0xcf, 0x19, 0x6e, 0xc3, 0x41, 0xba, 0x4c, 0x24, 0x7f, 0x2b, 0x88, 0x98, 0xc8, 0x8c,
-	0xff, 0xa9, 0xc1, 0xcd, 0x85, 0x5b, 0x9c, 0xa1, 0xb7, 0x60, 0x8b, 0x0b, 0x22, 0x54, 0x8a, 0x92,
-	0x05, 0x3a, 0x82, 0x36, 0xe5, 0xc2, 0x8b, 0x4f, 0xe3, 0x0e, 0xe2, 0xa2, 0x1c, 0x38, 0xe1, 0x98,
-	0xc9, 0x90, 0x32, 0x73, 0x75, 0x4b, 0x37, 0x92, 0xda, 0x35, 0x54, 0xed, 0x1a, 0x47, 0xaa, 0x76,
-	0xed, 0x83, 0x73, 0x6c, 0x6c, 0xeb, 0x2b, 0x24, 0x32, 0xa1, 0xc5, 0x68, 0xe4, 0xd0, 0x40, 0x90,
-	0x51, 0xc2, 0x28, 0x09, 0xe3, 0x62, 0xad, 0xd8, 0xe8, 0x62, 0xab, 0x9f, 0xee, 0xe0, 0x1b, 0xd0,
-	0xca, 0x3c, 0x15, 0x7b, 0xe2, 0x53, 0x79, 0x9e, 0xff, 0x34, 0xd0, 0x53, 0x5b, 0x3f, 0xf4, 0x7d,
-	0xea, 0x08, 0x2f, 0x0c, 0xd2, 0x5d, 0xce, 0x10, 0x83, 0xdd, 0x34, 0xd1, 0x83, 0x28, 0x36, 0xb6,
-	0x2b, 0xf2, 0x45, 0x7d, 0xb5, 0xda, 0x2d, 0x2d, 0x60, 0x34, 0xb2, 0x02, 0x9e, 0x05, 0x22, 0x9a,
-	0xd9, 0x3b, 0x2c, 0x63, 0xd2, 0x7d, 0x68, 0xce, 0xb9, 0xa0, 0x06, 0x54, 0x5f, 0xd3, 0x59, 0x9a,
-	0xd7, 0xf8, 0x2f, 0xfa, 0x14, 0xb6, 0xa6, 0xc4, 0x9f, 0xa8, 0x14, 0xbe, 0xbf, 0xe2, 0xb3, 0x91,
-	0x32, 0x12, 0xe4, 0x47, 0x95, 0x0f, 0x35, 0xfc, 0x00, 0x50, 0xa6, 0x4e, 0xd3, 0xa4, 0xa0, 0x5b,
-	0x00, 0xea, 0xd4, 0xea, 0x1d, 0xd9, 0xb5, 0xd4, 0xf2, 0xa5, 0x8b, 0x03, 0x68, 0xcd, 0x81, 0x38,
-	0x43, 0xaf, 0xa0, 0x29, 0x73, 0x34, 0x18, 0x86, 0xd1, 0x40, 0xbd, 0xea, 0x4b, 0xc8, 0xdb, 0x97,
-	0x2c, 0x9f, 0x87, 0x51, 0x6a, 0xc5, 0x2f, 0x60, 0x27, 0xeb, 0x80, 0x9e, 0xc2, 0x56, 0xf6, 0x32,
-	0xee, 0xac, 0x4e, 0x6e, 0x27, 0x40, 0xfc, 0x0a, 0xea, 0x19, 0x2b, 0x7a, 0x0e, 0xe0, 0x84, 0x81,
-	0xeb, 0xc5, 0x37, 0xa5, 0x58, 0xbb, 0x25, 0xac, 0x7d, 0x05, 0xb0, 0x33, 0x58, 0xfc, 0x18, 0x6a,
-	0xe7, 0x1b, 0x71, 0xc7, 0x90, 0x13, 0x24, 0xed, 0x18, 0x72, 0x38, 0x1c, 0xc0, 0x35, 0x99, 0xfd,
-	0x24, 0x4c, 0xcd, 0x4e, 0x57, 0xd6, 0xdf, 0xdb, 0xb0, 0xad, 0xda, 0x18, 0x12, 0xb0, 0x9b, 0x6b,
-	0x15, 0xc8, 0x2c, 0x11, 0x53, 0x6c, 0x56, 0xfa, 0xbd, 0xf5, 0x00, 0x9c, 0xe1, 0x8d, 0x38, 0x6a,
-	0x6e, 0x8e, 0x94, 0x46, 0x2d, 0x4e, 0xb2, 0xd2, 0xa8, 0x73, 0x63, 0x0a, 0x6f, 0xa0, 0xd7, 0x00,
-	0x17, 0x8f, 0x09, 0xdd, 0x2d, 0x61, 0xc8, 0x4d, 0x24, 0xbd, 0xb7, 0x86, 0xb7, 0x3a, 0x62, 0xae,
-	0xd9, 0x95, 0x1e, 0xb1, 0xd8, 0x5a, 0x4b, 0x8f, 0x38, 0xdf, 0x4b, 0x37, 0xd0, 0x29, 0xec, 0x64,
-	0xa7, 0x14, 0x32, 0x4a, 0x38, 0x0a, 0x53, 0x4e, 0x37, 0xd7, 0xf2, 0x97, 0x21, 0x7f, 0xd7, 0x72,
-	0x93, 0x31, 0xa9, 0x1b, 0x6b, 0x75, 0x1e, 0xd5, 0x0a, 0xf4, 0x27, 0x97, 0xee, 0x74, 0x78, 0x03,
-	0x9d, 0xc1, 0x7e, 0xa1, 0x51, 0xa0, 0xfb, 0xab, 0x5f, 0x99, 0x92, 0x60, 0xad, 0x0b, 0x91, 0xb1,
-	0x87, 0x50, 0x7f, 0x4e, 0x02, 0xd7, 0xa7, 0xcf, 0xe2, 0x8f, 0x44, 0xb4, 0xa4, 0x9c, 0x93, 0x2f,
-	0x48, 0xc2, 0x3c, 0x43, 0xba, 0x7d, 0xc3, 0x47, 0xcb, 0x1e, 0x55, 0xc1, 0x33, 0x8e, 0x12, 0x06,
-	0x9c, 0xa2, 0x3f, 0x34, 0x68, 0x2d, 0x18, 0x88, 0xe8, 0x83, 0xd5, 0x12, 0x57, 0x98, 0xaf, 0xfa,
-	0xa3, 0xcb, 0xc0, 0x38, 0x3b, 0x7c, 0xf8, 0xbd, 0x35, 0xf2, 0xc4, 0xc9, 0xe4, 0xd8, 0x70, 0xc2,
-	0xb1, 0x19, 0x73, 0x98, 0x8a, 0xc3, 0x5c, 0xfa, 0x8d, 0x7d, 0x7c, 0x4d, 0x4e, 0xdc, 0x07, 0xff,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x42, 0x82, 0xfd, 0xe8, 0x0b, 0x00, 0x00,
+	proto.RegisterFile("api/interservice/authz/v2/project.proto", fileDescriptor_project_77f69eaaf8ebc0d5)
+}
+
+var fileDescriptor_project_77f69eaaf8ebc0d5 = []byte{
+	// 1172 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xd7, 0xda, 0xce, 0xd7, 0xc9, 0xa7, 0x27, 0x6d, 0x62, 0x8d, 0x54, 0xfd, 0xa3, 0xfd, 0x57,
+	0x10, 0x42, 0xbd, 0x9b, 0xba, 0x25, 0x25, 0x20, 0x55, 0x6d, 0xd2, 0xd2, 0x22, 0xa8, 0x14, 0x6d,
+	0x53, 0x90, 0xa8, 0x20, 0x6c, 0xbc, 0x93, 0x64, 0xc1, 0xde, 0x9d, 0xee, 0x8c, 0x2d, 0x52, 0xc4,
+	0x05, 0x88, 0x67, 0xe2, 0x82, 0x2b, 0x1e, 0x80, 0x3b, 0x78, 0x09, 0xde, 0x81, 0x0b, 0x34, 0x5f,
+	0xce, 0xee, 0xda, 0x61, 0xbd, 0x56, 0xd4, 0x0b, 0x6e, 0xac, 0x9d, 0xb3, 0xe7, 0x77, 0x3e, 0x7e,
+	0x67, 0xe6, 0xcc, 0x59, 0xc3, 0xdb, 0x3e, 0x0d, 0xdd, 0x30, 0xe2, 0x24, 0x61, 0x24, 0xe9, 0x87,
+	0x6d, 0xe2, 0xfa, 0x3d, 0x7e, 0xf6, 0xda, 0xed, 0xb7, 0x5c, 0x9a, 0xc4, 0xdf, 0x90, 0x36, 0x77,
+	0x68, 0x12, 0xf3, 0x18, 0xdd, 0x68, 0x9f, 0x91, 0x13, 0xc7, 0xef, 0xf1, 0xb8, 0xeb, 0x73, 0xe2,
+	0x04, 0x71, 0xd7, 0x0f, 0x23, 0x47, 0x2a, 0x3b, 0xfd, 0x16, 0x5e, 0xef, 0xfb, 0x9d, 0x30, 0xf0,
+	0x39, 0x71, 0xcd, 0x83, 0xc2, 0xe1, 0x9b, 0x97, 0x3b, 0xe0, 0xe7, 0xd4, 0x68, 0xd9, 0x43, 0x5a,
+	0xa4, 0x4f, 0x22, 0xae, 0x7e, 0xb5, 0xce, 0xff, 0x4e, 0xe3, 0xf8, 0xb4, 0x43, 0x5c, 0xb9, 0x3a,
+	0xee, 0x9d, 0xb8, 0x3c, 0xec, 0x12, 0xc6, 0xfd, 0x2e, 0x55, 0x0a, 0xf6, 0x4f, 0x16, 0xcc, 0x1c,
+	0xa8, 0xa0, 0x11, 0x82, 0x5a, 0xe4, 0x77, 0x49, 0xc3, 0xda, 0xb0, 0x36, 0xe7, 0x3c, 0xf9, 0x8c,
+	0x96, 0xa0, 0x12, 0x06, 0x8d, 0x8a, 0x94, 0x54, 0xc2, 0x00, 0xdd, 0x83, 0x9a, 0x08, 0xa1, 0x51,
+	0xdd, 0xb0, 0x36, 0x97, 0x5a, 0xff, 0x77, 0xfe, 0x35, 0x43, 0xe7, 0xf0, 0x9c, 0x12, 0x4f, 0x02,
+	0x10, 0x86, 0x59, 0x4d, 0x0e, 0x6b, 0xd4, 0x36, 0xaa, 0x9b, 0x73, 0xde, 0x60, 0x6d, 0x7b, 0xb0,
+	0xb2, 0x9f, 0x10, 0x9f, 0x13, 0x1d, 0x89, 0x47, 0x5e, 0x8d, 0x0c, 0x66, 0xeb, 0x22, 0x98, 0x3d,
+	0xfc, 0xeb, 0x5f, 0xbf, 0x55, 0xaf, 0x27, 0xab, 0xad, 0xfa, 0x57, 0x2f, 0xfd, 0xe6, 0xeb, 0xed,
+	0xe6, 0x6e, 0xf3, 0xcb, 0xef, 0x6f, 0xdf, 0xda, 0xb9, 0xfb, 0xc3, 0x4d, 0x11, 0xa8, 0xfd, 0x02,
+	0xea, 0x39, 0x9b, 0x8c, 0xa2, 0x07, 0x30, 0xa3, 0x9d, 0x4a, 0xbb, 0xf3, 0xad, 0xb7, 0x0a, 0x12,
+	0x30, 0x60, 0x03, 0xb3, 0x3f, 0x84, 0xc5, 0x27, 0x84, 0xa7, 0xe2, 0x54, 0x31, 0x59, 0x63, 0xc5,
+	0xe4, 0xc1, 0x52, 0x1a, 0x7c, 0x25, 0x01, 0xd5, 0x61, 0xf9, 0xd3, 0x90, 0x19, 0xa3, 0xcc, 0x23,
+	0xaf, 0xec, 0xcf, 0x60, 0x25, 0x2b, 0x62, 0x14, 0xed, 0xa5, 0xe8, 0xb7, 0x36, 0xaa, 0x25, 0x3c,
+	0x65, 0xca, 0xf4, 0x82, 0x06, 0x57, 0x5e, 0xa6, 0x9c, 0xcd, 0x2b, 0x61, 0xe5, 0x3e, 0xac, 0x3c,
+	0x22, 0x1d, 0x92, 0x09, 0xb5, 0x4c, 0xa5, 0x56, 0xa1, 0x9e, 0xc3, 0x33, 0x6a, 0x37, 0x60, 0x4d,
+	0x2f, 0x55, 0xc8, 0xcf, 0xb9, 0xcf, 0x7b, 0x92, 0xf1, 0x5f, 0x2c, 0x58, 0x1f, 0xf9, 0x8a, 0x51,
+	0x74, 0x0d, 0xa6, 0x18, 0xf7, 0xb9, 0xa1, 0x48, 0x2d, 0xd0, 0x21, 0x34, 0x08, 0xe3, 0xa1, 0xc8,
+	0x26, 0x38, 0x12, 0x87, 0xf2, 0xa8, 0x1d, 0x77, 0xa9, 0x74, 0x29, 0x99, 0x9b, 0x6f, 0x61, 0x47,
+	0x9d, 0x5d, 0xc7, 0x9c, 0x5d, 0xe7, 0xd0, 0x9c, 0x5d, 0x6f, 0x6d, 0x80, 0x15, 0xb2, 0x7d, 0x83,
+	0x44, 0x2e, 0xac, 0x52, 0x92, 0xb4, 0x49, 0xc4, 0xfd, 0x53, 0x65, 0x51, 0x1a, 0x14, 0x87, 0xb5,
+	0xe2, 0xa1, 0x8b, 0x57, 0xfb, 0xfa, 0x8d, 0x7d, 0x1d, 0x56, 0x53, 0x5b, 0xc5, 0xeb, 0x75, 0x88,
+	0xcc, 0xe7, 0x6f, 0x0b, 0xb0, 0x96, 0xed, 0xc7, 0x9d, 0x0e, 0x69, 0xf3, 0x30, 0x8e, 0xf4, 0x5b,
+	0x46, 0x11, 0x85, 0x45, 0x4d, 0xf4, 0x51, 0x22, 0x84, 0x8d, 0x8a, 0xdc, 0x51, 0x9f, 0x8c, 0x57,
+	0xa5, 0x11, 0x16, 0x9d, 0x74, 0x00, 0x8f, 0x23, 0x9e, 0x9c, 0x7b, 0x0b, 0x34, 0x25, 0xc2, 0x1d,
+	0xa8, 0x0f, 0xa9, 0xa0, 0x15, 0xa8, 0x7e, 0x4b, 0xce, 0x35, 0xaf, 0xe2, 0x11, 0x3d, 0x84, 0xa9,
+	0xbe, 0xdf, 0xe9, 0x19, 0x0a, 0xdf, 0x1d, 0x73, 0xdb, 0xc8, 0x30, 0x14, 0xf2, 0x83, 0xca, 0xfb,
+	0x96, 0x7d, 0x07, 0x50, 0xea, 0x9c, 0x6a, 0x52, 0xd0, 0x0d, 0x00, 0x93, 0xb5, 0xd9, 0x47, 0xde,
+	0x9c, 0x96, 0x7c, 0x1c, 0xd8, 0x11, 0xac, 0x0e, 0x81, 0x18, 0x45, 0x9f, 0x43, 0x5d, 0x72, 0x74,
+	0x74, 0x12, 0x27, 0x47, 0x66, 0x57, 0x4f, 0x10, 0xde, 0xb2, 0xb4, 0xf2, 0x51, 0x9c, 0x68, 0xa9,
+	0x7d, 0x00, 0x0b, 0x69, 0x05, 0xf4, 0x00, 0xa6, 0xd2, 0xc5, 0xd8, 0x1a, 0xdf, 0xb8, 0xa7, 0x80,
+	0xf6, 0xef, 0x16, 0xcc, 0xa7, 0xc4, 0xe8, 0x29, 0x40, 0x3b, 0x8e, 0x82, 0x50, 0x94, 0xca, 0x98,
+	0xdd, 0x2c, 0x30, 0xbb, 0x6f, 0x00, 0x5e, 0x0a, 0xab, 0x6f, 0x91, 0xea, 0xe0, 0x16, 0xc9, 0x52,
+	0x59, 0xcb, 0x51, 0x39, 0x68, 0x2a, 0x53, 0xa9, 0xa6, 0xb2, 0x06, 0xd3, 0x4c, 0x1e, 0xaa, 0xc6,
+	0xb4, 0x94, 0xea, 0x15, 0x6a, 0xc0, 0x4c, 0x20, 0x37, 0x7f, 0xd0, 0x98, 0xd9, 0xb0, 0x36, 0x67,
+	0x3d, 0xb3, 0xb4, 0xef, 0xc1, 0xdc, 0x20, 0x1a, 0x61, 0x52, 0xde, 0x5b, 0xba, 0x4f, 0x89, 0x67,
+	0x61, 0x52, 0xd6, 0x5c, 0xe5, 0x36, 0xe7, 0xe9, 0x95, 0xfd, 0xa7, 0x05, 0x8b, 0xea, 0xee, 0x90,
+	0xec, 0x94, 0x6b, 0x1d, 0x68, 0x37, 0x93, 0x5b, 0x71, 0x17, 0x1c, 0x91, 0x77, 0x35, 0x95, 0x77,
+	0xb6, 0x08, 0xb5, 0xc9, 0x8b, 0x60, 0x1f, 0xc0, 0x52, 0x3a, 0x2b, 0x46, 0xd1, 0x7d, 0xa8, 0x89,
+	0xca, 0xeb, 0x26, 0x5b, 0x66, 0xc7, 0x48, 0x9c, 0x24, 0x4a, 0xf5, 0xbb, 0xff, 0x1a, 0x51, 0xe9,
+	0xac, 0xae, 0x80, 0x28, 0x06, 0xf0, 0x84, 0xf0, 0x37, 0x4b, 0x92, 0xfd, 0x0c, 0xe6, 0x07, 0x4e,
+	0xaf, 0x20, 0x87, 0x47, 0xb0, 0x26, 0xae, 0x0a, 0x2f, 0xdb, 0x86, 0xca, 0x5e, 0xac, 0x2f, 0x61,
+	0x7d, 0xa4, 0x15, 0x79, 0xeb, 0xeb, 0x06, 0x66, 0x4d, 0xda, 0xc0, 0xfa, 0xb0, 0xa8, 0x6e, 0xed,
+	0x37, 0xcc, 0xf4, 0x0a, 0x2c, 0xa5, 0xfd, 0x32, 0x6a, 0x5f, 0x03, 0xf4, 0x90, 0xd2, 0xce, 0xb9,
+	0xcc, 0xf3, 0x39, 0xf7, 0x13, 0x41, 0x94, 0xb8, 0x6d, 0x87, 0xa4, 0x8c, 0xb6, 0xfe, 0x58, 0x84,
+	0x59, 0x33, 0xac, 0xa1, 0xc4, 0x1c, 0x29, 0x2d, 0x41, 0x6e, 0x01, 0x0f, 0xf9, 0x91, 0x0c, 0x6f,
+	0x97, 0x03, 0x30, 0x2a, 0x7c, 0x66, 0x66, 0xe5, 0x42, 0x9f, 0xf9, 0x69, 0x1d, 0x6f, 0x97, 0x03,
+	0x30, 0x8a, 0x42, 0x79, 0x24, 0x8c, 0xc3, 0x5b, 0x05, 0xf8, 0xcc, 0xcc, 0x8d, 0x9b, 0x25, 0xb4,
+	0x55, 0x7a, 0x99, 0x61, 0xae, 0x30, 0xbd, 0xfc, 0xe8, 0x88, 0xb7, 0xcb, 0x01, 0x18, 0x45, 0x31,
+	0x2c, 0xa4, 0x67, 0x70, 0xe4, 0x14, 0x58, 0xc8, 0xcd, 0xf0, 0xd8, 0x2d, 0xa5, 0xaf, 0xf8, 0xbc,
+	0xe8, 0xee, 0x85, 0x7c, 0x66, 0xae, 0x37, 0xdc, 0x2c, 0xa1, 0xad, 0x5c, 0x5d, 0xf4, 0xc7, 0x42,
+	0x57, 0x99, 0x0b, 0x02, 0x37, 0x4b, 0x68, 0x33, 0x8a, 0xbe, 0x86, 0x19, 0xdd, 0xc3, 0xd0, 0x3b,
+	0xc5, 0x45, 0x37, 0x4e, 0xb6, 0xc6, 0x55, 0x65, 0x14, 0xfd, 0x6c, 0xa9, 0x11, 0x38, 0xd7, 0x91,
+	0xd0, 0x7b, 0x63, 0x14, 0x60, 0xb8, 0x17, 0xe2, 0x9d, 0x49, 0x60, 0x8a, 0xd3, 0x8b, 0x16, 0x52,
+	0xc8, 0x69, 0xa6, 0xcb, 0xe1, 0x66, 0x09, 0x6d, 0x46, 0xd1, 0x77, 0xb0, 0x9c, 0xeb, 0x42, 0xe8,
+	0x76, 0x81, 0x85, 0xe1, 0x5e, 0x86, 0x5b, 0x65, 0x21, 0x8c, 0xa2, 0x1f, 0xad, 0xcc, 0x97, 0xa9,
+	0x7c, 0x8b, 0x5a, 0xe3, 0xef, 0x74, 0x33, 0x8a, 0xe3, 0xdd, 0x89, 0xbf, 0x34, 0x44, 0xf6, 0xb9,
+	0x31, 0xbd, 0x30, 0xfb, 0xe1, 0x6f, 0x01, 0xdc, 0x2a, 0x0b, 0x61, 0x14, 0x9d, 0xc0, 0xfc, 0x53,
+	0x3f, 0x0a, 0x3a, 0xe4, 0xb1, 0xf8, 0x83, 0x06, 0x5d, 0x32, 0x9b, 0xa8, 0x7f, 0x6f, 0x7c, 0x1a,
+	0x3a, 0x52, 0xed, 0x19, 0x3b, 0xc5, 0xcd, 0xb1, 0x34, 0x85, 0x8f, 0x38, 0x62, 0x44, 0xee, 0xe8,
+	0x11, 0x1f, 0xa3, 0x85, 0x3b, 0x7a, 0xf4, 0xb7, 0x2d, 0xde, 0x99, 0x04, 0xc6, 0xe8, 0xde, 0xdd,
+	0x2f, 0x5a, 0xa7, 0x21, 0x3f, 0xeb, 0x1d, 0x3b, 0xed, 0xb8, 0xeb, 0x0a, 0x1b, 0xae, 0xb1, 0xe1,
+	0x5e, 0xfa, 0xff, 0xd6, 0xf1, 0xb4, 0xfc, 0xda, 0xbd, 0xf3, 0xcf, 0x00, 0x1a, 0xaa, 0x26, 0xf3,
+	0x64, 0x13, 0x00, 0x00,
 }
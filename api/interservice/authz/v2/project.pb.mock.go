@@ -35,6 +35,12 @@ type ProjectsServerMock struct {
 	GetProjectFunc          func(context.Context, *GetProjectReq) (*GetProjectResp, error)
 	DeleteProjectFunc       func(context.Context, *DeleteProjectReq) (*DeleteProjectResp, error)
 	ListProjectsFunc        func(context.Context, *ListProjectsReq) (*ListProjectsResp, error)
+	CreateRuleFunc          func(context.Context, *CreateRuleReq) (*CreateRuleResp, error)
+	UpdateRuleFunc          func(context.Context, *UpdateRuleReq) (*UpdateRuleResp, error)
+	GetRuleFunc             func(context.Context, *GetRuleReq) (*GetRuleResp, error)
+	ListRulesForProjectFunc func(context.Context, *ListRulesForProjectReq) (*ListRulesForProjectResp, error)
+	DeleteRuleFunc          func(context.Context, *DeleteRuleReq) (*DeleteRuleResp, error)
+	ApplyRulesStartFunc     func(context.Context, *ApplyRulesStartReq) (*ApplyRulesStartResp, error)
 	ListProjectRulesFunc    func(context.Context, *ListProjectRulesReq) (*ProjectCollectionRulesResp, error)
 	GetProjectRulesFunc     func(context.Context, *GetProjectRulesReq) (*GetProjectRulesResp, error)
 	HandleEventFunc         func(context.Context, *event.EventMsg) (*event.EventResponse, error)
@@ -101,6 +107,78 @@ func (m *ProjectsServerMock) ListProjects(ctx context.Context, req *ListProjects
 	return nil, status.Error(codes.Internal, "mock: 'ListProjects' not implemented")
 }
 
+func (m *ProjectsServerMock) CreateRule(ctx context.Context, req *CreateRuleReq) (*CreateRuleResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.CreateRuleFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'CreateRule' not implemented")
+}
+
+func (m *ProjectsServerMock) UpdateRule(ctx context.Context, req *UpdateRuleReq) (*UpdateRuleResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.UpdateRuleFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'UpdateRule' not implemented")
+}
+
+func (m *ProjectsServerMock) GetRule(ctx context.Context, req *GetRuleReq) (*GetRuleResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.GetRuleFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'GetRule' not implemented")
+}
+
+func (m *ProjectsServerMock) ListRulesForProject(ctx context.Context, req *ListRulesForProjectReq) (*ListRulesForProjectResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ListRulesForProjectFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ListRulesForProject' not implemented")
+}
+
+func (m *ProjectsServerMock) DeleteRule(ctx context.Context, req *DeleteRuleReq) (*DeleteRuleResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.DeleteRuleFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'DeleteRule' not implemented")
+}
+
+func (m *ProjectsServerMock) ApplyRulesStart(ctx context.Context, req *ApplyRulesStartReq) (*ApplyRulesStartResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ApplyRulesStartFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ApplyRulesStart' not implemented")
+}
+
 func (m *ProjectsServerMock) ListProjectRules(ctx context.Context, req *ListProjectRulesReq) (*ProjectCollectionRulesResp, error) {
 	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
 		if err := msg.Validate(); err != nil {
@@ -156,6 +234,12 @@ func (m *ProjectsServerMock) Reset() {
 	m.GetProjectFunc = nil
 	m.DeleteProjectFunc = nil
 	m.ListProjectsFunc = nil
+	m.CreateRuleFunc = nil
+	m.UpdateRuleFunc = nil
+	m.GetRuleFunc = nil
+	m.ListRulesForProjectFunc = nil
+	m.DeleteRuleFunc = nil
+	m.ApplyRulesStartFunc = nil
 	m.ListProjectRulesFunc = nil
 	m.GetProjectRulesFunc = nil
 	m.HandleEventFunc = nil
@@ -1377,6 +1377,16 @@ func (m *ProjectRule) Validate() error {
 
 	}
 
+	// no validation rules for Id
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for Deleted
+
 	return nil
 }
 
@@ -1499,3 +1509,950 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ConditionValidationError{}
+
+// Validate checks the field values on CreateRuleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *CreateRuleReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_CreateRuleReq_Id_Pattern.MatchString(m.GetId()) {
+		return CreateRuleReqValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	if !_CreateRuleReq_ProjectId_Pattern.MatchString(m.GetProjectId()) {
+		return CreateRuleReqValidationError{
+			field:  "ProjectId",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetConditions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateRuleReqValidationError{
+					field:  fmt.Sprintf("Conditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// CreateRuleReqValidationError is the validation error returned by
+// CreateRuleReq.Validate if the designated constraints aren't met.
+type CreateRuleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRuleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRuleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRuleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRuleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRuleReqValidationError) ErrorName() string { return "CreateRuleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRuleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRuleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRuleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRuleReqValidationError{}
+
+var _CreateRuleReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+var _CreateRuleReq_ProjectId_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+// Validate checks the field values on CreateRuleResp with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *CreateRuleResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRuleRespValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateRuleRespValidationError is the validation error returned by
+// CreateRuleResp.Validate if the designated constraints aren't met.
+type CreateRuleRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRuleRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRuleRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRuleRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRuleRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRuleRespValidationError) ErrorName() string { return "CreateRuleRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRuleRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRuleResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRuleRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRuleRespValidationError{}
+
+// Validate checks the field values on UpdateRuleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *UpdateRuleReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_UpdateRuleReq_Id_Pattern.MatchString(m.GetId()) {
+		return UpdateRuleReqValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	if !_UpdateRuleReq_ProjectId_Pattern.MatchString(m.GetProjectId()) {
+		return UpdateRuleReqValidationError{
+			field:  "ProjectId",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetConditions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateRuleReqValidationError{
+					field:  fmt.Sprintf("Conditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// UpdateRuleReqValidationError is the validation error returned by
+// UpdateRuleReq.Validate if the designated constraints aren't met.
+type UpdateRuleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRuleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRuleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRuleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRuleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRuleReqValidationError) ErrorName() string { return "UpdateRuleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateRuleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRuleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRuleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRuleReqValidationError{}
+
+var _UpdateRuleReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+var _UpdateRuleReq_ProjectId_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+// Validate checks the field values on UpdateRuleResp with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *UpdateRuleResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRuleRespValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateRuleRespValidationError is the validation error returned by
+// UpdateRuleResp.Validate if the designated constraints aren't met.
+type UpdateRuleRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRuleRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRuleRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRuleRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRuleRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRuleRespValidationError) ErrorName() string { return "UpdateRuleRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateRuleRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRuleResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRuleRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRuleRespValidationError{}
+
+// Validate checks the field values on GetRuleReq with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *GetRuleReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_GetRuleReq_Id_Pattern.MatchString(m.GetId()) {
+		return GetRuleReqValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	if !_GetRuleReq_ProjectId_Pattern.MatchString(m.GetProjectId()) {
+		return GetRuleReqValidationError{
+			field:  "ProjectId",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	return nil
+}
+
+// GetRuleReqValidationError is the validation error returned by
+// GetRuleReq.Validate if the designated constraints aren't met.
+type GetRuleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRuleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRuleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRuleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRuleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRuleReqValidationError) ErrorName() string { return "GetRuleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRuleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRuleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRuleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRuleReqValidationError{}
+
+var _GetRuleReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+var _GetRuleReq_ProjectId_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+// Validate checks the field values on GetRuleResp with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *GetRuleResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRuleRespValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetRuleRespValidationError is the validation error returned by
+// GetRuleResp.Validate if the designated constraints aren't met.
+type GetRuleRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRuleRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRuleRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRuleRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRuleRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRuleRespValidationError) ErrorName() string { return "GetRuleRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRuleRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRuleResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRuleRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRuleRespValidationError{}
+
+// Validate checks the field values on ListRulesForProjectReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRulesForProjectReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListRulesForProjectReq_Id_Pattern.MatchString(m.GetId()) {
+		return ListRulesForProjectReqValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	return nil
+}
+
+// ListRulesForProjectReqValidationError is the validation error returned by
+// ListRulesForProjectReq.Validate if the designated constraints aren't met.
+type ListRulesForProjectReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRulesForProjectReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRulesForProjectReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRulesForProjectReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRulesForProjectReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRulesForProjectReqValidationError) ErrorName() string {
+	return "ListRulesForProjectReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRulesForProjectReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRulesForProjectReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRulesForProjectReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRulesForProjectReqValidationError{}
+
+var _ListRulesForProjectReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+// Validate checks the field values on ListRulesForProjectResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRulesForProjectResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRulesForProjectRespValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListRulesForProjectRespValidationError is the validation error returned by
+// ListRulesForProjectResp.Validate if the designated constraints aren't met.
+type ListRulesForProjectRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRulesForProjectRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRulesForProjectRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRulesForProjectRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRulesForProjectRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRulesForProjectRespValidationError) ErrorName() string {
+	return "ListRulesForProjectRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRulesForProjectRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRulesForProjectResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRulesForProjectRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRulesForProjectRespValidationError{}
+
+// Validate checks the field values on DeleteRuleReq with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *DeleteRuleReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_DeleteRuleReq_Id_Pattern.MatchString(m.GetId()) {
+		return DeleteRuleReqValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	if !_DeleteRuleReq_ProjectId_Pattern.MatchString(m.GetProjectId()) {
+		return DeleteRuleReqValidationError{
+			field:  "ProjectId",
+			reason: "value does not match regex pattern \"^[a-z0-9-]{1,64}$\"",
+		}
+	}
+
+	return nil
+}
+
+// DeleteRuleReqValidationError is the validation error returned by
+// DeleteRuleReq.Validate if the designated constraints aren't met.
+type DeleteRuleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRuleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRuleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRuleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRuleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRuleReqValidationError) ErrorName() string { return "DeleteRuleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRuleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRuleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRuleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRuleReqValidationError{}
+
+var _DeleteRuleReq_Id_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+var _DeleteRuleReq_ProjectId_Pattern = regexp.MustCompile("^[a-z0-9-]{1,64}$")
+
+// Validate checks the field values on DeleteRuleResp with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *DeleteRuleResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// DeleteRuleRespValidationError is the validation error returned by
+// DeleteRuleResp.Validate if the designated constraints aren't met.
+type DeleteRuleRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRuleRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRuleRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRuleRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRuleRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRuleRespValidationError) ErrorName() string { return "DeleteRuleRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRuleRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRuleResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRuleRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRuleRespValidationError{}
+
+// Validate checks the field values on ApplyRulesStartReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplyRulesStartReq) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ApplyRulesStartReqValidationError is the validation error returned by
+// ApplyRulesStartReq.Validate if the designated constraints aren't met.
+type ApplyRulesStartReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyRulesStartReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyRulesStartReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyRulesStartReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyRulesStartReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyRulesStartReqValidationError) ErrorName() string {
+	return "ApplyRulesStartReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyRulesStartReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyRulesStartReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyRulesStartReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyRulesStartReqValidationError{}
+
+// Validate checks the field values on ApplyRulesStartResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplyRulesStartResp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ApplyRulesStartRespValidationError is the validation error returned by
+// ApplyRulesStartResp.Validate if the designated constraints aren't met.
+type ApplyRulesStartRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyRulesStartRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyRulesStartRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyRulesStartRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyRulesStartRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyRulesStartRespValidationError) ErrorName() string {
+	return "ApplyRulesStartRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyRulesStartRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyRulesStartResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyRulesStartRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyRulesStartRespValidationError{}
@@ -30,7 +30,7 @@ func (m *InventoryNodes) Reset()         { *m = InventoryNodes{} }
 func (m *InventoryNodes) String() string { return proto.CompactTextString(m) }
 func (*InventoryNodes) ProtoMessage()    {}
 func (*InventoryNodes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inventory_nodes_659aa2ae1f39340d, []int{0}
+	return fileDescriptor_inventory_nodes_fdf2f23792863073, []int{0}
 }
 func (m *InventoryNodes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InventoryNodes.Unmarshal(m, b)
@@ -70,6 +70,7 @@ type InventoryNode struct {
 	LastCcrReceived      *timestamp.Timestamp `protobuf:"bytes,10,opt,name=last_ccr_received,json=lastCcrReceived,proto3" json:"last_ccr_received,omitempty" toml:"last_ccr_received,omitempty" mapstructure:"last_ccr_received,omitempty"`
 	Name                 string               `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty" toml:"name,omitempty" mapstructure:"name,omitempty"`
 	Fqdn                 string               `protobuf:"bytes,12,opt,name=fqdn,proto3" json:"fqdn,omitempty" toml:"fqdn,omitempty" mapstructure:"fqdn,omitempty"`
+	LatestRunId          string               `protobuf:"bytes,13,opt,name=latest_run_id,json=latestRunId,proto3" json:"latest_run_id,omitempty" toml:"latest_run_id,omitempty" mapstructure:"latest_run_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -79,7 +80,7 @@ func (m *InventoryNode) Reset()         { *m = InventoryNode{} }
 func (m *InventoryNode) String() string { return proto.CompactTextString(m) }
 func (*InventoryNode) ProtoMessage()    {}
 func (*InventoryNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inventory_nodes_659aa2ae1f39340d, []int{1}
+	return fileDescriptor_inventory_nodes_fdf2f23792863073, []int{1}
 }
 func (m *InventoryNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InventoryNode.Unmarshal(m, b)
@@ -183,42 +184,50 @@ func (m *InventoryNode) GetFqdn() string {
 	return ""
 }
 
+func (m *InventoryNode) GetLatestRunId() string {
+	if m != nil {
+		return m.LatestRunId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InventoryNodes)(nil), "chef.automate.domain.cfgmgmt.response.InventoryNodes")
 	proto.RegisterType((*InventoryNode)(nil), "chef.automate.domain.cfgmgmt.response.InventoryNode")
 }
 
 func init() {
-	proto.RegisterFile("api/interservice/cfgmgmt/response/inventory_nodes.proto", fileDescriptor_inventory_nodes_659aa2ae1f39340d)
-}
-
-var fileDescriptor_inventory_nodes_659aa2ae1f39340d = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x8b, 0xdb, 0x30,
-	0x10, 0xc5, 0x49, 0xb2, 0x7f, 0x27, 0xbb, 0x49, 0x57, 0x27, 0x91, 0x4b, 0x43, 0x60, 0xdb, 0xb4,
-	0x07, 0x09, 0xd2, 0x85, 0x42, 0xe9, 0xa9, 0x85, 0x85, 0xf4, 0xd0, 0x43, 0x58, 0x7a, 0x28, 0x05,
-	0xa3, 0xc8, 0x63, 0x67, 0xa8, 0x25, 0xb9, 0xb2, 0x12, 0x48, 0x3f, 0x67, 0x3f, 0x50, 0xb1, 0x1c,
-	0x85, 0xe6, 0xb4, 0xbd, 0x8d, 0x9f, 0x7e, 0xef, 0xbd, 0xc1, 0x0c, 0xbc, 0x57, 0x35, 0x49, 0xb2,
-	0x01, 0x7d, 0x83, 0x7e, 0x47, 0x1a, 0xa5, 0x2e, 0x4a, 0x53, 0x9a, 0x20, 0x3d, 0x36, 0xb5, 0xb3,
-	0x0d, 0x4a, 0xb2, 0x3b, 0xb4, 0xc1, 0xf9, 0x7d, 0x66, 0x5d, 0x8e, 0x8d, 0xa8, 0xbd, 0x0b, 0x8e,
-	0xdd, 0xeb, 0x0d, 0x16, 0x42, 0x6d, 0x83, 0x33, 0x2a, 0xa0, 0xc8, 0x9d, 0x51, 0x64, 0xc5, 0xc1,
-	0x2c, 0x92, 0x79, 0xf2, 0xb2, 0x74, 0xae, 0xac, 0x50, 0x46, 0xd3, 0x7a, 0x5b, 0xc8, 0x40, 0x06,
-	0x9b, 0xa0, 0x4c, 0xdd, 0xe5, 0xcc, 0x7e, 0xc0, 0x68, 0x99, 0x0a, 0xbe, 0xb6, 0xf9, 0xec, 0x0b,
-	0x9c, 0xc7, 0x22, 0xde, 0x9b, 0x0e, 0xe6, 0xc3, 0xc5, 0x83, 0xf8, 0xaf, 0x26, 0x71, 0x92, 0xb2,
-	0xea, 0x22, 0x66, 0x7f, 0x06, 0x70, 0x7b, 0xf2, 0xc0, 0x46, 0xd0, 0xa7, 0x9c, 0xf7, 0xa6, 0xbd,
-	0xf9, 0xf5, 0xaa, 0x4f, 0x39, 0x7b, 0x80, 0x4b, 0xbd, 0x41, 0xfd, 0x93, 0x2c, 0xef, 0x4f, 0x7b,
-	0xf3, 0xe1, 0x62, 0x22, 0xba, 0x95, 0x45, 0x5a, 0x59, 0x3c, 0xa5, 0x95, 0x57, 0x09, 0x65, 0x33,
-	0xb8, 0x71, 0xbe, 0x54, 0x96, 0x7e, 0xab, 0x40, 0xce, 0xf2, 0x41, 0xcc, 0x3b, 0xd1, 0xd8, 0x04,
-	0xae, 0xea, 0x4a, 0x85, 0xc2, 0x79, 0xc3, 0xcf, 0xe2, 0xfb, 0xf1, 0x9b, 0xbd, 0x86, 0x71, 0x9a,
-	0xb3, 0x42, 0x19, 0xaa, 0xf6, 0xfc, 0x3c, 0x22, 0xa3, 0x24, 0x3f, 0x46, 0x95, 0xbd, 0x81, 0x17,
-	0x47, 0x70, 0x87, 0xbe, 0x69, 0xcb, 0x2e, 0x22, 0x79, 0x0c, 0xf8, 0xd6, 0xc9, 0xec, 0x1e, 0x46,
-	0xba, 0x22, 0xb4, 0xe1, 0x08, 0x5e, 0x46, 0xf0, 0xb6, 0x53, 0x13, 0xf6, 0x0a, 0xc6, 0xa8, 0x17,
-	0x19, 0xd9, 0x26, 0x28, 0xab, 0x31, 0xa3, 0x9c, 0x5f, 0x75, 0x1c, 0xea, 0xc5, 0xf2, 0xa0, 0x2e,
-	0x73, 0xf6, 0x16, 0xee, 0x4e, 0xb8, 0xb0, 0xaf, 0x91, 0x5f, 0x77, 0xd5, 0xff, 0x90, 0x4f, 0xfb,
-	0x1a, 0xd9, 0x23, 0xdc, 0x55, 0xaa, 0x09, 0x99, 0xd6, 0x3e, 0xf3, 0xa8, 0x91, 0x76, 0x98, 0x73,
-	0x78, 0xf6, 0x77, 0x8e, 0x5b, 0xd3, 0x67, 0xed, 0x57, 0x07, 0x0b, 0x63, 0x70, 0x66, 0x95, 0x41,
-	0x3e, 0x8c, 0x35, 0x71, 0x6e, 0xb5, 0xe2, 0x57, 0x6e, 0xf9, 0x4d, 0xa7, 0xb5, 0xf3, 0xa7, 0x8f,
-	0xdf, 0x3f, 0x94, 0x14, 0x36, 0xdb, 0xb5, 0xd0, 0xce, 0xc8, 0xf6, 0x3e, 0x64, 0xba, 0x0f, 0xf9,
-	0xec, 0x41, 0xaf, 0x2f, 0xe2, 0x2a, 0xef, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xda, 0xb2, 0xcf,
-	0x23, 0xfc, 0x02, 0x00, 0x00,
+	proto.RegisterFile("api/interservice/cfgmgmt/response/inventory_nodes.proto", fileDescriptor_inventory_nodes_fdf2f23792863073)
+}
+
+var fileDescriptor_inventory_nodes_fdf2f23792863073 = []byte{
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x8b, 0x13, 0x31,
+	0x14, 0xc7, 0xe9, 0x76, 0x7f, 0xa6, 0xdb, 0xd6, 0xcd, 0x29, 0xf4, 0x62, 0x29, 0xac, 0x56, 0x0f,
+	0x09, 0xd4, 0x05, 0x41, 0x3c, 0x29, 0x2c, 0xd4, 0x83, 0x87, 0x61, 0xf1, 0x20, 0xc2, 0x90, 0x26,
+	0x6f, 0xa6, 0xc1, 0x49, 0x32, 0x26, 0x99, 0x42, 0xfd, 0xcb, 0x3d, 0xca, 0x24, 0x4d, 0xb1, 0xa7,
+	0xf5, 0xf6, 0xe6, 0xfb, 0x3e, 0xef, 0xfb, 0x7d, 0x13, 0x1e, 0x7a, 0xcf, 0x5b, 0xc5, 0x94, 0x09,
+	0xe0, 0x3c, 0xb8, 0x9d, 0x12, 0xc0, 0x44, 0x55, 0xeb, 0x5a, 0x07, 0xe6, 0xc0, 0xb7, 0xd6, 0x78,
+	0x60, 0xca, 0xec, 0xc0, 0x04, 0xeb, 0xf6, 0xa5, 0xb1, 0x12, 0x3c, 0x6d, 0x9d, 0x0d, 0x16, 0xdf,
+	0x8b, 0x2d, 0x54, 0x94, 0x77, 0xc1, 0x6a, 0x1e, 0x80, 0x4a, 0xab, 0xb9, 0x32, 0xf4, 0x30, 0x4c,
+	0xf3, 0xf0, 0xec, 0x65, 0x6d, 0x6d, 0xdd, 0x00, 0x8b, 0x43, 0x9b, 0xae, 0x62, 0x41, 0x69, 0xf0,
+	0x81, 0xeb, 0x36, 0xf9, 0x2c, 0x7e, 0xa0, 0xc9, 0x3a, 0x07, 0x7c, 0xed, 0xfd, 0xf1, 0x17, 0x74,
+	0x11, 0x83, 0xc8, 0x60, 0x3e, 0x5c, 0x8e, 0x56, 0x0f, 0xf4, 0xbf, 0x92, 0xe8, 0x89, 0x4b, 0x91,
+	0x2c, 0x16, 0x7f, 0x86, 0x68, 0x7c, 0xd2, 0xc0, 0x13, 0x74, 0xa6, 0x24, 0x19, 0xcc, 0x07, 0xcb,
+	0x9b, 0xe2, 0x4c, 0x49, 0xfc, 0x80, 0xae, 0xc4, 0x16, 0xc4, 0x4f, 0x65, 0xc8, 0xd9, 0x7c, 0xb0,
+	0x1c, 0xad, 0x66, 0x34, 0xad, 0x4c, 0xf3, 0xca, 0xf4, 0x29, 0xaf, 0x5c, 0x64, 0x14, 0x2f, 0xd0,
+	0xad, 0x75, 0x35, 0x37, 0xea, 0x37, 0x0f, 0xca, 0x1a, 0x32, 0x8c, 0x7e, 0x27, 0x1a, 0x9e, 0xa1,
+	0xeb, 0xb6, 0xe1, 0xa1, 0xb2, 0x4e, 0x93, 0xf3, 0xd8, 0x3f, 0x7e, 0xe3, 0xd7, 0x68, 0x9a, 0xeb,
+	0xb2, 0xe2, 0x5a, 0x35, 0x7b, 0x72, 0x11, 0x91, 0x49, 0x96, 0x1f, 0xa3, 0x8a, 0xdf, 0xa0, 0x17,
+	0x47, 0x70, 0x07, 0xce, 0xf7, 0x61, 0x97, 0x91, 0x3c, 0x1a, 0x7c, 0x4b, 0x32, 0xbe, 0x47, 0x13,
+	0xd1, 0x28, 0x30, 0xe1, 0x08, 0x5e, 0x45, 0x70, 0x9c, 0xd4, 0x8c, 0xbd, 0x42, 0x53, 0x10, 0xab,
+	0x52, 0x19, 0x1f, 0xb8, 0x11, 0x50, 0x2a, 0x49, 0xae, 0x13, 0x07, 0x62, 0xb5, 0x3e, 0xa8, 0x6b,
+	0x89, 0xdf, 0xa2, 0xbb, 0x13, 0x2e, 0xec, 0x5b, 0x20, 0x37, 0x29, 0xfa, 0x1f, 0xf2, 0x69, 0xdf,
+	0x02, 0x7e, 0x44, 0x77, 0x0d, 0xf7, 0xa1, 0x14, 0xc2, 0x95, 0x0e, 0x04, 0xa8, 0x1d, 0x48, 0x82,
+	0x9e, 0x7d, 0xce, 0x69, 0x3f, 0xf4, 0x59, 0xb8, 0xe2, 0x30, 0x82, 0x31, 0x3a, 0x37, 0x5c, 0x03,
+	0x19, 0xc5, 0x98, 0x58, 0xf7, 0x5a, 0xf5, 0x4b, 0x1a, 0x72, 0x9b, 0xb4, 0xbe, 0xc6, 0x0b, 0x34,
+	0x6e, 0x78, 0x00, 0x1f, 0x4a, 0xd7, 0x99, 0xfe, 0x0f, 0xc6, 0xb1, 0x39, 0x4a, 0x62, 0xd1, 0x99,
+	0xb5, 0xfc, 0xf4, 0xf1, 0xfb, 0x87, 0x5a, 0x85, 0x6d, 0xb7, 0xa1, 0xc2, 0x6a, 0xd6, 0xdf, 0x10,
+	0xcb, 0x37, 0xc4, 0x9e, 0x3d, 0xfa, 0xcd, 0x65, 0x5c, 0xf7, 0xdd, 0xdf, 0x01, 0x00, 0xe3, 0x95,
+	0x23, 0x46, 0x20, 0x03, 0x00, 0x00,
 }
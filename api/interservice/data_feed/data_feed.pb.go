@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api/interservice/data_feed/data_feed.proto
+
+package data_feed // import "github.com/chef/automate/api/interservice/data_feed"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ListDestinationStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListDestinationStatusRequest) Reset()         { *m = ListDestinationStatusRequest{} }
+func (m *ListDestinationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDestinationStatusRequest) ProtoMessage()    {}
+func (*ListDestinationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_data_feed_e32aeeb3d550b43d, []int{0}
+}
+func (m *ListDestinationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDestinationStatusRequest.Unmarshal(m, b)
+}
+func (m *ListDestinationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDestinationStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDestinationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDestinationStatusRequest.Merge(dst, src)
+}
+func (m *ListDestinationStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDestinationStatusRequest.Size(m)
+}
+func (m *ListDestinationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDestinationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDestinationStatusRequest proto.InternalMessageInfo
+
+type ListDestinationStatusResponse struct {
+	Destinations         []*DestinationStatus `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty" toml:"destinations,omitempty" mapstructure:"destinations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListDestinationStatusResponse) Reset()         { *m = ListDestinationStatusResponse{} }
+func (m *ListDestinationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDestinationStatusResponse) ProtoMessage()    {}
+func (*ListDestinationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_data_feed_e32aeeb3d550b43d, []int{1}
+}
+func (m *ListDestinationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDestinationStatusResponse.Unmarshal(m, b)
+}
+func (m *ListDestinationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDestinationStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDestinationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDestinationStatusResponse.Merge(dst, src)
+}
+func (m *ListDestinationStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDestinationStatusResponse.Size(m)
+}
+func (m *ListDestinationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDestinationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDestinationStatusResponse proto.InternalMessageInfo
+
+func (m *ListDestinationStatusResponse) GetDestinations() []*DestinationStatus {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+type DestinationStatus struct {
+	// id of the notifications rule the destination belongs to
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" toml:"name,omitempty" mapstructure:"name,omitempty"`
+	Url  string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty" toml:"url,omitempty" mapstructure:"url,omitempty"`
+	// the last feed window the destination accepted
+	LastSuccessStart *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_success_start,json=lastSuccessStart,proto3" json:"last_success_start,omitempty" toml:"last_success_start,omitempty" mapstructure:"last_success_start,omitempty"`
+	LastSuccessEnd   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_success_end,json=lastSuccessEnd,proto3" json:"last_success_end,omitempty" toml:"last_success_end,omitempty" mapstructure:"last_success_end,omitempty"`
+	LastAttempt      *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty" toml:"last_attempt,omitempty" mapstructure:"last_attempt,omitempty"`
+	// failures since the last successful delivery
+	ConsecutiveFailures  int64    `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty" toml:"consecutive_failures,omitempty" mapstructure:"consecutive_failures,omitempty"`
+	TotalFailures        int64    `protobuf:"varint,8,opt,name=total_failures,json=totalFailures,proto3" json:"total_failures,omitempty" toml:"total_failures,omitempty" mapstructure:"total_failures,omitempty"`
+	LastError            string   `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty" toml:"last_error,omitempty" mapstructure:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *DestinationStatus) Reset()         { *m = DestinationStatus{} }
+func (m *DestinationStatus) String() string { return proto.CompactTextString(m) }
+func (*DestinationStatus) ProtoMessage()    {}
+func (*DestinationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_data_feed_e32aeeb3d550b43d, []int{2}
+}
+func (m *DestinationStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestinationStatus.Unmarshal(m, b)
+}
+func (m *DestinationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestinationStatus.Marshal(b, m, deterministic)
+}
+func (dst *DestinationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationStatus.Merge(dst, src)
+}
+func (m *DestinationStatus) XXX_Size() int {
+	return xxx_messageInfo_DestinationStatus.Size(m)
+}
+func (m *DestinationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationStatus proto.InternalMessageInfo
+
+func (m *DestinationStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DestinationStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DestinationStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DestinationStatus) GetLastSuccessStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSuccessStart
+	}
+	return nil
+}
+
+func (m *DestinationStatus) GetLastSuccessEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSuccessEnd
+	}
+	return nil
+}
+
+func (m *DestinationStatus) GetLastAttempt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return nil
+}
+
+func (m *DestinationStatus) GetConsecutiveFailures() int64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *DestinationStatus) GetTotalFailures() int64 {
+	if m != nil {
+		return m.TotalFailures
+	}
+	return 0
+}
+
+func (m *DestinationStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ListDestinationStatusRequest)(nil), "chef.automate.domain.data_feed.ListDestinationStatusRequest")
+	proto.RegisterType((*ListDestinationStatusResponse)(nil), "chef.automate.domain.data_feed.ListDestinationStatusResponse")
+	proto.RegisterType((*DestinationStatus)(nil), "chef.automate.domain.data_feed.DestinationStatus")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DatafeedServiceClient is the client API for DatafeedService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DatafeedServiceClient interface {
+	// Reports the delivery state of every data feed destination
+	ListDestinationStatus(ctx context.Context, in *ListDestinationStatusRequest, opts ...grpc.CallOption) (*ListDestinationStatusResponse, error)
+}
+
+type datafeedServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDatafeedServiceClient(cc *grpc.ClientConn) DatafeedServiceClient {
+	return &datafeedServiceClient{cc}
+}
+
+func (c *datafeedServiceClient) ListDestinationStatus(ctx context.Context, in *ListDestinationStatusRequest, opts ...grpc.CallOption) (*ListDestinationStatusResponse, error) {
+	out := new(ListDestinationStatusResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.data_feed.DatafeedService/ListDestinationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DatafeedServiceServer is the server API for DatafeedService service.
+type DatafeedServiceServer interface {
+	// Reports the delivery state of every data feed destination
+	ListDestinationStatus(context.Context, *ListDestinationStatusRequest) (*ListDestinationStatusResponse, error)
+}
+
+func RegisterDatafeedServiceServer(s *grpc.Server, srv DatafeedServiceServer) {
+	s.RegisterService(&_DatafeedService_serviceDesc, srv)
+}
+
+func _DatafeedService_ListDestinationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatafeedServiceServer).ListDestinationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.data_feed.DatafeedService/ListDestinationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatafeedServiceServer).ListDestinationStatus(ctx, req.(*ListDestinationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DatafeedService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chef.automate.domain.data_feed.DatafeedService",
+	HandlerType: (*DatafeedServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDestinationStatus",
+			Handler:    _DatafeedService_ListDestinationStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/interservice/data_feed/data_feed.proto",
+}
+
+func init() {
+	proto.RegisterFile("api/interservice/data_feed/data_feed.proto", fileDescriptor_data_feed_e32aeeb3d550b43d)
+}
+
+var fileDescriptor_data_feed_e32aeeb3d550b43d = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6b, 0x13, 0x41,
+	0x14, 0xc6, 0xd9, 0xa4, 0x56, 0xf3, 0x52, 0x63, 0x1c, 0x15, 0x86, 0x60, 0x6b, 0x08, 0x08, 0xc1,
+	0xc3, 0x2c, 0x4d, 0xf1, 0x66, 0x0f, 0x4a, 0x2a, 0x1e, 0x3c, 0x25, 0x7a, 0xf1, 0x12, 0x5e, 0x76,
+	0x5f, 0xd2, 0x81, 0xdd, 0x9d, 0x75, 0xe6, 0x4d, 0xfe, 0x0f, 0xc1, 0x3f, 0xc2, 0x3f, 0x53, 0x66,
+	0xd6, 0x34, 0x2d, 0xb5, 0x0d, 0xf4, 0xf6, 0xf8, 0xde, 0xf7, 0x7e, 0x0c, 0xdf, 0x7e, 0x0b, 0xef,
+	0xb0, 0xd6, 0xa9, 0xae, 0x98, 0xac, 0x23, 0xbb, 0xd1, 0x19, 0xa5, 0x39, 0x32, 0x2e, 0x56, 0x44,
+	0xf9, 0x6e, 0x52, 0xb5, 0x35, 0x6c, 0xc4, 0x49, 0x76, 0x49, 0x2b, 0x85, 0x9e, 0x4d, 0x89, 0x4c,
+	0x2a, 0x37, 0x25, 0xea, 0x4a, 0x5d, 0xb9, 0x06, 0x6f, 0xd6, 0xc6, 0xac, 0x0b, 0x4a, 0xa3, 0x7b,
+	0xe9, 0x57, 0x29, 0xeb, 0x92, 0x1c, 0x63, 0x59, 0x37, 0x80, 0xd1, 0x09, 0xbc, 0xfe, 0xaa, 0x1d,
+	0x4f, 0xc9, 0xb1, 0xae, 0x90, 0xb5, 0xa9, 0xe6, 0x8c, 0xec, 0xdd, 0x8c, 0x7e, 0x7a, 0x72, 0x3c,
+	0xda, 0xc0, 0xf1, 0x1d, 0x7b, 0x57, 0x9b, 0xca, 0x91, 0xf8, 0x0e, 0x47, 0xf9, 0x6e, 0xe9, 0x64,
+	0x32, 0x6c, 0x8f, 0xbb, 0x93, 0x53, 0x75, 0xff, 0xc3, 0xd4, 0x6d, 0xe0, 0x0d, 0xcc, 0xe8, 0x57,
+	0x1b, 0x9e, 0xdf, 0xf2, 0x88, 0x1e, 0xb4, 0x74, 0x2e, 0x93, 0x61, 0x32, 0xee, 0xcc, 0x5a, 0x3a,
+	0x17, 0x02, 0x0e, 0x2a, 0x2c, 0x49, 0xb6, 0xa2, 0x12, 0x67, 0xd1, 0x87, 0xb6, 0xb7, 0x85, 0x6c,
+	0x47, 0x29, 0x8c, 0xe2, 0x0b, 0x88, 0x02, 0x1d, 0x2f, 0x9c, 0xcf, 0x32, 0x72, 0x6e, 0xe1, 0x18,
+	0x2d, 0xcb, 0x83, 0x61, 0x32, 0xee, 0x4e, 0x06, 0xaa, 0x49, 0x48, 0x6d, 0x13, 0x52, 0xdf, 0xb6,
+	0x09, 0xcd, 0xfa, 0xe1, 0x6a, 0xde, 0x1c, 0xcd, 0xc3, 0x8d, 0x98, 0x42, 0xff, 0x06, 0x89, 0xaa,
+	0x5c, 0x3e, 0xda, 0xcb, 0xe9, 0x5d, 0xe3, 0x5c, 0x54, 0xb9, 0x38, 0x87, 0xa3, 0x48, 0x41, 0x66,
+	0x2a, 0x6b, 0x96, 0x87, 0x7b, 0x09, 0xdd, 0xe0, 0xff, 0xd8, 0xd8, 0xc5, 0x29, 0xbc, 0xcc, 0x42,
+	0xf4, 0x99, 0x67, 0xbd, 0xa1, 0xc5, 0x0a, 0x75, 0xe1, 0x2d, 0x39, 0xf9, 0x78, 0x98, 0x8c, 0xdb,
+	0xb3, 0x17, 0xd7, 0x76, 0x9f, 0xff, 0xad, 0xc4, 0x5b, 0xe8, 0xb1, 0x61, 0x2c, 0x76, 0xe6, 0x27,
+	0xd1, 0xfc, 0x34, 0xaa, 0x57, 0xb6, 0x63, 0x80, 0xf8, 0x30, 0xb2, 0xd6, 0x58, 0xd9, 0x89, 0x09,
+	0x76, 0x82, 0x72, 0x11, 0x84, 0xc9, 0x9f, 0x04, 0x9e, 0x4d, 0x91, 0x31, 0x7c, 0xc0, 0x79, 0x53,
+	0x4d, 0xf1, 0x3b, 0x81, 0x57, 0xff, 0x2d, 0x88, 0xf8, 0xb0, 0xaf, 0x02, 0xf7, 0xf5, 0x6e, 0x70,
+	0xfe, 0xc0, 0xeb, 0xa6, 0x95, 0x9f, 0xde, 0xff, 0x38, 0x5b, 0x6b, 0xbe, 0xf4, 0x4b, 0x95, 0x99,
+	0x32, 0x0d, 0xa8, 0x74, 0x8b, 0x4a, 0xef, 0xfe, 0xbd, 0x96, 0x87, 0x31, 0xfb, 0xb3, 0xbf, 0x03,
+	0x00, 0x69, 0x65, 0x9e, 0xa3, 0x83, 0x03, 0x00, 0x00,
+}
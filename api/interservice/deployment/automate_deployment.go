@@ -5,7 +5,9 @@ import (
 	fmt "fmt"
 	"io"
 	"strings"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	grpc "google.golang.org/grpc"
 )
@@ -97,5 +99,20 @@ func (c *BackupStatusResponse) Format() string {
 		msg = "Unknown"
 	}
 
+	if schedule := c.GetSchedule(); schedule != nil {
+		msg += fmt.Sprintf("\nScheduled backups: %s", schedule.Cron)
+		if next, err := ptypes.Timestamp(schedule.NextRun); err == nil {
+			msg += fmt.Sprintf("\nNext scheduled backup: %s", next.Format(time.RFC3339))
+		}
+		if last, err := ptypes.Timestamp(schedule.LastRun); err == nil {
+			msg += fmt.Sprintf("\nLast scheduled backup: %s", last.Format(time.RFC3339))
+			if schedule.LastError != "" {
+				msg += fmt.Sprintf(" (failed: %s)", schedule.LastError)
+			} else {
+				msg += fmt.Sprintf(" (backup %s, %d pruned)", schedule.GetLastBackup().TaskID(), schedule.LastPruned)
+			}
+		}
+	}
+
 	return msg
 }
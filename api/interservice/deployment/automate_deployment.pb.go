@@ -78,7 +78,7 @@ func (x UpgradeStatusResponse_UpgradeState) String() string {
 	return proto.EnumName(UpgradeStatusResponse_UpgradeState_name, int32(x))
 }
 func (UpgradeStatusResponse_UpgradeState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{33, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{33, 0}
 }
 
 type DeployEvent_Status int32
@@ -113,7 +113,7 @@ func (x DeployEvent_Status) String() string {
 	return proto.EnumName(DeployEvent_Status_name, int32(x))
 }
 func (DeployEvent_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 0}
 }
 
 type DeployEvent_PhaseID int32
@@ -151,7 +151,7 @@ func (x DeployEvent_PhaseID) String() string {
 	return proto.EnumName(DeployEvent_PhaseID_name, int32(x))
 }
 func (DeployEvent_PhaseID) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 1}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 1}
 }
 
 type DeployEvent_Backup_Operation_Type int32
@@ -174,7 +174,7 @@ func (x DeployEvent_Backup_Operation_Type) String() string {
 	return proto.EnumName(DeployEvent_Backup_Operation_Type_name, int32(x))
 }
 func (DeployEvent_Backup_Operation_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 3, 0, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 3, 0, 0}
 }
 
 type ServiceState_State int32
@@ -217,7 +217,7 @@ func (x ServiceState_State) String() string {
 	return proto.EnumName(ServiceState_State_name, int32(x))
 }
 func (ServiceState_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{53, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{53, 0}
 }
 
 type BackupTask_BackupState int32
@@ -246,7 +246,7 @@ func (x BackupTask_BackupState) String() string {
 	return proto.EnumName(BackupTask_BackupState_name, int32(x))
 }
 func (BackupTask_BackupState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{70, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{70, 0}
 }
 
 type BackupStatusResponse_OperationType int32
@@ -284,7 +284,7 @@ func (x BackupStatusResponse_OperationType) String() string {
 	return proto.EnumName(BackupStatusResponse_OperationType_name, int32(x))
 }
 func (BackupStatusResponse_OperationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{86, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{89, 0}
 }
 
 type A1UpgradeStatusResponse_MigrationStatus int32
@@ -313,7 +313,7 @@ func (x A1UpgradeStatusResponse_MigrationStatus) String() string {
 	return proto.EnumName(A1UpgradeStatusResponse_MigrationStatus_name, int32(x))
 }
 func (A1UpgradeStatusResponse_MigrationStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{94, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{98, 0}
 }
 
 type GetCLIExecutableRequest struct {
@@ -327,7 +327,7 @@ func (m *GetCLIExecutableRequest) Reset()         { *m = GetCLIExecutableRequest
 func (m *GetCLIExecutableRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCLIExecutableRequest) ProtoMessage()    {}
 func (*GetCLIExecutableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{0}
 }
 func (m *GetCLIExecutableRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCLIExecutableRequest.Unmarshal(m, b)
@@ -366,7 +366,7 @@ func (m *GetCLIExecutableResponse) Reset()         { *m = GetCLIExecutableRespon
 func (m *GetCLIExecutableResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCLIExecutableResponse) ProtoMessage()    {}
 func (*GetCLIExecutableResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{1}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{1}
 }
 func (m *GetCLIExecutableResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCLIExecutableResponse.Unmarshal(m, b)
@@ -410,7 +410,7 @@ func (m *NodeInventoryRequest) Reset()         { *m = NodeInventoryRequest{} }
 func (m *NodeInventoryRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeInventoryRequest) ProtoMessage()    {}
 func (*NodeInventoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{2}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{2}
 }
 func (m *NodeInventoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInventoryRequest.Unmarshal(m, b)
@@ -441,7 +441,7 @@ func (m *NodeInventoryResponse) Reset()         { *m = NodeInventoryResponse{} }
 func (m *NodeInventoryResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeInventoryResponse) ProtoMessage()    {}
 func (*NodeInventoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{3}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{3}
 }
 func (m *NodeInventoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeInventoryResponse.Unmarshal(m, b)
@@ -479,7 +479,7 @@ func (m *InfrastructureNodeDeleteRequest) Reset()         { *m = InfrastructureN
 func (m *InfrastructureNodeDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*InfrastructureNodeDeleteRequest) ProtoMessage()    {}
 func (*InfrastructureNodeDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{4}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{4}
 }
 func (m *InfrastructureNodeDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfrastructureNodeDeleteRequest.Unmarshal(m, b)
@@ -516,7 +516,7 @@ func (m *InfrastructureNodeDeleteResponse) Reset()         { *m = Infrastructure
 func (m *InfrastructureNodeDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*InfrastructureNodeDeleteResponse) ProtoMessage()    {}
 func (*InfrastructureNodeDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{5}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{5}
 }
 func (m *InfrastructureNodeDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfrastructureNodeDeleteResponse.Unmarshal(m, b)
@@ -556,7 +556,7 @@ func (m *InventoryNode) Reset()         { *m = InventoryNode{} }
 func (m *InventoryNode) String() string { return proto.CompactTextString(m) }
 func (*InventoryNode) ProtoMessage()    {}
 func (*InventoryNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{6}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{6}
 }
 func (m *InventoryNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InventoryNode.Unmarshal(m, b)
@@ -657,7 +657,7 @@ func (m *UsageRequest) Reset()         { *m = UsageRequest{} }
 func (m *UsageRequest) String() string { return proto.CompactTextString(m) }
 func (*UsageRequest) ProtoMessage()    {}
 func (*UsageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{7}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{7}
 }
 func (m *UsageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageRequest.Unmarshal(m, b)
@@ -695,7 +695,7 @@ func (m *UsageResponse) Reset()         { *m = UsageResponse{} }
 func (m *UsageResponse) String() string { return proto.CompactTextString(m) }
 func (*UsageResponse) ProtoMessage()    {}
 func (*UsageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{8}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{8}
 }
 func (m *UsageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageResponse.Unmarshal(m, b)
@@ -737,7 +737,7 @@ func (m *NodeUsage) Reset()         { *m = NodeUsage{} }
 func (m *NodeUsage) String() string { return proto.CompactTextString(m) }
 func (*NodeUsage) ProtoMessage()    {}
 func (*NodeUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{9}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{9}
 }
 func (m *NodeUsage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeUsage.Unmarshal(m, b)
@@ -803,7 +803,7 @@ func (m *GenerateAdminTokenRequest) Reset()         { *m = GenerateAdminTokenReq
 func (m *GenerateAdminTokenRequest) String() string { return proto.CompactTextString(m) }
 func (*GenerateAdminTokenRequest) ProtoMessage()    {}
 func (*GenerateAdminTokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{10}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{10}
 }
 func (m *GenerateAdminTokenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenerateAdminTokenRequest.Unmarshal(m, b)
@@ -841,7 +841,7 @@ func (m *GenerateAdminTokenResponse) Reset()         { *m = GenerateAdminTokenRe
 func (m *GenerateAdminTokenResponse) String() string { return proto.CompactTextString(m) }
 func (*GenerateAdminTokenResponse) ProtoMessage()    {}
 func (*GenerateAdminTokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{11}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{11}
 }
 func (m *GenerateAdminTokenResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenerateAdminTokenResponse.Unmarshal(m, b)
@@ -880,7 +880,7 @@ func (m *NewDeploymentRequest) Reset()         { *m = NewDeploymentRequest{} }
 func (m *NewDeploymentRequest) String() string { return proto.CompactTextString(m) }
 func (*NewDeploymentRequest) ProtoMessage()    {}
 func (*NewDeploymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{12}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{12}
 }
 func (m *NewDeploymentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewDeploymentRequest.Unmarshal(m, b)
@@ -925,7 +925,7 @@ func (m *ConfigureDeploymentRequest) Reset()         { *m = ConfigureDeploymentR
 func (m *ConfigureDeploymentRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigureDeploymentRequest) ProtoMessage()    {}
 func (*ConfigureDeploymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{13}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{13}
 }
 func (m *ConfigureDeploymentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureDeploymentRequest.Unmarshal(m, b)
@@ -964,7 +964,7 @@ func (m *ConfigureDeploymentResponse) Reset()         { *m = ConfigureDeployment
 func (m *ConfigureDeploymentResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfigureDeploymentResponse) ProtoMessage()    {}
 func (*ConfigureDeploymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{14}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{14}
 }
 func (m *ConfigureDeploymentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureDeploymentResponse.Unmarshal(m, b)
@@ -1009,7 +1009,7 @@ func (m *DeployRequest) Reset()         { *m = DeployRequest{} }
 func (m *DeployRequest) String() string { return proto.CompactTextString(m) }
 func (*DeployRequest) ProtoMessage()    {}
 func (*DeployRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{15}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{15}
 }
 func (m *DeployRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployRequest.Unmarshal(m, b)
@@ -1047,7 +1047,7 @@ func (m *DeployResponse) Reset()         { *m = DeployResponse{} }
 func (m *DeployResponse) String() string { return proto.CompactTextString(m) }
 func (*DeployResponse) ProtoMessage()    {}
 func (*DeployResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{16}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{16}
 }
 func (m *DeployResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployResponse.Unmarshal(m, b)
@@ -1086,7 +1086,7 @@ func (m *DeployStatusRequest) Reset()         { *m = DeployStatusRequest{} }
 func (m *DeployStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*DeployStatusRequest) ProtoMessage()    {}
 func (*DeployStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{17}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{17}
 }
 func (m *DeployStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployStatusRequest.Unmarshal(m, b)
@@ -1131,7 +1131,7 @@ func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
 func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveRequest) ProtoMessage()    {}
 func (*RemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{18}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{18}
 }
 func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveRequest.Unmarshal(m, b)
@@ -1168,7 +1168,7 @@ func (m *ManifestVersionRequest) Reset()         { *m = ManifestVersionRequest{}
 func (m *ManifestVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*ManifestVersionRequest) ProtoMessage()    {}
 func (*ManifestVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{19}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{19}
 }
 func (m *ManifestVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ManifestVersionRequest.Unmarshal(m, b)
@@ -1198,7 +1198,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{20}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{20}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
@@ -1228,7 +1228,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{21}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{21}
 }
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
@@ -1258,7 +1258,7 @@ func (m *DeployIDRequest) Reset()         { *m = DeployIDRequest{} }
 func (m *DeployIDRequest) String() string { return proto.CompactTextString(m) }
 func (*DeployIDRequest) ProtoMessage()    {}
 func (*DeployIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{22}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{22}
 }
 func (m *DeployIDRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployIDRequest.Unmarshal(m, b)
@@ -1288,7 +1288,7 @@ func (m *RemoveResponse) Reset()         { *m = RemoveResponse{} }
 func (m *RemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveResponse) ProtoMessage()    {}
 func (*RemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{23}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{23}
 }
 func (m *RemoveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoveResponse.Unmarshal(m, b)
@@ -1318,7 +1318,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{24}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{24}
 }
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
@@ -1348,7 +1348,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{25}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{25}
 }
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
@@ -1378,7 +1378,7 @@ func (m *StopConvergeRequest) Reset()         { *m = StopConvergeRequest{} }
 func (m *StopConvergeRequest) String() string { return proto.CompactTextString(m) }
 func (*StopConvergeRequest) ProtoMessage()    {}
 func (*StopConvergeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{26}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{26}
 }
 func (m *StopConvergeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopConvergeRequest.Unmarshal(m, b)
@@ -1408,7 +1408,7 @@ func (m *StopConvergeResponse) Reset()         { *m = StopConvergeResponse{} }
 func (m *StopConvergeResponse) String() string { return proto.CompactTextString(m) }
 func (*StopConvergeResponse) ProtoMessage()    {}
 func (*StopConvergeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{27}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{27}
 }
 func (m *StopConvergeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopConvergeResponse.Unmarshal(m, b)
@@ -1438,7 +1438,7 @@ func (m *StartConvergeRequest) Reset()         { *m = StartConvergeRequest{} }
 func (m *StartConvergeRequest) String() string { return proto.CompactTextString(m) }
 func (*StartConvergeRequest) ProtoMessage()    {}
 func (*StartConvergeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{28}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{28}
 }
 func (m *StartConvergeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartConvergeRequest.Unmarshal(m, b)
@@ -1468,7 +1468,7 @@ func (m *StartConvergeResponse) Reset()         { *m = StartConvergeResponse{} }
 func (m *StartConvergeResponse) String() string { return proto.CompactTextString(m) }
 func (*StartConvergeResponse) ProtoMessage()    {}
 func (*StartConvergeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{29}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{29}
 }
 func (m *StartConvergeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartConvergeResponse.Unmarshal(m, b)
@@ -1498,7 +1498,7 @@ func (m *ServiceVersionsRequest) Reset()         { *m = ServiceVersionsRequest{}
 func (m *ServiceVersionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ServiceVersionsRequest) ProtoMessage()    {}
 func (*ServiceVersionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{30}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{30}
 }
 func (m *ServiceVersionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceVersionsRequest.Unmarshal(m, b)
@@ -1528,7 +1528,7 @@ func (m *SystemLogsRequest) Reset()         { *m = SystemLogsRequest{} }
 func (m *SystemLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*SystemLogsRequest) ProtoMessage()    {}
 func (*SystemLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{31}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{31}
 }
 func (m *SystemLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SystemLogsRequest.Unmarshal(m, b)
@@ -1558,7 +1558,7 @@ func (m *UpgradeStatusRequest) Reset()         { *m = UpgradeStatusRequest{} }
 func (m *UpgradeStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*UpgradeStatusRequest) ProtoMessage()    {}
 func (*UpgradeStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{32}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{32}
 }
 func (m *UpgradeStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeStatusRequest.Unmarshal(m, b)
@@ -1601,7 +1601,7 @@ func (m *UpgradeStatusResponse) Reset()         { *m = UpgradeStatusResponse{} }
 func (m *UpgradeStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*UpgradeStatusResponse) ProtoMessage()    {}
 func (*UpgradeStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{33}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{33}
 }
 func (m *UpgradeStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeStatusResponse.Unmarshal(m, b)
@@ -1666,7 +1666,7 @@ func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
 func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*SetLogLevelRequest) ProtoMessage()    {}
 func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{34}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{34}
 }
 func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetLogLevelRequest.Unmarshal(m, b)
@@ -1696,7 +1696,7 @@ func (m *SetLogLevelResponse) Reset()         { *m = SetLogLevelResponse{} }
 func (m *SetLogLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*SetLogLevelResponse) ProtoMessage()    {}
 func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{35}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{35}
 }
 func (m *SetLogLevelResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetLogLevelResponse.Unmarshal(m, b)
@@ -1728,7 +1728,7 @@ func (m *UpgradingService) Reset()         { *m = UpgradingService{} }
 func (m *UpgradingService) String() string { return proto.CompactTextString(m) }
 func (*UpgradingService) ProtoMessage()    {}
 func (*UpgradingService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{36}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{36}
 }
 func (m *UpgradingService) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradingService.Unmarshal(m, b)
@@ -1774,7 +1774,7 @@ func (m *PackageOptions) Reset()         { *m = PackageOptions{} }
 func (m *PackageOptions) String() string { return proto.CompactTextString(m) }
 func (*PackageOptions) ProtoMessage()    {}
 func (*PackageOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{37}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{37}
 }
 func (m *PackageOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PackageOptions.Unmarshal(m, b)
@@ -1820,7 +1820,7 @@ func (m *ConfigureRequest) Reset()         { *m = ConfigureRequest{} }
 func (m *ConfigureRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigureRequest) ProtoMessage()    {}
 func (*ConfigureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{38}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{38}
 }
 func (m *ConfigureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigureRequest.Unmarshal(m, b)
@@ -1867,7 +1867,7 @@ func (m *DeploymentID) Reset()         { *m = DeploymentID{} }
 func (m *DeploymentID) String() string { return proto.CompactTextString(m) }
 func (*DeploymentID) ProtoMessage()    {}
 func (*DeploymentID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{39}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{39}
 }
 func (m *DeploymentID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeploymentID.Unmarshal(m, b)
@@ -1919,7 +1919,7 @@ func (m *DeploymentStatus) Reset()         { *m = DeploymentStatus{} }
 func (m *DeploymentStatus) String() string { return proto.CompactTextString(m) }
 func (*DeploymentStatus) ProtoMessage()    {}
 func (*DeploymentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{40}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{40}
 }
 func (m *DeploymentStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeploymentStatus.Unmarshal(m, b)
@@ -1966,7 +1966,7 @@ func (m *DeployEvent) Reset()         { *m = DeployEvent{} }
 func (m *DeployEvent) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent) ProtoMessage()    {}
 func (*DeployEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41}
 }
 func (m *DeployEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployEvent.Unmarshal(m, b)
@@ -2225,7 +2225,7 @@ func (m *DeployEvent_Deploy) Reset()         { *m = DeployEvent_Deploy{} }
 func (m *DeployEvent_Deploy) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent_Deploy) ProtoMessage()    {}
 func (*DeployEvent_Deploy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 0}
 }
 func (m *DeployEvent_Deploy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployEvent_Deploy.Unmarshal(m, b)
@@ -2264,7 +2264,7 @@ func (m *DeployEvent_Phase) Reset()         { *m = DeployEvent_Phase{} }
 func (m *DeployEvent_Phase) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent_Phase) ProtoMessage()    {}
 func (*DeployEvent_Phase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 1}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 1}
 }
 func (m *DeployEvent_Phase) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployEvent_Phase.Unmarshal(m, b)
@@ -2313,7 +2313,7 @@ func (m *DeployEvent_PhaseStep) Reset()         { *m = DeployEvent_PhaseStep{} }
 func (m *DeployEvent_PhaseStep) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent_PhaseStep) ProtoMessage()    {}
 func (*DeployEvent_PhaseStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 2}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 2}
 }
 func (m *DeployEvent_PhaseStep) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployEvent_PhaseStep.Unmarshal(m, b)
@@ -2374,7 +2374,7 @@ func (m *DeployEvent_Backup) Reset()         { *m = DeployEvent_Backup{} }
 func (m *DeployEvent_Backup) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent_Backup) ProtoMessage()    {}
 func (*DeployEvent_Backup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 3}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 3}
 }
 func (m *DeployEvent_Backup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployEvent_Backup.Unmarshal(m, b)
@@ -2431,7 +2431,7 @@ func (m *DeployEvent_Backup_Operation) Reset()         { *m = DeployEvent_Backup
 func (m *DeployEvent_Backup_Operation) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent_Backup_Operation) ProtoMessage()    {}
 func (*DeployEvent_Backup_Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 3, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 3, 0}
 }
 func (m *DeployEvent_Backup_Operation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployEvent_Backup_Operation.Unmarshal(m, b)
@@ -2505,7 +2505,7 @@ func (m *DeployEvent_TaskComplete) Reset()         { *m = DeployEvent_TaskComple
 func (m *DeployEvent_TaskComplete) String() string { return proto.CompactTextString(m) }
 func (*DeployEvent_TaskComplete) ProtoMessage()    {}
 func (*DeployEvent_TaskComplete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{41, 4}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{41, 4}
 }
 func (m *DeployEvent_TaskComplete) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployEvent_TaskComplete.Unmarshal(m, b)
@@ -2536,7 +2536,7 @@ func (m *LogLine) Reset()         { *m = LogLine{} }
 func (m *LogLine) String() string { return proto.CompactTextString(m) }
 func (*LogLine) ProtoMessage()    {}
 func (*LogLine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{42}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{42}
 }
 func (m *LogLine) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLine.Unmarshal(m, b)
@@ -2574,7 +2574,7 @@ func (m *SupportBundleConfig) Reset()         { *m = SupportBundleConfig{} }
 func (m *SupportBundleConfig) String() string { return proto.CompactTextString(m) }
 func (*SupportBundleConfig) ProtoMessage()    {}
 func (*SupportBundleConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{43}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{43}
 }
 func (m *SupportBundleConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SupportBundleConfig.Unmarshal(m, b)
@@ -2611,7 +2611,7 @@ func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
 func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()    {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{44}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{44}
 }
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusRequest.Unmarshal(m, b)
@@ -2643,7 +2643,7 @@ func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{45}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{45}
 }
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatusResponse.Unmarshal(m, b)
@@ -2688,7 +2688,7 @@ func (m *ServiceVersionsResponse) Reset()         { *m = ServiceVersionsResponse
 func (m *ServiceVersionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ServiceVersionsResponse) ProtoMessage()    {}
 func (*ServiceVersionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{46}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{46}
 }
 func (m *ServiceVersionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceVersionsResponse.Unmarshal(m, b)
@@ -2729,7 +2729,7 @@ func (m *ServiceVersion) Reset()         { *m = ServiceVersion{} }
 func (m *ServiceVersion) String() string { return proto.CompactTextString(m) }
 func (*ServiceVersion) ProtoMessage()    {}
 func (*ServiceVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{47}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{47}
 }
 func (m *ServiceVersion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceVersion.Unmarshal(m, b)
@@ -2787,7 +2787,7 @@ func (m *LicenseStatusRequest) Reset()         { *m = LicenseStatusRequest{} }
 func (m *LicenseStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*LicenseStatusRequest) ProtoMessage()    {}
 func (*LicenseStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{48}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{48}
 }
 func (m *LicenseStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LicenseStatusRequest.Unmarshal(m, b)
@@ -2821,7 +2821,7 @@ func (m *LicenseStatusResponse) Reset()         { *m = LicenseStatusResponse{} }
 func (m *LicenseStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*LicenseStatusResponse) ProtoMessage()    {}
 func (*LicenseStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{49}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{49}
 }
 func (m *LicenseStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LicenseStatusResponse.Unmarshal(m, b)
@@ -2881,7 +2881,7 @@ func (m *LicenseApplyRequest) Reset()         { *m = LicenseApplyRequest{} }
 func (m *LicenseApplyRequest) String() string { return proto.CompactTextString(m) }
 func (*LicenseApplyRequest) ProtoMessage()    {}
 func (*LicenseApplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{50}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{50}
 }
 func (m *LicenseApplyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LicenseApplyRequest.Unmarshal(m, b)
@@ -2928,7 +2928,7 @@ func (m *LicenseApplyResponse) Reset()         { *m = LicenseApplyResponse{} }
 func (m *LicenseApplyResponse) String() string { return proto.CompactTextString(m) }
 func (*LicenseApplyResponse) ProtoMessage()    {}
 func (*LicenseApplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{51}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{51}
 }
 func (m *LicenseApplyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LicenseApplyResponse.Unmarshal(m, b)
@@ -2980,7 +2980,7 @@ func (m *ServiceStatus) Reset()         { *m = ServiceStatus{} }
 func (m *ServiceStatus) String() string { return proto.CompactTextString(m) }
 func (*ServiceStatus) ProtoMessage()    {}
 func (*ServiceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{52}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{52}
 }
 func (m *ServiceStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceStatus.Unmarshal(m, b)
@@ -3021,7 +3021,7 @@ func (m *ServiceState) Reset()         { *m = ServiceState{} }
 func (m *ServiceState) String() string { return proto.CompactTextString(m) }
 func (*ServiceState) ProtoMessage()    {}
 func (*ServiceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{53}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{53}
 }
 func (m *ServiceState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceState.Unmarshal(m, b)
@@ -3083,7 +3083,7 @@ func (m *GatherLogsRequest) Reset()         { *m = GatherLogsRequest{} }
 func (m *GatherLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GatherLogsRequest) ProtoMessage()    {}
 func (*GatherLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{54}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{54}
 }
 func (m *GatherLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatherLogsRequest.Unmarshal(m, b)
@@ -3123,7 +3123,7 @@ func (m *GatherLogsResponse) Reset()         { *m = GatherLogsResponse{} }
 func (m *GatherLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*GatherLogsResponse) ProtoMessage()    {}
 func (*GatherLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{55}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{55}
 }
 func (m *GatherLogsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatherLogsResponse.Unmarshal(m, b)
@@ -3175,7 +3175,7 @@ func (m *GatherLogsDownloadRequest) Reset()         { *m = GatherLogsDownloadReq
 func (m *GatherLogsDownloadRequest) String() string { return proto.CompactTextString(m) }
 func (*GatherLogsDownloadRequest) ProtoMessage()    {}
 func (*GatherLogsDownloadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{56}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{56}
 }
 func (m *GatherLogsDownloadRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatherLogsDownloadRequest.Unmarshal(m, b)
@@ -3213,7 +3213,7 @@ func (m *GatherLogsDownloadResponse) Reset()         { *m = GatherLogsDownloadRe
 func (m *GatherLogsDownloadResponse) String() string { return proto.CompactTextString(m) }
 func (*GatherLogsDownloadResponse) ProtoMessage()    {}
 func (*GatherLogsDownloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{57}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{57}
 }
 func (m *GatherLogsDownloadResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatherLogsDownloadResponse.Unmarshal(m, b)
@@ -3250,7 +3250,7 @@ func (m *RestartServicesRequest) Reset()         { *m = RestartServicesRequest{}
 func (m *RestartServicesRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartServicesRequest) ProtoMessage()    {}
 func (*RestartServicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{58}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{58}
 }
 func (m *RestartServicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestartServicesRequest.Unmarshal(m, b)
@@ -3281,7 +3281,7 @@ func (m *RestartServicesResponse) Reset()         { *m = RestartServicesResponse
 func (m *RestartServicesResponse) String() string { return proto.CompactTextString(m) }
 func (*RestartServicesResponse) ProtoMessage()    {}
 func (*RestartServicesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{59}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{59}
 }
 func (m *RestartServicesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestartServicesResponse.Unmarshal(m, b)
@@ -3319,7 +3319,7 @@ func (m *GetAutomateConfigRequest) Reset()         { *m = GetAutomateConfigReque
 func (m *GetAutomateConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAutomateConfigRequest) ProtoMessage()    {}
 func (*GetAutomateConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{60}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{60}
 }
 func (m *GetAutomateConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAutomateConfigRequest.Unmarshal(m, b)
@@ -3351,7 +3351,7 @@ func (m *GetAutomateConfigResponse) Reset()         { *m = GetAutomateConfigResp
 func (m *GetAutomateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAutomateConfigResponse) ProtoMessage()    {}
 func (*GetAutomateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{61}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{61}
 }
 func (m *GetAutomateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAutomateConfigResponse.Unmarshal(m, b)
@@ -3397,7 +3397,7 @@ func (m *PatchAutomateConfigRequest) Reset()         { *m = PatchAutomateConfigR
 func (m *PatchAutomateConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*PatchAutomateConfigRequest) ProtoMessage()    {}
 func (*PatchAutomateConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{62}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{62}
 }
 func (m *PatchAutomateConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PatchAutomateConfigRequest.Unmarshal(m, b)
@@ -3442,7 +3442,7 @@ func (m *PatchAutomateConfigResponse) Reset()         { *m = PatchAutomateConfig
 func (m *PatchAutomateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*PatchAutomateConfigResponse) ProtoMessage()    {}
 func (*PatchAutomateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{63}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{63}
 }
 func (m *PatchAutomateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PatchAutomateConfigResponse.Unmarshal(m, b)
@@ -3480,7 +3480,7 @@ func (m *SetAutomateConfigRequest) Reset()         { *m = SetAutomateConfigReque
 func (m *SetAutomateConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*SetAutomateConfigRequest) ProtoMessage()    {}
 func (*SetAutomateConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{64}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{64}
 }
 func (m *SetAutomateConfigRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAutomateConfigRequest.Unmarshal(m, b)
@@ -3518,7 +3518,7 @@ func (m *SetAutomateConfigResponse) Reset()         { *m = SetAutomateConfigResp
 func (m *SetAutomateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*SetAutomateConfigResponse) ProtoMessage()    {}
 func (*SetAutomateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{65}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{65}
 }
 func (m *SetAutomateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAutomateConfigResponse.Unmarshal(m, b)
@@ -3555,7 +3555,7 @@ func (m *DumpDBRequest) Reset()         { *m = DumpDBRequest{} }
 func (m *DumpDBRequest) String() string { return proto.CompactTextString(m) }
 func (*DumpDBRequest) ProtoMessage()    {}
 func (*DumpDBRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{66}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{66}
 }
 func (m *DumpDBRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpDBRequest.Unmarshal(m, b)
@@ -3586,7 +3586,7 @@ func (m *DumpDBResponse) Reset()         { *m = DumpDBResponse{} }
 func (m *DumpDBResponse) String() string { return proto.CompactTextString(m) }
 func (*DumpDBResponse) ProtoMessage()    {}
 func (*DumpDBResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{67}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{67}
 }
 func (m *DumpDBResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DumpDBResponse.Unmarshal(m, b)
@@ -3626,7 +3626,7 @@ func (m *ManifestVersionResponse) Reset()         { *m = ManifestVersionResponse
 func (m *ManifestVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestVersionResponse) ProtoMessage()    {}
 func (*ManifestVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{68}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{68}
 }
 func (m *ManifestVersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ManifestVersionResponse.Unmarshal(m, b)
@@ -3678,7 +3678,7 @@ func (m *DeployIDResponse) Reset()         { *m = DeployIDResponse{} }
 func (m *DeployIDResponse) String() string { return proto.CompactTextString(m) }
 func (*DeployIDResponse) ProtoMessage()    {}
 func (*DeployIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{69}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{69}
 }
 func (m *DeployIDResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeployIDResponse.Unmarshal(m, b)
@@ -3718,7 +3718,7 @@ func (m *BackupTask) Reset()         { *m = BackupTask{} }
 func (m *BackupTask) String() string { return proto.CompactTextString(m) }
 func (*BackupTask) ProtoMessage()    {}
 func (*BackupTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{70}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{70}
 }
 func (m *BackupTask) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupTask.Unmarshal(m, b)
@@ -3764,7 +3764,7 @@ func (m *BackupDescription) Reset()         { *m = BackupDescription{} }
 func (m *BackupDescription) String() string { return proto.CompactTextString(m) }
 func (*BackupDescription) ProtoMessage()    {}
 func (*BackupDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{71}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{71}
 }
 func (m *BackupDescription) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupDescription.Unmarshal(m, b)
@@ -3814,7 +3814,7 @@ func (m *S3BackupLocation) Reset()         { *m = S3BackupLocation{} }
 func (m *S3BackupLocation) String() string { return proto.CompactTextString(m) }
 func (*S3BackupLocation) ProtoMessage()    {}
 func (*S3BackupLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{72}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{72}
 }
 func (m *S3BackupLocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_S3BackupLocation.Unmarshal(m, b)
@@ -3876,6 +3876,199 @@ func (m *S3BackupLocation) GetSessionToken() string {
 	return ""
 }
 
+// Credentials of GCS and Azure locations are never part of a request or a
+// task: they name files on the Automate host that deployment-service reads
+// whenever it accesses the location.
+type GCSBackupLocation struct {
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty" toml:"bucket_name,omitempty" mapstructure:"bucket_name,omitempty"`
+	BasePath   string `protobuf:"bytes,2,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty" toml:"base_path,omitempty" mapstructure:"base_path,omitempty"`
+	// endpoint is only needed when not using Google Cloud Storage itself,
+	// for example an emulator like fake-gcs-server
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty" toml:"endpoint,omitempty" mapstructure:"endpoint,omitempty"`
+	// Path to the JSON key of the service account to authenticate as. When
+	// empty the application default credentials are used.
+	CredentialsPath      string   `protobuf:"bytes,5,opt,name=credentials_path,json=credentialsPath,proto3" json:"credentials_path,omitempty" toml:"credentials_path,omitempty" mapstructure:"credentials_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *GCSBackupLocation) Reset()         { *m = GCSBackupLocation{} }
+func (m *GCSBackupLocation) String() string { return proto.CompactTextString(m) }
+func (*GCSBackupLocation) ProtoMessage()    {}
+func (*GCSBackupLocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{73}
+}
+func (m *GCSBackupLocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCSBackupLocation.Unmarshal(m, b)
+}
+func (m *GCSBackupLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCSBackupLocation.Marshal(b, m, deterministic)
+}
+func (dst *GCSBackupLocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCSBackupLocation.Merge(dst, src)
+}
+func (m *GCSBackupLocation) XXX_Size() int {
+	return xxx_messageInfo_GCSBackupLocation.Size(m)
+}
+func (m *GCSBackupLocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCSBackupLocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCSBackupLocation proto.InternalMessageInfo
+
+func (m *GCSBackupLocation) GetBucketName() string {
+	if m != nil {
+		return m.BucketName
+	}
+	return ""
+}
+
+func (m *GCSBackupLocation) GetBasePath() string {
+	if m != nil {
+		return m.BasePath
+	}
+	return ""
+}
+
+func (m *GCSBackupLocation) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GCSBackupLocation) GetCredentialsPath() string {
+	if m != nil {
+		return m.CredentialsPath
+	}
+	return ""
+}
+
+type AzureBackupLocation struct {
+	ContainerName string `protobuf:"bytes,1,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty" toml:"container_name,omitempty" mapstructure:"container_name,omitempty"`
+	BasePath      string `protobuf:"bytes,2,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty" toml:"base_path,omitempty" mapstructure:"base_path,omitempty"`
+	// endpoint defaults to https://<account_name>.blob.core.windows.net
+	Endpoint    string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty" toml:"endpoint,omitempty" mapstructure:"endpoint,omitempty"`
+	AccountName string `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty" toml:"account_name,omitempty" mapstructure:"account_name,omitempty"`
+	// Path to a file holding the key of the storage account
+	AccountKeyPath       string   `protobuf:"bytes,6,opt,name=account_key_path,json=accountKeyPath,proto3" json:"account_key_path,omitempty" toml:"account_key_path,omitempty" mapstructure:"account_key_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *AzureBackupLocation) Reset()         { *m = AzureBackupLocation{} }
+func (m *AzureBackupLocation) String() string { return proto.CompactTextString(m) }
+func (*AzureBackupLocation) ProtoMessage()    {}
+func (*AzureBackupLocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{74}
+}
+func (m *AzureBackupLocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AzureBackupLocation.Unmarshal(m, b)
+}
+func (m *AzureBackupLocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AzureBackupLocation.Marshal(b, m, deterministic)
+}
+func (dst *AzureBackupLocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureBackupLocation.Merge(dst, src)
+}
+func (m *AzureBackupLocation) XXX_Size() int {
+	return xxx_messageInfo_AzureBackupLocation.Size(m)
+}
+func (m *AzureBackupLocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureBackupLocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureBackupLocation proto.InternalMessageInfo
+
+func (m *AzureBackupLocation) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *AzureBackupLocation) GetBasePath() string {
+	if m != nil {
+		return m.BasePath
+	}
+	return ""
+}
+
+func (m *AzureBackupLocation) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *AzureBackupLocation) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
+}
+
+func (m *AzureBackupLocation) GetAccountKeyPath() string {
+	if m != nil {
+		return m.AccountKeyPath
+	}
+	return ""
+}
+
+// BackupEncryption names the file holding the secret backups are encrypted
+// with. Only one of key_path or passphrase_path is set. Like location
+// credentials, the secret itself is never part of a request or a task.
+type BackupEncryption struct {
+	// Path to a file holding a 256 bit AES key, raw or base64 encoded
+	KeyPath string `protobuf:"bytes,3,opt,name=key_path,json=keyPath,proto3" json:"key_path,omitempty" toml:"key_path,omitempty" mapstructure:"key_path,omitempty"`
+	// Path to a file holding a passphrase, stretched into an AES key with
+	// PBKDF2
+	PassphrasePath       string   `protobuf:"bytes,4,opt,name=passphrase_path,json=passphrasePath,proto3" json:"passphrase_path,omitempty" toml:"passphrase_path,omitempty" mapstructure:"passphrase_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *BackupEncryption) Reset()         { *m = BackupEncryption{} }
+func (m *BackupEncryption) String() string { return proto.CompactTextString(m) }
+func (*BackupEncryption) ProtoMessage()    {}
+func (*BackupEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{75}
+}
+func (m *BackupEncryption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupEncryption.Unmarshal(m, b)
+}
+func (m *BackupEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupEncryption.Marshal(b, m, deterministic)
+}
+func (dst *BackupEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupEncryption.Merge(dst, src)
+}
+func (m *BackupEncryption) XXX_Size() int {
+	return xxx_messageInfo_BackupEncryption.Size(m)
+}
+func (m *BackupEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupEncryption proto.InternalMessageInfo
+
+func (m *BackupEncryption) GetKeyPath() string {
+	if m != nil {
+		return m.KeyPath
+	}
+	return ""
+}
+
+func (m *BackupEncryption) GetPassphrasePath() string {
+	if m != nil {
+		return m.PassphrasePath
+	}
+	return ""
+}
+
 type BackupRestoreTask struct {
 	Id     *timestamp.Timestamp `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
 	Backup *BackupTask          `protobuf:"bytes,2,opt,name=backup,proto3" json:"backup,omitempty" toml:"backup,omitempty" mapstructure:"backup,omitempty"`
@@ -3894,8 +4087,15 @@ type BackupRestoreTask struct {
 	// airgap artifact. upgrade will be ignored.
 	Airgap bool `protobuf:"varint,8,opt,name=airgap,proto3" json:"airgap,omitempty" toml:"airgap,omitempty" mapstructure:"airgap,omitempty"`
 	// If S3BackupLocation is provided, the backup will be restored from S3
-	S3BackupLocation     *S3BackupLocation `protobuf:"bytes,9,opt,name=s3_backup_location,json=s3BackupLocation,proto3" json:"s3_backup_location,omitempty" toml:"s3_backup_location,omitempty" mapstructure:"s3_backup_location,omitempty"`
-	Sha256               string            `protobuf:"bytes,10,opt,name=sha256,proto3" json:"sha256,omitempty" toml:"sha256,omitempty" mapstructure:"sha256,omitempty"`
+	S3BackupLocation *S3BackupLocation `protobuf:"bytes,9,opt,name=s3_backup_location,json=s3BackupLocation,proto3" json:"s3_backup_location,omitempty" toml:"s3_backup_location,omitempty" mapstructure:"s3_backup_location,omitempty"`
+	Sha256           string            `protobuf:"bytes,10,opt,name=sha256,proto3" json:"sha256,omitempty" toml:"sha256,omitempty" mapstructure:"sha256,omitempty"`
+	// If GCSBackupLocation is provided, the backup will be restored from GCS
+	GcsBackupLocation *GCSBackupLocation `protobuf:"bytes,11,opt,name=gcs_backup_location,json=gcsBackupLocation,proto3" json:"gcs_backup_location,omitempty" toml:"gcs_backup_location,omitempty" mapstructure:"gcs_backup_location,omitempty"`
+	// If AzureBackupLocation is provided, the backup will be restored from
+	// Azure Blob storage
+	AzureBackupLocation *AzureBackupLocation `protobuf:"bytes,12,opt,name=azure_backup_location,json=azureBackupLocation,proto3" json:"azure_backup_location,omitempty" toml:"azure_backup_location,omitempty" mapstructure:"azure_backup_location,omitempty"`
+	// The secret the backup was encrypted with, if it was encrypted
+	Encryption           *BackupEncryption `protobuf:"bytes,13,opt,name=encryption,proto3" json:"encryption,omitempty" toml:"encryption,omitempty" mapstructure:"encryption,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte            `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32             `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -3905,7 +4105,7 @@ func (m *BackupRestoreTask) Reset()         { *m = BackupRestoreTask{} }
 func (m *BackupRestoreTask) String() string { return proto.CompactTextString(m) }
 func (*BackupRestoreTask) ProtoMessage()    {}
 func (*BackupRestoreTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{73}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{76}
 }
 func (m *BackupRestoreTask) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupRestoreTask.Unmarshal(m, b)
@@ -3995,6 +4195,27 @@ func (m *BackupRestoreTask) GetSha256() string {
 	return ""
 }
 
+func (m *BackupRestoreTask) GetGcsBackupLocation() *GCSBackupLocation {
+	if m != nil {
+		return m.GcsBackupLocation
+	}
+	return nil
+}
+
+func (m *BackupRestoreTask) GetAzureBackupLocation() *AzureBackupLocation {
+	if m != nil {
+		return m.AzureBackupLocation
+	}
+	return nil
+}
+
+func (m *BackupRestoreTask) GetEncryption() *BackupEncryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
 type BackupDeleteTask struct {
 	Id                   *timestamp.Timestamp `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" toml:"id,omitempty" mapstructure:"id,omitempty"`
 	Backups              []*BackupTask        `protobuf:"bytes,2,rep,name=backups,proto3" json:"backups,omitempty" toml:"backups,omitempty" mapstructure:"backups,omitempty"`
@@ -4007,7 +4228,7 @@ func (m *BackupDeleteTask) Reset()         { *m = BackupDeleteTask{} }
 func (m *BackupDeleteTask) String() string { return proto.CompactTextString(m) }
 func (*BackupDeleteTask) ProtoMessage()    {}
 func (*BackupDeleteTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{74}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{77}
 }
 func (m *BackupDeleteTask) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupDeleteTask.Unmarshal(m, b)
@@ -4042,16 +4263,22 @@ func (m *BackupDeleteTask) GetBackups() []*BackupTask {
 }
 
 type CreateBackupRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	// If a location is provided, the backup is stored there instead of the
+	// configured backup location
+	GcsBackupLocation   *GCSBackupLocation   `protobuf:"bytes,1,opt,name=gcs_backup_location,json=gcsBackupLocation,proto3" json:"gcs_backup_location,omitempty" toml:"gcs_backup_location,omitempty" mapstructure:"gcs_backup_location,omitempty"`
+	AzureBackupLocation *AzureBackupLocation `protobuf:"bytes,2,opt,name=azure_backup_location,json=azureBackupLocation,proto3" json:"azure_backup_location,omitempty" toml:"azure_backup_location,omitempty" mapstructure:"azure_backup_location,omitempty"`
+	// If provided, the backup objects are encrypted before they are stored
+	Encryption           *BackupEncryption `protobuf:"bytes,3,opt,name=encryption,proto3" json:"encryption,omitempty" toml:"encryption,omitempty" mapstructure:"encryption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte            `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32             `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
 func (m *CreateBackupRequest) Reset()         { *m = CreateBackupRequest{} }
 func (m *CreateBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateBackupRequest) ProtoMessage()    {}
 func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{75}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{78}
 }
 func (m *CreateBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateBackupRequest.Unmarshal(m, b)
@@ -4071,6 +4298,27 @@ func (m *CreateBackupRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateBackupRequest proto.InternalMessageInfo
 
+func (m *CreateBackupRequest) GetGcsBackupLocation() *GCSBackupLocation {
+	if m != nil {
+		return m.GcsBackupLocation
+	}
+	return nil
+}
+
+func (m *CreateBackupRequest) GetAzureBackupLocation() *AzureBackupLocation {
+	if m != nil {
+		return m.AzureBackupLocation
+	}
+	return nil
+}
+
+func (m *CreateBackupRequest) GetEncryption() *BackupEncryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
 type CreateBackupResponse struct {
 	Backup               *BackupTask `protobuf:"bytes,1,opt,name=backup,proto3" json:"backup,omitempty" toml:"backup,omitempty" mapstructure:"backup,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -4082,7 +4330,7 @@ func (m *CreateBackupResponse) Reset()         { *m = CreateBackupResponse{} }
 func (m *CreateBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateBackupResponse) ProtoMessage()    {}
 func (*CreateBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{76}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{79}
 }
 func (m *CreateBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateBackupResponse.Unmarshal(m, b)
@@ -4119,7 +4367,7 @@ func (m *ListBackupsRequest) Reset()         { *m = ListBackupsRequest{} }
 func (m *ListBackupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListBackupsRequest) ProtoMessage()    {}
 func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{77}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{80}
 }
 func (m *ListBackupsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListBackupsRequest.Unmarshal(m, b)
@@ -4150,7 +4398,7 @@ func (m *ListBackupsResponse) Reset()         { *m = ListBackupsResponse{} }
 func (m *ListBackupsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListBackupsResponse) ProtoMessage()    {}
 func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{78}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{81}
 }
 func (m *ListBackupsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListBackupsResponse.Unmarshal(m, b)
@@ -4188,7 +4436,7 @@ func (m *ShowBackupRequest) Reset()         { *m = ShowBackupRequest{} }
 func (m *ShowBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowBackupRequest) ProtoMessage()    {}
 func (*ShowBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{79}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{82}
 }
 func (m *ShowBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowBackupRequest.Unmarshal(m, b)
@@ -4228,7 +4476,7 @@ func (m *ShowBackupResponse) Reset()         { *m = ShowBackupResponse{} }
 func (m *ShowBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowBackupResponse) ProtoMessage()    {}
 func (*ShowBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{80}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{83}
 }
 func (m *ShowBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ShowBackupResponse.Unmarshal(m, b)
@@ -4280,7 +4528,7 @@ func (m *DeleteBackupsRequest) Reset()         { *m = DeleteBackupsRequest{} }
 func (m *DeleteBackupsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteBackupsRequest) ProtoMessage()    {}
 func (*DeleteBackupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{81}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{84}
 }
 func (m *DeleteBackupsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteBackupsRequest.Unmarshal(m, b)
@@ -4318,7 +4566,7 @@ func (m *DeleteBackupsResponse) Reset()         { *m = DeleteBackupsResponse{} }
 func (m *DeleteBackupsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteBackupsResponse) ProtoMessage()    {}
 func (*DeleteBackupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{82}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{85}
 }
 func (m *DeleteBackupsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteBackupsResponse.Unmarshal(m, b)
@@ -4356,7 +4604,7 @@ func (m *RestoreBackupRequest) Reset()         { *m = RestoreBackupRequest{} }
 func (m *RestoreBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupRequest) ProtoMessage()    {}
 func (*RestoreBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{83}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{86}
 }
 func (m *RestoreBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestoreBackupRequest.Unmarshal(m, b)
@@ -4394,7 +4642,7 @@ func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
 func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreBackupResponse) ProtoMessage()    {}
 func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{84}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{87}
 }
 func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
@@ -4431,7 +4679,7 @@ func (m *BackupStatusRequest) Reset()         { *m = BackupStatusRequest{} }
 func (m *BackupStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*BackupStatusRequest) ProtoMessage()    {}
 func (*BackupStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{85}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{88}
 }
 func (m *BackupStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupStatusRequest.Unmarshal(m, b)
@@ -4452,18 +4700,20 @@ func (m *BackupStatusRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_BackupStatusRequest proto.InternalMessageInfo
 
 type BackupStatusResponse struct {
-	OpType               BackupStatusResponse_OperationType `protobuf:"varint,1,opt,name=op_type,json=opType,proto3,enum=chef.automate.domain.deployment.BackupStatusResponse_OperationType" json:"op_type,omitempty" toml:"op_type,omitempty" mapstructure:"op_type,omitempty"`
-	TaskIds              []string                           `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty" toml:"task_ids,omitempty" mapstructure:"task_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                           `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte                             `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32                              `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	OpType  BackupStatusResponse_OperationType `protobuf:"varint,1,opt,name=op_type,json=opType,proto3,enum=chef.automate.domain.deployment.BackupStatusResponse_OperationType" json:"op_type,omitempty" toml:"op_type,omitempty" mapstructure:"op_type,omitempty"`
+	TaskIds []string                           `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty" toml:"task_ids,omitempty" mapstructure:"task_ids,omitempty"`
+	// schedule is only set when scheduled backups are configured
+	Schedule             *BackupSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty" toml:"schedule,omitempty" mapstructure:"schedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte          `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32           `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
 func (m *BackupStatusResponse) Reset()         { *m = BackupStatusResponse{} }
 func (m *BackupStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*BackupStatusResponse) ProtoMessage()    {}
 func (*BackupStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{86}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{89}
 }
 func (m *BackupStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BackupStatusResponse.Unmarshal(m, b)
@@ -4497,6 +4747,96 @@ func (m *BackupStatusResponse) GetTaskIds() []string {
 	return nil
 }
 
+func (m *BackupStatusResponse) GetSchedule() *BackupSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// BackupSchedule is the state of the backups scheduled by deployment-service
+type BackupSchedule struct {
+	Cron    string               `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty" toml:"cron,omitempty" mapstructure:"cron,omitempty"`
+	NextRun *timestamp.Timestamp `protobuf:"bytes,2,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty" toml:"next_run,omitempty" mapstructure:"next_run,omitempty"`
+	LastRun *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty" toml:"last_run,omitempty" mapstructure:"last_run,omitempty"`
+	// last_backup is the backup created by the last run, if it got that far
+	LastBackup *BackupTask `protobuf:"bytes,4,opt,name=last_backup,json=lastBackup,proto3" json:"last_backup,omitempty" toml:"last_backup,omitempty" mapstructure:"last_backup,omitempty"`
+	// last_error is why the last run failed, it is empty when it succeeded
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty" toml:"last_error,omitempty" mapstructure:"last_error,omitempty"`
+	// last_pruned is the number of backups the retention rules deleted in
+	// the last run
+	LastPruned           int32    `protobuf:"varint,6,opt,name=last_pruned,json=lastPruned,proto3" json:"last_pruned,omitempty" toml:"last_pruned,omitempty" mapstructure:"last_pruned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *BackupSchedule) Reset()         { *m = BackupSchedule{} }
+func (m *BackupSchedule) String() string { return proto.CompactTextString(m) }
+func (*BackupSchedule) ProtoMessage()    {}
+func (*BackupSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{90}
+}
+func (m *BackupSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupSchedule.Unmarshal(m, b)
+}
+func (m *BackupSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupSchedule.Marshal(b, m, deterministic)
+}
+func (dst *BackupSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupSchedule.Merge(dst, src)
+}
+func (m *BackupSchedule) XXX_Size() int {
+	return xxx_messageInfo_BackupSchedule.Size(m)
+}
+func (m *BackupSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupSchedule proto.InternalMessageInfo
+
+func (m *BackupSchedule) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *BackupSchedule) GetNextRun() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextRun
+	}
+	return nil
+}
+
+func (m *BackupSchedule) GetLastRun() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastRun
+	}
+	return nil
+}
+
+func (m *BackupSchedule) GetLastBackup() *BackupTask {
+	if m != nil {
+		return m.LastBackup
+	}
+	return nil
+}
+
+func (m *BackupSchedule) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *BackupSchedule) GetLastPruned() int32 {
+	if m != nil {
+		return m.LastPruned
+	}
+	return 0
+}
+
 type CancelBackupRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -4507,7 +4847,7 @@ func (m *CancelBackupRequest) Reset()         { *m = CancelBackupRequest{} }
 func (m *CancelBackupRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelBackupRequest) ProtoMessage()    {}
 func (*CancelBackupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{87}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{91}
 }
 func (m *CancelBackupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelBackupRequest.Unmarshal(m, b)
@@ -4537,7 +4877,7 @@ func (m *CancelBackupResponse) Reset()         { *m = CancelBackupResponse{} }
 func (m *CancelBackupResponse) String() string { return proto.CompactTextString(m) }
 func (*CancelBackupResponse) ProtoMessage()    {}
 func (*CancelBackupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{88}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{92}
 }
 func (m *CancelBackupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelBackupResponse.Unmarshal(m, b)
@@ -4568,7 +4908,7 @@ func (m *UpgradeRequest) Reset()         { *m = UpgradeRequest{} }
 func (m *UpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*UpgradeRequest) ProtoMessage()    {}
 func (*UpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{89}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{93}
 }
 func (m *UpgradeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeRequest.Unmarshal(m, b)
@@ -4608,7 +4948,7 @@ func (m *UpgradeResponse) Reset()         { *m = UpgradeResponse{} }
 func (m *UpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*UpgradeResponse) ProtoMessage()    {}
 func (*UpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{90}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{94}
 }
 func (m *UpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeResponse.Unmarshal(m, b)
@@ -4659,7 +4999,7 @@ func (m *CurrentReleaseManifestRequest) Reset()         { *m = CurrentReleaseMan
 func (m *CurrentReleaseManifestRequest) String() string { return proto.CompactTextString(m) }
 func (*CurrentReleaseManifestRequest) ProtoMessage()    {}
 func (*CurrentReleaseManifestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{91}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{95}
 }
 func (m *CurrentReleaseManifestRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CurrentReleaseManifestRequest.Unmarshal(m, b)
@@ -4690,7 +5030,7 @@ func (m *ReleaseManifest) Reset()         { *m = ReleaseManifest{} }
 func (m *ReleaseManifest) String() string { return proto.CompactTextString(m) }
 func (*ReleaseManifest) ProtoMessage()    {}
 func (*ReleaseManifest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{92}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{96}
 }
 func (m *ReleaseManifest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReleaseManifest.Unmarshal(m, b)
@@ -4727,7 +5067,7 @@ func (m *A1UpgradeStatusRequest) Reset()         { *m = A1UpgradeStatusRequest{}
 func (m *A1UpgradeStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*A1UpgradeStatusRequest) ProtoMessage()    {}
 func (*A1UpgradeStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{93}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{97}
 }
 func (m *A1UpgradeStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_A1UpgradeStatusRequest.Unmarshal(m, b)
@@ -4759,7 +5099,7 @@ func (m *A1UpgradeStatusResponse) Reset()         { *m = A1UpgradeStatusResponse
 func (m *A1UpgradeStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*A1UpgradeStatusResponse) ProtoMessage()    {}
 func (*A1UpgradeStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{94}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{98}
 }
 func (m *A1UpgradeStatusResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_A1UpgradeStatusResponse.Unmarshal(m, b)
@@ -4815,7 +5155,7 @@ func (m *A1UpgradeStatusResponse_ServiceMigrationStatus) String() string {
 }
 func (*A1UpgradeStatusResponse_ServiceMigrationStatus) ProtoMessage() {}
 func (*A1UpgradeStatusResponse_ServiceMigrationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_automate_deployment_2b5e07aecc45f53d, []int{94, 0}
+	return fileDescriptor_automate_deployment_875469570e26b0e7, []int{98, 0}
 }
 func (m *A1UpgradeStatusResponse_ServiceMigrationStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_A1UpgradeStatusResponse_ServiceMigrationStatus.Unmarshal(m, b)
@@ -4944,6 +5284,9 @@ func init() {
 	proto.RegisterType((*BackupTask)(nil), "chef.automate.domain.deployment.BackupTask")
 	proto.RegisterType((*BackupDescription)(nil), "chef.automate.domain.deployment.BackupDescription")
 	proto.RegisterType((*S3BackupLocation)(nil), "chef.automate.domain.deployment.S3BackupLocation")
+	proto.RegisterType((*GCSBackupLocation)(nil), "chef.automate.domain.deployment.GCSBackupLocation")
+	proto.RegisterType((*AzureBackupLocation)(nil), "chef.automate.domain.deployment.AzureBackupLocation")
+	proto.RegisterType((*BackupEncryption)(nil), "chef.automate.domain.deployment.BackupEncryption")
 	proto.RegisterType((*BackupRestoreTask)(nil), "chef.automate.domain.deployment.BackupRestoreTask")
 	proto.RegisterType((*BackupDeleteTask)(nil), "chef.automate.domain.deployment.BackupDeleteTask")
 	proto.RegisterType((*CreateBackupRequest)(nil), "chef.automate.domain.deployment.CreateBackupRequest")
@@ -4958,6 +5301,7 @@ func init() {
 	proto.RegisterType((*RestoreBackupResponse)(nil), "chef.automate.domain.deployment.RestoreBackupResponse")
 	proto.RegisterType((*BackupStatusRequest)(nil), "chef.automate.domain.deployment.BackupStatusRequest")
 	proto.RegisterType((*BackupStatusResponse)(nil), "chef.automate.domain.deployment.BackupStatusResponse")
+	proto.RegisterType((*BackupSchedule)(nil), "chef.automate.domain.deployment.BackupSchedule")
 	proto.RegisterType((*CancelBackupRequest)(nil), "chef.automate.domain.deployment.CancelBackupRequest")
 	proto.RegisterType((*CancelBackupResponse)(nil), "chef.automate.domain.deployment.CancelBackupResponse")
 	proto.RegisterType((*UpgradeRequest)(nil), "chef.automate.domain.deployment.UpgradeRequest")
@@ -6632,275 +6976,294 @@ var _Deployment_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("api/interservice/deployment/automate_deployment.proto", fileDescriptor_automate_deployment_2b5e07aecc45f53d)
-}
-
-var fileDescriptor_automate_deployment_2b5e07aecc45f53d = []byte{
-	// 4255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0xcd, 0x73, 0xdb, 0x48,
-	0x76, 0x37, 0x48, 0x8a, 0x14, 0x1f, 0xbf, 0xa0, 0xb6, 0x6c, 0xd3, 0xf0, 0x4e, 0xd9, 0x83, 0xdd,
-	0xc9, 0xcc, 0xd8, 0x59, 0xca, 0x23, 0x7f, 0x8c, 0x3d, 0xb3, 0x9b, 0x5a, 0x9a, 0xe4, 0x58, 0x1c,
-	0xd1, 0x94, 0x02, 0x4a, 0xe3, 0xfd, 0x9a, 0x60, 0x60, 0xb0, 0x45, 0x61, 0x45, 0x02, 0x58, 0x00,
-	0xd4, 0x58, 0x9e, 0xaa, 0x49, 0x2a, 0x5f, 0x55, 0x49, 0x55, 0x2a, 0xb9, 0x24, 0x87, 0xe4, 0x90,
-	0x4a, 0x55, 0xf2, 0x0f, 0xe4, 0x96, 0xbd, 0x26, 0xd7, 0xad, 0xe4, 0x9c, 0xca, 0x31, 0xf7, 0xe4,
-	0x4f, 0xd8, 0xea, 0x2f, 0x10, 0xa0, 0x20, 0x13, 0x94, 0xe5, 0xbd, 0xb1, 0x5f, 0x77, 0xbf, 0xd7,
-	0x1f, 0xaf, 0x5f, 0xbf, 0xf7, 0x7b, 0x0d, 0xc2, 0x03, 0xc3, 0xb5, 0x36, 0x2c, 0x3b, 0xc0, 0x9e,
-	0x8f, 0xbd, 0x63, 0xcb, 0xc4, 0x1b, 0x43, 0xec, 0x8e, 0x9d, 0x93, 0x09, 0xb6, 0x83, 0x0d, 0x63,
-	0x1a, 0x38, 0x13, 0x23, 0xc0, 0xfa, 0x8c, 0xd6, 0x70, 0x3d, 0x27, 0x70, 0xd0, 0x4d, 0xf3, 0x10,
-	0x1f, 0x34, 0x44, 0x7d, 0x63, 0xe8, 0x4c, 0x0c, 0xcb, 0x6e, 0xcc, 0x9a, 0x29, 0x37, 0x47, 0x8e,
-	0x33, 0x1a, 0xe3, 0x0d, 0xda, 0xfc, 0xc5, 0xf4, 0x60, 0x23, 0xb0, 0x26, 0xd8, 0x0f, 0x8c, 0x89,
-	0xcb, 0x38, 0x28, 0xb7, 0x89, 0x60, 0xd3, 0xb1, 0x0f, 0xac, 0x51, 0x54, 0x24, 0xa3, 0xe8, 0x1e,
-	0xfe, 0xe5, 0x14, 0xfb, 0x5c, 0x9a, 0x72, 0x27, 0xb9, 0x6d, 0x38, 0x3c, 0x56, 0xc5, 0x1a, 0xab,
-	0x0f, 0xe0, 0xda, 0x53, 0x1c, 0xb4, 0x7a, 0xdd, 0xce, 0x4b, 0x6c, 0x4e, 0x03, 0xe3, 0xc5, 0x18,
-	0x6b, 0x8c, 0x1b, 0x52, 0x60, 0xd5, 0x1d, 0x1b, 0xc1, 0x81, 0xe3, 0x4d, 0xea, 0xd2, 0x2d, 0xe9,
-	0x83, 0xa2, 0x16, 0x96, 0xd5, 0x2d, 0xa8, 0x9f, 0xee, 0xe6, 0xbb, 0x8e, 0xed, 0x63, 0x54, 0x87,
-	0xc2, 0x31, 0xf6, 0x7c, 0xcb, 0xb1, 0x79, 0x37, 0x51, 0x44, 0x08, 0x72, 0x43, 0x23, 0x30, 0xea,
-	0x99, 0x5b, 0xd2, 0x07, 0x65, 0x8d, 0xfe, 0x56, 0xaf, 0xc2, 0x7a, 0xdf, 0x19, 0xe2, 0xae, 0x7d,
-	0x8c, 0xed, 0xc0, 0xf1, 0x4e, 0xb8, 0x74, 0xf5, 0x4b, 0xb8, 0x32, 0x47, 0xe7, 0xec, 0xdb, 0xb0,
-	0x62, 0x3b, 0x43, 0xec, 0xd7, 0xa5, 0x5b, 0xd9, 0x0f, 0x4a, 0x9b, 0x8d, 0xc6, 0x82, 0xc5, 0x6d,
-	0x84, 0x2c, 0x08, 0x3f, 0x8d, 0x75, 0x56, 0x3f, 0x81, 0x9b, 0x5d, 0xfb, 0xc0, 0x33, 0xfc, 0xc0,
-	0x9b, 0x9a, 0xc1, 0xd4, 0xc3, 0xa4, 0xb2, 0x8d, 0xc7, 0x38, 0x08, 0xe7, 0x7f, 0x0d, 0x0a, 0xa4,
-	0xad, 0x6e, 0x0d, 0xf9, 0x3c, 0xf2, 0xa4, 0xd8, 0x1d, 0xaa, 0x2a, 0xdc, 0x3a, 0xbb, 0x2f, 0x1b,
-	0xa5, 0xfa, 0xbf, 0x19, 0xa8, 0xc4, 0x04, 0x93, 0xc9, 0xdb, 0xc6, 0x04, 0x73, 0x5e, 0xf4, 0x37,
-	0x52, 0xa1, 0xec, 0x78, 0x23, 0xc3, 0xb6, 0x5e, 0x19, 0x01, 0x59, 0xaf, 0x0c, 0xad, 0x8b, 0xd1,
-	0xd0, 0x55, 0xc8, 0xfb, 0x81, 0x11, 0x4c, 0xfd, 0x7a, 0x96, 0x8d, 0x82, 0x95, 0xd0, 0xfb, 0x50,
-	0x13, 0xdb, 0xa1, 0x1f, 0x18, 0x13, 0x6b, 0x7c, 0x52, 0xcf, 0xd1, 0x06, 0x55, 0x41, 0xfe, 0x8c,
-	0x52, 0x63, 0xfb, 0xb8, 0x12, 0xdf, 0x47, 0xf4, 0x21, 0xc8, 0x21, 0x13, 0xb1, 0x69, 0x79, 0xda,
-	0x26, 0x64, 0xfe, 0x05, 0xdf, 0xbc, 0x3a, 0x14, 0xcc, 0x43, 0x6c, 0x1e, 0x59, 0x76, 0xbd, 0xc0,
-	0xb6, 0x95, 0x17, 0xd1, 0x7b, 0x50, 0x35, 0xc7, 0x16, 0xb6, 0x83, 0x90, 0xc5, 0x2a, 0x6d, 0x50,
-	0x61, 0x54, 0xc1, 0xe0, 0x77, 0xa0, 0x86, 0xcd, 0x4d, 0xdd, 0xb2, 0xfd, 0xc0, 0xb0, 0x4d, 0xba,
-	0xae, 0x45, 0xd6, 0x0e, 0x9b, 0x9b, 0x5d, 0x4e, 0xed, 0x0e, 0xd1, 0x6d, 0x58, 0x8b, 0xb5, 0x0b,
-	0x4e, 0x5c, 0x5c, 0x07, 0x36, 0xa8, 0x48, 0xcb, 0xbd, 0x13, 0x17, 0xab, 0x5d, 0x28, 0xef, 0xfb,
-	0xc6, 0x28, 0xdc, 0xb3, 0xc7, 0x00, 0x7e, 0x60, 0x78, 0x81, 0x4e, 0x0e, 0x10, 0x5d, 0xea, 0xd2,
-	0xa6, 0xd2, 0x60, 0xa7, 0xab, 0x21, 0x4e, 0x57, 0x63, 0x4f, 0x9c, 0x2e, 0xad, 0x48, 0x5b, 0x93,
-	0xb2, 0xfa, 0xfb, 0x50, 0xe1, 0xac, 0xb8, 0xa2, 0xfd, 0x28, 0xae, 0x68, 0xb7, 0x17, 0x2a, 0x1a,
-	0xd9, 0x66, 0xc6, 0x82, 0x2b, 0xd9, 0xdf, 0x67, 0xa0, 0x18, 0x12, 0x51, 0x15, 0x32, 0xa1, 0x2a,
-	0x65, 0xac, 0x21, 0xba, 0x01, 0xc5, 0xb1, 0xe1, 0x07, 0xba, 0x8f, 0xb1, 0xd8, 0xf9, 0x55, 0x42,
-	0x18, 0x60, 0x6c, 0x93, 0x45, 0xa0, 0x95, 0xa6, 0xe9, 0xe9, 0x1e, 0x36, 0xb1, 0x75, 0x8c, 0x87,
-	0x5c, 0x01, 0x6a, 0xa4, 0xa2, 0x65, 0x7a, 0x1a, 0x27, 0xa3, 0x77, 0xa1, 0xcc, 0xb7, 0x82, 0xad,
-	0x15, 0x53, 0x83, 0x12, 0xa7, 0x91, 0x75, 0x42, 0x7b, 0xb0, 0x3a, 0xc1, 0x81, 0x41, 0x4f, 0xdf,
-	0x0a, 0x9d, 0xce, 0xa3, 0xf4, 0xd3, 0x69, 0x3c, 0xe3, 0x5d, 0x3b, 0x76, 0xe0, 0x9d, 0x68, 0x21,
-	0x27, 0xe5, 0x53, 0xa8, 0xc4, 0xaa, 0x90, 0x0c, 0xd9, 0x23, 0x7c, 0xc2, 0xe7, 0x48, 0x7e, 0xa2,
-	0x75, 0x58, 0x39, 0x36, 0xc6, 0x53, 0xcc, 0x27, 0xc8, 0x0a, 0x9f, 0x64, 0x1e, 0x49, 0xea, 0x0f,
-	0xe1, 0xfa, 0x53, 0x6c, 0x63, 0xcf, 0x08, 0x70, 0x73, 0x38, 0xb1, 0xec, 0x3d, 0xe7, 0x08, 0xdb,
-	0x62, 0x1f, 0x6f, 0x41, 0x69, 0x88, 0x7d, 0xd3, 0xb3, 0xdc, 0x60, 0x66, 0x47, 0xa2, 0x24, 0xf5,
-	0x31, 0x28, 0x49, 0xdd, 0xf9, 0xde, 0xdd, 0x80, 0xa2, 0xe1, 0x5a, 0x7a, 0x40, 0x88, 0xc2, 0x78,
-	0x19, 0xae, 0x45, 0x1b, 0xa9, 0xff, 0x26, 0xc1, 0x7a, 0x1f, 0x7f, 0xdd, 0x0e, 0xa7, 0x2a, 0xa4,
-	0x3e, 0x85, 0x3c, 0x33, 0x8e, 0x5c, 0x73, 0x36, 0x16, 0xae, 0x51, 0x93, 0x57, 0xb5, 0x68, 0x37,
-	0x8d, 0x77, 0x47, 0x3f, 0x86, 0x9a, 0x6b, 0x98, 0x47, 0xc6, 0x08, 0xeb, 0x0e, 0x1d, 0xae, 0x4f,
-	0xe7, 0x9f, 0x86, 0xe3, 0x2e, 0xeb, 0xb7, 0xc3, 0xba, 0x69, 0x55, 0x37, 0x56, 0x56, 0x31, 0x28,
-	0x4c, 0xd6, 0xd4, 0xc3, 0x6f, 0x6f, 0x02, 0xea, 0xaf, 0x24, 0xb8, 0x91, 0x28, 0x87, 0xaf, 0xaf,
-	0x06, 0x95, 0x19, 0x13, 0x61, 0x21, 0x4b, 0x9b, 0xdf, 0x5f, 0x28, 0x6f, 0xc6, 0xab, 0xdb, 0xd6,
-	0xca, 0xb3, 0x8a, 0xee, 0x30, 0x32, 0xf8, 0xcc, 0x9b, 0x0d, 0xfe, 0x0e, 0x54, 0x98, 0x98, 0xc8,
-	0x4d, 0xc6, 0xef, 0x6b, 0x76, 0x98, 0x8b, 0x5a, 0x58, 0x56, 0x3f, 0x84, 0xaa, 0x68, 0xcc, 0xe7,
-	0x76, 0x0d, 0x0a, 0x81, 0xe1, 0x1f, 0x45, 0xec, 0x3e, 0x29, 0x76, 0x87, 0xea, 0x1f, 0x4b, 0x70,
-	0x99, 0xb5, 0x1d, 0x50, 0x13, 0x2c, 0xd8, 0xbf, 0x8d, 0xc5, 0x88, 0x0c, 0x22, 0x13, 0x1b, 0xc4,
-	0x1d, 0xa8, 0x68, 0x78, 0xe2, 0x1c, 0xe3, 0x34, 0x93, 0xab, 0xc3, 0xd5, 0x67, 0x86, 0x6d, 0x1d,
-	0x60, 0x5f, 0x58, 0x61, 0x71, 0xbd, 0x56, 0xa0, 0xb4, 0x6b, 0xd9, 0x23, 0x51, 0xac, 0x42, 0x99,
-	0x15, 0xf9, 0xf5, 0xb5, 0x06, 0x35, 0x36, 0xb8, 0x6e, 0x5b, 0x34, 0x91, 0xa1, 0x2a, 0x04, 0xf3,
-	0x46, 0x15, 0x28, 0x0d, 0x02, 0xc7, 0x8d, 0xf0, 0x60, 0x45, 0x5e, 0x7d, 0x05, 0x2e, 0x93, 0x72,
-	0xcb, 0xb1, 0x8f, 0xb1, 0x17, 0x9a, 0x68, 0x72, 0xe1, 0xc7, 0xc9, 0xbc, 0x39, 0xa5, 0x1b, 0x5e,
-	0x30, 0xdf, 0xfe, 0x1a, 0x5c, 0x99, 0xa3, 0xf3, 0x0e, 0x75, 0xb8, 0x3a, 0x60, 0x13, 0xe5, 0x73,
-	0x13, 0x1b, 0xa2, 0x5e, 0x86, 0xb5, 0xc1, 0x89, 0x1f, 0xe0, 0x49, 0xcf, 0x19, 0xf9, 0x11, 0xb9,
-	0xfb, 0xee, 0xc8, 0x33, 0x86, 0x38, 0xb6, 0x7b, 0xea, 0xdf, 0x65, 0xe1, 0xca, 0x5c, 0x05, 0x57,
-	0x84, 0x9f, 0xc0, 0x0a, 0xb9, 0x6b, 0xd9, 0x3d, 0x52, 0xdd, 0x6c, 0x2d, 0xdc, 0xcf, 0x44, 0x36,
-	0x51, 0x2a, 0xd6, 0x18, 0x47, 0xf4, 0x15, 0x20, 0x0f, 0x93, 0xee, 0x96, 0x3d, 0xd2, 0xc3, 0xed,
-	0xcb, 0x51, 0xcb, 0xfc, 0x51, 0x4a, 0x39, 0x96, 0x3d, 0xe2, 0xf3, 0xd7, 0xd6, 0x42, 0x66, 0x9c,
-	0xe2, 0xa3, 0x47, 0x50, 0x1f, 0x1b, 0x01, 0xf6, 0x03, 0xdd, 0x38, 0x36, 0xac, 0x31, 0xf1, 0xd0,
-	0xc2, 0xeb, 0x99, 0xdd, 0x23, 0x57, 0x59, 0x7d, 0x53, 0x54, 0x8b, 0x7b, 0xfa, 0x7d, 0xa8, 0x0d,
-	0xb1, 0x6f, 0x79, 0x78, 0x18, 0x76, 0x60, 0x6e, 0x43, 0x95, 0x93, 0x23, 0x0d, 0xcd, 0xa9, 0xe7,
-	0x45, 0x2f, 0x7e, 0xe6, 0x3b, 0x54, 0x39, 0x99, 0x37, 0x54, 0xef, 0x43, 0x39, 0xba, 0x08, 0xa8,
-	0x04, 0x85, 0xfd, 0xfe, 0x76, 0x7f, 0xe7, 0x79, 0x5f, 0xbe, 0x84, 0x2a, 0x50, 0xdc, 0xdf, 0x7d,
-	0xaa, 0x35, 0xdb, 0xdd, 0xfe, 0x53, 0x59, 0x42, 0xab, 0x90, 0xeb, 0xb6, 0x7b, 0x1d, 0x39, 0xa3,
-	0x2a, 0x80, 0x06, 0x38, 0xe8, 0x39, 0xa3, 0x1e, 0x3e, 0xc6, 0x63, 0xbe, 0x5d, 0x9f, 0xe7, 0x56,
-	0x25, 0x39, 0x43, 0x75, 0x2b, 0x5a, 0xc7, 0x55, 0xe2, 0x9f, 0x25, 0x90, 0xe7, 0x17, 0x87, 0xd8,
-	0x95, 0xc0, 0xf0, 0x46, 0x38, 0x48, 0x6d, 0x14, 0xe3, 0x6a, 0xa5, 0xf1, 0xee, 0x84, 0x91, 0x61,
-	0x06, 0x53, 0x63, 0x9c, 0xda, 0x40, 0xcd, 0x33, 0x62, 0xdd, 0xd5, 0x17, 0x50, 0x8d, 0x9b, 0x79,
-	0xb2, 0x94, 0xce, 0x31, 0xf6, 0x3c, 0x6b, 0x88, 0x75, 0xc7, 0xb3, 0x46, 0x96, 0xb8, 0xb5, 0xaa,
-	0x82, 0xbc, 0x43, 0xa9, 0xa4, 0xe1, 0xa1, 0xe1, 0x05, 0xd6, 0x81, 0x61, 0x06, 0xbe, 0xee, 0x1a,
-	0xc1, 0x21, 0xb7, 0x0f, 0xd5, 0x19, 0x79, 0xd7, 0x08, 0x0e, 0xd5, 0x2f, 0x40, 0x0e, 0x0d, 0xb8,
-	0x30, 0x15, 0xef, 0x42, 0x99, 0xeb, 0x9a, 0x1e, 0x71, 0x45, 0x4b, 0x9c, 0xd6, 0x27, 0x1e, 0xe9,
-	0x4d, 0x28, 0xf1, 0xa0, 0x22, 0x70, 0x26, 0x63, 0xce, 0x1b, 0x18, 0x69, 0xcf, 0x99, 0x8c, 0xd5,
-	0x57, 0x50, 0x8e, 0x9a, 0xad, 0x53, 0x5e, 0xcd, 0x63, 0x00, 0xd3, 0xc3, 0x46, 0x80, 0x87, 0xba,
-	0x11, 0xf0, 0x85, 0x7a, 0xad, 0x07, 0xc6, 0x5b, 0x37, 0x03, 0x22, 0x9b, 0xfa, 0x3c, 0x86, 0x19,
-	0xcc, 0xb4, 0x14, 0x08, 0xa9, 0x49, 0x29, 0xea, 0xf7, 0x40, 0x9e, 0xc9, 0x66, 0xa7, 0x8c, 0xb8,
-	0x1c, 0x13, 0x7f, 0x24, 0x5c, 0x8e, 0x89, 0x3f, 0x52, 0xff, 0xb6, 0x06, 0x25, 0xd6, 0xac, 0x43,
-	0xfc, 0x6f, 0x66, 0x20, 0x7f, 0x39, 0xc5, 0xb6, 0xc9, 0x66, 0x9c, 0xd3, 0xc2, 0x32, 0xfa, 0xee,
-	0xbc, 0xe9, 0xe6, 0x1e, 0x78, 0xcc, 0x16, 0x37, 0x20, 0x47, 0xdd, 0xc9, 0xec, 0xc2, 0xc9, 0xd0,
-	0x76, 0xe8, 0x19, 0xe4, 0x59, 0x7f, 0x7a, 0x6e, 0x4a, 0x9b, 0xf7, 0x52, 0x5e, 0x04, 0x74, 0xb8,
-	0xfc, 0xf7, 0xd6, 0x25, 0x8d, 0x33, 0x41, 0x9f, 0xc3, 0x8a, 0x7b, 0x68, 0xf8, 0x98, 0x1e, 0xae,
-	0xd2, 0xe6, 0xe6, 0x52, 0xdc, 0x76, 0x49, 0xcf, 0xad, 0x4b, 0x1a, 0x63, 0x81, 0x9e, 0x03, 0xd0,
-	0x1f, 0xba, 0x1f, 0x60, 0x97, 0xfa, 0xf1, 0xa5, 0xcd, 0x87, 0xcb, 0x33, 0x1c, 0x04, 0xd8, 0xdd,
-	0xba, 0xa4, 0x15, 0x5d, 0x51, 0x40, 0x5f, 0x41, 0x85, 0xde, 0x57, 0xa6, 0x33, 0x71, 0x49, 0x20,
-	0x44, 0x43, 0x80, 0xd2, 0xe6, 0xe3, 0xa5, 0x78, 0xef, 0x19, 0xfe, 0x51, 0x8b, 0x33, 0xd8, 0xba,
-	0xa4, 0x95, 0x83, 0x48, 0x99, 0xac, 0xea, 0x0b, 0xc3, 0x3c, 0x9a, 0xba, 0x34, 0x6a, 0x58, 0x76,
-	0x55, 0x9f, 0xd0, 0xae, 0x64, 0x55, 0x19, 0x13, 0x65, 0x1f, 0xf2, 0xac, 0x1e, 0x6d, 0x87, 0x01,
-	0x16, 0xb3, 0xf3, 0xcb, 0x31, 0xe6, 0xc6, 0x9e, 0xb3, 0x50, 0xfe, 0x45, 0x82, 0x15, 0xba, 0x44,
-	0x68, 0x07, 0x56, 0xd9, 0x52, 0xf3, 0xe3, 0x51, 0xdd, 0xbc, 0xbf, 0xfc, 0x42, 0x77, 0xdb, 0x5a,
-	0x81, 0x72, 0xe9, 0x0e, 0x23, 0xe3, 0xcc, 0xbc, 0xf9, 0x38, 0xff, 0x47, 0x82, 0x62, 0xb8, 0x95,
-	0x17, 0x3f, 0xd6, 0x1b, 0x50, 0x24, 0x1a, 0xc6, 0xcc, 0x0c, 0x8f, 0x6d, 0x08, 0x81, 0xda, 0x98,
-	0xed, 0x58, 0x44, 0xfb, 0x66, 0x13, 0x21, 0x01, 0x06, 0xf6, 0x3c, 0xc7, 0xe3, 0x77, 0x14, 0x2b,
-	0x28, 0xff, 0x9d, 0x83, 0x3c, 0xdb, 0xf2, 0x0b, 0xdd, 0x5e, 0xf4, 0x25, 0x80, 0xe3, 0x92, 0xa0,
-	0x83, 0xfb, 0xf4, 0xe4, 0xbe, 0xfe, 0xe1, 0x39, 0x14, 0xb1, 0xb1, 0x23, 0xb8, 0x68, 0x11, 0x86,
-	0x68, 0x2f, 0x1e, 0xf6, 0x64, 0x53, 0x1e, 0x78, 0xc6, 0xb3, 0x3d, 0xeb, 0x19, 0x0b, 0x95, 0x94,
-	0x5f, 0x67, 0xa0, 0x18, 0xca, 0xbb, 0xd8, 0xf5, 0x10, 0xa0, 0x46, 0x26, 0x02, 0x6a, 0x84, 0x3b,
-	0x92, 0x8b, 0xec, 0x08, 0xb1, 0xb4, 0xfe, 0x89, 0x6d, 0xea, 0xae, 0xe7, 0x8c, 0x3c, 0xec, 0xfb,
-	0x74, 0xbf, 0x24, 0xad, 0x4c, 0x88, 0xbb, 0x9c, 0x86, 0xde, 0x83, 0xaa, 0x11, 0x6f, 0x95, 0xa7,
-	0xad, 0x2a, 0x46, 0xac, 0xd9, 0x17, 0x90, 0xa3, 0x81, 0x6e, 0x81, 0x4e, 0xe0, 0xc9, 0x1b, 0xad,
-	0x7f, 0x83, 0xc4, 0xc7, 0x1a, 0xe5, 0xa7, 0xde, 0x84, 0x1c, 0x8d, 0x96, 0x01, 0xf2, 0x4f, 0x9a,
-	0xad, 0xed, 0xfd, 0x5d, 0xf9, 0x12, 0xf1, 0x55, 0xb4, 0xce, 0x60, 0x6f, 0x47, 0xeb, 0xc8, 0xd2,
-	0xe7, 0xb9, 0xd5, 0xac, 0x9c, 0x53, 0xaa, 0x50, 0x8e, 0x5a, 0x2a, 0xf5, 0x5b, 0xc8, 0xf3, 0xcb,
-	0xa8, 0x04, 0x85, 0xe7, 0xcd, 0xee, 0x1e, 0xf1, 0x64, 0x58, 0xcf, 0xfd, 0x7e, 0x9f, 0xb9, 0x35,
-	0x35, 0x28, 0xb5, 0x76, 0x9e, 0xed, 0xf6, 0x3a, 0x7b, 0x1d, 0x7d, 0x67, 0x5b, 0xce, 0xa0, 0x35,
-	0xa8, 0x84, 0x84, 0xcf, 0x9a, 0xdd, 0x9e, 0x9c, 0x45, 0x75, 0x58, 0x1f, 0x74, 0x7a, 0x9f, 0xe9,
-	0xcc, 0x1d, 0xea, 0xe8, 0xbb, 0x9d, 0x3e, 0x75, 0x8a, 0x72, 0xe8, 0x3b, 0x50, 0xa7, 0x35, 0x5a,
-	0xa7, 0xb5, 0xd3, 0xff, 0xac, 0xfb, 0x74, 0x5f, 0x9b, 0xd5, 0xae, 0xa8, 0xff, 0x20, 0x41, 0x81,
-	0x9f, 0x40, 0x24, 0x43, 0xb9, 0xdb, 0xef, 0xee, 0xe9, 0x83, 0x8e, 0xf6, 0x45, 0xb7, 0xd5, 0x91,
-	0x2f, 0xa1, 0xcb, 0x50, 0xeb, 0xf6, 0x07, 0x7b, 0xcd, 0x5e, 0x2f, 0x24, 0x4a, 0x44, 0xfa, 0x60,
-	0xaf, 0xa9, 0xcd, 0xda, 0x65, 0x88, 0xf4, 0xd6, 0x56, 0xa7, 0xb5, 0x2d, 0x48, 0xfa, 0x56, 0xa7,
-	0xd9, 0xdb, 0xdb, 0x92, 0xb3, 0xe8, 0x0a, 0xac, 0xb5, 0xb4, 0x4e, 0x73, 0xaf, 0xa3, 0x37, 0xdb,
-	0xcf, 0xba, 0x7d, 0x7d, 0x7f, 0xd0, 0xd1, 0xe4, 0x1c, 0xe1, 0xd1, 0xdc, 0xdd, 0xed, 0xfd, 0x44,
-	0xef, 0x75, 0x5b, 0x9d, 0xfe, 0xa0, 0x23, 0xaf, 0x20, 0x04, 0xd5, 0xfd, 0x7e, 0x6f, 0xa7, 0xd9,
-	0x0e, 0xf9, 0xe6, 0x9f, 0x14, 0x60, 0x05, 0x93, 0x95, 0x57, 0xdf, 0x81, 0x02, 0x71, 0xd8, 0x2c,
-	0x9b, 0x62, 0x61, 0x63, 0xcb, 0x0e, 0xb1, 0x30, 0xf2, 0x5b, 0x7d, 0x08, 0x97, 0x07, 0x53, 0xd7,
-	0x75, 0xbc, 0xe0, 0xc9, 0xd4, 0x1e, 0x8e, 0x79, 0x50, 0x47, 0x9c, 0x02, 0x3f, 0x30, 0x46, 0xc4,
-	0x4f, 0x1e, 0x5a, 0x1e, 0xef, 0x01, 0x9c, 0xd4, 0xb6, 0x3c, 0xb5, 0x06, 0x95, 0xb8, 0x43, 0xff,
-	0x1f, 0x12, 0x54, 0xe7, 0x3c, 0xf9, 0x7d, 0xa8, 0x0a, 0xc7, 0x27, 0xa2, 0xfb, 0x69, 0xc0, 0x43,
-	0xee, 0xc1, 0x71, 0x7e, 0x15, 0x3f, 0x5a, 0x44, 0x3f, 0x83, 0xb5, 0x88, 0xf7, 0x10, 0x0b, 0x5e,
-	0x17, 0x73, 0xe6, 0x41, 0x2b, 0x1b, 0xb4, 0x26, 0xcf, 0x6a, 0x58, 0x85, 0x7a, 0x00, 0xd7, 0x4e,
-	0x85, 0x37, 0x7c, 0x3a, 0xdb, 0x73, 0x21, 0xdf, 0x39, 0x5c, 0xd1, 0x59, 0x8c, 0xe8, 0x42, 0x35,
-	0x5e, 0x97, 0x88, 0x54, 0x5e, 0x85, 0x3c, 0xf7, 0x4b, 0x79, 0x38, 0xca, 0x4a, 0x51, 0xb0, 0x37,
-	0x1b, 0x07, 0x7b, 0xeb, 0x50, 0xf0, 0xf0, 0x18, 0x13, 0xc7, 0x85, 0x19, 0x02, 0x51, 0x24, 0x91,
-	0x58, 0xcf, 0x32, 0xb1, 0xed, 0xcf, 0x45, 0x62, 0xff, 0x2a, 0xc1, 0x95, 0xb9, 0x0a, 0x3e, 0x61,
-	0x19, 0xb2, 0x3e, 0xf7, 0xdf, 0x57, 0x35, 0xf2, 0x13, 0xbd, 0x03, 0x30, 0x66, 0x4d, 0x67, 0x5e,
-	0x5b, 0x91, 0x53, 0xba, 0x43, 0x62, 0x6d, 0xcc, 0xa9, 0x1f, 0x38, 0x13, 0xec, 0xb1, 0x3b, 0x88,
-	0x0d, 0xae, 0x2c, 0x88, 0xf4, 0x1e, 0x6a, 0x41, 0x0d, 0xbf, 0x74, 0x2d, 0x66, 0x07, 0xf4, 0x21,
-	0x89, 0xf4, 0x72, 0x0b, 0x5d, 0xbc, 0xea, 0xac, 0x4b, 0xdb, 0x08, 0xb0, 0xda, 0x81, 0xcb, 0x7c,
-	0xcc, 0x4d, 0xd7, 0x1d, 0x87, 0x90, 0x43, 0x1d, 0x0a, 0x7c, 0x34, 0x02, 0x04, 0xe7, 0x45, 0x62,
-	0x1e, 0x0f, 0x1c, 0xcf, 0x64, 0x36, 0x73, 0x55, 0x63, 0x05, 0xf5, 0x30, 0x5c, 0x13, 0xce, 0x66,
-	0x06, 0xa6, 0x4f, 0x5d, 0x32, 0xb4, 0x21, 0x9f, 0xbd, 0x28, 0x92, 0x9a, 0x09, 0xf6, 0x7d, 0x63,
-	0x24, 0xac, 0xaf, 0x28, 0xa2, 0xef, 0x40, 0x71, 0x38, 0x75, 0xc7, 0x96, 0x49, 0x66, 0x94, 0xa5,
-	0xbd, 0x66, 0x04, 0xf5, 0xa7, 0x50, 0x89, 0x29, 0x35, 0xea, 0x9e, 0xd2, 0xa6, 0xef, 0x2f, 0x73,
-	0x2c, 0x70, 0x44, 0x97, 0xfe, 0x5f, 0x82, 0x72, 0xb4, 0x2a, 0x51, 0x95, 0xba, 0x22, 0xac, 0x4e,
-	0xeb, 0xc6, 0x44, 0x39, 0x36, 0x62, 0x61, 0xb4, 0x0c, 0x59, 0xd7, 0x62, 0xb8, 0x68, 0x4e, 0x23,
-	0x3f, 0x89, 0x9e, 0x4e, 0x5d, 0xea, 0xad, 0xe7, 0x28, 0x91, 0x97, 0xd4, 0xe7, 0xb0, 0xc2, 0x46,
-	0xb4, 0x0e, 0x72, 0x6b, 0xa7, 0xdf, 0xef, 0xb4, 0xf6, 0xba, 0x3b, 0x7d, 0xbd, 0xa3, 0x69, 0x3b,
-	0x9a, 0x7c, 0x89, 0x44, 0x9d, 0x6d, 0x12, 0x8e, 0x4a, 0x28, 0x0f, 0x99, 0x9d, 0x6d, 0x39, 0xcb,
-	0x4c, 0xb9, 0xd6, 0x67, 0xf6, 0xb7, 0x0c, 0xab, 0x2d, 0xad, 0xbb, 0xd7, 0x6d, 0x35, 0x7b, 0x72,
-	0x26, 0x1a, 0xbe, 0xae, 0xa8, 0x77, 0x61, 0xed, 0xa9, 0x11, 0x1c, 0x62, 0x2f, 0x82, 0x35, 0x50,
-	0x68, 0xd7, 0x19, 0xe9, 0xc4, 0xae, 0xf9, 0x22, 0xe6, 0x18, 0x33, 0xdb, 0xe7, 0xab, 0x7f, 0x08,
-	0x28, 0xda, 0x83, 0x6f, 0xf4, 0x4d, 0x28, 0xbd, 0xa0, 0x76, 0x2f, 0x1a, 0x9a, 0x01, 0x23, 0x51,
-	0x6d, 0x7d, 0x1f, 0x6a, 0xbc, 0x01, 0x05, 0x76, 0xfd, 0xe9, 0x44, 0x44, 0x7e, 0x8c, 0xdc, 0xe2,
-	0xd4, 0x08, 0x27, 0xdf, 0x7a, 0xc5, 0x14, 0x20, 0x2b, 0x38, 0x0d, 0xac, 0x57, 0x58, 0xfd, 0x01,
-	0x5c, 0x9f, 0x0d, 0xa0, 0xed, 0x7c, 0x6d, 0x8f, 0x1d, 0x63, 0x28, 0x86, 0xbe, 0x68, 0x1c, 0xea,
-	0x5d, 0x50, 0x92, 0x7a, 0xf3, 0x69, 0x88, 0x14, 0x8f, 0x14, 0x49, 0xf1, 0xd4, 0xe1, 0xaa, 0x86,
-	0x29, 0xd0, 0x2e, 0xd0, 0x09, 0x71, 0xe2, 0x37, 0xe1, 0xda, 0xa9, 0x9a, 0x45, 0x28, 0x9c, 0x42,
-	0x53, 0x4f, 0x73, 0xd0, 0x1f, 0xe7, 0xf7, 0x12, 0xae, 0x27, 0xd4, 0x71, 0x8e, 0x17, 0x86, 0xee,
-	0x22, 0xc8, 0x1d, 0x1a, 0x3e, 0x0b, 0xbc, 0x73, 0x1a, 0xfd, 0xad, 0x9e, 0x80, 0xb2, 0x6b, 0x04,
-	0xe6, 0x61, 0xe2, 0xb8, 0xde, 0xae, 0xe8, 0x87, 0x70, 0x23, 0x51, 0xf4, 0xa2, 0x85, 0x34, 0xa1,
-	0x3e, 0x38, 0x63, 0x21, 0x2f, 0x0e, 0x48, 0xbe, 0x0f, 0xd7, 0x07, 0x67, 0xee, 0xc8, 0x99, 0x43,
-	0xab, 0x41, 0xa5, 0x3d, 0x9d, 0xb8, 0xed, 0x27, 0x62, 0x63, 0xbf, 0x07, 0x55, 0x41, 0x78, 0x8d,
-	0xa2, 0xfd, 0x91, 0x04, 0xd7, 0x4e, 0xe1, 0x9d, 0xbc, 0x3d, 0x3d, 0x3e, 0xd6, 0x78, 0xa8, 0x87,
-	0xa9, 0x55, 0x81, 0xb0, 0x50, 0x72, 0x68, 0xe0, 0xc9, 0xd9, 0x65, 0x0d, 0xfd, 0x43, 0x43, 0x84,
-	0x2e, 0x94, 0x30, 0x38, 0x34, 0x28, 0x3c, 0x32, 0xb6, 0x74, 0x71, 0xb1, 0x71, 0x88, 0xc2, 0x1c,
-	0x5b, 0x1a, 0xbf, 0xdb, 0x3e, 0x16, 0x10, 0x45, 0xb7, 0x1d, 0x8a, 0xfe, 0x6e, 0x12, 0x3e, 0x3c,
-	0x07, 0x32, 0xa8, 0xff, 0x25, 0x01, 0x30, 0xdf, 0x94, 0xf8, 0x96, 0xe8, 0x76, 0x08, 0xab, 0xbc,
-	0xfe, 0x3a, 0xca, 0x58, 0x43, 0xf4, 0x2c, 0x6e, 0x50, 0x3f, 0x4e, 0x19, 0x2f, 0x10, 0x39, 0xfc,
-	0x67, 0xd4, 0xa8, 0xaa, 0x4f, 0xa1, 0x14, 0xa1, 0x12, 0xcf, 0xb5, 0xdb, 0xd7, 0x77, 0xb5, 0x9d,
-	0xa7, 0x5a, 0x67, 0x30, 0x60, 0x80, 0x9d, 0xf0, 0x5c, 0xdb, 0xb2, 0x44, 0x9c, 0x65, 0xe2, 0xbf,
-	0x76, 0xda, 0x72, 0x96, 0xd8, 0xc9, 0x76, 0xa7, 0xd7, 0xa1, 0x0e, 0x70, 0x4e, 0xfd, 0x14, 0xd6,
-	0x4e, 0x45, 0x26, 0xa7, 0xf0, 0xa2, 0xab, 0x90, 0xf7, 0x0f, 0x8d, 0xcd, 0x07, 0x0f, 0x85, 0x63,
-	0xc1, 0x4a, 0xea, 0x7f, 0x4a, 0x20, 0x0f, 0xee, 0xb1, 0xfe, 0x3d, 0xc7, 0x64, 0xb1, 0x0b, 0x35,
-	0x4e, 0xe6, 0x11, 0x0e, 0xe6, 0x8c, 0x13, 0x21, 0x51, 0x23, 0x49, 0x36, 0x8f, 0xc4, 0xb1, 0x11,
-	0x60, 0x6c, 0x95, 0x10, 0x76, 0x8d, 0xe0, 0x10, 0x29, 0xb0, 0x8a, 0xed, 0xa1, 0xeb, 0x58, 0x76,
-	0xc0, 0x77, 0x2e, 0x2c, 0x13, 0x7f, 0xc2, 0x30, 0x4d, 0xec, 0xfb, 0xfa, 0x11, 0x16, 0x89, 0xd4,
-	0x22, 0xa3, 0x6c, 0xe3, 0x13, 0x52, 0xed, 0x63, 0xd3, 0xc3, 0x01, 0xad, 0x66, 0xa1, 0x66, 0x91,
-	0x51, 0x48, 0x35, 0x09, 0x6e, 0xb0, 0x4f, 0xf4, 0x8d, 0xa7, 0x9c, 0x18, 0x0e, 0x5a, 0xe6, 0x44,
-	0x96, 0x76, 0xfa, 0x55, 0x56, 0xac, 0x07, 0xb1, 0x79, 0x8e, 0x87, 0x97, 0xde, 0xe8, 0x56, 0x08,
-	0x81, 0x30, 0x27, 0xf3, 0xce, 0x12, 0x3b, 0x2d, 0x80, 0x0f, 0x32, 0x15, 0xf6, 0x8b, 0xfa, 0xd3,
-	0x6c, 0x1d, 0x8a, 0x8c, 0xd2, 0xb6, 0xbc, 0x24, 0x24, 0x32, 0x97, 0x16, 0x89, 0x5c, 0x49, 0x42,
-	0x22, 0x59, 0xe2, 0xd8, 0xb0, 0x6d, 0x3c, 0x9e, 0x25, 0x8e, 0x69, 0x91, 0x39, 0x37, 0x14, 0x17,
-	0xa6, 0x0b, 0x46, 0x9d, 0x1b, 0x5a, 0x24, 0x5a, 0x61, 0x58, 0xde, 0xc8, 0x70, 0x29, 0x8e, 0xb4,
-	0xaa, 0xf1, 0x12, 0xd2, 0x01, 0xf9, 0xf7, 0x74, 0x3e, 0xfe, 0x31, 0x57, 0x0b, 0x0e, 0x08, 0x2d,
-	0xc6, 0xcd, 0xe7, 0xf5, 0x49, 0x93, 0xfd, 0x79, 0x0d, 0x9b, 0xa9, 0x23, 0xc4, 0xd4, 0xf1, 0xcf,
-	0x24, 0x90, 0x85, 0x32, 0x93, 0xa0, 0x6f, 0xe9, 0xbd, 0xeb, 0x40, 0x81, 0x0d, 0x5b, 0xc0, 0x06,
-	0x4b, 0x6d, 0x9e, 0xe8, 0xab, 0xde, 0x80, 0xcb, 0x2d, 0x0a, 0x98, 0x0a, 0x4d, 0x12, 0xa8, 0x78,
-	0x51, 0x06, 0xf5, 0x67, 0xb0, 0x1e, 0xaf, 0xe4, 0x06, 0x68, 0xa6, 0x37, 0xd2, 0xb9, 0xf5, 0x46,
-	0x5d, 0x07, 0xd4, 0xb3, 0xfc, 0x80, 0xd5, 0x84, 0x37, 0xf8, 0xcf, 0x89, 0xfb, 0x1b, 0xa1, 0x72,
-	0x89, 0x17, 0x34, 0xdb, 0x1f, 0xc3, 0xda, 0xe0, 0xd0, 0xf9, 0x3a, 0x36, 0xd7, 0x8b, 0x99, 0xcd,
-	0xbf, 0x4b, 0x80, 0xa2, 0xac, 0x13, 0x6e, 0x95, 0x22, 0xbb, 0x55, 0x2e, 0xe6, 0xd4, 0xbd, 0x15,
-	0x64, 0x47, 0xfd, 0x12, 0xd6, 0x99, 0x3a, 0xc6, 0x77, 0x25, 0xba, 0xfc, 0xd2, 0x1b, 0x2c, 0xff,
-	0x0b, 0xb8, 0x32, 0xc7, 0x9e, 0x2f, 0x53, 0x17, 0xf2, 0x43, 0x5a, 0xc1, 0xb7, 0xe0, 0xa3, 0xd4,
-	0x13, 0x11, 0x67, 0x47, 0xe3, 0x0c, 0xd4, 0x21, 0xac, 0x73, 0x73, 0x18, 0xdf, 0xe5, 0x1e, 0x09,
-	0x1f, 0x29, 0x9d, 0xcb, 0x48, 0xbb, 0x58, 0x11, 0xe3, 0xaa, 0x09, 0x16, 0x2a, 0x86, 0x2b, 0x73,
-	0x52, 0xf8, 0x4c, 0x2e, 0x56, 0xcc, 0x15, 0xb8, 0x3c, 0xbb, 0x3a, 0x67, 0x81, 0xed, 0xff, 0x49,
-	0xb0, 0x1e, 0xa7, 0x73, 0xe9, 0x3f, 0x87, 0x82, 0xe3, 0xb2, 0x47, 0x1b, 0x69, 0x73, 0x8c, 0x49,
-	0x7c, 0x66, 0x68, 0x16, 0x05, 0xb3, 0xf2, 0x8e, 0x4b, 0x61, 0xac, 0xeb, 0xb0, 0xca, 0xdd, 0x2b,
-	0x76, 0x0a, 0x8b, 0x5a, 0x81, 0xf9, 0x57, 0xbe, 0xfa, 0x07, 0x50, 0x89, 0xf5, 0x21, 0xb7, 0x38,
-	0xc3, 0x78, 0xe4, 0x4b, 0xe4, 0x37, 0xbd, 0xc5, 0x3b, 0xb2, 0x14, 0x85, 0xbf, 0xb2, 0x24, 0x4a,
-	0xea, 0x75, 0x07, 0x7b, 0x72, 0x8e, 0xfc, 0x1a, 0x6c, 0xed, 0x3c, 0x97, 0x57, 0x68, 0xc7, 0x66,
-	0xbf, 0xd5, 0xe9, 0xc9, 0xf9, 0x30, 0x77, 0x57, 0x20, 0x0b, 0xd1, 0x32, 0x6c, 0x13, 0x8f, 0x63,
-	0x9b, 0x4a, 0x22, 0xff, 0x38, 0x99, 0xe7, 0xed, 0x6e, 0x43, 0x95, 0x27, 0x08, 0x23, 0xf1, 0x73,
-	0xf2, 0x23, 0x32, 0xf5, 0x25, 0xd4, 0xc2, 0xb6, 0x7c, 0x19, 0x3f, 0x04, 0xd9, 0xf5, 0xf0, 0xb1,
-	0xe5, 0x4c, 0x7d, 0x3d, 0xde, 0xab, 0x26, 0xe8, 0x02, 0xdb, 0x78, 0x17, 0xca, 0x36, 0x7e, 0x39,
-	0x4b, 0x58, 0x32, 0x1f, 0xa1, 0x44, 0x68, 0xa2, 0x49, 0xc4, 0x2b, 0xcd, 0xc6, 0xbc, 0xd2, 0x9b,
-	0xf0, 0x4e, 0x8b, 0x25, 0x36, 0xb9, 0xb7, 0x27, 0x7c, 0x4d, 0x31, 0xbd, 0xf7, 0xa0, 0x36, 0x57,
-	0x43, 0x0c, 0xca, 0x2f, 0x7c, 0x3e, 0x9c, 0xb2, 0x46, 0x7f, 0x93, 0x78, 0xa8, 0xf9, 0x51, 0x62,
-	0x2e, 0xfa, 0x9f, 0x72, 0x70, 0xed, 0x54, 0x15, 0x9f, 0xa4, 0x03, 0xf4, 0x06, 0x36, 0xc6, 0x63,
-	0x3d, 0x86, 0xdf, 0x6e, 0x2d, 0x76, 0xcd, 0x93, 0x39, 0x36, 0x9e, 0x59, 0x23, 0xa6, 0x01, 0x02,
-	0xdd, 0xe2, 0xfc, 0x39, 0x2e, 0xf0, 0x0a, 0xe4, 0x38, 0x68, 0x86, 0x85, 0x31, 0xdf, 0x39, 0xb7,
-	0x48, 0x1e, 0xe6, 0xcd, 0x4b, 0xae, 0xc5, 0x70, 0x35, 0xec, 0x2b, 0xbf, 0x96, 0xc2, 0xe4, 0xfe,
-	0x5c, 0xdb, 0x34, 0x49, 0xcc, 0xaf, 0xe6, 0x32, 0x25, 0x17, 0xb7, 0x44, 0x02, 0xf7, 0x56, 0x60,
-	0x35, 0x84, 0xa8, 0x89, 0x92, 0xac, 0x68, 0x61, 0x99, 0x6c, 0xb9, 0x65, 0x1f, 0x38, 0xdc, 0x6d,
-	0xa2, 0xbf, 0xd5, 0x2e, 0xd4, 0xe6, 0xe7, 0x11, 0x4b, 0x82, 0xcf, 0x39, 0xd9, 0x12, 0x45, 0x1c,
-	0xb8, 0x93, 0x2d, 0x67, 0xa2, 0x3e, 0xf6, 0xe6, 0x9f, 0xdc, 0x01, 0x98, 0x65, 0x41, 0x91, 0x15,
-	0xe6, 0xb1, 0x1a, 0x29, 0x71, 0x70, 0xae, 0x6c, 0xca, 0x46, 0xea, 0xf6, 0x5c, 0x03, 0xa7, 0x80,
-	0x18, 0xa5, 0x6d, 0x04, 0x46, 0xf8, 0xd0, 0xe0, 0xad, 0x8b, 0x9d, 0x88, 0xf9, 0x0e, 0x9c, 0x09,
-	0x7e, 0xfb, 0xe2, 0x02, 0x91, 0xe0, 0xe6, 0xfb, 0x94, 0x36, 0x13, 0x16, 0x3b, 0xc9, 0xca, 0xef,
-	0x2e, 0x93, 0x94, 0xb8, 0x2b, 0xa1, 0x5f, 0x40, 0x61, 0xd7, 0xc3, 0x63, 0xc7, 0x18, 0xfe, 0x56,
-	0x16, 0x94, 0xbd, 0xe4, 0x49, 0xb9, 0xa0, 0xb1, 0xf7, 0x46, 0x29, 0xc4, 0xc5, 0x9f, 0x09, 0xa1,
-	0xaf, 0xf9, 0xc3, 0x9e, 0x3e, 0x45, 0x4c, 0x7f, 0x8b, 0x8a, 0x33, 0x85, 0x4a, 0xec, 0x99, 0x1f,
-	0x7a, 0xb0, 0xf8, 0xcd, 0x63, 0xc2, 0xb3, 0x40, 0x65, 0xb9, 0x87, 0x5c, 0xe8, 0x6f, 0x24, 0xb8,
-	0x9c, 0xf0, 0x76, 0x0e, 0x7d, 0x9a, 0x32, 0x25, 0x90, 0xf4, 0xb2, 0x4f, 0xf9, 0xc1, 0xf9, 0x3a,
-	0xf3, 0x95, 0x30, 0x21, 0xb7, 0x6b, 0xd9, 0x23, 0xb4, 0x58, 0x2b, 0x23, 0x8f, 0xc2, 0x52, 0xcc,
-	0x3b, 0xfa, 0x66, 0x8c, 0x58, 0x22, 0x7e, 0x64, 0x52, 0xa4, 0x55, 0x62, 0x87, 0x65, 0x23, 0x75,
-	0x7b, 0x2e, 0xea, 0x4f, 0x25, 0xa8, 0xcd, 0x25, 0x47, 0xd0, 0xc7, 0x4b, 0xa6, 0x40, 0x42, 0xe9,
-	0x8f, 0x96, 0xef, 0xc8, 0x87, 0xf1, 0x2d, 0x54, 0x62, 0xf9, 0x8a, 0x14, 0x0a, 0x96, 0x94, 0xf8,
-	0x50, 0x1e, 0x2e, 0xdb, 0x8d, 0xcb, 0xff, 0x06, 0xca, 0xd1, 0xa4, 0x41, 0x0a, 0x53, 0x95, 0x90,
-	0xaa, 0x50, 0x1e, 0x2c, 0xd9, 0x6b, 0xa6, 0x53, 0x83, 0xc0, 0x71, 0x53, 0xe8, 0x54, 0xe4, 0x91,
-	0x60, 0x0a, 0x9d, 0x8a, 0xbe, 0x21, 0x44, 0x63, 0x80, 0xd9, 0x4b, 0x3e, 0xb4, 0xd8, 0x37, 0x3f,
-	0xf5, 0xec, 0x4f, 0xf9, 0x60, 0xf1, 0xec, 0x18, 0x30, 0x7f, 0x57, 0x42, 0x53, 0x80, 0x19, 0xb4,
-	0x9d, 0x42, 0xda, 0x29, 0xe0, 0x5f, 0xb9, 0xb7, 0x54, 0x1f, 0x3e, 0xc9, 0xbf, 0x96, 0xa2, 0x19,
-	0x01, 0x01, 0xa9, 0xa3, 0x4f, 0x96, 0xe0, 0x35, 0x87, 0xe2, 0x2b, 0x9f, 0x9e, 0xab, 0x2f, 0x1b,
-	0xcf, 0x5d, 0x89, 0x28, 0x56, 0xf4, 0x8d, 0x66, 0x0a, 0xc5, 0x4a, 0x78, 0xe9, 0x99, 0x42, 0xb1,
-	0x92, 0x1e, 0x82, 0x92, 0x53, 0x15, 0x7b, 0xf0, 0x89, 0xd2, 0xf0, 0x39, 0xfd, 0x70, 0x34, 0xc5,
-	0xa9, 0x4a, 0x7c, 0x57, 0x4a, 0xe4, 0xc7, 0x9c, 0xc1, 0x14, 0xf2, 0x93, 0x9c, 0xf9, 0x14, 0xf2,
-	0x93, 0x1d, 0x7d, 0x62, 0xdc, 0xe6, 0xb2, 0x22, 0x29, 0x8c, 0x5b, 0x72, 0x86, 0x25, 0x85, 0x71,
-	0x3b, 0x2b, 0x01, 0xf3, 0x97, 0x12, 0xac, 0x9d, 0x4a, 0xa6, 0xa0, 0xc5, 0x0f, 0xba, 0xce, 0x4a,
-	0xce, 0x28, 0x9f, 0x9c, 0xa7, 0x2b, 0x1f, 0x0c, 0xb9, 0x53, 0x13, 0x92, 0x1c, 0x29, 0xee, 0xd4,
-	0xb3, 0xb3, 0x32, 0x29, 0xee, 0xd4, 0xd7, 0xe5, 0x55, 0xc8, 0xfa, 0x0c, 0xce, 0xb1, 0x3e, 0x83,
-	0xf3, 0xaf, 0xcf, 0xd9, 0x99, 0x94, 0x23, 0xc8, 0xb3, 0xfc, 0x48, 0x1a, 0xaf, 0x2a, 0x9a, 0x59,
-	0x49, 0xe3, 0x55, 0xc5, 0x12, 0x2f, 0x77, 0x25, 0xaa, 0xa0, 0x73, 0x69, 0x96, 0x14, 0x0a, 0x9a,
-	0xfc, 0x10, 0x3d, 0x85, 0x82, 0x9e, 0x95, 0xd1, 0xf9, 0x06, 0xca, 0x51, 0xb4, 0x33, 0x85, 0x91,
-	0x4a, 0x40, 0x4e, 0x53, 0x18, 0xa9, 0x44, 0x48, 0xf5, 0x25, 0x94, 0x22, 0xb8, 0x27, 0xba, 0x97,
-	0xe2, 0x0e, 0x9d, 0xc7, 0x4e, 0x95, 0xfb, 0xcb, 0x75, 0x0a, 0xbd, 0x5a, 0x98, 0x01, 0x97, 0x69,
-	0xae, 0xc4, 0x79, 0x00, 0x35, 0xc5, 0x25, 0x95, 0x80, 0x8c, 0x7e, 0x0b, 0x95, 0x18, 0x16, 0x98,
-	0xc2, 0x2a, 0x26, 0x41, 0x93, 0xca, 0xc3, 0x65, 0xbb, 0xcd, 0xe4, 0xc7, 0x10, 0xbc, 0x14, 0xf2,
-	0x93, 0x70, 0xc5, 0x14, 0xf2, 0x93, 0x81, 0xc2, 0x6f, 0xa0, 0x1c, 0x85, 0xde, 0x52, 0x68, 0x5b,
-	0x02, 0x12, 0x98, 0x42, 0xdb, 0x12, 0x71, 0x42, 0xa2, 0xea, 0x11, 0xdc, 0x2c, 0x8d, 0xaa, 0x9f,
-	0x46, 0xdf, 0xd2, 0xa8, 0x7a, 0x02, 0x38, 0x87, 0xc6, 0x50, 0xe0, 0x17, 0x15, 0xda, 0x48, 0x7b,
-	0xa5, 0x09, 0x91, 0x77, 0xd3, 0x77, 0x98, 0x1d, 0xac, 0xc8, 0xcb, 0x7e, 0x94, 0xe6, 0x75, 0xc8,
-	0xfc, 0x37, 0x02, 0x29, 0x0e, 0x56, 0xc2, 0xc7, 0x03, 0xe8, 0xaf, 0x88, 0x1b, 0x76, 0xea, 0x93,
-	0xb2, 0x34, 0x6e, 0xd8, 0x59, 0x9f, 0xb1, 0xa5, 0x71, 0xc3, 0xce, 0xfe, 0x86, 0xcd, 0x81, 0x55,
-	0x91, 0x4a, 0x46, 0x77, 0x53, 0x86, 0xa0, 0xe1, 0xe7, 0x3a, 0xca, 0x47, 0x4b, 0xf4, 0x98, 0xdd,
-	0x68, 0x57, 0x93, 0x01, 0x4e, 0xf4, 0x7b, 0x8b, 0x55, 0xe7, 0x75, 0xc8, 0x68, 0x0a, 0x3d, 0x98,
-	0x97, 0xf8, 0xe7, 0x12, 0xd4, 0xe6, 0x50, 0xb9, 0x14, 0x97, 0x4c, 0x32, 0xae, 0x9a, 0xe2, 0x92,
-	0x39, 0x03, 0x00, 0xbc, 0x2b, 0x11, 0xc3, 0x13, 0xfb, 0x10, 0x39, 0x0d, 0x8a, 0x90, 0xf0, 0x41,
-	0x73, 0x0a, 0xc3, 0x93, 0xfc, 0xbd, 0xf3, 0x3f, 0x4a, 0x50, 0x3f, 0xeb, 0x73, 0x63, 0xf4, 0xa3,
-	0x14, 0x5f, 0x3f, 0xbf, 0xf6, 0x2b, 0x67, 0xa5, 0xf9, 0x06, 0x1c, 0xf8, 0x08, 0x0f, 0x60, 0x85,
-	0x7d, 0xe1, 0xba, 0x38, 0xb8, 0x8b, 0x7e, 0xac, 0xab, 0x34, 0xd2, 0x36, 0xe7, 0x72, 0xfe, 0x42,
-	0x02, 0x79, 0xfe, 0xab, 0x73, 0xf4, 0x28, 0x8d, 0x57, 0x99, 0xf4, 0x7d, 0xbb, 0xf2, 0xf8, 0x1c,
-	0x3d, 0x85, 0x56, 0x3c, 0x79, 0xf8, 0xd3, 0xfb, 0x23, 0x2b, 0x38, 0x9c, 0xbe, 0x68, 0x98, 0xce,
-	0x64, 0x83, 0x30, 0x0a, 0x3f, 0xb0, 0xdf, 0x78, 0xcd, 0x9f, 0x04, 0xbc, 0xc8, 0xd3, 0xf4, 0xf0,
-	0xbd, 0xdf, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x46, 0xd6, 0xb5, 0x4a, 0x40, 0x00, 0x00,
+	proto.RegisterFile("api/interservice/deployment/automate_deployment.proto", fileDescriptor_automate_deployment_875469570e26b0e7)
+}
+
+var fileDescriptor_automate_deployment_875469570e26b0e7 = []byte{
+	// 4546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x59, 0x73, 0x1b, 0x57,
+	0x76, 0xb0, 0x1a, 0x00, 0x09, 0xe0, 0x60, 0x6b, 0x5e, 0x92, 0x12, 0xdc, 0x1e, 0x97, 0xec, 0x9e,
+	0x99, 0xcf, 0xdb, 0x67, 0x52, 0xa6, 0x44, 0x5a, 0xb2, 0x3c, 0xa9, 0x81, 0x00, 0x98, 0x84, 0x08,
+	0x81, 0x9c, 0x06, 0x69, 0xcd, 0xe6, 0xc0, 0xcd, 0xc6, 0x25, 0xd8, 0x43, 0xa0, 0xbb, 0xa7, 0xbb,
+	0x41, 0x8b, 0x4a, 0x95, 0x93, 0xca, 0x56, 0x95, 0x54, 0xa5, 0x92, 0x97, 0xe4, 0x21, 0xa9, 0xca,
+	0x52, 0x95, 0xfc, 0x81, 0xbc, 0x25, 0x35, 0x6f, 0xc9, 0x43, 0x5e, 0xa6, 0x92, 0xe7, 0x24, 0x8f,
+	0xf9, 0x01, 0xf9, 0x09, 0xa9, 0xbb, 0xf5, 0x02, 0x40, 0x42, 0x83, 0xa2, 0xfc, 0xc4, 0xbe, 0xe7,
+	0x9e, 0xe5, 0x2e, 0xe7, 0x9e, 0x7b, 0x96, 0x4b, 0xc0, 0xb6, 0xee, 0x98, 0x9b, 0xa6, 0xe5, 0x63,
+	0xd7, 0xc3, 0xee, 0x85, 0x69, 0xe0, 0xcd, 0x3e, 0x76, 0x86, 0xf6, 0xe5, 0x08, 0x5b, 0xfe, 0xa6,
+	0x3e, 0xf6, 0xed, 0x91, 0xee, 0xe3, 0x5e, 0x08, 0xdb, 0x70, 0x5c, 0xdb, 0xb7, 0xd1, 0x6d, 0xe3,
+	0x0c, 0x9f, 0x6e, 0x88, 0xfe, 0x8d, 0xbe, 0x3d, 0xd2, 0x4d, 0x6b, 0x23, 0x44, 0x53, 0x6e, 0x0f,
+	0x6c, 0x7b, 0x30, 0xc4, 0x9b, 0x14, 0xfd, 0x64, 0x7c, 0xba, 0xe9, 0x9b, 0x23, 0xec, 0xf9, 0xfa,
+	0xc8, 0x61, 0x1c, 0x94, 0x0f, 0x88, 0x60, 0xc3, 0xb6, 0x4e, 0xcd, 0x41, 0x54, 0x24, 0x83, 0xf4,
+	0x5c, 0xfc, 0xcb, 0x31, 0xf6, 0xb8, 0x34, 0xe5, 0xc3, 0xd9, 0xb8, 0xc1, 0xf0, 0x58, 0x17, 0x43,
+	0x56, 0xb7, 0xe1, 0xd6, 0x2e, 0xf6, 0xeb, 0xed, 0x56, 0xf3, 0x19, 0x36, 0xc6, 0xbe, 0x7e, 0x32,
+	0xc4, 0x1a, 0xe3, 0x86, 0x14, 0xc8, 0x39, 0x43, 0xdd, 0x3f, 0xb5, 0xdd, 0x51, 0x55, 0x7a, 0x5b,
+	0x7a, 0x2f, 0xaf, 0x05, 0x6d, 0x75, 0x0f, 0xaa, 0xd3, 0x64, 0x9e, 0x63, 0x5b, 0x1e, 0x46, 0x55,
+	0xc8, 0x5e, 0x60, 0xd7, 0x33, 0x6d, 0x8b, 0x93, 0x89, 0x26, 0x42, 0x90, 0xe9, 0xeb, 0xbe, 0x5e,
+	0x4d, 0xbd, 0x2d, 0xbd, 0x57, 0xd4, 0xe8, 0xb7, 0x7a, 0x13, 0xd6, 0x3a, 0x76, 0x1f, 0xb7, 0xac,
+	0x0b, 0x6c, 0xf9, 0xb6, 0x7b, 0xc9, 0xa5, 0xab, 0x5f, 0xc2, 0xfa, 0x04, 0x9c, 0xb3, 0x6f, 0xc0,
+	0x92, 0x65, 0xf7, 0xb1, 0x57, 0x95, 0xde, 0x4e, 0xbf, 0x57, 0xd8, 0xda, 0xd8, 0x98, 0xb3, 0xb8,
+	0x1b, 0x01, 0x0b, 0xc2, 0x4f, 0x63, 0xc4, 0xea, 0xa7, 0x70, 0xbb, 0x65, 0x9d, 0xba, 0xba, 0xe7,
+	0xbb, 0x63, 0xc3, 0x1f, 0xbb, 0x98, 0x74, 0x36, 0xf0, 0x10, 0xfb, 0xc1, 0xfc, 0x6f, 0x41, 0x96,
+	0xe0, 0xf6, 0xcc, 0x3e, 0x9f, 0xc7, 0x32, 0x69, 0xb6, 0xfa, 0xaa, 0x0a, 0x6f, 0xbf, 0x98, 0x96,
+	0x8d, 0x52, 0xfd, 0x9f, 0x14, 0x94, 0x62, 0x82, 0xc9, 0xe4, 0x2d, 0x7d, 0x84, 0x39, 0x2f, 0xfa,
+	0x8d, 0x54, 0x28, 0xda, 0xee, 0x40, 0xb7, 0xcc, 0xe7, 0xba, 0x4f, 0xd6, 0x2b, 0x45, 0xfb, 0x62,
+	0x30, 0x74, 0x13, 0x96, 0x3d, 0x5f, 0xf7, 0xc7, 0x5e, 0x35, 0xcd, 0x46, 0xc1, 0x5a, 0xe8, 0x5d,
+	0xa8, 0x88, 0xed, 0xe8, 0x9d, 0xea, 0x23, 0x73, 0x78, 0x59, 0xcd, 0x50, 0x84, 0xb2, 0x00, 0x7f,
+	0x4e, 0xa1, 0xb1, 0x7d, 0x5c, 0x8a, 0xef, 0x23, 0x7a, 0x1f, 0xe4, 0x80, 0x89, 0xd8, 0xb4, 0x65,
+	0x8a, 0x13, 0x30, 0xff, 0x82, 0x6f, 0x5e, 0x15, 0xb2, 0xc6, 0x19, 0x36, 0xce, 0x4d, 0xab, 0x9a,
+	0x65, 0xdb, 0xca, 0x9b, 0xe8, 0xfb, 0x50, 0x36, 0x86, 0x26, 0xb6, 0xfc, 0x80, 0x45, 0x8e, 0x22,
+	0x94, 0x18, 0x54, 0x30, 0xf8, 0x7f, 0x50, 0xc1, 0xc6, 0x56, 0xcf, 0xb4, 0x3c, 0x5f, 0xb7, 0x0c,
+	0xba, 0xae, 0x79, 0x86, 0x87, 0x8d, 0xad, 0x16, 0x87, 0xb6, 0xfa, 0xe8, 0x03, 0x58, 0x89, 0xe1,
+	0xf9, 0x97, 0x0e, 0xae, 0x02, 0x1b, 0x54, 0x04, 0xf3, 0xe8, 0xd2, 0xc1, 0x6a, 0x0b, 0x8a, 0xc7,
+	0x9e, 0x3e, 0x08, 0xf6, 0xec, 0x01, 0x80, 0xe7, 0xeb, 0xae, 0xdf, 0xf3, 0x4d, 0xbe, 0xd4, 0x85,
+	0x2d, 0x65, 0x83, 0x9d, 0xae, 0x0d, 0x71, 0xba, 0x36, 0x8e, 0xc4, 0xe9, 0xd2, 0xf2, 0x14, 0x9b,
+	0xb4, 0xd5, 0x1f, 0x41, 0x89, 0xb3, 0xe2, 0x8a, 0xf6, 0xc3, 0xb8, 0xa2, 0x7d, 0x30, 0x57, 0xd1,
+	0xc8, 0x36, 0x33, 0x16, 0x5c, 0xc9, 0xfe, 0x32, 0x05, 0xf9, 0x00, 0x88, 0xca, 0x90, 0x0a, 0x54,
+	0x29, 0x65, 0xf6, 0xd1, 0x9b, 0x90, 0x1f, 0xea, 0x9e, 0xdf, 0xf3, 0x30, 0x16, 0x3b, 0x9f, 0x23,
+	0x80, 0x2e, 0xc6, 0x16, 0x59, 0x04, 0xda, 0x69, 0x18, 0x6e, 0xcf, 0xc5, 0x06, 0x36, 0x2f, 0x70,
+	0x9f, 0x2b, 0x40, 0x85, 0x74, 0xd4, 0x0d, 0x57, 0xe3, 0x60, 0xf4, 0x0e, 0x14, 0xf9, 0x56, 0xb0,
+	0xb5, 0x62, 0x6a, 0x50, 0xe0, 0x30, 0xb2, 0x4e, 0xe8, 0x08, 0x72, 0x23, 0xec, 0xeb, 0xf4, 0xf4,
+	0x2d, 0xd1, 0xe9, 0xdc, 0x4f, 0x3e, 0x9d, 0x8d, 0x27, 0x9c, 0xb4, 0x69, 0xf9, 0xee, 0xa5, 0x16,
+	0x70, 0x52, 0x1e, 0x42, 0x29, 0xd6, 0x85, 0x64, 0x48, 0x9f, 0xe3, 0x4b, 0x3e, 0x47, 0xf2, 0x89,
+	0xd6, 0x60, 0xe9, 0x42, 0x1f, 0x8e, 0x31, 0x9f, 0x20, 0x6b, 0x7c, 0x9a, 0xba, 0x2f, 0xa9, 0x3f,
+	0x80, 0x37, 0x76, 0xb1, 0x85, 0x5d, 0xdd, 0xc7, 0xb5, 0xfe, 0xc8, 0xb4, 0x8e, 0xec, 0x73, 0x6c,
+	0x89, 0x7d, 0x7c, 0x1b, 0x0a, 0x7d, 0xec, 0x19, 0xae, 0xe9, 0xf8, 0xa1, 0x1d, 0x89, 0x82, 0xd4,
+	0x07, 0xa0, 0xcc, 0x22, 0xe7, 0x7b, 0xf7, 0x26, 0xe4, 0x75, 0xc7, 0xec, 0xf9, 0x04, 0x28, 0x8c,
+	0x97, 0xee, 0x98, 0x14, 0x49, 0xfd, 0x27, 0x09, 0xd6, 0x3a, 0xf8, 0xeb, 0x46, 0x30, 0x55, 0x21,
+	0x75, 0x17, 0x96, 0x99, 0x71, 0xe4, 0x9a, 0xb3, 0x39, 0x77, 0x8d, 0x6a, 0xbc, 0xab, 0x4e, 0xc9,
+	0x34, 0x4e, 0x8e, 0x7e, 0x0c, 0x15, 0x47, 0x37, 0xce, 0xf5, 0x01, 0xee, 0xd9, 0x74, 0xb8, 0x5e,
+	0x35, 0x95, 0x90, 0xe3, 0x21, 0xa3, 0x3b, 0x60, 0x64, 0x5a, 0xd9, 0x89, 0xb5, 0x55, 0x0c, 0x0a,
+	0x93, 0x35, 0x76, 0xf1, 0xeb, 0x9b, 0x80, 0xfa, 0xcf, 0x12, 0xbc, 0x39, 0x53, 0x0e, 0x5f, 0x5f,
+	0x0d, 0x4a, 0x21, 0x13, 0x61, 0x21, 0x0b, 0x5b, 0x1f, 0xcd, 0x95, 0x17, 0xf2, 0x6a, 0x35, 0xb4,
+	0x62, 0xd8, 0xd1, 0xea, 0x47, 0x06, 0x9f, 0x7a, 0xb5, 0xc1, 0x7f, 0x08, 0x25, 0x26, 0x26, 0x72,
+	0x93, 0xf1, 0xfb, 0x9a, 0x1d, 0xe6, 0xbc, 0x16, 0xb4, 0xd5, 0xf7, 0xa1, 0x2c, 0x90, 0xf9, 0xdc,
+	0x6e, 0x41, 0xd6, 0xd7, 0xbd, 0xf3, 0x88, 0xdd, 0x27, 0xcd, 0x56, 0x5f, 0xfd, 0x5d, 0x09, 0x56,
+	0x19, 0x6e, 0x97, 0x9a, 0x60, 0xc1, 0xfe, 0x75, 0x2c, 0x46, 0x64, 0x10, 0xa9, 0xd8, 0x20, 0x3e,
+	0x84, 0x92, 0x86, 0x47, 0xf6, 0x05, 0x4e, 0x32, 0xb9, 0x2a, 0xdc, 0x7c, 0xa2, 0x5b, 0xe6, 0x29,
+	0xf6, 0x84, 0x15, 0x16, 0xd7, 0x6b, 0x09, 0x0a, 0x87, 0xa6, 0x35, 0x10, 0xcd, 0x32, 0x14, 0x59,
+	0x93, 0x5f, 0x5f, 0x2b, 0x50, 0x61, 0x83, 0x6b, 0x35, 0x04, 0x8a, 0x0c, 0x65, 0x21, 0x98, 0x23,
+	0x95, 0xa0, 0xd0, 0xf5, 0x6d, 0x27, 0xc2, 0x83, 0x35, 0x79, 0xf7, 0x3a, 0xac, 0x92, 0x76, 0xdd,
+	0xb6, 0x2e, 0xb0, 0x1b, 0x98, 0x68, 0x72, 0xe1, 0xc7, 0xc1, 0x1c, 0x9d, 0xc2, 0x75, 0xd7, 0x9f,
+	0xc4, 0xbf, 0x05, 0xeb, 0x13, 0x70, 0x4e, 0x50, 0x85, 0x9b, 0x5d, 0x36, 0x51, 0x3e, 0x37, 0xb1,
+	0x21, 0xea, 0x2a, 0xac, 0x74, 0x2f, 0x3d, 0x1f, 0x8f, 0xda, 0xf6, 0xc0, 0x8b, 0xc8, 0x3d, 0x76,
+	0x06, 0xae, 0xde, 0xc7, 0xb1, 0xdd, 0x53, 0xff, 0x22, 0x0d, 0xeb, 0x13, 0x1d, 0x5c, 0x11, 0x7e,
+	0x02, 0x4b, 0xe4, 0xae, 0x65, 0xf7, 0x48, 0x79, 0xab, 0x3e, 0x77, 0x3f, 0x67, 0xb2, 0x89, 0x42,
+	0xb1, 0xc6, 0x38, 0xa2, 0xaf, 0x00, 0xb9, 0x98, 0x90, 0x9b, 0xd6, 0xa0, 0x17, 0x6c, 0x5f, 0x86,
+	0x5a, 0xe6, 0x8f, 0x13, 0xca, 0x31, 0xad, 0x01, 0x9f, 0xbf, 0xb6, 0x12, 0x30, 0xe3, 0x10, 0x0f,
+	0xdd, 0x87, 0xea, 0x50, 0xf7, 0xb1, 0xe7, 0xf7, 0xf4, 0x0b, 0xdd, 0x1c, 0x12, 0x0f, 0x2d, 0xb8,
+	0x9e, 0xd9, 0x3d, 0x72, 0x93, 0xf5, 0xd7, 0x44, 0xb7, 0xb8, 0xa7, 0xdf, 0x85, 0x4a, 0x1f, 0x7b,
+	0xa6, 0x8b, 0xfb, 0x01, 0x01, 0x73, 0x1b, 0xca, 0x1c, 0x1c, 0x41, 0x34, 0xc6, 0xae, 0x1b, 0xbd,
+	0xf8, 0x99, 0xef, 0x50, 0xe6, 0x60, 0x8e, 0xa8, 0xde, 0x83, 0x62, 0x74, 0x11, 0x50, 0x01, 0xb2,
+	0xc7, 0x9d, 0xfd, 0xce, 0xc1, 0xd3, 0x8e, 0x7c, 0x03, 0x95, 0x20, 0x7f, 0x7c, 0xb8, 0xab, 0xd5,
+	0x1a, 0xad, 0xce, 0xae, 0x2c, 0xa1, 0x1c, 0x64, 0x5a, 0x8d, 0x76, 0x53, 0x4e, 0xa9, 0x0a, 0xa0,
+	0x2e, 0xf6, 0xdb, 0xf6, 0xa0, 0x8d, 0x2f, 0xf0, 0x90, 0x6f, 0xd7, 0xe3, 0x4c, 0x4e, 0x92, 0x53,
+	0x54, 0xb7, 0xa2, 0x7d, 0x5c, 0x25, 0xfe, 0x5e, 0x02, 0x79, 0x72, 0x71, 0x88, 0x5d, 0xf1, 0x75,
+	0x77, 0x80, 0xfd, 0xc4, 0x46, 0x31, 0xae, 0x56, 0x1a, 0x27, 0x27, 0x8c, 0x74, 0xc3, 0x1f, 0xeb,
+	0xc3, 0x6a, 0xea, 0x8a, 0x8c, 0x18, 0xb9, 0x7a, 0x02, 0xe5, 0xb8, 0x99, 0x27, 0x4b, 0x69, 0x5f,
+	0x60, 0xd7, 0x35, 0xfb, 0xb8, 0x67, 0xbb, 0xe6, 0xc0, 0x14, 0xb7, 0x56, 0x59, 0x80, 0x0f, 0x28,
+	0x94, 0x20, 0x9e, 0xe9, 0xae, 0x6f, 0x9e, 0xea, 0x86, 0xef, 0xf5, 0x1c, 0xdd, 0x3f, 0xe3, 0xf6,
+	0xa1, 0x1c, 0x82, 0x0f, 0x75, 0xff, 0x4c, 0xfd, 0x02, 0xe4, 0xc0, 0x80, 0x0b, 0x53, 0xf1, 0x0e,
+	0x14, 0xb9, 0xae, 0xf5, 0x22, 0xae, 0x68, 0x81, 0xc3, 0x3a, 0xc4, 0x23, 0xbd, 0x0d, 0x05, 0x1e,
+	0x54, 0xf8, 0xf6, 0x68, 0xc8, 0x79, 0x03, 0x03, 0x1d, 0xd9, 0xa3, 0xa1, 0xfa, 0x1c, 0x8a, 0x51,
+	0xb3, 0x35, 0xe5, 0xd5, 0x3c, 0x00, 0x30, 0x5c, 0xac, 0xfb, 0xb8, 0xdf, 0xd3, 0xfd, 0x6a, 0x6a,
+	0xbe, 0x07, 0xc6, 0xb1, 0x6b, 0x3e, 0x91, 0x4d, 0x7d, 0x1e, 0xdd, 0xf0, 0x43, 0x2d, 0x05, 0x02,
+	0xaa, 0x51, 0x88, 0xfa, 0x3d, 0x90, 0x43, 0xd9, 0xec, 0x94, 0x11, 0x97, 0x63, 0xe4, 0x0d, 0x84,
+	0xcb, 0x31, 0xf2, 0x06, 0xea, 0x9f, 0x57, 0xa0, 0xc0, 0xd0, 0x9a, 0xc4, 0xff, 0x66, 0x06, 0xf2,
+	0x97, 0x63, 0x6c, 0x19, 0x6c, 0xc6, 0x19, 0x2d, 0x68, 0xa3, 0xef, 0x4e, 0x9a, 0x6e, 0xee, 0x81,
+	0xc7, 0x6c, 0xf1, 0x06, 0x64, 0xa8, 0x3b, 0x99, 0x9e, 0x3b, 0x19, 0x8a, 0x87, 0x9e, 0xc0, 0x32,
+	0xa3, 0xa7, 0xe7, 0xa6, 0xb0, 0x75, 0x37, 0xe1, 0x45, 0xd0, 0xbc, 0x08, 0xbf, 0xf7, 0x6e, 0x68,
+	0x9c, 0x09, 0x7a, 0x0c, 0x4b, 0xce, 0x99, 0xee, 0x61, 0x7a, 0xb8, 0x0a, 0x5b, 0x5b, 0x0b, 0x71,
+	0x3b, 0x24, 0x94, 0x7b, 0x37, 0x34, 0xc6, 0x02, 0x3d, 0x05, 0xa0, 0x1f, 0x3d, 0xcf, 0xc7, 0x0e,
+	0xf5, 0xe3, 0x0b, 0x5b, 0x3b, 0x8b, 0x33, 0xec, 0xfa, 0xd8, 0xd9, 0xbb, 0xa1, 0xe5, 0x1d, 0xd1,
+	0x40, 0x5f, 0x41, 0x89, 0xde, 0x57, 0x86, 0x3d, 0x72, 0x86, 0xd8, 0xc7, 0x34, 0x04, 0x28, 0x6c,
+	0x3d, 0x58, 0x88, 0xf7, 0x91, 0xee, 0x9d, 0xd7, 0x39, 0x83, 0xbd, 0x1b, 0x5a, 0xd1, 0x8f, 0xb4,
+	0xc9, 0xaa, 0x9e, 0xe8, 0xc6, 0xf9, 0xd8, 0xa9, 0xe6, 0xaf, 0xb0, 0xaa, 0x8f, 0x28, 0x29, 0x59,
+	0x55, 0xc6, 0x44, 0x39, 0x86, 0x65, 0xd6, 0x8f, 0xf6, 0x83, 0x00, 0x8b, 0xd9, 0xf9, 0xc5, 0x18,
+	0x73, 0x63, 0xcf, 0x59, 0x28, 0xff, 0x20, 0xc1, 0x12, 0x5d, 0x22, 0x74, 0x00, 0x39, 0xb6, 0xd4,
+	0xfc, 0x78, 0x94, 0xb7, 0xee, 0x2d, 0xbe, 0xd0, 0xad, 0x86, 0x96, 0xa5, 0x5c, 0x5a, 0xfd, 0xc8,
+	0x38, 0x53, 0xaf, 0x3e, 0xce, 0xff, 0x96, 0x20, 0x1f, 0x6c, 0xe5, 0xf5, 0x8f, 0xf5, 0x4d, 0xc8,
+	0x13, 0x0d, 0x63, 0x66, 0x86, 0xc7, 0x36, 0x04, 0x40, 0x6d, 0xcc, 0x7e, 0x2c, 0xa2, 0x7d, 0xb5,
+	0x89, 0x90, 0x00, 0x03, 0xbb, 0xae, 0xed, 0xf2, 0x3b, 0x8a, 0x35, 0x94, 0xff, 0xcc, 0xc0, 0x32,
+	0xdb, 0xf2, 0x6b, 0xdd, 0x5e, 0xf4, 0x25, 0x80, 0xed, 0x60, 0x57, 0x17, 0x3e, 0x3d, 0xb9, 0xaf,
+	0x7f, 0x70, 0x05, 0x45, 0xdc, 0x38, 0x10, 0x5c, 0xb4, 0x08, 0x43, 0x74, 0x14, 0x0f, 0x7b, 0xd2,
+	0x09, 0x0f, 0x3c, 0xe3, 0xd9, 0x08, 0x29, 0x63, 0xa1, 0x92, 0xf2, 0xeb, 0x14, 0xe4, 0x03, 0x79,
+	0xd7, 0xbb, 0x1e, 0x22, 0xa9, 0x91, 0x8a, 0x24, 0x35, 0x82, 0x1d, 0xc9, 0x44, 0x76, 0x84, 0x58,
+	0x5a, 0xef, 0xd2, 0x32, 0x7a, 0x8e, 0x6b, 0x0f, 0x5c, 0xec, 0x79, 0x74, 0xbf, 0x24, 0xad, 0x48,
+	0x80, 0x87, 0x1c, 0x46, 0x32, 0x09, 0x7a, 0x1c, 0x6b, 0x99, 0x62, 0x95, 0xf4, 0x18, 0xda, 0x17,
+	0x90, 0xa1, 0x81, 0x6e, 0x96, 0x4e, 0xe0, 0xd1, 0x2b, 0xad, 0xff, 0x06, 0x89, 0x8f, 0x35, 0xca,
+	0x4f, 0xbd, 0x0d, 0x19, 0xd2, 0x42, 0x00, 0xcb, 0x8f, 0x6a, 0xf5, 0xfd, 0xe3, 0x43, 0xf9, 0x06,
+	0xf1, 0x55, 0xb4, 0x66, 0xf7, 0xe8, 0x40, 0x6b, 0xca, 0xd2, 0xe3, 0x4c, 0x2e, 0x2d, 0x67, 0x94,
+	0x32, 0x14, 0xa3, 0x96, 0x4a, 0xfd, 0x06, 0x96, 0xf9, 0x65, 0x54, 0x80, 0xec, 0xd3, 0x5a, 0xeb,
+	0x88, 0x78, 0x32, 0x8c, 0xf2, 0xb8, 0xd3, 0x61, 0x6e, 0x4d, 0x05, 0x0a, 0xf5, 0x83, 0x27, 0x87,
+	0xed, 0xe6, 0x51, 0xb3, 0x77, 0xb0, 0x2f, 0xa7, 0xd0, 0x0a, 0x94, 0x02, 0xc0, 0xe7, 0xb5, 0x56,
+	0x5b, 0x4e, 0xa3, 0x2a, 0xac, 0x75, 0x9b, 0xed, 0xcf, 0x7b, 0xcc, 0x1d, 0x6a, 0xf6, 0x0e, 0x9b,
+	0x1d, 0xea, 0x14, 0x65, 0xd0, 0x77, 0xa0, 0x4a, 0x7b, 0xb4, 0x66, 0xfd, 0xa0, 0xf3, 0x79, 0x6b,
+	0xf7, 0x58, 0x0b, 0x7b, 0x97, 0xd4, 0xbf, 0x92, 0x20, 0xcb, 0x4f, 0x20, 0x92, 0xa1, 0xd8, 0xea,
+	0xb4, 0x8e, 0x7a, 0xdd, 0xa6, 0xf6, 0x45, 0xab, 0xde, 0x94, 0x6f, 0xa0, 0x55, 0xa8, 0xb4, 0x3a,
+	0xdd, 0xa3, 0x5a, 0xbb, 0x1d, 0x00, 0x25, 0x22, 0xbd, 0x7b, 0x54, 0xd3, 0x42, 0xbc, 0x14, 0x91,
+	0x5e, 0xdf, 0x6b, 0xd6, 0xf7, 0x05, 0xa8, 0xb7, 0xd7, 0xac, 0xb5, 0x8f, 0xf6, 0xe4, 0x34, 0x5a,
+	0x87, 0x95, 0xba, 0xd6, 0xac, 0x1d, 0x35, 0x7b, 0xb5, 0xc6, 0x93, 0x56, 0xa7, 0x77, 0xdc, 0x6d,
+	0x6a, 0x72, 0x86, 0xf0, 0xa8, 0x1d, 0x1e, 0xb6, 0x7f, 0xd2, 0x6b, 0xb7, 0xea, 0xcd, 0x4e, 0xb7,
+	0x29, 0x2f, 0x21, 0x04, 0xe5, 0xe3, 0x4e, 0xfb, 0xa0, 0xd6, 0x08, 0xf8, 0x2e, 0x3f, 0xca, 0xc2,
+	0x12, 0x26, 0x2b, 0xaf, 0xbe, 0x05, 0x59, 0xe2, 0xb0, 0x99, 0x16, 0xcd, 0x85, 0x0d, 0x4d, 0x2b,
+	0xc8, 0x85, 0x91, 0x6f, 0x75, 0x07, 0x56, 0xbb, 0x63, 0xc7, 0xb1, 0x5d, 0xff, 0xd1, 0xd8, 0xea,
+	0x0f, 0x79, 0x50, 0x47, 0x9c, 0x02, 0xcf, 0xd7, 0x07, 0xc4, 0x4f, 0xee, 0x9b, 0x2e, 0xa7, 0x00,
+	0x0e, 0x6a, 0x98, 0xae, 0x5a, 0x81, 0x52, 0xdc, 0xa1, 0xff, 0x57, 0x09, 0xca, 0x13, 0x9e, 0xfc,
+	0x31, 0x94, 0x85, 0xe3, 0x13, 0xd1, 0xfd, 0x24, 0xc9, 0x43, 0xee, 0xc1, 0x71, 0x7e, 0x25, 0x2f,
+	0xda, 0x44, 0x3f, 0x83, 0x95, 0x10, 0xb5, 0x17, 0x0b, 0x5e, 0xe7, 0x73, 0xe6, 0x41, 0x2b, 0x1b,
+	0xb4, 0x26, 0x87, 0x3d, 0xac, 0x43, 0x3d, 0x85, 0x5b, 0x53, 0xe1, 0x0d, 0x9f, 0xce, 0xfe, 0x44,
+	0xc8, 0x77, 0x05, 0x57, 0x34, 0x8c, 0x11, 0x1d, 0x28, 0xc7, 0xfb, 0x66, 0x66, 0x2a, 0x6f, 0xc2,
+	0x32, 0xf7, 0x4b, 0x79, 0x38, 0xca, 0x5a, 0xd1, 0x64, 0x6f, 0x3a, 0x9e, 0xec, 0xad, 0x42, 0xd6,
+	0xc5, 0x43, 0x4c, 0x1c, 0x17, 0x66, 0x08, 0x44, 0x93, 0x44, 0x62, 0x6d, 0xd3, 0xc0, 0x96, 0x37,
+	0x11, 0x89, 0xfd, 0xa3, 0x04, 0xeb, 0x13, 0x1d, 0x7c, 0xc2, 0x32, 0xa4, 0x3d, 0xee, 0xbf, 0xe7,
+	0x34, 0xf2, 0x89, 0xde, 0x02, 0x18, 0x32, 0xd4, 0xd0, 0x6b, 0xcb, 0x73, 0x48, 0xab, 0x4f, 0xac,
+	0x8d, 0x31, 0xf6, 0x7c, 0x7b, 0x84, 0x5d, 0x76, 0x07, 0xb1, 0xc1, 0x15, 0x05, 0x90, 0xde, 0x43,
+	0x75, 0xa8, 0xe0, 0x67, 0x8e, 0xc9, 0xec, 0x40, 0xaf, 0xaf, 0xfb, 0x6c, 0xa4, 0x2f, 0x77, 0xf1,
+	0xca, 0x21, 0x49, 0x43, 0xf7, 0xb1, 0xda, 0x84, 0x55, 0x3e, 0xe6, 0x9a, 0xe3, 0x0c, 0x83, 0x94,
+	0x43, 0x15, 0xb2, 0x7c, 0x34, 0x22, 0x09, 0xce, 0x9b, 0xc4, 0x3c, 0x9e, 0xda, 0xae, 0xc1, 0x6c,
+	0x66, 0x4e, 0x63, 0x0d, 0xf5, 0x0c, 0xd6, 0xe2, 0x6c, 0xc2, 0x64, 0xfa, 0xd8, 0x21, 0x43, 0xeb,
+	0xf3, 0xd9, 0x8b, 0x26, 0xe9, 0x19, 0x61, 0x8f, 0xe4, 0xe7, 0xf8, 0xf4, 0x45, 0x13, 0x7d, 0x07,
+	0xf2, 0xfd, 0xb1, 0x33, 0x34, 0x0d, 0xdd, 0x67, 0x13, 0xcf, 0x69, 0x21, 0x40, 0xfd, 0x29, 0x94,
+	0x62, 0x4a, 0x8d, 0x5a, 0x53, 0xda, 0xf4, 0xd1, 0x22, 0xc7, 0x02, 0x47, 0x74, 0xe9, 0x7f, 0x25,
+	0x28, 0x46, 0xbb, 0x66, 0xaa, 0x52, 0x4b, 0x84, 0xd5, 0x49, 0xdd, 0x98, 0x28, 0xc7, 0x8d, 0x58,
+	0x18, 0x2d, 0x43, 0xda, 0x31, 0x59, 0x5e, 0x34, 0xa3, 0x91, 0x4f, 0xa2, 0xa7, 0x63, 0x87, 0x7a,
+	0xeb, 0x19, 0x0a, 0xe4, 0x2d, 0xf5, 0x29, 0x2c, 0xb1, 0x11, 0xad, 0x81, 0x5c, 0x3f, 0xe8, 0x74,
+	0x9a, 0xf5, 0xa3, 0xd6, 0x41, 0xa7, 0xd7, 0xd4, 0xb4, 0x03, 0x4d, 0xbe, 0x41, 0xa2, 0xce, 0x06,
+	0x09, 0x47, 0x25, 0xb4, 0x0c, 0xa9, 0x83, 0x7d, 0x39, 0xcd, 0x4c, 0xb9, 0xd6, 0x61, 0xf6, 0xb7,
+	0x08, 0xb9, 0xba, 0xd6, 0x3a, 0x6a, 0xd5, 0x6b, 0x6d, 0x39, 0x15, 0x0d, 0x5f, 0x97, 0xd4, 0x3b,
+	0xb0, 0xb2, 0xab, 0xfb, 0x67, 0xd8, 0x8d, 0xe4, 0x1a, 0x68, 0x6a, 0xd7, 0x1e, 0xf4, 0x88, 0x5d,
+	0xf3, 0x44, 0xcc, 0x31, 0x64, 0xb6, 0xcf, 0x53, 0x7f, 0x1b, 0x50, 0x94, 0x82, 0x6f, 0xf4, 0x6d,
+	0x28, 0x9c, 0x50, 0xbb, 0x17, 0x0d, 0xcd, 0x80, 0x81, 0xa8, 0xb6, 0xbe, 0x0b, 0x15, 0x8e, 0x40,
+	0x13, 0xbb, 0xde, 0x78, 0x24, 0x22, 0x3f, 0x06, 0xae, 0x73, 0x68, 0x84, 0x93, 0x67, 0x3e, 0x67,
+	0x0a, 0x90, 0x16, 0x9c, 0xba, 0xe6, 0x73, 0xac, 0x7e, 0x06, 0x6f, 0x84, 0x03, 0x68, 0xd8, 0x5f,
+	0x5b, 0x43, 0x5b, 0xef, 0x8b, 0xa1, 0xcf, 0x1b, 0x87, 0x7a, 0x07, 0x94, 0x59, 0xd4, 0x7c, 0x1a,
+	0xa2, 0xc4, 0x23, 0x45, 0x4a, 0x3c, 0x55, 0xb8, 0xa9, 0x61, 0x9a, 0x68, 0x17, 0xd9, 0x09, 0x71,
+	0xe2, 0xb7, 0xe0, 0xd6, 0x54, 0xcf, 0xbc, 0x2c, 0x9c, 0x42, 0x4b, 0x4f, 0x13, 0xa9, 0x3f, 0xce,
+	0xef, 0x19, 0xbc, 0x31, 0xa3, 0x8f, 0x73, 0xbc, 0xb6, 0xec, 0x2e, 0x82, 0xcc, 0x99, 0xee, 0xb1,
+	0xc0, 0x3b, 0xa3, 0xd1, 0x6f, 0xf5, 0x12, 0x94, 0x43, 0xdd, 0x37, 0xce, 0x66, 0x8e, 0xeb, 0xf5,
+	0x8a, 0xde, 0x81, 0x37, 0x67, 0x8a, 0x9e, 0xb7, 0x90, 0x06, 0x54, 0xbb, 0xd8, 0x7f, 0xbd, 0x03,
+	0x56, 0xef, 0xc1, 0x1b, 0x5d, 0xec, 0x2f, 0x3a, 0xb4, 0x0a, 0x94, 0x1a, 0xe3, 0x91, 0xd3, 0x78,
+	0x24, 0x36, 0xf6, 0x7b, 0x50, 0x16, 0x80, 0x97, 0x28, 0xda, 0xef, 0x48, 0x70, 0x6b, 0x2a, 0xdf,
+	0xc9, 0xf1, 0xe9, 0xf1, 0x31, 0x87, 0xfd, 0x5e, 0x50, 0x5a, 0x15, 0x19, 0x16, 0x0a, 0x0e, 0x0c,
+	0x3c, 0x39, 0xbb, 0x0c, 0xd1, 0x3b, 0xd3, 0x45, 0xe8, 0x42, 0x01, 0xdd, 0x33, 0x9d, 0xa6, 0x47,
+	0x86, 0x66, 0x4f, 0x5c, 0x6c, 0x3c, 0x45, 0x61, 0x0c, 0x4d, 0x8d, 0xdf, 0x6d, 0x9f, 0x80, 0x1c,
+	0x26, 0x4e, 0xb9, 0xe8, 0xef, 0xce, 0xca, 0x0f, 0x4f, 0x24, 0x19, 0xd4, 0xff, 0x90, 0x00, 0x98,
+	0x6f, 0x4a, 0x7c, 0x4b, 0xf4, 0x41, 0x90, 0x56, 0x79, 0xf9, 0x75, 0x44, 0x52, 0x2e, 0x4f, 0xe2,
+	0x06, 0xf5, 0x93, 0x84, 0xf1, 0x02, 0x91, 0xc3, 0x3f, 0xa3, 0x46, 0x55, 0xdd, 0x85, 0x42, 0x04,
+	0x4a, 0x3c, 0xd7, 0x56, 0xa7, 0x77, 0xa8, 0x1d, 0xec, 0x6a, 0xcd, 0x6e, 0x97, 0x25, 0xec, 0x84,
+	0xe7, 0xda, 0x90, 0x25, 0xe2, 0x2c, 0x13, 0xff, 0xb5, 0xd9, 0x90, 0xd3, 0xc4, 0x4e, 0x36, 0x9a,
+	0xed, 0x26, 0x75, 0x80, 0x33, 0xea, 0x43, 0x58, 0x99, 0x8a, 0x4c, 0xa6, 0xf2, 0x45, 0xa4, 0xbc,
+	0x79, 0xa6, 0x6f, 0x6d, 0xef, 0x08, 0xc7, 0x82, 0xb5, 0xd4, 0x7f, 0x97, 0x40, 0xee, 0xde, 0x65,
+	0xf4, 0x6d, 0xdb, 0x60, 0xb1, 0x0b, 0x35, 0x4e, 0xc6, 0x39, 0xf6, 0x27, 0x8c, 0x13, 0x01, 0x51,
+	0x23, 0x49, 0x36, 0x8f, 0xc4, 0xb1, 0x91, 0xc4, 0x58, 0x8e, 0x00, 0x48, 0x4a, 0x8c, 0x24, 0x82,
+	0xb0, 0xd5, 0x77, 0x6c, 0xd3, 0xf2, 0xf9, 0xce, 0x05, 0x6d, 0xe2, 0x4f, 0xe8, 0x86, 0x81, 0x3d,
+	0xaf, 0x47, 0x0a, 0x58, 0xcc, 0x61, 0xc9, 0x33, 0xc8, 0x3e, 0xbe, 0x24, 0xdd, 0x1e, 0x36, 0x5c,
+	0xec, 0xd3, 0x6e, 0x16, 0x6a, 0xe6, 0x19, 0x84, 0x74, 0x93, 0xe0, 0x06, 0x7b, 0x44, 0xdf, 0x78,
+	0xc9, 0x89, 0xe5, 0x41, 0x8b, 0x1c, 0xc8, 0xca, 0x4e, 0x7f, 0x2d, 0xc1, 0xca, 0x6e, 0xbd, 0xfb,
+	0xad, 0x4d, 0xe9, 0x7d, 0x90, 0x0d, 0x17, 0xf7, 0xb1, 0xe5, 0x9b, 0xfa, 0x90, 0xe7, 0x0a, 0xd9,
+	0xc8, 0x2b, 0x11, 0x38, 0x61, 0xf3, 0x38, 0x93, 0xcb, 0xc8, 0x4b, 0xea, 0xbf, 0x49, 0xb0, 0x5a,
+	0x7b, 0x3e, 0x76, 0xf1, 0xc4, 0x10, 0x49, 0x7d, 0xd7, 0xb6, 0x7c, 0xdd, 0xb4, 0xb0, 0x1b, 0x1d,
+	0x65, 0x29, 0x80, 0xbe, 0xda, 0x40, 0xdf, 0x81, 0xa2, 0x6e, 0x18, 0xf6, 0xd8, 0xe2, 0x6b, 0xc0,
+	0xeb, 0x97, 0x1c, 0x46, 0x79, 0xbf, 0x07, 0xb2, 0x40, 0x39, 0xc7, 0x97, 0x4c, 0x04, 0xcf, 0x35,
+	0x73, 0xf8, 0x3e, 0xbe, 0xe4, 0x53, 0x59, 0x92, 0x97, 0xd5, 0x13, 0x90, 0xd9, 0x24, 0x9a, 0x96,
+	0xe1, 0x5e, 0x32, 0xcd, 0x7b, 0x03, 0x72, 0x01, 0x2d, 0xf7, 0x55, 0xcf, 0x19, 0x11, 0xad, 0xa5,
+	0xeb, 0x9e, 0xe7, 0x9c, 0xb9, 0xc1, 0x04, 0x44, 0x2d, 0x3d, 0x00, 0x73, 0xee, 0x92, 0x9c, 0x7a,
+	0x9c, 0xc9, 0xa5, 0xe4, 0xb4, 0xfa, 0x5f, 0x4b, 0x42, 0xbf, 0xc9, 0x1d, 0x66, 0xbb, 0x78, 0xe1,
+	0x83, 0x5b, 0x0f, 0x52, 0x5a, 0x2c, 0x68, 0xf8, 0x70, 0x81, 0x93, 0x2b, 0x12, 0x59, 0x44, 0x35,
+	0xd9, 0x17, 0x8d, 0x8f, 0xd8, 0xc4, 0xf2, 0x0c, 0xd2, 0x30, 0xdd, 0x59, 0x99, 0xe5, 0x4c, 0xd2,
+	0xcc, 0xf2, 0xd2, 0xac, 0xcc, 0x32, 0x7b, 0x08, 0xa0, 0x5b, 0x16, 0x1e, 0x86, 0x0f, 0x01, 0x68,
+	0x93, 0x39, 0xab, 0x34, 0xcf, 0x5f, 0x5d, 0x16, 0xce, 0x2a, 0x6d, 0x92, 0x53, 0xae, 0x9b, 0xee,
+	0x40, 0x77, 0x68, 0x5e, 0x30, 0xa7, 0xf1, 0x16, 0xea, 0x01, 0xf2, 0xee, 0xf6, 0xf8, 0xf8, 0x87,
+	0x5c, 0xe1, 0x78, 0x82, 0x6f, 0x7e, 0x1d, 0x64, 0xd2, 0x3e, 0x68, 0xb2, 0x37, 0x01, 0x89, 0x98,
+	0x17, 0x88, 0x9a, 0x17, 0x74, 0x02, 0xab, 0x03, 0xc3, 0x9b, 0x92, 0x5c, 0x48, 0x98, 0x71, 0x99,
+	0x3a, 0xc7, 0xda, 0xca, 0xc0, 0xf0, 0x26, 0x64, 0x9f, 0xc1, 0xba, 0x4e, 0x8e, 0xd3, 0x94, 0x94,
+	0x22, 0x95, 0x32, 0x3f, 0xc5, 0x36, 0xe3, 0x30, 0x6a, 0xab, 0xfa, 0x34, 0x10, 0xfd, 0x08, 0x00,
+	0x07, 0x8a, 0x5e, 0x2d, 0x25, 0x5c, 0xbe, 0xc9, 0x13, 0xa2, 0x45, 0x98, 0xa8, 0x7f, 0x20, 0x89,
+	0x23, 0xc4, 0x5e, 0xb6, 0x2c, 0xac, 0xdc, 0x4d, 0xc8, 0xb2, 0x79, 0x8b, 0x3c, 0xd9, 0x42, 0xda,
+	0x2d, 0x68, 0xd5, 0x5f, 0xa5, 0x60, 0xb5, 0x4e, 0x4b, 0x04, 0xe2, 0xac, 0x31, 0x0f, 0xe5, 0x05,
+	0x1b, 0x28, 0x7d, 0x2b, 0x1b, 0x98, 0x7a, 0xbd, 0x1b, 0x98, 0xbe, 0x86, 0x0d, 0x7c, 0x9c, 0xc9,
+	0xe5, 0x65, 0x50, 0x7f, 0x06, 0x6b, 0xf1, 0xd5, 0xe3, 0x3e, 0x49, 0x68, 0x7a, 0xa4, 0x2b, 0x9b,
+	0x1e, 0x75, 0x0d, 0x50, 0xdb, 0xf4, 0x7c, 0xd6, 0x13, 0x38, 0xf5, 0x3f, 0x87, 0xd5, 0x18, 0x94,
+	0x4b, 0xbc, 0x26, 0x7d, 0xf8, 0x31, 0xac, 0x74, 0xcf, 0xec, 0xaf, 0xe3, 0xca, 0x70, 0x2d, 0xb3,
+	0xf9, 0x17, 0x09, 0x50, 0x94, 0xf5, 0x0c, 0x47, 0x33, 0xcf, 0x1c, 0xcd, 0xeb, 0x31, 0xdc, 0xaf,
+	0x25, 0xd9, 0xab, 0x7e, 0x09, 0x6b, 0xec, 0xc0, 0xc6, 0x77, 0x25, 0xba, 0xfc, 0xd2, 0x2b, 0x2c,
+	0xff, 0x09, 0xac, 0x4f, 0xb0, 0xe7, 0xcb, 0xd4, 0x22, 0x45, 0x2f, 0xd2, 0x51, 0x95, 0x16, 0xd2,
+	0xde, 0xd0, 0xba, 0x68, 0x9c, 0x81, 0xda, 0x87, 0x35, 0x7e, 0xa3, 0xc6, 0x77, 0xb9, 0x4d, 0x32,
+	0x4a, 0x14, 0x9e, 0xf8, 0x98, 0x4f, 0xdd, 0xcf, 0x9a, 0x60, 0xa1, 0x62, 0x58, 0x9f, 0x90, 0xc2,
+	0x67, 0x72, 0xbd, 0x62, 0xd6, 0x61, 0x35, 0xf4, 0xa6, 0xc3, 0x5c, 0xd7, 0xaf, 0x52, 0xb0, 0x16,
+	0x87, 0x73, 0xe9, 0x3f, 0x87, 0xac, 0xed, 0xb0, 0x77, 0x5c, 0x49, 0x9f, 0x1d, 0xcc, 0xe2, 0x13,
+	0x26, 0xb8, 0x69, 0x7e, 0x7b, 0xd9, 0x76, 0xc8, 0x5f, 0xe2, 0x03, 0xf1, 0x88, 0x8b, 0x9d, 0xc2,
+	0xbc, 0x96, 0x65, 0x21, 0x97, 0x47, 0x93, 0x8a, 0xc6, 0x19, 0xee, 0x8f, 0x87, 0xa2, 0xd2, 0xb9,
+	0x99, 0x54, 0x32, 0x27, 0xd3, 0x02, 0x06, 0xea, 0x6f, 0x42, 0x29, 0x36, 0x00, 0x12, 0x25, 0xb0,
+	0x1c, 0xb2, 0x7c, 0x83, 0x7c, 0xd3, 0x28, 0x81, 0x24, 0xa2, 0x23, 0xe9, 0xf5, 0x34, 0xc9, 0xc2,
+	0xb4, 0x5b, 0xdd, 0x23, 0x39, 0x43, 0xbe, 0xba, 0x7b, 0x07, 0x4f, 0xe5, 0x25, 0x4a, 0x58, 0xeb,
+	0xd4, 0x9b, 0x6d, 0x79, 0x39, 0x78, 0x1b, 0x90, 0x55, 0xff, 0x36, 0x05, 0xe5, 0xb8, 0x70, 0x72,
+	0x4e, 0x0d, 0x37, 0x78, 0x2b, 0x46, 0xbf, 0xd1, 0x36, 0xe4, 0x2c, 0xfc, 0xcc, 0xef, 0xb9, 0x63,
+	0x2b, 0x41, 0x29, 0x3a, 0x4b, 0x70, 0xb5, 0x31, 0x25, 0xa3, 0x85, 0x68, 0x42, 0x36, 0xbf, 0xe8,
+	0x9b, 0x25, 0xb8, 0x84, 0xac, 0xcd, 0xeb, 0xd7, 0xdc, 0x34, 0x64, 0x16, 0x37, 0x0d, 0xb4, 0xd8,
+	0xfd, 0x28, 0xf0, 0xeb, 0x28, 0xb7, 0x68, 0x75, 0x8b, 0x3e, 0x18, 0x6c, 0x12, 0x40, 0x50, 0x2c,
+	0x77, 0xdc, 0xb1, 0x85, 0xfb, 0xd4, 0xdf, 0x5a, 0x62, 0xf4, 0x87, 0x14, 0x42, 0x14, 0xaf, 0xae,
+	0x5b, 0x06, 0x1e, 0xc6, 0x0e, 0x11, 0x49, 0xbe, 0xc6, 0xc1, 0xfc, 0xe9, 0xc4, 0x07, 0x50, 0xe6,
+	0x6f, 0x34, 0x22, 0x29, 0xcc, 0xd9, 0xef, 0x78, 0xd5, 0x67, 0x50, 0x09, 0x70, 0xb9, 0xda, 0x92,
+	0x87, 0xa4, 0x2e, 0xbe, 0x30, 0xed, 0xb1, 0xd7, 0x8b, 0x53, 0x55, 0x04, 0x5c, 0xa4, 0x97, 0xdf,
+	0x81, 0x22, 0xdd, 0x14, 0x81, 0xc6, 0x42, 0x85, 0x02, 0x81, 0x09, 0x94, 0x48, 0x62, 0x20, 0x1d,
+	0x4b, 0x0c, 0xdc, 0x86, 0xb7, 0xea, 0xec, 0x6d, 0x09, 0x0f, 0xb8, 0x45, 0xb8, 0x2f, 0xa6, 0xf7,
+	0x7d, 0xa8, 0x4c, 0xf4, 0x10, 0xc5, 0xf8, 0x85, 0xc7, 0x87, 0x53, 0xd4, 0xe8, 0x37, 0x49, 0x49,
+	0xd5, 0x3e, 0x9e, 0xf9, 0x1c, 0xe8, 0xef, 0x32, 0x70, 0x6b, 0xaa, 0x8b, 0x4f, 0xd2, 0x06, 0xea,
+	0x34, 0xeb, 0xc3, 0x61, 0x2f, 0x56, 0x42, 0xdb, 0x9b, 0xef, 0x08, 0xcc, 0xe6, 0xb8, 0xf1, 0xc4,
+	0x1c, 0xb0, 0x43, 0x22, 0x0a, 0x0c, 0x9c, 0x3f, 0x6b, 0xa2, 0xe7, 0x20, 0xc7, 0xeb, 0x16, 0x58,
+	0x5c, 0x9e, 0x07, 0x57, 0x16, 0xc9, 0x33, 0x6d, 0x93, 0x92, 0x2b, 0xb1, 0xd2, 0x06, 0xf6, 0x94,
+	0x5f, 0x4b, 0x70, 0x73, 0x36, 0x6e, 0x92, 0x77, 0x24, 0x5f, 0x4d, 0x14, 0xab, 0xaf, 0x6f, 0x89,
+	0x38, 0x5f, 0xfa, 0xac, 0x59, 0x54, 0x09, 0xd3, 0x54, 0xfb, 0x83, 0x36, 0xd9, 0x72, 0xd3, 0x3a,
+	0xb5, 0x79, 0xa4, 0x43, 0xbf, 0xd5, 0x16, 0x54, 0x26, 0xe7, 0x11, 0x7b, 0x87, 0x34, 0x91, 0xe7,
+	0x90, 0x68, 0xd2, 0x97, 0xe7, 0x39, 0xe4, 0x54, 0x34, 0xcd, 0xb1, 0xf5, 0x7b, 0x1f, 0x02, 0x84,
+	0x0f, 0x51, 0x90, 0x19, 0x3c, 0x25, 0xd8, 0x48, 0x58, 0x8a, 0xe4, 0xca, 0xa6, 0x6c, 0x26, 0xc6,
+	0xe7, 0x1a, 0x38, 0x06, 0xc4, 0x20, 0x0d, 0xdd, 0xd7, 0x83, 0xb7, 0x5e, 0xaf, 0x5d, 0xec, 0x48,
+	0xcc, 0xb7, 0x6b, 0x8f, 0xf0, 0xeb, 0x17, 0xe7, 0x8b, 0x37, 0x46, 0x7c, 0x9f, 0x92, 0x3e, 0x46,
+	0x88, 0x9d, 0x64, 0xe5, 0xff, 0x2f, 0x52, 0x17, 0xbe, 0x23, 0xa1, 0x5f, 0x40, 0xf6, 0xd0, 0xc5,
+	0x24, 0x9b, 0xfd, 0xad, 0x2c, 0x28, 0x7b, 0x4c, 0x99, 0x70, 0x41, 0x63, 0x4f, 0x3e, 0x95, 0xcd,
+	0xc4, 0xf8, 0x5c, 0xdc, 0xd7, 0xfc, 0x6d, 0x65, 0xc7, 0xb6, 0xbe, 0x5d, 0xc5, 0x19, 0x43, 0x29,
+	0xf6, 0xd2, 0x1a, 0x6d, 0xcf, 0x7f, 0x76, 0x3e, 0xe3, 0x65, 0xb6, 0xb2, 0xd8, 0x5b, 0x5a, 0xf4,
+	0x67, 0x12, 0xac, 0xce, 0x78, 0xbe, 0x8c, 0x1e, 0x26, 0xac, 0xca, 0xce, 0x7a, 0x5c, 0xad, 0x7c,
+	0x76, 0x35, 0x62, 0xbe, 0x12, 0x06, 0x64, 0xc8, 0x0b, 0x5b, 0x34, 0x5f, 0x2b, 0x23, 0xef, 0x72,
+	0x95, 0x8f, 0x12, 0x62, 0x73, 0x21, 0x66, 0xf0, 0x12, 0x21, 0x41, 0x65, 0x3b, 0x76, 0x58, 0x36,
+	0x13, 0xe3, 0x73, 0x51, 0xbf, 0x2f, 0x41, 0x65, 0xa2, 0x3e, 0x8d, 0x3e, 0x59, 0xb0, 0x0a, 0x1d,
+	0x48, 0xbf, 0xbf, 0x38, 0x21, 0x1f, 0xc6, 0x37, 0x50, 0x8a, 0x95, 0x8c, 0x13, 0x28, 0xd8, 0xac,
+	0xda, 0xb3, 0xb2, 0xb3, 0x28, 0x19, 0x97, 0xff, 0x5b, 0x50, 0x8c, 0xd6, 0x6d, 0x13, 0x98, 0xaa,
+	0x19, 0xd5, 0x62, 0x65, 0x7b, 0x41, 0xaa, 0x50, 0xa7, 0xc8, 0x53, 0xea, 0x04, 0x3a, 0x15, 0x79,
+	0xa7, 0xad, 0x7c, 0x94, 0x10, 0x9b, 0x0b, 0x19, 0x02, 0x84, 0x8f, 0xa9, 0xd1, 0xfc, 0x58, 0x68,
+	0xea, 0xe5, 0xb5, 0xf2, 0xde, 0xfc, 0xd9, 0xb1, 0xda, 0xe8, 0x1d, 0x09, 0x8d, 0x01, 0xc2, 0xea,
+	0x62, 0x02, 0x69, 0x53, 0xb5, 0x57, 0xe5, 0xee, 0x42, 0x34, 0x7c, 0x92, 0x7f, 0x2a, 0x01, 0x9a,
+	0xae, 0x6a, 0xa2, 0x4f, 0x17, 0xe0, 0x35, 0x51, 0x48, 0x55, 0x1e, 0x5e, 0x89, 0x96, 0x8d, 0xe7,
+	0x8e, 0x44, 0x14, 0x2b, 0xfa, 0x4c, 0x3e, 0x81, 0x62, 0xcd, 0x78, 0x6c, 0xaf, 0x6c, 0x2f, 0x48,
+	0x15, 0x9e, 0xaa, 0xd8, 0x9b, 0x7b, 0x94, 0x84, 0xcf, 0xf4, 0xdb, 0x7d, 0x65, 0x67, 0x51, 0xb2,
+	0x50, 0x7e, 0xcc, 0x19, 0x4c, 0x20, 0x7f, 0x96, 0x33, 0xaf, 0xec, 0x2c, 0x4a, 0x16, 0x31, 0x6e,
+	0x13, 0x85, 0xe9, 0x04, 0xc6, 0x6d, 0x76, 0x91, 0x5b, 0xb9, 0xbf, 0x38, 0x21, 0x1f, 0xc6, 0x1f,
+	0x93, 0x8a, 0xd1, 0x64, 0xf5, 0x14, 0xcd, 0x7f, 0x53, 0xfb, 0xa2, 0xfa, 0xb8, 0xf2, 0xe9, 0x55,
+	0x48, 0xf9, 0x60, 0xc8, 0x9d, 0x3a, 0xa3, 0xce, 0x9c, 0xe0, 0x4e, 0x7d, 0x71, 0x61, 0x5c, 0xf9,
+	0xec, 0x6a, 0xc4, 0x91, 0xf5, 0xe9, 0x5e, 0x61, 0x7d, 0xba, 0x57, 0x5f, 0x9f, 0x17, 0x17, 0xb3,
+	0xcf, 0x61, 0x99, 0x95, 0xa8, 0x93, 0x78, 0x55, 0xd1, 0xe2, 0xb6, 0xb2, 0x99, 0x18, 0x3f, 0xb0,
+	0x0e, 0x44, 0x41, 0x27, 0x2a, 0xdd, 0x09, 0x14, 0x74, 0xf6, 0xff, 0x02, 0x29, 0xf7, 0x17, 0x27,
+	0x0c, 0x6f, 0xbf, 0x68, 0x76, 0x39, 0x81, 0x91, 0x9a, 0x91, 0xca, 0x57, 0xb6, 0x17, 0xa4, 0xe2,
+	0xc2, 0x9f, 0x41, 0x21, 0x92, 0x67, 0x46, 0x77, 0x13, 0xdc, 0xa1, 0x93, 0xb9, 0x6a, 0xe5, 0xde,
+	0x62, 0x44, 0x81, 0x57, 0x0b, 0x61, 0xa2, 0x38, 0xc9, 0x95, 0x38, 0x99, 0xb0, 0x56, 0xee, 0x2e,
+	0x44, 0x13, 0x5a, 0xc5, 0x58, 0xee, 0x35, 0x81, 0x55, 0x9c, 0x95, 0x0a, 0x56, 0x76, 0x16, 0x25,
+	0x0b, 0xe5, 0xc7, 0x32, 0xa6, 0x09, 0xe4, 0xcf, 0xca, 0xe3, 0x2a, 0x3b, 0x8b, 0x92, 0x85, 0xda,
+	0x16, 0x4d, 0x75, 0x26, 0xd0, 0xb6, 0x19, 0x99, 0x57, 0x65, 0x7b, 0x41, 0xaa, 0x88, 0xaa, 0x47,
+	0xf2, 0x66, 0x49, 0x54, 0x7d, 0x3a, 0xfb, 0xa6, 0x6c, 0x2f, 0x48, 0x15, 0xf8, 0x60, 0x59, 0x7e,
+	0x51, 0xa1, 0xcd, 0xa4, 0x57, 0x9a, 0x10, 0x79, 0x27, 0x39, 0x41, 0x78, 0xb0, 0x22, 0xff, 0x5c,
+	0x95, 0xe0, 0x60, 0x4d, 0xff, 0x9b, 0x96, 0x72, 0x6f, 0x31, 0x22, 0x2e, 0xf9, 0x4f, 0x88, 0x1b,
+	0x36, 0xf5, 0x5f, 0xbd, 0x49, 0xdc, 0xb0, 0x17, 0xfd, 0x27, 0xb1, 0xf2, 0xf0, 0x4a, 0xb4, 0x41,
+	0xc2, 0x2f, 0x27, 0x5e, 0xf3, 0xa0, 0x3b, 0x09, 0x43, 0xd0, 0xe0, 0x3f, 0x26, 0x95, 0x8f, 0x17,
+	0xa0, 0x08, 0x6f, 0xb4, 0x9b, 0xb3, 0x13, 0x9c, 0xe8, 0x37, 0xe6, 0xab, 0xce, 0xcb, 0x32, 0xa3,
+	0x09, 0xf4, 0x60, 0x52, 0xe2, 0x1f, 0x4a, 0x50, 0x99, 0xc8, 0xca, 0x25, 0xb8, 0x64, 0x66, 0xe7,
+	0x55, 0x95, 0xfb, 0x8b, 0x13, 0x06, 0xb7, 0xdd, 0x37, 0x50, 0x8a, 0xfd, 0x16, 0x44, 0x92, 0x2c,
+	0xc2, 0x8c, 0xdf, 0x94, 0x50, 0x76, 0x16, 0x25, 0xe3, 0xbb, 0xf2, 0x37, 0x12, 0x54, 0x5f, 0xf4,
+	0x8b, 0x0f, 0xe8, 0x87, 0x09, 0x7e, 0x80, 0xe2, 0xa5, 0x3f, 0x34, 0xa1, 0xd4, 0x5e, 0x81, 0x03,
+	0x1f, 0xe1, 0x29, 0x2c, 0xb1, 0x1f, 0x19, 0x98, 0x1f, 0xdc, 0x45, 0x7f, 0x2f, 0x41, 0xd9, 0x48,
+	0x8a, 0xce, 0xe5, 0xfc, 0x91, 0x04, 0xf2, 0xe4, 0x0f, 0x7f, 0xa0, 0xfb, 0x49, 0xbc, 0xca, 0x59,
+	0x3f, 0x31, 0xa2, 0x3c, 0xb8, 0x02, 0xa5, 0xd0, 0x8a, 0x47, 0x3b, 0x3f, 0xbd, 0x37, 0x30, 0xfd,
+	0xb3, 0xf1, 0xc9, 0x86, 0x61, 0x8f, 0x36, 0x09, 0xa3, 0xe0, 0x37, 0x4e, 0x36, 0x5f, 0xf2, 0x3b,
+	0x2d, 0x27, 0xcb, 0xb4, 0x86, 0x73, 0xf7, 0xff, 0x06, 0x00, 0x22, 0x82, 0x09, 0x1b, 0xcd, 0x45,
+	0x00, 0x00,
 }
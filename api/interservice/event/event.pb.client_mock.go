@@ -74,6 +74,126 @@ func (mr *MockEventServiceClientMockRecorder) Subscribe(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventServiceClient)(nil).Subscribe), varargs...)
 }
 
+// Unsubscribe mocks base method
+func (m *MockEventServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unsubscribe", varargs...)
+	ret0, _ := ret[0].(*UnsubscribeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe
+func (mr *MockEventServiceClientMockRecorder) Unsubscribe(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockEventServiceClient)(nil).Unsubscribe), varargs...)
+}
+
+// ListSubscriptions mocks base method
+func (m *MockEventServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSubscriptions", varargs...)
+	ret0, _ := ret[0].(*ListSubscriptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions
+func (mr *MockEventServiceClientMockRecorder) ListSubscriptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockEventServiceClient)(nil).ListSubscriptions), varargs...)
+}
+
+// ListDeadLetters mocks base method
+func (m *MockEventServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeadLetters", varargs...)
+	ret0, _ := ret[0].(*ListDeadLettersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters
+func (mr *MockEventServiceClientMockRecorder) ListDeadLetters(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockEventServiceClient)(nil).ListDeadLetters), varargs...)
+}
+
+// GetDeadLetter mocks base method
+func (m *MockEventServiceClient) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetter, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeadLetter", varargs...)
+	ret0, _ := ret[0].(*DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter
+func (mr *MockEventServiceClientMockRecorder) GetDeadLetter(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockEventServiceClient)(nil).GetDeadLetter), varargs...)
+}
+
+// ReplayDeadLetter mocks base method
+func (m *MockEventServiceClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplayDeadLetter", varargs...)
+	ret0, _ := ret[0].(*ReplayDeadLetterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetter indicates an expected call of ReplayDeadLetter
+func (mr *MockEventServiceClientMockRecorder) ReplayDeadLetter(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetter", reflect.TypeOf((*MockEventServiceClient)(nil).ReplayDeadLetter), varargs...)
+}
+
+// ListEvents mocks base method
+func (m *MockEventServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEvents", varargs...)
+	ret0, _ := ret[0].(*ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents
+func (mr *MockEventServiceClientMockRecorder) ListEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventServiceClient)(nil).ListEvents), varargs...)
+}
+
 // Start mocks base method
 func (m *MockEventServiceClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +287,96 @@ func (mr *MockEventServiceServerMockRecorder) Subscribe(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventServiceServer)(nil).Subscribe), arg0, arg1)
 }
 
+// Unsubscribe mocks base method
+func (m *MockEventServiceServer) Unsubscribe(arg0 context.Context, arg1 *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", arg0, arg1)
+	ret0, _ := ret[0].(*UnsubscribeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe
+func (mr *MockEventServiceServerMockRecorder) Unsubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockEventServiceServer)(nil).Unsubscribe), arg0, arg1)
+}
+
+// ListSubscriptions mocks base method
+func (m *MockEventServiceServer) ListSubscriptions(arg0 context.Context, arg1 *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].(*ListSubscriptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions
+func (mr *MockEventServiceServerMockRecorder) ListSubscriptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockEventServiceServer)(nil).ListSubscriptions), arg0, arg1)
+}
+
+// ListDeadLetters mocks base method
+func (m *MockEventServiceServer) ListDeadLetters(arg0 context.Context, arg1 *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", arg0, arg1)
+	ret0, _ := ret[0].(*ListDeadLettersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters
+func (mr *MockEventServiceServerMockRecorder) ListDeadLetters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockEventServiceServer)(nil).ListDeadLetters), arg0, arg1)
+}
+
+// GetDeadLetter mocks base method
+func (m *MockEventServiceServer) GetDeadLetter(arg0 context.Context, arg1 *GetDeadLetterRequest) (*DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(*DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter
+func (mr *MockEventServiceServerMockRecorder) GetDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockEventServiceServer)(nil).GetDeadLetter), arg0, arg1)
+}
+
+// ReplayDeadLetter mocks base method
+func (m *MockEventServiceServer) ReplayDeadLetter(arg0 context.Context, arg1 *ReplayDeadLetterRequest) (*ReplayDeadLetterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLetter", arg0, arg1)
+	ret0, _ := ret[0].(*ReplayDeadLetterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLetter indicates an expected call of ReplayDeadLetter
+func (mr *MockEventServiceServerMockRecorder) ReplayDeadLetter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLetter", reflect.TypeOf((*MockEventServiceServer)(nil).ReplayDeadLetter), arg0, arg1)
+}
+
+// ListEvents mocks base method
+func (m *MockEventServiceServer) ListEvents(arg0 context.Context, arg1 *ListEventsRequest) (*ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", arg0, arg1)
+	ret0, _ := ret[0].(*ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents
+func (mr *MockEventServiceServerMockRecorder) ListEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventServiceServer)(nil).ListEvents), arg0, arg1)
+}
+
 // Start mocks base method
 func (m *MockEventServiceServer) Start(arg0 context.Context, arg1 *StartRequest) (*StartResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,3 +406,84 @@ func (mr *MockEventServiceServerMockRecorder) Stop(arg0, arg1 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockEventServiceServer)(nil).Stop), arg0, arg1)
 }
+
+// MockEventHandlerServiceClient is a mock of EventHandlerServiceClient interface
+type MockEventHandlerServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventHandlerServiceClientMockRecorder
+}
+
+// MockEventHandlerServiceClientMockRecorder is the mock recorder for MockEventHandlerServiceClient
+type MockEventHandlerServiceClientMockRecorder struct {
+	mock *MockEventHandlerServiceClient
+}
+
+// NewMockEventHandlerServiceClient creates a new mock instance
+func NewMockEventHandlerServiceClient(ctrl *gomock.Controller) *MockEventHandlerServiceClient {
+	mock := &MockEventHandlerServiceClient{ctrl: ctrl}
+	mock.recorder = &MockEventHandlerServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventHandlerServiceClient) EXPECT() *MockEventHandlerServiceClientMockRecorder {
+	return m.recorder
+}
+
+// HandleEvent mocks base method
+func (m *MockEventHandlerServiceClient) HandleEvent(ctx context.Context, in *EventMsg, opts ...grpc.CallOption) (*EventResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HandleEvent", varargs...)
+	ret0, _ := ret[0].(*EventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleEvent indicates an expected call of HandleEvent
+func (mr *MockEventHandlerServiceClientMockRecorder) HandleEvent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockEventHandlerServiceClient)(nil).HandleEvent), varargs...)
+}
+
+// MockEventHandlerServiceServer is a mock of EventHandlerServiceServer interface
+type MockEventHandlerServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventHandlerServiceServerMockRecorder
+}
+
+// MockEventHandlerServiceServerMockRecorder is the mock recorder for MockEventHandlerServiceServer
+type MockEventHandlerServiceServerMockRecorder struct {
+	mock *MockEventHandlerServiceServer
+}
+
+// NewMockEventHandlerServiceServer creates a new mock instance
+func NewMockEventHandlerServiceServer(ctrl *gomock.Controller) *MockEventHandlerServiceServer {
+	mock := &MockEventHandlerServiceServer{ctrl: ctrl}
+	mock.recorder = &MockEventHandlerServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventHandlerServiceServer) EXPECT() *MockEventHandlerServiceServerMockRecorder {
+	return m.recorder
+}
+
+// HandleEvent mocks base method
+func (m *MockEventHandlerServiceServer) HandleEvent(arg0 context.Context, arg1 *EventMsg) (*EventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleEvent", arg0, arg1)
+	ret0, _ := ret[0].(*EventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleEvent indicates an expected call of HandleEvent
+func (mr *MockEventHandlerServiceServerMockRecorder) HandleEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleEvent", reflect.TypeOf((*MockEventHandlerServiceServer)(nil).HandleEvent), arg0, arg1)
+}
@@ -36,7 +36,7 @@ func (m *EventType) Reset()         { *m = EventType{} }
 func (m *EventType) String() string { return proto.CompactTextString(m) }
 func (*EventType) ProtoMessage()    {}
 func (*EventType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{0}
+	return fileDescriptor_event_510e986a741e9555, []int{0}
 }
 func (m *EventType) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventType.Unmarshal(m, b)
@@ -77,7 +77,7 @@ func (m *Producer) Reset()         { *m = Producer{} }
 func (m *Producer) String() string { return proto.CompactTextString(m) }
 func (*Producer) ProtoMessage()    {}
 func (*Producer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{1}
+	return fileDescriptor_event_510e986a741e9555, []int{1}
 }
 func (m *Producer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Producer.Unmarshal(m, b)
@@ -138,7 +138,7 @@ func (m *Actor) Reset()         { *m = Actor{} }
 func (m *Actor) String() string { return proto.CompactTextString(m) }
 func (*Actor) ProtoMessage()    {}
 func (*Actor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{2}
+	return fileDescriptor_event_510e986a741e9555, []int{2}
 }
 func (m *Actor) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Actor.Unmarshal(m, b)
@@ -192,7 +192,7 @@ func (m *Object) Reset()         { *m = Object{} }
 func (m *Object) String() string { return proto.CompactTextString(m) }
 func (*Object) ProtoMessage()    {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{3}
+	return fileDescriptor_event_510e986a741e9555, []int{3}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Object.Unmarshal(m, b)
@@ -246,7 +246,7 @@ func (m *Target) Reset()         { *m = Target{} }
 func (m *Target) String() string { return proto.CompactTextString(m) }
 func (*Target) ProtoMessage()    {}
 func (*Target) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{4}
+	return fileDescriptor_event_510e986a741e9555, []int{4}
 }
 func (m *Target) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Target.Unmarshal(m, b)
@@ -307,7 +307,7 @@ func (m *EventMsg) Reset()         { *m = EventMsg{} }
 func (m *EventMsg) String() string { return proto.CompactTextString(m) }
 func (*EventMsg) ProtoMessage()    {}
 func (*EventMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{5}
+	return fileDescriptor_event_510e986a741e9555, []int{5}
 }
 func (m *EventMsg) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventMsg.Unmarshal(m, b)
@@ -408,7 +408,7 @@ func (m *EventResponse) Reset()         { *m = EventResponse{} }
 func (m *EventResponse) String() string { return proto.CompactTextString(m) }
 func (*EventResponse) ProtoMessage()    {}
 func (*EventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{6}
+	return fileDescriptor_event_510e986a741e9555, []int{6}
 }
 func (m *EventResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventResponse.Unmarshal(m, b)
@@ -446,7 +446,7 @@ func (m *PublishRequest) Reset()         { *m = PublishRequest{} }
 func (m *PublishRequest) String() string { return proto.CompactTextString(m) }
 func (*PublishRequest) ProtoMessage()    {}
 func (*PublishRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{7}
+	return fileDescriptor_event_510e986a741e9555, []int{7}
 }
 func (m *PublishRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishRequest.Unmarshal(m, b)
@@ -484,7 +484,7 @@ func (m *PublishResponse) Reset()         { *m = PublishResponse{} }
 func (m *PublishResponse) String() string { return proto.CompactTextString(m) }
 func (*PublishResponse) ProtoMessage()    {}
 func (*PublishResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{8}
+	return fileDescriptor_event_510e986a741e9555, []int{8}
 }
 func (m *PublishResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishResponse.Unmarshal(m, b)
@@ -511,65 +511,829 @@ func (m *PublishResponse) GetSuccess() bool {
 	return false
 }
 
+// Subscription registers a gRPC event handler for the events whose type
+// matches one of EventTypes. Types are event names or glob patterns such
+// as "scanJob*".
+type Subscription struct {
+	// chosen by the subscriber; subscribing again with the same ID replaces
+	// the subscription
+	ID         string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty" toml:"ID,omitempty" mapstructure:"ID,omitempty"`
+	EventTypes []string `protobuf:"bytes,2,rep,name=EventTypes,proto3" json:"EventTypes,omitempty" toml:"EventTypes,omitempty" mapstructure:"EventTypes,omitempty"`
+	// host:port of the handler
+	Target string `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty" toml:"Target,omitempty" mapstructure:"Target,omitempty"`
+	// one of feed, cfgingest, compliance_ingest, authz or event_handler
+	HandlerType string `protobuf:"bytes,4,opt,name=HandlerType,proto3" json:"HandlerType,omitempty" toml:"HandlerType,omitempty" mapstructure:"HandlerType,omitempty"`
+	// service name in the handler's certificate
+	ServiceName          string   `protobuf:"bytes,5,opt,name=ServiceName,proto3" json:"ServiceName,omitempty" toml:"ServiceName,omitempty" mapstructure:"ServiceName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{9}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+}
+func (dst *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(dst, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Subscription) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *Subscription) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *Subscription) GetHandlerType() string {
+	if m != nil {
+		return m.HandlerType
+	}
+	return ""
+}
+
+func (m *Subscription) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
 type SubscribeRequest struct {
+	Subscription         *Subscription `protobuf:"bytes,1,opt,name=Subscription,proto3" json:"Subscription,omitempty" toml:"Subscription,omitempty" mapstructure:"Subscription,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32         `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{10}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(dst, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetSubscription() *Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+	Subscription         *Subscription `protobuf:"bytes,1,opt,name=Subscription,proto3" json:"Subscription,omitempty" toml:"Subscription,omitempty" mapstructure:"Subscription,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32         `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{11}
+}
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+}
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(dst, src)
+}
+func (m *SubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeResponse.Size(m)
+}
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+
+func (m *SubscribeResponse) GetSubscription() *Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+type UnsubscribeRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty" toml:"ID,omitempty" mapstructure:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *UnsubscribeRequest) Reset()         { *m = UnsubscribeRequest{} }
+func (m *UnsubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeRequest) ProtoMessage()    {}
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{12}
+}
+func (m *UnsubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeRequest.Unmarshal(m, b)
+}
+func (m *UnsubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeRequest.Marshal(b, m, deterministic)
+}
+func (dst *UnsubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeRequest.Merge(dst, src)
+}
+func (m *UnsubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeRequest.Size(m)
+}
+func (m *UnsubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeRequest proto.InternalMessageInfo
+
+func (m *UnsubscribeRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type UnsubscribeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *UnsubscribeResponse) Reset()         { *m = UnsubscribeResponse{} }
+func (m *UnsubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeResponse) ProtoMessage()    {}
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{13}
+}
+func (m *UnsubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeResponse.Unmarshal(m, b)
+}
+func (m *UnsubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeResponse.Marshal(b, m, deterministic)
+}
+func (dst *UnsubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeResponse.Merge(dst, src)
+}
+func (m *UnsubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeResponse.Size(m)
+}
+func (m *UnsubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeResponse proto.InternalMessageInfo
+
+type ListSubscriptionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListSubscriptionsRequest) Reset()         { *m = ListSubscriptionsRequest{} }
+func (m *ListSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionsRequest) ProtoMessage()    {}
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{14}
+}
+func (m *ListSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionsRequest.Unmarshal(m, b)
+}
+func (m *ListSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionsRequest.Merge(dst, src)
+}
+func (m *ListSubscriptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionsRequest.Size(m)
+}
+func (m *ListSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscriptionsRequest proto.InternalMessageInfo
+
+type ListSubscriptionsResponse struct {
+	Subscriptions        []*Subscription `protobuf:"bytes,1,rep,name=Subscriptions,proto3" json:"Subscriptions,omitempty" toml:"Subscriptions,omitempty" mapstructure:"Subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte          `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32           `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListSubscriptionsResponse) Reset()         { *m = ListSubscriptionsResponse{} }
+func (m *ListSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionsResponse) ProtoMessage()    {}
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{15}
+}
+func (m *ListSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionsResponse.Unmarshal(m, b)
+}
+func (m *ListSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionsResponse.Merge(dst, src)
+}
+func (m *ListSubscriptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionsResponse.Size(m)
+}
+func (m *ListSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+// DeadLetter is an event that could not be delivered to the handler of a
+// subscription after exhausting its retries
+type DeadLetter struct {
+	ID                   string               `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty" toml:"ID,omitempty" mapstructure:"ID,omitempty"`
+	SubscriptionID       string               `protobuf:"bytes,2,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty" toml:"SubscriptionID,omitempty" mapstructure:"SubscriptionID,omitempty"`
+	Event                *EventMsg            `protobuf:"bytes,3,opt,name=Event,proto3" json:"Event,omitempty" toml:"Event,omitempty" mapstructure:"Event,omitempty"`
+	Attempts             int32                `protobuf:"varint,4,opt,name=Attempts,proto3" json:"Attempts,omitempty" toml:"Attempts,omitempty" mapstructure:"Attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,5,opt,name=LastError,proto3" json:"LastError,omitempty" toml:"LastError,omitempty" mapstructure:"LastError,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Created,proto3" json:"Created,omitempty" toml:"Created,omitempty" mapstructure:"Created,omitempty"`
+	LastAttempt          *timestamp.Timestamp `protobuf:"bytes,7,opt,name=LastAttempt,proto3" json:"LastAttempt,omitempty" toml:"LastAttempt,omitempty" mapstructure:"LastAttempt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{16}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetter.Unmarshal(m, b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+}
+func (dst *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(dst, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return xxx_messageInfo_DeadLetter.Size(m)
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetEvent() *EventMsg {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetLastAttempt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return nil
+}
+
+// ListDeadLettersRequest filters dead letters by subscription and event
+// type; empty fields match everything
+type ListDeadLettersRequest struct {
+	SubscriptionID       string   `protobuf:"bytes,1,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty" toml:"SubscriptionID,omitempty" mapstructure:"SubscriptionID,omitempty"`
+	EventType            string   `protobuf:"bytes,2,opt,name=EventType,proto3" json:"EventType,omitempty" toml:"EventType,omitempty" mapstructure:"EventType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListDeadLettersRequest) Reset()         { *m = ListDeadLettersRequest{} }
+func (m *ListDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeadLettersRequest) ProtoMessage()    {}
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{17}
+}
+func (m *ListDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *ListDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeadLettersRequest.Merge(dst, src)
+}
+func (m *ListDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeadLettersRequest.Size(m)
+}
+func (m *ListDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ListDeadLettersRequest) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *ListDeadLettersRequest) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+type ListDeadLettersResponse struct {
+	DeadLetters          []*DeadLetter `protobuf:"bytes,1,rep,name=DeadLetters,proto3" json:"DeadLetters,omitempty" toml:"DeadLetters,omitempty" mapstructure:"DeadLetters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte        `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32         `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ListDeadLettersResponse) Reset()         { *m = ListDeadLettersResponse{} }
+func (m *ListDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeadLettersResponse) ProtoMessage()    {}
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{18}
+}
+func (m *ListDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *ListDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeadLettersResponse.Merge(dst, src)
+}
+func (m *ListDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeadLettersResponse.Size(m)
+}
+func (m *ListDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeadLettersResponse proto.InternalMessageInfo
+
+func (m *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+type GetDeadLetterRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty" toml:"ID,omitempty" mapstructure:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *GetDeadLetterRequest) Reset()         { *m = GetDeadLetterRequest{} }
+func (m *GetDeadLetterRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeadLetterRequest) ProtoMessage()    {}
+func (*GetDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{19}
+}
+func (m *GetDeadLetterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeadLetterRequest.Unmarshal(m, b)
+}
+func (m *GetDeadLetterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeadLetterRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeadLetterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeadLetterRequest.Merge(dst, src)
+}
+func (m *GetDeadLetterRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeadLetterRequest.Size(m)
+}
+func (m *GetDeadLetterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeadLetterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeadLetterRequest proto.InternalMessageInfo
+
+func (m *GetDeadLetterRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type ReplayDeadLetterRequest struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty" toml:"ID,omitempty" mapstructure:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *ReplayDeadLetterRequest) Reset()         { *m = ReplayDeadLetterRequest{} }
+func (m *ReplayDeadLetterRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayDeadLetterRequest) ProtoMessage()    {}
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{20}
+}
+func (m *ReplayDeadLetterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplayDeadLetterRequest.Unmarshal(m, b)
+}
+func (m *ReplayDeadLetterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplayDeadLetterRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReplayDeadLetterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayDeadLetterRequest.Merge(dst, src)
+}
+func (m *ReplayDeadLetterRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplayDeadLetterRequest.Size(m)
+}
+func (m *ReplayDeadLetterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayDeadLetterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayDeadLetterRequest proto.InternalMessageInfo
+
+func (m *ReplayDeadLetterRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+type ReplayDeadLetterResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
 	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
-func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
-func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeRequest) ProtoMessage()    {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{9}
+func (m *ReplayDeadLetterResponse) Reset()         { *m = ReplayDeadLetterResponse{} }
+func (m *ReplayDeadLetterResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayDeadLetterResponse) ProtoMessage()    {}
+func (*ReplayDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{21}
+}
+func (m *ReplayDeadLetterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplayDeadLetterResponse.Unmarshal(m, b)
+}
+func (m *ReplayDeadLetterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplayDeadLetterResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReplayDeadLetterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayDeadLetterResponse.Merge(dst, src)
+}
+func (m *ReplayDeadLetterResponse) XXX_Size() int {
+	return xxx_messageInfo_ReplayDeadLetterResponse.Size(m)
+}
+func (m *ReplayDeadLetterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayDeadLetterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayDeadLetterResponse proto.InternalMessageInfo
+
+// EventRecord is a published event as kept in the event history, with the
+// outcome of its delivery to every subscribed handler
+type EventRecord struct {
+	Event                *EventMsg            `protobuf:"bytes,1,opt,name=Event,proto3" json:"Event,omitempty" toml:"Event,omitempty" mapstructure:"Event,omitempty"`
+	Received             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=Received,proto3" json:"Received,omitempty" toml:"Received,omitempty" mapstructure:"Received,omitempty"`
+	Deliveries           []*Delivery          `protobuf:"bytes,3,rep,name=Deliveries,proto3" json:"Deliveries,omitempty" toml:"Deliveries,omitempty" mapstructure:"Deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *EventRecord) Reset()         { *m = EventRecord{} }
+func (m *EventRecord) String() string { return proto.CompactTextString(m) }
+func (*EventRecord) ProtoMessage()    {}
+func (*EventRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{22}
+}
+func (m *EventRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventRecord.Unmarshal(m, b)
+}
+func (m *EventRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventRecord.Marshal(b, m, deterministic)
+}
+func (dst *EventRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecord.Merge(dst, src)
+}
+func (m *EventRecord) XXX_Size() int {
+	return xxx_messageInfo_EventRecord.Size(m)
+}
+func (m *EventRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecord proto.InternalMessageInfo
+
+func (m *EventRecord) GetEvent() *EventMsg {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *EventRecord) GetReceived() *timestamp.Timestamp {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
+func (m *EventRecord) GetDeliveries() []*Delivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+type Delivery struct {
+	SubscriptionID string `protobuf:"bytes,1,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty" toml:"SubscriptionID,omitempty" mapstructure:"SubscriptionID,omitempty"`
+	// delivered or dead_lettered
+	Status               string               `protobuf:"bytes,2,opt,name=Status,proto3" json:"Status,omitempty" toml:"Status,omitempty" mapstructure:"Status,omitempty"`
+	Attempts             int32                `protobuf:"varint,3,opt,name=Attempts,proto3" json:"Attempts,omitempty" toml:"Attempts,omitempty" mapstructure:"Attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,4,opt,name=LastError,proto3" json:"LastError,omitempty" toml:"LastError,omitempty" mapstructure:"LastError,omitempty"`
+	Completed            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=Completed,proto3" json:"Completed,omitempty" toml:"Completed,omitempty" mapstructure:"Completed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
+}
+
+func (m *Delivery) Reset()         { *m = Delivery{} }
+func (m *Delivery) String() string { return proto.CompactTextString(m) }
+func (*Delivery) ProtoMessage()    {}
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{23}
+}
+func (m *Delivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Delivery.Unmarshal(m, b)
+}
+func (m *Delivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Delivery.Marshal(b, m, deterministic)
+}
+func (dst *Delivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delivery.Merge(dst, src)
+}
+func (m *Delivery) XXX_Size() int {
+	return xxx_messageInfo_Delivery.Size(m)
+}
+func (m *Delivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delivery proto.InternalMessageInfo
+
+func (m *Delivery) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *Delivery) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Delivery) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Delivery) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
 }
-func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+
+func (m *Delivery) GetCompleted() *timestamp.Timestamp {
+	if m != nil {
+		return m.Completed
+	}
+	return nil
 }
-func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+
+// ListEventsRequest filters the event history; empty fields match
+// everything. Producer matches the producer ID or name. Start and End bound
+// the time the events were published.
+type ListEventsRequest struct {
+	EventType            string               `protobuf:"bytes,1,opt,name=EventType,proto3" json:"EventType,omitempty" toml:"EventType,omitempty" mapstructure:"EventType,omitempty"`
+	Producer             string               `protobuf:"bytes,2,opt,name=Producer,proto3" json:"Producer,omitempty" toml:"Producer,omitempty" mapstructure:"Producer,omitempty"`
+	Start                *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Start,proto3" json:"Start,omitempty" toml:"Start,omitempty" mapstructure:"Start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=End,proto3" json:"End,omitempty" toml:"End,omitempty" mapstructure:"End,omitempty"`
+	Page                 int32                `protobuf:"varint,5,opt,name=Page,proto3" json:"Page,omitempty" toml:"Page,omitempty" mapstructure:"Page,omitempty"`
+	PerPage              int32                `protobuf:"varint,6,opt,name=PerPage,proto3" json:"PerPage,omitempty" toml:"PerPage,omitempty" mapstructure:"PerPage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte               `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32                `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
-func (dst *SubscribeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeRequest.Merge(dst, src)
+
+func (m *ListEventsRequest) Reset()         { *m = ListEventsRequest{} }
+func (m *ListEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEventsRequest) ProtoMessage()    {}
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{24}
 }
-func (m *SubscribeRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeRequest.Size(m)
+func (m *ListEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsRequest.Unmarshal(m, b)
 }
-func (m *SubscribeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+func (m *ListEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsRequest.Merge(dst, src)
+}
+func (m *ListEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEventsRequest.Size(m)
+}
+func (m *ListEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListEventsRequest proto.InternalMessageInfo
 
-type SubscribeResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_unrecognized     []byte   `json:"-" toml:"-" mapstructure:"-,omitempty"`
-	XXX_sizecache        int32    `json:"-" toml:"-" mapstructure:"-,omitempty"`
+func (m *ListEventsRequest) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
 }
 
-func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
-func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
-func (*SubscribeResponse) ProtoMessage()    {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{10}
+func (m *ListEventsRequest) GetProducer() string {
+	if m != nil {
+		return m.Producer
+	}
+	return ""
 }
-func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+
+func (m *ListEventsRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
 }
-func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+
+func (m *ListEventsRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
 }
-func (dst *SubscribeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeResponse.Merge(dst, src)
+
+func (m *ListEventsRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
 }
-func (m *SubscribeResponse) XXX_Size() int {
-	return xxx_messageInfo_SubscribeResponse.Size(m)
+
+func (m *ListEventsRequest) GetPerPage() int32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
 }
-func (m *SubscribeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+
+type ListEventsResponse struct {
+	Events               []*EventRecord `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty" toml:"Events,omitempty" mapstructure:"Events,omitempty"`
+	Total                int64          `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty" toml:"Total,omitempty" mapstructure:"Total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_unrecognized     []byte         `json:"-" toml:"-" mapstructure:"-,omitempty"`
+	XXX_sizecache        int32          `json:"-" toml:"-" mapstructure:"-,omitempty"`
 }
 
-var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+func (m *ListEventsResponse) Reset()         { *m = ListEventsResponse{} }
+func (m *ListEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEventsResponse) ProtoMessage()    {}
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_event_510e986a741e9555, []int{25}
+}
+func (m *ListEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEventsResponse.Unmarshal(m, b)
+}
+func (m *ListEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEventsResponse.Merge(dst, src)
+}
+func (m *ListEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEventsResponse.Size(m)
+}
+func (m *ListEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEventsResponse proto.InternalMessageInfo
+
+func (m *ListEventsResponse) GetEvents() []*EventRecord {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *ListEventsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
 
 type StartRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" toml:"-" mapstructure:"-,omitempty"`
@@ -581,7 +1345,7 @@ func (m *StartRequest) Reset()         { *m = StartRequest{} }
 func (m *StartRequest) String() string { return proto.CompactTextString(m) }
 func (*StartRequest) ProtoMessage()    {}
 func (*StartRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{11}
+	return fileDescriptor_event_510e986a741e9555, []int{26}
 }
 func (m *StartRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartRequest.Unmarshal(m, b)
@@ -611,7 +1375,7 @@ func (m *StartResponse) Reset()         { *m = StartResponse{} }
 func (m *StartResponse) String() string { return proto.CompactTextString(m) }
 func (*StartResponse) ProtoMessage()    {}
 func (*StartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{12}
+	return fileDescriptor_event_510e986a741e9555, []int{27}
 }
 func (m *StartResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartResponse.Unmarshal(m, b)
@@ -641,7 +1405,7 @@ func (m *StopRequest) Reset()         { *m = StopRequest{} }
 func (m *StopRequest) String() string { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()    {}
 func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{13}
+	return fileDescriptor_event_510e986a741e9555, []int{28}
 }
 func (m *StopRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
@@ -671,7 +1435,7 @@ func (m *StopResponse) Reset()         { *m = StopResponse{} }
 func (m *StopResponse) String() string { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()    {}
 func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_event_9ce071600d5e809e, []int{14}
+	return fileDescriptor_event_510e986a741e9555, []int{29}
 }
 func (m *StopResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
@@ -701,8 +1465,23 @@ func init() {
 	proto.RegisterType((*EventResponse)(nil), "chef.automate.domain.event.api.EventResponse")
 	proto.RegisterType((*PublishRequest)(nil), "chef.automate.domain.event.api.PublishRequest")
 	proto.RegisterType((*PublishResponse)(nil), "chef.automate.domain.event.api.PublishResponse")
+	proto.RegisterType((*Subscription)(nil), "chef.automate.domain.event.api.Subscription")
 	proto.RegisterType((*SubscribeRequest)(nil), "chef.automate.domain.event.api.SubscribeRequest")
 	proto.RegisterType((*SubscribeResponse)(nil), "chef.automate.domain.event.api.SubscribeResponse")
+	proto.RegisterType((*UnsubscribeRequest)(nil), "chef.automate.domain.event.api.UnsubscribeRequest")
+	proto.RegisterType((*UnsubscribeResponse)(nil), "chef.automate.domain.event.api.UnsubscribeResponse")
+	proto.RegisterType((*ListSubscriptionsRequest)(nil), "chef.automate.domain.event.api.ListSubscriptionsRequest")
+	proto.RegisterType((*ListSubscriptionsResponse)(nil), "chef.automate.domain.event.api.ListSubscriptionsResponse")
+	proto.RegisterType((*DeadLetter)(nil), "chef.automate.domain.event.api.DeadLetter")
+	proto.RegisterType((*ListDeadLettersRequest)(nil), "chef.automate.domain.event.api.ListDeadLettersRequest")
+	proto.RegisterType((*ListDeadLettersResponse)(nil), "chef.automate.domain.event.api.ListDeadLettersResponse")
+	proto.RegisterType((*GetDeadLetterRequest)(nil), "chef.automate.domain.event.api.GetDeadLetterRequest")
+	proto.RegisterType((*ReplayDeadLetterRequest)(nil), "chef.automate.domain.event.api.ReplayDeadLetterRequest")
+	proto.RegisterType((*ReplayDeadLetterResponse)(nil), "chef.automate.domain.event.api.ReplayDeadLetterResponse")
+	proto.RegisterType((*EventRecord)(nil), "chef.automate.domain.event.api.EventRecord")
+	proto.RegisterType((*Delivery)(nil), "chef.automate.domain.event.api.Delivery")
+	proto.RegisterType((*ListEventsRequest)(nil), "chef.automate.domain.event.api.ListEventsRequest")
+	proto.RegisterType((*ListEventsResponse)(nil), "chef.automate.domain.event.api.ListEventsResponse")
 	proto.RegisterType((*StartRequest)(nil), "chef.automate.domain.event.api.StartRequest")
 	proto.RegisterType((*StartResponse)(nil), "chef.automate.domain.event.api.StartResponse")
 	proto.RegisterType((*StopRequest)(nil), "chef.automate.domain.event.api.StopRequest")
@@ -723,6 +1502,12 @@ const _ = grpc.SupportPackageIsVersion4
 type EventServiceClient interface {
 	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetter, error)
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error)
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopResponse, error)
 }
@@ -753,6 +1538,60 @@ func (c *eventServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest
 	return out, nil
 }
 
+func (c *eventServiceClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventService/Unsubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventService/ListSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventService/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*DeadLetter, error) {
+	out := new(DeadLetter)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventService/GetDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*ReplayDeadLetterResponse, error) {
+	out := new(ReplayDeadLetterResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventService/ReplayDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventService/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
 	out := new(StartResponse)
 	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventService/Start", in, out, opts...)
@@ -775,6 +1614,12 @@ func (c *eventServiceClient) Stop(ctx context.Context, in *StopRequest, opts ...
 type EventServiceServer interface {
 	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
 	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	GetDeadLetter(context.Context, *GetDeadLetterRequest) (*DeadLetter, error)
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*ReplayDeadLetterResponse, error)
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	Start(context.Context, *StartRequest) (*StartResponse, error)
 	Stop(context.Context, *StopRequest) (*StopResponse, error)
 }
@@ -819,6 +1664,114 @@ func _EventService_Subscribe_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.event.api.EventService/Unsubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.event.api.EventService/ListSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.event.api.EventService/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.event.api.EventService/GetDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetDeadLetter(ctx, req.(*GetDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.event.api.EventService/ReplayDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.event.api.EventService/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EventService_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartRequest)
 	if err := dec(in); err != nil {
@@ -867,6 +1820,30 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Subscribe",
 			Handler:    _EventService_Subscribe_Handler,
 		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _EventService_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _EventService_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _EventService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "GetDeadLetter",
+			Handler:    _EventService_GetDeadLetter_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _EventService_ReplayDeadLetter_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _EventService_ListEvents_Handler,
+		},
 		{
 			MethodName: "Start",
 			Handler:    _EventService_Start_Handler,
@@ -880,49 +1857,152 @@ var _EventService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "api/interservice/event/event.proto",
 }
 
+// EventHandlerServiceClient is the client API for EventHandlerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventHandlerServiceClient interface {
+	HandleEvent(ctx context.Context, in *EventMsg, opts ...grpc.CallOption) (*EventResponse, error)
+}
+
+type eventHandlerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventHandlerServiceClient(cc *grpc.ClientConn) EventHandlerServiceClient {
+	return &eventHandlerServiceClient{cc}
+}
+
+func (c *eventHandlerServiceClient) HandleEvent(ctx context.Context, in *EventMsg, opts ...grpc.CallOption) (*EventResponse, error) {
+	out := new(EventResponse)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.event.api.EventHandlerService/HandleEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventHandlerServiceServer is the server API for EventHandlerService service.
+type EventHandlerServiceServer interface {
+	HandleEvent(context.Context, *EventMsg) (*EventResponse, error)
+}
+
+func RegisterEventHandlerServiceServer(s *grpc.Server, srv EventHandlerServiceServer) {
+	s.RegisterService(&_EventHandlerService_serviceDesc, srv)
+}
+
+func _EventHandlerService_HandleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventHandlerServiceServer).HandleEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.event.api.EventHandlerService/HandleEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventHandlerServiceServer).HandleEvent(ctx, req.(*EventMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventHandlerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chef.automate.domain.event.api.EventHandlerService",
+	HandlerType: (*EventHandlerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleEvent",
+			Handler:    _EventHandlerService_HandleEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/interservice/event/event.proto",
+}
+
 func init() {
-	proto.RegisterFile("api/interservice/event/event.proto", fileDescriptor_event_9ce071600d5e809e)
-}
-
-var fileDescriptor_event_9ce071600d5e809e = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0x56, 0x1b, 0xb7, 0x4d, 0x26, 0x7d, 0xc0, 0x72, 0xc0, 0xb2, 0x50, 0x5b, 0x59, 0x02, 0xb5,
-	0x4a, 0xb1, 0x69, 0xb8, 0x20, 0x10, 0x95, 0x40, 0xe1, 0x50, 0x89, 0x42, 0xb5, 0x8e, 0x90, 0xe8,
-	0x6d, 0xed, 0x6c, 0x1d, 0x57, 0x71, 0x6c, 0xbc, 0xeb, 0x48, 0xfd, 0x61, 0xfc, 0x34, 0xee, 0xc8,
-	0xb3, 0xbb, 0x4e, 0xd2, 0x56, 0x38, 0x1c, 0x7a, 0x89, 0xf6, 0xf1, 0x3d, 0xc6, 0x33, 0x9f, 0x63,
-	0x70, 0x59, 0x9e, 0xf8, 0xc9, 0x54, 0xf2, 0x42, 0xf0, 0x62, 0x96, 0x44, 0xdc, 0xe7, 0x33, 0x3e,
-	0x95, 0xea, 0xd7, 0xcb, 0x8b, 0x4c, 0x66, 0x64, 0x3f, 0x1a, 0xf3, 0x6b, 0x8f, 0x95, 0x32, 0x4b,
-	0x99, 0xe4, 0xde, 0x28, 0x4b, 0x59, 0x32, 0xf5, 0x14, 0x82, 0xe5, 0x89, 0x73, 0x10, 0x67, 0x59,
-	0x3c, 0xe1, 0x3e, 0xa2, 0xc3, 0xf2, 0xda, 0x97, 0x49, 0xca, 0x85, 0x64, 0x69, 0xae, 0x04, 0x9c,
-	0x17, 0x77, 0x01, 0x42, 0x16, 0x65, 0xa4, 0xe5, 0xdd, 0x03, 0xe8, 0x7c, 0xa9, 0xb4, 0x86, 0xb7,
-	0x39, 0x27, 0x04, 0xac, 0x6f, 0x2c, 0xe5, 0xf6, 0xda, 0xe1, 0xda, 0x51, 0x87, 0xe2, 0xda, 0x9d,
-	0x41, 0xfb, 0xb2, 0xc8, 0x46, 0x65, 0xc4, 0x0b, 0xb2, 0x0b, 0xeb, 0xe7, 0x03, 0x7d, 0xbb, 0x7e,
-	0x3e, 0x20, 0x2e, 0x6c, 0x9b, 0x3b, 0xe4, 0xad, 0xe3, 0xcd, 0xd2, 0xd9, 0x22, 0xa6, 0xf2, 0xb0,
-	0x5b, 0xcb, 0x18, 0xe3, 0x3b, 0x64, 0xb1, 0xb0, 0xad, 0xc3, 0x56, 0xe5, 0x5b, 0xad, 0xdd, 0x9f,
-	0xb0, 0xf1, 0x29, 0x92, 0xd9, 0x7d, 0xd3, 0x7d, 0x80, 0xef, 0xe1, 0x0d, 0x8f, 0xb0, 0x64, 0x6d,
-	0xb9, 0x70, 0x42, 0x0e, 0xa1, 0x3b, 0x48, 0x44, 0x3e, 0x61, 0xb7, 0x58, 0x93, 0xf2, 0x5b, 0x3c,
-	0x72, 0xaf, 0x60, 0x53, 0xe1, 0x1f, 0x47, 0x7b, 0xc8, 0x8a, 0x98, 0x3f, 0x86, 0xf6, 0x9f, 0x16,
-	0xb4, 0x71, 0x58, 0x17, 0x22, 0x26, 0x36, 0x6c, 0xe1, 0xba, 0xf6, 0x30, 0x5b, 0xf2, 0x11, 0xac,
-	0xda, 0xa2, 0xdb, 0x3f, 0xf6, 0xfe, 0x1d, 0x20, 0xaf, 0x1e, 0x3f, 0x45, 0x1a, 0x19, 0xcc, 0x07,
-	0x8e, 0x45, 0x74, 0xfb, 0x47, 0x4d, 0x12, 0x06, 0x4f, 0xe7, 0x51, 0x79, 0x60, 0xa4, 0xe4, 0x1d,
-	0x74, 0x2e, 0xcb, 0x70, 0x92, 0x88, 0x31, 0x1f, 0xd9, 0x1b, 0x28, 0xed, 0x78, 0x2a, 0x9d, 0x9e,
-	0x49, 0xa7, 0x37, 0x34, 0xf1, 0xa5, 0x73, 0x30, 0xf9, 0xa0, 0xc3, 0x60, 0x6f, 0x22, 0xeb, 0x65,
-	0x53, 0x41, 0x08, 0xa6, 0x3a, 0x40, 0x04, 0xac, 0x1f, 0xbc, 0x08, 0xed, 0x2d, 0x95, 0xea, 0x6a,
-	0x4d, 0xce, 0x4c, 0x04, 0xec, 0x36, 0x2a, 0xbe, 0x6a, 0x52, 0x54, 0x68, 0x6a, 0x82, 0x73, 0x66,
-	0xc6, 0x6c, 0x77, 0x56, 0xe3, 0x2b, 0x34, 0x35, 0xe1, 0xe8, 0x81, 0x35, 0x62, 0x92, 0xd9, 0x80,
-	0xec, 0xe7, 0xf7, 0xba, 0x10, 0xe0, 0x3b, 0x4a, 0x11, 0xe4, 0x1e, 0xc3, 0x0e, 0x0e, 0x89, 0x72,
-	0x91, 0x67, 0x53, 0xc1, 0xab, 0xd9, 0x07, 0x65, 0x14, 0x71, 0x21, 0x70, 0xf6, 0x6d, 0x6a, 0xb6,
-	0xee, 0x57, 0xd8, 0xd5, 0x5d, 0xa3, 0xfc, 0x57, 0xc9, 0x85, 0x24, 0xef, 0xa1, 0x75, 0x21, 0x62,
-	0xc4, 0xad, 0x30, 0x49, 0x13, 0x2f, 0x5a, 0x91, 0xdc, 0x1e, 0xec, 0xd5, 0x6a, 0x8d, 0xd6, 0x04,
-	0x9e, 0x04, 0x65, 0x28, 0xa2, 0x22, 0x09, 0xb9, 0x36, 0x77, 0x9f, 0xc1, 0xd3, 0x85, 0x33, 0x25,
-	0xe1, 0xee, 0xc2, 0x76, 0x20, 0x59, 0x21, 0x0d, 0x68, 0x0f, 0x76, 0xf4, 0x5e, 0x03, 0x76, 0xa0,
-	0x1b, 0xc8, 0x2c, 0x37, 0xf7, 0x88, 0xaf, 0xb6, 0xea, 0xba, 0xff, 0xbb, 0x05, 0xdb, 0x58, 0x67,
-	0xa0, 0xfe, 0x34, 0xc9, 0x0d, 0x6c, 0xe9, 0x32, 0x89, 0xd7, 0x18, 0xd5, 0xa5, 0xee, 0x38, 0xfe,
-	0xca, 0x78, 0xfd, 0xfc, 0x39, 0x74, 0xea, 0x27, 0x22, 0x6f, 0x9a, 0xd8, 0x77, 0x1b, 0xe2, 0x9c,
-	0xfe, 0x07, 0x43, 0x3b, 0x8e, 0x60, 0x03, 0xdb, 0x43, 0x4e, 0x1a, 0xb9, 0x0b, 0x5d, 0x75, 0x5e,
-	0xaf, 0x88, 0xd6, 0x2e, 0x0c, 0xac, 0xaa, 0xc9, 0xa4, 0xd7, 0x4c, 0xab, 0x27, 0xe3, 0x9c, 0xac,
-	0x06, 0x56, 0x16, 0x9f, 0x4f, 0xaf, 0xfc, 0x38, 0x91, 0xe3, 0x32, 0xf4, 0xa2, 0x2c, 0xf5, 0x2b,
-	0xa6, 0x6f, 0x98, 0xfe, 0xc3, 0x1f, 0xc2, 0x70, 0x13, 0x5f, 0x88, 0xb7, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x48, 0xc8, 0xd6, 0x6e, 0x29, 0x07, 0x00, 0x00,
+	proto.RegisterFile("api/interservice/event/event.proto", fileDescriptor_event_510e986a741e9555)
+}
+
+var fileDescriptor_event_510e986a741e9555 = []byte{
+	// 1241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5d, 0x6e, 0xdb, 0x46,
+	0x10, 0x06, 0xf5, 0x67, 0x69, 0x64, 0x3b, 0xc9, 0x26, 0x8d, 0x59, 0xc2, 0x48, 0x84, 0x45, 0x6b,
+	0xc4, 0xb5, 0x43, 0xc5, 0x4e, 0x90, 0xba, 0x7f, 0x06, 0x52, 0xcb, 0x68, 0x0c, 0x38, 0xad, 0xb1,
+	0x72, 0x0b, 0x34, 0x0f, 0x05, 0x28, 0x72, 0x2d, 0x33, 0x90, 0x44, 0x86, 0xbb, 0x12, 0xe0, 0x87,
+	0xbe, 0x14, 0x28, 0xd0, 0x33, 0xf4, 0x0e, 0x7d, 0xe9, 0x01, 0x7a, 0x85, 0x1e, 0xa0, 0xe7, 0xe8,
+	0x7b, 0xc1, 0xfd, 0xa1, 0x48, 0x49, 0x36, 0xa9, 0xa0, 0x79, 0x31, 0xb8, 0xb3, 0xdf, 0xcc, 0xec,
+	0xce, 0x7c, 0x33, 0xb3, 0x32, 0x60, 0x27, 0xf4, 0xdb, 0xfe, 0x88, 0xd3, 0x88, 0xd1, 0x68, 0xe2,
+	0xbb, 0xb4, 0x4d, 0x27, 0x74, 0xc4, 0xe5, 0x5f, 0x3b, 0x8c, 0x02, 0x1e, 0xa0, 0x07, 0xee, 0x25,
+	0xbd, 0xb0, 0x9d, 0x31, 0x0f, 0x86, 0x0e, 0xa7, 0xb6, 0x17, 0x0c, 0x1d, 0x7f, 0x64, 0x4b, 0x84,
+	0x13, 0xfa, 0xd6, 0xc3, 0x7e, 0x10, 0xf4, 0x07, 0xb4, 0x2d, 0xd0, 0xbd, 0xf1, 0x45, 0x9b, 0xfb,
+	0x43, 0xca, 0xb8, 0x33, 0x0c, 0xa5, 0x01, 0x6b, 0x73, 0x16, 0xc0, 0x78, 0x34, 0x76, 0x95, 0x79,
+	0xfc, 0x10, 0x1a, 0xc7, 0xb1, 0xad, 0xf3, 0xab, 0x90, 0x22, 0x04, 0x95, 0x6f, 0x9d, 0x21, 0x35,
+	0x8d, 0x96, 0xf1, 0xa8, 0x41, 0xc4, 0x37, 0x9e, 0x40, 0xfd, 0x2c, 0x0a, 0xbc, 0xb1, 0x4b, 0x23,
+	0xb4, 0x0e, 0xa5, 0x93, 0x8e, 0xda, 0x2d, 0x9d, 0x74, 0x10, 0x86, 0x55, 0xbd, 0x27, 0xf4, 0x4a,
+	0x62, 0x27, 0x23, 0x4b, 0x63, 0x62, 0x1f, 0x66, 0x39, 0x8b, 0xd1, 0x7e, 0xcf, 0x9d, 0x3e, 0x33,
+	0x2b, 0xad, 0x72, 0xec, 0x37, 0xfe, 0xc6, 0x3f, 0x42, 0xf5, 0x85, 0xcb, 0x83, 0x79, 0xa7, 0x0f,
+	0x00, 0xbe, 0xeb, 0xbd, 0xa1, 0xae, 0x38, 0xb2, 0x72, 0x99, 0x92, 0xa0, 0x16, 0x34, 0x3b, 0x3e,
+	0x0b, 0x07, 0xce, 0x95, 0x38, 0x93, 0xf4, 0x97, 0x16, 0xe1, 0xd7, 0x50, 0x93, 0xf8, 0xf7, 0x63,
+	0xfb, 0xdc, 0x89, 0xfa, 0xf4, 0x7d, 0xd8, 0xfe, 0xb7, 0x0c, 0x75, 0x91, 0xac, 0x57, 0xac, 0x8f,
+	0x4c, 0x58, 0x11, 0xdf, 0x89, 0x0f, 0xbd, 0x44, 0x5f, 0x41, 0x25, 0x71, 0xd1, 0xdc, 0xdf, 0xb6,
+	0x6f, 0x26, 0x90, 0x9d, 0xa4, 0x9f, 0x08, 0x35, 0xd4, 0x99, 0x26, 0x5c, 0x1c, 0xa2, 0xb9, 0xff,
+	0x28, 0xcf, 0x84, 0xc6, 0x93, 0x29, 0x55, 0x16, 0xa4, 0x14, 0x1d, 0x40, 0xe3, 0x6c, 0xdc, 0x1b,
+	0xf8, 0xec, 0x92, 0x7a, 0x66, 0x55, 0x98, 0xb6, 0x6c, 0xc9, 0x4e, 0x5b, 0xb3, 0xd3, 0x3e, 0xd7,
+	0xf4, 0x25, 0x53, 0x30, 0xfa, 0x42, 0x91, 0xc1, 0xac, 0x09, 0xad, 0x8f, 0xf3, 0x0e, 0x24, 0xc0,
+	0x44, 0x11, 0x08, 0x41, 0xe5, 0x07, 0x1a, 0xf5, 0xcc, 0x15, 0xc9, 0xea, 0xf8, 0x1b, 0x1d, 0x6a,
+	0x0a, 0x98, 0x75, 0x61, 0x71, 0x2b, 0xcf, 0xa2, 0x44, 0x13, 0x4d, 0x9c, 0x43, 0x9d, 0x66, 0xb3,
+	0x51, 0x4c, 0x5f, 0xa2, 0x89, 0x26, 0xc7, 0x0e, 0x54, 0x3c, 0x87, 0x3b, 0x26, 0x08, 0xed, 0x8d,
+	0xb9, 0x28, 0x74, 0x45, 0x8d, 0x12, 0x01, 0xc2, 0xdb, 0xb0, 0x26, 0x92, 0x44, 0x28, 0x0b, 0x83,
+	0x11, 0xa3, 0x71, 0xee, 0xbb, 0x63, 0xd7, 0xa5, 0x8c, 0x89, 0xdc, 0xd7, 0x89, 0x5e, 0xe2, 0x53,
+	0x58, 0x57, 0x51, 0x23, 0xf4, 0xed, 0x98, 0x32, 0x8e, 0x3e, 0x87, 0xf2, 0x2b, 0xd6, 0x37, 0x8d,
+	0x62, 0x99, 0xd4, 0xf4, 0x22, 0xb1, 0x12, 0xde, 0x81, 0x5b, 0x89, 0xb5, 0x5c, 0xd7, 0xbf, 0x1b,
+	0xb0, 0xda, 0x1d, 0xf7, 0x98, 0x1b, 0xf9, 0x21, 0xf7, 0x83, 0xd1, 0xa2, 0x02, 0x48, 0xb8, 0xc6,
+	0xcc, 0x92, 0x20, 0x46, 0x4a, 0x82, 0xee, 0x27, 0x31, 0x95, 0xdc, 0xd7, 0xb1, 0x6a, 0x41, 0xf3,
+	0xa5, 0x33, 0xf2, 0x06, 0xaa, 0x81, 0x54, 0x64, 0x61, 0xa4, 0x44, 0x31, 0xa2, 0x2b, 0x1b, 0xa8,
+	0x28, 0x9d, 0xaa, 0x44, 0xa4, 0x44, 0xd8, 0x83, 0xdb, 0xea, 0x6c, 0x3d, 0xaa, 0x23, 0x73, 0x96,
+	0x3d, 0xaf, 0x0a, 0xd1, 0x6e, 0x5e, 0x88, 0xd2, 0x3a, 0x24, 0x63, 0x01, 0x53, 0xb8, 0x93, 0xf2,
+	0xa2, 0x22, 0xf6, 0xff, 0xbb, 0xf9, 0x08, 0xd0, 0xf7, 0x23, 0x36, 0x7b, 0x9d, 0x99, 0x70, 0xe3,
+	0x0f, 0xe0, 0x6e, 0x06, 0x25, 0x8f, 0x83, 0x2d, 0x30, 0x4f, 0x7d, 0xc6, 0xd3, 0x06, 0x99, 0x32,
+	0x81, 0x03, 0xf8, 0x70, 0xc1, 0x9e, 0xba, 0x07, 0x81, 0xb5, 0xcc, 0x86, 0x69, 0xb4, 0xca, 0x4b,
+	0x5f, 0x24, 0x6b, 0x02, 0xff, 0x59, 0x02, 0xe8, 0x50, 0xc7, 0x3b, 0xa5, 0x9c, 0x2f, 0x98, 0x2f,
+	0x5b, 0xb0, 0x9e, 0xc6, 0x9f, 0x74, 0x54, 0xdb, 0x9c, 0x91, 0xa2, 0x43, 0xa8, 0x0a, 0x1e, 0x15,
+	0xed, 0x57, 0x09, 0xcb, 0xa5, 0x1a, 0xb2, 0xa0, 0xfe, 0x82, 0x73, 0x3a, 0x0c, 0x39, 0x13, 0xf4,
+	0xaa, 0x92, 0x64, 0x8d, 0x36, 0xa1, 0x71, 0xea, 0x30, 0x7e, 0x1c, 0x45, 0x41, 0xa4, 0x98, 0x35,
+	0x15, 0xa0, 0x67, 0xb0, 0x72, 0x14, 0x51, 0x87, 0x53, 0xcf, 0xac, 0xe5, 0x36, 0x34, 0x0d, 0x45,
+	0x5f, 0x42, 0x33, 0x36, 0xa1, 0x7c, 0x98, 0x2b, 0xb9, 0x9a, 0x69, 0x38, 0xfe, 0x09, 0xee, 0xc7,
+	0x59, 0x9a, 0xc6, 0x4d, 0xe7, 0x6f, 0x41, 0xbc, 0x8c, 0x85, 0xf1, 0xda, 0x4c, 0x0d, 0x7d, 0x15,
+	0xd2, 0xa9, 0x00, 0xf7, 0x61, 0x63, 0xce, 0xbe, 0xe2, 0xc0, 0x29, 0x34, 0x53, 0x62, 0xc5, 0x80,
+	0x4f, 0xf2, 0xc2, 0x3d, 0x55, 0x21, 0x69, 0x75, 0xbc, 0x05, 0xf7, 0xbe, 0xa1, 0x29, 0x3f, 0xd7,
+	0x31, 0x79, 0x1b, 0x36, 0x08, 0x8d, 0xa7, 0x60, 0x3e, 0xd4, 0x02, 0x73, 0x1e, 0xaa, 0x98, 0xff,
+	0xb7, 0x01, 0x4d, 0xd5, 0x47, 0xdd, 0x20, 0xf2, 0xa6, 0xac, 0x31, 0xde, 0x8d, 0x35, 0xcf, 0xa1,
+	0x4e, 0xa8, 0x4b, 0xfd, 0x09, 0xf5, 0xcc, 0x52, 0x6e, 0x0a, 0x13, 0x2c, 0x7a, 0x19, 0x73, 0x7e,
+	0xe0, 0x4f, 0x68, 0xe4, 0x53, 0x66, 0x96, 0x5b, 0xe5, 0x22, 0xce, 0x95, 0xc6, 0x15, 0x49, 0xe9,
+	0xe2, 0xbf, 0x0c, 0xa8, 0xeb, 0x8d, 0xc2, 0xc9, 0xbf, 0x0f, 0xb5, 0x2e, 0x77, 0xf8, 0x98, 0xa9,
+	0xcc, 0xab, 0x55, 0xa6, 0x08, 0xca, 0x37, 0x15, 0x41, 0x65, 0xb6, 0x08, 0x0e, 0xa0, 0x71, 0x14,
+	0x0c, 0xc3, 0x01, 0xe5, 0xc5, 0xe6, 0x7a, 0x02, 0xc6, 0xff, 0x18, 0x70, 0x27, 0xe6, 0x9a, 0x08,
+	0x68, 0x42, 0xe3, 0x0c, 0x3d, 0x8d, 0x19, 0x7a, 0xc6, 0xe7, 0x4c, 0xde, 0x27, 0xf2, 0x06, 0xc9,
+	0x1a, 0x3d, 0x81, 0x6a, 0x97, 0x3b, 0x91, 0x6e, 0x04, 0x37, 0x9d, 0x42, 0x02, 0xd1, 0x2e, 0x94,
+	0x8f, 0x47, 0x9e, 0x59, 0xc9, 0xc5, 0xc7, 0xb0, 0xf8, 0x29, 0x71, 0xe6, 0xf4, 0xe5, 0x84, 0xa9,
+	0x12, 0xf1, 0x1d, 0x4f, 0xc4, 0x33, 0x1a, 0x09, 0x71, 0x4d, 0x88, 0xf5, 0x12, 0x07, 0x80, 0xd2,
+	0x97, 0x53, 0x35, 0x74, 0x04, 0x35, 0x29, 0x51, 0xe5, 0xb3, 0x53, 0x88, 0x77, 0x92, 0xb3, 0x44,
+	0xa9, 0xa2, 0x7b, 0x50, 0x3d, 0x0f, 0xb8, 0x33, 0x10, 0x11, 0x28, 0x13, 0xb9, 0xc0, 0xeb, 0xb0,
+	0x2a, 0x6e, 0xa5, 0xfb, 0xf9, 0x2d, 0x58, 0x53, 0x6b, 0x55, 0x02, 0x6b, 0xd0, 0xec, 0xf2, 0x20,
+	0xd4, 0xfb, 0x02, 0x1f, 0x84, 0x7a, 0x7b, 0xff, 0x8f, 0x06, 0xac, 0x0a, 0x07, 0x6a, 0x74, 0xa2,
+	0x37, 0xb0, 0xa2, 0x1e, 0x00, 0xc8, 0xce, 0x7d, 0x04, 0x66, 0xde, 0x1d, 0x56, 0xbb, 0x30, 0x5e,
+	0xc5, 0x25, 0x84, 0x46, 0x32, 0x3c, 0xd1, 0x93, 0x82, 0x53, 0x25, 0x19, 0x7f, 0xd6, 0xde, 0x12,
+	0x1a, 0xca, 0xe3, 0x04, 0x9a, 0xa9, 0x09, 0x89, 0xf6, 0xf3, 0x2c, 0xcc, 0x0f, 0x5d, 0xeb, 0xe9,
+	0x52, 0x3a, 0xca, 0xef, 0x6f, 0x8a, 0xf5, 0x99, 0x59, 0x88, 0x0e, 0xf2, 0x4c, 0x5d, 0x37, 0xb6,
+	0xad, 0xcf, 0xde, 0x41, 0x53, 0x1d, 0xe5, 0x17, 0x03, 0x6e, 0xcd, 0x34, 0x7b, 0xf4, 0xbc, 0x88,
+	0xb9, 0xf9, 0xe9, 0x63, 0x7d, 0xba, 0xb4, 0x9e, 0x3a, 0xc4, 0x5b, 0x58, 0xcb, 0xcc, 0x01, 0xf4,
+	0x2c, 0xcf, 0xd2, 0xa2, 0xb1, 0x61, 0x2d, 0x31, 0x87, 0xd0, 0xaf, 0x06, 0xdc, 0x9e, 0x1d, 0x14,
+	0x28, 0xf7, 0x02, 0xd7, 0x4c, 0x21, 0xeb, 0x60, 0x79, 0x45, 0x75, 0x75, 0x06, 0x30, 0x6d, 0x11,
+	0x68, 0xaf, 0x48, 0x04, 0x33, 0xbd, 0xd2, 0xda, 0x5f, 0x46, 0x45, 0x39, 0xf5, 0x40, 0x37, 0xbf,
+	0xdc, 0x9a, 0x49, 0x75, 0x13, 0xeb, 0x71, 0x41, 0xb4, 0xf2, 0xe2, 0x40, 0x25, 0x6e, 0x2e, 0x68,
+	0x27, 0x5f, 0x2d, 0xe9, 0x48, 0xd6, 0x6e, 0x31, 0xb0, 0xea, 0x57, 0x3f, 0xc3, 0x5d, 0x71, 0x35,
+	0xf5, 0x5b, 0x40, 0x77, 0xad, 0x0b, 0xfd, 0x83, 0x41, 0x6c, 0xa2, 0xc2, 0x83, 0xdd, 0x7a, 0x5c,
+	0x08, 0xa9, 0xdd, 0x7f, 0xbd, 0xf7, 0xba, 0xdd, 0xf7, 0xf9, 0xe5, 0xb8, 0x67, 0xbb, 0xc1, 0xb0,
+	0x1d, 0xab, 0xb6, 0xb5, 0x6a, 0x7b, 0xf1, 0x7f, 0x76, 0x7a, 0x35, 0x31, 0x59, 0x9e, 0xfe, 0x37,
+	0x00, 0xb1, 0xab, 0x15, 0x2e, 0xfa, 0x11, 0x00, 0x00,
 }
@@ -0,0 +1,157 @@
+package clientcert
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/chef/automate/components/authn-service/authenticator"
+	"github.com/chef/automate/lib/tls/certs"
+)
+
+// defaultHeader is where automate-gateway passes on the certificate the client
+// has presented when sending its request
+const defaultHeader = "x-client-cert"
+
+// Config is used for configuring client certificate authenticators
+type Config struct {
+	// Header carries the client's certificate, URL-escaped PEM
+	Header string `json:"header"`
+	// RootCA is the PEM of the CA certificates client certificates are
+	// verified with; RootCAPath is the path of a file containing it
+	RootCA     string `json:"root_ca"`
+	RootCAPath string `json:"root_ca_path"`
+	// Teams assigns the requestors authenticated by the certificates with the
+	// given names to teams
+	Teams []TeamMapping `json:"teams"`
+}
+
+// TeamMapping assigns the requestor authenticated by the certificate with the
+// given name to teams
+type TeamMapping struct {
+	Name  string   `json:"name"`
+	Teams []string `json:"teams"`
+}
+
+// Authenticator holds the state of a client certificate authenticator
+type Authenticator struct {
+	header string
+	roots  *x509.CertPool
+	teams  map[string][]string
+	logger *zap.Logger
+}
+
+type requestor struct {
+	name  string
+	teams []string
+}
+
+func (r *requestor) Subject() string {
+	return "cert:" + r.name
+}
+
+func (r *requestor) Teams() []string {
+	teams := make([]string, len(r.teams))
+	for i, team := range r.teams {
+		teams[i] = "team:cert:" + team
+	}
+	return teams
+}
+
+// Open returns a client certificate authenticator
+func (c *Config) Open(_ *url.URL, _ *certs.ServiceCerts, logger *zap.Logger) (authenticator.Authenticator, error) {
+	rootCA := []byte(c.RootCA)
+	if c.RootCAPath != "" {
+		var err error
+		rootCA, err = ioutil.ReadFile(c.RootCAPath)
+		if err != nil {
+			return nil, fmt.Errorf("read client certificate root CA: %v", err)
+		}
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(rootCA) {
+		return nil, errors.New("no client certificate root CA certificates found")
+	}
+
+	header := c.Header
+	if header == "" {
+		header = defaultHeader
+	}
+
+	teams := make(map[string][]string, len(c.Teams))
+	for _, m := range c.Teams {
+		teams[m.Name] = append(teams[m.Name], m.Teams...)
+	}
+
+	return &Authenticator{
+		header: header,
+		roots:  roots,
+		teams:  teams,
+		logger: logger,
+	}, nil
+}
+
+// Authenticate processes the passed request, checking if it carries a client
+// certificate issued by the configured CA. It is up to automate-gateway to
+// only pass on certificates that the client has proven to own in the TLS
+// handshake.
+func (a *Authenticator) Authenticate(r *http.Request) (authenticator.Requestor, error) {
+	value := r.Header.Get(a.header)
+	if value == "" {
+		return nil, errors.New("client-cert-authenticator: no certificate in request")
+	}
+	cert, err := parseCert(value)
+	if err != nil {
+		return nil, fmt.Errorf("client-cert-authenticator: %v", err)
+	}
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:     a.roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("client-cert-authenticator: verify certificate: %v", err)
+	}
+	name := certName(cert)
+	if name == "" {
+		return nil, errors.New("client-cert-authenticator: certificate has no subject name")
+	}
+	// ":" and "*" have special meaning in policy members
+	if strings.ContainsAny(name, ":*") {
+		return nil, fmt.Errorf("client-cert-authenticator: invalid certificate subject name %q", name)
+	}
+
+	return &requestor{name: name, teams: a.teams[name]}, nil
+}
+
+func parseCert(escaped string) (*x509.Certificate, error) {
+	bs, err := url.QueryUnescape(escaped)
+	if err != nil {
+		return nil, fmt.Errorf("decode certificate: %v", err)
+	}
+	block, _ := pem.Decode([]byte(bs))
+	if block == nil {
+		return nil, errors.New("decode certificate: no PEM data")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// certName returns the name the certificate was issued for: its subject's
+// common name, or its first subject alternative name if it has none
+func certName(cert *x509.Certificate) string {
+	switch {
+	case cert.Subject.CommonName != "":
+		return cert.Subject.CommonName
+	case len(cert.DNSNames) > 0:
+		return cert.DNSNames[0]
+	case len(cert.EmailAddresses) > 0:
+		return cert.EmailAddresses[0]
+	}
+	return ""
+}
@@ -0,0 +1,183 @@
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func TestClientCertAuthenticator(t *testing.T) {
+	ca := newTestCA(t)
+	otherCA := newTestCA(t)
+
+	cfg := Config{
+		RootCA: ca.pem(),
+		Teams: []TeamMapping{
+			{Name: "ci-agent", Teams: []string{"ci", "builders"}},
+		},
+	}
+	authn, err := cfg.Open(nil, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		cert       string
+		header     string
+		expectFail bool
+		subject    string
+		teams      []string
+	}{
+		"cert with common name and teams": {
+			cert:    ca.issue(t, "ci-agent", nil, x509.ExtKeyUsageClientAuth),
+			subject: "cert:ci-agent",
+			teams:   []string{"team:cert:ci", "team:cert:builders"},
+		},
+		"cert without teams": {
+			cert:    ca.issue(t, "other-agent", nil, x509.ExtKeyUsageClientAuth),
+			subject: "cert:other-agent",
+			teams:   []string{},
+		},
+		"cert with subject alternative name only": {
+			cert:    ca.issue(t, "", []string{"agent.example.com"}, x509.ExtKeyUsageClientAuth),
+			subject: "cert:agent.example.com",
+			teams:   []string{},
+		},
+		"cert issued by another CA": {
+			cert:       otherCA.issue(t, "ci-agent", nil, x509.ExtKeyUsageClientAuth),
+			expectFail: true,
+		},
+		"cert not meant for client auth": {
+			cert:       ca.issue(t, "ci-agent", nil, x509.ExtKeyUsageServerAuth),
+			expectFail: true,
+		},
+		"cert without names": {
+			cert:       ca.issue(t, "", nil, x509.ExtKeyUsageClientAuth),
+			expectFail: true,
+		},
+		"cert with wildcard name": {
+			cert:       ca.issue(t, "*.example.com", nil, x509.ExtKeyUsageClientAuth),
+			expectFail: true,
+		},
+		"cert with colon in name": {
+			cert:       ca.issue(t, "ci:agent", nil, x509.ExtKeyUsageClientAuth),
+			expectFail: true,
+		},
+		"garbage in header": {
+			cert:       "ThisIsNotACert",
+			expectFail: true,
+		},
+		"no cert": {
+			expectFail: true,
+		},
+		"cert in another header": {
+			cert:       ca.issue(t, "ci-agent", nil, x509.ExtKeyUsageClientAuth),
+			header:     "x-other-cert",
+			expectFail: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			if tc.cert != "" {
+				hdr := tc.header
+				if hdr == "" {
+					hdr = defaultHeader
+				}
+				req.Header.Set(hdr, tc.cert)
+			}
+
+			requestor, err := authn.Authenticate(req)
+			if tc.expectFail {
+				assert.Error(t, err)
+				assert.Nil(t, requestor)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.subject, requestor.Subject())
+			assert.Equal(t, tc.teams, requestor.Teams())
+		})
+	}
+}
+
+func TestClientCertAuthenticatorCustomHeader(t *testing.T) {
+	ca := newTestCA(t)
+	cfg := Config{RootCA: ca.pem(), Header: "x-agent-cert"}
+	authn, err := cfg.Open(nil, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header = http.Header{}
+	req.Header.Set("x-agent-cert", ca.issue(t, "ci-agent", nil, x509.ExtKeyUsageClientAuth))
+
+	requestor, err := authn.Authenticate(req)
+	require.NoError(t, err)
+	assert.Equal(t, "cert:ci-agent", requestor.Subject())
+}
+
+func TestOpenWithoutRootCA(t *testing.T) {
+	cfg := Config{}
+	_, err := cfg.Open(nil, nil, zap.NewNop())
+	assert.Error(t, err)
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) pem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}))
+}
+
+// issue returns a certificate issued by the CA as automate-gateway passes it
+// on: URL-escaped PEM
+func (ca *testCA) issue(t *testing.T, cn string, dnsNames []string, usage x509.ExtKeyUsage) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	return url.QueryEscape(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -87,6 +88,10 @@ func (a *HeaderTokenAuthenticator) Authenticate(r *http.Request) (authenticator.
 		if err != nil {
 			return nil, err
 		}
+		// Failing to record the use of a token mustn't lock its users out
+		if err := a.tokens.RecordTokenUse(ctx, id, sourceIP(r)); err != nil {
+			a.logger.Warn("failed to record token use", zap.String("token_id", id), zap.Error(err))
+		}
 		requestor := apiClient{
 			id: id,
 		}
@@ -96,6 +101,16 @@ func (a *HeaderTokenAuthenticator) Authenticate(r *http.Request) (authenticator.
 	return nil, errors.New("header-token-authenticator: no token in request")
 }
 
+// sourceIP returns the address of the client the request originated from.
+// The authenticate server sets the remote address to the source address
+// determined by source_ip.FromContext; X-Forwarded-For isn't trusted here.
+func sourceIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
 // UnmarshalJSON allows Token to implement the unmarshaler interface to dynamically
 // determine the type of the storage adapter config.
 func (c *StorageConfig) UnmarshalJSON(b []byte) error {
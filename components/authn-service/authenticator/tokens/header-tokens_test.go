@@ -1,11 +1,15 @@
 package tokens
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	tokenmock "github.com/chef/automate/components/authn-service/tokens/mock"
@@ -84,6 +88,28 @@ func TestTokenAuthWithBackend(t *testing.T) {
 			},
 			expectFail: true,
 		},
+		{
+			name:    "when token matches and has not expired",
+			headers: []string{"x-foo"},
+			tokens: []*tokens.Token{
+				{ID: tokenID, Value: "foobear", Active: true, Expires: time.Now().Add(time.Hour)},
+			},
+			request: func(r *http.Request) {
+				r.Header.Set("x-foo", "foobear")
+			},
+			subject: "token:" + tokenID,
+		},
+		{
+			name:    "when token matches but has expired",
+			headers: []string{"x-foo"},
+			tokens: []*tokens.Token{
+				{ID: tokenID, Value: "foobear", Active: true, Expires: time.Now().Add(-time.Hour)},
+			},
+			request: func(r *http.Request) {
+				r.Header.Set("x-foo", "foobear")
+			},
+			expectFail: true,
+		},
 	}
 
 	for _, d := range tests {
@@ -124,3 +150,50 @@ func TestTokenAuthWithBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenAuthRecordsTokenUse(t *testing.T) {
+	tokenID := uuid.Must(uuid.NewV4()).String()
+	tests := map[string]struct {
+		request  func(*http.Request)
+		sourceIP string
+	}{
+		"the remote address is recorded": {
+			request:  func(r *http.Request) { r.RemoteAddr = "10.0.0.1:54321" },
+			sourceIP: "10.0.0.1",
+		},
+		"the source address set by the server is recorded": {
+			request:  func(r *http.Request) { r.RemoteAddr = "192.168.1.7" },
+			sourceIP: "192.168.1.7",
+		},
+		"X-Forwarded-For is not trusted": {
+			request: func(r *http.Request) {
+				r.RemoteAddr = "10.0.0.1:54321"
+				r.Header.Set("X-Forwarded-For", "192.168.1.7, 10.0.0.1")
+			},
+			sourceIP: "10.0.0.1",
+		},
+	}
+
+	for name, d := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts, err := (&tokenmock.Config{
+				Tokens: []*tokens.Token{{ID: tokenID, Value: "foobear", Active: true}},
+			}).Open(nil, logger)
+			require.NoError(t, err)
+			authn := NewHeaderTokenAuthenticator([]string{"x-foo"}, ts, logger)
+
+			r := httptest.NewRequest("GET", "/whatever", nil)
+			r.Header.Set("x-foo", "foobear")
+			d.request(r)
+
+			before := time.Now()
+			_, err = authn.Authenticate(r)
+			require.NoError(t, err)
+
+			token, err := ts.GetToken(context.Background(), tokenID)
+			require.NoError(t, err)
+			assert.Equal(t, d.sourceIP, token.LastUsedIP)
+			assert.False(t, token.LastUsed.Before(before.Truncate(time.Second)))
+		})
+	}
+}
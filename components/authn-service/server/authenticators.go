@@ -15,9 +15,11 @@ import (
 
 	api "github.com/chef/automate/api/interservice/authn"
 	"github.com/chef/automate/components/authn-service/authenticator"
+	"github.com/chef/automate/components/authn-service/authenticator/clientcert"
 	"github.com/chef/automate/components/authn-service/authenticator/mock"
 	"github.com/chef/automate/components/authn-service/authenticator/oidc"
 	"github.com/chef/automate/components/authn-service/authenticator/tokens"
+	"github.com/chef/automate/lib/grpc/source_ip"
 	"github.com/chef/automate/lib/tls/certs"
 )
 
@@ -34,6 +36,7 @@ var AuthenticatorsConfig = map[string]func() AuthenticatorConfig{
 	"mock-header-token": func() AuthenticatorConfig { return new(mock.HeaderTokenConfig) },
 	"oidc":              func() AuthenticatorConfig { return new(oidc.Config) },
 	"header-token":      func() AuthenticatorConfig { return new(tokens.HeaderTokenConfig) },
+	"client-cert":       func() AuthenticatorConfig { return new(clientcert.Config) },
 }
 
 // Authenticate provides a quick and dirty api.AuthenticationServer
@@ -52,6 +55,10 @@ func (s *Server) Authenticate(ctx context.Context, _ *api.AuthenticateRequest) (
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to construct request: %v", err.Error())
 	}
+	// Authenticators only see the source address determined from the trusted
+	// X-Forwarded-For metadata of automate-gateway, or the peer address
+	req.Header.Del(source_ip.ForwardedForKey)
+	req.RemoteAddr = source_ip.FromContext(ctx)
 	requestor, err := s.authenticate(req)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, err.Error())
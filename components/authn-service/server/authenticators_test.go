@@ -1,9 +1,24 @@
 package server
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	api "github.com/chef/automate/api/interservice/authn"
+	"github.com/chef/automate/components/authn-service/authenticator"
+	"github.com/chef/automate/lib/tls/test/helpers"
 )
 
 func TestRequestFromMD(t *testing.T) {
@@ -30,3 +45,59 @@ func checkRequest(expectedHeader string, mdKey ...string) func(*testing.T) {
 		}
 	}
 }
+
+// captureAuthenticator records the requests it is asked to authenticate
+type captureAuthenticator struct {
+	requests []*http.Request
+}
+
+func (a *captureAuthenticator) Authenticate(r *http.Request) (authenticator.Requestor, error) {
+	a.requests = append(a.requests, r)
+	return nil, errors.New("not authenticated")
+}
+
+func servicePeer(t *testing.T, service string, addr string) *peer.Peer {
+	serviceCerts := helpers.LoadDevCerts(t, service)
+	cert, err := x509.ParseCertificate(serviceCerts.ServiceKeyPair.Certificate[0])
+	require.NoError(t, err)
+	return &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 43210},
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func TestAuthenticateSetsSourceIP(t *testing.T) {
+	cases := map[string]struct {
+		peer     *peer.Peer
+		expected string
+	}{
+		"forwarded by automate-gateway": {
+			peer:     servicePeer(t, "automate-gateway", "127.0.0.1"),
+			expected: "192.0.2.10",
+		},
+		"forwarded by another service": {
+			peer:     servicePeer(t, "deployment-service", "10.0.0.7"),
+			expected: "10.0.0.7",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			capture := &captureAuthenticator{}
+			s := &Server{
+				authenticators: map[string]authenticator.Authenticator{"capture": capture},
+				logger:         zap.NewNop(),
+			}
+			ctx := metadata.NewIncomingContext(peer.NewContext(context.Background(), tc.peer),
+				metadata.Pairs("x-forwarded-for", "192.0.2.10", "api-token", "token"))
+
+			_, err := s.Authenticate(ctx, &api.AuthenticateRequest{})
+			assert.Error(t, err)
+			require.Len(t, capture.requests, 1)
+			assert.Equal(t, tc.expected, capture.requests[0].RemoteAddr)
+			assert.Empty(t, capture.requests[0].Header.Get("X-Forwarded-For"))
+		})
+	}
+}
@@ -67,7 +67,11 @@ func newTokenAPI(ts tokens.Storage, authzClient authz.SubjectPurgeClient) api.To
 }
 
 func (a *tokenAPI) CreateToken(ctx context.Context, req *api.CreateTokenReq) (*api.Token, error) {
-	t, err := a.ts.CreateToken(ctx, req.Id, req.Description, req.Active, req.Projects)
+	expires, err := parseTokenExpiry(req.Expires)
+	if err != nil {
+		return nil, err
+	}
+	t, err := a.ts.CreateToken(ctx, req.Id, req.Description, req.Active, req.Projects, expires)
 	if err != nil {
 		if _, ok := err.(*tokens.ConflictError); ok {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
@@ -80,7 +84,11 @@ func (a *tokenAPI) CreateToken(ctx context.Context, req *api.CreateTokenReq) (*a
 
 func (a *tokenAPI) CreateTokenWithValue(
 	ctx context.Context, req *api.CreateTokenWithValueReq) (*api.Token, error) {
-	t, err := a.ts.CreateTokenWithValue(ctx, req.Id, req.Value, req.Description, req.Active, req.Projects)
+	expires, err := parseTokenExpiry(req.Expires)
+	if err != nil {
+		return nil, err
+	}
+	t, err := a.ts.CreateTokenWithValue(ctx, req.Id, req.Value, req.Description, req.Active, req.Projects, expires)
 	if err != nil {
 		if _, ok := err.(*tokens.ConflictError); ok {
 			return nil, status.Error(codes.AlreadyExists, err.Error())
@@ -152,14 +160,42 @@ func (a *tokenAPI) UpdateToken(ctx context.Context, req *api.UpdateTokenReq) (*a
 	return toTokenResp(t), nil
 }
 
+// parseTokenExpiry parses the expiry of a new token. Tokens without one
+// don't expire, and the zero time is returned for them.
+func parseTokenExpiry(expires string) (time.Time, error) {
+	if expires == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument,
+			"expires must be an RFC 3339 timestamp: %s", err.Error())
+	}
+	if !t.After(time.Now()) {
+		return time.Time{}, status.Error(codes.InvalidArgument, "expires must be in the future")
+	}
+	return t, nil
+}
+
 func toTokenResp(t *tokens.Token) *api.Token {
 	return &api.Token{
 		Id:          t.ID,
 		Active:      t.Active,
 		Value:       t.Value,
+		ValuePrefix: t.ValuePrefix,
 		Description: t.Description,
 		Created:     t.Created.Format(time.RFC3339),
 		Updated:     t.Updated.Format(time.RFC3339),
 		Projects:    t.Projects,
+		Expires:     formatOptionalTime(t.Expires),
+		LastUsed:    formatOptionalTime(t.LastUsed),
+		LastUsedIp:  t.LastUsedIP,
+	}
+}
+
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
 	}
+	return t.Format(time.RFC3339)
 }
@@ -20,6 +20,7 @@ import (
 	authz "github.com/chef/automate/api/interservice/authz/common"
 	tokenMock "github.com/chef/automate/components/authn-service/tokens/mock"
 	tokens "github.com/chef/automate/components/authn-service/tokens/types"
+	tutil "github.com/chef/automate/components/authn-service/tokens/util"
 	"github.com/chef/automate/lib/grpc/grpctest"
 	"github.com/chef/automate/lib/grpc/secureconn"
 	"github.com/chef/automate/lib/tls/test/helpers"
@@ -165,8 +166,11 @@ func TestTokenGRPC(t *testing.T) {
 			if tok.Id != tokenID {
 				t.Errorf("expected ID %q, got %q", tokenID, tok.Id)
 			}
-			if tok.Value != mockToken.Value {
-				t.Errorf("expected value %q, got %q", mockToken.Value, tok.Value)
+			if tok.Value != "" {
+				t.Errorf("expected no value, got %q", tok.Value)
+			}
+			if expected := tutil.ValuePrefix(mockToken.Value); tok.ValuePrefix != expected {
+				t.Errorf("expected value prefix %q, got %q", expected, tok.ValuePrefix)
 			}
 			if tok.Description != mockToken.Description {
 				t.Errorf("expected description %q, got %q", mockToken.Description, tok.Description)
@@ -247,6 +251,41 @@ func TestTokenGRPC(t *testing.T) {
 			// https://programming.guide/go/nil-slice-vs-empty-slice.html
 			assert.Equal(t, []string(nil), tok.Projects)
 		})
+
+		t.Run("CreateToken with an expiry succeeds", func(t *testing.T) {
+			expires := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+			tok, err := cl.CreateToken(ctx, &api.CreateTokenReq{
+				Active:      true,
+				Description: "my expiring favorite",
+				Expires:     expires,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, tok)
+
+			assert.Equal(t, expires, tok.Expires)
+			assert.Empty(t, tok.LastUsed)
+			assert.Empty(t, tok.LastUsedIp)
+		})
+
+		t.Run("CreateToken with a malformed expiry fails", func(t *testing.T) {
+			tok, err := cl.CreateToken(ctx, &api.CreateTokenReq{
+				Active:      true,
+				Description: "my expiring favorite",
+				Expires:     "tomorrow",
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, tok)
+		})
+
+		t.Run("CreateToken with an expiry in the past fails", func(t *testing.T) {
+			tok, err := cl.CreateToken(ctx, &api.CreateTokenReq{
+				Active:      true,
+				Description: "my expired favorite",
+				Expires:     time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, tok)
+		})
 	})
 
 	t.Run("DeleteToken", func(t *testing.T) {
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -26,11 +27,11 @@ func (cfg *ErrorOnlyConfig) Open(_ *certs.ServiceCerts, logger *zap.Logger) (tok
 	return &state{err: errors.New(cfg.Msg)}, nil
 }
 
-func (s *state) CreateToken(context.Context, string, string, bool, []string) (*tokens.Token, error) {
+func (s *state) CreateToken(context.Context, string, string, bool, []string, time.Time) (*tokens.Token, error) {
 	return nil, s.err
 }
 func (s *state) CreateTokenWithValue(context.Context,
-	string, string, string, bool, []string) (*tokens.Token, error) {
+	string, string, string, bool, []string, time.Time) (*tokens.Token, error) {
 	return nil, s.err
 }
 func (s *state) CreateLegacyTokenWithValue(context.Context, string) (*tokens.Token, error) {
@@ -51,3 +52,6 @@ func (s *state) GetTokenIDWithValue(context.Context, string) (string, error) {
 func (s *state) GetTokens(context.Context) ([]*tokens.Token, error) {
 	return nil, s.err
 }
+func (s *state) RecordTokenUse(context.Context, string, string) error {
+	return s.err
+}
@@ -20,6 +20,8 @@ type Config struct {
 	Tokens []*tokens.Token `json:"tokens"`
 }
 
+// mock keeps the values of the tokens in memory, but like the pg adapter
+// only reveals them when the tokens are created
 type mock struct {
 	tokens []*tokens.Token
 }
@@ -30,12 +32,17 @@ func (cfg *Config) Open(_ *certs.ServiceCerts, logger *zap.Logger) (tokens.Stora
 }
 
 func (m *mock) GetTokens(ctx context.Context) ([]*tokens.Token, error) {
-	return m.tokens, nil
+	ts := make([]*tokens.Token, 0, len(m.tokens))
+	for _, t := range m.tokens {
+		ts = append(ts, redacted(t))
+	}
+	return ts, nil
 }
 
 func (m *mock) GetTokenIDWithValue(ctx context.Context, value string) (string, error) {
+	now := time.Now()
 	for i, t := range m.tokens {
-		if (t.Value == value) && t.Active {
+		if (t.Value == value) && t.Active && !t.Expired(now) {
 			return m.tokens[i].ID, nil
 		}
 	}
@@ -43,6 +50,14 @@ func (m *mock) GetTokenIDWithValue(ctx context.Context, value string) (string, e
 }
 
 func (m *mock) GetToken(ctx context.Context, id string) (*tokens.Token, error) {
+	t, err := m.findToken(id)
+	if err != nil {
+		return nil, err
+	}
+	return redacted(t), nil
+}
+
+func (m *mock) findToken(id string) (*tokens.Token, error) {
 	for i, t := range m.tokens {
 		if t.ID == id {
 			return m.tokens[i], nil
@@ -52,23 +67,23 @@ func (m *mock) GetToken(ctx context.Context, id string) (*tokens.Token, error) {
 }
 
 func (m *mock) CreateToken(_ context.Context, id, description string,
-	active bool, projects []string) (*tokens.Token, error) {
+	active bool, projects []string, expires time.Time) (*tokens.Token, error) {
 
 	if id == "" {
 		id = uuid.Must(uuid.NewV4()).String()
 	}
-	return m.createTokenWithValue(mockToken(id), description, active, id, projects)
+	return m.createTokenWithValue(mockToken(id), description, active, id, projects, expires)
 }
 
 func (m *mock) CreateTokenWithValue(_ context.Context,
-	id, value, description string, active bool, projects []string) (*tokens.Token, error) {
+	id, value, description string, active bool, projects []string, expires time.Time) (*tokens.Token, error) {
 	if id == "" {
 		id = uuid.Must(uuid.NewV4()).String()
 	}
 	if err := tutil.IsValidToken(value); err != nil {
 		return nil, err
 	}
-	return m.createTokenWithValue(value, description, active, id, projects)
+	return m.createTokenWithValue(value, description, active, id, projects, expires)
 }
 
 func (m *mock) CreateLegacyTokenWithValue(_ context.Context, value string) (*tokens.Token, error) {
@@ -76,11 +91,11 @@ func (m *mock) CreateLegacyTokenWithValue(_ context.Context, value string) (*tok
 		return nil, err
 	}
 	return m.createTokenWithValue(value, tokens.LegacyTokenDescription,
-		true, uuid.Must(uuid.NewV4()).String(), []string{})
+		true, uuid.Must(uuid.NewV4()).String(), []string{}, time.Time{})
 }
 
 func (m *mock) createTokenWithValue(value string,
-	description string, active bool, id string, projects []string) (*tokens.Token, error) {
+	description string, active bool, id string, projects []string, expires time.Time) (*tokens.Token, error) {
 
 	if len(projects) == 0 {
 		projects = []string{}
@@ -96,10 +111,13 @@ func (m *mock) createTokenWithValue(value string,
 		Updated:     now,
 		ID:          id,
 		Projects:    projects,
+		Expires:     expires.UTC(),
 	}
 
 	m.tokens = append(m.tokens, &tNew)
-	return &tNew, nil
+	created := tNew
+	created.ValuePrefix = tutil.ValuePrefix(value)
+	return &created, nil
 }
 
 func (m *mock) DeleteToken(ctx context.Context, id string) error {
@@ -153,7 +171,25 @@ func (m *mock) UpdateToken(ctx context.Context,
 		newTokens = append(newTokens, t)
 	}
 	m.tokens = newTokens
-	return newToken, nil
+	return redacted(newToken), nil
+}
+
+func (m *mock) RecordTokenUse(ctx context.Context, id, sourceIP string) error {
+	t, err := m.findToken(id)
+	if err != nil {
+		return err
+	}
+	t.LastUsed = time.Now().UTC()
+	t.LastUsedIP = sourceIP
+	return nil
+}
+
+// redacted returns a copy of the token without its value
+func redacted(t *tokens.Token) *tokens.Token {
+	r := *t
+	r.ValuePrefix = tutil.ValuePrefix(t.Value)
+	r.Value = ""
+	return &r
 }
 
 func mockToken(id string) string {
@@ -2,8 +2,11 @@ package pg
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	tokens "github.com/chef/automate/components/authn-service/tokens/types"
@@ -11,17 +14,24 @@ import (
 	uuid "github.com/chef/automate/lib/uuid4"
 )
 
+// tokenColumns are the columns scanToken expects, in order
+const tokenColumns = `id, description, value_prefix, active, project_ids, created, updated, expires, last_used, last_used_ip`
+
+// lastUsedResolution is how often the last use of a token is written when
+// it keeps being used from the same address
+const lastUsedResolution = "1 minute"
+
 func (a *adapter) CreateToken(ctx context.Context,
-	id, description string, active bool, projects []string) (*tokens.Token, error) {
+	id, description string, active bool, projects []string, expires time.Time) (*tokens.Token, error) {
 	value, err := tutil.GenerateNewToken()
 	if err != nil {
 		return nil, err
 	}
-	return a.CreateTokenWithValue(ctx, id, value, description, active, projects)
+	return a.CreateTokenWithValue(ctx, id, value, description, active, projects, expires)
 }
 
 func (a *adapter) CreateTokenWithValue(ctx context.Context,
-	id, value, description string, active bool, projects []string) (*tokens.Token, error) {
+	id, value, description string, active bool, projects []string, expires time.Time) (*tokens.Token, error) {
 	if err := tutil.IsValidToken(value); err != nil {
 		return nil, err
 	}
@@ -33,7 +43,7 @@ func (a *adapter) CreateTokenWithValue(ctx context.Context,
 		id = uid.String()
 	}
 
-	return a.insertToken(ctx, id, description, value, active, projects)
+	return a.insertToken(ctx, id, description, value, active, projects, expires)
 }
 
 func (a *adapter) CreateLegacyTokenWithValue(ctx context.Context, value string) (*tokens.Token, error) {
@@ -46,56 +56,64 @@ func (a *adapter) CreateLegacyTokenWithValue(ctx context.Context, value string)
 		return nil, err
 	}
 
-	return a.insertToken(ctx, id.String(), tokens.LegacyTokenDescription, value, true, []string{})
+	return a.insertToken(ctx, id.String(), tokens.LegacyTokenDescription, value, true, []string{}, time.Time{})
 }
 
 func (a *adapter) insertToken(ctx context.Context,
-	id string, description string, value string, active bool, projects []string) (*tokens.Token, error) {
+	id string, description string, value string, active bool, projects []string,
+	expires time.Time) (*tokens.Token, error) {
 
-	t := tokens.Token{}
 	// ensure we do not pass null projects to db and break the not null constraint
 	if projects == nil {
 		projects = []string{}
 	}
-	err := a.db.QueryRowContext(ctx,
-		`INSERT INTO chef_authn_tokens(id, description, value, active, project_ids, created, updated)
-		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
-		RETURNING id, description, value, active, project_ids, created, updated`,
-		id, description, value, active, pq.Array(projects)).
-		Scan(&t.ID, &t.Description, &t.Value, &t.Active, pq.Array(&t.Projects), &t.Created, &t.Updated)
+	var expiresAt pq.NullTime
+	if !expires.IsZero() {
+		expiresAt = pq.NullTime{Time: expires, Valid: true}
+	}
+	hash, salt, err := tutil.HashValue(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "hash token value")
+	}
+	t, err := scanToken(a.db.QueryRowContext(ctx,
+		`INSERT INTO chef_authn_tokens(id, description, value_hash, value_salt, value_prefix, active,
+		project_ids, created, updated, expires)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW(), $8)
+		RETURNING `+tokenColumns,
+		id, description, hash, salt, tutil.ValuePrefix(value), active, pq.Array(projects), expiresAt))
 
 	if err != nil {
 		return nil, processSQLError(err, "insert token")
 	}
-	return &t, nil
+	// this is the only time the value is known
+	t.Value = value
+	return t, nil
 }
 
 func (a *adapter) UpdateToken(ctx context.Context,
 	id, description string, active bool, projects []string) (*tokens.Token, error) {
-	t := tokens.Token{}
+	var t *tokens.Token
+	var err error
 
 	// ensure we do not pass null projects to db
 	if projects == nil {
 		projects = []string{}
 	}
 	if description != "" {
-		if err := a.db.QueryRowContext(ctx,
+		t, err = scanToken(a.db.QueryRowContext(ctx,
 			`UPDATE chef_authn_tokens SET active=$2, description=$3, project_ids=$4, updated=NOW() WHERE id=$1
-			RETURNING id, description, value, active, project_ids, created, updated`,
-			id, active, description, pq.Array(projects)).
-			Scan(&t.ID, &t.Description, &t.Value, &t.Active, pq.Array(&t.Projects), &t.Created, &t.Updated); err != nil {
-			return nil, processSQLError(err, "update token")
-		}
+			RETURNING `+tokenColumns,
+			id, active, description, pq.Array(projects)))
 	} else {
-		if err := a.db.QueryRowContext(ctx,
+		t, err = scanToken(a.db.QueryRowContext(ctx,
 			`UPDATE chef_authn_tokens SET active=$2, project_ids=$3, updated=NOW() WHERE id=$1
-			RETURNING id, description, value, active, project_ids, created, updated`,
-			id, active, pq.Array(projects)).
-			Scan(&t.ID, &t.Description, &t.Value, &t.Active, pq.Array(&t.Projects), &t.Created, &t.Updated); err != nil {
-			return nil, processSQLError(err, "update token")
-		}
+			RETURNING `+tokenColumns,
+			id, active, pq.Array(projects)))
 	}
-	return &t, nil
+	if err != nil {
+		return nil, processSQLError(err, "update token")
+	}
+	return t, nil
 }
 
 func (a *adapter) DeleteToken(ctx context.Context, id string) error {
@@ -108,32 +126,50 @@ func (a *adapter) DeleteToken(ctx context.Context, id string) error {
 }
 
 func (a *adapter) GetToken(ctx context.Context, id string) (*tokens.Token, error) {
-	t := tokens.Token{}
-	if err := a.db.QueryRowContext(ctx,
-		`SELECT id, description, value, active, project_ids, created, updated FROM chef_authn_tokens WHERE id=$1`,
-		id).
-		Scan(&t.ID, &t.Description, &t.Value, &t.Active, pq.Array(&t.Projects), &t.Created, &t.Updated); err != nil {
+	t, err := scanToken(a.db.QueryRowContext(ctx,
+		`SELECT `+tokenColumns+` FROM chef_authn_tokens WHERE id=$1`,
+		id))
+	if err != nil {
 		return nil, processSQLError(err, "select token by id")
 	}
-	return &t, nil
+	return t, nil
 }
 
+// GetTokenIDWithValue looks the tokens up by the prefix of the value, and
+// returns the one whose hash matches the value
 func (a *adapter) GetTokenIDWithValue(ctx context.Context, value string) (string, error) {
-	var id string
-	if err := a.db.QueryRowContext(ctx,
-		`SELECT id FROM chef_authn_tokens WHERE value=$1 AND active`,
-		value).
-		Scan(&id); err != nil {
+	rows, err := a.db.QueryContext(ctx,
+		`SELECT id, value_hash, value_salt FROM chef_authn_tokens
+		WHERE value_prefix=$1 AND active AND (expires IS NULL OR expires > NOW())`,
+		tutil.ValuePrefix(value))
+	if err != nil {
 		return "", processSQLError(err, "select token ID by value")
 	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			a.logger.Warn("failed to close DB rows", zap.Error(err))
+		}
+	}()
 
-	return id, nil
+	for rows.Next() {
+		var id, hash, salt string
+		if err := rows.Scan(&id, &hash, &salt); err != nil {
+			return "", processSQLError(err, "select token ID by value")
+		}
+		if tutil.ValueMatches(value, hash, salt) {
+			return id, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", processSQLError(err, "select token ID by value")
+	}
+	return "", &tokens.NotFoundError{}
 }
 
 func (a *adapter) GetTokens(ctx context.Context) ([]*tokens.Token, error) {
 	ts := []*tokens.Token{}
 	rows, err := a.db.QueryContext(ctx,
-		`SELECT id, description, value, active, project_ids, created, updated FROM chef_authn_tokens`)
+		`SELECT `+tokenColumns+` FROM chef_authn_tokens`)
 	if err != nil {
 		return nil, err
 	}
@@ -144,16 +180,48 @@ func (a *adapter) GetTokens(ctx context.Context) ([]*tokens.Token, error) {
 	}()
 
 	for rows.Next() {
-		t := tokens.Token{}
-		if err := rows.Scan(&t.ID, &t.Description, &t.Value, &t.Active, pq.Array(&t.Projects),
-			&t.Created, &t.Updated); err != nil {
+		t, err := scanToken(rows)
+		if err != nil {
 			return nil, err
 		}
-		ts = append(ts, &t)
+		ts = append(ts, t)
 	}
 	return ts, nil
 }
 
+// RecordTokenUse updates the last use of the token. To spare the database a
+// write for every request, the last use is only updated when the address
+// changes or the previous one is older than lastUsedResolution.
+func (a *adapter) RecordTokenUse(ctx context.Context, id, sourceIP string) error {
+	if _, err := a.db.ExecContext(ctx,
+		`UPDATE chef_authn_tokens SET last_used=NOW(), last_used_ip=$2
+		WHERE id=$1
+		AND (last_used IS NULL OR last_used < NOW() - $3::INTERVAL OR last_used_ip IS DISTINCT FROM $2)`,
+		id, sourceIP, lastUsedResolution); err != nil {
+		return processSQLError(err, "record token use")
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanToken reads a token from a row holding the tokenColumns
+func scanToken(row scanner) (*tokens.Token, error) {
+	t := tokens.Token{}
+	var expires, lastUsed pq.NullTime
+	var lastUsedIP sql.NullString
+	if err := row.Scan(&t.ID, &t.Description, &t.ValuePrefix, &t.Active, pq.Array(&t.Projects),
+		&t.Created, &t.Updated, &expires, &lastUsed, &lastUsedIP); err != nil {
+		return nil, err
+	}
+	t.Expires = expires.Time
+	t.LastUsed = lastUsed.Time
+	t.LastUsedIP = lastUsedIP.String
+	return &t, nil
+}
+
 // Reset deletes all tokens from the database /!\
 func (a *adapter) Reset(ctx context.Context) error {
 	_, err := a.db.ExecContext(ctx, `TRUNCATE chef_authn_tokens`)
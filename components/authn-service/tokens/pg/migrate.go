@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/chef/automate/components/authn-service/constants"
+	tutil "github.com/chef/automate/components/authn-service/tokens/util"
 )
 
 // migrate tries to execute all the migrations we know of
@@ -55,9 +56,17 @@ func migrate(c *sql.DB) error {
 		} else {
 			migrationNum := n + 1
 			m := migrations[n]
-			if _, err = tx.Exec(m.statement); err != nil {
-				err = errors.Wrapf(err, "migration '%s' (%d) failed", m.descr, migrationNum)
-				break
+			if m.statement != "" {
+				if _, err = tx.Exec(m.statement); err != nil {
+					err = errors.Wrapf(err, "migration '%s' (%d) failed", m.descr, migrationNum)
+					break
+				}
+			}
+			if m.fn != nil {
+				if err = m.fn(tx); err != nil {
+					err = errors.Wrapf(err, "migration '%s' (%d) failed", m.descr, migrationNum)
+					break
+				}
 			}
 
 			q := `INSERT INTO migrations (num, descr, at) VALUES ($1, $2, now());`
@@ -96,9 +105,13 @@ func migrate(c *sql.DB) error {
 	return err
 }
 
+// migration is a SQL statement, a function for changes that can't be made
+// in SQL alone, or both. The function runs after the statement, in the same
+// transaction.
 type migration struct {
 	descr     string
 	statement string
+	fn        func(*sql.Tx) error
 }
 
 var migrations = []migration{
@@ -160,4 +173,65 @@ var migrations = []migration{
 		descr:     "add default empty array for project_ids",
 		statement: `ALTER TABLE chef_authn_tokens ALTER COLUMN project_ids SET DEFAULT '{}';`,
 	},
+	// tokens may expire, and record when and from where they were last used
+	{
+		descr: "add expires, last_used and last_used_ip columns",
+		statement: `ALTER TABLE chef_authn_tokens
+                  ADD COLUMN expires TIMESTAMPTZ,
+                  ADD COLUMN last_used TIMESTAMPTZ,
+                  ADD COLUMN last_used_ip TEXT;`,
+	},
+	// token values are no longer stored, only a salted hash of them and a
+	// prefix to look them up by
+	{
+		descr: "hash token values",
+		statement: `ALTER TABLE chef_authn_tokens
+                  ADD COLUMN value_hash TEXT,
+                  ADD COLUMN value_salt TEXT,
+                  ADD COLUMN value_prefix TEXT;`,
+		fn: hashTokenValues,
+	},
+	{
+		descr: "drop token values",
+		statement: `ALTER TABLE chef_authn_tokens
+                  DROP COLUMN value,
+                  ALTER COLUMN value_hash SET NOT NULL,
+                  ALTER COLUMN value_salt SET NOT NULL,
+                  ALTER COLUMN value_prefix SET NOT NULL;
+                CREATE INDEX IF NOT EXISTS chef_authn_tokens_value_prefix_idx ON chef_authn_tokens (value_prefix);`,
+	},
+}
+
+// hashTokenValues fills in the hash, salt and prefix of the values of the
+// existing tokens
+func hashTokenValues(tx *sql.Tx) error {
+	rows, err := tx.Query(`SELECT id, value FROM chef_authn_tokens`)
+	if err != nil {
+		return errors.Wrap(err, "select token values")
+	}
+	values := map[string]string{}
+	for rows.Next() {
+		var id, value string
+		if err := rows.Scan(&id, &value); err != nil {
+			rows.Close() // nolint: errcheck
+			return errors.Wrap(err, "read token value")
+		}
+		values[id] = value
+	}
+	if err := rows.Close(); err != nil {
+		return errors.Wrap(err, "read token values")
+	}
+
+	for id, value := range values {
+		hash, salt, err := tutil.HashValue(value)
+		if err != nil {
+			return errors.Wrap(err, "hash token value")
+		}
+		if _, err := tx.Exec(
+			`UPDATE chef_authn_tokens SET value_hash=$2, value_salt=$3, value_prefix=$4 WHERE id=$1`,
+			id, hash, salt, tutil.ValuePrefix(value)); err != nil {
+			return errors.Wrapf(err, "store hash of token %s", id)
+		}
+	}
+	return nil
 }
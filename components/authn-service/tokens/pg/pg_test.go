@@ -15,6 +15,7 @@ import (
 
 	"github.com/chef/automate/components/authn-service/tokens/pg"
 	tokens "github.com/chef/automate/components/authn-service/tokens/types"
+	tutil "github.com/chef/automate/components/authn-service/tokens/util"
 )
 
 func setup(t *testing.T) (tokens.Storage, *sql.DB, context.Context) {
@@ -119,7 +120,7 @@ func TestGetToken(t *testing.T) {
 
 	resp, err := store.GetToken(ctx, exp.ID)
 	require.NoError(err)
-	assert.Equal(&exp, resp)
+	assert.Equal(storedToken(exp), resp)
 }
 
 func TestGetTokens(t *testing.T) {
@@ -131,7 +132,7 @@ func TestGetTokens(t *testing.T) {
 	//DB time and Golang time are rounded differently
 	tme := time.Now().UTC().Round(time.Second)
 
-	tokens := []*tokens.Token{
+	toks := []*tokens.Token{
 		{
 			ID:          "Uncool-token",
 			Description: "Uncool Token",
@@ -155,13 +156,13 @@ func TestGetTokens(t *testing.T) {
 	resp, err := store.GetTokens(ctx)
 	require.Empty(resp)
 
-	insertToken(t, db, *tokens[0])
-	insertToken(t, db, *tokens[1])
+	insertToken(t, db, *toks[0])
+	insertToken(t, db, *toks[1])
 
 	resp, err = store.GetTokens(ctx)
 	require.NoError(err)
 
-	assert.ElementsMatch(tokens, resp)
+	assert.ElementsMatch([]*tokens.Token{storedToken(*toks[0]), storedToken(*toks[1])}, resp)
 }
 
 func TestGetTokenIDWithValue(t *testing.T) {
@@ -213,17 +214,20 @@ func TestCreateToken(t *testing.T) {
 		"when valid project id passed": func(t *testing.T) {
 			project_ids := []string{"project-1"}
 
-			tok, err := store.CreateToken(ctx, id, desc, active, project_ids)
+			tok, err := store.CreateToken(ctx, id, desc, active, project_ids, time.Time{})
 			require.NoError(err)
 			require.NotNil(tok)
 
 			assertCount(t, 1, db.QueryRow(`SELECT count(*) FROM chef_authn_tokens
 			WHERE id=$1`, id))
 
-			var value string
-			err = db.QueryRow(`SELECT value FROM chef_authn_tokens WHERE id=$1`, id).Scan(&value)
+			var hash, prefix string
+			err = db.QueryRow(`SELECT value_hash, value_prefix FROM chef_authn_tokens WHERE id=$1`, id).
+				Scan(&hash, &prefix)
 			require.NoError(err)
-			assert.NotNil(value)
+			assert.True(tutil.ValueMatches(tok.Value, hash, hashSalt(t, db, id)))
+			assert.Equal(tutil.ValuePrefix(tok.Value), prefix)
+			assert.NotContains(hash, tok.Value)
 
 			assert.Equal(id, tok.ID)
 			assert.Equal(desc, tok.Description)
@@ -235,17 +239,20 @@ func TestCreateToken(t *testing.T) {
 		"when no project_ids passed": func(t *testing.T) {
 			project_ids := []string{}
 
-			tok, err := store.CreateToken(ctx, id, desc, active, project_ids)
+			tok, err := store.CreateToken(ctx, id, desc, active, project_ids, time.Time{})
 			require.NoError(err)
 			require.NotNil(tok)
 
 			assertCount(t, 1, db.QueryRow(`SELECT count(*) FROM chef_authn_tokens
 			WHERE id=$1`, id))
 
-			var value string
-			err = db.QueryRow(`SELECT value FROM chef_authn_tokens WHERE id=$1`, id).Scan(&value)
+			var hash, prefix string
+			err = db.QueryRow(`SELECT value_hash, value_prefix FROM chef_authn_tokens WHERE id=$1`, id).
+				Scan(&hash, &prefix)
 			require.NoError(err)
-			assert.NotNil(value)
+			assert.True(tutil.ValueMatches(tok.Value, hash, hashSalt(t, db, id)))
+			assert.Equal(tutil.ValuePrefix(tok.Value), prefix)
+			assert.NotContains(hash, tok.Value)
 
 			assert.Equal(id, tok.ID)
 			assert.Equal(desc, tok.Description)
@@ -272,7 +279,7 @@ func TestCreateTokenWithValue(t *testing.T) {
 	active := true
 	value := "2flYtvKNAISyGAX9SlvuJOWQ1fU="
 	project_ids := []string{}
-	tok, err := store.CreateTokenWithValue(ctx, id, value, desc, active, project_ids)
+	tok, err := store.CreateTokenWithValue(ctx, id, value, desc, active, project_ids, time.Time{})
 	require.NoError(err)
 	require.NotNil(tok)
 
@@ -284,6 +291,11 @@ func TestCreateTokenWithValue(t *testing.T) {
 	assert.WithinDuration(time.Now(), tok.Created, time.Second)
 	assert.WithinDuration(time.Now(), tok.Updated, time.Second)
 	assert.Equal([]string{}, tok.Projects)
+	assert.Equal(value, tok.Value)
+
+	tokID, err := store.GetTokenIDWithValue(ctx, value)
+	require.NoError(err)
+	assert.Equal(id, tokID)
 }
 
 func TestUpdateToken(t *testing.T) {
@@ -317,7 +329,7 @@ func TestUpdateToken(t *testing.T) {
 			assert.Equal(tok.ID, resp.ID)
 			assert.Equal(tok.Description, resp.Description)
 			assert.Equal(tok.Active, resp.Active)
-			assert.Equal(tok.Value, resp.Value)
+			assert.Equal(tutil.ValuePrefix(tok.Value), resp.ValuePrefix)
 			assert.Equal(tok.Projects, resp.Projects)
 			assert.Equal(tok.Created, resp.Created)
 			assert.NotEqual(tok.Updated, resp.Updated)
@@ -330,7 +342,7 @@ func TestUpdateToken(t *testing.T) {
 			assert.Equal(tok.ID, resp.ID)
 			assert.Equal(updatedDesc, resp.Description)
 			assert.Equal(tok.Active, resp.Active)
-			assert.Equal(tok.Value, resp.Value)
+			assert.Equal(tutil.ValuePrefix(tok.Value), resp.ValuePrefix)
 			assert.Equal(tok.Projects, resp.Projects)
 			assert.Equal(tok.Created, resp.Created)
 			assert.NotEqual(tok.Updated, resp.Updated)
@@ -343,7 +355,7 @@ func TestUpdateToken(t *testing.T) {
 			assert.Equal(tok.ID, resp.ID)
 			assert.Equal(tok.Description, resp.Description)
 			assert.Equal(updatedActive, resp.Active)
-			assert.Equal(tok.Value, resp.Value)
+			assert.Equal(tutil.ValuePrefix(tok.Value), resp.ValuePrefix)
 			assert.Equal(tok.Projects, resp.Projects)
 			assert.Equal(tok.Created, resp.Created)
 			assert.NotEqual(tok.Updated, resp.Updated)
@@ -356,7 +368,7 @@ func TestUpdateToken(t *testing.T) {
 			assert.Equal(tok.ID, resp.ID)
 			assert.Equal(tok.Description, resp.Description)
 			assert.Equal(tok.Active, resp.Active)
-			assert.Equal(tok.Value, resp.Value)
+			assert.Equal(tutil.ValuePrefix(tok.Value), resp.ValuePrefix)
 			assert.Equal(updatedProjects, resp.Projects)
 			assert.Equal(tok.Created, resp.Created)
 			assert.NotEqual(tok.Updated, resp.Updated)
@@ -371,7 +383,7 @@ func TestUpdateToken(t *testing.T) {
 			assert.Equal(tok.ID, resp.ID)
 			assert.Equal(updatedDesc, resp.Description)
 			assert.Equal(updatedActive, resp.Active)
-			assert.Equal(tok.Value, resp.Value)
+			assert.Equal(tutil.ValuePrefix(tok.Value), resp.ValuePrefix)
 			assert.Equal(updatedProjects, resp.Projects)
 			assert.Equal(tok.Created, resp.Created)
 			assert.NotEqual(tok.Updated, resp.Updated)
@@ -414,10 +426,27 @@ func insertToken(t *testing.T, db *sql.DB, tok tokens.Token) {
 	if len(tok.Projects) == 0 {
 		tok.Projects = []string{}
 	}
-	_, err := db.Exec(`INSERT INTO chef_authn_tokens (id, description, active, value, project_ids, created, updated)
-		values ($1, $2, $3, $4, $5, $6, $7)`, tok.ID, tok.Description, tok.Active, tok.Value, pq.Array(tok.Projects),
-		tok.Created, tok.Updated)
+	hash, salt, err := tutil.HashValue(tok.Value)
 	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO chef_authn_tokens (id, description, active, value_hash, value_salt, value_prefix,
+		project_ids, created, updated)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`, tok.ID, tok.Description, tok.Active,
+		hash, salt, tutil.ValuePrefix(tok.Value), pq.Array(tok.Projects), tok.Created, tok.Updated)
+	require.NoError(t, err)
+}
+
+// storedToken returns the token as it is read back from the database: only
+// the prefix of its value is known
+func storedToken(tok tokens.Token) *tokens.Token {
+	tok.ValuePrefix = tutil.ValuePrefix(tok.Value)
+	tok.Value = ""
+	return &tok
+}
+
+func hashSalt(t *testing.T, db *sql.DB, id string) string {
+	var salt string
+	require.NoError(t, db.QueryRow(`SELECT value_salt FROM chef_authn_tokens WHERE id=$1`, id).Scan(&salt))
+	return salt
 }
 
 func assertCount(t *testing.T, expected int, row *sql.Row) {
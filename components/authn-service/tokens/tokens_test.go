@@ -62,6 +62,10 @@ func TestToken(t *testing.T) {
 		testGetToken,
 		testGetTokenIDWithValue,
 		testGetTokenIDWithValueNotFound,
+		testGetTokenIDWithValueExpired,
+		testGetTokenIDWithValueSharedPrefix,
+		testCreateTokenWithExpiry,
+		testRecordTokenUse,
 		testCreateToken,
 		testCreateTokenWithValue,
 		testCreateLegacyTokenWithValue,
@@ -117,10 +121,10 @@ func TestToken(t *testing.T) {
 }
 
 func testGetTokens(ctx context.Context, t *testing.T, ta tokens.Storage) {
-	_, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"})
+	_, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"}, time.Time{})
 	require.Nil(t, err, "expected no error, got err=%v", err)
 
-	_, err = ta.CreateToken(ctx, "id1", "node2", true, []string{"project-1"})
+	_, err = ta.CreateToken(ctx, "id1", "node2", true, []string{"project-1"}, time.Time{})
 	require.Nil(t, err, "expected no error, got err=%v", err)
 
 	toks, err := ta.GetTokens(ctx)
@@ -134,16 +138,15 @@ func testGetTokens(ctx context.Context, t *testing.T, ta tokens.Storage) {
 }
 
 func testGetToken(ctx context.Context, t *testing.T, ta tokens.Storage) {
-	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"})
+	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"}, time.Time{})
 	require.Nil(t, err, "expected no error, got err=%v", err)
 
 	tok, err := ta.GetToken(ctx, tok0.ID)
 	require.Nil(t, err, "expected no error, got err=%v", err)
 	require.NotNil(t, tok, "expected token 'node1', got token=%v", tok)
 
-	if tok.Value != tok0.Value {
-		t.Errorf("expected token 'node1' to have a token %v, got %v", tok0.Value, tok.Value)
-	}
+	assert.Empty(t, tok.Value, "expected the value to only be revealed on creation")
+	assert.Equal(t, tok0.ValuePrefix, tok.ValuePrefix)
 }
 
 func testGetTokenIDWithValueNotFound(ctx context.Context, t *testing.T, ta tokens.Storage) {
@@ -156,7 +159,7 @@ func testGetTokenIDWithValueNotFound(ctx context.Context, t *testing.T, ta token
 }
 
 func testGetTokenIDWithValue(ctx context.Context, t *testing.T, ta tokens.Storage) {
-	tok, err := ta.CreateToken(ctx, "id0", "node3", true, []string{"project-1"})
+	tok, err := ta.CreateToken(ctx, "id0", "node3", true, []string{"project-1"}, time.Time{})
 	require.Nilf(t, err, "expected no error, got err=%v", err)
 	require.NotNilf(t, tok, "expected token 'node3', got token=%v", tok)
 
@@ -169,9 +172,81 @@ func testGetTokenIDWithValue(ctx context.Context, t *testing.T, ta tokens.Storag
 	assert.Equalf(t, tokID, tok.ID, "expected token ID to match %q", tok.ID)
 }
 
+func testGetTokenIDWithValueSharedPrefix(ctx context.Context, t *testing.T, ta tokens.Storage) {
+	value0 := "abcd" + generateRandomTokenString(tutil.MinimumTokenLength())
+	value1 := "abcd" + generateRandomTokenString(tutil.MinimumTokenLength())
+	tok0, err := ta.CreateTokenWithValue(ctx, "id0", value0, "node1", true, []string{}, time.Time{})
+	require.NoError(t, err)
+	tok1, err := ta.CreateTokenWithValue(ctx, "id1", value1, "node2", true, []string{}, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, tok0.ValuePrefix, tok1.ValuePrefix)
+
+	tokID, err := ta.GetTokenIDWithValue(ctx, value0)
+	require.NoError(t, err)
+	assert.Equal(t, tok0.ID, tokID)
+
+	tokID, err = ta.GetTokenIDWithValue(ctx, value1)
+	require.NoError(t, err)
+	assert.Equal(t, tok1.ID, tokID)
+
+	_, err = ta.GetTokenIDWithValue(ctx, "abcd"+generateRandomTokenString(tutil.MinimumTokenLength()))
+	if _, ok := errors.Cause(err).(*tokens.NotFoundError); !ok {
+		t.Errorf("expected token.NotFoundError, got %v", err)
+	}
+}
+
+func testGetTokenIDWithValueExpired(ctx context.Context, t *testing.T, ta tokens.Storage) {
+	tok, err := ta.CreateToken(ctx, "id0", "node3", true, []string{"project-1"}, time.Now().Add(time.Second))
+	require.NoError(t, err)
+
+	tokID, err := ta.GetTokenIDWithValue(ctx, tok.Value)
+	require.NoError(t, err)
+	assert.Equal(t, tok.ID, tokID)
+
+	time.Sleep(1100 * time.Millisecond)
+	_, err = ta.GetTokenIDWithValue(ctx, tok.Value)
+	if _, ok := errors.Cause(err).(*tokens.NotFoundError); !ok {
+		t.Errorf("expected token.NotFoundError for expired token, got %v", err)
+	}
+}
+
+func testCreateTokenWithExpiry(ctx context.Context, t *testing.T, ta tokens.Storage) {
+	expires := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	tok, err := ta.CreateToken(ctx, "id0", "node3", true, []string{"project-1"}, expires)
+	require.NoError(t, err)
+	assert.True(t, expires.Equal(tok.Expires), "expected expiry %s, got %s", expires, tok.Expires)
+
+	tok2, err := ta.GetToken(ctx, tok.ID)
+	require.NoError(t, err)
+	assert.True(t, expires.Equal(tok2.Expires), "expected expiry %s, got %s", expires, tok2.Expires)
+	assert.False(t, tok2.Expired(time.Now()))
+	assert.True(t, tok2.Expired(expires))
+}
+
+func testRecordTokenUse(ctx context.Context, t *testing.T, ta tokens.Storage) {
+	before := time.Now().Add(-time.Second * 20).UTC()
+	tok, err := ta.CreateToken(ctx, "id0", "node3", true, []string{"project-1"}, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, tok.LastUsed.IsZero(), "expected new token to be unused")
+	assert.Empty(t, tok.LastUsedIP)
+
+	require.NoError(t, ta.RecordTokenUse(ctx, tok.ID, "192.168.1.7"))
+
+	tok2, err := ta.GetToken(ctx, tok.ID)
+	require.NoError(t, err)
+	assert.True(t, tok2.LastUsed.After(before), "expected last use after %s, got %s", before, tok2.LastUsed)
+	assert.Equal(t, "192.168.1.7", tok2.LastUsedIP)
+
+	// a use from another address is recorded right away
+	require.NoError(t, ta.RecordTokenUse(ctx, tok.ID, "10.0.0.1"))
+	tok3, err := ta.GetToken(ctx, tok.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", tok3.LastUsedIP)
+}
+
 func testCreateToken(ctx context.Context, t *testing.T, ta tokens.Storage) {
 	before := time.Now().Add(-(time.Second * 20)).UTC()
-	tok, err := ta.CreateToken(ctx, "id0", "node3", true, []string{"project-1"})
+	tok, err := ta.CreateToken(ctx, "id0", "node3", true, []string{"project-1"}, time.Time{})
 	require.Nil(t, err, "expected no error, got err=%v", err)
 	require.NotNil(t, tok, "expected token 'node3', got token=%v", tok)
 
@@ -183,9 +258,8 @@ func testCreateToken(ctx context.Context, t *testing.T, ta tokens.Storage) {
 	require.Nil(t, err, "expected no error, got err=%v", err)
 	require.NotNil(t, tok2, "expected token 'node3', got token=%v", tok2)
 
-	if tok2.Value != tok.Value {
-		t.Errorf("expected token 'node3' to have a value %v, got %v", tok.Value, tok2.Value)
-	}
+	assert.Empty(t, tok2.Value, "expected the value to only be revealed on creation")
+	assert.Equal(t, tutil.ValuePrefix(tok.Value), tok2.ValuePrefix)
 	if tok2.Description != tok.Description {
 		t.Errorf("expected token 'node3' to have a description %v, got %v", tok.Description, tok2.Description)
 	}
@@ -199,7 +273,7 @@ func testCreateTokenWithValue(ctx context.Context, t *testing.T, ta tokens.Stora
 	before := time.Now().Add(-time.Second * 20).UTC()
 
 	tok, err := ta.CreateTokenWithValue(ctx,
-		"id0", generateRandomTokenString(tutil.MinimumTokenLength()), "node3", true, []string{"project-1"})
+		"id0", generateRandomTokenString(tutil.MinimumTokenLength()), "node3", true, []string{"project-1"}, time.Time{})
 	require.NoError(t, err)
 	require.NotNilf(t, tok, "expected token 'node3', got token=%v", tok)
 	require.NotZero(t, tok.Value, "expected returned token to have a value")
@@ -208,7 +282,8 @@ func testCreateTokenWithValue(ctx context.Context, t *testing.T, ta tokens.Stora
 	require.Nil(t, err, "expected no error, got err=%v", err)
 	require.NotNil(t, tok2, "expected token 'node3', got token=%v", tok2)
 
-	assert.Equal(t, tok.Value, tok2.Value)
+	assert.Empty(t, tok2.Value, "expected the value to only be revealed on creation")
+	assert.Equal(t, tutil.ValuePrefix(tok.Value), tok2.ValuePrefix)
 	assert.Equal(t, tok.Description, tok2.Description)
 	if !tok2.Created.After(before) {
 		t.Errorf("expected token 'node3' creation time to be after %s, got %s", before, tok2.Created)
@@ -231,8 +306,13 @@ func testCreateLegacyTokenWithValue(ctx context.Context, t *testing.T, ta tokens
 	tok2, err := ta.GetToken(ctx, tok.ID)
 	require.Nil(t, err, "expected no error, got err=%v", err)
 	require.NotNil(t, tok2, "expected token got token=%v", tok2)
-	assert.Equal(t, tok.Value, tok2.Value)
+	assert.Empty(t, tok2.Value, "expected the value to only be revealed on creation")
+	assert.Equal(t, tutil.ValuePrefix(tok.Value), tok2.ValuePrefix)
 	assert.Equal(t, tokens.LegacyTokenDescription, tok2.Description)
+
+	tokID, err := ta.GetTokenIDWithValue(ctx, tok.Value)
+	require.NoError(t, err)
+	assert.Equal(t, tok.ID, tokID)
 	assert.ElementsMatch(t, tok.Projects, tok2.Projects)
 	if !tok2.Created.After(before) {
 		t.Errorf("expected token creation time to be after %s, got %s", before, tok2.Created)
@@ -249,7 +329,7 @@ func generateRandomTokenString(length int) string {
 }
 
 func testDeleteToken(ctx context.Context, t *testing.T, ta tokens.Storage) {
-	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"})
+	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"}, time.Time{})
 	require.Nil(t, err, "expected no error, got err=%v", err)
 
 	err = ta.DeleteToken(ctx, tok0.ID)
@@ -274,7 +354,7 @@ func testDeleteTokenNotFound(ctx context.Context, t *testing.T, ta tokens.Storag
 }
 
 func testUpdateTokenActiveOnly(ctx context.Context, t *testing.T, ta tokens.Storage) {
-	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"})
+	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"}, time.Time{})
 	if err != nil {
 		t.Fatalf("expected no error, got err=%v", err)
 	}
@@ -287,7 +367,7 @@ func testUpdateTokenActiveOnly(ctx context.Context, t *testing.T, ta tokens.Stor
 }
 
 func testUpdateTokenUpdatesAll(ctx context.Context, t *testing.T, ta tokens.Storage) {
-	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"})
+	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"}, time.Time{})
 	if err != nil {
 		t.Fatalf("expected no error, got err=%v", err)
 	}
@@ -311,7 +391,7 @@ func testUpdateTokenNotFound(ctx context.Context, t *testing.T, ta tokens.Storag
 }
 
 func testUpdateTokenUpdatesUpdatedField(ctx context.Context, t *testing.T, ta tokens.Storage) {
-	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"})
+	tok0, err := ta.CreateToken(ctx, "id0", "node1", true, []string{"project-1"}, time.Time{})
 	require.Nil(t, err, "expected no error, got err=%v", err)
 
 	tok, err := ta.UpdateToken(ctx, tok0.ID, "", false, []string{"project-1"})
@@ -16,24 +16,46 @@ const LegacyTokenDescription = "Legacy data collector token ported from A1"
 type Token struct {
 	ID          string
 	Description string
-	Value       string
+	// Value is only known when the token is created, only a salted hash of it
+	// is stored
+	Value string
+	// ValuePrefix is the start of the value, for telling tokens apart
+	ValuePrefix string
 	Active      bool
 	Created     time.Time
 	Updated     time.Time
 	Projects    []string
+	// Expires is when the token stops being accepted, it is the zero time for
+	// tokens that don't expire
+	Expires time.Time
+	// LastUsed and LastUsedIP record the last successful authentication with
+	// the token, they are unset for tokens that were never used
+	LastUsed   time.Time
+	LastUsedIP string
+}
+
+// Expired returns whether the token has expired at the given time
+func (t *Token) Expired(now time.Time) bool {
+	return !t.Expires.IsZero() && !now.Before(t.Expires)
 }
 
 // Storage is the interface for various adapters
 type Storage interface {
-	CreateToken(ctx context.Context, id, description string, active bool, projects []string) (*Token, error)
+	CreateToken(ctx context.Context, id, description string, active bool, projects []string,
+		expires time.Time) (*Token, error)
 	CreateTokenWithValue(ctx context.Context, id, value, description string,
-		active bool, projects []string) (*Token, error)
+		active bool, projects []string, expires time.Time) (*Token, error)
 	CreateLegacyTokenWithValue(ctx context.Context, value string) (*Token, error)
 	DeleteToken(context.Context, string) error
 	UpdateToken(ctx context.Context, id, description string, active bool, projects []string) (*Token, error)
 	GetToken(context.Context, string) (*Token, error)
+	// GetTokenIDWithValue returns the ID of the active, unexpired token with
+	// the value
 	GetTokenIDWithValue(ctx context.Context, value string) (string, error)
 	GetTokens(context.Context) ([]*Token, error)
+	// RecordTokenUse records that the token was used to authenticate a request
+	// from sourceIP
+	RecordTokenUse(ctx context.Context, id, sourceIP string) error
 }
 
 // Resetter allows resetting the adapter to factory settings (e.g. deletes all
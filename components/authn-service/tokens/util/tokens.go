@@ -2,7 +2,10 @@ package util
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 )
 
@@ -46,3 +49,44 @@ func GenerateNewToken() (string, error) {
 	}
 	return base64.URLEncoding.EncodeToString(b), nil
 }
+
+// valuePrefixLength is the number of characters of a token's value that are
+// stored in plaintext, to tell tokens apart and to look them up by value
+const valuePrefixLength = 4
+
+// valueSaltLength is the number of random bytes the hash of a token's value
+// is salted with
+const valueSaltLength = 16
+
+// ValuePrefix returns the part of the token's value that is stored in
+// plaintext. Legacy tokens can be very short, at most half of their value
+// is revealed.
+func ValuePrefix(value string) string {
+	n := valuePrefixLength
+	if len(value)/2 < n {
+		n = len(value) / 2
+	}
+	return value[:n]
+}
+
+// HashValue returns the hash of the token's value, salted with a random salt,
+// and the salt. Both are hex encoded.
+func HashValue(value string) (hash string, salt string, err error) {
+	b := make([]byte, valueSaltLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	salt = hex.EncodeToString(b)
+	return hashValueWithSalt(value, salt), salt, nil
+}
+
+// ValueMatches returns whether the value is the one the hash was computed
+// from
+func ValueMatches(value, hash, salt string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashValueWithSalt(value, salt)), []byte(hash)) == 1
+}
+
+func hashValueWithSalt(value, salt string) string {
+	sum := sha256.Sum256([]byte(salt + value))
+	return hex.EncodeToString(sum[:])
+}
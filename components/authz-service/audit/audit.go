@@ -0,0 +1,90 @@
+// Package audit keeps a log of the authorization decisions made by the IAM v2
+// authorizer, for answering who was allowed to do what, and which policy
+// statements allowed (or denied) it.
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/chef/automate/components/authz-service/engine"
+)
+
+// Decision is an authorization decision, as recorded in the audit log.
+type Decision struct {
+	Time     time.Time `json:"time"`
+	Method   string    `json:"method"`
+	Subjects []string  `json:"subjects"`
+	// SourceIP is the address of the client the request was made by, if known
+	SourceIP string `json:"source_ip,omitempty"`
+	Action   string `json:"action,omitempty"`
+	Resource string `json:"resource,omitempty"`
+	// Projects are the projects requested, if any
+	Projects []string `json:"projects,omitempty"`
+	Allowed  bool     `json:"allowed"`
+	// AuthorizedProjects are the projects the decision allowed, for the
+	// methods that answer with projects
+	AuthorizedProjects []string `json:"authorized_projects,omitempty"`
+	// Matches are the policy statements the decision was based on
+	Matches []engine.Match `json:"matches,omitempty"`
+}
+
+// Result is used for filtering decisions by their outcome.
+type Result int
+
+const (
+	// Any decision matches
+	Any Result = iota
+	// Allowed decisions match
+	Allowed
+	// Denied decisions match
+	Denied
+)
+
+// DefaultLimit is the number of decisions listed if the filter has no limit.
+const DefaultLimit = 100
+
+// Filter selects the decisions to list. Empty fields match everything.
+type Filter struct {
+	// Subject matches decisions made for requests including this subject
+	Subject  string
+	Action   string
+	Resource string
+	Result   Result
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+}
+
+// Match returns true if the filter selects the decision.
+func (f *Filter) Match(d *Decision) bool {
+	switch {
+	case f.Action != "" && f.Action != d.Action,
+		f.Resource != "" && f.Resource != d.Resource,
+		f.Result == Allowed && !d.Allowed,
+		f.Result == Denied && d.Allowed,
+		!f.Since.IsZero() && d.Time.Before(f.Since),
+		!f.Until.IsZero() && d.Time.After(f.Until):
+		return false
+	}
+	if f.Subject == "" {
+		return true
+	}
+	for _, sub := range d.Subjects {
+		if sub == f.Subject {
+			return true
+		}
+	}
+	return false
+}
+
+// Store persists decisions.
+type Store interface {
+	// Write appends the decisions to the log
+	Write(context.Context, []*Decision) error
+
+	// List returns the decisions selected by the filter, most recent first
+	List(context.Context, Filter) ([]*Decision, error)
+
+	Close() error
+}
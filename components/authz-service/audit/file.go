@@ -0,0 +1,180 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultMaxSize    = 100 << 20 // 100MB
+	defaultMaxBackups = 10
+)
+
+// FileStore writes decisions to a file, one JSON object per line. When the
+// file grows beyond its maximum size, it is rotated: path is moved to path.1,
+// path.1 to path.2, and so on, dropping the files beyond the configured
+// number of backups.
+type FileStore struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// NewFileStore opens (or creates) the file at path. Non-positive maxSize and
+// maxBackups are replaced by their defaults.
+func NewFileStore(path string, maxSize int64, maxBackups int) (*FileStore, error) {
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	if maxBackups <= 0 {
+		maxBackups = defaultMaxBackups
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Wrap(err, "create audit log directory")
+	}
+	s := FileStore{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Write appends the decisions to the file, rotating it as needed.
+func (s *FileStore) Write(_ context.Context, ds []*Decision) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, d := range ds {
+		line, err := json.Marshal(d)
+		if err != nil {
+			return errors.Wrap(err, "marshal decision")
+		}
+		line = append(line, '\n')
+
+		if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+			if err := s.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := s.f.Write(line)
+		s.size += int64(n)
+		if err != nil {
+			return errors.Wrap(err, "write audit log")
+		}
+	}
+	return nil
+}
+
+// List reads all decisions from the file and its backups, and returns the
+// most recent ones selected by the filter.
+func (s *FileStore) List(_ context.Context, f Filter) ([]*Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f.Limit <= 0 {
+		f.Limit = DefaultLimit
+	}
+
+	// The most recent matches are kept in a ring buffer of size f.Limit,
+	// with next pointing at the oldest one once it's full.
+	ring := make([]*Decision, 0, f.Limit)
+	next := 0
+	for i := s.maxBackups; i >= 0; i-- {
+		err := s.scan(s.name(i), func(d *Decision) {
+			if !f.Match(d) {
+				return
+			}
+			if len(ring) < f.Limit {
+				ring = append(ring, d)
+				return
+			}
+			ring[next] = d
+			next = (next + 1) % f.Limit
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ds := make([]*Decision, 0, len(ring))
+	for i := len(ring) - 1; i >= 0; i-- {
+		ds = append(ds, ring[(next+i)%len(ring)])
+	}
+	return ds, nil
+}
+
+// Close closes the file.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
+
+func (s *FileStore) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "open audit log")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close() // nolint: errcheck
+		return errors.Wrap(err, "stat audit log")
+	}
+	s.f = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileStore) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return errors.Wrap(err, "close audit log")
+	}
+	for i := s.maxBackups - 1; i >= 0; i-- {
+		err := os.Rename(s.name(i), s.name(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "rotate audit log")
+		}
+	}
+	return s.open()
+}
+
+// name returns the name of the i-th backup, with 0 being the current file
+func (s *FileStore) name(i int) string {
+	if i == 0 {
+		return s.path
+	}
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+func (s *FileStore) scan(name string, fn func(*Decision)) error {
+	f, err := os.Open(name)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "open audit log")
+	}
+	defer f.Close() // nolint: errcheck
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16<<20)
+	for scanner.Scan() {
+		var d Decision
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			continue // skip partially written lines
+		}
+		fn(&d)
+	}
+	return errors.Wrapf(scanner.Err(), "read audit log %s", name)
+}
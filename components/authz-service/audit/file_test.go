@@ -0,0 +1,125 @@
+package audit_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/authz-service/audit"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	decisions := []*audit.Decision{
+		{Time: now.Add(-3 * time.Hour), Method: "IsAuthorized", Subjects: []string{"user:local:alice", "team:local:admins"},
+			Action: "iam:users:delete", Resource: "iam:users:bob", Allowed: true},
+		{Time: now.Add(-2 * time.Hour), Method: "IsAuthorized", Subjects: []string{"user:local:bob"},
+			Action: "iam:users:delete", Resource: "iam:users:alice", Allowed: false},
+		{Time: now.Add(-1 * time.Hour), Method: "IsAuthorized", Subjects: []string{"token:ci"},
+			Action: "infra:nodes:list", Resource: "infra:nodes", Allowed: true},
+	}
+
+	t.Run("lists decisions selected by filter, most recent first", func(t *testing.T) {
+		s := newFileStore(t, 0, 0)
+		defer s.cleanup()
+		require.NoError(t, s.Write(ctx, decisions))
+
+		cases := map[string]struct {
+			filter   audit.Filter
+			expected []*audit.Decision
+		}{
+			"no filter": {audit.Filter{}, []*audit.Decision{decisions[2], decisions[1], decisions[0]}},
+			"limit":     {audit.Filter{Limit: 2}, []*audit.Decision{decisions[2], decisions[1]}},
+			"subject":   {audit.Filter{Subject: "team:local:admins"}, []*audit.Decision{decisions[0]}},
+			"action":    {audit.Filter{Action: "iam:users:delete"}, []*audit.Decision{decisions[1], decisions[0]}},
+			"resource":  {audit.Filter{Resource: "infra:nodes"}, []*audit.Decision{decisions[2]}},
+			"allowed":   {audit.Filter{Result: audit.Allowed}, []*audit.Decision{decisions[2], decisions[0]}},
+			"denied":    {audit.Filter{Result: audit.Denied}, []*audit.Decision{decisions[1]}},
+			"time range": {audit.Filter{Since: now.Add(-150 * time.Minute), Until: now.Add(-90 * time.Minute)},
+				[]*audit.Decision{decisions[1]}},
+			"nothing matching": {audit.Filter{Subject: "user:local:eve"}, []*audit.Decision{}},
+		}
+		for name, tc := range cases {
+			t.Run(name, func(t *testing.T) {
+				actual, err := s.List(ctx, tc.filter)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, actual)
+			})
+		}
+	})
+
+	t.Run("rotates file, keeping the configured number of backups", func(t *testing.T) {
+		s := newFileStore(t, 300, 2)
+		defer s.cleanup()
+		var all []*audit.Decision
+		for i := 0; i < 20; i++ {
+			d := &audit.Decision{
+				Time:     now.Add(time.Duration(i) * time.Second),
+				Method:   "IsAuthorized",
+				Subjects: []string{fmt.Sprintf("user:local:user-%d", i)},
+				Action:   "iam:users:get",
+				Resource: "iam:users",
+			}
+			all = append(all, d)
+			require.NoError(t, s.Write(ctx, []*audit.Decision{d}))
+		}
+
+		files, err := filepath.Glob(filepath.Join(s.dir, "*"))
+		require.NoError(t, err)
+		assert.Len(t, files, 3)
+
+		actual, err := s.List(ctx, audit.Filter{Limit: 20})
+		require.NoError(t, err)
+		require.NotEmpty(t, actual)
+		assert.True(t, len(actual) < len(all), "oldest decisions have been dropped")
+		assert.Equal(t, all[len(all)-1], actual[0])
+		for i := 1; i < len(actual); i++ {
+			assert.True(t, actual[i].Time.Before(actual[i-1].Time), "ordered by time")
+		}
+	})
+
+	t.Run("appends to existing file", func(t *testing.T) {
+		s := newFileStore(t, 0, 0)
+		defer s.cleanup()
+		require.NoError(t, s.Write(ctx, decisions[:1]))
+		require.NoError(t, s.Close())
+
+		s2, err := audit.NewFileStore(s.path, 0, 0)
+		require.NoError(t, err)
+		defer s2.Close()
+		require.NoError(t, s2.Write(ctx, decisions[1:2]))
+
+		actual, err := s2.List(ctx, audit.Filter{})
+		require.NoError(t, err)
+		assert.Equal(t, []*audit.Decision{decisions[1], decisions[0]}, actual)
+	})
+}
+
+type testFileStore struct {
+	*audit.FileStore
+	dir  string
+	path string
+}
+
+func newFileStore(t *testing.T, maxSize int64, maxBackups int) testFileStore {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "decisions.log")
+	s, err := audit.NewFileStore(path, maxSize, maxBackups)
+	require.NoError(t, err)
+	return testFileStore{FileStore: s, dir: dir, path: path}
+}
+
+func (s testFileStore) cleanup() {
+	s.Close()           // nolint: errcheck
+	os.RemoveAll(s.dir) // nolint: errcheck
+}
@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/chef/automate/components/authz-service/audit"
+	storage_errors "github.com/chef/automate/components/authz-service/storage"
+	"github.com/chef/automate/components/authz-service/storage/postgres"
+	"github.com/chef/automate/components/authz-service/storage/postgres/migration"
+	"github.com/chef/automate/lib/logger"
+)
+
+// pruneInterval is how often decisions older than the retention period are
+// deleted
+const pruneInterval = time.Hour
+
+type pg struct {
+	db        *sql.DB
+	log       logger.Logger
+	retention time.Duration
+	done      chan struct{}
+}
+
+// New returns a store writing decisions to the iam_authz_decisions table.
+// Decisions older than retention are deleted periodically; if retention is
+// zero, they are kept forever.
+func New(ctx context.Context, l logger.Logger, migConf migration.Config,
+	retention time.Duration) (audit.Store, error) {
+
+	db, err := postgres.New(ctx, migConf)
+	if err != nil {
+		return nil, err
+	}
+
+	p := pg{db: db, log: l, retention: retention, done: make(chan struct{})}
+	if retention > 0 {
+		go p.pruneLoop()
+	}
+	return &p, nil
+}
+
+// Write inserts the decisions in one transaction.
+func (p *pg) Write(ctx context.Context, ds []*audit.Decision) error {
+	tx, err := p.db.BeginTx(ctx, nil /* use driver default */)
+	if err != nil {
+		return postgres.ProcessError(err)
+	}
+	defer tx.Rollback() // nolint: errcheck
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO iam_authz_decisions
+  (time, method, subjects, source_ip, action, resource, projects, allowed, authorized_projects, matches)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`)
+	if err != nil {
+		return postgres.ProcessError(err)
+	}
+	defer stmt.Close() // nolint: errcheck
+
+	for _, d := range ds {
+		matches, err := json.Marshal(d.Matches)
+		if err != nil {
+			return errors.Wrap(err, "marshal matches")
+		}
+		_, err = stmt.ExecContext(ctx, d.Time, d.Method, pq.Array(d.Subjects), d.SourceIP,
+			d.Action, d.Resource, pq.Array(nonNil(d.Projects)), d.Allowed,
+			pq.Array(nonNil(d.AuthorizedProjects)), matches)
+		if err != nil {
+			return postgres.ProcessError(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return storage_errors.NewErrTxCommit(err)
+	}
+	return nil
+}
+
+// List queries the decisions selected by the filter, most recent first.
+func (p *pg) List(ctx context.Context, f audit.Filter) ([]*audit.Decision, error) {
+	if f.Limit <= 0 {
+		f.Limit = audit.DefaultLimit
+	}
+
+	var conds []string
+	var args []interface{}
+	cond := func(c string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(c, len(args)))
+	}
+	if f.Subject != "" {
+		cond("$%d = ANY(subjects)", f.Subject)
+	}
+	if f.Action != "" {
+		cond("action = $%d", f.Action)
+	}
+	if f.Resource != "" {
+		cond("resource = $%d", f.Resource)
+	}
+	switch f.Result {
+	case audit.Allowed:
+		cond("allowed = $%d", true)
+	case audit.Denied:
+		cond("allowed = $%d", false)
+	}
+	if !f.Since.IsZero() {
+		cond("time >= $%d", f.Since)
+	}
+	if !f.Until.IsZero() {
+		cond("time <= $%d", f.Until)
+	}
+
+	query := `SELECT time, method, subjects, source_ip, action, resource, projects, allowed, authorized_projects, matches
+  FROM iam_authz_decisions`
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	args = append(args, f.Limit)
+	query += fmt.Sprintf(" ORDER BY time DESC, db_id DESC LIMIT $%d", len(args))
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, postgres.ProcessError(err)
+	}
+	defer rows.Close() // nolint: errcheck
+
+	ds := []*audit.Decision{}
+	for rows.Next() {
+		var d audit.Decision
+		var matches []byte
+		err := rows.Scan(&d.Time, &d.Method, pq.Array(&d.Subjects), &d.SourceIP, &d.Action,
+			&d.Resource, pq.Array(&d.Projects), &d.Allowed,
+			pq.Array(&d.AuthorizedProjects), &matches)
+		if err != nil {
+			return nil, postgres.ProcessError(err)
+		}
+		if err := json.Unmarshal(matches, &d.Matches); err != nil {
+			return nil, errors.Wrap(err, "unmarshal matches")
+		}
+		ds = append(ds, &d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.ProcessError(err)
+	}
+	return ds, nil
+}
+
+// Close stops pruning, and closes the database connection.
+func (p *pg) Close() error {
+	close(p.done)
+	return p.db.Close()
+}
+
+func (p *pg) pruneLoop() {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		if err := p.prune(context.Background()); err != nil {
+			p.log.WithError(err).Error("could not prune audit log")
+		}
+		select {
+		case <-ticker.C:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *pg) prune(ctx context.Context) error {
+	res, err := p.db.ExecContext(ctx,
+		`DELETE FROM iam_authz_decisions WHERE time < $1`, time.Now().Add(-p.retention))
+	if err != nil {
+		return postgres.ProcessError(err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n > 0 {
+		p.log.WithField("decisions", n).Debug("pruned audit log")
+	}
+	return nil
+}
+
+func nonNil(ss []string) []string {
+	if ss == nil {
+		return []string{}
+	}
+	return ss
+}
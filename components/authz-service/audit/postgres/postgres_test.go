@@ -0,0 +1,143 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/authz-service/audit"
+	"github.com/chef/automate/components/authz-service/audit/postgres"
+	"github.com/chef/automate/components/authz-service/engine"
+	"github.com/chef/automate/components/authz-service/storage/postgres/migration"
+	"github.com/chef/automate/lib/logger"
+)
+
+// Note: see storage/v2/postgres/postgres_test.go for how to run these tests
+// against a local database.
+
+func TestWriteAndList(t *testing.T) {
+	ctx := context.Background()
+	store := setup(t)
+	defer store.Close()
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	decisions := []*audit.Decision{
+		{Time: now.Add(-3 * time.Hour), Method: "IsAuthorized", Subjects: []string{"user:local:alice", "team:local:admins"},
+			Action: "iam:users:delete", Resource: "iam:users:bob", Allowed: true,
+			Matches: []engine.Match{{Effect: "allow", PolicyID: "administrator-access", StatementID: "s1"}}},
+		{Time: now.Add(-2 * time.Hour), Method: "ProjectsAuthorized", Subjects: []string{"user:local:bob"},
+			Action: "iam:users:delete", Resource: "iam:users:alice", Projects: []string{"p1"},
+			Allowed: false, AuthorizedProjects: []string{}},
+		{Time: now.Add(-1 * time.Hour), Method: "FilterAuthorizedProjects", Subjects: []string{"token:ci"},
+			Allowed: true, AuthorizedProjects: []string{"p1", "p2"}},
+	}
+	require.NoError(t, store.Write(ctx, decisions))
+
+	// what we expect to read back
+	for _, d := range decisions {
+		if d.Projects == nil {
+			d.Projects = []string{}
+		}
+		if d.AuthorizedProjects == nil {
+			d.AuthorizedProjects = []string{}
+		}
+	}
+
+	cases := map[string]struct {
+		filter   audit.Filter
+		expected []*audit.Decision
+	}{
+		"no filter": {audit.Filter{}, []*audit.Decision{decisions[2], decisions[1], decisions[0]}},
+		"limit":     {audit.Filter{Limit: 1}, []*audit.Decision{decisions[2]}},
+		"subject":   {audit.Filter{Subject: "team:local:admins"}, []*audit.Decision{decisions[0]}},
+		"action":    {audit.Filter{Action: "iam:users:delete"}, []*audit.Decision{decisions[1], decisions[0]}},
+		"resource":  {audit.Filter{Resource: "iam:users:alice"}, []*audit.Decision{decisions[1]}},
+		"allowed":   {audit.Filter{Result: audit.Allowed}, []*audit.Decision{decisions[2], decisions[0]}},
+		"denied":    {audit.Filter{Result: audit.Denied}, []*audit.Decision{decisions[1]}},
+		"time range": {audit.Filter{Since: now.Add(-150 * time.Minute), Until: now.Add(-90 * time.Minute)},
+			[]*audit.Decision{decisions[1]}},
+		"nothing matching": {audit.Filter{Subject: "user:local:eve"}, []*audit.Decision{}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := store.List(ctx, tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, len(tc.expected), len(actual))
+			for i := range actual {
+				assert.True(t, tc.expected[i].Time.Equal(actual[i].Time))
+				actual[i].Time = tc.expected[i].Time
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func setup(t *testing.T) audit.Store {
+	t.Helper()
+	ctx := context.Background()
+	l, err := logger.NewLogger("text", "error")
+	require.NoError(t, err, "init logger for postgres storage")
+
+	migrationConfig, err := migrationConfigIfPGTestsToBeRun(l, "../../storage/postgres/migration/sql")
+	if err != nil {
+		t.Fatalf("couldn't initialize pg config for tests: %s", err.Error())
+	}
+	if migrationConfig == nil {
+		t.Skipf("start pg container and set PG_URL to run")
+	}
+
+	store, err := postgres.New(ctx, l, *migrationConfig, 0)
+	require.NoError(t, err)
+
+	db, err := sql.Open("postgres", migrationConfig.PGURL.String())
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.ExecContext(ctx, "DELETE FROM iam_authz_decisions")
+	require.NoError(t, err)
+
+	return store
+}
+
+// migrationConfigIfPGTestsToBeRun either returns the pg migration config
+// if PG_URL is set or we are in CI, otherwise it returns nil, indicating
+// postgres based tests shouldn't be run.
+func migrationConfigIfPGTestsToBeRun(l logger.Logger, migrationPath string) (*migration.Config, error) {
+	customPGURL, pgURLPassed := os.LookupEnv("PG_URL")
+	ciMode := os.Getenv("CI") == "true"
+
+	// If in CI mode, use the default
+	if ciMode {
+		pgURL, err := url.Parse("postgres://postgres@127.0.0.1:5432/authz_test?sslmode=disable")
+		if err != nil {
+			return nil, err
+		}
+		return &migration.Config{
+			Path:   migrationPath,
+			Logger: l,
+			PGURL:  pgURL,
+		}, nil
+	}
+
+	// If PG_URL wasn't passed (and we aren't in CI)
+	// we shouldn't run the postgres tests, return nil.
+	if !pgURLPassed {
+		return nil, nil
+	}
+
+	pgURL, err := url.Parse(customPGURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &migration.Config{
+		Path:   migrationPath,
+		Logger: l,
+		PGURL:  pgURL,
+	}, nil
+}
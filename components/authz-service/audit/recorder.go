@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chef/automate/components/authz-service/engine"
+	"github.com/chef/automate/lib/logger"
+)
+
+const (
+	defaultQueueSize = 1024
+	maxBatchSize     = 100
+)
+
+// Config controls which decisions get recorded. Sample rates are between 0
+// (record none) and 1 (record all).
+type Config struct {
+	AllowedSampleRate float64
+	DeniedSampleRate  float64
+	// QueueSize is the number of decisions buffered for writing; if the store
+	// cannot keep up, further decisions are dropped
+	QueueSize int
+}
+
+// Recorder records decisions without blocking the authorization requests
+// they're made in: they are queued, and written to the store in the
+// background.
+type Recorder struct {
+	log     logger.Logger
+	store   Store
+	matcher engine.V2Matcher
+	cfg     Config
+
+	queue   chan *Decision
+	dropped uint64
+	done    chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewRecorder returns a Recorder writing to store, and starts its background
+// writer. The matcher is used for looking up the policy statements each
+// decision was based on.
+func NewRecorder(l logger.Logger, store Store, matcher engine.V2Matcher, cfg Config) *Recorder {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	r := &Recorder{
+		log:     l,
+		store:   store,
+		matcher: matcher,
+		cfg:     cfg,
+		queue:   make(chan *Decision, cfg.QueueSize),
+		done:    make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Record queues the decision for writing, if it's sampled. The policy
+// statements the decision was based on are looked up by the background
+// writer, so recording doesn't cost the request another policy evaluation.
+func (r *Recorder) Record(_ context.Context, d *Decision) {
+	if !r.sampled(d.Allowed) {
+		return
+	}
+	if d.Time.IsZero() {
+		d.Time = time.Now().UTC()
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.queue <- d:
+	default:
+		atomic.AddUint64(&r.dropped, 1)
+	}
+}
+
+// List returns the recorded decisions selected by the filter, most recent
+// first.
+func (r *Recorder) List(ctx context.Context, f Filter) ([]*Decision, error) {
+	if f.Limit <= 0 {
+		f.Limit = DefaultLimit
+	}
+	return r.store.List(ctx, f)
+}
+
+// Close writes all queued decisions, and closes the store.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	<-r.done
+	return r.store.Close()
+}
+
+func (r *Recorder) sampled(allowed bool) bool {
+	rate := r.cfg.DeniedSampleRate
+	if allowed {
+		rate = r.cfg.AllowedSampleRate
+	}
+	switch {
+	case rate >= 1:
+		return true
+	case rate <= 0:
+		return false
+	}
+	return rand.Float64() < rate
+}
+
+func (r *Recorder) run() {
+	defer close(r.done)
+	batch := make([]*Decision, 0, maxBatchSize)
+	for d := range r.queue {
+		batch = append(batch[:0], d)
+	collect: // whatever else has been queued in the meantime
+		for len(batch) < maxBatchSize {
+			select {
+			case d, ok := <-r.queue:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, d)
+			default:
+				break collect
+			}
+		}
+		r.addMatches(batch)
+		if err := r.store.Write(context.Background(), batch); err != nil {
+			r.log.WithError(err).WithField("decisions", len(batch)).Error("could not write to audit log")
+		}
+		if n := atomic.SwapUint64(&r.dropped, 0); n > 0 {
+			r.log.WithField("decisions", n).Warn("audit log queue full, dropped decisions")
+		}
+	}
+}
+
+// addMatches looks up the policy statements the decisions were based on. The
+// statements' conditions are checked against the time and source IP of the
+// decision.
+// Note: this happens shortly after the decisions were made, so if the policies
+// have been changed in the meantime, the statements might not be the ones in
+// effect when a decision was made.
+func (r *Recorder) addMatches(batch []*Decision) {
+	for _, d := range batch {
+		if d.Action == "" || d.Resource == "" {
+			continue
+		}
+		ctx := engine.WithEnvironment(context.Background(),
+			engine.Environment{Time: d.Time, SourceIP: d.SourceIP})
+		matches, err := r.matcher.V2MatchingStatements(ctx,
+			engine.Subjects(d.Subjects), engine.Action(d.Action), engine.Resource(d.Resource))
+		if err != nil {
+			r.log.WithError(err).Warn("could not look up matching statements for audit log")
+			continue
+		}
+		d.Matches = matches
+	}
+}
@@ -0,0 +1,169 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/authz-service/audit"
+	"github.com/chef/automate/components/authz-service/engine"
+	"github.com/chef/automate/components/authz-service/engine/opa"
+	"github.com/chef/automate/lib/logger"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	l, err := logger.NewLogger("text", "error")
+	require.NoError(t, err)
+	matches := []engine.Match{{Effect: "allow", PolicyID: "admin", StatementID: "s1"}}
+
+	t.Run("records sampled decisions with matching statements", func(t *testing.T) {
+		store := &memStore{}
+		rec := audit.NewRecorder(l, store, &testMatcher{matches: matches}, audit.Config{
+			AllowedSampleRate: 1,
+			DeniedSampleRate:  0,
+		})
+		rec.Record(ctx, &audit.Decision{Method: "IsAuthorized", Subjects: []string{"user:local:alice"},
+			Action: "iam:users:get", Resource: "iam:users", Allowed: true})
+		rec.Record(ctx, &audit.Decision{Method: "IsAuthorized", Subjects: []string{"user:local:bob"},
+			Action: "iam:users:get", Resource: "iam:users", Allowed: false})
+		rec.Record(ctx, &audit.Decision{Method: "FilterAuthorizedProjects", Subjects: []string{"user:local:alice"},
+			Allowed: true, AuthorizedProjects: []string{"p1"}})
+		require.NoError(t, rec.Close())
+
+		require.Len(t, store.decisions, 2)
+		assert.Equal(t, []string{"user:local:alice"}, store.decisions[0].Subjects)
+		assert.Equal(t, matches, store.decisions[0].Matches)
+		assert.False(t, store.decisions[0].Time.IsZero())
+		assert.Nil(t, store.decisions[1].Matches, "no lookup without action and resource")
+		assert.True(t, store.closed)
+	})
+
+	t.Run("records decisions without matches if the lookup fails", func(t *testing.T) {
+		store := &memStore{}
+		rec := audit.NewRecorder(l, store, &testMatcher{err: errors.New("boom")}, audit.Config{
+			AllowedSampleRate: 1,
+			DeniedSampleRate:  1,
+		})
+		rec.Record(ctx, &audit.Decision{Method: "IsAuthorized", Subjects: []string{"user:local:bob"},
+			Action: "iam:users:get", Resource: "iam:users", Allowed: false})
+		require.NoError(t, rec.Close())
+
+		require.Len(t, store.decisions, 1)
+		assert.Nil(t, store.decisions[0].Matches)
+	})
+
+	t.Run("looks up matching statements in the background", func(t *testing.T) {
+		store := &memStore{}
+		matcher := &testMatcher{matches: matches, block: make(chan struct{})}
+		rec := audit.NewRecorder(l, store, matcher, audit.Config{AllowedSampleRate: 1})
+		rec.Record(ctx, &audit.Decision{Method: "FilterAuthorizedPairs", Subjects: []string{"user:local:alice"},
+			Action: "iam:users:get", Resource: "iam:users", Allowed: true})
+
+		close(matcher.block) // Record has returned without waiting for the lookup
+		require.NoError(t, rec.Close())
+		require.Len(t, store.decisions, 1)
+		assert.Equal(t, matches, store.decisions[0].Matches)
+	})
+
+	t.Run("checks statement conditions against the decision's time and source IP", func(t *testing.T) {
+		e, err := opa.New(ctx, l)
+		require.NoError(t, err)
+		decided := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+		require.NoError(t, e.V2SetPolicies(ctx, map[string]interface{}{
+			"office-hours": map[string]interface{}{
+				"members": []string{"user:local:alice"},
+				"statements": map[string]interface{}{
+					"s1": map[string]interface{}{
+						"effect":    "allow",
+						"actions":   []string{"iam:users:get"},
+						"resources": []string{"iam:users"},
+						"conditions": map[string]interface{}{
+							"time_windows": []interface{}{map[string]interface{}{
+								"start_ns": decided.Add(-time.Hour).UnixNano(),
+								"end_ns":   decided.Add(time.Hour).UnixNano(),
+							}},
+							"source_ips": []string{"10.0.0.0/8"},
+						},
+					},
+				},
+			},
+		}, map[string]interface{}{}, map[string][]interface{}{}))
+
+		store := &memStore{}
+		rec := audit.NewRecorder(l, store, e, audit.Config{AllowedSampleRate: 1, DeniedSampleRate: 1})
+		decision := func(sourceIP string) *audit.Decision {
+			return &audit.Decision{Time: decided, Method: "IsAuthorized", Subjects: []string{"user:local:alice"},
+				SourceIP: sourceIP, Action: "iam:users:get", Resource: "iam:users", Allowed: sourceIP == "10.1.2.3"}
+		}
+		rec.Record(ctx, decision("10.1.2.3"))
+		rec.Record(ctx, decision("192.0.2.10"))
+		require.NoError(t, rec.Close())
+
+		require.Len(t, store.decisions, 2)
+		assert.Equal(t, []engine.Match{{Effect: "allow", PolicyID: "office-hours", StatementID: "s1"}},
+			store.decisions[0].Matches, "the decision was made in the time window, from an allowed address")
+		assert.Empty(t, store.decisions[1].Matches, "the decision was made from another address")
+	})
+
+	t.Run("ignores decisions after close", func(t *testing.T) {
+		store := &memStore{}
+		rec := audit.NewRecorder(l, store, &testMatcher{}, audit.Config{AllowedSampleRate: 1})
+		require.NoError(t, rec.Close())
+		rec.Record(ctx, &audit.Decision{Method: "IsAuthorized", Allowed: true})
+		assert.Empty(t, store.decisions)
+	})
+
+	t.Run("lists decisions using the default limit", func(t *testing.T) {
+		store := &memStore{}
+		rec := audit.NewRecorder(l, store, &testMatcher{}, audit.Config{})
+		defer rec.Close()
+		_, err := rec.List(ctx, audit.Filter{Subject: "user:local:alice"})
+		require.NoError(t, err)
+		assert.Equal(t, audit.Filter{Subject: "user:local:alice", Limit: audit.DefaultLimit}, store.listed)
+	})
+}
+
+type memStore struct {
+	sync.Mutex
+	decisions []*audit.Decision
+	listed    audit.Filter
+	closed    bool
+}
+
+func (m *memStore) Write(_ context.Context, ds []*audit.Decision) error {
+	m.Lock()
+	defer m.Unlock()
+	m.decisions = append(m.decisions, ds...)
+	return nil
+}
+
+func (m *memStore) List(_ context.Context, f audit.Filter) ([]*audit.Decision, error) {
+	m.listed = f
+	return nil, nil
+}
+
+func (m *memStore) Close() error {
+	m.closed = true
+	return nil
+}
+
+type testMatcher struct {
+	matches []engine.Match
+	err     error
+	// block, if set, makes lookups wait until it is closed
+	block chan struct{}
+}
+
+func (m *testMatcher) V2MatchingStatements(context.Context,
+	engine.Subjects, engine.Action, engine.Resource) ([]engine.Match, error) {
+	if m.block != nil {
+		<-m.block
+	}
+	return m.matches, m.err
+}
@@ -5,11 +5,15 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/chef/automate/components/authz-service/audit"
+	audit_postgres "github.com/chef/automate/components/authz-service/audit/postgres"
+	"github.com/chef/automate/components/authz-service/engine"
 	"github.com/chef/automate/components/authz-service/engine/opa"
 	"github.com/chef/automate/components/authz-service/server"
 	"github.com/chef/automate/components/authz-service/storage/postgres/datamigration"
@@ -39,11 +43,27 @@ type config struct {
 	LogFormat          string `mapstructure:"log-format"`
 	LogLevel           string `mapstructure:"log-level"`
 	certs.TLSConfig    `mapstructure:"tls"`
-	PGURL              string `mapstructure:"pg_url"`
-	Database           string `mapstructure:"database"`
-	MigrationsPath     string `mapstructure:"migrations-path"`
-	DataMigrationsPath string `mapstructure:"data-migrations-path"`
-	EventAddress       string `mapstructure:"event-address"`
+	PGURL              string      `mapstructure:"pg_url"`
+	Database           string      `mapstructure:"database"`
+	MigrationsPath     string      `mapstructure:"migrations-path"`
+	DataMigrationsPath string      `mapstructure:"data-migrations-path"`
+	EventAddress       string      `mapstructure:"event-address"`
+	Audit              auditConfig `mapstructure:"audit"`
+}
+
+// auditConfig configures the audit log of IAM v2 authorization decisions
+type auditConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Store is either "file" or "postgresql"
+	Store string `mapstructure:"store"`
+	// Path, MaxSizeMB and MaxBackups configure the file store
+	Path       string `mapstructure:"path"`
+	MaxSizeMB  int64  `mapstructure:"max-size-mb"`
+	MaxBackups int    `mapstructure:"max-backups"`
+	// RetentionDays configures the postgresql store
+	RetentionDays     int     `mapstructure:"retention-days"`
+	AllowedSampleRate float64 `mapstructure:"allowed-sample-rate"`
+	DeniedSampleRate  float64 `mapstructure:"denied-sample-rate"`
 }
 
 func serve(_ *cobra.Command, args []string) {
@@ -113,9 +133,40 @@ Please pass a config file as the only argument to this command.`))
 		Logger: l,
 	}
 
+	var rec *audit.Recorder
+	if cfg.Audit.Enabled {
+		rec, err = newAuditRecorder(ctx, l, engine, migrationConfig, cfg.Audit)
+		if err != nil {
+			fail(errors.Wrap(err, "could not initialize audit log"))
+		}
+	}
+
 	// if server.GRPC() returns, it's with an error
 	fail(server.GRPC(ctx, cfg.GRPC, l, connFactory, engine, migrationConfig,
-		dataMigrationConfig, cfg.EventAddress))
+		dataMigrationConfig, cfg.EventAddress, rec))
+}
+
+func newAuditRecorder(ctx context.Context, l logger.Logger, m engine.V2Matcher,
+	migrationConfig migration.Config, cfg auditConfig) (*audit.Recorder, error) {
+	var store audit.Store
+	var err error
+	switch cfg.Store {
+	case "file":
+		store, err = audit.NewFileStore(cfg.Path, cfg.MaxSizeMB<<20, cfg.MaxBackups)
+	case "postgresql":
+		retention := time.Duration(cfg.RetentionDays) * 24 * time.Hour
+		store, err = audit_postgres.New(ctx, l, migrationConfig, retention)
+	default:
+		err = fmt.Errorf("unknown audit log store %q", cfg.Store)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return audit.NewRecorder(l, store, m, audit.Config{
+		AllowedSampleRate: cfg.AllowedSampleRate,
+		DeniedSampleRate:  cfg.DeniedSampleRate,
+	}), nil
 }
 
 // fail outputs the error and exits with a non-zero code
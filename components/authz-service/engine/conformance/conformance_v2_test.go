@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -79,6 +80,100 @@ func TestV2IsAuthorized(t *testing.T) {
 	}
 }
 
+func TestV2IsAuthorizedWithConditions(t *testing.T) {
+	ctx, engines := setup(t)
+	sub, act, res := "user:local:admin", "iam:users:create", "iam:users"
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	args := func(env engine.Environment) (context.Context, engine.Subjects, engine.Action, engine.Resource) {
+		return engine.WithEnvironment(ctx, env),
+			engine.Subject(sub), engine.Action(act), engine.Resource(res)
+	}
+	policy := func(conditions map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"members": engine.Subject(sub),
+			"statements": map[string]interface{}{
+				"statement-id-0": map[string]interface{}{
+					"actions":    []string{act},
+					"resources":  []string{res},
+					"effect":     "allow",
+					"conditions": conditions,
+				},
+			},
+		}
+	}
+	window := func(start, end time.Time) map[string]interface{} {
+		return map[string]interface{}{"start_ns": start.UnixNano(), "end_ns": end.UnixNano()}
+	}
+
+	cases := map[string]struct {
+		conditions map[string]interface{}
+		env        engine.Environment
+		expected   bool
+	}{
+		"request within time window": {
+			conditions: map[string]interface{}{
+				"time_windows": []interface{}{window(now.Add(-time.Hour), now.Add(time.Hour))},
+			},
+			env:      engine.Environment{Time: now},
+			expected: true,
+		},
+		"request after time window": {
+			conditions: map[string]interface{}{
+				"time_windows": []interface{}{window(now.Add(-2*time.Hour), now.Add(-time.Hour))},
+			},
+			env:      engine.Environment{Time: now},
+			expected: false,
+		},
+		"request from allowed source IP": {
+			conditions: map[string]interface{}{"source_ips": []string{"10.0.0.0/8"}},
+			env:        engine.Environment{Time: now, SourceIP: "10.1.2.3"},
+			expected:   true,
+		},
+		"request from other source IP": {
+			conditions: map[string]interface{}{"source_ips": []string{"10.0.0.0/8"}},
+			env:        engine.Environment{Time: now, SourceIP: "192.168.1.1"},
+			expected:   false,
+		},
+		"request from unknown source IP": {
+			conditions: map[string]interface{}{"source_ips": []string{"10.0.0.0/8"}},
+			env:        engine.Environment{Time: now},
+			expected:   false,
+		},
+		"request by allowed subject type": {
+			conditions: map[string]interface{}{"subject_types": []string{"user"}},
+			env:        engine.Environment{Time: now},
+			expected:   true,
+		},
+		"request by other subject type": {
+			conditions: map[string]interface{}{"subject_types": []string{"token"}},
+			env:        engine.Environment{Time: now},
+			expected:   false,
+		},
+		"all conditions have to be met": {
+			conditions: map[string]interface{}{
+				"time_windows": []interface{}{window(now.Add(-time.Hour), now.Add(time.Hour))},
+				"source_ips":   []string{"10.0.0.0/8"},
+			},
+			env:      engine.Environment{Time: now, SourceIP: "192.168.1.1"},
+			expected: false,
+		},
+	}
+
+	for desc, e := range engines {
+		t.Run(desc, func(t *testing.T) {
+			for name, tc := range cases {
+				t.Run(name, func(t *testing.T) {
+					setPoliciesV2(t, e, policy(tc.conditions))
+					actual, err := e.V2IsAuthorized(args(tc.env))
+					require.NoError(t, err)
+					assert.Equal(t, tc.expected, actual)
+				})
+			}
+		})
+	}
+}
+
 func TestV2ProjectsAuthorized(t *testing.T) {
 	ctx, engines := setup(t)
 	sub, act, res, proj1, proj2 := "user:local:admin", "iam:users:create", "iam:users", "proj-1", "proj-2"
@@ -162,6 +257,217 @@ func TestV2ProjectsAuthorized(t *testing.T) {
 	}
 }
 
+func TestV2MatchingStatements(t *testing.T) {
+	ctx, engines := setup(t)
+	sub, act, res := "user:local:admin", "iam:users:create", "iam:users"
+
+	args := func() (context.Context, engine.Subjects, engine.Action, engine.Resource) {
+		return ctx, engine.Subject(sub), engine.Action(act), engine.Resource(res)
+	}
+
+	for desc, e := range engines {
+		t.Run(desc, func(t *testing.T) {
+			t.Run("when the store is empty, returns empty list", func(t *testing.T) {
+				actual, err := e.V2MatchingStatements(args())
+				require.NoError(t, err)
+				assert.Empty(t, actual)
+			})
+
+			t.Run("returns allow and deny statements matching, of policies the subject is a member of", func(t *testing.T) {
+				policy0 := map[string]interface{}{
+					"members": engine.Subject(sub),
+					"statements": map[string]interface{}{
+						"statement-id-0": map[string]interface{}{
+							"actions":   []string{act},
+							"resources": []string{res},
+							"effect":    "allow",
+						},
+						"statement-id-1": map[string]interface{}{
+							"actions":   []string{"iam:users:delete"},
+							"resources": []string{res},
+							"effect":    "deny",
+						},
+					},
+				}
+				policy1 := map[string]interface{}{
+					"members": engine.Subject(sub),
+					"statements": map[string]interface{}{
+						"statement-id-2": map[string]interface{}{
+							"role":      "handyman",
+							"resources": []string{"iam:*"},
+							"effect":    "deny",
+						},
+					},
+				}
+				policy2 := map[string]interface{}{
+					"members": engine.Subject("user:local:someone-else"),
+					"statements": map[string]interface{}{
+						"statement-id-3": map[string]interface{}{
+							"actions":   []string{act},
+							"resources": []string{res},
+							"effect":    "allow",
+						},
+					},
+				}
+				role := map[string]interface{}{
+					"id":      "handyman",
+					"actions": []string{"iam:users:*"},
+				}
+				setPoliciesV2(t, e, policy0, policy1, policy2, role)
+
+				actual, err := e.V2MatchingStatements(args())
+				require.NoError(t, err)
+				assert.ElementsMatch(t, []engine.Match{
+					{Effect: "allow", PolicyID: "0", StatementID: "statement-id-0"},
+					{Effect: "deny", PolicyID: "1", StatementID: "statement-id-2"},
+				}, actual)
+			})
+		})
+	}
+}
+
+func TestV2Explain(t *testing.T) {
+	ctx, engines := setup(t)
+	sub, act, res := "user:local:admin", "iam:users:create", "iam:users"
+
+	args := func(policies map[string]interface{}) (context.Context, engine.Subjects,
+		engine.Action, engine.Resource, engine.Projects, map[string]interface{}) {
+		return ctx, engine.Subject(sub), engine.Action(act), engine.Resource(res),
+			engine.ProjectList(), policies
+	}
+
+	for desc, e := range engines {
+		t.Run(desc, func(t *testing.T) {
+			t.Run("when the store is empty, returns unauthorized without statements", func(t *testing.T) {
+				actual, err := e.V2Explain(args(nil))
+				require.NoError(t, err)
+				assert.False(t, actual.Authorized)
+				assert.Empty(t, actual.Projects)
+				assert.Empty(t, actual.Statements)
+			})
+
+			policy0 := map[string]interface{}{
+				"members": engine.Subject(sub),
+				"statements": map[string]interface{}{
+					"statement-id-0": map[string]interface{}{
+						"actions":   []string{act},
+						"resources": []string{res},
+						"projects":  []string{"project-1"},
+						"effect":    "allow",
+					},
+				},
+			}
+			policy1 := map[string]interface{}{
+				"members": engine.Subject("user:local:someone-else"),
+				"statements": map[string]interface{}{
+					"statement-id-1": map[string]interface{}{
+						"actions":   []string{act},
+						"resources": []string{res},
+						"effect":    "allow",
+					},
+				},
+			}
+			setPoliciesV2(t, e, policy0, policy1)
+
+			t.Run("returns decision, projects, and matching statements", func(t *testing.T) {
+				actual, err := e.V2Explain(args(nil))
+				require.NoError(t, err)
+				assert.True(t, actual.Authorized)
+				assert.Equal(t, []string{"project-1"}, actual.Projects)
+				assert.Equal(t, []engine.Statement{{
+					Match:     engine.Match{Effect: "allow", PolicyID: "0", StatementID: "statement-id-0"},
+					Actions:   []string{act},
+					Resources: []string{res},
+					Projects:  []string{"project-1"},
+				}}, actual.Statements)
+			})
+
+			t.Run("evaluates hypothetical policies in addition to, and in place of stored ones", func(t *testing.T) {
+				deny := map[string]interface{}{
+					"members": engine.Subject("team:local:admins", sub),
+					"statements": map[string]interface{}{
+						"statement-id-2": map[string]interface{}{
+							"actions":   []string{"iam:users:*"},
+							"resources": []string{"*"},
+							"effect":    "deny",
+						},
+					},
+				}
+				actual, err := e.V2Explain(args(map[string]interface{}{
+					"1":    map[string]interface{}{"members": engine.Subject(sub), "statements": policy1["statements"]},
+					"deny": deny,
+				}))
+				require.NoError(t, err)
+				assert.False(t, actual.Authorized)
+				assert.ElementsMatch(t, []engine.Match{
+					{Effect: "allow", PolicyID: "0", StatementID: "statement-id-0"},
+					{Effect: "allow", PolicyID: "1", StatementID: "statement-id-1"},
+					{Effect: "deny", PolicyID: "deny", StatementID: "statement-id-2"},
+				}, matches(actual.Statements))
+			})
+
+			t.Run("hypothetical policies don't alter the engine's state", func(t *testing.T) {
+				actual, err := e.V2Explain(args(nil))
+				require.NoError(t, err)
+				assert.True(t, actual.Authorized)
+				assert.Equal(t, []engine.Match{
+					{Effect: "allow", PolicyID: "0", StatementID: "statement-id-0"},
+				}, matches(actual.Statements))
+			})
+		})
+	}
+}
+
+func TestV2ExplainWithConditions(t *testing.T) {
+	ctx, engines := setup(t)
+	sub, act, res := "user:local:admin", "iam:users:create", "iam:users"
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	start, end := now.Add(-time.Hour), now.Add(time.Hour)
+	ctx = engine.WithEnvironment(ctx, engine.Environment{Time: now, SourceIP: "10.1.2.3"})
+
+	policy := map[string]interface{}{
+		"members": engine.Subject(sub),
+		"statements": map[string]interface{}{
+			"statement-id-0": map[string]interface{}{
+				"actions":   []string{act},
+				"resources": []string{res},
+				"effect":    "allow",
+				"conditions": map[string]interface{}{
+					"time_windows": []interface{}{
+						map[string]interface{}{"start_ns": start.UnixNano(), "end_ns": end.UnixNano()},
+					},
+					"source_ips":    []string{"10.0.0.0/8"},
+					"subject_types": []string{},
+				},
+			},
+		},
+	}
+
+	for desc, e := range engines {
+		t.Run(desc, func(t *testing.T) {
+			setPoliciesV2(t, e, policy)
+			actual, err := e.V2Explain(ctx, engine.Subject(sub), engine.Action(act), engine.Resource(res),
+				engine.ProjectList(), nil)
+			require.NoError(t, err)
+			assert.True(t, actual.Authorized)
+			require.Len(t, actual.Statements, 1)
+			assert.Equal(t, &engine.Conditions{
+				TimeWindows:  []engine.TimeWindow{{Start: start, End: end}},
+				SourceIPs:    []string{"10.0.0.0/8"},
+				SubjectTypes: []string{},
+			}, actual.Statements[0].Conditions)
+		})
+	}
+}
+
+func matches(statements []engine.Statement) []engine.Match {
+	ms := make([]engine.Match, len(statements))
+	for i, st := range statements {
+		ms[i] = st.Match
+	}
+	return ms
+}
+
 func TestV2FilterAuthorizedPairs(t *testing.T) {
 	ctx, engines := setup(t)
 	sub, act0, res0, act1, res1 := "user:local:someid", "iam:users:create",
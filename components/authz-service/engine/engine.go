@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"time"
 )
 
 // Engine abstracts different decision engines.
@@ -20,6 +21,7 @@ type Engine interface {
 	V2Authorizer
 	V2Writer
 
+	V2Matcher
 	ProjectRulesRetriever
 }
 
@@ -60,6 +62,21 @@ type V2Authorizer interface {
 	// V2FilterAuthorizedProjects returns a list of projects corresponding to the
 	// sublist of authorized Pairs allowed from the passed-in list.
 	V2FilterAuthorizedProjects(context.Context, Subjects, []Pair) ([]string, error)
+
+	// V2Explain returns the decision for the subjects/action/resource tuple,
+	// together with the statements it was based on. If policies are passed,
+	// they replace the stored policies of the same IDs, or are added to them,
+	// for this evaluation only.
+	V2Explain(context.Context, Subjects, Action, Resource, Projects, map[string]interface{}) (*Explanation, error)
+}
+
+// V2Matcher is the interface for looking up the policy statements that an
+// authorization decision was based on.
+type V2Matcher interface {
+
+	// V2MatchingStatements returns the statements whose actions and resources
+	// match the action/resource pair, of all policies the subjects are members of.
+	V2MatchingStatements(context.Context, Subjects, Action, Resource) ([]Match, error)
 }
 
 // Writer is the interface for writing policies to a decision engine
@@ -76,6 +93,74 @@ type ProjectRulesRetriever interface {
 	ListProjectMappings(context.Context) (map[string][]Rule, error)
 }
 
+// Match identifies a policy statement that matched an authorization request.
+type Match struct {
+	Effect      string `json:"effect"`
+	PolicyID    string `json:"policy_id"`
+	StatementID string `json:"statement_id"`
+}
+
+// Environment describes the circumstances an authorization request is made
+// in. The conditions of policy statements are checked against it.
+type Environment struct {
+	Time time.Time
+	// SourceIP is the address of the client making the request, if known
+	SourceIP string
+}
+
+type environmentKey struct{}
+
+// WithEnvironment returns a copy of ctx carrying env, for the V2Authorizer
+// and V2Matcher methods to evaluate policy statement conditions with.
+func WithEnvironment(ctx context.Context, env Environment) context.Context {
+	return context.WithValue(ctx, environmentKey{}, env)
+}
+
+// EnvironmentFromContext returns the environment carried by ctx. If it has
+// none, or no time set, the current time is used.
+func EnvironmentFromContext(ctx context.Context) Environment {
+	env, _ := ctx.Value(environmentKey{}).(Environment)
+	if env.Time.IsZero() {
+		env.Time = time.Now()
+	}
+	return env
+}
+
+// Explanation is an authorization decision together with the policy
+// statements it was based on.
+type Explanation struct {
+	Authorized bool
+	// Projects are the requested projects that are allowed, or all allowed
+	// projects, if none were requested
+	Projects   []string
+	Statements []Statement
+}
+
+// Statement is a policy statement that matched an authorization request.
+type Statement struct {
+	Match
+	Role      string
+	Actions   []string
+	Resources []string
+	Projects  []string
+	// Conditions are nil for a statement without conditions
+	Conditions *Conditions
+}
+
+// Conditions restrict when a policy statement applies: it only applies if
+// all of them are met. An empty list means no restriction.
+type Conditions struct {
+	TimeWindows  []TimeWindow
+	SourceIPs    []string
+	SubjectTypes []string
+}
+
+// TimeWindow is a period of time, including its start and excluding its end.
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
 type Rule struct {
 	Type   string
 	Values []string
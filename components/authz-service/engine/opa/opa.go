@@ -5,9 +5,12 @@ package opa
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/open-policy-agent/opa/ast"
 	"github.com/open-policy-agent/opa/rego"
@@ -44,6 +47,8 @@ const (
 	rulesForProjectQuery    = "data.rule_mappings.rules_for_project"
 	listProjectMapQuery     = "data.rule_mappings.rules_for_all_projects"
 	filteredProjectsV2Query = "data.authz_v2.introspection.authorized_project"
+	matchesV2Query          = "data.authz_v2.match[_]"
+	explainV2Query          = "data.authz_v2.match[[effect, pol_id, statement_id]]; statement := data.policies[pol_id].statements[statement_id]"
 )
 
 // OptFunc is the type of functional options to be passed to New()
@@ -76,6 +81,14 @@ func New(ctx context.Context, l logger.Logger, opts ...OptFunc) (*State, error)
 	if err != nil {
 		return nil, errors.Wrapf(err, "parse query %q", filteredProjectsV2Query)
 	}
+	matchesV2QueryParsed, err := ast.ParseBody(matchesV2Query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse query %q", matchesV2Query)
+	}
+	explainV2QueryParsed, err := ast.ParseBody(explainV2Query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse query %q", explainV2Query)
+	}
 	rulesForProjectQueryParsed, err := ast.ParseBody(rulesForProjectQuery)
 	if err != nil {
 		return nil, errors.Wrapf(err, "parse query %q", rulesForProjectQuery)
@@ -94,6 +107,8 @@ func New(ctx context.Context, l logger.Logger, opts ...OptFunc) (*State, error)
 			authzProjectsV2Query:    authzProjectsV2QueryParsed,
 			filteredPairsV2Query:    filteredPairsV2QueryParsed,
 			filteredProjectsV2Query: filteredProjectsV2QueryParsed,
+			matchesV2Query:          matchesV2QueryParsed,
+			explainV2Query:          explainV2QueryParsed,
 			rulesForProjectQuery:    rulesForProjectQueryParsed,
 			listProjectMapQuery:     listProjectMapQueryParsed,
 		},
@@ -301,6 +316,7 @@ func (s *State) V2IsAuthorized(
 		[2]*ast.Term{ast.NewTerm(ast.String("resource")), ast.NewTerm(ast.String(resource))},
 		[2]*ast.Term{ast.NewTerm(ast.String("action")), ast.NewTerm(ast.String(action))},
 	)
+	addEnvironment(ctx, input)
 	resultSet, err := s.v2PartialAuth.Rego(rego.ParsedInput(input)).Eval(ctx)
 	if err != nil {
 		return false, &ErrEvaluation{e: err}
@@ -344,6 +360,7 @@ func (s *State) V2ProjectsAuthorized(
 		[2]*ast.Term{ast.NewTerm(ast.String("action")), ast.NewTerm(ast.String(action))},
 		[2]*ast.Term{ast.NewTerm(ast.String("projects")), ast.NewTerm(projs)},
 	)
+	addEnvironment(ctx, input)
 	resultSet, err := s.v2PartialProjects.Rego(rego.ParsedInput(input)).Eval(ctx)
 	if err != nil {
 		return []string{}, &ErrEvaluation{e: err}
@@ -384,7 +401,7 @@ func (s *State) V2FilterAuthorizedPairs(
 		"pairs":    pairs,
 	}
 
-	rs, err := s.evalQuery(ctx, s.queries[filteredPairsV2Query], opaInput, s.v2Store)
+	rs, err := s.evalQuery(ctx, s.queries[filteredPairsV2Query], withEnvironment(ctx, opaInput), s.v2Store)
 	if err != nil {
 		return nil, &ErrEvaluation{e: err}
 	}
@@ -405,7 +422,7 @@ func (s *State) V2FilterAuthorizedProjects(
 		"pairs":    pairs,
 	}
 
-	rs, err := s.evalQuery(ctx, s.queries[filteredProjectsV2Query], opaInput, s.v2Store)
+	rs, err := s.evalQuery(ctx, s.queries[filteredProjectsV2Query], withEnvironment(ctx, opaInput), s.v2Store)
 	if err != nil {
 		return nil, &ErrEvaluation{e: err}
 	}
@@ -413,6 +430,147 @@ func (s *State) V2FilterAuthorizedProjects(
 	return s.projectsFromResults(rs)
 }
 
+// V2MatchingStatements returns the statements matching the action/resource
+// pair, of all policies the subjects are members of. These are the statements
+// V2IsAuthorized bases its decision on.
+func (s *State) V2MatchingStatements(
+	ctx context.Context,
+	subjects engine.Subjects,
+	action engine.Action,
+	resource engine.Resource) ([]engine.Match, error) {
+
+	opaInput := map[string]interface{}{
+		"subjects": subjects,
+		"action":   action,
+		"resource": resource,
+	}
+
+	rs, err := s.evalQuery(ctx, s.queries[matchesV2Query], withEnvironment(ctx, opaInput), s.v2Store)
+	if err != nil {
+		return nil, &ErrEvaluation{e: err}
+	}
+
+	return s.matchesFromResults(rs)
+}
+
+// V2Explain evaluates the subjects/action/resource tuple like V2IsAuthorized
+// and V2ProjectsAuthorized do, and additionally returns the statements that
+// matched. If policies are passed, they are evaluated in place of the stored
+// policies with the same IDs, and in addition to all others. The engine's
+// state is not altered.
+func (s *State) V2Explain(
+	ctx context.Context,
+	subjects engine.Subjects,
+	action engine.Action,
+	resource engine.Resource,
+	projects engine.Projects,
+	policies map[string]interface{}) (*engine.Explanation, error) {
+
+	store := s.v2Store
+	if len(policies) > 0 {
+		var err error
+		store, err = withPolicies(ctx, store, policies)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if projects == nil {
+		projects = engine.Projects{}
+	}
+	opaInput := map[string]interface{}{
+		"subjects": subjects,
+		"action":   action,
+		"resource": resource,
+		"projects": projects,
+	}
+	withEnvironment(ctx, opaInput)
+
+	rs, err := s.evalQuery(ctx, s.queries[explainV2Query], opaInput, store)
+	if err != nil {
+		return nil, &ErrEvaluation{e: err}
+	}
+	var exp engine.Explanation
+	if exp.Statements, err = s.statementsFromResults(rs); err != nil {
+		return nil, err
+	}
+	// Note: this is what data.authz_v2.authorized amounts to; we don't query it
+	// here since, without partial evaluation, multiple allowing statements cause
+	// conflicts for its "allow" rule.
+	allow, deny := false, false
+	for _, st := range exp.Statements {
+		switch st.Effect {
+		case "allow":
+			allow = true
+		case "deny":
+			deny = true
+		}
+	}
+	exp.Authorized = allow && !deny
+
+	rs, err = s.evalQuery(ctx, s.queries[authzProjectsV2Query], opaInput, store)
+	if err != nil {
+		return nil, &ErrEvaluation{e: err}
+	}
+	if exp.Projects, err = s.projectsFromResults(rs); err != nil {
+		return nil, err
+	}
+	return &exp, nil
+}
+
+// withEnvironment adds the environment carried by ctx to the input.
+func withEnvironment(ctx context.Context, input map[string]interface{}) map[string]interface{} {
+	env := engine.EnvironmentFromContext(ctx)
+	input["time_ns"] = env.Time.UnixNano()
+	// Note: an invalid address would make evaluating source IP conditions fail
+	// altogether, so it's treated like a missing one.
+	if net.ParseIP(env.SourceIP) != nil {
+		input["source_ip"] = env.SourceIP
+	}
+	return input
+}
+
+// addEnvironment is withEnvironment for parsed input.
+func addEnvironment(ctx context.Context, input ast.Object) {
+	env := engine.EnvironmentFromContext(ctx)
+	input.Insert(ast.StringTerm("time_ns"), ast.IntNumberTerm(int(env.Time.UnixNano())))
+	if net.ParseIP(env.SourceIP) != nil {
+		input.Insert(ast.StringTerm("source_ip"), ast.StringTerm(env.SourceIP))
+	}
+}
+
+// withPolicies returns a copy of store, with the passed policies added to
+// (or replacing) its policies.
+func withPolicies(ctx context.Context, store storage.Store,
+	policies map[string]interface{}) (storage.Store, error) {
+
+	raw, err := storage.ReadOne(ctx, store, storage.Path{})
+	if err != nil {
+		return nil, errors.Wrap(err, "read OPA store")
+	}
+	data, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("unexpected OPA store data: %T", raw)
+	}
+
+	merged := map[string]interface{}{}
+	if stored, ok := data["policies"].(map[string]interface{}); ok {
+		for id, pol := range stored {
+			merged[id] = pol
+		}
+	}
+	for id, pol := range policies {
+		merged[id] = pol
+	}
+
+	copied := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		copied[k] = v
+	}
+	copied["policies"] = merged
+	return inmem.NewFromObject(copied), nil
+}
+
 // Note(sr) Right now, it doesn't seem like this was doing much more than
 // retrieving data from OPA's store. However, that's fine -- we'll need those
 // mapping rules in OPA's store for other things (most likely), so retrieving
@@ -493,6 +651,118 @@ func (s *State) pairsFromResults(rs rego.ResultSet) ([]engine.Pair, error) {
 	return pairs, nil
 }
 
+func (s *State) matchesFromResults(rs rego.ResultSet) ([]engine.Match, error) {
+	matches := make([]engine.Match, len(rs))
+	for i, r := range rs {
+		if len(r.Expressions) != 1 {
+			return nil, &ErrUnexpectedResultExpression{exps: r.Expressions}
+		}
+		vals, err := s.stringArrayFromResults(r.Expressions)
+		if err != nil || len(vals) != 3 {
+			return nil, &ErrUnexpectedResultExpression{exps: r.Expressions}
+		}
+		matches[i] = engine.Match{Effect: vals[0], PolicyID: vals[1], StatementID: vals[2]}
+	}
+
+	return matches, nil
+}
+
+func (s *State) statementsFromResults(rs rego.ResultSet) ([]engine.Statement, error) {
+	statements := make([]engine.Statement, len(rs))
+	for i, r := range rs {
+		effect, ok1 := r.Bindings["effect"].(string)
+		polID, ok2 := r.Bindings["pol_id"].(string)
+		statementID, ok3 := r.Bindings["statement_id"].(string)
+		statement, ok4 := r.Bindings["statement"].(map[string]interface{})
+		if !ok1 || !ok2 || !ok3 || !ok4 {
+			return nil, &ErrUnexpectedResultSet{set: rs}
+		}
+		st := engine.Statement{
+			Match: engine.Match{Effect: effect, PolicyID: polID, StatementID: statementID},
+		}
+		st.Role, _ = statement["role"].(string)
+		var err error
+		if st.Actions, err = stringSlice(statement["actions"]); err != nil {
+			return nil, &ErrUnexpectedResultSet{set: rs}
+		}
+		if st.Resources, err = stringSlice(statement["resources"]); err != nil {
+			return nil, &ErrUnexpectedResultSet{set: rs}
+		}
+		if st.Projects, err = stringSlice(statement["projects"]); err != nil {
+			return nil, &ErrUnexpectedResultSet{set: rs}
+		}
+		if conditions, ok := statement["conditions"].(map[string]interface{}); ok {
+			if st.Conditions, err = conditionsFromResult(conditions); err != nil {
+				return nil, &ErrUnexpectedResultSet{set: rs}
+			}
+		}
+		statements[i] = st
+	}
+	return statements, nil
+}
+
+// conditionsFromResult converts the conditions of a statement from OPA's
+// results; times are nanoseconds since the epoch
+func conditionsFromResult(conditions map[string]interface{}) (*engine.Conditions, error) {
+	c := engine.Conditions{}
+	var err error
+	if c.SourceIPs, err = stringSlice(conditions["source_ips"]); err != nil {
+		return nil, err
+	}
+	if c.SubjectTypes, err = stringSlice(conditions["subject_types"]); err != nil {
+		return nil, err
+	}
+	windows, _ := conditions["time_windows"].([]interface{})
+	c.TimeWindows = make([]engine.TimeWindow, len(windows))
+	for i := range windows {
+		window, ok := windows[i].(map[string]interface{})
+		if !ok {
+			return nil, errors.New("error casting to time window")
+		}
+		start, err := nanoseconds(window["start_ns"])
+		if err != nil {
+			return nil, err
+		}
+		end, err := nanoseconds(window["end_ns"])
+		if err != nil {
+			return nil, err
+		}
+		c.TimeWindows[i] = engine.TimeWindow{Start: time.Unix(0, start).UTC(), End: time.Unix(0, end).UTC()}
+	}
+	return &c, nil
+}
+
+// nanoseconds converts a number from OPA's results
+func nanoseconds(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case int64:
+		return n, nil
+	}
+	return 0, errors.New("error casting to number")
+}
+
+// stringSlice converts an array from OPA's results; null is an empty array
+func stringSlice(v interface{}) ([]string, error) {
+	if v == nil {
+		return []string{}, nil
+	}
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil, errors.New("error casting to array")
+	}
+	ss := make([]string, len(raw))
+	for i := range raw {
+		s, ok := raw[i].(string)
+		if !ok {
+			return nil, errors.New("error casting to string")
+		}
+		ss[i] = s
+	}
+	return ss, nil
+}
+
 func (s *State) projectsFromResults(rs rego.ResultSet) ([]string, error) {
 	if len(rs) != 1 {
 		return nil, &ErrUnexpectedResultSet{set: rs}
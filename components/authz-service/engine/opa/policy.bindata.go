@@ -93,7 +93,7 @@ func policyAuthzRego() (*asset, error) {
 	return a, nil
 }
 
-var _policyAuthz_v2Rego = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\xb1\x6e\xdb\x30\x10\x9d\xcd\xaf\x38\x70\xb2\x03\xd5\x28\x3a\x06\xd0\x50\x14\x5d\x8a\x00\x2d\xda\x6e\x82\x40\xd0\x12\xdd\xb0\x95\x48\x81\xa4\x12\x24\x81\xfd\xed\x05\x79\x94\x4c\x29\x76\x6c\x4f\x99\xa4\x7b\x7c\xef\xee\xe9\x44\x1e\x3b\x5e\xfd\xe3\x7f\x04\xf0\xde\xdd\x3f\xb3\x87\x4f\x84\xc8\xb6\xd3\xc6\x41\xcd\x1d\x5f\x57\xba\x6d\xb5\x9a\x40\x9d\x6e\x64\x25\x85\x9d\x80\x46\x37\xc2\x12\x52\x8b\x2d\xef\x1b\x17\x92\x69\x23\x9f\x45\x0d\x39\x6c\x79\x63\x05\x21\xf7\xdc\xb2\x56\xb4\x1b\x61\x8a\x4e\x37\x4c\xd6\x25\xbc\x90\x85\x7f\xb5\xfd\x06\x6e\x73\x18\x12\x0f\xcb\x6b\x64\xdb\x82\x95\x64\x21\x55\xd7\xbb\x81\x19\x82\xb5\xed\x37\x7f\x45\xe5\x70\x1d\x8d\x0e\x18\x6b\xb9\xab\xee\x85\x5d\x8e\xb2\x0c\x62\xa5\x15\xd9\xa1\x17\x23\xac\xee\x4d\x25\x8a\x58\x2f\x03\xeb\xb8\x13\xad\x50\xce\x57\x0f\xee\x0e\xc8\xc0\x3e\x6a\x74\xa4\xd9\x62\x92\x63\x3d\xa8\x26\x1e\x07\x70\x6a\x72\x84\x53\x1f\x03\x16\x4c\x2b\xcd\x1e\x65\x53\x57\xdc\xd4\x4b\xbe\xf2\xf6\x2a\xad\x1c\x97\xca\x2e\x79\x06\xf4\x86\xae\x20\x1f\xba\xbd\x23\x84\x57\x4e\x6a\x95\x14\xf1\x89\xb5\x11\x75\x90\xa6\xc9\x22\xec\x9b\xec\x33\x60\x78\x26\x45\x0e\xe9\xe2\xd2\x76\x8d\x74\x31\x51\x06\xf4\x96\xae\x32\x40\xcc\x8b\x7c\xbc\x9a\xa6\x5b\x16\x56\x98\x07\xe9\x3f\x97\xde\xd0\x32\x83\x43\xcc\x32\x60\xa5\xaf\xe0\x4c\x2f\x4e\xaa\xdc\x53\x77\x44\x8b\xe8\x05\x72\x7a\x43\x33\x78\x10\x66\x33\x2f\x1d\xb0\x53\xf2\x89\x8a\x5d\xc4\x2f\x33\x60\x87\x65\xbf\xf1\x90\x72\xd9\xb6\x43\xee\x75\x9b\x0e\x35\xb8\xe5\x5e\xfd\x41\xbf\xd3\x10\x4c\x0b\x23\x32\x1e\x8d\xf3\x0e\xaf\x38\x02\xba\x11\x90\x83\x7f\x30\x59\x93\x45\x98\x14\x45\x0c\x53\xb3\x03\x09\x91\x33\xd6\x13\xe6\xe8\xba\x33\xda\x9f\xfd\xa2\x88\x2f\x78\xe4\x4f\xf8\x47\xca\x75\x8d\x8d\x22\xec\x6c\x0c\x66\xfe\x12\x4a\x06\x31\x78\x27\x83\x95\xee\x95\x9b\xd9\x0a\x13\xe2\xe3\xfb\xf8\x51\xda\xc1\xd4\x8d\xf7\x31\x6f\x23\x9b\x4c\x29\x7c\xf5\xa6\xe9\x7e\xff\xf9\xee\xee\xc3\x8f\x9f\xdf\xbf\x7d\xfd\xf2\xfb\xd7\x7e\x4f\x8f\xa9\x67\x43\xee\x20\x97\xea\x14\x9d\xc5\x49\x8a\xcd\x3a\x34\x28\x70\x8a\x42\x6c\xb7\x67\x3a\x83\x8c\xeb\x1a\x83\x1a\xb2\x78\x7d\x27\x22\x76\xee\x6e\x42\xd6\xdb\xc7\x34\x0c\xf0\xa6\xd1\x8f\x90\x43\xfc\x18\x1a\x62\x1a\x47\x6c\xe9\xef\x6b\xf5\x94\x2c\xfb\x30\x59\x4d\x6e\xf1\x17\xb2\x08\x5a\xfc\x8d\x9e\x37\xa6\x17\xf5\xb8\x91\xe2\x33\xb4\x65\x5e\xf2\xad\x0f\xb9\x74\x23\x86\x9a\xa3\xa9\xa3\x65\x4f\x59\x22\x3b\xf2\x3f\x00\x00\xff\xff\x17\xb5\x82\x86\xea\x08\x00\x00")
+var _policyAuthz_v2Rego = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x57\x4d\x8b\xdb\x30\x10\x3d\xc7\xbf\x62\x70\x2f\x71\x49\x4d\xe9\x71\xdb\x14\x96\xd2\x4b\x59\x68\x69\x7b\x33\xc1\x68\x6d\x65\xa3\xae\x2d\x19\x49\xde\x90\x2d\xcd\x6f\xef\xe8\xc3\xb6\x9c\xcd\x87\x73\x5a\x08\x24\xf3\xf4\x66\xe6\x69\x24\x8d\x94\x86\x14\x8f\xe4\x81\x02\x69\xf5\xe6\x39\x7f\xfa\x10\x45\xac\x6e\x84\xd4\x50\x12\x4d\xd2\x42\xd4\xb5\xe0\x23\xa8\x11\x15\x2b\x18\x55\x23\x50\x8a\x0a\x91\xa8\xa4\x6b\xd2\x56\xda\x06\x13\x92\x3d\xd3\x12\x96\xb0\x26\x95\xa2\x51\xb4\x21\x2a\xaf\x69\x7d\x4f\x65\x86\x21\x72\x56\xae\xe0\x6f\x34\x33\x3f\x55\x7b\x0f\x37\x4b\xe8\x02\x77\xc3\xa9\x63\xab\x2c\x5f\x45\x33\xc6\x9b\x56\x77\x4c\x6b\xa4\x68\xfc\xa1\x85\x76\xe3\x4e\x68\x87\xe5\x35\xd1\xc5\x86\xaa\x79\xef\xb6\x00\x9f\x29\x89\xfe\x39\x2d\x92\x2a\xd1\xca\x82\x66\x3e\xdf\x02\x94\x26\x9a\xd6\x94\x6b\x93\xdd\xaa\x1b\x90\x8e\x7d\x54\x68\x4f\x53\xd9\x28\x46\xda\x79\x8d\x34\x76\xe0\x58\x64\x0f\x87\x3a\x3a\xcc\x8a\xe6\x22\xdf\xb2\xaa\x2c\x88\x2c\xe7\x24\x31\xf2\x0a\xc1\x35\x61\x5c\xcd\xc9\x02\xe2\xb7\x71\x02\xcb\xae\xda\x48\x27\x85\x66\x82\x07\x49\x4c\x60\x21\x69\x69\x5d\xc3\x60\x1e\x36\x45\x36\x11\x9c\x79\x21\xc4\x12\xc2\xc1\xb9\x6a\x2a\xa6\x7d\x20\xd4\x72\x13\x27\x48\xb5\x98\x71\x32\x76\x32\x0e\x37\xcf\x14\x95\x4f\xcc\x4c\x17\x95\xaf\x16\x30\xd8\x39\x7e\x56\x26\x83\x96\x2d\x3d\xe9\xa5\x77\xcd\x11\x5f\x87\x4e\x70\x47\xc7\x05\x3c\x51\x79\x7f\x98\xda\x62\xa7\xdc\x47\x5e\xf9\x24\x3e\x32\xf3\x61\xd8\x6c\x3c\x47\x99\xb6\xed\x1c\xf7\xba\x4d\xe7\x7c\xdc\x96\x7b\xb1\x82\x66\xa7\x39\x30\x4c\xec\x90\xfe\x68\x5c\x56\x78\xc5\x11\xc0\xce\x80\xd3\x37\x5f\x68\x47\x33\xdb\x29\x32\x6f\x86\x62\x3b\x92\x43\x2e\x48\x0f\x98\xbd\xea\x46\x0a\x73\xf6\x51\xb6\xfb\xe1\x8e\xfc\x09\xfd\x8e\x72\x5d\x61\xbd\x93\xab\xac\x37\x0e\xf4\x05\x14\x4c\xef\x8c\x57\x12\x58\x88\x96\xeb\x03\x59\xb6\x43\xbc\x7f\x1d\x3d\x5c\x68\x18\xab\x31\x3a\x0e\xcb\x98\x8f\xba\x94\xfb\x69\x44\xc7\xfb\xfd\xed\xdd\xdd\xbb\x1f\x3f\xbf\x7f\xfb\xfa\xe5\xf7\xaf\xfd\x3e\x3e\xe6\x7d\xd0\xe4\x06\x77\xc6\x4f\xd1\x73\xdf\x49\x5d\xb1\x86\x02\xbd\x81\xdb\xa1\x0c\xb0\x65\x78\xa1\xb5\x1a\xb0\xe3\x96\xcc\xee\x58\x20\xd5\x96\xec\xf0\xab\xc1\x36\x47\xd5\x47\x10\x9c\x5a\x5a\xc8\x11\xbc\xda\x61\x24\xcf\x01\xb6\x46\xaf\x0a\xc4\x1a\xf4\x86\xd6\x40\x24\x85\x9a\xea\x14\x33\xd5\x4c\x29\xc6\x1f\x40\x48\xa0\x75\xa3\x77\x50\x31\xa5\x0d\x31\x08\xc6\x94\x65\x47\x03\x84\xf7\xa9\x9e\xd4\x47\xae\x5a\x36\xb7\x54\x3d\x94\x0e\xf9\x4c\x5d\xa6\x67\x0f\xa4\x5f\xb5\x6b\x82\x7c\x33\xcd\x6a\x9a\xf7\x80\xc9\x38\x1f\x86\xf1\xc6\xf2\x37\x29\x6b\xce\x92\xfc\xa3\xc0\x5c\x0e\x67\x78\xfe\x54\x0c\x50\x30\xba\x80\x47\xc6\xcb\x64\x3c\xad\xcc\x60\x2b\xb3\xb9\xd1\xf5\xbc\x52\x77\xe5\x6a\x38\x93\x20\xb6\x11\xb6\x18\x52\x6c\x55\x9c\x4c\x8b\xe9\xe8\xa6\xbe\xc3\x40\x1a\x06\x1a\x1e\x4f\x0e\xc6\xc5\xf8\xbc\x04\x37\x68\x96\x40\xea\xdc\xd4\x79\xcc\xf8\xd4\x11\x28\x2f\x73\xb7\xec\x13\x0a\x3d\x65\x8e\x7d\x18\x3f\xc3\xa9\x61\x71\xdf\x17\xac\x94\xb9\xc0\xeb\xb6\x22\x4d\x30\x9e\x0e\x21\x6d\xd3\xf5\x6f\xc3\x0e\x74\x59\x26\x6d\x80\x49\xfa\x83\x48\xdd\x14\x26\x07\xb7\xe5\x56\xa6\x47\xcc\x5f\xbc\x60\x17\x66\x01\x0b\xa2\xe7\x31\xbe\x30\xb2\x70\x7a\x61\x46\x4b\xc4\xd7\xd4\x2a\xb1\xa9\x6d\x1f\xcb\x32\xba\x5e\x5f\xe8\xde\x8e\x71\xdd\x31\x74\x3e\xd1\xec\xe5\xbb\xdd\x61\x97\xde\xcf\x8e\x75\xfe\x29\x11\x9e\xa6\x73\xed\xc4\x3e\x46\xab\x0a\x77\xfa\x12\xfc\xa4\x63\x6b\xc7\xfe\xb9\xb8\x32\xff\x3d\xf8\x2e\x18\x36\x66\x30\x1a\xfc\x23\xc1\x7a\x58\x5f\xb7\xda\x86\xd7\x87\xa7\x65\x7f\x29\xfa\x6f\x5b\xbe\xc3\x94\xe7\x26\x3c\xf5\x52\xb5\x39\x7b\x51\x47\xd3\x9e\x92\x84\xae\xff\x01\x59\xbd\x2f\x12\xb6\x0d\x00\x00")
 
 func policyAuthz_v2RegoBytes() ([]byte, error) {
 	return bindataRead(
@@ -108,7 +108,7 @@ func policyAuthz_v2Rego() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "policy/authz_v2.rego", size: 2282, mode: os.FileMode(420), modTime: time.Unix(1554324388, 0)}
+	info := bindataFileInfo{name: "policy/authz_v2.rego", size: 3510, mode: os.FileMode(420), modTime: time.Unix(1792325536, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -153,7 +153,7 @@ func policyIntrospectionRego() (*asset, error) {
 	return a, nil
 }
 
-var _policyIntrospection_v2Rego = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x93\x4d\x6e\xdb\x30\x10\x85\xd7\x9a\x53\x0c\x9c\x45\x5a\x40\xd0\xa2\xcb\x00\xbe\x42\x2f\x20\x08\x04\x23\x8e\x6b\xb6\x12\x47\x20\x47\x29\x9a\x20\x77\x2f\x48\x8a\xae\x6c\x39\x81\xfb\x83\xae\x24\x0e\xdf\x7b\x9a\x8f\x1c\x4d\xba\xff\xa6\xbf\x10\xea\x59\x8e\xcf\xea\xe9\x53\x63\x9d\x78\x0e\x13\xf5\x62\xd9\x01\xd8\x71\x62\x2f\x68\xb4\xe8\xa6\x68\x50\x87\xac\x3f\xdb\xed\x79\x1c\xd9\x9d\x95\x26\x1e\x6c\x6f\x29\x9c\x15\x3d\x0f\x14\x00\x26\x6d\xbd\x1a\xb5\xf4\x47\x0a\xca\x53\xe0\xd9\xf7\xd4\xb6\x13\x0f\xca\x9a\x1a\x83\x68\xa1\x91\x9c\xa4\x55\x14\x77\x1d\xbe\x40\x55\x22\x17\x61\xd7\x9c\x84\xa1\x5d\x7b\xba\xa6\x64\x86\x56\x75\xb8\x5f\x05\x96\x0d\xa8\xac\x9b\x66\x69\x62\xf8\x22\x8a\xaf\x50\x65\x92\x53\x40\xe9\xf2\x43\xdc\x3d\x55\xeb\x2b\x89\x1f\xe1\xf5\x02\x4c\xa7\x63\xfc\x97\x58\x39\x71\x03\x95\xcb\x6f\x22\xa5\xeb\x5a\xbc\xe7\x3c\xb9\x56\x6f\xa2\xfe\x07\x4b\x1c\x05\xdc\x63\x7c\x28\x6b\xa0\x4a\xa3\xd1\x2e\xcb\x0b\xd4\x54\xfd\x6b\xca\x55\x4a\x02\x4c\x22\x15\x25\x6d\x4b\x87\x03\xf5\x92\x49\x6a\xbc\x46\x99\xf9\xb2\x0e\x1f\xf6\x78\x3b\x69\xf6\x94\x0e\x8f\x3a\xa8\x91\xc6\x47\xf2\xc5\x09\xd5\x1f\xfc\x0f\x17\xa6\x5b\xee\x27\x32\xdf\xe1\x67\x16\x7a\x40\x61\xf4\x24\xb3\x77\x28\x47\xc2\x30\x3f\x06\x12\xe4\x43\x5a\xc5\x3e\xd9\xdb\x67\x32\xc9\x18\x4a\x7d\xf2\xfc\x64\x0d\x19\x4c\x37\x50\xc3\x1d\xf2\xec\xd1\xcf\x03\x05\x1c\xe7\x20\xb8\xcb\x91\xbb\xa4\xbe\x8f\xde\xfb\xfc\xdb\x83\x1e\x06\xfe\x4e\x26\x1f\x76\xea\x06\xf7\xb8\x3e\xff\x5d\x52\xec\xca\x05\xa8\x1a\x55\xd7\x01\x18\x72\xf6\x5d\x9b\x21\xf7\x63\xe3\xfa\x05\xb0\x76\xbe\x40\xb5\x6d\x03\x2a\xc7\x82\x9b\xcf\xc4\xa3\x3a\x89\x3d\x7f\xa5\x5e\xda\xe5\x99\xc7\x3c\xbf\xff\xde\x1c\x2c\xa6\x38\xb5\x50\xbd\x03\x7f\x7d\xfa\x52\x4b\x2b\xb0\x2b\x5d\xbd\xd5\x31\xbc\xc2\xcf\x00\x00\x00\xff\xff\x3c\xe8\x62\xec\xeb\x05\x00\x00")
+var _policyIntrospection_v2Rego = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x54\xcb\x6e\x83\x30\x10\x3c\xe3\xaf\x58\xa5\x87\xb4\x52\xc4\xa1\xc7\x48\xf9\x85\xfe\x00\x42\xc8\x81\x4d\xe3\x16\x30\xb2\x97\x54\x4d\xd5\x7f\xef\xda\xc6\x94\x3c\x95\xaa\x55\xa5\x28\xe0\xf5\xcc\xb0\xe3\x59\xe8\x64\xf9\x2a\x9f\x11\x64\x4f\xdb\x7d\xb1\x7b\x4c\x55\x4b\x46\xdb\x0e\x4b\x52\xba\x15\x42\x35\x9d\x36\x04\x95\x24\x99\x46\x0c\x48\x1b\xf0\x07\xbb\xa5\x6e\x1a\x66\x4c\x4b\x9d\xae\x55\xa9\xd0\x1e\x14\x8d\xae\xb9\x22\x3a\xa9\x4c\xd1\x48\x2a\xb7\x68\x0b\x83\x56\xf7\xa6\xc4\x2c\x63\x4a\xa1\xaa\x05\x58\x92\x84\x0d\xb6\xe4\x57\x0e\x9c\xe7\xf0\x21\x92\x28\x39\x00\xf3\x74\x04\xda\x6c\xca\xc9\xd3\xa8\x69\xb3\x22\x87\xd5\x44\x30\x6e\x88\x44\xb5\x5d\x4f\xa9\x13\x1f\x40\xee\x56\x24\xc1\xc9\x28\x10\xbb\xbc\x77\xbb\x63\x75\x71\x46\xf1\x41\x7c\x1e\x19\x93\xfe\x18\xff\xd2\x56\x50\x3c\x31\x15\xca\x17\x2d\xf9\xb8\x06\xee\xa1\x9f\x50\x5b\x9c\x48\xfd\x87\x17\x37\x0a\xdc\xa2\xbb\xf0\x5a\x24\x7e\x34\xb2\x61\x79\x64\xd5\x57\x7f\xed\x72\xa2\xe2\x0d\x7a\x50\xe1\x20\x59\x86\x9b\x0d\x4f\x7d\x70\xc2\xff\x67\x5c\x06\x7f\x01\x07\xcb\x15\xdc\xee\x34\x70\x62\x87\x5b\x69\x8b\x06\x9b\x35\x9a\xc8\xe4\x53\xfb\xf9\xfb\x70\x44\xba\x25\x9f\xd8\x41\xa9\xdb\x4a\xf9\xd3\xe5\x46\xe8\x3c\x87\xd1\x7c\x42\x77\xf0\xa4\x09\x97\x40\x1a\x0c\x52\x6f\x5a\xa0\x2d\x82\xed\xd7\x16\x09\xf4\xc6\xaf\x9c\xa6\x36\x6a\x8f\x95\x7f\x8c\x8d\xf5\xce\xe8\x9d\xaa\xb8\xea\xf3\x5a\xb0\x16\xbb\x02\xd3\x73\xca\xd0\xf4\x96\x60\x16\x24\x67\x1e\x3d\x77\xdc\x79\xf8\x48\x08\x59\xd7\xfa\x0d\xab\x10\x8d\xef\x9d\x53\x9e\xa6\x35\xf3\x88\x59\x8c\xab\xe0\x1f\x37\x2c\x2a\x6c\xd5\x55\x1a\x03\xde\x4f\x58\xdf\x06\xa6\x4c\xce\xfa\xb4\x0d\x91\xb4\x9a\xbf\x64\xc7\x8f\x71\x47\x35\x82\x8d\x7e\xe1\xb4\xb3\xe1\x1a\x5e\x8a\x70\xff\xb3\xa9\x19\x48\x6e\xc6\x45\x72\xc5\xfc\xe5\xf4\xa6\xc6\xce\x74\x75\xa9\x63\xa6\x7e\x01\x0d\xa5\xa0\xb4\x19\x06\x00\x00")
 
 func policyIntrospection_v2RegoBytes() ([]byte, error) {
 	return bindataRead(
@@ -168,7 +168,7 @@ func policyIntrospection_v2Rego() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "policy/introspection_v2.rego", size: 1515, mode: os.FileMode(420), modTime: time.Unix(1553727342, 0)}
+	info := bindataFileInfo{name: "policy/introspection_v2.rego", size: 1561, mode: os.FileMode(420), modTime: time.Unix(1792325118, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
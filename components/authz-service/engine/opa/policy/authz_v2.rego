@@ -76,11 +76,56 @@ project_matches(in, _) {
 	count(in) == 0
 }
 
+# A statement without conditions always applies; one with conditions only
+# applies if all of them are met. A missing or empty list of conditions is met.
+conditions_met[[pol_id, statement_id]] {
+	statement := policies[pol_id].statements[statement_id]
+	not statement.conditions
+}
+
+conditions_met[[pol_id, statement_id]] {
+	conditions := policies[pol_id].statements[statement_id].conditions
+	time_condition_met(conditions)
+	source_ip_condition_met(conditions)
+	subject_type_condition_met(conditions)
+}
+
+has_conditions(conditions, kind) {
+	conditions[kind][_]
+}
+
+time_condition_met(conditions) {
+	not has_conditions(conditions, "time_windows")
+}
+
+time_condition_met(conditions) {
+	window := conditions.time_windows[_]
+	input.time_ns >= window.start_ns
+	input.time_ns < window.end_ns
+}
+
+source_ip_condition_met(conditions) {
+	not has_conditions(conditions, "source_ips")
+}
+
+source_ip_condition_met(conditions) {
+	net.cidr_overlap(conditions.source_ips[_], input.source_ip)
+}
+
+subject_type_condition_met(conditions) {
+	not has_conditions(conditions, "subject_types")
+}
+
+subject_type_condition_met(conditions) {
+	startswith(input.subjects[_], concat("", [conditions.subject_types[_], ":"]))
+}
+
 match[[effect, pol_id, statement_id]] {
 	effect := policies[pol_id].statements[statement_id].effect
 	has_member[pol_id]
 	has_resource[[pol_id, statement_id]]
 	has_action[[pol_id, statement_id]]
+	conditions_met[[pol_id, statement_id]]
 }
 
 allow = match[["allow", _, _]]
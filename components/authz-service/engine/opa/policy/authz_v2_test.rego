@@ -157,6 +157,69 @@ test_deny_matches_all_properties_and_effect_deny {
 		 with input as {"subjects": ["x"], "action": "y", "resource": "z"}
 }
 
+###############  conditions  #########################################
+
+test_conditions_met_when_statement_has_no_conditions {
+	conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid as {"effect": "allow"}
+}
+
+test_conditions_met_when_all_conditions_are_empty {
+	conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [], "source_ips": [], "subject_types": []}
+}
+
+test_conditions_met_when_time_is_in_one_of_the_windows {
+	conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [{"start_ns": 10, "end_ns": 20}, {"start_ns": 30, "end_ns": 40}], "source_ips": [], "subject_types": []}
+		 with input.time_ns as 30
+}
+
+test_conditions_not_met_when_time_is_outside_of_windows {
+	not conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [{"start_ns": 10, "end_ns": 20}, {"start_ns": 30, "end_ns": 40}], "source_ips": [], "subject_types": []}
+		 with input.time_ns as 20
+}
+
+test_conditions_not_met_when_time_is_missing {
+	not conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [{"start_ns": 10, "end_ns": 20}], "source_ips": [], "subject_types": []}
+}
+
+test_conditions_met_when_source_ip_is_in_one_of_the_cidrs {
+	conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [], "source_ips": ["10.0.0.0/8", "192.168.1.0/24"], "subject_types": []}
+		 with input.source_ip as "192.168.1.17"
+}
+
+test_conditions_not_met_when_source_ip_is_outside_of_cidrs {
+	not conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [], "source_ips": ["10.0.0.0/8"], "subject_types": []}
+		 with input.source_ip as "192.168.1.17"
+}
+
+test_conditions_not_met_when_source_ip_is_missing {
+	not conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [], "source_ips": ["10.0.0.0/8"], "subject_types": []}
+}
+
+test_conditions_met_when_subject_type_matches {
+	conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [], "source_ips": [], "subject_types": ["token"]}
+		 with input.subjects as ["token:admin-token"]
+}
+
+test_conditions_not_met_when_subject_type_does_not_match {
+	not conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [], "source_ips": [], "subject_types": ["token"]}
+		 with input.subjects as ["user:local:alice", "team:local:admins"]
+}
+
+test_conditions_not_met_when_any_condition_is_not_met {
+	not conditions_met[["polid", "sid"]] with data.policies.polid.statements.sid.conditions as {"time_windows": [{"start_ns": 10, "end_ns": 20}], "source_ips": ["10.0.0.0/8"], "subject_types": []}
+		 with input as {"time_ns": 15, "source_ip": "192.168.1.17"}
+}
+
+test_allow_does_not_match_when_conditions_are_not_met {
+	not allow with data.policies.polid as {"members": ["x"], "statements": {"statementid": {"effect": "allow", "actions": ["y"], "resources": ["z"], "conditions": {"time_windows": [], "source_ips": ["10.0.0.0/8"], "subject_types": []}}}}
+		 with input as {"subjects": ["x"], "action": "y", "resource": "z", "source_ip": "192.168.1.17"}
+}
+
+test_deny_matches_when_conditions_are_met {
+	deny with data.policies.polid as {"members": ["x"], "statements": {"statementid": {"effect": "deny", "actions": ["y"], "resources": ["z"], "conditions": {"time_windows": [], "source_ips": ["10.0.0.0/8"], "subject_types": []}}}}
+		 with input as {"subjects": ["x"], "action": "y", "resource": "z", "source_ip": "10.1.2.3"}
+}
+
 ###############  authorized  #########################################
 
 test_not_authorized_when_only_not_matching_policies_with_effect_allow_are_present {
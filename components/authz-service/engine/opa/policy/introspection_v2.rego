@@ -29,6 +29,7 @@ match_pair[[effect, pair, pol_id, statement_id]] {
 	authz.has_member[pol_id]
 	pair_matches_resource[[pol_id, statement_id, pair]]
 	pair_matches_action[[pol_id, statement_id, pair]]
+	authz.conditions_met[[pol_id, statement_id]]
 }
 
 # Note: to return the subset of the authorized pairs of the provided input,
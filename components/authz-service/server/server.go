@@ -24,6 +24,7 @@ import (
 	"github.com/chef/automate/api/interservice/authz"
 	"github.com/chef/automate/api/interservice/authz/common"
 	api_v2 "github.com/chef/automate/api/interservice/authz/v2"
+	"github.com/chef/automate/components/authz-service/audit"
 	"github.com/chef/automate/components/authz-service/engine"
 	v1 "github.com/chef/automate/components/authz-service/server/v1"
 	v2 "github.com/chef/automate/components/authz-service/server/v2"
@@ -31,11 +32,13 @@ import (
 	"github.com/chef/automate/components/authz-service/storage/postgres/migration"
 )
 
-// GRPC creates and listens on grpc server.
+// GRPC creates and listens on grpc server. IAM v2 authorization decisions are
+// recorded using rec, unless it is nil.
 func GRPC(ctx context.Context,
 	addr string, l logger.Logger, connFactory *secureconn.Factory,
 	e engine.Engine, migrationsConfig migration.Config,
-	dataMigrationsConfig datamigration.Config, eventServiceAddress string) error {
+	dataMigrationsConfig datamigration.Config, eventServiceAddress string,
+	rec *audit.Recorder) error {
 
 	grpclog.SetLoggerV2(l)
 	list, err := net.Listen("tcp", addr)
@@ -45,7 +48,7 @@ func GRPC(ctx context.Context,
 	l.Printf("Authz GRPC API listening on %s", addr)
 
 	server, err := NewGRPCServer(ctx, connFactory, l, e, migrationsConfig,
-		dataMigrationsConfig, eventServiceAddress)
+		dataMigrationsConfig, eventServiceAddress, rec)
 	if err != nil {
 		return err
 	}
@@ -58,7 +61,7 @@ func NewGRPCServer(ctx context.Context,
 	connFactory *secureconn.Factory, l logger.Logger,
 	e engine.Engine, migrationsConfig migration.Config,
 	dataMigrationsConfig datamigration.Config,
-	eventServiceAddress string) (*grpc.Server, error) {
+	eventServiceAddress string, rec *audit.Recorder) (*grpc.Server, error) {
 
 	// Note(sr): we're buffering one bool, as NewPostgresPolicyServer writes
 	// to this before we've got readers
@@ -82,16 +85,21 @@ func NewGRPCServer(ctx context.Context,
 	}
 
 	v2ProjectsServer, err := v2.NewPostgresProjectsServer(ctx, l, migrationsConfig,
-		dataMigrationsConfig, e, eventServiceClient)
+		dataMigrationsConfig, eventServiceClient)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not initialize v2 projects server")
 	}
 
-	v2AuthzServer, err := v2.NewAuthzServer(l, e)
+	v2AuthzServer, err := v2.NewAuthzServer(l, e, rec)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not initialize v2 authz server")
 	}
 
+	v2AuditServer, err := v2.NewAuditServer(l, rec)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize v2 audit server")
+	}
+
 	subjectPurgeServer, err := v2.NewSubjectPurgeServer(ctx, l, v1Server, v2PolServer)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not initialize subject purge server")
@@ -136,6 +144,7 @@ func NewGRPCServer(ctx context.Context,
 	api_v2.RegisterPoliciesServer(g, v2PolServer)
 	api_v2.RegisterProjectsServer(g, v2ProjectsServer)
 	api_v2.RegisterAuthorizationServer(g, v2AuthzServer)
+	api_v2.RegisterAuditServer(g, v2AuditServer)
 	common.RegisterSubjectPurgeServer(g, subjectPurgeServer)
 	reflection.Register(g)
 	return g, nil
@@ -0,0 +1,107 @@
+package v2
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chef/automate/lib/logger"
+
+	api "github.com/chef/automate/api/interservice/authz/v2"
+	"github.com/chef/automate/components/authz-service/audit"
+)
+
+type auditServer struct {
+	log   logger.Logger
+	audit *audit.Recorder
+}
+
+// NewAuditServer returns a new IAM v2 Audit server, listing the decisions
+// recorded by rec. If rec is nil, the audit log is disabled, and listing
+// decisions fails.
+func NewAuditServer(l logger.Logger, rec *audit.Recorder) (api.AuditServer, error) {
+	return &auditServer{
+		log:   l,
+		audit: rec,
+	}, nil
+}
+
+func (s *auditServer) ListDecisions(
+	ctx context.Context,
+	req *api.ListDecisionsReq) (*api.ListDecisionsResp, error) {
+
+	if s.audit == nil {
+		return nil, status.Error(codes.FailedPrecondition, "audit log is not enabled")
+	}
+
+	f := audit.Filter{
+		Subject:  req.Subject,
+		Action:   req.Action,
+		Resource: req.Resource,
+		Limit:    int(req.Limit),
+	}
+	switch req.Result {
+	case api.ListDecisionsReq_ALLOWED:
+		f.Result = audit.Allowed
+	case api.ListDecisionsReq_DENIED:
+		f.Result = audit.Denied
+	}
+	var err error
+	if f.Since, err = fromTimestamp(req.Since); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid since: %s", err.Error())
+	}
+	if f.Until, err = fromTimestamp(req.Until); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid until: %s", err.Error())
+	}
+
+	ds, err := s.audit.List(ctx, f)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := api.ListDecisionsResp{Decisions: make([]*api.Decision, len(ds))}
+	for i, d := range ds {
+		resp.Decisions[i], err = toPBDecision(d)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &resp, nil
+}
+
+func fromTimestamp(ts *tspb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	return ptypes.Timestamp(ts)
+}
+
+func toPBDecision(d *audit.Decision) (*api.Decision, error) {
+	ts, err := ptypes.TimestampProto(d.Time)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]*api.Match, len(d.Matches))
+	for i, m := range d.Matches {
+		matches[i] = &api.Match{
+			Effect:      m.Effect,
+			PolicyId:    m.PolicyID,
+			StatementId: m.StatementID,
+		}
+	}
+	return &api.Decision{
+		Time:               ts,
+		Method:             d.Method,
+		Subjects:           d.Subjects,
+		Action:             d.Action,
+		Resource:           d.Resource,
+		Projects:           d.Projects,
+		Allowed:            d.Allowed,
+		AuthorizedProjects: d.AuthorizedProjects,
+		Matches:            matches,
+	}, nil
+}
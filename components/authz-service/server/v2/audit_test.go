@@ -0,0 +1,140 @@
+package v2_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	api_v2 "github.com/chef/automate/api/interservice/authz/v2"
+	"github.com/chef/automate/components/authz-service/audit"
+	"github.com/chef/automate/components/authz-service/engine"
+	v2 "github.com/chef/automate/components/authz-service/server/v2"
+	"github.com/chef/automate/lib/grpc/grpctest"
+	"github.com/chef/automate/lib/logger"
+)
+
+func TestListDecisions(t *testing.T) {
+	ctx := context.Background()
+	l, err := logger.NewLogger("text", "error")
+	require.NoError(t, err)
+
+	t.Run("when the audit log is disabled, returns FailedPrecondition", func(t *testing.T) {
+		srv, err := v2.NewAuditServer(l, nil)
+		require.NoError(t, err)
+		_, err = srv.ListDecisions(ctx, &api_v2.ListDecisionsReq{})
+		grpctest.AssertCode(t, codes.FailedPrecondition, err)
+	})
+
+	t.Run("returns the decisions selected by the request", func(t *testing.T) {
+		store, cleanup := newFileStore(t)
+		defer cleanup()
+		now := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, store.Write(ctx, []*audit.Decision{
+			{Time: now.Add(-2 * time.Hour), Method: "IsAuthorized", Subjects: []string{"user:local:alice"},
+				Action: "iam:users:delete", Resource: "iam:users:bob", Allowed: true,
+				Matches: []engine.Match{{Effect: "allow", PolicyID: "administrator-access", StatementID: "s1"}}},
+			{Time: now.Add(-1 * time.Hour), Method: "IsAuthorized", Subjects: []string{"user:local:bob"},
+				Action: "iam:users:delete", Resource: "iam:users:alice", Allowed: false},
+		}))
+		rec := audit.NewRecorder(l, store, &matcher{}, audit.Config{})
+		srv, err := v2.NewAuditServer(l, rec)
+		require.NoError(t, err)
+
+		since, err := ptypes.TimestampProto(now.Add(-3 * time.Hour))
+		require.NoError(t, err)
+		resp, err := srv.ListDecisions(ctx, &api_v2.ListDecisionsReq{
+			Resource: "iam:users:bob",
+			Result:   api_v2.ListDecisionsReq_ALLOWED,
+			Since:    since,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Decisions, 1)
+		d := resp.Decisions[0]
+		assert.Equal(t, []string{"user:local:alice"}, d.Subjects)
+		assert.Equal(t, "iam:users:delete", d.Action)
+		assert.True(t, d.Allowed)
+		assert.Equal(t, []*api_v2.Match{{Effect: "allow", PolicyId: "administrator-access", StatementId: "s1"}}, d.Matches)
+		ts, err := ptypes.Timestamp(d.Time)
+		require.NoError(t, err)
+		assert.True(t, now.Add(-2*time.Hour).Equal(ts))
+	})
+}
+
+func TestAuthzServerRecordsDecisions(t *testing.T) {
+	ctx := context.Background()
+	l, err := logger.NewLogger("text", "error")
+	require.NoError(t, err)
+	store, cleanup := newFileStore(t)
+	defer cleanup()
+	matches := []engine.Match{{Effect: "allow", PolicyID: "viewer-access", StatementID: "s1"}}
+	rec := audit.NewRecorder(l, store, &matcher{matches: matches}, audit.Config{
+		AllowedSampleRate: 1,
+		DeniedSampleRate:  1,
+	})
+
+	eng := responderEngine{
+		authorized: true,
+		pairs:      []engine.Pair{{Action: "iam:users:list", Resource: "iam:users"}},
+	}
+	srv, err := v2.NewAuthzServer(l, &eng, rec)
+	require.NoError(t, err)
+
+	_, err = srv.IsAuthorized(ctx, &api_v2.IsAuthorizedReq{
+		Subjects: []string{"user:local:alice"},
+		Action:   "iam:users:get",
+		Resource: "iam:users:bob",
+	})
+	require.NoError(t, err)
+	_, err = srv.FilterAuthorizedPairs(ctx, &api_v2.FilterAuthorizedPairsReq{
+		Subjects: []string{"user:local:alice"},
+		Pairs: []*api_v2.Pair{
+			{Action: "iam:users:list", Resource: "iam:users"},
+			{Action: "iam:users:create", Resource: "iam:users"},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, rec.Close()) // flushes the queue
+
+	ds, err := store.List(ctx, audit.Filter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, ds, 3)
+	byAction := map[string]*audit.Decision{}
+	for _, d := range ds {
+		byAction[d.Action] = d
+	}
+	assert.Equal(t, "IsAuthorized", byAction["iam:users:get"].Method)
+	assert.True(t, byAction["iam:users:get"].Allowed)
+	assert.Equal(t, matches, byAction["iam:users:get"].Matches)
+	assert.Equal(t, "FilterAuthorizedPairs", byAction["iam:users:list"].Method)
+	assert.True(t, byAction["iam:users:list"].Allowed)
+	assert.False(t, byAction["iam:users:create"].Allowed)
+}
+
+func newFileStore(t *testing.T) (*audit.FileStore, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	store, err := audit.NewFileStore(filepath.Join(dir, "decisions.log"), 0, 0)
+	require.NoError(t, err)
+	return store, func() {
+		store.Close()     // nolint: errcheck
+		os.RemoveAll(dir) // nolint: errcheck
+	}
+}
+
+type matcher struct {
+	matches []engine.Match
+}
+
+func (m *matcher) V2MatchingStatements(context.Context,
+	engine.Subjects, engine.Action, engine.Resource) ([]engine.Match, error) {
+	return m.matches, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -11,8 +12,10 @@ import (
 	"github.com/chef/automate/lib/stringutils"
 
 	api "github.com/chef/automate/api/interservice/authz/v2"
+	"github.com/chef/automate/components/authz-service/audit"
 	constants "github.com/chef/automate/components/authz-service/constants/v2"
 	"github.com/chef/automate/components/authz-service/engine"
+	storage "github.com/chef/automate/components/authz-service/storage/v2"
 )
 
 // These do not have to be the same
@@ -20,13 +23,16 @@ import (
 type authzServer struct {
 	log    logger.Logger
 	engine engine.V2Authorizer
+	audit  *audit.Recorder
 }
 
-// NewAuthzServer returns a new IAM v2 Authz server.
-func NewAuthzServer(l logger.Logger, e engine.V2Authorizer) (api.AuthorizationServer, error) {
+// NewAuthzServer returns a new IAM v2 Authz server. Its decisions are recorded
+// using rec, unless it is nil.
+func NewAuthzServer(l logger.Logger, e engine.V2Authorizer, rec *audit.Recorder) (api.AuthorizationServer, error) {
 	return &authzServer{
 		log:    l,
 		engine: e,
+		audit:  rec,
 	}, nil
 }
 
@@ -34,7 +40,7 @@ func (s *authzServer) IsAuthorized(
 	ctx context.Context,
 	req *api.IsAuthorizedReq) (*api.IsAuthorizedResp, error) {
 
-	authorized, err := s.engine.V2IsAuthorized(ctx,
+	authorized, err := s.engine.V2IsAuthorized(withEnvironment(ctx, req.SourceIp),
 		engine.Subjects(req.Subjects),
 		engine.Action(req.Action),
 		engine.Resource(req.Resource))
@@ -42,6 +48,14 @@ func (s *authzServer) IsAuthorized(
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	s.record(ctx, &audit.Decision{
+		Method:   "IsAuthorized",
+		Subjects: req.Subjects,
+		SourceIP: req.SourceIp,
+		Action:   req.Action,
+		Resource: req.Resource,
+		Allowed:  authorized,
+	})
 
 	return &api.IsAuthorizedResp{
 		Authorized: authorized,
@@ -52,7 +66,7 @@ func (s *authzServer) ProjectsAuthorized(
 	ctx context.Context,
 	req *api.ProjectsAuthorizedReq) (*api.ProjectsAuthorizedResp, error) {
 
-	projectsAuthorized, err := s.engine.V2ProjectsAuthorized(ctx,
+	projectsAuthorized, err := s.engine.V2ProjectsAuthorized(withEnvironment(ctx, req.SourceIp),
 		engine.Subjects(req.Subjects),
 		engine.Action(req.Action),
 		engine.Resource(req.Resource),
@@ -60,18 +74,18 @@ func (s *authzServer) ProjectsAuthorized(
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	// Generally we return the engine's response verbatim
-	// but there are two cases that need to be intercepted and adjusted.
+	s.record(ctx, &audit.Decision{
+		Method:             "ProjectsAuthorized",
+		Subjects:           req.Subjects,
+		SourceIP:           req.SourceIp,
+		Action:             req.Action,
+		Resource:           req.Resource,
+		Projects:           req.ProjectsFilter,
+		Allowed:            len(projectsAuthorized) > 0,
+		AuthorizedProjects: projectsAuthorized,
+	})
 	if stringutils.SliceContains(projectsAuthorized, constants.AllProjectsID) {
-		if len(req.ProjectsFilter) == 0 {
-			// Engine allows all and we requested all, so signify it as all.
-			// This must be different than the requested notion of all,
-			// an empty array, because an empty array coming back from the engine means none!
-			projectsAuthorized = []string{constants.AllProjectsExternalID}
-		} else {
-			// Engine allows all--but we want that to mean just the *requested* ones.
-			projectsAuthorized = req.ProjectsFilter
-		}
+		projectsAuthorized = externalProjects(projectsAuthorized, req.ProjectsFilter)
 		s.logProjectQuery(req, projectsAuthorized)
 	}
 
@@ -80,15 +94,137 @@ func (s *authzServer) ProjectsAuthorized(
 	}, nil
 }
 
+// ExplainAuthorization returns the decision for the request, together with
+// all the statements it was based on. Decisions are explained using the
+// policies in effect, optionally amended by the policies passed in the
+// request, which are not stored.
+func (s *authzServer) ExplainAuthorization(
+	ctx context.Context,
+	req *api.ExplainAuthorizationReq) (*api.ExplainAuthorizationResp, error) {
+
+	var policyMap map[string]interface{}
+	if len(req.Policies) > 0 {
+		policies := make([]*storage.Policy, len(req.Policies))
+		for i, p := range req.Policies {
+			name := p.Name
+			if name == "" {
+				name = p.Id
+			}
+			pol, err := policyFromAPI(p.Id, name, storage.Custom, p.Members, p.Statements, p.Projects)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"error parsing policy %q: %s", p.Id, err.Error())
+			}
+			policies[i] = &pol
+		}
+		policyMap = opaPolicyMap(policies)
+	}
+
+	env := engine.Environment{SourceIP: req.SourceIp}
+	if req.Time != nil {
+		t, err := ptypes.Timestamp(req.Time)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time: %s", err.Error())
+		}
+		env.Time = t
+	}
+
+	exp, err := s.engine.V2Explain(engine.WithEnvironment(ctx, env),
+		engine.Subjects(req.Subjects),
+		engine.Action(req.Action),
+		engine.Resource(req.Resource),
+		engine.ProjectList(req.Projects...),
+		policyMap)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	projects := exp.Projects
+	if stringutils.SliceContains(projects, constants.AllProjectsID) {
+		projects = externalProjects(projects, req.Projects)
+	}
+	statements := make([]*api.ExplainedStatement, len(exp.Statements))
+	for i, st := range exp.Statements {
+		effect := api.Statement_ALLOW
+		if st.Effect == "deny" {
+			effect = api.Statement_DENY
+		}
+		statements[i] = &api.ExplainedStatement{
+			PolicyId:    st.PolicyID,
+			StatementId: st.StatementID,
+			Statement: &api.Statement{
+				Effect:    effect,
+				Role:      st.Role,
+				Actions:   st.Actions,
+				Resources: st.Resources,
+				Projects:  externalProjectIDs(st.Projects),
+			},
+		}
+		if st.Conditions != nil {
+			statements[i].Statement.Conditions = conditionsFromEngine(st.Conditions)
+		}
+	}
+
+	return &api.ExplainAuthorizationResp{
+		Authorized: exp.Authorized,
+		Projects:   projects,
+		Statements: statements,
+	}, nil
+}
+
+func conditionsFromEngine(c *engine.Conditions) *api.Conditions {
+	windows := make([]*api.TimeWindow, len(c.TimeWindows))
+	for i, w := range c.TimeWindows {
+		start, _ := ptypes.TimestampProto(w.Start)
+		end, _ := ptypes.TimestampProto(w.End)
+		windows[i] = &api.TimeWindow{Start: start, End: end}
+	}
+	return &api.Conditions{
+		TimeWindows:  windows,
+		SourceIps:    c.SourceIPs,
+		SubjectTypes: c.SubjectTypes,
+	}
+}
+
+// externalProjects adjusts the engine's response for the projects allowed if
+// it contains the "all projects" meta-project.
+func externalProjects(authorized, requested []string) []string {
+	if len(requested) == 0 {
+		// Engine allows all and we requested all, so signify it as all.
+		// This must be different than the requested notion of all,
+		// an empty array, because an empty array coming back from the engine means none!
+		return []string{constants.AllProjectsExternalID}
+	}
+	// Engine allows all--but we want that to mean just the *requested* ones.
+	return requested
+}
+
 func (s *authzServer) FilterAuthorizedPairs(
 	ctx context.Context,
 	req *api.FilterAuthorizedPairsReq) (*api.FilterAuthorizedPairsResp, error) {
-	resp, err := s.engine.V2FilterAuthorizedPairs(ctx,
+	resp, err := s.engine.V2FilterAuthorizedPairs(withEnvironment(ctx, req.SourceIp),
 		engine.Subjects(req.Subjects),
 		toEnginePairs(req.Pairs))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if s.audit != nil {
+		// every requested pair is a decision of its own
+		allowed := make(map[engine.Pair]bool, len(resp))
+		for _, p := range resp {
+			allowed[p] = true
+		}
+		for _, p := range toEnginePairs(req.Pairs) {
+			s.audit.Record(ctx, &audit.Decision{
+				Method:   "FilterAuthorizedPairs",
+				Subjects: req.Subjects,
+				SourceIP: req.SourceIp,
+				Action:   string(p.Action),
+				Resource: string(p.Resource),
+				Allowed:  allowed[p],
+			})
+		}
+	}
 
 	return &api.FilterAuthorizedPairsResp{
 		Pairs: toPBPairs(resp),
@@ -98,18 +234,38 @@ func (s *authzServer) FilterAuthorizedPairs(
 func (s *authzServer) FilterAuthorizedProjects(
 	ctx context.Context,
 	req *api.FilterAuthorizedPairsReq) (*api.FilterAuthorizedProjectsResp, error) {
-	resp, err := s.engine.V2FilterAuthorizedProjects(ctx,
+	resp, err := s.engine.V2FilterAuthorizedProjects(withEnvironment(ctx, req.SourceIp),
 		engine.Subjects(req.Subjects),
 		toEnginePairs(req.Pairs))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	s.record(ctx, &audit.Decision{
+		Method:             "FilterAuthorizedProjects",
+		Subjects:           req.Subjects,
+		SourceIP:           req.SourceIp,
+		Allowed:            len(resp) > 0,
+		AuthorizedProjects: resp,
+	})
 
 	return &api.FilterAuthorizedProjectsResp{
 		Projects: resp,
 	}, nil
 }
 
+// withEnvironment returns a context carrying the environment of a request made
+// now by a client at sourceIP, for the conditions of policy statements to be
+// checked against.
+func withEnvironment(ctx context.Context, sourceIP string) context.Context {
+	return engine.WithEnvironment(ctx, engine.Environment{SourceIP: sourceIP})
+}
+
+func (s *authzServer) record(ctx context.Context, d *audit.Decision) {
+	if s.audit != nil {
+		s.audit.Record(ctx, d)
+	}
+}
+
 func (s *authzServer) logQuery(req *api.IsAuthorizedReq, authorized bool, err error) {
 	result := fmt.Sprintf("%t", authorized)
 	if err != nil {
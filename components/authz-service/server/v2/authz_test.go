@@ -3,13 +3,17 @@ package v2_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
 
 	api_v2 "github.com/chef/automate/api/interservice/authz/v2"
 	constants "github.com/chef/automate/components/authz-service/constants/v2"
 	"github.com/chef/automate/components/authz-service/engine"
+	"github.com/chef/automate/lib/grpc/grpctest"
 )
 
 /************ ************ ************ ************ ************ ************
@@ -46,6 +50,19 @@ func TestIsAuthorized(t *testing.T) {
 		require.NoError(t, err)
 		assert.False(t, resp.Authorized)
 	})
+
+	t.Run("passes the request's source IP and the current time to the engine", func(t *testing.T) {
+		before := time.Now()
+		_, err := ts.authz.IsAuthorized(ctx, &api_v2.IsAuthorizedReq{
+			Subjects: []string{"user:local:admin"},
+			Resource: "some:thing",
+			Action:   "do:that:thing",
+			SourceIp: "192.0.2.10",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "192.0.2.10", eng.env.SourceIP)
+		assert.False(t, eng.env.Time.Before(before))
+	})
 }
 
 func TestProjectsAuthorized(t *testing.T) {
@@ -138,6 +155,135 @@ func TestFilterAuthorizedProjects(t *testing.T) {
 	})
 }
 
+func TestExplainAuthorization(t *testing.T) {
+	eng := responderEngine{}
+	ctx, ts := setupAuthTests(t, &eng)
+	req := func() *api_v2.ExplainAuthorizationReq {
+		return &api_v2.ExplainAuthorizationReq{
+			Subjects: []string{"user:local:admin"},
+			Resource: "some:thing",
+			Action:   "do:that:thing",
+		}
+	}
+
+	t.Run("returns the engine's decision and statements", func(t *testing.T) {
+		eng.explanation = &engine.Explanation{
+			Authorized: false,
+			Projects:   []string{"project-1"},
+			Statements: []engine.Statement{
+				{
+					Match:     engine.Match{Effect: "allow", PolicyID: "pol-1", StatementID: "st-1"},
+					Actions:   []string{"do:that:thing"},
+					Resources: []string{"*"},
+					Projects:  []string{constants.AllProjectsID},
+				},
+				{
+					Match:     engine.Match{Effect: "deny", PolicyID: "pol-2", StatementID: "st-2"},
+					Role:      "viewer",
+					Resources: []string{"some:*"},
+					Projects:  []string{"project-1"},
+					Conditions: &engine.Conditions{
+						TimeWindows: []engine.TimeWindow{{
+							Start: time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC),
+							End:   time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC),
+						}},
+						SourceIPs:    []string{"10.0.0.0/8"},
+						SubjectTypes: []string{"token"},
+					},
+				},
+			},
+		}
+		resp, err := ts.authz.ExplainAuthorization(ctx, req())
+		require.NoError(t, err)
+		assert.False(t, resp.Authorized)
+		assert.Equal(t, []string{"project-1"}, resp.Projects)
+		assert.Equal(t, []*api_v2.ExplainedStatement{
+			{
+				PolicyId:    "pol-1",
+				StatementId: "st-1",
+				Statement: &api_v2.Statement{
+					Effect:    api_v2.Statement_ALLOW,
+					Actions:   []string{"do:that:thing"},
+					Resources: []string{"*"},
+					Projects:  []string{constants.AllProjectsExternalID},
+				},
+			},
+			{
+				PolicyId:    "pol-2",
+				StatementId: "st-2",
+				Statement: &api_v2.Statement{
+					Effect:    api_v2.Statement_DENY,
+					Role:      "viewer",
+					Resources: []string{"some:*"},
+					Projects:  []string{"project-1"},
+					Conditions: &api_v2.Conditions{
+						TimeWindows: []*api_v2.TimeWindow{{
+							Start: &tspb.Timestamp{Seconds: 1559347200},
+							End:   &tspb.Timestamp{Seconds: 1561939200},
+						}},
+						SourceIps:    []string{"10.0.0.0/8"},
+						SubjectTypes: []string{"token"},
+					},
+				},
+			},
+		}, resp.Statements)
+		assert.Nil(t, eng.policies)
+	})
+
+	t.Run("when the engine allows all projects, returns the requested ones", func(t *testing.T) {
+		eng.explanation = &engine.Explanation{
+			Authorized: true,
+			Projects:   []string{constants.AllProjectsID},
+		}
+		r := req()
+		r.Projects = []string{"project-1", "project-2"}
+		resp, err := ts.authz.ExplainAuthorization(ctx, r)
+		require.NoError(t, err)
+		assert.True(t, resp.Authorized)
+		assert.Equal(t, []string{"project-1", "project-2"}, resp.Projects)
+	})
+
+	t.Run("passes hypothetical policies to the engine", func(t *testing.T) {
+		eng.explanation = &engine.Explanation{}
+		r := req()
+		r.Policies = []*api_v2.Policy{{
+			Id:      "new-policy",
+			Members: []string{"user:local:admin"},
+			Statements: []*api_v2.Statement{{
+				Effect:  api_v2.Statement_DENY,
+				Actions: []string{"do:that:thing"},
+			}},
+		}}
+		_, err := ts.authz.ExplainAuthorization(ctx, r)
+		require.NoError(t, err)
+		require.Contains(t, eng.policies, "new-policy")
+		pol := eng.policies["new-policy"].(map[string]interface{})
+		assert.Equal(t, []string{"user:local:admin"}, pol["members"])
+		statements := pol["statements"].(map[string]interface{})
+		require.Len(t, statements, 1)
+		for _, st := range statements {
+			assert.Equal(t, map[string]interface{}{
+				"effect":    "deny",
+				"role":      "",
+				"projects":  []string{},
+				"actions":   []string{"do:that:thing"},
+				"resources": []string{"*"},
+			}, st)
+		}
+	})
+
+	t.Run("when a hypothetical policy is invalid, returns InvalidArgument", func(t *testing.T) {
+		r := req()
+		r.Policies = []*api_v2.Policy{{
+			Id:         "new-policy",
+			Members:    []string{"user:local:admin"},
+			Statements: []*api_v2.Statement{{Effect: api_v2.Statement_DENY}},
+		}}
+		_, err := ts.authz.ExplainAuthorization(ctx, r)
+		grpctest.AssertCode(t, codes.InvalidArgument, err)
+	})
+}
+
 func setupAuthTests(t *testing.T, eng *responderEngine) (context.Context, testSetup) {
 	ctx := context.Background()
 	v2Chan := make(chan bool, 1)
@@ -149,16 +295,20 @@ func setupAuthTests(t *testing.T, eng *responderEngine) (context.Context, testSe
 }
 
 type responderEngine struct {
-	authorized bool
-	pairs      []engine.Pair
-	projects   []string
+	authorized  bool
+	pairs       []engine.Pair
+	projects    []string
+	explanation *engine.Explanation
+	policies    map[string]interface{} // passed to V2Explain
+	env         engine.Environment     // passed to V2IsAuthorized
 }
 
 func (e *responderEngine) V2IsAuthorized(
-	context.Context,
-	engine.Subjects,
-	engine.Action,
-	engine.Resource) (bool, error) {
+	ctx context.Context,
+	_ engine.Subjects,
+	_ engine.Action,
+	_ engine.Resource) (bool, error) {
+	e.env = engine.EnvironmentFromContext(ctx)
 	return e.authorized, nil
 }
 
@@ -184,3 +334,14 @@ func (e *responderEngine) V2FilterAuthorizedProjects(
 	[]engine.Pair) ([]string, error) {
 	return e.projects, nil
 }
+
+func (e *responderEngine) V2Explain(
+	_ context.Context,
+	_ engine.Subjects,
+	_ engine.Action,
+	_ engine.Resource,
+	_ engine.Projects,
+	policies map[string]interface{}) (*engine.Explanation, error) {
+	e.policies = policies
+	return e.explanation, nil
+}
@@ -1384,6 +1384,66 @@ func TestV1PolicyMigration(t *testing.T) {
 				}),
 			),
 		},
+		"compliance:waivers,create": {
+			storage_v1.Policy{
+				ID:       polID,
+				Subjects: []string{"user:local:albertine", "team:local:admins"},
+				Action:   "create",
+				Resource: "compliance:waivers",
+			},
+			checks(
+				hasStatements(storage_v2.Statement{
+					Effect:    storage_v2.Allow,
+					Resources: []string{"compliance:waivers"},
+					Actions:   []string{"*:create"},
+				}),
+			),
+		},
+		"compliance:waivers:*,*": {
+			storage_v1.Policy{
+				ID:       polID,
+				Subjects: []string{"user:local:albertine", "team:local:admins"},
+				Action:   "*",
+				Resource: "compliance:waivers:*",
+			},
+			checks(
+				hasStatements(storage_v2.Statement{
+					Effect:    storage_v2.Allow,
+					Resources: []string{"compliance:waivers:*"},
+					Actions:   []string{"*"},
+				}),
+			),
+		},
+		"compliance:waivers:id,delete": {
+			storage_v1.Policy{
+				ID:       polID,
+				Subjects: []string{"user:local:albertine", "team:local:admins"},
+				Action:   "delete",
+				Resource: "compliance:waivers:id",
+			},
+			checks(
+				hasStatements(storage_v2.Statement{
+					Effect:    storage_v2.Allow,
+					Resources: []string{"compliance:waivers:id"},
+					Actions:   []string{"*:delete"},
+				}),
+			),
+		},
+		"compliance:waivers,search": {
+			storage_v1.Policy{
+				ID:       polID,
+				Subjects: []string{"user:local:albertine", "team:local:admins"},
+				Action:   "search",
+				Resource: "compliance:waivers",
+			},
+			checks(
+				hasStatements(storage_v2.Statement{
+					Effect:    storage_v2.Allow,
+					Resources: []string{"compliance:waivers"},
+					Actions:   []string{"*:list"},
+				}),
+			),
+		},
 		"events,read": {
 			storage_v1.Policy{
 				ID:       polID,
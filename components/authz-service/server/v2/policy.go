@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -677,19 +678,27 @@ func (s *policyServer) getPolicyMap(ctx context.Context) (map[string]interface{}
 
 	policies = append(policies, SystemPolicies()...)
 
-	// OPA requires this format
+	return opaPolicyMap(policies), nil
+}
+
+// opaPolicyMap converts policies into the format OPA requires
+func opaPolicyMap(policies []*storage.Policy) map[string]interface{} {
 	data := make(map[string]interface{})
 	for _, p := range policies {
 
 		statements := make(map[string]interface{})
 		for _, st := range p.Statements {
-			statements[st.ID.String()] = map[string]interface{}{
+			statement := map[string]interface{}{
 				"effect":    st.Effect.String(),
 				"role":      st.Role,
 				"projects":  st.Projects,
 				"actions":   st.Actions,
 				"resources": st.Resources,
 			}
+			if st.Conditions != nil {
+				statement["conditions"] = opaConditions(st.Conditions)
+			}
+			statements[st.ID.String()] = statement
 		}
 
 		members := make([]string, len(p.Members))
@@ -702,7 +711,25 @@ func (s *policyServer) getPolicyMap(ctx context.Context) (map[string]interface{}
 			"statements": statements,
 		}
 	}
-	return data, nil
+	return data
+}
+
+// opaConditions converts statement conditions into the format OPA requires;
+// times are passed as nanoseconds since the epoch, so the policy can compare
+// them to the request's time
+func opaConditions(c *storage.Conditions) map[string]interface{} {
+	windows := make([]interface{}, len(c.TimeWindows))
+	for i, w := range c.TimeWindows {
+		windows[i] = map[string]interface{}{
+			"start_ns": w.Start.UnixNano(),
+			"end_ns":   w.End.UnixNano(),
+		}
+	}
+	return map[string]interface{}{
+		"time_windows":  windows,
+		"source_ips":    c.SourceIPs,
+		"subject_types": c.SubjectTypes,
+	}
 }
 
 func (s *policyServer) getRoleMap(ctx context.Context) (map[string]interface{}, error) {
@@ -808,7 +835,8 @@ func (s *policyServer) EngineUpdateInterceptor() grpc.UnaryServerInterceptor {
 			"/chef.automate.domain.authz.v2.Policies/GetPolicy",
 			"/chef.automate.domain.authz.v2.Policies/GetRole",
 			"/chef.automate.domain.authz.v2.Policies/ListPolicyMembers",
-			"/chef.automate.domain.authz.v2.Policies/GetPolicyVersion":
+			"/chef.automate.domain.authz.v2.Policies/GetPolicyVersion",
+			"/chef.automate.domain.authz.v2.Policies/ExportState":
 			// do nothing
 		default:
 			if err := s.updateEngineStore(ctx); err != nil {
@@ -875,27 +903,50 @@ func typeFromInternal(t storage.Type) api.Type {
 func statementsFromInternal(internal []storage.Statement) []*api.Statement {
 	resp := make([]*api.Statement, len(internal))
 	for i, statement := range internal {
-		projects := make([]string, len(statement.Projects))
-		for i, project := range statement.Projects {
-			if project == constants.AllProjectsID {
-				projects[i] = constants.AllProjectsExternalID
-			} else {
-				projects[i] = project
-			}
-		}
-
 		resp[i] = &api.Statement{
 			Effect:    effectFromInternal(statement.Effect),
 			Role:      statement.Role,
-			Projects:  projects,
+			Projects:  externalProjectIDs(statement.Projects),
 			Actions:   statement.Actions,
 			Resources: statement.Resources,
 		}
+		if statement.Conditions != nil {
+			resp[i].Conditions = conditionsFromInternal(statement.Conditions)
+		}
 	}
 
 	return resp
 }
 
+func conditionsFromInternal(internal *storage.Conditions) *api.Conditions {
+	windows := make([]*api.TimeWindow, len(internal.TimeWindows))
+	for i, w := range internal.TimeWindows {
+		// the times have been validated when the conditions were created
+		start, _ := ptypes.TimestampProto(w.Start)
+		end, _ := ptypes.TimestampProto(w.End)
+		windows[i] = &api.TimeWindow{Start: start, End: end}
+	}
+	return &api.Conditions{
+		TimeWindows:  windows,
+		SourceIps:    internal.SourceIPs,
+		SubjectTypes: internal.SubjectTypes,
+	}
+}
+
+// externalProjectIDs maps the ID of the "all projects" meta-project to its
+// external representation
+func externalProjectIDs(internal []string) []string {
+	projects := make([]string, len(internal))
+	for i, project := range internal {
+		if project == constants.AllProjectsID {
+			projects[i] = constants.AllProjectsExternalID
+		} else {
+			projects[i] = project
+		}
+	}
+	return projects
+}
+
 func effectFromInternal(internal storage.Effect) api.Statement_Effect {
 	switch internal {
 	case storage.Allow:
@@ -973,7 +1024,40 @@ func statementFromAPI(statement *api.Statement) (storage.Statement, error) {
 		}
 	}
 
-	return storage.NewStatement(effect, statement.Role, projects, statement.Resources, statement.Actions)
+	st, err := storage.NewStatement(effect, statement.Role, projects, statement.Resources, statement.Actions)
+	if err != nil {
+		return storage.Statement{}, err
+	}
+
+	st.Conditions, err = conditionsFromAPI(statement.Conditions)
+	if err != nil {
+		return storage.Statement{}, err
+	}
+	return st, nil
+}
+
+func conditionsFromAPI(conditions *api.Conditions) (*storage.Conditions, error) {
+	if conditions == nil {
+		return nil, nil
+	}
+
+	windows := make([]storage.TimeWindow, len(conditions.TimeWindows))
+	for i, w := range conditions.TimeWindows {
+		if w.GetStart() == nil || w.GetEnd() == nil {
+			return nil, errors.New("invalid time window: start and end are required")
+		}
+		start, err := ptypes.Timestamp(w.Start)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid time window start")
+		}
+		end, err := ptypes.Timestamp(w.End)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid time window end")
+		}
+		windows[i] = storage.TimeWindow{Start: start.UTC(), End: end.UTC()}
+	}
+
+	return storage.NewConditions(windows, conditions.SourceIps, conditions.SubjectTypes)
 }
 
 func effectFromAPI(eff api.Statement_Effect) (storage.Effect, error) {
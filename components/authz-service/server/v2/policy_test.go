@@ -7,7 +7,9 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/jaswdr/faker"
 	cache "github.com/patrickmn/go-cache"
@@ -320,6 +322,75 @@ func TestCreatePolicy(t *testing.T) {
 			require.NotNil(t, resp)
 			assert.Equal(t, len(items)+1, store.ItemCount())
 		}},
+		{"successfully creates policy with statement containing conditions", func(t *testing.T) {
+			start := time.Date(2019, 6, 1, 8, 0, 0, 0, time.UTC)
+			end := start.Add(4 * time.Hour)
+			startProto, err := ptypes.TimestampProto(start)
+			require.NoError(t, err)
+			endProto, err := ptypes.TimestampProto(end)
+			require.NoError(t, err)
+			statement0 := api_v2.Statement{
+				Effect:  api_v2.Statement_ALLOW,
+				Actions: []string{"*"},
+				Conditions: &api_v2.Conditions{
+					TimeWindows:  []*api_v2.TimeWindow{{Start: startProto, End: endProto}},
+					SourceIps:    []string{"10.0.0.0/8"},
+					SubjectTypes: []string{"user"},
+				},
+			}
+			req := api_v2.CreatePolicyReq{
+				Id:         "break-glass",
+				Name:       "break glass admin",
+				Members:    []string{"user:local:alice"},
+				Statements: []*api_v2.Statement{&statement0},
+			}
+
+			resp, err := cl.CreatePolicy(ctx, &req)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(resp.Statements))
+			conditions := resp.Statements[0].Conditions
+			require.NotNil(t, conditions)
+			require.Equal(t, 1, len(conditions.TimeWindows))
+			assert.Equal(t, startProto.Seconds, conditions.TimeWindows[0].Start.Seconds)
+			assert.Equal(t, endProto.Seconds, conditions.TimeWindows[0].End.Seconds)
+			assert.Equal(t, []string{"10.0.0.0/8"}, conditions.SourceIps)
+			assert.Equal(t, []string{"user"}, conditions.SubjectTypes)
+
+			pol := getPolicyFromStore(t, store, resp.Id)
+			require.Equal(t, 1, len(pol.Statements))
+			assert.Equal(t, &storage.Conditions{
+				TimeWindows:  []storage.TimeWindow{{Start: start, End: end}},
+				SourceIPs:    []string{"10.0.0.0/8"},
+				SubjectTypes: []string{"user"},
+			}, pol.Statements[0].Conditions)
+		}},
+		{"fails with InvalidArgument when policy contains statement with invalid conditions", func(t *testing.T) {
+			_, items := addSomePoliciesToStore(t, store, prng)
+			for desc, conditions := range map[string]*api_v2.Conditions{
+				"source IP not in CIDR notation": {SourceIps: []string{"10.0.0.1"}},
+				"unknown subject type":           {SubjectTypes: []string{"team"}},
+				"time window without end":        {TimeWindows: []*api_v2.TimeWindow{{Start: ptypes.TimestampNow()}}},
+			} {
+				t.Run(desc, func(t *testing.T) {
+					req := api_v2.CreatePolicyReq{
+						Id:      "policy1",
+						Name:    "my favorite policy",
+						Members: []string{"user:local:alice"},
+						Statements: []*api_v2.Statement{{
+							Effect:     api_v2.Statement_ALLOW,
+							Actions:    []string{"*"},
+							Conditions: conditions,
+						}},
+					}
+
+					resp, err := cl.CreatePolicy(ctx, &req)
+
+					require.Nil(t, resp)
+					require.Equal(t, len(items), store.ItemCount())
+					grpctest.AssertCode(t, codes.InvalidArgument, err)
+				})
+			}
+		}},
 		{"fails with InvalidArgument when policy contains statement with empty actions AND no role", func(t *testing.T) {
 			_, items := addSomePoliciesToStore(t, store, prng)
 			statement0 := api_v2.Statement{
@@ -2849,10 +2920,10 @@ func setupV2(t *testing.T,
 	require.NoError(t, err)
 
 	eventServiceClient := &mockEventServiceClient{}
-	projectsSrv, err := v2.NewProjectsServer(ctx, l, mem_v2, &testProjectRulesRetriever{}, eventServiceClient)
+	projectsSrv, err := v2.NewProjectsServer(ctx, l, mem_v2, eventServiceClient)
 	require.NoError(t, err)
 
-	authzV2, err := v2.NewAuthzServer(l, authorizer)
+	authzV2, err := v2.NewAuthzServer(l, authorizer, nil)
 	require.NoError(t, err)
 
 	serviceCerts := helpers.LoadDevCerts(t, "authz-service")
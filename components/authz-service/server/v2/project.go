@@ -12,7 +12,6 @@ import (
 
 	api "github.com/chef/automate/api/interservice/authz/v2"
 	automate_event "github.com/chef/automate/api/interservice/event"
-	"github.com/chef/automate/components/authz-service/engine"
 	storage_errors "github.com/chef/automate/components/authz-service/storage"
 	"github.com/chef/automate/components/authz-service/storage/postgres/datamigration"
 	"github.com/chef/automate/components/authz-service/storage/postgres/migration"
@@ -29,7 +28,6 @@ import (
 type state struct {
 	log                  logger.Logger
 	store                storage.Storage
-	engine               engine.ProjectRulesRetriever
 	projectUpdateManager ProjectUpdateManager
 }
 
@@ -37,11 +35,10 @@ type state struct {
 func NewMemstoreProjectsServer(
 	ctx context.Context,
 	l logger.Logger,
-	e engine.ProjectRulesRetriever,
 	eventServiceClient automate_event.EventServiceClient,
 ) (api.ProjectsServer, error) {
 
-	return NewProjectsServer(ctx, l, memstore.New(), e, eventServiceClient)
+	return NewProjectsServer(ctx, l, memstore.New(), eventServiceClient)
 }
 
 // NewPostgresProjectsServer instantiates a ProjectsServer using a PG store
@@ -50,7 +47,6 @@ func NewPostgresProjectsServer(
 	l logger.Logger,
 	migrationsConfig migration.Config,
 	dataMigrationsConfig datamigration.Config,
-	e engine.ProjectRulesRetriever,
 	eventServiceClient automate_event.EventServiceClient,
 ) (api.ProjectsServer, error) {
 
@@ -58,21 +54,19 @@ func NewPostgresProjectsServer(
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to initialize v2 store state")
 	}
-	return NewProjectsServer(ctx, l, s, e, eventServiceClient)
+	return NewProjectsServer(ctx, l, s, eventServiceClient)
 }
 
 func NewProjectsServer(
 	ctx context.Context,
 	l logger.Logger,
 	s storage.Storage,
-	e engine.ProjectRulesRetriever,
 	eventServiceClient automate_event.EventServiceClient,
 ) (api.ProjectsServer, error) {
 
 	return &state{
 		log:                  l,
 		store:                s,
-		engine:               e,
 		projectUpdateManager: NewProjectUpdateManager(eventServiceClient),
 	}, nil
 }
@@ -206,20 +200,133 @@ func (s *state) DeleteProject(ctx context.Context,
 	}
 }
 
+func (s *state) CreateRule(ctx context.Context,
+	req *api.CreateRuleReq) (*api.CreateRuleResp, error) {
+	r, err := storage.NewRule(req.Id, req.ProjectId, req.Name, ruleConditionsFromAPI(req.Conditions))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"creating rule with ID %q: %s", req.Id, err.Error())
+	}
+
+	resp, err := s.store.CreateRule(ctx, &r)
+	switch err {
+	case nil:
+		return &api.CreateRuleResp{Rule: fromStorageRule(resp)}, nil
+	case storage_errors.ErrConflict:
+		return nil, status.Errorf(codes.AlreadyExists, "rule with ID %q already exists", req.Id)
+	case storage_errors.ErrForeignKey:
+		return nil, status.Errorf(codes.NotFound, "no project with ID %q found", req.ProjectId)
+	default:
+		return nil, status.Errorf(codes.Internal,
+			"error creating rule with ID %q: %s", req.Id, err.Error())
+	}
+}
+
+func (s *state) UpdateRule(ctx context.Context,
+	req *api.UpdateRuleReq) (*api.UpdateRuleResp, error) {
+	r, err := storage.NewRule(req.Id, req.ProjectId, req.Name, ruleConditionsFromAPI(req.Conditions))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"updating rule with ID %q: %s", req.Id, err.Error())
+	}
+
+	resp, err := s.store.UpdateRule(ctx, &r)
+	switch err {
+	case nil:
+		return &api.UpdateRuleResp{Rule: fromStorageRule(resp)}, nil
+	case storage_errors.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound,
+			"no rule with ID %q found for project %q", req.Id, req.ProjectId)
+	default:
+		return nil, status.Errorf(codes.Internal,
+			"error updating rule with ID %q: %s", req.Id, err.Error())
+	}
+}
+
+func (s *state) GetRule(ctx context.Context,
+	req *api.GetRuleReq) (*api.GetRuleResp, error) {
+	resp, err := s.store.GetStagedOrAppliedRule(ctx, req.ProjectId, req.Id)
+	switch err {
+	case nil:
+		return &api.GetRuleResp{Rule: fromStorageRule(resp)}, nil
+	case storage_errors.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound,
+			"no rule with ID %q found for project %q", req.Id, req.ProjectId)
+	default:
+		return nil, status.Errorf(codes.Internal,
+			"error retrieving rule with ID %q: %s", req.Id, err.Error())
+	}
+}
+
+func (s *state) ListRulesForProject(ctx context.Context,
+	req *api.ListRulesForProjectReq) (*api.ListRulesForProjectResp, error) {
+	rules, err := s.store.ListStagedOrAppliedRules(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"error retrieving rules for project %q: %s", req.Id, err.Error())
+	}
+
+	resp := api.ListRulesForProjectResp{
+		Rules: make([]*api.ProjectRule, len(rules)),
+	}
+	for i, r := range rules {
+		resp.Rules[i] = fromStorageRule(r)
+	}
+	return &resp, nil
+}
+
+func (s *state) DeleteRule(ctx context.Context,
+	req *api.DeleteRuleReq) (*api.DeleteRuleResp, error) {
+	err := s.store.DeleteRule(ctx, req.ProjectId, req.Id)
+	switch err {
+	case nil:
+		return &api.DeleteRuleResp{}, nil
+	case storage_errors.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound,
+			"no rule with ID %q found for project %q", req.Id, req.ProjectId)
+	default:
+		return nil, status.Errorf(codes.Internal,
+			"error deleting rule with ID %q: %s", req.Id, err.Error())
+	}
+}
+
+// ApplyRulesStart makes the staged rule changes take effect, and starts
+// updating the projects of the domain services' resources accordingly.
+func (s *state) ApplyRulesStart(ctx context.Context,
+	_ *api.ApplyRulesStartReq) (*api.ApplyRulesStartResp, error) {
+	// Applying while an update is running would leave the resources tagged
+	// according to a mix of old and new rules.
+	if s.projectUpdateManager.State() == RunningState {
+		return nil, status.Error(codes.FailedPrecondition,
+			"a project update is in progress, try again once it has completed")
+	}
+
+	if err := s.store.ApplyStagedRules(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "error applying staged rules: %s", err.Error())
+	}
+
+	if err := s.projectUpdateManager.Start(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting project update: %s", err.Error())
+	}
+	return &api.ApplyRulesStartResp{}, nil
+}
+
 func (s *state) ListProjectRules(ctx context.Context,
 	req *api.ListProjectRulesReq) (*api.ProjectCollectionRulesResp, error) {
 
-	ruleMap, err := s.engine.ListProjectMappings(ctx)
+	rules, err := s.store.ListRules(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	projectRules := make(map[string]*api.ProjectRules, len(ruleMap))
-	for projectID, rules := range ruleMap {
-		pr := rulesToProjectRules(rules)
-		projectRules[projectID] = &api.ProjectRules{
-			Rules: pr,
+	projectRules := make(map[string]*api.ProjectRules)
+	for _, r := range rules {
+		pr, ok := projectRules[r.ProjectID]
+		if !ok {
+			pr = &api.ProjectRules{}
+			projectRules[r.ProjectID] = pr
 		}
+		pr.Rules = append(pr.Rules, fromStorageRule(r))
 	}
 	return &api.ProjectCollectionRulesResp{
 		ProjectRules: projectRules,
@@ -233,19 +340,25 @@ func (s *state) GetProjectRules(ctx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "GetProjectRules requires a ProjectID")
 	}
 
-	rules, err := s.engine.RulesForProject(ctx, req.ProjectId)
+	rules, err := s.store.ListRules(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not retrieve rules for project %q: %s",
 			req.ProjectId, err.Error())
 	}
-	if len(rules) == 0 {
+	pr := []*api.ProjectRule{}
+	for _, r := range rules {
+		if r.ProjectID == req.ProjectId {
+			pr = append(pr, fromStorageRule(r))
+		}
+	}
+	if len(pr) == 0 {
 		return nil, status.Errorf(codes.NotFound,
 			"could not find project mapping rules for project %s", req.ProjectId)
 	}
 
 	return &api.GetProjectRulesResp{
 		RulesForProject: &api.ProjectRules{
-			Rules: rulesToProjectRules(rules),
+			Rules: pr,
 		},
 	}, nil
 }
@@ -282,16 +395,31 @@ func fromStorageProject(p *storage.Project) (*api.Project, error) {
 	}, nil
 }
 
-func rulesToProjectRules(rules []engine.Rule) []*api.ProjectRule {
-	pr := make([]*api.Condition, len(rules))
-	for j, r := range rules {
-		pr[j] = &api.Condition{
-			Type:   r.Type,
-			Values: r.Values,
+func fromStorageRule(r *storage.Rule) *api.ProjectRule {
+	conditions := make([]*api.Condition, len(r.Conditions))
+	for i, c := range r.Conditions {
+		conditions[i] = &api.Condition{
+			Type:   c.Type,
+			Values: c.Values,
 		}
 	}
-	rule := &api.ProjectRule{
-		Conditions: pr,
+	return &api.ProjectRule{
+		Id:         r.ID,
+		ProjectId:  r.ProjectID,
+		Name:       r.Name,
+		Conditions: conditions,
+		Status:     r.Status.String(),
+		Deleted:    r.Deleted,
+	}
+}
+
+func ruleConditionsFromAPI(cs []*api.Condition) []storage.Condition {
+	conditions := make([]storage.Condition, len(cs))
+	for i, c := range cs {
+		conditions[i] = storage.Condition{
+			Type:   c.Type,
+			Values: c.Values,
+		}
 	}
-	return []*api.ProjectRule{rule}
+	return conditions
 }
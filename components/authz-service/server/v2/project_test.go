@@ -16,7 +16,6 @@ import (
 	api "github.com/chef/automate/api/interservice/authz/v2"
 	automate_event "github.com/chef/automate/api/interservice/event"
 	constants "github.com/chef/automate/components/authz-service/constants/v2"
-	"github.com/chef/automate/components/authz-service/engine"
 	"github.com/chef/automate/components/authz-service/prng"
 	grpc_server "github.com/chef/automate/components/authz-service/server"
 	v2 "github.com/chef/automate/components/authz-service/server/v2"
@@ -325,6 +324,355 @@ func TestListProjects(t *testing.T) {
 	}
 }
 
+func TestCreateRule(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		desc string
+		f    func(*testing.T)
+	}{
+		{"if the rule id is invalid, returns 'invalid argument'", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			resp, err := cl.CreateRule(ctx, &api.CreateRuleReq{
+				Id:         "no_underscores",
+				ProjectId:  "foo-project",
+				Name:       "my rule",
+				Conditions: []*api.Condition{{Type: "ChefServers", Values: []string{"chef.example.com"}}},
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, resp)
+		}},
+		{"if the rule has no conditions, returns 'invalid argument'", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			resp, err := cl.CreateRule(ctx, &api.CreateRuleReq{
+				Id:        "foo-rule",
+				ProjectId: "foo-project",
+				Name:      "my rule",
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, resp)
+		}},
+		{"if a condition type is unknown, returns 'invalid argument'", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			resp, err := cl.CreateRule(ctx, &api.CreateRuleReq{
+				Id:         "foo-rule",
+				ProjectId:  "foo-project",
+				Name:       "my rule",
+				Conditions: []*api.Condition{{Type: "Platform", Values: []string{"ubuntu"}}},
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, resp)
+		}},
+		{"if the project does not exist, returns 'not found'", func(t *testing.T) {
+			cl, _, _ := setupProjects(t)
+			resp, err := cl.CreateRule(ctx, &api.CreateRuleReq{
+				Id:         "foo-rule",
+				ProjectId:  "foo-project",
+				Name:       "my rule",
+				Conditions: []*api.Condition{{Type: "ChefServers", Values: []string{"chef.example.com"}}},
+			})
+			grpctest.AssertCode(t, codes.NotFound, err)
+			assert.Nil(t, resp)
+		}},
+		{"if the rule already exists, returns 'already exists'", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			req := api.CreateRuleReq{
+				Id:         "foo-rule",
+				ProjectId:  "foo-project",
+				Name:       "my rule",
+				Conditions: []*api.Condition{{Type: "ChefServers", Values: []string{"chef.example.com"}}},
+			}
+			_, err := cl.CreateRule(ctx, &req)
+			require.NoError(t, err)
+
+			resp, err := cl.CreateRule(ctx, &req)
+			grpctest.AssertCode(t, codes.AlreadyExists, err)
+			assert.Nil(t, resp)
+		}},
+		{"creates a staged rule that is not in effect yet", func(t *testing.T) {
+			cl, store, eventServiceClient := setupProjects(t)
+			numberOfPublishes := eventServiceClient.PublishedEvents
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+
+			resp, err := cl.CreateRule(ctx, &api.CreateRuleReq{
+				Id:        "foo-rule",
+				ProjectId: "foo-project",
+				Name:      "my rule",
+				Conditions: []*api.Condition{
+					{Type: "ChefServers", Values: []string{"chef.example.com"}},
+					{Type: "ChefOrgs", Values: []string{"org1", "org2"}},
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "foo-rule", resp.Rule.Id)
+			assert.Equal(t, "foo-project", resp.Rule.ProjectId)
+			assert.Equal(t, "my rule", resp.Rule.Name)
+			assert.Equal(t, "staged", resp.Rule.Status)
+			require.Equal(t, 2, len(resp.Rule.Conditions))
+			assert.Equal(t, "ChefOrgs", resp.Rule.Conditions[1].Type)
+			assert.Equal(t, []string{"org1", "org2"}, resp.Rule.Conditions[1].Values)
+
+			_, err = cl.GetProjectRules(ctx, &api.GetProjectRulesReq{ProjectId: "foo-project"})
+			grpctest.AssertCode(t, codes.NotFound, err)
+			assert.Equal(t, numberOfPublishes, eventServiceClient.PublishedEvents)
+		}},
+	}
+
+	rand.Shuffle(len(cases), func(i, j int) {
+		cases[i], cases[j] = cases[j], cases[i]
+	})
+
+	for _, test := range cases {
+		t.Run(test.desc, test.f)
+	}
+}
+
+func TestUpdateRule(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		desc string
+		f    func(*testing.T)
+	}{
+		{"if the rule does not exist, returns 'not found'", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			resp, err := cl.UpdateRule(ctx, &api.UpdateRuleReq{
+				Id:         "foo-rule",
+				ProjectId:  "foo-project",
+				Name:       "my rule",
+				Conditions: []*api.Condition{{Type: "ChefServers", Values: []string{"chef.example.com"}}},
+			})
+			grpctest.AssertCode(t, codes.NotFound, err)
+			assert.Nil(t, resp)
+		}},
+		{"if the rule belongs to another project, returns 'not found'", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			addProjectToStore(t, store, "bar-project", "my bar", storage.Custom)
+			createRule(t, cl, "foo-project", "foo-rule")
+
+			resp, err := cl.UpdateRule(ctx, &api.UpdateRuleReq{
+				Id:         "foo-rule",
+				ProjectId:  "bar-project",
+				Name:       "my rule",
+				Conditions: []*api.Condition{{Type: "ChefServers", Values: []string{"chef.example.com"}}},
+			})
+			grpctest.AssertCode(t, codes.NotFound, err)
+			assert.Nil(t, resp)
+		}},
+		{"if a condition has no values, returns 'invalid argument'", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			createRule(t, cl, "foo-project", "foo-rule")
+
+			resp, err := cl.UpdateRule(ctx, &api.UpdateRuleReq{
+				Id:         "foo-rule",
+				ProjectId:  "foo-project",
+				Name:       "my rule",
+				Conditions: []*api.Condition{{Type: "ChefServers"}},
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, resp)
+		}},
+		{"stages changes to an applied rule, leaving the applied rule in effect", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			createRule(t, cl, "foo-project", "foo-rule")
+			_, err := cl.ApplyRulesStart(ctx, &api.ApplyRulesStartReq{})
+			require.NoError(t, err)
+
+			resp, err := cl.UpdateRule(ctx, &api.UpdateRuleReq{
+				Id:         "foo-rule",
+				ProjectId:  "foo-project",
+				Name:       "updated rule",
+				Conditions: []*api.Condition{{Type: "Roles", Values: []string{"webserver"}}},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "updated rule", resp.Rule.Name)
+			assert.Equal(t, "staged", resp.Rule.Status)
+
+			get, err := cl.GetRule(ctx, &api.GetRuleReq{Id: "foo-rule", ProjectId: "foo-project"})
+			require.NoError(t, err)
+			assert.Equal(t, "updated rule", get.Rule.Name)
+			assert.Equal(t, "staged", get.Rule.Status)
+
+			applied, err := cl.GetProjectRules(ctx, &api.GetProjectRulesReq{ProjectId: "foo-project"})
+			require.NoError(t, err)
+			require.Equal(t, 1, len(applied.RulesForProject.Rules))
+			assert.Equal(t, "my rule", applied.RulesForProject.Rules[0].Name)
+			assert.Equal(t, "applied", applied.RulesForProject.Rules[0].Status)
+		}},
+	}
+
+	rand.Shuffle(len(cases), func(i, j int) {
+		cases[i], cases[j] = cases[j], cases[i]
+	})
+
+	for _, test := range cases {
+		t.Run(test.desc, test.f)
+	}
+}
+
+func TestDeleteRule(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		desc string
+		f    func(*testing.T)
+	}{
+		{"if the rule does not exist, returns 'not found'", func(t *testing.T) {
+			cl, _, _ := setupProjects(t)
+			resp, err := cl.DeleteRule(ctx, &api.DeleteRuleReq{Id: "foo-rule", ProjectId: "foo-project"})
+			grpctest.AssertCode(t, codes.NotFound, err)
+			assert.Nil(t, resp)
+		}},
+		{"deletes a rule that has never been applied right away", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			createRule(t, cl, "foo-project", "foo-rule")
+
+			_, err := cl.DeleteRule(ctx, &api.DeleteRuleReq{Id: "foo-rule", ProjectId: "foo-project"})
+			require.NoError(t, err)
+
+			_, err = cl.GetRule(ctx, &api.GetRuleReq{Id: "foo-rule", ProjectId: "foo-project"})
+			grpctest.AssertCode(t, codes.NotFound, err)
+		}},
+		{"stages the deletion of an applied rule", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			createRule(t, cl, "foo-project", "foo-rule")
+			_, err := cl.ApplyRulesStart(ctx, &api.ApplyRulesStartReq{})
+			require.NoError(t, err)
+
+			_, err = cl.DeleteRule(ctx, &api.DeleteRuleReq{Id: "foo-rule", ProjectId: "foo-project"})
+			require.NoError(t, err)
+
+			get, err := cl.GetRule(ctx, &api.GetRuleReq{Id: "foo-rule", ProjectId: "foo-project"})
+			require.NoError(t, err)
+			assert.Equal(t, "staged", get.Rule.Status)
+			assert.True(t, get.Rule.Deleted)
+
+			applied, err := cl.GetProjectRules(ctx, &api.GetProjectRulesReq{ProjectId: "foo-project"})
+			require.NoError(t, err)
+			assert.Equal(t, 1, len(applied.RulesForProject.Rules))
+		}},
+	}
+
+	rand.Shuffle(len(cases), func(i, j int) {
+		cases[i], cases[j] = cases[j], cases[i]
+	})
+
+	for _, test := range cases {
+		t.Run(test.desc, test.f)
+	}
+}
+
+func TestListRulesForProject(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		desc string
+		f    func(*testing.T)
+	}{
+		{"if the project has no rules, returns empty list", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			resp, err := cl.ListRulesForProject(ctx, &api.ListRulesForProjectReq{Id: "foo-project"})
+			require.NoError(t, err)
+			assert.Empty(t, resp.Rules)
+		}},
+		{"returns staged and applied rules of the project only", func(t *testing.T) {
+			cl, store, _ := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			addProjectToStore(t, store, "bar-project", "my bar", storage.Custom)
+			createRule(t, cl, "foo-project", "applied-rule")
+			_, err := cl.ApplyRulesStart(ctx, &api.ApplyRulesStartReq{})
+			require.NoError(t, err)
+			createRule(t, cl, "foo-project", "staged-rule")
+			createRule(t, cl, "bar-project", "other-rule")
+
+			resp, err := cl.ListRulesForProject(ctx, &api.ListRulesForProjectReq{Id: "foo-project"})
+			require.NoError(t, err)
+			statuses := map[string]string{}
+			for _, r := range resp.Rules {
+				statuses[r.Id] = r.Status
+			}
+			assert.Equal(t, map[string]string{
+				"applied-rule": "applied",
+				"staged-rule":  "staged",
+			}, statuses)
+		}},
+	}
+
+	rand.Shuffle(len(cases), func(i, j int) {
+		cases[i], cases[j] = cases[j], cases[i]
+	})
+
+	for _, test := range cases {
+		t.Run(test.desc, test.f)
+	}
+}
+
+func TestApplyRulesStart(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		desc string
+		f    func(*testing.T)
+	}{
+		{"applies staged rules and publishes a project update event", func(t *testing.T) {
+			cl, store, eventServiceClient := setupProjects(t)
+			numberOfPublishes := eventServiceClient.PublishedEvents
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			addProjectToStore(t, store, "bar-project", "my bar", storage.Custom)
+			createRule(t, cl, "foo-project", "foo-rule")
+			createRule(t, cl, "bar-project", "bar-rule")
+
+			_, err := cl.ApplyRulesStart(ctx, &api.ApplyRulesStartReq{})
+			require.NoError(t, err)
+			assert.Equal(t, numberOfPublishes+1, eventServiceClient.PublishedEvents)
+
+			resp, err := cl.ListProjectRules(ctx, &api.ListProjectRulesReq{})
+			require.NoError(t, err)
+			require.Equal(t, 2, len(resp.ProjectRules))
+			require.Equal(t, 1, len(resp.ProjectRules["foo-project"].Rules))
+			assert.Equal(t, "foo-rule", resp.ProjectRules["foo-project"].Rules[0].Id)
+			assert.Equal(t, "applied", resp.ProjectRules["foo-project"].Rules[0].Status)
+			require.Equal(t, 1, len(resp.ProjectRules["bar-project"].Rules))
+			assert.Equal(t, "bar-rule", resp.ProjectRules["bar-project"].Rules[0].Id)
+		}},
+		{"if a project update is running, returns 'failed precondition'", func(t *testing.T) {
+			cl, store, eventServiceClient := setupProjects(t)
+			addProjectToStore(t, store, "foo-project", "my foo", storage.Custom)
+			_, err := cl.ApplyRulesStart(ctx, &api.ApplyRulesStartReq{})
+			require.NoError(t, err)
+			numberOfPublishes := eventServiceClient.PublishedEvents
+			createRule(t, cl, "foo-project", "foo-rule")
+
+			_, err = cl.ApplyRulesStart(ctx, &api.ApplyRulesStartReq{})
+			grpctest.AssertCode(t, codes.FailedPrecondition, err)
+			assert.Equal(t, numberOfPublishes, eventServiceClient.PublishedEvents)
+
+			get, err := cl.GetRule(ctx, &api.GetRuleReq{Id: "foo-rule", ProjectId: "foo-project"})
+			require.NoError(t, err)
+			assert.Equal(t, "staged", get.Rule.Status)
+		}},
+	}
+
+	rand.Shuffle(len(cases), func(i, j int) {
+		cases[i], cases[j] = cases[j], cases[i]
+	})
+
+	for _, test := range cases {
+		t.Run(test.desc, test.f)
+	}
+}
+
 func addProjectToStore(t *testing.T, store *cache.Cache, id, name string, projType storage.Type) api.Project {
 	t.Helper()
 
@@ -348,6 +696,18 @@ func addProjectToStore(t *testing.T, store *cache.Cache, id, name string, projTy
 	}
 }
 
+func createRule(t *testing.T, cl api.ProjectsClient, projectID, id string) {
+	t.Helper()
+
+	_, err := cl.CreateRule(context.Background(), &api.CreateRuleReq{
+		Id:         id,
+		ProjectId:  projectID,
+		Name:       "my rule",
+		Conditions: []*api.Condition{{Type: "ChefServers", Values: []string{"chef.example.com"}}},
+	})
+	require.NoError(t, err)
+}
+
 func setupProjects(t *testing.T) (api.ProjectsClient, *cache.Cache, *mockEventServiceClient) {
 	t.Helper()
 	ctx := context.Background()
@@ -358,8 +718,7 @@ func setupProjects(t *testing.T) (api.ProjectsClient, *cache.Cache, *mockEventSe
 
 	mem_v2 := memstore_v2.New()
 	eventServiceClient := &mockEventServiceClient{}
-	projectsSrv, err := v2.NewProjectsServer(ctx, l, mem_v2, &testProjectRulesRetriever{},
-		eventServiceClient)
+	projectsSrv, err := v2.NewProjectsServer(ctx, l, mem_v2, eventServiceClient)
 	require.NoError(t, err)
 
 	serviceCerts := helpers.LoadDevCerts(t, "authz-service")
@@ -382,19 +741,6 @@ func setupProjects(t *testing.T) (api.ProjectsClient, *cache.Cache, *mockEventSe
 	return api.NewProjectsClient(conn), mem_v2.ProjectsCache(), eventServiceClient
 }
 
-// TODO More testing
-type testProjectRulesRetriever struct{}
-
-func (t *testProjectRulesRetriever) RulesForProject(
-	context.Context, string) ([]engine.Rule, error) {
-	return []engine.Rule{}, nil
-}
-
-func (t *testProjectRulesRetriever) ListProjectMappings(
-	context.Context) (map[string][]engine.Rule, error) {
-	return make(map[string][]engine.Rule, 0), nil
-}
-
 type mockEventServiceClient struct {
 	PublishedEvents       int
 	LastestPublishedEvent *automate_event.EventMsg
@@ -414,6 +760,42 @@ func (t *mockEventServiceClient) Subscribe(ctx context.Context,
 	return &automate_event.SubscribeResponse{}, nil
 }
 
+func (t *mockEventServiceClient) Unsubscribe(ctx context.Context,
+	in *automate_event.UnsubscribeRequest,
+	opts ...grpc.CallOption) (*automate_event.UnsubscribeResponse, error) {
+	return &automate_event.UnsubscribeResponse{}, nil
+}
+
+func (t *mockEventServiceClient) ListSubscriptions(ctx context.Context,
+	in *automate_event.ListSubscriptionsRequest,
+	opts ...grpc.CallOption) (*automate_event.ListSubscriptionsResponse, error) {
+	return &automate_event.ListSubscriptionsResponse{}, nil
+}
+
+func (t *mockEventServiceClient) ListDeadLetters(ctx context.Context,
+	in *automate_event.ListDeadLettersRequest,
+	opts ...grpc.CallOption) (*automate_event.ListDeadLettersResponse, error) {
+	return &automate_event.ListDeadLettersResponse{}, nil
+}
+
+func (t *mockEventServiceClient) GetDeadLetter(ctx context.Context,
+	in *automate_event.GetDeadLetterRequest,
+	opts ...grpc.CallOption) (*automate_event.DeadLetter, error) {
+	return &automate_event.DeadLetter{}, nil
+}
+
+func (t *mockEventServiceClient) ReplayDeadLetter(ctx context.Context,
+	in *automate_event.ReplayDeadLetterRequest,
+	opts ...grpc.CallOption) (*automate_event.ReplayDeadLetterResponse, error) {
+	return &automate_event.ReplayDeadLetterResponse{}, nil
+}
+
+func (t *mockEventServiceClient) ListEvents(ctx context.Context,
+	in *automate_event.ListEventsRequest,
+	opts ...grpc.CallOption) (*automate_event.ListEventsResponse, error) {
+	return &automate_event.ListEventsResponse{}, nil
+}
+
 func (t *mockEventServiceClient) Start(ctx context.Context,
 	in *automate_event.StartRequest,
 	opts ...grpc.CallOption) (*automate_event.StartResponse, error) {
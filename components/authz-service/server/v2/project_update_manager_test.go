@@ -281,6 +281,42 @@ func (m *MockEventServiceClient) Subscribe(ctx context.Context,
 	return &automate_event.SubscribeResponse{}, nil
 }
 
+func (m *MockEventServiceClient) Unsubscribe(ctx context.Context,
+	in *automate_event.UnsubscribeRequest,
+	opts ...grpc.CallOption) (*automate_event.UnsubscribeResponse, error) {
+	return &automate_event.UnsubscribeResponse{}, nil
+}
+
+func (m *MockEventServiceClient) ListSubscriptions(ctx context.Context,
+	in *automate_event.ListSubscriptionsRequest,
+	opts ...grpc.CallOption) (*automate_event.ListSubscriptionsResponse, error) {
+	return &automate_event.ListSubscriptionsResponse{}, nil
+}
+
+func (m *MockEventServiceClient) ListDeadLetters(ctx context.Context,
+	in *automate_event.ListDeadLettersRequest,
+	opts ...grpc.CallOption) (*automate_event.ListDeadLettersResponse, error) {
+	return &automate_event.ListDeadLettersResponse{}, nil
+}
+
+func (m *MockEventServiceClient) GetDeadLetter(ctx context.Context,
+	in *automate_event.GetDeadLetterRequest,
+	opts ...grpc.CallOption) (*automate_event.DeadLetter, error) {
+	return &automate_event.DeadLetter{}, nil
+}
+
+func (m *MockEventServiceClient) ReplayDeadLetter(ctx context.Context,
+	in *automate_event.ReplayDeadLetterRequest,
+	opts ...grpc.CallOption) (*automate_event.ReplayDeadLetterResponse, error) {
+	return &automate_event.ReplayDeadLetterResponse{}, nil
+}
+
+func (m *MockEventServiceClient) ListEvents(ctx context.Context,
+	in *automate_event.ListEventsRequest,
+	opts ...grpc.CallOption) (*automate_event.ListEventsResponse, error) {
+	return &automate_event.ListEventsResponse{}, nil
+}
+
 func (m *MockEventServiceClient) Start(ctx context.Context,
 	in *automate_event.StartRequest,
 	opts ...grpc.CallOption) (*automate_event.StartResponse, error) {
@@ -0,0 +1,399 @@
+package v2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	api "github.com/chef/automate/api/interservice/authz/v2"
+	storage_errors "github.com/chef/automate/components/authz-service/storage"
+	storage "github.com/chef/automate/components/authz-service/storage/v2"
+	"github.com/chef/automate/lib/stringutils"
+)
+
+const (
+	projectKind = "project"
+	roleKind    = "role"
+	policyKind  = "policy"
+)
+
+// iamState holds the objects dealt with by ExportState and ImportState, keyed
+// by ID.
+type iamState struct {
+	policies map[string]*storage.Policy
+	roles    map[string]*storage.Role
+	projects map[string]*storage.Project
+}
+
+func newIAMState() *iamState {
+	return &iamState{
+		policies: map[string]*storage.Policy{},
+		roles:    map[string]*storage.Role{},
+		projects: map[string]*storage.Project{},
+	}
+}
+
+// stateObject is what the objects of an iamState are compared by: their type,
+// and their API representation with everything that doesn't matter for
+// comparison (type, order of sets) normalized.
+type stateObject struct {
+	typ storage.Type
+	msg proto.Message
+}
+
+// ExportState returns all IAM v2 policies, roles, and projects, regardless of
+// the projects filter of the request. The hidden system projects are left
+// out.
+func (s *policyServer) ExportState(ctx context.Context,
+	_ *api.ExportStateReq) (*api.ExportStateResp, error) {
+	st, err := s.currentState(withoutProjectsFilter(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error retrieving IAM state: %s", err.Error())
+	}
+
+	resp := api.ExportStateResp{}
+	for _, id := range sortedIDs(st.objects(policyKind)) {
+		pol, err := policyFromInternal(st.policies[id])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error converting policy %q: %s", id, err.Error())
+		}
+		resp.Policies = append(resp.Policies, pol)
+	}
+	for _, id := range sortedIDs(st.objects(roleKind)) {
+		role, err := roleFromInternal(st.roles[id])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error converting role %q: %s", id, err.Error())
+		}
+		resp.Roles = append(resp.Roles, role)
+	}
+	hidden := storage.DefaultProjectIDs()
+	for _, id := range sortedIDs(st.objects(projectKind)) {
+		if stringutils.SliceContains(hidden, id) {
+			continue
+		}
+		project, err := fromStorageProject(st.projects[id])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error converting project %q: %s", id, err.Error())
+		}
+		resp.Projects = append(resp.Projects, project)
+	}
+	return &resp, nil
+}
+
+// ImportState makes the stored policies, roles, and projects match those of
+// the request: missing ones are created, differing ones updated, and custom
+// ones not part of the request are deleted. Chef-managed objects are never
+// changed; changes to them are reported as skipped. Importing the same state
+// twice results in no changes the second time.
+//
+// The changes are not applied atomically: if one of them fails, those that
+// came before it remain in effect.
+func (s *policyServer) ImportState(ctx context.Context,
+	req *api.ImportStateReq) (*api.ImportStateResp, error) {
+	ctx = withoutProjectsFilter(ctx)
+
+	desired, err := stateFromAPI(req.Policies, req.Roles, req.Projects)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error parsing IAM state: %s", err.Error())
+	}
+	current, err := s.currentState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error retrieving IAM state: %s", err.Error())
+	}
+
+	changes := planStateChanges(current, desired)
+	if req.DryRun {
+		return &api.ImportStateResp{Changes: changes}, nil
+	}
+
+	for _, c := range changes {
+		if c.Operation == api.StateChange_SKIP {
+			continue
+		}
+		err := s.applyStateChange(ctx, c, desired)
+		switch err {
+		case nil: // continue
+		case storage_errors.ErrConflict:
+			return nil, status.Errorf(codes.AlreadyExists,
+				"cannot %s %s %q: conflicts with an existing %s", operationName(c), c.Kind, c.Id, c.Kind)
+		case storage_errors.ErrForeignKey:
+			return nil, status.Errorf(codes.InvalidArgument,
+				"cannot %s %s %q: refers to projects that don't exist", operationName(c), c.Kind, c.Id)
+		default:
+			return nil, status.Errorf(codes.Internal,
+				"error applying change to %s %q: %s", c.Kind, c.Id, err.Error())
+		}
+	}
+	return &api.ImportStateResp{Changes: changes}, nil
+}
+
+// currentState returns all stored policies, roles, and projects, including
+// the hidden system projects.
+func (s *policyServer) currentState(ctx context.Context) (*iamState, error) {
+	st := newIAMState()
+	pols, err := s.store.ListPolicies(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list policies")
+	}
+	for _, pol := range pols {
+		st.policies[pol.ID] = pol
+	}
+	roles, err := s.store.ListRoles(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list roles")
+	}
+	for _, role := range roles {
+		st.roles[role.ID] = role
+	}
+	projects, err := s.store.ListProjects(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list projects")
+	}
+	for _, project := range projects {
+		st.projects[project.ID] = project
+	}
+	return st, nil
+}
+
+// stateFromAPI validates the passed objects the same way the requests
+// creating them would be validated, and converts them for storage.
+func stateFromAPI(policies []*api.Policy, roles []*api.Role, projects []*api.Project) (*iamState, error) {
+	st := newIAMState()
+	for _, p := range projects {
+		if _, ok := st.projects[p.Id]; ok {
+			return nil, errors.Errorf("duplicate project %q", p.Id)
+		}
+		if err := (&api.CreateProjectReq{Id: p.Id, Name: p.Name}).Validate(); err != nil {
+			return nil, errors.Wrapf(err, "project %q", p.Id)
+		}
+		project, err := storage.NewProject(p.Id, p.Name, typeFromAPI(p.Type))
+		if err != nil {
+			return nil, errors.Wrapf(err, "project %q", p.Id)
+		}
+		st.projects[p.Id] = &project
+	}
+
+	for _, r := range roles {
+		if _, ok := st.roles[r.Id]; ok {
+			return nil, errors.Errorf("duplicate role %q", r.Id)
+		}
+		if err := (&api.CreateRoleReq{
+			Id:       r.Id,
+			Name:     r.Name,
+			Actions:  r.Actions,
+			Projects: r.Projects,
+		}).Validate(); err != nil {
+			return nil, errors.Wrapf(err, "role %q", r.Id)
+		}
+		role, err := storage.NewRole(r.Id, r.Name, typeFromAPI(r.Type), r.Actions, r.Projects)
+		if err != nil {
+			return nil, errors.Wrapf(err, "role %q", r.Id)
+		}
+		st.roles[r.Id] = role
+	}
+
+	for _, p := range policies {
+		if _, ok := st.policies[p.Id]; ok {
+			return nil, errors.Errorf("duplicate policy %q", p.Id)
+		}
+		if err := (&api.CreatePolicyReq{
+			Id:         p.Id,
+			Name:       p.Name,
+			Members:    p.Members,
+			Statements: p.Statements,
+			Projects:   p.Projects,
+		}).Validate(); err != nil {
+			return nil, errors.Wrapf(err, "policy %q", p.Id)
+		}
+		pol, err := policyFromAPI(p.Id, p.Name, typeFromAPI(p.Type), p.Members, p.Statements, p.Projects)
+		if err != nil {
+			return nil, errors.Wrapf(err, "policy %q", p.Id)
+		}
+		st.policies[p.Id] = &pol
+	}
+	return st, nil
+}
+
+// planStateChanges returns the changes needed to get from the current to the
+// desired state, in the order they need to be applied in: roles and policies
+// refer to projects, and policies refer to roles, so projects are created
+// first and deleted last.
+func planStateChanges(current, desired *iamState) []*api.StateChange {
+	changes := []*api.StateChange{}
+	for _, kind := range []string{projectKind, roleKind, policyKind} {
+		cur, des := current.objects(kind), desired.objects(kind)
+		for _, id := range sortedIDs(des) {
+			if c := upsertChange(kind, id, cur, des[id]); c != nil {
+				changes = append(changes, c)
+			}
+		}
+	}
+	for _, kind := range []string{policyKind, roleKind, projectKind} {
+		cur, des := current.objects(kind), desired.objects(kind)
+		for _, id := range sortedIDs(cur) {
+			if _, ok := des[id]; !ok && cur[id].typ == storage.Custom {
+				changes = append(changes, &api.StateChange{
+					Operation: api.StateChange_DELETE,
+					Kind:      kind,
+					Id:        id,
+				})
+			}
+		}
+	}
+	return changes
+}
+
+// upsertChange returns the change needed to get an object to its desired
+// version, or nil if it is up-to-date.
+func upsertChange(kind, id string, current map[string]stateObject, desired stateObject) *api.StateChange {
+	cur, exists := current[id]
+	c := api.StateChange{Kind: kind, Id: id}
+	switch {
+	case !exists && desired.typ == storage.ChefManaged:
+		c.Operation = api.StateChange_SKIP
+		c.Reason = "chef-managed objects cannot be created"
+	case !exists:
+		c.Operation = api.StateChange_CREATE
+	case proto.Equal(cur.msg, desired.msg):
+		return nil
+	case cur.typ == storage.ChefManaged:
+		c.Operation = api.StateChange_SKIP
+		c.Reason = "chef-managed objects cannot be changed"
+	default:
+		c.Operation = api.StateChange_UPDATE
+	}
+	return &c
+}
+
+// applyStateChange applies a change other than a skip, taking the objects to create or update
+// from the desired state; those are always custom objects.
+func (s *policyServer) applyStateChange(ctx context.Context, c *api.StateChange, desired *iamState) error {
+	if c.Operation == api.StateChange_DELETE {
+		switch c.Kind {
+		case projectKind:
+			return s.store.DeleteProject(ctx, c.Id)
+		case roleKind:
+			return s.store.DeleteRole(ctx, c.Id)
+		case policyKind:
+			return s.store.DeletePolicy(ctx, c.Id)
+		}
+		return nil
+	}
+
+	var err error
+	create := c.Operation == api.StateChange_CREATE
+	switch c.Kind {
+	case projectKind:
+		project := *desired.projects[c.Id]
+		project.Type = storage.Custom
+		if create {
+			_, err = s.store.CreateProject(ctx, &project)
+		} else {
+			_, err = s.store.UpdateProject(ctx, &project)
+		}
+	case roleKind:
+		role := *desired.roles[c.Id]
+		role.Type = storage.Custom
+		if create {
+			_, err = s.store.CreateRole(ctx, &role)
+		} else {
+			_, err = s.store.UpdateRole(ctx, &role)
+		}
+	case policyKind:
+		pol := *desired.policies[c.Id]
+		pol.Type = storage.Custom
+		if create {
+			_, err = s.store.CreatePolicy(ctx, &pol)
+		} else {
+			_, err = s.store.UpdatePolicy(ctx, &pol)
+		}
+	}
+	return err
+}
+
+// objects returns the comparable representations of all objects of a kind.
+func (st *iamState) objects(kind string) map[string]stateObject {
+	objs := map[string]stateObject{}
+	switch kind {
+	case projectKind:
+		for id, project := range st.projects {
+			// the conversion never fails
+			msg, _ := fromStorageProject(project)
+			msg.Type = api.Type_CUSTOM
+			objs[id] = stateObject{typ: project.Type, msg: msg}
+		}
+	case roleKind:
+		for id, role := range st.roles {
+			msg, _ := roleFromInternal(role)
+			msg.Type = api.Type_CUSTOM
+			msg.Projects = sortedCopy(msg.Projects)
+			objs[id] = stateObject{typ: role.Type, msg: msg}
+		}
+	case policyKind:
+		for id, pol := range st.policies {
+			msg, _ := policyFromInternal(pol)
+			msg.Type = api.Type_CUSTOM
+			msg.Members = sortedCopy(msg.Members)
+			msg.Projects = sortedCopy(msg.Projects)
+			for _, statement := range msg.Statements {
+				statement.Projects = sortedCopy(statement.Projects)
+			}
+			sort.Slice(msg.Statements, func(i, j int) bool {
+				return proto.CompactTextString(msg.Statements[i]) < proto.CompactTextString(msg.Statements[j])
+			})
+			objs[id] = stateObject{typ: pol.Type, msg: msg}
+		}
+	}
+	return objs
+}
+
+// withoutProjectsFilter removes the projects filter from the request context,
+// so that the storage layer returns all objects.
+func withoutProjectsFilter(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	md = md.Copy()
+	delete(md, "projects")
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func typeFromAPI(t api.Type) storage.Type {
+	if t == api.Type_CHEF_MANAGED {
+		return storage.ChefManaged
+	}
+	return storage.Custom
+}
+
+func operationName(c *api.StateChange) string {
+	switch c.Operation {
+	case api.StateChange_CREATE:
+		return "create"
+	case api.StateChange_UPDATE:
+		return "update"
+	default:
+		return "delete"
+	}
+}
+
+func sortedIDs(objs map[string]stateObject) []string {
+	ids := make([]string, 0, len(objs))
+	for id := range objs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func sortedCopy(ss []string) []string {
+	sorted := make([]string, len(ss))
+	copy(sorted, ss)
+	sort.Strings(sorted)
+	return sorted
+}
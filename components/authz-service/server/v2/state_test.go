@@ -0,0 +1,302 @@
+package v2_test
+
+import (
+	"context"
+	"testing"
+
+	cache "github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	api_v2 "github.com/chef/automate/api/interservice/authz/v2"
+	constants "github.com/chef/automate/components/authz-service/constants/v2"
+	storage "github.com/chef/automate/components/authz-service/storage/v2"
+	"github.com/chef/automate/lib/grpc/grpctest"
+)
+
+func TestExportState(t *testing.T) {
+	ctx := context.Background()
+	ts := setupV2(t, nil, nil, nil, nil)
+	cl := ts.policy
+
+	t.Run("exports policies, roles, and projects, leaving out hidden system projects", func(t *testing.T) {
+		seedState(t, ts)
+		ts.projectCache.Add(constants.AllProjectsID, &storage.Project{
+			ID:       constants.AllProjectsID,
+			Name:     "All Projects",
+			Type:     storage.ChefManaged,
+			Projects: []string{constants.AllProjectsID},
+		}, cache.NoExpiration)
+
+		resp, err := cl.ExportState(ctx, &api_v2.ExportStateReq{})
+		require.NoError(t, err)
+
+		require.Equal(t, 2, len(resp.Policies))
+		assert.Equal(t, "admin-policy", resp.Policies[0].Id)
+		assert.Equal(t, api_v2.Type_CHEF_MANAGED, resp.Policies[0].Type)
+		assert.Equal(t, "my-policy", resp.Policies[1].Id)
+		assert.Equal(t, api_v2.Type_CUSTOM, resp.Policies[1].Type)
+		assert.Equal(t, []string{"team:local:my-team"}, resp.Policies[1].Members)
+		require.Equal(t, 1, len(resp.Roles))
+		assert.Equal(t, "my-role", resp.Roles[0].Id)
+		require.Equal(t, 1, len(resp.Projects))
+		assert.Equal(t, "my-project", resp.Projects[0].Id)
+	})
+}
+
+func TestImportState(t *testing.T) {
+	ctx := context.Background()
+	ts := setupV2(t, nil, nil, nil, nil)
+	cl := ts.policy
+
+	cases := map[string]func(*testing.T){
+		"importing the exported state changes nothing": func(t *testing.T) {
+			seedState(t, ts)
+			exported, err := cl.ExportState(ctx, &api_v2.ExportStateReq{})
+			require.NoError(t, err)
+
+			resp, err := cl.ImportState(ctx, &api_v2.ImportStateReq{
+				Policies: exported.Policies,
+				Roles:    exported.Roles,
+				Projects: exported.Projects,
+			})
+			require.NoError(t, err)
+			assert.Empty(t, resp.Changes)
+		},
+		"a dry run reports the changes without applying them": func(t *testing.T) {
+			seedState(t, ts)
+			desired := desiredState()
+
+			resp, err := cl.ImportState(ctx, &api_v2.ImportStateReq{
+				Policies: desired.Policies,
+				Roles:    desired.Roles,
+				Projects: desired.Projects,
+				DryRun:   true,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []string{
+				"CREATE project other-project",
+				"UPDATE role my-role",
+				"CREATE policy other-policy",
+				"DELETE policy my-policy",
+			}, changeStrings(resp.Changes))
+
+			_, found := ts.policyCache.Get("my-policy")
+			assert.True(t, found)
+			_, found = ts.policyCache.Get("other-policy")
+			assert.False(t, found)
+			_, found = ts.projectCache.Get("other-project")
+			assert.False(t, found)
+		},
+		"applies the changes, after which importing the same state changes nothing": func(t *testing.T) {
+			seedState(t, ts)
+			desired := desiredState()
+			req := api_v2.ImportStateReq{
+				Policies: desired.Policies,
+				Roles:    desired.Roles,
+				Projects: desired.Projects,
+			}
+
+			resp, err := cl.ImportState(ctx, &req)
+			require.NoError(t, err)
+			assert.Equal(t, 4, len(resp.Changes))
+
+			_, found := ts.policyCache.Get("my-policy")
+			assert.False(t, found)
+			pol := getPolicyFromStore(t, ts.policyCache, "other-policy")
+			assert.Equal(t, storage.Custom, pol.Type)
+			role := getRoleFromStore(t, ts.roleCache, "my-role")
+			assert.Equal(t, []string{"iam:users:get", "iam:users:list"}, role.Actions)
+			_, found = ts.projectCache.Get("other-project")
+			assert.True(t, found)
+
+			resp, err = cl.ImportState(ctx, &req)
+			require.NoError(t, err)
+			assert.Empty(t, resp.Changes)
+		},
+		"member and statement order doesn't matter": func(t *testing.T) {
+			seedState(t, ts)
+			exported, err := cl.ExportState(ctx, &api_v2.ExportStateReq{})
+			require.NoError(t, err)
+			pol := exported.Policies[1]
+			require.Equal(t, "my-policy", pol.Id)
+			pol.Members = []string{"user:local:alice", "team:local:my-team"}
+			_, err = cl.UpdatePolicy(ctx, &api_v2.UpdatePolicyReq{
+				Id:         pol.Id,
+				Name:       pol.Name,
+				Members:    pol.Members,
+				Statements: pol.Statements,
+				Projects:   pol.Projects,
+			})
+			require.NoError(t, err)
+
+			pol.Members = []string{"team:local:my-team", "user:local:alice"}
+			pol.Statements[0], pol.Statements[1] = pol.Statements[1], pol.Statements[0]
+			resp, err := cl.ImportState(ctx, &api_v2.ImportStateReq{
+				Policies: exported.Policies,
+				Roles:    exported.Roles,
+				Projects: exported.Projects,
+			})
+			require.NoError(t, err)
+			assert.Empty(t, resp.Changes)
+		},
+		"chef-managed objects are never changed, created, or deleted": func(t *testing.T) {
+			seedState(t, ts)
+			exported, err := cl.ExportState(ctx, &api_v2.ExportStateReq{})
+			require.NoError(t, err)
+			admin := exported.Policies[0]
+			require.Equal(t, "admin-policy", admin.Id)
+			admin.Members = append(admin.Members, "user:local:mallory")
+			newManaged := &api_v2.Role{
+				Id:      "new-managed-role",
+				Name:    "New Managed Role",
+				Type:    api_v2.Type_CHEF_MANAGED,
+				Actions: []string{"*"},
+			}
+
+			resp, err := cl.ImportState(ctx, &api_v2.ImportStateReq{
+				Policies: exported.Policies,
+				Roles:    append(exported.Roles, newManaged),
+				Projects: exported.Projects,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []string{
+				"SKIP role new-managed-role",
+				"SKIP policy admin-policy",
+			}, changeStrings(resp.Changes))
+			assert.NotEmpty(t, resp.Changes[0].Reason)
+
+			stored := getPolicyFromStore(t, ts.policyCache, "admin-policy")
+			assert.Equal(t, 1, len(stored.Members))
+			_, found := ts.roleCache.Get("new-managed-role")
+			assert.False(t, found)
+
+			resp, err = cl.ImportState(ctx, &api_v2.ImportStateReq{})
+			require.NoError(t, err)
+			_, found = ts.policyCache.Get("admin-policy")
+			assert.True(t, found)
+		},
+		"invalid objects are rejected without applying any changes": func(t *testing.T) {
+			seedState(t, ts)
+			desired := desiredState()
+			desired.Policies[0].Members = []string{"not-a-member"}
+
+			resp, err := cl.ImportState(ctx, &api_v2.ImportStateReq{
+				Policies: desired.Policies,
+				Roles:    desired.Roles,
+				Projects: desired.Projects,
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, resp)
+			_, found := ts.policyCache.Get("my-policy")
+			assert.True(t, found)
+		},
+		"duplicate objects are rejected": func(t *testing.T) {
+			desired := desiredState()
+
+			resp, err := cl.ImportState(ctx, &api_v2.ImportStateReq{
+				Projects: append(desired.Projects, desired.Projects[0]),
+			})
+			grpctest.AssertCode(t, codes.InvalidArgument, err)
+			assert.Nil(t, resp)
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, test)
+		ts.policyCache.Flush()
+		ts.roleCache.Flush()
+		ts.projectCache.Flush()
+	}
+}
+
+// seedState stores a chef-managed policy, and a custom policy, role, and
+// project.
+func seedState(t *testing.T, ts testSetup) {
+	t.Helper()
+	ctx := context.Background()
+
+	admin, err := storage.NewPolicy("admin-policy", "Admin", storage.ChefManaged,
+		[]storage.Member{genMember(t, "team:local:admins")},
+		[]storage.Statement{{
+			ID:        genUUID(t),
+			Effect:    storage.Allow,
+			Actions:   []string{"*"},
+			Resources: []string{"*"},
+			Projects:  []string{constants.AllProjectsID},
+		}}, []string{})
+	require.NoError(t, err)
+	ts.policyCache.Add(admin.ID, &admin, cache.NoExpiration)
+
+	_, err = ts.projects.CreateProject(ctx, &api_v2.CreateProjectReq{Id: "my-project", Name: "My Project"})
+	require.NoError(t, err)
+	_, err = ts.policy.CreateRole(ctx, &api_v2.CreateRoleReq{
+		Id:      "my-role",
+		Name:    "My Role",
+		Actions: []string{"iam:users:get"},
+	})
+	require.NoError(t, err)
+	_, err = ts.policy.CreatePolicy(ctx, &api_v2.CreatePolicyReq{
+		Id:      "my-policy",
+		Name:    "My Policy",
+		Members: []string{"team:local:my-team"},
+		Statements: []*api_v2.Statement{
+			{
+				Effect:    api_v2.Statement_ALLOW,
+				Role:      "my-role",
+				Resources: []string{"*"},
+				Projects:  []string{"my-project"},
+			},
+			{
+				Effect:    api_v2.Statement_DENY,
+				Actions:   []string{"iam:users:delete"},
+				Resources: []string{"*"},
+				Projects:  []string{constants.AllProjectsExternalID},
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+// desiredState differs from the state stored by seedState: my-role has
+// another action, other-project and other-policy are new, and my-policy is
+// gone. The chef-managed admin-policy is left out.
+func desiredState() *api_v2.ExportStateResp {
+	return &api_v2.ExportStateResp{
+		Projects: []*api_v2.Project{
+			{Id: "my-project", Name: "My Project", Type: api_v2.Type_CUSTOM},
+			{Id: "other-project", Name: "Other Project", Type: api_v2.Type_CUSTOM},
+		},
+		Roles: []*api_v2.Role{
+			{
+				Id:      "my-role",
+				Name:    "My Role",
+				Type:    api_v2.Type_CUSTOM,
+				Actions: []string{"iam:users:get", "iam:users:list"},
+			},
+		},
+		Policies: []*api_v2.Policy{
+			{
+				Id:      "other-policy",
+				Name:    "Other Policy",
+				Type:    api_v2.Type_CUSTOM,
+				Members: []string{"user:local:alice"},
+				Statements: []*api_v2.Statement{{
+					Effect:    api_v2.Statement_ALLOW,
+					Role:      "my-role",
+					Resources: []string{"*"},
+					Projects:  []string{"other-project"},
+				}},
+			},
+		},
+	}
+}
+
+func changeStrings(changes []*api_v2.StateChange) []string {
+	strs := make([]string, len(changes))
+	for i, c := range changes {
+		strs[i] = c.Operation.String() + " " + c.Kind + " " + c.Id
+	}
+	return strs
+}
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE iam_authz_decisions (
+  db_id BIGSERIAL PRIMARY KEY,
+  time TIMESTAMPTZ NOT NULL,
+  method TEXT NOT NULL,
+  subjects TEXT[] NOT NULL,
+  action TEXT NOT NULL DEFAULT '',
+  resource TEXT NOT NULL DEFAULT '',
+  projects TEXT[] NOT NULL DEFAULT '{}',
+  allowed BOOLEAN NOT NULL,
+  authorized_projects TEXT[] NOT NULL DEFAULT '{}',
+  matches JSONB NOT NULL DEFAULT '[]'
+);
+
+CREATE INDEX iam_authz_decisions_time_idx ON iam_authz_decisions (time);
+CREATE INDEX iam_authz_decisions_subjects_idx ON iam_authz_decisions USING GIN (subjects);
+
+COMMIT;
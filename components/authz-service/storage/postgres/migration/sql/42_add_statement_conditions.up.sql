@@ -0,0 +1,142 @@
+BEGIN;
+
+ALTER TABLE iam_statements ADD COLUMN conditions JSONB;
+
+DROP FUNCTION IF EXISTS insert_iam_statement_into_policy(_policy_id TEXT, _statement_id UUID, _statement_effect iam_effect, _statement_actions TEXT[],
+  _statement_resources TEXT[], _statement_role TEXT, _statement_projects TEXT[]);
+
+CREATE OR REPLACE FUNCTION
+  insert_iam_statement_into_policy(_policy_id TEXT, _statement_id UUID, _statement_effect iam_effect, _statement_actions TEXT[],
+  _statement_resources TEXT[], _statement_role TEXT, _statement_projects TEXT[], _statement_conditions JSONB)
+  RETURNS void AS $$
+
+    INSERT INTO iam_policy_statements (policy_id, statement_id)
+      VALUES (_policy_id, _statement_id);
+
+    INSERT INTO iam_statements (id, effect, actions, resources, role, conditions)
+      VALUES (_statement_id, _statement_effect, _statement_actions, _statement_resources, _statement_role, _statement_conditions);
+
+    INSERT INTO iam_statement_projects (statement_id, project_id)
+    SELECT _statement_id s_id, p_id
+    FROM UNNEST(_statement_projects) p_id ON CONFLICT DO NOTHING
+
+$$ LANGUAGE sql;
+
+-- update query_policy(ies) so they return each statement's conditions
+CREATE OR REPLACE FUNCTION
+  query_policy(_policy_id TEXT, _projects_filter TEXT[])
+  RETURNS json AS $$
+
+  WITH temp AS (
+    SELECT
+      pol.id,
+      pol.name,
+      pol.type,
+      -- get policy's statements using temporary table
+      ( WITH statement_rows AS (
+          SELECT
+            stmt.id,
+            stmt.effect,
+            stmt.actions,
+            stmt.resources,
+            stmt.role,
+            stmt.conditions,
+            -- get each statement's projects by cross-referencing iam_policy_statements and iam_statement_projects
+            (
+              SELECT COALESCE(Json_agg(proj.id) FILTER (WHERE proj.id IS NOT NULL), '[]')
+              FROM iam_statement_projects AS stmt_projs
+              LEFT OUTER JOIN iam_projects AS proj ON stmt_projs.statement_id = stmt.id
+              WHERE stmt_projs.project_id = proj.id
+            ) AS projects
+          FROM iam_policy_statements AS pol_stmts
+          LEFT OUTER JOIN iam_statements AS stmt ON pol_stmts.statement_id = stmt.id
+          WHERE pol_stmts.policy_id = pol.id
+          GROUP BY stmt.id
+        )
+        SELECT array_agg(statement_rows) FILTER (WHERE statement_rows.id IS NOT NULL)
+        FROM statement_rows
+      ) AS statements,
+      -- get policy members
+      ( SELECT array_agg(mem) FILTER (WHERE mem.id IS NOT NULL)
+        FROM iam_policy_members AS pol_mems
+        LEFT OUTER JOIN iam_members AS mem ON pol_mems.member_id = mem.id
+        WHERE pol_mems.policy_id = pol.id
+      ) AS members,
+      -- get projects
+      ( SELECT array_agg(proj.id) FILTER (WHERE proj.id IS NOT NULL)
+        FROM iam_policy_projects AS pol_projs
+        LEFT OUTER JOIN iam_projects AS proj ON pol_projs.project_id = proj.id
+        WHERE pol_projs.policy_id = pol.id
+      ) AS projects
+    FROM iam_policies as pol
+    WHERE pol.id = _policy_id
+    GROUP BY pol.id
+  )
+  SELECT json_build_object(
+      'id', temp.id,
+      'name', temp.name,
+      'type', temp.type,
+      'statements', COALESCE(temp.statements, '{}'),
+      'members', COALESCE(temp.members, '{}'),
+      'projects', COALESCE(temp.projects, '{}')
+    ) AS policy FROM temp
+  WHERE projects_match(temp.projects::TEXT[],  _projects_filter);
+
+$$ LANGUAGE sql;
+
+CREATE OR REPLACE FUNCTION
+  query_policies(_projects_filter TEXT[])
+  RETURNS setof json AS $$
+
+  WITH temp AS (
+    SELECT
+      pol.id,
+      pol.name,
+      pol.type,
+      ( WITH statement_rows AS (
+          SELECT
+            stmt.id,
+            stmt.effect,
+            stmt.actions,
+            stmt.resources,
+            stmt.role,
+            stmt.conditions,
+            ( SELECT COALESCE(json_agg(proj.id) FILTER (WHERE proj.id IS NOT NULL), '[]')
+              FROM iam_statement_projects AS stmt_projs
+              LEFT OUTER JOIN iam_projects AS proj ON stmt_projs.statement_id = stmt.id
+              WHERE stmt_projs.project_id = proj.id
+            ) AS projects
+          FROM iam_policy_statements AS pol_stmts
+          LEFT OUTER JOIN iam_statements AS stmt ON pol_stmts.statement_id = stmt.id
+          WHERE pol_stmts.policy_id = pol.id
+          GROUP BY stmt.id
+        )
+        SELECT array_agg(statement_rows) FILTER (WHERE statement_rows.id IS NOT NULL)
+        FROM statement_rows
+      ) AS statements,
+      ( SELECT array_agg(mem) FILTER (WHERE mem.id IS NOT NULL)
+        FROM iam_policy_members AS pol_mems
+        LEFT OUTER JOIN iam_members AS mem ON pol_mems.member_id = mem.id
+        WHERE pol_mems.policy_id = pol.id
+      ) AS members,
+      ( SELECT array_agg(proj.id) FILTER (WHERE proj.id IS NOT NULL)
+        FROM iam_policy_projects AS pol_projs
+        LEFT OUTER JOIN iam_projects AS proj ON pol_projs.project_id = proj.id
+        WHERE pol_projs.policy_id = pol.id
+      ) AS projects
+    FROM iam_policies as pol
+    GROUP BY pol.id
+  )
+  SELECT json_build_object(
+      'id', temp.id,
+      'name', temp.name,
+      'type', temp.type,
+      'statements', COALESCE(temp.statements, '{}'),
+      'members', COALESCE(temp.members, '{}'),
+      'projects', COALESCE(temp.projects, '{}')
+    ) AS policy FROM temp
+  WHERE projects_match(temp.projects::TEXT[],  _projects_filter);
+
+$$ LANGUAGE sql;
+
+COMMIT;
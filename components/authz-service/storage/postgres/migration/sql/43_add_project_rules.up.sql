@@ -0,0 +1,25 @@
+BEGIN;
+
+-- rules in effect
+CREATE TABLE iam_project_rules (
+  db_id SERIAL,
+  id TEXT PRIMARY KEY,
+  project_id TEXT NOT NULL REFERENCES iam_projects ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  conditions JSONB NOT NULL
+);
+
+-- changes to rules that take effect once they are applied
+CREATE TABLE iam_staged_project_rules (
+  db_id SERIAL,
+  id TEXT PRIMARY KEY,
+  project_id TEXT NOT NULL REFERENCES iam_projects ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  conditions JSONB NOT NULL,
+  deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX iam_project_rules_project_id_idx ON iam_project_rules (project_id);
+CREATE INDEX iam_staged_project_rules_project_id_idx ON iam_staged_project_rules (project_id);
+
+COMMIT;
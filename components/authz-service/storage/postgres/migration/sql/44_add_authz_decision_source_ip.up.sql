@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE iam_authz_decisions ADD COLUMN source_ip TEXT NOT NULL DEFAULT '';
+
+COMMIT;
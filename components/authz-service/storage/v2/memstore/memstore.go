@@ -14,16 +14,23 @@ type State struct {
 	policies *cache.Cache
 	roles    *cache.Cache
 	projects *cache.Cache
-	ms       storage.MigrationStatus
+	// rules are the applied rules, stagedRules the staged changes to them
+	rules       *cache.Cache
+	stagedRules *cache.Cache
+	ms          storage.MigrationStatus
 }
 
 var ErrTypeAssertionFailed = errors.New("type assertion failed: could not convert interface{} to *storage.Policy")
 
+var errRuleTypeAssertionFailed = errors.New("type assertion failed: could not convert interface{} to *storage.Rule")
+
 func New() *State {
 	return &State{
-		policies: cache.New(cache.NoExpiration, -1 /* never run cleanup */),
-		roles:    cache.New(cache.NoExpiration, -1),
-		projects: cache.New(cache.NoExpiration, -1),
+		policies:    cache.New(cache.NoExpiration, -1 /* never run cleanup */),
+		roles:       cache.New(cache.NoExpiration, -1),
+		projects:    cache.New(cache.NoExpiration, -1),
+		rules:       cache.New(cache.NoExpiration, -1),
+		stagedRules: cache.New(cache.NoExpiration, -1),
 	}
 }
 
@@ -254,6 +261,13 @@ func (s *State) DeleteProject(ctx context.Context, id string) error {
 	}
 
 	s.projects.Delete(id)
+	for _, rules := range []*cache.Cache{s.rules, s.stagedRules} {
+		for ruleID, item := range rules.Items() {
+			if r, ok := item.Object.(*storage.Rule); ok && r.ProjectID == id {
+				rules.Delete(ruleID)
+			}
+		}
+	}
 	return nil
 }
 
@@ -271,6 +285,111 @@ func (s *State) ListProjects(context.Context) ([]*storage.Project, error) {
 	return projects, nil
 }
 
+func (s *State) CreateRule(_ context.Context, rule *storage.Rule) (*storage.Rule, error) {
+	if _, found := s.projects.Get(rule.ProjectID); !found {
+		return nil, storage_errors.ErrForeignKey
+	}
+	if _, found := s.rules.Get(rule.ID); found {
+		return nil, storage_errors.ErrConflict
+	}
+	copyRule := *rule
+	copyRule.Status = storage.Staged
+	if err := s.stagedRules.Add(rule.ID, &copyRule, cache.NoExpiration); err != nil {
+		return nil, storage_errors.ErrConflict
+	}
+	return &copyRule, nil
+}
+
+func (s *State) UpdateRule(ctx context.Context, rule *storage.Rule) (*storage.Rule, error) {
+	if _, err := s.GetStagedOrAppliedRule(ctx, rule.ProjectID, rule.ID); err != nil {
+		return nil, err
+	}
+	copyRule := *rule
+	copyRule.Status = storage.Staged
+	copyRule.Deleted = false
+	s.stagedRules.Set(rule.ID, &copyRule, cache.NoExpiration)
+	return &copyRule, nil
+}
+
+func (s *State) DeleteRule(ctx context.Context, projectID, id string) error {
+	if _, err := s.GetStagedOrAppliedRule(ctx, projectID, id); err != nil {
+		return err
+	}
+	item, found := s.rules.Get(id)
+	if !found { // never applied: nothing to stage
+		s.stagedRules.Delete(id)
+		return nil
+	}
+	applied, ok := item.(*storage.Rule)
+	if !ok {
+		return errRuleTypeAssertionFailed
+	}
+	deleted := *applied
+	deleted.Status = storage.Staged
+	deleted.Deleted = true
+	s.stagedRules.Set(id, &deleted, cache.NoExpiration)
+	return nil
+}
+
+func (s *State) GetStagedOrAppliedRule(_ context.Context, projectID, id string) (*storage.Rule, error) {
+	for _, rules := range []*cache.Cache{s.stagedRules, s.rules} {
+		if item, found := rules.Get(id); found {
+			r, ok := item.(*storage.Rule)
+			if !ok {
+				return nil, errRuleTypeAssertionFailed
+			}
+			if r.ProjectID != projectID {
+				return nil, storage_errors.ErrNotFound
+			}
+			return r, nil
+		}
+	}
+	return nil, storage_errors.ErrNotFound
+}
+
+func (s *State) ListStagedOrAppliedRules(_ context.Context, projectID string) ([]*storage.Rule, error) {
+	rules := []*storage.Rule{}
+	seen := map[string]bool{}
+	for _, cached := range []*cache.Cache{s.stagedRules, s.rules} {
+		for id, item := range cached.Items() {
+			if r, ok := item.Object.(*storage.Rule); ok && r.ProjectID == projectID && !seen[id] {
+				seen[id] = true
+				rules = append(rules, r)
+			}
+		}
+	}
+	return rules, nil
+}
+
+func (s *State) ListRules(context.Context) ([]*storage.Rule, error) {
+	items := s.rules.Items()
+	rules := make([]*storage.Rule, 0, len(items))
+	for _, item := range items {
+		if r, ok := item.Object.(*storage.Rule); ok {
+			rules = append(rules, r)
+		}
+	}
+	return rules, nil
+}
+
+func (s *State) ApplyStagedRules(context.Context) error {
+	for id, item := range s.stagedRules.Items() {
+		r, ok := item.Object.(*storage.Rule)
+		if !ok {
+			return errRuleTypeAssertionFailed
+		}
+		if r.Deleted {
+			s.rules.Delete(id)
+		} else {
+			applied := *r
+			applied.Status = storage.Applied
+			s.rules.Set(id, &applied, cache.NoExpiration)
+		}
+	}
+	s.stagedRules.Flush()
+	return nil
+}
+
 func (s *State) CreateRole(_ context.Context, role *storage.Role) (*storage.Role, error) {
 	if err := s.roles.Add(role.ID, role, cache.NoExpiration); err != nil {
 		return nil, storage_errors.ErrConflict
@@ -341,6 +460,8 @@ func (s *State) Reset(ctx context.Context) error {
 	s.policies.Flush()
 	s.roles.Flush()
 	s.projects.Flush()
+	s.rules.Flush()
+	s.stagedRules.Flush()
 
 	return nil
 }
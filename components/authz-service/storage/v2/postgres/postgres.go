@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -297,10 +298,18 @@ func (p *pg) insertPolicyStatementsWithQuerier(ctx context.Context,
 	policyID string, inputStatements []v2.Statement,
 	q Querier) error {
 	for _, s := range inputStatements {
+		var conditions sql.NullString // NULL if there are none
+		if s.Conditions != nil {
+			raw, err := json.Marshal(s.Conditions)
+			if err != nil {
+				return errors.Wrap(err, "marshal statement conditions")
+			}
+			conditions = sql.NullString{String: string(raw), Valid: true}
+		}
 		_, err := q.ExecContext(ctx,
-			`SELECT insert_iam_statement_into_policy($1, $2, $3, $4, $5, $6, $7);`,
+			`SELECT insert_iam_statement_into_policy($1, $2, $3, $4, $5, $6, $7, $8);`,
 			policyID, s.ID, s.Effect.String(), pq.Array(s.Actions),
-			pq.Array(s.Resources), s.Role, pq.Array(s.Projects),
+			pq.Array(s.Resources), s.Role, pq.Array(s.Projects), conditions,
 		)
 		if err != nil {
 			err = p.processError(err)
@@ -987,6 +996,238 @@ func (p *pg) insertProjectWithQuerier(ctx context.Context, project *v2.Project,
 	return nil
 }
 
+/* * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * */
+/* * * * * * * * * * * * * * * * * * * *  RULES  * * * * * * * * * * * * * * * * * * * */
+/* * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * */
+
+// stagedOrAppliedRules selects all rules, preferring their staged versions
+const stagedOrAppliedRules = `
+	SELECT DISTINCT ON (id) id, project_id, name, conditions, deleted, staged FROM (
+		SELECT id, project_id, name, conditions, deleted, true AS staged FROM iam_staged_project_rules
+		UNION ALL
+		SELECT id, project_id, name, conditions, false AS deleted, false AS staged FROM iam_project_rules
+	) AS rules`
+
+func (p *pg) CreateRule(ctx context.Context, rule *v2.Rule) (*v2.Rule, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tx, err := p.db.BeginTx(ctx, nil /* use driver default */)
+	if err != nil {
+		return nil, p.processError(err)
+	}
+
+	var applied bool
+	row := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM iam_project_rules WHERE id=$1)`, rule.ID)
+	if err := row.Scan(&applied); err != nil {
+		return nil, p.processError(err)
+	}
+	if applied {
+		return nil, storage_errors.ErrConflict
+	}
+
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal rule conditions")
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO iam_staged_project_rules (id, project_id, name, conditions) VALUES ($1, $2, $3, $4);`,
+		rule.ID, rule.ProjectID, rule.Name, string(conditions))
+	if err != nil {
+		return nil, p.processError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, storage_errors.NewErrTxCommit(err)
+	}
+
+	created := *rule
+	created.Status = v2.Staged
+	return &created, nil
+}
+
+func (p *pg) UpdateRule(ctx context.Context, rule *v2.Rule) (*v2.Rule, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tx, err := p.db.BeginTx(ctx, nil /* use driver default */)
+	if err != nil {
+		return nil, p.processError(err)
+	}
+
+	if _, err := p.getStagedOrAppliedRuleWithQuerier(ctx, rule.ProjectID, rule.ID, tx); err != nil {
+		return nil, p.processError(err)
+	}
+
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal rule conditions")
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO iam_staged_project_rules (id, project_id, name, conditions) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET name=excluded.name, conditions=excluded.conditions, deleted=false;`,
+		rule.ID, rule.ProjectID, rule.Name, string(conditions))
+	if err != nil {
+		return nil, p.processError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, storage_errors.NewErrTxCommit(err)
+	}
+
+	updated := *rule
+	updated.Status = v2.Staged
+	updated.Deleted = false
+	return &updated, nil
+}
+
+func (p *pg) DeleteRule(ctx context.Context, projectID, id string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tx, err := p.db.BeginTx(ctx, nil /* use driver default */)
+	if err != nil {
+		return p.processError(err)
+	}
+
+	if _, err := p.getStagedOrAppliedRuleWithQuerier(ctx, projectID, id, tx); err != nil {
+		return p.processError(err)
+	}
+
+	// A rule that has been applied is staged for deletion, one that hasn't is
+	// deleted right away.
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO iam_staged_project_rules (id, project_id, name, conditions, deleted)
+		SELECT id, project_id, name, conditions, true FROM iam_project_rules WHERE id=$1
+		ON CONFLICT (id) DO UPDATE SET deleted=true;`, id)
+	if err != nil {
+		return p.processError(err)
+	}
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM iam_staged_project_rules
+		WHERE id=$1 AND NOT EXISTS(SELECT 1 FROM iam_project_rules WHERE id=$1);`, id)
+	if err != nil {
+		return p.processError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return storage_errors.NewErrTxCommit(err)
+	}
+	return nil
+}
+
+func (p *pg) GetStagedOrAppliedRule(ctx context.Context, projectID, id string) (*v2.Rule, error) {
+	rule, err := p.getStagedOrAppliedRuleWithQuerier(ctx, projectID, id, p.db)
+	if err != nil {
+		return nil, p.processError(err)
+	}
+	return rule, nil
+}
+
+func (p *pg) getStagedOrAppliedRuleWithQuerier(ctx context.Context,
+	projectID, id string, q Querier) (*v2.Rule, error) {
+	row := q.QueryRowContext(ctx,
+		stagedOrAppliedRules+` WHERE id=$1 AND project_id=$2 ORDER BY id, staged DESC;`,
+		id, projectID)
+	return scanRule(row)
+}
+
+func (p *pg) ListStagedOrAppliedRules(ctx context.Context, projectID string) ([]*v2.Rule, error) {
+	rows, err := p.db.QueryContext(ctx,
+		stagedOrAppliedRules+` WHERE project_id=$1 ORDER BY id, staged DESC;`, projectID)
+	if err != nil {
+		return nil, p.processError(err)
+	}
+	return p.scanRules(rows)
+}
+
+func (p *pg) ListRules(ctx context.Context) ([]*v2.Rule, error) {
+	rows, err := p.db.QueryContext(ctx,
+		`SELECT id, project_id, name, conditions, false, false FROM iam_project_rules ORDER BY id;`)
+	if err != nil {
+		return nil, p.processError(err)
+	}
+	return p.scanRules(rows)
+}
+
+func (p *pg) ApplyStagedRules(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tx, err := p.db.BeginTx(ctx, nil /* use driver default */)
+	if err != nil {
+		return p.processError(err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`DELETE FROM iam_project_rules AS r USING iam_staged_project_rules AS s
+		WHERE r.id = s.id AND s.deleted;`)
+	if err != nil {
+		return p.processError(err)
+	}
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO iam_project_rules (id, project_id, name, conditions)
+		SELECT id, project_id, name, conditions FROM iam_staged_project_rules WHERE NOT deleted
+		ON CONFLICT (id) DO UPDATE SET name=excluded.name, conditions=excluded.conditions;`)
+	if err != nil {
+		return p.processError(err)
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM iam_staged_project_rules;`)
+	if err != nil {
+		return p.processError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return storage_errors.NewErrTxCommit(err)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+func scanRule(row scanner) (*v2.Rule, error) {
+	var rule v2.Rule
+	var conditions []byte
+	var staged bool
+	if err := row.Scan(&rule.ID, &rule.ProjectID, &rule.Name, &conditions, &rule.Deleted, &staged); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(conditions, &rule.Conditions); err != nil {
+		return nil, errors.Wrap(err, "unmarshal rule conditions")
+	}
+	if staged {
+		rule.Status = v2.Staged
+	}
+	return &rule, nil
+}
+
+func (p *pg) scanRules(rows *sql.Rows) ([]*v2.Rule, error) {
+	defer func() {
+		if err := rows.Close(); err != nil {
+			p.logger.Warnf("failed to close db rows: %s", err.Error())
+		}
+	}()
+
+	rules := []*v2.Rule{}
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			return nil, p.processError(err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, p.processError(err)
+	}
+	return rules, nil
+}
+
 /* * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * */
 /* * * * * * * * * * * * * * * * * * * * SUPPORT * * * * * * * * * * * * * * * * * * * */
 /* * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * * */
@@ -3660,6 +3660,196 @@ func TestListProjects(t *testing.T) {
 	}
 }
 
+func TestCreateRule(t *testing.T) {
+	store, db, _ := setup(t)
+	defer db.close(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	cases := map[string]func(*testing.T){
+		"successfully creates staged rule": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			rule := genRule(t, "rule-1", "project-1")
+
+			resp, err := store.CreateRule(ctx, &rule)
+			require.NoError(t, err)
+			assert.Equal(t, &rule, resp)
+
+			assertOne(t, db.QueryRow(`SELECT count(*) FROM iam_staged_project_rules WHERE id='rule-1'`))
+			assertEmpty(t, db.QueryRow(`SELECT count(*) FROM iam_project_rules`))
+		},
+		"does not create rule for a project that does not exist": func(t *testing.T) {
+			rule := genRule(t, "rule-1", "project-1")
+
+			resp, err := store.CreateRule(ctx, &rule)
+			assert.Equal(t, storage_errors.ErrForeignKey, err)
+			assert.Nil(t, resp)
+		},
+		"does not create rule with duplicate ID": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			rule := genRule(t, "rule-1", "project-1")
+			_, err := store.CreateRule(ctx, &rule)
+			require.NoError(t, err)
+
+			resp, err := store.CreateRule(ctx, &rule)
+			assert.Equal(t, storage_errors.ErrConflict, err)
+			assert.Nil(t, resp)
+		},
+		"does not create rule with the ID of an applied rule": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			rule := genRule(t, "rule-1", "project-1")
+			_, err := store.CreateRule(ctx, &rule)
+			require.NoError(t, err)
+			require.NoError(t, store.ApplyStagedRules(ctx))
+
+			resp, err := store.CreateRule(ctx, &rule)
+			assert.Equal(t, storage_errors.ErrConflict, err)
+			assert.Nil(t, resp)
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, test)
+		db.flush(t)
+	}
+}
+
+func TestUpdateRule(t *testing.T) {
+	store, db, _ := setup(t)
+	defer db.close(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	cases := map[string]func(*testing.T){
+		"returns NotFound if the rule does not exist": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			rule := genRule(t, "rule-1", "project-1")
+
+			resp, err := store.UpdateRule(ctx, &rule)
+			assert.Equal(t, storage_errors.ErrNotFound, err)
+			assert.Nil(t, resp)
+		},
+		"stages an update of an applied rule": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			rule := genRule(t, "rule-1", "project-1")
+			_, err := store.CreateRule(ctx, &rule)
+			require.NoError(t, err)
+			require.NoError(t, store.ApplyStagedRules(ctx))
+
+			rule.Name = "updated name"
+			resp, err := store.UpdateRule(ctx, &rule)
+			require.NoError(t, err)
+			assert.Equal(t, &rule, resp)
+
+			staged, err := store.GetStagedOrAppliedRule(ctx, "project-1", "rule-1")
+			require.NoError(t, err)
+			assert.Equal(t, "updated name", staged.Name)
+			assert.Equal(t, storage.Staged, staged.Status)
+
+			applied, err := store.ListRules(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(applied))
+			assert.Equal(t, "my rule", applied[0].Name)
+			assert.Equal(t, storage.Applied, applied[0].Status)
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, test)
+		db.flush(t)
+	}
+}
+
+func TestDeleteRule(t *testing.T) {
+	store, db, _ := setup(t)
+	defer db.close(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	cases := map[string]func(*testing.T){
+		"returns NotFound if the rule does not exist": func(t *testing.T) {
+			err := store.DeleteRule(ctx, "project-1", "rule-1")
+			assert.Equal(t, storage_errors.ErrNotFound, err)
+		},
+		"deletes a staged rule that has not been applied": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			rule := genRule(t, "rule-1", "project-1")
+			_, err := store.CreateRule(ctx, &rule)
+			require.NoError(t, err)
+
+			require.NoError(t, store.DeleteRule(ctx, "project-1", "rule-1"))
+			assertEmpty(t, db.QueryRow(`SELECT count(*) FROM iam_staged_project_rules`))
+		},
+		"stages the deletion of an applied rule": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			rule := genRule(t, "rule-1", "project-1")
+			_, err := store.CreateRule(ctx, &rule)
+			require.NoError(t, err)
+			require.NoError(t, store.ApplyStagedRules(ctx))
+
+			require.NoError(t, store.DeleteRule(ctx, "project-1", "rule-1"))
+			staged, err := store.GetStagedOrAppliedRule(ctx, "project-1", "rule-1")
+			require.NoError(t, err)
+			assert.True(t, staged.Deleted)
+			assertOne(t, db.QueryRow(`SELECT count(*) FROM iam_project_rules`))
+
+			require.NoError(t, store.ApplyStagedRules(ctx))
+			assertEmpty(t, db.QueryRow(`SELECT count(*) FROM iam_project_rules`))
+			assertEmpty(t, db.QueryRow(`SELECT count(*) FROM iam_staged_project_rules`))
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, test)
+		db.flush(t)
+	}
+}
+
+func TestListStagedOrAppliedRules(t *testing.T) {
+	store, db, _ := setup(t)
+	defer db.close(t)
+	defer store.Close()
+	ctx := context.Background()
+
+	cases := map[string]func(*testing.T){
+		"returns an empty list if the project has no rules": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			resp, err := store.ListStagedOrAppliedRules(ctx, "project-1")
+			require.NoError(t, err)
+			assert.Empty(t, resp)
+		},
+		"returns the staged version of rules that have one": func(t *testing.T) {
+			insertTestProject(t, db, "project-1", "let's go jigglypuff - topsecret", storage.Custom)
+			insertTestProject(t, db, "project-2", "let's go pikachu", storage.Custom)
+			rule1 := genRule(t, "rule-1", "project-1")
+			rule2 := genRule(t, "rule-2", "project-1")
+			rule3 := genRule(t, "rule-3", "project-2")
+			for _, r := range []*storage.Rule{&rule1, &rule2, &rule3} {
+				_, err := store.CreateRule(ctx, r)
+				require.NoError(t, err)
+			}
+			require.NoError(t, store.ApplyStagedRules(ctx))
+			rule2.Name = "updated name"
+			_, err := store.UpdateRule(ctx, &rule2)
+			require.NoError(t, err)
+
+			resp, err := store.ListStagedOrAppliedRules(ctx, "project-1")
+			require.NoError(t, err)
+			require.Equal(t, 2, len(resp))
+			assert.Equal(t, "rule-1", resp[0].ID)
+			assert.Equal(t, storage.Applied, resp[0].Status)
+			assert.Equal(t, "rule-2", resp[1].ID)
+			assert.Equal(t, "updated name", resp[1].Name)
+			assert.Equal(t, storage.Staged, resp[1].Status)
+		},
+	}
+
+	for name, test := range cases {
+		t.Run(name, test)
+		db.flush(t)
+	}
+}
+
 func TestCreateRole(t *testing.T) {
 	store, db, _ := setup(t)
 	defer db.close(t)
@@ -5300,6 +5490,15 @@ func genRole(t *testing.T, id string, name string, actions []string, projects []
 	return *role
 }
 
+func genRule(t *testing.T, id, projectID string) storage.Rule {
+	t.Helper()
+	rule, err := storage.NewRule(id, projectID, "my rule", []storage.Condition{
+		{Type: "ChefServers", Values: []string{"chef.example.com"}},
+	})
+	require.NoError(t, err)
+	return rule
+}
+
 func insertTestPolicy(t *testing.T, db *testDB, policyName string) string {
 	row := db.QueryRow(fmt.Sprintf("INSERT INTO iam_policies "+
 		"(id, name) VALUES (uuid_generate_v4(), '%s') "+
@@ -0,0 +1,113 @@
+package v2
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	storage_errors "github.com/chef/automate/components/authz-service/storage"
+	rule_types "github.com/chef/automate/lib/authz"
+)
+
+// RuleStatus tells if a rule is in effect (applied), or if it's been changed
+// without the change having been applied yet (staged).
+type RuleStatus int
+
+const (
+	// Applied rules are in effect
+	Applied RuleStatus = iota
+	// Staged rules are changes that take effect once the staged rules are applied
+	Staged
+)
+
+func (s RuleStatus) String() string {
+	switch s {
+	case Applied:
+		return "applied"
+	case Staged:
+		return "staged"
+	default:
+		panic("unreachable")
+	}
+}
+
+// Rule is a project ingest rule: nodes matching all of its conditions are
+// assigned to its project.
+type Rule struct {
+	ID         string      `json:"id"`
+	ProjectID  string      `json:"project_id"`
+	Name       string      `json:"name"`
+	Conditions []Condition `json:"conditions"`
+	Status     RuleStatus  `json:"-"`
+	// Deleted is set on staged rules: applying them deletes the rule
+	Deleted bool `json:"deleted"`
+}
+
+// Condition matches nodes with an attribute of Type having any of Values.
+type Condition struct {
+	Type   string   `json:"type"`
+	Values []string `json:"values"`
+}
+
+// conditionTypes are the node attributes rule conditions can refer to
+var conditionTypes = map[string]bool{
+	rule_types.ChefServersTag:      true,
+	rule_types.ChefOrgsTag:         true,
+	rule_types.ChefEnvironmentsTag: true,
+	rule_types.RolesTag:            true,
+	rule_types.ChefTagsTag:         true,
+	rule_types.PolicyGroupTag:      true,
+	rule_types.PolicyNameTag:       true,
+}
+
+// Scan implements pq Scan interface for a Rule reference
+// so we can pull them out of the database directly as the correct type.
+func (r *Rule) Scan(src interface{}) error {
+	if src == nil {
+		return storage_errors.ErrNotFound
+	}
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("type assertion .([]byte) failed")
+	}
+	return json.Unmarshal(source, r)
+}
+
+// NewRule is a factory for creating a Rule storage object that also does
+// validation around what a valid rule is in terms of our storage layer. New
+// rules are staged.
+func NewRule(id, projectID, name string, conditions []Condition) (Rule, error) {
+	if emptyOrWhitespaceOnlyRE.MatchString(id) {
+		return Rule{}, errors.New("a rule id must contain non-whitespace characters")
+	}
+	if emptyOrWhitespaceOnlyRE.MatchString(projectID) {
+		return Rule{}, errors.New("a rule must belong to a project")
+	}
+	if emptyOrWhitespaceOnlyRE.MatchString(name) {
+		return Rule{}, errors.New("a rule name must contain non-whitespace characters")
+	}
+	if len(conditions) == 0 {
+		return Rule{}, errors.New("a rule must have at least one condition")
+	}
+	for _, c := range conditions {
+		if !conditionTypes[c.Type] {
+			return Rule{}, errors.Errorf("invalid condition type %q", c.Type)
+		}
+		if len(c.Values) == 0 {
+			return Rule{}, errors.Errorf("condition of type %q must have at least one value", c.Type)
+		}
+		for _, v := range c.Values {
+			if emptyOrWhitespaceOnlyRE.MatchString(v) {
+				return Rule{}, errors.Errorf("condition of type %q has an empty value", c.Type)
+			}
+		}
+	}
+
+	return Rule{
+		ID:         id,
+		ProjectID:  projectID,
+		Name:       name,
+		Conditions: conditions,
+		Status:     Staged,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package v2_test
+
+import (
+	"testing"
+
+	storage "github.com/chef/automate/components/authz-service/storage/v2"
+)
+
+func TestNewRule(t *testing.T) {
+	validConditions := []storage.Condition{
+		{Type: "ChefServers", Values: []string{"chef.example.com"}},
+		{Type: "ChefTags", Values: []string{"production"}},
+	}
+	for name, tc := range map[string]struct {
+		id, projectID, name string
+		conditions          []storage.Condition
+		expectErr           bool
+	}{
+		"empty id": {
+			projectID:  "my-project",
+			name:       "my-name",
+			conditions: validConditions,
+			expectErr:  true,
+		},
+		"empty project id": {
+			id:         "my-id",
+			name:       "my-name",
+			conditions: validConditions,
+			expectErr:  true,
+		},
+		"all whitespace name": {
+			id:         "my-id",
+			projectID:  "my-project",
+			name:       "   ",
+			conditions: validConditions,
+			expectErr:  true,
+		},
+		"no conditions": {
+			id:        "my-id",
+			projectID: "my-project",
+			name:      "my-name",
+			expectErr: true,
+		},
+		"unknown condition type": {
+			id:         "my-id",
+			projectID:  "my-project",
+			name:       "my-name",
+			conditions: []storage.Condition{{Type: "Platform", Values: []string{"ubuntu"}}},
+			expectErr:  true,
+		},
+		"condition without values": {
+			id:         "my-id",
+			projectID:  "my-project",
+			name:       "my-name",
+			conditions: []storage.Condition{{Type: "Roles"}},
+			expectErr:  true,
+		},
+		"condition with empty value": {
+			id:         "my-id",
+			projectID:  "my-project",
+			name:       "my-name",
+			conditions: []storage.Condition{{Type: "Roles", Values: []string{"webserver", ""}}},
+			expectErr:  true,
+		},
+		"valid rule": {
+			id:         "my-id",
+			projectID:  "my-project",
+			name:       "my-name",
+			conditions: validConditions,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rule, err := storage.NewRule(tc.id, tc.projectID, tc.name, tc.conditions)
+			if tc.expectErr != (err != nil) {
+				t.Fail()
+			}
+			if err == nil && rule.Status != storage.Staged {
+				t.Errorf("expected new rule to be staged, got %s", rule.Status)
+			}
+		})
+	}
+}
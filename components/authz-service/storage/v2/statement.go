@@ -1,6 +1,9 @@
 package v2
 
 import (
+	"net"
+	"time"
+
 	"github.com/pkg/errors"
 
 	storage_errors "github.com/chef/automate/components/authz-service/storage"
@@ -15,6 +18,83 @@ type Statement struct {
 	Role      string    `json:"role"`
 	Projects  []string  `json:"projects"`
 	Effect    Effect    `json:"effect"`
+	// Conditions restrict when the statement applies; a statement without
+	// conditions always applies
+	Conditions *Conditions `json:"conditions"`
+}
+
+// Conditions restrict when a statement applies: it only applies if all of
+// them are met. An empty list means no restriction.
+type Conditions struct {
+	// TimeWindows are the periods the statement applies in; a request has to
+	// be made in one of them
+	TimeWindows []TimeWindow `json:"time_windows"`
+	// SourceIPs are CIDRs; the address of the request's client has to be in
+	// one of them
+	SourceIPs []string `json:"source_ips"`
+	// SubjectTypes are the types of subjects the statement applies to, like
+	// "user" or "token"; a request has to include a subject of one of them
+	SubjectTypes []string `json:"subject_types"`
+}
+
+// TimeWindow is a period of time, including its start and excluding its end.
+type TimeWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// conditionSubjectTypes are the subject types conditions can refer to; teams
+// are left out on purpose: they are never the requestor
+var conditionSubjectTypes = map[string]bool{
+	"user":  true,
+	"token": true,
+	"tls":   true,
+	"cert":  true,
+}
+
+// NewConditions is a factory for creating a Conditions storage object that
+// also does validation around what valid conditions are. If no conditions
+// are passed, it returns nil.
+func NewConditions(timeWindows []TimeWindow, sourceIPs, subjectTypes []string) (*Conditions, error) {
+	if len(timeWindows) == 0 && len(sourceIPs) == 0 && len(subjectTypes) == 0 {
+		return nil, nil
+	}
+	for _, w := range timeWindows {
+		if !w.Start.Before(w.End) {
+			return nil, errors.Errorf("invalid time window: start %s is not before end %s",
+				w.Start.Format(time.RFC3339), w.End.Format(time.RFC3339))
+		}
+	}
+	for _, cidr := range sourceIPs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, errors.Errorf("invalid source IP range %q: must be in CIDR notation", cidr)
+		}
+	}
+	for _, t := range subjectTypes {
+		if !conditionSubjectTypes[t] {
+			return nil, errors.Errorf("invalid subject type %q: must be one of user, token, tls, or cert", t)
+		}
+	}
+
+	return &Conditions{
+		TimeWindows:  nonNilWindows(timeWindows),
+		SourceIPs:    nonNil(sourceIPs),
+		SubjectTypes: nonNil(subjectTypes),
+	}, nil
+}
+
+func nonNilWindows(ws []TimeWindow) []TimeWindow {
+	if ws == nil {
+		return []TimeWindow{}
+	}
+	return ws
+}
+
+func nonNil(ss []string) []string {
+	if ss == nil {
+		return []string{}
+	}
+	return ss
 }
 
 // NewStatement is a factory for creating a Statement storage object that also does
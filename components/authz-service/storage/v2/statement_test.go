@@ -0,0 +1,73 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storage "github.com/chef/automate/components/authz-service/storage/v2"
+)
+
+func TestNewConditions(t *testing.T) {
+	now := time.Now().UTC()
+	for name, tc := range map[string]struct {
+		timeWindows  []storage.TimeWindow
+		sourceIPs    []string
+		subjectTypes []string
+		expectErr    bool
+	}{
+		"time window": {
+			timeWindows: []storage.TimeWindow{{Start: now, End: now.Add(time.Hour)}},
+		},
+		"time window ending before it starts": {
+			timeWindows: []storage.TimeWindow{{Start: now, End: now.Add(-time.Hour)}},
+			expectErr:   true,
+		},
+		"empty time window": {
+			timeWindows: []storage.TimeWindow{{Start: now, End: now}},
+			expectErr:   true,
+		},
+		"IPv4 and IPv6 CIDRs": {
+			sourceIPs: []string{"10.0.0.0/8", "2001:db8::/32"},
+		},
+		"address without prefix length": {
+			sourceIPs: []string{"10.0.0.1"},
+			expectErr: true,
+		},
+		"subject types": {
+			subjectTypes: []string{"user", "token", "tls", "cert"},
+		},
+		"team subject type": {
+			subjectTypes: []string{"team"},
+			expectErr:    true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			conds, err := storage.NewConditions(tc.timeWindows, tc.sourceIPs, tc.subjectTypes)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, conds)
+		})
+	}
+
+	t.Run("no conditions", func(t *testing.T) {
+		conds, err := storage.NewConditions(nil, nil, nil)
+		require.NoError(t, err)
+		assert.Nil(t, conds)
+	})
+
+	t.Run("unset conditions are empty", func(t *testing.T) {
+		conds, err := storage.NewConditions(nil, []string{"10.0.0.0/8"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &storage.Conditions{
+			TimeWindows:  []storage.TimeWindow{},
+			SourceIPs:    []string{"10.0.0.0/8"},
+			SubjectTypes: []string{},
+		}, conds)
+	})
+}
@@ -14,6 +14,7 @@ type Storage interface {
 	policyStorage
 	roleStorage
 	projectStorage
+	ruleStorage
 
 	// Reset allows "factory-resetting" IAM v2 policies
 	Reset(context.Context) error
@@ -57,6 +58,23 @@ type projectStorage interface {
 	ListProjects(context.Context) ([]*Project, error)
 }
 
+// ruleStorage keeps project ingest rules. Changes to rules are staged; they
+// take effect once ApplyStagedRules is called.
+type ruleStorage interface {
+	CreateRule(context.Context, *Rule) (*Rule, error)
+	UpdateRule(context.Context, *Rule) (*Rule, error)
+	// DeleteRule removes a rule that was never applied right away, and stages
+	// the deletion of an applied rule
+	DeleteRule(ctx context.Context, projectID, id string) error
+	// GetStagedOrAppliedRule returns the staged version of a rule, if it has
+	// one, and its applied version otherwise
+	GetStagedOrAppliedRule(ctx context.Context, projectID, id string) (*Rule, error)
+	ListStagedOrAppliedRules(ctx context.Context, projectID string) ([]*Rule, error)
+	// ListRules returns the applied rules of all projects
+	ListRules(context.Context) ([]*Rule, error)
+	ApplyStagedRules(context.Context) error
+}
+
 type MigrationStatusProvider interface {
 	// record migration status
 	Pristine(context.Context) error // for reset
@@ -38,19 +38,32 @@ var backupCmdFlags = struct {
 	airgap         string
 	yes            bool
 
-	createWaitTimeout  int64
-	listWaitTimeout    int64
-	showWaitTimeout    int64
-	deleteWaitTimeout  int64
-	restoreWaitTimeout int64
-	statusWaitTimeout  int64
-	cancelWaitTimeout  int64
+	createWaitTimeout   int64
+	listWaitTimeout     int64
+	showWaitTimeout     int64
+	deleteWaitTimeout   int64
+	restoreWaitTimeout  int64
+	statusWaitTimeout   int64
+	cancelWaitTimeout   int64
+	validateWaitTimeout int64
 
 	s3Endpoint     string
 	s3AccessKey    string
 	s3SecretKey    string
 	s3SessionToken string
 
+	gcsBucket          string
+	gcsEndpoint        string
+	gcsCredentialsPath string
+
+	azureContainer      string
+	azureEndpoint       string
+	azureAccountName    string
+	azureAccountKeyPath string
+
+	encryptionKeyFile        string
+	encryptionPassphraseFile string
+
 	sha256 string
 }{}
 
@@ -63,6 +76,8 @@ func init() {
 	backupCmd.AddCommand(fixBackupRepoPermissionsCmd)
 	backupCmd.AddCommand(statusBackupCmd)
 	backupCmd.AddCommand(cancelBackupCmd)
+	backupCmd.AddCommand(integrityBackupCmd)
+	integrityBackupCmd.AddCommand(validateBackupIntegrityCmd)
 
 	backupCmd.PersistentFlags().BoolVarP(&backupCmdFlags.noProgress, "no-progress", "", false, "Don't follow operation progress")
 	backupCmd.PersistentFlags().Int64VarP(&backupCmdFlags.requestTimeout, "request-timeout", "r", 20, "API request timeout for deployment-service in seconds")
@@ -70,8 +85,17 @@ func init() {
 	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.s3AccessKey, "s3-access-key", "", "The S3 access key ID")
 	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.s3SecretKey, "s3-secret-key", "", "The S3 secret access key")
 	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.s3SessionToken, "s3-session-token", "", "The S3 session token when assuming an IAM role")
+	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.gcsEndpoint, "gcs-endpoint", "", "The GCS endpoint URL, only needed when using an emulator")
+	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.gcsCredentialsPath, "gcs-credentials-path", "", "The path to the JSON key of the GCS service account, readable by the hab user. Defaults to the application default credentials")
+	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.azureEndpoint, "azure-endpoint", "", "The Azure Blob storage endpoint URL. Defaults to https://<account-name>.blob.core.windows.net")
+	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.azureAccountName, "azure-account-name", "", "The Azure storage account name")
+	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.azureAccountKeyPath, "azure-account-key-path", "", "The path to a file holding the Azure storage account key, readable by the hab user")
+	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.encryptionKeyFile, "encryption-key-file", "", "The path to a file holding a 256 bit key, raw or base64 encoded, to encrypt or decrypt the backup with, readable by the hab user")
+	backupCmd.PersistentFlags().StringVar(&backupCmdFlags.encryptionPassphraseFile, "encryption-passphrase-file", "", "The path to a file holding a passphrase to encrypt or decrypt the backup with, readable by the hab user")
 
 	createBackupCmd.PersistentFlags().Int64VarP(&backupCmdFlags.createWaitTimeout, "wait-timeout", "t", 7200, "How long to wait for a operation to complete before raising an error")
+	createBackupCmd.PersistentFlags().StringVar(&backupCmdFlags.gcsBucket, "gcs-bucket", "", "Store the backup in the given GCS bucket, optionally followed by a base path (bucket/base/path)")
+	createBackupCmd.PersistentFlags().StringVar(&backupCmdFlags.azureContainer, "azure-container", "", "Store the backup in the given Azure Blob storage container, optionally followed by a base path (container/base/path)")
 
 	listBackupCmd.PersistentFlags().Int64VarP(&backupCmdFlags.listWaitTimeout, "wait-timeout", "t", 60, "How long to wait for a operation to complete before raising an error")
 
@@ -93,6 +117,10 @@ func init() {
 	restoreBackupCmd.PersistentFlags().StringVar(&backupCmdFlags.sha256, "sha256", "", "The SHA256 checksum of the backup")
 	restoreBackupCmd.PersistentFlags().Int64VarP(&backupCmdFlags.restoreWaitTimeout, "wait-timeout", "t", 7200, "How long to wait for a operation to complete before raising an error")
 
+	validateBackupIntegrityCmd.PersistentFlags().StringVarP(&backupCmdFlags.baseBackupDir, "backup-dir", "b", "/var/opt/chef-automate/backups", "Directory used for backups")
+	validateBackupIntegrityCmd.PersistentFlags().StringVar(&backupCmdFlags.sha256, "sha256", "", "The SHA256 checksum of the backup")
+	validateBackupIntegrityCmd.PersistentFlags().Int64VarP(&backupCmdFlags.validateWaitTimeout, "wait-timeout", "t", 7200, "How long to wait for a operation to complete before raising an error")
+
 	deleteBackupCmd.PersistentFlags().BoolVar(&backupDeleteCmdFlags.yes, "yes", false, "Agree to all prompts")
 	deleteBackupCmd.PersistentFlags().Int64VarP(&backupCmdFlags.deleteWaitTimeout, "wait-timeout", "t", 120, "How long to wait for a operation to complete before raising an error")
 
@@ -183,10 +211,29 @@ var cancelBackupCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(0),
 }
 
+var integrityBackupCmd = &cobra.Command{
+	Use:   "integrity COMMAND",
+	Short: "verify the integrity of Chef Automate backups",
+}
+
+var validateBackupIntegrityCmd = &cobra.Command{
+	Use:   "validate ID_OR_PATH",
+	Short: "validate the integrity of a Chef Automate backup",
+	Long:  "Validate every object of a Chef Automate backup against the checksums recorded when it was created. Encrypted backups are decrypted with the given encryption key or passphrase",
+	RunE:  runValidateBackupIntegrityCmd,
+	Args:  cobra.ExactArgs(1),
+}
+
 func runCreateBackupCmd(cmd *cobra.Command, args []string) error {
+	req, err := createBackupRequestFromFlags()
+	if err != nil {
+		return err
+	}
+
 	res, err := client.CreateBackup(
 		time.Duration(backupCmdFlags.requestTimeout)*time.Second,
 		time.Duration(backupCmdFlags.createWaitTimeout)*time.Second,
+		req,
 		writer,
 	)
 	if err != nil {
@@ -222,22 +269,162 @@ func runCreateBackupCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// createBackupRequestFromFlags returns the request to create a backup in the
+// location given on the command line, if any
+func createBackupRequestFromFlags() (*api.CreateBackupRequest, error) {
+	req := &api.CreateBackupRequest{}
+
+	if backupCmdFlags.gcsBucket != "" && backupCmdFlags.azureContainer != "" {
+		return nil, status.New(status.InvalidCommandArgsError,
+			"--gcs-bucket and --azure-container can't be used together")
+	}
+
+	if backupCmdFlags.gcsBucket != "" {
+		spec, err := parseLocationSpecFromCLIArgs("gs://" + backupCmdFlags.gcsBucket)
+		if err != nil {
+			return nil, err
+		}
+		gcsSpec := spec.(backup.GCSLocationSpecification)
+		req.GcsBackupLocation = &api.GCSBackupLocation{
+			BucketName:      gcsSpec.BucketName,
+			BasePath:        gcsSpec.BasePath,
+			Endpoint:        gcsSpec.Endpoint,
+			CredentialsPath: gcsSpec.CredentialsPath,
+		}
+	}
+
+	if backupCmdFlags.azureContainer != "" {
+		spec, err := parseLocationSpecFromCLIArgs("azure://" + backupCmdFlags.azureContainer)
+		if err != nil {
+			return nil, err
+		}
+		azSpec := spec.(backup.AzureLocationSpecification)
+		req.AzureBackupLocation = &api.AzureBackupLocation{
+			ContainerName:  azSpec.ContainerName,
+			BasePath:       azSpec.BasePath,
+			Endpoint:       azSpec.Endpoint,
+			AccountName:    azSpec.AccountName,
+			AccountKeyPath: azSpec.AccountKeyPath,
+		}
+	}
+
+	enc, err := backupEncryptionFromFlags()
+	if err != nil {
+		return nil, err
+	}
+	req.Encryption = enc
+
+	return req, nil
+}
+
+// backupEncryptionFromFlags returns the file holding the secret to encrypt or
+// decrypt backups with, or nil when none was given
+func backupEncryptionFromFlags() (*api.BackupEncryption, error) {
+	if backupCmdFlags.encryptionKeyFile != "" && backupCmdFlags.encryptionPassphraseFile != "" {
+		return nil, status.New(status.InvalidCommandArgsError,
+			"--encryption-key-file and --encryption-passphrase-file can't be used together")
+	}
+
+	if backupCmdFlags.encryptionKeyFile != "" {
+		keyPath, err := secretFilePath(backupCmdFlags.encryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &api.BackupEncryption{KeyPath: keyPath}, nil
+	}
+
+	if backupCmdFlags.encryptionPassphraseFile != "" {
+		passphrasePath, err := secretFilePath(backupCmdFlags.encryptionPassphraseFile)
+		if err != nil {
+			return nil, err
+		}
+		return &api.BackupEncryption{PassphrasePath: passphrasePath}, nil
+	}
+
+	return nil, nil
+}
+
+// splitBucketAndBasePath splits a scheme://bucket/base/path location
+func splitBucketAndBasePath(location string, scheme string) (string, string, error) {
+	bucketAndBasePath := strings.TrimPrefix(location, scheme)
+	parts := strings.SplitN(bucketAndBasePath, "/", 2)
+	if parts[0] == "" {
+		return "", "", status.Errorf(status.InvalidCommandArgsError,
+			"%q could not be parsed. The expected input is %sbucket/base/path",
+			location, scheme,
+		)
+	}
+
+	if len(parts) == 1 {
+		return parts[0], "", nil
+	}
+	return parts[0], parts[1], nil
+}
+
+// secretFilePath returns the absolute path of a file holding credentials or
+// an encryption secret. Only the path is sent to deployment-service, which
+// reads the file when it needs the secret.
+func secretFilePath(path string) (string, error) {
+	fqPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", status.Annotate(err, status.FileAccessError)
+	}
+	if _, err := os.Stat(fqPath); err != nil {
+		return "", status.Wrapf(err, status.FileAccessError, "Reading %s failed", fqPath)
+	}
+	return fqPath, nil
+}
+
 func parseLocationSpecFromCLIArgs(location string) (backup.LocationSpecification, error) {
+	if strings.HasPrefix(location, "gs://") {
+		bucketName, basePath, err := splitBucketAndBasePath(location, "gs://")
+		if err != nil {
+			return nil, err
+		}
+
+		credentialsPath := ""
+		if backupCmdFlags.gcsCredentialsPath != "" {
+			credentialsPath, err = secretFilePath(backupCmdFlags.gcsCredentialsPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return backup.GCSLocationSpecification{
+			BucketName:      bucketName,
+			BasePath:        basePath,
+			Endpoint:        backupCmdFlags.gcsEndpoint,
+			CredentialsPath: credentialsPath,
+		}, nil
+	}
+
+	if strings.HasPrefix(location, "azure://") {
+		containerName, basePath, err := splitBucketAndBasePath(location, "azure://")
+		if err != nil {
+			return nil, err
+		}
+		if backupCmdFlags.azureAccountName == "" || backupCmdFlags.azureAccountKeyPath == "" {
+			return nil, status.New(status.InvalidCommandArgsError,
+				"--azure-account-name and --azure-account-key-path are required to use Azure Blob storage")
+		}
+		accountKeyPath, err := secretFilePath(backupCmdFlags.azureAccountKeyPath)
+		if err != nil {
+			return nil, err
+		}
+
+		return backup.AzureLocationSpecification{
+			ContainerName:  containerName,
+			BasePath:       basePath,
+			Endpoint:       backupCmdFlags.azureEndpoint,
+			AccountName:    backupCmdFlags.azureAccountName,
+			AccountKeyPath: accountKeyPath,
+		}, nil
+	}
+
 	if strings.HasPrefix(location, "s3://") {
-		bucketAndBasePath := strings.TrimPrefix(location, "s3://")
-		parts := strings.SplitN(bucketAndBasePath, "/", 2)
-		bucketName := ""
-		basePath := ""
-		if len(parts) == 1 {
-			bucketName = parts[0]
-		} else if len(parts) == 2 {
-			bucketName = parts[0]
-			basePath = parts[1]
-		} else {
-			return nil, status.Errorf(status.InvalidCommandArgsError,
-				"%q could not be parsed. The expected input is s3://bucket/base/path",
-				location,
-			)
+		bucketName, basePath, err := splitBucketAndBasePath(location, "s3://")
+		if err != nil {
+			return nil, err
 		}
 
 		return backup.S3LocationSpecification{
@@ -474,6 +661,55 @@ func runShowBackupCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runValidateBackupIntegrityCmd(cmd *cobra.Command, args []string) error {
+	// Like restore, the argument is either a backup id in the backup
+	// directory or the location of the backup followed by its id
+	backupID := path.Base(args[0])
+	location := strings.TrimSuffix(args[0], backupID)
+	if location == "" {
+		location = backupCmdFlags.baseBackupDir
+	}
+
+	if _, err := api.NewBackupTaskFromID(backupID); err != nil {
+		return status.Wrapf(
+			err,
+			status.InvalidCommandArgsError,
+			"Converting %s into a backup ID failed",
+			backupID,
+		)
+	}
+
+	locationSpec, err := parseLocationSpecFromCLIArgs(location)
+	if err != nil {
+		return err
+	}
+
+	enc, err := backupEncryptionFromFlags()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(backupCmdFlags.validateWaitTimeout)*time.Second)
+	defer cancel()
+
+	key, err := backup.LoadEncryptionKey(ctx, locationSpec.ToBucket(backupID), enc)
+	if err != nil {
+		return status.Wrap(err, status.BackupError, "Loading the backup encryption key failed")
+	}
+	bucket := backup.WithEncryptionKey(locationSpec, key).ToBucket(backupID)
+
+	writer.Titlef("Validating backup %s", backupID)
+	if err := backup.ValidateBackupIntegrity(ctx, bucket, backupCmdFlags.sha256); err != nil {
+		if backup.IsSnapshotChecksumError(errors.Cause(err)) {
+			return status.Annotate(errors.Cause(err), status.SnapshotChecksumMismatchError)
+		}
+		return status.Wrap(err, status.BackupError, "Validating the backup failed")
+	}
+
+	writer.Successf("Backup %s is valid", backupID)
+	return nil
+}
+
 func runBackupStatusCmd(cmd *cobra.Command, args []string) error {
 	res, err := client.BackupStatus(
 		time.Duration(backupCmdFlags.requestTimeout)*time.Second,
@@ -640,6 +876,11 @@ func runRestoreBackupCmd(cmd *cobra.Command, args []string) error {
 	rt.Airgap = backupCmdFlags.airgap != ""
 	rt.Sha256 = backupCmdFlags.sha256
 
+	rt.Encryption, err = backupEncryptionFromFlags()
+	if err != nil {
+		return err
+	}
+
 	if err := locationSpec.ConfigureBackupRestoreTask(rt); err != nil {
 		return status.Annotate(err, status.MarshalError)
 	}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/chef/automate/components/automate-cli/pkg/diagnostics/lbrequest"
+	"github.com/chef/automate/components/automate-cli/pkg/status"
+	"github.com/chef/automate/components/automate-gateway/api/compliance/reporting"
+	"github.com/chef/automate/lib/httputils"
+	"github.com/chef/automate/lib/stringutils"
+)
+
+var complianceCmdFlags = struct {
+	format     string
+	filters    []string
+	tokenStdin bool
+	url        string
+	caCert     string
+	insecure   bool
+	overwrite  bool
+}{}
+
+var complianceExportFormats = []string{"json", "csv", "junit", "sarif", "html"}
+
+// complianceTokenEnv is the environment variable holding the API token used
+// for exporting compliance reports. The token is not accepted as a flag, so
+// that it doesn't show up in the process list or the shell history.
+const complianceTokenEnv = "CHEF_AUTOMATE_TOKEN"
+
+func init() {
+	complianceExportCmd.Flags().StringVarP(
+		&complianceCmdFlags.format,
+		"format",
+		"f",
+		"json",
+		fmt.Sprintf("Format of the export: %s", strings.Join(complianceExportFormats, ", ")))
+	complianceExportCmd.Flags().StringArrayVar(
+		&complianceCmdFlags.filters,
+		"filter",
+		[]string{},
+		"Only export the reports matching the filter, given as TYPE:VALUE, for example "+
+			"environment:production or end_time:2019-06-30T23:59:59Z. May be repeated")
+	complianceExportCmd.Flags().BoolVar(
+		&complianceCmdFlags.tokenStdin,
+		"token-stdin",
+		false,
+		fmt.Sprintf("Read the API token from stdin instead of the %s environment variable", complianceTokenEnv))
+	complianceExportCmd.Flags().StringVar(
+		&complianceCmdFlags.url,
+		"url",
+		"https://localhost",
+		"URL of Chef Automate")
+	complianceExportCmd.Flags().StringVar(
+		&complianceCmdFlags.caCert,
+		"ca-cert",
+		"",
+		"Path to the PEM encoded CA certificate to verify the TLS certificate of Chef Automate with, in addition to the system's")
+	complianceExportCmd.Flags().BoolVar(
+		&complianceCmdFlags.insecure,
+		"insecure",
+		false,
+		"Do not verify the TLS certificate of Chef Automate")
+	complianceExportCmd.Flags().BoolVarP(
+		&complianceCmdFlags.overwrite,
+		"overwrite",
+		"o",
+		false,
+		"Overwrite FILE if it exists")
+
+	complianceCmd.AddCommand(complianceExportCmd)
+	RootCmd.AddCommand(complianceCmd)
+}
+
+var complianceCmd = &cobra.Command{
+	Use:   "compliance COMMAND",
+	Short: "Chef Automate compliance commands",
+}
+
+var complianceExportCmd = &cobra.Command{
+	Use:   "export FILE",
+	Short: "Export compliance reports",
+	Long: "Export the latest compliance report of every node matching the filters to FILE.\n\n" +
+		"Reports can be exported as JSON, CSV, JUnit XML for CI dashboards, SARIF for " +
+		"code scanning tools, or as a self-contained HTML document.\n\n" +
+		"The API token is read from the " + complianceTokenEnv + " environment variable, " +
+		"or from stdin with --token-stdin.",
+	Args: cobra.ExactArgs(1),
+	RunE: runComplianceExportCmd,
+}
+
+func runComplianceExportCmd(cmd *cobra.Command, args []string) error {
+	if !stringutils.SliceContains(complianceExportFormats, complianceCmdFlags.format) {
+		return status.Errorf(status.InvalidCommandArgsError,
+			"Unsupported export format %q, use one of: %s",
+			complianceCmdFlags.format, strings.Join(complianceExportFormats, ", "))
+	}
+	token, err := complianceExportToken(os.Stdin)
+	if err != nil {
+		return err
+	}
+	tlsConfig, err := complianceExportTLSConfig()
+	if err != nil {
+		return err
+	}
+	filters, err := parseComplianceFilters(complianceCmdFlags.filters)
+	if err != nil {
+		return err
+	}
+	lbURL, err := url.Parse(complianceCmdFlags.url)
+	if err != nil {
+		return status.Wrap(err, status.InvalidCommandArgsError, "Parsing URL failed")
+	}
+
+	outFile := args[0]
+	if _, err := os.Stat(outFile); err == nil && !complianceCmdFlags.overwrite {
+		return status.Errorf(status.FileAccessError,
+			"%s already exists, use --overwrite to replace it", outFile)
+	}
+
+	req, err := lbrequest.New("/api/v0/compliance/reporting/export",
+		lbrequest.WithMethod("POST"),
+		lbrequest.WithURL(*lbURL),
+		lbrequest.WithAuthToken(token),
+		lbrequest.WithJSONObjectBody(&reporting.Query{
+			Type:    complianceCmdFlags.format,
+			Filters: filters,
+		}))
+	if err != nil {
+		return status.Wrap(err, status.APIError, "Failed to create the export request")
+	}
+
+	tr := httputils.NewDefaultTransport()
+	tr.TLSClientConfig = tlsConfig
+	httpClient := &http.Client{Transport: tr}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return status.Wrap(err, status.APIUnreachableError, "Request to export compliance reports failed")
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return status.Errorf(status.APIError, "Request to export compliance reports failed: %s: %s",
+			resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	f, err := os.Create(outFile)
+	if err != nil {
+		return status.Annotate(err, status.FileAccessError)
+	}
+	defer f.Close() // nolint: errcheck
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return status.Wrap(err, status.APIError, "Failed to write the compliance reports export")
+	}
+
+	writer.Successf("Exported compliance reports as %s to %s", complianceCmdFlags.format, outFile)
+	return nil
+}
+
+// complianceExportToken returns the API token from stdin if --token-stdin is
+// given, and from the environment otherwise
+func complianceExportToken(stdin io.Reader) (string, error) {
+	if !complianceCmdFlags.tokenStdin {
+		token := strings.TrimSpace(os.Getenv(complianceTokenEnv))
+		if token == "" {
+			return "", status.Errorf(status.InvalidCommandArgsError,
+				"An API token is required, set %s or pass it on stdin with --token-stdin", complianceTokenEnv)
+		}
+		return token, nil
+	}
+
+	line, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", status.Wrap(err, status.InvalidCommandArgsError, "Reading the API token from stdin failed")
+	}
+	token := strings.TrimSpace(line)
+	if token == "" {
+		return "", status.New(status.InvalidCommandArgsError, "An API token is required, none was given on stdin")
+	}
+	return token, nil
+}
+
+// complianceExportTLSConfig returns the TLS config for requests to Chef
+// Automate: its certificate is verified using the system's CAs and the one
+// given with --ca-cert, unless verification is turned off with --insecure.
+func complianceExportTLSConfig() (*tls.Config, error) {
+	if complianceCmdFlags.insecure {
+		if complianceCmdFlags.caCert != "" {
+			return nil, status.New(status.InvalidCommandArgsError,
+				"--insecure and --ca-cert cannot be used together")
+		}
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	}
+	if complianceCmdFlags.caCert == "" {
+		return &tls.Config{}, nil
+	}
+
+	pem, err := ioutil.ReadFile(complianceCmdFlags.caCert)
+	if err != nil {
+		return nil, status.Wrapf(err, status.FileAccessError, "Reading %s failed", complianceCmdFlags.caCert)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, status.Errorf(status.InvalidCommandArgsError,
+			"%s does not contain a PEM encoded certificate", complianceCmdFlags.caCert)
+	}
+	return &tls.Config{RootCAs: pool}, nil
+}
+
+// parseComplianceFilters turns TYPE:VALUE filters into the list filters of a
+// reporting query, collecting the values of each type
+func parseComplianceFilters(filters []string) ([]*reporting.ListFilter, error) {
+	listFilters := make([]*reporting.ListFilter, 0, len(filters))
+	byType := make(map[string]*reporting.ListFilter, len(filters))
+	for _, filter := range filters {
+		parts := strings.SplitN(filter, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, status.Errorf(status.InvalidCommandArgsError,
+				"Invalid filter %q, filters are given as TYPE:VALUE", filter)
+		}
+		listFilter, ok := byType[parts[0]]
+		if !ok {
+			listFilter = &reporting.ListFilter{Type: parts[0]}
+			byType[parts[0]] = listFilter
+			listFilters = append(listFilters, listFilter)
+		}
+		listFilter.Values = append(listFilter.Values, parts[1])
+	}
+	return listFilters, nil
+}
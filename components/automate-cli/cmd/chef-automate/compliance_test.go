@@ -0,0 +1,142 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/automate-gateway/api/compliance/reporting"
+)
+
+func TestParseComplianceFilters(t *testing.T) {
+	filters, err := parseComplianceFilters([]string{
+		"environment:production",
+		"end_time:2019-06-30T23:59:59Z",
+		"environment:staging",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*reporting.ListFilter{
+		{Type: "environment", Values: []string{"production", "staging"}},
+		{Type: "end_time", Values: []string{"2019-06-30T23:59:59Z"}},
+	}, filters)
+
+	for _, invalid := range []string{"environment", ":production", "environment:"} {
+		_, err := parseComplianceFilters([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestComplianceExportToken(t *testing.T) {
+	defer os.Unsetenv(complianceTokenEnv)
+	defer func() { complianceCmdFlags.tokenStdin = false }()
+
+	t.Run("reads the token from the environment", func(t *testing.T) {
+		complianceCmdFlags.tokenStdin = false
+		os.Setenv(complianceTokenEnv, " env-token\n")
+		token, err := complianceExportToken(strings.NewReader("stdin-token\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "env-token", token)
+
+		os.Unsetenv(complianceTokenEnv)
+		_, err = complianceExportToken(strings.NewReader("stdin-token\n"))
+		assert.Error(t, err)
+	})
+
+	t.Run("reads the token from stdin with --token-stdin", func(t *testing.T) {
+		complianceCmdFlags.tokenStdin = true
+		os.Setenv(complianceTokenEnv, "env-token")
+		token, err := complianceExportToken(strings.NewReader("stdin-token\nignored\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "stdin-token", token)
+
+		token, err = complianceExportToken(strings.NewReader("stdin-token"))
+		require.NoError(t, err)
+		assert.Equal(t, "stdin-token", token)
+
+		_, err = complianceExportToken(strings.NewReader(""))
+		assert.Error(t, err)
+	})
+}
+
+func TestComplianceExportTLSConfig(t *testing.T) {
+	defer func() {
+		complianceCmdFlags.insecure = false
+		complianceCmdFlags.caCert = ""
+	}()
+	dir, err := ioutil.TempDir("", "compliance-export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("verifies certificates by default", func(t *testing.T) {
+		complianceCmdFlags.insecure, complianceCmdFlags.caCert = false, ""
+		cfg, err := complianceExportTLSConfig()
+		require.NoError(t, err)
+		assert.False(t, cfg.InsecureSkipVerify)
+		assert.Nil(t, cfg.RootCAs)
+	})
+
+	t.Run("skips verification with --insecure", func(t *testing.T) {
+		complianceCmdFlags.insecure, complianceCmdFlags.caCert = true, ""
+		cfg, err := complianceExportTLSConfig()
+		require.NoError(t, err)
+		assert.True(t, cfg.InsecureSkipVerify)
+	})
+
+	t.Run("trusts the CA given with --ca-cert", func(t *testing.T) {
+		caPath := filepath.Join(dir, "ca.crt")
+		require.NoError(t, ioutil.WriteFile(caPath, testCACert(t), 0644))
+		complianceCmdFlags.insecure, complianceCmdFlags.caCert = false, caPath
+		cfg, err := complianceExportTLSConfig()
+		require.NoError(t, err)
+		assert.False(t, cfg.InsecureSkipVerify)
+		assert.NotNil(t, cfg.RootCAs)
+
+		complianceCmdFlags.insecure = true
+		_, err = complianceExportTLSConfig()
+		assert.Error(t, err, "--insecure and --ca-cert")
+	})
+
+	t.Run("fails without a certificate in the --ca-cert file", func(t *testing.T) {
+		notACert := filepath.Join(dir, "not-a-cert")
+		require.NoError(t, ioutil.WriteFile(notACert, []byte("garbage"), 0644))
+		complianceCmdFlags.insecure, complianceCmdFlags.caCert = false, notACert
+		_, err := complianceExportTLSConfig()
+		assert.Error(t, err)
+
+		complianceCmdFlags.caCert = filepath.Join(dir, "missing")
+		_, err = complianceExportTLSConfig()
+		assert.Error(t, err)
+	})
+}
+
+func testCACert(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -3,9 +3,11 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 	grpc_status "google.golang.org/grpc/status"
@@ -14,6 +16,7 @@ import (
 	v2_constants "github.com/chef/automate/components/authz-service/constants/v2"
 	"github.com/chef/automate/components/automate-cli/pkg/adminmgmt"
 	"github.com/chef/automate/components/automate-cli/pkg/client/apiclient"
+	"github.com/chef/automate/components/automate-cli/pkg/iamstate"
 	"github.com/chef/automate/components/automate-cli/pkg/status"
 	policies_common "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/common"
 	policies_req "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/request"
@@ -24,6 +27,10 @@ var iamCmdFlags = struct {
 	adminToken  bool
 	tokenID     string
 	betaVersion bool
+	subjects    []string
+	projects    []string
+	policyFiles []string
+	sourceIP    string
 }{}
 
 func newIAMCommand() *cobra.Command {
@@ -67,6 +74,69 @@ func newIAMCreateTokenCommand() *cobra.Command {
 	return cmd
 }
 
+func newIAMExplainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "explain ACTION RESOURCE",
+		Short: "Explain an IAM v2 authorization decision",
+		Long: "Show whether the subjects are allowed to perform ACTION on RESOURCE, " +
+			"and which policy statements allow or deny it. " +
+			"Policies that are not yet saved can be included using --policy.",
+		RunE: runIAMExplainCmd,
+		Args: cobra.ExactArgs(2),
+	}
+	cmd.PersistentFlags().StringSliceVar(
+		&iamCmdFlags.subjects,
+		"subject",
+		nil,
+		"Subject of the request, like user:local:alice or team:local:admins (required, can be repeated)")
+	cmd.PersistentFlags().StringSliceVar(
+		&iamCmdFlags.projects,
+		"project",
+		nil,
+		"Project of the request (can be repeated)")
+	cmd.PersistentFlags().StringSliceVar(
+		&iamCmdFlags.policyFiles,
+		"policy",
+		nil,
+		"JSON file of a policy to evaluate in place of the saved policy with the same ID (can be repeated)")
+	cmd.PersistentFlags().StringVar(
+		&iamCmdFlags.sourceIP,
+		"source-ip",
+		"",
+		"Client address of the request, to check the source IP conditions of policy statements against")
+	return cmd
+}
+
+func newIAMExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export FILE",
+		Short: "Export IAM v2 policies, roles, projects, and team memberships",
+		Long: "Write all IAM v2 policies, roles, projects, and team memberships to FILE. " +
+			"FILE is written as TOML if its name ends in .toml, and as JSON otherwise.",
+		RunE: runIAMExportCmd,
+		Args: cobra.ExactArgs(1),
+	}
+}
+
+func newIAMImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import IAM v2 policies, roles, projects, and team memberships",
+		Long: "Make the IAM v2 policies, roles, projects, and the memberships of teams " +
+			"match those in FILE, as written by `chef-automate iam export`. " +
+			"Policies, roles, and projects not in FILE are deleted. " +
+			"Chef-managed policies, roles, and projects are never changed.",
+		RunE: runIAMImportCmd,
+		Args: cobra.ExactArgs(1),
+	}
+	cmd.PersistentFlags().BoolVar(
+		&iamCmdFlags.dryRun,
+		"dry-run",
+		false,
+		"Show the changes the import would make without making them")
+	return cmd
+}
+
 func newIAMRestoreDefaultAdminAccessCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "restore PASSWORD",
@@ -132,6 +202,9 @@ func init() {
 	iamCommand.AddCommand(newIAMUpgradeToV2Cmd())
 	iamCommand.AddCommand(newIAMResetToV1Cmd())
 	iamCommand.AddCommand(newIAMVersionCmd())
+	iamCommand.AddCommand(newIAMExplainCmd())
+	iamCommand.AddCommand(newIAMExportCmd())
+	iamCommand.AddCommand(newIAMImportCmd())
 
 	iamAdminAccessCommand := newIAMAdminAccessCommand()
 	iamCommand.AddCommand(iamAdminAccessCommand)
@@ -280,6 +353,137 @@ func runIAMVersionCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runIAMExplainCmd(cmd *cobra.Command, args []string) error {
+	if len(iamCmdFlags.subjects) == 0 {
+		return status.New(status.InvalidCommandArgsError, "At least one --subject is required")
+	}
+	req := &policies_req.ExplainAuthorizationReq{
+		Subjects: iamCmdFlags.subjects,
+		Action:   args[0],
+		Resource: args[1],
+		Projects: iamCmdFlags.projects,
+		SourceIp: iamCmdFlags.sourceIP,
+	}
+	for _, path := range iamCmdFlags.policyFiles {
+		pol, err := readPolicyFile(path)
+		if err != nil {
+			return err
+		}
+		req.Policies = append(req.Policies, pol)
+	}
+
+	ctx := context.Background()
+	apiClient, err := apiclient.OpenConnection(ctx)
+	if err != nil {
+		return status.Wrap(err, status.APIUnreachableError,
+			"Failed to create a connection to the API")
+	}
+
+	resp, err := apiClient.PoliciesClient().ExplainAuthorization(ctx, req)
+	if err != nil {
+		return status.Wrap(err, status.APIError, "Failed to explain authorization decision")
+	}
+
+	if resp.Authorized {
+		writer.Successf("%s is allowed on %s", req.Action, req.Resource)
+	} else {
+		writer.Failf("%s is not allowed on %s", req.Action, req.Resource)
+	}
+	if len(resp.Projects) > 0 {
+		writer.Printf("Allowed projects: %s\n", strings.Join(resp.Projects, ", "))
+	}
+	if len(resp.Statements) == 0 {
+		writer.Println("No policy statements match the request.")
+		return nil
+	}
+	writer.Println("Matching policy statements:")
+	for _, st := range resp.Statements {
+		writer.Printf("  %s: policy %q, statement %s\n",
+			st.Statement.Effect, st.PolicyId, st.StatementId)
+		if st.Statement.Role != "" {
+			writer.Printf("    role: %s\n", st.Statement.Role)
+		}
+		if len(st.Statement.Actions) > 0 {
+			writer.Printf("    actions: %s\n", strings.Join(st.Statement.Actions, ", "))
+		}
+		writer.Printf("    resources: %s\n", strings.Join(st.Statement.Resources, ", "))
+		if len(st.Projects) > 0 {
+			writer.Printf("    projects: %s\n", strings.Join(st.Projects, ", "))
+		}
+	}
+	return nil
+}
+
+func readPolicyFile(path string) (*policies_common.Policy, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, status.Wrapf(err, status.FileAccessError, "Failed to open policy file %s", path)
+	}
+	defer f.Close() // nolint: errcheck
+
+	var pol policies_common.Policy
+	if err := jsonpb.Unmarshal(f, &pol); err != nil {
+		return nil, status.Wrapf(err, status.MarshalError, "Failed to parse policy file %s", path)
+	}
+	return &pol, nil
+}
+
+func runIAMExportCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	apiClient, err := apiclient.OpenConnection(ctx)
+	if err != nil {
+		return status.Wrap(err, status.APIUnreachableError,
+			"Failed to create a connection to the API")
+	}
+
+	doc, err := iamstate.Export(ctx, apiClient)
+	if err != nil {
+		return err
+	}
+	if err := iamstate.Write(args[0], doc); err != nil {
+		return err
+	}
+	writer.Successf("Exported %d policies, %d roles, %d projects, and %d teams to %s",
+		len(doc.Policies), len(doc.Roles), len(doc.Projects), len(doc.Teams), args[0])
+	return nil
+}
+
+func runIAMImportCmd(cmd *cobra.Command, args []string) error {
+	doc, err := iamstate.Read(args[0])
+	if err != nil {
+		return err
+	}
+	if iamCmdFlags.dryRun {
+		writer.Title("Dry run: showing the changes the import would make without making them")
+	} else {
+		writer.Titlef("Importing IAM state from %s", args[0])
+	}
+
+	ctx := context.Background()
+	apiClient, err := apiclient.OpenConnection(ctx)
+	if err != nil {
+		return status.Wrap(err, status.APIUnreachableError,
+			"Failed to create a connection to the API")
+	}
+
+	changes, err := iamstate.Import(ctx, apiClient, doc, iamCmdFlags.dryRun)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		writer.Println("No changes needed.")
+		return nil
+	}
+	for _, c := range changes {
+		if c.Operation == "SKIP" {
+			writer.Skippedf("%s %s: %s", c.Kind, c.ID, c.Reason)
+		} else {
+			writer.Successf("%s %s %s", strings.ToLower(c.Operation), c.Kind, c.ID)
+		}
+	}
+	return nil
+}
+
 func runRestoreDefaultAdminAccessAdminCmd(cmd *cobra.Command, args []string) error {
 	if iamCmdFlags.dryRun {
 		writer.Title("Dry run: showing all actions needed to restore default admin access without performing any changes\n")
@@ -89,6 +89,7 @@ func CreateMockConn(t *testing.T) (client.APIClient, ServerMocks, error) {
 			AuthzMock:    mockAuthz,
 			PoliciesMock: mockPolicies,
 			TeamsMock:    mockTeams,
+			TeamsV2Mock:  mockV2Teams,
 			TokensMock:   mockTokens,
 			TokensV2Mock: mockV2Tokens,
 			UsersMock:    mockUsers,
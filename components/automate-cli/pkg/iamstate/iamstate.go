@@ -0,0 +1,445 @@
+// Package iamstate exports the IAM v2 state -- policies, roles, projects,
+// and team memberships -- to a versioned document, and imports it again.
+package iamstate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pelletier/go-toml"
+	"google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+
+	"github.com/chef/automate/components/automate-cli/pkg/client"
+	"github.com/chef/automate/components/automate-cli/pkg/status"
+	policies_common "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/common"
+	policies_req "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/request"
+)
+
+// Version is the version of the document format. Documents of other versions
+// are rejected on import.
+const Version = 1
+
+const (
+	chefManagedType = "chef-managed"
+	customType      = "custom"
+
+	// TeamMemberKind is the kind of the changes to team memberships
+	TeamMemberKind = "team member"
+)
+
+// Document is the IAM v2 state. It is written as JSON, or as TOML if the
+// file name ends in .toml.
+type Document struct {
+	Version  int       `json:"version" toml:"version"`
+	Policies []Policy  `json:"policies" toml:"policies,omitempty"`
+	Roles    []Role    `json:"roles" toml:"roles,omitempty"`
+	Projects []Project `json:"projects" toml:"projects,omitempty"`
+	Teams    []Team    `json:"teams" toml:"teams,omitempty"`
+}
+
+// Policy is an IAM v2 policy.
+type Policy struct {
+	ID         string      `json:"id" toml:"id"`
+	Name       string      `json:"name" toml:"name"`
+	Type       string      `json:"type" toml:"type"`
+	Members    []string    `json:"members" toml:"members"`
+	Statements []Statement `json:"statements" toml:"statements"`
+	Projects   []string    `json:"projects" toml:"projects"`
+}
+
+// Statement is a statement of a policy.
+type Statement struct {
+	Effect     string      `json:"effect" toml:"effect"`
+	Role       string      `json:"role,omitempty" toml:"role,omitempty"`
+	Actions    []string    `json:"actions,omitempty" toml:"actions,omitempty"`
+	Resources  []string    `json:"resources,omitempty" toml:"resources,omitempty"`
+	Projects   []string    `json:"projects" toml:"projects"`
+	Conditions *Conditions `json:"conditions,omitempty" toml:"conditions,omitempty"`
+}
+
+// Conditions restrict when a statement applies.
+type Conditions struct {
+	TimeWindows  []TimeWindow `json:"time_windows,omitempty" toml:"time_windows,omitempty"`
+	SourceIPs    []string     `json:"source_ips,omitempty" toml:"source_ips,omitempty"`
+	SubjectTypes []string     `json:"subject_types,omitempty" toml:"subject_types,omitempty"`
+}
+
+// TimeWindow is the time span a statement applies in.
+type TimeWindow struct {
+	Start time.Time `json:"start" toml:"start"`
+	End   time.Time `json:"end" toml:"end"`
+}
+
+// Role is an IAM v2 role.
+type Role struct {
+	ID       string   `json:"id" toml:"id"`
+	Name     string   `json:"name" toml:"name"`
+	Type     string   `json:"type" toml:"type"`
+	Actions  []string `json:"actions" toml:"actions"`
+	Projects []string `json:"projects" toml:"projects"`
+}
+
+// Project is an IAM v2 project.
+type Project struct {
+	ID   string `json:"id" toml:"id"`
+	Name string `json:"name" toml:"name"`
+	Type string `json:"type" toml:"type"`
+}
+
+// Team holds the IDs of the users that are members of a team. Teams
+// themselves are not part of the IAM state, so only the memberships of teams
+// that exist are imported.
+type Team struct {
+	ID      string   `json:"id" toml:"id"`
+	Members []string `json:"members" toml:"members"`
+}
+
+// Change is a change an import makes, or would make on a dry run.
+type Change struct {
+	// CREATE, UPDATE, DELETE, or SKIP
+	Operation string
+	// project, role, policy, or team member
+	Kind string
+	ID   string
+	// why the change is skipped
+	Reason string
+}
+
+func (c Change) String() string {
+	if c.Reason != "" {
+		return fmt.Sprintf("%s %s %s (%s)", c.Operation, c.Kind, c.ID, c.Reason)
+	}
+	return fmt.Sprintf("%s %s %s", c.Operation, c.Kind, c.ID)
+}
+
+// Export retrieves the IAM v2 state.
+func Export(ctx context.Context, apiClient client.APIClient) (*Document, error) {
+	resp, err := apiClient.PoliciesClient().ExportState(ctx, &policies_req.ExportStateReq{})
+	if err != nil {
+		return nil, status.Wrap(err, status.APIError, "Failed to export IAM policies, roles, and projects")
+	}
+
+	doc := Document{Version: Version}
+	for _, pol := range resp.Policies {
+		doc.Policies = append(doc.Policies, policyFromAPI(pol))
+	}
+	for _, role := range resp.Roles {
+		doc.Roles = append(doc.Roles, Role{
+			ID:       role.Id,
+			Name:     role.Name,
+			Type:     typeFromAPI(role.Type),
+			Actions:  role.Actions,
+			Projects: role.Projects,
+		})
+	}
+	for _, project := range resp.Projects {
+		doc.Projects = append(doc.Projects, Project{
+			ID:   project.Id,
+			Name: project.Name,
+			Type: typeFromAPI(project.Type),
+		})
+	}
+
+	teamsResp, err := apiClient.TeamsV2Client().GetTeams(ctx, &policies_req.GetTeamsReq{})
+	if err != nil {
+		return nil, status.Wrap(err, status.APIError, "Failed to retrieve teams")
+	}
+	for _, team := range teamsResp.Teams {
+		members, err := teamMembers(ctx, apiClient, team.Id)
+		if err != nil {
+			return nil, err
+		}
+		doc.Teams = append(doc.Teams, Team{ID: team.Id, Members: members})
+	}
+	sort.Slice(doc.Teams, func(i, j int) bool { return doc.Teams[i].ID < doc.Teams[j].ID })
+
+	return &doc, nil
+}
+
+// Import makes the IAM v2 state match the document: objects missing from it
+// are deleted, chef-managed objects are left alone. The memberships of the
+// document's teams are replaced; teams it doesn't mention are left alone.
+// On a dry run, the changes are only reported.
+func Import(ctx context.Context, apiClient client.APIClient, doc *Document, dryRun bool) ([]Change, error) {
+	if doc.Version != Version {
+		return nil, status.Errorf(status.InvalidCommandArgsError,
+			"Unsupported IAM state version %d, expected %d", doc.Version, Version)
+	}
+
+	// team memberships are checked first, so that no changes are made if any
+	// of the teams are missing
+	var memberChanges []Change
+	toAdd := map[string][]string{}
+	toRemove := map[string][]string{}
+	for _, team := range doc.Teams {
+		current, err := teamMembers(ctx, apiClient, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		added, removed := diff(current, team.Members)
+		for _, id := range added {
+			memberChanges = append(memberChanges, Change{Operation: "CREATE", Kind: TeamMemberKind, ID: team.ID + "/" + id})
+		}
+		for _, id := range removed {
+			memberChanges = append(memberChanges, Change{Operation: "DELETE", Kind: TeamMemberKind, ID: team.ID + "/" + id})
+		}
+		toAdd[team.ID], toRemove[team.ID] = added, removed
+	}
+
+	req, err := importReq(doc)
+	if err != nil {
+		return nil, err
+	}
+	req.DryRun = dryRun
+	resp, err := apiClient.PoliciesClient().ImportState(ctx, req)
+	if err != nil {
+		return nil, status.Wrap(err, status.APIError, "Failed to import IAM policies, roles, and projects")
+	}
+	changes := make([]Change, len(resp.Changes))
+	for i, c := range resp.Changes {
+		changes[i] = Change{Operation: c.Operation.String(), Kind: c.Kind, ID: c.Id, Reason: c.Reason}
+	}
+
+	if !dryRun {
+		for _, team := range doc.Teams {
+			if len(toAdd[team.ID]) > 0 {
+				_, err := apiClient.TeamsV2Client().AddTeamMembers(ctx, &policies_req.AddTeamMembersReq{
+					Id:      team.ID,
+					UserIds: toAdd[team.ID],
+				})
+				if err != nil {
+					return nil, status.Wrapf(err, status.APIError, "Failed to add members to team %s", team.ID)
+				}
+			}
+			if len(toRemove[team.ID]) > 0 {
+				_, err := apiClient.TeamsV2Client().RemoveTeamMembers(ctx, &policies_req.RemoveTeamMembersReq{
+					Id:      team.ID,
+					UserIds: toRemove[team.ID],
+				})
+				if err != nil {
+					return nil, status.Wrapf(err, status.APIError, "Failed to remove members from team %s", team.ID)
+				}
+			}
+		}
+	}
+
+	return append(changes, memberChanges...), nil
+}
+
+// Read reads a document from a JSON or TOML file.
+func Read(path string) (*Document, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, status.Wrapf(err, status.FileAccessError, "Failed to read IAM state file %s", path)
+	}
+
+	var doc Document
+	if isTOML(path) {
+		err = toml.Unmarshal(data, &doc)
+	} else {
+		err = json.Unmarshal(data, &doc)
+	}
+	if err != nil {
+		return nil, status.Wrapf(err, status.MarshalError, "Failed to parse IAM state file %s", path)
+	}
+	return &doc, nil
+}
+
+// Write writes a document to a JSON or TOML file.
+func Write(path string, doc *Document) error {
+	var data []byte
+	var err error
+	if isTOML(path) {
+		data, err = toml.Marshal(*doc)
+	} else {
+		data, err = json.MarshalIndent(doc, "", "  ")
+	}
+	if err != nil {
+		return status.Wrap(err, status.MarshalError, "Failed to serialize IAM state")
+	}
+
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return status.Wrapf(err, status.FileAccessError, "Failed to write IAM state file %s", path)
+	}
+	return nil
+}
+
+func isTOML(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".toml"
+}
+
+func teamMembers(ctx context.Context, apiClient client.APIClient, teamID string) ([]string, error) {
+	resp, err := apiClient.TeamsV2Client().GetTeamMembership(ctx, &policies_req.GetTeamMembershipReq{Id: teamID})
+	switch grpc_status.Convert(err).Code() {
+	case codes.OK:
+		members := append([]string{}, resp.UserIds...)
+		sort.Strings(members)
+		return members, nil
+	case codes.NotFound:
+		return nil, status.Errorf(status.InvalidCommandArgsError, "Team %s does not exist", teamID)
+	default:
+		return nil, status.Wrapf(err, status.APIError, "Failed to retrieve members of team %s", teamID)
+	}
+}
+
+// diff returns the elements of desired that are not in current, and those of
+// current that are not in desired.
+func diff(current, desired []string) (added, removed []string) {
+	inCurrent := map[string]bool{}
+	for _, id := range current {
+		inCurrent[id] = true
+	}
+	inDesired := map[string]bool{}
+	for _, id := range desired {
+		if !inCurrent[id] && !inDesired[id] {
+			added = append(added, id)
+		}
+		inDesired[id] = true
+	}
+	for _, id := range current {
+		if !inDesired[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(added)
+	return added, removed
+}
+
+func importReq(doc *Document) (*policies_req.ImportStateReq, error) {
+	req := policies_req.ImportStateReq{}
+	for _, pol := range doc.Policies {
+		apiPol, err := policyToAPI(pol)
+		if err != nil {
+			return nil, status.Wrapf(err, status.InvalidCommandArgsError, "Invalid policy %s", pol.ID)
+		}
+		req.Policies = append(req.Policies, apiPol)
+	}
+	for _, role := range doc.Roles {
+		t, err := typeToAPI(role.Type)
+		if err != nil {
+			return nil, status.Wrapf(err, status.InvalidCommandArgsError, "Invalid role %s", role.ID)
+		}
+		req.Roles = append(req.Roles, &policies_common.Role{
+			Id:       role.ID,
+			Name:     role.Name,
+			Type:     t,
+			Actions:  role.Actions,
+			Projects: role.Projects,
+		})
+	}
+	for _, project := range doc.Projects {
+		t, err := typeToAPI(project.Type)
+		if err != nil {
+			return nil, status.Wrapf(err, status.InvalidCommandArgsError, "Invalid project %s", project.ID)
+		}
+		req.Projects = append(req.Projects, &policies_common.Project{
+			Id:   project.ID,
+			Name: project.Name,
+			Type: t,
+		})
+	}
+	return &req, nil
+}
+
+func policyFromAPI(pol *policies_common.Policy) Policy {
+	statements := make([]Statement, len(pol.Statements))
+	for i, st := range pol.Statements {
+		statements[i] = Statement{
+			Effect:    strings.ToLower(st.Effect.String()),
+			Role:      st.Role,
+			Actions:   st.Actions,
+			Resources: st.Resources,
+			Projects:  st.Projects,
+		}
+		if c := st.Conditions; c != nil {
+			conditions := Conditions{SourceIPs: c.SourceIps, SubjectTypes: c.SubjectTypes}
+			for _, w := range c.TimeWindows {
+				// the timestamps come from the service, so they are valid
+				start, _ := ptypes.Timestamp(w.Start)
+				end, _ := ptypes.Timestamp(w.End)
+				conditions.TimeWindows = append(conditions.TimeWindows, TimeWindow{Start: start, End: end})
+			}
+			statements[i].Conditions = &conditions
+		}
+	}
+	return Policy{
+		ID:         pol.Id,
+		Name:       pol.Name,
+		Type:       typeFromAPI(pol.Type),
+		Members:    pol.Members,
+		Statements: statements,
+		Projects:   pol.Projects,
+	}
+}
+
+func policyToAPI(pol Policy) (*policies_common.Policy, error) {
+	t, err := typeToAPI(pol.Type)
+	if err != nil {
+		return nil, err
+	}
+	statements := make([]*policies_common.Statement, len(pol.Statements))
+	for i, st := range pol.Statements {
+		effect, ok := policies_common.Statement_Effect_value[strings.ToUpper(st.Effect)]
+		if !ok {
+			return nil, fmt.Errorf("invalid statement effect %q", st.Effect)
+		}
+		statements[i] = &policies_common.Statement{
+			Effect:    policies_common.Statement_Effect(effect),
+			Role:      st.Role,
+			Actions:   st.Actions,
+			Resources: st.Resources,
+			Projects:  st.Projects,
+		}
+		if c := st.Conditions; c != nil {
+			conditions := policies_common.Conditions{SourceIps: c.SourceIPs, SubjectTypes: c.SubjectTypes}
+			for _, w := range c.TimeWindows {
+				start, err := ptypes.TimestampProto(w.Start)
+				if err != nil {
+					return nil, err
+				}
+				end, err := ptypes.TimestampProto(w.End)
+				if err != nil {
+					return nil, err
+				}
+				conditions.TimeWindows = append(conditions.TimeWindows,
+					&policies_common.TimeWindow{Start: start, End: end})
+			}
+			statements[i].Conditions = &conditions
+		}
+	}
+	return &policies_common.Policy{
+		Id:         pol.ID,
+		Name:       pol.Name,
+		Type:       t,
+		Members:    pol.Members,
+		Statements: statements,
+		Projects:   pol.Projects,
+	}, nil
+}
+
+func typeFromAPI(t policies_common.Type) string {
+	if t == policies_common.Type_CHEF_MANAGED {
+		return chefManagedType
+	}
+	return customType
+}
+
+// typeToAPI defaults to custom: only custom objects can be imported.
+func typeToAPI(t string) (policies_common.Type, error) {
+	switch t {
+	case chefManagedType:
+		return policies_common.Type_CHEF_MANAGED, nil
+	case customType, "":
+		return policies_common.Type_CUSTOM, nil
+	default:
+		return 0, fmt.Errorf("invalid type %q", t)
+	}
+}
@@ -0,0 +1,289 @@
+package iamstate_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chef/automate/components/automate-cli/pkg/client/mock"
+	"github.com/chef/automate/components/automate-cli/pkg/iamstate"
+	policies_common "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/common"
+	policies_req "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/request"
+	policies_resp "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/response"
+)
+
+func TestReadWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "iamstate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	doc := testDocument()
+	for _, name := range []string{"state.json", "state.toml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			require.NoError(t, iamstate.Write(path, &doc))
+
+			read, err := iamstate.Read(path)
+			require.NoError(t, err)
+			assert.Equal(t, doc, *read)
+		})
+	}
+
+	t.Run("invalid file", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+		_, err := iamstate.Read(path)
+		assert.Error(t, err)
+	})
+}
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	apiClient, serverMocks, err := mock.CreateMockConn(t)
+	require.NoError(t, err)
+	defer apiClient.CloseConnection()
+
+	start, err := ptypes.TimestampProto(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	end, err := ptypes.TimestampProto(time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	serverMocks.PoliciesMock.ExportStateFunc = func(
+		context.Context, *policies_req.ExportStateReq) (*policies_resp.ExportStateResp, error) {
+		return &policies_resp.ExportStateResp{
+			Policies: []*policies_common.Policy{{
+				Id:      "my-policy",
+				Name:    "My Policy",
+				Type:    policies_common.Type_CUSTOM,
+				Members: []string{"team:local:my-team"},
+				Statements: []*policies_common.Statement{{
+					Effect:    policies_common.Statement_DENY,
+					Actions:   []string{"iam:users:delete"},
+					Resources: []string{"*"},
+					Projects:  []string{"~~ALL-PROJECTS~~"},
+					Conditions: &policies_common.Conditions{
+						TimeWindows: []*policies_common.TimeWindow{{Start: start, End: end}},
+						SourceIps:   []string{"10.0.0.0/8"},
+					},
+				}},
+			}},
+			Roles: []*policies_common.Role{{
+				Id:      "owner",
+				Name:    "Owner",
+				Type:    policies_common.Type_CHEF_MANAGED,
+				Actions: []string{"*"},
+			}},
+			Projects: []*policies_common.Project{{
+				Id:   "my-project",
+				Name: "My Project",
+				Type: policies_common.Type_CUSTOM,
+			}},
+		}, nil
+	}
+	serverMocks.TeamsV2Mock.GetTeamsFunc = func(
+		context.Context, *policies_req.GetTeamsReq) (*policies_resp.GetTeamsResp, error) {
+		return &policies_resp.GetTeamsResp{Teams: []*policies_common.Team{{Id: "my-team"}, {Id: "admins"}}}, nil
+	}
+	serverMocks.TeamsV2Mock.GetTeamMembershipFunc = membershipFunc(map[string][]string{
+		"admins":  {"admin-id"},
+		"my-team": {"bob-id", "alice-id"},
+	})
+
+	doc, err := iamstate.Export(ctx, apiClient)
+	require.NoError(t, err)
+	assert.Equal(t, iamstate.Document{
+		Version: iamstate.Version,
+		Policies: []iamstate.Policy{{
+			ID:      "my-policy",
+			Name:    "My Policy",
+			Type:    "custom",
+			Members: []string{"team:local:my-team"},
+			Statements: []iamstate.Statement{{
+				Effect:    "deny",
+				Actions:   []string{"iam:users:delete"},
+				Resources: []string{"*"},
+				Projects:  []string{"~~ALL-PROJECTS~~"},
+				Conditions: &iamstate.Conditions{
+					TimeWindows: []iamstate.TimeWindow{{
+						Start: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+						End:   time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC),
+					}},
+					SourceIPs: []string{"10.0.0.0/8"},
+				},
+			}},
+		}},
+		Roles: []iamstate.Role{{
+			ID:      "owner",
+			Name:    "Owner",
+			Type:    "chef-managed",
+			Actions: []string{"*"},
+		}},
+		Projects: []iamstate.Project{{ID: "my-project", Name: "My Project", Type: "custom"}},
+		Teams: []iamstate.Team{
+			{ID: "admins", Members: []string{"admin-id"}},
+			{ID: "my-team", Members: []string{"alice-id", "bob-id"}},
+		},
+	}, *doc)
+}
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	apiClient, serverMocks, err := mock.CreateMockConn(t)
+	require.NoError(t, err)
+	defer apiClient.CloseConnection()
+
+	var importReq *policies_req.ImportStateReq
+	serverMocks.PoliciesMock.ImportStateFunc = func(
+		_ context.Context, req *policies_req.ImportStateReq) (*policies_resp.ImportStateResp, error) {
+		importReq = req
+		return &policies_resp.ImportStateResp{Changes: []*policies_common.StateChange{
+			{Operation: policies_common.StateChange_CREATE, Kind: "project", Id: "my-project"},
+			{Operation: policies_common.StateChange_SKIP, Kind: "role", Id: "owner", Reason: "chef-managed"},
+		}}, nil
+	}
+	serverMocks.TeamsV2Mock.GetTeamMembershipFunc = membershipFunc(map[string][]string{
+		"my-team": {"carol-id", "alice-id"},
+	})
+	added := map[string][]string{}
+	serverMocks.TeamsV2Mock.AddTeamMembersFunc = func(
+		_ context.Context, req *policies_req.AddTeamMembersReq) (*policies_resp.AddTeamMembersResp, error) {
+		added[req.Id] = req.UserIds
+		return &policies_resp.AddTeamMembersResp{}, nil
+	}
+	removed := map[string][]string{}
+	serverMocks.TeamsV2Mock.RemoveTeamMembersFunc = func(
+		_ context.Context, req *policies_req.RemoveTeamMembersReq) (*policies_resp.RemoveTeamMembersResp, error) {
+		removed[req.Id] = req.UserIds
+		return &policies_resp.RemoveTeamMembersResp{}, nil
+	}
+	reset := func() {
+		importReq = nil
+		added = map[string][]string{}
+		removed = map[string][]string{}
+	}
+
+	expectedChanges := []string{
+		"CREATE project my-project",
+		"SKIP role owner (chef-managed)",
+		"CREATE team member my-team/bob-id",
+		"DELETE team member my-team/carol-id",
+	}
+
+	t.Run("on a dry run, reports the changes without making them", func(t *testing.T) {
+		reset()
+		doc := testDocument()
+		changes, err := iamstate.Import(ctx, apiClient, &doc, true)
+		require.NoError(t, err)
+		assert.Equal(t, expectedChanges, changeStrings(changes))
+		require.NotNil(t, importReq)
+		assert.True(t, importReq.DryRun)
+		assert.Empty(t, added)
+		assert.Empty(t, removed)
+	})
+
+	t.Run("makes the changes", func(t *testing.T) {
+		reset()
+		doc := testDocument()
+		changes, err := iamstate.Import(ctx, apiClient, &doc, false)
+		require.NoError(t, err)
+		assert.Equal(t, expectedChanges, changeStrings(changes))
+		require.NotNil(t, importReq)
+		assert.False(t, importReq.DryRun)
+		require.Equal(t, 1, len(importReq.Policies))
+		assert.Equal(t, policies_common.Statement_ALLOW, importReq.Policies[0].Statements[0].Effect)
+		assert.Equal(t, []string{"my-project"}, importReq.Policies[0].Statements[0].Projects)
+		assert.Equal(t, policies_common.Type_CHEF_MANAGED, importReq.Roles[0].Type)
+		assert.Equal(t, map[string][]string{"my-team": {"bob-id"}}, added)
+		assert.Equal(t, map[string][]string{"my-team": {"carol-id"}}, removed)
+	})
+
+	t.Run("fails without making changes if a team doesn't exist", func(t *testing.T) {
+		reset()
+		doc := testDocument()
+		doc.Teams = append(doc.Teams, iamstate.Team{ID: "missing", Members: []string{"dan-id"}})
+		_, err := iamstate.Import(ctx, apiClient, &doc, false)
+		assert.Error(t, err)
+		assert.Nil(t, importReq)
+		assert.Empty(t, added)
+	})
+
+	t.Run("fails on unsupported versions", func(t *testing.T) {
+		reset()
+		doc := testDocument()
+		doc.Version = iamstate.Version + 1
+		_, err := iamstate.Import(ctx, apiClient, &doc, false)
+		assert.Error(t, err)
+		assert.Nil(t, importReq)
+	})
+
+	t.Run("fails on invalid statement effects", func(t *testing.T) {
+		reset()
+		doc := testDocument()
+		doc.Policies[0].Statements[0].Effect = "maybe"
+		_, err := iamstate.Import(ctx, apiClient, &doc, false)
+		assert.Error(t, err)
+		assert.Nil(t, importReq)
+	})
+}
+
+func testDocument() iamstate.Document {
+	return iamstate.Document{
+		Version: iamstate.Version,
+		Policies: []iamstate.Policy{{
+			ID:      "my-policy",
+			Name:    "My Policy",
+			Type:    "custom",
+			Members: []string{"team:local:my-team"},
+			Statements: []iamstate.Statement{{
+				Effect:    "allow",
+				Role:      "owner",
+				Resources: []string{"*"},
+				Projects:  []string{"my-project"},
+				Conditions: &iamstate.Conditions{
+					TimeWindows: []iamstate.TimeWindow{{
+						Start: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+						End:   time.Date(2019, 2, 1, 0, 0, 0, 0, time.UTC),
+					}},
+					SubjectTypes: []string{"user"},
+				},
+			}},
+			Projects: []string{},
+		}},
+		Roles: []iamstate.Role{{
+			ID:       "owner",
+			Name:     "Owner",
+			Type:     "chef-managed",
+			Actions:  []string{"*"},
+			Projects: []string{},
+		}},
+		Projects: []iamstate.Project{{ID: "my-project", Name: "My Project", Type: "custom"}},
+		Teams:    []iamstate.Team{{ID: "my-team", Members: []string{"alice-id", "bob-id"}}},
+	}
+}
+
+func membershipFunc(members map[string][]string) func(context.Context,
+	*policies_req.GetTeamMembershipReq) (*policies_resp.GetTeamMembershipResp, error) {
+	return func(_ context.Context, req *policies_req.GetTeamMembershipReq) (*policies_resp.GetTeamMembershipResp, error) {
+		ids, ok := members[req.Id]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "team %q not found", req.Id)
+		}
+		return &policies_resp.GetTeamMembershipResp{UserIds: ids}, nil
+	}
+}
+
+func changeStrings(changes []iamstate.Change) []string {
+	strs := make([]string, len(changes))
+	for i, c := range changes {
+		strs[i] = c.String()
+	}
+	return strs
+}
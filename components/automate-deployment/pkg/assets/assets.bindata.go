@@ -176,7 +176,7 @@ func dataA1stub_certsChefautomateupgradefromv1selftestKey() (*asset, error) {
 	return a, nil
 }
 
-var _dataBindsTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xd1\x72\xdb\x20\x10\x7c\xcf\x57\xe8\x07\xf8\x88\x76\x92\xe9\x78\xa6\x8d\xdb\x4c\xfb\xdc\xc1\xe8\x24\x31\x46\xa0\x02\x8a\xed\x7c\x7d\xc7\x8d\x2d\x09\xb1\x20\xd9\xe9\x5b\x72\x86\xdd\xe5\xb8\xdb\x43\xbc\xeb\x94\x14\xdc\x4b\xa3\x1d\x73\x64\x5f\xa5\xa0\xe2\xe5\xe9\xc7\xaf\xcd\xcb\xd3\x63\xc1\x7b\x6f\x5a\xee\x89\x75\x35\xab\xb9\xa7\x03\x3f\x15\xf4\x4a\xda\x0f\x4b\xbb\x9a\x39\x59\x92\xe0\xf6\x1a\x7a\x80\x90\x9f\x37\xcf\x8f\x9b\xe7\x2f\xbf\xbf\x6d\x1f\x9f\x0a\xe7\xad\x14\xfe\x81\xf7\xbe\xd1\x90\xb4\x79\x1b\xc2\x83\x84\x92\x8e\x50\x4f\xac\xa0\xf0\xc4\x5b\x37\xea\x09\x68\x52\x42\xde\xfe\xd7\xe9\x03\xac\x04\xdb\x3b\xac\x70\x6c\x67\xcc\xde\x35\xa4\xaa\x3c\x2b\xe6\x01\x28\x4b\x7c\xba\x96\xfa\x08\xb8\x02\x90\x69\xd4\x08\x46\x56\x34\x34\x89\x92\x1b\x74\x0d\xb1\x4b\x00\x70\x2d\x29\x32\x82\xed\x64\x65\x8d\xf3\x1f\x4a\xc1\x04\x66\x05\xe3\xe5\x48\xb7\xe5\xe1\x8a\xbf\x26\x11\x77\x1e\xe1\xa2\x0b\x9d\xa0\xa4\x4e\x99\x53\x3b\xad\xbe\xec\x41\xcf\xed\x72\x77\x42\xcf\x9b\xb3\xe8\xa4\xb8\xf3\x52\x38\xe2\x56\x34\x23\xcf\x8e\x8b\x7d\xdf\xc5\xd5\x10\x2e\xcf\x23\x8f\x49\x8d\xe5\x07\x38\x70\x4f\x80\x6d\x49\xf1\x23\x95\x0f\xd1\xed\x6c\xbf\xff\xdc\x6c\x9f\x3f\x7d\x2d\x42\x6f\x08\x7b\x57\x98\xb6\x53\x92\x6b\x41\x93\x90\xae\x64\xcd\xda\xba\x1d\x6f\x01\x5c\x4c\x68\x12\x52\xd7\xe4\xc6\x7f\x95\x14\xa4\x1d\x31\x61\xb4\xb7\x46\x8d\x71\x23\xb8\x62\xbd\xa3\xd1\xc7\xb4\x29\xa9\xe5\x9a\xd7\x41\xcc\xcb\x2a\xb2\x57\x47\xc2\x92\x77\x69\x07\x0c\xcf\x9f\xbd\x01\x65\x78\xc9\x76\x5c\x9d\x4f\x6e\x83\x54\xcd\x5a\x1b\x5b\x73\xd4\x01\xbd\x1c\xff\x3e\x18\xbb\xaf\x94\x39\x5c\x10\x1c\x39\x27\x8d\x8e\x85\x86\x1a\xf2\x97\x3a\xa9\x69\x50\xf0\xc6\xf9\xda\x92\xfb\xa3\xe0\x86\x05\xe4\x61\x73\x3e\x63\x9d\x35\x2d\xf9\x86\x7a\x37\x49\x17\x9a\x82\x69\xbf\x9c\x40\xe4\x35\xf5\x32\xaf\x65\x96\xe1\x38\x23\xc3\x82\xb3\x28\xb2\xc9\x9d\xeb\x58\xde\x41\xee\x77\x9a\x39\x10\x62\x0d\x5d\x05\x2e\xe9\x25\x53\x72\x67\xb9\xc5\x3f\x83\x56\x5e\x7a\x6e\x20\x73\x4f\xbe\x03\x50\xa3\x82\x77\xc9\xac\x4b\x91\xac\x84\x33\x91\x8b\xc0\xa0\x11\x20\x48\x9c\x90\xd8\xc8\x80\xdd\x0e\x39\x80\x1b\xe0\x98\xe2\x9e\xb3\x8a\xa8\x8c\x61\x11\xc4\x2a\x37\x03\xa0\x49\x6a\x25\x2b\x12\x27\xa1\x40\x46\x41\x6a\x42\x6b\x4e\x21\x20\x32\x70\x21\x0b\xe3\x0a\xa6\x15\xc0\x40\xb6\x7f\xd5\x86\x5c\x2e\x39\xbd\x82\x02\x9d\x01\xa4\x29\x72\x22\x66\x73\xec\x86\x06\x0a\xb5\xcc\x70\x86\xfb\x89\x73\x31\x5f\x8a\x54\xa5\xc6\x29\x5c\x1b\x8f\xd8\x35\x5f\x1d\xe1\x30\x05\x20\x88\x0b\x59\xc2\x87\xbe\x2c\xa2\xae\x40\x0c\x58\x09\xea\xb1\x1b\x1d\x1b\xb0\x23\x54\xc4\x0f\xc0\x72\xe4\x68\x3d\x82\x9d\x3f\x79\x6e\x1d\x41\xf3\xfd\x98\x23\x78\x9f\x00\x8e\xf5\x5f\xa5\x11\x18\x22\x0c\x4a\x6d\xb1\x38\xf3\x7c\x21\x16\x64\xb3\x92\x2b\x76\xed\xa0\xdc\xca\xbf\x01\x00\x00\xff\xff\x7b\x3f\xe1\x49\x27\x10\x00\x00")
+var _dataBindsTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x57\xd1\x8e\xda\x30\x10\x7c\xe7\x2b\xf2\x03\xf9\x88\x56\xa0\x0a\xa9\x85\xf6\xd4\x3e\x57\xc6\xd9\x04\x0b\x27\x4e\x6d\xe7\x80\xfb\xfa\x9a\x1e\x24\xb1\x3d\x0e\x81\xeb\x13\x64\xb3\x9e\xd9\x5d\xef\x8e\x1d\xd6\xb6\x52\x70\x66\x85\x6a\x4c\x6e\x48\xbf\x0a\x4e\xd9\xcb\xea\xc7\xaf\xf5\xcb\x6a\x99\xb1\xce\xaa\x9a\x59\xca\xdb\x2a\xaf\xdc\xef\x91\x9d\x33\x7a\xa5\xc6\xf6\xae\xee\x85\x11\x05\x71\xa6\x6f\xa6\x05\x43\x90\x9f\xd7\x9b\xe5\x7a\xf3\xe5\xf7\xb7\xed\x72\x95\x19\xab\x05\xb7\x0b\x87\xbe\x6f\x20\xe9\xfe\xad\x37\xf7\x21\x14\x74\x82\xf1\xc4\x11\x64\x96\x58\x6d\x86\x78\x3c\x9a\x54\x20\x6f\xff\x2b\x7b\x0f\x2b\xc1\xf6\x0e\xcb\x4d\xbe\x53\xea\x60\xf6\x24\xcb\x69\x56\xcc\x03\x50\xee\xf1\x35\x95\x68\x4e\x80\xcb\x03\x19\x5b\x15\xcf\x49\xf3\x3d\x8d\xac\x64\xfa\xb8\x7a\xdb\xd5\x00\xb8\xee\x45\xe4\x08\x76\xa2\xd4\xca\xd8\x0f\x95\x60\x04\x33\x83\xf1\x9a\xd2\x63\x75\xb8\xe1\xcf\x29\xc4\x93\x29\x5c\xe3\x42\x19\x14\xd4\x4a\x75\xae\xc7\xdd\x37\x99\xe8\x65\x5c\x9e\x2e\xe8\x65\xf1\x24\x3a\x49\x66\xac\xe0\x86\x98\x8b\x78\xe0\xd9\x31\x7e\xe8\xda\xb8\x1b\x7c\xf7\x69\xe4\xa1\xa8\x71\xf8\x1e\x0e\x5c\xe3\x61\x6b\xe7\x7f\xa2\x62\x11\xed\xce\xf6\xfb\xcf\xf5\x76\xf3\xe9\x6b\xe6\x6b\x83\x3f\xbb\x5c\xd5\x4e\xc8\x58\xc3\x69\x64\x6a\x4a\x51\xe5\x75\x55\x0f\xbb\x00\x36\xc6\x17\x09\xd1\x54\x64\x86\x47\xa7\x8d\xd4\x18\xb7\xe3\xaa\xb1\x5a\xc9\xc1\xae\x38\x93\x79\xe7\x1e\x7b\x53\xa3\x0a\xaa\x59\xc3\x2a\xcf\x66\x45\x19\xc9\xab\x21\xae\xc9\x9a\xb4\x02\xfa\xf9\x4f\xee\x80\x54\xac\xc8\x77\x4c\x5e\x32\xd7\x5e\xa9\x82\xd1\xc6\xd2\x1c\x4d\x40\x27\x86\xff\x47\xa5\x0f\xa5\x54\xc7\x2b\x82\x21\x63\x5c\x1e\x71\xa0\x7e\x0c\xd3\x9b\x3a\xea\x69\xd0\xf0\x6e\x62\x2b\x4d\xe6\x8f\x84\x0b\xee\x20\xf7\x8b\xa7\x2b\xd6\x6a\x55\x93\xdd\x53\x67\x46\xe5\x42\xa7\x60\x5a\x2f\x47\x10\xd3\x31\xb9\x6a\x4e\xc6\x12\x54\x38\xae\x48\xef\x70\x09\x8a\x74\x72\xe5\x3c\x96\x77\x90\xe7\x95\x26\x04\x42\xac\xbe\xaa\x40\x97\x4e\xe4\x52\xec\x34\xd3\xf8\x35\x18\xe5\x7b\xd7\x0d\x24\xee\xc9\x7b\x00\x1a\x54\x70\x2f\x09\xa6\x14\x85\x95\x50\x26\x17\x4d\x08\x06\x85\x00\x41\xe2\x82\xc4\x42\x06\xe4\xb6\xaf\x01\x5c\x00\x8f\x29\x66\x59\x5e\x12\x15\xf3\xae\x53\x08\x16\x2b\xdc\x8c\x72\xc6\xdc\x7d\x35\xe3\xb2\x00\xef\x64\x3e\x52\x94\xc4\xcf\x5c\xd2\x1c\xe0\x40\xef\x53\x08\x88\x0c\xec\xf2\x9d\x33\x10\xee\x15\x80\x81\x6c\xff\x5a\x18\x49\x67\xf2\x48\xf4\xba\x3e\x00\x48\x53\xcc\xea\x04\x20\x12\xfe\x6a\x84\x1f\x1c\xad\x0f\xcc\xb4\x9f\x49\x80\xd3\xef\x6e\x5c\xc9\xd0\x15\x45\x95\x3a\xe1\xa1\x6f\x7c\xea\xcf\xf9\x10\xf2\xcf\x77\x00\x82\xb8\x90\x4a\x7d\xe8\x63\x27\x9a\x40\xc4\x80\x23\x41\x23\xfe\x60\x7f\x00\x76\x84\x8a\xf8\x01\xd8\x14\x39\xf2\x47\xb0\xe1\x2d\xec\xd1\x86\x0f\xd7\x63\x0e\xef\xca\x04\x38\xe6\x7f\x28\x47\x60\x88\xd0\x6b\xb5\xbb\xcd\x39\xcd\xe7\x63\x41\x36\x2d\x5c\x2b\xdf\x26\x68\xca\xf3\x2f\x0e\x06\x7b\xa7\xba\x10\x00\x00")
 
 func dataBindsTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -191,7 +191,7 @@ func dataBindsTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/binds.txt", size: 4135, mode: os.FileMode(420), modTime: time.Unix(1554883156, 0)}
+	info := bindataFileInfo{name: "data/binds.txt", size: 4282, mode: os.FileMode(420), modTime: time.Unix(1792320508, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
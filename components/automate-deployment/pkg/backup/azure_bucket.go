@@ -0,0 +1,344 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/chef/automate/lib/httputils"
+)
+
+const (
+	azureAPIVersion = "2018-11-09"
+	// azureBlockSize is the size of the blocks blobs are uploaded in. Blocks
+	// are buffered in memory before being sent.
+	azureBlockSize = 4 * 1024 * 1024
+)
+
+// azureBucket is a Bucket backed by an Azure Blob storage container. It talks
+// to the Blob service REST API using Shared Key authorization, which is also
+// what emulators like Azurite implement.
+type azureBucket struct {
+	client      *http.Client
+	endpoint    string
+	accountName string
+	accountKey  []byte
+	container   string
+	basePath    string
+}
+
+// NewAzureBucket returns a Bucket backed by the given container. The endpoint
+// is the URL of the storage account, for example
+// https://myaccount.blob.core.windows.net
+func NewAzureBucket(container string, basePath string, endpoint string, accountName string, accountKey string) (Bucket, error) {
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding azure storage account key")
+	}
+
+	return &azureBucket{
+		client:      &http.Client{Transport: httputils.NewDefaultTransport()},
+		endpoint:    strings.TrimSuffix(endpoint, "/"),
+		accountName: accountName,
+		accountKey:  key,
+		container:   container,
+		basePath:    basePath,
+	}, nil
+}
+
+type azureBlobList struct {
+	Blobs struct {
+		Blob []struct {
+			Name string `xml:"Name"`
+		} `xml:"Blob"`
+		BlobPrefix []struct {
+			Name string `xml:"Name"`
+		} `xml:"BlobPrefix"`
+	} `xml:"Blobs"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (bkt *azureBucket) blobURL(blobPath string) string {
+	return bkt.endpoint + "/" + bkt.container + "/" + (&url.URL{Path: blobPath}).EscapedPath()
+}
+
+// do signs and sends the request
+func (bkt *azureBucket) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureAPIVersion)
+
+	mac := hmac.New(sha256.New, bkt.accountKey)
+	mac.Write([]byte(bkt.stringToSign(req))) //nolint: errcheck // docs for hash.Hash say this never errors
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", bkt.accountName, signature))
+
+	return bkt.client.Do(req.WithContext(ctx))
+}
+
+// stringToSign builds the Shared Key signature input described in
+// https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (bkt *azureBucket) stringToSign(req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	msHeaders := []string{}
+	for name := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-ms-") {
+			msHeaders = append(msHeaders, name)
+		}
+	}
+	sort.Strings(msHeaders)
+	canonicalHeaders := ""
+	for _, name := range msHeaders {
+		canonicalHeaders += name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n"
+	}
+
+	canonicalResource := "/" + bkt.accountName + req.URL.EscapedPath()
+	query := req.URL.Query()
+	params := []string{}
+	for name := range query {
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	for _, name := range params {
+		values := query[name]
+		sort.Strings(values)
+		canonicalResource += "\n" + strings.ToLower(name) + ":" + strings.Join(values, ",")
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, we send x-ms-date instead
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+	}, "\n") + "\n" + canonicalHeaders + canonicalResource
+}
+
+func (bkt *azureBucket) NewReader(ctx context.Context, name string, verifier ObjectVerifier) (io.ReadCloser, error) {
+	relPath := path.Join(bkt.basePath, name)
+	validatePath(relPath)
+
+	req, err := http.NewRequest("GET", bkt.blobURL(relPath), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := bkt.do(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", relPath)
+	}
+	if err := checkObjectResponse(resp, relPath); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	cksumReader := newChecksummingReader(resp.Body)
+	defer cksumReader.Close()
+	return newVerifiedReader(name, cksumReader, verifier)
+}
+
+// NewWriter uploads the blob in blocks as it is written. The blob is only
+// created when the writer is closed and the block list committed. The blocks
+// of a failed writer are never committed and are garbage collected by Azure.
+func (bkt *azureBucket) NewWriter(ctx context.Context, name string) (BlobWriter, error) {
+	relPath := path.Join(bkt.basePath, name)
+	validatePath(relPath)
+
+	return &azureWriter{
+		ctx:    ctx,
+		bucket: bkt,
+		path:   relPath,
+		sha256: sha256.New(),
+	}, nil
+}
+
+type azureWriter struct {
+	ctx      context.Context
+	bucket   *azureBucket
+	path     string
+	buf      bytes.Buffer
+	blockIDs []string
+	sha256   hash.Hash
+}
+
+func (w *azureWriter) Write(p []byte) (int, error) {
+	w.sha256.Write(p) //nolint: errcheck // docs for hash.Hash say this never errors
+	w.buf.Write(p)    //nolint: errcheck // bytes.Buffer writes never error
+	for w.buf.Len() >= azureBlockSize {
+		if err := w.putBlock(w.buf.Next(azureBlockSize)); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *azureWriter) putBlock(block []byte) error {
+	blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", len(w.blockIDs))))
+	query := url.Values{}
+	query.Set("comp", "block")
+	query.Set("blockid", blockID)
+
+	req, err := http.NewRequest("PUT", w.bucket.blobURL(w.path)+"?"+query.Encode(), bytes.NewReader(block))
+	if err != nil {
+		return err
+	}
+	resp, err := w.bucket.do(w.ctx, req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to upload block of %s", w.path)
+	}
+	defer resp.Body.Close()
+	if err := checkObjectResponse(resp, w.path); err != nil {
+		return err
+	}
+
+	w.blockIDs = append(w.blockIDs, blockID)
+	return nil
+}
+
+func (w *azureWriter) Close() error {
+	if w.buf.Len() > 0 {
+		if err := w.putBlock(w.buf.Next(w.buf.Len())); err != nil {
+			return err
+		}
+	}
+
+	blockList := bytes.NewBufferString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for _, id := range w.blockIDs {
+		blockList.WriteString("<Latest>" + id + "</Latest>")
+	}
+	blockList.WriteString("</BlockList>")
+
+	req, err := http.NewRequest("PUT", w.bucket.blobURL(w.path)+"?comp=blocklist", blockList)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	resp, err := w.bucket.do(w.ctx, req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to commit %s", w.path)
+	}
+	defer resp.Body.Close()
+	return checkObjectResponse(resp, w.path)
+}
+
+func (w *azureWriter) Fail(err error) error {
+	w.buf.Reset()
+	return err
+}
+
+func (w *azureWriter) BlobSHA256() string {
+	return hex.EncodeToString(w.sha256.Sum(nil))
+}
+
+func (bkt *azureBucket) List(ctx context.Context, pathPrefix string, delimited bool) ([]BucketObject, []SharedPrefix, error) {
+	pathPrefix = path.Join(bkt.basePath, pathPrefix)
+	validatePath(pathPrefix)
+
+	prefix := pathPrefix
+	if prefix != "" && prefix[len(prefix)-1] != '/' {
+		prefix = prefix + "/"
+	}
+
+	objs := []BucketObject{}
+	prefixes := []SharedPrefix{}
+
+	query := url.Values{}
+	query.Set("restype", "container")
+	query.Set("comp", "list")
+	query.Set("prefix", prefix)
+	if delimited {
+		query.Set("delimiter", "/")
+	}
+
+	for {
+		req, err := http.NewRequest("GET", bkt.endpoint+"/"+bkt.container+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err := bkt.do(ctx, req)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to list %s", prefix)
+		}
+
+		page := azureBlobList{}
+		err = checkObjectResponse(resp, prefix)
+		if err == nil {
+			err = xml.NewDecoder(resp.Body).Decode(&page)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to list %s", prefix)
+		}
+
+		for _, b := range page.Blobs.Blob {
+			objs = append(objs, BucketObject{
+				Name: strings.TrimPrefix(strings.TrimPrefix(b.Name, bkt.basePath), "/"),
+			})
+		}
+		for _, p := range page.Blobs.BlobPrefix {
+			prefixes = append(prefixes, SharedPrefix(strings.TrimPrefix(strings.TrimPrefix(p.Name, bkt.basePath), "/")))
+		}
+
+		if page.NextMarker == "" {
+			return objs, prefixes, nil
+		}
+		query.Set("marker", page.NextMarker)
+	}
+}
+
+func (bkt *azureBucket) Delete(ctx context.Context, objectPaths []string) error {
+	for _, objectPath := range objectPaths {
+		fullObjectPath := path.Join(bkt.basePath, objectPath)
+		validatePath(fullObjectPath)
+
+		req, err := http.NewRequest("DELETE", bkt.blobURL(fullObjectPath), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := bkt.do(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete objects")
+		}
+		err = checkObjectResponse(resp, fullObjectPath)
+		resp.Body.Close()
+		if err != nil {
+			if IsNotExist(err) {
+				continue
+			}
+			logrus.WithFields(logrus.Fields{
+				"path":   fullObjectPath,
+				"reason": err.Error(),
+			}).Warn("Failed to delete object")
+			return errors.Wrap(err, "failed to delete objects")
+		}
+	}
+
+	return nil
+}
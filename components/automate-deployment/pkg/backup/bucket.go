@@ -4,9 +4,11 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"hash"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
@@ -83,12 +85,38 @@ type Bucket interface {
 // IsNotExist returns true if the error represents a object access against a objects that
 // does not exist
 func IsNotExist(err error) bool {
+	_, objectNotFound := errors.Cause(err).(objectNotFoundError)
 	return os.IsNotExist(err) ||
 		blob.IsNotExist(err) ||
+		objectNotFound ||
 		// blob.IsNotExist returns the wrong answer for minio's NoSuchKey error
 		strings.Contains(err.Error(), "NoSuchKey")
 }
 
+// objectNotFoundError is returned by the buckets that talk to an object
+// storage REST API when the object does not exist
+type objectNotFoundError struct {
+	name string
+}
+
+func (e objectNotFoundError) Error() string {
+	return fmt.Sprintf("object %s does not exist", e.name)
+}
+
+// checkObjectResponse turns a non successful response from an object storage
+// REST API into an error
+func checkObjectResponse(resp *http.Response, name string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return objectNotFoundError{name: name}
+	}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	return errors.Errorf("request for %s failed with %s: %s", name, resp.Status, strings.TrimSpace(string(body)))
+}
+
 type fsBucket struct {
 	basePath string
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -222,6 +223,90 @@ func TestFilesystemBucket(t *testing.T) {
 	})
 }
 
+func TestEncryptedFilesystemBucket(t *testing.T) {
+	suite.Run(t, &BucketTestSuite{
+		beforeTest: func(suite *BucketTestSuite) {
+			tmpDir, err := ioutil.TempDir("", "fs-bucket-test")
+			suite.Require().NoError(err)
+			suite.locationSpec = EncryptedLocationSpecification{
+				LocationSpecification: FilesystemLocationSpecification{Path: tmpDir},
+				Key:                   make([]byte, encryptionKeySize),
+			}
+			suite.afterTest = func(*BucketTestSuite) {
+				os.RemoveAll(tmpDir)
+			}
+		},
+	})
+}
+
+// TestGCSBucket runs against fake-gcs-server or any other GCS JSON API
+// emulator. Set AUTOMATE_BACKUP_TEST_GCS_ENDPOINT to its URL and
+// AUTOMATE_BACKUP_TEST_GCS_BUCKET to an existing bucket to run it, e.g.
+//
+//	docker run -d -p 4443:4443 fsouza/fake-gcs-server -scheme http
+//	curl -X POST -d '{"name":"testing"}' http://localhost:4443/storage/v1/b
+func TestGCSBucket(t *testing.T) {
+	endpoint := os.Getenv("AUTOMATE_BACKUP_TEST_GCS_ENDPOINT")
+	bucketName := os.Getenv("AUTOMATE_BACKUP_TEST_GCS_BUCKET")
+	if endpoint == "" || bucketName == "" {
+		t.Skip("AUTOMATE_BACKUP_TEST_GCS_ENDPOINT and AUTOMATE_BACKUP_TEST_GCS_BUCKET are not set")
+	}
+
+	i := 0
+	suite.Run(t, &BucketTestSuite{
+		beforeTest: func(suite *BucketTestSuite) {
+			i++
+			suite.locationSpec = GCSLocationSpecification{
+				BucketName: bucketName,
+				BasePath:   "testing-" + strconv.Itoa(i),
+				Endpoint:   endpoint,
+			}
+		},
+	})
+}
+
+// TestAzureBucket runs against Azurite. Set AUTOMATE_BACKUP_TEST_AZURE_ENDPOINT
+// to the blob service URL of the account (http://127.0.0.1:10000/devstoreaccount1),
+// AUTOMATE_BACKUP_TEST_AZURE_ACCOUNT_KEY to the account key and
+// AUTOMATE_BACKUP_TEST_AZURE_CONTAINER to an existing container to run it.
+func TestAzureBucket(t *testing.T) {
+	endpoint := os.Getenv("AUTOMATE_BACKUP_TEST_AZURE_ENDPOINT")
+	accountKey := os.Getenv("AUTOMATE_BACKUP_TEST_AZURE_ACCOUNT_KEY")
+	container := os.Getenv("AUTOMATE_BACKUP_TEST_AZURE_CONTAINER")
+	if endpoint == "" || accountKey == "" || container == "" {
+		t.Skip("AUTOMATE_BACKUP_TEST_AZURE_ENDPOINT, AUTOMATE_BACKUP_TEST_AZURE_ACCOUNT_KEY and AUTOMATE_BACKUP_TEST_AZURE_CONTAINER are not set")
+	}
+
+	accountName := os.Getenv("AUTOMATE_BACKUP_TEST_AZURE_ACCOUNT_NAME")
+	if accountName == "" {
+		accountName = "devstoreaccount1"
+	}
+
+	keyFile, err := ioutil.TempFile("", "azure-account-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(keyFile.Name())
+	if _, err := keyFile.WriteString(accountKey + "\n"); err != nil {
+		t.Fatal(err)
+	}
+	keyFile.Close()
+
+	i := 0
+	suite.Run(t, &BucketTestSuite{
+		beforeTest: func(suite *BucketTestSuite) {
+			i++
+			suite.locationSpec = AzureLocationSpecification{
+				ContainerName:  container,
+				BasePath:       "testing-" + strconv.Itoa(i),
+				Endpoint:       endpoint,
+				AccountName:    accountName,
+				AccountKeyPath: keyFile.Name(),
+			}
+		},
+	})
+}
+
 /*
 I'm checking in this commented code because I think it'll be useful if someone ever wants to
 make changes to the S3 implementation. Just spin up minio and set the endpoint to that. The
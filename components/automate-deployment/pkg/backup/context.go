@@ -215,10 +215,10 @@ func (ctx Context) TransactDelete(f TransactFunc) error {
 }
 
 func (ctx Context) DeleteBackupMetadata() error {
-	err := ctx.bucket.Delete(ctx.ctx, []string{metadataChecksumsObjectName, statusObjectName})
+	err := ctx.bucket.Delete(ctx.ctx, []string{metadataChecksumsObjectName, encryptionObjectName, statusObjectName})
 	if err != nil && !IsNotExist(err) {
-		return errors.Wrapf(err, "Failed to delete backup metadata objects [%s, %s]",
-			metadataChecksumsObjectName, statusObjectName)
+		return errors.Wrapf(err, "Failed to delete backup metadata objects [%s, %s, %s]",
+			metadataChecksumsObjectName, encryptionObjectName, statusObjectName)
 	}
 	return nil
 }
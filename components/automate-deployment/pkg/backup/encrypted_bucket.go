@@ -0,0 +1,313 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Encrypted objects are laid out as:
+//
+//	magic | wrapped data key | nonce prefix | segment...
+//
+// Every object is encrypted with its own random data key, which is sealed
+// with the key of the backup. The data is sealed in segments so that objects
+// can be streamed. The nonce of a segment is the nonce prefix followed by the
+// segment counter and a flag marking the last segment, which prevents the
+// segments from being reordered or the object from being truncated.
+const (
+	encryptedObjectMagic = "A2BKENC1"
+	encryptedSegmentSize = 64 * 1024
+	noncePrefixSize      = 7
+)
+
+// EncryptedLocationSpecification is a LocationSpecification whose buckets
+// encrypt the objects written to them and decrypt the objects read from them
+type EncryptedLocationSpecification struct {
+	LocationSpecification
+	Key []byte
+}
+
+// ToBucket returns a bucket that encrypts the objects of the wrapped location
+func (encspec EncryptedLocationSpecification) ToBucket(baseKey string) Bucket {
+	return NewEncryptedBucket(encspec.LocationSpecification.ToBucket(baseKey), encspec.Key)
+}
+
+func (encspec EncryptedLocationSpecification) String() string {
+	return fmt.Sprintf("encrypted %s", encspec.LocationSpecification)
+}
+
+// WithEncryptionKey returns a location specification that encrypts the
+// objects stored in locationSpec with the key. When the key is nil
+// locationSpec is returned as is.
+func WithEncryptionKey(locationSpec LocationSpecification, key []byte) LocationSpecification {
+	if key == nil {
+		return locationSpec
+	}
+	return EncryptedLocationSpecification{LocationSpecification: locationSpec, Key: key}
+}
+
+// encryptedBucket encrypts the objects it stores in the inner bucket. The
+// checksums of the objects are the checksums of their plaintext, so backups
+// are verified the same way whether they are encrypted or not.
+type encryptedBucket struct {
+	inner Bucket
+	aead  cipher.AEAD
+}
+
+// NewEncryptedBucket returns a Bucket that encrypts the objects it stores in
+// the inner bucket with the given 256 bit key
+func NewEncryptedBucket(inner Bucket, key []byte) Bucket {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return errBucket{err: err}
+	}
+	return &encryptedBucket{inner: inner, aead: aead}
+}
+
+// storedInPlaintext returns whether the object is left unencrypted. The
+// objects at the root of the backup and the metadata of the services are
+// needed to list, show and delete backups without the key. They don't hold
+// any secrets and are covered by the checksums of the backup.
+func storedInPlaintext(name string) bool {
+	parts := strings.Split(name, "/")
+	return len(parts) == 1 || (len(parts) == 2 && parts[1] == metadataFileBaseName)
+}
+
+func (bkt *encryptedBucket) NewReader(ctx context.Context, name string, verifier ObjectVerifier) (io.ReadCloser, error) {
+	if storedInPlaintext(name) {
+		return bkt.inner.NewReader(ctx, name, verifier)
+	}
+
+	// The ciphertext is authenticated as it is decrypted, the verifier
+	// checks the plaintext
+	r, err := bkt.inner.NewReader(ctx, name, &NoOpObjectVerifier{})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	dr, err := newDecryptingReader(r, bkt.aead)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decrypt %s", name)
+	}
+
+	cksumReader := newChecksummingReader(ioutil.NopCloser(dr))
+	defer cksumReader.Close()
+	return newVerifiedReader(name, cksumReader, verifier)
+}
+
+func (bkt *encryptedBucket) NewWriter(ctx context.Context, name string) (BlobWriter, error) {
+	w, err := bkt.inner.NewWriter(ctx, name)
+	if err != nil || storedInPlaintext(name) {
+		return w, err
+	}
+
+	ew, err := newEncryptingWriter(w, bkt.aead)
+	if err != nil {
+		return nil, w.Fail(errors.Wrapf(err, "failed to encrypt %s", name))
+	}
+	return ew, nil
+}
+
+func (bkt *encryptedBucket) List(ctx context.Context, pathPrefix string, delimited bool) ([]BucketObject, []SharedPrefix, error) {
+	return bkt.inner.List(ctx, pathPrefix, delimited)
+}
+
+func (bkt *encryptedBucket) Delete(ctx context.Context, objectPaths []string) error {
+	return bkt.inner.Delete(ctx, objectPaths)
+}
+
+type encryptingWriter struct {
+	inner   BlobWriter
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint32
+	buf     []byte
+	sealed  []byte
+	sha256  hash.Hash
+}
+
+func newEncryptingWriter(inner BlobWriter, keyAEAD cipher.AEAD) (*encryptingWriter, error) {
+	dataKey := make([]byte, encryptionKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, errors.Wrap(err, "generating data key")
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keyNonce := make([]byte, keyAEAD.NonceSize())
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, keyNonce); err != nil {
+		return nil, errors.Wrap(err, "generating nonce")
+	}
+	if _, err := io.ReadFull(rand.Reader, nonce[:noncePrefixSize]); err != nil {
+		return nil, errors.Wrap(err, "generating nonce")
+	}
+
+	header := []byte(encryptedObjectMagic)
+	header = append(header, keyNonce...)
+	header = keyAEAD.Seal(header, keyNonce, dataKey, []byte(encryptedObjectMagic))
+	header = append(header, nonce[:noncePrefixSize]...)
+	if _, err := inner.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &encryptingWriter{
+		inner:  inner,
+		aead:   aead,
+		nonce:  nonce,
+		buf:    make([]byte, 0, encryptedSegmentSize),
+		sealed: make([]byte, 0, encryptedSegmentSize+aead.Overhead()),
+		sha256: sha256.New(),
+	}, nil
+}
+
+func (w *encryptingWriter) Write(p []byte) (int, error) {
+	w.sha256.Write(p) //nolint: errcheck // docs for hash.Hash say this never errors
+	w.buf = append(w.buf, p...)
+	// Keep the remainder buffered even when it is a full segment, only Close
+	// knows which segment is the last one
+	for len(w.buf) > encryptedSegmentSize {
+		if err := w.seal(w.buf[:encryptedSegmentSize], false); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[encryptedSegmentSize:]...)
+	}
+	return len(p), nil
+}
+
+func (w *encryptingWriter) seal(segment []byte, last bool) error {
+	if w.counter == math.MaxUint32 {
+		return errors.New("object too large to encrypt")
+	}
+	setSegmentNonce(w.nonce, w.counter, last)
+	w.counter++
+
+	w.sealed = w.aead.Seal(w.sealed[:0], w.nonce, segment, nil)
+	_, err := w.inner.Write(w.sealed)
+	return err
+}
+
+func (w *encryptingWriter) Close() error {
+	if err := w.seal(w.buf, true); err != nil {
+		return w.inner.Fail(err)
+	}
+	return w.inner.Close()
+}
+
+func (w *encryptingWriter) Fail(err error) error {
+	return w.inner.Fail(err)
+}
+
+func (w *encryptingWriter) BlobSHA256() string {
+	return hex.EncodeToString(w.sha256.Sum(nil))
+}
+
+type decryptingReader struct {
+	r       *bufio.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint32
+	segment []byte
+	buf     []byte
+	done    bool
+}
+
+func newDecryptingReader(r io.Reader, keyAEAD cipher.AEAD) (*decryptingReader, error) {
+	header := make([]byte, len(encryptedObjectMagic)+keyAEAD.NonceSize()+encryptionKeySize+keyAEAD.Overhead()+noncePrefixSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.New("the object is not encrypted")
+	}
+	if !bytes.Equal(header[:len(encryptedObjectMagic)], []byte(encryptedObjectMagic)) {
+		return nil, errors.New("the object is not encrypted")
+	}
+	header = header[len(encryptedObjectMagic):]
+
+	keyNonce, header := header[:keyAEAD.NonceSize()], header[keyAEAD.NonceSize():]
+	wrappedKey, noncePrefix := header[:len(header)-noncePrefixSize], header[len(header)-noncePrefixSize:]
+	dataKey, err := keyAEAD.Open(nil, keyNonce, wrappedKey, []byte(encryptedObjectMagic))
+	if err != nil {
+		return nil, ErrEncryptionSecretMismatch
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, noncePrefix)
+	return &decryptingReader{
+		r:       bufio.NewReader(r),
+		aead:    aead,
+		nonce:   nonce,
+		segment: make([]byte, encryptedSegmentSize+aead.Overhead()),
+	}, nil
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptingReader) next() error {
+	n, err := io.ReadFull(d.r, d.segment)
+	last := false
+	switch err {
+	case nil:
+		if _, err := d.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		last = true
+	case io.EOF:
+		return errors.New("the encrypted object is truncated")
+	default:
+		return err
+	}
+
+	setSegmentNonce(d.nonce, d.counter, last)
+	d.counter++
+
+	d.buf, err = d.aead.Open(d.segment[:0], d.nonce, d.segment[:n], nil)
+	if err != nil {
+		return errors.New("the encrypted object failed authentication")
+	}
+	d.done = last
+	return nil
+}
+
+func setSegmentNonce(nonce []byte, counter uint32, last bool) {
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	nonce[len(nonce)-1] = 0
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+}
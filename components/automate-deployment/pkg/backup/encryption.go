@@ -0,0 +1,303 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "github.com/chef/automate/api/interservice/deployment"
+)
+
+const (
+	// encryptionObjectName is the object at the root of an encrypted backup
+	// that records how its key was derived
+	encryptionObjectName = "encryption.json"
+
+	encryptionAlgorithm = "AES-256-GCM"
+	keyDerivationNone   = "none"
+	keyDerivationPBKDF2 = "PBKDF2-HMAC-SHA256"
+
+	encryptionKeySize = 32
+	pbkdf2SaltSize    = 16
+	pbkdf2Iterations  = 100000
+)
+
+// keyCheckPlaintext is sealed with the key of the backup so that a wrong key
+// or passphrase is caught before anything is restored
+var keyCheckPlaintext = []byte("chef-automate-backup")
+
+var (
+	// ErrEncryptionSecretRequired is returned when an encrypted backup is read
+	// without an encryption key or passphrase
+	ErrEncryptionSecretRequired = errors.New("the backup is encrypted, an encryption key or passphrase is required")
+	// ErrEncryptionSecretMismatch is returned when the encryption key or
+	// passphrase isn't the one the backup was encrypted with
+	ErrEncryptionSecretMismatch = errors.New("the encryption key or passphrase does not match the one the backup was encrypted with")
+)
+
+// EncryptionMetadata records how the key of an encrypted backup is derived
+// from the secret given by the user. It never contains the key itself.
+type EncryptionMetadata struct {
+	Algorithm     string `json:"algorithm"`
+	KeyDerivation string `json:"key_derivation"`
+	Salt          []byte `json:"salt,omitempty"`
+	Iterations    int    `json:"iterations,omitempty"`
+	KeyCheck      []byte `json:"key_check"`
+}
+
+// encryptionSecret is the key or passphrase read from the file named by an
+// api.BackupEncryption. It only lives as long as the operation using it.
+type encryptionSecret struct {
+	key        []byte
+	passphrase string
+}
+
+// EncryptionEnabled returns whether the secret asks for backups to be
+// encrypted
+func EncryptionEnabled(enc *api.BackupEncryption) bool {
+	return enc.GetKeyPath() != "" || enc.GetPassphrasePath() != ""
+}
+
+// readEncryptionSecret reads the key or passphrase from the file named by
+// enc. A trailing newline is not part of a passphrase.
+func readEncryptionSecret(enc *api.BackupEncryption) (*encryptionSecret, error) {
+	switch {
+	case enc.GetKeyPath() != "":
+		data, err := ioutil.ReadFile(enc.GetKeyPath())
+		if err != nil {
+			return nil, errors.Wrap(err, "reading the encryption key")
+		}
+		key, err := ParseEncryptionKey(data)
+		if err != nil {
+			return nil, err
+		}
+		return &encryptionSecret{key: key}, nil
+	case enc.GetPassphrasePath() != "":
+		data, err := ioutil.ReadFile(enc.GetPassphrasePath())
+		if err != nil {
+			return nil, errors.Wrap(err, "reading the encryption passphrase")
+		}
+		passphrase := strings.TrimRight(string(data), "\r\n")
+		if passphrase == "" {
+			return nil, errors.Errorf("the encryption passphrase file %s is empty", enc.GetPassphrasePath())
+		}
+		return &encryptionSecret{passphrase: passphrase}, nil
+	default:
+		return nil, ErrEncryptionSecretRequired
+	}
+}
+
+// NewEncryptionMetadata derives the key a new backup is encrypted with from
+// the secret and returns it along with the metadata to store in the backup.
+func NewEncryptionMetadata(enc *api.BackupEncryption) (*EncryptionMetadata, []byte, error) {
+	if !EncryptionEnabled(enc) {
+		return nil, nil, errors.New("an encryption key or passphrase is required")
+	}
+	secret, err := readEncryptionSecret(enc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	md := &EncryptionMetadata{Algorithm: encryptionAlgorithm}
+
+	switch {
+	case len(secret.key) > 0:
+		md.KeyDerivation = keyDerivationNone
+	default:
+		md.KeyDerivation = keyDerivationPBKDF2
+		md.Iterations = pbkdf2Iterations
+		md.Salt = make([]byte, pbkdf2SaltSize)
+		if _, err := io.ReadFull(rand.Reader, md.Salt); err != nil {
+			return nil, nil, errors.Wrap(err, "generating key derivation salt")
+		}
+	}
+
+	key, err := md.deriveKey(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, nil, errors.Wrap(err, "generating key check nonce")
+	}
+	md.KeyCheck = aead.Seal(nonce, nonce, keyCheckPlaintext, nil)
+
+	return md, key, nil
+}
+
+// Key derives the key of the backup from the secret, and checks that it is
+// the key the backup was encrypted with.
+func (md *EncryptionMetadata) Key(enc *api.BackupEncryption) ([]byte, error) {
+	if md.Algorithm != encryptionAlgorithm {
+		return nil, errors.Errorf("unsupported backup encryption algorithm %q", md.Algorithm)
+	}
+	if !EncryptionEnabled(enc) {
+		return nil, ErrEncryptionSecretRequired
+	}
+	secret, err := readEncryptionSecret(enc)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := md.deriveKey(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(md.KeyCheck) < aead.NonceSize() {
+		return nil, errors.New("invalid backup encryption key check")
+	}
+	nonce, sealed := md.KeyCheck[:aead.NonceSize()], md.KeyCheck[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil || !bytes.Equal(plaintext, keyCheckPlaintext) {
+		return nil, ErrEncryptionSecretMismatch
+	}
+
+	return key, nil
+}
+
+func (md *EncryptionMetadata) deriveKey(secret *encryptionSecret) ([]byte, error) {
+	switch md.KeyDerivation {
+	case keyDerivationNone:
+		if len(secret.key) == 0 {
+			return nil, errors.New("the backup was encrypted with a key, not a passphrase")
+		}
+		if len(secret.key) != encryptionKeySize {
+			return nil, errors.Errorf("the encryption key must be %d bytes long", encryptionKeySize)
+		}
+		return secret.key, nil
+	case keyDerivationPBKDF2:
+		if secret.passphrase == "" {
+			return nil, errors.New("the backup was encrypted with a passphrase, not a key")
+		}
+		return pbkdf2SHA256([]byte(secret.passphrase), md.Salt, md.Iterations, encryptionKeySize), nil
+	default:
+		return nil, errors.Errorf("unsupported backup key derivation %q", md.KeyDerivation)
+	}
+}
+
+// WriteEncryptionMetadata stores the encryption metadata at the root of the
+// backup in the bucket
+func WriteEncryptionMetadata(ctx context.Context, bucket Bucket, md *EncryptionMetadata) error {
+	data, err := json.MarshalIndent(md, "", "    ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal backup encryption metadata")
+	}
+
+	w, err := bucket.NewWriter(ctx, encryptionObjectName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create writer for %s", encryptionObjectName)
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return w.Fail(err)
+	}
+	return w.Close()
+}
+
+// LoadEncryptionMetadata returns the encryption metadata of the backup in
+// the bucket, or nil when the backup isn't encrypted
+func LoadEncryptionMetadata(ctx context.Context, bucket Bucket) (*EncryptionMetadata, error) {
+	r, err := bucket.NewReader(ctx, encryptionObjectName, &NoOpObjectVerifier{})
+	if err != nil {
+		if IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "error opening backup encryption metadata object with key %s", encryptionObjectName)
+	}
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading backup encryption metadata at %s", encryptionObjectName)
+	}
+
+	md := &EncryptionMetadata{}
+	if err := json.Unmarshal(data, md); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse backup encryption metadata at %s", encryptionObjectName)
+	}
+	return md, nil
+}
+
+// LoadEncryptionKey returns the key the backup in the bucket was encrypted
+// with, or nil when the backup isn't encrypted
+func LoadEncryptionKey(ctx context.Context, bucket Bucket, enc *api.BackupEncryption) ([]byte, error) {
+	md, err := LoadEncryptionMetadata(ctx, bucket)
+	if err != nil || md == nil {
+		return nil, err
+	}
+	return md.Key(enc)
+}
+
+// ParseEncryptionKey parses the contents of a key file. The file must hold a
+// 256 bit key, either as raw bytes or base64 encoded.
+func ParseEncryptionKey(data []byte) ([]byte, error) {
+	if len(data) == encryptionKeySize {
+		return data, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(key) != encryptionKeySize {
+		return nil, errors.Errorf("the encryption key must be %d bytes long, either raw or base64 encoded", encryptionKeySize)
+	}
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "initializing backup encryption cipher")
+	}
+	return cipher.NewGCM(block)
+}
+
+// pbkdf2SHA256 implements PBKDF2 as described in RFC 8018 with HMAC-SHA256
+// as the pseudorandom function
+func pbkdf2SHA256(password []byte, salt []byte, iterations int, keyLen int) []byte {
+	prf := hmac.New(sha256.New, password)
+	numBlocks := (keyLen + prf.Size() - 1) / prf.Size()
+
+	dk := make([]byte, 0, numBlocks*prf.Size())
+	u := make([]byte, 0, prf.Size())
+	blockIndex := make([]byte, 4)
+	for block := 1; block <= numBlocks; block++ {
+		binary.BigEndian.PutUint32(blockIndex, uint32(block))
+		prf.Reset()
+		prf.Write(salt)       //nolint: errcheck // docs for hash.Hash say this never errors
+		prf.Write(blockIndex) //nolint: errcheck
+		u = prf.Sum(u[:0])
+
+		t := make([]byte, len(u))
+		copy(t, u)
+		for i := 1; i < iterations; i++ {
+			prf.Reset()
+			prf.Write(u) //nolint: errcheck
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		dk = append(dk, t...)
+	}
+	return dk[:keyLen]
+}
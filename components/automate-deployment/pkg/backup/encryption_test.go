@@ -0,0 +1,226 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/chef/automate/api/interservice/deployment"
+)
+
+func TestPBKDF2SHA256(t *testing.T) {
+	// Test vector from RFC 7914 section 11
+	expected := "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc" +
+		"49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"
+	dk := pbkdf2SHA256([]byte("passwd"), []byte("salt"), 1, 64)
+	assert.Equal(t, expected, hex.EncodeToString(dk))
+}
+
+// writeSecret writes the secret to a file in dir and returns its path
+func writeSecret(t *testing.T, dir string, name string, secret []byte) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, secret, 0600))
+	return path
+}
+
+func passphraseFile(t *testing.T, dir string, passphrase string) *api.BackupEncryption {
+	return &api.BackupEncryption{PassphrasePath: writeSecret(t, dir, "passphrase-"+passphrase, []byte(passphrase+"\n"))}
+}
+
+func keyFile(t *testing.T, dir string, key []byte) *api.BackupEncryption {
+	return &api.BackupEncryption{KeyPath: writeSecret(t, dir, "key-"+hex.EncodeToString(key[:4]), key)}
+}
+
+func TestEncryptionMetadata(t *testing.T) {
+	key := make([]byte, encryptionKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	secretDir, err := ioutil.TempDir("", "encryption-secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(secretDir)
+
+	t.Run("derives the key from a passphrase", func(t *testing.T) {
+		md, derived, err := NewEncryptionMetadata(passphraseFile(t, secretDir, "hunter2"))
+		require.NoError(t, err)
+		assert.Equal(t, keyDerivationPBKDF2, md.KeyDerivation)
+		assert.Len(t, derived, encryptionKeySize)
+
+		loaded, err := md.Key(passphraseFile(t, secretDir, "hunter2"))
+		require.NoError(t, err)
+		assert.Equal(t, derived, loaded)
+
+		_, err = md.Key(passphraseFile(t, secretDir, "hunter3"))
+		assert.Equal(t, ErrEncryptionSecretMismatch, err)
+	})
+
+	t.Run("uses the key as is", func(t *testing.T) {
+		md, derived, err := NewEncryptionMetadata(keyFile(t, secretDir, key))
+		require.NoError(t, err)
+		assert.Equal(t, keyDerivationNone, md.KeyDerivation)
+		assert.Equal(t, key, derived)
+
+		otherKey := bytes.Repeat([]byte{0x5a}, encryptionKeySize)
+		_, err = md.Key(keyFile(t, secretDir, otherKey))
+		assert.Equal(t, ErrEncryptionSecretMismatch, err)
+	})
+
+	t.Run("requires a secret to load the key", func(t *testing.T) {
+		md, _, err := NewEncryptionMetadata(keyFile(t, secretDir, key))
+		require.NoError(t, err)
+
+		_, err = md.Key(nil)
+		assert.Equal(t, ErrEncryptionSecretRequired, err)
+	})
+
+	t.Run("is stored in the bucket", func(t *testing.T) {
+		tmpDir, err := ioutil.TempDir("", "encryption-test")
+		require.NoError(t, err)
+		defer os.RemoveAll(tmpDir)
+		bucket := NewFilesystemBucket(tmpDir)
+
+		loaded, err := LoadEncryptionKey(context.Background(), bucket, nil)
+		require.NoError(t, err)
+		assert.Nil(t, loaded, "unencrypted backups have no key")
+
+		md, _, err := NewEncryptionMetadata(passphraseFile(t, secretDir, "hunter2"))
+		require.NoError(t, err)
+		require.NoError(t, WriteEncryptionMetadata(context.Background(), bucket, md))
+
+		_, err = LoadEncryptionKey(context.Background(), bucket, nil)
+		assert.Equal(t, ErrEncryptionSecretRequired, err)
+		loaded, err = LoadEncryptionKey(context.Background(), bucket, passphraseFile(t, secretDir, "hunter2"))
+		require.NoError(t, err)
+		assert.Len(t, loaded, encryptionKeySize)
+	})
+}
+
+func TestReadEncryptionSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encryption-secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	key := bytes.Repeat([]byte{0xa5}, encryptionKeySize)
+
+	t.Run("reads a base64 encoded key", func(t *testing.T) {
+		path := writeSecret(t, dir, "key", []byte(base64.StdEncoding.EncodeToString(key)+"\n"))
+		secret, err := readEncryptionSecret(&api.BackupEncryption{KeyPath: path})
+		require.NoError(t, err)
+		assert.Equal(t, &encryptionSecret{key: key}, secret)
+	})
+
+	t.Run("strips the trailing newline of a passphrase", func(t *testing.T) {
+		path := writeSecret(t, dir, "passphrase", []byte("correct horse battery staple \n"))
+		secret, err := readEncryptionSecret(&api.BackupEncryption{PassphrasePath: path})
+		require.NoError(t, err)
+		assert.Equal(t, &encryptionSecret{passphrase: "correct horse battery staple "}, secret)
+	})
+
+	t.Run("rejects an empty passphrase", func(t *testing.T) {
+		path := writeSecret(t, dir, "empty", []byte("\n"))
+		_, err := readEncryptionSecret(&api.BackupEncryption{PassphrasePath: path})
+		assert.Error(t, err)
+	})
+
+	t.Run("fails when the file is missing", func(t *testing.T) {
+		_, err := readEncryptionSecret(&api.BackupEncryption{KeyPath: filepath.Join(dir, "missing")})
+		assert.Error(t, err)
+	})
+}
+
+func TestParseEncryptionKey(t *testing.T) {
+	key := bytes.Repeat([]byte{0xa5}, encryptionKeySize)
+
+	parsed, err := ParseEncryptionKey(key)
+	require.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	parsed, err = ParseEncryptionKey([]byte(base64.StdEncoding.EncodeToString(key) + "\n"))
+	require.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	_, err = ParseEncryptionKey([]byte("too short"))
+	assert.Error(t, err)
+}
+
+func TestEncryptedBucket(t *testing.T) {
+	ctx := context.Background()
+	tmpDir, err := ioutil.TempDir("", "encrypted-bucket-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	key := make([]byte, encryptionKeySize)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+
+	inner := NewFilesystemBucket(tmpDir)
+	bucket := NewEncryptedBucket(inner, key)
+
+	write := func(name string, data []byte) string {
+		w, err := bucket.NewWriter(ctx, name)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return w.BlobSHA256()
+	}
+
+	sizes := []int{0, 1, encryptedSegmentSize, 3*encryptedSegmentSize + 17}
+	for _, size := range sizes {
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+
+		sha := write("svc/data", data)
+		verifier := &SHA256Verifier{blobSHA256s: map[string]string{"svc/data": sha}}
+
+		r, err := bucket.NewReader(ctx, "svc/data", verifier)
+		require.NoError(t, err, "size %d", size)
+		read, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		r.Close()
+		assert.Equal(t, data, read, "size %d", size)
+	}
+
+	t.Run("encrypts the service data", func(t *testing.T) {
+		write("svc/data", []byte("super secret token"))
+		stored, err := ioutil.ReadFile(filepath.Join(tmpDir, "svc", "data"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(stored), "super secret token")
+	})
+
+	t.Run("leaves the backup metadata in plaintext", func(t *testing.T) {
+		write("svc/metadata.json", []byte("{}"))
+		write("checksums.json", []byte("{}"))
+		for _, name := range []string{"svc/metadata.json", "checksums.json"} {
+			stored, err := ioutil.ReadFile(filepath.Join(tmpDir, name))
+			require.NoError(t, err)
+			assert.Equal(t, "{}", string(stored))
+		}
+	})
+
+	t.Run("detects truncated objects", func(t *testing.T) {
+		write("svc/data", make([]byte, 2*encryptedSegmentSize+1))
+		objPath := filepath.Join(tmpDir, "svc", "data")
+		stored, err := ioutil.ReadFile(objPath)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(objPath, stored[:len(stored)-17], 0600))
+
+		_, err = bucket.NewReader(ctx, "svc/data", &NoOpObjectVerifier{})
+		assert.Error(t, err)
+	})
+
+	t.Run("fails with the wrong key", func(t *testing.T) {
+		write("svc/data", []byte("hello"))
+		_, err := NewEncryptedBucket(inner, make([]byte, encryptionKeySize)).
+			NewReader(ctx, "svc/data", &NoOpObjectVerifier{})
+		assert.Error(t, err)
+	})
+}
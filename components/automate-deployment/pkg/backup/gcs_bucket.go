@@ -0,0 +1,225 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsReadWriteScope  = "https://www.googleapis.com/auth/devstorage.read_write"
+)
+
+// gcsBucket is a Bucket backed by Google Cloud Storage. It talks to the GCS
+// JSON API, which is also what emulators like fake-gcs-server implement.
+type gcsBucket struct {
+	client   *http.Client
+	endpoint string
+	name     string
+	basePath string
+}
+
+// NewGCSBucket returns a Bucket backed by the named GCS bucket. The client is
+// expected to add the credentials to the requests.
+func NewGCSBucket(name string, basePath string, endpoint string, client *http.Client) Bucket {
+	return &gcsBucket{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		name:     name,
+		basePath: basePath,
+	}
+}
+
+type gcsObjectList struct {
+	Items []struct {
+		Name string `json:"name"`
+	} `json:"items"`
+	Prefixes      []string `json:"prefixes"`
+	NextPageToken string   `json:"nextPageToken"`
+}
+
+func (bkt *gcsBucket) objectURL(objectPath string) string {
+	return bkt.endpoint + "/storage/v1/b/" + url.PathEscape(bkt.name) + "/o/" + url.PathEscape(objectPath)
+}
+
+func (bkt *gcsBucket) NewReader(ctx context.Context, name string, verifier ObjectVerifier) (io.ReadCloser, error) {
+	relPath := path.Join(bkt.basePath, name)
+	validatePath(relPath)
+
+	req, err := http.NewRequest("GET", bkt.objectURL(relPath)+"?alt=media", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := bkt.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", relPath)
+	}
+	if err := checkObjectResponse(resp, relPath); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	cksumReader := newChecksummingReader(resp.Body)
+	defer cksumReader.Close()
+	return newVerifiedReader(name, cksumReader, verifier)
+}
+
+// NewWriter streams the object to GCS as it is written. The upload is only
+// completed when the writer is closed, failing the writer aborts it.
+func (bkt *gcsBucket) NewWriter(ctx context.Context, name string) (BlobWriter, error) {
+	relPath := path.Join(bkt.basePath, name)
+	validatePath(relPath)
+
+	uploadURL := bkt.endpoint + "/upload/storage/v1/b/" + url.PathEscape(bkt.name) +
+		"/o?uploadType=media&name=" + url.QueryEscape(relPath)
+
+	pr, pw := io.Pipe()
+	req, err := http.NewRequest("POST", uploadURL, pr)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	w := &gcsWriter{
+		pw:     pw,
+		done:   make(chan error, 1),
+		sha256: sha256.New(),
+	}
+	go func() {
+		resp, err := bkt.client.Do(req.WithContext(ctx))
+		if err == nil {
+			err = checkObjectResponse(resp, relPath)
+			resp.Body.Close()
+		} else {
+			err = errors.Wrapf(err, "failed to upload %s", relPath)
+		}
+		// Unblock any pending write if the upload ended early
+		pr.CloseWithError(err) // nolint: errcheck
+		w.done <- err
+	}()
+
+	return w, nil
+}
+
+type gcsWriter struct {
+	pw     *io.PipeWriter
+	done   chan error
+	sha256 hash.Hash
+}
+
+func (w *gcsWriter) Write(p []byte) (int, error) {
+	w.sha256.Write(p) //nolint: errcheck // docs for hash.Hash say this never errors
+	return w.pw.Write(p)
+}
+
+func (w *gcsWriter) Close() error {
+	w.pw.Close() // nolint: errcheck
+	return <-w.done
+}
+
+func (w *gcsWriter) Fail(err error) error {
+	w.pw.CloseWithError(err) // nolint: errcheck
+	<-w.done
+	return err
+}
+
+func (w *gcsWriter) BlobSHA256() string {
+	return hex.EncodeToString(w.sha256.Sum(nil))
+}
+
+func (bkt *gcsBucket) List(ctx context.Context, pathPrefix string, delimited bool) ([]BucketObject, []SharedPrefix, error) {
+	pathPrefix = path.Join(bkt.basePath, pathPrefix)
+	validatePath(pathPrefix)
+
+	prefix := pathPrefix
+	if prefix != "" && prefix[len(prefix)-1] != '/' {
+		prefix = prefix + "/"
+	}
+
+	objs := []BucketObject{}
+	prefixes := []SharedPrefix{}
+
+	query := url.Values{}
+	query.Set("prefix", prefix)
+	if delimited {
+		query.Set("delimiter", "/")
+	}
+
+	for {
+		req, err := http.NewRequest("GET", bkt.endpoint+"/storage/v1/b/"+url.PathEscape(bkt.name)+"/o?"+query.Encode(), nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err := bkt.client.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to list %s", prefix)
+		}
+
+		page := gcsObjectList{}
+		err = checkObjectResponse(resp, prefix)
+		if err == nil {
+			err = json.NewDecoder(resp.Body).Decode(&page)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to list %s", prefix)
+		}
+
+		for _, item := range page.Items {
+			objs = append(objs, BucketObject{
+				Name: strings.TrimPrefix(strings.TrimPrefix(item.Name, bkt.basePath), "/"),
+			})
+		}
+		for _, p := range page.Prefixes {
+			prefixes = append(prefixes, SharedPrefix(strings.TrimPrefix(strings.TrimPrefix(p, bkt.basePath), "/")))
+		}
+
+		if page.NextPageToken == "" {
+			return objs, prefixes, nil
+		}
+		query.Set("pageToken", page.NextPageToken)
+	}
+}
+
+// Delete removes the objects one by one as the JSON API only supports batch
+// deletes through multipart requests
+func (bkt *gcsBucket) Delete(ctx context.Context, objectPaths []string) error {
+	for _, objectPath := range objectPaths {
+		fullObjectPath := path.Join(bkt.basePath, objectPath)
+		validatePath(fullObjectPath)
+
+		req, err := http.NewRequest("DELETE", bkt.objectURL(fullObjectPath), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := bkt.client.Do(req.WithContext(ctx))
+		if err != nil {
+			return errors.Wrap(err, "failed to delete objects")
+		}
+		err = checkObjectResponse(resp, fullObjectPath)
+		resp.Body.Close()
+		if err != nil {
+			if IsNotExist(err) {
+				continue
+			}
+			logrus.WithFields(logrus.Fields{
+				"path":   fullObjectPath,
+				"reason": err.Error(),
+			}).Warn("Failed to delete object")
+			return errors.Wrap(err, "failed to delete objects")
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"path"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2/google"
 
 	config "github.com/chef/automate/api/config/shared"
 	api "github.com/chef/automate/api/interservice/deployment"
@@ -166,6 +168,168 @@ func (s3spec S3LocationSpecification) String() string {
 	return fmt.Sprintf("s3 repository <s3://%s/%s>", s3spec.BucketName, s3spec.BasePath)
 }
 
+// GCSLocationSpecification describes a location in Google Cloud Storage
+type GCSLocationSpecification struct {
+	// Required
+	BucketName string
+
+	// Optional
+	BasePath string
+	// Endpoint is set when using an emulator. Requests to it are not
+	// authenticated unless CredentialsPath is set.
+	Endpoint string
+	// CredentialsPath is the path to the JSON key of a service account, read
+	// when the bucket is accessed. When it isn't set the application default
+	// credentials are used.
+	CredentialsPath string
+}
+
+func (gcsspec GCSLocationSpecification) ToBucket(baseKey string) Bucket {
+	client, err := gcsspec.httpClient()
+	if err != nil {
+		logrus.WithError(err).Warn("could not initialize gcs bucket")
+		return errBucket{err: err}
+	}
+
+	endpoint := gcsspec.Endpoint
+	if endpoint == "" {
+		endpoint = gcsDefaultEndpoint
+	}
+
+	return NewGCSBucket(gcsspec.BucketName, path.Join(gcsspec.BasePath, baseKey), endpoint, client)
+}
+
+func (gcsspec GCSLocationSpecification) httpClient() (*http.Client, error) {
+	if gcsspec.CredentialsPath != "" {
+		credentialsJSON, err := ioutil.ReadFile(gcsspec.CredentialsPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading gcs credentials")
+		}
+		jwtConfig, err := google.JWTConfigFromJSON(credentialsJSON, gcsReadWriteScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing gcs credentials")
+		}
+		return jwtConfig.Client(context.Background()), nil
+	}
+
+	if gcsspec.Endpoint != "" {
+		return &http.Client{Transport: httputils.NewDefaultTransport()}, nil
+	}
+
+	return google.DefaultClient(context.Background(), gcsReadWriteScope)
+}
+
+func (gcsspec GCSLocationSpecification) ConfigureBackupRestoreTask(req *api.BackupRestoreTask) error {
+	req.GcsBackupLocation = &api.GCSBackupLocation{
+		BucketName:      gcsspec.BucketName,
+		BasePath:        gcsspec.BasePath,
+		Endpoint:        gcsspec.Endpoint,
+		CredentialsPath: gcsspec.CredentialsPath,
+	}
+	return nil
+}
+
+func (gcsspec GCSLocationSpecification) String() string {
+	return fmt.Sprintf("gcs repository <gs://%s/%s>", gcsspec.BucketName, gcsspec.BasePath)
+}
+
+// AzureLocationSpecification describes a location in an Azure Blob storage
+// container
+type AzureLocationSpecification struct {
+	// Required
+	ContainerName string
+	AccountName   string
+	// AccountKeyPath is the path to a file holding the key of the storage
+	// account, read when the bucket is accessed
+	AccountKeyPath string
+
+	// Optional
+	BasePath string
+	// Endpoint defaults to the blob service of the storage account
+	Endpoint string
+}
+
+func (azspec AzureLocationSpecification) ToBucket(baseKey string) Bucket {
+	endpoint := azspec.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", azspec.AccountName)
+	}
+
+	accountKey, err := ioutil.ReadFile(azspec.AccountKeyPath)
+	if err != nil {
+		err = errors.Wrap(err, "reading azure account key")
+		logrus.WithError(err).Warn("could not initialize azure bucket")
+		return errBucket{err: err}
+	}
+
+	bucket, err := NewAzureBucket(azspec.ContainerName, path.Join(azspec.BasePath, baseKey),
+		endpoint, azspec.AccountName, strings.TrimSpace(string(accountKey)))
+	if err != nil {
+		logrus.WithError(err).Warn("could not initialize azure bucket")
+		return errBucket{err: err}
+	}
+
+	return bucket
+}
+
+func (azspec AzureLocationSpecification) ConfigureBackupRestoreTask(req *api.BackupRestoreTask) error {
+	req.AzureBackupLocation = &api.AzureBackupLocation{
+		ContainerName:  azspec.ContainerName,
+		BasePath:       azspec.BasePath,
+		Endpoint:       azspec.Endpoint,
+		AccountName:    azspec.AccountName,
+		AccountKeyPath: azspec.AccountKeyPath,
+	}
+	return nil
+}
+
+func (azspec AzureLocationSpecification) String() string {
+	return fmt.Sprintf("azure repository <azure://%s/%s>", azspec.ContainerName, azspec.BasePath)
+}
+
+// servedByBackupGateway tells whether the backup-gateway can be configured to
+// serve the backups stored at the location. It has no GCS or Azure mode.
+func servedByBackupGateway(locationSpec LocationSpecification) bool {
+	switch locationSpec.(type) {
+	case GCSLocationSpecification, AzureLocationSpecification:
+		return false
+	default:
+		return true
+	}
+}
+
+func newGCSLocationSpecification(loc *api.GCSBackupLocation) GCSLocationSpecification {
+	return GCSLocationSpecification{
+		BucketName:      loc.GetBucketName(),
+		BasePath:        loc.GetBasePath(),
+		Endpoint:        loc.GetEndpoint(),
+		CredentialsPath: loc.GetCredentialsPath(),
+	}
+}
+
+func newAzureLocationSpecification(loc *api.AzureBackupLocation) AzureLocationSpecification {
+	return AzureLocationSpecification{
+		ContainerName:  loc.GetContainerName(),
+		BasePath:       loc.GetBasePath(),
+		Endpoint:       loc.GetEndpoint(),
+		AccountName:    loc.GetAccountName(),
+		AccountKeyPath: loc.GetAccountKeyPath(),
+	}
+}
+
+// NewRemoteLocationSpecificationFromCreateRequest returns the location the
+// backup was requested to be stored at, or nil when the configured backup
+// location should be used.
+func NewRemoteLocationSpecificationFromCreateRequest(req *api.CreateBackupRequest) LocationSpecification {
+	if req.GetGcsBackupLocation().GetBucketName() != "" {
+		return newGCSLocationSpecification(req.GetGcsBackupLocation())
+	}
+	if req.GetAzureBackupLocation().GetContainerName() != "" {
+		return newAzureLocationSpecification(req.GetAzureBackupLocation())
+	}
+	return nil
+}
+
 // NewRemoteLocationSpecificationFromRestoreTask takes BackupRestoreTask and converts
 // it into a corresponding LocationSpecification type depending on the backup location.
 func NewRemoteLocationSpecificationFromRestoreTask(restoreTask *api.BackupRestoreTask) LocationSpecification {
@@ -179,6 +343,12 @@ func NewRemoteLocationSpecificationFromRestoreTask(restoreTask *api.BackupRestor
 			SessionToken: restoreTask.GetS3BackupLocation().GetSessionToken(),
 		}
 	}
+	if restoreTask.GetGcsBackupLocation().GetBucketName() != "" {
+		return newGCSLocationSpecification(restoreTask.GetGcsBackupLocation())
+	}
+	if restoreTask.GetAzureBackupLocation().GetContainerName() != "" {
+		return newAzureLocationSpecification(restoreTask.GetAzureBackupLocation())
+	}
 
 	return FilesystemLocationSpecification{Path: restoreTask.GetBackupDir()}
 }
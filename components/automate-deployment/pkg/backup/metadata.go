@@ -174,3 +174,46 @@ func (m *Metadata) Verifier() ObjectVerifier {
 		return &SHA256Verifier{blobSHA256s: m.ContentsSHA256}
 	}
 }
+
+// ValidateBackupIntegrity reads every object of the backup in the bucket and
+// checks it against the checksums recorded when the backup was created. The
+// bucket must decrypt the objects of encrypted backups.
+func ValidateBackupIntegrity(ctx context.Context, bucket Bucket, sha256 string) error {
+	verifier, err := LoadMetadataVerifier(bucket, sha256)
+	if err != nil {
+		return err
+	}
+
+	specs, _, err := LoadAllSpecsFromBackup(ctx, bucket, verifier)
+	if err != nil {
+		return err
+	}
+
+	for _, spec := range specs {
+		metadata, err := LoadServiceMetadata(bucket, spec.Name, verifier)
+		if err != nil {
+			return err
+		}
+		objectVerifier := metadata.Verifier()
+
+		objs, _, err := bucket.List(ctx, spec.Name, false)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list objects of %s", spec.Name)
+		}
+		for _, obj := range objs {
+			if obj.Name == path.Join(spec.Name, metadataFileBaseName) {
+				continue
+			}
+			if err := objectVerifier.ObjectValid(obj.Name); err != nil {
+				return err
+			}
+			r, err := bucket.NewReader(ctx, obj.Name, objectVerifier)
+			if err != nil {
+				return errors.Wrapf(err, "failed to verify %s", obj.Name)
+			}
+			r.Close() // nolint: errcheck
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	api "github.com/chef/automate/api/interservice/deployment"
+)
+
+// RetentionPolicy describes which backups are kept when backups are pruned
+type RetentionPolicy struct {
+	// KeepDaily is the number of days to keep the most recent backup of
+	KeepDaily int
+	// KeepWeekly is the number of weeks to keep the most recent backup of
+	KeepWeekly int
+	// MaxAge is the age after which backups are deleted
+	MaxAge time.Duration
+}
+
+// IsSet returns whether the policy has any rules. Nothing is pruned when it
+// doesn't.
+func (p RetentionPolicy) IsSet() bool {
+	return p.KeepDaily > 0 || p.KeepWeekly > 0 || p.MaxAge > 0
+}
+
+// BackupsToPrune returns the backups the policy doesn't keep. Backups older
+// than MaxAge are never kept. When KeepDaily or KeepWeekly are set only the
+// backups they select are kept, otherwise every backup younger than MaxAge is
+// kept. Days and weeks are in UTC. The most recent completed backup is always
+// kept, and backups that are in progress or being deleted are left alone.
+func (p RetentionPolicy) BackupsToPrune(backups []*api.BackupTask, now time.Time) []*api.BackupTask {
+	if !p.IsSet() {
+		return nil
+	}
+
+	type candidate struct {
+		task *api.BackupTask
+		time time.Time
+	}
+	candidates := []candidate{}
+	for _, b := range backups {
+		if b.State != api.BackupTask_COMPLETED && b.State != api.BackupTask_FAILED {
+			continue
+		}
+		ts, err := ptypes.Timestamp(b.Id)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, candidate{task: b, time: ts.UTC()})
+	}
+	// Newest first, so the first backup of each day or week is the one kept
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].time.After(candidates[j].time)
+	})
+
+	keep := map[*api.BackupTask]bool{}
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+	keptLatest := false
+	for _, c := range candidates {
+		if c.task.State != api.BackupTask_COMPLETED {
+			continue
+		}
+
+		if !keptLatest {
+			keep[c.task] = true
+			keptLatest = true
+		}
+
+		if p.MaxAge > 0 && now.Sub(c.time) > p.MaxAge {
+			continue
+		}
+
+		if p.KeepDaily == 0 && p.KeepWeekly == 0 {
+			keep[c.task] = true
+			continue
+		}
+
+		day := c.time.Format("2006-01-02")
+		if !days[day] && len(days) < p.KeepDaily {
+			days[day] = true
+			keep[c.task] = true
+		}
+
+		year, week := c.time.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%02d", year, week)
+		if !weeks[weekKey] && len(weeks) < p.KeepWeekly {
+			weeks[weekKey] = true
+			keep[c.task] = true
+		}
+	}
+
+	prune := []*api.BackupTask{}
+	for _, c := range candidates {
+		if !keep[c.task] {
+			prune = append(prune, c.task)
+		}
+	}
+	return prune
+}
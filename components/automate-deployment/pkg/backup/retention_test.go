@@ -0,0 +1,85 @@
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/chef/automate/api/interservice/deployment"
+)
+
+func TestRetentionPolicyBackupsToPrune(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2019, 7, 17, 12, 0, 0, 0, time.UTC)
+
+	newTask := func(t *testing.T, age time.Duration, state api.BackupTask_BackupState) *api.BackupTask {
+		id, err := ptypes.TimestampProto(now.Add(-age))
+		require.NoError(t, err)
+		return &api.BackupTask{Id: id, State: state}
+	}
+	taskIDs := func(tasks []*api.BackupTask) []string {
+		ids := []string{}
+		for _, task := range tasks {
+			ids = append(ids, task.TaskID())
+		}
+		return ids
+	}
+
+	t.Run("prunes nothing without rules", func(t *testing.T) {
+		backups := []*api.BackupTask{newTask(t, 1000*time.Hour, api.BackupTask_COMPLETED)}
+		assert.Empty(t, RetentionPolicy{}.BackupsToPrune(backups, now))
+	})
+
+	t.Run("keeps the most recent backup of each day", func(t *testing.T) {
+		today := newTask(t, 1*time.Hour, api.BackupTask_COMPLETED)
+		earlierToday := newTask(t, 2*time.Hour, api.BackupTask_COMPLETED)
+		yesterday := newTask(t, 24*time.Hour, api.BackupTask_COMPLETED)
+		twoDaysAgo := newTask(t, 48*time.Hour, api.BackupTask_COMPLETED)
+
+		pruned := RetentionPolicy{KeepDaily: 2}.BackupsToPrune(
+			[]*api.BackupTask{twoDaysAgo, today, yesterday, earlierToday}, now)
+		assert.ElementsMatch(t, taskIDs([]*api.BackupTask{earlierToday, twoDaysAgo}), taskIDs(pruned))
+	})
+
+	t.Run("keeps the most recent backup of each week", func(t *testing.T) {
+		thisWeek := newTask(t, 24*time.Hour, api.BackupTask_COMPLETED)
+		alsoThisWeek := newTask(t, 48*time.Hour, api.BackupTask_COMPLETED)
+		lastWeek := newTask(t, 7*24*time.Hour, api.BackupTask_COMPLETED)
+		threeWeeksAgo := newTask(t, 21*24*time.Hour, api.BackupTask_COMPLETED)
+
+		pruned := RetentionPolicy{KeepWeekly: 2}.BackupsToPrune(
+			[]*api.BackupTask{thisWeek, alsoThisWeek, lastWeek, threeWeeksAgo}, now)
+		assert.ElementsMatch(t, taskIDs([]*api.BackupTask{alsoThisWeek, threeWeeksAgo}), taskIDs(pruned))
+	})
+
+	t.Run("deletes backups older than the max age", func(t *testing.T) {
+		recent := newTask(t, 24*time.Hour, api.BackupTask_COMPLETED)
+		old := newTask(t, 100*24*time.Hour, api.BackupTask_COMPLETED)
+
+		pruned := RetentionPolicy{KeepDaily: 7, MaxAge: 90 * 24 * time.Hour}.BackupsToPrune(
+			[]*api.BackupTask{recent, old}, now)
+		assert.Equal(t, taskIDs([]*api.BackupTask{old}), taskIDs(pruned))
+	})
+
+	t.Run("always keeps the most recent completed backup", func(t *testing.T) {
+		latest := newTask(t, 100*24*time.Hour, api.BackupTask_COMPLETED)
+		older := newTask(t, 101*24*time.Hour, api.BackupTask_COMPLETED)
+		failed := newTask(t, 1*time.Hour, api.BackupTask_FAILED)
+
+		pruned := RetentionPolicy{MaxAge: 90 * 24 * time.Hour}.BackupsToPrune(
+			[]*api.BackupTask{latest, older, failed}, now)
+		assert.ElementsMatch(t, taskIDs([]*api.BackupTask{older, failed}), taskIDs(pruned))
+	})
+
+	t.Run("leaves running backups alone", func(t *testing.T) {
+		running := newTask(t, 100*24*time.Hour, api.BackupTask_IN_PROGRESS)
+		deleting := newTask(t, 100*24*time.Hour, api.BackupTask_DELETING)
+
+		pruned := RetentionPolicy{MaxAge: time.Hour}.BackupsToPrune(
+			[]*api.BackupTask{running, deleting}, now)
+		assert.Empty(t, pruned)
+	})
+}
@@ -53,9 +53,10 @@ type Runner struct {
 	connFactory     *secureconn.Factory
 	releaseManifest manifest.ReleaseManifest
 	configRenderer  func(*deployment.Service) (string, error)
+	cmdExecutor     command.Executor
 
 	locationSpec        LocationSpecification // backup-gateway
-	restoreLocationSpec LocationSpecification // filesystem or S3
+	restoreLocationSpec LocationSpecification // filesystem, S3, GCS or Azure
 	backupTask          *api.BackupTask
 	restoreTask         *api.BackupRestoreTask
 }
@@ -71,6 +72,7 @@ func NewRunner(opts ...RunnerOpt) *Runner {
 		configRenderer: func(*deployment.Service) (string, error) {
 			return "", errors.New("invalid config renderer")
 		},
+		cmdExecutor: command.NewExecExecutor(),
 	}
 
 	for _, opt := range opts {
@@ -149,8 +151,11 @@ func WithReleaseManifest(releaseManifest manifest.ReleaseManifest) RunnerOpt {
 	}
 }
 
-// CreateBackup creates an Automate Backup
-func (r *Runner) CreateBackup(ctx context.Context, dep *deployment.Deployment, sender events.EventSender) (*api.BackupTask, error) {
+// CreateBackup creates an Automate Backup. When locationSpec is not nil the
+// backup is stored there rather than in the backup-gateway; it must pass
+// CheckRemoteLocation. When enc holds a key or passphrase the backup objects
+// are encrypted with it, Elasticsearch snapshots are not.
+func (r *Runner) CreateBackup(ctx context.Context, dep *deployment.Deployment, sender events.EventSender, locationSpec LocationSpecification, enc *api.BackupEncryption) (*api.BackupTask, error) {
 	r.backupTask = &api.BackupTask{Id: ptypes.TimestampNow()}
 
 	r.infof("Backup running")
@@ -158,11 +163,29 @@ func (r *Runner) CreateBackup(ctx context.Context, dep *deployment.Deployment, s
 	r.lockedDeployment = dep
 	r.publishBackupEvent(r.backupTask.TaskID(), api.DeployEvent_RUNNING)
 
-	go r.startBackupOperations(ctx)
+	if locationSpec == nil {
+		locationSpec = r.locationSpec
+	}
+	go r.startBackupOperations(ctx, locationSpec, enc)
 
 	return r.backupTask, nil
 }
 
+// CheckRemoteLocation returns an error if the backup can't be stored at the
+// location instead of the backup-gateway. Elasticsearch snapshots are always
+// taken in the snapshot repository of the deployment, so a backup including
+// them wouldn't hold everything needed to restore it.
+func (r *Runner) CheckRemoteLocation(locationSpec LocationSpecification) error {
+	for _, spec := range r.specs {
+		if len(spec.SyncEsIndices) > 0 || len(spec.AsyncEsIndices) > 0 {
+			return errors.Errorf(
+				"the backup includes Elasticsearch snapshots of %s, which can't be stored in %s; use the configured backup location instead",
+				spec.Name, locationSpec)
+		}
+	}
+	return nil
+}
+
 // DeleteBackups deletes one or many Automate Backups
 func (r *Runner) DeleteBackups(ctx context.Context, dep *deployment.Deployment, backupTasks []*api.BackupTask) error {
 	ctx, cancel := context.WithCancel(ctx)
@@ -490,17 +513,17 @@ func (r *Runner) startRestoreOperations(ctx context.Context) {
 		return
 	}
 
+	// Encrypted backups are decrypted with the secret in the file named by the
+	// restore task. The backup-gateway serves the same backup so it uses the
+	// same key.
+	key, err := LoadEncryptionKey(ctx, r.restoreLocationSpec.ToBucket(r.restoreTask.Backup.TaskID()), r.restoreTask.Encryption)
+	if err != nil {
+		r.failf(err, "Failed to load the backup encryption key")
+		return
+	}
+
 	// Build a context that we can pass to each operation
-	restoreCtx := NewContext(
-		WithContextCtx(ctx),
-		WithContextBackupRestoreTask(r.restoreTask),
-		WithContextBackupLocationSpecification(r.locationSpec),
-		WithContextBackupRestoreLocationSpecification(r.restoreLocationSpec),
-		WithContextPgConnInfo(r.pgConnInfo),
-		WithContextEsSidecarInfo(r.esSidecarInfo),
-		WithContextConnFactory(r.connFactory),
-		WithContextReleaseManifest(r.releaseManifest),
-	)
+	restoreCtx := r.newRestoreContext(ctx, key)
 
 	// Build a slice of services that we wish to restore
 	backupManifest, err := LoadBackupManifest(restoreCtx.restoreBucket, r.restoreTask)
@@ -551,6 +574,28 @@ func (r *Runner) startRestoreOperations(ctx context.Context) {
 	r.publishBackupEvent(r.restoreTask.TaskID(), api.DeployEvent_COMPLETE_OK)
 }
 
+// newRestoreContext returns the context of the restore operations. Backups are
+// read through the backup-gateway, which serves the restore location, except
+// for the ones in GCS or Azure Blob storage: the backup-gateway has no mode
+// for those so they are read from the restore location directly.
+func (r *Runner) newRestoreContext(ctx context.Context, key []byte) Context {
+	locationSpec := r.locationSpec
+	if !servedByBackupGateway(r.restoreLocationSpec) {
+		locationSpec = r.restoreLocationSpec
+	}
+
+	return NewContext(
+		WithContextCtx(ctx),
+		WithContextBackupRestoreTask(r.restoreTask),
+		WithContextBackupLocationSpecification(WithEncryptionKey(locationSpec, key)),
+		WithContextBackupRestoreLocationSpecification(WithEncryptionKey(r.restoreLocationSpec, key)),
+		WithContextPgConnInfo(r.pgConnInfo),
+		WithContextEsSidecarInfo(r.esSidecarInfo),
+		WithContextConnFactory(r.connFactory),
+		WithContextReleaseManifest(r.releaseManifest),
+	)
+}
+
 // Install and binlink automate-cli if it exists in the given manifest
 func (r *Runner) restoreAutomateCLI(manifest manifest.ReleaseManifest) error {
 	svc := deployment.ServiceFromManifest(manifest, "automate-cli")
@@ -577,7 +622,6 @@ func (r *Runner) restoreAutomateCLI(manifest manifest.ReleaseManifest) error {
 
 func (r *Runner) restoreServices(desiredServices []*deployment.Service, restoreCtx Context, cancel func()) error {
 	channel := r.lockedDeployment.Config.GetDeployment().GetV1().GetSvc().GetChannel().GetValue()
-	var bucket Bucket
 
 	// Load the verifier from the remote bucket since the backup gateway won't be
 	// available yet by this point.
@@ -620,61 +664,7 @@ func (r *Runner) restoreServices(desiredServices []*deployment.Service, restoreC
 			return err
 		}
 
-		// Load backup metadata for the service. It contains the backup spec
-		// that we'll use to restore the service.
-		// NOTE: the backup-gateway is a special case that needs the remote
-		// bucket
-		switch svc.Name() {
-		case "backup-gateway":
-			bucket = restoreCtx.restoreBucket
-		default:
-			bucket = restoreCtx.bucket
-		}
-
-		var spec Spec
-		metadata, err := LoadServiceMetadata(
-			bucket,
-			svc.Name(),
-			verifier,
-		)
-
-		// If the metadata file exists but we failed to load it for whatever
-		// reason, like a network issue or corrupted metadata file, then we want to
-		// error out.
-		if err != nil && !IsNotExist(err) {
-			r.failf(err, "Failed to load metadata for service %s", svc.Name())
-			return err
-		}
-
-		// If the backup metadata is missing, we assume the service is stateless or
-		// a data service. Therefore, we'll create a blank specification for the
-		// service that contains no restore actions.
-		if err != nil {
-			spec = Spec{Name: svc.Name()}
-		} else {
-			spec = *metadata.Spec
-		}
-
-		// TODO make the executor set the command executor on specs/operations
-		SetCommandExecutor(spec, command.NewExecExecutor())
-
-		// Create a new executor to run the restore operations for services'
-		// backup spec.
-		executor := NewExecutor(
-			WithEventChan(r.eventChan),
-			WithErrorChan(r.errChan),
-			WithSpec(spec),
-			WithCancel(cancel),
-		)
-
-		// Restore the specification
-		// TODO: Right now we assume that the commands that are run can be run
-		// before the service is started. That's okay because the only spec that
-		// has commands is the deployment-service which is running this code.
-		// If that changes we will have to separate pre/post service operational
-		// restore operations.
-		if err := executor.Restore(restoreCtx, metadata); err != nil {
-			r.failf(err, "Failed to restore synchronous operations")
+		if err := r.restoreServiceData(restoreCtx, svc.Name(), verifier, cancel); err != nil {
 			return err
 		}
 
@@ -702,6 +692,62 @@ func (r *Runner) restoreServices(desiredServices []*deployment.Service, restoreC
 	return nil
 }
 
+// restoreServiceData runs the restore operations of the backup spec of the
+// service, which is loaded from its backup metadata.
+func (r *Runner) restoreServiceData(restoreCtx Context, name string, verifier ObjectVerifier, cancel func()) error {
+	// NOTE: the backup-gateway is a special case that needs the remote
+	// bucket
+	bucket := restoreCtx.bucket
+	if name == "backup-gateway" {
+		bucket = restoreCtx.restoreBucket
+	}
+
+	var spec Spec
+	metadata, err := LoadServiceMetadata(bucket, name, verifier)
+
+	// If the metadata file exists but we failed to load it for whatever
+	// reason, like a network issue or corrupted metadata file, then we want to
+	// error out.
+	if err != nil && !IsNotExist(err) {
+		r.failf(err, "Failed to load metadata for service %s", name)
+		return err
+	}
+
+	// If the backup metadata is missing, we assume the service is stateless or
+	// a data service. Therefore, we'll create a blank specification for the
+	// service that contains no restore actions.
+	if err != nil {
+		spec = Spec{Name: name}
+	} else {
+		spec = *metadata.Spec
+	}
+
+	// TODO make the executor set the command executor on specs/operations
+	SetCommandExecutor(spec, r.cmdExecutor)
+
+	// Create a new executor to run the restore operations for services'
+	// backup spec.
+	executor := NewExecutor(
+		WithEventChan(r.eventChan),
+		WithErrorChan(r.errChan),
+		WithSpec(spec),
+		WithCancel(cancel),
+	)
+
+	// Restore the specification
+	// TODO: Right now we assume that the commands that are run can be run
+	// before the service is started. That's okay because the only spec that
+	// has commands is the deployment-service which is running this code.
+	// If that changes we will have to separate pre/post service operational
+	// restore operations.
+	if err := executor.Restore(restoreCtx, metadata); err != nil {
+		r.failf(err, "Failed to restore synchronous operations")
+		return err
+	}
+
+	return nil
+}
+
 func (r *Runner) loadSvcFromManifest(manifest manifest.ReleaseManifest, name string) (*deployment.Service, error) {
 	svc := deployment.ServiceFromManifest(manifest, name)
 	if svc == nil {
@@ -866,7 +912,7 @@ func (r *Runner) clearRunningTask() {
 	}
 }
 
-func (r *Runner) startBackupOperations(ctx context.Context) {
+func (r *Runner) startBackupOperations(ctx context.Context, locationSpec LocationSpecification, enc *api.BackupEncryption) {
 	var err error
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -893,9 +939,23 @@ func (r *Runner) startBackupOperations(ctx context.Context) {
 		r.clearRunningTask()
 	}()
 
+	if EncryptionEnabled(enc) {
+		md, key, err := NewEncryptionMetadata(enc)
+		if err != nil {
+			r.failf(err, "Failed to derive the backup encryption key")
+			return
+		}
+		err = WriteEncryptionMetadata(ctx, locationSpec.ToBucket(r.backupTask.TaskID()), md)
+		if err != nil {
+			r.failf(err, "Failed to write the backup encryption metadata")
+			return
+		}
+		locationSpec = WithEncryptionKey(locationSpec, key)
+	}
+
 	backupCtx := NewContext(
 		WithContextCtx(ctx),
-		WithContextBackupLocationSpecification(r.locationSpec),
+		WithContextBackupLocationSpecification(locationSpec),
 		WithContextPgConnInfo(r.pgConnInfo),
 		WithContextBackupTask(r.backupTask),
 		WithContextEsSidecarInfo(r.esSidecarInfo),
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	api "github.com/chef/automate/api/interservice/deployment"
 	"github.com/chef/automate/components/automate-deployment/pkg/deployment"
 	"github.com/chef/automate/components/automate-deployment/pkg/events"
+	"github.com/chef/automate/lib/platform/command"
 )
 
 func TestRunnerCreate(t *testing.T) {
@@ -24,7 +26,7 @@ func TestRunnerCreate(t *testing.T) {
 		r, ctx, dep, sender, cleanup := testBackupRunner(testDefaultSpecs(), 5*time.Second)
 		defer cleanup()
 		r.specs = []Spec{}
-		task, err := r.CreateBackup(ctx, dep, sender)
+		task, err := r.CreateBackup(ctx, dep, sender, nil, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, task)
@@ -39,7 +41,7 @@ func TestRunnerCreate(t *testing.T) {
 
 		r.specs = []Spec{}
 
-		task, err := r.CreateBackup(ctx, dep, sender)
+		task, err := r.CreateBackup(ctx, dep, sender, nil, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, task)
@@ -110,7 +112,7 @@ func TestListBackups(t *testing.T) {
 		defer cleanup()
 
 		r.locationSpec = testLocationSpec(dir)
-		task, err := r.CreateBackup(ctx, dep, sender)
+		task, err := r.CreateBackup(ctx, dep, sender, nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, task)
 
@@ -149,7 +151,7 @@ func TestBackupDelete(t *testing.T) {
 		defer cleanup()
 
 		r.locationSpec = testLocationSpec(dir)
-		task, err := r.CreateBackup(ctx, dep, sender)
+		task, err := r.CreateBackup(ctx, dep, sender, nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, task)
 
@@ -200,6 +202,93 @@ func TestCancelBackup(t *testing.T) {
 	}
 }
 
+func TestCheckRemoteLocation(t *testing.T) {
+	r := NewRunner(WithSpecs(testDefaultSpecs()))
+	assert.NoError(t, r.CheckRemoteLocation(GCSLocationSpecification{BucketName: "backups"}))
+
+	r = NewRunner(WithSpecs(append(testDefaultSpecs(), Spec{
+		Name:          "ingest",
+		SyncEsIndices: []ElasticsearchOperation{{ServiceName: "automate-cs-ingest"}},
+	})))
+	assert.Error(t, r.CheckRemoteLocation(GCSLocationSpecification{BucketName: "backups"}))
+}
+
+// TestRemoteBackupRoundtrip creates a backup in GCS and restores the data of
+// its services from there, without the backup-gateway. It runs against a GCS
+// emulator, see TestGCSBucket.
+func TestRemoteBackupRoundtrip(t *testing.T) {
+	endpoint := os.Getenv("AUTOMATE_BACKUP_TEST_GCS_ENDPOINT")
+	bucketName := os.Getenv("AUTOMATE_BACKUP_TEST_GCS_BUCKET")
+	if endpoint == "" || bucketName == "" {
+		t.Skip("AUTOMATE_BACKUP_TEST_GCS_ENDPOINT and AUTOMATE_BACKUP_TEST_GCS_BUCKET are not set")
+	}
+
+	dir, err := ioutil.TempDir("", "remote-backup-roundtrip")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	remote := GCSLocationSpecification{
+		BucketName: bucketName,
+		BasePath:   "roundtrip-" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		Endpoint:   endpoint,
+	}
+	spec := Spec{
+		Name:          "compliance-service",
+		WriteMetadata: true,
+		SyncCmds: []CommandExecuteOperation{{
+			Name:       "compliance-data",
+			ObjectName: []string{"compliance-service"},
+			Cmd:        Cmd{Name: "data", Dump: []string{"dump"}, Restore: []string{"restore"}},
+			PkgOrigin:  "chef",
+			PkgName:    "compliance-service",
+		}},
+	}
+	SetCommandExecutor(spec, shellExecutor{command.NewExecExecutor(), "printf 'compliance data'"})
+
+	r, ctx, dep, sender, cleanup := testBackupRunner([]Spec{spec}, 30*time.Second)
+	defer cleanup()
+	// nothing is stored in, nor read from, the backup-gateway
+	r.locationSpec = testLocationSpec(filepath.Join(dir, "backup-gateway"))
+
+	require.NoError(t, r.CheckRemoteLocation(remote))
+	task, err := r.CreateBackup(ctx, dep, sender, remote, nil)
+	require.NoError(t, err)
+	require.NoError(t, waitForBackup(sender))
+
+	sha256, err := ShowBackupChecksum(remote.ToBucket(task.TaskID()))
+	require.NoError(t, err)
+
+	restored := filepath.Join(dir, "restored")
+	r.cmdExecutor = shellExecutor{command.NewExecExecutor(), "cat > " + restored}
+	r.restoreLocationSpec = remote
+	r.restoreTask = &api.BackupRestoreTask{Backup: task, Sha256: sha256}
+	r.eventChan = make(chan api.DeployEvent_Backup_Operation)
+	go func() {
+		for range r.eventChan {
+		}
+	}()
+
+	restoreCtx := r.newRestoreContext(ctx, nil)
+	verifier, err := LoadMetadataVerifier(restoreCtx.restoreBucket, sha256)
+	require.NoError(t, err)
+	require.NoError(t, r.restoreServiceData(restoreCtx, "compliance-service", verifier, func() {}))
+
+	data, err := ioutil.ReadFile(restored)
+	require.NoError(t, err)
+	assert.Equal(t, "compliance data", string(data))
+}
+
+// shellExecutor runs the script with sh in place of the commands of backup
+// operations, which need habitat
+type shellExecutor struct {
+	command.Executor
+	script string
+}
+
+func (e shellExecutor) Run(_ string, opts ...command.Opt) error {
+	return e.Executor.Run("sh", append(opts, command.Args("-c", e.script))...)
+}
+
 func waitForBackup(e events.EventSender) error {
 	waitChan := make(chan error)
 	go e.StreamTo(func(evt *api.DeployEvent) error {
@@ -352,6 +352,16 @@ func DefaultSpecs(chefServerEnabled bool, workflowEnabled bool) []Spec {
 		{Name: "automate-es-gateway", WriteMetadata: false},
 		{Name: "automate-gateway", WriteMetadata: false},
 		{Name: "event-gateway", WriteMetadata: false},
+		{
+			Name:          "data-feed-service",
+			WriteMetadata: true,
+			SyncDbsV2: []DatabaseDumpOperationV2{
+				{
+					Name: "chef_data_feed_service",
+					User: "data_feed",
+				},
+			},
+		},
 		{Name: "automate-load-balancer", WriteMetadata: false},
 		{Name: "automate-postgresql", WriteMetadata: false},
 		{Name: "automate-pg-gateway", WriteMetadata: false},
@@ -360,10 +370,18 @@ func DefaultSpecs(chefServerEnabled bool, workflowEnabled bool) []Spec {
 		{Name: "config-mgmt-service", WriteMetadata: false},
 		{Name: "data-lifecycle-service", WriteMetadata: false},
 		{Name: "es-sidecar-service", WriteMetadata: false},
-		{Name: "event-service", WriteMetadata: false},
+		{
+			Name:          "event-service",
+			WriteMetadata: true,
+			SyncDbsV2: []DatabaseDumpOperationV2{
+				{
+					Name: "chef_event_service",
+					User: "event",
+				},
+			},
+		},
 		{Name: "local-user-service", WriteMetadata: false},
 		{Name: "pg-sidecar-service", WriteMetadata: false},
-		{Name: "data-feed-service", WriteMetadata: false},
 	}
 
 	if chefServerEnabled {
@@ -14,7 +14,7 @@ import (
 // CreateBackup makes a gRPC request to the deployment service to start a
 // new backup create routine. The server returns a backup ID which can be used
 // to stream backup events.
-func CreateBackup(conTimeout, reqTimeout time.Duration, _ cli.FormatWriter) (*api.CreateBackupResponse, error) {
+func CreateBackup(conTimeout, reqTimeout time.Duration, req *api.CreateBackupRequest, _ cli.FormatWriter) (*api.CreateBackupResponse, error) {
 	con, ctx, cancel, err := newCon(conTimeout, reqTimeout)
 	defer cancel()
 
@@ -26,7 +26,7 @@ func CreateBackup(conTimeout, reqTimeout time.Duration, _ cli.FormatWriter) (*ap
 		)
 	}
 
-	res, err := con.CreateBackup(ctx, &api.CreateBackupRequest{})
+	res, err := con.CreateBackup(ctx, req)
 	if err != nil {
 		err = status.Wrap(
 			err,
@@ -389,6 +389,12 @@ func (r *DeploymentRestore) Restore() error {
 
 	// Initialize bucket. Its view should only be from the backup task id in question
 	locationSpec := backup.NewRemoteLocationSpecificationFromRestoreTask(r.restoreTask)
+	key, err := backup.LoadEncryptionKey(context.Background(),
+		locationSpec.ToBucket(r.restoreTask.Backup.TaskID()), r.restoreTask.Encryption)
+	if err != nil {
+		return status.Wrap(err, status.BackupRestoreError, "Loading the backup encryption key failed")
+	}
+	locationSpec = backup.WithEncryptionKey(locationSpec, key)
 	bucket := locationSpec.ToBucket(r.restoreTask.Backup.TaskID())
 
 	// Write the converge loop disable sentinel file so we don't try and
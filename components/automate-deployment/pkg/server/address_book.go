@@ -23,6 +23,8 @@ func (s *server) AddressForService(name string) string {
 		port = s.deployment.Config.GetCompliance().GetV1().GetSys().GetService().GetPort().GetValue()
 	case "config-mgmt-service":
 		port = s.deployment.Config.GetConfigMgmt().GetV1().GetSys().GetService().GetPort().GetValue()
+	case "event-service":
+		port = s.deployment.Config.GetEventService().GetV1().GetSys().GetService().GetPort().GetValue()
 	case "ingest-service":
 		port = s.deployment.Config.GetIngest().GetV1().GetSys().GetService().GetPort().GetValue()
 	case "license-control-service":
@@ -19,6 +19,13 @@ func (s *server) CreateBackup(ctx context.Context, req *api.CreateBackupRequest)
 		return nil, ErrorNotConfigured
 	}
 
+	locationSpec := backup.NewRemoteLocationSpecificationFromCreateRequest(req)
+	if locationSpec != nil {
+		if err := s.backupRunner.CheckRemoteLocation(locationSpec); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
 	// lock the deployment for the duration of the backup
 	// this gives us the following properties:
 	// - only one backup can take place at a time
@@ -37,7 +44,7 @@ func (s *server) CreateBackup(ctx context.Context, req *api.CreateBackupRequest)
 
 	sender := s.newEventSender()
 
-	task, err := s.backupRunner.CreateBackup(ctx, s.deployment, sender)
+	task, err := s.backupRunner.CreateBackup(ctx, s.deployment, sender, locationSpec, req.Encryption)
 	if err != nil {
 		// CreateBackup doesn't look like it can return an error. But if it did,
 		// the mutex needs to be unlocked?
@@ -201,7 +208,11 @@ func (s *server) BackupStatus(ctx context.Context, req *api.BackupStatusRequest)
 		return nil, status.Error(codes.Internal, "failed to get running backup task")
 	}
 
-	return task.Status, nil
+	return &api.BackupStatusResponse{
+		OpType:   task.Status.GetOpType(),
+		TaskIds:  task.Status.GetTaskIds(),
+		Schedule: s.backupScheduler.Status(),
+	}, nil
 }
 
 func (s *server) backupGatewayLocationSpec() (backup.LocationSpecification, error) {
@@ -0,0 +1,323 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/pkg/errors"
+	"github.com/robfig/cron"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	dc "github.com/chef/automate/api/config/deployment"
+	api "github.com/chef/automate/api/interservice/deployment"
+	automate_event "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/automate-deployment/pkg/backup"
+	automate_event_type "github.com/chef/automate/components/event-service/server"
+	event_ids "github.com/chef/automate/lib/event"
+)
+
+const (
+	// How often the scheduler checks whether a backup is due
+	backupScheduleCheckInterval = time.Minute
+	// How long a failure event may take to be published
+	backupEventPublishTimeout = 30 * time.Second
+)
+
+// backupScheduler creates backups on the schedule configured in
+// deployment.v1.sys.backup and prunes them with its retention rules. The
+// backups are created and deleted with the backup runner, under the
+// deployment lock, just like the ones requested through the API.
+type backupScheduler struct {
+	server *server
+
+	mu       sync.Mutex
+	schedule cron.Schedule
+	status   api.BackupSchedule
+	// running is set while a check, and the backup it starts, is in progress
+	running bool
+}
+
+func newBackupScheduler(s *server) *backupScheduler {
+	return &backupScheduler{server: s}
+}
+
+// Start checks whether a backup is due every minute until the process exits.
+// Checks run in the background since they wait for the deployment lock, which
+// is held for as long as any backup, restore or converge runs. A check isn't
+// started while the previous one is still running.
+func (b *backupScheduler) Start() {
+	go func() {
+		ticker := time.NewTicker(backupScheduleCheckInterval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			if !b.startCheck() {
+				continue
+			}
+			go func(now time.Time) {
+				defer b.finishCheck()
+				b.tick(now)
+			}(now)
+		}
+	}()
+}
+
+func (b *backupScheduler) startCheck() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.running {
+		return false
+	}
+	b.running = true
+	return true
+}
+
+func (b *backupScheduler) finishCheck() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.running = false
+}
+
+// Status returns the state of the schedule, or nil when no schedule is
+// configured
+func (b *backupScheduler) Status() *api.BackupSchedule {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.schedule == nil {
+		return nil
+	}
+	status := b.status
+	return &status
+}
+
+func (b *backupScheduler) tick(now time.Time) {
+	spec, ok := b.configuredSchedule()
+	if !ok {
+		return
+	}
+
+	if !b.due(now, spec) {
+		return
+	}
+
+	if b.server.convergeDisabled() {
+		logrus.Warn("Skipping scheduled backup because the converge disable file is present")
+		return
+	}
+
+	logrus.Info("Starting scheduled backup")
+	task, pruned, err := b.run(context.Background())
+
+	b.mu.Lock()
+	b.status.LastRun, _ = ptypes.TimestampProto(now)
+	b.status.LastBackup = task
+	b.status.LastPruned = pruned
+	b.status.LastError = ""
+	if err != nil {
+		b.status.LastError = err.Error()
+	}
+	b.mu.Unlock()
+
+	if err != nil {
+		logrus.WithError(err).Error("Scheduled backup failed")
+		b.publishFailure(task, err)
+		return
+	}
+	logrus.WithFields(logrus.Fields{
+		"backup_id": task.TaskID(),
+		"pruned":    pruned,
+	}).Info("Scheduled backup completed")
+}
+
+// configuredSchedule returns the configured cron spec, read under the
+// deployment lock since the config is replaced when the deployment is
+// reconfigured
+func (b *backupScheduler) configuredSchedule() (string, bool) {
+	s := b.server
+	if s.deployment == nil {
+		return "", false
+	}
+
+	s.deployment.Lock()
+	defer s.deployment.Unlock()
+	if s.deployment.Config == nil {
+		return "", false
+	}
+	return s.deployment.Config.GetDeployment().GetV1().GetSys().GetBackup().GetSchedule().GetCron().GetValue(), true
+}
+
+// due picks up changes to the configured schedule spec and returns whether a
+// backup should be created now
+func (b *backupScheduler) due(now time.Time, spec string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if spec != b.status.Cron {
+		b.schedule = nil
+		b.status = api.BackupSchedule{Cron: spec}
+		if spec == "" {
+			return false
+		}
+
+		schedule, err := cron.ParseStandard(spec)
+		if err != nil {
+			logrus.WithError(err).WithField("cron", spec).Error("Invalid backup schedule")
+			return false
+		}
+		b.schedule = schedule
+		b.status.NextRun, _ = ptypes.TimestampProto(schedule.Next(now))
+		logrus.WithField("cron", spec).Info("Scheduling backups")
+		return false
+	}
+
+	if b.schedule == nil {
+		return false
+	}
+
+	next, err := ptypes.Timestamp(b.status.NextRun)
+	if err != nil || now.Before(next) {
+		return false
+	}
+	b.status.NextRun, _ = ptypes.TimestampProto(b.schedule.Next(now))
+	return true
+}
+
+// run creates a backup, waits for it to finish and then prunes the backups
+// the retention rules don't keep. It returns the backup and the number of
+// backups that were pruned.
+func (b *backupScheduler) run(ctx context.Context) (*api.BackupTask, int32, error) {
+	s := b.server
+
+	// The lock is released by the backup runner when the backup finishes.
+	// Everything the backup and pruning need from the deployment is read
+	// while it is held.
+	if err := s.acquireLock(ctx); err != nil {
+		return nil, 0, errors.Wrap(err, "acquiring deployment lock")
+	}
+	runner := s.backupRunner
+	retention := proto.Clone(s.deployment.Config.GetDeployment().GetV1().GetSys().GetBackup().GetRetention()).(*dc.ConfigRequest_V1_System_Backup_Retention)
+
+	sender := s.newEventSender()
+	task, err := runner.CreateBackup(ctx, s.deployment, sender, nil, nil)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "creating backup")
+	}
+	s.senderStore.Set(task.TaskID(), sender)
+
+	var last *api.DeployEvent_Backup
+	err = sender.StreamTo(func(ev *api.DeployEvent) error {
+		if backupEvent := ev.GetBackup(); backupEvent != nil {
+			last = backupEvent
+		}
+		return nil
+	})
+	if err != nil {
+		return task, 0, errors.Wrap(err, "waiting for backup")
+	}
+	if last.GetStatus() != api.DeployEvent_COMPLETE_OK {
+		for _, op := range last.GetOperations() {
+			if op.GetError() != "" {
+				return task, 0, errors.New(op.GetError())
+			}
+		}
+		return task, 0, errors.New("backup failed")
+	}
+
+	pruned, err := b.prune(ctx, runner, retention)
+	if err != nil {
+		return task, pruned, errors.Wrap(err, "pruning backups")
+	}
+	return task, pruned, nil
+}
+
+func (b *backupScheduler) prune(ctx context.Context, runner *backup.Runner, retention *dc.ConfigRequest_V1_System_Backup_Retention) (int32, error) {
+	s := b.server
+
+	policy := backup.RetentionPolicy{
+		KeepDaily:  int(retention.GetKeepDaily().GetValue()),
+		KeepWeekly: int(retention.GetKeepWeekly().GetValue()),
+	}
+	if maxAge := retention.GetMaxAge().GetValue(); maxAge != "" {
+		d, err := time.ParseDuration(maxAge)
+		if err != nil {
+			return 0, errors.Wrap(err, "parsing max_age")
+		}
+		policy.MaxAge = d
+	}
+	if !policy.IsSet() {
+		return 0, nil
+	}
+
+	backups, err := runner.ListBackups(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "listing backups")
+	}
+	toDelete := policy.BackupsToPrune(backups, time.Now())
+	if len(toDelete) == 0 {
+		return 0, nil
+	}
+
+	// The lock is released by the backup runner when the deletion finishes
+	if err := s.acquireLock(ctx); err != nil {
+		return 0, errors.Wrap(err, "acquiring deployment lock")
+	}
+	if err := s.backupRunner.DeleteBackups(ctx, s.deployment, toDelete); err != nil {
+		return 0, err
+	}
+	return int32(len(toDelete)), nil
+}
+
+// publishFailure sends a scheduledBackupFailed event to the event-service
+// so that the failure can be alerted on
+func (b *backupScheduler) publishFailure(task *api.BackupTask, failure error) {
+	s := b.server
+
+	ctx, cancel := context.WithTimeout(context.Background(), backupEventPublishTimeout)
+	defer cancel()
+
+	conn, err := s.connFactory.DialContext(ctx, "event-service", s.AddressForService("event-service"), grpc.WithBlock())
+	if err != nil {
+		logrus.WithError(err).Error("Failed to connect to event-service to publish scheduled backup failure")
+		return
+	}
+	defer conn.Close() // nolint: errcheck
+
+	backupID := ""
+	if task != nil {
+		backupID = task.TaskID()
+	}
+
+	eventID, err := uuid.NewV4()
+	if err != nil {
+		logrus.WithError(err).Error("Failed to create scheduled backup failure event ID")
+		return
+	}
+
+	event := &automate_event.EventMsg{
+		EventID:   eventID.String(),
+		Published: ptypes.TimestampNow(),
+		Type:      &automate_event.EventType{Name: automate_event_type.ScheduledBackupFailed},
+		Producer: &automate_event.Producer{
+			ID:           event_ids.DeploymentServiceProducerID,
+			ProducerName: deploymentServiceName,
+			ProducerType: "system component",
+		},
+		Data: &_struct.Struct{
+			Fields: map[string]*_struct.Value{
+				"backup_id": {Kind: &_struct.Value_StringValue{StringValue: backupID}},
+				"error":     {Kind: &_struct.Value_StringValue{StringValue: failure.Error()}},
+			},
+		},
+	}
+
+	_, err = automate_event.NewEventServiceClient(conn).Publish(ctx, &automate_event.PublishRequest{Msg: event})
+	if err != nil {
+		logrus.WithError(err).Error("Failed to publish scheduled backup failure")
+	}
+}
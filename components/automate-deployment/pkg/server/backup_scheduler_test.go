@@ -0,0 +1,85 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func nextRun(t *testing.T, b *backupScheduler) time.Time {
+	status := b.Status()
+	require.NotNil(t, status)
+	next, err := ptypes.Timestamp(status.NextRun)
+	require.NoError(t, err)
+	return next
+}
+
+func TestBackupSchedulerDue(t *testing.T) {
+	start := time.Date(2019, 7, 1, 12, 30, 0, 0, time.UTC)
+	daily := "0 2 * * *"
+
+	t.Run("is never due without a schedule", func(t *testing.T) {
+		b := &backupScheduler{}
+		assert.False(t, b.due(start, ""))
+		assert.False(t, b.due(start.Add(48*time.Hour), ""))
+		assert.Nil(t, b.Status())
+	})
+
+	t.Run("schedules the next run when the schedule is configured", func(t *testing.T) {
+		b := &backupScheduler{}
+		assert.False(t, b.due(start, daily))
+		assert.Equal(t, daily, b.Status().Cron)
+		assert.Equal(t, time.Date(2019, 7, 2, 2, 0, 0, 0, time.UTC), nextRun(t, b))
+	})
+
+	t.Run("is due once the next run is reached", func(t *testing.T) {
+		b := &backupScheduler{}
+		b.due(start, daily)
+
+		assert.False(t, b.due(time.Date(2019, 7, 2, 1, 59, 0, 0, time.UTC), daily))
+		assert.True(t, b.due(time.Date(2019, 7, 2, 2, 0, 0, 0, time.UTC), daily))
+		assert.Equal(t, time.Date(2019, 7, 3, 2, 0, 0, 0, time.UTC), nextRun(t, b))
+		assert.False(t, b.due(time.Date(2019, 7, 2, 2, 1, 0, 0, time.UTC), daily))
+	})
+
+	t.Run("runs once after missed runs", func(t *testing.T) {
+		b := &backupScheduler{}
+		b.due(start, daily)
+
+		late := time.Date(2019, 7, 5, 12, 0, 0, 0, time.UTC)
+		assert.True(t, b.due(late, daily))
+		assert.False(t, b.due(late.Add(time.Minute), daily))
+		assert.Equal(t, time.Date(2019, 7, 6, 2, 0, 0, 0, time.UTC), nextRun(t, b))
+	})
+
+	t.Run("reschedules when the schedule changes", func(t *testing.T) {
+		b := &backupScheduler{}
+		b.due(start, daily)
+
+		hourly := "0 * * * *"
+		assert.False(t, b.due(start, hourly))
+		assert.Equal(t, hourly, b.Status().Cron)
+		assert.Equal(t, time.Date(2019, 7, 1, 13, 0, 0, 0, time.UTC), nextRun(t, b))
+
+		assert.False(t, b.due(start, ""))
+		assert.Nil(t, b.Status())
+	})
+
+	t.Run("is never due with an invalid schedule", func(t *testing.T) {
+		b := &backupScheduler{}
+		assert.False(t, b.due(start, "not a cron spec"))
+		assert.False(t, b.due(start.Add(48*time.Hour), "not a cron spec"))
+		assert.Nil(t, b.Status())
+	})
+}
+
+func TestBackupSchedulerChecksDontOverlap(t *testing.T) {
+	b := &backupScheduler{}
+	require.True(t, b.startCheck())
+	assert.False(t, b.startCheck())
+	b.finishCheck()
+	assert.True(t, b.startCheck())
+}
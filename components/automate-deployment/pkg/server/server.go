@@ -73,6 +73,7 @@ type server struct {
 	ensureStatusTimeout  time.Duration
 	ensureStatusInterval time.Duration
 	backupRunner         *backup.Runner
+	backupScheduler      *backupScheduler
 
 	releaseManifestProvider manifest.CachingReleaseManifestProvider
 
@@ -1047,6 +1048,7 @@ func StartServer(config *Config) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to load the backup runner")
 	}
+	server.backupScheduler = newBackupScheduler(server)
 
 	// register grpc services
 	api.RegisterDeploymentServer(grpcServer, server)
@@ -1090,6 +1092,7 @@ func StartServer(config *Config) error {
 	go server.ReconfigureHandler(hupChan, grpcServer)
 
 	server.convergeLoop.Start()
+	server.backupScheduler.Start()
 	return grpcServer.Serve(listener)
 }
 
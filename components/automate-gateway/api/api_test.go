@@ -18,6 +18,7 @@ import (
 	_ "github.com/chef/automate/components/automate-gateway/api/compliance/reporting"
 	_ "github.com/chef/automate/components/automate-gateway/api/compliance/reporting/stats"
 	_ "github.com/chef/automate/components/automate-gateway/api/compliance/scanner/jobs"
+	_ "github.com/chef/automate/components/automate-gateway/api/compliance/waivers"
 	_ "github.com/chef/automate/components/automate-gateway/api/deployment"
 	_ "github.com/chef/automate/components/automate-gateway/api/event_feed"
 	_ "github.com/chef/automate/components/automate-gateway/api/gateway"
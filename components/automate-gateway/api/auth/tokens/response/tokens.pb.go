@@ -19,12 +19,15 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Token struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	Created              string   `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
-	Updated              string   `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// value is only returned when the token is created
+	Value   string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Active  bool   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Created string `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	Updated string `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	// value_prefix is the start of the value, for telling tokens apart
+	ValuePrefix          string   `protobuf:"bytes,11,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -34,7 +37,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_a3b458063a88fe05, []int{0}
+	return fileDescriptor_tokens_86345ecce81ac83d, []int{0}
 }
 func (m *Token) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Token.Unmarshal(m, b)
@@ -96,6 +99,13 @@ func (m *Token) GetUpdated() string {
 	return ""
 }
 
+func (m *Token) GetValuePrefix() string {
+	if m != nil {
+		return m.ValuePrefix
+	}
+	return ""
+}
+
 type Tokens struct {
 	Tokens               []*Token `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -107,7 +117,7 @@ func (m *Tokens) Reset()         { *m = Tokens{} }
 func (m *Tokens) String() string { return proto.CompactTextString(m) }
 func (*Tokens) ProtoMessage()    {}
 func (*Tokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_a3b458063a88fe05, []int{1}
+	return fileDescriptor_tokens_86345ecce81ac83d, []int{1}
 }
 func (m *Tokens) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Tokens.Unmarshal(m, b)
@@ -144,7 +154,7 @@ func (m *DeleteTokenResp) Reset()         { *m = DeleteTokenResp{} }
 func (m *DeleteTokenResp) String() string { return proto.CompactTextString(m) }
 func (*DeleteTokenResp) ProtoMessage()    {}
 func (*DeleteTokenResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_a3b458063a88fe05, []int{2}
+	return fileDescriptor_tokens_86345ecce81ac83d, []int{2}
 }
 func (m *DeleteTokenResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteTokenResp.Unmarshal(m, b)
@@ -171,26 +181,27 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/auth/tokens/response/tokens.proto", fileDescriptor_tokens_a3b458063a88fe05)
-}
-
-var fileDescriptor_tokens_a3b458063a88fe05 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x90, 0xc1, 0x4a, 0xc4, 0x30,
-	0x10, 0x86, 0x69, 0xd7, 0x56, 0x9d, 0x05, 0xc5, 0x20, 0x92, 0x63, 0xed, 0xa9, 0x17, 0x13, 0xd0,
-	0x17, 0x50, 0xf1, 0x24, 0x88, 0x50, 0x3c, 0x79, 0xcb, 0xa6, 0xe3, 0x36, 0xb8, 0x6d, 0x42, 0x33,
-	0x5d, 0xf1, 0x59, 0x7c, 0x59, 0x69, 0x9a, 0x8a, 0x37, 0xc1, 0xe3, 0xf7, 0x7f, 0x33, 0x61, 0xf2,
-	0xc3, 0x9d, 0xb6, 0x9d, 0xb3, 0x3d, 0xf6, 0xe4, 0xa5, 0x1a, 0xc9, 0x76, 0x8a, 0xf0, 0x6a, 0xab,
-	0x08, 0x3f, 0xd4, 0xa7, 0x54, 0xce, 0x4c, 0x61, 0x2b, 0xc9, 0xbe, 0x63, 0xef, 0xe5, 0x80, 0xde,
-	0xd9, 0xde, 0x63, 0x64, 0xe1, 0x06, 0x4b, 0x96, 0x5d, 0xea, 0x16, 0xdf, 0xc4, 0xb2, 0x2c, 0x94,
-	0x33, 0x22, 0xfa, 0x65, 0xbe, 0xfc, 0x4a, 0x20, 0x7b, 0x99, 0x32, 0x76, 0x02, 0xa9, 0x69, 0x78,
-	0x52, 0x24, 0xd5, 0x71, 0x9d, 0x9a, 0x86, 0x15, 0xb0, 0x6e, 0xd0, 0xeb, 0xc1, 0x38, 0x32, 0xb6,
-	0xe7, 0x69, 0x10, 0xbf, 0x23, 0x76, 0x0e, 0xd9, 0x5e, 0xed, 0x46, 0xe4, 0xab, 0xe0, 0x66, 0x60,
-	0x17, 0x90, 0x2b, 0x4d, 0x66, 0x8f, 0xfc, 0xa0, 0x48, 0xaa, 0xa3, 0x3a, 0x12, 0xe3, 0x70, 0xa8,
-	0x07, 0x54, 0x84, 0x0d, 0xcf, 0xc2, 0xfc, 0x82, 0x93, 0x19, 0x5d, 0x13, 0x4c, 0x3e, 0x9b, 0x88,
-	0xe5, 0x23, 0xe4, 0xe1, 0x38, 0xcf, 0x6e, 0x21, 0x9f, 0x4f, 0xe7, 0x49, 0xb1, 0xaa, 0xd6, 0xd7,
-	0x95, 0xf8, 0xf3, 0x6f, 0x22, 0xac, 0xd6, 0x71, 0xaf, 0x3c, 0x83, 0xd3, 0x07, 0xdc, 0x21, 0xe1,
-	0x1c, 0xa3, 0x77, 0xf7, 0xcf, 0xaf, 0x4f, 0x5b, 0x43, 0xed, 0xb8, 0x11, 0xda, 0x76, 0x72, 0x7a,
-	0xf0, 0xa7, 0x69, 0xf9, 0x9f, 0xf6, 0x37, 0x79, 0xe8, 0xfd, 0xe6, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x26, 0xcd, 0xf1, 0xfe, 0xbc, 0x01, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/auth/tokens/response/tokens.proto", fileDescriptor_tokens_86345ecce81ac83d)
+}
+
+var fileDescriptor_tokens_86345ecce81ac83d = []byte{
+	// 281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x4a, 0xc4, 0x30,
+	0x10, 0x86, 0xe9, 0xae, 0x5b, 0x75, 0x2a, 0x8a, 0x41, 0x24, 0xc7, 0x6e, 0x4f, 0xbd, 0x98, 0x80,
+	0xbe, 0x80, 0x8a, 0x27, 0x41, 0x94, 0xe2, 0xc9, 0x8b, 0x64, 0xd3, 0xd9, 0x6d, 0x70, 0xdb, 0x84,
+	0x66, 0xba, 0xea, 0xcb, 0xf9, 0x6c, 0xd2, 0xb4, 0x15, 0x6f, 0x82, 0xc7, 0xff, 0xfb, 0x66, 0x86,
+	0x19, 0x06, 0x6e, 0xb4, 0xad, 0x9d, 0x6d, 0xb0, 0x21, 0x2f, 0x55, 0x47, 0xb6, 0x56, 0x84, 0x17,
+	0x1b, 0x45, 0xf8, 0xae, 0x3e, 0xa5, 0x72, 0xa6, 0x87, 0x95, 0x24, 0xfb, 0x86, 0x8d, 0x97, 0x2d,
+	0x7a, 0x67, 0x1b, 0x8f, 0x63, 0x16, 0xae, 0xb5, 0x64, 0xd9, 0x52, 0x57, 0xb8, 0x16, 0x53, 0xb3,
+	0x50, 0xce, 0x88, 0xd1, 0x4f, 0xf5, 0xd9, 0x57, 0x04, 0x8b, 0xe7, 0x9e, 0xb1, 0x63, 0x98, 0x99,
+	0x92, 0x47, 0x69, 0x94, 0x1f, 0x16, 0x33, 0x53, 0xb2, 0x14, 0x92, 0x12, 0xbd, 0x6e, 0x8d, 0x23,
+	0x63, 0x1b, 0x3e, 0x0b, 0xe2, 0x37, 0x62, 0x67, 0xb0, 0xd8, 0xa9, 0x6d, 0x87, 0x7c, 0x1e, 0xdc,
+	0x10, 0xd8, 0x39, 0xc4, 0x4a, 0x93, 0xd9, 0x21, 0xdf, 0x4b, 0xa3, 0xfc, 0xa0, 0x18, 0x13, 0xe3,
+	0xb0, 0xaf, 0x5b, 0x54, 0x84, 0x25, 0x5f, 0x84, 0xfa, 0x29, 0xf6, 0xa6, 0x73, 0x65, 0x30, 0xf1,
+	0x60, 0xc6, 0xc8, 0x96, 0x70, 0x14, 0x86, 0xbe, 0xba, 0x16, 0xd7, 0xe6, 0x83, 0x27, 0xc3, 0x12,
+	0x81, 0x3d, 0x05, 0x94, 0xdd, 0x43, 0x1c, 0xf6, 0xf7, 0xec, 0x1a, 0xe2, 0xe1, 0x3a, 0x1e, 0xa5,
+	0xf3, 0x3c, 0xb9, 0xcc, 0xc5, 0x9f, 0xe7, 0x8b, 0xd0, 0x5a, 0x8c, 0x7d, 0xd9, 0x29, 0x9c, 0xdc,
+	0xe1, 0x16, 0x09, 0x07, 0x8c, 0xde, 0xdd, 0x3e, 0xbe, 0x3c, 0x6c, 0x0c, 0x55, 0xdd, 0x4a, 0x68,
+	0x5b, 0xcb, 0x7e, 0xe0, 0xcf, 0x33, 0xe4, 0x7f, 0x1e, 0xb4, 0x8a, 0xc3, 0x6b, 0xae, 0xbe, 0x07,
+	0x00, 0x01, 0xfa, 0xb8, 0x20, 0xdf, 0x01, 0x00, 0x00,
 }
@@ -182,7 +182,8 @@ func init() {
           "type": "string"
         },
         "value": {
-          "type": "string"
+          "type": "string",
+          "title": "value is only returned when the token is created"
         },
         "active": {
           "type": "boolean",
@@ -193,6 +194,10 @@ func init() {
         },
         "updated": {
           "type": "string"
+        },
+        "value_prefix": {
+          "type": "string",
+          "title": "value_prefix is the start of the value, for telling tokens apart"
         }
       }
     },
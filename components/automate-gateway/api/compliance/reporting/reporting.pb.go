@@ -49,7 +49,7 @@ func (x Query_OrderType) String() string {
 	return proto.EnumName(Query_OrderType_name, int32(x))
 }
 func (Query_OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{3, 0}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{3, 0}
 }
 
 type TimeQuery struct {
@@ -63,7 +63,7 @@ func (m *TimeQuery) Reset()         { *m = TimeQuery{} }
 func (m *TimeQuery) String() string { return proto.CompactTextString(m) }
 func (*TimeQuery) ProtoMessage()    {}
 func (*TimeQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{0}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{0}
 }
 func (m *TimeQuery) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TimeQuery.Unmarshal(m, b)
@@ -101,7 +101,7 @@ func (m *ExportData) Reset()         { *m = ExportData{} }
 func (m *ExportData) String() string { return proto.CompactTextString(m) }
 func (*ExportData) ProtoMessage()    {}
 func (*ExportData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{1}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{1}
 }
 func (m *ExportData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExportData.Unmarshal(m, b)
@@ -139,7 +139,7 @@ func (m *Id) Reset()         { *m = Id{} }
 func (m *Id) String() string { return proto.CompactTextString(m) }
 func (*Id) ProtoMessage()    {}
 func (*Id) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{2}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{2}
 }
 func (m *Id) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Id.Unmarshal(m, b)
@@ -183,7 +183,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{3}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{3}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -264,7 +264,7 @@ func (m *ListFilter) Reset()         { *m = ListFilter{} }
 func (m *ListFilter) String() string { return proto.CompactTextString(m) }
 func (*ListFilter) ProtoMessage()    {}
 func (*ListFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{4}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{4}
 }
 func (m *ListFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListFilter.Unmarshal(m, b)
@@ -309,7 +309,7 @@ func (m *Total) Reset()         { *m = Total{} }
 func (m *Total) String() string { return proto.CompactTextString(m) }
 func (*Total) ProtoMessage()    {}
 func (*Total) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{5}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{5}
 }
 func (m *Total) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Total.Unmarshal(m, b)
@@ -350,7 +350,7 @@ func (m *Failed) Reset()         { *m = Failed{} }
 func (m *Failed) String() string { return proto.CompactTextString(m) }
 func (*Failed) ProtoMessage()    {}
 func (*Failed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{6}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{6}
 }
 func (m *Failed) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Failed.Unmarshal(m, b)
@@ -412,7 +412,7 @@ func (m *ControlSummary) Reset()         { *m = ControlSummary{} }
 func (m *ControlSummary) String() string { return proto.CompactTextString(m) }
 func (*ControlSummary) ProtoMessage()    {}
 func (*ControlSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{7}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{7}
 }
 func (m *ControlSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlSummary.Unmarshal(m, b)
@@ -472,7 +472,7 @@ func (m *Reports) Reset()         { *m = Reports{} }
 func (m *Reports) String() string { return proto.CompactTextString(m) }
 func (*Reports) ProtoMessage()    {}
 func (*Reports) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{8}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{8}
 }
 func (m *Reports) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Reports.Unmarshal(m, b)
@@ -507,30 +507,31 @@ func (m *Reports) GetTotal() int32 {
 }
 
 type Report struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NodeId               string               `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	NodeName             string               `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	EndTime              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Status               string               `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Controls             *ControlSummary      `protobuf:"bytes,6,opt,name=controls,proto3" json:"controls,omitempty"`
-	Environment          string               `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
-	Version              string               `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
-	Platform             *Platform            `protobuf:"bytes,9,opt,name=platform,proto3" json:"platform,omitempty"`
-	Statistics           *Statistics          `protobuf:"bytes,10,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Profiles             []*Profile           `protobuf:"bytes,11,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	JobId                string               `protobuf:"bytes,12,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Ipaddress            string               `protobuf:"bytes,13,opt,name=ipaddress,proto3" json:"ipaddress,omitempty"`
-	Fqdn                 string               `protobuf:"bytes,14,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId   string               `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName string               `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	EndTime  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// waived if every failed control is waived
+	Status               string          `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Controls             *ControlSummary `protobuf:"bytes,6,opt,name=controls,proto3" json:"controls,omitempty"`
+	Environment          string          `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	Version              string          `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+	Platform             *Platform       `protobuf:"bytes,9,opt,name=platform,proto3" json:"platform,omitempty"`
+	Statistics           *Statistics     `protobuf:"bytes,10,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Profiles             []*Profile      `protobuf:"bytes,11,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	JobId                string          `protobuf:"bytes,12,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Ipaddress            string          `protobuf:"bytes,13,opt,name=ipaddress,proto3" json:"ipaddress,omitempty"`
+	Fqdn                 string          `protobuf:"bytes,14,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{9}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{9}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Report.Unmarshal(m, b)
@@ -676,7 +677,7 @@ func (m *Profile) Reset()         { *m = Profile{} }
 func (m *Profile) String() string { return proto.CompactTextString(m) }
 func (*Profile) ProtoMessage()    {}
 func (*Profile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{10}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{10}
 }
 func (m *Profile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Profile.Unmarshal(m, b)
@@ -834,7 +835,7 @@ func (m *Ref) Reset()         { *m = Ref{} }
 func (m *Ref) String() string { return proto.CompactTextString(m) }
 func (*Ref) ProtoMessage()    {}
 func (*Ref) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{11}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{11}
 }
 func (m *Ref) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ref.Unmarshal(m, b)
@@ -884,7 +885,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{12}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{12}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Result.Unmarshal(m, b)
@@ -958,7 +959,7 @@ func (m *SourceLocation) Reset()         { *m = SourceLocation{} }
 func (m *SourceLocation) String() string { return proto.CompactTextString(m) }
 func (*SourceLocation) ProtoMessage()    {}
 func (*SourceLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{13}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{13}
 }
 func (m *SourceLocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SourceLocation.Unmarshal(m, b)
@@ -1004,7 +1005,7 @@ func (m *Option) Reset()         { *m = Option{} }
 func (m *Option) String() string { return proto.CompactTextString(m) }
 func (*Option) ProtoMessage()    {}
 func (*Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{14}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{14}
 }
 func (m *Option) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Option.Unmarshal(m, b)
@@ -1053,7 +1054,7 @@ func (m *Support) Reset()         { *m = Support{} }
 func (m *Support) String() string { return proto.CompactTextString(m) }
 func (*Support) ProtoMessage()    {}
 func (*Support) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{15}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{15}
 }
 func (m *Support) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Support.Unmarshal(m, b)
@@ -1131,7 +1132,7 @@ func (m *Dependency) Reset()         { *m = Dependency{} }
 func (m *Dependency) String() string { return proto.CompactTextString(m) }
 func (*Dependency) ProtoMessage()    {}
 func (*Dependency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{16}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{16}
 }
 func (m *Dependency) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Dependency.Unmarshal(m, b)
@@ -1255,7 +1256,7 @@ func (m *Group) Reset()         { *m = Group{} }
 func (m *Group) String() string { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()    {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{17}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{17}
 }
 func (m *Group) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Group.Unmarshal(m, b)
@@ -1297,25 +1298,27 @@ func (m *Group) GetControls() []string {
 }
 
 type Control struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code                 string            `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Desc                 string            `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
-	Impact               float32           `protobuf:"fixed32,4,opt,name=impact,proto3" json:"impact,omitempty"`
-	Title                string            `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	SourceLocation       *SourceLocation   `protobuf:"bytes,6,opt,name=source_location,json=sourceLocation,proto3" json:"source_location,omitempty"`
-	Results              []*Result         `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
-	Refs                 []*Ref            `protobuf:"bytes,8,rep,name=refs,proto3" json:"refs,omitempty"`
-	Tags                 map[string]string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id             string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string            `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Desc           string            `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Impact         float32           `protobuf:"fixed32,4,opt,name=impact,proto3" json:"impact,omitempty"`
+	Title          string            `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	SourceLocation *SourceLocation   `protobuf:"bytes,6,opt,name=source_location,json=sourceLocation,proto3" json:"source_location,omitempty"`
+	Results        []*Result         `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+	Refs           []*Ref            `protobuf:"bytes,8,rep,name=refs,proto3" json:"refs,omitempty"`
+	Tags           map[string]string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// set when the control failed and a waiver applies to it
+	Waiver               *ControlWaiver `protobuf:"bytes,10,opt,name=waiver,proto3" json:"waiver,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *Control) Reset()         { *m = Control{} }
 func (m *Control) String() string { return proto.CompactTextString(m) }
 func (*Control) ProtoMessage()    {}
 func (*Control) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{18}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{18}
 }
 func (m *Control) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Control.Unmarshal(m, b)
@@ -1398,6 +1401,67 @@ func (m *Control) GetTags() map[string]string {
 	return nil
 }
 
+func (m *Control) GetWaiver() *ControlWaiver {
+	if m != nil {
+		return m.Waiver
+	}
+	return nil
+}
+
+type ControlWaiver struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Justification        string               `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ControlWaiver) Reset()         { *m = ControlWaiver{} }
+func (m *ControlWaiver) String() string { return proto.CompactTextString(m) }
+func (*ControlWaiver) ProtoMessage()    {}
+func (*ControlWaiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f45c117eac59be60, []int{19}
+}
+func (m *ControlWaiver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlWaiver.Unmarshal(m, b)
+}
+func (m *ControlWaiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlWaiver.Marshal(b, m, deterministic)
+}
+func (dst *ControlWaiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlWaiver.Merge(dst, src)
+}
+func (m *ControlWaiver) XXX_Size() int {
+	return xxx_messageInfo_ControlWaiver.Size(m)
+}
+func (m *ControlWaiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlWaiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlWaiver proto.InternalMessageInfo
+
+func (m *ControlWaiver) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ControlWaiver) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+func (m *ControlWaiver) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 type Attribute struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Options              *Option  `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
@@ -1410,7 +1474,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{19}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{20}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Attribute.Unmarshal(m, b)
@@ -1456,7 +1520,7 @@ func (m *Platform) Reset()         { *m = Platform{} }
 func (m *Platform) String() string { return proto.CompactTextString(m) }
 func (*Platform) ProtoMessage()    {}
 func (*Platform) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{20}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{21}
 }
 func (m *Platform) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Platform.Unmarshal(m, b)
@@ -1501,7 +1565,7 @@ func (m *Statistics) Reset()         { *m = Statistics{} }
 func (m *Statistics) String() string { return proto.CompactTextString(m) }
 func (*Statistics) ProtoMessage()    {}
 func (*Statistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{21}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{22}
 }
 func (m *Statistics) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Statistics.Unmarshal(m, b)
@@ -1542,7 +1606,7 @@ func (m *SuggestionRequest) Reset()         { *m = SuggestionRequest{} }
 func (m *SuggestionRequest) String() string { return proto.CompactTextString(m) }
 func (*SuggestionRequest) ProtoMessage()    {}
 func (*SuggestionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{22}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{23}
 }
 func (m *SuggestionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SuggestionRequest.Unmarshal(m, b)
@@ -1604,7 +1668,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{23}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{24}
 }
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Suggestion.Unmarshal(m, b)
@@ -1663,7 +1727,7 @@ func (m *Suggestions) Reset()         { *m = Suggestions{} }
 func (m *Suggestions) String() string { return proto.CompactTextString(m) }
 func (*Suggestions) ProtoMessage()    {}
 func (*Suggestions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{24}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{25}
 }
 func (m *Suggestions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Suggestions.Unmarshal(m, b)
@@ -1702,7 +1766,7 @@ func (m *ProfileMins) Reset()         { *m = ProfileMins{} }
 func (m *ProfileMins) String() string { return proto.CompactTextString(m) }
 func (*ProfileMins) ProtoMessage()    {}
 func (*ProfileMins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{25}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{26}
 }
 func (m *ProfileMins) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileMins.Unmarshal(m, b)
@@ -1750,7 +1814,7 @@ func (m *ProfileCounts) Reset()         { *m = ProfileCounts{} }
 func (m *ProfileCounts) String() string { return proto.CompactTextString(m) }
 func (*ProfileCounts) ProtoMessage()    {}
 func (*ProfileCounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{26}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{27}
 }
 func (m *ProfileCounts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileCounts.Unmarshal(m, b)
@@ -1813,7 +1877,7 @@ func (m *ProfileMin) Reset()         { *m = ProfileMin{} }
 func (m *ProfileMin) String() string { return proto.CompactTextString(m) }
 func (*ProfileMin) ProtoMessage()    {}
 func (*ProfileMin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{27}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{28}
 }
 func (m *ProfileMin) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileMin.Unmarshal(m, b)
@@ -1885,7 +1949,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{28}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{29}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -1966,7 +2030,7 @@ func (m *Nodes) Reset()         { *m = Nodes{} }
 func (m *Nodes) String() string { return proto.CompactTextString(m) }
 func (*Nodes) ProtoMessage()    {}
 func (*Nodes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{29}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{30}
 }
 func (m *Nodes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Nodes.Unmarshal(m, b)
@@ -2012,7 +2076,7 @@ func (m *Kv) Reset()         { *m = Kv{} }
 func (m *Kv) String() string { return proto.CompactTextString(m) }
 func (*Kv) ProtoMessage()    {}
 func (*Kv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{30}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{31}
 }
 func (m *Kv) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Kv.Unmarshal(m, b)
@@ -2060,7 +2124,7 @@ func (m *LatestReportSummary) Reset()         { *m = LatestReportSummary{} }
 func (m *LatestReportSummary) String() string { return proto.CompactTextString(m) }
 func (*LatestReportSummary) ProtoMessage()    {}
 func (*LatestReportSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{31}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{32}
 }
 func (m *LatestReportSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LatestReportSummary.Unmarshal(m, b)
@@ -2121,7 +2185,7 @@ func (m *ProfileMeta) Reset()         { *m = ProfileMeta{} }
 func (m *ProfileMeta) String() string { return proto.CompactTextString(m) }
 func (*ProfileMeta) ProtoMessage()    {}
 func (*ProfileMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_6fb1619daca0336e, []int{32}
+	return fileDescriptor_reporting_f45c117eac59be60, []int{33}
 }
 func (m *ProfileMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileMeta.Unmarshal(m, b)
@@ -2162,6 +2226,318 @@ func (m *ProfileMeta) GetId() string {
 	return ""
 }
 
+type DiffQuery struct {
+	// the reports to compare, from_id being the earlier one
+	FromId string `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// when no report ids are given, the latest report of the node is compared
+	// with its latest report before the given time or, if no time is given,
+	// with the report before its latest one
+	NodeId               string               `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Before               *timestamp.Timestamp `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DiffQuery) Reset()         { *m = DiffQuery{} }
+func (m *DiffQuery) String() string { return proto.CompactTextString(m) }
+func (*DiffQuery) ProtoMessage()    {}
+func (*DiffQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f45c117eac59be60, []int{34}
+}
+func (m *DiffQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffQuery.Unmarshal(m, b)
+}
+func (m *DiffQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffQuery.Marshal(b, m, deterministic)
+}
+func (dst *DiffQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffQuery.Merge(dst, src)
+}
+func (m *DiffQuery) XXX_Size() int {
+	return xxx_messageInfo_DiffQuery.Size(m)
+}
+func (m *DiffQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffQuery proto.InternalMessageInfo
+
+func (m *DiffQuery) GetFromId() string {
+	if m != nil {
+		return m.FromId
+	}
+	return ""
+}
+
+func (m *DiffQuery) GetToId() string {
+	if m != nil {
+		return m.ToId
+	}
+	return ""
+}
+
+func (m *DiffQuery) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *DiffQuery) GetBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+type ReportDiff struct {
+	From *Report `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *Report `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// controls that passed or were skipped and now fail
+	NewlyFailed []*ControlDiff `protobuf:"bytes,3,rep,name=newly_failed,json=newlyFailed,proto3" json:"newly_failed,omitempty"`
+	// controls that failed and now pass
+	NewlyPassed []*ControlDiff `protobuf:"bytes,4,rep,name=newly_passed,json=newlyPassed,proto3" json:"newly_passed,omitempty"`
+	// controls that passed or failed and are now skipped
+	NewlySkipped []*ControlDiff `protobuf:"bytes,5,rep,name=newly_skipped,json=newlySkipped,proto3" json:"newly_skipped,omitempty"`
+	// controls whose impact changed, whatever their status
+	ImpactChanged        []*ControlDiff `protobuf:"bytes,6,rep,name=impact_changed,json=impactChanged,proto3" json:"impact_changed,omitempty"`
+	AddedProfiles        []*ProfileDiff `protobuf:"bytes,7,rep,name=added_profiles,json=addedProfiles,proto3" json:"added_profiles,omitempty"`
+	RemovedProfiles      []*ProfileDiff `protobuf:"bytes,8,rep,name=removed_profiles,json=removedProfiles,proto3" json:"removed_profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReportDiff) Reset()         { *m = ReportDiff{} }
+func (m *ReportDiff) String() string { return proto.CompactTextString(m) }
+func (*ReportDiff) ProtoMessage()    {}
+func (*ReportDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f45c117eac59be60, []int{35}
+}
+func (m *ReportDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportDiff.Unmarshal(m, b)
+}
+func (m *ReportDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportDiff.Marshal(b, m, deterministic)
+}
+func (dst *ReportDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportDiff.Merge(dst, src)
+}
+func (m *ReportDiff) XXX_Size() int {
+	return xxx_messageInfo_ReportDiff.Size(m)
+}
+func (m *ReportDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportDiff proto.InternalMessageInfo
+
+func (m *ReportDiff) GetFrom() *Report {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetTo() *Report {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetNewlyFailed() []*ControlDiff {
+	if m != nil {
+		return m.NewlyFailed
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetNewlyPassed() []*ControlDiff {
+	if m != nil {
+		return m.NewlyPassed
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetNewlySkipped() []*ControlDiff {
+	if m != nil {
+		return m.NewlySkipped
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetImpactChanged() []*ControlDiff {
+	if m != nil {
+		return m.ImpactChanged
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetAddedProfiles() []*ProfileDiff {
+	if m != nil {
+		return m.AddedProfiles
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetRemovedProfiles() []*ProfileDiff {
+	if m != nil {
+		return m.RemovedProfiles
+	}
+	return nil
+}
+
+type ControlDiff struct {
+	ProfileName          string   `protobuf:"bytes,1,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	FromStatus           string   `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus             string   `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	FromImpact           float32  `protobuf:"fixed32,6,opt,name=from_impact,json=fromImpact,proto3" json:"from_impact,omitempty"`
+	ToImpact             float32  `protobuf:"fixed32,7,opt,name=to_impact,json=toImpact,proto3" json:"to_impact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ControlDiff) Reset()         { *m = ControlDiff{} }
+func (m *ControlDiff) String() string { return proto.CompactTextString(m) }
+func (*ControlDiff) ProtoMessage()    {}
+func (*ControlDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f45c117eac59be60, []int{36}
+}
+func (m *ControlDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlDiff.Unmarshal(m, b)
+}
+func (m *ControlDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlDiff.Marshal(b, m, deterministic)
+}
+func (dst *ControlDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlDiff.Merge(dst, src)
+}
+func (m *ControlDiff) XXX_Size() int {
+	return xxx_messageInfo_ControlDiff.Size(m)
+}
+func (m *ControlDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlDiff proto.InternalMessageInfo
+
+func (m *ControlDiff) GetProfileName() string {
+	if m != nil {
+		return m.ProfileName
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetFromStatus() string {
+	if m != nil {
+		return m.FromStatus
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetToStatus() string {
+	if m != nil {
+		return m.ToStatus
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetFromImpact() float32 {
+	if m != nil {
+		return m.FromImpact
+	}
+	return 0
+}
+
+func (m *ControlDiff) GetToImpact() float32 {
+	if m != nil {
+		return m.ToImpact
+	}
+	return 0
+}
+
+type ProfileDiff struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Sha256               string   `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileDiff) Reset()         { *m = ProfileDiff{} }
+func (m *ProfileDiff) String() string { return proto.CompactTextString(m) }
+func (*ProfileDiff) ProtoMessage()    {}
+func (*ProfileDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f45c117eac59be60, []int{37}
+}
+func (m *ProfileDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileDiff.Unmarshal(m, b)
+}
+func (m *ProfileDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileDiff.Marshal(b, m, deterministic)
+}
+func (dst *ProfileDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileDiff.Merge(dst, src)
+}
+func (m *ProfileDiff) XXX_Size() int {
+	return xxx_messageInfo_ProfileDiff.Size(m)
+}
+func (m *ProfileDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileDiff proto.InternalMessageInfo
+
+func (m *ProfileDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProfileDiff) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProfileDiff) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ProfileDiff) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TimeQuery)(nil), "chef.automate.api.compliance.reporting.v1.TimeQuery")
 	proto.RegisterType((*ExportData)(nil), "chef.automate.api.compliance.reporting.v1.ExportData")
@@ -2183,6 +2559,7 @@ func init() {
 	proto.RegisterType((*Group)(nil), "chef.automate.api.compliance.reporting.v1.Group")
 	proto.RegisterType((*Control)(nil), "chef.automate.api.compliance.reporting.v1.Control")
 	proto.RegisterMapType((map[string]string)(nil), "chef.automate.api.compliance.reporting.v1.Control.TagsEntry")
+	proto.RegisterType((*ControlWaiver)(nil), "chef.automate.api.compliance.reporting.v1.ControlWaiver")
 	proto.RegisterType((*Attribute)(nil), "chef.automate.api.compliance.reporting.v1.Attribute")
 	proto.RegisterType((*Platform)(nil), "chef.automate.api.compliance.reporting.v1.Platform")
 	proto.RegisterType((*Statistics)(nil), "chef.automate.api.compliance.reporting.v1.Statistics")
@@ -2197,6 +2574,10 @@ func init() {
 	proto.RegisterType((*Kv)(nil), "chef.automate.api.compliance.reporting.v1.Kv")
 	proto.RegisterType((*LatestReportSummary)(nil), "chef.automate.api.compliance.reporting.v1.LatestReportSummary")
 	proto.RegisterType((*ProfileMeta)(nil), "chef.automate.api.compliance.reporting.v1.ProfileMeta")
+	proto.RegisterType((*DiffQuery)(nil), "chef.automate.api.compliance.reporting.v1.DiffQuery")
+	proto.RegisterType((*ReportDiff)(nil), "chef.automate.api.compliance.reporting.v1.ReportDiff")
+	proto.RegisterType((*ControlDiff)(nil), "chef.automate.api.compliance.reporting.v1.ControlDiff")
+	proto.RegisterType((*ProfileDiff)(nil), "chef.automate.api.compliance.reporting.v1.ProfileDiff")
 	proto.RegisterEnum("chef.automate.api.compliance.reporting.v1.Query_OrderType", Query_OrderType_name, Query_OrderType_value)
 }
 
@@ -2220,6 +2601,8 @@ type ReportingServiceClient interface {
 	ListSuggestions(ctx context.Context, in *SuggestionRequest, opts ...grpc.CallOption) (*Suggestions, error)
 	// should cover /search/profiles
 	ListProfiles(ctx context.Context, in *Query, opts ...grpc.CallOption) (*ProfileMins, error)
+	// should cover /reports/diff
+	DiffReports(ctx context.Context, in *DiffQuery, opts ...grpc.CallOption) (*ReportDiff, error)
 	Export(ctx context.Context, in *Query, opts ...grpc.CallOption) (ReportingService_ExportClient, error)
 	ReadNode(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Node, error)
 	ListNodes(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Nodes, error)
@@ -2271,6 +2654,15 @@ func (c *reportingServiceClient) ListProfiles(ctx context.Context, in *Query, op
 	return out, nil
 }
 
+func (c *reportingServiceClient) DiffReports(ctx context.Context, in *DiffQuery, opts ...grpc.CallOption) (*ReportDiff, error) {
+	out := new(ReportDiff)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.compliance.reporting.v1.ReportingService/DiffReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reportingServiceClient) Export(ctx context.Context, in *Query, opts ...grpc.CallOption) (ReportingService_ExportClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_ReportingService_serviceDesc.Streams[0], "/chef.automate.api.compliance.reporting.v1.ReportingService/Export", opts...)
 	if err != nil {
@@ -2349,6 +2741,8 @@ type ReportingServiceServer interface {
 	ListSuggestions(context.Context, *SuggestionRequest) (*Suggestions, error)
 	// should cover /search/profiles
 	ListProfiles(context.Context, *Query) (*ProfileMins, error)
+	// should cover /reports/diff
+	DiffReports(context.Context, *DiffQuery) (*ReportDiff, error)
 	Export(*Query, ReportingService_ExportServer) error
 	ReadNode(context.Context, *Id) (*Node, error)
 	ListNodes(context.Context, *Query) (*Nodes, error)
@@ -2432,6 +2826,24 @@ func _ReportingService_ListProfiles_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingService_DiffReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).DiffReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.compliance.reporting.v1.ReportingService/DiffReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).DiffReports(ctx, req.(*DiffQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ReportingService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Query)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2545,6 +2957,10 @@ var _ReportingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListProfiles",
 			Handler:    _ReportingService_ListProfiles_Handler,
 		},
+		{
+			MethodName: "DiffReports",
+			Handler:    _ReportingService_DiffReports_Handler,
+		},
 		{
 			MethodName: "ReadNode",
 			Handler:    _ReportingService_ReadNode_Handler,
@@ -2573,165 +2989,189 @@ var _ReportingService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/compliance/reporting/reporting.proto", fileDescriptor_reporting_6fb1619daca0336e)
-}
-
-var fileDescriptor_reporting_6fb1619daca0336e = []byte{
-	// 2486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xf7, 0x0e, 0xf7, 0x59, 0x4b, 0xd1, 0xd2, 0x98, 0xa2, 0xc6, 0x2b, 0x4b, 0xa6, 0xc6, 0x9f,
-	0x9e, 0xf8, 0xb4, 0x94, 0xa9, 0x48, 0x96, 0x18, 0xe7, 0x21, 0x8b, 0x94, 0x43, 0xeb, 0x45, 0x0f,
-	0xa9, 0x04, 0xc8, 0x85, 0x69, 0xce, 0xf4, 0x2e, 0x47, 0x9a, 0x97, 0xa7, 0x7b, 0x68, 0x6d, 0x8c,
-	0x5c, 0x1c, 0x03, 0x41, 0x04, 0x04, 0x08, 0x9c, 0x43, 0x10, 0xc0, 0x87, 0x00, 0x39, 0xe7, 0x90,
-	0x20, 0xfa, 0x23, 0x82, 0x1c, 0x72, 0xc8, 0x2d, 0xe7, 0xdc, 0x83, 0x5c, 0x73, 0x0a, 0xaa, 0x1f,
-	0x33, 0xb3, 0xda, 0x25, 0xa5, 0x21, 0x74, 0x62, 0x57, 0x75, 0xf7, 0x6f, 0xba, 0xab, 0x7f, 0x55,
-	0xd5, 0xd5, 0x4b, 0x58, 0x75, 0xe3, 0x30, 0x89, 0x23, 0x1a, 0x71, 0xb6, 0x44, 0x32, 0x1e, 0x87,
-	0x84, 0xd3, 0xcb, 0x43, 0xc2, 0xe9, 0xe7, 0x64, 0xb4, 0x44, 0x12, 0x7f, 0x09, 0xfb, 0x03, 0x9f,
-	0x44, 0x2e, 0x5d, 0x4a, 0x69, 0x12, 0xa7, 0xdc, 0x8f, 0x86, 0x45, 0xab, 0x9f, 0xa4, 0x31, 0x8f,
-	0xcd, 0x8b, 0xee, 0x2e, 0x1d, 0xf4, 0xf5, 0xfc, 0x3e, 0x49, 0xfc, 0x7e, 0x31, 0xaf, 0x5f, 0x8c,
-	0xde, 0x7b, 0xbf, 0xf7, 0xce, 0x30, 0x8e, 0x87, 0x01, 0x15, 0xd8, 0x24, 0x8a, 0x62, 0x4e, 0xb8,
-	0x1f, 0x47, 0x4c, 0x02, 0xf5, 0xde, 0x55, 0xbd, 0x42, 0xda, 0xc9, 0x06, 0x4b, 0xdc, 0x0f, 0x29,
-	0xe3, 0x24, 0x4c, 0xd4, 0x80, 0x93, 0x2f, 0x0e, 0xa0, 0x61, 0xc2, 0x47, 0xaa, 0xf3, 0x22, 0x82,
-	0xd2, 0xa7, 0x9c, 0xa6, 0x11, 0x09, 0x70, 0xe5, 0x61, 0x1c, 0x2d, 0xed, 0xd1, 0x94, 0xf9, 0xc5,
-	0x5f, 0x35, 0xf4, 0xfb, 0x53, 0xf7, 0x9d, 0x26, 0xae, 0x44, 0x76, 0x2f, 0x0f, 0x69, 0x74, 0x39,
-	0x89, 0x03, 0xdf, 0x1d, 0xed, 0xb3, 0xd4, 0x2a, 0x08, 0x3e, 0x09, 0x27, 0x11, 0xec, 0x3b, 0xd0,
-	0xd9, 0xf2, 0x43, 0xfa, 0x69, 0x46, 0xd3, 0x91, 0x79, 0x13, 0x80, 0x71, 0x92, 0xf2, 0x6d, 0xdc,
-	0xb1, 0x55, 0x5b, 0xac, 0x5d, 0xe8, 0x2e, 0xf7, 0xfa, 0x72, 0xb7, 0x7d, 0xbd, 0xdb, 0xfe, 0x96,
-	0x36, 0x87, 0xd3, 0x11, 0xa3, 0x51, 0xb6, 0xcf, 0x01, 0xac, 0x3d, 0x45, 0x13, 0xaf, 0x12, 0x4e,
-	0x4c, 0x0b, 0x5a, 0x6e, 0x1c, 0x71, 0x1a, 0x71, 0x81, 0x32, 0xeb, 0x68, 0xd1, 0x9e, 0x07, 0x63,
-	0xdd, 0x33, 0xe7, 0xc0, 0xf0, 0x3d, 0xd1, 0xd5, 0x71, 0x0c, 0xdf, 0xb3, 0xff, 0x64, 0x40, 0x43,
-	0x2e, 0x41, 0xf6, 0x98, 0xba, 0xc7, 0x34, 0xa1, 0xce, 0x47, 0x09, 0xb5, 0xde, 0x12, 0x1a, 0xd1,
-	0x36, 0x1f, 0x42, 0x6b, 0xe0, 0x07, 0x9c, 0xa6, 0xcc, 0x9a, 0x5f, 0x9c, 0xb9, 0xd0, 0x5d, 0xbe,
-	0xd6, 0x7f, 0xe5, 0xb3, 0xef, 0xdf, 0xf3, 0x19, 0xbf, 0x23, 0x66, 0x3b, 0x1a, 0xc5, 0xdc, 0x80,
-	0x46, 0x9c, 0x7a, 0x34, 0xb5, 0x8e, 0x2f, 0xd6, 0x2e, 0xcc, 0x2d, 0xaf, 0x54, 0x80, 0x13, 0xab,
-	0xee, 0x3f, 0xc4, 0xd9, 0x5b, 0xa3, 0x84, 0x3a, 0x12, 0x08, 0x97, 0xcd, 0xe2, 0x94, 0x5b, 0x0b,
-	0x72, 0xd9, 0xd8, 0x46, 0x5d, 0x42, 0x86, 0xd4, 0x3a, 0xb1, 0x58, 0xbb, 0xd0, 0x70, 0x44, 0xdb,
-	0x7c, 0x1b, 0xda, 0x09, 0x4d, 0xb7, 0x85, 0xde, 0x12, 0xfa, 0x56, 0x42, 0xd3, 0x0d, 0x32, 0xa4,
-	0xf6, 0x69, 0xe8, 0xe4, 0xb0, 0x66, 0x0b, 0x66, 0x6e, 0x6d, 0xde, 0x3e, 0xfa, 0x86, 0xd9, 0x86,
-	0xfa, 0xea, 0xda, 0xe6, 0xed, 0xa3, 0x35, 0xfb, 0x06, 0x40, 0xb1, 0x17, 0x73, 0x01, 0x9a, 0x7b,
-	0x24, 0xc8, 0xa8, 0x34, 0x49, 0xc7, 0x51, 0x52, 0x6e, 0xbf, 0xe3, 0x85, 0xfd, 0xec, 0x53, 0xd0,
-	0xd8, 0x8a, 0x39, 0x09, 0xcc, 0x79, 0x68, 0x70, 0x6c, 0x88, 0x93, 0x68, 0x38, 0x52, 0xb0, 0x07,
-	0xd0, 0xbc, 0x43, 0xfc, 0x80, 0x7a, 0xd3, 0xfb, 0x51, 0x1b, 0xfa, 0x51, 0x9c, 0x5a, 0x86, 0xd4,
-	0x0a, 0x41, 0x68, 0xc9, 0xe3, 0x38, 0xb5, 0x66, 0x94, 0x16, 0x05, 0xb3, 0x07, 0x6d, 0x37, 0xf5,
-	0xb9, 0xef, 0x92, 0xc0, 0xaa, 0x8b, 0x8e, 0x5c, 0xb6, 0x7f, 0x61, 0xc0, 0xdc, 0xed, 0x38, 0xe2,
-	0x69, 0x1c, 0x6c, 0x66, 0x61, 0x48, 0xd2, 0xd1, 0x3e, 0x1f, 0xfc, 0x01, 0x34, 0x13, 0xc2, 0x18,
-	0xf5, 0xc4, 0x17, 0xbb, 0xcb, 0x57, 0x2a, 0x9c, 0x8f, 0xd8, 0xa8, 0xa3, 0xe6, 0x9b, 0x9f, 0x40,
-	0x8b, 0x3d, 0xf1, 0x93, 0x84, 0x7a, 0x62, 0x99, 0x87, 0x81, 0xd2, 0x00, 0xe6, 0x3a, 0x34, 0x07,
-	0xc2, 0x4c, 0x62, 0x63, 0xdd, 0xe5, 0xf7, 0x2b, 0x40, 0x49, 0xfb, 0x3a, 0x0a, 0xc0, 0x0e, 0xa0,
-	0xe5, 0x88, 0x6e, 0x66, 0xde, 0x85, 0x96, 0x1c, 0xc9, 0xac, 0x9a, 0xe0, 0x76, 0x15, 0x58, 0x09,
-	0xe2, 0x68, 0x84, 0xc2, 0x9c, 0x46, 0xf9, 0x7c, 0xff, 0x53, 0x87, 0xa6, 0x1c, 0xf9, 0xa2, 0x1f,
-	0x9a, 0x27, 0xa0, 0x15, 0xc5, 0x1e, 0xdd, 0xf6, 0xa5, 0xa9, 0x3b, 0x4e, 0x13, 0xc5, 0x75, 0xcf,
-	0x3c, 0x09, 0x1d, 0xd1, 0x11, 0x91, 0x90, 0x0a, 0xd3, 0x75, 0x9c, 0x36, 0x2a, 0x1e, 0x90, 0x90,
-	0x9a, 0xd7, 0xa0, 0x4d, 0x23, 0x4f, 0x06, 0x8d, 0xfa, 0x4b, 0x83, 0x46, 0x8b, 0x46, 0x1e, 0x4a,
-	0x48, 0x59, 0xc6, 0x09, 0xcf, 0x98, 0xd5, 0x90, 0xdf, 0x92, 0x92, 0xf9, 0x08, 0xda, 0xae, 0xa4,
-	0x05, 0xb3, 0x9a, 0x02, 0xee, 0x66, 0x05, 0x1b, 0x8c, 0x33, 0xca, 0xc9, 0xa1, 0xcc, 0x45, 0xe8,
-	0xd2, 0x68, 0xcf, 0x4f, 0xe3, 0x28, 0xc4, 0xb8, 0xd4, 0x12, 0xdf, 0x2c, 0xab, 0x30, 0x6a, 0xa9,
-	0x00, 0x6d, 0xb5, 0x45, 0xaf, 0x16, 0xcd, 0x87, 0xd0, 0x4e, 0x02, 0xc2, 0x07, 0x71, 0x1a, 0x5a,
-	0x1d, 0xb1, 0xa4, 0xab, 0x15, 0x96, 0xb4, 0xa1, 0xa6, 0x3a, 0x39, 0x88, 0xf9, 0x48, 0x44, 0x5a,
-	0xee, 0x33, 0xee, 0xbb, 0xcc, 0x02, 0x01, 0x59, 0x25, 0x8a, 0x6d, 0xe6, 0x93, 0x9d, 0x12, 0x90,
-	0xf9, 0x00, 0xda, 0x49, 0x1a, 0x0f, 0xfc, 0x80, 0x32, 0xab, 0x2b, 0xe8, 0xb3, 0x5c, 0x65, 0x9d,
-	0x72, 0xaa, 0x93, 0x63, 0x98, 0xc7, 0xa1, 0xf9, 0x38, 0xde, 0x41, 0x3a, 0xcc, 0x0a, 0x83, 0x34,
-	0x1e, 0xc7, 0x3b, 0xeb, 0x9e, 0xf9, 0x0e, 0x74, 0xfc, 0x84, 0x78, 0x5e, 0x4a, 0x19, 0xb3, 0x8e,
-	0x88, 0x9e, 0x42, 0x81, 0x21, 0x67, 0xf0, 0x99, 0x17, 0x59, 0x73, 0x32, 0xe4, 0x60, 0xdb, 0xfe,
-	0x65, 0x13, 0x5a, 0x0a, 0x1e, 0xfb, 0x05, 0x8d, 0x24, 0xed, 0x44, 0x5b, 0x30, 0xd5, 0xe7, 0x01,
-	0x55, 0xb4, 0x93, 0x82, 0x79, 0x1a, 0x20, 0x24, 0x7e, 0xc4, 0x89, 0x1f, 0xd1, 0x54, 0xd1, 0xae,
-	0xa4, 0xc1, 0x75, 0xb8, 0x71, 0x32, 0x4a, 0xfd, 0xe1, 0x2e, 0x17, 0xcc, 0xeb, 0x38, 0x85, 0xc2,
-	0x3c, 0x0f, 0x6f, 0xe6, 0xc2, 0x36, 0x0d, 0x89, 0x1f, 0x28, 0xa2, 0xcd, 0xe5, 0xea, 0x35, 0xd4,
-	0xe2, 0xb9, 0x07, 0xbe, 0x4b, 0x23, 0x46, 0x05, 0xdf, 0x3a, 0x8e, 0x16, 0xb1, 0x87, 0x49, 0x22,
-	0x29, 0xbe, 0x68, 0xf1, 0x00, 0xae, 0xcc, 0x43, 0x23, 0xfe, 0x1c, 0xd7, 0xdb, 0x91, 0x5b, 0x11,
-	0x02, 0x9e, 0x0c, 0xcb, 0x12, 0xe9, 0xd8, 0x47, 0x2b, 0x9f, 0xcc, 0xa6, 0x9c, 0xea, 0xe4, 0x18,
-	0x98, 0x03, 0x3d, 0x9a, 0xd0, 0xc8, 0x63, 0xd6, 0xb1, 0xca, 0x39, 0x70, 0x55, 0xcc, 0xa4, 0x91,
-	0x3b, 0x72, 0x34, 0x8a, 0xf0, 0xc6, 0x5d, 0xb2, 0x7c, 0xed, 0xba, 0x4a, 0xbe, 0x4a, 0xc2, 0xe0,
-	0x3b, 0x4c, 0xe3, 0x2c, 0x61, 0xd6, 0x5b, 0xe2, 0x3b, 0x55, 0x22, 0xe6, 0xc7, 0x38, 0xd1, 0x51,
-	0xf3, 0xd1, 0x04, 0xb9, 0x5f, 0xcf, 0x57, 0x36, 0x81, 0xf2, 0xeb, 0x92, 0x43, 0x6f, 0x01, 0x10,
-	0xce, 0x53, 0x7f, 0x27, 0xe3, 0x94, 0x59, 0xc7, 0x05, 0xe2, 0xb7, 0x2a, 0x20, 0xde, 0xd2, 0x93,
-	0x9d, 0x12, 0x8e, 0x79, 0x16, 0xe6, 0x02, 0xc2, 0x29, 0xe3, 0xdb, 0xfa, 0x7c, 0x65, 0x0e, 0x3f,
-	0x22, 0xb5, 0x3f, 0x54, 0xa7, 0x5c, 0x04, 0xaf, 0x13, 0x63, 0xc1, 0xeb, 0x0c, 0xcc, 0x62, 0x82,
-	0xd8, 0x0e, 0x29, 0x63, 0x3a, 0xa9, 0x77, 0x9c, 0x2e, 0xea, 0xee, 0x4b, 0x95, 0x7d, 0x11, 0x66,
-	0x1c, 0x3a, 0x30, 0x8f, 0xc2, 0x4c, 0x96, 0x06, 0xca, 0x0b, 0xb0, 0x89, 0x9a, 0x94, 0x0e, 0x94,
-	0x0b, 0x60, 0xd3, 0xfe, 0x4b, 0x0d, 0x43, 0x35, 0xcb, 0x02, 0x5e, 0xfa, 0x60, 0x6d, 0xec, 0x83,
-	0x27, 0xd1, 0x07, 0x3c, 0xba, 0xed, 0x51, 0xe6, 0xaa, 0xa9, 0x6d, 0x54, 0xac, 0x52, 0xe6, 0xe2,
-	0xf5, 0x22, 0xcd, 0x22, 0x19, 0x99, 0xd1, 0x7d, 0x0c, 0xa7, 0x95, 0x66, 0x91, 0x88, 0xbe, 0xa7,
-	0xc6, 0xee, 0x7a, 0xca, 0x79, 0xf2, 0xfb, 0x1c, 0xf2, 0x5b, 0x6f, 0x41, 0x3a, 0x8d, 0x16, 0x27,
-	0x76, 0xd8, 0x9c, 0xdc, 0xe1, 0x75, 0x98, 0xdb, 0x8c, 0xb3, 0xd4, 0xa5, 0xf7, 0x62, 0x57, 0xdc,
-	0x36, 0xf5, 0xd6, 0x6a, 0xf9, 0xd6, 0x30, 0x0a, 0x04, 0x7e, 0x44, 0x55, 0x6a, 0x12, 0x6d, 0x7b,
-	0x15, 0x9a, 0x0f, 0x13, 0x31, 0x7e, 0x11, 0xba, 0xb8, 0xa1, 0xd4, 0x17, 0xa2, 0x9a, 0x57, 0x56,
-	0xe1, 0x02, 0x3d, 0x3a, 0x20, 0x59, 0xc0, 0xd5, 0xae, 0xb5, 0x68, 0x7f, 0x53, 0x83, 0x96, 0x72,
-	0x18, 0x4c, 0x68, 0x31, 0xdb, 0x2e, 0x85, 0x9b, 0x66, 0xcc, 0x44, 0xce, 0x3a, 0x09, 0x9d, 0x98,
-	0x6d, 0x0f, 0x48, 0xe8, 0x07, 0x23, 0x6d, 0xb6, 0x98, 0xdd, 0x11, 0x32, 0x62, 0xa7, 0x34, 0xa0,
-	0x84, 0xe9, 0x5c, 0xa7, 0x45, 0x64, 0x87, 0x1f, 0xb1, 0x84, 0xba, 0x39, 0x3b, 0xa4, 0xe5, 0x8e,
-	0x48, 0xad, 0x66, 0x47, 0xaf, 0x94, 0x2f, 0xa4, 0xf9, 0x72, 0xd9, 0xfe, 0xab, 0x01, 0x50, 0x38,
-	0xe0, 0xd4, 0x68, 0xa8, 0xa8, 0x61, 0x14, 0xd4, 0x10, 0x77, 0x47, 0xbe, 0xab, 0x96, 0x23, 0xda,
-	0x38, 0x6a, 0xe8, 0xeb, 0xb8, 0x87, 0x4d, 0xe4, 0xc8, 0x4e, 0x4a, 0x22, 0x77, 0x57, 0x67, 0x54,
-	0x29, 0xe1, 0x48, 0x4e, 0x86, 0xea, 0xa4, 0xb0, 0x89, 0x23, 0xb1, 0x34, 0xf1, 0x75, 0x1e, 0x54,
-	0xd2, 0x01, 0x61, 0x6d, 0x11, 0xba, 0x2c, 0x4b, 0x68, 0x1a, 0x92, 0xf4, 0x09, 0xe5, 0x2a, 0xb8,
-	0x95, 0x55, 0x88, 0x39, 0xf4, 0xf9, 0x6e, 0xb6, 0x23, 0xf2, 0x59, 0xc7, 0x51, 0x12, 0x46, 0xf1,
-	0xc2, 0x05, 0xad, 0xae, 0x8c, 0xe2, 0x85, 0xa6, 0xc4, 0xec, 0xd9, 0x03, 0x5d, 0xe9, 0xc8, 0x24,
-	0xd1, 0xd6, 0xa1, 0x21, 0x62, 0xcc, 0xc4, 0x45, 0x66, 0x7a, 0x3e, 0xe9, 0x95, 0x22, 0xd0, 0x8c,
-	0xb8, 0x26, 0xe7, 0xb2, 0xfd, 0xef, 0x19, 0x68, 0xa9, 0x18, 0x33, 0x81, 0x66, 0x42, 0x1d, 0x5d,
-	0x4a, 0x81, 0x89, 0x36, 0xea, 0x84, 0xcb, 0xa9, 0x13, 0xc1, 0x36, 0xee, 0xc4, 0x0f, 0x13, 0xe2,
-	0xca, 0x43, 0x31, 0x1c, 0x25, 0x15, 0xab, 0x69, 0x94, 0x57, 0xb3, 0x03, 0x6f, 0x32, 0xe1, 0x25,
-	0xdb, 0x81, 0x72, 0x93, 0x43, 0x5c, 0x77, 0xc6, 0xfd, 0xcc, 0x99, 0x63, 0xe3, 0x7e, 0x27, 0xae,
-	0x93, 0x18, 0x3f, 0x98, 0xd5, 0x3a, 0xc4, 0x75, 0x12, 0x67, 0x3a, 0x1a, 0xc1, 0xfc, 0x08, 0xea,
-	0x29, 0x1d, 0x30, 0xab, 0x2d, 0x90, 0xfa, 0x95, 0x90, 0x06, 0x8e, 0x98, 0x6b, 0x6e, 0x40, 0x9d,
-	0x93, 0x21, 0xb3, 0x3a, 0x02, 0xe3, 0xc3, 0xea, 0x09, 0xa0, 0xbf, 0x45, 0x86, 0x6c, 0x2d, 0xe2,
-	0xe9, 0xc8, 0x11, 0x48, 0xbd, 0x0f, 0xa0, 0x93, 0xab, 0x90, 0xe9, 0x4f, 0xe8, 0x48, 0xc7, 0x99,
-	0x27, 0x54, 0x94, 0x14, 0xa2, 0x14, 0xd2, 0x4c, 0x10, 0xc2, 0x8a, 0x71, 0xa3, 0x66, 0x07, 0xd0,
-	0xc9, 0x53, 0xc0, 0x54, 0x37, 0xbc, 0x0b, 0xad, 0x58, 0x04, 0x1b, 0xa6, 0x0a, 0x8f, 0x2a, 0xc6,
-	0x93, 0x81, 0xcc, 0xd1, 0x08, 0xf6, 0x0d, 0x68, 0xeb, 0x7b, 0xe0, 0xd4, 0x8f, 0x95, 0x62, 0x8e,
-	0x31, 0x16, 0x73, 0xec, 0x0b, 0x00, 0xc5, 0x75, 0x0f, 0x39, 0xec, 0x65, 0x29, 0xc9, 0xc3, 0xa2,
-	0xe1, 0xe4, 0xb2, 0xfd, 0xfb, 0x1a, 0x1c, 0xdb, 0xcc, 0x86, 0x43, 0xca, 0xc4, 0xb7, 0xe9, 0x67,
-	0x19, 0x65, 0x3c, 0x2f, 0x01, 0x6b, 0xa5, 0x12, 0x1a, 0x75, 0xf4, 0xa9, 0x0e, 0x9d, 0xa2, 0x2d,
-	0x6a, 0x56, 0xff, 0xa7, 0x54, 0x15, 0x70, 0xa2, 0x5d, 0x2e, 0xb5, 0xeb, 0xaf, 0xa3, 0xd4, 0xb6,
-	0x7f, 0x02, 0x50, 0xac, 0x30, 0x5f, 0x46, 0xad, 0xb4, 0x0c, 0xe9, 0x7c, 0x46, 0xd9, 0x95, 0x99,
-	0x1b, 0xa7, 0x3a, 0x81, 0x49, 0xa1, 0x1c, 0xa8, 0xea, 0x63, 0x81, 0xca, 0x1e, 0x40, 0xb7, 0xf8,
-	0x02, 0x33, 0x7f, 0x84, 0x71, 0x2b, 0x17, 0x55, 0x51, 0x55, 0xe9, 0xaa, 0x5d, 0x18, 0xb4, 0x8c,
-	0x64, 0xff, 0xb9, 0x06, 0x5d, 0x75, 0xa5, 0xbd, 0xef, 0x47, 0xcc, 0xfc, 0xb4, 0x74, 0xf7, 0xae,
-	0xfe, 0x95, 0x02, 0xa9, 0x74, 0xfd, 0xde, 0xc0, 0x28, 0x9d, 0x45, 0x5c, 0xf3, 0xef, 0x46, 0x75,
-	0xc0, 0xdb, 0x62, 0xbe, 0xa3, 0x70, 0xec, 0x18, 0x8e, 0x8c, 0x75, 0xec, 0x53, 0x71, 0x2f, 0xe4,
-	0xb5, 0xad, 0x4c, 0xcf, 0x4a, 0x12, 0xf7, 0xe1, 0x52, 0xfd, 0xdc, 0x28, 0xaa, 0xe1, 0x85, 0xbc,
-	0x46, 0x97, 0x65, 0xbe, 0x92, 0xec, 0xa7, 0x00, 0xc5, 0xd6, 0x2a, 0x5c, 0xfd, 0x25, 0x0b, 0x66,
-	0x72, 0x16, 0xec, 0x7b, 0xde, 0xfb, 0x95, 0x91, 0xf6, 0xef, 0x66, 0xa0, 0xfe, 0x00, 0x23, 0xf5,
-	0x94, 0x68, 0x2e, 0x16, 0x61, 0x94, 0x16, 0x51, 0x2e, 0xf0, 0x66, 0x5e, 0x47, 0x81, 0xf7, 0x42,
-	0xb5, 0x59, 0x9f, 0xac, 0x36, 0x5d, 0x50, 0x57, 0xca, 0x6d, 0x89, 0x25, 0x12, 0x6e, 0x77, 0xf9,
-	0xbb, 0x55, 0x1c, 0x4c, 0xcc, 0x97, 0xb5, 0xbc, 0x2e, 0x78, 0x67, 0x83, 0x92, 0xd2, 0xbc, 0x35,
-	0x16, 0x6e, 0x2f, 0x57, 0xc0, 0xbe, 0xbb, 0x27, 0xe3, 0xab, 0xe9, 0x94, 0x78, 0x0d, 0x02, 0xe6,
-	0xfa, 0x21, 0x78, 0x4d, 0x39, 0x29, 0x88, 0x6d, 0x7b, 0xd0, 0xc0, 0xa3, 0x61, 0xe6, 0x1a, 0x34,
-	0x22, 0x6c, 0x28, 0x8f, 0x59, 0xaa, 0x80, 0x8c, 0x00, 0x8e, 0x9c, 0x5d, 0xb0, 0x78, 0xbe, 0xfc,
-	0xd0, 0xf1, 0xff, 0x60, 0xdc, 0xdd, 0x7b, 0xd5, 0x94, 0x60, 0xff, 0xad, 0x06, 0x6f, 0x4d, 0x31,
-	0xe8, 0x04, 0x7d, 0xca, 0xaf, 0x1d, 0xc6, 0x61, 0x5e, 0x3b, 0x66, 0xf6, 0x7d, 0xed, 0xa8, 0xbf,
-	0xb6, 0xd7, 0x0e, 0xfb, 0x6e, 0x11, 0x9c, 0x28, 0x27, 0xfb, 0x65, 0x1c, 0xed, 0x52, 0xc6, 0xb8,
-	0x4b, 0xbd, 0xe0, 0x7c, 0xcb, 0x7f, 0x3f, 0x06, 0x47, 0x1d, 0xfd, 0xd9, 0x4d, 0x9a, 0xee, 0xf9,
-	0x2e, 0x35, 0x7f, 0x6e, 0x40, 0x17, 0x23, 0xbc, 0x7e, 0xb9, 0xba, 0x52, 0xf5, 0xd5, 0xb4, 0xb7,
-	0x5c, 0xf9, 0x69, 0x8b, 0xd9, 0xbf, 0xaa, 0x7d, 0xf9, 0xdc, 0x3a, 0x07, 0xef, 0x14, 0xe3, 0x56,
-	0xf2, 0x71, 0x2b, 0xfa, 0xdd, 0xab, 0xc9, 0x28, 0x49, 0xdd, 0xdd, 0x67, 0xcf, 0xad, 0x0f, 0x5e,
-	0x32, 0xf2, 0xc4, 0x44, 0x2f, 0x5b, 0x09, 0x7c, 0xc6, 0xbf, 0xfc, 0xc7, 0xbf, 0x7e, 0x63, 0xd8,
-	0xf6, 0xa9, 0x83, 0x7e, 0x7a, 0x60, 0x2b, 0xb5, 0x4b, 0xe6, 0xaf, 0x0d, 0x00, 0x87, 0x12, 0x4f,
-	0xf9, 0x5b, 0x75, 0x23, 0x54, 0x7f, 0xdf, 0xb3, 0xbf, 0x41, 0x1b, 0x5c, 0x84, 0x33, 0x07, 0xed,
-	0x6c, 0xe5, 0x0b, 0xdf, 0xfb, 0x99, 0x59, 0x4f, 0x29, 0xf1, 0x9e, 0x3d, 0xb7, 0xbe, 0xfd, 0x2a,
-	0x83, 0x17, 0xa6, 0xd8, 0x62, 0x48, 0xa5, 0x29, 0x2e, 0xd9, 0x67, 0x0f, 0x34, 0xc5, 0x92, 0xef,
-	0x2d, 0x21, 0x08, 0x9a, 0xe4, 0x8f, 0x06, 0xbc, 0x89, 0xc4, 0x28, 0x67, 0xe1, 0x0f, 0x0f, 0x97,
-	0x70, 0xe5, 0x0d, 0xa6, 0x77, 0xfd, 0x50, 0xb3, 0x99, 0xfd, 0x07, 0x34, 0xd4, 0x25, 0x58, 0x9c,
-	0xba, 0xf7, 0x52, 0x2e, 0x2f, 0x11, 0x66, 0xed, 0x15, 0x46, 0x4f, 0xda, 0xb2, 0xf4, 0xd1, 0x82,
-	0x3e, 0xe7, 0xec, 0x33, 0xd3, 0x6d, 0x56, 0xc2, 0x42, 0x7b, 0x7d, 0x6d, 0xc0, 0x2c, 0xda, 0x6b,
-	0x43, 0xa7, 0xfd, 0xea, 0x24, 0xba, 0x7e, 0xa8, 0x9b, 0x06, 0xb3, 0x7f, 0x8b, 0x06, 0x3a, 0x0f,
-	0xa7, 0xa6, 0x6e, 0x39, 0xbf, 0x86, 0x14, 0xd6, 0xf9, 0xde, 0xcb, 0x86, 0x9e, 0x9e, 0xe8, 0xd6,
-	0xbb, 0x2a, 0xec, 0xf2, 0x9e, 0x7d, 0x7a, 0xba, 0x5d, 0x34, 0x0a, 0x1a, 0x25, 0x83, 0xa6, 0xfc,
-	0x3d, 0xe9, 0x10, 0xd6, 0xa8, 0x72, 0xef, 0x2a, 0x7e, 0xb4, 0xb2, 0xdf, 0xb8, 0x52, 0x33, 0xbf,
-	0x32, 0xa0, 0x8d, 0xee, 0x2c, 0x2e, 0x0e, 0x55, 0xd2, 0xe5, 0xba, 0xd7, 0xab, 0x9a, 0xbc, 0xb4,
-	0xf5, 0xdf, 0x9d, 0x6a, 0x52, 0x91, 0xd8, 0xc6, 0xbd, 0xf8, 0x3b, 0x2f, 0x1f, 0xda, 0x9b, 0x18,
-	0x20, 0xf2, 0x6c, 0xee, 0xc7, 0x67, 0xcd, 0xf7, 0xa6, 0xdb, 0x5e, 0x60, 0x68, 0x2f, 0x46, 0x33,
-	0x74, 0x90, 0x92, 0x32, 0x49, 0x57, 0x3f, 0x81, 0x2b, 0x15, 0x4d, 0xc1, 0xec, 0xaf, 0xd1, 0x16,
-	0xff, 0x07, 0xbd, 0xfd, 0x37, 0x58, 0xa2, 0xe1, 0xcd, 0x03, 0xc7, 0x9d, 0xdc, 0xc7, 0x06, 0x39,
-	0x01, 0xcf, 0xdb, 0xf6, 0x41, 0x46, 0x90, 0x9f, 0x41, 0x12, 0xfe, 0xb3, 0x06, 0xf0, 0x31, 0x2d,
-	0xde, 0xfc, 0x26, 0xf2, 0xfc, 0x5a, 0x98, 0xf0, 0x51, 0x6f, 0x1f, 0x9e, 0x84, 0x71, 0xd4, 0xd7,
-	0x3f, 0xf8, 0x2a, 0x88, 0xf5, 0x68, 0x10, 0xdb, 0x5f, 0xc9, 0xad, 0x4e, 0x4f, 0x4c, 0x3a, 0x05,
-	0xeb, 0x33, 0xbf, 0x0a, 0x0b, 0x6c, 0xc4, 0x38, 0x0d, 0x57, 0x98, 0xcc, 0xbb, 0xf9, 0x88, 0xb7,
-	0xc7, 0xf5, 0xea, 0x0b, 0xf9, 0x49, 0xbf, 0x6b, 0xee, 0x93, 0xbc, 0xf4, 0xfc, 0xff, 0xd6, 0xe0,
-	0xd8, 0x3d, 0xf9, 0xce, 0xfd, 0x88, 0x91, 0x21, 0x95, 0x67, 0x5d, 0xe5, 0x01, 0x35, 0xff, 0xe1,
-	0xf8, 0x50, 0x99, 0xfc, 0x8b, 0x03, 0x92, 0x98, 0x7a, 0x7f, 0xcf, 0xc4, 0x83, 0x63, 0x1d, 0x0f,
-	0xee, 0xd9, 0x73, 0xeb, 0x93, 0x57, 0x19, 0x7c, 0x76, 0xda, 0x90, 0xf2, 0x36, 0x05, 0x0d, 0x3e,
-	0x7a, 0xf8, 0xe3, 0xfb, 0xf2, 0x75, 0x0a, 0x97, 0xb9, 0x84, 0x8b, 0xcf, 0x7f, 0x3f, 0x5f, 0x3a,
-	0xcc, 0x7f, 0x23, 0xec, 0x34, 0x05, 0x27, 0xae, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xac, 0x83,
-	0xf7, 0xc6, 0xcc, 0x20, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/compliance/reporting/reporting.proto", fileDescriptor_reporting_f45c117eac59be60)
+}
+
+var fileDescriptor_reporting_f45c117eac59be60 = []byte{
+	// 2874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xdd, 0x8f, 0x1c, 0x47,
+	0xb5, 0xbf, 0xdd, 0xf3, 0x7d, 0x66, 0x77, 0xed, 0xb4, 0xbf, 0x3a, 0xe3, 0xd8, 0xde, 0x74, 0xe2,
+	0xc4, 0x8e, 0xae, 0x67, 0x9d, 0xcd, 0xb5, 0xe3, 0xec, 0xcd, 0xbd, 0xe0, 0x78, 0xd7, 0x61, 0xe3,
+	0xc4, 0xde, 0xf4, 0x3a, 0x44, 0x80, 0xd0, 0x50, 0xdb, 0x5d, 0x33, 0x5b, 0x76, 0x4f, 0x77, 0xa7,
+	0xab, 0x66, 0xed, 0x21, 0x42, 0x42, 0x21, 0x02, 0x11, 0x09, 0x04, 0xe1, 0x01, 0x21, 0xe5, 0x01,
+	0x89, 0x67, 0x1e, 0x40, 0xf8, 0x0f, 0xe0, 0x11, 0x78, 0x83, 0x37, 0x1e, 0x78, 0xe2, 0x1f, 0xe0,
+	0x95, 0x27, 0x74, 0xaa, 0xaa, 0x3f, 0xc6, 0x33, 0xbb, 0xeb, 0x1e, 0xe7, 0x69, 0xea, 0x9c, 0xaa,
+	0xf3, 0xab, 0xaa, 0x53, 0xe7, 0xa3, 0x4e, 0xf5, 0xc0, 0xba, 0x17, 0x0d, 0xe3, 0x28, 0xa4, 0xa1,
+	0xe0, 0x2b, 0x64, 0x24, 0xa2, 0x21, 0x11, 0xf4, 0xd2, 0x80, 0x08, 0xfa, 0x80, 0x8c, 0x57, 0x48,
+	0xcc, 0x56, 0xb0, 0x3f, 0x60, 0x24, 0xf4, 0xe8, 0x4a, 0x42, 0xe3, 0x28, 0x11, 0x2c, 0x1c, 0xe4,
+	0xad, 0x6e, 0x9c, 0x44, 0x22, 0xb2, 0x2e, 0x7a, 0xbb, 0xb4, 0xdf, 0x4d, 0xe5, 0xbb, 0x24, 0x66,
+	0xdd, 0x5c, 0xae, 0x9b, 0x8f, 0xde, 0x7b, 0xb5, 0xf3, 0xdc, 0x20, 0x8a, 0x06, 0x01, 0x95, 0xd8,
+	0x24, 0x0c, 0x23, 0x41, 0x04, 0x8b, 0x42, 0xae, 0x80, 0x3a, 0xe7, 0x74, 0xaf, 0xa4, 0x76, 0x46,
+	0xfd, 0x15, 0xc1, 0x86, 0x94, 0x0b, 0x32, 0x8c, 0xf5, 0x80, 0xd3, 0x8f, 0x0f, 0xa0, 0xc3, 0x58,
+	0x8c, 0x75, 0xe7, 0x45, 0x04, 0xa5, 0x0f, 0x05, 0x4d, 0x42, 0x12, 0xe0, 0xca, 0x87, 0x51, 0xb8,
+	0xb2, 0x47, 0x13, 0xce, 0xf2, 0x5f, 0x3d, 0xf4, 0xab, 0x33, 0xf7, 0x9d, 0xc4, 0x9e, 0x42, 0xf6,
+	0x2e, 0x0d, 0x68, 0x78, 0x29, 0x8e, 0x02, 0xe6, 0x8d, 0xf7, 0x59, 0x6a, 0x19, 0x04, 0x46, 0x86,
+	0xd3, 0x08, 0xce, 0x4d, 0x68, 0xdd, 0x65, 0x43, 0xfa, 0xfe, 0x88, 0x26, 0x63, 0xeb, 0x0d, 0x00,
+	0x2e, 0x48, 0x22, 0x7a, 0xb8, 0x63, 0xdb, 0x58, 0x36, 0x2e, 0xb4, 0x57, 0x3b, 0x5d, 0xb5, 0xdb,
+	0x6e, 0xba, 0xdb, 0xee, 0xdd, 0x54, 0x1d, 0x6e, 0x4b, 0x8e, 0x46, 0xda, 0x79, 0x09, 0x60, 0xe3,
+	0x21, 0xaa, 0x78, 0x9d, 0x08, 0x62, 0xd9, 0xd0, 0xf0, 0xa2, 0x50, 0xd0, 0x50, 0x48, 0x94, 0x05,
+	0x37, 0x25, 0x9d, 0xe3, 0x60, 0x6e, 0xfa, 0xd6, 0x12, 0x98, 0xcc, 0x97, 0x5d, 0x2d, 0xd7, 0x64,
+	0xbe, 0xf3, 0x3b, 0x13, 0x6a, 0x6a, 0x09, 0xaa, 0xc7, 0x4a, 0x7b, 0x2c, 0x0b, 0xaa, 0x62, 0x1c,
+	0x53, 0xfb, 0x98, 0xe4, 0xc8, 0xb6, 0x75, 0x07, 0x1a, 0x7d, 0x16, 0x08, 0x9a, 0x70, 0xfb, 0xf8,
+	0x72, 0xe5, 0x42, 0x7b, 0xf5, 0x4a, 0xf7, 0x89, 0xcf, 0xbe, 0xfb, 0x2e, 0xe3, 0xe2, 0xa6, 0x94,
+	0x76, 0x53, 0x14, 0x6b, 0x0b, 0x6a, 0x51, 0xe2, 0xd3, 0xc4, 0x3e, 0xb1, 0x6c, 0x5c, 0x58, 0x5a,
+	0x5d, 0x2b, 0x01, 0x27, 0x57, 0xdd, 0xbd, 0x83, 0xd2, 0x77, 0xc7, 0x31, 0x75, 0x15, 0x10, 0x2e,
+	0x9b, 0x47, 0x89, 0xb0, 0x4f, 0xaa, 0x65, 0x63, 0x1b, 0x79, 0x31, 0x19, 0x50, 0xfb, 0xd4, 0xb2,
+	0x71, 0xa1, 0xe6, 0xca, 0xb6, 0xf5, 0x2c, 0x34, 0x63, 0x9a, 0xf4, 0x24, 0xdf, 0x96, 0xfc, 0x46,
+	0x4c, 0x93, 0x2d, 0x32, 0xa0, 0xce, 0x59, 0x68, 0x65, 0xb0, 0x56, 0x03, 0x2a, 0xd7, 0xb7, 0x6f,
+	0x1c, 0xfd, 0x2f, 0xab, 0x09, 0xd5, 0xf5, 0x8d, 0xed, 0x1b, 0x47, 0x0d, 0xe7, 0x1a, 0x40, 0xbe,
+	0x17, 0xeb, 0x24, 0xd4, 0xf7, 0x48, 0x30, 0xa2, 0x4a, 0x25, 0x2d, 0x57, 0x53, 0x99, 0xfe, 0x4e,
+	0xe4, 0xfa, 0x73, 0xce, 0x40, 0xed, 0x6e, 0x24, 0x48, 0x60, 0x1d, 0x87, 0x9a, 0xc0, 0x86, 0x3c,
+	0x89, 0x9a, 0xab, 0x08, 0xa7, 0x0f, 0xf5, 0x9b, 0x84, 0x05, 0xd4, 0x9f, 0xdd, 0x8f, 0xdc, 0x21,
+	0x0b, 0xa3, 0xc4, 0x36, 0x15, 0x57, 0x12, 0x92, 0x4b, 0xee, 0x45, 0x89, 0x5d, 0xd1, 0x5c, 0x24,
+	0xac, 0x0e, 0x34, 0xbd, 0x84, 0x09, 0xe6, 0x91, 0xc0, 0xae, 0xca, 0x8e, 0x8c, 0x76, 0x7e, 0x64,
+	0xc2, 0xd2, 0x8d, 0x28, 0x14, 0x49, 0x14, 0x6c, 0x8f, 0x86, 0x43, 0x92, 0x8c, 0xf7, 0x99, 0xf0,
+	0x6b, 0x50, 0x8f, 0x09, 0xe7, 0xd4, 0x97, 0x33, 0xb6, 0x57, 0x2f, 0x97, 0x38, 0x1f, 0xb9, 0x51,
+	0x57, 0xcb, 0x5b, 0xef, 0x40, 0x83, 0xdf, 0x67, 0x71, 0x4c, 0x7d, 0xbb, 0x32, 0x27, 0x54, 0x0a,
+	0x60, 0x6d, 0x42, 0xbd, 0x2f, 0xd5, 0x24, 0x37, 0xd6, 0x5e, 0x7d, 0xb5, 0x04, 0x94, 0xd2, 0xaf,
+	0xab, 0x01, 0x9c, 0x00, 0x1a, 0xae, 0xec, 0xe6, 0xd6, 0x2d, 0x68, 0xa8, 0x91, 0xdc, 0x36, 0x96,
+	0x2b, 0x25, 0x61, 0x15, 0x88, 0x9b, 0x22, 0xe4, 0xea, 0x34, 0x8b, 0xe7, 0xfb, 0xaf, 0x2a, 0xd4,
+	0xd5, 0xc8, 0xc7, 0xfd, 0xd0, 0x3a, 0x05, 0x8d, 0x30, 0xf2, 0x69, 0x8f, 0x29, 0x55, 0xb7, 0xdc,
+	0x3a, 0x92, 0x9b, 0xbe, 0x75, 0x1a, 0x5a, 0xb2, 0x23, 0x24, 0x43, 0x2a, 0x55, 0xd7, 0x72, 0x9b,
+	0xc8, 0xb8, 0x4d, 0x86, 0xd4, 0xba, 0x02, 0x4d, 0x1a, 0xfa, 0x2a, 0x68, 0x54, 0x0f, 0x0d, 0x1a,
+	0x0d, 0x1a, 0xfa, 0x48, 0xa1, 0xc9, 0x72, 0x41, 0xc4, 0x88, 0xdb, 0x35, 0x35, 0x97, 0xa2, 0xac,
+	0x0f, 0xa0, 0xe9, 0x29, 0xb3, 0xe0, 0x76, 0x5d, 0xc2, 0xbd, 0x51, 0x42, 0x07, 0x93, 0x16, 0xe5,
+	0x66, 0x50, 0xd6, 0x32, 0xb4, 0x69, 0xb8, 0xc7, 0x92, 0x28, 0x1c, 0x62, 0x5c, 0x6a, 0xc8, 0x39,
+	0x8b, 0x2c, 0x8c, 0x5a, 0x3a, 0x40, 0xdb, 0x4d, 0xd9, 0x9b, 0x92, 0xd6, 0x1d, 0x68, 0xc6, 0x01,
+	0x11, 0xfd, 0x28, 0x19, 0xda, 0x2d, 0xb9, 0xa4, 0xd7, 0x4a, 0x2c, 0x69, 0x4b, 0x8b, 0xba, 0x19,
+	0x88, 0xf5, 0x81, 0x8c, 0xb4, 0x82, 0x71, 0xc1, 0x3c, 0x6e, 0xc3, 0xb2, 0x51, 0x32, 0x8a, 0x6d,
+	0x67, 0xc2, 0x6e, 0x01, 0xc8, 0xba, 0x0d, 0xcd, 0x38, 0x89, 0xfa, 0x2c, 0xa0, 0xdc, 0x6e, 0x4b,
+	0xf3, 0x59, 0x2d, 0xb3, 0x4e, 0x25, 0xea, 0x66, 0x18, 0xd6, 0x09, 0xa8, 0xdf, 0x8b, 0x76, 0xd0,
+	0x1c, 0x16, 0xa4, 0x42, 0x6a, 0xf7, 0xa2, 0x9d, 0x4d, 0xdf, 0x7a, 0x0e, 0x5a, 0x2c, 0x26, 0xbe,
+	0x9f, 0x50, 0xce, 0xed, 0x45, 0xd9, 0x93, 0x33, 0x30, 0xe4, 0xf4, 0x3f, 0xf2, 0x43, 0x7b, 0x49,
+	0x85, 0x1c, 0x6c, 0x3b, 0x3f, 0xae, 0x43, 0x43, 0xc3, 0x63, 0xbf, 0x34, 0x23, 0x65, 0x76, 0xb2,
+	0x2d, 0x2d, 0x95, 0x89, 0x80, 0x6a, 0xb3, 0x53, 0x84, 0x75, 0x16, 0x60, 0x48, 0x58, 0x28, 0x08,
+	0x0b, 0x69, 0xa2, 0xcd, 0xae, 0xc0, 0xc1, 0x75, 0x78, 0x51, 0x3c, 0x4e, 0xd8, 0x60, 0x57, 0x48,
+	0xcb, 0x6b, 0xb9, 0x39, 0xc3, 0x7a, 0x19, 0x8e, 0x64, 0x44, 0x8f, 0x0e, 0x09, 0x0b, 0xb4, 0xa1,
+	0x2d, 0x65, 0xec, 0x0d, 0xe4, 0xe2, 0xb9, 0x07, 0xcc, 0xa3, 0x21, 0xa7, 0xd2, 0xde, 0x5a, 0x6e,
+	0x4a, 0x62, 0x0f, 0x57, 0x86, 0xa4, 0xed, 0x25, 0x25, 0x0f, 0xb0, 0x95, 0xe3, 0x50, 0x8b, 0x1e,
+	0xe0, 0x7a, 0x5b, 0x6a, 0x2b, 0x92, 0xc0, 0x93, 0xe1, 0xa3, 0x58, 0x39, 0xf6, 0xd1, 0xd2, 0x27,
+	0xb3, 0xad, 0x44, 0xdd, 0x0c, 0x03, 0x73, 0xa0, 0x4f, 0x63, 0x1a, 0xfa, 0xdc, 0x7e, 0xa6, 0x74,
+	0x0e, 0x5c, 0x97, 0x92, 0x34, 0xf4, 0xc6, 0x6e, 0x8a, 0x22, 0xbd, 0x71, 0x97, 0xac, 0x5e, 0xb9,
+	0xaa, 0x93, 0xaf, 0xa6, 0x30, 0xf8, 0x0e, 0x92, 0x68, 0x14, 0x73, 0xfb, 0xd8, 0x72, 0xa5, 0x64,
+	0xc4, 0x7c, 0x1b, 0x05, 0x5d, 0x2d, 0x8f, 0x2a, 0xc8, 0xfc, 0xfa, 0x78, 0x69, 0x15, 0x68, 0xbf,
+	0x2e, 0x38, 0xf4, 0x5d, 0x00, 0x22, 0x44, 0xc2, 0x76, 0x46, 0x82, 0x72, 0xfb, 0x84, 0x44, 0xfc,
+	0x9f, 0x12, 0x88, 0xd7, 0x53, 0x61, 0xb7, 0x80, 0x63, 0x9d, 0x87, 0xa5, 0x80, 0x08, 0xca, 0x45,
+	0x2f, 0x3d, 0x5f, 0x95, 0xc3, 0x17, 0x15, 0xf7, 0xeb, 0xfa, 0x94, 0xf3, 0xe0, 0x75, 0x6a, 0x22,
+	0x78, 0x3d, 0x0f, 0x0b, 0x98, 0x20, 0x7a, 0x43, 0xca, 0x79, 0x9a, 0xd4, 0x5b, 0x6e, 0x1b, 0x79,
+	0xef, 0x29, 0x96, 0x73, 0x11, 0x2a, 0x2e, 0xed, 0x5b, 0x47, 0xa1, 0x32, 0x4a, 0x02, 0xed, 0x05,
+	0xd8, 0x44, 0x4e, 0x42, 0xfb, 0xda, 0x05, 0xb0, 0xe9, 0xfc, 0xc1, 0xc0, 0x50, 0xcd, 0x47, 0x81,
+	0x28, 0x4c, 0x68, 0x4c, 0x4c, 0x78, 0x1a, 0x7d, 0xc0, 0xa7, 0x3d, 0x9f, 0x72, 0x4f, 0x8b, 0x36,
+	0x91, 0xb1, 0x4e, 0xb9, 0x87, 0xd7, 0x8b, 0x64, 0x14, 0xaa, 0xc8, 0x8c, 0xee, 0x63, 0xba, 0x8d,
+	0x64, 0x14, 0xca, 0xe8, 0x7b, 0x66, 0xe2, 0xae, 0xa7, 0x9d, 0x27, 0xbb, 0xcf, 0xa1, 0x7d, 0xa7,
+	0x5b, 0x50, 0x4e, 0x93, 0x92, 0x53, 0x3b, 0xac, 0x4f, 0xef, 0xf0, 0x2a, 0x2c, 0x6d, 0x47, 0xa3,
+	0xc4, 0xa3, 0xef, 0x46, 0x9e, 0xbc, 0x6d, 0xa6, 0x5b, 0x33, 0xb2, 0xad, 0x61, 0x14, 0x08, 0x58,
+	0x48, 0x75, 0x6a, 0x92, 0x6d, 0x67, 0x1d, 0xea, 0x77, 0x62, 0x39, 0x7e, 0x19, 0xda, 0xb8, 0xa1,
+	0x84, 0x49, 0x52, 0xcb, 0x15, 0x59, 0xb8, 0x40, 0x9f, 0xf6, 0xc9, 0x28, 0x10, 0x7a, 0xd7, 0x29,
+	0xe9, 0x7c, 0x61, 0x40, 0x43, 0x3b, 0x0c, 0x26, 0xb4, 0x88, 0xf7, 0x0a, 0xe1, 0xa6, 0x1e, 0x71,
+	0x99, 0xb3, 0x4e, 0x43, 0x2b, 0xe2, 0xbd, 0x3e, 0x19, 0xb2, 0x60, 0x9c, 0xaa, 0x2d, 0xe2, 0x37,
+	0x25, 0x8d, 0xd8, 0x09, 0x0d, 0x28, 0xe1, 0x69, 0xae, 0x4b, 0x49, 0xb4, 0x0e, 0x16, 0xf2, 0x98,
+	0x7a, 0x99, 0x75, 0x28, 0xcd, 0x2d, 0x2a, 0x6e, 0x6a, 0x1d, 0x9d, 0x42, 0xbe, 0x50, 0xea, 0xcb,
+	0x68, 0xe7, 0x4f, 0x26, 0x40, 0xee, 0x80, 0x33, 0xa3, 0xa1, 0x36, 0x0d, 0x33, 0x37, 0x0d, 0x79,
+	0x77, 0x14, 0xbb, 0x7a, 0x39, 0xb2, 0x8d, 0xa3, 0x06, 0x2c, 0x8d, 0x7b, 0xd8, 0x44, 0x1b, 0xd9,
+	0x49, 0x48, 0xe8, 0xed, 0xa6, 0x19, 0x55, 0x51, 0x38, 0x52, 0x90, 0x81, 0x3e, 0x29, 0x6c, 0xe2,
+	0x48, 0x2c, 0x4d, 0x58, 0x9a, 0x07, 0x35, 0x75, 0x40, 0x58, 0x5b, 0x86, 0x36, 0x1f, 0xc5, 0x34,
+	0x19, 0x92, 0xe4, 0x3e, 0x15, 0x3a, 0xb8, 0x15, 0x59, 0x88, 0x39, 0x60, 0x62, 0x77, 0xb4, 0x23,
+	0xf3, 0x59, 0xcb, 0xd5, 0x14, 0x46, 0xf1, 0xdc, 0x05, 0xed, 0xb6, 0xec, 0x2b, 0x70, 0x0a, 0x96,
+	0xbd, 0x70, 0xa0, 0x2b, 0x2d, 0x4e, 0x1b, 0xda, 0x26, 0xd4, 0x64, 0x8c, 0x99, 0xba, 0xc8, 0xcc,
+	0xce, 0x27, 0x9d, 0x42, 0x04, 0xaa, 0xc8, 0x6b, 0x72, 0x46, 0x3b, 0x7f, 0xac, 0x42, 0x43, 0xc7,
+	0x98, 0x29, 0x34, 0x0b, 0xaa, 0xe8, 0x52, 0x1a, 0x4c, 0xb6, 0x91, 0x27, 0x5d, 0x4e, 0x9f, 0x08,
+	0xb6, 0x71, 0x27, 0x6c, 0x18, 0x13, 0x4f, 0x1d, 0x8a, 0xe9, 0x6a, 0x2a, 0x5f, 0x4d, 0xad, 0xb8,
+	0x9a, 0x1d, 0x38, 0xc2, 0xa5, 0x97, 0xf4, 0x02, 0xed, 0x26, 0x73, 0x5c, 0x77, 0x26, 0xfd, 0xcc,
+	0x5d, 0xe2, 0x13, 0xb4, 0xba, 0x4e, 0x62, 0xfc, 0xe0, 0x76, 0x63, 0x8e, 0xeb, 0x24, 0x4a, 0xba,
+	0x29, 0x82, 0xf5, 0x16, 0x54, 0x13, 0xda, 0xe7, 0x76, 0x53, 0x22, 0x75, 0x4b, 0x21, 0xf5, 0x5d,
+	0x29, 0x6b, 0x6d, 0x41, 0x55, 0x90, 0x01, 0xb7, 0x5b, 0x12, 0xe3, 0xcd, 0xf2, 0x09, 0xa0, 0x7b,
+	0x97, 0x0c, 0xf8, 0x46, 0x28, 0x92, 0xb1, 0x2b, 0x91, 0xac, 0x2d, 0xa8, 0x3f, 0x20, 0x6c, 0x8f,
+	0x26, 0xfa, 0x1a, 0x75, 0xad, 0x3c, 0xe6, 0x87, 0x52, 0xde, 0xd5, 0x38, 0x9d, 0xd7, 0xa1, 0x95,
+	0x4d, 0x82, 0xbe, 0x73, 0x9f, 0x8e, 0xd3, 0xc8, 0x75, 0x9f, 0xca, 0x22, 0x45, 0x16, 0x57, 0xa9,
+	0x6d, 0x49, 0x62, 0xcd, 0xbc, 0x66, 0x38, 0xdf, 0x37, 0x60, 0x71, 0x02, 0x72, 0xca, 0x92, 0x5e,
+	0x84, 0xc5, 0x7b, 0x23, 0x2e, 0x58, 0x9f, 0xe9, 0x13, 0x57, 0x18, 0x93, 0x4c, 0xac, 0xc3, 0xe9,
+	0xc3, 0x98, 0x25, 0x94, 0xf7, 0x88, 0xb0, 0x2b, 0x87, 0x5e, 0xa9, 0x5b, 0x7a, 0xf4, 0x75, 0xe1,
+	0x04, 0xd0, 0xca, 0xf2, 0xda, 0xcc, 0xd8, 0x72, 0x0b, 0x1a, 0x91, 0x8c, 0xa0, 0x5c, 0x57, 0x53,
+	0x65, 0x2c, 0x42, 0x45, 0x67, 0x37, 0x45, 0x70, 0xae, 0x41, 0x33, 0xbd, 0xdc, 0xce, 0x9c, 0xac,
+	0x10, 0x48, 0xcd, 0x89, 0x40, 0xea, 0x5c, 0x00, 0xc8, 0xef, 0xb0, 0xe8, 0x98, 0xfe, 0x28, 0x21,
+	0x59, 0xac, 0x37, 0xdd, 0x8c, 0x76, 0x7e, 0x6d, 0xc0, 0x33, 0xdb, 0xa3, 0xc1, 0x80, 0x72, 0x39,
+	0x37, 0xfd, 0x68, 0x44, 0xb9, 0xc8, 0xea, 0x5a, 0xa3, 0xf0, 0x2e, 0x80, 0x3c, 0xfa, 0x30, 0xcd,
+	0x07, 0xb2, 0x8d, 0x3c, 0xce, 0xbe, 0x4b, 0x75, 0x55, 0x2a, 0xdb, 0xc5, 0xf7, 0x83, 0xea, 0x97,
+	0xf1, 0x7e, 0xe0, 0x7c, 0x07, 0x20, 0x5f, 0x61, 0xb6, 0x0c, 0xa3, 0xb0, 0x0c, 0x65, 0x07, 0x66,
+	0x31, 0x3e, 0x71, 0x2f, 0x4a, 0xd2, 0xac, 0xac, 0x88, 0x62, 0xf4, 0xad, 0x4e, 0x44, 0x5f, 0xa7,
+	0x0f, 0xed, 0x7c, 0x06, 0x6e, 0x7d, 0x88, 0xc1, 0x38, 0x23, 0x6d, 0xa3, 0xf4, 0x2e, 0x0a, 0x0a,
+	0x2d, 0x22, 0x39, 0xbf, 0x37, 0xa0, 0xad, 0xef, 0xe9, 0xef, 0xb1, 0x90, 0x5b, 0xef, 0x17, 0x0a,
+	0x8a, 0xf2, 0xb3, 0xe4, 0x48, 0x85, 0x9a, 0x62, 0x0b, 0x53, 0xcf, 0x28, 0x14, 0xa9, 0xfd, 0x5d,
+	0x2b, 0x0f, 0x78, 0x43, 0xca, 0xbb, 0x1a, 0xc7, 0x89, 0x60, 0x71, 0xa2, 0x63, 0x9f, 0x67, 0x84,
+	0x93, 0x59, 0xc1, 0xae, 0xee, 0x1c, 0x9a, 0x92, 0x97, 0xfc, 0xc2, 0xa3, 0x40, 0x2d, 0x2f, 0xf1,
+	0x4f, 0x66, 0x0f, 0x0f, 0xea, 0xed, 0x42, 0x53, 0xce, 0x43, 0x80, 0x7c, 0x6b, 0x25, 0xea, 0x19,
+	0x65, 0x05, 0x95, 0xcc, 0x0a, 0xf6, 0x3d, 0xef, 0xfd, 0x6a, 0x63, 0xe7, 0x57, 0x15, 0xa8, 0xde,
+	0x8e, 0xfc, 0x14, 0x6a, 0x22, 0x45, 0xc9, 0x45, 0x98, 0x85, 0x45, 0x14, 0xab, 0xd6, 0xca, 0x97,
+	0x51, 0xb5, 0x3e, 0x56, 0x42, 0x57, 0xa7, 0x4b, 0x68, 0x0f, 0xf4, 0x3d, 0xb9, 0xa7, 0xb0, 0xe4,
+	0x2d, 0xa2, 0xbd, 0xfa, 0xff, 0x65, 0x1c, 0x4c, 0xca, 0xab, 0x07, 0x8a, 0xb4, 0x8a, 0x5f, 0x08,
+	0x0a, 0x4c, 0xeb, 0xfa, 0x44, 0x0e, 0xb9, 0x54, 0x02, 0xfb, 0xd6, 0x9e, 0x4e, 0x1a, 0x6e, 0xc1,
+	0xae, 0x41, 0xc2, 0x5c, 0x9d, 0xc3, 0xae, 0xa9, 0x20, 0xb9, 0x61, 0x3b, 0x3e, 0xd4, 0xf0, 0x68,
+	0xb8, 0xb5, 0x01, 0x35, 0x7c, 0x1b, 0x49, 0x3d, 0x66, 0xa5, 0x04, 0x32, 0x02, 0xb8, 0x4a, 0x3a,
+	0xb7, 0xe2, 0xe3, 0xc5, 0xd7, 0x9b, 0xff, 0x06, 0xf3, 0xd6, 0xde, 0x93, 0x66, 0x25, 0xe7, 0x2f,
+	0x06, 0x1c, 0x9b, 0xa1, 0xd0, 0x29, 0xf3, 0x29, 0x3e, 0xe1, 0x98, 0xf3, 0x3c, 0xe1, 0x54, 0xf6,
+	0x7d, 0xc2, 0xa9, 0x7e, 0x69, 0x4f, 0x38, 0xce, 0xad, 0x3c, 0x38, 0x51, 0x41, 0xf6, 0xcb, 0x38,
+	0xa9, 0x4b, 0x99, 0x93, 0x2e, 0xf5, 0x98, 0xf3, 0x39, 0x3f, 0x34, 0xa0, 0xb5, 0xce, 0xfa, 0x7d,
+	0xf5, 0xee, 0x7c, 0x0a, 0x1a, 0xfd, 0x24, 0x1a, 0xf6, 0x32, 0xad, 0xd4, 0x91, 0xdc, 0xf4, 0xad,
+	0x63, 0x78, 0x0a, 0xf9, 0x83, 0x58, 0x55, 0x44, 0x9b, 0x13, 0xef, 0x64, 0x95, 0x89, 0x77, 0xb2,
+	0x55, 0xa8, 0xef, 0xd0, 0x3e, 0x06, 0xf6, 0xc3, 0x1f, 0xc2, 0xf4, 0x48, 0xe7, 0xcf, 0x35, 0x00,
+	0x75, 0x3a, 0xb8, 0x1c, 0x6b, 0x03, 0xaa, 0x38, 0xb5, 0x6d, 0x94, 0xce, 0xce, 0x0a, 0xc4, 0x95,
+	0xe2, 0xd6, 0x75, 0x30, 0x45, 0x64, 0x9b, 0xf3, 0x82, 0x98, 0x22, 0xb2, 0xbe, 0x01, 0x0b, 0x21,
+	0x7d, 0x10, 0x8c, 0x7b, 0x3a, 0x6c, 0x56, 0x4a, 0x3b, 0x8a, 0x3e, 0x49, 0xdc, 0x97, 0xdb, 0x96,
+	0x58, 0xfa, 0x65, 0x39, 0x83, 0xce, 0xe2, 0xeb, 0xd3, 0x43, 0x6f, 0x49, 0x28, 0xeb, 0x5b, 0xb0,
+	0xa8, 0xa0, 0xd3, 0xa0, 0x5e, 0x7b, 0x2a, 0x6c, 0xb5, 0xce, 0x6d, 0x9d, 0x11, 0xbe, 0x0d, 0x4b,
+	0xea, 0x4e, 0xdf, 0xf3, 0x76, 0x49, 0x38, 0xa0, 0xbe, 0x5d, 0x7f, 0x2a, 0xf4, 0x45, 0x85, 0x76,
+	0x43, 0x81, 0x21, 0x3c, 0xf1, 0x7d, 0xea, 0xf7, 0xb2, 0xe0, 0xd4, 0x98, 0x37, 0x38, 0x29, 0x78,
+	0x89, 0xb6, 0x95, 0xa6, 0x5e, 0x02, 0x47, 0x13, 0x3a, 0x8c, 0xf6, 0x8a, 0x13, 0x34, 0x9f, 0x6a,
+	0x82, 0x23, 0x1a, 0x2f, 0x9d, 0xc2, 0xf9, 0xab, 0x01, 0xed, 0xc2, 0x06, 0xb1, 0x88, 0xd3, 0x53,
+	0x15, 0xab, 0xf0, 0xb6, 0xe6, 0xc9, 0x52, 0x7c, 0xc6, 0xdd, 0x48, 0xe5, 0xce, 0x4a, 0x31, 0x77,
+	0x9e, 0x83, 0xb6, 0x74, 0x50, 0x1d, 0x6f, 0x54, 0xee, 0x01, 0x64, 0x6d, 0x67, 0x0f, 0x21, 0x22,
+	0xea, 0x4d, 0x64, 0xcd, 0xa6, 0x88, 0x74, 0x67, 0x2a, 0xad, 0xcb, 0xb3, 0xba, 0xbc, 0x75, 0x49,
+	0xe9, 0x4d, 0xc9, 0xd1, 0xd2, 0xba, 0xbb, 0xa1, 0xae, 0xa0, 0x22, 0x52, 0x9d, 0x0e, 0xcb, 0xe2,
+	0x8e, 0xdc, 0xd3, 0x93, 0x27, 0xfc, 0x42, 0x34, 0xaa, 0x4c, 0x27, 0x78, 0xf5, 0xdc, 0x56, 0x2d,
+	0x3e, 0xb7, 0xad, 0xfe, 0xe3, 0x18, 0x1c, 0x75, 0x53, 0x6d, 0x6f, 0xd3, 0x64, 0x8f, 0x79, 0xd4,
+	0xfa, 0x81, 0x09, 0x6d, 0xbc, 0x77, 0xa6, 0x1f, 0x09, 0x2e, 0x97, 0xfd, 0x40, 0xd5, 0x59, 0x2d,
+	0x1d, 0x01, 0xb8, 0xf3, 0x13, 0xe3, 0x93, 0x47, 0xf6, 0x4b, 0xf0, 0x5c, 0x3e, 0x6e, 0x2d, 0x1b,
+	0xb7, 0x96, 0x7e, 0x62, 0xa8, 0x73, 0x4a, 0x12, 0x6f, 0xf7, 0xb3, 0x47, 0xf6, 0xeb, 0x87, 0x8c,
+	0x3c, 0x35, 0xd5, 0xcb, 0xd7, 0x02, 0xc6, 0xc5, 0x27, 0x7f, 0xfb, 0xe7, 0x2f, 0x4c, 0xc7, 0x39,
+	0x73, 0xd0, 0x57, 0x5e, 0xbe, 0x66, 0xbc, 0x62, 0xfd, 0xcc, 0xc4, 0x38, 0x49, 0x7c, 0x7d, 0x0b,
+	0x28, 0xaf, 0x84, 0xf2, 0x61, 0xd0, 0xf9, 0x02, 0x75, 0x70, 0x11, 0x9e, 0x3f, 0x68, 0x67, 0x6b,
+	0x1f, 0x33, 0xff, 0x7b, 0x56, 0x35, 0xa1, 0xc4, 0xff, 0xec, 0x91, 0xfd, 0xbf, 0x4f, 0x32, 0xf8,
+	0xe4, 0x0c, 0x5d, 0x0c, 0xa8, 0x52, 0xc5, 0x2b, 0xce, 0xf9, 0x03, 0x55, 0xb1, 0xc2, 0xfc, 0x15,
+	0x04, 0x41, 0x95, 0xfc, 0xd6, 0x84, 0x23, 0x68, 0x18, 0xc5, 0xda, 0xe0, 0xcd, 0xf9, 0xca, 0x00,
+	0x55, 0x57, 0x75, 0xae, 0xce, 0x25, 0xcd, 0x9d, 0xdf, 0xa0, 0xa2, 0x5e, 0x81, 0xe5, 0x99, 0x7b,
+	0x2f, 0x54, 0x18, 0x05, 0x83, 0xd9, 0x78, 0x82, 0xd1, 0xd3, 0xba, 0x2c, 0x4c, 0x9a, 0x9b, 0xcf,
+	0x4b, 0xce, 0xf3, 0xb3, 0x75, 0x56, 0xc0, 0x42, 0x7d, 0x7d, 0x6e, 0xc2, 0x02, 0xea, 0x2b, 0x8b,
+	0x88, 0xe5, 0x8d, 0xe8, 0xea, 0x5c, 0xf5, 0x0f, 0x77, 0x7e, 0x89, 0x0a, 0x7a, 0x19, 0xce, 0xcc,
+	0xdc, 0x72, 0x56, 0x1c, 0xe5, 0xda, 0xf9, 0xca, 0x61, 0x43, 0xcf, 0x4e, 0x75, 0xa7, 0xbb, 0xca,
+	0xf5, 0xf2, 0x82, 0x73, 0x76, 0xb6, 0x5e, 0x52, 0x14, 0x54, 0xca, 0x4f, 0x4d, 0x68, 0xcb, 0x60,
+	0xae, 0x1d, 0xb5, 0xcc, 0x1b, 0x7a, 0x76, 0x81, 0xea, 0x5c, 0x29, 0xed, 0x5c, 0x28, 0xeb, 0xfc,
+	0xbc, 0x5c, 0x90, 0xb9, 0x7a, 0xc8, 0xc8, 0x83, 0x1c, 0xeb, 0x65, 0xc7, 0x39, 0xd8, 0xb1, 0x7c,
+	0xd6, 0xef, 0xa3, 0x42, 0x38, 0xd4, 0xd5, 0x7f, 0x19, 0xe6, 0x30, 0x8f, 0x32, 0x6a, 0xc8, 0xff,
+	0x30, 0x71, 0xd9, 0xb0, 0x3e, 0x35, 0xa1, 0x89, 0xd1, 0x4d, 0x56, 0x77, 0x65, 0x6a, 0x9a, 0x4d,
+	0xbf, 0x53, 0xb6, 0xc2, 0x48, 0x8d, 0xf1, 0xdc, 0x4c, 0x5d, 0xca, 0xea, 0x63, 0x32, 0xa8, 0xfd,
+	0xdf, 0xe1, 0x43, 0x3b, 0x53, 0x03, 0x6e, 0xcb, 0xce, 0x54, 0xfb, 0xe7, 0xad, 0x17, 0x66, 0x6b,
+	0x5f, 0x62, 0xa4, 0x41, 0x0d, 0xd5, 0xd0, 0x42, 0x0f, 0x95, 0xc2, 0x73, 0xe8, 0xff, 0x72, 0x49,
+	0x55, 0x70, 0xe7, 0x73, 0xd4, 0xc5, 0x8b, 0xd0, 0xd9, 0x7f, 0x83, 0x05, 0xfb, 0x7b, 0xe3, 0xc0,
+	0x71, 0xa7, 0xf7, 0xd1, 0x41, 0xe6, 0x8f, 0xfb, 0x9a, 0xa0, 0x52, 0x82, 0x9a, 0x06, 0x4d, 0xf0,
+	0xef, 0x06, 0xc0, 0xdb, 0x34, 0xff, 0xda, 0x34, 0x55, 0x46, 0x6c, 0xe0, 0x5f, 0x8e, 0x3a, 0xfb,
+	0xd8, 0xc9, 0x30, 0x0a, 0xbb, 0xe9, 0x5f, 0x8d, 0x34, 0xc4, 0x66, 0xd8, 0x8f, 0x9c, 0x4f, 0xd5,
+	0x56, 0x67, 0xbb, 0x90, 0x16, 0xc8, 0xce, 0xfc, 0x35, 0x38, 0xc9, 0xc7, 0x5c, 0xd0, 0xe1, 0x1a,
+	0x57, 0xd7, 0x90, 0x6c, 0xc4, 0xb3, 0x93, 0x7c, 0x3d, 0x43, 0x76, 0xd2, 0xe7, 0xac, 0x7d, 0x72,
+	0x79, 0x2a, 0xff, 0x6f, 0x03, 0x9e, 0x79, 0x57, 0x7d, 0x61, 0xfd, 0x00, 0x9f, 0xf1, 0xd5, 0x59,
+	0x97, 0x09, 0x3b, 0xd9, 0x5f, 0x96, 0xe6, 0xba, 0xd8, 0x7c, 0x7c, 0x40, 0x4e, 0xd7, 0x5f, 0x7e,
+	0x47, 0xb8, 0x2e, 0xab, 0x8a, 0x07, 0xf7, 0xd9, 0x23, 0xfb, 0x9d, 0x27, 0x19, 0x7c, 0x7e, 0xd6,
+	0x90, 0xe2, 0x36, 0xa5, 0x19, 0xbc, 0x75, 0xe7, 0x9b, 0xef, 0xa9, 0xef, 0x22, 0xb8, 0xcc, 0x15,
+	0x5c, 0x7c, 0xf6, 0xcf, 0xad, 0x95, 0x79, 0xfe, 0x07, 0xb7, 0x53, 0x97, 0x36, 0xf1, 0xda, 0x7f,
+	0x06, 0x00, 0x6d, 0x0e, 0x09, 0x96, 0x46, 0x27, 0x00, 0x00,
 }
@@ -72,6 +72,23 @@ func init() {
 		}
 		return ""
 	})
+	policy.MapMethodTo("/chef.automate.api.compliance.reporting.v1.ReportingService/DiffReports", "compliance:reporting:reports", "search", "POST", "/compliance/reporting/reports/diff", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*DiffQuery); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "from_id":
+					return m.FromId
+				case "to_id":
+					return m.ToId
+				case "node_id":
+					return m.NodeId
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
 	policy.MapMethodTo("/chef.automate.api.compliance.reporting.v1.ReportingService/ReadNode", "compliance:reporting:nodes:{id}", "read", "GET", "/compliance/reporting/nodes/id/{id}", func(unexpandedResource string, input interface{}) string {
 		if m, ok := input.(*Id); ok {
 			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
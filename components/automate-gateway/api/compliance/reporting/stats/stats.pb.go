@@ -46,7 +46,7 @@ func (x Query_OrderType) String() string {
 	return proto.EnumName(Query_OrderType_name, int32(x))
 }
 func (Query_OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{2, 0}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{2, 0}
 }
 
 type Empty struct {
@@ -59,7 +59,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{0}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{0}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -91,7 +91,7 @@ func (m *ListFilter) Reset()         { *m = ListFilter{} }
 func (m *ListFilter) String() string { return proto.CompactTextString(m) }
 func (*ListFilter) ProtoMessage()    {}
 func (*ListFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{1}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{1}
 }
 func (m *ListFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListFilter.Unmarshal(m, b)
@@ -144,7 +144,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{2}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{2}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -240,7 +240,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{3}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{3}
 }
 func (m *Summary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Summary.Unmarshal(m, b)
@@ -282,12 +282,14 @@ func (m *Summary) GetReportSummary() *ReportSummary {
 }
 
 type ControlsSummary struct {
-	Failures             int32    `protobuf:"varint,1,opt,name=failures,proto3" json:"failures,omitempty"`
-	Majors               int32    `protobuf:"varint,2,opt,name=majors,proto3" json:"majors,omitempty"`
-	Minors               int32    `protobuf:"varint,3,opt,name=minors,proto3" json:"minors,omitempty"`
-	Criticals            int32    `protobuf:"varint,4,opt,name=criticals,proto3" json:"criticals,omitempty"`
-	Passed               int32    `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`
-	Skipped              int32    `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failures  int32 `protobuf:"varint,1,opt,name=failures,proto3" json:"failures,omitempty"`
+	Majors    int32 `protobuf:"varint,2,opt,name=majors,proto3" json:"majors,omitempty"`
+	Minors    int32 `protobuf:"varint,3,opt,name=minors,proto3" json:"minors,omitempty"`
+	Criticals int32 `protobuf:"varint,4,opt,name=criticals,proto3" json:"criticals,omitempty"`
+	Passed    int32 `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`
+	Skipped   int32 `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// failed controls that a waiver applies to, not included in failures
+	Waived               int32    `protobuf:"varint,7,opt,name=waived,proto3" json:"waived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -297,7 +299,7 @@ func (m *ControlsSummary) Reset()         { *m = ControlsSummary{} }
 func (m *ControlsSummary) String() string { return proto.CompactTextString(m) }
 func (*ControlsSummary) ProtoMessage()    {}
 func (*ControlsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{4}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{4}
 }
 func (m *ControlsSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlsSummary.Unmarshal(m, b)
@@ -359,6 +361,13 @@ func (m *ControlsSummary) GetSkipped() int32 {
 	return 0
 }
 
+func (m *ControlsSummary) GetWaived() int32 {
+	if m != nil {
+		return m.Waived
+	}
+	return 0
+}
+
 type NodeSummary struct {
 	Compliant            int32    `protobuf:"varint,1,opt,name=compliant,proto3" json:"compliant,omitempty"`
 	Skipped              int32    `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
@@ -375,7 +384,7 @@ func (m *NodeSummary) Reset()         { *m = NodeSummary{} }
 func (m *NodeSummary) String() string { return proto.CompactTextString(m) }
 func (*NodeSummary) ProtoMessage()    {}
 func (*NodeSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{5}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{5}
 }
 func (m *NodeSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeSummary.Unmarshal(m, b)
@@ -451,7 +460,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{6}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{6}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stats.Unmarshal(m, b)
@@ -500,7 +509,8 @@ func (m *Stats) GetProfiles() int32 {
 }
 
 type ReportSummary struct {
-	Stats                *Stats   `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	Stats *Stats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	// waived if every failed control is waived
 	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Duration             float64  `protobuf:"fixed64,2,opt,name=duration,proto3" json:"duration,omitempty"`
 	StartDate            string   `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
@@ -513,7 +523,7 @@ func (m *ReportSummary) Reset()         { *m = ReportSummary{} }
 func (m *ReportSummary) String() string { return proto.CompactTextString(m) }
 func (*ReportSummary) ProtoMessage()    {}
 func (*ReportSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{7}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{7}
 }
 func (m *ReportSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportSummary.Unmarshal(m, b)
@@ -562,10 +572,12 @@ func (m *ReportSummary) GetStartDate() string {
 }
 
 type Trend struct {
-	ReportTime           string   `protobuf:"bytes,1,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
-	Passed               int32    `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
-	Failed               int32    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
-	Skipped              int32    `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	ReportTime string `protobuf:"bytes,1,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
+	Passed     int32  `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed     int32  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped    int32  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// failed controls that a waiver applies to, not included in failed
+	Waived               int32    `protobuf:"varint,5,opt,name=waived,proto3" json:"waived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -575,7 +587,7 @@ func (m *Trend) Reset()         { *m = Trend{} }
 func (m *Trend) String() string { return proto.CompactTextString(m) }
 func (*Trend) ProtoMessage()    {}
 func (*Trend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{8}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{8}
 }
 func (m *Trend) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Trend.Unmarshal(m, b)
@@ -623,6 +635,13 @@ func (m *Trend) GetSkipped() int32 {
 	return 0
 }
 
+func (m *Trend) GetWaived() int32 {
+	if m != nil {
+		return m.Waived
+	}
+	return 0
+}
+
 type Trends struct {
 	Trends               []*Trend `protobuf:"bytes,1,rep,name=trends,proto3" json:"trends,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -634,7 +653,7 @@ func (m *Trends) Reset()         { *m = Trends{} }
 func (m *Trends) String() string { return proto.CompactTextString(m) }
 func (*Trends) ProtoMessage()    {}
 func (*Trends) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{9}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{9}
 }
 func (m *Trends) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Trends.Unmarshal(m, b)
@@ -674,7 +693,7 @@ func (m *Profile) Reset()         { *m = Profile{} }
 func (m *Profile) String() string { return proto.CompactTextString(m) }
 func (*Profile) ProtoMessage()    {}
 func (*Profile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{10}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{10}
 }
 func (m *Profile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Profile.Unmarshal(m, b)
@@ -733,7 +752,7 @@ func (m *ProfileList) Reset()         { *m = ProfileList{} }
 func (m *ProfileList) String() string { return proto.CompactTextString(m) }
 func (*ProfileList) ProtoMessage()    {}
 func (*ProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{11}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{11}
 }
 func (m *ProfileList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileList.Unmarshal(m, b)
@@ -829,7 +848,7 @@ func (m *ProfileSummary) Reset()         { *m = ProfileSummary{} }
 func (m *ProfileSummary) String() string { return proto.CompactTextString(m) }
 func (*ProfileSummary) ProtoMessage()    {}
 func (*ProfileSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{12}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{12}
 }
 func (m *ProfileSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileSummary.Unmarshal(m, b)
@@ -934,7 +953,7 @@ func (m *ProfileSummaryStats) Reset()         { *m = ProfileSummaryStats{} }
 func (m *ProfileSummaryStats) String() string { return proto.CompactTextString(m) }
 func (*ProfileSummaryStats) ProtoMessage()    {}
 func (*ProfileSummaryStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{13}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{13}
 }
 func (m *ProfileSummaryStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileSummaryStats.Unmarshal(m, b)
@@ -1005,7 +1024,7 @@ func (m *ControlStats) Reset()         { *m = ControlStats{} }
 func (m *ControlStats) String() string { return proto.CompactTextString(m) }
 func (*ControlStats) ProtoMessage()    {}
 func (*ControlStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{14}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{14}
 }
 func (m *ControlStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlStats.Unmarshal(m, b)
@@ -1084,7 +1103,7 @@ func (m *Support) Reset()         { *m = Support{} }
 func (m *Support) String() string { return proto.CompactTextString(m) }
 func (*Support) ProtoMessage()    {}
 func (*Support) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{15}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{15}
 }
 func (m *Support) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Support.Unmarshal(m, b)
@@ -1167,7 +1186,7 @@ func (m *Failures) Reset()         { *m = Failures{} }
 func (m *Failures) String() string { return proto.CompactTextString(m) }
 func (*Failures) ProtoMessage()    {}
 func (*Failures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{16}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{16}
 }
 func (m *Failures) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Failures.Unmarshal(m, b)
@@ -1216,10 +1235,12 @@ func (m *Failures) GetEnvironments() []*FailureSummary {
 }
 
 type FailureSummary struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Failures             int32    `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	Profile              string   `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Failures int32  `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+	Id       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Profile  string `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	// failed controls that a waiver applies to, not included in failures
+	Waived               int32    `protobuf:"varint,5,opt,name=waived,proto3" json:"waived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1229,7 +1250,7 @@ func (m *FailureSummary) Reset()         { *m = FailureSummary{} }
 func (m *FailureSummary) String() string { return proto.CompactTextString(m) }
 func (*FailureSummary) ProtoMessage()    {}
 func (*FailureSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_0869e04145cbefa0, []int{17}
+	return fileDescriptor_stats_91d3b5fdd3d19d66, []int{17}
 }
 func (m *FailureSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FailureSummary.Unmarshal(m, b)
@@ -1277,6 +1298,13 @@ func (m *FailureSummary) GetProfile() string {
 	return ""
 }
 
+func (m *FailureSummary) GetWaived() int32 {
+	if m != nil {
+		return m.Waived
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "chef.automate.api.compliance.reporting.stats.v1.Empty")
 	proto.RegisterType((*ListFilter)(nil), "chef.automate.api.compliance.reporting.stats.v1.ListFilter")
@@ -1477,108 +1505,109 @@ var _StatsService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/compliance/reporting/stats/stats.proto", fileDescriptor_stats_0869e04145cbefa0)
-}
-
-var fileDescriptor_stats_0869e04145cbefa0 = []byte{
-	// 1578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x14, 0xc9,
-	0x15, 0x4f, 0xcf, 0x77, 0xbf, 0xb1, 0xc7, 0xa4, 0x20, 0x4e, 0xe3, 0x18, 0x30, 0x0d, 0x49, 0x1c,
-	0x22, 0xcf, 0x28, 0x8e, 0x44, 0x88, 0x93, 0x10, 0x02, 0x86, 0x13, 0x22, 0xa4, 0x6d, 0x50, 0x04,
-	0x8a, 0x46, 0x45, 0x4f, 0x79, 0x5c, 0xb8, 0xbf, 0x54, 0x55, 0x63, 0x34, 0xc9, 0x8d, 0x53, 0x94,
-	0x6b, 0x4e, 0xc9, 0x69, 0xbf, 0xb4, 0xd2, 0x22, 0xad, 0xb4, 0x17, 0xa4, 0x3d, 0xed, 0x61, 0xef,
-	0x7b, 0xda, 0x7f, 0x61, 0xaf, 0xbb, 0x7f, 0xc3, 0xaa, 0xbe, 0x7a, 0xba, 0xb1, 0xc7, 0xf2, 0x0c,
-	0x68, 0x2f, 0x56, 0xbf, 0x5f, 0xbd, 0x7a, 0x5f, 0xf5, 0xea, 0xf7, 0xca, 0x03, 0xf7, 0xc2, 0x34,
-	0xce, 0xd2, 0x84, 0x24, 0x82, 0xf7, 0xf0, 0x48, 0xa4, 0x31, 0x16, 0x64, 0x63, 0x88, 0x05, 0x79,
-	0x81, 0xc7, 0x3d, 0x9c, 0xd1, 0x9e, 0x5c, 0x8f, 0x28, 0x4e, 0x42, 0xd2, 0x63, 0x24, 0x4b, 0x99,
-	0xa0, 0xc9, 0xb0, 0xc7, 0x05, 0x16, 0x5c, 0xff, 0xed, 0x66, 0x2c, 0x15, 0x29, 0xea, 0x85, 0xfb,
-	0x64, 0xaf, 0x6b, 0x2d, 0x74, 0x71, 0x46, 0xbb, 0x93, 0x9d, 0xdd, 0x7c, 0x67, 0x57, 0xef, 0x39,
-	0xfc, 0xcd, 0xca, 0xea, 0x30, 0x4d, 0x87, 0x11, 0x51, 0x3e, 0x70, 0x92, 0xa4, 0x02, 0x0b, 0x9a,
-	0x26, 0xc6, 0xdc, 0xca, 0xad, 0x63, 0xc3, 0x62, 0x59, 0xd8, 0x53, 0xeb, 0xe1, 0xc6, 0x90, 0x24,
-	0x1b, 0x59, 0x1a, 0xd1, 0x70, 0xfc, 0x0e, 0x2c, 0x50, 0x1c, 0x1f, 0xb5, 0xe0, 0x37, 0xa1, 0x7e,
-	0x37, 0xce, 0xc4, 0xd8, 0xbf, 0x01, 0x70, 0x9f, 0x72, 0x71, 0x8f, 0x46, 0x82, 0x30, 0xb4, 0x0c,
-	0x8d, 0x43, 0x1c, 0x8d, 0x08, 0xf7, 0xce, 0xad, 0x55, 0xd7, 0xdd, 0xc0, 0x48, 0x08, 0x41, 0x4d,
-	0x8c, 0x33, 0xe2, 0xfd, 0x64, 0xcd, 0x59, 0x77, 0x03, 0xf5, 0xed, 0x7f, 0x5b, 0x81, 0xfa, 0xdf,
-	0x46, 0x84, 0x8d, 0x51, 0x07, 0x2a, 0x74, 0xe0, 0xfd, 0x58, 0xad, 0x55, 0xe8, 0x20, 0xd7, 0x3e,
-	0x3b, 0xd1, 0x96, 0x18, 0xa7, 0xff, 0x24, 0x1e, 0x5a, 0x73, 0xd6, 0xeb, 0x81, 0xfa, 0x46, 0x2b,
-	0xd0, 0xa2, 0x89, 0x20, 0xec, 0x10, 0x47, 0xde, 0x79, 0x85, 0xe7, 0x32, 0x7a, 0x04, 0xcd, 0x3d,
-	0x15, 0x93, 0x0e, 0xa5, 0xbd, 0xf9, 0x87, 0xee, 0x8c, 0xa7, 0xd0, 0x9d, 0xe4, 0x15, 0x58, 0x5b,
-	0xe8, 0x31, 0xd4, 0x53, 0x36, 0x20, 0x4c, 0x65, 0xd2, 0xd9, 0xbc, 0x35, 0xb3, 0x51, 0x95, 0x71,
-	0xf7, 0xaf, 0xd2, 0xc6, 0xee, 0x38, 0x23, 0x81, 0x36, 0xa7, 0xd2, 0x4b, 0x99, 0xf0, 0x96, 0x75,
-	0xca, 0xf2, 0x5b, 0x62, 0x19, 0x1e, 0x12, 0xef, 0xa7, 0x3a, 0x65, 0xf9, 0x8d, 0xce, 0x43, 0x2b,
-	0x23, 0xac, 0xaf, 0x70, 0x4f, 0xe1, 0xcd, 0x8c, 0xb0, 0x87, 0x78, 0x48, 0xfc, 0x8b, 0xe0, 0xe6,
-	0x66, 0x51, 0x13, 0xaa, 0x7f, 0xd9, 0xb9, 0x73, 0xe6, 0x47, 0xa8, 0x05, 0xb5, 0xed, 0xbb, 0x3b,
-	0x77, 0xce, 0x38, 0xfe, 0x17, 0x15, 0x68, 0xee, 0x8c, 0xe2, 0x18, 0xb3, 0x31, 0x3a, 0x80, 0x33,
-	0x61, 0x9a, 0x08, 0x96, 0x46, 0xbc, 0xcf, 0x35, 0xe6, 0x39, 0x6b, 0xce, 0x7a, 0x7b, 0x8e, 0x8c,
-	0xee, 0x18, 0x43, 0xc6, 0x76, 0xb0, 0x14, 0x96, 0x01, 0xd4, 0x87, 0x85, 0x24, 0x1d, 0x90, 0xdc,
-	0x51, 0x45, 0x39, 0xfa, 0xe3, 0xcc, 0x8e, 0x1e, 0xa4, 0x03, 0x62, 0x9d, 0xb4, 0x93, 0x89, 0x80,
-	0x08, 0x74, 0xb4, 0x7a, 0xee, 0xa2, 0xaa, 0x5c, 0xdc, 0x9c, 0xd9, 0x45, 0xa0, 0x20, 0xeb, 0x64,
-	0x91, 0x15, 0x45, 0xff, 0x53, 0x07, 0x96, 0xde, 0x48, 0x56, 0xb6, 0xe0, 0x1e, 0xa6, 0xd1, 0x88,
-	0x11, 0xae, 0x0a, 0x58, 0x0f, 0x72, 0x59, 0x5e, 0x86, 0x18, 0x3f, 0x4f, 0x19, 0x57, 0x19, 0xd7,
-	0x03, 0x23, 0x29, 0x9c, 0x26, 0x12, 0xaf, 0x1a, 0x5c, 0x49, 0x68, 0x15, 0xdc, 0x90, 0x51, 0x41,
-	0x43, 0x1c, 0x71, 0xaf, 0xa6, 0x96, 0x26, 0x80, 0xdc, 0x95, 0x61, 0xce, 0xc9, 0xc0, 0xab, 0xeb,
-	0x5d, 0x5a, 0x42, 0x1e, 0x34, 0xf9, 0x01, 0xcd, 0x32, 0x32, 0xf0, 0x1a, 0xba, 0x21, 0x8c, 0xe8,
-	0x7f, 0xe9, 0x40, 0xbb, 0x50, 0x33, 0x65, 0xdf, 0x64, 0x2f, 0x4c, 0xb0, 0x13, 0xa0, 0x68, 0xa7,
-	0x52, 0xb2, 0x83, 0x7c, 0x79, 0x7e, 0xc9, 0x64, 0xab, 0x8e, 0xba, 0x84, 0xa1, 0x9f, 0x81, 0xbb,
-	0x4f, 0x87, 0xfb, 0x7d, 0x46, 0xf9, 0x81, 0x89, 0xbd, 0x25, 0x81, 0x80, 0xf2, 0x03, 0x74, 0x09,
-	0xda, 0x31, 0x19, 0xd0, 0x51, 0xac, 0x97, 0x75, 0xfc, 0xa0, 0x21, 0xa5, 0x70, 0x1e, 0x5a, 0x51,
-	0xfa, 0x42, 0xaf, 0x9a, 0x24, 0xa2, 0xf4, 0x85, 0x5c, 0xf2, 0xff, 0x05, 0xf5, 0x1d, 0x79, 0x3a,
-	0xe8, 0x1c, 0xd4, 0xe5, 0x99, 0xeb, 0x32, 0x57, 0x03, 0x2d, 0xc8, 0x9c, 0xb2, 0x08, 0x8b, 0xbd,
-	0x94, 0xc5, 0xb6, 0xcc, 0x13, 0x40, 0x46, 0x4e, 0x92, 0x43, 0xca, 0xd2, 0x24, 0x96, 0x5c, 0x67,
-	0x23, 0x2f, 0x62, 0xf2, 0x04, 0x33, 0x96, 0xee, 0xd1, 0x88, 0xd8, 0xa2, 0xe7, 0xb2, 0xff, 0x99,
-	0x03, 0x8b, 0xa5, 0x96, 0x40, 0xf7, 0xa1, 0xae, 0x9a, 0x45, 0xa9, 0xb6, 0x37, 0xaf, 0xcf, 0xdc,
-	0x61, 0x2a, 0x99, 0x40, 0x1b, 0x91, 0x67, 0x2a, 0x3f, 0x46, 0x3a, 0x29, 0x37, 0x30, 0x92, 0x8c,
-	0x69, 0x30, 0x62, 0x8a, 0x70, 0x55, 0x52, 0x4e, 0x90, 0xcb, 0xe8, 0x02, 0x00, 0x17, 0x98, 0x89,
-	0xfe, 0x00, 0x0b, 0xa2, 0x32, 0x72, 0x03, 0x57, 0x21, 0xdb, 0x58, 0x10, 0x9f, 0x41, 0x7d, 0x97,
-	0x91, 0x64, 0x20, 0x8b, 0x6e, 0x2e, 0x85, 0xa0, 0x31, 0x31, 0x0e, 0x40, 0x43, 0xbb, 0x34, 0x26,
-	0x85, 0x86, 0xaa, 0x94, 0x1a, 0x6a, 0x19, 0x1a, 0xb2, 0x85, 0xc9, 0xc0, 0xb6, 0xa7, 0x96, 0x8a,
-	0x0d, 0x52, 0x2b, 0x37, 0xda, 0xdf, 0xa1, 0xa1, 0x7c, 0x72, 0xf4, 0x00, 0x1a, 0x42, 0x7d, 0x79,
-	0x8e, 0x22, 0xdd, 0xd9, 0xeb, 0xa3, 0x0c, 0x05, 0xc6, 0x8a, 0xff, 0x79, 0x05, 0x9a, 0x0f, 0xf5,
-	0x69, 0x48, 0x1a, 0x31, 0x07, 0xd3, 0x8f, 0x28, 0x17, 0xc6, 0xc3, 0xec, 0x34, 0x62, 0xec, 0x49,
-	0x76, 0x0f, 0xda, 0xd9, 0x44, 0x40, 0xfb, 0xb0, 0x64, 0x1d, 0x94, 0xa9, 0xea, 0xcf, 0xf3, 0xfa,
-	0xb0, 0x44, 0xd2, 0xc9, 0x4a, 0x32, 0x7a, 0x06, 0x8b, 0x86, 0x24, 0xfb, 0xba, 0x9b, 0xaa, 0x2a,
-	0x97, 0x3f, 0xcd, 0xcb, 0xbd, 0xba, 0xa9, 0x16, 0xc2, 0x82, 0xe4, 0x7f, 0xe5, 0x40, 0xbb, 0x90,
-	0xaa, 0x9c, 0x26, 0x09, 0xce, 0x1b, 0x41, 0x7d, 0x9b, 0xc1, 0x5b, 0xc9, 0x07, 0x6f, 0x91, 0xcd,
-	0xaa, 0x53, 0xd9, 0xac, 0x36, 0x85, 0xcd, 0xea, 0xd3, 0xd9, 0xac, 0x31, 0x9d, 0xcd, 0x9a, 0xd3,
-	0xd8, 0xac, 0x55, 0x6e, 0xb2, 0xf7, 0xab, 0xd0, 0x29, 0x97, 0xf5, 0xd8, 0x94, 0xce, 0x41, 0x5d,
-	0x50, 0x11, 0x11, 0x93, 0x95, 0x16, 0xa4, 0xd9, 0x43, 0xc2, 0xb8, 0xbc, 0x4f, 0xfa, 0xc6, 0x58,
-	0x51, 0xae, 0x44, 0x34, 0x24, 0x09, 0x27, 0x2a, 0x2f, 0x37, 0xb0, 0x22, 0xba, 0x08, 0x10, 0x63,
-	0x9a, 0x08, 0x4c, 0x13, 0xc2, 0x54, 0x72, 0x6e, 0x50, 0x40, 0x34, 0x9d, 0x66, 0x63, 0x46, 0x87,
-	0xfb, 0x42, 0x25, 0xe8, 0x06, 0x13, 0x00, 0xfd, 0x12, 0x96, 0x72, 0xa1, 0x4f, 0x62, 0x4c, 0x23,
-	0x95, 0xa9, 0x1b, 0x74, 0x72, 0xf8, 0xae, 0x44, 0x55, 0xc6, 0xa6, 0xdb, 0x5a, 0x3a, 0x00, 0x23,
-	0xa2, 0x5d, 0x68, 0xf1, 0x51, 0x26, 0xcf, 0x9c, 0x7b, 0xae, 0x6a, 0x90, 0x1b, 0xb3, 0xd3, 0x8d,
-	0x36, 0x10, 0xe4, 0x96, 0xd0, 0x13, 0xcb, 0x60, 0xa0, 0x7a, 0x7b, 0xfb, 0x2d, 0x7b, 0xbb, 0xc8,
-	0x67, 0xfe, 0x87, 0x0e, 0x9c, 0x3d, 0x66, 0xb9, 0x40, 0x29, 0x4e, 0x89, 0x52, 0xa6, 0x51, 0x50,
-	0xa1, 0x0b, 0xaa, 0xe5, 0x59, 0x74, 0x19, 0x16, 0xf4, 0xde, 0xbe, 0x1e, 0x06, 0xba, 0x17, 0xdb,
-	0x1a, 0x7b, 0xa0, 0x46, 0xc2, 0x25, 0x68, 0x8b, 0x54, 0xe0, 0xc8, 0x68, 0x98, 0x69, 0xa3, 0x20,
-	0xa5, 0xe0, 0xbf, 0xe7, 0xc0, 0x42, 0xf1, 0xe2, 0x48, 0x77, 0xe6, 0xea, 0x98, 0x56, 0xb2, 0xe2,
-	0x94, 0x6e, 0x9a, 0x84, 0x5d, 0x9d, 0xc2, 0x9c, 0xb5, 0x69, 0xcc, 0x59, 0x2f, 0xa7, 0xb3, 0x0c,
-	0x0d, 0x1a, 0x67, 0x38, 0xd4, 0x0d, 0x54, 0x09, 0x8c, 0xe4, 0x7f, 0xe7, 0xc8, 0xb7, 0x9a, 0x3a,
-	0x31, 0xb9, 0x3b, 0xe5, 0xfd, 0x42, 0xa3, 0x37, 0x53, 0xbe, 0xa1, 0x7a, 0x7d, 0x15, 0xdc, 0x94,
-	0xf7, 0xf7, 0x70, 0x4c, 0xa3, 0xb1, 0x89, 0xd0, 0x4d, 0xf9, 0x86, 0x06, 0xe4, 0x3e, 0x46, 0x22,
-	0x82, 0xb9, 0x9d, 0x12, 0x56, 0x44, 0x3f, 0x87, 0x0e, 0x4d, 0x78, 0x46, 0xc2, 0xbe, 0xbd, 0x14,
-	0xba, 0xf5, 0x17, 0x35, 0xfa, 0xd8, 0x5c, 0x8d, 0xab, 0xb0, 0x68, 0x47, 0xa9, 0x76, 0xaf, 0xef,
-	0x40, 0x0e, 0xea, 0x20, 0xd6, 0x61, 0x29, 0xd7, 0x32, 0xa1, 0xe8, 0xcb, 0x90, 0xc3, 0x36, 0x20,
-	0x39, 0x69, 0x0d, 0x64, 0xee, 0x42, 0x2e, 0xfb, 0xff, 0xae, 0x42, 0xeb, 0x9e, 0xa5, 0x9a, 0xa7,
-	0x85, 0x91, 0xac, 0x59, 0x7e, 0x76, 0x06, 0x36, 0xc6, 0x2c, 0x03, 0xe7, 0x06, 0xd1, 0x3f, 0xca,
-	0x2f, 0x86, 0x77, 0x62, 0xbd, 0xf0, 0xe4, 0x78, 0x0a, 0x2d, 0xfb, 0xfe, 0x35, 0xac, 0xfe, 0xf6,
-	0xb1, 0x5b, 0x83, 0x28, 0x7c, 0xe3, 0x3d, 0x53, 0x7b, 0x37, 0x0e, 0x4a, 0x46, 0xfd, 0xe7, 0xd0,
-	0x29, 0xaf, 0x1f, 0xcb, 0xb3, 0xc5, 0x51, 0x51, 0x79, 0x63, 0x54, 0xe8, 0xb1, 0x52, 0xcd, 0xc7,
-	0x8a, 0x07, 0x4d, 0x53, 0x7e, 0xcb, 0xb1, 0x46, 0xdc, 0x7c, 0xe5, 0xc2, 0x82, 0xba, 0x83, 0x3b,
-	0x84, 0x1d, 0xd2, 0x90, 0xa0, 0x8f, 0x2a, 0xd0, 0x0e, 0x08, 0x1e, 0x58, 0xd7, 0xd7, 0xe7, 0xfb,
-	0x07, 0x6b, 0x65, 0x1e, 0xa6, 0xd4, 0xaf, 0xfc, 0x0f, 0x9c, 0x97, 0xaf, 0xbd, 0x6b, 0xe0, 0x4f,
-	0xb4, 0xb7, 0x72, 0xed, 0x2d, 0xa5, 0xbd, 0x65, 0x09, 0xba, 0xc6, 0x08, 0x1e, 0xfc, 0xe7, 0xb5,
-	0x77, 0xfb, 0x54, 0xda, 0xab, 0x47, 0x74, 0x8c, 0xcb, 0xad, 0x21, 0x11, 0x2f, 0xbf, 0xfe, 0xe6,
-	0xbf, 0x95, 0x75, 0xff, 0xca, 0x89, 0x3f, 0x29, 0x18, 0x75, 0xe7, 0x1a, 0xfa, 0x5f, 0x05, 0x5c,
-	0x59, 0x26, 0xfd, 0xd4, 0x9b, 0xb7, 0x48, 0xbf, 0x9b, 0xef, 0x75, 0xc6, 0xfd, 0xff, 0xcb, 0x1a,
-	0xad, 0xc3, 0xda, 0x09, 0x59, 0xab, 0x07, 0x5c, 0x5e, 0xa1, 0x9b, 0xa7, 0xd0, 0x5d, 0x39, 0xa2,
-	0xa1, 0xbc, 0xe5, 0xd5, 0xf9, 0x85, 0x7f, 0xf9, 0xa4, 0xea, 0x28, 0x23, 0xb2, 0x36, 0x9f, 0x54,
-	0x60, 0x41, 0xd6, 0xe6, 0xa1, 0xbd, 0xf1, 0x3f, 0x5c, 0x0f, 0x19, 0x97, 0xfe, 0xc7, 0xb2, 0x3e,
-	0xbf, 0x86, 0x2b, 0x27, 0xe4, 0x9c, 0xf3, 0x91, 0x2d, 0xd1, 0xf6, 0xe9, 0xd4, 0x2f, 0x1c, 0x51,
-	0xb2, 0x79, 0xe6, 0x85, 0xfa, 0x95, 0x7f, 0xf5, 0xa4, 0x42, 0x59, 0x53, 0xb2, 0x56, 0xaf, 0x4c,
-	0xad, 0x72, 0xea, 0x9d, 0xb7, 0x56, 0xbf, 0x9f, 0x97, 0x83, 0xf8, 0x69, 0x8a, 0x95, 0x33, 0xcb,
-	0xe9, 0x8a, 0x95, 0xab, 0x1f, 0x2d, 0x96, 0xf5, 0x7a, 0xda, 0x62, 0x59, 0x53, 0x5b, 0xce, 0xb5,
-	0xdb, 0x8f, 0x9e, 0xec, 0x0c, 0xa9, 0xd8, 0x1f, 0x3d, 0x93, 0x99, 0xa9, 0xdf, 0xf4, 0xf2, 0x1f,
-	0xcf, 0x7a, 0xf3, 0xff, 0x52, 0xf8, 0xac, 0xa1, 0x7e, 0x51, 0xfb, 0xed, 0xf7, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x2f, 0x0e, 0x43, 0x6e, 0x6e, 0x14, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/compliance/reporting/stats/stats.proto", fileDescriptor_stats_91d3b5fdd3d19d66)
+}
+
+var fileDescriptor_stats_91d3b5fdd3d19d66 = []byte{
+	// 1586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0x67, 0xfd, 0x11, 0x7b, 0x9f, 0x13, 0xa7, 0x4c, 0x4b, 0xd9, 0x86, 0xb6, 0xa4, 0xdb, 0x02,
+	0xa1, 0x28, 0xb6, 0x08, 0x52, 0x29, 0x01, 0x4a, 0x69, 0xd3, 0x9e, 0xaa, 0x52, 0x36, 0x69, 0x85,
+	0x8a, 0x90, 0x35, 0x5d, 0x4f, 0x9c, 0x21, 0xfb, 0xa5, 0x99, 0x71, 0x22, 0xc3, 0xad, 0x27, 0xd4,
+	0x2b, 0x27, 0x38, 0xf1, 0x25, 0x24, 0x7a, 0xe2, 0x52, 0x89, 0x13, 0x07, 0x24, 0x8e, 0x9c, 0xf8,
+	0x17, 0xb8, 0xc2, 0xdf, 0x80, 0xe6, 0x6b, 0xbd, 0x6e, 0xe2, 0x28, 0x36, 0x11, 0x97, 0x68, 0xdf,
+	0x6f, 0xde, 0xbc, 0x37, 0xbf, 0x37, 0x6f, 0x7e, 0x33, 0x31, 0xdc, 0x0c, 0xd3, 0x38, 0x4b, 0x13,
+	0x92, 0x08, 0xde, 0xc6, 0x7d, 0x91, 0xc6, 0x58, 0x90, 0xe5, 0x1e, 0x16, 0x64, 0x17, 0x0f, 0xda,
+	0x38, 0xa3, 0x6d, 0x39, 0x1e, 0x51, 0x9c, 0x84, 0xa4, 0xcd, 0x48, 0x96, 0x32, 0x41, 0x93, 0x5e,
+	0x9b, 0x0b, 0x2c, 0xb8, 0xfe, 0xdb, 0xca, 0x58, 0x2a, 0x52, 0xd4, 0x0e, 0xb7, 0xc8, 0x66, 0xcb,
+	0x46, 0x68, 0xe1, 0x8c, 0xb6, 0x86, 0x33, 0x5b, 0xf9, 0xcc, 0x96, 0x9e, 0xb3, 0xf3, 0xfa, 0xc2,
+	0xe9, 0x5e, 0x9a, 0xf6, 0x22, 0xa2, 0x72, 0xe0, 0x24, 0x49, 0x05, 0x16, 0x34, 0x4d, 0x4c, 0xb8,
+	0x85, 0xab, 0xfb, 0x2e, 0x8b, 0x65, 0x61, 0x5b, 0x8d, 0x87, 0xcb, 0x3d, 0x92, 0x2c, 0x67, 0x69,
+	0x44, 0xc3, 0xc1, 0x11, 0x44, 0xa0, 0x38, 0xde, 0x1b, 0xc1, 0xaf, 0x41, 0xf5, 0x46, 0x9c, 0x89,
+	0x81, 0x7f, 0x19, 0xe0, 0x16, 0xe5, 0xe2, 0x26, 0x8d, 0x04, 0x61, 0xe8, 0x24, 0xcc, 0xec, 0xe0,
+	0xa8, 0x4f, 0xb8, 0x77, 0x62, 0xb1, 0xbc, 0xe4, 0x06, 0xc6, 0x42, 0x08, 0x2a, 0x62, 0x90, 0x11,
+	0xef, 0xb9, 0x45, 0x67, 0xc9, 0x0d, 0xd4, 0xb7, 0xff, 0x77, 0x09, 0xaa, 0x1f, 0xf6, 0x09, 0x1b,
+	0xa0, 0x26, 0x94, 0x68, 0xd7, 0x7b, 0x56, 0x8d, 0x95, 0x68, 0x37, 0xf7, 0x3e, 0x3e, 0xf4, 0x96,
+	0x18, 0xa7, 0x9f, 0x11, 0x0f, 0x2d, 0x3a, 0x4b, 0xd5, 0x40, 0x7d, 0xa3, 0x05, 0xa8, 0xd3, 0x44,
+	0x10, 0xb6, 0x83, 0x23, 0xef, 0x94, 0xc2, 0x73, 0x1b, 0xdd, 0x85, 0xda, 0xa6, 0x5a, 0x93, 0x5e,
+	0x4a, 0x63, 0xe5, 0xed, 0xd6, 0x84, 0xbb, 0xd0, 0x1a, 0xf2, 0x0a, 0x6c, 0x2c, 0x74, 0x0f, 0xaa,
+	0x29, 0xeb, 0x12, 0xa6, 0x98, 0x34, 0x57, 0xae, 0x4e, 0x1c, 0x54, 0x31, 0x6e, 0x7d, 0x20, 0x63,
+	0x6c, 0x0c, 0x32, 0x12, 0xe8, 0x70, 0x8a, 0x5e, 0xca, 0x84, 0x77, 0x52, 0x53, 0x96, 0xdf, 0x12,
+	0xcb, 0x70, 0x8f, 0x78, 0xcf, 0x6b, 0xca, 0xf2, 0x1b, 0x9d, 0x82, 0x7a, 0x46, 0x58, 0x47, 0xe1,
+	0x9e, 0xc2, 0x6b, 0x19, 0x61, 0x77, 0x70, 0x8f, 0xf8, 0x67, 0xc1, 0xcd, 0xc3, 0xa2, 0x1a, 0x94,
+	0xdf, 0x5f, 0xbf, 0x7e, 0xec, 0x19, 0x54, 0x87, 0xca, 0xda, 0x8d, 0xf5, 0xeb, 0xc7, 0x1c, 0xff,
+	0xd7, 0x12, 0xd4, 0xd6, 0xfb, 0x71, 0x8c, 0xd9, 0x00, 0x6d, 0xc3, 0xb1, 0x30, 0x4d, 0x04, 0x4b,
+	0x23, 0xde, 0xe1, 0x1a, 0xf3, 0x9c, 0x45, 0x67, 0xa9, 0x31, 0x05, 0xa3, 0xeb, 0x26, 0x90, 0x89,
+	0x1d, 0xcc, 0x87, 0xa3, 0x00, 0xea, 0xc0, 0x6c, 0x92, 0x76, 0x49, 0x9e, 0xa8, 0xa4, 0x12, 0xbd,
+	0x33, 0x71, 0xa2, 0xdb, 0x69, 0x97, 0xd8, 0x24, 0x8d, 0x64, 0x68, 0x20, 0x02, 0x4d, 0xed, 0x9e,
+	0xa7, 0x28, 0xab, 0x14, 0x57, 0x26, 0x4e, 0x11, 0x28, 0xc8, 0x26, 0x99, 0x63, 0x45, 0xd3, 0xff,
+	0xdd, 0x81, 0xf9, 0xa7, 0xc8, 0xca, 0x16, 0xdc, 0xc4, 0x34, 0xea, 0x33, 0xc2, 0x55, 0x01, 0xab,
+	0x41, 0x6e, 0xcb, 0xc3, 0x10, 0xe3, 0x4f, 0x53, 0xc6, 0x15, 0xe3, 0x6a, 0x60, 0x2c, 0x85, 0xd3,
+	0x44, 0xe2, 0x65, 0x83, 0x2b, 0x0b, 0x9d, 0x06, 0x37, 0x64, 0x54, 0xd0, 0x10, 0x47, 0xdc, 0xab,
+	0xa8, 0xa1, 0x21, 0x20, 0x67, 0x65, 0x98, 0x73, 0xd2, 0xf5, 0xaa, 0x7a, 0x96, 0xb6, 0x90, 0x07,
+	0x35, 0xbe, 0x4d, 0xb3, 0x8c, 0x74, 0xbd, 0x19, 0xdd, 0x10, 0xc6, 0x94, 0x33, 0x76, 0x31, 0xdd,
+	0x21, 0x5d, 0xaf, 0xa6, 0x67, 0x68, 0xcb, 0xff, 0xcd, 0x81, 0x46, 0xa1, 0x96, 0x2a, 0xaf, 0xa9,
+	0x8a, 0x30, 0x24, 0x86, 0x40, 0x31, 0x7e, 0x69, 0x34, 0xbe, 0x2f, 0xf7, 0x35, 0x19, 0x4e, 0xd5,
+	0x6c, 0x46, 0x30, 0xf4, 0x02, 0xb8, 0x5b, 0xb4, 0xb7, 0xd5, 0x61, 0x94, 0x6f, 0x1b, 0x4e, 0x75,
+	0x09, 0x04, 0x94, 0x6f, 0xa3, 0x17, 0xa1, 0x11, 0x93, 0x2e, 0xed, 0xc7, 0x7a, 0x58, 0xf3, 0x02,
+	0x0d, 0x29, 0x87, 0x53, 0x50, 0x8f, 0xd2, 0x5d, 0x3d, 0x6a, 0xc8, 0x45, 0xe9, 0xae, 0x1c, 0xf2,
+	0x3f, 0x87, 0xea, 0xba, 0xdc, 0x35, 0x74, 0x02, 0xaa, 0xb2, 0x17, 0x74, 0xf9, 0xcb, 0x81, 0x36,
+	0x24, 0xa7, 0x2c, 0xc2, 0x62, 0x33, 0x65, 0xb1, 0x2d, 0xff, 0x10, 0x90, 0x2b, 0x27, 0xc9, 0x0e,
+	0x65, 0x69, 0x12, 0x4b, 0x0d, 0xb4, 0x2b, 0x2f, 0x62, 0x72, 0x67, 0x33, 0x96, 0x6e, 0xd2, 0x88,
+	0xd8, 0xcd, 0xc8, 0x6d, 0xff, 0x67, 0x07, 0xe6, 0x46, 0x5a, 0x05, 0xdd, 0x82, 0xaa, 0x6a, 0x22,
+	0xe5, 0xda, 0x58, 0xb9, 0x34, 0x71, 0xe7, 0x29, 0x32, 0x81, 0x0e, 0x22, 0x77, 0x4e, 0x7e, 0xf4,
+	0x35, 0x29, 0x37, 0x30, 0x96, 0x5c, 0x53, 0xb7, 0xcf, 0x94, 0x10, 0x2b, 0x52, 0x4e, 0x90, 0xdb,
+	0xe8, 0x0c, 0x00, 0x17, 0x98, 0x89, 0x4e, 0x17, 0x0b, 0xa2, 0x18, 0xb9, 0x81, 0xab, 0x90, 0x35,
+	0x2c, 0x88, 0xff, 0xc8, 0x81, 0xea, 0x06, 0x23, 0x49, 0x57, 0x56, 0xdd, 0x9c, 0x16, 0x41, 0x63,
+	0x62, 0x32, 0x80, 0x86, 0x36, 0x68, 0x4c, 0x0a, 0x9d, 0x56, 0x1a, 0xe9, 0xb4, 0x93, 0x30, 0x23,
+	0x7b, 0x9b, 0x74, 0x6d, 0xdf, 0x6a, 0xab, 0xd8, 0x21, 0x95, 0x71, 0x1d, 0x58, 0x1d, 0xe9, 0xc0,
+	0x8f, 0x60, 0x46, 0xad, 0x85, 0xa3, 0xdb, 0x30, 0x23, 0xd4, 0x97, 0xe7, 0x2c, 0x96, 0xa7, 0x2a,
+	0x9c, 0x0a, 0x14, 0x98, 0x28, 0xfe, 0x2f, 0x25, 0xa8, 0xdd, 0xd1, 0xdb, 0x24, 0x75, 0xc7, 0xec,
+	0x58, 0x27, 0xa2, 0x5c, 0x98, 0x0c, 0x93, 0xeb, 0x8e, 0x89, 0x27, 0xaf, 0x83, 0xa0, 0x91, 0x0d,
+	0x0d, 0xb4, 0x05, 0xf3, 0x36, 0xc1, 0xa8, 0xb6, 0xbd, 0x37, 0x6d, 0x0e, 0xab, 0x3c, 0xcd, 0x6c,
+	0xc4, 0x46, 0x0f, 0x60, 0xce, 0xa8, 0x6a, 0x47, 0xb7, 0x59, 0x59, 0x71, 0x79, 0x77, 0x5a, 0xb1,
+	0xd6, 0xdd, 0x36, 0x1b, 0x16, 0x2c, 0xff, 0x0f, 0x07, 0x1a, 0x05, 0xaa, 0xf2, 0xfa, 0x49, 0x70,
+	0xde, 0x20, 0xea, 0xdb, 0xdc, 0xd4, 0xa5, 0xfc, 0xa6, 0x2e, 0xca, 0x5f, 0x79, 0xac, 0xfc, 0x55,
+	0xc6, 0xc8, 0x5f, 0x75, 0xbc, 0xfc, 0xcd, 0x8c, 0x97, 0xbf, 0xda, 0x38, 0xf9, 0xab, 0x8f, 0x34,
+	0x9f, 0xff, 0x6d, 0x19, 0x9a, 0xa3, 0x65, 0xdd, 0x97, 0xd2, 0x09, 0xa8, 0x0a, 0x2a, 0x22, 0x62,
+	0x58, 0x69, 0x43, 0x86, 0xdd, 0x21, 0x8c, 0xcb, 0x83, 0xa6, 0x8f, 0x92, 0x35, 0xe5, 0x48, 0x44,
+	0x43, 0x92, 0x70, 0xa2, 0x78, 0xb9, 0x81, 0x35, 0xd1, 0x59, 0x80, 0x18, 0xd3, 0x44, 0x60, 0x9a,
+	0x10, 0xa6, 0xc8, 0xb9, 0x41, 0x01, 0xd1, 0x3a, 0x9b, 0x0d, 0x18, 0xed, 0x6d, 0x09, 0x45, 0xd0,
+	0x0d, 0x86, 0x00, 0x7a, 0x05, 0xe6, 0x73, 0xa3, 0x43, 0x62, 0x4c, 0x23, 0xc5, 0xd4, 0x0d, 0x9a,
+	0x39, 0x7c, 0x43, 0xa2, 0x8a, 0xb1, 0xe9, 0xb6, 0xba, 0x5e, 0x80, 0x31, 0xd1, 0x06, 0xd4, 0x79,
+	0x3f, 0x93, 0x7b, 0xce, 0x3d, 0x57, 0x35, 0xc8, 0xe5, 0xc9, 0x75, 0x48, 0x07, 0x08, 0xf2, 0x48,
+	0xe8, 0xbe, 0x95, 0x36, 0x50, 0xbd, 0xbd, 0xf6, 0x1f, 0x7b, 0xbb, 0x28, 0x74, 0xfe, 0xf7, 0x0e,
+	0x1c, 0xdf, 0x67, 0xb8, 0x20, 0x35, 0xce, 0x88, 0xd4, 0x8c, 0x93, 0xa6, 0x42, 0x17, 0x94, 0x47,
+	0x25, 0xe8, 0x1c, 0xcc, 0xea, 0xb9, 0x1d, 0x7d, 0x4b, 0xe8, 0x5e, 0x6c, 0x68, 0x4c, 0xde, 0x82,
+	0x5c, 0x0a, 0xa2, 0x48, 0x05, 0x8e, 0x8c, 0x87, 0xb9, 0x86, 0x14, 0xa4, 0x1c, 0xfc, 0x6f, 0x1c,
+	0x98, 0x2d, 0x1e, 0x1c, 0x99, 0xce, 0x1c, 0x1d, 0xd3, 0x4a, 0xd6, 0x1c, 0xd3, 0x4d, 0xc3, 0x65,
+	0x97, 0xc7, 0x28, 0x6a, 0x65, 0x9c, 0xa2, 0x56, 0xf7, 0x28, 0x2a, 0x8d, 0x33, 0x1c, 0xea, 0x06,
+	0x2a, 0x05, 0xc6, 0xf2, 0xff, 0x71, 0xe4, 0xe3, 0x4e, 0xed, 0x98, 0x9c, 0x9d, 0xf2, 0x4e, 0xa1,
+	0xd1, 0x6b, 0x29, 0x5f, 0x96, 0xa6, 0xec, 0xc0, 0x94, 0x77, 0x36, 0x71, 0x4c, 0xa3, 0x81, 0x59,
+	0xa1, 0x9b, 0xf2, 0x65, 0x0d, 0xc8, 0x79, 0x8c, 0x44, 0x04, 0x73, 0x7b, 0x7d, 0x58, 0x13, 0xbd,
+	0x04, 0x4d, 0x9a, 0xf0, 0x8c, 0x84, 0x1d, 0x7b, 0x28, 0x74, 0xeb, 0xcf, 0x69, 0xf4, 0x9e, 0x39,
+	0x1a, 0x17, 0x60, 0xce, 0xde, 0xb1, 0x3a, 0xbd, 0x3e, 0x03, 0x39, 0xa8, 0x17, 0xb1, 0x04, 0xf3,
+	0xb9, 0x97, 0x59, 0x8a, 0x3e, 0x0c, 0x39, 0x6c, 0x17, 0x24, 0xaf, 0x60, 0x03, 0x99, 0xb3, 0x90,
+	0xdb, 0xfe, 0x17, 0x65, 0xa8, 0xdf, 0xb4, 0x52, 0xf3, 0x71, 0xe1, 0xae, 0xd6, 0x2a, 0x3f, 0xb9,
+	0x02, 0x9b, 0x60, 0x56, 0x81, 0xf3, 0x80, 0xe8, 0x93, 0xd1, 0xa7, 0xc4, 0x91, 0x44, 0x1f, 0x46,
+	0x94, 0x6b, 0xb7, 0x0f, 0x66, 0xaf, 0x7c, 0x34, 0xd1, 0xf3, 0x80, 0x28, 0x7c, 0xea, 0xa1, 0x53,
+	0x39, 0x9a, 0x04, 0x23, 0x41, 0xfd, 0x87, 0x0e, 0x34, 0x47, 0x1d, 0xf6, 0x15, 0xda, 0xe2, 0x5d,
+	0x51, 0x7a, 0xea, 0xae, 0xd0, 0xf7, 0x4a, 0x39, 0xbf, 0x57, 0x3c, 0xa8, 0x99, 0xfa, 0x5b, 0x91,
+	0x35, 0xe6, 0xb8, 0x27, 0xc5, 0xca, 0x63, 0x17, 0x66, 0xd5, 0xe1, 0x5c, 0x27, 0x6c, 0x87, 0x86,
+	0x04, 0xfd, 0x50, 0x82, 0x46, 0x40, 0x70, 0xd7, 0x2e, 0xe9, 0xd2, 0x74, 0xff, 0xaa, 0x2d, 0x4c,
+	0x23, 0xa1, 0xfa, 0xff, 0x85, 0xef, 0x9c, 0x87, 0x4f, 0xbc, 0x8b, 0xe0, 0x0f, 0xbd, 0x57, 0x73,
+	0xef, 0x55, 0xe5, 0xbd, 0x6a, 0x95, 0xbb, 0xc2, 0x08, 0xee, 0x3e, 0x7a, 0xe2, 0x5d, 0x3b, 0x94,
+	0xf7, 0xe9, 0x3d, 0x3e, 0x26, 0xe5, 0x6a, 0x8f, 0x88, 0x87, 0x7f, 0xfe, 0xf5, 0x65, 0x69, 0xc9,
+	0x3f, 0x7f, 0xe0, 0x8f, 0x13, 0xc6, 0xdd, 0xb9, 0x88, 0xbe, 0x2a, 0x81, 0x2b, 0xcb, 0xa4, 0xdf,
+	0x86, 0xd3, 0x16, 0xe9, 0xcd, 0xe9, 0x9e, 0x6d, 0xdc, 0xff, 0x5a, 0xd6, 0x68, 0x09, 0x16, 0x0f,
+	0x60, 0xad, 0x5e, 0x76, 0x79, 0x85, 0xae, 0x1c, 0xc2, 0x77, 0x61, 0x8f, 0x87, 0xca, 0x96, 0x57,
+	0xe7, 0x65, 0xff, 0xdc, 0x41, 0xd5, 0x51, 0x41, 0x64, 0x6d, 0x7e, 0x2a, 0xc1, 0xac, 0xac, 0xcd,
+	0x1d, 0x2b, 0x05, 0xff, 0x5f, 0x0f, 0x99, 0x94, 0xfe, 0x8f, 0xb2, 0x3e, 0xaf, 0xc1, 0xf9, 0x03,
+	0x38, 0xe7, 0x42, 0x65, 0x4b, 0xb4, 0x76, 0x38, 0xf7, 0x33, 0x7b, 0x9c, 0x2c, 0xcf, 0xbc, 0x50,
+	0xaf, 0xfa, 0x17, 0x0e, 0x2a, 0x94, 0x0d, 0x25, 0x6b, 0xf5, 0xd8, 0xd4, 0x2a, 0xd7, 0xe4, 0x69,
+	0x6b, 0xf5, 0xd6, 0xb4, 0xe2, 0xc4, 0x0f, 0x53, 0xac, 0x5c, 0x71, 0x0e, 0x57, 0xac, 0xdc, 0x7d,
+	0x6f, 0xb1, 0x6c, 0xd6, 0xc3, 0x16, 0xcb, 0x86, 0x5a, 0x75, 0x2e, 0x5e, 0xbb, 0x7b, 0x7f, 0xbd,
+	0x47, 0xc5, 0x56, 0xff, 0x81, 0x64, 0xa6, 0x7e, 0x1d, 0xcc, 0x7f, 0x86, 0x6b, 0x4f, 0xff, 0x9b,
+	0xe3, 0x83, 0x19, 0xf5, 0xdb, 0xdc, 0x1b, 0xff, 0x0e, 0x00, 0xdb, 0x4f, 0xd8, 0xbd, 0xb8, 0x14,
+	0x00, 0x00,
 }
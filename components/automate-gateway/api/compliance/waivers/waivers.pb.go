@@ -0,0 +1,520 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: components/automate-gateway/api/compliance/waivers/waivers.proto
+
+package waivers // import "github.com/chef/automate/components/automate-gateway/api/compliance/waivers"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/chef/automate/components/automate-grpc/protoc-gen-policy/api"
+import _ "github.com/chef/automate/components/automate-grpc/protoc-gen-policy/iam"
+import common "github.com/chef/automate/components/compliance-service/api/common"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Id struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Id) Reset()         { *m = Id{} }
+func (m *Id) String() string { return proto.CompactTextString(m) }
+func (*Id) ProtoMessage()    {}
+func (*Id) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_bb2292453ce57939, []int{0}
+}
+func (m *Id) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Id.Unmarshal(m, b)
+}
+func (m *Id) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Id.Marshal(b, m, deterministic)
+}
+func (dst *Id) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Id.Merge(dst, src)
+}
+func (m *Id) XXX_Size() int {
+	return xxx_messageInfo_Id.Size(m)
+}
+func (m *Id) XXX_DiscardUnknown() {
+	xxx_messageInfo_Id.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Id proto.InternalMessageInfo
+
+func (m *Id) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Query struct {
+	// only list the waivers of this profile
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	// also list the waivers that have expired
+	IncludeExpired       bool     `protobuf:"varint,2,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Query) Reset()         { *m = Query{} }
+func (m *Query) String() string { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()    {}
+func (*Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_bb2292453ce57939, []int{1}
+}
+func (m *Query) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Query.Unmarshal(m, b)
+}
+func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+}
+func (dst *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(dst, src)
+}
+func (m *Query) XXX_Size() int {
+	return xxx_messageInfo_Query.Size(m)
+}
+func (m *Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_Query.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Query proto.InternalMessageInfo
+
+func (m *Query) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *Query) GetIncludeExpired() bool {
+	if m != nil {
+		return m.IncludeExpired
+	}
+	return false
+}
+
+// A Waiver accepts the risk of a control failing on the nodes that its node
+// filters select. Failures of the control are reported as waived instead of
+// failed until the waiver expires.
+type Waiver struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the sha256 of the profile
+	ProfileId string `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	ControlId string `protobuf:"bytes,3,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	// filters by environment, node_id, node_name, platform, role or recipe;
+	// a waiver without filters applies to every node
+	NodeFilters   []*common.Filter `protobuf:"bytes,4,rep,name=node_filters,json=nodeFilters,proto3" json:"node_filters,omitempty"`
+	Justification string           `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	// a waiver without an expiry applies until it is deleted
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Waiver) Reset()         { *m = Waiver{} }
+func (m *Waiver) String() string { return proto.CompactTextString(m) }
+func (*Waiver) ProtoMessage()    {}
+func (*Waiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_bb2292453ce57939, []int{2}
+}
+func (m *Waiver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Waiver.Unmarshal(m, b)
+}
+func (m *Waiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Waiver.Marshal(b, m, deterministic)
+}
+func (dst *Waiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Waiver.Merge(dst, src)
+}
+func (m *Waiver) XXX_Size() int {
+	return xxx_messageInfo_Waiver.Size(m)
+}
+func (m *Waiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_Waiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Waiver proto.InternalMessageInfo
+
+func (m *Waiver) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Waiver) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *Waiver) GetControlId() string {
+	if m != nil {
+		return m.ControlId
+	}
+	return ""
+}
+
+func (m *Waiver) GetNodeFilters() []*common.Filter {
+	if m != nil {
+		return m.NodeFilters
+	}
+	return nil
+}
+
+func (m *Waiver) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+func (m *Waiver) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Waiver) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Waiver) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type Waivers struct {
+	Waivers              []*Waiver `protobuf:"bytes,1,rep,name=waivers,proto3" json:"waivers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Waivers) Reset()         { *m = Waivers{} }
+func (m *Waivers) String() string { return proto.CompactTextString(m) }
+func (*Waivers) ProtoMessage()    {}
+func (*Waivers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_bb2292453ce57939, []int{3}
+}
+func (m *Waivers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Waivers.Unmarshal(m, b)
+}
+func (m *Waivers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Waivers.Marshal(b, m, deterministic)
+}
+func (dst *Waivers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Waivers.Merge(dst, src)
+}
+func (m *Waivers) XXX_Size() int {
+	return xxx_messageInfo_Waivers.Size(m)
+}
+func (m *Waivers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Waivers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Waivers proto.InternalMessageInfo
+
+func (m *Waivers) GetWaivers() []*Waiver {
+	if m != nil {
+		return m.Waivers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Id)(nil), "chef.automate.api.compliance.waivers.v1.Id")
+	proto.RegisterType((*Query)(nil), "chef.automate.api.compliance.waivers.v1.Query")
+	proto.RegisterType((*Waiver)(nil), "chef.automate.api.compliance.waivers.v1.Waiver")
+	proto.RegisterType((*Waivers)(nil), "chef.automate.api.compliance.waivers.v1.Waivers")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WaiversServiceClient is the client API for WaiversService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WaiversServiceClient interface {
+	Create(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Id, error)
+	Read(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Waiver, error)
+	Update(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*empty.Empty, error)
+	Delete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error)
+	List(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Waivers, error)
+}
+
+type waiversServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWaiversServiceClient(cc *grpc.ClientConn) WaiversServiceClient {
+	return &waiversServiceClient{cc}
+}
+
+func (c *waiversServiceClient) Create(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Id, error) {
+	out := new(Id)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.compliance.waivers.v1.WaiversService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) Read(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Waiver, error) {
+	out := new(Waiver)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.compliance.waivers.v1.WaiversService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) Update(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.compliance.waivers.v1.WaiversService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) Delete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.compliance.waivers.v1.WaiversService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) List(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Waivers, error) {
+	out := new(Waivers)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.compliance.waivers.v1.WaiversService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WaiversServiceServer is the server API for WaiversService service.
+type WaiversServiceServer interface {
+	Create(context.Context, *Waiver) (*Id, error)
+	Read(context.Context, *Id) (*Waiver, error)
+	Update(context.Context, *Waiver) (*empty.Empty, error)
+	Delete(context.Context, *Id) (*empty.Empty, error)
+	List(context.Context, *Query) (*Waivers, error)
+}
+
+func RegisterWaiversServiceServer(s *grpc.Server, srv WaiversServiceServer) {
+	s.RegisterService(&_WaiversService_serviceDesc, srv)
+}
+
+func _WaiversService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Waiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.compliance.waivers.v1.WaiversService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Create(ctx, req.(*Waiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.compliance.waivers.v1.WaiversService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Read(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Waiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.compliance.waivers.v1.WaiversService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Update(ctx, req.(*Waiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.compliance.waivers.v1.WaiversService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Delete(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.compliance.waivers.v1.WaiversService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).List(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WaiversService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chef.automate.api.compliance.waivers.v1.WaiversService",
+	HandlerType: (*WaiversServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _WaiversService_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _WaiversService_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _WaiversService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _WaiversService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _WaiversService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "components/automate-gateway/api/compliance/waivers/waivers.proto",
+}
+
+func init() {
+	proto.RegisterFile("components/automate-gateway/api/compliance/waivers/waivers.proto", fileDescriptor_waivers_bb2292453ce57939)
+}
+
+var fileDescriptor_waivers_bb2292453ce57939 = []byte{
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0xd3, 0xd4, 0x6d, 0xb7, 0x50, 0xa4, 0x05, 0x15, 0xe3, 0xb6, 0x6a, 0xb0, 0x90, 0x1a,
+	0x15, 0xc5, 0xa6, 0x01, 0x0e, 0xe4, 0x44, 0x81, 0x22, 0x45, 0x80, 0x10, 0xa1, 0x15, 0x12, 0x97,
+	0x68, 0xeb, 0x9d, 0xa4, 0x8b, 0x6c, 0xaf, 0xb1, 0x37, 0x29, 0x51, 0x85, 0x84, 0x02, 0xa7, 0x5c,
+	0x79, 0x16, 0xbf, 0x07, 0x12, 0x07, 0xc4, 0xad, 0x48, 0x3c, 0x08, 0xb2, 0xd7, 0xee, 0x4f, 0xea,
+	0xb4, 0x35, 0xa7, 0x8d, 0x66, 0xe6, 0x9b, 0x99, 0x6f, 0xe6, 0xdb, 0x8d, 0xd1, 0x63, 0x9b, 0xbb,
+	0x3e, 0xf7, 0xc0, 0x13, 0xa1, 0x45, 0x7a, 0x82, 0xbb, 0x44, 0x40, 0xad, 0x4b, 0x04, 0xec, 0x93,
+	0x81, 0x45, 0x7c, 0x66, 0xc5, 0x7e, 0x87, 0x11, 0xcf, 0x06, 0x6b, 0x9f, 0xb0, 0x3e, 0x04, 0x61,
+	0x76, 0x9a, 0x7e, 0xc0, 0x05, 0xc7, 0x6b, 0xf6, 0x1e, 0x74, 0xcc, 0x0c, 0x6b, 0x12, 0x9f, 0x99,
+	0xc7, 0x18, 0x33, 0x8b, 0xed, 0x6f, 0xe8, 0xab, 0x5d, 0xce, 0xbb, 0x0e, 0x58, 0x09, 0x6c, 0xb7,
+	0xd7, 0xb1, 0x04, 0x73, 0x21, 0x14, 0xc4, 0xf5, 0x65, 0x26, 0x7d, 0x39, 0x0d, 0x88, 0xcb, 0x12,
+	0xcf, 0xe3, 0x82, 0x08, 0xc6, 0xbd, 0xb4, 0x8e, 0xbe, 0x34, 0x0e, 0x07, 0xd7, 0x17, 0x83, 0xd4,
+	0x99, 0x4f, 0x23, 0xf0, 0x6d, 0x19, 0x6e, 0xd7, 0xba, 0xe0, 0xd5, 0x7c, 0xee, 0x30, 0x7b, 0x30,
+	0x21, 0x7d, 0x91, 0x0c, 0x8c, 0xb8, 0x39, 0x19, 0x1e, 0x9e, 0xc8, 0x70, 0x3c, 0x81, 0x5a, 0x08,
+	0x41, 0x9f, 0xd9, 0x90, 0x0d, 0xd3, 0xe5, 0x5e, 0x7a, 0x48, 0x98, 0x71, 0x03, 0x95, 0x9a, 0x14,
+	0x2f, 0xa0, 0x12, 0xa3, 0x9a, 0x52, 0x51, 0xaa, 0x73, 0xad, 0x12, 0xa3, 0xc6, 0x6b, 0x34, 0xfd,
+	0xa6, 0x07, 0xc1, 0x00, 0xaf, 0x20, 0xe4, 0x07, 0xbc, 0xc3, 0x1c, 0x68, 0x1f, 0x05, 0xcc, 0xa5,
+	0x96, 0x26, 0xc5, 0x6b, 0xe8, 0x1a, 0xf3, 0x6c, 0xa7, 0x47, 0xa1, 0x0d, 0x9f, 0x7c, 0x16, 0x00,
+	0xd5, 0x4a, 0x15, 0xa5, 0x3a, 0xdb, 0x5a, 0x48, 0xcd, 0x5b, 0xd2, 0x6a, 0x7c, 0x9b, 0x42, 0xea,
+	0xbb, 0x64, 0x19, 0xe3, 0xb5, 0xc6, 0x4a, 0x94, 0xc6, 0x4b, 0xac, 0x20, 0x64, 0x73, 0x4f, 0x04,
+	0xdc, 0x89, 0xdd, 0x53, 0xd2, 0x9d, 0x5a, 0x9a, 0x14, 0xef, 0xa0, 0x2b, 0x1e, 0xa7, 0xd0, 0xee,
+	0x30, 0x47, 0x40, 0x10, 0x6a, 0xe5, 0xca, 0x54, 0x75, 0xbe, 0x5e, 0x37, 0x4f, 0xcb, 0x82, 0x72,
+	0x97, 0x30, 0xef, 0xa4, 0x32, 0x52, 0xa1, 0xc4, 0x83, 0x78, 0x9e, 0x40, 0x5b, 0xf3, 0x71, 0x1e,
+	0xf9, 0x3b, 0xc4, 0x77, 0xd0, 0xd5, 0x0f, 0xbd, 0x50, 0xb0, 0x0e, 0xb3, 0x93, 0x29, 0x6b, 0xd3,
+	0x49, 0xe1, 0xd3, 0x46, 0xfc, 0x08, 0x21, 0x49, 0x3b, 0x6c, 0x13, 0xa1, 0xa9, 0x15, 0xa5, 0x3a,
+	0x5f, 0xd7, 0x4d, 0xa9, 0x14, 0x33, 0x53, 0x8a, 0xb9, 0x9d, 0x09, 0xad, 0x35, 0x97, 0x46, 0x6f,
+	0x8a, 0x18, 0x6a, 0x07, 0x40, 0x04, 0xd0, 0x18, 0x3a, 0x73, 0x31, 0x34, 0x8d, 0x96, 0xd0, 0x9e,
+	0x4f, 0x33, 0xe8, 0xec, 0xc5, 0xd0, 0x34, 0x7a, 0x53, 0x18, 0xdb, 0x68, 0x46, 0x6e, 0x21, 0xc4,
+	0x4d, 0x34, 0x93, 0xde, 0x0e, 0x4d, 0x49, 0x66, 0x66, 0x99, 0x97, 0xbc, 0x4a, 0xa6, 0x4c, 0xd1,
+	0xca, 0xf0, 0xf5, 0xc3, 0x59, 0xb4, 0x90, 0xa6, 0x7d, 0x2b, 0xe5, 0x86, 0x7f, 0x2b, 0x48, 0x7d,
+	0x9a, 0x74, 0x8c, 0x8b, 0xe6, 0xd5, 0xef, 0x5e, 0x1a, 0xd0, 0xa4, 0xc6, 0xc7, 0x61, 0xa4, 0x2d,
+	0x23, 0x7c, 0xec, 0x6f, 0xa4, 0x7e, 0xac, 0xca, 0x89, 0x8d, 0x22, 0xcd, 0xca, 0xf5, 0xdf, 0x3a,
+	0x6b, 0x6b, 0x48, 0xc8, 0xf0, 0xe7, 0xdf, 0xef, 0x25, 0xcd, 0xb8, 0x9e, 0xf3, 0xfa, 0x34, 0x94,
+	0x75, 0xfc, 0x47, 0x41, 0xe5, 0x16, 0x10, 0x8a, 0x8b, 0x34, 0xaa, 0x17, 0x1d, 0x83, 0x71, 0x30,
+	0x8c, 0xb4, 0x55, 0x74, 0x33, 0xa7, 0xcb, 0x03, 0x46, 0x3f, 0xe3, 0x72, 0x00, 0x84, 0x8e, 0x22,
+	0x6d, 0x63, 0x72, 0xc8, 0x62, 0x8e, 0xa3, 0x0b, 0x22, 0xa1, 0xb7, 0x82, 0x97, 0xf2, 0x1e, 0x57,
+	0x46, 0xad, 0x04, 0xfa, 0x4b, 0x41, 0xea, 0x8e, 0x4f, 0xff, 0x6b, 0x7f, 0x8b, 0x67, 0xb4, 0xb8,
+	0x15, 0xbf, 0x95, 0xc6, 0x57, 0x65, 0x18, 0x69, 0xb7, 0x27, 0xb7, 0xab, 0x4a, 0x9d, 0x8e, 0x22,
+	0xed, 0xc1, 0xe4, 0xa0, 0xbc, 0xad, 0x49, 0x5c, 0x42, 0xab, 0xa2, 0x9f, 0x47, 0x2b, 0xde, 0xde,
+	0x0f, 0x05, 0xa9, 0xcf, 0xc0, 0x01, 0x01, 0xc5, 0xf6, 0x37, 0x89, 0xd5, 0x97, 0x8b, 0x58, 0xd1,
+	0xa4, 0x58, 0x71, 0x56, 0x12, 0x27, 0x97, 0xb5, 0x7e, 0xee, 0xb2, 0x0e, 0x15, 0x54, 0x7e, 0xc9,
+	0x42, 0x81, 0xcd, 0x4b, 0x13, 0x4a, 0x5e, 0x77, 0xfd, 0x5e, 0xc1, 0xd5, 0x86, 0x46, 0x7f, 0xf2,
+	0x75, 0x0b, 0x81, 0x04, 0xf6, 0xde, 0x28, 0xd2, 0x6a, 0xb9, 0xfe, 0x3c, 0xee, 0x0e, 0x0b, 0xa5,
+	0x1a, 0x57, 0x0d, 0x3d, 0x8f, 0xa0, 0x4c, 0xd9, 0x50, 0xd6, 0x9f, 0xbc, 0x7a, 0xff, 0xa2, 0xcb,
+	0xc4, 0x5e, 0x6f, 0x37, 0xee, 0xcf, 0x8a, 0xbb, 0x3e, 0xfa, 0x9b, 0xb4, 0x8a, 0x7f, 0x43, 0xec,
+	0xaa, 0xc9, 0x12, 0xef, 0xff, 0x1b, 0x00, 0xcc, 0x53, 0xd8, 0x75, 0x80, 0x08, 0x00, 0x00,
+}
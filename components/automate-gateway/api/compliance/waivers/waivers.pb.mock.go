@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-grpc-mock. DO NOT EDIT.
+// source: components/automate-gateway/api/compliance/waivers/waivers.proto
+
+package waivers
+
+import (
+	"context"
+
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// verify that the mock satisfies the WaiversServiceServer interface (at compile time)
+var _ WaiversServiceServer = &WaiversServiceServerMock{}
+
+// NewWaiversServiceServerMock gives you a fresh instance of WaiversServiceServerMock.
+func NewWaiversServiceServerMock() *WaiversServiceServerMock {
+	return &WaiversServiceServerMock{validateRequests: true}
+}
+
+// NewWaiversServiceServerMockWithoutValidation gives you a fresh instance of
+// WaiversServiceServerMock which does not attempt to validate requests before passing
+// them to their respective '*Func'.
+func NewWaiversServiceServerMockWithoutValidation() *WaiversServiceServerMock {
+	return &WaiversServiceServerMock{}
+}
+
+// WaiversServiceServerMock is the mock-what-you-want struct that stubs all not-overridden
+// methods with "not implemented" returns
+type WaiversServiceServerMock struct {
+	validateRequests bool
+	CreateFunc       func(context.Context, *Waiver) (*Id, error)
+	ReadFunc         func(context.Context, *Id) (*Waiver, error)
+	UpdateFunc       func(context.Context, *Waiver) (*empty.Empty, error)
+	DeleteFunc       func(context.Context, *Id) (*empty.Empty, error)
+	ListFunc         func(context.Context, *Query) (*Waivers, error)
+}
+
+func (m *WaiversServiceServerMock) Create(ctx context.Context, req *Waiver) (*Id, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.CreateFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'Create' not implemented")
+}
+
+func (m *WaiversServiceServerMock) Read(ctx context.Context, req *Id) (*Waiver, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ReadFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'Read' not implemented")
+}
+
+func (m *WaiversServiceServerMock) Update(ctx context.Context, req *Waiver) (*empty.Empty, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.UpdateFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'Update' not implemented")
+}
+
+func (m *WaiversServiceServerMock) Delete(ctx context.Context, req *Id) (*empty.Empty, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.DeleteFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'Delete' not implemented")
+}
+
+func (m *WaiversServiceServerMock) List(ctx context.Context, req *Query) (*Waivers, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ListFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'List' not implemented")
+}
+
+// Reset resets all overridden functions
+func (m *WaiversServiceServerMock) Reset() {
+	m.CreateFunc = nil
+	m.ReadFunc = nil
+	m.UpdateFunc = nil
+	m.DeleteFunc = nil
+	m.ListFunc = nil
+}
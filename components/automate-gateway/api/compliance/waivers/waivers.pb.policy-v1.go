@@ -0,0 +1,86 @@
+// Code generated by protoc-gen-policy. DO NOT EDIT.
+// source: components/automate-gateway/api/compliance/waivers/waivers.proto
+
+package waivers
+
+import policy "github.com/chef/automate/components/automate-gateway/api/authz/policy"
+
+func init() {
+	policy.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Create", "compliance:waivers", "create", "POST", "/compliance/waivers", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Waiver); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				case "profile_id":
+					return m.ProfileId
+				case "control_id":
+					return m.ControlId
+				case "justification":
+					return m.Justification
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policy.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Read", "compliance:waivers:{id}", "read", "GET", "/compliance/waivers/id/{id}", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Id); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policy.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Update", "compliance:waivers:{id}", "update", "PUT", "/compliance/waivers/id/{id}", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Waiver); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				case "profile_id":
+					return m.ProfileId
+				case "control_id":
+					return m.ControlId
+				case "justification":
+					return m.Justification
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policy.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Delete", "compliance:waivers:{id}", "delete", "DELETE", "/compliance/waivers/id/{id}", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Id); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policy.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/List", "compliance:waivers", "search", "POST", "/compliance/waivers/search", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Query); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "profile_id":
+					return m.ProfileId
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+}
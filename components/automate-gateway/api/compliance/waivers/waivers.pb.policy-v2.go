@@ -0,0 +1,86 @@
+// Code generated by protoc-gen-policy. DO NOT EDIT.
+// source: components/automate-gateway/api/compliance/waivers/waivers.proto
+
+package waivers
+
+import policyv2 "github.com/chef/automate/components/automate-gateway/authz/policy_v2"
+
+func init() {
+	policyv2.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Create", "compliance:waivers", "compliance:waivers:create", "POST", "/compliance/waivers", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Waiver); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				case "profile_id":
+					return m.ProfileId
+				case "control_id":
+					return m.ControlId
+				case "justification":
+					return m.Justification
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policyv2.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Read", "compliance:waivers:{id}", "compliance:waivers:get", "GET", "/compliance/waivers/id/{id}", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Id); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policyv2.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Update", "compliance:waivers:{id}", "compliance:waivers:update", "PUT", "/compliance/waivers/id/{id}", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Waiver); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				case "profile_id":
+					return m.ProfileId
+				case "control_id":
+					return m.ControlId
+				case "justification":
+					return m.Justification
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policyv2.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/Delete", "compliance:waivers:{id}", "compliance:waivers:delete", "DELETE", "/compliance/waivers/id/{id}", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Id); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "id":
+					return m.Id
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policyv2.MapMethodTo("/chef.automate.api.compliance.waivers.v1.WaiversService/List", "compliance:waivers", "compliance:waivers:list", "POST", "/compliance/waivers/search", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*Query); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "profile_id":
+					return m.ProfileId
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+}
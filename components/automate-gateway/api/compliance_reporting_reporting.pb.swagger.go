@@ -122,6 +122,33 @@ func init() {
         ]
       }
     },
+    "/compliance/reporting/reports/diff": {
+      "post": {
+        "summary": "should cover /reports/diff",
+        "operationId": "DiffReports",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v1ReportDiff"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v1DiffQuery"
+            }
+          }
+        ],
+        "tags": [
+          "ReportingService"
+        ]
+      }
+    },
     "/compliance/reporting/reports/id/{id}": {
       "post": {
         "summary": "should cover /reports/:reportid",
@@ -295,6 +322,38 @@ func init() {
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "waiver": {
+          "$ref": "#/definitions/v1ControlWaiver",
+          "title": "set when the control failed and a waiver applies to it"
+        }
+      }
+    },
+    "v1ControlDiff": {
+      "type": "object",
+      "properties": {
+        "profile_name": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "from_status": {
+          "type": "string"
+        },
+        "to_status": {
+          "type": "string"
+        },
+        "from_impact": {
+          "type": "number",
+          "format": "float"
+        },
+        "to_impact": {
+          "type": "number",
+          "format": "float"
         }
       }
     },
@@ -316,6 +375,21 @@ func init() {
         }
       }
     },
+    "v1ControlWaiver": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "justification": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "v1Dependency": {
       "type": "object",
       "properties": {
@@ -360,6 +434,26 @@ func init() {
         }
       }
     },
+    "v1DiffQuery": {
+      "type": "object",
+      "properties": {
+        "from_id": {
+          "type": "string",
+          "title": "the reports to compare, from_id being the earlier one"
+        },
+        "to_id": {
+          "type": "string"
+        },
+        "node_id": {
+          "type": "string",
+          "title": "when no report ids are given, the latest report of the node is compared\nwith its latest report before the given time or, if no time is given,\nwith the report before its latest one"
+        },
+        "before": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
     "v1ExportData": {
       "type": "object",
       "properties": {
@@ -614,6 +708,23 @@ func init() {
         }
       }
     },
+    "v1ProfileDiff": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        },
+        "sha256": {
+          "type": "string"
+        }
+      }
+    },
     "v1ProfileMeta": {
       "type": "object",
       "properties": {
@@ -721,7 +832,8 @@ func init() {
           "format": "date-time"
         },
         "status": {
-          "type": "string"
+          "type": "string",
+          "title": "waived if every failed control is waived"
         },
         "controls": {
           "$ref": "#/definitions/v1ControlSummary"
@@ -755,6 +867,57 @@ func init() {
         }
       }
     },
+    "v1ReportDiff": {
+      "type": "object",
+      "properties": {
+        "from": {
+          "$ref": "#/definitions/v1Report"
+        },
+        "to": {
+          "$ref": "#/definitions/v1Report"
+        },
+        "newly_failed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ControlDiff"
+          },
+          "title": "controls that passed or were skipped and now fail"
+        },
+        "newly_passed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ControlDiff"
+          },
+          "title": "controls that failed and now pass"
+        },
+        "newly_skipped": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ControlDiff"
+          },
+          "title": "controls that passed or failed and are now skipped"
+        },
+        "impact_changed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ControlDiff"
+          },
+          "title": "controls whose impact changed, whatever their status"
+        },
+        "added_profiles": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ProfileDiff"
+          }
+        },
+        "removed_profiles": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ProfileDiff"
+          }
+        }
+      }
+    },
     "v1Reports": {
       "type": "object",
       "properties": {
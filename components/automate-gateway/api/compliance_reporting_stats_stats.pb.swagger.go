@@ -188,6 +188,11 @@ func init() {
         "skipped": {
           "type": "integer",
           "format": "int32"
+        },
+        "waived": {
+          "type": "integer",
+          "format": "int32",
+          "title": "failed controls that a waiver applies to, not included in failures"
         }
       }
     },
@@ -206,6 +211,11 @@ func init() {
         },
         "profile": {
           "type": "string"
+        },
+        "waived": {
+          "type": "integer",
+          "format": "int32",
+          "title": "failed controls that a waiver applies to, not included in failures"
         }
       }
     },
@@ -445,7 +455,8 @@ func init() {
           "$ref": "#/definitions/v1Stats"
         },
         "status": {
-          "type": "string"
+          "type": "string",
+          "title": "waived if every failed control is waived"
         },
         "duration": {
           "type": "number",
@@ -534,6 +545,11 @@ func init() {
         "skipped": {
           "type": "integer",
           "format": "int32"
+        },
+        "waived": {
+          "type": "integer",
+          "format": "int32",
+          "title": "failed controls that a waiver applies to, not included in failed"
         }
       }
     },
@@ -0,0 +1,244 @@
+package api
+
+func init() {
+	Swagger.Add("compliance_waivers_waivers", `{
+  "swagger": "2.0",
+  "info": {
+    "title": "components/automate-gateway/api/compliance/waivers/waivers.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/compliance/waivers": {
+      "post": {
+        "operationId": "Create",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v1Id"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v1Waiver"
+            }
+          }
+        ],
+        "tags": [
+          "WaiversService"
+        ]
+      }
+    },
+    "/compliance/waivers/id/{id}": {
+      "get": {
+        "operationId": "Read",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v1Waiver"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "WaiversService"
+        ]
+      },
+      "delete": {
+        "operationId": "Delete",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "properties": {}
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "WaiversService"
+        ]
+      },
+      "put": {
+        "operationId": "Update",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "properties": {}
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v1Waiver"
+            }
+          }
+        ],
+        "tags": [
+          "WaiversService"
+        ]
+      }
+    },
+    "/compliance/waivers/search": {
+      "post": {
+        "operationId": "List",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v1Waivers"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v1Query"
+            }
+          }
+        ],
+        "tags": [
+          "WaiversService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "commonFilter": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "exclude": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "v1Id": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "v1Query": {
+      "type": "object",
+      "properties": {
+        "profile_id": {
+          "type": "string",
+          "title": "only list the waivers of this profile"
+        },
+        "include_expired": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "also list the waivers that have expired"
+        }
+      }
+    },
+    "v1Waiver": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "profile_id": {
+          "type": "string",
+          "title": "the sha256 of the profile"
+        },
+        "control_id": {
+          "type": "string"
+        },
+        "node_filters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/commonFilter"
+          },
+          "title": "filters by environment, node_id, node_name, platform, role or recipe;\na waiver without filters applies to every node"
+        },
+        "justification": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time",
+          "title": "a waiver without an expiry applies until it is deleted"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "description": "A Waiver accepts the risk of a control failing on the nodes that its node\nfilters select. Failures of the control are reported as waived instead of\nfailed until the waiver expires."
+    },
+    "v1Waivers": {
+      "type": "object",
+      "properties": {
+        "waivers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1Waiver"
+          }
+        }
+      }
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,240 @@
+package api
+
+func init() {
+	Swagger.Add("event_history", `{
+  "swagger": "2.0",
+  "info": {
+    "title": "api/external/event_history/event_history.proto",
+    "version": "version not set"
+  },
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/event_history": {
+      "get": {
+        "operationId": "ListEvents",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/event_historyListEventsResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "event_type",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "producer",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "start",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "end",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "page",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "per_page",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          }
+        ],
+        "tags": [
+          "EventHistory"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "event_historyDelivery": {
+      "type": "object",
+      "properties": {
+        "subscription_id": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "delivered or dead_lettered"
+        },
+        "attempts": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "last_error": {
+          "type": "string"
+        },
+        "completed": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "title": "Delivery is the outcome of handing the event to a subscribed service"
+    },
+    "event_historyEntity": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "object_type": {
+          "type": "string"
+        }
+      }
+    },
+    "event_historyEvent": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "event_type": {
+          "type": "string"
+        },
+        "producer": {
+          "$ref": "#/definitions/event_historyEntity"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "published": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "actor": {
+          "$ref": "#/definitions/event_historyEntity"
+        },
+        "verb": {
+          "type": "string"
+        },
+        "object": {
+          "$ref": "#/definitions/event_historyEntity"
+        },
+        "target": {
+          "$ref": "#/definitions/event_historyEntity"
+        },
+        "data": {
+          "$ref": "#/definitions/protobufStruct"
+        },
+        "received": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/event_historyDelivery"
+          }
+        }
+      }
+    },
+    "event_historyListEventsResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/event_historyEvent"
+          }
+        },
+        "total": {
+          "type": "string",
+          "format": "int64"
+        }
+      }
+    },
+    "protobufListValue": {
+      "type": "object",
+      "properties": {
+        "values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/protobufValue"
+          }
+        }
+      }
+    },
+    "protobufNullValue": {
+      "type": "string",
+      "enum": [
+        "NULL_VALUE"
+      ],
+      "default": "NULL_VALUE"
+    },
+    "protobufStruct": {
+      "type": "object",
+      "properties": {
+        "fields": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/protobufValue"
+          }
+        }
+      }
+    },
+    "protobufValue": {
+      "type": "object",
+      "properties": {
+        "null_value": {
+          "$ref": "#/definitions/protobufNullValue"
+        },
+        "number_value": {
+          "type": "number",
+          "format": "double"
+        },
+        "string_value": {
+          "type": "string"
+        },
+        "bool_value": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "struct_value": {
+          "$ref": "#/definitions/protobufStruct"
+        },
+        "list_value": {
+          "$ref": "#/definitions/protobufListValue"
+        }
+      }
+    }
+  }
+}
+`)
+}
@@ -6,6 +6,7 @@ package common // import "github.com/chef/automate/components/automate-gateway/a
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -38,7 +39,7 @@ func (x Type) String() string {
 	return proto.EnumName(Type_name, int32(x))
 }
 func (Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{0}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{0}
 }
 
 // passed to UpgradeToV2 to set version
@@ -62,7 +63,7 @@ func (x Flag) String() string {
 	return proto.EnumName(Flag_name, int32(x))
 }
 func (Flag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{1}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{1}
 }
 
 type Statement_Effect int32
@@ -85,7 +86,7 @@ func (x Statement_Effect) String() string {
 	return proto.EnumName(Statement_Effect_name, int32(x))
 }
 func (Statement_Effect) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{1, 0}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{1, 0}
 }
 
 type Version_VersionNumber int32
@@ -111,7 +112,37 @@ func (x Version_VersionNumber) String() string {
 	return proto.EnumName(Version_VersionNumber_name, int32(x))
 }
 func (Version_VersionNumber) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{4, 0}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{6, 0}
+}
+
+type StateChange_Operation int32
+
+const (
+	StateChange_CREATE StateChange_Operation = 0
+	StateChange_UPDATE StateChange_Operation = 1
+	StateChange_DELETE StateChange_Operation = 2
+	// chef-managed objects are never changed
+	StateChange_SKIP StateChange_Operation = 3
+)
+
+var StateChange_Operation_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "DELETE",
+	3: "SKIP",
+}
+var StateChange_Operation_value = map[string]int32{
+	"CREATE": 0,
+	"UPDATE": 1,
+	"DELETE": 2,
+	"SKIP":   3,
+}
+
+func (x StateChange_Operation) String() string {
+	return proto.EnumName(StateChange_Operation_name, int32(x))
+}
+func (StateChange_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{10, 0}
 }
 
 type Policy struct {
@@ -130,7 +161,7 @@ func (m *Policy) Reset()         { *m = Policy{} }
 func (m *Policy) String() string { return proto.CompactTextString(m) }
 func (*Policy) ProtoMessage()    {}
 func (*Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{0}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{0}
 }
 func (m *Policy) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Policy.Unmarshal(m, b)
@@ -199,7 +230,12 @@ type Statement struct {
 	// references
 	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	// Note: these are for display only, not to be set in CreatePolicy/UpdatePolicy
-	Resources            []string `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	Resources []string `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	// optional restrictions on when the statement applies
+	Conditions *Conditions `protobuf:"bytes,6,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	// Note: like resources, these are ignored by CreatePolicy/UpdatePolicy;
+	// only ImportState sets them
+	Projects             []string `protobuf:"bytes,7,rep,name=projects,proto3" json:"projects,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -209,7 +245,7 @@ func (m *Statement) Reset()         { *m = Statement{} }
 func (m *Statement) String() string { return proto.CompactTextString(m) }
 func (*Statement) ProtoMessage()    {}
 func (*Statement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{1}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{1}
 }
 func (m *Statement) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Statement.Unmarshal(m, b)
@@ -257,6 +293,126 @@ func (m *Statement) GetResources() []string {
 	return nil
 }
 
+func (m *Statement) GetConditions() *Conditions {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+func (m *Statement) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+// Conditions restrict when a statement applies: it only applies if all of them
+// are met. An empty list means no restriction.
+type Conditions struct {
+	// the request has to be made in one of these
+	TimeWindows []*TimeWindow `protobuf:"bytes,1,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows,omitempty"`
+	// CIDRs, like "10.0.0.0/8"; the request's client address has to be in one of these
+	SourceIps []string `protobuf:"bytes,2,rep,name=source_ips,json=sourceIps,proto3" json:"source_ips,omitempty"`
+	// the request has to be made by a subject of one of these types: "user",
+	// "token", "tls", or "cert"
+	SubjectTypes         []string `protobuf:"bytes,3,rep,name=subject_types,json=subjectTypes,proto3" json:"subject_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Conditions) Reset()         { *m = Conditions{} }
+func (m *Conditions) String() string { return proto.CompactTextString(m) }
+func (*Conditions) ProtoMessage()    {}
+func (*Conditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{2}
+}
+func (m *Conditions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Conditions.Unmarshal(m, b)
+}
+func (m *Conditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Conditions.Marshal(b, m, deterministic)
+}
+func (dst *Conditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Conditions.Merge(dst, src)
+}
+func (m *Conditions) XXX_Size() int {
+	return xxx_messageInfo_Conditions.Size(m)
+}
+func (m *Conditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Conditions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Conditions proto.InternalMessageInfo
+
+func (m *Conditions) GetTimeWindows() []*TimeWindow {
+	if m != nil {
+		return m.TimeWindows
+	}
+	return nil
+}
+
+func (m *Conditions) GetSourceIps() []string {
+	if m != nil {
+		return m.SourceIps
+	}
+	return nil
+}
+
+func (m *Conditions) GetSubjectTypes() []string {
+	if m != nil {
+		return m.SubjectTypes
+	}
+	return nil
+}
+
+type TimeWindow struct {
+	Start                *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{3}
+}
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeWindow.Unmarshal(m, b)
+}
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+}
+func (dst *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(dst, src)
+}
+func (m *TimeWindow) XXX_Size() int {
+	return xxx_messageInfo_TimeWindow.Size(m)
+}
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
+func (m *TimeWindow) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *TimeWindow) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
 type Role struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
@@ -272,7 +428,7 @@ func (m *Role) Reset()         { *m = Role{} }
 func (m *Role) String() string { return proto.CompactTextString(m) }
 func (*Role) ProtoMessage()    {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{2}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{4}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Role.Unmarshal(m, b)
@@ -341,7 +497,7 @@ func (m *Project) Reset()         { *m = Project{} }
 func (m *Project) String() string { return proto.CompactTextString(m) }
 func (*Project) ProtoMessage()    {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{3}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{5}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Project.Unmarshal(m, b)
@@ -402,7 +558,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_e0d003c67bfdba69, []int{4}
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{6}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Version.Unmarshal(m, b)
@@ -436,55 +592,376 @@ func (m *Version) GetMinor() Version_VersionNumber {
 	return Version_V0
 }
 
+// an authorization decision recorded in the audit log
+type Decision struct {
+	Time               *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Method             string               `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Subjects           []string             `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Action             string               `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Resource           string               `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	Projects           []string             `protobuf:"bytes,6,rep,name=projects,proto3" json:"projects,omitempty"`
+	Allowed            bool                 `protobuf:"varint,7,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	AuthorizedProjects []string             `protobuf:"bytes,8,rep,name=authorized_projects,json=authorizedProjects,proto3" json:"authorized_projects,omitempty"`
+	// the policy statements the decision was based on
+	Matches              []*DecisionMatch `protobuf:"bytes,9,rep,name=matches,proto3" json:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Decision) Reset()         { *m = Decision{} }
+func (m *Decision) String() string { return proto.CompactTextString(m) }
+func (*Decision) ProtoMessage()    {}
+func (*Decision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{7}
+}
+func (m *Decision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Decision.Unmarshal(m, b)
+}
+func (m *Decision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Decision.Marshal(b, m, deterministic)
+}
+func (dst *Decision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Decision.Merge(dst, src)
+}
+func (m *Decision) XXX_Size() int {
+	return xxx_messageInfo_Decision.Size(m)
+}
+func (m *Decision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Decision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Decision proto.InternalMessageInfo
+
+func (m *Decision) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Decision) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Decision) GetSubjects() []string {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+func (m *Decision) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *Decision) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *Decision) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *Decision) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *Decision) GetAuthorizedProjects() []string {
+	if m != nil {
+		return m.AuthorizedProjects
+	}
+	return nil
+}
+
+func (m *Decision) GetMatches() []*DecisionMatch {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+type DecisionMatch struct {
+	Effect               Statement_Effect `protobuf:"varint,1,opt,name=effect,proto3,enum=chef.automate.api.iam.v2beta.Statement_Effect" json:"effect,omitempty"`
+	PolicyId             string           `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	StatementId          string           `protobuf:"bytes,3,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DecisionMatch) Reset()         { *m = DecisionMatch{} }
+func (m *DecisionMatch) String() string { return proto.CompactTextString(m) }
+func (*DecisionMatch) ProtoMessage()    {}
+func (*DecisionMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{8}
+}
+func (m *DecisionMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecisionMatch.Unmarshal(m, b)
+}
+func (m *DecisionMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecisionMatch.Marshal(b, m, deterministic)
+}
+func (dst *DecisionMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecisionMatch.Merge(dst, src)
+}
+func (m *DecisionMatch) XXX_Size() int {
+	return xxx_messageInfo_DecisionMatch.Size(m)
+}
+func (m *DecisionMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecisionMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecisionMatch proto.InternalMessageInfo
+
+func (m *DecisionMatch) GetEffect() Statement_Effect {
+	if m != nil {
+		return m.Effect
+	}
+	return Statement_ALLOW
+}
+
+func (m *DecisionMatch) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *DecisionMatch) GetStatementId() string {
+	if m != nil {
+		return m.StatementId
+	}
+	return ""
+}
+
+// a policy statement that matched an explained authorization request
+type ExplainedStatement struct {
+	PolicyId             string     `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	StatementId          string     `protobuf:"bytes,2,opt,name=statement_id,json=statementId,proto3" json:"statement_id,omitempty"`
+	Statement            *Statement `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	Projects             []string   `protobuf:"bytes,4,rep,name=projects,proto3" json:"projects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ExplainedStatement) Reset()         { *m = ExplainedStatement{} }
+func (m *ExplainedStatement) String() string { return proto.CompactTextString(m) }
+func (*ExplainedStatement) ProtoMessage()    {}
+func (*ExplainedStatement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{9}
+}
+func (m *ExplainedStatement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainedStatement.Unmarshal(m, b)
+}
+func (m *ExplainedStatement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainedStatement.Marshal(b, m, deterministic)
+}
+func (dst *ExplainedStatement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainedStatement.Merge(dst, src)
+}
+func (m *ExplainedStatement) XXX_Size() int {
+	return xxx_messageInfo_ExplainedStatement.Size(m)
+}
+func (m *ExplainedStatement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainedStatement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainedStatement proto.InternalMessageInfo
+
+func (m *ExplainedStatement) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *ExplainedStatement) GetStatementId() string {
+	if m != nil {
+		return m.StatementId
+	}
+	return ""
+}
+
+func (m *ExplainedStatement) GetStatement() *Statement {
+	if m != nil {
+		return m.Statement
+	}
+	return nil
+}
+
+func (m *ExplainedStatement) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+// StateChange is a change ImportState makes, or would make on a dry run, to
+// get from the current to the imported IAM state.
+type StateChange struct {
+	Operation StateChange_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=chef.automate.api.iam.v2beta.StateChange_Operation" json:"operation,omitempty"`
+	// "project", "role", or "policy"
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id   string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// why a change is skipped
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateChange) Reset()         { *m = StateChange{} }
+func (m *StateChange) String() string { return proto.CompactTextString(m) }
+func (*StateChange) ProtoMessage()    {}
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_a9b000a9f62ced47, []int{10}
+}
+func (m *StateChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateChange.Unmarshal(m, b)
+}
+func (m *StateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateChange.Marshal(b, m, deterministic)
+}
+func (dst *StateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChange.Merge(dst, src)
+}
+func (m *StateChange) XXX_Size() int {
+	return xxx_messageInfo_StateChange.Size(m)
+}
+func (m *StateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChange proto.InternalMessageInfo
+
+func (m *StateChange) GetOperation() StateChange_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return StateChange_CREATE
+}
+
+func (m *StateChange) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *StateChange) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StateChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Policy)(nil), "chef.automate.api.iam.v2beta.Policy")
 	proto.RegisterType((*Statement)(nil), "chef.automate.api.iam.v2beta.Statement")
+	proto.RegisterType((*Conditions)(nil), "chef.automate.api.iam.v2beta.Conditions")
+	proto.RegisterType((*TimeWindow)(nil), "chef.automate.api.iam.v2beta.TimeWindow")
 	proto.RegisterType((*Role)(nil), "chef.automate.api.iam.v2beta.Role")
 	proto.RegisterType((*Project)(nil), "chef.automate.api.iam.v2beta.Project")
 	proto.RegisterType((*Version)(nil), "chef.automate.api.iam.v2beta.Version")
+	proto.RegisterType((*Decision)(nil), "chef.automate.api.iam.v2beta.Decision")
+	proto.RegisterType((*DecisionMatch)(nil), "chef.automate.api.iam.v2beta.DecisionMatch")
+	proto.RegisterType((*ExplainedStatement)(nil), "chef.automate.api.iam.v2beta.ExplainedStatement")
+	proto.RegisterType((*StateChange)(nil), "chef.automate.api.iam.v2beta.StateChange")
 	proto.RegisterEnum("chef.automate.api.iam.v2beta.Type", Type_name, Type_value)
 	proto.RegisterEnum("chef.automate.api.iam.v2beta.Flag", Flag_name, Flag_value)
 	proto.RegisterEnum("chef.automate.api.iam.v2beta.Statement_Effect", Statement_Effect_name, Statement_Effect_value)
 	proto.RegisterEnum("chef.automate.api.iam.v2beta.Version_VersionNumber", Version_VersionNumber_name, Version_VersionNumber_value)
+	proto.RegisterEnum("chef.automate.api.iam.v2beta.StateChange_Operation", StateChange_Operation_name, StateChange_Operation_value)
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/common/policy.proto", fileDescriptor_policy_e0d003c67bfdba69)
-}
-
-var fileDescriptor_policy_e0d003c67bfdba69 = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x51, 0x6b, 0x1a, 0x41,
-	0x10, 0x76, 0xf5, 0x3c, 0x73, 0x93, 0xd6, 0x1e, 0xfb, 0x74, 0x94, 0x14, 0xe4, 0x28, 0x44, 0x02,
-	0xdd, 0x6b, 0x2e, 0xd0, 0xc7, 0x82, 0x4d, 0x34, 0x4d, 0x31, 0x1a, 0xce, 0xd4, 0xd2, 0xbe, 0xc8,
-	0x7a, 0x59, 0xcd, 0x06, 0xf7, 0xf6, 0xb8, 0x5b, 0x5b, 0x7c, 0xec, 0xbf, 0xe8, 0xaf, 0xe9, 0x73,
-	0xff, 0x47, 0xff, 0x48, 0xd9, 0x3d, 0xb5, 0x9a, 0x07, 0x69, 0x03, 0x79, 0x9a, 0x9d, 0x61, 0xbe,
-	0x6f, 0xe6, 0x9b, 0x59, 0x06, 0xde, 0xc6, 0x52, 0xa4, 0x32, 0x61, 0x89, 0xca, 0x03, 0x3a, 0x57,
-	0x52, 0x50, 0xc5, 0x5e, 0x4d, 0xa9, 0x62, 0xdf, 0xe8, 0x22, 0xa0, 0x29, 0x0f, 0x38, 0x15, 0xc1,
-	0xd7, 0x70, 0xcc, 0x14, 0x0d, 0x62, 0x29, 0x84, 0x4c, 0x82, 0x54, 0xce, 0x78, 0xbc, 0x20, 0x69,
-	0x26, 0x95, 0xc4, 0x07, 0xf1, 0x2d, 0x9b, 0x90, 0x15, 0x92, 0xd0, 0x94, 0x13, 0x4e, 0x05, 0x29,
-	0x10, 0xfe, 0x6f, 0x04, 0xf6, 0x95, 0x49, 0xc7, 0x18, 0xac, 0x84, 0x0a, 0xe6, 0xa1, 0x06, 0x6a,
-	0x3a, 0x91, 0x79, 0xe3, 0x3a, 0x94, 0xf9, 0x8d, 0x57, 0x36, 0x91, 0x32, 0xbf, 0xc1, 0x6f, 0xc0,
-	0x52, 0x8b, 0x94, 0x79, 0x95, 0x06, 0x6a, 0xd6, 0x43, 0x9f, 0xec, 0xe2, 0x26, 0xd7, 0x8b, 0x94,
-	0x45, 0x26, 0x1f, 0x7b, 0x50, 0x13, 0x4c, 0x8c, 0x59, 0x96, 0x7b, 0x56, 0xa3, 0xd2, 0x74, 0xa2,
-	0x95, 0x8b, 0xcf, 0x01, 0x72, 0x45, 0x15, 0x13, 0x5a, 0xa0, 0x57, 0x6d, 0x54, 0x9a, 0xfb, 0xe1,
-	0xe1, 0x6e, 0xde, 0xc1, 0x2a, 0x3f, 0xda, 0x80, 0xe2, 0xe7, 0xb0, 0x97, 0x66, 0xf2, 0x8e, 0xc5,
-	0x2a, 0xf7, 0x6c, 0x53, 0x63, 0xed, 0xfb, 0x3f, 0x11, 0x38, 0x6b, 0x14, 0xee, 0x80, 0xcd, 0x26,
-	0x13, 0x16, 0x2b, 0x23, 0xb5, 0x1e, 0x92, 0x7f, 0x2c, 0x47, 0xda, 0x06, 0x15, 0x2d, 0xd1, 0x5a,
-	0x14, 0x8d, 0x15, 0x97, 0x49, 0xee, 0x55, 0x0a, 0x51, 0x4b, 0x57, 0x8f, 0x32, 0x93, 0x33, 0xe6,
-	0x59, 0xc5, 0x28, 0xf5, 0x1b, 0x1f, 0x80, 0x93, 0xb1, 0x5c, 0xce, 0xb3, 0x98, 0x15, 0x3a, 0x9d,
-	0xe8, 0x6f, 0xc0, 0x7f, 0x01, 0x76, 0xc1, 0x8e, 0x1d, 0xa8, 0xb6, 0xba, 0xdd, 0xfe, 0x27, 0xb7,
-	0x84, 0xf7, 0xc0, 0x3a, 0x6b, 0xf7, 0x3e, 0xbb, 0xc8, 0xff, 0x81, 0xc0, 0x8a, 0x34, 0xcb, 0x23,
-	0x2f, 0x69, 0xa5, 0xc7, 0xda, 0xd6, 0xb3, 0x39, 0xdb, 0xea, 0xbd, 0xd9, 0x7e, 0x47, 0x50, 0xbb,
-	0x2a, 0x9c, 0x47, 0xed, 0x6e, 0xb3, 0x07, 0xeb, 0x5e, 0x0f, 0xbf, 0x10, 0xd4, 0x86, 0x2c, 0xcb,
-	0xb9, 0x4c, 0xf0, 0x05, 0x54, 0x05, 0xbd, 0x93, 0xd9, 0x72, 0xb9, 0x27, 0xbb, 0x0b, 0x2c, 0x51,
-	0x2b, 0xdb, 0x9b, 0xeb, 0x5f, 0x19, 0x15, 0x0c, 0x86, 0x8a, 0x27, 0x32, 0x33, 0xdd, 0x3f, 0x98,
-	0x4a, 0x33, 0xf8, 0x87, 0xf0, 0x74, 0x2b, 0x8e, 0x6d, 0x28, 0x0f, 0x5f, 0xbb, 0x25, 0x63, 0x8f,
-	0x5d, 0x64, 0x6c, 0xe8, 0x96, 0x8f, 0x5e, 0x82, 0xa5, 0x45, 0x63, 0x17, 0x9e, 0x9c, 0xbe, 0x6f,
-	0x77, 0x46, 0x97, 0xad, 0x5e, 0xeb, 0xbc, 0x7d, 0xe6, 0x96, 0x30, 0x80, 0x7d, 0xfa, 0x71, 0x70,
-	0xdd, 0xbf, 0x74, 0xd1, 0x51, 0x13, 0xac, 0xce, 0x8c, 0x4e, 0xf1, 0x33, 0xd8, 0x1f, 0xb6, 0xa3,
-	0xc1, 0x45, 0xbf, 0x37, 0x0a, 0x47, 0x9a, 0x6e, 0x2b, 0x70, 0xec, 0xa2, 0x77, 0xdd, 0x2f, 0x1f,
-	0xa6, 0x5c, 0xdd, 0xce, 0xc7, 0x24, 0x96, 0x22, 0xd0, 0x02, 0xd6, 0x57, 0x24, 0xf8, 0xef, 0xcb,
-	0x32, 0xb6, 0xcd, 0x4d, 0x39, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0xce, 0x96, 0x65, 0x2f, 0x95,
-	0x04, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/common/policy.proto", fileDescriptor_policy_a9b000a9f62ced47)
+}
+
+var fileDescriptor_policy_a9b000a9f62ced47 = []byte{
+	// 957 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xee, 0x38, 0x8e, 0x13, 0x9f, 0xec, 0x2e, 0xd6, 0x20, 0x55, 0xd6, 0xd2, 0x8a, 0x60, 0x90,
+	0x1a, 0x15, 0xb0, 0xdb, 0x54, 0x42, 0xe2, 0x06, 0x69, 0xd9, 0xf5, 0xb6, 0xa1, 0xfb, 0x87, 0x37,
+	0xdd, 0x0a, 0x6e, 0xa2, 0x89, 0x33, 0x9b, 0x4c, 0x89, 0x3d, 0x96, 0x3d, 0x61, 0x59, 0xee, 0x78,
+	0x0a, 0xb8, 0xe1, 0x8e, 0x77, 0xe0, 0x15, 0xb8, 0xe4, 0x8e, 0x07, 0xe0, 0x45, 0xd0, 0xcc, 0xd8,
+	0x4e, 0xb2, 0x48, 0xe9, 0x56, 0xd0, 0x2b, 0xcf, 0x39, 0x73, 0xbe, 0x6f, 0xe6, 0x9c, 0xf9, 0xe6,
+	0x8c, 0xe1, 0x8b, 0x98, 0x27, 0x19, 0x4f, 0x69, 0x2a, 0x8a, 0x80, 0x2c, 0x04, 0x4f, 0x88, 0xa0,
+	0x9f, 0x4e, 0x89, 0xa0, 0x57, 0xe4, 0x3a, 0x20, 0x19, 0x0b, 0x18, 0x49, 0x82, 0xef, 0xfb, 0x63,
+	0x2a, 0x48, 0x10, 0xf3, 0x24, 0xe1, 0x69, 0x90, 0xf1, 0x39, 0x8b, 0xaf, 0xfd, 0x2c, 0xe7, 0x82,
+	0xe3, 0x7b, 0xf1, 0x8c, 0x5e, 0xfa, 0x15, 0xd2, 0x27, 0x19, 0xf3, 0x19, 0x49, 0x7c, 0x8d, 0xd8,
+	0x7d, 0x7f, 0xca, 0xf9, 0x74, 0x4e, 0x03, 0x15, 0x3b, 0x5e, 0x5c, 0x06, 0x82, 0x25, 0xb4, 0x10,
+	0x24, 0xc9, 0x34, 0xdc, 0xfb, 0x1b, 0x81, 0x75, 0xa6, 0xf8, 0x30, 0x06, 0x33, 0x25, 0x09, 0x75,
+	0x51, 0x17, 0xf5, 0xec, 0x48, 0x8d, 0xf1, 0x0e, 0x18, 0x6c, 0xe2, 0x1a, 0xca, 0x63, 0xb0, 0x09,
+	0xfe, 0x0c, 0x4c, 0x71, 0x9d, 0x51, 0xb7, 0xd1, 0x45, 0xbd, 0x9d, 0xbe, 0xe7, 0x6f, 0x5a, 0xdc,
+	0x1f, 0x5e, 0x67, 0x34, 0x52, 0xf1, 0xd8, 0x85, 0x56, 0x42, 0x93, 0x31, 0xcd, 0x0b, 0xd7, 0xec,
+	0x36, 0x7a, 0x76, 0x54, 0x99, 0xf8, 0x29, 0x40, 0x21, 0x88, 0xa0, 0x89, 0xac, 0x80, 0xdb, 0xec,
+	0x36, 0x7a, 0x9d, 0xfe, 0x83, 0xcd, 0xbc, 0xe7, 0x55, 0x7c, 0xb4, 0x02, 0xc5, 0xbb, 0xd0, 0xce,
+	0x72, 0xfe, 0x8a, 0xc6, 0xa2, 0x70, 0x2d, 0xb5, 0x46, 0x6d, 0x7b, 0xbf, 0x19, 0x60, 0xd7, 0x28,
+	0x7c, 0x08, 0x16, 0xbd, 0xbc, 0xa4, 0xb1, 0x50, 0xa9, 0xee, 0xf4, 0xfd, 0x5b, 0x2e, 0xe7, 0x87,
+	0x0a, 0x15, 0x95, 0x68, 0x99, 0x14, 0x89, 0x05, 0xe3, 0x69, 0xe1, 0x36, 0x74, 0x52, 0xa5, 0x29,
+	0x4b, 0x99, 0xf3, 0x39, 0x75, 0x4d, 0x5d, 0x4a, 0x39, 0xc6, 0xf7, 0xc0, 0xce, 0x69, 0xc1, 0x17,
+	0x79, 0x4c, 0x75, 0x9e, 0x76, 0xb4, 0x74, 0xe0, 0x67, 0x00, 0x31, 0x4f, 0x27, 0x4c, 0xd3, 0x59,
+	0x5d, 0xd4, 0xeb, 0xf4, 0x7b, 0x9b, 0xf7, 0xb5, 0x5f, 0xc7, 0x47, 0x2b, 0xd8, 0xb5, 0x3a, 0xb4,
+	0x6e, 0xd4, 0xe1, 0x3e, 0x58, 0x3a, 0x07, 0x6c, 0x43, 0x73, 0xef, 0xe8, 0xe8, 0xf4, 0xa5, 0x73,
+	0x07, 0xb7, 0xc1, 0x3c, 0x08, 0x4f, 0xbe, 0x71, 0x90, 0xf7, 0x2b, 0x02, 0x58, 0xb2, 0xe2, 0xe7,
+	0xb0, 0x25, 0xe5, 0x32, 0xba, 0x62, 0xe9, 0x84, 0x5f, 0x15, 0x2e, 0xea, 0x36, 0x5e, 0xbf, 0xab,
+	0x21, 0x4b, 0xe8, 0x4b, 0x05, 0x88, 0x3a, 0xa2, 0x1e, 0x17, 0xf8, 0x3e, 0x80, 0xce, 0x75, 0xc4,
+	0xb2, 0xc2, 0x35, 0x74, 0xfe, 0xda, 0x33, 0xc8, 0x0a, 0xfc, 0x21, 0x6c, 0x17, 0x8b, 0xb1, 0xdc,
+	0xe5, 0x48, 0x0a, 0xa6, 0xaa, 0xe8, 0x56, 0xe9, 0x94, 0x52, 0x2a, 0xbc, 0x39, 0xc0, 0x92, 0x1e,
+	0x3f, 0x82, 0x66, 0x21, 0x48, 0xae, 0x4f, 0xb1, 0xd3, 0xdf, 0xf5, 0xb5, 0xd6, 0xfd, 0x4a, 0xeb,
+	0xfe, 0xb0, 0xd2, 0x7a, 0xa4, 0x03, 0xf1, 0x27, 0xd0, 0xa0, 0xa9, 0x96, 0xf3, 0xe6, 0x78, 0x19,
+	0xe6, 0xfd, 0x82, 0xc0, 0x8c, 0xe4, 0xc9, 0xbd, 0xe5, 0x8b, 0x51, 0x69, 0xc8, 0x5c, 0xd7, 0xd0,
+	0xea, 0x39, 0x36, 0x6f, 0x9c, 0xe3, 0x4f, 0x08, 0x5a, 0x67, 0xda, 0x78, 0xab, 0xbb, 0x5b, 0xdd,
+	0x83, 0x79, 0x63, 0x0f, 0x7f, 0x20, 0x68, 0x5d, 0xd0, 0xbc, 0x60, 0x3c, 0xc5, 0x03, 0x68, 0x26,
+	0xe4, 0x15, 0xcf, 0xcb, 0x0b, 0xf5, 0x64, 0xf3, 0x02, 0x25, 0xaa, 0xfa, 0x9e, 0x2c, 0x64, 0x27,
+	0x88, 0x34, 0x83, 0xa2, 0x62, 0x29, 0xcf, 0x5d, 0xe3, 0xbf, 0x50, 0x49, 0x06, 0xef, 0x01, 0x6c,
+	0xaf, 0xf9, 0xb1, 0x05, 0xc6, 0xc5, 0x23, 0xe7, 0x8e, 0xfa, 0x3e, 0x76, 0x90, 0xfa, 0xf6, 0x1d,
+	0xc3, 0xfb, 0xd3, 0x80, 0xf6, 0x01, 0x8d, 0x99, 0xca, 0xc5, 0x07, 0x53, 0xea, 0xf6, 0x16, 0xaa,
+	0x52, 0x71, 0xf8, 0x2e, 0x58, 0x09, 0x15, 0x33, 0x5e, 0xd5, 0xbb, 0xb4, 0x64, 0xed, 0x4a, 0xf1,
+	0x56, 0x62, 0xae, 0x6d, 0x89, 0xd1, 0xc7, 0x5c, 0x76, 0x88, 0xd2, 0x92, 0x98, 0xaa, 0x25, 0xb8,
+	0x4d, 0x35, 0x53, 0xdb, 0x9b, 0xfa, 0x9b, 0x52, 0xd1, 0x7c, 0xce, 0xaf, 0xe8, 0xc4, 0x6d, 0x75,
+	0x51, 0xaf, 0x1d, 0x55, 0x26, 0x0e, 0xe0, 0x5d, 0xb2, 0x10, 0x33, 0x9e, 0xb3, 0x1f, 0xe9, 0x64,
+	0x54, 0x13, 0xb4, 0x15, 0x01, 0x5e, 0x4e, 0x9d, 0x55, 0x54, 0x21, 0xb4, 0x12, 0x22, 0xe2, 0x19,
+	0x2d, 0x5c, 0x5b, 0xdd, 0xf7, 0x8f, 0x37, 0x9f, 0x40, 0x55, 0xb7, 0x63, 0x09, 0x8a, 0x2a, 0xac,
+	0xf7, 0x33, 0x82, 0xed, 0xb5, 0xa9, 0xff, 0xad, 0xeb, 0xbe, 0x07, 0xb6, 0x7e, 0x00, 0x47, 0xb5,
+	0xc4, 0xdb, 0xda, 0x31, 0x98, 0xe0, 0x0f, 0x60, 0xab, 0x7e, 0x12, 0xe4, 0x7c, 0x43, 0xcd, 0x77,
+	0x6a, 0xdf, 0x60, 0xe2, 0xfd, 0x8e, 0x00, 0x87, 0x3f, 0x64, 0x73, 0xc2, 0x52, 0x3a, 0x59, 0x3e,
+	0x0a, 0x6b, 0xb4, 0xe8, 0x35, 0xb4, 0xc6, 0xbf, 0x68, 0x71, 0x08, 0x76, 0x6d, 0xaa, 0x65, 0xdf,
+	0xe0, 0x19, 0x5b, 0x22, 0x37, 0xde, 0xb8, 0xbf, 0x10, 0x74, 0x14, 0x68, 0x7f, 0x46, 0xd2, 0x29,
+	0xc5, 0x5f, 0x83, 0xcd, 0x33, 0x9a, 0x13, 0x25, 0xa4, 0x5b, 0xdd, 0xbc, 0x15, 0xb4, 0x7f, 0x5a,
+	0x41, 0xa3, 0x25, 0x8b, 0x6c, 0x26, 0xdf, 0xb1, 0xb4, 0x4a, 0x50, 0x8d, 0xcb, 0x66, 0xd2, 0xa8,
+	0x9b, 0xc9, 0x5d, 0xb0, 0x72, 0x4a, 0x8a, 0xa5, 0x78, 0xb5, 0xe5, 0x7d, 0x0e, 0x76, 0xcd, 0x89,
+	0x01, 0xac, 0xfd, 0x28, 0xdc, 0x1b, 0x86, 0xce, 0x1d, 0x39, 0x7e, 0x71, 0x76, 0x20, 0xc7, 0x48,
+	0x8e, 0x0f, 0xc2, 0xa3, 0x70, 0x18, 0x3a, 0x86, 0x7c, 0x78, 0xce, 0x9f, 0x0f, 0xce, 0x9c, 0xc6,
+	0xc3, 0x8f, 0xc0, 0x94, 0x5d, 0x07, 0x3b, 0xb0, 0xb5, 0xff, 0x2c, 0x3c, 0x1c, 0x1d, 0xef, 0x9d,
+	0xec, 0x3d, 0x0d, 0x0f, 0x34, 0x76, 0xff, 0xc5, 0xf9, 0xf0, 0xf4, 0xd8, 0x41, 0x0f, 0x7b, 0x60,
+	0x1e, 0xce, 0xc9, 0x14, 0xbf, 0x03, 0x9d, 0x8b, 0x30, 0x3a, 0x1f, 0x9c, 0x9e, 0x8c, 0xfa, 0x23,
+	0x79, 0x9f, 0xd7, 0x1c, 0x8f, 0x1d, 0xf4, 0xe5, 0xd1, 0xb7, 0x5f, 0x4d, 0x99, 0x98, 0x2d, 0xc6,
+	0x7e, 0xcc, 0x93, 0x40, 0x96, 0xa4, 0xfe, 0xb7, 0x0a, 0xde, 0xf8, 0x7f, 0x6b, 0x6c, 0xa9, 0xbb,
+	0xff, 0xe4, 0x9f, 0x01, 0x00, 0x94, 0xcc, 0xa5, 0x3b, 0xab, 0x09, 0x00, 0x00,
 }
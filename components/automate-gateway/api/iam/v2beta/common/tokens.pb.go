@@ -19,13 +19,20 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Token struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Active               bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	CreatedAt            string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            string   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Projects             []string `protobuf:"bytes,7,rep,name=projects,proto3" json:"projects,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// value is only returned when the token is created
+	Value     string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Active    bool     `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt string   `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string   `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Projects  []string `protobuf:"bytes,7,rep,name=projects,proto3" json:"projects,omitempty"`
+	// expires_at, last_used_at and last_used_ip are empty when unset
+	ExpiresAt  string `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt string `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastUsedIp string `protobuf:"bytes,10,opt,name=last_used_ip,json=lastUsedIp,proto3" json:"last_used_ip,omitempty"`
+	// value_prefix is the start of the value, for telling tokens apart
+	ValuePrefix          string   `protobuf:"bytes,11,opt,name=value_prefix,json=valuePrefix,proto3" json:"value_prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -35,7 +42,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_b64fc6b49b7f9263, []int{0}
+	return fileDescriptor_tokens_fa86515780825554, []int{0}
 }
 func (m *Token) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Token.Unmarshal(m, b)
@@ -104,30 +111,62 @@ func (m *Token) GetProjects() []string {
 	return nil
 }
 
+func (m *Token) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *Token) GetLastUsedAt() string {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return ""
+}
+
+func (m *Token) GetLastUsedIp() string {
+	if m != nil {
+		return m.LastUsedIp
+	}
+	return ""
+}
+
+func (m *Token) GetValuePrefix() string {
+	if m != nil {
+		return m.ValuePrefix
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Token)(nil), "chef.automate.api.iam.v2beta.Token")
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/common/tokens.proto", fileDescriptor_tokens_b64fc6b49b7f9263)
-}
-
-var fileDescriptor_tokens_b64fc6b49b7f9263 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x3f, 0x4f, 0xc3, 0x30,
-	0x10, 0xc5, 0x95, 0xb4, 0x09, 0x8d, 0x07, 0x06, 0x0b, 0x21, 0x0b, 0x81, 0x14, 0x31, 0x65, 0xc1,
-	0x96, 0x60, 0x47, 0x2a, 0x23, 0x62, 0x8a, 0x98, 0x58, 0xd0, 0xc5, 0x39, 0x5a, 0x03, 0xce, 0x59,
-	0xc9, 0x25, 0x88, 0xcf, 0xc5, 0x17, 0x44, 0xf9, 0xd3, 0xee, 0xdd, 0xfc, 0x7e, 0xcf, 0x6f, 0xb8,
-	0x9f, 0x78, 0xb4, 0xe4, 0x03, 0x35, 0xd8, 0x70, 0x67, 0xa0, 0x67, 0xf2, 0xc0, 0x78, 0xb7, 0x03,
-	0xc6, 0x1f, 0xf8, 0x35, 0x10, 0x9c, 0x71, 0xe0, 0xcd, 0x70, 0x5f, 0x21, 0x83, 0xb1, 0xe4, 0x3d,
-	0x35, 0x86, 0xe9, 0x0b, 0x9b, 0x4e, 0x87, 0x96, 0x98, 0xe4, 0xb5, 0xdd, 0xe3, 0x87, 0x3e, 0x2c,
-	0x35, 0x04, 0xa7, 0x1d, 0x78, 0x3d, 0x2f, 0x6e, 0xff, 0x22, 0x91, 0xbc, 0x8e, 0xdf, 0xe5, 0xb9,
-	0x88, 0x5d, 0xad, 0xa2, 0x3c, 0x2a, 0xb2, 0x32, 0x76, 0xb5, 0x94, 0x62, 0xdd, 0x80, 0x47, 0x15,
-	0x4f, 0x64, 0x7a, 0xcb, 0x0b, 0x91, 0x0c, 0xf0, 0xdd, 0xa3, 0x5a, 0x4d, 0x70, 0x0e, 0xf2, 0x52,
-	0xa4, 0x60, 0xd9, 0x0d, 0xa8, 0xd6, 0x79, 0x54, 0x6c, 0xca, 0x25, 0xc9, 0x1b, 0x21, 0x6c, 0x8b,
-	0xc0, 0x58, 0xbf, 0x03, 0xab, 0x64, 0x9a, 0x64, 0x0b, 0xd9, 0xf2, 0x58, 0xf7, 0xa1, 0x3e, 0xd4,
-	0xe9, 0x5c, 0x2f, 0x64, 0xcb, 0xf2, 0x4a, 0x6c, 0x42, 0x4b, 0x9f, 0x68, 0xb9, 0x53, 0x67, 0xf9,
-	0xaa, 0xc8, 0xca, 0x63, 0x7e, 0x7a, 0x79, 0x7b, 0xde, 0x39, 0xde, 0xf7, 0x95, 0xb6, 0xe4, 0xcd,
-	0x78, 0xe0, 0x51, 0x8d, 0x39, 0x59, 0x57, 0x95, 0x4e, 0xa2, 0x1e, 0xfe, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xb5, 0x6b, 0x18, 0x21, 0x6a, 0x01, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/common/tokens.proto", fileDescriptor_tokens_fa86515780825554)
+}
+
+var fileDescriptor_tokens_fa86515780825554 = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4d, 0x4b, 0x03, 0x31,
+	0x10, 0x86, 0xe9, 0xf6, 0xc3, 0xee, 0xb4, 0x78, 0x08, 0x22, 0x41, 0x14, 0x56, 0x4f, 0xbd, 0xb8,
+	0x01, 0xbd, 0x0b, 0xf5, 0xa6, 0x78, 0x90, 0xa2, 0x17, 0x2f, 0x65, 0xba, 0x3b, 0x6d, 0xa3, 0xcd,
+	0x26, 0x6c, 0x66, 0x6b, 0xfd, 0x69, 0xfe, 0x3b, 0xd9, 0xec, 0xb6, 0xa0, 0x37, 0x6f, 0x99, 0x67,
+	0xde, 0x27, 0x90, 0xbc, 0x70, 0x97, 0x59, 0xe3, 0x6c, 0x41, 0x05, 0x7b, 0x85, 0x15, 0x5b, 0x83,
+	0x4c, 0xd7, 0x2b, 0x64, 0xfa, 0xc4, 0x2f, 0x85, 0x4e, 0x2b, 0x8d, 0x46, 0x6d, 0x6f, 0x16, 0xc4,
+	0xa8, 0x32, 0x6b, 0x8c, 0x2d, 0x14, 0xdb, 0x0f, 0x2a, 0x7c, 0xea, 0x4a, 0xcb, 0x56, 0x9c, 0x67,
+	0x6b, 0x5a, 0xa6, 0x7b, 0x33, 0x45, 0xa7, 0x53, 0x8d, 0x26, 0x6d, 0x8c, 0xab, 0xef, 0x08, 0xfa,
+	0x2f, 0x75, 0x5c, 0x1c, 0x43, 0xa4, 0x73, 0xd9, 0x49, 0x3a, 0x93, 0x78, 0x16, 0xe9, 0x5c, 0x08,
+	0xe8, 0x15, 0x68, 0x48, 0x46, 0x81, 0x84, 0xb3, 0x38, 0x81, 0xfe, 0x16, 0x37, 0x15, 0xc9, 0x6e,
+	0x80, 0xcd, 0x20, 0x4e, 0x61, 0x80, 0x19, 0xeb, 0x2d, 0xc9, 0x5e, 0xd2, 0x99, 0x0c, 0x67, 0xed,
+	0x24, 0x2e, 0x00, 0xb2, 0x92, 0x90, 0x29, 0x9f, 0x23, 0xcb, 0x7e, 0x50, 0xe2, 0x96, 0x4c, 0xb9,
+	0x5e, 0x57, 0x2e, 0xdf, 0xaf, 0x07, 0xcd, 0xba, 0x25, 0x53, 0x16, 0x67, 0x30, 0x74, 0xa5, 0x7d,
+	0xa7, 0x8c, 0xbd, 0x3c, 0x4a, 0xba, 0x93, 0x78, 0x76, 0x98, 0x6b, 0x95, 0x76, 0x4e, 0x97, 0xe4,
+	0x6b, 0x75, 0xd8, 0xa8, 0x2d, 0x99, 0xb2, 0x48, 0x60, 0xbc, 0x41, 0xcf, 0xf3, 0xca, 0x37, 0x77,
+	0xc7, 0x21, 0x00, 0x35, 0x7b, 0xf5, 0x94, 0xff, 0x4d, 0x68, 0x27, 0xe1, 0x77, 0xe2, 0xc1, 0x89,
+	0x4b, 0x18, 0x87, 0xd7, 0xcd, 0x5d, 0x49, 0x4b, 0xbd, 0x93, 0xa3, 0x90, 0x18, 0x05, 0xf6, 0x1c,
+	0xd0, 0xfd, 0xd3, 0xdb, 0xe3, 0x4a, 0xf3, 0xba, 0x5a, 0xa4, 0x99, 0x35, 0xaa, 0xfe, 0xe6, 0x43,
+	0x41, 0xea, 0xdf, 0xa5, 0x2d, 0x06, 0xa1, 0xae, 0xdb, 0x9f, 0x01, 0x00, 0xf3, 0xba, 0x94, 0xa2,
+	0xf0, 0x01, 0x00, 0x00,
 }
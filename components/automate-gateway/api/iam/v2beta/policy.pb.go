@@ -60,10 +60,15 @@ type PoliciesClient interface {
 	GetProject(ctx context.Context, in *request.GetProjectReq, opts ...grpc.CallOption) (*response.GetProjectResp, error)
 	ListProjects(ctx context.Context, in *request.ListProjectsReq, opts ...grpc.CallOption) (*response.ListProjectsResp, error)
 	DeleteProject(ctx context.Context, in *request.DeleteProjectReq, opts ...grpc.CallOption) (*response.DeleteProjectResp, error)
+	ListDecisions(ctx context.Context, in *request.ListDecisionsReq, opts ...grpc.CallOption) (*response.ListDecisionsResp, error)
+	ExplainAuthorization(ctx context.Context, in *request.ExplainAuthorizationReq, opts ...grpc.CallOption) (*response.ExplainAuthorizationResp, error)
 	// Expose on GRPC API only so we don't expose this to the enduser.
 	// Just want to be able to trigger this via automate-cli.
 	UpgradeToV2(ctx context.Context, in *request.UpgradeToV2Req, opts ...grpc.CallOption) (*response.UpgradeToV2Resp, error)
 	ResetToV1(ctx context.Context, in *request.ResetToV1Req, opts ...grpc.CallOption) (*response.ResetToV1Resp, error)
+	// Expose on GRPC API only: used by automate-cli's iam export and import.
+	ExportState(ctx context.Context, in *request.ExportStateReq, opts ...grpc.CallOption) (*response.ExportStateResp, error)
+	ImportState(ctx context.Context, in *request.ImportStateReq, opts ...grpc.CallOption) (*response.ImportStateResp, error)
 }
 
 type policiesClient struct {
@@ -254,6 +259,24 @@ func (c *policiesClient) DeleteProject(ctx context.Context, in *request.DeletePr
 	return out, nil
 }
 
+func (c *policiesClient) ListDecisions(ctx context.Context, in *request.ListDecisionsReq, opts ...grpc.CallOption) (*response.ListDecisionsResp, error) {
+	out := new(response.ListDecisionsResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.iam.v2beta.Policies/ListDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policiesClient) ExplainAuthorization(ctx context.Context, in *request.ExplainAuthorizationReq, opts ...grpc.CallOption) (*response.ExplainAuthorizationResp, error) {
+	out := new(response.ExplainAuthorizationResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.iam.v2beta.Policies/ExplainAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *policiesClient) UpgradeToV2(ctx context.Context, in *request.UpgradeToV2Req, opts ...grpc.CallOption) (*response.UpgradeToV2Resp, error) {
 	out := new(response.UpgradeToV2Resp)
 	err := c.cc.Invoke(ctx, "/chef.automate.api.iam.v2beta.Policies/UpgradeToV2", in, out, opts...)
@@ -272,6 +295,24 @@ func (c *policiesClient) ResetToV1(ctx context.Context, in *request.ResetToV1Req
 	return out, nil
 }
 
+func (c *policiesClient) ExportState(ctx context.Context, in *request.ExportStateReq, opts ...grpc.CallOption) (*response.ExportStateResp, error) {
+	out := new(response.ExportStateResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.iam.v2beta.Policies/ExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policiesClient) ImportState(ctx context.Context, in *request.ImportStateReq, opts ...grpc.CallOption) (*response.ImportStateResp, error) {
+	out := new(response.ImportStateResp)
+	err := c.cc.Invoke(ctx, "/chef.automate.api.iam.v2beta.Policies/ImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PoliciesServer is the server API for Policies service.
 type PoliciesServer interface {
 	CreatePolicy(context.Context, *request.CreatePolicyReq) (*response.CreatePolicyResp, error)
@@ -294,10 +335,15 @@ type PoliciesServer interface {
 	GetProject(context.Context, *request.GetProjectReq) (*response.GetProjectResp, error)
 	ListProjects(context.Context, *request.ListProjectsReq) (*response.ListProjectsResp, error)
 	DeleteProject(context.Context, *request.DeleteProjectReq) (*response.DeleteProjectResp, error)
+	ListDecisions(context.Context, *request.ListDecisionsReq) (*response.ListDecisionsResp, error)
+	ExplainAuthorization(context.Context, *request.ExplainAuthorizationReq) (*response.ExplainAuthorizationResp, error)
 	// Expose on GRPC API only so we don't expose this to the enduser.
 	// Just want to be able to trigger this via automate-cli.
 	UpgradeToV2(context.Context, *request.UpgradeToV2Req) (*response.UpgradeToV2Resp, error)
 	ResetToV1(context.Context, *request.ResetToV1Req) (*response.ResetToV1Resp, error)
+	// Expose on GRPC API only: used by automate-cli's iam export and import.
+	ExportState(context.Context, *request.ExportStateReq) (*response.ExportStateResp, error)
+	ImportState(context.Context, *request.ImportStateReq) (*response.ImportStateResp, error)
 }
 
 func RegisterPoliciesServer(s *grpc.Server, srv PoliciesServer) {
@@ -664,6 +710,42 @@ func _Policies_DeleteProject_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Policies_ListDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(request.ListDecisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoliciesServer).ListDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.iam.v2beta.Policies/ListDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoliciesServer).ListDecisions(ctx, req.(*request.ListDecisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Policies_ExplainAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(request.ExplainAuthorizationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoliciesServer).ExplainAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.iam.v2beta.Policies/ExplainAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoliciesServer).ExplainAuthorization(ctx, req.(*request.ExplainAuthorizationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Policies_UpgradeToV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(request.UpgradeToV2Req)
 	if err := dec(in); err != nil {
@@ -700,6 +782,42 @@ func _Policies_ResetToV1_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Policies_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(request.ExportStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoliciesServer).ExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.iam.v2beta.Policies/ExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoliciesServer).ExportState(ctx, req.(*request.ExportStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Policies_ImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(request.ImportStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoliciesServer).ImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.api.iam.v2beta.Policies/ImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoliciesServer).ImportState(ctx, req.(*request.ImportStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Policies_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chef.automate.api.iam.v2beta.Policies",
 	HandlerType: (*PoliciesServer)(nil),
@@ -784,6 +902,14 @@ var _Policies_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _Policies_DeleteProject_Handler,
 		},
+		{
+			MethodName: "ListDecisions",
+			Handler:    _Policies_ListDecisions_Handler,
+		},
+		{
+			MethodName: "ExplainAuthorization",
+			Handler:    _Policies_ExplainAuthorization_Handler,
+		},
 		{
 			MethodName: "UpgradeToV2",
 			Handler:    _Policies_UpgradeToV2_Handler,
@@ -792,94 +918,110 @@ var _Policies_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetToV1",
 			Handler:    _Policies_ResetToV1_Handler,
 		},
+		{
+			MethodName: "ExportState",
+			Handler:    _Policies_ExportState_Handler,
+		},
+		{
+			MethodName: "ImportState",
+			Handler:    _Policies_ImportState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "components/automate-gateway/api/iam/v2beta/policy.proto",
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/policy.proto", fileDescriptor_policy_f725f80934d181cb)
-}
-
-var fileDescriptor_policy_f725f80934d181cb = []byte{
-	// 1252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x35, 0x29, 0x4d, 0x93, 0x57, 0xa7, 0x49, 0xc6, 0x69, 0x98, 0xba, 0xb9, 0xb0, 0x15,
-	0x6a, 0x49, 0x1b, 0xaf, 0xe2, 0x52, 0x90, 0x7c, 0xe1, 0x47, 0x41, 0xbd, 0x80, 0x84, 0xa2, 0x36,
-	0x48, 0x5c, 0xa2, 0x8d, 0x3d, 0x38, 0x8b, 0x6c, 0xcf, 0x74, 0x67, 0x1c, 0x14, 0x21, 0x38, 0x58,
-	0x70, 0xf1, 0x35, 0x07, 0x24, 0x38, 0xf0, 0x57, 0xec, 0x05, 0xc1, 0x89, 0x23, 0x5c, 0x00, 0x21,
-	0x21, 0x21, 0x21, 0x21, 0x55, 0xea, 0x09, 0x89, 0x3f, 0x01, 0xcd, 0xfe, 0x9c, 0xdd, 0xf5, 0xee,
-	0x8e, 0x73, 0xcc, 0xcc, 0xf7, 0x3d, 0xcf, 0xe7, 0xbd, 0x37, 0xb3, 0xef, 0x05, 0x5e, 0xef, 0xb1,
-	0x11, 0x67, 0x63, 0x3a, 0x96, 0xc2, 0x76, 0x26, 0x92, 0x8d, 0x1c, 0x49, 0xf7, 0x06, 0x8e, 0xa4,
-	0x9f, 0x3a, 0x67, 0xb6, 0xc3, 0x5d, 0xdb, 0x75, 0x46, 0xf6, 0x69, 0xe7, 0x98, 0x4a, 0xc7, 0xe6,
-	0x6c, 0xe8, 0xf6, 0xce, 0xda, 0xdc, 0x63, 0x92, 0xe1, 0x9d, 0xde, 0x09, 0xfd, 0xb8, 0x1d, 0x9b,
-	0xb4, 0x1d, 0xee, 0xb6, 0x5d, 0x67, 0xd4, 0x0e, 0xa5, 0xad, 0x9d, 0x01, 0x63, 0x83, 0x21, 0x0d,
-	0x3c, 0x38, 0xe3, 0x31, 0x93, 0x8e, 0x74, 0xd9, 0x58, 0x84, 0xb6, 0xad, 0x37, 0x16, 0xf8, 0x51,
-	0x8f, 0x3e, 0x9d, 0x50, 0x21, 0x33, 0x3f, 0xde, 0x7a, 0x73, 0x21, 0x07, 0x82, 0xb3, 0xb1, 0xa0,
-	0x26, 0x1e, 0x3c, 0xde, 0xb3, 0x83, 0xfd, 0xde, 0xde, 0x80, 0x8e, 0xf7, 0x42, 0x8b, 0x12, 0x88,
-	0x45, 0x3c, 0xa8, 0xe3, 0x14, 0x3c, 0x74, 0xbe, 0xbf, 0x0d, 0x2b, 0x1f, 0x28, 0x81, 0x4b, 0x05,
-	0xfe, 0x1b, 0x41, 0xe3, 0xa1, 0x47, 0x1d, 0x49, 0x83, 0xa5, 0x33, 0xbc, 0xd7, 0xae, 0x8a, 0x70,
-	0x5b, 0xd7, 0x1e, 0xd0, 0xa7, 0xad, 0xf6, 0x22, 0x72, 0xc1, 0x2d, 0x36, 0xf5, 0xc9, 0x3a, 0xac,
-	0x39, 0x13, 0x79, 0xd2, 0xe5, 0xd1, 0x21, 0xa6, 0x3e, 0x59, 0xc1, 0xcb, 0xbd, 0x40, 0x3a, 0xf3,
-	0xc9, 0x35, 0x68, 0xb8, 0xce, 0x28, 0xd9, 0x9d, 0xf9, 0xe4, 0x3a, 0x6e, 0xea, 0x2b, 0xdd, 0x50,
-	0x3a, 0xfd, 0xfd, 0xd9, 0xf9, 0xd2, 0xb6, 0xb5, 0x55, 0xa8, 0x15, 0xe5, 0x52, 0xed, 0x5d, 0xea,
-	0xa2, 0x5d, 0xfc, 0x07, 0x82, 0xd5, 0x47, 0x54, 0x46, 0x74, 0xbb, 0xd5, 0xc7, 0x4d, 0x84, 0x0a,
-	0xed, 0xae, 0xb1, 0x36, 0xe2, 0xda, 0x02, 0x9c, 0xe1, 0xea, 0x7e, 0xe6, 0xf6, 0x3f, 0x9f, 0xfa,
-	0xe4, 0x32, 0xbe, 0x34, 0xa0, 0x72, 0xe6, 0x93, 0x26, 0x6c, 0x66, 0x38, 0xd4, 0xfe, 0xcc, 0x27,
-	0x18, 0x6f, 0x64, 0x96, 0x07, 0x54, 0x06, 0xe7, 0xbf, 0x89, 0x6f, 0xcc, 0x63, 0xb3, 0x95, 0x19,
-	0xfe, 0x15, 0x41, 0xe3, 0x3d, 0x57, 0xc8, 0x24, 0x93, 0x35, 0x89, 0xd3, 0xb5, 0x06, 0x89, 0xcb,
-	0xca, 0x05, 0xb7, 0x8e, 0xe7, 0x27, 0x6e, 0x19, 0xbf, 0xe0, 0x51, 0xa7, 0x3f, 0x37, 0x6d, 0x4d,
-	0x9c, 0xc5, 0x1d, 0xba, 0x22, 0x04, 0xdb, 0xc6, 0x73, 0x93, 0x86, 0x9f, 0x21, 0x68, 0xbc, 0x43,
-	0x87, 0xd4, 0xb4, 0x18, 0x75, 0xad, 0x01, 0x53, 0x56, 0x2e, 0xb8, 0x35, 0xa9, 0x48, 0xda, 0x0a,
-	0x5e, 0xee, 0x07, 0xfa, 0xf2, 0xbc, 0xe5, 0xcb, 0x32, 0xd4, 0x87, 0xa9, 0xdb, 0xad, 0x48, 0xdd,
-	0xbf, 0x08, 0x1a, 0x4f, 0x78, 0xdf, 0xf8, 0xce, 0xe9, 0x5a, 0x03, 0xcc, 0xac, 0x5c, 0x70, 0xeb,
-	0x8b, 0x6a, 0xcc, 0x49, 0xa0, 0x37, 0xc7, 0x0c, 0xf5, 0x21, 0x66, 0xab, 0x1c, 0x33, 0xbd, 0x82,
-	0xcf, 0x11, 0x6c, 0x24, 0xb7, 0xe5, 0x90, 0x7a, 0xc2, 0x65, 0x63, 0xbc, 0x6f, 0x78, 0xbb, 0x22,
-	0xbd, 0xe2, 0xee, 0x2c, 0x6a, 0x22, 0xb8, 0x35, 0xac, 0x2b, 0x5b, 0x1d, 0x3a, 0xb6, 0xac, 0xb8,
-	0x93, 0x3b, 0xb8, 0x55, 0xfc, 0x36, 0x1d, 0x9d, 0x46, 0x50, 0x5f, 0x2e, 0xc1, 0x66, 0x72, 0x73,
-	0xce, 0xde, 0xa7, 0xa3, 0x63, 0xea, 0x09, 0xdc, 0x31, 0xbc, 0x6a, 0xb1, 0x81, 0x62, 0xbd, 0xbf,
-	0xb0, 0x8d, 0xe0, 0xd6, 0x57, 0xa8, 0x22, 0xd3, 0x29, 0xf2, 0x4d, 0xb8, 0x51, 0xc8, 0x73, 0x77,
-	0x14, 0xfa, 0x99, 0xf9, 0xe4, 0x45, 0x7c, 0x3d, 0x8d, 0x48, 0xe4, 0x3e, 0xe1, 0xbf, 0x85, 0x5f,
-	0x2a, 0xcd, 0xb8, 0x1d, 0xf9, 0xc0, 0xdf, 0x2e, 0xc1, 0xd6, 0x01, 0xe5, 0x43, 0xa7, 0x47, 0xb3,
-	0x91, 0x78, 0x50, 0x4d, 0x35, 0xcf, 0x46, 0x05, 0xe3, 0xb5, 0x8b, 0x98, 0x09, 0x6e, 0x9d, 0x23,
-	0xc3, 0xca, 0xaf, 0x89, 0x48, 0x0b, 0x93, 0x62, 0x44, 0xb4, 0x6b, 0x70, 0xab, 0x55, 0x1f, 0x94,
-	0xf4, 0x3a, 0x7c, 0xb7, 0x04, 0xcd, 0x03, 0x3a, 0x62, 0xa7, 0xb9, 0xe0, 0xbc, 0x5a, 0x47, 0x59,
-	0x30, 0x51, 0xb1, 0x79, 0x70, 0x01, 0x2b, 0xc1, 0xad, 0x6f, 0x90, 0xe1, 0xdb, 0x77, 0x91, 0xd0,
-	0x68, 0x0f, 0xe1, 0xae, 0x75, 0xa7, 0x36, 0x34, 0x5d, 0x2f, 0x38, 0x64, 0x1a, 0xa1, 0xf3, 0x25,
-	0xd8, 0x78, 0xab, 0xdf, 0xcf, 0x86, 0xa7, 0xe6, 0xc1, 0xc8, 0xeb, 0x0d, 0x1e, 0x8c, 0xa2, 0x89,
-	0xe0, 0xd6, 0xd7, 0x35, 0x81, 0x49, 0xda, 0x94, 0x8b, 0x04, 0x46, 0x6b, 0x5c, 0x6e, 0x5b, 0x2f,
-	0xd7, 0x07, 0xc6, 0xe9, 0xf7, 0xd3, 0xa8, 0xfc, 0x82, 0x00, 0xc2, 0x7e, 0xea, 0x80, 0x0d, 0x29,
-	0xbe, 0x6b, 0xd2, 0x79, 0x29, 0xa5, 0x8a, 0xc4, 0x3d, 0x73, 0xb1, 0xe0, 0x56, 0x6f, 0xea, 0x93,
-	0x06, 0x40, 0x10, 0x02, 0x8f, 0x0d, 0x0b, 0x1d, 0xda, 0x55, 0x58, 0x55, 0x6c, 0xc1, 0x56, 0xfa,
-	0x56, 0x06, 0x7f, 0xea, 0x88, 0x4d, 0x6b, 0x33, 0xd3, 0x11, 0x07, 0x9e, 0x12, 0x9c, 0x1f, 0x10,
-	0xac, 0xaa, 0x67, 0x4c, 0xfd, 0xaa, 0xa8, 0x6b, 0xcc, 0x12, 0xa1, 0x41, 0x63, 0xa6, 0x69, 0x05,
-	0xb7, 0x0e, 0xe7, 0xb0, 0xa4, 0x4f, 0x61, 0x8e, 0x64, 0x03, 0x5f, 0x4b, 0x49, 0x92, 0x76, 0xa5,
-	0x89, 0x8b, 0x1c, 0xf8, 0x27, 0x04, 0x57, 0x1e, 0xd1, 0xe0, 0x87, 0xf0, 0x9d, 0xda, 0x0f, 0x53,
-	0x9c, 0x87, 0x57, 0x0c, 0x95, 0x51, 0x12, 0x36, 0x61, 0x3d, 0x3d, 0x78, 0xa1, 0x9d, 0xdc, 0x00,
-	0xed, 0xb4, 0xd1, 0xc7, 0x7a, 0x1d, 0xaf, 0xa5, 0x6b, 0xf1, 0xa3, 0x4d, 0xf0, 0x76, 0x01, 0x20,
-	0x6c, 0x45, 0x7e, 0x43, 0x00, 0x61, 0x5b, 0x64, 0x52, 0x53, 0xa9, 0xd2, 0xa0, 0xa6, 0x74, 0xb1,
-	0xe0, 0x96, 0x5b, 0x86, 0xa3, 0x3f, 0x36, 0xf3, 0x88, 0x32, 0xd5, 0xa5, 0xbd, 0x2c, 0x64, 0xb7,
-	0x0c, 0xea, 0x2f, 0x04, 0x10, 0x36, 0x41, 0x26, 0x50, 0xa9, 0xd2, 0x00, 0x4a, 0x17, 0x0b, 0x6e,
-	0x79, 0x15, 0x50, 0xc9, 0xc7, 0xa5, 0x16, 0x4a, 0xfb, 0x92, 0x90, 0x56, 0x09, 0x54, 0x7a, 0x6f,
-	0xfe, 0x41, 0xb0, 0x16, 0x8d, 0x55, 0x1e, 0xfb, 0x84, 0xf6, 0x24, 0x36, 0x9b, 0xc1, 0x42, 0xb1,
-	0x62, 0xb4, 0x17, 0xd2, 0xe7, 0x87, 0xb6, 0x70, 0xbd, 0x6c, 0x68, 0x8b, 0x76, 0xb5, 0xb6, 0x31,
-	0x5a, 0xa9, 0x1a, 0xda, 0x62, 0x97, 0x09, 0xe3, 0x7f, 0x08, 0xd6, 0xa2, 0x36, 0xd6, 0x8c, 0x31,
-	0x23, 0x36, 0x60, 0xcc, 0xe9, 0xf3, 0x4d, 0x72, 0x7c, 0xe6, 0x8a, 0x26, 0x59, 0x97, 0xcc, 0xa1,
-	0xad, 0x68, 0x92, 0x23, 0x49, 0x2e, 0xad, 0x7f, 0x22, 0x00, 0xd5, 0xc1, 0x46, 0xbc, 0x06, 0xc3,
-	0x67, 0x0a, 0x7b, 0xcf, 0x5c, 0x9c, 0x1f, 0x55, 0x73, 0xa4, 0x85, 0x51, 0x35, 0x87, 0x19, 0xb7,
-	0xc5, 0xf1, 0x72, 0xd9, 0xa8, 0xaa, 0x33, 0xa6, 0xa3, 0x6a, 0xb4, 0x6a, 0x34, 0xaa, 0x46, 0x5a,
-	0xd3, 0x51, 0x35, 0x91, 0xe7, 0x47, 0xd5, 0xb4, 0x5c, 0x8b, 0xa3, 0x6a, 0x5a, 0xac, 0xf1, 0xa8,
-	0x1a, 0x73, 0x95, 0x8e, 0xaa, 0x31, 0xc2, 0x73, 0x04, 0x6b, 0xd1, 0x3c, 0x69, 0x56, 0xa1, 0x19,
-	0xb1, 0x41, 0x85, 0xe6, 0xf4, 0xf9, 0x69, 0xb5, 0x58, 0xa1, 0x85, 0x69, 0xb5, 0xa6, 0x42, 0x2b,
-	0xa6, 0xd5, 0x4c, 0xf6, 0x7e, 0x46, 0x70, 0xf5, 0x09, 0x1f, 0x78, 0x4e, 0x9f, 0x3e, 0x66, 0x87,
-	0x1d, 0x5c, 0xfb, 0x42, 0x26, 0x52, 0x45, 0xb9, 0xb7, 0x80, 0x5a, 0x70, 0xeb, 0x68, 0xea, 0x93,
-	0x16, 0x10, 0x71, 0x26, 0x24, 0x1d, 0x75, 0xd5, 0x79, 0x27, 0xa1, 0xe2, 0x48, 0xb2, 0xa3, 0xd3,
-	0xce, 0xd4, 0x27, 0xab, 0xf8, 0x4a, 0xb4, 0x34, 0xf3, 0x09, 0x81, 0xed, 0xa2, 0x54, 0x39, 0x9b,
-	0xf9, 0x64, 0x0b, 0xe3, 0xe2, 0x1e, 0xfe, 0x11, 0xc1, 0xea, 0x01, 0x15, 0x54, 0x3e, 0x66, 0x87,
-	0xfb, 0x75, 0x5d, 0x47, 0x22, 0x34, 0xe8, 0x3a, 0x34, 0xad, 0xe0, 0xd6, 0x87, 0xd3, 0xfc, 0xe1,
-	0x3c, 0xb5, 0x1f, 0x50, 0xec, 0x4f, 0x7d, 0x72, 0x05, 0x5f, 0x0e, 0x16, 0x66, 0x3e, 0xd9, 0x86,
-	0xad, 0xbc, 0x4c, 0xb9, 0x09, 0x2f, 0x5b, 0x7e, 0xe7, 0xed, 0x77, 0x3f, 0x7a, 0x38, 0x70, 0xe5,
-	0xc9, 0xe4, 0xb8, 0xdd, 0x63, 0x23, 0x5b, 0x9d, 0x28, 0xf9, 0x2f, 0xa0, 0x6d, 0xfe, 0xdf, 0xc9,
-	0xe3, 0xe5, 0xe0, 0x5f, 0x81, 0xf7, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x11, 0xe1, 0xff, 0xc8,
-	0x88, 0x15, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/policy.proto", fileDescriptor_policy_58befee8c55a2179)
+}
+
+var fileDescriptor_policy_58befee8c55a2179 = []byte{
+	// 1379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x35, 0xf9, 0xfd, 0x48, 0xc9, 0x34, 0x06, 0x67, 0x92, 0xa6, 0x1b, 0x37, 0x80, 0x3a,
+	0x55, 0x51, 0x71, 0x6b, 0x5b, 0x75, 0x09, 0x48, 0xb9, 0x40, 0x69, 0xa3, 0x28, 0x12, 0x6f, 0x32,
+	0x4d, 0x90, 0x0a, 0x28, 0xda, 0xd8, 0x83, 0xb3, 0xc8, 0xeb, 0x9d, 0xee, 0x8c, 0x43, 0x03, 0xea,
+	0xc5, 0x52, 0x0f, 0x44, 0x70, 0x40, 0xfc, 0x29, 0xb0, 0x27, 0x24, 0x2e, 0x9c, 0x40, 0xaa, 0xa8,
+	0xd4, 0x2b, 0x52, 0x24, 0xe0, 0x7f, 0xe0, 0xc0, 0x05, 0xcd, 0xec, 0xdb, 0xcc, 0xae, 0xbd, 0x3b,
+	0xae, 0xb8, 0x79, 0x67, 0x3e, 0xcf, 0xee, 0xf3, 0x7d, 0x9e, 0x79, 0x79, 0x1e, 0xc3, 0xd7, 0xbb,
+	0x9e, 0x4b, 0xbd, 0x21, 0x19, 0x72, 0xd6, 0xb2, 0x47, 0xdc, 0x73, 0x6d, 0x4e, 0x1a, 0x7d, 0x9b,
+	0x93, 0xcf, 0xed, 0xe3, 0x96, 0x4d, 0x9d, 0x96, 0x63, 0xbb, 0xad, 0xa3, 0xf6, 0x01, 0xe1, 0x76,
+	0x8b, 0x7a, 0x03, 0xa7, 0x7b, 0xdc, 0xa4, 0xbe, 0xc7, 0x3d, 0xb4, 0xde, 0x3d, 0x24, 0x9f, 0x36,
+	0x63, 0x93, 0xa6, 0x4d, 0x9d, 0xa6, 0x63, 0xbb, 0xcd, 0x10, 0xad, 0xad, 0xf7, 0x3d, 0xaf, 0x3f,
+	0x20, 0xf2, 0x0d, 0xf6, 0x70, 0xe8, 0x71, 0x9b, 0x3b, 0xde, 0x90, 0x85, 0xb6, 0xb5, 0x37, 0x66,
+	0xf8, 0xa8, 0x4f, 0xee, 0x8d, 0x08, 0xe3, 0xda, 0xc7, 0x6b, 0x6f, 0xce, 0xf4, 0x02, 0x46, 0xbd,
+	0x21, 0x23, 0x26, 0x6f, 0xf0, 0x69, 0xb7, 0x25, 0xe7, 0xbb, 0x8d, 0x3e, 0x19, 0x36, 0x42, 0x8b,
+	0x29, 0x22, 0x66, 0x79, 0x83, 0x70, 0x27, 0xf7, 0x86, 0xf6, 0xdf, 0x57, 0xe1, 0xb3, 0xef, 0x0b,
+	0xc0, 0x21, 0x0c, 0x3d, 0x06, 0x70, 0xf1, 0x96, 0x4f, 0x6c, 0x4e, 0xe4, 0xd0, 0x31, 0x6a, 0x34,
+	0x8b, 0x22, 0xdc, 0x54, 0xd9, 0x0e, 0xb9, 0x57, 0x6b, 0xce, 0x82, 0x33, 0x8a, 0xed, 0x71, 0x60,
+	0x9d, 0x87, 0x15, 0x7b, 0xc4, 0x0f, 0x37, 0x69, 0xec, 0xc4, 0x7c, 0x57, 0x62, 0x27, 0x81, 0x75,
+	0x09, 0x2e, 0x3a, 0xb6, 0x9b, 0xce, 0x2c, 0xab, 0x4f, 0x9b, 0x21, 0x36, 0x7e, 0xf2, 0xd7, 0x77,
+	0x73, 0x6b, 0x78, 0x25, 0xb7, 0x46, 0x04, 0x02, 0xea, 0xe8, 0x11, 0x80, 0x0b, 0xdb, 0x84, 0x47,
+	0x7a, 0xea, 0xc5, 0x0e, 0x26, 0xa0, 0x10, 0x73, 0xd5, 0x98, 0x65, 0x14, 0xf7, 0xc7, 0x81, 0xb5,
+	0x06, 0x91, 0xa6, 0x64, 0xf3, 0x4b, 0xa7, 0xf7, 0x00, 0xfd, 0xaf, 0x4f, 0xf8, 0x49, 0x60, 0x5d,
+	0x86, 0x4b, 0x9a, 0xf7, 0x72, 0xae, 0xaa, 0x0d, 0xf5, 0x09, 0x97, 0x6a, 0x2e, 0xa0, 0xb5, 0x49,
+	0x6a, 0x5a, 0xd2, 0xe4, 0x17, 0x00, 0x17, 0xdf, 0x76, 0x18, 0x4f, 0x72, 0x56, 0x92, 0x22, 0x95,
+	0x35, 0x48, 0x91, 0x8e, 0x33, 0x8a, 0xef, 0x8e, 0x03, 0xeb, 0x5c, 0x36, 0x45, 0xff, 0xf7, 0x89,
+	0xdd, 0x3b, 0x09, 0xac, 0x8b, 0x99, 0x04, 0xe9, 0x12, 0x07, 0x0e, 0x0b, 0x05, 0xad, 0xa2, 0x89,
+	0xe9, 0x41, 0xbf, 0x03, 0xb8, 0x78, 0x9b, 0x0c, 0x88, 0xe9, 0x72, 0x53, 0x59, 0x03, 0x2d, 0x3a,
+	0xce, 0x28, 0x76, 0xc7, 0x81, 0xb5, 0x3e, 0x31, 0x49, 0xf3, 0x3d, 0xc9, 0x9e, 0x04, 0xd6, 0x95,
+	0x49, 0x79, 0xd2, 0x17, 0x5e, 0xc8, 0x86, 0xa9, 0xaa, 0x17, 0xa4, 0xea, 0x14, 0xc0, 0xc5, 0x5d,
+	0xda, 0x33, 0xde, 0x4d, 0x2a, 0x6b, 0x20, 0x4f, 0xc7, 0x19, 0xc5, 0x74, 0xba, 0xbc, 0x91, 0x64,
+	0xcd, 0xe4, 0x85, 0xac, 0x94, 0xf7, 0x62, 0x6d, 0xba, 0x3c, 0xb1, 0xb9, 0x4e, 0x01, 0xac, 0x26,
+	0xfb, 0x60, 0x8f, 0xf8, 0xcc, 0xf1, 0x86, 0xe8, 0xba, 0xe1, 0xbe, 0x89, 0x78, 0xa1, 0xb4, 0x3d,
+	0xab, 0x09, 0xa3, 0xb8, 0x57, 0xb4, 0x30, 0xd5, 0xdd, 0x96, 0x38, 0x36, 0x79, 0xb7, 0xad, 0xa3,
+	0x5a, 0xfe, 0x7e, 0xd9, 0x3f, 0x8a, 0x6c, 0xfe, 0x01, 0x70, 0x29, 0xd9, 0x13, 0xc7, 0xef, 0x10,
+	0xf7, 0x80, 0xf8, 0x0c, 0xb5, 0x0d, 0x37, 0x51, 0x6c, 0x20, 0x34, 0xde, 0x98, 0xd9, 0x86, 0x51,
+	0xfc, 0x60, 0x1c, 0x58, 0xb5, 0x89, 0x29, 0x8d, 0x95, 0xb6, 0xe1, 0x5a, 0x2e, 0xa1, 0x9b, 0x6e,
+	0xe4, 0xe7, 0xb9, 0x34, 0x08, 0xd1, 0x5b, 0x13, 0xd9, 0x97, 0xd0, 0xc5, 0xa9, 0xa9, 0x6d, 0xc5,
+	0xf6, 0xdf, 0xcc, 0xc1, 0x95, 0x0e, 0xa1, 0x03, 0xbb, 0x4b, 0xf4, 0x00, 0x6c, 0x14, 0x8b, 0x99,
+	0x64, 0x23, 0x62, 0xf0, 0xda, 0xd3, 0x98, 0x31, 0x8a, 0x1f, 0x02, 0x83, 0xa5, 0xbd, 0x51, 0x14,
+	0x09, 0x2b, 0x1f, 0x09, 0x65, 0x9d, 0xbf, 0x5c, 0x2b, 0x0f, 0x86, 0x58, 0xef, 0xdf, 0xce, 0xc1,
+	0xe5, 0x0e, 0x71, 0xbd, 0xa3, 0x4c, 0x38, 0x5e, 0x2d, 0xd3, 0x95, 0x33, 0x11, 0xd1, 0xd8, 0x78,
+	0x0a, 0x2b, 0x46, 0xf1, 0xd7, 0xc0, 0xe0, 0x18, 0x9b, 0x35, 0x18, 0xca, 0x99, 0xd6, 0xc0, 0x57,
+	0xca, 0x83, 0xe1, 0x4b, 0xe7, 0x44, 0x4c, 0x1e, 0xce, 0xc1, 0xea, 0xcd, 0x5e, 0x4f, 0x0f, 0x48,
+	0xc9, 0x19, 0x90, 0xe5, 0x0d, 0xce, 0x80, 0xbc, 0x09, 0xa3, 0xf8, 0xab, 0x82, 0x50, 0x24, 0x55,
+	0xc4, 0xac, 0xa1, 0x50, 0xea, 0x8a, 0x3a, 0xbe, 0x5c, 0x1e, 0x0a, 0xbb, 0xd7, 0x13, 0x71, 0xf8,
+	0x09, 0x40, 0x18, 0x16, 0x38, 0x1d, 0x6f, 0x40, 0xd0, 0x55, 0x93, 0x52, 0x48, 0x90, 0x42, 0xfb,
+	0x35, 0x73, 0x98, 0x51, 0xbc, 0x3b, 0x0e, 0xac, 0x15, 0x08, 0xa5, 0x68, 0xdf, 0x1b, 0x68, 0x25,
+	0xd3, 0x0b, 0x70, 0x41, 0x28, 0x0a, 0x87, 0xab, 0xc9, 0x4f, 0x55, 0xd4, 0x2a, 0x5e, 0xd2, 0x4a,
+	0x53, 0x39, 0x0f, 0xea, 0xe8, 0x07, 0x00, 0x17, 0xc4, 0x29, 0xd4, 0x91, 0xb6, 0xf5, 0xf2, 0xe3,
+	0x4a, 0x82, 0x06, 0x95, 0x92, 0xc2, 0x32, 0x8a, 0xdf, 0x1d, 0x07, 0x16, 0xd2, 0xbc, 0x8f, 0x8f,
+	0xb2, 0x0b, 0xaa, 0xef, 0xcf, 0xa5, 0xbe, 0x27, 0x75, 0xc4, 0x32, 0xca, 0x7b, 0x8e, 0x7e, 0x04,
+	0xf0, 0xcc, 0x36, 0x91, 0x1f, 0x40, 0x57, 0x4a, 0xef, 0x91, 0x38, 0xe2, 0xaf, 0x18, 0x92, 0x8c,
+	0xe2, 0x8f, 0xc6, 0x81, 0xb5, 0x0a, 0x9f, 0x4f, 0x1d, 0xd6, 0xea, 0xba, 0x97, 0xa0, 0xe2, 0xa9,
+	0x9c, 0xa8, 0xa4, 0xcf, 0xf1, 0x61, 0x6b, 0xa1, 0xd5, 0x9c, 0xe3, 0x61, 0x8d, 0xf0, 0x2b, 0x80,
+	0x30, 0xac, 0x53, 0x4c, 0x56, 0x4d, 0x4a, 0x1a, 0xac, 0x1a, 0x15, 0x8e, 0x6a, 0xed, 0xb5, 0xbc,
+	0x8c, 0xf4, 0xc8, 0xc0, 0x39, 0x25, 0xca, 0xfa, 0x51, 0xce, 0x07, 0xab, 0x3e, 0x4d, 0xcc, 0x23,
+	0x00, 0x61, 0x58, 0x95, 0x98, 0x88, 0x49, 0x49, 0x03, 0x31, 0x2a, 0x1c, 0x5d, 0xfe, 0x93, 0xc4,
+	0x24, 0x97, 0x41, 0xa1, 0x18, 0xe5, 0xe4, 0xbf, 0x50, 0x9b, 0x22, 0x46, 0xec, 0x88, 0x27, 0x00,
+	0x56, 0xa2, 0x9e, 0xc5, 0xf7, 0x3e, 0x23, 0x5d, 0x8e, 0xcc, 0x1a, 0x9c, 0x10, 0x16, 0xaa, 0x5a,
+	0x33, 0xf1, 0xd9, 0x8e, 0x28, 0x1c, 0x9f, 0xd4, 0x11, 0xc5, 0x33, 0xcb, 0xea, 0x53, 0x51, 0x47,
+	0x14, 0x23, 0xa0, 0x8e, 0xfe, 0x00, 0xb0, 0x12, 0xd5, 0x8e, 0x66, 0xaa, 0x34, 0xd8, 0x40, 0x55,
+	0x86, 0xcf, 0x56, 0xa6, 0xb1, 0x2b, 0x53, 0x2a, 0x53, 0x6d, 0x5a, 0xd7, 0x57, 0x50, 0x99, 0x46,
+	0x48, 0x92, 0xba, 0xc7, 0x00, 0x42, 0x51, 0x36, 0x46, 0x0a, 0x0d, 0x7a, 0xb9, 0x54, 0xde, 0x35,
+	0x73, 0x38, 0xdb, 0xf9, 0x69, 0xce, 0x67, 0x3a, 0x3f, 0x6d, 0xae, 0xaa, 0x0d, 0x4d, 0xeb, 0xfc,
+	0x54, 0x55, 0x69, 0xe7, 0x17, 0x27, 0xdf, 0xa4, 0xf3, 0x8b, 0x58, 0xd3, 0xce, 0x2f, 0xc1, 0xb3,
+	0x9d, 0x5f, 0xfc, 0xcd, 0x6c, 0xe7, 0x17, 0x8f, 0xeb, 0x12, 0xa7, 0x76, 0x7e, 0x31, 0x7e, 0x0a,
+	0x60, 0x25, 0x6a, 0xcf, 0xcc, 0xd6, 0xa0, 0x06, 0x1b, 0xac, 0xc1, 0x0c, 0x9f, 0x6d, 0xfe, 0xf4,
+	0x35, 0x98, 0x6b, 0xfe, 0x0a, 0xd6, 0x60, 0x41, 0xf3, 0xa7, 0x65, 0xeb, 0x37, 0x00, 0x2b, 0x22,
+	0xa4, 0xb7, 0x49, 0xd7, 0x11, 0x9d, 0x04, 0x43, 0x06, 0xf1, 0x4f, 0x60, 0x03, 0x85, 0x19, 0x9e,
+	0x51, 0xfc, 0x49, 0x51, 0x47, 0x74, 0x09, 0xca, 0x7b, 0xa9, 0x97, 0xb8, 0x83, 0xb4, 0xc7, 0x34,
+	0x65, 0xe7, 0xd1, 0x39, 0x55, 0x55, 0x6a, 0xf0, 0x27, 0x80, 0x2b, 0x5b, 0xf7, 0xe9, 0xc0, 0x76,
+	0x86, 0x37, 0x47, 0xfc, 0xd0, 0xf3, 0x9d, 0x2f, 0xe4, 0x3f, 0x49, 0x65, 0xcd, 0xc0, 0x24, 0x1b,
+	0x83, 0x66, 0x60, 0xb2, 0x19, 0xa3, 0xf8, 0xe3, 0xff, 0xe0, 0x1f, 0x09, 0x0b, 0x2f, 0xab, 0x22,
+	0x49, 0xf8, 0x2d, 0x71, 0x70, 0xfc, 0x0c, 0xe0, 0xd9, 0x5d, 0xda, 0xf7, 0xed, 0x1e, 0xb9, 0xe3,
+	0xed, 0xb5, 0x51, 0xe9, 0xbd, 0x94, 0xa0, 0x42, 0x53, 0x63, 0x06, 0x9a, 0x51, 0xfc, 0xe1, 0x58,
+	0x24, 0xc6, 0x62, 0xc7, 0x8c, 0x13, 0x77, 0x53, 0xb8, 0x3a, 0x0a, 0x89, 0x7d, 0xee, 0xed, 0x1f,
+	0xb5, 0xd1, 0x99, 0xe8, 0xf1, 0x24, 0xb0, 0xae, 0xc1, 0xd5, 0x3c, 0x26, 0x3d, 0x44, 0xf9, 0x71,
+	0x14, 0x00, 0xb8, 0xd0, 0x21, 0x8c, 0xf0, 0x3b, 0xde, 0xde, 0xf5, 0xb2, 0x5a, 0x2e, 0x01, 0x0d,
+	0x6a, 0x39, 0x85, 0x65, 0x14, 0xbf, 0x37, 0x16, 0x05, 0x90, 0xea, 0x98, 0x2f, 0xe6, 0xa5, 0xf7,
+	0xd7, 0xd1, 0x33, 0xf2, 0x41, 0x6e, 0xab, 0x95, 0x2c, 0x22, 0xfd, 0xaa, 0x66, 0x47, 0xd1, 0xf7,
+	0x00, 0x9e, 0xdd, 0xba, 0x4f, 0x3d, 0x9f, 0x7f, 0xc0, 0x6d, 0x4e, 0xca, 0xa2, 0xaf, 0xa0, 0x06,
+	0xd1, 0xd7, 0x68, 0x46, 0xf1, 0xb6, 0x6c, 0xae, 0x55, 0x27, 0x98, 0xfc, 0xe8, 0x3c, 0x91, 0xa0,
+	0x3c, 0xba, 0xf3, 0xb3, 0x4b, 0xca, 0x48, 0x08, 0x4a, 0xaf, 0x77, 0x5c, 0x63, 0xaf, 0x77, 0xdc,
+	0x59, 0xbc, 0xde, 0x71, 0x0d, 0xbd, 0x76, 0x5c, 0x43, 0xaf, 0x43, 0xf0, 0xad, 0xad, 0xbb, 0xb7,
+	0xfa, 0x0e, 0x3f, 0x1c, 0x1d, 0x34, 0xbb, 0x9e, 0xdb, 0x12, 0x3e, 0x24, 0x7f, 0x21, 0xb7, 0xcc,
+	0xff, 0xda, 0x3e, 0x98, 0x97, 0xff, 0x23, 0xdf, 0xf8, 0x77, 0x00, 0x48, 0x09, 0xa7, 0x69, 0xc5,
+	0x17, 0x00, 0x00,
 }
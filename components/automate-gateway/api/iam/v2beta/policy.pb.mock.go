@@ -51,8 +51,12 @@ type PoliciesServerMock struct {
 	GetProjectFunc           func(context.Context, *request.GetProjectReq) (*response.GetProjectResp, error)
 	ListProjectsFunc         func(context.Context, *request.ListProjectsReq) (*response.ListProjectsResp, error)
 	DeleteProjectFunc        func(context.Context, *request.DeleteProjectReq) (*response.DeleteProjectResp, error)
+	ListDecisionsFunc        func(context.Context, *request.ListDecisionsReq) (*response.ListDecisionsResp, error)
+	ExplainAuthorizationFunc func(context.Context, *request.ExplainAuthorizationReq) (*response.ExplainAuthorizationResp, error)
 	UpgradeToV2Func          func(context.Context, *request.UpgradeToV2Req) (*response.UpgradeToV2Resp, error)
 	ResetToV1Func            func(context.Context, *request.ResetToV1Req) (*response.ResetToV1Resp, error)
+	ExportStateFunc          func(context.Context, *request.ExportStateReq) (*response.ExportStateResp, error)
+	ImportStateFunc          func(context.Context, *request.ImportStateReq) (*response.ImportStateResp, error)
 }
 
 func (m *PoliciesServerMock) CreatePolicy(ctx context.Context, req *request.CreatePolicyReq) (*response.CreatePolicyResp, error) {
@@ -295,6 +299,30 @@ func (m *PoliciesServerMock) DeleteProject(ctx context.Context, req *request.Del
 	return nil, status.Error(codes.Internal, "mock: 'DeleteProject' not implemented")
 }
 
+func (m *PoliciesServerMock) ListDecisions(ctx context.Context, req *request.ListDecisionsReq) (*response.ListDecisionsResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ListDecisionsFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ListDecisions' not implemented")
+}
+
+func (m *PoliciesServerMock) ExplainAuthorization(ctx context.Context, req *request.ExplainAuthorizationReq) (*response.ExplainAuthorizationResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ExplainAuthorizationFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ExplainAuthorization' not implemented")
+}
+
 func (m *PoliciesServerMock) UpgradeToV2(ctx context.Context, req *request.UpgradeToV2Req) (*response.UpgradeToV2Resp, error) {
 	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
 		if err := msg.Validate(); err != nil {
@@ -319,6 +347,30 @@ func (m *PoliciesServerMock) ResetToV1(ctx context.Context, req *request.ResetTo
 	return nil, status.Error(codes.Internal, "mock: 'ResetToV1' not implemented")
 }
 
+func (m *PoliciesServerMock) ExportState(ctx context.Context, req *request.ExportStateReq) (*response.ExportStateResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ExportStateFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ExportState' not implemented")
+}
+
+func (m *PoliciesServerMock) ImportState(ctx context.Context, req *request.ImportStateReq) (*response.ImportStateResp, error) {
+	if msg, ok := interface{}(req).(interface{ Validate() error }); m.validateRequests && ok {
+		if err := msg.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if f := m.ImportStateFunc; f != nil {
+		return f(ctx, req)
+	}
+	return nil, status.Error(codes.Internal, "mock: 'ImportState' not implemented")
+}
+
 // Reset resets all overridden functions
 func (m *PoliciesServerMock) Reset() {
 	m.CreatePolicyFunc = nil
@@ -341,6 +393,10 @@ func (m *PoliciesServerMock) Reset() {
 	m.GetProjectFunc = nil
 	m.ListProjectsFunc = nil
 	m.DeleteProjectFunc = nil
+	m.ListDecisionsFunc = nil
+	m.ExplainAuthorizationFunc = nil
 	m.UpgradeToV2Func = nil
 	m.ResetToV1Func = nil
+	m.ExportStateFunc = nil
+	m.ImportStateFunc = nil
 }
@@ -241,10 +241,50 @@ func init() {
 		}
 		return ""
 	})
+	policy.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ListDecisions", "auth:policies", "read", "GET", "/iam/v2beta/decisions", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*request.ListDecisionsReq); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "subject":
+					return m.Subject
+				case "action":
+					return m.Action
+				case "resource":
+					return m.Resource
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policy.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ExplainAuthorization", "auth:policies", "read", "POST", "/iam/v2beta/explain", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*request.ExplainAuthorizationReq); ok {
+			return policy.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "action":
+					return m.Action
+				case "resource":
+					return m.Resource
+				case "source_ip":
+					return m.SourceIp
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
 	policy.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/UpgradeToV2", "system:iam:upgrade_to_v2", "upgrade", "", "", func(unexpandedResource string, input interface{}) string {
 		return unexpandedResource
 	})
 	policy.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ResetToV1", "system:iam:reset_to_v1", "reset", "", "", func(unexpandedResource string, input interface{}) string {
 		return unexpandedResource
 	})
+	policy.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ExportState", "system:iam:state", "export", "", "", func(unexpandedResource string, input interface{}) string {
+		return unexpandedResource
+	})
+	policy.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ImportState", "system:iam:state", "import", "", "", func(unexpandedResource string, input interface{}) string {
+		return unexpandedResource
+	})
 }
@@ -241,10 +241,50 @@ func init() {
 		}
 		return ""
 	})
+	policyv2.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ListDecisions", "iam:decisions", "iam:decisions:list", "GET", "/iam/v2beta/decisions", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*request.ListDecisionsReq); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "subject":
+					return m.Subject
+				case "action":
+					return m.Action
+				case "resource":
+					return m.Resource
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
+	policyv2.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ExplainAuthorization", "iam:policies", "iam:policies:list", "POST", "/iam/v2beta/explain", func(unexpandedResource string, input interface{}) string {
+		if m, ok := input.(*request.ExplainAuthorizationReq); ok {
+			return policyv2.ExpandParameterizedResource(unexpandedResource, func(want string) string {
+				switch want {
+				case "action":
+					return m.Action
+				case "resource":
+					return m.Resource
+				case "source_ip":
+					return m.SourceIp
+				default:
+					return ""
+				}
+			})
+		}
+		return ""
+	})
 	policyv2.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/UpgradeToV2", "system:iam:upgradeToV2", "system:iam:upgrade", "", "", func(unexpandedResource string, input interface{}) string {
 		return unexpandedResource
 	})
 	policyv2.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ResetToV1", "system:iam:resetToV1", "system:iam:reset", "", "", func(unexpandedResource string, input interface{}) string {
 		return unexpandedResource
 	})
+	policyv2.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ExportState", "system:iam:state", "system:iam:export", "", "", func(unexpandedResource string, input interface{}) string {
+		return unexpandedResource
+	})
+	policyv2.MapMethodTo("/chef.automate.api.iam.v2beta.Policies/ImportState", "system:iam:state", "system:iam:import", "", "", func(unexpandedResource string, input interface{}) string {
+		return unexpandedResource
+	})
 }
@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import common "github.com/chef/automate/components/automate-gateway/api/iam/v2beta/common"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -19,6 +20,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ListDecisionsReq_Result int32
+
+const (
+	ListDecisionsReq_ANY     ListDecisionsReq_Result = 0
+	ListDecisionsReq_ALLOWED ListDecisionsReq_Result = 1
+	ListDecisionsReq_DENIED  ListDecisionsReq_Result = 2
+)
+
+var ListDecisionsReq_Result_name = map[int32]string{
+	0: "ANY",
+	1: "ALLOWED",
+	2: "DENIED",
+}
+var ListDecisionsReq_Result_value = map[string]int32{
+	"ANY":     0,
+	"ALLOWED": 1,
+	"DENIED":  2,
+}
+
+func (x ListDecisionsReq_Result) String() string {
+	return proto.EnumName(ListDecisionsReq_Result_name, int32(x))
+}
+func (ListDecisionsReq_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_policy_36fdec534becf74e, []int{22, 0}
+}
+
 // Does not contain type as the enduser can only create 'custom' policies.
 type CreatePolicyReq struct {
 	Id                   string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -35,7 +62,7 @@ func (m *CreatePolicyReq) Reset()         { *m = CreatePolicyReq{} }
 func (m *CreatePolicyReq) String() string { return proto.CompactTextString(m) }
 func (*CreatePolicyReq) ProtoMessage()    {}
 func (*CreatePolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{0}
+	return fileDescriptor_policy_36fdec534becf74e, []int{0}
 }
 func (m *CreatePolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreatePolicyReq.Unmarshal(m, b)
@@ -101,7 +128,7 @@ func (m *DeletePolicyReq) Reset()         { *m = DeletePolicyReq{} }
 func (m *DeletePolicyReq) String() string { return proto.CompactTextString(m) }
 func (*DeletePolicyReq) ProtoMessage()    {}
 func (*DeletePolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{1}
+	return fileDescriptor_policy_36fdec534becf74e, []int{1}
 }
 func (m *DeletePolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeletePolicyReq.Unmarshal(m, b)
@@ -138,7 +165,7 @@ func (m *ListPoliciesReq) Reset()         { *m = ListPoliciesReq{} }
 func (m *ListPoliciesReq) String() string { return proto.CompactTextString(m) }
 func (*ListPoliciesReq) ProtoMessage()    {}
 func (*ListPoliciesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{2}
+	return fileDescriptor_policy_36fdec534becf74e, []int{2}
 }
 func (m *ListPoliciesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPoliciesReq.Unmarshal(m, b)
@@ -170,7 +197,7 @@ func (m *AddPolicyMembersReq) Reset()         { *m = AddPolicyMembersReq{} }
 func (m *AddPolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*AddPolicyMembersReq) ProtoMessage()    {}
 func (*AddPolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{3}
+	return fileDescriptor_policy_36fdec534becf74e, []int{3}
 }
 func (m *AddPolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddPolicyMembersReq.Unmarshal(m, b)
@@ -215,7 +242,7 @@ func (m *GetPolicyReq) Reset()         { *m = GetPolicyReq{} }
 func (m *GetPolicyReq) String() string { return proto.CompactTextString(m) }
 func (*GetPolicyReq) ProtoMessage()    {}
 func (*GetPolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{4}
+	return fileDescriptor_policy_36fdec534becf74e, []int{4}
 }
 func (m *GetPolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPolicyReq.Unmarshal(m, b)
@@ -258,7 +285,7 @@ func (m *UpdatePolicyReq) Reset()         { *m = UpdatePolicyReq{} }
 func (m *UpdatePolicyReq) String() string { return proto.CompactTextString(m) }
 func (*UpdatePolicyReq) ProtoMessage()    {}
 func (*UpdatePolicyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{5}
+	return fileDescriptor_policy_36fdec534becf74e, []int{5}
 }
 func (m *UpdatePolicyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePolicyReq.Unmarshal(m, b)
@@ -324,7 +351,7 @@ func (m *UpgradeToV2Req) Reset()         { *m = UpgradeToV2Req{} }
 func (m *UpgradeToV2Req) String() string { return proto.CompactTextString(m) }
 func (*UpgradeToV2Req) ProtoMessage()    {}
 func (*UpgradeToV2Req) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{6}
+	return fileDescriptor_policy_36fdec534becf74e, []int{6}
 }
 func (m *UpgradeToV2Req) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeToV2Req.Unmarshal(m, b)
@@ -361,7 +388,7 @@ func (m *GetPolicyVersionReq) Reset()         { *m = GetPolicyVersionReq{} }
 func (m *GetPolicyVersionReq) String() string { return proto.CompactTextString(m) }
 func (*GetPolicyVersionReq) ProtoMessage()    {}
 func (*GetPolicyVersionReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{7}
+	return fileDescriptor_policy_36fdec534becf74e, []int{7}
 }
 func (m *GetPolicyVersionReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPolicyVersionReq.Unmarshal(m, b)
@@ -391,7 +418,7 @@ func (m *ResetToV1Req) Reset()         { *m = ResetToV1Req{} }
 func (m *ResetToV1Req) String() string { return proto.CompactTextString(m) }
 func (*ResetToV1Req) ProtoMessage()    {}
 func (*ResetToV1Req) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{8}
+	return fileDescriptor_policy_36fdec534becf74e, []int{8}
 }
 func (m *ResetToV1Req) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetToV1Req.Unmarshal(m, b)
@@ -422,7 +449,7 @@ func (m *ListPolicyMembersReq) Reset()         { *m = ListPolicyMembersReq{} }
 func (m *ListPolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyMembersReq) ProtoMessage()    {}
 func (*ListPolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{9}
+	return fileDescriptor_policy_36fdec534becf74e, []int{9}
 }
 func (m *ListPolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPolicyMembersReq.Unmarshal(m, b)
@@ -461,7 +488,7 @@ func (m *ReplacePolicyMembersReq) Reset()         { *m = ReplacePolicyMembersReq
 func (m *ReplacePolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*ReplacePolicyMembersReq) ProtoMessage()    {}
 func (*ReplacePolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{10}
+	return fileDescriptor_policy_36fdec534becf74e, []int{10}
 }
 func (m *ReplacePolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplacePolicyMembersReq.Unmarshal(m, b)
@@ -507,7 +534,7 @@ func (m *RemovePolicyMembersReq) Reset()         { *m = RemovePolicyMembersReq{}
 func (m *RemovePolicyMembersReq) String() string { return proto.CompactTextString(m) }
 func (*RemovePolicyMembersReq) ProtoMessage()    {}
 func (*RemovePolicyMembersReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{11}
+	return fileDescriptor_policy_36fdec534becf74e, []int{11}
 }
 func (m *RemovePolicyMembersReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemovePolicyMembersReq.Unmarshal(m, b)
@@ -556,7 +583,7 @@ func (m *CreateRoleReq) Reset()         { *m = CreateRoleReq{} }
 func (m *CreateRoleReq) String() string { return proto.CompactTextString(m) }
 func (*CreateRoleReq) ProtoMessage()    {}
 func (*CreateRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{12}
+	return fileDescriptor_policy_36fdec534becf74e, []int{12}
 }
 func (m *CreateRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRoleReq.Unmarshal(m, b)
@@ -615,7 +642,7 @@ func (m *GetRoleReq) Reset()         { *m = GetRoleReq{} }
 func (m *GetRoleReq) String() string { return proto.CompactTextString(m) }
 func (*GetRoleReq) ProtoMessage()    {}
 func (*GetRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{13}
+	return fileDescriptor_policy_36fdec534becf74e, []int{13}
 }
 func (m *GetRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRoleReq.Unmarshal(m, b)
@@ -653,7 +680,7 @@ func (m *DeleteRoleReq) Reset()         { *m = DeleteRoleReq{} }
 func (m *DeleteRoleReq) String() string { return proto.CompactTextString(m) }
 func (*DeleteRoleReq) ProtoMessage()    {}
 func (*DeleteRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{14}
+	return fileDescriptor_policy_36fdec534becf74e, []int{14}
 }
 func (m *DeleteRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRoleReq.Unmarshal(m, b)
@@ -694,7 +721,7 @@ func (m *UpdateRoleReq) Reset()         { *m = UpdateRoleReq{} }
 func (m *UpdateRoleReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateRoleReq) ProtoMessage()    {}
 func (*UpdateRoleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{15}
+	return fileDescriptor_policy_36fdec534becf74e, []int{15}
 }
 func (m *UpdateRoleReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRoleReq.Unmarshal(m, b)
@@ -752,7 +779,7 @@ func (m *ListRolesReq) Reset()         { *m = ListRolesReq{} }
 func (m *ListRolesReq) String() string { return proto.CompactTextString(m) }
 func (*ListRolesReq) ProtoMessage()    {}
 func (*ListRolesReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{16}
+	return fileDescriptor_policy_36fdec534becf74e, []int{16}
 }
 func (m *ListRolesReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRolesReq.Unmarshal(m, b)
@@ -783,7 +810,7 @@ func (m *GetProjectReq) Reset()         { *m = GetProjectReq{} }
 func (m *GetProjectReq) String() string { return proto.CompactTextString(m) }
 func (*GetProjectReq) ProtoMessage()    {}
 func (*GetProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{17}
+	return fileDescriptor_policy_36fdec534becf74e, []int{17}
 }
 func (m *GetProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectReq.Unmarshal(m, b)
@@ -820,7 +847,7 @@ func (m *ListProjectsReq) Reset()         { *m = ListProjectsReq{} }
 func (m *ListProjectsReq) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsReq) ProtoMessage()    {}
 func (*ListProjectsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{18}
+	return fileDescriptor_policy_36fdec534becf74e, []int{18}
 }
 func (m *ListProjectsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsReq.Unmarshal(m, b)
@@ -852,7 +879,7 @@ func (m *CreateProjectReq) Reset()         { *m = CreateProjectReq{} }
 func (m *CreateProjectReq) String() string { return proto.CompactTextString(m) }
 func (*CreateProjectReq) ProtoMessage()    {}
 func (*CreateProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{19}
+	return fileDescriptor_policy_36fdec534becf74e, []int{19}
 }
 func (m *CreateProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateProjectReq.Unmarshal(m, b)
@@ -898,7 +925,7 @@ func (m *UpdateProjectReq) Reset()         { *m = UpdateProjectReq{} }
 func (m *UpdateProjectReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateProjectReq) ProtoMessage()    {}
 func (*UpdateProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{20}
+	return fileDescriptor_policy_36fdec534becf74e, []int{20}
 }
 func (m *UpdateProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateProjectReq.Unmarshal(m, b)
@@ -943,7 +970,7 @@ func (m *DeleteProjectReq) Reset()         { *m = DeleteProjectReq{} }
 func (m *DeleteProjectReq) String() string { return proto.CompactTextString(m) }
 func (*DeleteProjectReq) ProtoMessage()    {}
 func (*DeleteProjectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_a15a519e01facaa9, []int{21}
+	return fileDescriptor_policy_36fdec534becf74e, []int{21}
 }
 func (m *DeleteProjectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteProjectReq.Unmarshal(m, b)
@@ -970,6 +997,277 @@ func (m *DeleteProjectReq) GetId() string {
 	return ""
 }
 
+type ListDecisionsReq struct {
+	// only list decisions made for requests including this subject
+	Subject  string                  `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Action   string                  `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Resource string                  `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Result   ListDecisionsReq_Result `protobuf:"varint,4,opt,name=result,proto3,enum=chef.automate.api.iam.v2beta.ListDecisionsReq_Result" json:"result,omitempty"`
+	Since    *timestamp.Timestamp    `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until    *timestamp.Timestamp    `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	// maximum number of decisions listed, most recent first; defaults to 100
+	Limit                int32    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDecisionsReq) Reset()         { *m = ListDecisionsReq{} }
+func (m *ListDecisionsReq) String() string { return proto.CompactTextString(m) }
+func (*ListDecisionsReq) ProtoMessage()    {}
+func (*ListDecisionsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_36fdec534becf74e, []int{22}
+}
+func (m *ListDecisionsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDecisionsReq.Unmarshal(m, b)
+}
+func (m *ListDecisionsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDecisionsReq.Marshal(b, m, deterministic)
+}
+func (dst *ListDecisionsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDecisionsReq.Merge(dst, src)
+}
+func (m *ListDecisionsReq) XXX_Size() int {
+	return xxx_messageInfo_ListDecisionsReq.Size(m)
+}
+func (m *ListDecisionsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDecisionsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDecisionsReq proto.InternalMessageInfo
+
+func (m *ListDecisionsReq) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ListDecisionsReq) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ListDecisionsReq) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *ListDecisionsReq) GetResult() ListDecisionsReq_Result {
+	if m != nil {
+		return m.Result
+	}
+	return ListDecisionsReq_ANY
+}
+
+func (m *ListDecisionsReq) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *ListDecisionsReq) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *ListDecisionsReq) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ExplainAuthorizationReq struct {
+	Subjects []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Action   string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Resource string   `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Projects []string `protobuf:"bytes,4,rep,name=projects,proto3" json:"projects,omitempty"`
+	// policies that are not saved: they are evaluated in place of the saved
+	// policies with the same IDs, and in addition to all others
+	Policies []*common.Policy `protobuf:"bytes,5,rep,name=policies,proto3" json:"policies,omitempty"`
+	// the client address and time to check statement conditions against;
+	// the time defaults to now
+	SourceIp             string               `protobuf:"bytes,6,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	Time                 *timestamp.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExplainAuthorizationReq) Reset()         { *m = ExplainAuthorizationReq{} }
+func (m *ExplainAuthorizationReq) String() string { return proto.CompactTextString(m) }
+func (*ExplainAuthorizationReq) ProtoMessage()    {}
+func (*ExplainAuthorizationReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_36fdec534becf74e, []int{23}
+}
+func (m *ExplainAuthorizationReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainAuthorizationReq.Unmarshal(m, b)
+}
+func (m *ExplainAuthorizationReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainAuthorizationReq.Marshal(b, m, deterministic)
+}
+func (dst *ExplainAuthorizationReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainAuthorizationReq.Merge(dst, src)
+}
+func (m *ExplainAuthorizationReq) XXX_Size() int {
+	return xxx_messageInfo_ExplainAuthorizationReq.Size(m)
+}
+func (m *ExplainAuthorizationReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainAuthorizationReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainAuthorizationReq proto.InternalMessageInfo
+
+func (m *ExplainAuthorizationReq) GetSubjects() []string {
+	if m != nil {
+		return m.Subjects
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationReq) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationReq) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationReq) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationReq) GetPolicies() []*common.Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationReq) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationReq) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type ExportStateReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportStateReq) Reset()         { *m = ExportStateReq{} }
+func (m *ExportStateReq) String() string { return proto.CompactTextString(m) }
+func (*ExportStateReq) ProtoMessage()    {}
+func (*ExportStateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_36fdec534becf74e, []int{24}
+}
+func (m *ExportStateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportStateReq.Unmarshal(m, b)
+}
+func (m *ExportStateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportStateReq.Marshal(b, m, deterministic)
+}
+func (dst *ExportStateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportStateReq.Merge(dst, src)
+}
+func (m *ExportStateReq) XXX_Size() int {
+	return xxx_messageInfo_ExportStateReq.Size(m)
+}
+func (m *ExportStateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportStateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportStateReq proto.InternalMessageInfo
+
+type ImportStateReq struct {
+	Policies []*common.Policy  `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	Roles    []*common.Role    `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Projects []*common.Project `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	// only report the changes, don't make them
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportStateReq) Reset()         { *m = ImportStateReq{} }
+func (m *ImportStateReq) String() string { return proto.CompactTextString(m) }
+func (*ImportStateReq) ProtoMessage()    {}
+func (*ImportStateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_36fdec534becf74e, []int{25}
+}
+func (m *ImportStateReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportStateReq.Unmarshal(m, b)
+}
+func (m *ImportStateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportStateReq.Marshal(b, m, deterministic)
+}
+func (dst *ImportStateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportStateReq.Merge(dst, src)
+}
+func (m *ImportStateReq) XXX_Size() int {
+	return xxx_messageInfo_ImportStateReq.Size(m)
+}
+func (m *ImportStateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportStateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportStateReq proto.InternalMessageInfo
+
+func (m *ImportStateReq) GetPolicies() []*common.Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ImportStateReq) GetRoles() []*common.Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ImportStateReq) GetProjects() []*common.Project {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ImportStateReq) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*CreatePolicyReq)(nil), "chef.automate.api.iam.v2beta.CreatePolicyReq")
 	proto.RegisterType((*DeletePolicyReq)(nil), "chef.automate.api.iam.v2beta.DeletePolicyReq")
@@ -993,44 +1291,70 @@ func init() {
 	proto.RegisterType((*CreateProjectReq)(nil), "chef.automate.api.iam.v2beta.CreateProjectReq")
 	proto.RegisterType((*UpdateProjectReq)(nil), "chef.automate.api.iam.v2beta.UpdateProjectReq")
 	proto.RegisterType((*DeleteProjectReq)(nil), "chef.automate.api.iam.v2beta.DeleteProjectReq")
+	proto.RegisterType((*ListDecisionsReq)(nil), "chef.automate.api.iam.v2beta.ListDecisionsReq")
+	proto.RegisterType((*ExplainAuthorizationReq)(nil), "chef.automate.api.iam.v2beta.ExplainAuthorizationReq")
+	proto.RegisterType((*ExportStateReq)(nil), "chef.automate.api.iam.v2beta.ExportStateReq")
+	proto.RegisterType((*ImportStateReq)(nil), "chef.automate.api.iam.v2beta.ImportStateReq")
+	proto.RegisterEnum("chef.automate.api.iam.v2beta.ListDecisionsReq_Result", ListDecisionsReq_Result_name, ListDecisionsReq_Result_value)
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/request/policy.proto", fileDescriptor_policy_a15a519e01facaa9)
-}
-
-var fileDescriptor_policy_a15a519e01facaa9 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x55, 0x93, 0x00, 0xcd, 0x90, 0x38, 0xc5, 0xe5, 0xc3, 0xaa, 0x2a, 0x1a, 0xf6, 0x00, 0xb9,
-	0x60, 0x8b, 0x20, 0xf5, 0x48, 0x45, 0x8b, 0x08, 0x12, 0x54, 0x42, 0x4b, 0x9b, 0x03, 0xb7, 0x8d,
-	0x3d, 0x75, 0x17, 0x79, 0xbd, 0x8e, 0x77, 0x53, 0xd4, 0xff, 0xc5, 0x0f, 0x44, 0xbb, 0x76, 0xac,
-	0x24, 0xaa, 0x2d, 0x85, 0x8a, 0x5b, 0xc6, 0x99, 0x37, 0xf3, 0xe6, 0xbd, 0x9d, 0x81, 0x93, 0x50,
-	0x8a, 0x4c, 0xa6, 0x98, 0x6a, 0x15, 0xb0, 0x85, 0x96, 0x82, 0x69, 0x7c, 0x1b, 0x33, 0x8d, 0xbf,
-	0xd9, 0x6d, 0xc0, 0x32, 0x1e, 0x70, 0x26, 0x82, 0x9b, 0xf1, 0x0c, 0x35, 0x0b, 0x72, 0x9c, 0x2f,
-	0x50, 0xe9, 0x20, 0x93, 0x09, 0x0f, 0x6f, 0xfd, 0x2c, 0x97, 0x5a, 0xba, 0x87, 0xe1, 0x35, 0x5e,
-	0xf9, 0x4b, 0xa8, 0xcf, 0x32, 0xee, 0x73, 0x26, 0xfc, 0x02, 0x72, 0xf0, 0x61, 0x8b, 0xf2, 0xa1,
-	0x14, 0x42, 0xa6, 0x6b, 0xd5, 0xc9, 0x9f, 0x1d, 0x18, 0x9c, 0xe5, 0xc8, 0x34, 0x7e, 0xb7, 0x9f,
-	0x29, 0xce, 0x5d, 0x07, 0x5a, 0x3c, 0xf2, 0x76, 0x86, 0x3b, 0xa3, 0x2e, 0x6d, 0xf1, 0xc8, 0x75,
-	0xa1, 0x93, 0x32, 0x81, 0x5e, 0xcb, 0x7e, 0xb1, 0xbf, 0x5d, 0x0f, 0x1e, 0x09, 0x14, 0x33, 0xcc,
-	0x95, 0xd7, 0x1e, 0xb6, 0x47, 0x5d, 0xba, 0x0c, 0xdd, 0x09, 0x80, 0xd2, 0x4c, 0xa3, 0x30, 0x9c,
-	0xbc, 0xce, 0xb0, 0x3d, 0x7a, 0x3c, 0x7e, 0xe3, 0x37, 0x0d, 0xe1, 0xff, 0x58, 0xe6, 0xd3, 0x15,
-	0xa8, 0x7b, 0x00, 0xbb, 0x59, 0x2e, 0x7f, 0x61, 0xa8, 0x95, 0xf7, 0xc0, 0xf6, 0xa8, 0x62, 0xf2,
-	0x0a, 0x06, 0x9f, 0x30, 0xc1, 0x06, 0xd6, 0xe4, 0x09, 0x0c, 0xbe, 0x71, 0xa5, 0x6d, 0x02, 0x47,
-	0x45, 0x71, 0x4e, 0x4e, 0x60, 0xff, 0x63, 0x14, 0x15, 0x90, 0xf3, 0x82, 0xee, 0x5d, 0xf3, 0xae,
-	0xcc, 0xd6, 0x5a, 0x9b, 0x8d, 0xbc, 0x84, 0xde, 0x04, 0x75, 0x7d, 0x4f, 0xa3, 0xe6, 0x65, 0x16,
-	0x35, 0xaa, 0x59, 0x5b, 0x7d, 0x43, 0xb9, 0xf6, 0xbf, 0x2b, 0xb7, 0x34, 0x6c, 0x77, 0xc5, 0xb0,
-	0x55, 0x35, 0xbb, 0x1b, 0x6a, 0x7e, 0x01, 0xe7, 0x32, 0x8b, 0x73, 0x16, 0xe1, 0x85, 0x9c, 0x8e,
-	0x0d, 0xe9, 0x63, 0xe8, 0x5c, 0x25, 0x2c, 0xb6, 0xb4, 0x9d, 0x31, 0x69, 0x26, 0xf1, 0x39, 0x61,
-	0x31, 0xb5, 0xf9, 0xe4, 0x19, 0xec, 0x57, 0x02, 0x4d, 0x31, 0x57, 0x5c, 0xa6, 0x46, 0x78, 0x07,
-	0x7a, 0x14, 0x15, 0xea, 0x0b, 0x39, 0x7d, 0x67, 0xe2, 0xd7, 0xf0, 0xb4, 0xf2, 0xa6, 0xc1, 0x09,
-	0x72, 0x06, 0x2f, 0x28, 0x66, 0x09, 0x0b, 0xf1, 0x1e, 0xa6, 0x9d, 0xc2, 0x73, 0x8a, 0x42, 0xde,
-	0xdc, 0xa7, 0x06, 0x87, 0x7e, 0xb1, 0x25, 0x54, 0x26, 0xb8, 0xc5, 0x8e, 0xb0, 0x50, 0x73, 0x99,
-	0x56, 0x3b, 0x52, 0x86, 0x6b, 0x66, 0x74, 0x36, 0xcc, 0x38, 0x04, 0x98, 0xa0, 0xae, 0xe9, 0x43,
-	0x8e, 0xa0, 0x5f, 0x3c, 0xfc, 0xba, 0x04, 0x0e, 0xfd, 0xe2, 0x05, 0xfe, 0x7f, 0xa6, 0x0e, 0xf4,
-	0x8c, 0x8b, 0xa6, 0x91, 0x5d, 0xaf, 0x23, 0xe8, 0x1b, 0xf3, 0x8b, 0xbf, 0x9b, 0x56, 0xb2, 0x2c,
-	0x60, 0x30, 0xc7, 0xb0, 0x57, 0x9e, 0x9f, 0x5a, 0xd8, 0x5d, 0x8c, 0x0d, 0xae, 0x5c, 0xb4, 0xed,
-	0x70, 0x04, 0xf6, 0xca, 0xc3, 0x51, 0x8b, 0x3b, 0x3d, 0xff, 0xf9, 0x35, 0xe6, 0xfa, 0x7a, 0x31,
-	0xf3, 0x43, 0x29, 0x02, 0xf3, 0xf4, 0xab, 0xd3, 0x1a, 0x6c, 0x7f, 0xcd, 0x67, 0x0f, 0xed, 0xa5,
-	0x7d, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xec, 0xda, 0xd7, 0xec, 0x0a, 0x06, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/request/policy.proto", fileDescriptor_policy_36fdec534becf74e)
+}
+
+var fileDescriptor_policy_36fdec534becf74e = []byte{
+	// 847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0xfd, 0x9b, 0x1c, 0x27, 0x8e, 0x99, 0x96, 0x66, 0x15, 0x2a, 0x62, 0x56, 0xfc, 0x58,
+	0x48, 0xec, 0x82, 0x11, 0x15, 0x57, 0x14, 0xb7, 0x36, 0x21, 0x22, 0x29, 0x68, 0x48, 0x83, 0xe0,
+	0xa6, 0x1a, 0xaf, 0x4f, 0x9c, 0x41, 0x3b, 0x3b, 0x9b, 0xd9, 0xd9, 0xd2, 0xf0, 0x1c, 0x3c, 0x0a,
+	0xaf, 0xc2, 0x3b, 0xf0, 0x18, 0x68, 0x66, 0xd6, 0xc6, 0x89, 0xea, 0x8d, 0x92, 0x8a, 0x3b, 0x9f,
+	0xf1, 0xf9, 0xce, 0xf9, 0xe6, 0x3b, 0x3f, 0xb3, 0xf0, 0x38, 0x96, 0x22, 0x93, 0x29, 0xa6, 0x3a,
+	0x8f, 0x58, 0xa1, 0xa5, 0x60, 0x1a, 0x3f, 0x9d, 0x33, 0x8d, 0xbf, 0xb3, 0xcb, 0x88, 0x65, 0x3c,
+	0xe2, 0x4c, 0x44, 0x2f, 0x87, 0x53, 0xd4, 0x2c, 0x52, 0x78, 0x51, 0x60, 0xae, 0xa3, 0x4c, 0x26,
+	0x3c, 0xbe, 0x0c, 0x33, 0x25, 0xb5, 0x24, 0x0f, 0xe3, 0x73, 0x3c, 0x0b, 0x17, 0xd0, 0x90, 0x65,
+	0x3c, 0xe4, 0x4c, 0x84, 0x0e, 0xb2, 0xf7, 0xf5, 0x2d, 0xc2, 0xc7, 0x52, 0x08, 0x99, 0x5e, 0x89,
+	0xbe, 0xb7, 0x3f, 0x97, 0x72, 0x9e, 0x60, 0x64, 0xad, 0x69, 0x71, 0x16, 0x69, 0x2e, 0x30, 0xd7,
+	0x4c, 0x64, 0xce, 0x21, 0xf8, 0xcb, 0x83, 0x9d, 0xa7, 0x0a, 0x99, 0xc6, 0x1f, 0x2d, 0x8e, 0xe2,
+	0x05, 0xe9, 0x42, 0x8d, 0xcf, 0x7c, 0xaf, 0xef, 0x0d, 0x36, 0x69, 0x8d, 0xcf, 0x08, 0x81, 0x46,
+	0xca, 0x04, 0xfa, 0x35, 0x7b, 0x62, 0x7f, 0x13, 0x1f, 0xda, 0x02, 0xc5, 0x14, 0x55, 0xee, 0xd7,
+	0xfb, 0xf5, 0xc1, 0x26, 0x5d, 0x98, 0xe4, 0x00, 0x20, 0xd7, 0x4c, 0xa3, 0x30, 0xa4, 0xfd, 0x46,
+	0xbf, 0x3e, 0xe8, 0x0c, 0x3f, 0x0e, 0xab, 0x6e, 0x19, 0xfe, 0xb4, 0xf0, 0xa7, 0x2b, 0x50, 0xb2,
+	0x07, 0x1b, 0x99, 0x92, 0xbf, 0x61, 0xac, 0x73, 0xbf, 0x69, 0x73, 0x2c, 0xed, 0xe0, 0x7d, 0xd8,
+	0x19, 0x63, 0x82, 0x15, 0xac, 0x83, 0xb7, 0x61, 0xe7, 0x88, 0xe7, 0xda, 0x3a, 0x70, 0xcc, 0x29,
+	0x5e, 0x04, 0x8f, 0xe1, 0xde, 0x68, 0x36, 0x73, 0x90, 0x63, 0x47, 0xf7, 0x75, 0xf7, 0x5d, 0xb9,
+	0x5b, 0xed, 0xca, 0xdd, 0x82, 0xf7, 0x60, 0xeb, 0x00, 0xf5, 0xfa, 0x9c, 0x46, 0xcd, 0xe7, 0xd9,
+	0xac, 0x52, 0xcd, 0xb5, 0xd1, 0xaf, 0x29, 0x57, 0xbf, 0xbb, 0x72, 0x8b, 0x82, 0x6d, 0xac, 0x14,
+	0x6c, 0x55, 0xcd, 0xcd, 0x6b, 0x6a, 0x7e, 0x07, 0xdd, 0xe7, 0xd9, 0x5c, 0xb1, 0x19, 0x9e, 0xc8,
+	0xd3, 0xa1, 0x21, 0xfd, 0x08, 0x1a, 0x67, 0x09, 0x9b, 0x5b, 0xda, 0xdd, 0x61, 0x50, 0x4d, 0xe2,
+	0xdb, 0x84, 0xcd, 0xa9, 0xf5, 0x0f, 0xde, 0x81, 0x7b, 0x4b, 0x81, 0x4e, 0x51, 0xe5, 0x5c, 0xa6,
+	0x46, 0xf8, 0x2e, 0x6c, 0x51, 0xcc, 0x51, 0x9f, 0xc8, 0xd3, 0xcf, 0x8d, 0xfd, 0x11, 0xdc, 0x5f,
+	0xd6, 0xa6, 0xa2, 0x12, 0xc1, 0x53, 0xd8, 0xa5, 0x98, 0x25, 0x2c, 0xc6, 0x37, 0x28, 0xda, 0x13,
+	0x78, 0x40, 0x51, 0xc8, 0x97, 0x6f, 0x12, 0x83, 0xc3, 0xb6, 0x9b, 0x12, 0x2a, 0x13, 0xbc, 0xc5,
+	0x8c, 0xb0, 0x58, 0x73, 0x99, 0x2e, 0x67, 0xa4, 0x34, 0xaf, 0x14, 0xa3, 0x71, 0xad, 0x18, 0x0f,
+	0x01, 0x0e, 0x50, 0xaf, 0xc9, 0x13, 0xec, 0xc3, 0xb6, 0x6b, 0xfc, 0x75, 0x0e, 0x1c, 0xb6, 0x5d,
+	0x07, 0xfe, 0xff, 0x4c, 0xbb, 0xb0, 0x65, 0xaa, 0x68, 0x12, 0xd9, 0xf1, 0xda, 0x87, 0x6d, 0x53,
+	0x7c, 0xf7, 0x77, 0xd5, 0x48, 0x96, 0x01, 0x0c, 0xe6, 0x11, 0xf4, 0xca, 0xf5, 0xb3, 0x16, 0xf6,
+	0x3a, 0xc6, 0x06, 0x57, 0x0e, 0xda, 0xed, 0x70, 0x01, 0xf4, 0xca, 0xc5, 0xb1, 0x9e, 0xe6, 0xdf,
+	0x35, 0xe8, 0x19, 0x9e, 0x63, 0x8c, 0xb9, 0xe9, 0x60, 0xdb, 0x2b, 0x3e, 0xb4, 0xf3, 0x62, 0x6a,
+	0x20, 0xa5, 0xe7, 0xc2, 0x24, 0x0f, 0xa0, 0xe5, 0xd4, 0x2a, 0x13, 0x95, 0x96, 0x91, 0x4e, 0x61,
+	0x2e, 0x0b, 0x15, 0xa3, 0x5f, 0xb7, 0xff, 0x2c, 0x6d, 0x72, 0x0c, 0x2d, 0x85, 0x79, 0x91, 0x68,
+	0xbf, 0x61, 0x27, 0xec, 0xcb, 0xea, 0x09, 0xbb, 0xce, 0x26, 0xa4, 0x16, 0x4c, 0xcb, 0x20, 0xe4,
+	0x33, 0x68, 0xe6, 0x3c, 0x8d, 0xd1, 0x6f, 0xf6, 0xbd, 0x41, 0x67, 0xb8, 0x17, 0xba, 0xb5, 0x1f,
+	0x2e, 0xd6, 0x7e, 0x78, 0xb2, 0x58, 0xfb, 0xd4, 0x39, 0x1a, 0x44, 0x91, 0x6a, 0x9e, 0xf8, 0xad,
+	0x9b, 0x11, 0xd6, 0x91, 0xdc, 0x87, 0x66, 0xc2, 0x05, 0xd7, 0x7e, 0xbb, 0xef, 0x0d, 0x9a, 0xd4,
+	0x19, 0xc1, 0x27, 0xd0, 0x72, 0x5c, 0x48, 0x1b, 0xea, 0xa3, 0x67, 0xbf, 0xf4, 0xde, 0x22, 0x1d,
+	0x68, 0x8f, 0x8e, 0x8e, 0x7e, 0xf8, 0x79, 0x32, 0xee, 0x79, 0x04, 0xa0, 0x35, 0x9e, 0x3c, 0x3b,
+	0x9c, 0x8c, 0x7b, 0xb5, 0xe0, 0xcf, 0x1a, 0xec, 0x4e, 0x5e, 0x65, 0x09, 0xe3, 0xe9, 0xa8, 0xd0,
+	0xe7, 0x52, 0xf1, 0x3f, 0x98, 0x76, 0x1b, 0xc2, 0x88, 0x55, 0xea, 0x99, 0xfb, 0x9e, 0xeb, 0xb3,
+	0x85, 0x7d, 0x27, 0x81, 0x2b, 0xfa, 0x96, 0x7c, 0x03, 0x1b, 0x59, 0xf9, 0x2a, 0xd8, 0x87, 0xa5,
+	0x33, 0xfc, 0xa0, 0x5a, 0xfe, 0x72, 0x99, 0x2f, 0x51, 0xe4, 0x5d, 0xd8, 0x74, 0x79, 0x5e, 0xf0,
+	0xcc, 0x2a, 0x68, 0xe8, 0xda, 0x83, 0xc3, 0x8c, 0x84, 0xd0, 0x30, 0xaf, 0xac, 0xdf, 0xbe, 0x51,
+	0x59, 0xeb, 0x17, 0xf4, 0xa0, 0x3b, 0x79, 0x95, 0x49, 0xa5, 0xed, 0x32, 0x37, 0x43, 0xf1, 0x8f,
+	0x07, 0xdd, 0x43, 0xb1, 0x7a, 0x74, 0x85, 0xb3, 0x77, 0x27, 0xce, 0x5f, 0x41, 0x53, 0x99, 0x49,
+	0xb5, 0xab, 0xad, 0x73, 0xd3, 0x4e, 0xb7, 0xdb, 0xc3, 0x01, 0xc8, 0x68, 0x45, 0x4b, 0xf7, 0x2a,
+	0x7d, 0x78, 0x43, 0xee, 0x72, 0xb6, 0xfe, 0x93, 0x7c, 0x17, 0xda, 0x33, 0x75, 0xf9, 0x42, 0x15,
+	0xa9, 0x6d, 0xf8, 0x0d, 0xda, 0x9a, 0xa9, 0x4b, 0x5a, 0xa4, 0x4f, 0x8e, 0x7f, 0xfd, 0x7e, 0xce,
+	0xf5, 0x79, 0x31, 0x0d, 0x63, 0x29, 0x22, 0x13, 0x75, 0xf9, 0x9d, 0x13, 0xdd, 0xfe, 0xd3, 0x6a,
+	0xda, 0xb2, 0x2a, 0x7f, 0xf1, 0xef, 0x00, 0x4a, 0xa2, 0x68, 0xf5, 0x97, 0x09, 0x00, 0x00,
 }
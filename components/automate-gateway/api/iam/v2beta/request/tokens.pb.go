@@ -19,11 +19,14 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type CreateTokenReq struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Active               bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
-	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Projects             []string `protobuf:"bytes,5,rep,name=projects,proto3" json:"projects,omitempty"`
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Active   bool     `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Value    string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Projects []string `protobuf:"bytes,5,rep,name=projects,proto3" json:"projects,omitempty"`
+	// RFC 3339 timestamp after which the token is no longer accepted. Tokens
+	// without one don't expire.
+	ExpiresAt            string   `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -33,7 +36,7 @@ func (m *CreateTokenReq) Reset()         { *m = CreateTokenReq{} }
 func (m *CreateTokenReq) String() string { return proto.CompactTextString(m) }
 func (*CreateTokenReq) ProtoMessage()    {}
 func (*CreateTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_e6ac700d926d65fa, []int{0}
+	return fileDescriptor_tokens_84a0bda419a137a6, []int{0}
 }
 func (m *CreateTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateTokenReq.Unmarshal(m, b)
@@ -88,6 +91,13 @@ func (m *CreateTokenReq) GetProjects() []string {
 	return nil
 }
 
+func (m *CreateTokenReq) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
 type GetTokenReq struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -99,7 +109,7 @@ func (m *GetTokenReq) Reset()         { *m = GetTokenReq{} }
 func (m *GetTokenReq) String() string { return proto.CompactTextString(m) }
 func (*GetTokenReq) ProtoMessage()    {}
 func (*GetTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_e6ac700d926d65fa, []int{1}
+	return fileDescriptor_tokens_84a0bda419a137a6, []int{1}
 }
 func (m *GetTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTokenReq.Unmarshal(m, b)
@@ -141,7 +151,7 @@ func (m *UpdateTokenReq) Reset()         { *m = UpdateTokenReq{} }
 func (m *UpdateTokenReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateTokenReq) ProtoMessage()    {}
 func (*UpdateTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_e6ac700d926d65fa, []int{2}
+	return fileDescriptor_tokens_84a0bda419a137a6, []int{2}
 }
 func (m *UpdateTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateTokenReq.Unmarshal(m, b)
@@ -200,7 +210,7 @@ func (m *DeleteTokenReq) Reset()         { *m = DeleteTokenReq{} }
 func (m *DeleteTokenReq) String() string { return proto.CompactTextString(m) }
 func (*DeleteTokenReq) ProtoMessage()    {}
 func (*DeleteTokenReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_e6ac700d926d65fa, []int{3}
+	return fileDescriptor_tokens_84a0bda419a137a6, []int{3}
 }
 func (m *DeleteTokenReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteTokenReq.Unmarshal(m, b)
@@ -237,7 +247,7 @@ func (m *ListTokensReq) Reset()         { *m = ListTokensReq{} }
 func (m *ListTokensReq) String() string { return proto.CompactTextString(m) }
 func (*ListTokensReq) ProtoMessage()    {}
 func (*ListTokensReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tokens_e6ac700d926d65fa, []int{4}
+	return fileDescriptor_tokens_84a0bda419a137a6, []int{4}
 }
 func (m *ListTokensReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListTokensReq.Unmarshal(m, b)
@@ -266,26 +276,27 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/request/tokens.proto", fileDescriptor_tokens_e6ac700d926d65fa)
-}
-
-var fileDescriptor_tokens_e6ac700d926d65fa = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x3f, 0x4b, 0xc4, 0x40,
-	0x10, 0xc5, 0xb9, 0xbf, 0xdc, 0x8d, 0x18, 0x61, 0x11, 0x09, 0xa2, 0x10, 0x52, 0xa5, 0x71, 0x17,
-	0xf4, 0x03, 0x08, 0x2a, 0x58, 0xa8, 0x4d, 0xd0, 0xc6, 0x6e, 0x92, 0x8c, 0x97, 0xd5, 0xdb, 0xec,
-	0x26, 0x3b, 0x89, 0xd8, 0xf8, 0xd9, 0xe5, 0x12, 0xbd, 0xee, 0x04, 0xc1, 0x6e, 0xde, 0x30, 0x8f,
-	0xf7, 0x98, 0x1f, 0x5c, 0xe6, 0xd6, 0x38, 0x5b, 0x51, 0xc5, 0x5e, 0x61, 0xcb, 0xd6, 0x20, 0xd3,
-	0xd9, 0x0a, 0x99, 0xde, 0xf1, 0x43, 0xa1, 0xd3, 0x4a, 0xa3, 0x51, 0xdd, 0x79, 0x46, 0x8c, 0xaa,
-	0xa1, 0xba, 0x25, 0xcf, 0x8a, 0xed, 0x1b, 0x55, 0x5e, 0xba, 0xc6, 0xb2, 0x15, 0x27, 0x79, 0x49,
-	0x2f, 0xf2, 0xc7, 0x2a, 0xd1, 0x69, 0xa9, 0xd1, 0xc8, 0xc1, 0x12, 0x7f, 0x42, 0x70, 0xdd, 0x10,
-	0x32, 0x3d, 0x6e, 0x3c, 0x29, 0xd5, 0x22, 0x80, 0xb1, 0x2e, 0xc2, 0x51, 0x34, 0x4a, 0x96, 0xe9,
-	0x58, 0x17, 0x42, 0xc0, 0xb4, 0x42, 0x43, 0xe1, 0xb8, 0xdf, 0xf4, 0xb3, 0x38, 0x82, 0x39, 0xe6,
-	0xac, 0x3b, 0x0a, 0x27, 0xd1, 0x28, 0x59, 0xa4, 0xdf, 0x4a, 0x1c, 0xc2, 0xac, 0xc3, 0x75, 0x4b,
-	0xe1, 0xb4, 0x3f, 0x1e, 0x84, 0x38, 0x86, 0x85, 0x6b, 0xec, 0x2b, 0xe5, 0xec, 0xc3, 0x59, 0x34,
-	0x49, 0x96, 0xe9, 0x56, 0xc7, 0xa7, 0xb0, 0x77, 0x4b, 0xbc, 0x2b, 0x3c, 0x2e, 0x21, 0x78, 0x72,
-	0xc5, 0x7f, 0xd5, 0xfb, 0xad, 0x48, 0x04, 0xc1, 0x0d, 0xad, 0x69, 0x77, 0x52, 0x7c, 0x00, 0xfb,
-	0xf7, 0xda, 0x0f, 0x5d, 0x7d, 0x4a, 0xf5, 0xd5, 0xc3, 0xf3, 0xdd, 0x4a, 0x73, 0xd9, 0x66, 0x32,
-	0xb7, 0x46, 0x6d, 0xde, 0xbc, 0x25, 0xa4, 0xfe, 0x4e, 0x2d, 0x9b, 0xf7, 0xbc, 0x2e, 0xbe, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x1e, 0x79, 0x73, 0x3c, 0xf2, 0x01, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/request/tokens.proto", fileDescriptor_tokens_84a0bda419a137a6)
+}
+
+var fileDescriptor_tokens_84a0bda419a137a6 = []byte{
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x49, 0xff, 0x68, 0xcf, 0xc7, 0x17, 0x61, 0x10, 0x19, 0xc4, 0x42, 0xc8, 0xaa, 0x1b,
+	0x67, 0x40, 0x2f, 0x40, 0xfc, 0x01, 0x17, 0xea, 0x26, 0xe8, 0xc6, 0x8d, 0x9c, 0xa6, 0xc7, 0x76,
+	0xb4, 0x93, 0x99, 0x66, 0x4e, 0xa2, 0x5e, 0x8c, 0xf7, 0x2a, 0x4d, 0x6a, 0x77, 0x15, 0x04, 0x77,
+	0xf3, 0xbe, 0xcc, 0xcb, 0x79, 0xe0, 0x81, 0xb3, 0xdc, 0x59, 0xef, 0x0a, 0x2a, 0x38, 0x68, 0xac,
+	0xd8, 0x59, 0x64, 0x3a, 0x9e, 0x23, 0xd3, 0x1b, 0x7e, 0x68, 0xf4, 0x46, 0x1b, 0xb4, 0xba, 0x3e,
+	0x99, 0x12, 0xa3, 0x2e, 0x69, 0x55, 0x51, 0x60, 0xcd, 0xee, 0x95, 0x8a, 0xa0, 0x7c, 0xe9, 0xd8,
+	0x89, 0xa3, 0x7c, 0x41, 0xcf, 0xea, 0x7b, 0xaa, 0xd0, 0x1b, 0x65, 0xd0, 0xaa, 0x76, 0x92, 0x7e,
+	0x46, 0x10, 0x5f, 0x96, 0x84, 0x4c, 0xf7, 0xeb, 0x51, 0x46, 0x2b, 0x11, 0x43, 0xc7, 0xcc, 0x64,
+	0x94, 0x44, 0x93, 0x51, 0xd6, 0x31, 0x33, 0x21, 0xa0, 0x57, 0xa0, 0x25, 0xd9, 0x69, 0x9a, 0xe6,
+	0x2d, 0x0e, 0x60, 0x80, 0x39, 0x9b, 0x9a, 0x64, 0x37, 0x89, 0x26, 0xc3, 0x6c, 0x93, 0xc4, 0x3e,
+	0xf4, 0x6b, 0x5c, 0x56, 0x24, 0x7b, 0xcd, 0xe7, 0x36, 0x88, 0x43, 0x18, 0xfa, 0xd2, 0xbd, 0x50,
+	0xce, 0x41, 0xf6, 0x93, 0xee, 0x64, 0x94, 0x6d, 0xb3, 0x18, 0x03, 0xd0, 0xbb, 0x37, 0x25, 0x85,
+	0x27, 0x64, 0x39, 0x68, 0x66, 0xa3, 0x4d, 0x73, 0xce, 0xe9, 0x18, 0xfe, 0x5d, 0x13, 0xef, 0x62,
+	0x4b, 0x17, 0x10, 0x3f, 0xf8, 0xd9, 0x5f, 0xd1, 0xff, 0xc0, 0x99, 0x26, 0x10, 0x5f, 0xd1, 0x92,
+	0x76, 0x5f, 0x4a, 0xf7, 0xe0, 0xff, 0xad, 0x09, 0x2d, 0x6b, 0xc8, 0x68, 0x75, 0x71, 0xf7, 0x78,
+	0x33, 0x37, 0xbc, 0xa8, 0xa6, 0x2a, 0x77, 0x56, 0xaf, 0x35, 0x6c, 0x0d, 0xea, 0xdf, 0x5b, 0x9d,
+	0x0e, 0x1a, 0x9f, 0xa7, 0x5f, 0x03, 0x00, 0xde, 0xf9, 0x01, 0x96, 0x12, 0x02, 0x00, 0x00,
 }
@@ -30,7 +30,7 @@ func (m *CreatePolicyResp) Reset()         { *m = CreatePolicyResp{} }
 func (m *CreatePolicyResp) String() string { return proto.CompactTextString(m) }
 func (*CreatePolicyResp) ProtoMessage()    {}
 func (*CreatePolicyResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{0}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{0}
 }
 func (m *CreatePolicyResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreatePolicyResp.Unmarshal(m, b)
@@ -68,7 +68,7 @@ func (m *GetPolicyResp) Reset()         { *m = GetPolicyResp{} }
 func (m *GetPolicyResp) String() string { return proto.CompactTextString(m) }
 func (*GetPolicyResp) ProtoMessage()    {}
 func (*GetPolicyResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{1}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{1}
 }
 func (m *GetPolicyResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPolicyResp.Unmarshal(m, b)
@@ -106,7 +106,7 @@ func (m *UpdatePolicyResp) Reset()         { *m = UpdatePolicyResp{} }
 func (m *UpdatePolicyResp) String() string { return proto.CompactTextString(m) }
 func (*UpdatePolicyResp) ProtoMessage()    {}
 func (*UpdatePolicyResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{2}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{2}
 }
 func (m *UpdatePolicyResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdatePolicyResp.Unmarshal(m, b)
@@ -144,7 +144,7 @@ func (m *ListPoliciesResp) Reset()         { *m = ListPoliciesResp{} }
 func (m *ListPoliciesResp) String() string { return proto.CompactTextString(m) }
 func (*ListPoliciesResp) ProtoMessage()    {}
 func (*ListPoliciesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{3}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{3}
 }
 func (m *ListPoliciesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPoliciesResp.Unmarshal(m, b)
@@ -182,7 +182,7 @@ func (m *AddPolicyMembersResp) Reset()         { *m = AddPolicyMembersResp{} }
 func (m *AddPolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*AddPolicyMembersResp) ProtoMessage()    {}
 func (*AddPolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{4}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{4}
 }
 func (m *AddPolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddPolicyMembersResp.Unmarshal(m, b)
@@ -219,7 +219,7 @@ func (m *DeletePolicyResp) Reset()         { *m = DeletePolicyResp{} }
 func (m *DeletePolicyResp) String() string { return proto.CompactTextString(m) }
 func (*DeletePolicyResp) ProtoMessage()    {}
 func (*DeletePolicyResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{5}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{5}
 }
 func (m *DeletePolicyResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeletePolicyResp.Unmarshal(m, b)
@@ -250,7 +250,7 @@ func (m *UpgradeToV2Resp) Reset()         { *m = UpgradeToV2Resp{} }
 func (m *UpgradeToV2Resp) String() string { return proto.CompactTextString(m) }
 func (*UpgradeToV2Resp) ProtoMessage()    {}
 func (*UpgradeToV2Resp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{6}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{6}
 }
 func (m *UpgradeToV2Resp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpgradeToV2Resp.Unmarshal(m, b)
@@ -288,7 +288,7 @@ func (m *GetPolicyVersionResp) Reset()         { *m = GetPolicyVersionResp{} }
 func (m *GetPolicyVersionResp) String() string { return proto.CompactTextString(m) }
 func (*GetPolicyVersionResp) ProtoMessage()    {}
 func (*GetPolicyVersionResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{7}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{7}
 }
 func (m *GetPolicyVersionResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetPolicyVersionResp.Unmarshal(m, b)
@@ -325,7 +325,7 @@ func (m *ResetToV1Resp) Reset()         { *m = ResetToV1Resp{} }
 func (m *ResetToV1Resp) String() string { return proto.CompactTextString(m) }
 func (*ResetToV1Resp) ProtoMessage()    {}
 func (*ResetToV1Resp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{8}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{8}
 }
 func (m *ResetToV1Resp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetToV1Resp.Unmarshal(m, b)
@@ -356,7 +356,7 @@ func (m *ListPolicyMembersResp) Reset()         { *m = ListPolicyMembersResp{} }
 func (m *ListPolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyMembersResp) ProtoMessage()    {}
 func (*ListPolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{9}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{9}
 }
 func (m *ListPolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPolicyMembersResp.Unmarshal(m, b)
@@ -394,7 +394,7 @@ func (m *ReplacePolicyMembersResp) Reset()         { *m = ReplacePolicyMembersRe
 func (m *ReplacePolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*ReplacePolicyMembersResp) ProtoMessage()    {}
 func (*ReplacePolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{10}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{10}
 }
 func (m *ReplacePolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReplacePolicyMembersResp.Unmarshal(m, b)
@@ -432,7 +432,7 @@ func (m *RemovePolicyMembersResp) Reset()         { *m = RemovePolicyMembersResp
 func (m *RemovePolicyMembersResp) String() string { return proto.CompactTextString(m) }
 func (*RemovePolicyMembersResp) ProtoMessage()    {}
 func (*RemovePolicyMembersResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{11}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{11}
 }
 func (m *RemovePolicyMembersResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemovePolicyMembersResp.Unmarshal(m, b)
@@ -470,7 +470,7 @@ func (m *CreateRoleResp) Reset()         { *m = CreateRoleResp{} }
 func (m *CreateRoleResp) String() string { return proto.CompactTextString(m) }
 func (*CreateRoleResp) ProtoMessage()    {}
 func (*CreateRoleResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{12}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{12}
 }
 func (m *CreateRoleResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRoleResp.Unmarshal(m, b)
@@ -508,7 +508,7 @@ func (m *GetRoleResp) Reset()         { *m = GetRoleResp{} }
 func (m *GetRoleResp) String() string { return proto.CompactTextString(m) }
 func (*GetRoleResp) ProtoMessage()    {}
 func (*GetRoleResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{13}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{13}
 }
 func (m *GetRoleResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRoleResp.Unmarshal(m, b)
@@ -545,7 +545,7 @@ func (m *DeleteRoleResp) Reset()         { *m = DeleteRoleResp{} }
 func (m *DeleteRoleResp) String() string { return proto.CompactTextString(m) }
 func (*DeleteRoleResp) ProtoMessage()    {}
 func (*DeleteRoleResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{14}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{14}
 }
 func (m *DeleteRoleResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRoleResp.Unmarshal(m, b)
@@ -576,7 +576,7 @@ func (m *UpdateRoleResp) Reset()         { *m = UpdateRoleResp{} }
 func (m *UpdateRoleResp) String() string { return proto.CompactTextString(m) }
 func (*UpdateRoleResp) ProtoMessage()    {}
 func (*UpdateRoleResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{15}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{15}
 }
 func (m *UpdateRoleResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateRoleResp.Unmarshal(m, b)
@@ -614,7 +614,7 @@ func (m *ListRolesResp) Reset()         { *m = ListRolesResp{} }
 func (m *ListRolesResp) String() string { return proto.CompactTextString(m) }
 func (*ListRolesResp) ProtoMessage()    {}
 func (*ListRolesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{16}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{16}
 }
 func (m *ListRolesResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRolesResp.Unmarshal(m, b)
@@ -652,7 +652,7 @@ func (m *GetProjectResp) Reset()         { *m = GetProjectResp{} }
 func (m *GetProjectResp) String() string { return proto.CompactTextString(m) }
 func (*GetProjectResp) ProtoMessage()    {}
 func (*GetProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{17}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{17}
 }
 func (m *GetProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectResp.Unmarshal(m, b)
@@ -690,7 +690,7 @@ func (m *ListProjectsResp) Reset()         { *m = ListProjectsResp{} }
 func (m *ListProjectsResp) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsResp) ProtoMessage()    {}
 func (*ListProjectsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{18}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{18}
 }
 func (m *ListProjectsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsResp.Unmarshal(m, b)
@@ -728,7 +728,7 @@ func (m *CreateProjectResp) Reset()         { *m = CreateProjectResp{} }
 func (m *CreateProjectResp) String() string { return proto.CompactTextString(m) }
 func (*CreateProjectResp) ProtoMessage()    {}
 func (*CreateProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{19}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{19}
 }
 func (m *CreateProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateProjectResp.Unmarshal(m, b)
@@ -766,7 +766,7 @@ func (m *UpdateProjectResp) Reset()         { *m = UpdateProjectResp{} }
 func (m *UpdateProjectResp) String() string { return proto.CompactTextString(m) }
 func (*UpdateProjectResp) ProtoMessage()    {}
 func (*UpdateProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{20}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{20}
 }
 func (m *UpdateProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateProjectResp.Unmarshal(m, b)
@@ -803,7 +803,7 @@ func (m *DeleteProjectResp) Reset()         { *m = DeleteProjectResp{} }
 func (m *DeleteProjectResp) String() string { return proto.CompactTextString(m) }
 func (*DeleteProjectResp) ProtoMessage()    {}
 func (*DeleteProjectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_policy_01c6180e29f95ec6, []int{21}
+	return fileDescriptor_policy_8b9825101738dc4d, []int{21}
 }
 func (m *DeleteProjectResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteProjectResp.Unmarshal(m, b)
@@ -823,6 +823,193 @@ func (m *DeleteProjectResp) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteProjectResp proto.InternalMessageInfo
 
+type ListDecisionsResp struct {
+	Decisions            []*common.Decision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListDecisionsResp) Reset()         { *m = ListDecisionsResp{} }
+func (m *ListDecisionsResp) String() string { return proto.CompactTextString(m) }
+func (*ListDecisionsResp) ProtoMessage()    {}
+func (*ListDecisionsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8b9825101738dc4d, []int{22}
+}
+func (m *ListDecisionsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDecisionsResp.Unmarshal(m, b)
+}
+func (m *ListDecisionsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDecisionsResp.Marshal(b, m, deterministic)
+}
+func (dst *ListDecisionsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDecisionsResp.Merge(dst, src)
+}
+func (m *ListDecisionsResp) XXX_Size() int {
+	return xxx_messageInfo_ListDecisionsResp.Size(m)
+}
+func (m *ListDecisionsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDecisionsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDecisionsResp proto.InternalMessageInfo
+
+func (m *ListDecisionsResp) GetDecisions() []*common.Decision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+type ExplainAuthorizationResp struct {
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	// the requested projects that are allowed, or all allowed projects if none
+	// were requested
+	Projects []string `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	// the statements that matched the request, allowing or denying it
+	Statements           []*common.ExplainedStatement `protobuf:"bytes,3,rep,name=statements,proto3" json:"statements,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ExplainAuthorizationResp) Reset()         { *m = ExplainAuthorizationResp{} }
+func (m *ExplainAuthorizationResp) String() string { return proto.CompactTextString(m) }
+func (*ExplainAuthorizationResp) ProtoMessage()    {}
+func (*ExplainAuthorizationResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8b9825101738dc4d, []int{23}
+}
+func (m *ExplainAuthorizationResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainAuthorizationResp.Unmarshal(m, b)
+}
+func (m *ExplainAuthorizationResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainAuthorizationResp.Marshal(b, m, deterministic)
+}
+func (dst *ExplainAuthorizationResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainAuthorizationResp.Merge(dst, src)
+}
+func (m *ExplainAuthorizationResp) XXX_Size() int {
+	return xxx_messageInfo_ExplainAuthorizationResp.Size(m)
+}
+func (m *ExplainAuthorizationResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainAuthorizationResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainAuthorizationResp proto.InternalMessageInfo
+
+func (m *ExplainAuthorizationResp) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
+	}
+	return false
+}
+
+func (m *ExplainAuthorizationResp) GetProjects() []string {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationResp) GetStatements() []*common.ExplainedStatement {
+	if m != nil {
+		return m.Statements
+	}
+	return nil
+}
+
+type ExportStateResp struct {
+	Policies             []*common.Policy  `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	Roles                []*common.Role    `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Projects             []*common.Project `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportStateResp) Reset()         { *m = ExportStateResp{} }
+func (m *ExportStateResp) String() string { return proto.CompactTextString(m) }
+func (*ExportStateResp) ProtoMessage()    {}
+func (*ExportStateResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8b9825101738dc4d, []int{24}
+}
+func (m *ExportStateResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportStateResp.Unmarshal(m, b)
+}
+func (m *ExportStateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportStateResp.Marshal(b, m, deterministic)
+}
+func (dst *ExportStateResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportStateResp.Merge(dst, src)
+}
+func (m *ExportStateResp) XXX_Size() int {
+	return xxx_messageInfo_ExportStateResp.Size(m)
+}
+func (m *ExportStateResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportStateResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportStateResp proto.InternalMessageInfo
+
+func (m *ExportStateResp) GetPolicies() []*common.Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *ExportStateResp) GetRoles() []*common.Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ExportStateResp) GetProjects() []*common.Project {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+type ImportStateResp struct {
+	Changes              []*common.StateChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ImportStateResp) Reset()         { *m = ImportStateResp{} }
+func (m *ImportStateResp) String() string { return proto.CompactTextString(m) }
+func (*ImportStateResp) ProtoMessage()    {}
+func (*ImportStateResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_policy_8b9825101738dc4d, []int{25}
+}
+func (m *ImportStateResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportStateResp.Unmarshal(m, b)
+}
+func (m *ImportStateResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportStateResp.Marshal(b, m, deterministic)
+}
+func (dst *ImportStateResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportStateResp.Merge(dst, src)
+}
+func (m *ImportStateResp) XXX_Size() int {
+	return xxx_messageInfo_ImportStateResp.Size(m)
+}
+func (m *ImportStateResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportStateResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportStateResp proto.InternalMessageInfo
+
+func (m *ImportStateResp) GetChanges() []*common.StateChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreatePolicyResp)(nil), "chef.automate.api.iam.v2beta.CreatePolicyResp")
 	proto.RegisterType((*GetPolicyResp)(nil), "chef.automate.api.iam.v2beta.GetPolicyResp")
@@ -846,43 +1033,56 @@ func init() {
 	proto.RegisterType((*CreateProjectResp)(nil), "chef.automate.api.iam.v2beta.CreateProjectResp")
 	proto.RegisterType((*UpdateProjectResp)(nil), "chef.automate.api.iam.v2beta.UpdateProjectResp")
 	proto.RegisterType((*DeleteProjectResp)(nil), "chef.automate.api.iam.v2beta.DeleteProjectResp")
+	proto.RegisterType((*ListDecisionsResp)(nil), "chef.automate.api.iam.v2beta.ListDecisionsResp")
+	proto.RegisterType((*ExplainAuthorizationResp)(nil), "chef.automate.api.iam.v2beta.ExplainAuthorizationResp")
+	proto.RegisterType((*ExportStateResp)(nil), "chef.automate.api.iam.v2beta.ExportStateResp")
+	proto.RegisterType((*ImportStateResp)(nil), "chef.automate.api.iam.v2beta.ImportStateResp")
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/response/policy.proto", fileDescriptor_policy_01c6180e29f95ec6)
-}
-
-var fileDescriptor_policy_01c6180e29f95ec6 = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xdf, 0x6b, 0x13, 0x41,
-	0x10, 0xc7, 0x09, 0x6a, 0x5b, 0xa7, 0x24, 0xbd, 0xc4, 0x8a, 0x41, 0x7c, 0x28, 0x8b, 0x82, 0x20,
-	0xde, 0xd9, 0x54, 0xc4, 0x07, 0xd1, 0xd6, 0x1f, 0x54, 0xa1, 0x95, 0x78, 0x5c, 0x22, 0xf8, 0xb6,
-	0xb9, 0x8c, 0xe9, 0xca, 0xed, 0xcd, 0xb2, 0xb7, 0x8d, 0xf4, 0xbf, 0x97, 0xdb, 0x1f, 0xa7, 0xbe,
-	0x84, 0x4b, 0x9b, 0xc7, 0xd9, 0xdb, 0xcf, 0x87, 0xef, 0xec, 0xcd, 0xed, 0xc1, 0x71, 0x4e, 0x52,
-	0x51, 0x89, 0xa5, 0xa9, 0x12, 0x7e, 0x69, 0x48, 0x72, 0x83, 0xcf, 0x17, 0xdc, 0xe0, 0x6f, 0x7e,
-	0x95, 0x70, 0x25, 0x12, 0xc1, 0x65, 0xb2, 0x1c, 0xcd, 0xd0, 0xf0, 0x44, 0x63, 0xa5, 0xa8, 0xac,
-	0x30, 0x51, 0x54, 0x88, 0xfc, 0x2a, 0x56, 0x9a, 0x0c, 0x0d, 0x1e, 0xe5, 0x17, 0xf8, 0x33, 0x0e,
-	0x6c, 0xcc, 0x95, 0x88, 0x05, 0x97, 0xb1, 0x63, 0x1e, 0xbe, 0x5d, 0xc3, 0x9f, 0x93, 0x94, 0x54,
-	0xfe, 0x67, 0x67, 0x63, 0x88, 0x3e, 0x68, 0xe4, 0x06, 0xc7, 0x76, 0x35, 0xc5, 0x4a, 0x0d, 0xde,
-	0xc0, 0x96, 0xdb, 0x33, 0xec, 0x1c, 0x74, 0x9e, 0xee, 0x8e, 0x1e, 0xc7, 0xab, 0x22, 0xc4, 0x9e,
-	0xf4, 0x0c, 0x3b, 0x87, 0xee, 0x29, 0x9a, 0x8d, 0xe9, 0xc6, 0x10, 0x4d, 0xd4, 0x7c, 0x93, 0x01,
-	0x33, 0x88, 0xce, 0x44, 0xe5, 0x12, 0x0a, 0xac, 0xac, 0xf1, 0x18, 0x76, 0x94, 0xaf, 0x87, 0x9d,
-	0x83, 0x5b, 0xad, 0x9d, 0x0d, 0xc5, 0x5e, 0xc0, 0xfe, 0xc9, 0x7c, 0xee, 0x96, 0xcf, 0x51, 0xce,
-	0x50, 0x3b, 0xf3, 0x10, 0xb6, 0xa5, 0x2b, 0xad, 0xf8, 0x6e, 0x1a, 0x4a, 0x36, 0x80, 0xe8, 0x23,
-	0x16, 0xf8, 0x6f, 0x67, 0xec, 0x19, 0xec, 0x4d, 0xd4, 0x42, 0xf3, 0x39, 0x66, 0x34, 0x1d, 0x05,
-	0x81, 0x46, 0x45, 0xda, 0x34, 0x02, 0x5f, 0xb2, 0xef, 0xb0, 0xdf, 0x9c, 0xf4, 0x14, 0x75, 0x25,
-	0xa8, 0xb4, 0xc4, 0x3b, 0xd8, 0x5e, 0xba, 0xd2, 0x9f, 0xcf, 0x93, 0xd5, 0xbd, 0x04, 0x36, 0x50,
-	0x6c, 0x0f, 0xba, 0x29, 0x56, 0x68, 0x32, 0x9a, 0x1e, 0xda, 0x58, 0x87, 0x70, 0xbf, 0x39, 0xb2,
-	0x96, 0xdd, 0xbd, 0x84, 0x61, 0x8a, 0xaa, 0xe0, 0x39, 0xae, 0x43, 0x1d, 0xc1, 0x83, 0x14, 0x25,
-	0x2d, 0xd7, 0x82, 0x3e, 0x43, 0xcf, 0xcd, 0x70, 0x4a, 0x05, 0xda, 0xbd, 0xaf, 0xe0, 0xb6, 0xa6,
-	0x02, 0x7d, 0xfb, 0x6c, 0x75, 0xfb, 0x96, 0xb2, 0xfb, 0xd9, 0x27, 0xd8, 0x3d, 0x45, 0x73, 0x63,
-	0x4d, 0x04, 0x3d, 0xf7, 0x66, 0x83, 0xa9, 0x8e, 0xe8, 0xa6, 0xf8, 0xc6, 0xee, 0x2f, 0xd0, 0xad,
-	0x5f, 0x45, 0xbd, 0xe2, 0xce, 0xe5, 0x35, 0xdc, 0xa9, 0x1f, 0x84, 0xb9, 0x6d, 0x63, 0x72, 0x00,
-	0xfb, 0x06, 0xbd, 0x7a, 0x7e, 0x34, 0xfd, 0xc2, 0xdc, 0x84, 0xc9, 0x51, 0xae, 0x6c, 0x37, 0x39,
-	0x81, 0x0d, 0x14, 0x9b, 0xf8, 0x6f, 0xcb, 0x95, 0x2e, 0xe0, 0x09, 0xec, 0xf8, 0xc7, 0x21, 0x63,
-	0x4b, 0x6b, 0x83, 0xb1, 0x0c, 0xfa, 0xfe, 0x96, 0xda, 0x64, 0xd8, 0x0c, 0xfa, 0xfe, 0x6a, 0xd9,
-	0xa4, 0xf5, 0x1e, 0xf4, 0xfd, 0x67, 0xfd, 0xd7, 0xfa, 0xfe, 0xeb, 0x8f, 0xb3, 0x85, 0x30, 0x17,
-	0x97, 0xb3, 0x38, 0x27, 0x99, 0xd4, 0xc2, 0xe6, 0xb6, 0x4e, 0xae, 0xf1, 0x87, 0x98, 0x6d, 0xd9,
-	0xdb, 0xfb, 0xe8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x96, 0x2e, 0x4b, 0x5f, 0x06, 0x00,
-	0x00,
+	proto.RegisterFile("components/automate-gateway/api/iam/v2beta/response/policy.proto", fileDescriptor_policy_8b9825101738dc4d)
+}
+
+var fileDescriptor_policy_8b9825101738dc4d = []byte{
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdb, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0x95, 0xf6, 0xfb, 0x7a, 0x98, 0xaa, 0xcd, 0x81, 0x22, 0xac, 0x0a, 0xa1, 0x6a, 0x05,
+	0x08, 0x84, 0xb0, 0x7b, 0x40, 0x88, 0x0b, 0x04, 0x2d, 0x6d, 0x55, 0x2a, 0xb5, 0x28, 0x98, 0x34,
+	0x08, 0xee, 0x36, 0xf6, 0x90, 0x2c, 0xf2, 0x7a, 0x57, 0xf6, 0x36, 0xb4, 0x3c, 0x11, 0x8f, 0xc3,
+	0x23, 0x21, 0xef, 0xc1, 0x49, 0x6e, 0xac, 0xa4, 0xcd, 0xe5, 0x8c, 0xfd, 0xfb, 0xe7, 0x3f, 0xe3,
+	0x99, 0xdd, 0xc0, 0x41, 0x24, 0xb8, 0x14, 0x29, 0xa6, 0x2a, 0x0f, 0xe8, 0x95, 0x12, 0x9c, 0x2a,
+	0x7c, 0xd9, 0xa7, 0x0a, 0x7f, 0xd1, 0x9b, 0x80, 0x4a, 0x16, 0x30, 0xca, 0x83, 0xe1, 0x5e, 0x0f,
+	0x15, 0x0d, 0x32, 0xcc, 0xa5, 0x48, 0x73, 0x0c, 0xa4, 0x48, 0x58, 0x74, 0xe3, 0xcb, 0x4c, 0x28,
+	0xd1, 0x7a, 0x18, 0x0d, 0xf0, 0x87, 0xef, 0x58, 0x9f, 0x4a, 0xe6, 0x33, 0xca, 0x7d, 0xc3, 0x6c,
+	0xbd, 0x9b, 0x41, 0x3f, 0x12, 0x9c, 0x8b, 0x74, 0x42, 0x9d, 0xb4, 0xa1, 0x71, 0x94, 0x21, 0x55,
+	0xd8, 0xd6, 0xd9, 0x10, 0x73, 0xd9, 0x7a, 0x0b, 0x4b, 0xe6, 0x1d, 0xaf, 0xb6, 0x5d, 0x7b, 0xb6,
+	0xb6, 0xf7, 0xd8, 0xaf, 0xb2, 0xe0, 0x5b, 0xd2, 0x32, 0xe4, 0x02, 0xd6, 0x4f, 0x51, 0xcd, 0x4d,
+	0xae, 0x0d, 0x8d, 0x4b, 0x19, 0xcf, 0xd3, 0x60, 0x07, 0x1a, 0xe7, 0x2c, 0x37, 0x0e, 0x19, 0xe6,
+	0x5a, 0xf1, 0x00, 0x56, 0xa4, 0x8d, 0xbd, 0xda, 0xf6, 0xe2, 0xd4, 0x9a, 0x25, 0x45, 0x76, 0x60,
+	0xf3, 0x30, 0x8e, 0x4d, 0xfa, 0x02, 0x79, 0x0f, 0x33, 0xa3, 0xec, 0xc1, 0x32, 0x37, 0xa1, 0x16,
+	0x5e, 0x0d, 0x5d, 0x48, 0x5a, 0xd0, 0x38, 0xc6, 0x04, 0xc7, 0x2b, 0x23, 0x2f, 0xa0, 0x7e, 0x29,
+	0xfb, 0x19, 0x8d, 0xb1, 0x23, 0xba, 0x7b, 0x4e, 0x20, 0x43, 0x29, 0x32, 0x55, 0x0a, 0xd8, 0x90,
+	0x7c, 0x85, 0xcd, 0xb2, 0xd3, 0x5d, 0xcc, 0x72, 0x26, 0x52, 0x4d, 0xbc, 0x87, 0xe5, 0xa1, 0x09,
+	0x6d, 0x7f, 0x9e, 0x54, 0xd7, 0xe2, 0x58, 0x47, 0x91, 0x3a, 0xac, 0x87, 0x98, 0xa3, 0xea, 0x88,
+	0xee, 0xae, 0xb6, 0xb5, 0x0b, 0xf7, 0xcb, 0x96, 0x4d, 0x59, 0xdd, 0x2b, 0xf0, 0x42, 0x94, 0x09,
+	0x8d, 0x70, 0x16, 0x6a, 0x1f, 0x1e, 0x84, 0xc8, 0xc5, 0x70, 0x26, 0xe8, 0x23, 0x6c, 0x98, 0x19,
+	0x0e, 0x45, 0x82, 0xfa, 0xdd, 0xd7, 0xf0, 0x5f, 0x26, 0x12, 0xb4, 0xe5, 0x93, 0xea, 0xf2, 0x35,
+	0xa5, 0xdf, 0x27, 0x27, 0xb0, 0x76, 0x8a, 0xea, 0xce, 0x32, 0x0d, 0xd8, 0x30, 0x5f, 0xd6, 0x29,
+	0x15, 0x16, 0xcd, 0x14, 0xdf, 0x59, 0xfb, 0x0c, 0xd6, 0x8b, 0x4f, 0x51, 0x64, 0x4c, 0x5f, 0xde,
+	0xc0, 0xff, 0xc5, 0x03, 0x37, 0xb7, 0xd3, 0x28, 0x19, 0x80, 0x7c, 0x86, 0x8d, 0x62, 0x7e, 0x32,
+	0xf1, 0x13, 0x23, 0xe5, 0x26, 0x47, 0x9a, 0x70, 0xba, 0xc9, 0x71, 0xac, 0xa3, 0xc8, 0xa5, 0xdd,
+	0x2d, 0x13, 0x1a, 0x83, 0x87, 0xb0, 0x62, 0x1f, 0x3b, 0x8f, 0x53, 0xaa, 0x96, 0x18, 0xe9, 0x40,
+	0xd3, 0x9e, 0x52, 0xf3, 0x34, 0xdb, 0x81, 0xa6, 0x3d, 0x5a, 0xe6, 0xa9, 0x7a, 0x0f, 0x9a, 0x76,
+	0xad, 0x47, 0xaa, 0xe4, 0x1b, 0x34, 0x8b, 0xbe, 0x1c, 0x63, 0xc4, 0x8a, 0x0d, 0x33, 0x8d, 0x39,
+	0x86, 0xd5, 0xd8, 0x25, 0x6c, 0x67, 0x9e, 0x56, 0xff, 0x98, 0xe3, 0xc3, 0x11, 0x48, 0xfe, 0xd4,
+	0xc0, 0x3b, 0xb9, 0x96, 0x09, 0x65, 0xe9, 0xe1, 0x95, 0x1a, 0x88, 0x8c, 0xfd, 0xa6, 0xca, 0x1d,
+	0x05, 0x8f, 0x00, 0xa8, 0x4d, 0x62, 0xac, 0x0b, 0x5a, 0x09, 0xc7, 0x32, 0xad, 0xad, 0xb1, 0x6f,
+	0xb3, 0xa0, 0xb7, 0xaa, 0x8c, 0x5b, 0x6d, 0x80, 0x5c, 0x51, 0x85, 0xbc, 0xb8, 0x5c, 0xbc, 0x45,
+	0xed, 0x6f, 0xa7, 0xda, 0x9f, 0xf5, 0x81, 0xf1, 0x17, 0x07, 0x86, 0x63, 0x1a, 0xe4, 0x6f, 0x0d,
+	0xea, 0x27, 0xd7, 0xc5, 0xe1, 0xa5, 0x9f, 0xcf, 0xe7, 0xe4, 0x1d, 0x2d, 0xc0, 0xc2, 0x8c, 0x0b,
+	0x30, 0x31, 0x99, 0x8b, 0xb7, 0x9b, 0xcc, 0x2e, 0xd4, 0xcf, 0xf8, 0x64, 0x45, 0x47, 0xb0, 0x1c,
+	0x0d, 0x68, 0xda, 0x2f, 0x0b, 0x7a, 0x5e, 0x2d, 0xaa, 0xc9, 0x23, 0x4d, 0x84, 0x8e, 0xfc, 0xf0,
+	0xe9, 0xfb, 0x79, 0x9f, 0xa9, 0xc1, 0x55, 0xcf, 0x8f, 0x04, 0x0f, 0x0a, 0xbe, 0xbc, 0xde, 0x83,
+	0x5b, 0xfc, 0xa5, 0xe8, 0x2d, 0xe9, 0xeb, 0x7e, 0xff, 0xdf, 0x00, 0x89, 0xf4, 0xdb, 0x48, 0x90,
+	0x08, 0x00, 0x00,
 }
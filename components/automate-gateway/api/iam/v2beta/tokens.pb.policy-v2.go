@@ -19,6 +19,8 @@ func init() {
 					return m.Name
 				case "value":
 					return m.Value
+				case "expires_at":
+					return m.ExpiresAt
 				default:
 					return ""
 				}
@@ -18,6 +18,103 @@ func init() {
     "application/json"
   ],
   "paths": {
+    "/iam/v2beta/decisions": {
+      "get": {
+        "operationId": "ListDecisions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2betaListDecisionsResp"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "subject",
+            "description": "only list decisions made for requests including this subject.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "action",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "resource",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "result",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "enum": [
+              "ANY",
+              "ALLOWED",
+              "DENIED"
+            ],
+            "default": "ANY"
+          },
+          {
+            "name": "since",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "until",
+            "in": "query",
+            "required": false,
+            "type": "string",
+            "format": "date-time"
+          },
+          {
+            "name": "limit",
+            "description": "maximum number of decisions listed, most recent first; defaults to 100.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          }
+        ],
+        "tags": [
+          "Policies"
+        ]
+      }
+    },
+    "/iam/v2beta/explain": {
+      "post": {
+        "operationId": "ExplainAuthorization",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/v2betaExplainAuthorizationResp"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/v2betaExplainAuthorizationReq"
+            }
+          }
+        ],
+        "tags": [
+          "Policies"
+        ]
+      }
+    },
     "/iam/v2beta/policies": {
       "get": {
         "operationId": "ListPolicies",
@@ -502,6 +599,26 @@ func init() {
     }
   },
   "definitions": {
+    "ListDecisionsReqResult": {
+      "type": "string",
+      "enum": [
+        "ANY",
+        "ALLOWED",
+        "DENIED"
+      ],
+      "default": "ANY"
+    },
+    "StateChangeOperation": {
+      "type": "string",
+      "enum": [
+        "CREATE",
+        "UPDATE",
+        "DELETE",
+        "SKIP"
+      ],
+      "default": "CREATE",
+      "title": "- SKIP: chef-managed objects are never changed"
+    },
     "StatementEffect": {
       "type": "string",
       "enum": [
@@ -552,6 +669,33 @@ func init() {
         }
       }
     },
+    "v2betaConditions": {
+      "type": "object",
+      "properties": {
+        "time_windows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaTimeWindow"
+          },
+          "title": "the request has to be made in one of these"
+        },
+        "source_ips": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "CIDRs, like \"10.0.0.0/8\"; the request's client address has to be in one of these"
+        },
+        "subject_types": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "the request has to be made by a subject of one of these types: \"user\",\n\"token\", \"tls\", or \"cert\""
+        }
+      },
+      "description": "Conditions restrict when a statement applies: it only applies if all of them\nare met. An empty list means no restriction."
+    },
     "v2betaCreatePolicyReq": {
       "type": "object",
       "properties": {
@@ -641,6 +785,68 @@ func init() {
         }
       }
     },
+    "v2betaDecision": {
+      "type": "object",
+      "properties": {
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "method": {
+          "type": "string"
+        },
+        "subjects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "action": {
+          "type": "string"
+        },
+        "resource": {
+          "type": "string"
+        },
+        "projects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "allowed": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "authorized_projects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "matches": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaDecisionMatch"
+          },
+          "title": "the policy statements the decision was based on"
+        }
+      },
+      "title": "an authorization decision recorded in the audit log"
+    },
+    "v2betaDecisionMatch": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "$ref": "#/definitions/StatementEffect"
+        },
+        "policy_id": {
+          "type": "string"
+        },
+        "statement_id": {
+          "type": "string"
+        }
+      }
+    },
     "v2betaDeletePolicyResp": {
       "type": "object"
     },
@@ -650,6 +856,111 @@ func init() {
     "v2betaDeleteRoleResp": {
       "type": "object"
     },
+    "v2betaExplainAuthorizationReq": {
+      "type": "object",
+      "properties": {
+        "subjects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "action": {
+          "type": "string"
+        },
+        "resource": {
+          "type": "string"
+        },
+        "projects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaPolicy"
+          },
+          "title": "policies that are not saved: they are evaluated in place of the saved\npolicies with the same IDs, and in addition to all others"
+        },
+        "source_ip": {
+          "type": "string",
+          "title": "the client address and time to check statement conditions against;\nthe time defaults to now"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "v2betaExplainAuthorizationResp": {
+      "type": "object",
+      "properties": {
+        "authorized": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "projects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "the requested projects that are allowed, or all allowed projects if none\nwere requested"
+        },
+        "statements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaExplainedStatement"
+          },
+          "title": "the statements that matched the request, allowing or denying it"
+        }
+      }
+    },
+    "v2betaExplainedStatement": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "string"
+        },
+        "statement_id": {
+          "type": "string"
+        },
+        "statement": {
+          "$ref": "#/definitions/v2betaStatement"
+        },
+        "projects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "title": "a policy statement that matched an explained authorization request"
+    },
+    "v2betaExportStateResp": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaPolicy"
+          }
+        },
+        "roles": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaRole"
+          }
+        },
+        "projects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaProject"
+          }
+        }
+      }
+    },
     "v2betaFlag": {
       "type": "string",
       "enum": [
@@ -691,6 +1002,28 @@ func init() {
         }
       }
     },
+    "v2betaImportStateResp": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaStateChange"
+          }
+        }
+      }
+    },
+    "v2betaListDecisionsResp": {
+      "type": "object",
+      "properties": {
+        "decisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v2betaDecision"
+          }
+        }
+      }
+    },
     "v2betaListPoliciesResp": {
       "type": "object",
       "properties": {
@@ -866,6 +1199,26 @@ func init() {
         }
       }
     },
+    "v2betaStateChange": {
+      "type": "object",
+      "properties": {
+        "operation": {
+          "$ref": "#/definitions/StateChangeOperation"
+        },
+        "kind": {
+          "type": "string",
+          "title": "\"project\", \"role\", or \"policy\""
+        },
+        "id": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string",
+          "title": "why a change is skipped"
+        }
+      },
+      "description": "StateChange is a change ImportState makes, or would make on a dry run, to\nget from the current to the imported IAM state."
+    },
     "v2betaStatement": {
       "type": "object",
       "properties": {
@@ -889,6 +1242,30 @@ func init() {
             "type": "string"
           },
           "title": "Note: these are for display only, not to be set in CreatePolicy/UpdatePolicy"
+        },
+        "conditions": {
+          "$ref": "#/definitions/v2betaConditions",
+          "title": "optional restrictions on when the statement applies"
+        },
+        "projects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Note: like resources, these are ignored by CreatePolicy/UpdatePolicy;\nonly ImportState sets them"
+        }
+      }
+    },
+    "v2betaTimeWindow": {
+      "type": "object",
+      "properties": {
+        "start": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "end": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -158,6 +158,10 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "expires_at": {
+          "type": "string",
+          "description": "RFC 3339 timestamp after which the token is no longer accepted. Tokens\nwithout one don't expire."
         }
       }
     },
@@ -201,7 +205,8 @@ func init() {
           "type": "string"
         },
         "value": {
-          "type": "string"
+          "type": "string",
+          "title": "value is only returned when the token is created"
         },
         "active": {
           "type": "boolean",
@@ -218,6 +223,20 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "expires_at": {
+          "type": "string",
+          "title": "expires_at, last_used_at and last_used_ip are empty when unset"
+        },
+        "last_used_at": {
+          "type": "string"
+        },
+        "last_used_ip": {
+          "type": "string"
+        },
+        "value_prefix": {
+          "type": "string",
+          "title": "value_prefix is the start of the value, for telling tokens apart"
         }
       }
     },
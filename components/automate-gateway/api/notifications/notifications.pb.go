@@ -55,7 +55,7 @@ func (x Rule_Event) String() string {
 	return proto.EnumName(Rule_Event_name, int32(x))
 }
 func (Rule_Event) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{3, 0}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{6, 0}
 }
 
 type SlackAlert struct {
@@ -69,7 +69,7 @@ func (m *SlackAlert) Reset()         { *m = SlackAlert{} }
 func (m *SlackAlert) String() string { return proto.CompactTextString(m) }
 func (*SlackAlert) ProtoMessage()    {}
 func (*SlackAlert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{0}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{0}
 }
 func (m *SlackAlert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SlackAlert.Unmarshal(m, b)
@@ -107,7 +107,7 @@ func (m *WebhookAlert) Reset()         { *m = WebhookAlert{} }
 func (m *WebhookAlert) String() string { return proto.CompactTextString(m) }
 func (*WebhookAlert) ProtoMessage()    {}
 func (*WebhookAlert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{1}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{1}
 }
 func (m *WebhookAlert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WebhookAlert.Unmarshal(m, b)
@@ -146,7 +146,7 @@ func (m *ServiceNowAlert) Reset()         { *m = ServiceNowAlert{} }
 func (m *ServiceNowAlert) String() string { return proto.CompactTextString(m) }
 func (*ServiceNowAlert) ProtoMessage()    {}
 func (*ServiceNowAlert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{2}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{2}
 }
 func (m *ServiceNowAlert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceNowAlert.Unmarshal(m, b)
@@ -180,6 +180,146 @@ func (m *ServiceNowAlert) GetSecretId() string {
 	return ""
 }
 
+// Data feed destinations. These actions are only valid for Assets rules;
+// the secret holds the destination credentials (see data-feed-service).
+type DataFeedWebhookAlert struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SecretId             string   `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DataFeedWebhookAlert) Reset()         { *m = DataFeedWebhookAlert{} }
+func (m *DataFeedWebhookAlert) String() string { return proto.CompactTextString(m) }
+func (*DataFeedWebhookAlert) ProtoMessage()    {}
+func (*DataFeedWebhookAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{3}
+}
+func (m *DataFeedWebhookAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataFeedWebhookAlert.Unmarshal(m, b)
+}
+func (m *DataFeedWebhookAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataFeedWebhookAlert.Marshal(b, m, deterministic)
+}
+func (dst *DataFeedWebhookAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataFeedWebhookAlert.Merge(dst, src)
+}
+func (m *DataFeedWebhookAlert) XXX_Size() int {
+	return xxx_messageInfo_DataFeedWebhookAlert.Size(m)
+}
+func (m *DataFeedWebhookAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataFeedWebhookAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataFeedWebhookAlert proto.InternalMessageInfo
+
+func (m *DataFeedWebhookAlert) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DataFeedWebhookAlert) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
+type SplunkHecAlert struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SecretId             string   `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SplunkHecAlert) Reset()         { *m = SplunkHecAlert{} }
+func (m *SplunkHecAlert) String() string { return proto.CompactTextString(m) }
+func (*SplunkHecAlert) ProtoMessage()    {}
+func (*SplunkHecAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{4}
+}
+func (m *SplunkHecAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplunkHecAlert.Unmarshal(m, b)
+}
+func (m *SplunkHecAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplunkHecAlert.Marshal(b, m, deterministic)
+}
+func (dst *SplunkHecAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplunkHecAlert.Merge(dst, src)
+}
+func (m *SplunkHecAlert) XXX_Size() int {
+	return xxx_messageInfo_SplunkHecAlert.Size(m)
+}
+func (m *SplunkHecAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplunkHecAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplunkHecAlert proto.InternalMessageInfo
+
+func (m *SplunkHecAlert) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SplunkHecAlert) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
+type S3ObjectAlert struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SecretId             string   `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *S3ObjectAlert) Reset()         { *m = S3ObjectAlert{} }
+func (m *S3ObjectAlert) String() string { return proto.CompactTextString(m) }
+func (*S3ObjectAlert) ProtoMessage()    {}
+func (*S3ObjectAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{5}
+}
+func (m *S3ObjectAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_S3ObjectAlert.Unmarshal(m, b)
+}
+func (m *S3ObjectAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_S3ObjectAlert.Marshal(b, m, deterministic)
+}
+func (dst *S3ObjectAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3ObjectAlert.Merge(dst, src)
+}
+func (m *S3ObjectAlert) XXX_Size() int {
+	return xxx_messageInfo_S3ObjectAlert.Size(m)
+}
+func (m *S3ObjectAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3ObjectAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_S3ObjectAlert proto.InternalMessageInfo
+
+func (m *S3ObjectAlert) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *S3ObjectAlert) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
 type Rule struct {
 	Id    string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name  string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -188,6 +328,9 @@ type Rule struct {
 	//	*Rule_SlackAlert
 	//	*Rule_WebhookAlert
 	//	*Rule_ServiceNowAlert
+	//	*Rule_DataFeedWebhookAlert
+	//	*Rule_SplunkHecAlert
+	//	*Rule_S3ObjectAlert
 	Action               isRule_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -198,7 +341,7 @@ func (m *Rule) Reset()         { *m = Rule{} }
 func (m *Rule) String() string { return proto.CompactTextString(m) }
 func (*Rule) ProtoMessage()    {}
 func (*Rule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{3}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{6}
 }
 func (m *Rule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Rule.Unmarshal(m, b)
@@ -255,12 +398,30 @@ type Rule_ServiceNowAlert struct {
 	ServiceNowAlert *ServiceNowAlert `protobuf:"bytes,6,opt,name=ServiceNowAlert,proto3,oneof"`
 }
 
+type Rule_DataFeedWebhookAlert struct {
+	DataFeedWebhookAlert *DataFeedWebhookAlert `protobuf:"bytes,7,opt,name=DataFeedWebhookAlert,proto3,oneof"`
+}
+
+type Rule_SplunkHecAlert struct {
+	SplunkHecAlert *SplunkHecAlert `protobuf:"bytes,8,opt,name=SplunkHecAlert,proto3,oneof"`
+}
+
+type Rule_S3ObjectAlert struct {
+	S3ObjectAlert *S3ObjectAlert `protobuf:"bytes,9,opt,name=S3ObjectAlert,proto3,oneof"`
+}
+
 func (*Rule_SlackAlert) isRule_Action() {}
 
 func (*Rule_WebhookAlert) isRule_Action() {}
 
 func (*Rule_ServiceNowAlert) isRule_Action() {}
 
+func (*Rule_DataFeedWebhookAlert) isRule_Action() {}
+
+func (*Rule_SplunkHecAlert) isRule_Action() {}
+
+func (*Rule_S3ObjectAlert) isRule_Action() {}
+
 func (m *Rule) GetAction() isRule_Action {
 	if m != nil {
 		return m.Action
@@ -289,12 +450,36 @@ func (m *Rule) GetServiceNowAlert() *ServiceNowAlert {
 	return nil
 }
 
+func (m *Rule) GetDataFeedWebhookAlert() *DataFeedWebhookAlert {
+	if x, ok := m.GetAction().(*Rule_DataFeedWebhookAlert); ok {
+		return x.DataFeedWebhookAlert
+	}
+	return nil
+}
+
+func (m *Rule) GetSplunkHecAlert() *SplunkHecAlert {
+	if x, ok := m.GetAction().(*Rule_SplunkHecAlert); ok {
+		return x.SplunkHecAlert
+	}
+	return nil
+}
+
+func (m *Rule) GetS3ObjectAlert() *S3ObjectAlert {
+	if x, ok := m.GetAction().(*Rule_S3ObjectAlert); ok {
+		return x.S3ObjectAlert
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Rule) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Rule_OneofMarshaler, _Rule_OneofUnmarshaler, _Rule_OneofSizer, []interface{}{
 		(*Rule_SlackAlert)(nil),
 		(*Rule_WebhookAlert)(nil),
 		(*Rule_ServiceNowAlert)(nil),
+		(*Rule_DataFeedWebhookAlert)(nil),
+		(*Rule_SplunkHecAlert)(nil),
+		(*Rule_S3ObjectAlert)(nil),
 	}
 }
 
@@ -317,6 +502,21 @@ func _Rule_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.ServiceNowAlert); err != nil {
 			return err
 		}
+	case *Rule_DataFeedWebhookAlert:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DataFeedWebhookAlert); err != nil {
+			return err
+		}
+	case *Rule_SplunkHecAlert:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SplunkHecAlert); err != nil {
+			return err
+		}
+	case *Rule_S3ObjectAlert:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.S3ObjectAlert); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Rule.Action has unexpected type %T", x)
@@ -351,6 +551,30 @@ func _Rule_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (
 		err := b.DecodeMessage(msg)
 		m.Action = &Rule_ServiceNowAlert{msg}
 		return true, err
+	case 7: // action.DataFeedWebhookAlert
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DataFeedWebhookAlert)
+		err := b.DecodeMessage(msg)
+		m.Action = &Rule_DataFeedWebhookAlert{msg}
+		return true, err
+	case 8: // action.SplunkHecAlert
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SplunkHecAlert)
+		err := b.DecodeMessage(msg)
+		m.Action = &Rule_SplunkHecAlert{msg}
+		return true, err
+	case 9: // action.S3ObjectAlert
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(S3ObjectAlert)
+		err := b.DecodeMessage(msg)
+		m.Action = &Rule_S3ObjectAlert{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -375,6 +599,21 @@ func _Rule_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Rule_DataFeedWebhookAlert:
+		s := proto.Size(x.DataFeedWebhookAlert)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Rule_SplunkHecAlert:
+		s := proto.Size(x.SplunkHecAlert)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Rule_S3ObjectAlert:
+		s := proto.Size(x.S3ObjectAlert)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -393,7 +632,7 @@ func (m *RuleIdentifier) Reset()         { *m = RuleIdentifier{} }
 func (m *RuleIdentifier) String() string { return proto.CompactTextString(m) }
 func (*RuleIdentifier) ProtoMessage()    {}
 func (*RuleIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{4}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{7}
 }
 func (m *RuleIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleIdentifier.Unmarshal(m, b)
@@ -432,7 +671,7 @@ func (m *RuleUpdateRequest) Reset()         { *m = RuleUpdateRequest{} }
 func (m *RuleUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*RuleUpdateRequest) ProtoMessage()    {}
 func (*RuleUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{5}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{8}
 }
 func (m *RuleUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleUpdateRequest.Unmarshal(m, b)
@@ -476,7 +715,7 @@ func (m *RuleListRequest) Reset()         { *m = RuleListRequest{} }
 func (m *RuleListRequest) String() string { return proto.CompactTextString(m) }
 func (*RuleListRequest) ProtoMessage()    {}
 func (*RuleListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{6}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{9}
 }
 func (m *RuleListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleListRequest.Unmarshal(m, b)
@@ -507,7 +746,7 @@ func (m *RuleUpdateResponse) Reset()         { *m = RuleUpdateResponse{} }
 func (m *RuleUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleUpdateResponse) ProtoMessage()    {}
 func (*RuleUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{7}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{10}
 }
 func (m *RuleUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleUpdateResponse.Unmarshal(m, b)
@@ -545,7 +784,7 @@ func (m *RuleDeleteResponse) Reset()         { *m = RuleDeleteResponse{} }
 func (m *RuleDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleDeleteResponse) ProtoMessage()    {}
 func (*RuleDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{8}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{11}
 }
 func (m *RuleDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleDeleteResponse.Unmarshal(m, b)
@@ -583,7 +822,7 @@ func (m *RuleAddRequest) Reset()         { *m = RuleAddRequest{} }
 func (m *RuleAddRequest) String() string { return proto.CompactTextString(m) }
 func (*RuleAddRequest) ProtoMessage()    {}
 func (*RuleAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{9}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{12}
 }
 func (m *RuleAddRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleAddRequest.Unmarshal(m, b)
@@ -622,7 +861,7 @@ func (m *RuleAddResponse) Reset()         { *m = RuleAddResponse{} }
 func (m *RuleAddResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleAddResponse) ProtoMessage()    {}
 func (*RuleAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{10}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{13}
 }
 func (m *RuleAddResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleAddResponse.Unmarshal(m, b)
@@ -668,7 +907,7 @@ func (m *RuleGetResponse) Reset()         { *m = RuleGetResponse{} }
 func (m *RuleGetResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleGetResponse) ProtoMessage()    {}
 func (*RuleGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{11}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{14}
 }
 func (m *RuleGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleGetResponse.Unmarshal(m, b)
@@ -714,7 +953,7 @@ func (m *RuleListResponse) Reset()         { *m = RuleListResponse{} }
 func (m *RuleListResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleListResponse) ProtoMessage()    {}
 func (*RuleListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{12}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{15}
 }
 func (m *RuleListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleListResponse.Unmarshal(m, b)
@@ -758,7 +997,7 @@ func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
 func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
 func (*VersionRequest) ProtoMessage()    {}
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{13}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{16}
 }
 func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionRequest.Unmarshal(m, b)
@@ -789,7 +1028,7 @@ func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
 func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
 func (*VersionResponse) ProtoMessage()    {}
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{14}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{17}
 }
 func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
@@ -828,7 +1067,7 @@ func (m *UsernamePassword) Reset()         { *m = UsernamePassword{} }
 func (m *UsernamePassword) String() string { return proto.CompactTextString(m) }
 func (*UsernamePassword) ProtoMessage()    {}
 func (*UsernamePassword) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{15}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{18}
 }
 func (m *UsernamePassword) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsernamePassword.Unmarshal(m, b)
@@ -873,7 +1112,7 @@ func (m *SecretId) Reset()         { *m = SecretId{} }
 func (m *SecretId) String() string { return proto.CompactTextString(m) }
 func (*SecretId) ProtoMessage()    {}
 func (*SecretId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{16}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{19}
 }
 func (m *SecretId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SecretId.Unmarshal(m, b)
@@ -910,7 +1149,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{17}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{20}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -946,7 +1185,7 @@ func (m *URLValidationRequest) Reset()         { *m = URLValidationRequest{} }
 func (m *URLValidationRequest) String() string { return proto.CompactTextString(m) }
 func (*URLValidationRequest) ProtoMessage()    {}
 func (*URLValidationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{18}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{21}
 }
 func (m *URLValidationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_URLValidationRequest.Unmarshal(m, b)
@@ -1126,7 +1365,7 @@ func (m *URLValidationResponse) Reset()         { *m = URLValidationResponse{} }
 func (m *URLValidationResponse) String() string { return proto.CompactTextString(m) }
 func (*URLValidationResponse) ProtoMessage()    {}
 func (*URLValidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_notifications_d6f21efe3f986263, []int{19}
+	return fileDescriptor_notifications_f21bac00f442d05b, []int{22}
 }
 func (m *URLValidationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_URLValidationResponse.Unmarshal(m, b)
@@ -1150,6 +1389,9 @@ func init() {
 	proto.RegisterType((*SlackAlert)(nil), "chef.automate.api.notifications.SlackAlert")
 	proto.RegisterType((*WebhookAlert)(nil), "chef.automate.api.notifications.WebhookAlert")
 	proto.RegisterType((*ServiceNowAlert)(nil), "chef.automate.api.notifications.ServiceNowAlert")
+	proto.RegisterType((*DataFeedWebhookAlert)(nil), "chef.automate.api.notifications.DataFeedWebhookAlert")
+	proto.RegisterType((*SplunkHecAlert)(nil), "chef.automate.api.notifications.SplunkHecAlert")
+	proto.RegisterType((*S3ObjectAlert)(nil), "chef.automate.api.notifications.S3ObjectAlert")
 	proto.RegisterType((*Rule)(nil), "chef.automate.api.notifications.Rule")
 	proto.RegisterType((*RuleIdentifier)(nil), "chef.automate.api.notifications.RuleIdentifier")
 	proto.RegisterType((*RuleUpdateRequest)(nil), "chef.automate.api.notifications.RuleUpdateRequest")
@@ -1441,80 +1683,86 @@ var _Notifications_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("components/automate-gateway/api/notifications/notifications.proto", fileDescriptor_notifications_d6f21efe3f986263)
-}
-
-var fileDescriptor_notifications_d6f21efe3f986263 = []byte{
-	// 1133 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xae, 0x1d, 0xc7, 0x7d, 0xa1, 0x8e, 0x33, 0xb4, 0x61, 0xe3, 0x40, 0x6a, 0x8d, 0x00,
-	0x85, 0x56, 0xb1, 0xd3, 0x54, 0x20, 0x61, 0x40, 0x6a, 0x12, 0x5a, 0x5c, 0x28, 0x15, 0xda, 0x28,
-	0x45, 0x42, 0x88, 0x30, 0xd9, 0x9d, 0x38, 0xab, 0xae, 0x77, 0x96, 0x9d, 0xd9, 0x44, 0x11, 0x70,
-	0x09, 0x5c, 0xb0, 0x90, 0x90, 0xe0, 0x17, 0xf0, 0x23, 0xf2, 0x4b, 0xb8, 0x72, 0xe4, 0x86, 0xc4,
-	0x81, 0x1b, 0x37, 0x34, 0xb3, 0xb3, 0xb6, 0xd7, 0x89, 0xe3, 0x4d, 0xe1, 0xe6, 0x99, 0x79, 0xdf,
-	0x37, 0xf3, 0x7d, 0xef, 0xed, 0x9b, 0x31, 0x6c, 0x3a, 0xac, 0x17, 0xb2, 0x80, 0x06, 0x82, 0xb7,
-	0x48, 0x2c, 0x58, 0x8f, 0x08, 0xba, 0xd6, 0x25, 0x82, 0x1e, 0x93, 0x93, 0x16, 0x09, 0xbd, 0x56,
-	0xc0, 0x84, 0x77, 0xe0, 0x39, 0x44, 0x78, 0x2c, 0xe0, 0xd9, 0x51, 0x33, 0x8c, 0x98, 0x60, 0xe8,
-	0x96, 0x73, 0x48, 0x0f, 0x9a, 0x29, 0xb8, 0x49, 0x42, 0xaf, 0x99, 0x09, 0xab, 0xbf, 0xdc, 0x65,
-	0xac, 0xeb, 0x53, 0x45, 0x47, 0x82, 0x80, 0x89, 0x51, 0x78, 0xfd, 0xfe, 0x85, 0x27, 0x88, 0x42,
-	0xa7, 0xa5, 0xd6, 0x9d, 0xb5, 0x2e, 0x0d, 0xd6, 0x42, 0xe6, 0x7b, 0xce, 0xc9, 0xff, 0xc0, 0xe0,
-	0x91, 0xde, 0x79, 0x06, 0xbc, 0x02, 0xb0, 0xe3, 0x13, 0xe7, 0xd9, 0xa6, 0x4f, 0x23, 0x81, 0x6a,
-	0x50, 0x8c, 0x23, 0xdf, 0x32, 0x1a, 0xc6, 0xea, 0x35, 0x5b, 0xfe, 0xc4, 0x0d, 0x78, 0xe1, 0x53,
-	0xba, 0x7f, 0xc8, 0xd8, 0xc4, 0x88, 0xfb, 0x30, 0xbf, 0x43, 0xa3, 0x23, 0xcf, 0xa1, 0x4f, 0xd8,
-	0xf1, 0x84, 0x20, 0xb4, 0x0c, 0xd7, 0x38, 0x75, 0x22, 0x2a, 0xf6, 0x3c, 0xd7, 0x32, 0xd5, 0x7c,
-	0x25, 0x99, 0x78, 0xe4, 0xe2, 0xdf, 0x8b, 0x50, 0xb2, 0x63, 0x9f, 0xa2, 0x2a, 0x98, 0x9e, 0xab,
-	0x61, 0xa6, 0xe7, 0x22, 0x04, 0xa5, 0x80, 0xf4, 0xa8, 0x06, 0xa8, 0xdf, 0x68, 0x13, 0x66, 0xe8,
-	0x11, 0x0d, 0x84, 0x55, 0x6c, 0x18, 0xab, 0xd5, 0x8d, 0x3b, 0xcd, 0x29, 0x39, 0x68, 0x4a, 0xe6,
-	0xe6, 0x03, 0x09, 0xb1, 0x13, 0x24, 0xfa, 0x78, 0x54, 0xb3, 0x55, 0x6a, 0x18, 0xab, 0x73, 0x39,
-	0x78, 0x86, 0x90, 0x4e, 0xc1, 0x1e, 0x35, 0x6d, 0x27, 0x6b, 0x91, 0x35, 0xa3, 0x08, 0xd7, 0xa6,
-	0x12, 0x8e, 0x82, 0x3a, 0x05, 0x3b, 0xeb, 0xf3, 0xe7, 0xe7, 0x5c, 0xb5, 0xca, 0x8a, 0x77, 0x7d,
-	0xfa, 0x41, 0xb3, 0xb8, 0x4e, 0xc1, 0x1e, 0xa7, 0xc2, 0x04, 0x66, 0x94, 0x23, 0xa8, 0x0a, 0xb0,
-	0xbd, 0x6d, 0x3f, 0x24, 0x9e, 0x1f, 0x47, 0xb4, 0x56, 0xd0, 0xe3, 0x9d, 0xd8, 0x71, 0x28, 0xe7,
-	0x35, 0x03, 0xdd, 0x84, 0x85, 0x6d, 0xd6, 0x0b, 0x7d, 0x8f, 0x04, 0x0e, 0x4d, 0xc3, 0xcc, 0xec,
-	0x74, 0x1a, 0x5d, 0x44, 0x00, 0xe5, 0x4d, 0xce, 0xa9, 0xe0, 0xb5, 0xd2, 0x56, 0x05, 0xca, 0xc4,
-	0x91, 0x07, 0xc2, 0x0d, 0xa8, 0xca, 0x1c, 0x3c, 0x72, 0x69, 0x20, 0x8f, 0x49, 0xa3, 0xf1, 0x3c,
-	0xe3, 0x2f, 0x60, 0x41, 0x46, 0xec, 0x86, 0x2e, 0x11, 0xd4, 0xa6, 0x5f, 0xc5, 0x94, 0x0b, 0xf4,
-	0x36, 0x94, 0xa2, 0xd8, 0x4f, 0x92, 0x3f, 0xb7, 0xf1, 0x5a, 0xae, 0x3c, 0xdb, 0x0a, 0xa2, 0xf9,
-	0x8b, 0x03, 0xfe, 0x05, 0x98, 0x97, 0xab, 0x8f, 0x3d, 0x2e, 0x34, 0x3b, 0x5e, 0x07, 0x34, 0xba,
-	0x25, 0x0f, 0x59, 0xc0, 0x29, 0xaa, 0x43, 0xa5, 0x47, 0x39, 0x27, 0x5d, 0xca, 0x2d, 0xa3, 0x51,
-	0x94, 0x55, 0x9a, 0x8e, 0x53, 0xc4, 0xfb, 0xd4, 0xa7, 0x39, 0x11, 0x1f, 0x25, 0xc2, 0x37, 0x5d,
-	0x77, 0x5c, 0x93, 0x71, 0x65, 0x4d, 0xf8, 0xbd, 0x44, 0x83, 0x22, 0x9b, 0xbe, 0xb7, 0xb6, 0xc0,
-	0x1c, 0x58, 0x70, 0x98, 0xc0, 0x3f, 0xa0, 0x22, 0x17, 0xfc, 0xf9, 0xcd, 0xc7, 0xcf, 0xa0, 0x36,
-	0x34, 0x3b, 0xc7, 0x56, 0xef, 0xc0, 0x8c, 0xc4, 0x71, 0xcb, 0x6c, 0x14, 0xf3, 0xef, 0x95, 0x60,
-	0x70, 0x0d, 0xaa, 0x4f, 0x69, 0xc4, 0x3d, 0x16, 0xa4, 0x89, 0xbd, 0x03, 0xf3, 0x83, 0x19, 0xbd,
-	0xbb, 0x05, 0xb3, 0x47, 0xc9, 0x94, 0xae, 0xb9, 0x74, 0x88, 0x3f, 0x84, 0xda, 0x2e, 0xa7, 0x91,
-	0x6c, 0x2c, 0x9f, 0x10, 0xce, 0x8f, 0x59, 0xe4, 0xca, 0xb3, 0xc6, 0x7a, 0x4e, 0x87, 0x0f, 0xc6,
-	0x72, 0x2d, 0xd4, 0x71, 0x69, 0x17, 0x4b, 0xc7, 0xb8, 0x0e, 0x95, 0x1d, 0xdd, 0xd1, 0xce, 0x15,
-	0xf8, 0x2c, 0xcc, 0x3c, 0xe8, 0x85, 0xe2, 0x04, 0xff, 0x6a, 0xc2, 0x8d, 0x5d, 0xfb, 0xf1, 0x53,
-	0xe2, 0x7b, 0xae, 0x92, 0x93, 0x56, 0xc6, 0xf9, 0x96, 0xf9, 0x25, 0x2c, 0xa4, 0xfb, 0xee, 0x65,
-	0x36, 0x9d, 0xdb, 0xb8, 0x3b, 0xd5, 0xa3, 0x71, 0x55, 0x9d, 0x82, 0x5d, 0x8b, 0xc7, 0x95, 0x76,
-	0x46, 0x9b, 0x72, 0x51, 0x31, 0xbf, 0x91, 0xa3, 0xbb, 0x24, 0x1a, 0x3b, 0x85, 0x61, 0x07, 0x47,
-	0xef, 0x42, 0x29, 0x60, 0x01, 0xd5, 0xbd, 0xf4, 0xf5, 0xa9, 0x24, 0xca, 0x8c, 0x4e, 0xc1, 0x56,
-	0xa8, 0xad, 0xeb, 0x30, 0xe7, 0x44, 0x54, 0xb5, 0x07, 0xe2, 0x73, 0xfc, 0x12, 0xdc, 0x1c, 0xb3,
-	0x28, 0xc9, 0xe3, 0xc6, 0x69, 0x15, 0xae, 0x3f, 0x19, 0xe5, 0x41, 0x7f, 0x1b, 0x30, 0x2b, 0xbf,
-	0x08, 0xf9, 0xd1, 0xb7, 0x72, 0x15, 0xce, 0xf0, 0x63, 0xac, 0xaf, 0xe7, 0x07, 0x24, 0x07, 0xc0,
-	0xdf, 0x19, 0xa7, 0x67, 0xd6, 0x4d, 0x78, 0x31, 0x13, 0xd5, 0x56, 0x95, 0x78, 0x7a, 0x66, 0x55,
-	0x50, 0xd9, 0x89, 0x28, 0x11, 0xb4, 0x3f, 0x29, 0xa8, 0x7f, 0x66, 0x61, 0xd4, 0xc8, 0x2e, 0xa8,
-	0xd1, 0x89, 0xdc, 0x89, 0xb7, 0x13, 0xf8, 0xe9, 0x6f, 0x7f, 0xfc, 0x62, 0x2e, 0xe1, 0x1b, 0x63,
-	0x0f, 0x10, 0xc5, 0xd0, 0x36, 0x6e, 0xa3, 0xef, 0x4d, 0x00, 0xdd, 0x85, 0xf2, 0xeb, 0x1e, 0x76,
-	0xdf, 0xfa, 0xbd, 0x5c, 0x80, 0x6c, 0x9f, 0xc3, 0x3f, 0x49, 0xe9, 0x75, 0xb0, 0x2e, 0x50, 0xd5,
-	0xfe, 0xda, 0x73, 0xbf, 0x4d, 0xf4, 0xbb, 0x0a, 0xd4, 0xbf, 0x34, 0x72, 0x9a, 0x09, 0x09, 0x87,
-	0x32, 0x61, 0xf9, 0xf6, 0xd2, 0x45, 0x26, 0xb4, 0x24, 0x0d, 0xea, 0x9b, 0x00, 0xba, 0x7d, 0x4b,
-	0x1b, 0x36, 0x72, 0xa9, 0xca, 0x5c, 0x31, 0x39, 0x9d, 0xc8, 0xde, 0x11, 0xf8, 0xe7, 0x5c, 0x4e,
-	0xc4, 0x0a, 0xf4, 0xdf, 0x9c, 0x48, 0x38, 0x94, 0x13, 0x2b, 0xf5, 0xc9, 0x4e, 0xc8, 0x9a, 0xf8,
-	0xc7, 0x80, 0x59, 0xd9, 0xdb, 0x9f, 0xab, 0x20, 0xf2, 0x7d, 0x08, 0x23, 0x57, 0x07, 0xee, 0x4f,
-	0xf7, 0xa0, 0x8c, 0x4a, 0x11, 0x25, 0xee, 0x54, 0x07, 0x6e, 0xa1, 0x57, 0x26, 0x3b, 0xd0, 0xa5,
-	0x22, 0x29, 0x04, 0x74, 0x49, 0x21, 0xfc, 0x65, 0xc0, 0x35, 0x75, 0xdb, 0xc8, 0x29, 0x94, 0x4f,
-	0xcc, 0xc8, 0x53, 0xa0, 0x7e, 0xf7, 0x0a, 0x08, 0xad, 0xff, 0x9b, 0xcb, 0xfa, 0xc0, 0x50, 0xf9,
-	0xc4, 0x2e, 0xd0, 0x40, 0x2b, 0x93, 0x45, 0xfb, 0x1e, 0x4f, 0x54, 0x2f, 0xa2, 0x0b, 0x7b, 0x00,
-	0xfa, 0xd1, 0x84, 0x79, 0xdd, 0x1e, 0xa9, 0x7e, 0x34, 0xa2, 0x37, 0xa7, 0x5f, 0x09, 0x17, 0x5c,
-	0x3b, 0xf5, 0xb7, 0xae, 0x0a, 0xd3, 0x06, 0xfc, 0x70, 0x69, 0x27, 0x04, 0x54, 0x39, 0xd2, 0xe7,
-	0xbb, 0xcc, 0x85, 0x57, 0x11, 0x9e, 0xec, 0x42, 0x4a, 0x90, 0xe4, 0x1f, 0x2f, 0x8e, 0x39, 0x71,
-	0x9c, 0xe8, 0x96, 0xb5, 0xff, 0xa7, 0x01, 0xb3, 0xfa, 0xca, 0xcf, 0x51, 0xfb, 0xd9, 0xe7, 0x42,
-	0x8e, 0xda, 0x1f, 0x7b, 0x4d, 0x60, 0x59, 0xdc, 0x8b, 0x70, 0x83, 0x27, 0x4f, 0xea, 0x3d, 0x2f,
-	0x38, 0x60, 0x6d, 0xfd, 0x9e, 0xc8, 0x24, 0xdf, 0x82, 0x45, 0x7e, 0xc2, 0x05, 0xed, 0xb5, 0x75,
-	0x68, 0x1a, 0xd5, 0x3f, 0xb3, 0x96, 0xd1, 0x52, 0x76, 0x4d, 0x6f, 0x32, 0x28, 0x78, 0x0b, 0x8d,
-	0x0b, 0xd6, 0xe0, 0xad, 0xce, 0x67, 0x0f, 0xbb, 0x9e, 0x38, 0x8c, 0xf7, 0x9b, 0x0e, 0xeb, 0xb5,
-	0xe4, 0xe1, 0x07, 0xff, 0xfc, 0x5a, 0x57, 0xfa, 0x47, 0xbb, 0x5f, 0x56, 0xff, 0x00, 0xef, 0xfd,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xda, 0x1c, 0x57, 0x09, 0x0f, 0x00, 0x00,
+	proto.RegisterFile("components/automate-gateway/api/notifications/notifications.proto", fileDescriptor_notifications_f21bac00f442d05b)
+}
+
+var fileDescriptor_notifications_f21bac00f442d05b = []byte{
+	// 1220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdf, 0x6e, 0xe3, 0xc4,
+	0x17, 0x8e, 0xf3, 0xbf, 0xa7, 0xbf, 0xa6, 0xe9, 0xfc, 0xba, 0x8b, 0x37, 0xfb, 0x2f, 0xb2, 0x04,
+	0x5a, 0x76, 0xd5, 0xa4, 0xdb, 0x6a, 0x41, 0x1b, 0x16, 0xd8, 0xb6, 0xdb, 0xe2, 0x85, 0x65, 0x41,
+	0x8e, 0x5a, 0x04, 0x42, 0x94, 0xa9, 0x3d, 0x4d, 0x4d, 0x1d, 0xdb, 0x78, 0xc6, 0xad, 0xaa, 0x15,
+	0x17, 0x44, 0x48, 0x48, 0xbd, 0xe5, 0x09, 0x78, 0x88, 0x3c, 0x04, 0xd7, 0x3c, 0x02, 0x08, 0x89,
+	0x4b, 0xee, 0xf7, 0x02, 0x79, 0x3c, 0x4e, 0xe2, 0xd4, 0x49, 0xdc, 0x8a, 0xbb, 0xcc, 0xf8, 0x7c,
+	0xdf, 0x99, 0xef, 0x9c, 0x33, 0xe7, 0xd8, 0x81, 0x0d, 0xdd, 0xe9, 0xba, 0x8e, 0x4d, 0x6c, 0x46,
+	0x9b, 0xd8, 0x67, 0x4e, 0x17, 0x33, 0xb2, 0xd2, 0xc1, 0x8c, 0x9c, 0xe2, 0xb3, 0x26, 0x76, 0xcd,
+	0xa6, 0xed, 0x30, 0xf3, 0xd0, 0xd4, 0x31, 0x33, 0x1d, 0x9b, 0xc6, 0x57, 0x0d, 0xd7, 0x73, 0x98,
+	0x83, 0xee, 0xea, 0x47, 0xe4, 0xb0, 0x11, 0x81, 0x1b, 0xd8, 0x35, 0x1b, 0x31, 0xb3, 0xda, 0xad,
+	0x8e, 0xe3, 0x74, 0x2c, 0xc2, 0xe9, 0xb0, 0x6d, 0x3b, 0x6c, 0x14, 0x5e, 0x7b, 0x9a, 0x78, 0x02,
+	0xcf, 0xd5, 0x9b, 0xfc, 0xb9, 0xbe, 0xd2, 0x21, 0xf6, 0x8a, 0xeb, 0x58, 0xa6, 0x7e, 0xf6, 0x1f,
+	0x30, 0x98, 0xb8, 0x7b, 0x91, 0x41, 0xb9, 0x03, 0xd0, 0xb6, 0xb0, 0x7e, 0xbc, 0x61, 0x11, 0x8f,
+	0xa1, 0x2a, 0xe4, 0x7c, 0xcf, 0x92, 0xa5, 0xba, 0x74, 0x6f, 0x4e, 0x0b, 0x7e, 0x2a, 0x75, 0xf8,
+	0xdf, 0x17, 0xe4, 0xe0, 0xc8, 0x71, 0x26, 0x5a, 0x3c, 0x85, 0xc5, 0x36, 0xf1, 0x4e, 0x4c, 0x9d,
+	0xbc, 0x74, 0x4e, 0x27, 0x18, 0xa1, 0x9b, 0x30, 0x47, 0x89, 0xee, 0x11, 0xb6, 0x6f, 0x1a, 0x72,
+	0x96, 0xef, 0x97, 0xc3, 0x8d, 0xe7, 0x86, 0xb2, 0x0d, 0xcb, 0xcf, 0x30, 0xc3, 0x3b, 0x84, 0x18,
+	0xd3, 0x7d, 0x4d, 0xa7, 0xf9, 0x10, 0x2a, 0x6d, 0xd7, 0xf2, 0xed, 0x63, 0x95, 0xe8, 0x57, 0x22,
+	0xf8, 0x00, 0x16, 0xda, 0xeb, 0x9f, 0x1d, 0x7c, 0x47, 0x74, 0x76, 0x25, 0xfc, 0xeb, 0x02, 0xe4,
+	0x35, 0xdf, 0x22, 0xa8, 0x02, 0x59, 0xd3, 0x10, 0xb0, 0xac, 0x69, 0x20, 0x04, 0x79, 0x1b, 0x77,
+	0x89, 0x00, 0xf0, 0xdf, 0x68, 0x03, 0x0a, 0xe4, 0x84, 0xd8, 0x4c, 0xce, 0xd5, 0xa5, 0x7b, 0x95,
+	0xb5, 0x07, 0x8d, 0x19, 0xb5, 0xd4, 0x08, 0x98, 0x1b, 0xdb, 0x01, 0x44, 0x0b, 0x91, 0xe8, 0xd3,
+	0xd1, 0xdc, 0xc9, 0xf9, 0xba, 0x74, 0x6f, 0x3e, 0x05, 0xcf, 0x10, 0xa2, 0x66, 0xb4, 0xd1, 0xe4,
+	0xb7, 0xe3, 0xa9, 0x96, 0x0b, 0x9c, 0x70, 0x65, 0x26, 0xe1, 0x28, 0x48, 0xcd, 0x68, 0xf1, 0x7a,
+	0xf9, 0xfa, 0x42, 0x75, 0xc8, 0x45, 0xce, 0xbb, 0x3a, 0xfb, 0xa0, 0x71, 0x9c, 0x9a, 0xd1, 0x2e,
+	0x14, 0xda, 0x71, 0x72, 0xe5, 0xc8, 0x25, 0xee, 0xe2, 0xd1, 0x4c, 0x17, 0x49, 0x60, 0x35, 0xa3,
+	0x25, 0x97, 0xe3, 0x97, 0xe3, 0xf5, 0x25, 0x97, 0xb9, 0x9b, 0xe6, 0x6c, 0x25, 0x31, 0x98, 0x9a,
+	0xd1, 0xc6, 0x0b, 0x75, 0x6f, 0xac, 0xf2, 0xe4, 0x39, 0xce, 0xdc, 0x98, 0xcd, 0x3c, 0x8a, 0x52,
+	0x33, 0x5a, 0x9c, 0x46, 0xc1, 0x50, 0xe0, 0x15, 0x83, 0x2a, 0x00, 0x5b, 0x5b, 0xda, 0x0e, 0x36,
+	0x2d, 0xdf, 0x23, 0xd5, 0x8c, 0x58, 0xb7, 0x7d, 0x5d, 0x27, 0x94, 0x56, 0x25, 0x74, 0x0d, 0x96,
+	0xb6, 0x9c, 0xae, 0x6b, 0x99, 0xd8, 0xd6, 0x49, 0x64, 0x96, 0x8d, 0x6f, 0x47, 0xd6, 0x39, 0x04,
+	0x50, 0xdc, 0xa0, 0x94, 0x30, 0x5a, 0xcd, 0x6f, 0x96, 0xa1, 0x88, 0xf5, 0xe0, 0x30, 0x4a, 0x1d,
+	0x2a, 0x41, 0x8d, 0x3e, 0x37, 0x88, 0x1d, 0x1c, 0x91, 0x78, 0xe3, 0xf7, 0x40, 0xf9, 0x06, 0x96,
+	0x02, 0x8b, 0x5d, 0xd7, 0xc0, 0x8c, 0x68, 0xe4, 0x7b, 0x9f, 0x50, 0x86, 0x1e, 0x43, 0xde, 0xf3,
+	0xad, 0xf0, 0x72, 0xcc, 0xaf, 0xbd, 0x99, 0xea, 0x1e, 0x68, 0x1c, 0x22, 0xf8, 0x73, 0x03, 0xfe,
+	0x25, 0x58, 0x0c, 0x9e, 0xbe, 0x30, 0x29, 0x13, 0xec, 0xca, 0x2a, 0xa0, 0x51, 0x97, 0xd4, 0x75,
+	0x6c, 0x4a, 0x50, 0x0d, 0xca, 0x5d, 0x42, 0x29, 0xee, 0x10, 0x2a, 0x4b, 0xf5, 0x5c, 0x70, 0x8b,
+	0xa3, 0x75, 0x84, 0x78, 0x46, 0x2c, 0x92, 0x12, 0xf1, 0x49, 0x28, 0x7c, 0xc3, 0x30, 0xc6, 0x35,
+	0x49, 0x97, 0xd6, 0xa4, 0xbc, 0x0f, 0x8b, 0x03, 0xb2, 0xd9, 0xbe, 0x45, 0x08, 0xb2, 0x83, 0x10,
+	0x1c, 0x85, 0xf0, 0x8f, 0x08, 0x4b, 0x05, 0xbf, 0x7a, 0xf0, 0x95, 0x63, 0xa8, 0x0e, 0x83, 0x9d,
+	0xc2, 0xd5, 0x7b, 0x50, 0x08, 0x70, 0x54, 0xce, 0xd6, 0x73, 0xe9, 0x7d, 0x85, 0x18, 0xa5, 0x0a,
+	0x95, 0x3d, 0xe2, 0x51, 0xd3, 0xb1, 0xa3, 0xc4, 0x3e, 0x80, 0xc5, 0xc1, 0x8e, 0xf0, 0x2e, 0x43,
+	0xe9, 0x24, 0xdc, 0x12, 0x35, 0x17, 0x2d, 0x95, 0x8f, 0xa1, 0xba, 0x4b, 0x89, 0x17, 0x34, 0xde,
+	0xcf, 0x31, 0xa5, 0xa7, 0x8e, 0x67, 0x04, 0x67, 0xf5, 0xc5, 0x9e, 0x30, 0x1f, 0xac, 0x83, 0x67,
+	0xae, 0xb0, 0x8b, 0xba, 0x7c, 0xb4, 0x56, 0x6a, 0x50, 0x6e, 0x8b, 0x8e, 0x7f, 0xa1, 0xc0, 0x4b,
+	0x50, 0xd8, 0xee, 0xba, 0xec, 0x4c, 0xf9, 0x35, 0x0b, 0xcb, 0xbb, 0xda, 0x8b, 0x3d, 0x6c, 0x99,
+	0x06, 0x66, 0xc3, 0x63, 0x27, 0x8c, 0x94, 0x6f, 0x61, 0x29, 0xf2, 0xbb, 0x1f, 0x73, 0x3a, 0xbf,
+	0xf6, 0x70, 0x66, 0x8c, 0xc6, 0x55, 0xa9, 0x19, 0xad, 0xea, 0x8f, 0x2b, 0x55, 0x47, 0x87, 0x56,
+	0x8e, 0x33, 0xbf, 0x9d, 0xa2, 0xfb, 0x86, 0x1a, 0xd5, 0xcc, 0x70, 0xc2, 0xa1, 0x27, 0x90, 0xb7,
+	0x1d, 0x9b, 0x88, 0x59, 0xf3, 0xd6, 0x4c, 0x12, 0x1e, 0x0c, 0x35, 0xa3, 0x71, 0xd4, 0xe6, 0x02,
+	0xcc, 0xeb, 0x1e, 0xe1, 0xed, 0x01, 0x5b, 0x54, 0x79, 0x03, 0xae, 0x8d, 0x85, 0x28, 0xcc, 0xe3,
+	0xda, 0x6f, 0x0b, 0xb0, 0xf0, 0x72, 0x94, 0x07, 0xfd, 0x2d, 0x41, 0x29, 0xb8, 0x11, 0xc1, 0xa5,
+	0x6f, 0xa6, 0x2a, 0x9c, 0xe1, 0x65, 0xac, 0xad, 0xa6, 0x07, 0x84, 0x07, 0x50, 0x5e, 0xf5, 0xfa,
+	0xf2, 0x6d, 0xf8, 0x7f, 0xcc, 0xa8, 0xc5, 0x0b, 0x11, 0x15, 0x75, 0x8f, 0x60, 0x46, 0xce, 0xfb,
+	0xf2, 0xbb, 0xc9, 0x06, 0xf5, 0xf8, 0x26, 0x5f, 0x9d, 0x05, 0x0e, 0x68, 0x2b, 0x84, 0xf6, 0x7e,
+	0xff, 0xf3, 0x97, 0xec, 0x0d, 0x65, 0x79, 0xec, 0xfd, 0x92, 0xa3, 0x5b, 0xd2, 0x7d, 0xf4, 0x5a,
+	0x02, 0x10, 0xcd, 0x27, 0xbd, 0xdc, 0x61, 0xd3, 0xad, 0xad, 0xa7, 0x02, 0xc4, 0xdb, 0x9b, 0xf2,
+	0xb3, 0xd4, 0xeb, 0xcb, 0x0a, 0xc8, 0x09, 0x8a, 0x5a, 0xaf, 0x4c, 0xe3, 0x07, 0x54, 0x34, 0x38,
+	0xe0, 0xbc, 0x2f, 0x3f, 0x99, 0x62, 0x35, 0x45, 0x7c, 0x88, 0xe7, 0xe2, 0x6f, 0xde, 0xbf, 0x91,
+	0x24, 0xbe, 0xc9, 0x29, 0x7a, 0x59, 0x00, 0xd1, 0xad, 0x03, 0xf9, 0x6b, 0xa9, 0xd4, 0xc4, 0x26,
+	0x4a, 0x6d, 0xfd, 0x52, 0x18, 0x11, 0x81, 0xf3, 0x99, 0x11, 0xf0, 0x39, 0xe0, 0xea, 0x11, 0x08,
+	0xf1, 0x3c, 0x02, 0x77, 0x6a, 0x93, 0x23, 0x10, 0xd4, 0xc0, 0x3f, 0x12, 0x94, 0x82, 0x16, 0x7e,
+	0xa5, 0x02, 0x48, 0x57, 0xef, 0x23, 0x13, 0x42, 0xe9, 0x05, 0xda, 0xeb, 0x53, 0x54, 0xe5, 0x3d,
+	0x82, 0x8d, 0xf3, 0xbe, 0xfc, 0x78, 0x8a, 0xcd, 0xed, 0xc9, 0xca, 0x3b, 0x84, 0x85, 0x89, 0x47,
+	0x53, 0x12, 0xff, 0x97, 0x04, 0x73, 0x7c, 0x98, 0xf0, 0x6b, 0x94, 0x4e, 0xc4, 0xc8, 0xa4, 0xaf,
+	0x3d, 0xbc, 0x04, 0x42, 0xe8, 0x66, 0xbd, 0xbe, 0x7c, 0x33, 0xf9, 0x1a, 0x47, 0x8a, 0x1f, 0x25,
+	0x3f, 0xbe, 0x33, 0x59, 0xac, 0x65, 0xd2, 0x50, 0xed, 0x75, 0x94, 0x78, 0xc7, 0xd1, 0x4f, 0x59,
+	0x58, 0x14, 0x5d, 0x8f, 0x88, 0x17, 0x4c, 0x34, 0xfb, 0x55, 0x35, 0x69, 0x9a, 0xd4, 0xde, 0xb9,
+	0x2c, 0x4c, 0x08, 0xff, 0x31, 0x48, 0xf8, 0xdd, 0x64, 0x69, 0xe5, 0x13, 0x71, 0x36, 0x9e, 0xef,
+	0x44, 0x13, 0x65, 0xb2, 0xfa, 0x08, 0x1c, 0xe6, 0x5b, 0xb9, 0x3e, 0x16, 0x81, 0xd3, 0x50, 0x6f,
+	0x50, 0xe3, 0x7f, 0x48, 0x50, 0x12, 0x13, 0x3c, 0x45, 0x8d, 0xc7, 0xa7, 0x7f, 0x6d, 0x35, 0x3d,
+	0x40, 0x48, 0xf6, 0x7b, 0x7d, 0xf9, 0x16, 0x2c, 0xd3, 0xf0, 0x0b, 0x62, 0xdf, 0xb4, 0x0f, 0x9d,
+	0x96, 0x78, 0x3d, 0x18, 0x24, 0x7b, 0x1d, 0xae, 0xd3, 0x33, 0xca, 0x48, 0xb7, 0x25, 0xcc, 0x06,
+	0x16, 0x37, 0xe2, 0xfb, 0x82, 0x7c, 0x50, 0xd8, 0x32, 0x1a, 0x17, 0x2a, 0x80, 0x9b, 0xea, 0x57,
+	0x3b, 0x1d, 0x93, 0x1d, 0xf9, 0x07, 0x0d, 0xdd, 0xe9, 0x36, 0x83, 0x43, 0x0f, 0x3e, 0xd4, 0x9b,
+	0x97, 0xfa, 0x03, 0xe2, 0xa0, 0xc8, 0x3f, 0xd8, 0xd7, 0xff, 0x1d, 0x00, 0x01, 0x34, 0x79, 0x5d,
+	0xb8, 0x10, 0x00, 0x00,
 }
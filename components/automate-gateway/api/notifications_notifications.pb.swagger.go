@@ -189,6 +189,18 @@ func init() {
       ],
       "default": "CCRFailure"
     },
+    "notificationsDataFeedWebhookAlert": {
+      "type": "object",
+      "properties": {
+        "url": {
+          "type": "string"
+        },
+        "secret_id": {
+          "type": "string"
+        }
+      },
+      "description": "Data feed destinations. These actions are only valid for Assets rules;\nthe secret holds the destination credentials (see data-feed-service)."
+    },
     "notificationsEmpty": {
       "type": "object"
     },
@@ -212,6 +224,15 @@ func init() {
         },
         "ServiceNowAlert": {
           "$ref": "#/definitions/notificationsServiceNowAlert"
+        },
+        "DataFeedWebhookAlert": {
+          "$ref": "#/definitions/notificationsDataFeedWebhookAlert"
+        },
+        "SplunkHecAlert": {
+          "$ref": "#/definitions/notificationsSplunkHecAlert"
+        },
+        "S3ObjectAlert": {
+          "$ref": "#/definitions/notificationsS3ObjectAlert"
         }
       }
     },
@@ -301,6 +322,17 @@ func init() {
         }
       }
     },
+    "notificationsS3ObjectAlert": {
+      "type": "object",
+      "properties": {
+        "url": {
+          "type": "string"
+        },
+        "secret_id": {
+          "type": "string"
+        }
+      }
+    },
     "notificationsSecretId": {
       "type": "object",
       "properties": {
@@ -328,6 +360,17 @@ func init() {
         }
       }
     },
+    "notificationsSplunkHecAlert": {
+      "type": "object",
+      "properties": {
+        "url": {
+          "type": "string"
+        },
+        "secret_id": {
+          "type": "string"
+        }
+      }
+    },
     "notificationsURLValidationRequest": {
       "type": "object",
       "properties": {
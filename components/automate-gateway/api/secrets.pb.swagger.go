@@ -61,6 +61,14 @@ func init() {
             "in": "path",
             "required": true,
             "type": "string"
+          },
+          {
+            "name": "version",
+            "description": "the version to read; the latest version if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
           }
         ],
         "tags": [
@@ -83,6 +91,14 @@ func init() {
             "in": "path",
             "required": true,
             "type": "string"
+          },
+          {
+            "name": "version",
+            "description": "the version to read; the latest version if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
           }
         ],
         "tags": [
@@ -120,6 +136,70 @@ func init() {
         ]
       }
     },
+    "/secrets/id/{id}/rollback": {
+      "post": {
+        "operationId": "Rollback",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/secretsRollbackResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/secretsRollbackRequest"
+            }
+          }
+        ],
+        "tags": [
+          "SecretsService"
+        ]
+      }
+    },
+    "/secrets/id/{id}/versions": {
+      "get": {
+        "operationId": "ListVersions",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/secretsSecretVersions"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "id",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "version",
+            "description": "the version to read; the latest version if unset.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          }
+        ],
+        "tags": [
+          "SecretsService"
+        ]
+      }
+    },
     "/secrets/search": {
       "post": {
         "operationId": "List",
@@ -182,6 +262,11 @@ func init() {
       "properties": {
         "id": {
           "type": "string"
+        },
+        "version": {
+          "type": "integer",
+          "format": "int32",
+          "title": "the version to read; the latest version if unset"
         }
       }
     },
@@ -221,6 +306,42 @@ func init() {
         }
       }
     },
+    "secretsReference": {
+      "type": "object",
+      "properties": {
+        "backend": {
+          "type": "string",
+          "title": "the backend holding the secret, e.g. \"vault\""
+        },
+        "path": {
+          "type": "string",
+          "title": "the path of the secret in the backend, e.g. \"ssh/production\" for Vault,\nrelative to the configured KV mount"
+        }
+      }
+    },
+    "secretsRollbackRequest": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "version": {
+          "type": "integer",
+          "format": "int32",
+          "title": "the version to restore; it becomes the latest version again"
+        }
+      }
+    },
+    "secretsRollbackResponse": {
+      "type": "object",
+      "properties": {
+        "version": {
+          "type": "integer",
+          "format": "int32",
+          "title": "the version created by the rollback"
+        }
+      }
+    },
     "secretsSecret": {
       "type": "object",
       "properties": {
@@ -248,6 +369,46 @@ func init() {
           "items": {
             "$ref": "#/definitions/secretsKv"
           }
+        },
+        "version": {
+          "type": "integer",
+          "format": "int32",
+          "title": "set on read; every update creates a new version"
+        },
+        "reference": {
+          "$ref": "#/definitions/secretsReference",
+          "title": "secret held by an external backend instead of data; its data is read\nfrom the backend whenever the secret is read"
+        }
+      }
+    },
+    "secretsSecretVersion": {
+      "type": "object",
+      "properties": {
+        "version": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "name": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "secretsSecretVersions": {
+      "type": "object",
+      "properties": {
+        "versions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/secretsSecretVersion"
+          },
+          "title": "the retained versions, latest first"
         }
       }
     },
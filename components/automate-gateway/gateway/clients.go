@@ -15,6 +15,7 @@ import (
 	iam_v2beta "github.com/chef/automate/api/interservice/authz/v2"
 	cfgmgmt "github.com/chef/automate/api/interservice/cfgmgmt/service"
 	deployment "github.com/chef/automate/api/interservice/deployment"
+	"github.com/chef/automate/api/interservice/event"
 	chef_ingest "github.com/chef/automate/api/interservice/ingest"
 	license_control "github.com/chef/automate/api/interservice/license_control"
 	"github.com/chef/automate/api/interservice/local_user"
@@ -26,6 +27,7 @@ import (
 	cc_reporting "github.com/chef/automate/components/compliance-service/api/reporting"
 	cc_stats "github.com/chef/automate/components/compliance-service/api/stats"
 	cc_version "github.com/chef/automate/components/compliance-service/api/version"
+	cc_waivers "github.com/chef/automate/components/compliance-service/api/waivers"
 	cc_ingest "github.com/chef/automate/components/compliance-service/ingest/ingest"
 	manager "github.com/chef/automate/components/nodemanager-service/api/manager"
 	nodes "github.com/chef/automate/components/nodemanager-service/api/nodes"
@@ -49,6 +51,7 @@ var defaultEndpoints = map[string]string{
 	"teams-service":           "0.0.0.0:9093",
 	"secrets-service":         "0.0.0.0:10131",
 	"applications-service":    "0.0.0.0:10133",
+	"event-service":           "0.0.0.0:10132",
 	"nodemanager-service":     "0.0.0.0:10120",
 }
 
@@ -90,6 +93,7 @@ type ClientsFactory interface {
 	AuthorizationV2Client() (iam_v2beta.AuthorizationClient, error)
 	PoliciesClient() (iam_v2beta.PoliciesClient, error)
 	ProjectsClient() (iam_v2beta.ProjectsClient, error)
+	AuditClient() (iam_v2beta.AuditClient, error)
 	TeamsV1Client() (teams_v1.TeamsV1Client, error)
 	TeamsV2Client() (teams_v2.TeamsV2Client, error)
 	TokensMgmtClient() (authn.TokensMgmtClient, error)
@@ -104,9 +108,11 @@ type ClientsFactory interface {
 	ComplianceJobsServiceClient() (jobs.JobsServiceClient, error)
 	ComplianceStatsServiceClient() (cc_stats.StatsServiceClient, error)
 	ComplianceVersionServiceClient() (cc_version.VersionServiceClient, error)
+	ComplianceWaiversServiceClient() (cc_waivers.WaiversServiceClient, error)
 	NodeManagerClient() (manager.NodeManagerServiceClient, error)
 	LicenseControlClient() (license_control.LicenseControlClient, error)
 	DeploymentServiceClient() (deployment.DeploymentClient, error)
+	EventServiceClient() (event.EventServiceClient, error)
 }
 
 // clientsFactory caches grpc client connections and returns clients
@@ -303,6 +309,16 @@ func (c *clientsFactory) ProjectsClient() (iam_v2beta.ProjectsClient, error) {
 	return iam_v2beta.NewProjectsClient(conn), nil
 }
 
+// AuditClient returns a client for the Audit service.
+// It requires the `authz` endpoint to be configured
+func (c *clientsFactory) AuditClient() (iam_v2beta.AuditClient, error) {
+	conn, err := c.connectionByName("authz-service")
+	if err != nil {
+		return nil, err
+	}
+	return iam_v2beta.NewAuditClient(conn), nil
+}
+
 // TeamsV1Client returns a V1 client for the Teams service.
 // It requires the `teams` endpoint to be configured
 func (c *clientsFactory) TeamsV1Client() (teams_v1.TeamsV1Client, error) {
@@ -361,6 +377,14 @@ func (c *clientsFactory) ApplicationsClient() (applications.ApplicationsServiceC
 	return applications.NewApplicationsServiceClient(conn), nil
 }
 
+func (c *clientsFactory) EventServiceClient() (event.EventServiceClient, error) {
+	conn, err := c.connectionByName("event-service")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewEventServiceClient(conn), nil
+}
+
 func (c *clientsFactory) SecretClient() (secrets.SecretsServiceClient, error) {
 	conn, err := c.connectionByName("secrets-service")
 	if err != nil {
@@ -417,6 +441,14 @@ func (c *clientsFactory) ComplianceStatsServiceClient() (cc_stats.StatsServiceCl
 	return cc_stats.NewStatsServiceClient(conn), nil
 }
 
+func (c *clientsFactory) ComplianceWaiversServiceClient() (cc_waivers.WaiversServiceClient, error) {
+	conn, err := c.connectionByName("compliance-service")
+	if err != nil {
+		return nil, err
+	}
+	return cc_waivers.NewWaiversServiceClient(conn), nil
+}
+
 func (c *clientsFactory) ComplianceVersionServiceClient() (cc_version.VersionServiceClient, error) {
 	conn, err := c.connectionByName("compliance-service")
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"github.com/chef/automate/components/automate-gateway/api/authz/pairs"
 	"github.com/chef/automate/lib/grpc/auth_context"
 	"github.com/chef/automate/lib/grpc/service_authn"
+	"github.com/chef/automate/lib/grpc/source_ip"
 )
 
 // NewAuthInterceptor returns an AuthInterceptor that performs
@@ -31,11 +32,23 @@ import (
 // service that uses this metadata as outgoing metadata.
 // If the inquiry's result is an error, it is returned as-is. If it's not, this
 // function returns only the context, and allows for further request processing.
+// Client certs forwarded by automate-load-balancer that verify with rootCerts
+// are taken to belong to Automate services.
 func NewAuthInterceptor(
 	authn authn.AuthenticationClient,
 	authz GRPCAuthorizationHandler,
+	rootCerts *x509.CertPool,
 ) AuthorizationInterceptor {
-	return &authInterceptor{authn: authn, authz: authz}
+	return &authInterceptor{authn: authn, authz: authz, serviceCerts: ServiceCertVerifyOptions(rootCerts)}
+}
+
+// ServiceCertVerifyOptions returns the options for verifying that a client cert
+// belongs to an Automate service.
+func ServiceCertVerifyOptions(rootCerts *x509.CertPool) x509.VerifyOptions {
+	return x509.VerifyOptions{
+		Roots:     rootCerts,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
 }
 
 type SwitchingAuthorizationHandler interface {
@@ -98,8 +111,9 @@ type AnnotatedAuthorizationResponse interface {
 }
 
 type authInterceptor struct {
-	authn authn.AuthenticationClient
-	authz GRPCAuthorizationHandler
+	authn        authn.AuthenticationClient
+	authz        GRPCAuthorizationHandler
+	serviceCerts x509.VerifyOptions
 }
 
 // UnaryInterceptor returns a grpc UnaryServerInterceptor that performs AuthN/Z.
@@ -128,14 +142,16 @@ func (a *authInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		var md metadata.MD
 		var ok bool
 		if md, ok = metadata.FromIncomingContext(authCtx); ok {
-			authCtx = metadata.NewOutgoingContext(authCtx, md)
+			clientCert := forwardedClientCert(ctx, a.serviceCerts)
+			outMD := WithSourceIP(WithClientCert(md, clientCert), source_ip.FromContext(ctx))
+			authCtx = metadata.NewOutgoingContext(authCtx, outMD)
 		}
 
 		var subs []string
 		var authResponse *authn.AuthenticateResponse
 		var err error
 
-		certSubject, ok := headerAuthValidForClientAndPeer(ctx)
+		certSubject, ok := headerAuthValidForClientAndPeer(ctx, a.serviceCerts)
 		if ok && !fromGateway(certSubject) {
 			subs = []string{certSubject}
 			log.Debugf("using client cert to authenticate request: %q", certSubject)
@@ -209,7 +225,7 @@ func (a *authInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor
 		// simply skipping the check would be fine, but we'll go and make sure the cert is the one the CLI is
 		// using
 		if _, ok := srv.(DeploymentCertAuthOnly); ok {
-			certSubject, ok := headerAuthValidForClientAndPeer(ss.Context())
+			certSubject, ok := headerAuthValidForClientAndPeer(ss.Context(), a.serviceCerts)
 			if ok && fromDeployment(certSubject) {
 				return handler(srv, ss)
 			}
@@ -230,17 +246,60 @@ func (a *authInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor
 // 3. If that client is NOT automate-load-balancer, we return that and don't
 //    inspect the other headers
 // 4. If the client is automate-load-balancer, we look at the header injected
-//    by automate-load-balancer as the (verified) cert of the client that has
-//    sent the request to automate-load-balancer.
-func headerAuthValidForClientAndPeer(ctx context.Context) (string, bool) {
+//    by automate-load-balancer as the cert of the client that has sent the
+//    request to automate-load-balancer. automate-load-balancer doesn't verify
+//    it against our CA -- certs issued by other CAs are meant for authn-service
+//    -- so we only return it if it verifies with serviceCerts.
+func headerAuthValidForClientAndPeer(ctx context.Context, serviceCerts x509.VerifyOptions) (string, bool) {
+	cert, forwarded, ok := clientCertForPeer(ctx)
+	if !ok {
+		return "", false
+	}
+	if forwarded {
+		if _, err := cert.Verify(serviceCerts); err != nil {
+			return "", false
+		}
+	}
+	return service_authn.ServiceSubjectFromCert(cert)
+}
+
+// forwardedClientCert returns the (escaped) cert of the client that has sent
+// its request to automate-load-balancer if it is meant for authn-service.
+func forwardedClientCert(ctx context.Context, serviceCerts x509.VerifyOptions) string {
+	if _, forwarded, ok := clientCertForPeer(ctx); !ok || !forwarded {
+		return ""
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	return ForwardedClientCert(md.Get("grpcgateway-x-client-cert")[0], serviceCerts)
+}
+
+// ForwardedClientCert returns the passed (escaped) cert, forwarded by
+// automate-load-balancer, if it wasn't issued for an Automate service: those
+// are the certs authn-service authenticates requests by.
+func ForwardedClientCert(escaped string, serviceCerts x509.VerifyOptions) string {
+	cert, ok := certFromString(escaped)
+	if !ok {
+		return ""
+	}
+	if _, err := cert.Verify(serviceCerts); err == nil {
+		return ""
+	}
+	return escaped
+}
+
+// clientCertForPeer follows the steps laid out for
+// headerAuthValidForClientAndPeer to find the cert of the client that has sent
+// the request, and whether it was forwarded by automate-load-balancer.
+func clientCertForPeer(ctx context.Context) (*x509.Certificate, bool, bool) {
 	// what follows is what we get from DIRECT GRPC connections
 	peer, ok := peer.FromContext(ctx)
 	if ok {
 		if tlsInfo, ok := peer.AuthInfo.(credentials.TLSInfo); ok {
 			if len(tlsInfo.State.VerifiedChains) > 0 && len(tlsInfo.State.VerifiedChains[0]) > 0 {
-				sub, ok := service_authn.ServiceSubjectFromCert(tlsInfo.State.VerifiedChains[0][0])
+				cert := tlsInfo.State.VerifiedChains[0][0]
+				sub, ok := service_authn.ServiceSubjectFromCert(cert)
 				if ok && !fromGateway(sub) {
-					return sub, true // any other service => don't inspect metadata
+					return cert, false, true // any other service => don't inspect metadata
 				}
 			}
 		}
@@ -252,42 +311,69 @@ func headerAuthValidForClientAndPeer(ctx context.Context) (string, bool) {
 
 		// this was injected into metadata for requests via HTTPS (grpc-gateway)
 		if vals := md.Get("x-client-cert"); len(vals) == 1 && vals[0] != "" {
-			sub, ok := subjFromCertString(vals[0])
+			cert, ok := certFromString(vals[0])
 			if !ok {
-				return "", false
+				return nil, false, false
 			}
-			if !strings.HasPrefix(sub, "tls:service:automate-load-balancer:") {
-				return sub, true // any other service => don't go on
+			sub, ok := service_authn.ServiceSubjectFromCert(cert)
+			if !ok || !strings.HasPrefix(sub, "tls:service:automate-load-balancer:") {
+				return cert, false, ok // any other service => don't go on
 			}
 
-			// this was checked by automate-load-balancer, passed on as headers, and
+			// this was passed on as headers by automate-load-balancer, and
 			// injected into metadata by grpc-gateway
 			if vals := md.Get("grpcgateway-x-client-cert"); len(vals) == 1 && vals[0] != "" {
-				return subjFromCertString(vals[0])
+				cert, ok := certFromString(vals[0])
+				return cert, true, ok
 			}
 		}
 	}
 
-	return "", false
+	return nil, false, false
 }
 
-func subjFromCertString(escaped string) (string, bool) {
+// WithClientCert returns a copy of md that passes cert -- a client cert
+// obtained via ForwardedClientCert -- on to authn-service, and drops any other
+// client cert metadata: authn-service can't tell where those came from.
+func WithClientCert(md metadata.MD, cert string) metadata.MD {
+	md = md.Copy()
+	delete(md, "grpcgateway-x-client-cert")
+	delete(md, "x-client-cert")
+	if cert != "" {
+		md.Set("x-client-cert", cert)
+	}
+	return md
+}
+
+// WithSourceIP returns a copy of the metadata that forwards the passed source
+// address, replacing any X-Forwarded-For metadata the client has sent. Other
+// services only trust X-Forwarded-For when it is sent by automate-gateway.
+func WithSourceIP(md metadata.MD, sourceIP string) metadata.MD {
+	md = md.Copy()
+	delete(md, source_ip.ForwardedForKey)
+	if sourceIP != "" {
+		md.Set(source_ip.ForwardedForKey, sourceIP)
+	}
+	return md
+}
+
+func certFromString(escaped string) (*x509.Certificate, bool) {
 	bs, err := url.QueryUnescape(escaped)
 	if err != nil {
 		logrus.WithError(err).Error("Failed to decode header container cert")
-		return "", false
+		return nil, false
 	}
 	block, _ := pem.Decode([]byte(bs))
 	if block == nil {
 		logrus.WithError(err).Error("Failed to decode cert")
-		return "", false
+		return nil, false
 	}
 	cert, err := x509.ParseCertificate(block.Bytes)
 	if err != nil {
 		logrus.WithError(err).Error("Failed to parse cert")
-		return "", false
+		return nil, false
 	}
-	return service_authn.ServiceSubjectFromCert(cert)
+	return cert, true
 }
 
 // fromGateway checks if the passed subject indicates the request came from
@@ -2,12 +2,18 @@ package middleware
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"math/big"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,6 +28,7 @@ func TestHeaderAuthValidForClientAndPeer(t *testing.T) {
 	albCert, _ := devCertToEncodedAndPeer(t, "automate-load-balancer")
 	_, agPeer := devCertToEncodedAndPeer(t, "automate-gateway")
 	otherServiceCert, otherServicePeer := devCertToEncodedAndPeer(t, "deployment-service")
+	externalCert := externalCertEncoded(t)
 	hash := "f42fec42094a67caee0c485ee28ec03587170fabfa8a2d7d06188a5acbcee6f0"
 
 	cases := map[string]struct {
@@ -38,6 +45,12 @@ func TestHeaderAuthValidForClientAndPeer(t *testing.T) {
 				peer.NewContext(context.Background(), agPeer),
 				metadata.Pairs("x-client-cert", albCert, "grpcgateway-x-client-cert", otherServiceCert))},
 
+		"from gateway-provided metadata if coming in through ALB, NOT issued by our CA": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), agPeer),
+				metadata.Pairs("x-client-cert", albCert, "grpcgateway-x-client-cert", externalCert)),
+			expectFailure: true},
+
 		"direct request NOT from automate-gateway": {
 			ctx: peer.NewContext(context.Background(), otherServicePeer),
 		},
@@ -64,7 +77,7 @@ func TestHeaderAuthValidForClientAndPeer(t *testing.T) {
 	}
 	for desc, tc := range cases {
 		t.Run(desc, func(t *testing.T) {
-			name, ok := headerAuthValidForClientAndPeer(tc.ctx)
+			name, ok := headerAuthValidForClientAndPeer(tc.ctx, devServiceCertVerifyOptions(t))
 
 			require.Equal(t, !tc.expectFailure, ok, "expected operation result=>%v but actual=>%v", !tc.expectFailure, ok)
 			if !tc.expectFailure {
@@ -74,6 +87,90 @@ func TestHeaderAuthValidForClientAndPeer(t *testing.T) {
 	}
 }
 
+func TestForwardedClientCert(t *testing.T) {
+	albCert, _ := devCertToEncodedAndPeer(t, "automate-load-balancer")
+	_, agPeer := devCertToEncodedAndPeer(t, "automate-gateway")
+	otherServiceCert, otherServicePeer := devCertToEncodedAndPeer(t, "deployment-service")
+	externalCert := externalCertEncoded(t)
+
+	cases := map[string]struct {
+		ctx      context.Context
+		expected string
+	}{
+		"external cert coming in through ALB": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), agPeer),
+				metadata.Pairs("x-client-cert", albCert, "grpcgateway-x-client-cert", externalCert)),
+			expected: externalCert,
+		},
+		"service cert coming in through ALB": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), agPeer),
+				metadata.Pairs("x-client-cert", albCert, "grpcgateway-x-client-cert", otherServiceCert)),
+		},
+		"external cert NOT coming in through ALB": {
+			ctx: metadata.NewIncomingContext(
+				context.Background(),
+				metadata.Pairs("grpcgateway-x-client-cert", externalCert)),
+		},
+		"external cert in metadata of direct request NOT from automate-gateway": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), otherServicePeer),
+				metadata.Pairs("x-client-cert", albCert, "grpcgateway-x-client-cert", externalCert)),
+		},
+		"no metadata": {
+			ctx: context.Background(),
+		},
+	}
+	for desc, tc := range cases {
+		t.Run(desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, forwardedClientCert(tc.ctx, devServiceCertVerifyOptions(t)))
+		})
+	}
+}
+
+func TestWithClientCert(t *testing.T) {
+	md := metadata.Pairs(
+		"x-client-cert", "alb-cert",
+		"grpcgateway-x-client-cert", "forged-cert",
+		"grpcgateway-api-token", "token")
+
+	t.Run("passes the cert on", func(t *testing.T) {
+		out := WithClientCert(md, "client-cert")
+		assert.Equal(t, []string{"client-cert"}, out.Get("x-client-cert"))
+		assert.Empty(t, out.Get("grpcgateway-x-client-cert"))
+		assert.Equal(t, []string{"token"}, out.Get("grpcgateway-api-token"))
+	})
+
+	t.Run("drops all client certs without a cert", func(t *testing.T) {
+		out := WithClientCert(md, "")
+		assert.Empty(t, out.Get("x-client-cert"))
+		assert.Empty(t, out.Get("grpcgateway-x-client-cert"))
+		assert.Equal(t, []string{"token"}, out.Get("grpcgateway-api-token"))
+	})
+
+	assert.Equal(t, []string{"alb-cert"}, md.Get("x-client-cert"), "leaves the passed metadata alone")
+}
+
+func TestWithSourceIP(t *testing.T) {
+	md := metadata.Pairs(
+		"x-forwarded-for", "203.0.113.66, 192.0.2.10",
+		"grpcgateway-api-token", "token")
+
+	t.Run("replaces the forwarded addresses", func(t *testing.T) {
+		out := WithSourceIP(md, "192.0.2.10")
+		assert.Equal(t, []string{"192.0.2.10"}, out.Get("x-forwarded-for"))
+		assert.Equal(t, []string{"token"}, out.Get("grpcgateway-api-token"))
+	})
+
+	t.Run("drops the forwarded addresses without a source address", func(t *testing.T) {
+		out := WithSourceIP(md, "")
+		assert.Empty(t, out.Get("x-forwarded-for"))
+	})
+
+	assert.Equal(t, []string{"203.0.113.66, 192.0.2.10"}, md.Get("x-forwarded-for"), "leaves the passed metadata alone")
+}
+
 func TestGetProjectsFromMetadata(t *testing.T) {
 	cases := map[string]struct {
 		input    []string
@@ -127,3 +224,34 @@ func devCertToEncodedAndPeer(t *testing.T, service string) (string, *peer.Peer)
 	}
 	return url.QueryEscape(string(block)), &p
 }
+
+// devServiceCertVerifyOptions returns the options for verifying service certs
+// issued by the dev CA, at a time when they were valid
+func devServiceCertVerifyOptions(t *testing.T) x509.VerifyOptions {
+	t.Helper()
+
+	serviceCerts := helpers.LoadDevCerts(t, "automate-gateway")
+	opts := ServiceCertVerifyOptions(serviceCerts.NewCertPool())
+	opts.CurrentTime = serviceCerts.RootCACert.NotAfter.Add(-24 * time.Hour)
+	return opts
+}
+
+// externalCertEncoded returns a client cert that was NOT issued by the dev CA,
+// encoded like automate-load-balancer passes it on
+func externalCertEncoded(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ci-agent"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	block := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return url.QueryEscape(string(block))
+}
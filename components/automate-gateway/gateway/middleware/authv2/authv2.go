@@ -14,6 +14,7 @@ import (
 	policy "github.com/chef/automate/components/automate-gateway/authz/policy_v2"
 	"github.com/chef/automate/components/automate-gateway/gateway/middleware"
 	"github.com/chef/automate/lib/grpc/auth_context"
+	"github.com/chef/automate/lib/grpc/source_ip"
 )
 
 type client struct {
@@ -67,6 +68,7 @@ func (c *client) Handle(ctx context.Context, subjects []string, projectsToFilter
 		Subjects: subjects,
 		Resource: resource,
 		Action:   action,
+		SourceIp: source_ip.FromContext(ctx),
 	})
 	if err != nil {
 		if status.Convert(err).Code() == codes.FailedPrecondition {
@@ -123,6 +125,7 @@ func (c *client) IsAuthorized(ctx context.Context, subjects []string, resource,
 		Subjects: subjects,
 		Resource: resource,
 		Action:   action,
+		SourceIp: source_ip.FromContext(ctx),
 	})
 }
 
@@ -136,6 +139,7 @@ func (c *client) FilterAuthorizedPairs(ctx context.Context, subjects []string, i
 	resp, err := c.client.FilterAuthorizedPairs(ctx, &authz.FilterAuthorizedPairsReq{
 		Subjects: subjects,
 		Pairs:    pairsV2,
+		SourceIp: source_ip.FromContext(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -157,6 +161,7 @@ func (c *client) FilterAuthorizedProjects(ctx context.Context, subjects []string
 	resp, err := c.client.FilterAuthorizedProjects(ctx, &authz.FilterAuthorizedPairsReq{
 		Subjects: subjects,
 		Pairs:    pairsV2,
+		SourceIp: source_ip.FromContext(ctx),
 	})
 	if err != nil {
 		return nil, err
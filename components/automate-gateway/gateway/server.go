@@ -274,7 +274,7 @@ func (s *Server) NewGRPCServer() (*grpc.Server, error) {
 		return nil, errors.Wrap(err, "create auth client")
 	}
 
-	authInterceptor := middleware.NewAuthInterceptor(authClient, s.authorizer)
+	authInterceptor := middleware.NewAuthInterceptor(authClient, s.authorizer, s.rootCerts)
 
 	logrusEntry := log.NewEntry(log.StandardLogger())
 
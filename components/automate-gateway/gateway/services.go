@@ -20,6 +20,7 @@ import (
 	// PB-generated imports
 	pb_apps "github.com/chef/automate/api/external/applications"
 	pb_cfgmgmt "github.com/chef/automate/api/external/cfgmgmt"
+	pb_event_history "github.com/chef/automate/api/external/event_history"
 	pb_ingest "github.com/chef/automate/api/external/ingest"
 	pb_secrets "github.com/chef/automate/api/external/secrets"
 	"github.com/chef/automate/api/interservice/authn"
@@ -34,6 +35,7 @@ import (
 	pb_cc_reporting "github.com/chef/automate/components/automate-gateway/api/compliance/reporting"
 	pb_cc_stats "github.com/chef/automate/components/automate-gateway/api/compliance/reporting/stats"
 	pb_cc_jobs "github.com/chef/automate/components/automate-gateway/api/compliance/scanner/jobs"
+	pb_cc_waivers "github.com/chef/automate/components/automate-gateway/api/compliance/waivers"
 	pb_deployment "github.com/chef/automate/components/automate-gateway/api/deployment"
 	pb_eventfeed "github.com/chef/automate/components/automate-gateway/api/event_feed"
 	pb_gateway "github.com/chef/automate/components/automate-gateway/api/gateway"
@@ -147,7 +149,12 @@ func (s *Server) RegisterGRPCServices(grpcServer *grpc.Server) error {
 	if err != nil {
 		return errors.Wrap(err, "create projects client for authz-service")
 	}
-	pb_iam_v2beta.RegisterPoliciesServer(grpcServer, handler_policies.NewServer(policiesClient, projectsClient))
+	auditClient, err := clients.AuditClient()
+	if err != nil {
+		return errors.Wrap(err, "create audit client for authz-service")
+	}
+	pb_iam_v2beta.RegisterPoliciesServer(grpcServer,
+		handler_policies.NewServer(policiesClient, projectsClient, auditClient, authzV2Client))
 
 	tokensMgmtClient, err := clients.TokensMgmtClient()
 	if err != nil {
@@ -189,12 +196,24 @@ func (s *Server) RegisterGRPCServices(grpcServer *grpc.Server) error {
 	}
 	pb_apps.RegisterApplicationsServiceServer(grpcServer, handler.NewApplicationsHandler(applicationsClient))
 
+	eventClient, err := clients.EventServiceClient()
+	if err != nil {
+		return errors.Wrap(err, "create client for event service")
+	}
+	pb_event_history.RegisterEventHistoryServer(grpcServer, handler.NewEventHistoryHandler(eventClient))
+
 	jobsClient, err := clients.ComplianceJobsServiceClient()
 	if err != nil {
 		return errors.Wrap(err, "create client for compliances jobs service")
 	}
 	pb_cc_jobs.RegisterJobsServiceServer(grpcServer, handler_compliance.NewJobsHandler(jobsClient))
 
+	waiversClient, err := clients.ComplianceWaiversServiceClient()
+	if err != nil {
+		return errors.Wrap(err, "create client for compliance waivers service")
+	}
+	pb_cc_waivers.RegisterWaiversServiceServer(grpcServer, handler_compliance.NewWaiversHandler(waiversClient))
+
 	nodesClient, err := clients.NodesClient()
 	if err != nil {
 		return errors.Wrap(err, "create client for nodes service")
@@ -263,6 +282,7 @@ type registerFunc func(context.Context, *runtime.ServeMux, string, []grpc.DialOp
 func unversionedRESTMux(grpcURI string, dopts []grpc.DialOption) (http.Handler, error) {
 	return muxFromRegisterMap(grpcURI, dopts, map[string]registerFunc{
 		"event feed":           pb_eventfeed.RegisterEventFeedHandlerFromEndpoint,
+		"event history":        pb_event_history.RegisterEventHistoryHandlerFromEndpoint,
 		"config management":    pb_cfgmgmt.RegisterConfigMgmtHandlerFromEndpoint,
 		"chef ingestion":       pb_ingest.RegisterChefIngesterHandlerFromEndpoint,
 		"deployment":           pb_deployment.RegisterDeploymentHandlerFromEndpoint,
@@ -278,6 +298,7 @@ func unversionedRESTMux(grpcURI string, dopts []grpc.DialOption) (http.Handler,
 		"cc_reporting":         pb_cc_reporting.RegisterReportingServiceHandlerFromEndpoint,
 		"cc_stats":             pb_cc_stats.RegisterStatsServiceHandlerFromEndpoint,
 		"cc_jobs":              pb_cc_jobs.RegisterJobsServiceHandlerFromEndpoint,
+		"cc_waivers":           pb_cc_waivers.RegisterWaiversServiceHandlerFromEndpoint,
 		"nodes":                pb_nodes.RegisterNodesServiceHandlerFromEndpoint,
 		"profiles":             pb_profiles.RegisterProfilesServiceHandlerFromEndpoint,
 		"teams-service":        pb_teams.RegisterTeamsHandlerFromEndpoint,
@@ -493,6 +514,16 @@ func (s *Server) ProfileTarHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// reportExportContentTypes are the content types of the report export formats
+var reportExportContentTypes = map[string]string{
+	"":      "application/json",
+	"json":  "application/json",
+	"csv":   "text/csv",
+	"junit": "application/xml",
+	"sarif": "application/sarif+json",
+	"html":  "text/html; charset=utf-8",
+}
+
 func (s *Server) ReportExportHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithCancel(r.Context())
 	defer cancel()
@@ -530,6 +561,9 @@ func (s *Server) ReportExportHandler(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if contentType, ok := reportExportContentTypes[query.Type]; ok {
+		w.Header().Set("Content-Type", contentType)
+	}
 	for {
 		data, err := stream.Recv()
 		if err == io.EOF {
@@ -612,7 +646,6 @@ func (s *Server) authRequest(r *http.Request,
 	// does this, but since this is being used in a custom handler we've got do
 	// it ourselves.
 	md := metadataFromRequest(r)
-	ctx := metadata.NewOutgoingContext(r.Context(), md)
 
 	// Handle certificate based authn:
 	//
@@ -620,20 +653,24 @@ func (s *Server) authRequest(r *http.Request,
 	// originate from the automate load balancer then generate the policy
 	// subject from the certificate's 'Common Name' and skip token based authn.
 	//
-	// This intentionally skips all requests that originate from the automate load
-	// balancer. If we wish to extend certificate based authn to custom handlers
-	// from the automate load balancer we'll need to decode the x-client-cert from
-	// the request metadata to build the policy subject from it.
+	// Requests that originate from the automate load balancer are
+	// authenticated by authn-service. If the load balancer has passed on a
+	// certificate that wasn't issued for an Automate service, authn-service
+	// gets it, too.
+	clientCert := ""
 	if tls := r.TLS; tls != nil {
 		if len(tls.VerifiedChains) > 0 && len(tls.VerifiedChains[0]) > 0 {
 			sub, ok := service_authn.ServiceSubjectFromCert(tls.VerifiedChains[0][0])
 			if ok {
 				if !strings.HasPrefix(sub, "tls:service:automate-load-balancer:") {
 					subjects = append(subjects, sub)
+				} else if fwd := r.Header.Get("X-Client-Cert"); fwd != "" {
+					clientCert = middleware.ForwardedClientCert(fwd, middleware.ServiceCertVerifyOptions(s.rootCerts))
 				}
 			}
 		}
 	}
+	ctx := metadata.NewOutgoingContext(r.Context(), middleware.WithClientCert(md, clientCert))
 
 	if len(subjects) < 1 {
 		authnClient, err := s.clientsFactory.AuthenticationClient()
@@ -12,6 +12,7 @@ import (
 	v2 "github.com/chef/automate/api/interservice/authz/v2"
 	service "github.com/chef/automate/api/interservice/cfgmgmt/service"
 	deployment "github.com/chef/automate/api/interservice/deployment"
+	event "github.com/chef/automate/api/interservice/event"
 	ingest "github.com/chef/automate/api/interservice/ingest"
 	license_control "github.com/chef/automate/api/interservice/license_control"
 	local_user "github.com/chef/automate/api/interservice/local_user"
@@ -23,6 +24,7 @@ import (
 	reporting "github.com/chef/automate/components/compliance-service/api/reporting"
 	stats "github.com/chef/automate/components/compliance-service/api/stats"
 	version "github.com/chef/automate/components/compliance-service/api/version"
+	waivers "github.com/chef/automate/components/compliance-service/api/waivers"
 	ingest0 "github.com/chef/automate/components/compliance-service/ingest/ingest"
 	manager "github.com/chef/automate/components/nodemanager-service/api/manager"
 	nodes "github.com/chef/automate/components/nodemanager-service/api/nodes"
@@ -220,6 +222,21 @@ func (mr *MockClientsFactoryMockRecorder) ProjectsClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectsClient", reflect.TypeOf((*MockClientsFactory)(nil).ProjectsClient))
 }
 
+// AuditClient mocks base method
+func (m *MockClientsFactory) AuditClient() (v2.AuditClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditClient")
+	ret0, _ := ret[0].(v2.AuditClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditClient indicates an expected call of AuditClient
+func (mr *MockClientsFactoryMockRecorder) AuditClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditClient", reflect.TypeOf((*MockClientsFactory)(nil).AuditClient))
+}
+
 // TeamsV1Client mocks base method
 func (m *MockClientsFactory) TeamsV1Client() (v1.TeamsV1Client, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +417,21 @@ func (mr *MockClientsFactoryMockRecorder) ComplianceJobsServiceClient() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComplianceJobsServiceClient", reflect.TypeOf((*MockClientsFactory)(nil).ComplianceJobsServiceClient))
 }
 
+// ComplianceWaiversServiceClient mocks base method
+func (m *MockClientsFactory) ComplianceWaiversServiceClient() (waivers.WaiversServiceClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ComplianceWaiversServiceClient")
+	ret0, _ := ret[0].(waivers.WaiversServiceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ComplianceWaiversServiceClient indicates an expected call of ComplianceWaiversServiceClient
+func (mr *MockClientsFactoryMockRecorder) ComplianceWaiversServiceClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComplianceWaiversServiceClient", reflect.TypeOf((*MockClientsFactory)(nil).ComplianceWaiversServiceClient))
+}
+
 // ComplianceStatsServiceClient mocks base method
 func (m *MockClientsFactory) ComplianceStatsServiceClient() (stats.StatsServiceClient, error) {
 	m.ctrl.T.Helper()
@@ -474,3 +506,18 @@ func (mr *MockClientsFactoryMockRecorder) DeploymentServiceClient() *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeploymentServiceClient", reflect.TypeOf((*MockClientsFactory)(nil).DeploymentServiceClient))
 }
+
+// EventServiceClient mocks base method
+func (m *MockClientsFactory) EventServiceClient() (event.EventServiceClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventServiceClient")
+	ret0, _ := ret[0].(event.EventServiceClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventServiceClient indicates an expected call of EventServiceClient
+func (mr *MockClientsFactoryMockRecorder) EventServiceClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventServiceClient", reflect.TypeOf((*MockClientsFactory)(nil).EventServiceClient))
+}
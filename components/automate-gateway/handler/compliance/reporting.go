@@ -60,6 +60,20 @@ func (a *Reporting) ReadReport(ctx context.Context, in *reporting.Query) (*repor
 	return out, nil
 }
 
+// should cover /reports/diff
+func (a *Reporting) DiffReports(ctx context.Context, in *reporting.DiffQuery) (*reporting.ReportDiff, error) {
+	inDomain := &reportingService.DiffQuery{}
+	out := &reporting.ReportDiff{}
+	f := func() (proto.Message, error) {
+		return a.client.DiffReports(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // should cover /suggestions
 func (a *Reporting) ListSuggestions(ctx context.Context, in *reporting.SuggestionRequest) (*reporting.Suggestions, error) {
 	inDomain := &reportingService.SuggestionRequest{}
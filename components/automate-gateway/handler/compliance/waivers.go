@@ -0,0 +1,92 @@
+package compliance
+
+import (
+	"context"
+
+	"github.com/chef/automate/components/automate-gateway/api/compliance/waivers"
+	"github.com/chef/automate/components/automate-gateway/gateway/middleware"
+	"github.com/chef/automate/components/automate-gateway/protobuf"
+	waiversService "github.com/chef/automate/components/compliance-service/api/waivers"
+	"github.com/golang/protobuf/proto"
+	gp "github.com/golang/protobuf/ptypes/empty"
+)
+
+type Waivers struct {
+	client waiversService.WaiversServiceClient
+}
+
+// asserts that we satisfy the correct interface here -- it's a safeguard
+var _ middleware.AuthContextReader = (*Waivers)(nil)
+
+func (*Waivers) AuthContextRead() {}
+
+func NewWaiversHandler(waiversClient waiversService.WaiversServiceClient) *Waivers {
+	return &Waivers{
+		client: waiversClient,
+	}
+}
+
+func (a *Waivers) Create(ctx context.Context, in *waivers.Waiver) (*waivers.Id, error) {
+	inDomain := &waiversService.Waiver{}
+	out := &waivers.Id{}
+	f := func() (proto.Message, error) {
+		return a.client.Create(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a *Waivers) Read(ctx context.Context, in *waivers.Id) (*waivers.Waiver, error) {
+	inDomain := &waiversService.Id{}
+	out := &waivers.Waiver{}
+	f := func() (proto.Message, error) {
+		return a.client.Read(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a *Waivers) Update(ctx context.Context, in *waivers.Waiver) (*gp.Empty, error) {
+	inDomain := &waiversService.Waiver{}
+	out := &gp.Empty{}
+	f := func() (proto.Message, error) {
+		return a.client.Update(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a *Waivers) Delete(ctx context.Context, in *waivers.Id) (*gp.Empty, error) {
+	inDomain := &waiversService.Id{}
+	out := &gp.Empty{}
+	f := func() (proto.Message, error) {
+		return a.client.Delete(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (a *Waivers) List(ctx context.Context, in *waivers.Query) (*waivers.Waivers, error) {
+	inDomain := &waiversService.Query{}
+	out := &waivers.Waivers{}
+	f := func() (proto.Message, error) {
+		return a.client.List(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/chef/automate/api/external/event_history"
+	"github.com/chef/automate/api/interservice/event"
+)
+
+// EventHistory - the event history service data structure
+type EventHistory struct {
+	client event.EventServiceClient
+}
+
+// NewEventHistoryHandler - create a new event history service handler
+func NewEventHistoryHandler(eventClient event.EventServiceClient) *EventHistory {
+	return &EventHistory{
+		client: eventClient,
+	}
+}
+
+// ListEvents returns a page of the events recorded by the event service,
+// along with how each was delivered to its subscribers
+func (e *EventHistory) ListEvents(
+	ctx context.Context,
+	in *event_history.ListEventsRequest) (*event_history.ListEventsResponse, error) {
+
+	resp, err := e.client.ListEvents(ctx, &event.ListEventsRequest{
+		EventType: in.EventType,
+		Producer:  in.Producer,
+		Start:     in.Start,
+		End:       in.End,
+		Page:      in.Page,
+		PerPage:   in.PerPage,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*event_history.Event, len(resp.Events))
+	for i, record := range resp.Events {
+		events[i] = toExternalEvent(record)
+	}
+	return &event_history.ListEventsResponse{
+		Events: events,
+		Total:  resp.Total,
+	}, nil
+}
+
+func toExternalEvent(record *event.EventRecord) *event_history.Event {
+	msg := record.GetEvent()
+	deliveries := make([]*event_history.Delivery, len(record.Deliveries))
+	for i, d := range record.Deliveries {
+		deliveries[i] = &event_history.Delivery{
+			SubscriptionId: d.SubscriptionID,
+			Status:         d.Status,
+			Attempts:       d.Attempts,
+			LastError:      d.LastError,
+			Completed:      d.Completed,
+		}
+	}
+
+	return &event_history.Event{
+		Id:        msg.GetEventID(),
+		EventType: msg.GetType().GetName(),
+		Producer: &event_history.Entity{
+			Id:         msg.GetProducer().GetID(),
+			Name:       msg.GetProducer().GetProducerName(),
+			ObjectType: msg.GetProducer().GetProducerType(),
+		},
+		Tags:      msg.GetTags(),
+		Published: msg.GetPublished(),
+		Actor: &event_history.Entity{
+			Id:         msg.GetActor().GetID(),
+			Name:       msg.GetActor().GetDisplayName(),
+			ObjectType: msg.GetActor().GetObjectType(),
+		},
+		Verb: msg.GetVerb(),
+		Object: &event_history.Entity{
+			Id:         msg.GetObject().GetID(),
+			Name:       msg.GetObject().GetDisplayName(),
+			ObjectType: msg.GetObject().GetObjectType(),
+		},
+		Target: &event_history.Entity{
+			Id:         msg.GetTarget().GetID(),
+			Name:       msg.GetTarget().GetDisplayName(),
+			ObjectType: msg.GetTarget().GetObjectType(),
+		},
+		Data:       msg.GetData(),
+		Received:   record.Received,
+		Deliveries: deliveries,
+	}
+}
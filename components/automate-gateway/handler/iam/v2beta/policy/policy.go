@@ -3,6 +3,7 @@ package policy
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -17,15 +18,20 @@ import (
 
 // Server is the server interface
 type Server struct {
-	policies authz.PoliciesClient
-	projects authz.ProjectsClient
+	policies   authz.PoliciesClient
+	projects   authz.ProjectsClient
+	audit      authz.AuditClient
+	authorizer authz.AuthorizationClient
 }
 
 // NewServer creates a server with its client.
-func NewServer(policies authz.PoliciesClient, projects authz.ProjectsClient) *Server {
+func NewServer(policies authz.PoliciesClient, projects authz.ProjectsClient,
+	audit authz.AuditClient, authorizer authz.AuthorizationClient) *Server {
 	return &Server{
-		policies: policies,
-		projects: projects,
+		policies:   policies,
+		projects:   projects,
+		audit:      audit,
+		authorizer: authorizer,
 	}
 }
 
@@ -255,6 +261,103 @@ func (p *Server) ResetToV1(
 	return &pb_resp.ResetToV1Resp{}, nil
 }
 
+// ExportState returns all IAM v2 policies, roles, and projects.
+func (p *Server) ExportState(
+	ctx context.Context, in *pb_req.ExportStateReq) (*pb_resp.ExportStateResp, error) {
+	resp, err := p.policies.ExportState(ctx, &authz.ExportStateReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*pb_common.Policy, len(resp.Policies))
+	for i, pol := range resp.Policies {
+		policies[i], err = convertDomainPolicyToAPIPolicy(pol)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	roles := make([]*pb_common.Role, len(resp.Roles))
+	for i, role := range resp.Roles {
+		roles[i], err = internalRoleToAPIRole(role)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	projects := make([]*pb_common.Project, len(resp.Projects))
+	for i, project := range resp.Projects {
+		projects[i], err = domainProjectToAPIProject(project)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &pb_resp.ExportStateResp{
+		Policies: policies,
+		Roles:    roles,
+		Projects: projects,
+	}, nil
+}
+
+// ImportState makes the IAM v2 policies, roles, and projects match those
+// passed in, or only reports the changes that would take on a dry run.
+func (p *Server) ImportState(
+	ctx context.Context, in *pb_req.ImportStateReq) (*pb_resp.ImportStateResp, error) {
+	policies := make([]*authz.Policy, len(in.Policies))
+	for i, pol := range in.Policies {
+		statements, err := convertAPIStateStatementSliceToDomain(pol.Statements)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument,
+				errors.Wrapf(err, "could not parse statements of policy %q", pol.Id).Error())
+		}
+		policies[i] = &authz.Policy{
+			Id:         pol.Id,
+			Name:       pol.Name,
+			Type:       typeToInternalType(pol.Type),
+			Members:    pol.Members,
+			Statements: statements,
+			Projects:   pol.Projects,
+		}
+	}
+	roles := make([]*authz.Role, len(in.Roles))
+	for i, role := range in.Roles {
+		roles[i] = &authz.Role{
+			Id:       role.Id,
+			Name:     role.Name,
+			Type:     typeToInternalType(role.Type),
+			Actions:  role.Actions,
+			Projects: role.Projects,
+		}
+	}
+	projects := make([]*authz.Project, len(in.Projects))
+	for i, project := range in.Projects {
+		projects[i] = &authz.Project{
+			Id:   project.Id,
+			Name: project.Name,
+			Type: typeToInternalType(project.Type),
+		}
+	}
+
+	resp, err := p.policies.ImportState(ctx, &authz.ImportStateReq{
+		Policies: policies,
+		Roles:    roles,
+		Projects: projects,
+		DryRun:   in.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*pb_common.StateChange, len(resp.Changes))
+	for i, c := range resp.Changes {
+		changes[i] = &pb_common.StateChange{
+			Operation: pb_common.StateChange_Operation(pb_common.StateChange_Operation_value[c.Operation.String()]),
+			Kind:      c.Kind,
+			Id:        c.Id,
+			Reason:    c.Reason,
+		}
+	}
+	return &pb_resp.ImportStateResp{Changes: changes}, nil
+}
+
 // CreateRole creates a new role.
 func (p *Server) CreateRole(
 	ctx context.Context, in *pb_req.CreateRoleReq) (*pb_resp.CreateRoleResp, error) {
@@ -435,6 +538,89 @@ func (p *Server) DeleteProject(
 	return &pb_resp.DeleteProjectResp{}, nil
 }
 
+// ListDecisions lists the authorization decisions recorded in the audit log.
+func (p *Server) ListDecisions(
+	ctx context.Context, in *pb_req.ListDecisionsReq) (*pb_resp.ListDecisionsResp, error) {
+	result, ok := authz.ListDecisionsReq_Result_value[in.Result.String()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unexpected result %q", in.Result)
+	}
+	resp, err := p.audit.ListDecisions(ctx, &authz.ListDecisionsReq{
+		Subject:  in.Subject,
+		Action:   in.Action,
+		Resource: in.Resource,
+		Result:   authz.ListDecisionsReq_Result(result),
+		Since:    in.Since,
+		Until:    in.Until,
+		Limit:    in.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]*pb_common.Decision, len(resp.Decisions))
+	for i, d := range resp.Decisions {
+		decisions[i], err = domainDecisionToAPIDecision(d)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &pb_resp.ListDecisionsResp{Decisions: decisions}, nil
+}
+
+// ExplainAuthorization returns the decision for the passed subjects, action,
+// and resource, together with the policy statements it was based on.
+func (p *Server) ExplainAuthorization(
+	ctx context.Context, in *pb_req.ExplainAuthorizationReq) (*pb_resp.ExplainAuthorizationResp, error) {
+	policies := make([]*authz.Policy, len(in.Policies))
+	for i, pol := range in.Policies {
+		statements, err := convertAPIStatementSliceToDomain(pol.Statements)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument,
+				errors.Wrapf(err, "could not parse statements of policy %q", pol.Id).Error())
+		}
+		policies[i] = &authz.Policy{
+			Id:         pol.Id,
+			Name:       pol.Name,
+			Members:    pol.Members,
+			Statements: statements,
+			Projects:   pol.Projects,
+		}
+	}
+
+	resp, err := p.authorizer.ExplainAuthorization(ctx, &authz.ExplainAuthorizationReq{
+		Subjects: in.Subjects,
+		Action:   in.Action,
+		Resource: in.Resource,
+		Projects: in.Projects,
+		Policies: policies,
+		SourceIp: in.SourceIp,
+		Time:     in.Time,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statements := make([]*pb_common.ExplainedStatement, len(resp.Statements))
+	for i, st := range resp.Statements {
+		converted, err := convertDomainStatementSliceToAPI([]*authz.Statement{st.Statement})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		statements[i] = &pb_common.ExplainedStatement{
+			PolicyId:    st.PolicyId,
+			StatementId: st.StatementId,
+			Statement:   converted[0],
+			Projects:    st.Statement.Projects,
+		}
+	}
+	return &pb_resp.ExplainAuthorizationResp{
+		Authorized: resp.Authorized,
+		Projects:   resp.Projects,
+		Statements: statements,
+	}, nil
+}
+
 func convertDomainPolicyToAPIPolicy(policy *authz.Policy) (*pb_common.Policy, error) {
 	statements, err := convertDomainStatementSliceToAPI(policy.Statements)
 	if err != nil {
@@ -472,9 +658,32 @@ func convertAPIStatementSliceToDomain(external []*pb_common.Statement) ([]*authz
 
 		// Note: this is where we ignore the request's statements' resources
 		internal = append(internal, &authz.Statement{
-			Effect:  authz.Statement_Effect(effectValue),
-			Actions: statement.Actions,
-			Role:    statement.Role,
+			Effect:     authz.Statement_Effect(effectValue),
+			Actions:    statement.Actions,
+			Role:       statement.Role,
+			Conditions: convertAPIConditionsToDomain(statement.Conditions),
+		})
+	}
+	return internal, nil
+}
+
+// convertAPIStateStatementSliceToDomain is like convertAPIStatementSliceToDomain,
+// but keeps the statements' resources and projects: imported statements are
+// stored as they were exported.
+func convertAPIStateStatementSliceToDomain(external []*pb_common.Statement) ([]*authz.Statement, error) {
+	var internal []*authz.Statement
+	for _, statement := range external {
+		effectValue, ok := authz.Statement_Effect_value[statement.Effect.String()]
+		if !ok {
+			return nil, errors.New("could not parse statement effect")
+		}
+		internal = append(internal, &authz.Statement{
+			Effect:     authz.Statement_Effect(effectValue),
+			Actions:    statement.Actions,
+			Role:       statement.Role,
+			Resources:  statement.Resources,
+			Projects:   statement.Projects,
+			Conditions: convertAPIConditionsToDomain(statement.Conditions),
 		})
 	}
 	return internal, nil
@@ -489,15 +698,47 @@ func convertDomainStatementSliceToAPI(internal []*authz.Statement) ([]*pb_common
 		}
 		effect := pb_common.Statement_Effect(effectValue)
 		external = append(external, &pb_common.Statement{
-			Effect:    effect,
-			Actions:   statement.Actions,
-			Role:      statement.Role,
-			Resources: statement.Resources,
+			Effect:     effect,
+			Actions:    statement.Actions,
+			Role:       statement.Role,
+			Resources:  statement.Resources,
+			Projects:   statement.Projects,
+			Conditions: convertDomainConditionsToAPI(statement.Conditions),
 		})
 	}
 	return external, nil
 }
 
+func convertAPIConditionsToDomain(external *pb_common.Conditions) *authz.Conditions {
+	if external == nil {
+		return nil
+	}
+	windows := make([]*authz.TimeWindow, len(external.TimeWindows))
+	for i, w := range external.TimeWindows {
+		windows[i] = &authz.TimeWindow{Start: w.Start, End: w.End}
+	}
+	return &authz.Conditions{
+		TimeWindows:  windows,
+		SourceIps:    external.SourceIps,
+		SubjectTypes: external.SubjectTypes,
+	}
+}
+
+func convertDomainConditionsToAPI(internal *authz.Conditions) *pb_common.Conditions {
+	if internal == nil {
+		return nil
+	}
+	windows := make([]*pb_common.TimeWindow, len(internal.TimeWindows))
+	for i, w := range internal.TimeWindows {
+		windows[i] = &pb_common.TimeWindow{Start: w.Start, End: w.End}
+	}
+	return &pb_common.Conditions{
+		TimeWindows:  windows,
+		SourceIps:    internal.SourceIps,
+		SubjectTypes: internal.SubjectTypes,
+	}
+}
+
 func internalRoleToAPIRole(role *authz.Role) (*pb_common.Role, error) {
 	t, err := typeFromInternalType(role.Type)
 	if err != nil {
@@ -536,6 +777,13 @@ func typeFromInternalType(s authz.Type) (pb_common.Type, error) {
 	}
 }
 
+func typeToInternalType(t pb_common.Type) authz.Type {
+	if t == pb_common.Type_CHEF_MANAGED {
+		return authz.Type_CHEF_MANAGED
+	}
+	return authz.Type_CUSTOM
+}
+
 func domainProjectToAPIProject(p *authz.Project) (*pb_common.Project, error) {
 	t, err := typeFromInternalType(p.Type)
 	if err != nil {
@@ -548,3 +796,29 @@ func domainProjectToAPIProject(p *authz.Project) (*pb_common.Project, error) {
 		Projects: p.Projects,
 	}, nil
 }
+
+func domainDecisionToAPIDecision(d *authz.Decision) (*pb_common.Decision, error) {
+	matches := make([]*pb_common.DecisionMatch, len(d.Matches))
+	for i, m := range d.Matches {
+		effectValue, ok := pb_common.Statement_Effect_value[strings.ToUpper(m.Effect)]
+		if !ok {
+			return nil, fmt.Errorf("unexpected effect %q for statement %q", m.Effect, m.StatementId)
+		}
+		matches[i] = &pb_common.DecisionMatch{
+			Effect:      pb_common.Statement_Effect(effectValue),
+			PolicyId:    m.PolicyId,
+			StatementId: m.StatementId,
+		}
+	}
+	return &pb_common.Decision{
+		Time:               d.Time,
+		Method:             d.Method,
+		Subjects:           d.Subjects,
+		Action:             d.Action,
+		Resource:           d.Resource,
+		Projects:           d.Projects,
+		Allowed:            d.Allowed,
+		AuthorizedProjects: d.AuthorizedProjects,
+		Matches:            matches,
+	}, nil
+}
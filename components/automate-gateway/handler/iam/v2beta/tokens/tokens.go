@@ -32,6 +32,7 @@ func (s *Server) CreateToken(
 			Active:      in.Active,
 			Value:       in.Value,
 			Projects:    in.Projects,
+			Expires:     in.ExpiresAt,
 		}
 		token, err = s.client.CreateTokenWithValue(ctx, req)
 	} else {
@@ -40,6 +41,7 @@ func (s *Server) CreateToken(
 			Description: in.Name,
 			Active:      in.Active,
 			Projects:    in.Projects,
+			Expires:     in.ExpiresAt,
 		})
 	}
 
@@ -109,12 +111,16 @@ func (s *Server) ListTokens(
 // Value returned from internal auth service
 func convert(token *authn.Token) *pb_common.Token {
 	return &pb_common.Token{
-		Id:        token.Id,
-		Name:      token.Description,
-		Active:    token.Active,
-		Value:     token.Value,
-		CreatedAt: token.Created,
-		UpdatedAt: token.Updated,
-		Projects:  token.Projects,
+		Id:          token.Id,
+		Name:        token.Description,
+		Active:      token.Active,
+		Value:       token.Value,
+		ValuePrefix: token.ValuePrefix,
+		CreatedAt:   token.Created,
+		UpdatedAt:   token.Updated,
+		Projects:    token.Projects,
+		ExpiresAt:   token.Expires,
+		LastUsedAt:  token.LastUsed,
+		LastUsedIp:  token.LastUsedIp,
 	}
 }
@@ -99,6 +99,34 @@ func (a *Secrets) List(ctx context.Context, in *secrets.Query) (*secrets.Secrets
 	return out, nil
 }
 
+// ListVersions - list the retained versions of a secret
+func (a *Secrets) ListVersions(ctx context.Context, in *secrets.Id) (*secrets.SecretVersions, error) {
+	inDomain := &secrets.Id{}
+	out := &secrets.SecretVersions{}
+	f := func() (proto.Message, error) {
+		return a.client.ListVersions(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Rollback - make a previous version of a secret its latest version again
+func (a *Secrets) Rollback(ctx context.Context, in *secrets.RollbackRequest) (*secrets.RollbackResponse, error) {
+	inDomain := &secrets.RollbackRequest{}
+	out := &secrets.RollbackResponse{}
+	f := func() (proto.Message, error) {
+		return a.client.Rollback(ctx, inDomain)
+	}
+	err := protobuf.CallDomainService(in, inDomain, f, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func findKeyValue(items []*secrets.Kv, key string) (*secrets.Kv, bool) {
 	for _, item := range items {
 		if item.Key == key {
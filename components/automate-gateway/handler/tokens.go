@@ -86,6 +86,7 @@ func fromUpstreamToken(c *authn.Token) *authRes.Token {
 		Id:          c.Id,
 		Description: c.Description,
 		Value:       c.Value,
+		ValuePrefix: c.ValuePrefix,
 		Active:      c.Active,
 		Created:     c.Created,
 		Updated:     c.Updated,
@@ -1,7 +1,8 @@
 export interface ApiToken {
   id: string;
   name: string;
-  value: string;
+  value: string; // only known right after the token was created
+  value_prefix: string;
   active: boolean;
   created_at: string;
   updated_at: string;
@@ -12,6 +13,7 @@ export interface ApiTokenV1 {
     id: string;
     description: string;
     value: string;
+    value_prefix: string;
     active: boolean;
     created: string;
     updated: string;
@@ -23,6 +25,7 @@ export function ensureApiTokenV2(token: ApiToken | ApiTokenV1): ApiToken {
       id: token.id,
       active: token.active,
       value: token.value,
+      value_prefix: token.value_prefix,
       name: token.description,
       created_at: token.created,
       updated_at: token.updated,
@@ -85,8 +85,8 @@ describe('apiTokenStatusEntityReducer', () => {
           // check number of entries
           expect(keys(entities).length).toEqual(payload.tokens.length);
 
-          // check value has been updated to payload's value for same id
-          expect(entities[existingTokenId].value).toBe(payload.tokens[0].value);
+          // check value prefix has been updated to payload's value prefix for same id
+          expect(entities[existingTokenId].value_prefix).toBe(payload.tokens[0].value_prefix);
 
           // check all other ids are no longer present in state
           keys(state.entities).forEach((key) => {
@@ -253,7 +253,8 @@ describe('apiTokenStatusEntityReducer', () => {
   function genToken(tokenId?: string): ApiToken {
     return {
       id: tokenId ? tokenId : faker.random.uuid(),
-      value: faker.lorem.word(),
+      value: '',
+      value_prefix: faker.lorem.word().slice(0, 4),
       name: faker.lorem.words(),
       active: faker.random.boolean(),
       created_at: faker.date.past().toISOString(),
@@ -87,7 +87,7 @@ describe('ApiTokenListComponent', () => {
   });
 
   describe('sortedApiTokens$', () => {
-    const base = { value: 'random', active: true, created_at: '', updated_at: '', projects: [] };
+    const base = { value: '', value_prefix: 'rand', active: true, created_at: '', updated_at: '', projects: [] };
     let store: Store<NgrxStateAtom>;
     beforeEach(() => {
       store = TestBed.get(Store);
@@ -45,7 +45,7 @@ func (x Query_OrderType) String() string {
 	return proto.EnumName(Query_OrderType_name, int32(x))
 }
 func (Query_OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{2, 0}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{2, 0}
 }
 
 type ExportData struct {
@@ -59,7 +59,7 @@ func (m *ExportData) Reset()         { *m = ExportData{} }
 func (m *ExportData) String() string { return proto.CompactTextString(m) }
 func (*ExportData) ProtoMessage()    {}
 func (*ExportData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{0}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{0}
 }
 func (m *ExportData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExportData.Unmarshal(m, b)
@@ -97,7 +97,7 @@ func (m *Id) Reset()         { *m = Id{} }
 func (m *Id) String() string { return proto.CompactTextString(m) }
 func (*Id) ProtoMessage()    {}
 func (*Id) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{1}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{1}
 }
 func (m *Id) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Id.Unmarshal(m, b)
@@ -141,7 +141,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{2}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{2}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -222,7 +222,7 @@ func (m *ListFilter) Reset()         { *m = ListFilter{} }
 func (m *ListFilter) String() string { return proto.CompactTextString(m) }
 func (*ListFilter) ProtoMessage()    {}
 func (*ListFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{3}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{3}
 }
 func (m *ListFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListFilter.Unmarshal(m, b)
@@ -267,7 +267,7 @@ func (m *Total) Reset()         { *m = Total{} }
 func (m *Total) String() string { return proto.CompactTextString(m) }
 func (*Total) ProtoMessage()    {}
 func (*Total) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{4}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{4}
 }
 func (m *Total) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Total.Unmarshal(m, b)
@@ -308,7 +308,7 @@ func (m *Failed) Reset()         { *m = Failed{} }
 func (m *Failed) String() string { return proto.CompactTextString(m) }
 func (*Failed) ProtoMessage()    {}
 func (*Failed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{5}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{5}
 }
 func (m *Failed) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Failed.Unmarshal(m, b)
@@ -370,7 +370,7 @@ func (m *ControlSummary) Reset()         { *m = ControlSummary{} }
 func (m *ControlSummary) String() string { return proto.CompactTextString(m) }
 func (*ControlSummary) ProtoMessage()    {}
 func (*ControlSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{6}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{6}
 }
 func (m *ControlSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlSummary.Unmarshal(m, b)
@@ -430,7 +430,7 @@ func (m *Reports) Reset()         { *m = Reports{} }
 func (m *Reports) String() string { return proto.CompactTextString(m) }
 func (*Reports) ProtoMessage()    {}
 func (*Reports) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{7}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{7}
 }
 func (m *Reports) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Reports.Unmarshal(m, b)
@@ -465,30 +465,31 @@ func (m *Reports) GetTotal() int32 {
 }
 
 type Report struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NodeId               string               `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	NodeName             string               `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	EndTime              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Status               string               `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Controls             *ControlSummary      `protobuf:"bytes,6,opt,name=controls,proto3" json:"controls,omitempty"`
-	Environment          string               `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
-	Version              string               `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
-	Platform             *Platform            `protobuf:"bytes,9,opt,name=platform,proto3" json:"platform,omitempty"`
-	Statistics           *Statistics          `protobuf:"bytes,10,opt,name=statistics,proto3" json:"statistics,omitempty"`
-	Profiles             []*Profile           `protobuf:"bytes,11,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	JobId                string               `protobuf:"bytes,12,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	Ipaddress            string               `protobuf:"bytes,13,opt,name=ipaddress,proto3" json:"ipaddress,omitempty"`
-	Fqdn                 string               `protobuf:"bytes,14,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId   string               `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName string               `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	EndTime  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// waived if every failed control is waived
+	Status               string          `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Controls             *ControlSummary `protobuf:"bytes,6,opt,name=controls,proto3" json:"controls,omitempty"`
+	Environment          string          `protobuf:"bytes,7,opt,name=environment,proto3" json:"environment,omitempty"`
+	Version              string          `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+	Platform             *Platform       `protobuf:"bytes,9,opt,name=platform,proto3" json:"platform,omitempty"`
+	Statistics           *Statistics     `protobuf:"bytes,10,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Profiles             []*Profile      `protobuf:"bytes,11,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	JobId                string          `protobuf:"bytes,12,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Ipaddress            string          `protobuf:"bytes,13,opt,name=ipaddress,proto3" json:"ipaddress,omitempty"`
+	Fqdn                 string          `protobuf:"bytes,14,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Report) Reset()         { *m = Report{} }
 func (m *Report) String() string { return proto.CompactTextString(m) }
 func (*Report) ProtoMessage()    {}
 func (*Report) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{8}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{8}
 }
 func (m *Report) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Report.Unmarshal(m, b)
@@ -634,7 +635,7 @@ func (m *Profile) Reset()         { *m = Profile{} }
 func (m *Profile) String() string { return proto.CompactTextString(m) }
 func (*Profile) ProtoMessage()    {}
 func (*Profile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{9}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{9}
 }
 func (m *Profile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Profile.Unmarshal(m, b)
@@ -792,7 +793,7 @@ func (m *Ref) Reset()         { *m = Ref{} }
 func (m *Ref) String() string { return proto.CompactTextString(m) }
 func (*Ref) ProtoMessage()    {}
 func (*Ref) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{10}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{10}
 }
 func (m *Ref) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Ref.Unmarshal(m, b)
@@ -842,7 +843,7 @@ func (m *Result) Reset()         { *m = Result{} }
 func (m *Result) String() string { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()    {}
 func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{11}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{11}
 }
 func (m *Result) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Result.Unmarshal(m, b)
@@ -916,7 +917,7 @@ func (m *SourceLocation) Reset()         { *m = SourceLocation{} }
 func (m *SourceLocation) String() string { return proto.CompactTextString(m) }
 func (*SourceLocation) ProtoMessage()    {}
 func (*SourceLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{12}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{12}
 }
 func (m *SourceLocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SourceLocation.Unmarshal(m, b)
@@ -962,7 +963,7 @@ func (m *Option) Reset()         { *m = Option{} }
 func (m *Option) String() string { return proto.CompactTextString(m) }
 func (*Option) ProtoMessage()    {}
 func (*Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{13}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{13}
 }
 func (m *Option) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Option.Unmarshal(m, b)
@@ -1011,7 +1012,7 @@ func (m *Support) Reset()         { *m = Support{} }
 func (m *Support) String() string { return proto.CompactTextString(m) }
 func (*Support) ProtoMessage()    {}
 func (*Support) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{14}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{14}
 }
 func (m *Support) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Support.Unmarshal(m, b)
@@ -1089,7 +1090,7 @@ func (m *Dependency) Reset()         { *m = Dependency{} }
 func (m *Dependency) String() string { return proto.CompactTextString(m) }
 func (*Dependency) ProtoMessage()    {}
 func (*Dependency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{15}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{15}
 }
 func (m *Dependency) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Dependency.Unmarshal(m, b)
@@ -1213,7 +1214,7 @@ func (m *Group) Reset()         { *m = Group{} }
 func (m *Group) String() string { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()    {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{16}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{16}
 }
 func (m *Group) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Group.Unmarshal(m, b)
@@ -1255,25 +1256,27 @@ func (m *Group) GetControls() []string {
 }
 
 type Control struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code                 string            `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Desc                 string            `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
-	Impact               float32           `protobuf:"fixed32,4,opt,name=impact,proto3" json:"impact,omitempty"`
-	Title                string            `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	SourceLocation       *SourceLocation   `protobuf:"bytes,6,opt,name=source_location,json=sourceLocation,proto3" json:"source_location,omitempty"`
-	Results              []*Result         `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
-	Refs                 []*Ref            `protobuf:"bytes,8,rep,name=refs,proto3" json:"refs,omitempty"`
-	Tags                 map[string]string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id             string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string            `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Desc           string            `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Impact         float32           `protobuf:"fixed32,4,opt,name=impact,proto3" json:"impact,omitempty"`
+	Title          string            `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	SourceLocation *SourceLocation   `protobuf:"bytes,6,opt,name=source_location,json=sourceLocation,proto3" json:"source_location,omitempty"`
+	Results        []*Result         `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+	Refs           []*Ref            `protobuf:"bytes,8,rep,name=refs,proto3" json:"refs,omitempty"`
+	Tags           map[string]string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// set when the control failed and a waiver applies to it
+	Waiver               *ControlWaiver `protobuf:"bytes,10,opt,name=waiver,proto3" json:"waiver,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *Control) Reset()         { *m = Control{} }
 func (m *Control) String() string { return proto.CompactTextString(m) }
 func (*Control) ProtoMessage()    {}
 func (*Control) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{17}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{17}
 }
 func (m *Control) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Control.Unmarshal(m, b)
@@ -1356,6 +1359,67 @@ func (m *Control) GetTags() map[string]string {
 	return nil
 }
 
+func (m *Control) GetWaiver() *ControlWaiver {
+	if m != nil {
+		return m.Waiver
+	}
+	return nil
+}
+
+type ControlWaiver struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Justification        string               `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"`
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ControlWaiver) Reset()         { *m = ControlWaiver{} }
+func (m *ControlWaiver) String() string { return proto.CompactTextString(m) }
+func (*ControlWaiver) ProtoMessage()    {}
+func (*ControlWaiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{18}
+}
+func (m *ControlWaiver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlWaiver.Unmarshal(m, b)
+}
+func (m *ControlWaiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlWaiver.Marshal(b, m, deterministic)
+}
+func (dst *ControlWaiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlWaiver.Merge(dst, src)
+}
+func (m *ControlWaiver) XXX_Size() int {
+	return xxx_messageInfo_ControlWaiver.Size(m)
+}
+func (m *ControlWaiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlWaiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlWaiver proto.InternalMessageInfo
+
+func (m *ControlWaiver) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ControlWaiver) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+func (m *ControlWaiver) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 type Attribute struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Options              *Option  `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
@@ -1368,7 +1432,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{18}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{19}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Attribute.Unmarshal(m, b)
@@ -1414,7 +1478,7 @@ func (m *Platform) Reset()         { *m = Platform{} }
 func (m *Platform) String() string { return proto.CompactTextString(m) }
 func (*Platform) ProtoMessage()    {}
 func (*Platform) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{19}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{20}
 }
 func (m *Platform) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Platform.Unmarshal(m, b)
@@ -1459,7 +1523,7 @@ func (m *Statistics) Reset()         { *m = Statistics{} }
 func (m *Statistics) String() string { return proto.CompactTextString(m) }
 func (*Statistics) ProtoMessage()    {}
 func (*Statistics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{20}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{21}
 }
 func (m *Statistics) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Statistics.Unmarshal(m, b)
@@ -1500,7 +1564,7 @@ func (m *SuggestionRequest) Reset()         { *m = SuggestionRequest{} }
 func (m *SuggestionRequest) String() string { return proto.CompactTextString(m) }
 func (*SuggestionRequest) ProtoMessage()    {}
 func (*SuggestionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{21}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{22}
 }
 func (m *SuggestionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SuggestionRequest.Unmarshal(m, b)
@@ -1562,7 +1626,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{22}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{23}
 }
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Suggestion.Unmarshal(m, b)
@@ -1621,7 +1685,7 @@ func (m *Suggestions) Reset()         { *m = Suggestions{} }
 func (m *Suggestions) String() string { return proto.CompactTextString(m) }
 func (*Suggestions) ProtoMessage()    {}
 func (*Suggestions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{23}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{24}
 }
 func (m *Suggestions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Suggestions.Unmarshal(m, b)
@@ -1660,7 +1724,7 @@ func (m *ProfileMins) Reset()         { *m = ProfileMins{} }
 func (m *ProfileMins) String() string { return proto.CompactTextString(m) }
 func (*ProfileMins) ProtoMessage()    {}
 func (*ProfileMins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{24}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{25}
 }
 func (m *ProfileMins) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileMins.Unmarshal(m, b)
@@ -1708,7 +1772,7 @@ func (m *ProfileCounts) Reset()         { *m = ProfileCounts{} }
 func (m *ProfileCounts) String() string { return proto.CompactTextString(m) }
 func (*ProfileCounts) ProtoMessage()    {}
 func (*ProfileCounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{25}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{26}
 }
 func (m *ProfileCounts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileCounts.Unmarshal(m, b)
@@ -1771,7 +1835,7 @@ func (m *ProfileMin) Reset()         { *m = ProfileMin{} }
 func (m *ProfileMin) String() string { return proto.CompactTextString(m) }
 func (*ProfileMin) ProtoMessage()    {}
 func (*ProfileMin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{26}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{27}
 }
 func (m *ProfileMin) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileMin.Unmarshal(m, b)
@@ -1843,7 +1907,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{27}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{28}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -1924,7 +1988,7 @@ func (m *Nodes) Reset()         { *m = Nodes{} }
 func (m *Nodes) String() string { return proto.CompactTextString(m) }
 func (*Nodes) ProtoMessage()    {}
 func (*Nodes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{28}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{29}
 }
 func (m *Nodes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Nodes.Unmarshal(m, b)
@@ -1970,7 +2034,7 @@ func (m *Kv) Reset()         { *m = Kv{} }
 func (m *Kv) String() string { return proto.CompactTextString(m) }
 func (*Kv) ProtoMessage()    {}
 func (*Kv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{29}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{30}
 }
 func (m *Kv) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Kv.Unmarshal(m, b)
@@ -2018,7 +2082,7 @@ func (m *LatestReportSummary) Reset()         { *m = LatestReportSummary{} }
 func (m *LatestReportSummary) String() string { return proto.CompactTextString(m) }
 func (*LatestReportSummary) ProtoMessage()    {}
 func (*LatestReportSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{30}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{31}
 }
 func (m *LatestReportSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LatestReportSummary.Unmarshal(m, b)
@@ -2079,7 +2143,7 @@ func (m *ProfileMeta) Reset()         { *m = ProfileMeta{} }
 func (m *ProfileMeta) String() string { return proto.CompactTextString(m) }
 func (*ProfileMeta) ProtoMessage()    {}
 func (*ProfileMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_reporting_2cd76b035e6a4e26, []int{31}
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{32}
 }
 func (m *ProfileMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileMeta.Unmarshal(m, b)
@@ -2120,6 +2184,318 @@ func (m *ProfileMeta) GetId() string {
 	return ""
 }
 
+type DiffQuery struct {
+	// the reports to compare, from_id being the earlier one
+	FromId string `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
+	// when no report ids are given, the latest report of the node is compared
+	// with its latest report before the given time or, if no time is given,
+	// with the report before its latest one
+	NodeId               string               `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Before               *timestamp.Timestamp `protobuf:"bytes,4,opt,name=before,proto3" json:"before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DiffQuery) Reset()         { *m = DiffQuery{} }
+func (m *DiffQuery) String() string { return proto.CompactTextString(m) }
+func (*DiffQuery) ProtoMessage()    {}
+func (*DiffQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{33}
+}
+func (m *DiffQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffQuery.Unmarshal(m, b)
+}
+func (m *DiffQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffQuery.Marshal(b, m, deterministic)
+}
+func (dst *DiffQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffQuery.Merge(dst, src)
+}
+func (m *DiffQuery) XXX_Size() int {
+	return xxx_messageInfo_DiffQuery.Size(m)
+}
+func (m *DiffQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffQuery proto.InternalMessageInfo
+
+func (m *DiffQuery) GetFromId() string {
+	if m != nil {
+		return m.FromId
+	}
+	return ""
+}
+
+func (m *DiffQuery) GetToId() string {
+	if m != nil {
+		return m.ToId
+	}
+	return ""
+}
+
+func (m *DiffQuery) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *DiffQuery) GetBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+type ReportDiff struct {
+	From *Report `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *Report `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// controls that passed or were skipped and now fail
+	NewlyFailed []*ControlDiff `protobuf:"bytes,3,rep,name=newly_failed,json=newlyFailed,proto3" json:"newly_failed,omitempty"`
+	// controls that failed and now pass
+	NewlyPassed []*ControlDiff `protobuf:"bytes,4,rep,name=newly_passed,json=newlyPassed,proto3" json:"newly_passed,omitempty"`
+	// controls that passed or failed and are now skipped
+	NewlySkipped []*ControlDiff `protobuf:"bytes,5,rep,name=newly_skipped,json=newlySkipped,proto3" json:"newly_skipped,omitempty"`
+	// controls whose impact changed, whatever their status
+	ImpactChanged        []*ControlDiff `protobuf:"bytes,6,rep,name=impact_changed,json=impactChanged,proto3" json:"impact_changed,omitempty"`
+	AddedProfiles        []*ProfileDiff `protobuf:"bytes,7,rep,name=added_profiles,json=addedProfiles,proto3" json:"added_profiles,omitempty"`
+	RemovedProfiles      []*ProfileDiff `protobuf:"bytes,8,rep,name=removed_profiles,json=removedProfiles,proto3" json:"removed_profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReportDiff) Reset()         { *m = ReportDiff{} }
+func (m *ReportDiff) String() string { return proto.CompactTextString(m) }
+func (*ReportDiff) ProtoMessage()    {}
+func (*ReportDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{34}
+}
+func (m *ReportDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportDiff.Unmarshal(m, b)
+}
+func (m *ReportDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportDiff.Marshal(b, m, deterministic)
+}
+func (dst *ReportDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportDiff.Merge(dst, src)
+}
+func (m *ReportDiff) XXX_Size() int {
+	return xxx_messageInfo_ReportDiff.Size(m)
+}
+func (m *ReportDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportDiff proto.InternalMessageInfo
+
+func (m *ReportDiff) GetFrom() *Report {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetTo() *Report {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetNewlyFailed() []*ControlDiff {
+	if m != nil {
+		return m.NewlyFailed
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetNewlyPassed() []*ControlDiff {
+	if m != nil {
+		return m.NewlyPassed
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetNewlySkipped() []*ControlDiff {
+	if m != nil {
+		return m.NewlySkipped
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetImpactChanged() []*ControlDiff {
+	if m != nil {
+		return m.ImpactChanged
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetAddedProfiles() []*ProfileDiff {
+	if m != nil {
+		return m.AddedProfiles
+	}
+	return nil
+}
+
+func (m *ReportDiff) GetRemovedProfiles() []*ProfileDiff {
+	if m != nil {
+		return m.RemovedProfiles
+	}
+	return nil
+}
+
+type ControlDiff struct {
+	ProfileName          string   `protobuf:"bytes,1,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	FromStatus           string   `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus             string   `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	FromImpact           float32  `protobuf:"fixed32,6,opt,name=from_impact,json=fromImpact,proto3" json:"from_impact,omitempty"`
+	ToImpact             float32  `protobuf:"fixed32,7,opt,name=to_impact,json=toImpact,proto3" json:"to_impact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ControlDiff) Reset()         { *m = ControlDiff{} }
+func (m *ControlDiff) String() string { return proto.CompactTextString(m) }
+func (*ControlDiff) ProtoMessage()    {}
+func (*ControlDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{35}
+}
+func (m *ControlDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ControlDiff.Unmarshal(m, b)
+}
+func (m *ControlDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ControlDiff.Marshal(b, m, deterministic)
+}
+func (dst *ControlDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlDiff.Merge(dst, src)
+}
+func (m *ControlDiff) XXX_Size() int {
+	return xxx_messageInfo_ControlDiff.Size(m)
+}
+func (m *ControlDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlDiff proto.InternalMessageInfo
+
+func (m *ControlDiff) GetProfileName() string {
+	if m != nil {
+		return m.ProfileName
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetFromStatus() string {
+	if m != nil {
+		return m.FromStatus
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetToStatus() string {
+	if m != nil {
+		return m.ToStatus
+	}
+	return ""
+}
+
+func (m *ControlDiff) GetFromImpact() float32 {
+	if m != nil {
+		return m.FromImpact
+	}
+	return 0
+}
+
+func (m *ControlDiff) GetToImpact() float32 {
+	if m != nil {
+		return m.ToImpact
+	}
+	return 0
+}
+
+type ProfileDiff struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Version              string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Sha256               string   `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProfileDiff) Reset()         { *m = ProfileDiff{} }
+func (m *ProfileDiff) String() string { return proto.CompactTextString(m) }
+func (*ProfileDiff) ProtoMessage()    {}
+func (*ProfileDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_reporting_f256048208d1dd2a, []int{36}
+}
+func (m *ProfileDiff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileDiff.Unmarshal(m, b)
+}
+func (m *ProfileDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileDiff.Marshal(b, m, deterministic)
+}
+func (dst *ProfileDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileDiff.Merge(dst, src)
+}
+func (m *ProfileDiff) XXX_Size() int {
+	return xxx_messageInfo_ProfileDiff.Size(m)
+}
+func (m *ProfileDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileDiff proto.InternalMessageInfo
+
+func (m *ProfileDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProfileDiff) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProfileDiff) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *ProfileDiff) GetSha256() string {
+	if m != nil {
+		return m.Sha256
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ExportData)(nil), "chef.automate.domain.compliance.api.reporting.ExportData")
 	proto.RegisterType((*Id)(nil), "chef.automate.domain.compliance.api.reporting.Id")
@@ -2140,6 +2516,7 @@ func init() {
 	proto.RegisterType((*Group)(nil), "chef.automate.domain.compliance.api.reporting.Group")
 	proto.RegisterType((*Control)(nil), "chef.automate.domain.compliance.api.reporting.Control")
 	proto.RegisterMapType((map[string]string)(nil), "chef.automate.domain.compliance.api.reporting.Control.TagsEntry")
+	proto.RegisterType((*ControlWaiver)(nil), "chef.automate.domain.compliance.api.reporting.ControlWaiver")
 	proto.RegisterType((*Attribute)(nil), "chef.automate.domain.compliance.api.reporting.Attribute")
 	proto.RegisterType((*Platform)(nil), "chef.automate.domain.compliance.api.reporting.Platform")
 	proto.RegisterType((*Statistics)(nil), "chef.automate.domain.compliance.api.reporting.Statistics")
@@ -2154,6 +2531,10 @@ func init() {
 	proto.RegisterType((*Kv)(nil), "chef.automate.domain.compliance.api.reporting.Kv")
 	proto.RegisterType((*LatestReportSummary)(nil), "chef.automate.domain.compliance.api.reporting.LatestReportSummary")
 	proto.RegisterType((*ProfileMeta)(nil), "chef.automate.domain.compliance.api.reporting.ProfileMeta")
+	proto.RegisterType((*DiffQuery)(nil), "chef.automate.domain.compliance.api.reporting.DiffQuery")
+	proto.RegisterType((*ReportDiff)(nil), "chef.automate.domain.compliance.api.reporting.ReportDiff")
+	proto.RegisterType((*ControlDiff)(nil), "chef.automate.domain.compliance.api.reporting.ControlDiff")
+	proto.RegisterType((*ProfileDiff)(nil), "chef.automate.domain.compliance.api.reporting.ProfileDiff")
 	proto.RegisterEnum("chef.automate.domain.compliance.api.reporting.Query_OrderType", Query_OrderType_name, Query_OrderType_value)
 }
 
@@ -2176,6 +2557,7 @@ type ReportingServiceClient interface {
 	Export(ctx context.Context, in *Query, opts ...grpc.CallOption) (ReportingService_ExportClient, error)
 	ReadNode(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Node, error)
 	ListNodes(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Nodes, error)
+	DiffReports(ctx context.Context, in *DiffQuery, opts ...grpc.CallOption) (*ReportDiff, error)
 }
 
 type reportingServiceClient struct {
@@ -2272,6 +2654,15 @@ func (c *reportingServiceClient) ListNodes(ctx context.Context, in *Query, opts
 	return out, nil
 }
 
+func (c *reportingServiceClient) DiffReports(ctx context.Context, in *DiffQuery, opts ...grpc.CallOption) (*ReportDiff, error) {
+	out := new(ReportDiff)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.compliance.api.reporting.ReportingService/DiffReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReportingServiceServer is the server API for ReportingService service.
 type ReportingServiceServer interface {
 	ListReports(context.Context, *Query) (*Reports, error)
@@ -2281,6 +2672,7 @@ type ReportingServiceServer interface {
 	Export(*Query, ReportingService_ExportServer) error
 	ReadNode(context.Context, *Id) (*Node, error)
 	ListNodes(context.Context, *Query) (*Nodes, error)
+	DiffReports(context.Context, *DiffQuery) (*ReportDiff, error)
 }
 
 func RegisterReportingServiceServer(s *grpc.Server, srv ReportingServiceServer) {
@@ -2416,6 +2808,24 @@ func _ReportingService_ListNodes_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingService_DiffReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportingServiceServer).DiffReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.compliance.api.reporting.ReportingService/DiffReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportingServiceServer).DiffReports(ctx, req.(*DiffQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReportingService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chef.automate.domain.compliance.api.reporting.ReportingService",
 	HandlerType: (*ReportingServiceServer)(nil),
@@ -2444,6 +2854,10 @@ var _ReportingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListNodes",
 			Handler:    _ReportingService_ListNodes_Handler,
 		},
+		{
+			MethodName: "DiffReports",
+			Handler:    _ReportingService_DiffReports_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2456,137 +2870,160 @@ var _ReportingService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("components/compliance-service/api/reporting/reporting.proto", fileDescriptor_reporting_2cd76b035e6a4e26)
-}
-
-var fileDescriptor_reporting_2cd76b035e6a4e26 = []byte{
-	// 2046 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4b, 0x73, 0x1c, 0x49,
-	0x11, 0xd6, 0xbc, 0xa7, 0x73, 0x64, 0xad, 0xb7, 0x2c, 0xdb, 0x8d, 0xbc, 0xbb, 0x88, 0x8e, 0x00,
-	0x4c, 0x04, 0x3b, 0x02, 0xad, 0xed, 0x35, 0xe6, 0x11, 0xbb, 0x6b, 0xd9, 0x84, 0xc2, 0x4f, 0x4a,
-	0x62, 0x83, 0x85, 0xc3, 0x50, 0xea, 0xae, 0x19, 0x95, 0xdd, 0xd3, 0xdd, 0x5b, 0x55, 0x2d, 0x2c,
-	0x88, 0x3d, 0xc0, 0x3f, 0x20, 0x38, 0x70, 0x81, 0x1b, 0x17, 0xee, 0x5c, 0xe0, 0x1f, 0x70, 0xe2,
-	0x27, 0x41, 0x64, 0x55, 0xf5, 0x63, 0x2c, 0xc9, 0x41, 0x77, 0xf8, 0x56, 0x99, 0x35, 0xfd, 0x55,
-	0x56, 0x56, 0xe6, 0x97, 0x95, 0x35, 0xf0, 0xc3, 0x30, 0x5d, 0x66, 0x69, 0xc2, 0x13, 0xad, 0x76,
-	0x70, 0x18, 0x0b, 0x96, 0x84, 0xfc, 0x43, 0xc5, 0xe5, 0x89, 0x08, 0xf9, 0x0e, 0xcb, 0xc4, 0x8e,
-	0xe4, 0x59, 0x2a, 0xb5, 0x48, 0x16, 0xd5, 0x68, 0x9a, 0xc9, 0x54, 0xa7, 0xe4, 0xc3, 0xf0, 0x98,
-	0xcf, 0xa7, 0x2c, 0xd7, 0xe9, 0x92, 0x69, 0x3e, 0x8d, 0xd2, 0x25, 0x13, 0xc9, 0xb4, 0x82, 0x99,
-	0xb2, 0x4c, 0x4c, 0xcb, 0x8f, 0xb6, 0xde, 0x5b, 0xa4, 0xe9, 0x22, 0xb6, 0xa0, 0x2c, 0x49, 0x52,
-	0xcd, 0xb4, 0x48, 0x13, 0x65, 0xc1, 0xb6, 0xbe, 0xee, 0x66, 0x8d, 0x74, 0x94, 0xcf, 0x77, 0xb4,
-	0x58, 0x72, 0xa5, 0xd9, 0x32, 0xb3, 0x3f, 0x08, 0xbe, 0x05, 0xf0, 0xe0, 0x15, 0x62, 0xed, 0x31,
-	0xcd, 0x88, 0x0f, 0xa3, 0x30, 0x4d, 0x34, 0x4f, 0xb4, 0xdf, 0xd9, 0xee, 0xdc, 0x5c, 0xa7, 0x85,
-	0x18, 0x6c, 0x42, 0x77, 0x3f, 0x22, 0x1b, 0xd0, 0x15, 0x91, 0x99, 0xf2, 0x68, 0x57, 0x44, 0xc1,
-	0x3f, 0xbb, 0x30, 0xf8, 0x59, 0xce, 0xe5, 0xa9, 0x9b, 0x21, 0xc5, 0x0c, 0x21, 0xd0, 0xd7, 0xa7,
-	0x19, 0xf7, 0xaf, 0x18, 0x8d, 0x19, 0x93, 0x03, 0x18, 0xcd, 0x45, 0xac, 0xb9, 0x54, 0xfe, 0xe6,
-	0x76, 0xef, 0xe6, 0x64, 0xf7, 0x07, 0xd3, 0x46, 0x7b, 0x9d, 0x3e, 0x16, 0x4a, 0x3f, 0x34, 0x08,
-	0xb4, 0x40, 0x22, 0x87, 0x30, 0x48, 0x65, 0xc4, 0xa5, 0x7f, 0x75, 0xbb, 0x73, 0x73, 0x63, 0xf7,
-	0x27, 0x0d, 0x21, 0x8d, 0xf5, 0xd3, 0x67, 0x88, 0x70, 0x78, 0x9a, 0x71, 0x6a, 0xc1, 0xd0, 0x7c,
-	0x95, 0x4a, 0xed, 0x5f, 0xb3, 0xe6, 0xe3, 0x18, 0x75, 0x19, 0x5b, 0x70, 0xff, 0xfa, 0x76, 0xe7,
-	0xe6, 0x80, 0x9a, 0x31, 0xf9, 0x1a, 0x8c, 0x33, 0x2e, 0x67, 0x46, 0xef, 0x1b, 0xfd, 0x28, 0xe3,
-	0xf2, 0x39, 0x5b, 0xf0, 0xe0, 0x03, 0xf0, 0x4a, 0x58, 0x32, 0x82, 0xde, 0xa7, 0x07, 0xf7, 0x2f,
-	0xaf, 0x91, 0x31, 0xf4, 0xf7, 0x1e, 0x1c, 0xdc, 0xbf, 0xdc, 0x09, 0xee, 0x02, 0x54, 0xfb, 0x21,
-	0xd7, 0x60, 0x78, 0xc2, 0xe2, 0x9c, 0x5b, 0xd7, 0x78, 0xd4, 0x49, 0xa5, 0x1f, 0xaf, 0x56, 0x7e,
-	0x0c, 0xde, 0x87, 0xc1, 0x61, 0xaa, 0x59, 0x4c, 0x36, 0x61, 0xa0, 0x71, 0x60, 0x4e, 0x64, 0x40,
-	0xad, 0x10, 0xcc, 0x61, 0xf8, 0x90, 0x89, 0x98, 0x47, 0xe7, 0xcf, 0xa3, 0x76, 0x29, 0x92, 0x54,
-	0xfa, 0x5d, 0xab, 0x35, 0x82, 0xd1, 0xb2, 0x17, 0xa9, 0xf4, 0x7b, 0x4e, 0x8b, 0x02, 0xd9, 0x82,
-	0x71, 0x28, 0x85, 0x16, 0x21, 0x8b, 0xfd, 0xbe, 0x99, 0x28, 0xe5, 0xe0, 0x4f, 0x5d, 0xd8, 0xb8,
-	0x9f, 0x26, 0x5a, 0xa6, 0xf1, 0x41, 0xbe, 0x5c, 0x32, 0x79, 0x7a, 0xc1, 0x82, 0x8f, 0x61, 0x98,
-	0x31, 0xa5, 0x78, 0x64, 0x56, 0x9c, 0xec, 0xde, 0x6a, 0x78, 0x46, 0x66, 0xb3, 0xd4, 0x61, 0x90,
-	0xa7, 0x30, 0x52, 0x2f, 0x45, 0x96, 0xf1, 0xc8, 0x98, 0xda, 0x16, 0xae, 0x00, 0x21, 0x4f, 0x60,
-	0x38, 0x37, 0xee, 0x32, 0x1b, 0x9c, 0xec, 0xde, 0x6e, 0x08, 0x67, 0x7d, 0x4d, 0x1d, 0x48, 0x90,
-	0xc1, 0x88, 0x9a, 0x39, 0x45, 0x9e, 0xc1, 0xc8, 0xfe, 0x4c, 0xf9, 0x1d, 0x13, 0xef, 0x4d, 0xa1,
-	0x2d, 0x10, 0x2d, 0x50, 0x2a, 0xf7, 0x76, 0xeb, 0xe7, 0xfd, 0xfb, 0x01, 0x0c, 0xed, 0x2f, 0x5f,
-	0xcf, 0x4f, 0x72, 0x1d, 0x46, 0x49, 0x1a, 0xf1, 0x99, 0xb0, 0xae, 0xf7, 0xe8, 0x10, 0xc5, 0xfd,
-	0x88, 0xdc, 0x00, 0xcf, 0x4c, 0x24, 0x6c, 0xc9, 0x8d, 0x1b, 0x3d, 0x3a, 0x46, 0xc5, 0x53, 0xb6,
-	0xe4, 0xe4, 0x36, 0x8c, 0x79, 0x12, 0xcd, 0x90, 0x2a, 0x9c, 0x4f, 0xb6, 0xa6, 0x96, 0x47, 0xa6,
-	0x05, 0x8f, 0x4c, 0x0f, 0x0b, 0x1e, 0xa1, 0x23, 0x9e, 0x44, 0x28, 0x61, 0x08, 0x2b, 0xcd, 0x74,
-	0xae, 0xfc, 0x81, 0x5d, 0xcb, 0x4a, 0xe4, 0x0b, 0x18, 0x87, 0x36, 0x4c, 0x94, 0x3f, 0x34, 0x70,
-	0x3f, 0x6e, 0xe8, 0x87, 0xd5, 0x28, 0xa3, 0x25, 0x1c, 0xd9, 0x86, 0x09, 0x4f, 0x4e, 0x84, 0x4c,
-	0x93, 0x25, 0x72, 0xd6, 0xc8, 0xac, 0x5b, 0x57, 0x21, 0xa3, 0x9d, 0x70, 0xa9, 0x44, 0x9a, 0xf8,
-	0x63, 0x33, 0x5b, 0x88, 0xe4, 0x00, 0xc6, 0x59, 0xcc, 0xf4, 0x3c, 0x95, 0x4b, 0xdf, 0x33, 0x66,
-	0x7d, 0xdc, 0xd0, 0xac, 0xe7, 0xee, 0x73, 0x5a, 0x02, 0x91, 0x2f, 0x00, 0x70, 0xd7, 0x42, 0x69,
-	0x11, 0x2a, 0x1f, 0x0c, 0x6c, 0x53, 0x96, 0x3b, 0x28, 0x01, 0x68, 0x0d, 0x8c, 0x50, 0x18, 0x67,
-	0x32, 0x9d, 0x8b, 0x98, 0x2b, 0x7f, 0x62, 0xc2, 0xe9, 0x4e, 0x53, 0x7b, 0xed, 0xe7, 0xb4, 0xc4,
-	0x21, 0x57, 0x61, 0xf8, 0x22, 0x3d, 0xc2, 0xf0, 0x58, 0x37, 0xce, 0x19, 0xbc, 0x48, 0x8f, 0xf6,
-	0x23, 0xf2, 0x1e, 0x78, 0x22, 0x63, 0x51, 0x24, 0xb9, 0x52, 0xfe, 0x25, 0x33, 0x53, 0x29, 0x90,
-	0x92, 0xe6, 0x5f, 0x46, 0x89, 0xbf, 0x61, 0x29, 0x09, 0xc7, 0xc1, 0x5f, 0x87, 0x30, 0x72, 0xf0,
-	0x38, 0x6f, 0xc2, 0xca, 0x86, 0xa1, 0x19, 0x9b, 0xc8, 0x15, 0x3a, 0xe6, 0x2e, 0x0c, 0xad, 0x40,
-	0x3e, 0x00, 0x40, 0x8b, 0x35, 0x13, 0x09, 0x97, 0x2e, 0x0c, 0x6b, 0x1a, 0xb4, 0x23, 0x4c, 0xb3,
-	0x53, 0x29, 0x16, 0xc7, 0xda, 0x44, 0xa2, 0x47, 0x2b, 0x05, 0xf9, 0x36, 0xbc, 0x53, 0x0a, 0x33,
-	0xbe, 0x64, 0x22, 0x76, 0x81, 0xb7, 0x51, 0xaa, 0x1f, 0xa0, 0x16, 0x63, 0x20, 0x16, 0x21, 0x4f,
-	0x14, 0x37, 0xf1, 0xe7, 0xd1, 0x42, 0xc4, 0x19, 0x65, 0x83, 0xca, 0xc5, 0x4e, 0x21, 0xbe, 0x21,
-	0x6e, 0x36, 0x61, 0x90, 0xfe, 0x06, 0xed, 0xf5, 0xec, 0x56, 0x8c, 0x80, 0xa7, 0xa3, 0xf2, 0xcc,
-	0x26, 0xfb, 0xe5, 0x56, 0xa7, 0x73, 0x60, 0x3f, 0xa7, 0x25, 0x0e, 0xd6, 0xcb, 0x88, 0x67, 0x3c,
-	0x89, 0x94, 0xff, 0x6e, 0xab, 0x7a, 0xb9, 0x67, 0xbe, 0xe6, 0x49, 0x78, 0x4a, 0x0b, 0x24, 0x93,
-	0xa5, 0xc7, 0x6c, 0xf7, 0xf6, 0x1d, 0x57, 0xac, 0x9d, 0x84, 0x24, 0xbd, 0x90, 0x69, 0x9e, 0x29,
-	0xff, 0x8a, 0x59, 0xab, 0x29, 0xab, 0xfe, 0x14, 0x3f, 0xa6, 0x0e, 0x03, 0xdd, 0x51, 0xe6, 0xfc,
-	0x66, 0x2b, 0x77, 0xb8, 0x9c, 0xaf, 0x25, 0xfb, 0x2f, 0x00, 0x98, 0xd6, 0x52, 0x1c, 0xe5, 0x9a,
-	0x2b, 0xff, 0xaa, 0x41, 0xbd, 0xdb, 0x10, 0xf5, 0xd3, 0x02, 0x80, 0xd6, 0xb0, 0xc8, 0x37, 0x61,
-	0x23, 0x66, 0x9a, 0x2b, 0x3d, 0x2b, 0xce, 0xdc, 0xd6, 0xfd, 0x4b, 0x56, 0xfb, 0xb9, 0x3b, 0xf9,
-	0x8a, 0xe0, 0xae, 0xaf, 0x10, 0xdc, 0x37, 0x60, 0x1d, 0x8b, 0xc9, 0x6c, 0xc9, 0x95, 0x2a, 0x2e,
-	0x02, 0x1e, 0x9d, 0xa0, 0xee, 0x89, 0x55, 0x05, 0xdf, 0x81, 0x1e, 0xe5, 0x73, 0x72, 0x19, 0x7a,
-	0xb9, 0x8c, 0x5d, 0x66, 0xe0, 0x10, 0x35, 0x92, 0xcf, 0x5d, 0x5a, 0xe0, 0x30, 0xf8, 0x47, 0x07,
-	0xe9, 0x5c, 0xe5, 0xb1, 0xae, 0x2d, 0xd8, 0x59, 0x59, 0xf0, 0x06, 0xe6, 0x45, 0xc4, 0x67, 0x11,
-	0x57, 0xa1, 0xfb, 0x74, 0x8c, 0x8a, 0x3d, 0xae, 0x42, 0xbc, 0x92, 0xc8, 0x3c, 0xb1, 0xec, 0x8d,
-	0x29, 0xd5, 0xa5, 0x23, 0x99, 0x27, 0x86, 0xa1, 0xdf, 0x37, 0xec, 0x24, 0x75, 0x45, 0xed, 0x1e,
-	0xf5, 0x8c, 0xc6, 0x4c, 0xfb, 0x30, 0x2a, 0xb6, 0x60, 0x13, 0xa9, 0x10, 0xcf, 0xec, 0x70, 0x78,
-	0x76, 0x87, 0x77, 0x60, 0xe3, 0x20, 0xcd, 0x65, 0xc8, 0x1f, 0xa7, 0xa1, 0xb9, 0x82, 0x16, 0x5b,
-	0xeb, 0x94, 0x5b, 0x43, 0x66, 0x88, 0x45, 0xc2, 0x5d, 0xf9, 0x32, 0xe3, 0x60, 0x0f, 0x86, 0xcf,
-	0x32, 0xf3, 0xfb, 0x6d, 0x98, 0xe0, 0x86, 0xa4, 0x30, 0xa2, 0xfb, 0xae, 0xae, 0x42, 0x03, 0x23,
-	0x3e, 0x67, 0x79, 0xac, 0xdd, 0xae, 0x0b, 0x31, 0xf8, 0x4b, 0x07, 0x46, 0x2e, 0x81, 0xb0, 0xe8,
-	0xa5, 0x6a, 0x56, 0xa3, 0xa0, 0x61, 0xaa, 0x4c, 0x5d, 0xbb, 0x01, 0x5e, 0xaa, 0x66, 0x73, 0xb6,
-	0x14, 0xf1, 0x69, 0xe1, 0xb6, 0x54, 0x3d, 0x34, 0x32, 0x62, 0x4b, 0x1e, 0x73, 0xa6, 0x8a, 0x7a,
-	0x58, 0x88, 0x18, 0x1d, 0x22, 0x51, 0x19, 0x0f, 0xcb, 0xe8, 0xb0, 0x9e, 0xbb, 0x64, 0xb5, 0x45,
-	0x74, 0x6c, 0xd5, 0xea, 0x89, 0x75, 0x5f, 0x29, 0x07, 0xff, 0xee, 0x02, 0x54, 0xc9, 0x78, 0x2e,
-	0x43, 0xba, 0xd0, 0xe8, 0x56, 0xa1, 0x61, 0xee, 0x9b, 0xfa, 0xd8, 0x99, 0x63, 0xc6, 0xf8, 0xab,
-	0x85, 0x28, 0xb8, 0x10, 0x87, 0x18, 0x23, 0x47, 0x92, 0x25, 0xe1, 0x71, 0x51, 0x75, 0xad, 0x84,
-	0xbf, 0xd4, 0x6c, 0xe1, 0x4e, 0x0a, 0x87, 0xf8, 0xcb, 0x30, 0x5d, 0x2e, 0x45, 0x51, 0x27, 0x9d,
-	0xf4, 0x06, 0xaa, 0xdb, 0x86, 0x89, 0xca, 0x33, 0x2e, 0x97, 0x4c, 0xbe, 0xe4, 0xda, 0x11, 0x5e,
-	0x5d, 0x85, 0x98, 0x0b, 0xa1, 0x8f, 0xf3, 0x23, 0x53, 0xeb, 0x3c, 0xea, 0x24, 0x64, 0xf6, 0x2a,
-	0x07, 0xfd, 0x89, 0x65, 0xf6, 0x4a, 0x53, 0x8b, 0xec, 0xf5, 0x37, 0xa6, 0xd2, 0xa5, 0xb3, 0x81,
-	0xb6, 0x0f, 0x03, 0xc3, 0x35, 0x67, 0x2e, 0x3b, 0xe7, 0xd7, 0x98, 0xad, 0x1a, 0x13, 0xf5, 0xcc,
-	0xd5, 0xba, 0x94, 0x83, 0xff, 0xf6, 0x60, 0xe4, 0x78, 0xe6, 0x0c, 0x1a, 0x81, 0x3e, 0xa6, 0x94,
-	0x03, 0x33, 0x63, 0xd4, 0x99, 0x94, 0x73, 0x27, 0x82, 0x63, 0xdc, 0x89, 0x58, 0x66, 0x2c, 0xb4,
-	0x87, 0xd2, 0xa5, 0x4e, 0xaa, 0xac, 0x19, 0xd4, 0xad, 0x99, 0xc3, 0x3b, 0xca, 0x64, 0xc9, 0x2c,
-	0x76, 0x69, 0xd2, 0xf2, 0x4a, 0xb4, 0x9a, 0x6b, 0x74, 0x43, 0xad, 0xe6, 0x9e, 0xb9, 0x7a, 0x22,
-	0x87, 0x28, 0x7f, 0xd4, 0xf2, 0xea, 0x89, 0x5f, 0xd3, 0x02, 0x85, 0x3c, 0x84, 0xbe, 0xe4, 0x73,
-	0xe5, 0x8f, 0x0d, 0xda, 0x6e, 0x63, 0xb4, 0x39, 0x35, 0xdf, 0x93, 0x43, 0xe8, 0x6b, 0xb6, 0x50,
-	0xbe, 0x67, 0x70, 0x3e, 0x69, 0x57, 0x14, 0xa6, 0x87, 0x6c, 0xa1, 0x1e, 0x24, 0x5a, 0x9e, 0x52,
-	0x83, 0xb6, 0xf5, 0x31, 0x78, 0xa5, 0x0a, 0x23, 0xff, 0x25, 0x3f, 0x2d, 0x78, 0xe7, 0x25, 0x37,
-	0x6d, 0x89, 0x69, 0xa7, 0x8a, 0xc8, 0x30, 0xc2, 0xbd, 0xee, 0xdd, 0x4e, 0x90, 0x81, 0x57, 0x96,
-	0x84, 0x73, 0xd3, 0xf2, 0x19, 0x8c, 0x52, 0x43, 0x3e, 0xca, 0x35, 0x2f, 0x4d, 0x1d, 0x69, 0xc9,
-	0x8d, 0x16, 0x28, 0xc1, 0x5d, 0x18, 0x17, 0xf7, 0xc6, 0x73, 0x17, 0xac, 0xf1, 0x50, 0x77, 0x85,
-	0x87, 0x82, 0x9b, 0x00, 0xd5, 0xd5, 0x10, 0xe3, 0x3a, 0xca, 0x25, 0x2b, 0xa9, 0xb2, 0x4b, 0x4b,
-	0x39, 0xf8, 0x5b, 0x07, 0xde, 0x3d, 0xc8, 0x17, 0x0b, 0xae, 0xcc, 0xda, 0xfc, 0xcb, 0x9c, 0x2b,
-	0x5d, 0xb6, 0x92, 0x9d, 0x5a, 0x4b, 0x8e, 0x3a, 0xfe, 0xaa, 0xa0, 0x53, 0x33, 0x36, 0xbd, 0xaf,
-	0xf8, 0x2d, 0x77, 0x8d, 0xa0, 0x19, 0xd7, 0x5b, 0xf7, 0xfe, 0xdb, 0x6a, 0xdd, 0x83, 0x5f, 0x03,
-	0x54, 0x56, 0x96, 0xa6, 0x74, 0x6a, 0xa6, 0xd8, 0xa4, 0xec, 0xd6, 0x53, 0x5c, 0x85, 0xa9, 0x2c,
-	0x0a, 0x9b, 0x15, 0xea, 0x04, 0xd6, 0x5f, 0x21, 0xb0, 0xe0, 0x05, 0x4c, 0xaa, 0x15, 0x14, 0xf9,
-	0x15, 0xf2, 0x59, 0x29, 0xba, 0xa6, 0xac, 0xf1, 0xf5, 0xbc, 0x72, 0x6c, 0x1d, 0x2d, 0xf8, 0x57,
-	0x07, 0x26, 0xee, 0x0a, 0xfc, 0x44, 0x24, 0x8a, 0xfc, 0xbc, 0x76, 0x5f, 0x6f, 0xb7, 0x52, 0x85,
-	0x56, 0xbb, 0xb2, 0x1f, 0x22, 0x8b, 0xe7, 0x89, 0x2e, 0xe2, 0xf1, 0x47, 0xed, 0x40, 0xef, 0x1b,
-	0x0c, 0xea, 0xb0, 0x82, 0x14, 0x2e, 0xad, 0x4c, 0x5c, 0xd0, 0xc9, 0x5f, 0x2b, 0x7b, 0x65, 0x5b,
-	0xc2, 0x9d, 0x64, 0xee, 0xd1, 0xb5, 0x9e, 0x7c, 0x50, 0x75, 0xd7, 0xd7, 0xca, 0xde, 0xdf, 0x3e,
-	0x1f, 0x38, 0x29, 0x78, 0x05, 0x50, 0x6d, 0xaf, 0x41, 0xcb, 0x60, 0x23, 0xa2, 0x57, 0x46, 0xc4,
-	0x85, 0x67, 0x7f, 0x51, 0x3b, 0x1a, 0xfc, 0xbd, 0x07, 0xfd, 0xa7, 0xc8, 0xe6, 0xe7, 0x30, 0xbe,
-	0x31, 0xa2, 0x5b, 0x33, 0xa2, 0xde, 0x24, 0xf6, 0xde, 0x56, 0x93, 0xf8, 0x5a, 0xd7, 0xda, 0x3f,
-	0xdb, 0xb5, 0x2e, 0xc0, 0x5d, 0x3d, 0x67, 0x16, 0xc8, 0x14, 0xe6, 0xc9, 0xee, 0x67, 0x4d, 0x93,
-	0xce, 0x60, 0xd8, 0xb7, 0x81, 0xa2, 0x79, 0x5e, 0x8f, 0x6b, 0x4a, 0xf2, 0x60, 0x85, 0x8e, 0xbf,
-	0xdf, 0x10, 0xff, 0xd1, 0x89, 0xe5, 0x5f, 0xf2, 0x79, 0x2d, 0xd6, 0xc1, 0x40, 0xdd, 0x6b, 0x19,
-	0xeb, 0x5c, 0xb3, 0x2a, 0xd8, 0x83, 0x63, 0x18, 0xe0, 0x51, 0x29, 0xb2, 0x0f, 0x83, 0x04, 0x07,
-	0x2e, 0x93, 0x3e, 0x6a, 0x88, 0x8e, 0x20, 0xd4, 0x22, 0x54, 0x91, 0xbd, 0x59, 0x7f, 0x44, 0xf9,
-	0x2e, 0x74, 0x1f, 0x9d, 0xfc, 0xbf, 0xa5, 0x23, 0xf8, 0x4f, 0x07, 0xae, 0x9c, 0xe3, 0xdc, 0x33,
-	0x21, 0x55, 0x7f, 0x49, 0xe9, 0xb6, 0x79, 0x49, 0xe9, 0x5d, 0xf8, 0x92, 0xd2, 0x7f, 0xab, 0x2f,
-	0x29, 0xc1, 0xa3, 0x8a, 0xbc, 0xb8, 0x66, 0x17, 0x55, 0xa6, 0x22, 0xd5, 0xba, 0xab, 0xa9, 0xf6,
-	0x5a, 0x52, 0xee, 0xfe, 0x79, 0x04, 0x97, 0x69, 0xb1, 0xe6, 0x81, 0x7d, 0xf5, 0x26, 0x5f, 0xc1,
-	0x04, 0x8b, 0x40, 0xf1, 0x38, 0x76, 0xab, 0xcd, 0x43, 0xed, 0xd6, 0x9d, 0x56, 0x2f, 0x68, 0x2a,
-	0x58, 0x23, 0xbf, 0x03, 0xa0, 0x9c, 0x45, 0x2e, 0xee, 0xdb, 0xad, 0xde, 0xee, 0xfd, 0x2e, 0x58,
-	0x23, 0x7f, 0xec, 0xc0, 0x3b, 0xb8, 0xf9, 0x7a, 0x31, 0xfa, 0xa4, 0x7d, 0xdd, 0xb1, 0x05, 0x7d,
-	0xeb, 0x5e, 0x6b, 0x04, 0x74, 0xc8, 0x1f, 0x3a, 0xb0, 0x8e, 0x36, 0x3d, 0x2f, 0x2a, 0x4b, 0x3b,
-	0x9f, 0xdc, 0x6b, 0x5d, 0xd4, 0xd0, 0x88, 0xaf, 0x60, 0x68, 0xff, 0x7e, 0x68, 0xb9, 0x7a, 0xd3,
-	0x92, 0x5a, 0xfd, 0xd7, 0x11, 0xac, 0x7d, 0xaf, 0x43, 0x34, 0x8c, 0x31, 0x28, 0x4c, 0x39, 0x68,
-	0x4a, 0x7e, 0xfb, 0xd1, 0x56, 0x1b, 0x1a, 0x0a, 0xd6, 0xc8, 0x29, 0x78, 0xe8, 0x78, 0xcb, 0x6c,
-	0xed, 0xf6, 0x7d, 0xab, 0xc5, 0xca, 0x2a, 0x58, 0xfb, 0xec, 0xe1, 0x2f, 0xf7, 0x6c, 0x87, 0x86,
-	0x3f, 0xdd, 0x41, 0x8c, 0x9d, 0x02, 0x63, 0xa7, 0xc1, 0x9f, 0x56, 0x47, 0x43, 0x43, 0x5f, 0x1f,
-	0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xb2, 0x36, 0x94, 0xea, 0x1a, 0x00, 0x00,
+	proto.RegisterFile("components/compliance-service/api/reporting/reporting.proto", fileDescriptor_reporting_f256048208d1dd2a)
+}
+
+var fileDescriptor_reporting_f256048208d1dd2a = []byte{
+	// 2402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4b, 0x73, 0x1c, 0x49,
+	0xf1, 0xff, 0xf7, 0xbc, 0x27, 0x47, 0x92, 0xb5, 0x65, 0xd9, 0xee, 0xbf, 0xbc, 0x0f, 0xd1, 0xc1,
+	0xc3, 0x44, 0xb0, 0x23, 0xd0, 0xda, 0x5e, 0xaf, 0x59, 0x88, 0xf5, 0x5a, 0x32, 0xa1, 0xf0, 0x93,
+	0x1e, 0xb1, 0xb0, 0x10, 0xc4, 0x50, 0x9a, 0xae, 0x19, 0x95, 0xdd, 0xd3, 0xd5, 0x5b, 0x55, 0x23,
+	0x7b, 0xd8, 0xcb, 0xc2, 0x81, 0x23, 0x1c, 0xb8, 0xc2, 0x8d, 0x0b, 0x77, 0x2e, 0xf0, 0x0d, 0x38,
+	0x11, 0x41, 0x04, 0x5f, 0x82, 0x4f, 0x41, 0x64, 0x55, 0xf5, 0x63, 0x2c, 0xc9, 0xbb, 0xd3, 0xf2,
+	0xad, 0x32, 0xab, 0xeb, 0x57, 0xaf, 0xcc, 0x5f, 0x66, 0xe5, 0x0c, 0x7c, 0x7f, 0x24, 0xa6, 0xa9,
+	0x48, 0x58, 0xa2, 0xd5, 0x36, 0x36, 0x63, 0x4e, 0x93, 0x11, 0x7b, 0x57, 0x31, 0x79, 0xcc, 0x47,
+	0x6c, 0x9b, 0xa6, 0x7c, 0x5b, 0xb2, 0x54, 0x48, 0xcd, 0x93, 0x49, 0xd1, 0xea, 0xa7, 0x52, 0x68,
+	0x41, 0xde, 0x1d, 0x1d, 0xb1, 0x71, 0x9f, 0xce, 0xb4, 0x98, 0x52, 0xcd, 0xfa, 0x91, 0x98, 0x52,
+	0x9e, 0xf4, 0x0b, 0x98, 0x3e, 0x4d, 0x79, 0x3f, 0x1f, 0xb4, 0xf9, 0xe6, 0x44, 0x88, 0x49, 0x6c,
+	0x41, 0x69, 0x92, 0x08, 0x4d, 0x35, 0x17, 0x89, 0xb2, 0x60, 0x9b, 0xef, 0xb8, 0x5e, 0x23, 0x1d,
+	0xce, 0xc6, 0xdb, 0x9a, 0x4f, 0x99, 0xd2, 0x74, 0x9a, 0xda, 0x0f, 0x82, 0x6f, 0x02, 0xec, 0xbd,
+	0x40, 0xac, 0x5d, 0xaa, 0x29, 0xf1, 0xa1, 0x3d, 0x12, 0x89, 0x66, 0x89, 0xf6, 0xbd, 0x2d, 0xef,
+	0xda, 0x4a, 0x98, 0x89, 0xc1, 0x06, 0xd4, 0xf6, 0x23, 0xb2, 0x06, 0x35, 0x1e, 0x99, 0xae, 0x6e,
+	0x58, 0xe3, 0x51, 0xf0, 0xf7, 0x1a, 0x34, 0x7f, 0x3c, 0x63, 0x72, 0xee, 0x7a, 0x48, 0xd6, 0x43,
+	0x08, 0x34, 0xf4, 0x3c, 0x65, 0xfe, 0x45, 0xa3, 0x31, 0x6d, 0x32, 0x80, 0xf6, 0x98, 0xc7, 0x9a,
+	0x49, 0xe5, 0x6f, 0x6c, 0xd5, 0xaf, 0xf5, 0x76, 0x3e, 0xe8, 0x2f, 0xb5, 0xd7, 0xfe, 0x03, 0xae,
+	0xf4, 0x3d, 0x83, 0x10, 0x66, 0x48, 0xe4, 0x00, 0x9a, 0x42, 0x46, 0x4c, 0xfa, 0x97, 0xb6, 0xbc,
+	0x6b, 0x6b, 0x3b, 0x3f, 0x5c, 0x12, 0xd2, 0xac, 0xbe, 0xff, 0x18, 0x11, 0x0e, 0xe6, 0x29, 0x0b,
+	0x2d, 0x18, 0x2e, 0x5f, 0x09, 0xa9, 0xfd, 0xcb, 0x76, 0xf9, 0xd8, 0x46, 0x5d, 0x4a, 0x27, 0xcc,
+	0xbf, 0xb2, 0xe5, 0x5d, 0x6b, 0x86, 0xa6, 0x4d, 0xfe, 0x1f, 0x3a, 0x29, 0x93, 0x43, 0xa3, 0xf7,
+	0x8d, 0xbe, 0x9d, 0x32, 0xf9, 0x84, 0x4e, 0x58, 0xf0, 0x36, 0x74, 0x73, 0x58, 0xd2, 0x86, 0xfa,
+	0x9d, 0xc1, 0xdd, 0xf5, 0xff, 0x23, 0x1d, 0x68, 0xec, 0xee, 0x0d, 0xee, 0xae, 0x7b, 0xc1, 0x2d,
+	0x80, 0x62, 0x3f, 0xe4, 0x32, 0xb4, 0x8e, 0x69, 0x3c, 0x63, 0xf6, 0x68, 0xba, 0xa1, 0x93, 0xf2,
+	0x73, 0xbc, 0x54, 0x9c, 0x63, 0xf0, 0x16, 0x34, 0x0f, 0x84, 0xa6, 0x31, 0xd9, 0x80, 0xa6, 0xc6,
+	0x86, 0xb9, 0x91, 0x66, 0x68, 0x85, 0x60, 0x0c, 0xad, 0x7b, 0x94, 0xc7, 0x2c, 0x3a, 0xbd, 0x1f,
+	0xb5, 0x53, 0x9e, 0x08, 0xe9, 0xd7, 0xac, 0xd6, 0x08, 0x46, 0x4b, 0x9f, 0x0a, 0xe9, 0xd7, 0x9d,
+	0x16, 0x05, 0xb2, 0x09, 0x9d, 0x91, 0xe4, 0x9a, 0x8f, 0x68, 0xec, 0x37, 0x4c, 0x47, 0x2e, 0x07,
+	0x7f, 0xac, 0xc1, 0xda, 0x5d, 0x91, 0x68, 0x29, 0xe2, 0xc1, 0x6c, 0x3a, 0xa5, 0x72, 0x7e, 0xc6,
+	0x84, 0x0f, 0xa0, 0x95, 0x52, 0xa5, 0x58, 0x64, 0x66, 0xec, 0xed, 0x5c, 0x5f, 0xf2, 0x8e, 0xcc,
+	0x66, 0x43, 0x87, 0x41, 0x1e, 0x41, 0x5b, 0x3d, 0xe3, 0x69, 0xca, 0x22, 0xbf, 0x7e, 0x0e, 0xb8,
+	0x0c, 0x84, 0x3c, 0x84, 0xd6, 0xd8, 0x1c, 0x97, 0xd9, 0x60, 0x6f, 0xe7, 0xc6, 0x92, 0x70, 0xf6,
+	0xac, 0x43, 0x07, 0x12, 0xa4, 0xd0, 0x0e, 0x4d, 0x9f, 0x22, 0x8f, 0xa1, 0x6d, 0x3f, 0x53, 0xbe,
+	0xb7, 0x55, 0xaf, 0x00, 0x6d, 0x81, 0xc2, 0x0c, 0xa5, 0x38, 0xde, 0x5a, 0xf9, 0xbe, 0x7f, 0xd3,
+	0x84, 0x96, 0xfd, 0xf2, 0x65, 0xff, 0x24, 0x57, 0xa0, 0x9d, 0x88, 0x88, 0x0d, 0xb9, 0x3d, 0xfa,
+	0x6e, 0xd8, 0x42, 0x71, 0x3f, 0x22, 0x57, 0xa1, 0x6b, 0x3a, 0x12, 0x3a, 0x65, 0xe6, 0x18, 0xbb,
+	0x61, 0x07, 0x15, 0x8f, 0xe8, 0x94, 0x91, 0x1b, 0xd0, 0x61, 0x49, 0x34, 0x44, 0xaa, 0x70, 0x67,
+	0xb2, 0xd9, 0xb7, 0x3c, 0xd2, 0xcf, 0x78, 0xa4, 0x7f, 0x90, 0xf1, 0x48, 0xd8, 0x66, 0x49, 0x84,
+	0x12, 0x9a, 0xb0, 0xd2, 0x54, 0xcf, 0x94, 0xdf, 0xb4, 0x73, 0x59, 0x89, 0x7c, 0x0a, 0x9d, 0x91,
+	0x35, 0x13, 0xe5, 0xb7, 0x0c, 0xdc, 0x0f, 0x96, 0x3c, 0x87, 0x45, 0x2b, 0x0b, 0x73, 0x38, 0xb2,
+	0x05, 0x3d, 0x96, 0x1c, 0x73, 0x29, 0x92, 0x29, 0x72, 0x56, 0xdb, 0xcc, 0x5b, 0x56, 0x21, 0xa3,
+	0x1d, 0x33, 0xa9, 0xb8, 0x48, 0xfc, 0x8e, 0xe9, 0xcd, 0x44, 0x32, 0x80, 0x4e, 0x1a, 0x53, 0x3d,
+	0x16, 0x72, 0xea, 0x77, 0xcd, 0xb2, 0xde, 0x5f, 0x72, 0x59, 0x4f, 0xdc, 0xf0, 0x30, 0x07, 0x22,
+	0x9f, 0x02, 0xe0, 0xae, 0xb9, 0xd2, 0x7c, 0xa4, 0x7c, 0xd8, 0xf2, 0x2a, 0xb0, 0xdc, 0x20, 0x07,
+	0x08, 0x4b, 0x60, 0x24, 0x84, 0x4e, 0x2a, 0xc5, 0x98, 0xc7, 0x4c, 0xf9, 0x3d, 0x63, 0x4e, 0x37,
+	0x97, 0x5d, 0xaf, 0x1d, 0x1e, 0xe6, 0x38, 0xe4, 0x12, 0xb4, 0x9e, 0x8a, 0x43, 0x34, 0x8f, 0x15,
+	0x73, 0x38, 0xcd, 0xa7, 0xe2, 0x70, 0x3f, 0x22, 0x6f, 0x42, 0x97, 0xa7, 0x34, 0x8a, 0x24, 0x53,
+	0xca, 0x5f, 0x35, 0x3d, 0x85, 0x02, 0x29, 0x69, 0xfc, 0x59, 0x94, 0xf8, 0x6b, 0x96, 0x92, 0xb0,
+	0x1d, 0xfc, 0xb9, 0x05, 0x6d, 0x07, 0x8f, 0xfd, 0xc6, 0xac, 0xac, 0x19, 0x9a, 0xb6, 0xb1, 0x5c,
+	0xae, 0x63, 0xe6, 0xcc, 0xd0, 0x0a, 0xe4, 0x6d, 0x00, 0x5c, 0xb1, 0xa6, 0x3c, 0x61, 0xd2, 0x99,
+	0x61, 0x49, 0x83, 0xeb, 0x18, 0x89, 0x74, 0x2e, 0xf9, 0xe4, 0x48, 0x1b, 0x4b, 0xec, 0x86, 0x85,
+	0x82, 0x7c, 0x0b, 0x2e, 0xe4, 0xc2, 0x90, 0x4d, 0x29, 0x8f, 0x9d, 0xe1, 0xad, 0xe5, 0xea, 0x3d,
+	0xd4, 0xa2, 0x0d, 0xc4, 0x7c, 0xc4, 0x12, 0xc5, 0x8c, 0xfd, 0x75, 0xc3, 0x4c, 0xc4, 0x1e, 0x65,
+	0x8d, 0xca, 0xd9, 0x4e, 0x26, 0xbe, 0xc2, 0x6e, 0x36, 0xa0, 0x29, 0x9e, 0xe3, 0x7a, 0xbb, 0x76,
+	0x2b, 0x46, 0xc0, 0xdb, 0x51, 0xb3, 0xd4, 0x3a, 0xfb, 0x7a, 0xa5, 0xdb, 0x19, 0xd8, 0xe1, 0x61,
+	0x8e, 0x83, 0xf1, 0x32, 0x62, 0x29, 0x4b, 0x22, 0xe5, 0xbf, 0x51, 0x29, 0x5e, 0xee, 0x9a, 0xd1,
+	0x2c, 0x19, 0xcd, 0xc3, 0x0c, 0xc9, 0x78, 0xe9, 0x11, 0xdd, 0xb9, 0x71, 0xd3, 0x05, 0x6b, 0x27,
+	0x21, 0x49, 0x4f, 0xa4, 0x98, 0xa5, 0xca, 0xbf, 0xb8, 0x55, 0xaf, 0xc0, 0xaa, 0x3f, 0xc2, 0xc1,
+	0xa1, 0xc3, 0xc0, 0xe3, 0xc8, 0x7d, 0x7e, 0xa3, 0xd2, 0x71, 0x38, 0x9f, 0x2f, 0x39, 0xfb, 0xcf,
+	0x00, 0xa8, 0xd6, 0x92, 0x1f, 0xce, 0x34, 0x53, 0xfe, 0x25, 0x83, 0x7a, 0x6b, 0x49, 0xd4, 0x3b,
+	0x19, 0x40, 0x58, 0xc2, 0x22, 0xdf, 0x80, 0xb5, 0x98, 0x6a, 0xa6, 0xf4, 0x30, 0xbb, 0x73, 0x1b,
+	0xf7, 0x57, 0xad, 0xf6, 0x13, 0x77, 0xf3, 0x05, 0xc1, 0x5d, 0x59, 0x20, 0xb8, 0xaf, 0xc1, 0x0a,
+	0x06, 0x93, 0xe1, 0x94, 0x29, 0x95, 0x25, 0x02, 0xdd, 0xb0, 0x87, 0xba, 0x87, 0x56, 0x15, 0x7c,
+	0x1b, 0xea, 0x21, 0x1b, 0x93, 0x75, 0xa8, 0xcf, 0x64, 0xec, 0x3c, 0x03, 0x9b, 0xa8, 0x91, 0x6c,
+	0xec, 0xdc, 0x02, 0x9b, 0xc1, 0xdf, 0x3c, 0xa4, 0x73, 0x35, 0x8b, 0x75, 0x69, 0x42, 0x6f, 0x61,
+	0xc2, 0xab, 0xe8, 0x17, 0x11, 0x1b, 0x46, 0x4c, 0x8d, 0xdc, 0xd0, 0x0e, 0x2a, 0x76, 0x99, 0x1a,
+	0x61, 0x4a, 0x22, 0x67, 0x89, 0x65, 0x6f, 0x74, 0xa9, 0x5a, 0xd8, 0x96, 0xb3, 0xc4, 0x30, 0xf4,
+	0x5b, 0x86, 0x9d, 0xa4, 0x2e, 0xa8, 0xbd, 0x1b, 0x76, 0x8d, 0xc6, 0x74, 0xfb, 0xd0, 0xce, 0xb6,
+	0x60, 0x1d, 0x29, 0x13, 0x4f, 0xec, 0xb0, 0x75, 0x72, 0x87, 0x37, 0x61, 0x6d, 0x20, 0x66, 0x72,
+	0xc4, 0x1e, 0x88, 0x91, 0x49, 0x41, 0xb3, 0xad, 0x79, 0xf9, 0xd6, 0x90, 0x19, 0x62, 0x9e, 0x30,
+	0x17, 0xbe, 0x4c, 0x3b, 0xd8, 0x85, 0xd6, 0xe3, 0xd4, 0x7c, 0xbf, 0x05, 0x3d, 0xdc, 0x90, 0xe4,
+	0x46, 0x74, 0xe3, 0xca, 0x2a, 0x5c, 0x60, 0xc4, 0xc6, 0x74, 0x16, 0x6b, 0xb7, 0xeb, 0x4c, 0x0c,
+	0xfe, 0xe4, 0x41, 0xdb, 0x39, 0x10, 0x06, 0x3d, 0xa1, 0x86, 0x25, 0x0a, 0x6a, 0x09, 0x65, 0xe2,
+	0xda, 0x55, 0xe8, 0x0a, 0x35, 0x1c, 0xd3, 0x29, 0x8f, 0xe7, 0xd9, 0xb1, 0x09, 0x75, 0xcf, 0xc8,
+	0x88, 0x2d, 0x59, 0xcc, 0xa8, 0xca, 0xe2, 0x61, 0x26, 0xa2, 0x75, 0xf0, 0x44, 0xa5, 0x6c, 0x94,
+	0x5b, 0x87, 0x3d, 0xb9, 0x55, 0xab, 0xcd, 0xac, 0x63, 0xb3, 0x14, 0x4f, 0xec, 0xf1, 0xe5, 0x72,
+	0xf0, 0xcf, 0x1a, 0x40, 0xe1, 0x8c, 0xa7, 0x32, 0xa4, 0x33, 0x8d, 0x5a, 0x61, 0x1a, 0x26, 0xdf,
+	0xd4, 0x47, 0x6e, 0x39, 0xa6, 0x8d, 0x5f, 0x4d, 0x78, 0xc6, 0x85, 0xd8, 0x44, 0x1b, 0x39, 0x94,
+	0x34, 0x19, 0x1d, 0x65, 0x51, 0xd7, 0x4a, 0xf8, 0xa5, 0xa6, 0x13, 0x77, 0x53, 0xd8, 0xc4, 0x2f,
+	0x47, 0x62, 0x3a, 0xe5, 0x59, 0x9c, 0x74, 0xd2, 0x2b, 0xa8, 0x6e, 0x0b, 0x7a, 0x6a, 0x96, 0x32,
+	0x39, 0xa5, 0xf2, 0x19, 0xd3, 0x8e, 0xf0, 0xca, 0x2a, 0xc4, 0x9c, 0x70, 0x7d, 0x34, 0x3b, 0x34,
+	0xb1, 0xae, 0x1b, 0x3a, 0x09, 0x99, 0xbd, 0xf0, 0x41, 0xbf, 0x67, 0xfa, 0x4a, 0x9a, 0x92, 0x65,
+	0xaf, 0xbc, 0xd2, 0x95, 0x56, 0x4f, 0x1a, 0xda, 0x3e, 0x34, 0x0d, 0xd7, 0x9c, 0x48, 0x76, 0x4e,
+	0x8f, 0x31, 0x9b, 0x25, 0x26, 0xaa, 0x9b, 0xd4, 0x3a, 0x97, 0x83, 0xff, 0x34, 0xa0, 0xed, 0x78,
+	0xe6, 0x04, 0x1a, 0x81, 0x06, 0xba, 0x94, 0x03, 0x33, 0x6d, 0xd4, 0x19, 0x97, 0x73, 0x37, 0x82,
+	0x6d, 0xdc, 0x09, 0x9f, 0xa6, 0x74, 0x64, 0x2f, 0xa5, 0x16, 0x3a, 0xa9, 0x58, 0x4d, 0xb3, 0xbc,
+	0x9a, 0x31, 0x5c, 0x50, 0xc6, 0x4b, 0x86, 0xb1, 0x73, 0x93, 0x8a, 0x29, 0xd1, 0xa2, 0xaf, 0x85,
+	0x6b, 0x6a, 0x41, 0xb6, 0xa9, 0x27, 0x72, 0x88, 0xf2, 0xdb, 0x15, 0x53, 0x4f, 0x1c, 0x1d, 0x66,
+	0x28, 0xe4, 0x1e, 0x34, 0x24, 0x1b, 0x2b, 0xbf, 0x63, 0xd0, 0x76, 0x96, 0x46, 0x1b, 0x87, 0x66,
+	0x3c, 0x39, 0x80, 0x86, 0xa6, 0x13, 0xe5, 0x77, 0x0d, 0xce, 0x47, 0xd5, 0x82, 0x42, 0xff, 0x80,
+	0x4e, 0xd4, 0x5e, 0xa2, 0xe5, 0x3c, 0x34, 0x68, 0xe4, 0x00, 0x5a, 0xcf, 0x29, 0x3f, 0x66, 0xd2,
+	0xa5, 0x5c, 0x1f, 0x56, 0xc3, 0xfd, 0xa9, 0xc1, 0x08, 0x1d, 0xd6, 0xe6, 0xfb, 0xd0, 0xcd, 0x27,
+	0x42, 0x7f, 0x7a, 0xc6, 0xe6, 0x19, 0x9b, 0x3d, 0x63, 0xe6, 0xb1, 0x63, 0x1e, 0x69, 0x99, 0xbd,
+	0x19, 0xe1, 0x76, 0xed, 0x96, 0x17, 0x7c, 0xe1, 0xc1, 0xea, 0x02, 0xe4, 0x09, 0xeb, 0xfa, 0x3a,
+	0xac, 0x3e, 0x9d, 0x29, 0xcd, 0xc7, 0xdc, 0x59, 0x81, 0xc5, 0x58, 0x54, 0x92, 0x0f, 0x00, 0xd8,
+	0x8b, 0x94, 0x4b, 0xa6, 0x86, 0x54, 0xfb, 0xf5, 0x2f, 0x4d, 0xc5, 0xbb, 0xee, 0xeb, 0x3b, 0x3a,
+	0x48, 0xa1, 0x9b, 0xc7, 0xba, 0x53, 0xf9, 0xe6, 0x31, 0xb4, 0x85, 0x61, 0x55, 0xe5, 0x5e, 0x65,
+	0xcb, 0x5a, 0x88, 0x65, 0xed, 0x30, 0x43, 0x09, 0x6e, 0x41, 0x27, 0x4b, 0x88, 0x4f, 0x9d, 0xb0,
+	0x44, 0xb0, 0xb5, 0x05, 0x82, 0x0d, 0xae, 0x01, 0x14, 0x39, 0x2f, 0x3a, 0x6c, 0x34, 0x93, 0x34,
+	0x8f, 0x01, 0xb5, 0x30, 0x97, 0x83, 0xbf, 0x78, 0xf0, 0xc6, 0x60, 0x36, 0x99, 0x30, 0x65, 0xe6,
+	0x66, 0x9f, 0xcd, 0x98, 0xd2, 0xf9, 0x1b, 0xd9, 0x2b, 0xd5, 0x1a, 0x50, 0xc7, 0x5e, 0x64, 0x71,
+	0xc2, 0xb4, 0x51, 0xa7, 0xf8, 0xaf, 0x99, 0x7b, 0xe1, 0x9a, 0x76, 0xb9, 0x26, 0xd1, 0x78, 0x5d,
+	0x35, 0x89, 0xe0, 0x57, 0x00, 0xc5, 0x2a, 0xf3, 0xa5, 0x78, 0xa5, 0xa5, 0x58, 0x7b, 0xa8, 0x95,
+	0xb9, 0x4b, 0x8d, 0x84, 0xcc, 0x22, 0xb6, 0x15, 0xca, 0xcc, 0xdc, 0x58, 0x60, 0xe6, 0xe0, 0x29,
+	0xf4, 0x8a, 0x19, 0x14, 0xf9, 0x05, 0x12, 0x75, 0x2e, 0xfa, 0x5e, 0xa5, 0x9d, 0x94, 0x0e, 0xb6,
+	0x8c, 0x16, 0xfc, 0xc3, 0x83, 0x9e, 0xcb, 0xed, 0x1f, 0xf2, 0x44, 0x91, 0x9f, 0x94, 0x1e, 0x22,
+	0xd5, 0x66, 0x2a, 0xd0, 0x4a, 0x6f, 0x91, 0x03, 0x0c, 0x4f, 0xb3, 0x44, 0x67, 0xf6, 0xf8, 0x61,
+	0x35, 0xd0, 0xbb, 0x06, 0x23, 0x74, 0x58, 0x81, 0x80, 0xd5, 0x85, 0x8e, 0x33, 0x4a, 0x14, 0x97,
+	0xf3, 0x22, 0x80, 0xcd, 0x4d, 0x9c, 0x64, 0x1e, 0x08, 0xa5, 0x62, 0x43, 0xb3, 0x28, 0x1b, 0x5c,
+	0xce, 0x8b, 0x1a, 0xb6, 0x2e, 0xe2, 0xa4, 0xe0, 0x05, 0x40, 0xb1, 0xbd, 0x25, 0xde, 0x42, 0xd6,
+	0x22, 0xea, 0xb9, 0x45, 0x9c, 0x79, 0xf7, 0x67, 0xbd, 0xb3, 0x83, 0xbf, 0xd6, 0xa1, 0xf1, 0x48,
+	0x44, 0x19, 0xd4, 0x42, 0x28, 0x33, 0x8b, 0xa8, 0x95, 0x16, 0x51, 0x7e, 0xfd, 0xd6, 0x5f, 0xd7,
+	0xeb, 0xf7, 0xa5, 0xe7, 0x78, 0xe3, 0xe4, 0x73, 0x7c, 0x02, 0x2e, 0xa7, 0x1e, 0x5a, 0x20, 0x93,
+	0x71, 0xf4, 0x76, 0x3e, 0x5e, 0xd6, 0xe9, 0x0c, 0x86, 0x2d, 0x7a, 0x64, 0x55, 0x81, 0x95, 0xb8,
+	0xa4, 0x24, 0x7b, 0x0b, 0x71, 0xe6, 0x7b, 0x4b, 0xe2, 0xdf, 0x3f, 0x76, 0x81, 0xe5, 0x93, 0x92,
+	0xad, 0x83, 0x81, 0xba, 0x5d, 0xd1, 0xd6, 0x99, 0xa6, 0x85, 0xb1, 0x07, 0x47, 0xd0, 0xc4, 0xab,
+	0x52, 0x64, 0x1f, 0x9a, 0x58, 0x77, 0xc9, 0x3c, 0xe9, 0xbd, 0x25, 0xd1, 0x11, 0x24, 0xb4, 0x08,
+	0x85, 0x65, 0x6f, 0x94, 0xab, 0x43, 0xdf, 0x81, 0xda, 0xfd, 0xe3, 0xaf, 0x1a, 0xbd, 0x82, 0x7f,
+	0x79, 0x70, 0xf1, 0x94, 0xc3, 0x3d, 0x61, 0x52, 0xe5, 0x12, 0x51, 0xad, 0x4a, 0x89, 0xa8, 0x7e,
+	0x66, 0x89, 0xa8, 0xf1, 0x5a, 0x4b, 0x44, 0xc1, 0xfd, 0x82, 0xbc, 0x98, 0xa6, 0x67, 0x45, 0xa6,
+	0xcc, 0xd5, 0x6a, 0x8b, 0xae, 0xf6, 0x92, 0x53, 0x06, 0xbf, 0xf3, 0xa0, 0xbb, 0xcb, 0xc7, 0x63,
+	0x5b, 0xf3, 0xbe, 0x02, 0xed, 0xb1, 0x14, 0xd3, 0x61, 0x7e, 0x32, 0x2d, 0x14, 0xf7, 0x23, 0x72,
+	0x11, 0x6f, 0xa2, 0x28, 0xba, 0x35, 0xb4, 0xd8, 0x5f, 0xa8, 0xc5, 0xd5, 0x17, 0x6a, 0x71, 0x3b,
+	0xd0, 0x3a, 0x64, 0x63, 0x24, 0xff, 0x2f, 0x2f, 0xb6, 0xb9, 0x2f, 0x83, 0xff, 0x36, 0x01, 0xec,
+	0x0d, 0xe1, 0x72, 0xc8, 0x3e, 0x34, 0x70, 0x6a, 0xdf, 0xab, 0x14, 0xc9, 0x2d, 0x50, 0x68, 0x20,
+	0xc8, 0x1e, 0xd4, 0xb4, 0xf0, 0x6b, 0xe7, 0x01, 0xaa, 0x69, 0x41, 0x7e, 0x09, 0x2b, 0x09, 0x7b,
+	0x1e, 0xcf, 0x87, 0x8e, 0x56, 0xeb, 0x95, 0x9c, 0xc7, 0xdd, 0x2a, 0xee, 0x31, 0xec, 0x19, 0x3c,
+	0x57, 0xd9, 0xce, 0xe1, 0x73, 0x0e, 0x7e, 0x3d, 0xf0, 0x4f, 0x0c, 0x1c, 0x19, 0xc2, 0xaa, 0x85,
+	0xcf, 0xc8, 0xbf, 0x79, 0x6e, 0x7c, 0xbb, 0xde, 0x81, 0x8b, 0x1e, 0x14, 0xd6, 0xec, 0x3b, 0x61,
+	0x38, 0x3a, 0xa2, 0xc9, 0x84, 0x45, 0x7e, 0xeb, 0xdc, 0x33, 0xac, 0x5a, 0xc4, 0xbb, 0x16, 0x10,
+	0xa7, 0xa0, 0x51, 0xc4, 0xa2, 0x61, 0x4e, 0x60, 0xed, 0xf3, 0x10, 0x98, 0x9d, 0xc2, 0x20, 0x3e,
+	0xc9, 0x42, 0x36, 0x83, 0x75, 0xc9, 0xa6, 0xe2, 0xb8, 0x3c, 0x49, 0xe7, 0xdc, 0x93, 0x5c, 0x70,
+	0x98, 0xd9, 0x34, 0xc1, 0xbf, 0x3d, 0xe8, 0x95, 0x36, 0x8a, 0x8f, 0x44, 0x37, 0x5d, 0xf9, 0x95,
+	0xdf, 0x73, 0x3a, 0xf3, 0xd4, 0x3f, 0x25, 0xbf, 0xb2, 0x31, 0xb7, 0x5e, 0x8e, 0xb9, 0xef, 0x40,
+	0xcf, 0x38, 0xb0, 0xe3, 0x24, 0x1b, 0xaf, 0x00, 0x55, 0x83, 0xbc, 0xd0, 0xa2, 0xc5, 0x70, 0x21,
+	0xda, 0x76, 0xb4, 0x70, 0x9d, 0xd9, 0x68, 0xf7, 0xfc, 0x6b, 0x99, 0xcc, 0xcd, 0x8c, 0xde, 0x37,
+	0x1a, 0x37, 0xda, 0x75, 0xb7, 0x6d, 0x2a, 0xab, 0x85, 0xed, 0x0c, 0x78, 0xce, 0x4b, 0x66, 0x4f,
+	0x5f, 0x3d, 0x51, 0x28, 0xb1, 0x55, 0xfd, 0x64, 0x62, 0x60, 0x4b, 0x7b, 0x8d, 0x72, 0x69, 0x6f,
+	0xe7, 0xf7, 0x1d, 0x58, 0x0f, 0xb3, 0xa3, 0x1e, 0xd8, 0x1f, 0x21, 0xc9, 0xe7, 0xd0, 0xc3, 0xd4,
+	0x35, 0xfb, 0xad, 0xe2, 0x7a, 0x95, 0xdf, 0xcd, 0x36, 0x6f, 0x56, 0x22, 0x08, 0x45, 0xe6, 0xc8,
+	0x5e, 0x34, 0xb2, 0x62, 0xc5, 0xb9, 0xab, 0x91, 0x13, 0xf9, 0x83, 0x07, 0x17, 0x70, 0xe3, 0xe5,
+	0xf4, 0xf9, 0xa3, 0xea, 0x99, 0xb2, 0x7d, 0x82, 0x6c, 0xde, 0xae, 0x8c, 0xa0, 0xc8, 0x17, 0x1e,
+	0xac, 0xe0, 0x8a, 0x72, 0xb7, 0xaa, 0x76, 0x1e, 0xb7, 0x2b, 0x27, 0xe1, 0x8a, 0x7c, 0x0e, 0x2d,
+	0xfb, 0x2b, 0x70, 0xc5, 0xb9, 0x97, 0x7d, 0x00, 0x14, 0x3f, 0x39, 0x7f, 0xd7, 0x23, 0x12, 0x3a,
+	0x68, 0x0c, 0x26, 0x75, 0x5d, 0x36, 0x51, 0xdb, 0x8f, 0x36, 0xab, 0xa4, 0x4c, 0xe4, 0x39, 0x74,
+	0xf1, 0xc8, 0x6d, 0x0e, 0x56, 0x6d, 0xcf, 0xd7, 0x2b, 0xcc, 0xab, 0xc8, 0x6f, 0x3d, 0xe8, 0x19,
+	0x96, 0x73, 0x9e, 0xb0, 0x6c, 0x01, 0x3b, 0xcf, 0x3e, 0x96, 0x3e, 0xf3, 0x22, 0x5d, 0xf8, 0xf8,
+	0xde, 0xcf, 0x77, 0x6d, 0x9d, 0x0e, 0xbf, 0xde, 0x46, 0x98, 0xed, 0x0c, 0x66, 0x7b, 0x89, 0xbf,
+	0x2e, 0x1c, 0xb6, 0x4c, 0x86, 0xf2, 0xde, 0xff, 0x06, 0x00, 0xf6, 0x55, 0xbd, 0x70, 0xf0, 0x20,
+	0x00, 0x00,
 }
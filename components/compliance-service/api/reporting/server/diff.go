@@ -0,0 +1,111 @@
+package server
+
+import (
+	"github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/inspec"
+	"github.com/chef/automate/components/compliance-service/reporting/util"
+)
+
+// diffReports compares the controls of the profiles the two reports share,
+// matching profiles by name so that a new version of a profile is compared
+// with the previous one
+func diffReports(from, to *reporting.Report) *reporting.ReportDiff {
+	diff := &reporting.ReportDiff{
+		From:            reportHeader(from),
+		To:              reportHeader(to),
+		NewlyFailed:     make([]*reporting.ControlDiff, 0),
+		NewlyPassed:     make([]*reporting.ControlDiff, 0),
+		NewlySkipped:    make([]*reporting.ControlDiff, 0),
+		ImpactChanged:   make([]*reporting.ControlDiff, 0),
+		AddedProfiles:   make([]*reporting.ProfileDiff, 0),
+		RemovedProfiles: make([]*reporting.ProfileDiff, 0),
+	}
+
+	fromProfiles := profilesByName(from.Profiles)
+	toProfiles := profilesByName(to.Profiles)
+	for _, profile := range from.Profiles {
+		if _, ok := toProfiles[profile.Name]; !ok {
+			diff.RemovedProfiles = append(diff.RemovedProfiles, profileDiff(profile))
+		}
+	}
+
+	for _, toProfile := range to.Profiles {
+		fromProfile, ok := fromProfiles[toProfile.Name]
+		if !ok {
+			diff.AddedProfiles = append(diff.AddedProfiles, profileDiff(toProfile))
+			continue
+		}
+
+		fromControls := make(map[string]*reporting.Control, len(fromProfile.Controls))
+		for _, control := range fromProfile.Controls {
+			fromControls[control.Id] = control
+		}
+		for _, toControl := range toProfile.Controls {
+			fromControl, ok := fromControls[toControl.Id]
+			if !ok {
+				continue
+			}
+			controlDiff := &reporting.ControlDiff{
+				ProfileName: toProfile.Name,
+				Id:          toControl.Id,
+				Title:       toControl.Title,
+				FromStatus:  util.ControlStatus(fromControl),
+				ToStatus:    util.ControlStatus(toControl),
+				FromImpact:  fromControl.Impact,
+				ToImpact:    toControl.Impact,
+			}
+
+			switch {
+			case controlDiff.ToStatus == inspec.ResultStatusFailed &&
+				controlDiff.FromStatus != inspec.ResultStatusFailed:
+				diff.NewlyFailed = append(diff.NewlyFailed, controlDiff)
+			case controlDiff.ToStatus == inspec.ResultStatusPassed &&
+				controlDiff.FromStatus == inspec.ResultStatusFailed:
+				diff.NewlyPassed = append(diff.NewlyPassed, controlDiff)
+			case controlDiff.ToStatus == inspec.ResultStatusSkipped &&
+				controlDiff.FromStatus != inspec.ResultStatusSkipped:
+				diff.NewlySkipped = append(diff.NewlySkipped, controlDiff)
+			}
+			if controlDiff.FromImpact != controlDiff.ToImpact {
+				diff.ImpactChanged = append(diff.ImpactChanged, controlDiff)
+			}
+		}
+	}
+	return diff
+}
+
+// reportHeader returns the report without its profiles
+func reportHeader(report *reporting.Report) *reporting.Report {
+	return &reporting.Report{
+		Id:          report.Id,
+		NodeId:      report.NodeId,
+		NodeName:    report.NodeName,
+		EndTime:     report.EndTime,
+		Status:      report.Status,
+		Controls:    report.Controls,
+		Environment: report.Environment,
+		Version:     report.Version,
+		Platform:    report.Platform,
+		Statistics:  report.Statistics,
+		JobId:       report.JobId,
+		Ipaddress:   report.Ipaddress,
+		Fqdn:        report.Fqdn,
+	}
+}
+
+func profilesByName(profiles []*reporting.Profile) map[string]*reporting.Profile {
+	byName := make(map[string]*reporting.Profile, len(profiles))
+	for _, profile := range profiles {
+		byName[profile.Name] = profile
+	}
+	return byName
+}
+
+func profileDiff(profile *reporting.Profile) *reporting.ProfileDiff {
+	return &reporting.ProfileDiff{
+		Name:    profile.Name,
+		Title:   profile.Title,
+		Version: profile.Version,
+		Sha256:  profile.Sha256,
+	}
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chef/automate/components/compliance-service/api/reporting"
+)
+
+func newDiffTestControl(id string, impact float32, statuses ...string) *reporting.Control {
+	results := make([]*reporting.Result, len(statuses))
+	for i, status := range statuses {
+		results[i] = &reporting.Result{Status: status}
+	}
+	return &reporting.Control{Id: id, Title: id + " title", Impact: impact, Results: results}
+}
+
+func TestDiffReports(t *testing.T) {
+	from := &reporting.Report{
+		Id:     "report-1",
+		Status: "failed",
+		Profiles: []*reporting.Profile{
+			{
+				Name:    "linux-baseline",
+				Version: "1.0.0",
+				Sha256:  "linux-1",
+				Controls: []*reporting.Control{
+					newDiffTestControl("control-1", 0.5, "passed"),
+					newDiffTestControl("control-2", 0.5, "passed", "failed"),
+					newDiffTestControl("control-3", 0.5, "passed"),
+					newDiffTestControl("control-4", 0.3, "failed"),
+					newDiffTestControl("control-5", 0.5, "skipped"),
+					newDiffTestControl("control-6", 0.5, "passed"),
+				},
+			},
+			{Name: "ssh-baseline", Version: "2.0.0", Sha256: "ssh-2"},
+		},
+	}
+	to := &reporting.Report{
+		Id:     "report-2",
+		Status: "failed",
+		Profiles: []*reporting.Profile{
+			{
+				Name:    "linux-baseline",
+				Version: "1.1.0",
+				Sha256:  "linux-2",
+				Controls: []*reporting.Control{
+					newDiffTestControl("control-1", 0.5, "failed"),
+					newDiffTestControl("control-2", 0.5, "passed"),
+					newDiffTestControl("control-3", 0.5, "skipped"),
+					newDiffTestControl("control-4", 0.9, "failed"),
+					newDiffTestControl("control-5", 0.5, "failed"),
+					newDiffTestControl("control-7", 0.5, "failed"),
+				},
+			},
+			{Name: "apache-baseline", Title: "Apache", Version: "3.0.0", Sha256: "apache-3"},
+		},
+	}
+
+	diff := diffReports(from, to)
+
+	assert.Equal(t, "report-1", diff.From.Id)
+	assert.Nil(t, diff.From.Profiles)
+	assert.Equal(t, "report-2", diff.To.Id)
+	assert.Nil(t, diff.To.Profiles)
+
+	controlIds := func(controls []*reporting.ControlDiff) []string {
+		ids := make([]string, len(controls))
+		for i, control := range controls {
+			ids[i] = control.Id
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"control-1", "control-5"}, controlIds(diff.NewlyFailed))
+	assert.Equal(t, []string{"control-2"}, controlIds(diff.NewlyPassed))
+	assert.Equal(t, []string{"control-3"}, controlIds(diff.NewlySkipped))
+	assert.Equal(t, []*reporting.ControlDiff{{
+		ProfileName: "linux-baseline",
+		Id:          "control-4",
+		Title:       "control-4 title",
+		FromStatus:  "failed",
+		ToStatus:    "failed",
+		FromImpact:  0.3,
+		ToImpact:    0.9,
+	}}, diff.ImpactChanged)
+
+	assert.Equal(t, []*reporting.ProfileDiff{
+		{Name: "apache-baseline", Title: "Apache", Version: "3.0.0", Sha256: "apache-3"},
+	}, diff.AddedProfiles)
+	assert.Equal(t, []*reporting.ProfileDiff{
+		{Name: "ssh-baseline", Version: "2.0.0", Sha256: "ssh-2"},
+	}, diff.RemovedProfiles)
+}
+
+func TestDiffReportsRequiresReports(t *testing.T) {
+	srv := New(nil, nil)
+	for _, in := range []*reporting.DiffQuery{
+		{},
+		{FromId: "report-1"},
+		{ToId: "report-2", NodeId: "node-1"},
+	} {
+		_, err := srv.DiffReports(context.Background(), in)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%+v", in)
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/chef/automate/components/compliance-service/api/reporting"
+)
+
+type exportTestStream struct {
+	grpc.ServerStream
+	content strings.Builder
+}
+
+func (stream *exportTestStream) Send(data *reporting.ExportData) error {
+	stream.content.Write(data.Content)
+	return nil
+}
+
+func testConvert(report *reporting.Report) (string, error) {
+	return "<" + report.Id + ">", nil
+}
+
+func TestDocumentExport(t *testing.T) {
+	stream := &exportTestStream{}
+	exporter, finisher := documentExport(stream, testConvert, "[", ",", "]")
+	require.NoError(t, exporter(&reporting.Report{Id: "a"}))
+	require.NoError(t, exporter(&reporting.Report{Id: "b"}))
+	require.NoError(t, finisher())
+	assert.Equal(t, "[<a>,<b>]", stream.content.String())
+}
+
+func TestDocumentExportWithoutReports(t *testing.T) {
+	stream := &exportTestStream{}
+	_, finisher := documentExport(stream, testConvert, "[", ",", "]")
+	require.NoError(t, finisher())
+	assert.Equal(t, "[]", stream.content.String())
+}
+
+func TestGetExportHandler(t *testing.T) {
+	for _, format := range []string{"", "json", "csv", "junit", "sarif", "html"} {
+		exporter, finisher, err := getExportHandler(format, &exportTestStream{})
+		require.NoError(t, err, format)
+		assert.NotNil(t, exporter, format)
+		assert.NotNil(t, finisher, format)
+	}
+
+	_, _, err := getExportHandler("pdf", &exportTestStream{})
+	assert.Error(t, err)
+}
@@ -7,12 +7,15 @@ import (
 	"io"
 	sorter "sort"
 	"strings"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/dao/pgdb"
 	"github.com/chef/automate/components/compliance-service/reporting/relaxting"
 	"github.com/chef/automate/components/compliance-service/reporting/util"
 	"github.com/chef/automate/components/compliance-service/utils"
@@ -28,11 +31,13 @@ const streamBufferSize = 262144
 // Server implementation for reporting
 type Server struct {
 	es *relaxting.ES2Backend
+	db *pgdb.DB
 }
 
-// New creates a new server
-func New(es *relaxting.ES2Backend) *Server {
-	return &Server{es: es}
+// New creates a new server. The active waivers of db are applied to the
+// reports; without db, no failures are waived.
+func New(es *relaxting.ES2Backend, db *pgdb.DB) *Server {
+	return &Server{es: es, db: db}
 }
 
 // ListReports returns a list of reports based on query
@@ -80,9 +85,69 @@ func (srv *Server) ReadReport(ctx context.Context, in *reporting.Query) (*report
 	if err != nil {
 		return nil, utils.FormatErrorMsg(err, in.Id)
 	}
+	err = srv.waiveReport(report)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, in.Id)
+	}
 	return report, nil
 }
 
+// DiffReports compares two reports, given by id or as the latest reports of a node
+func (srv *Server) DiffReports(ctx context.Context, in *reporting.DiffQuery) (*reporting.ReportDiff, error) {
+	if (in.FromId == "") != (in.ToId == "") {
+		return nil, status.Error(codes.InvalidArgument, "Both 'from_id' and 'to_id' must be specified")
+	}
+	if in.FromId == "" && in.NodeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "Either 'from_id' and 'to_id' or 'node_id' must be specified")
+	}
+	var before *time.Time
+	if in.Before != nil {
+		beforeTime, err := ptypes.Timestamp(in.Before)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid 'before' time: %s", err))
+		}
+		before = &beforeTime
+	}
+	filters, err := filterByProjects(ctx, map[string][]string{})
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, "")
+	}
+
+	toId := in.ToId
+	if toId == "" {
+		toId, err = srv.es.GetNodeReportIdBefore(in.NodeId, nil, filters)
+		if err != nil {
+			return nil, utils.FormatErrorMsg(err, in.NodeId)
+		}
+	}
+	// Using ComplianceTwenty as the reports might not be in the latest index
+	to, err := srv.es.GetReport(relaxting.ComplianceDailyRepTwenty, toId, filters)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, toId)
+	}
+
+	fromId := in.FromId
+	if fromId == "" {
+		if before == nil {
+			latest, err := ptypes.Timestamp(to.EndTime)
+			if err != nil {
+				return nil, utils.FormatErrorMsg(err, toId)
+			}
+			before = &latest
+		}
+		fromId, err = srv.es.GetNodeReportIdBefore(in.NodeId, before, filters)
+		if err != nil {
+			return nil, utils.FormatErrorMsg(err, in.NodeId)
+		}
+	}
+	from, err := srv.es.GetReport(relaxting.ComplianceDailyRepTwenty, fromId, filters)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, fromId)
+	}
+
+	return diffReports(from, to), nil
+}
+
 // ListSuggestions returns a list of suggestions based on query
 func (srv *Server) ListSuggestions(ctx context.Context, in *reporting.SuggestionRequest) (*reporting.Suggestions, error) {
 	var suggestions reporting.Suggestions
@@ -124,7 +189,10 @@ func (srv *Server) ListProfiles(ctx context.Context, in *reporting.Query) (*repo
 
 type exportHandler func(*reporting.Report) error
 
-// Export streams a json or csv export
+// exportFinisher completes an export after all of its reports were streamed
+type exportFinisher func() error
+
+// Export streams a json, csv, junit, sarif or html export
 func (srv *Server) Export(in *reporting.Query, stream reporting.ReportingService_ExportServer) error {
 	formattedFilters := formatFilters(in.Filters)
 
@@ -144,7 +212,7 @@ func (srv *Server) Export(in *reporting.Query, stream reporting.ReportingService
 		return status.Error(codes.InvalidArgument, "Invalid: Only one 'control' filter is allowed")
 	}
 
-	exporter, err := getExportHandler(in.Type, stream)
+	exporter, finisher, err := getExportHandler(in.Type, stream)
 	if err != nil {
 		return err
 	}
@@ -153,6 +221,10 @@ func (srv *Server) Export(in *reporting.Query, stream reporting.ReportingService
 	if err != nil {
 		return err
 	}
+	err = finisher()
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("Failed to complete %s export. Error: %s", in.Type, err))
+	}
 	return nil
 }
 
@@ -187,17 +259,30 @@ func exportReports(filters map[string][]string, esr *relaxting.ES2Backend, sendR
 	return nil
 }
 
-func getExportHandler(format string, stream reporting.ReportingService_ExportServer) (exportHandler, error) {
+func getExportHandler(format string, stream reporting.ReportingService_ExportServer) (exportHandler, exportFinisher, error) {
 	switch format {
 	case "", "json":
-		return jsonExport(stream), nil
+		return jsonExport(stream), noExportFinisher, nil
 	case "csv":
-		return csvExport(stream), nil
+		return csvExport(stream), noExportFinisher, nil
+	case "junit":
+		exporter, finisher := documentExport(stream, util.ReportToJUnit, util.JUnitHeader, "", util.JUnitFooter)
+		return exporter, finisher, nil
+	case "sarif":
+		exporter, finisher := documentExport(stream, util.ReportToSARIF, util.SARIFHeader, util.SARIFSeparator, util.SARIFFooter)
+		return exporter, finisher, nil
+	case "html":
+		exporter, finisher := documentExport(stream, util.ReportToHTML, util.HTMLHeader, "", util.HTMLFooter)
+		return exporter, finisher, nil
 	default:
-		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf(format+" export is not supported"))
+		return nil, nil, status.Error(codes.Unauthenticated, fmt.Sprintf(format+" export is not supported"))
 	}
 }
 
+func noExportFinisher() error {
+	return nil
+}
+
 func jsonExport(stream reporting.ReportingService_ExportServer) exportHandler {
 	return func(data *reporting.Report) error {
 		raw, err := json.Marshal(data)
@@ -252,6 +337,50 @@ func csvExport(stream reporting.ReportingService_ExportServer) exportHandler {
 	}
 }
 
+// documentExport streams the reports of a format that exports a single
+// document: the header goes before the first report, the separator between
+// two reports and the footer after the last report
+func documentExport(stream reporting.ReportingService_ExportServer, convert func(*reporting.Report) (string, error),
+	header, separator, footer string) (exportHandler, exportFinisher) {
+	initialRun := true
+	exporter := func(data *reporting.Report) error {
+		res, err := convert(data)
+		if err != nil {
+			return err
+		}
+
+		if initialRun {
+			initialRun = false
+			res = header + res
+		} else {
+			res = separator + res
+		}
+		return sendExportData(stream, res)
+	}
+	finisher := func() error {
+		if initialRun {
+			initialRun = false
+			return sendExportData(stream, header+footer)
+		}
+		return sendExportData(stream, footer)
+	}
+	return exporter, finisher
+}
+
+func sendExportData(stream reporting.ReportingService_ExportServer, content string) error {
+	reader := bytes.NewReader([]byte(content))
+	buf := make([]byte, streamBufferSize)
+
+	writer := chunks.NewWriter(streamBufferSize, func(p []byte) error {
+		return stream.Send(&reporting.ExportData{Content: p})
+	})
+	_, err := io.CopyBuffer(writer, reader, buf)
+	if err != nil {
+		return fmt.Errorf("Failed to export: %+v", err)
+	}
+	return nil
+}
+
 // ListNodes returns a list of nodes based on query
 func (srv *Server) ListNodes(ctx context.Context, in *reporting.Query) (*reporting.Nodes, error) {
 	var nodes reporting.Nodes
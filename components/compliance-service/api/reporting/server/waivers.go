@@ -0,0 +1,80 @@
+package server
+
+import (
+	"github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+	"github.com/chef/automate/components/compliance-service/reporting/relaxting"
+)
+
+// waiveReport marks the failed controls of the report that the active
+// waivers apply to
+func (srv *Server) waiveReport(report *reporting.Report) error {
+	if srv.db == nil || report == nil {
+		return nil
+	}
+	activeWaivers, err := srv.db.GetActiveWaivers()
+	if err != nil {
+		return err
+	}
+
+	profileIDs := make(map[string]bool, len(report.Profiles))
+	for _, profile := range report.Profiles {
+		profileIDs[profile.Sha256] = true
+	}
+	profileWaivers := make([]*waivers.Waiver, 0)
+	for _, waiver := range activeWaivers {
+		if profileIDs[waiver.ProfileId] {
+			profileWaivers = append(profileWaivers, waiver)
+		}
+	}
+
+	nodeWaivers, err := srv.es.GetReportWaivers(relaxting.ComplianceDailyRepTwenty, report.Id, profileWaivers)
+	if err != nil {
+		return err
+	}
+	applyWaivers(report, nodeWaivers)
+	return nil
+}
+
+// applyWaivers sets the waiver of the failed controls that one of the
+// waivers of the node applies to. When every failed control is waived, the
+// status of the report is waived.
+func applyWaivers(report *reporting.Report, nodeWaivers []*waivers.Waiver) {
+	if len(nodeWaivers) == 0 {
+		return
+	}
+	byControl := make(map[string]*waivers.Waiver, len(nodeWaivers))
+	for _, waiver := range nodeWaivers {
+		byControl[waiver.ProfileId+"|"+waiver.ControlId] = waiver
+	}
+
+	failed, waived := 0, 0
+	for _, profile := range report.Profiles {
+		for _, control := range profile.Controls {
+			if !controlFailed(control) {
+				continue
+			}
+			failed++
+			if waiver, ok := byControl[profile.Sha256+"|"+control.Id]; ok {
+				control.Waiver = &reporting.ControlWaiver{
+					Id:            waiver.Id,
+					Justification: waiver.Justification,
+					ExpiresAt:     waiver.ExpiresAt,
+				}
+				waived++
+			}
+		}
+	}
+	if report.Status == "failed" && failed > 0 && failed == waived {
+		report.Status = "waived"
+	}
+}
+
+func controlFailed(control *reporting.Control) bool {
+	for _, result := range control.Results {
+		if result.Status == "failed" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+)
+
+func newWaiverTestReport() *reporting.Report {
+	return &reporting.Report{
+		Id:     "report-1",
+		Status: "failed",
+		Profiles: []*reporting.Profile{
+			{
+				Sha256: "profile-a",
+				Controls: []*reporting.Control{
+					{Id: "control-1", Results: []*reporting.Result{{Status: "passed"}, {Status: "failed"}}},
+					{Id: "control-2", Results: []*reporting.Result{{Status: "passed"}}},
+					{Id: "control-3", Results: []*reporting.Result{{Status: "failed"}}},
+				},
+			},
+		},
+	}
+}
+
+func TestApplyWaivers(t *testing.T) {
+	expiresAt := &timestamp.Timestamp{Seconds: 1893456000}
+	report := newWaiverTestReport()
+	applyWaivers(report, []*waivers.Waiver{
+		{Id: "w1", ProfileId: "profile-a", ControlId: "control-1", Justification: "accepted", ExpiresAt: expiresAt},
+		{Id: "w2", ProfileId: "profile-a", ControlId: "control-2", Justification: "passes anyway"},
+		{Id: "w3", ProfileId: "profile-b", ControlId: "control-3", Justification: "other profile"},
+	})
+
+	controls := report.Profiles[0].Controls
+	assert.Equal(t, &reporting.ControlWaiver{Id: "w1", Justification: "accepted", ExpiresAt: expiresAt}, controls[0].Waiver)
+	assert.Nil(t, controls[1].Waiver)
+	assert.Nil(t, controls[2].Waiver)
+	assert.Equal(t, "failed", report.Status)
+}
+
+func TestApplyWaiversToEveryFailure(t *testing.T) {
+	report := newWaiverTestReport()
+	applyWaivers(report, []*waivers.Waiver{
+		{Id: "w1", ProfileId: "profile-a", ControlId: "control-1", Justification: "accepted"},
+		{Id: "w3", ProfileId: "profile-a", ControlId: "control-3", Justification: "accepted"},
+	})
+	assert.Equal(t, "waived", report.Status)
+
+	report = newWaiverTestReport()
+	applyWaivers(report, nil)
+	assert.Equal(t, "failed", report.Status)
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/chef/automate/components/compliance-service/api/stats"
+	"github.com/chef/automate/components/compliance-service/dao/pgdb"
 	"github.com/chef/automate/components/compliance-service/reporting/relaxting"
 	"github.com/chef/automate/components/compliance-service/utils"
 )
@@ -15,11 +16,13 @@ import (
 // Server implementation for stats
 type Server struct {
 	es *relaxting.ES2Backend
+	db *pgdb.DB
 }
 
-// New creates a new server
-func New(es *relaxting.ES2Backend) *Server {
-	return &Server{es: es}
+// New creates a new server. The active waivers of db are applied to the
+// stats; without db, no failures are waived.
+func New(es *relaxting.ES2Backend, db *pgdb.DB) *Server {
+	return &Server{es: es, db: db}
 }
 
 // ReadSummary returns summary, nodes-summary, or controls-summary information
@@ -33,6 +36,12 @@ func (srv *Server) ReadSummary(ctx context.Context, in *stats.Query) (*stats.Sum
 			err = utils.FormatErrorMsg(err, "")
 			return nil, err
 		}
+		if reportSummary.Status == "failed" {
+			err = srv.waiveReportSummary(reportSummary, formattedFilters)
+			if err != nil {
+				return nil, utils.FormatErrorMsg(err, "")
+			}
+		}
 		summary.ReportSummary = reportSummary
 	}
 	if in.Type == "nodes" {
@@ -49,6 +58,11 @@ func (srv *Server) ReadSummary(ctx context.Context, in *stats.Query) (*stats.Sum
 			err = utils.FormatErrorMsg(err, "")
 			return nil, err
 		}
+		waived, err := srv.waivedControls(formattedFilters)
+		if err != nil {
+			return nil, utils.FormatErrorMsg(err, "")
+		}
+		waiveControlsSummary(controlSummary, relaxting.WaivedTotal(waived))
 		summary.ControlsSummary = controlSummary
 	}
 	return &summary, nil
@@ -76,6 +90,12 @@ func (srv *Server) ReadTrend(ctx context.Context, in *stats.Query) (*stats.Trend
 		err = utils.FormatErrorMsg(err, "")
 		return nil, err
 	}
+	if in.Type == "controls" {
+		err = srv.waiveTrend(trend, formattedFilters)
+		if err != nil {
+			return nil, utils.FormatErrorMsg(err, "")
+		}
+	}
 
 	trends.Trends = trend
 	return &trends, nil
@@ -144,6 +164,13 @@ func (srv *Server) ReadFailures(ctx context.Context, in *stats.Query) (*stats.Fa
 		err = utils.FormatErrorMsg(err, "")
 		return nil, err
 	}
+	if len(failures.Controls) > 0 {
+		waived, err := srv.waivedControls(formattedFilters)
+		if err != nil {
+			return nil, utils.FormatErrorMsg(err, "")
+		}
+		failures.Controls = waiveControlFailures(failures.Controls, waived)
+	}
 	return failures, nil
 }
 
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"sort"
+
+	"github.com/chef/automate/components/compliance-service/api/stats"
+	"github.com/chef/automate/components/compliance-service/reporting/relaxting"
+)
+
+// waivedControls returns how many of the failed controls matching the
+// filters the active waivers apply to
+func (srv *Server) waivedControls(filters map[string][]string) ([]relaxting.WaivedControl, error) {
+	if srv.db == nil {
+		return nil, nil
+	}
+	activeWaivers, err := srv.db.GetActiveWaivers()
+	if err != nil {
+		return nil, err
+	}
+	return srv.es.GetWaivedControls(filters, activeWaivers)
+}
+
+// waiveReportSummary sets the status of a failed summary to waived when the
+// active waivers apply to every failed control
+func (srv *Server) waiveReportSummary(summary *stats.ReportSummary, filters map[string][]string) error {
+	waived, err := srv.waivedControls(filters)
+	if err != nil {
+		return err
+	}
+	waivedTotal := relaxting.WaivedTotal(waived).Total
+	if waivedTotal == 0 {
+		return nil
+	}
+	controlsSummary, err := srv.es.GetStatsSummaryControls(filters)
+	if err != nil {
+		return err
+	}
+	if controlsSummary.Failures <= waivedTotal {
+		summary.Status = "waived"
+	}
+	return nil
+}
+
+// waiveTrend moves the waived failures of each day of a controls trend out of
+// the failed count. The failures of a day are only waived by the waivers that
+// were in effect then, including those that have expired since.
+func (srv *Server) waiveTrend(trend []*stats.Trend, filters map[string][]string) error {
+	if srv.db == nil {
+		return nil
+	}
+	allWaivers, err := srv.db.GetWaivers("", true)
+	if err != nil {
+		return err
+	}
+	waivedByDay, err := srv.es.GetWaivedTrend(filters, allWaivers)
+	if err != nil {
+		return err
+	}
+	for _, bucket := range trend {
+		waived := minInt32(waivedByDay[bucket.ReportTime], bucket.Failed)
+		bucket.Failed -= waived
+		bucket.Waived = waived
+	}
+	return nil
+}
+
+// waiveControlsSummary moves the waived failures out of the failure counts
+func waiveControlsSummary(summary *stats.ControlsSummary, waived relaxting.WaivedCount) {
+	summary.Waived = minInt32(waived.Total, summary.Failures)
+	summary.Failures -= summary.Waived
+	summary.Minors -= minInt32(waived.Minor, summary.Minors)
+	summary.Majors -= minInt32(waived.Major, summary.Majors)
+	summary.Criticals -= minInt32(waived.Critical, summary.Criticals)
+}
+
+// waiveControlFailures moves the waived failures of the top failed controls
+// out of their failure counts. Controls that have no failures left are
+// dropped and the rest are sorted by failures again.
+func waiveControlFailures(controls []*stats.FailureSummary, waived []relaxting.WaivedControl) []*stats.FailureSummary {
+	if len(waived) == 0 {
+		return controls
+	}
+	waivedByControl := make(map[string]int32, len(waived))
+	for _, control := range waived {
+		waivedByControl[control.ControlID] += control.Total
+	}
+
+	failed := make([]*stats.FailureSummary, 0, len(controls))
+	for _, control := range controls {
+		control.Waived = minInt32(waivedByControl[control.Name], control.Failures)
+		control.Failures -= control.Waived
+		if control.Failures > 0 {
+			failed = append(failed, control)
+		}
+	}
+	sort.SliceStable(failed, func(i, j int) bool {
+		return failed[i].Failures > failed[j].Failures
+	})
+	return failed
+}
+
+func minInt32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
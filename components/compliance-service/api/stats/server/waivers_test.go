@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chef/automate/components/compliance-service/api/stats"
+	"github.com/chef/automate/components/compliance-service/reporting/relaxting"
+)
+
+func TestWaiveControlsSummary(t *testing.T) {
+	summary := &stats.ControlsSummary{Failures: 5, Criticals: 3, Majors: 1, Minors: 1, Passed: 10}
+	waiveControlsSummary(summary, relaxting.WaivedCount{Total: 3, Critical: 2, Major: 1})
+	assert.Equal(t, &stats.ControlsSummary{Failures: 2, Criticals: 1, Minors: 1, Passed: 10, Waived: 3}, summary)
+
+	summary = &stats.ControlsSummary{Passed: 10}
+	waiveControlsSummary(summary, relaxting.WaivedCount{})
+	assert.Equal(t, &stats.ControlsSummary{Passed: 10}, summary)
+}
+
+func TestWaiveControlFailures(t *testing.T) {
+	controls := []*stats.FailureSummary{
+		{Name: "control-1", Failures: 5},
+		{Name: "control-2", Failures: 4},
+		{Name: "control-3", Failures: 2},
+	}
+	waived := []relaxting.WaivedControl{
+		{ProfileID: "a", ControlID: "control-1", WaivedCount: relaxting.WaivedCount{Total: 2}},
+		{ProfileID: "b", ControlID: "control-1", WaivedCount: relaxting.WaivedCount{Total: 1}},
+		{ProfileID: "a", ControlID: "control-3", WaivedCount: relaxting.WaivedCount{Total: 2}},
+	}
+
+	assert.Equal(t, []*stats.FailureSummary{
+		{Name: "control-2", Failures: 4},
+		{Name: "control-1", Failures: 2, Waived: 3},
+	}, waiveControlFailures(controls, waived))
+
+	unchanged := []*stats.FailureSummary{{Name: "control-1", Failures: 5}}
+	assert.Equal(t, unchanged, waiveControlFailures(unchanged, nil))
+}
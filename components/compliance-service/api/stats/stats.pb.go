@@ -44,7 +44,7 @@ func (x Query_OrderType) String() string {
 	return proto.EnumName(Query_OrderType_name, int32(x))
 }
 func (Query_OrderType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{1, 0}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{1, 0}
 }
 
 type ListFilter struct {
@@ -59,7 +59,7 @@ func (m *ListFilter) Reset()         { *m = ListFilter{} }
 func (m *ListFilter) String() string { return proto.CompactTextString(m) }
 func (*ListFilter) ProtoMessage()    {}
 func (*ListFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{0}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{0}
 }
 func (m *ListFilter) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListFilter.Unmarshal(m, b)
@@ -112,7 +112,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{1}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{1}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Query.Unmarshal(m, b)
@@ -208,7 +208,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{2}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{2}
 }
 func (m *Summary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Summary.Unmarshal(m, b)
@@ -250,12 +250,14 @@ func (m *Summary) GetReportSummary() *ReportSummary {
 }
 
 type ControlsSummary struct {
-	Failures             int32    `protobuf:"varint,1,opt,name=failures,proto3" json:"failures,omitempty"`
-	Majors               int32    `protobuf:"varint,2,opt,name=majors,proto3" json:"majors,omitempty"`
-	Minors               int32    `protobuf:"varint,3,opt,name=minors,proto3" json:"minors,omitempty"`
-	Criticals            int32    `protobuf:"varint,4,opt,name=criticals,proto3" json:"criticals,omitempty"`
-	Passed               int32    `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`
-	Skipped              int32    `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failures  int32 `protobuf:"varint,1,opt,name=failures,proto3" json:"failures,omitempty"`
+	Majors    int32 `protobuf:"varint,2,opt,name=majors,proto3" json:"majors,omitempty"`
+	Minors    int32 `protobuf:"varint,3,opt,name=minors,proto3" json:"minors,omitempty"`
+	Criticals int32 `protobuf:"varint,4,opt,name=criticals,proto3" json:"criticals,omitempty"`
+	Passed    int32 `protobuf:"varint,5,opt,name=passed,proto3" json:"passed,omitempty"`
+	Skipped   int32 `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// failed controls that a waiver applies to, not included in failures
+	Waived               int32    `protobuf:"varint,7,opt,name=waived,proto3" json:"waived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -265,7 +267,7 @@ func (m *ControlsSummary) Reset()         { *m = ControlsSummary{} }
 func (m *ControlsSummary) String() string { return proto.CompactTextString(m) }
 func (*ControlsSummary) ProtoMessage()    {}
 func (*ControlsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{3}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{3}
 }
 func (m *ControlsSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlsSummary.Unmarshal(m, b)
@@ -327,6 +329,13 @@ func (m *ControlsSummary) GetSkipped() int32 {
 	return 0
 }
 
+func (m *ControlsSummary) GetWaived() int32 {
+	if m != nil {
+		return m.Waived
+	}
+	return 0
+}
+
 type NodeSummary struct {
 	Compliant            int32    `protobuf:"varint,1,opt,name=compliant,proto3" json:"compliant,omitempty"`
 	Skipped              int32    `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
@@ -343,7 +352,7 @@ func (m *NodeSummary) Reset()         { *m = NodeSummary{} }
 func (m *NodeSummary) String() string { return proto.CompactTextString(m) }
 func (*NodeSummary) ProtoMessage()    {}
 func (*NodeSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{4}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{4}
 }
 func (m *NodeSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeSummary.Unmarshal(m, b)
@@ -419,7 +428,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{5}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{5}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Stats.Unmarshal(m, b)
@@ -468,7 +477,8 @@ func (m *Stats) GetProfiles() int32 {
 }
 
 type ReportSummary struct {
-	Stats                *Stats   `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	Stats *Stats `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	// waived if every failed control is waived
 	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Duration             float64  `protobuf:"fixed64,2,opt,name=duration,proto3" json:"duration,omitempty"`
 	StartDate            string   `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
@@ -481,7 +491,7 @@ func (m *ReportSummary) Reset()         { *m = ReportSummary{} }
 func (m *ReportSummary) String() string { return proto.CompactTextString(m) }
 func (*ReportSummary) ProtoMessage()    {}
 func (*ReportSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{6}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{6}
 }
 func (m *ReportSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportSummary.Unmarshal(m, b)
@@ -530,10 +540,12 @@ func (m *ReportSummary) GetStartDate() string {
 }
 
 type Trend struct {
-	ReportTime           string   `protobuf:"bytes,1,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
-	Passed               int32    `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
-	Failed               int32    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
-	Skipped              int32    `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	ReportTime string `protobuf:"bytes,1,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
+	Passed     int32  `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Failed     int32  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Skipped    int32  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// failed controls that a waiver applies to, not included in failed
+	Waived               int32    `protobuf:"varint,5,opt,name=waived,proto3" json:"waived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -543,7 +555,7 @@ func (m *Trend) Reset()         { *m = Trend{} }
 func (m *Trend) String() string { return proto.CompactTextString(m) }
 func (*Trend) ProtoMessage()    {}
 func (*Trend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{7}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{7}
 }
 func (m *Trend) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Trend.Unmarshal(m, b)
@@ -591,6 +603,13 @@ func (m *Trend) GetSkipped() int32 {
 	return 0
 }
 
+func (m *Trend) GetWaived() int32 {
+	if m != nil {
+		return m.Waived
+	}
+	return 0
+}
+
 type Trends struct {
 	Trends               []*Trend `protobuf:"bytes,1,rep,name=trends,proto3" json:"trends,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -602,7 +621,7 @@ func (m *Trends) Reset()         { *m = Trends{} }
 func (m *Trends) String() string { return proto.CompactTextString(m) }
 func (*Trends) ProtoMessage()    {}
 func (*Trends) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{8}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{8}
 }
 func (m *Trends) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Trends.Unmarshal(m, b)
@@ -642,7 +661,7 @@ func (m *Profile) Reset()         { *m = Profile{} }
 func (m *Profile) String() string { return proto.CompactTextString(m) }
 func (*Profile) ProtoMessage()    {}
 func (*Profile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{9}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{9}
 }
 func (m *Profile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Profile.Unmarshal(m, b)
@@ -701,7 +720,7 @@ func (m *ProfileList) Reset()         { *m = ProfileList{} }
 func (m *ProfileList) String() string { return proto.CompactTextString(m) }
 func (*ProfileList) ProtoMessage()    {}
 func (*ProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{10}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{10}
 }
 func (m *ProfileList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileList.Unmarshal(m, b)
@@ -797,7 +816,7 @@ func (m *ProfileSummary) Reset()         { *m = ProfileSummary{} }
 func (m *ProfileSummary) String() string { return proto.CompactTextString(m) }
 func (*ProfileSummary) ProtoMessage()    {}
 func (*ProfileSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{11}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{11}
 }
 func (m *ProfileSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileSummary.Unmarshal(m, b)
@@ -902,7 +921,7 @@ func (m *ProfileSummaryStats) Reset()         { *m = ProfileSummaryStats{} }
 func (m *ProfileSummaryStats) String() string { return proto.CompactTextString(m) }
 func (*ProfileSummaryStats) ProtoMessage()    {}
 func (*ProfileSummaryStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{12}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{12}
 }
 func (m *ProfileSummaryStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProfileSummaryStats.Unmarshal(m, b)
@@ -973,7 +992,7 @@ func (m *ControlStats) Reset()         { *m = ControlStats{} }
 func (m *ControlStats) String() string { return proto.CompactTextString(m) }
 func (*ControlStats) ProtoMessage()    {}
 func (*ControlStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{13}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{13}
 }
 func (m *ControlStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ControlStats.Unmarshal(m, b)
@@ -1052,7 +1071,7 @@ func (m *Support) Reset()         { *m = Support{} }
 func (m *Support) String() string { return proto.CompactTextString(m) }
 func (*Support) ProtoMessage()    {}
 func (*Support) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{14}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{14}
 }
 func (m *Support) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Support.Unmarshal(m, b)
@@ -1135,7 +1154,7 @@ func (m *Failures) Reset()         { *m = Failures{} }
 func (m *Failures) String() string { return proto.CompactTextString(m) }
 func (*Failures) ProtoMessage()    {}
 func (*Failures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{15}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{15}
 }
 func (m *Failures) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Failures.Unmarshal(m, b)
@@ -1184,10 +1203,12 @@ func (m *Failures) GetEnvironments() []*FailureSummary {
 }
 
 type FailureSummary struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Failures             int32    `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	Profile              string   `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Failures int32  `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+	Id       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Profile  string `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	// failed controls that a waiver applies to, not included in failures
+	Waived               int32    `protobuf:"varint,5,opt,name=waived,proto3" json:"waived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1197,7 +1218,7 @@ func (m *FailureSummary) Reset()         { *m = FailureSummary{} }
 func (m *FailureSummary) String() string { return proto.CompactTextString(m) }
 func (*FailureSummary) ProtoMessage()    {}
 func (*FailureSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_stats_165e65d18ff2a2a5, []int{16}
+	return fileDescriptor_stats_0e37b563f7a065e5, []int{16}
 }
 func (m *FailureSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FailureSummary.Unmarshal(m, b)
@@ -1245,6 +1266,13 @@ func (m *FailureSummary) GetProfile() string {
 	return ""
 }
 
+func (m *FailureSummary) GetWaived() int32 {
+	if m != nil {
+		return m.Waived
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ListFilter)(nil), "chef.automate.domain.compliance.api.stats.ListFilter")
 	proto.RegisterType((*Query)(nil), "chef.automate.domain.compliance.api.stats.Query")
@@ -1438,95 +1466,96 @@ var _StatsService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("components/compliance-service/api/stats/stats.proto", fileDescriptor_stats_165e65d18ff2a2a5)
-}
-
-var fileDescriptor_stats_165e65d18ff2a2a5 = []byte{
-	// 1374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0x1c, 0x35,
-	0x18, 0xce, 0xec, 0xee, 0xec, 0xee, 0xbc, 0x9b, 0x6c, 0x8a, 0x5b, 0xc2, 0xb4, 0x94, 0x36, 0x8c,
-	0x40, 0x84, 0x43, 0x37, 0x25, 0x15, 0x50, 0x38, 0x20, 0xe8, 0x97, 0x38, 0xa0, 0x36, 0x38, 0x29,
-	0x08, 0x04, 0x5a, 0xb9, 0x3b, 0xce, 0xc6, 0xed, 0xcc, 0x78, 0x64, 0x7b, 0x53, 0x05, 0x7e, 0x07,
-	0x77, 0x24, 0x24, 0x0e, 0x08, 0xfe, 0x04, 0x77, 0x7e, 0x04, 0x57, 0x0e, 0x88, 0x9f, 0x80, 0xfc,
-	0x35, 0x3b, 0x53, 0x75, 0xa5, 0x0e, 0x11, 0x97, 0x68, 0xde, 0xc7, 0xf6, 0xe3, 0xf7, 0xb5, 0x1f,
-	0x3f, 0x76, 0x16, 0x6e, 0xcc, 0x78, 0x5e, 0xf2, 0x82, 0x16, 0x4a, 0xee, 0xea, 0xcf, 0x8c, 0x91,
-	0x62, 0x46, 0xaf, 0x49, 0x2a, 0x4e, 0xd8, 0x8c, 0xee, 0x92, 0x92, 0xed, 0x4a, 0x45, 0x94, 0xb4,
-	0x7f, 0x27, 0xa5, 0xe0, 0x8a, 0xa3, 0xb7, 0x67, 0xc7, 0xf4, 0x68, 0x42, 0x16, 0x8a, 0xe7, 0x44,
-	0xd1, 0x49, 0xca, 0x73, 0xc2, 0x8a, 0xc9, 0x72, 0xf8, 0x84, 0x94, 0x6c, 0x62, 0x06, 0x5c, 0xba,
-	0x3c, 0xe7, 0x7c, 0x9e, 0x59, 0x22, 0x52, 0x14, 0x5c, 0x11, 0xc5, 0x78, 0xe1, 0x88, 0x92, 0x9b,
-	0x00, 0x9f, 0x31, 0xa9, 0xee, 0xb1, 0x4c, 0x51, 0x81, 0xb6, 0xa0, 0x7f, 0x42, 0xb2, 0x05, 0x95,
-	0xf1, 0x85, 0xed, 0xee, 0x4e, 0x84, 0x5d, 0x84, 0x10, 0xf4, 0xd4, 0x69, 0x49, 0xe3, 0x97, 0xb7,
-	0x83, 0x9d, 0x08, 0x9b, 0xef, 0xe4, 0xcf, 0x0e, 0x84, 0x9f, 0x2f, 0xa8, 0x38, 0x45, 0x63, 0xe8,
-	0xb0, 0x34, 0x7e, 0xc9, 0xb4, 0x75, 0x58, 0x5a, 0xf5, 0x3e, 0xbf, 0xec, 0xad, 0x31, 0xc9, 0xbe,
-	0xa3, 0x31, 0xda, 0x0e, 0x76, 0x42, 0x6c, 0xbe, 0xd1, 0x25, 0x18, 0xb2, 0x42, 0x51, 0x71, 0x42,
-	0xb2, 0xf8, 0xa2, 0xc1, 0xab, 0x18, 0x3d, 0x80, 0xc1, 0x91, 0xc9, 0xc9, 0xa6, 0x32, 0xda, 0x7b,
-	0x77, 0xf2, 0xc2, 0x25, 0x4f, 0x96, 0x15, 0x61, 0xcf, 0x82, 0xf6, 0x21, 0xe4, 0x22, 0xa5, 0xc2,
-	0xd4, 0x30, 0xde, 0xfb, 0xb0, 0x05, 0x9d, 0xa9, 0x72, 0xf2, 0x40, 0x8f, 0x3e, 0x3c, 0x2d, 0x29,
-	0xb6, 0x44, 0xa6, 0x24, 0x2e, 0x54, 0xbc, 0x65, 0xcb, 0xd4, 0xdf, 0x1a, 0x2b, 0xc9, 0x9c, 0xc6,
-	0xaf, 0xd8, 0x32, 0xf5, 0x37, 0xba, 0x08, 0xc3, 0x92, 0x8a, 0xa9, 0xc1, 0x63, 0x83, 0x0f, 0x4a,
-	0x2a, 0xf6, 0xc9, 0x9c, 0x26, 0x57, 0x20, 0xaa, 0x68, 0xd1, 0x00, 0xba, 0x9f, 0x1c, 0xdc, 0x3e,
-	0xb7, 0x86, 0x86, 0xd0, 0xbb, 0x73, 0xf7, 0xe0, 0xf6, 0xb9, 0x20, 0xf9, 0xb5, 0x03, 0x83, 0x83,
-	0x45, 0x9e, 0x13, 0x71, 0x8a, 0x28, 0x9c, 0x9b, 0xf1, 0x42, 0x09, 0x9e, 0xc9, 0xa9, 0xb4, 0x58,
-	0x1c, 0x6c, 0x07, 0x3b, 0xa3, 0x56, 0xb5, 0xdc, 0x76, 0x14, 0x8e, 0x15, 0x6f, 0xce, 0x9a, 0x00,
-	0xfa, 0x0a, 0xd6, 0x0b, 0x9e, 0xd2, 0x6a, 0x8a, 0x8e, 0x99, 0xe2, 0xbd, 0x16, 0x53, 0xdc, 0xe7,
-	0x29, 0xf5, 0xf4, 0xa3, 0x62, 0x19, 0xa0, 0x29, 0x8c, 0x05, 0x2d, 0xb9, 0x50, 0x15, 0x79, 0xd7,
-	0x90, 0xdf, 0x6c, 0x41, 0x8e, 0x0d, 0x81, 0xa7, 0xdf, 0x10, 0xf5, 0x30, 0xf9, 0x2d, 0x80, 0xcd,
-	0x67, 0x0a, 0xd4, 0x22, 0x3b, 0x22, 0x2c, 0x5b, 0x08, 0x2a, 0xcd, 0x72, 0x85, 0xb8, 0x8a, 0xb5,
-	0xdc, 0x73, 0xf2, 0x98, 0x0b, 0x69, 0xaa, 0x0c, 0xb1, 0x8b, 0x0c, 0xce, 0x0a, 0x8d, 0x77, 0x1d,
-	0x6e, 0x22, 0x74, 0x19, 0xa2, 0x99, 0x60, 0x8a, 0xcd, 0x48, 0x26, 0xe3, 0x9e, 0x69, 0x5a, 0x02,
-	0x7a, 0x54, 0x49, 0xa4, 0xa4, 0x69, 0x1c, 0xda, 0x51, 0x36, 0x42, 0x31, 0x0c, 0xe4, 0x13, 0x56,
-	0x96, 0x34, 0x8d, 0xfb, 0x76, 0xfb, 0x5d, 0x98, 0xfc, 0x1e, 0xc0, 0xa8, 0xb6, 0x5a, 0x86, 0xdf,
-	0x15, 0xad, 0x5c, 0xb2, 0x4b, 0xa0, 0xce, 0xd3, 0x69, 0xf0, 0xa0, 0x44, 0xef, 0x59, 0xb1, 0x1c,
-	0x6a, 0xb3, 0x6e, 0x60, 0xe8, 0x55, 0x88, 0x8e, 0xd9, 0xfc, 0x78, 0x2a, 0x98, 0x7c, 0xe2, 0x72,
-	0x1f, 0x6a, 0x00, 0x33, 0xf9, 0x04, 0x5d, 0x85, 0x51, 0x4e, 0x53, 0xb6, 0xc8, 0x6d, 0xb3, 0xcd,
-	0x1f, 0x2c, 0x64, 0x3a, 0x5c, 0x84, 0x61, 0xc6, 0x9f, 0xda, 0x56, 0x57, 0x44, 0xc6, 0x9f, 0xea,
-	0xa6, 0xe4, 0x7b, 0x08, 0x0f, 0xf4, 0xd6, 0xa0, 0x0b, 0x10, 0xea, 0xdd, 0xb6, 0xcb, 0xdc, 0xc5,
-	0x36, 0xd0, 0x35, 0x95, 0x19, 0x51, 0x47, 0x5c, 0xe4, 0x7e, 0x99, 0x97, 0x80, 0xce, 0x9c, 0x16,
-	0x27, 0x4c, 0xf0, 0x22, 0xd7, 0x06, 0xe8, 0x33, 0xaf, 0x63, 0x7a, 0x07, 0x4b, 0xc1, 0x8f, 0x58,
-	0x46, 0xfd, 0xa2, 0x57, 0x71, 0xf2, 0x4b, 0x00, 0x1b, 0x0d, 0x49, 0xa0, 0x7b, 0x10, 0x1a, 0xa5,
-	0x98, 0xae, 0xa3, 0xbd, 0xeb, 0x2d, 0xb4, 0x65, 0xca, 0xc0, 0x76, 0xb8, 0xde, 0x4d, 0xfd, 0xb1,
-	0xb0, 0xe5, 0x44, 0xd8, 0x45, 0x3a, 0x9b, 0x74, 0x21, 0x8c, 0x87, 0x9a, 0x72, 0x02, 0x5c, 0xc5,
-	0xe8, 0x35, 0x00, 0xa9, 0x88, 0x50, 0xd3, 0x94, 0x28, 0x6a, 0x6a, 0x89, 0x70, 0x64, 0x90, 0x3b,
-	0x44, 0xd1, 0x44, 0x40, 0x78, 0x28, 0x68, 0x91, 0xea, 0xe5, 0x76, 0x07, 0x41, 0xb1, 0x9c, 0xba,
-	0x09, 0xc0, 0x42, 0x87, 0x2c, 0xa7, 0x35, 0x29, 0x75, 0x1a, 0x52, 0xda, 0x82, 0xbe, 0x16, 0x2f,
-	0x4d, 0xbd, 0x30, 0x6d, 0x54, 0x97, 0x46, 0xaf, 0x29, 0x31, 0x0c, 0x7d, 0x33, 0xa7, 0x44, 0x9f,
-	0x42, 0x5f, 0x99, 0xaf, 0x38, 0x30, 0x86, 0xda, 0x66, 0x65, 0x0c, 0x05, 0x76, 0xe3, 0x93, 0x9f,
-	0x3b, 0x30, 0xd8, 0xb7, 0x3b, 0xa0, 0xed, 0xc2, 0x6d, 0xc6, 0x34, 0x63, 0x52, 0x39, 0xee, 0x36,
-	0x76, 0xe1, 0x98, 0xb4, 0x67, 0xe3, 0x51, 0xb9, 0x0c, 0xd0, 0x23, 0xd8, 0xf4, 0xd4, 0x4d, 0x33,
-	0xfa, 0xa0, 0x3d, 0xbb, 0x37, 0x8c, 0x71, 0xd9, 0x88, 0xd1, 0x37, 0xb0, 0xe1, 0x0c, 0x70, 0x6a,
-	0x55, 0xd3, 0x35, 0xf9, 0xbf, 0xdf, 0xde, 0x51, 0xad, 0x78, 0xd6, 0x67, 0xb5, 0x28, 0xf9, 0x23,
-	0x80, 0x51, 0xad, 0x3c, 0x7d, 0x3b, 0x14, 0xa4, 0xda, 0x70, 0xf3, 0xed, 0x2e, 0xcf, 0x4e, 0x75,
-	0x79, 0xd6, 0xfd, 0xaa, 0xbb, 0xd2, 0xaf, 0x7a, 0x2b, 0xfc, 0x2a, 0x5c, 0xed, 0x57, 0xfd, 0xd5,
-	0x7e, 0x35, 0x58, 0xe5, 0x57, 0xc3, 0xa6, 0x98, 0x7e, 0xe8, 0xc2, 0xb8, 0xb9, 0xa0, 0xcf, 0x2d,
-	0xe9, 0x02, 0x84, 0x8a, 0xa9, 0x8c, 0xba, 0xaa, 0x6c, 0xa0, 0x69, 0x4f, 0xa8, 0x90, 0xfa, 0xdc,
-	0xd8, 0x93, 0xe1, 0x43, 0xdd, 0x92, 0xb1, 0x19, 0x2d, 0x24, 0x35, 0x75, 0x45, 0xd8, 0x87, 0xe8,
-	0x0a, 0x80, 0x5e, 0x78, 0x45, 0x58, 0x41, 0x85, 0x29, 0x2e, 0xc2, 0x35, 0xc4, 0x1a, 0x66, 0x79,
-	0x2a, 0xd8, 0xfc, 0x58, 0x99, 0x02, 0x23, 0xbc, 0x04, 0xd0, 0x5b, 0xb0, 0x59, 0x05, 0x53, 0x9a,
-	0x13, 0x96, 0x99, 0x4a, 0x23, 0x3c, 0xae, 0xe0, 0xbb, 0x1a, 0x35, 0x15, 0x3b, 0x85, 0x0d, 0x6d,
-	0x02, 0x2e, 0x44, 0xf7, 0x61, 0x28, 0x17, 0xa5, 0x3e, 0x97, 0x32, 0x8e, 0x8c, 0x34, 0xf6, 0xda,
-	0x18, 0x8a, 0x1d, 0x8a, 0x2b, 0x0e, 0x74, 0xe8, 0xdd, 0x09, 0x8c, 0x92, 0x3f, 0xfa, 0xcf, 0x4a,
-	0xae, 0x7b, 0x55, 0xf2, 0x53, 0x00, 0xe7, 0x9f, 0xd3, 0x5c, 0xb3, 0x8b, 0xa0, 0x61, 0x17, 0xab,
-	0xec, 0xa5, 0xb6, 0xf3, 0xdd, 0xe6, 0x0d, 0xf3, 0x3a, 0xac, 0xdb, 0xb1, 0x53, 0x6b, 0xf1, 0x56,
-	0x7f, 0x23, 0x8b, 0xdd, 0x37, 0x46, 0x7f, 0x15, 0x46, 0x8a, 0x2b, 0x92, 0xb9, 0x1e, 0xee, 0x0e,
-	0x31, 0x90, 0xe9, 0x90, 0xfc, 0x18, 0xc0, 0x7a, 0xfd, 0xb0, 0xe8, 0xe9, 0xdc, 0x71, 0x71, 0xf2,
-	0xf1, 0xe1, 0x0a, 0x05, 0x2d, 0xd3, 0xee, 0xae, 0x70, 0xc5, 0xde, 0x2a, 0x57, 0x0c, 0x9b, 0xe5,
-	0x6c, 0x41, 0x9f, 0xe5, 0x25, 0x99, 0x59, 0xd1, 0x74, 0xb0, 0x8b, 0x92, 0xbf, 0x03, 0xfd, 0xde,
-	0x32, 0x7b, 0xa5, 0x47, 0x73, 0x39, 0xad, 0x89, 0x7b, 0xc0, 0xe5, 0x35, 0xa3, 0xef, 0xcb, 0x10,
-	0x71, 0x39, 0x3d, 0x22, 0x39, 0xcb, 0x4e, 0x5d, 0x86, 0x11, 0x97, 0xd7, 0x2c, 0xa0, 0xc7, 0x09,
-	0x9a, 0x51, 0x22, 0xfd, 0x0d, 0xe0, 0x43, 0xf4, 0x26, 0x8c, 0x59, 0x21, 0x4b, 0x3a, 0x9b, 0xfa,
-	0x83, 0x60, 0xe5, 0xbe, 0x61, 0xd1, 0x2f, 0xdc, 0x71, 0x78, 0x03, 0x36, 0xfc, 0x05, 0x69, 0xa7,
-	0xb7, 0xba, 0xaf, 0x40, 0x9b, 0xc4, 0x0e, 0x6c, 0x56, 0xbd, 0x5c, 0x2a, 0xf6, 0x00, 0x54, 0xb0,
-	0x4f, 0x48, 0xdf, 0x9f, 0x0e, 0x72, 0xfa, 0xaf, 0xe2, 0xe4, 0x9f, 0x0e, 0x0c, 0xef, 0x79, 0x7b,
-	0x79, 0x58, 0xbb, 0x68, 0xad, 0x8f, 0xb7, 0x71, 0x5a, 0x47, 0xe3, 0x9d, 0xb6, 0xa2, 0x42, 0x5f,
-	0x36, 0x5f, 0x00, 0x67, 0xe4, 0xad, 0x3d, 0x1e, 0x1e, 0xc2, 0xd0, 0xbf, 0x5e, 0x9d, 0x6f, 0x9f,
-	0x25, 0x5f, 0x4f, 0x85, 0xbe, 0x7d, 0xe6, 0x4d, 0xd2, 0x3b, 0x2b, 0x75, 0x83, 0x2e, 0x79, 0x0c,
-	0xe3, 0x66, 0xfb, 0x73, 0x3d, 0xb4, 0x7e, 0x0d, 0x74, 0x9e, 0xb9, 0x06, 0xec, 0x95, 0xd1, 0xad,
-	0xae, 0x8c, 0x18, 0x06, 0x6e, 0xb1, 0xbd, 0x7f, 0xba, 0x70, 0xef, 0xaf, 0x2e, 0xac, 0x9b, 0xb3,
-	0x76, 0x60, 0xff, 0x9b, 0x44, 0x0b, 0x18, 0x61, 0x4a, 0x52, 0x3f, 0xf3, 0xf5, 0xb6, 0xff, 0x05,
-	0x5d, 0x6a, 0x67, 0x7f, 0xf6, 0x59, 0xbe, 0x86, 0x04, 0x44, 0x7a, 0x5a, 0xfb, 0xfa, 0x69, 0x3f,
-	0xe9, 0x3b, 0x6d, 0x9f, 0x2a, 0x32, 0x59, 0x43, 0x27, 0xb0, 0xae, 0xe7, 0xdc, 0xf7, 0x32, 0xfc,
-	0x7f, 0x6b, 0x75, 0xd3, 0x24, 0x6b, 0xe8, 0xa9, 0x9d, 0xb7, 0x3a, 0x55, 0xed, 0xe7, 0xbd, 0xd1,
-	0x5e, 0x6a, 0x32, 0x59, 0xbb, 0x75, 0xeb, 0xeb, 0x8f, 0xe7, 0x4c, 0x1d, 0x2f, 0x1e, 0xe9, 0x9e,
-	0xbb, 0x9a, 0x62, 0xd7, 0x53, 0xec, 0xbe, 0xe0, 0x6f, 0x0c, 0x8f, 0xfa, 0xe6, 0x57, 0x81, 0x1b,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xb6, 0xa4, 0xba, 0x95, 0x10, 0x00, 0x00,
+	proto.RegisterFile("components/compliance-service/api/stats/stats.proto", fileDescriptor_stats_0e37b563f7a065e5)
+}
+
+var fileDescriptor_stats_0e37b563f7a065e5 = []byte{
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x8f, 0xdc, 0xb4,
+	0x1b, 0xfe, 0x65, 0x32, 0x99, 0x99, 0xbc, 0xb3, 0x3b, 0xdb, 0x9f, 0x5b, 0x4a, 0x5a, 0x4a, 0x59,
+	0x22, 0x10, 0xcb, 0xa1, 0xb3, 0x65, 0x2b, 0xa0, 0x70, 0x40, 0xd0, 0x7f, 0xe2, 0x80, 0xda, 0x25,
+	0xbb, 0x80, 0x40, 0xa0, 0x91, 0x3b, 0xf1, 0xce, 0x9a, 0x26, 0x71, 0x64, 0x7b, 0xb6, 0x5a, 0xb8,
+	0x71, 0xe4, 0xce, 0x1d, 0x09, 0x89, 0x03, 0xe2, 0x53, 0x20, 0x71, 0xe4, 0x43, 0xc0, 0x07, 0x40,
+	0x7c, 0x04, 0x64, 0xbf, 0x4e, 0x26, 0x29, 0x1d, 0xa9, 0xa1, 0xe2, 0xb2, 0xf2, 0xfb, 0xc4, 0x7e,
+	0xfc, 0xbe, 0xf6, 0xe3, 0xc7, 0xde, 0x81, 0x6b, 0x73, 0x91, 0x97, 0xa2, 0x60, 0x85, 0x56, 0xbb,
+	0xa6, 0x99, 0x71, 0x5a, 0xcc, 0xd9, 0x15, 0xc5, 0xe4, 0x09, 0x9f, 0xb3, 0x5d, 0x5a, 0xf2, 0x5d,
+	0xa5, 0xa9, 0x56, 0xf8, 0x77, 0x5a, 0x4a, 0xa1, 0x05, 0x79, 0x75, 0x7e, 0xcc, 0x8e, 0xa6, 0x74,
+	0xa9, 0x45, 0x4e, 0x35, 0x9b, 0xa6, 0x22, 0xa7, 0xbc, 0x98, 0xae, 0x86, 0x4f, 0x69, 0xc9, 0xa7,
+	0x76, 0xc0, 0xc5, 0x4b, 0x0b, 0x21, 0x16, 0x19, 0x12, 0xd1, 0xa2, 0x10, 0x9a, 0x6a, 0x2e, 0x0a,
+	0x47, 0x14, 0x5f, 0x07, 0xf8, 0x80, 0x2b, 0x7d, 0x87, 0x67, 0x9a, 0x49, 0x72, 0x1e, 0x06, 0x27,
+	0x34, 0x5b, 0x32, 0x15, 0x9d, 0xdb, 0xf6, 0x77, 0xc2, 0xc4, 0x45, 0x84, 0x40, 0x5f, 0x9f, 0x96,
+	0x2c, 0x7a, 0x66, 0xdb, 0xdb, 0x09, 0x13, 0xdb, 0x8e, 0x7f, 0xef, 0x41, 0xf0, 0xe1, 0x92, 0xc9,
+	0x53, 0x32, 0x81, 0x1e, 0x4f, 0xa3, 0xff, 0xdb, 0x6f, 0x3d, 0x9e, 0xd6, 0xbd, 0xcf, 0xae, 0x7a,
+	0x1b, 0x4c, 0xf1, 0xaf, 0x58, 0x44, 0xb6, 0xbd, 0x9d, 0x20, 0xb1, 0x6d, 0x72, 0x11, 0x46, 0xbc,
+	0xd0, 0x4c, 0x9e, 0xd0, 0x2c, 0xba, 0x60, 0xf1, 0x3a, 0x26, 0xf7, 0x60, 0x78, 0x64, 0x73, 0xc2,
+	0x54, 0xc6, 0x7b, 0xaf, 0x4f, 0x9f, 0xb8, 0xe4, 0xe9, 0xaa, 0xa2, 0xa4, 0x62, 0x21, 0xfb, 0x10,
+	0x08, 0x99, 0x32, 0x69, 0x6b, 0x98, 0xec, 0xbd, 0xdd, 0x81, 0xce, 0x56, 0x39, 0xbd, 0x67, 0x46,
+	0x1f, 0x9e, 0x96, 0x2c, 0x41, 0x22, 0x5b, 0x92, 0x90, 0x3a, 0x3a, 0x8f, 0x65, 0x9a, 0xb6, 0xc1,
+	0x4a, 0xba, 0x60, 0xd1, 0xb3, 0x58, 0xa6, 0x69, 0x93, 0x0b, 0x30, 0x2a, 0x99, 0x9c, 0x59, 0x3c,
+	0xb2, 0xf8, 0xb0, 0x64, 0x72, 0x9f, 0x2e, 0x58, 0x7c, 0x19, 0xc2, 0x9a, 0x96, 0x0c, 0xc1, 0x7f,
+	0xef, 0xe0, 0xe6, 0x99, 0xff, 0x91, 0x11, 0xf4, 0x6f, 0xdd, 0x3e, 0xb8, 0x79, 0xc6, 0x8b, 0x7f,
+	0xee, 0xc1, 0xf0, 0x60, 0x99, 0xe7, 0x54, 0x9e, 0x12, 0x06, 0x67, 0xe6, 0xa2, 0xd0, 0x52, 0x64,
+	0x6a, 0xa6, 0x10, 0x8b, 0xbc, 0x6d, 0x6f, 0x67, 0xdc, 0xa9, 0x96, 0x9b, 0x8e, 0xc2, 0xb1, 0x26,
+	0x5b, 0xf3, 0x36, 0x40, 0x3e, 0x85, 0x8d, 0x42, 0xa4, 0xac, 0x9e, 0xa2, 0x67, 0xa7, 0x78, 0xa3,
+	0xc3, 0x14, 0x77, 0x45, 0xca, 0x2a, 0xfa, 0x71, 0xb1, 0x0a, 0xc8, 0x0c, 0x26, 0x92, 0x95, 0x42,
+	0xea, 0x9a, 0xdc, 0xb7, 0xe4, 0xd7, 0x3b, 0x90, 0x27, 0x96, 0xa0, 0xa2, 0xdf, 0x94, 0xcd, 0x30,
+	0xfe, 0xd5, 0x83, 0xad, 0x47, 0x0a, 0x34, 0x22, 0x3b, 0xa2, 0x3c, 0x5b, 0x4a, 0xa6, 0xec, 0x72,
+	0x05, 0x49, 0x1d, 0x1b, 0xb9, 0xe7, 0xf4, 0x4b, 0x21, 0x95, 0xad, 0x32, 0x48, 0x5c, 0x64, 0x71,
+	0x5e, 0x18, 0xdc, 0x77, 0xb8, 0x8d, 0xc8, 0x25, 0x08, 0xe7, 0x92, 0x6b, 0x3e, 0xa7, 0x99, 0x8a,
+	0xfa, 0xf6, 0xd3, 0x0a, 0x30, 0xa3, 0x4a, 0xaa, 0x14, 0x4b, 0xa3, 0x00, 0x47, 0x61, 0x44, 0x22,
+	0x18, 0xaa, 0x07, 0xbc, 0x2c, 0x59, 0x1a, 0x0d, 0x70, 0xfb, 0x5d, 0x68, 0x46, 0x3c, 0xa4, 0xfc,
+	0x84, 0xa5, 0xd1, 0x10, 0x47, 0x60, 0x14, 0xff, 0xe2, 0xc1, 0xb8, 0xb1, 0x8a, 0x76, 0x5e, 0xb7,
+	0x18, 0xda, 0x15, 0xb1, 0x02, 0x9a, 0xfc, 0xbd, 0x36, 0x7f, 0x6c, 0xf6, 0xb2, 0x58, 0x0d, 0xc5,
+	0x6a, 0x5a, 0x18, 0x79, 0x0e, 0xc2, 0x63, 0xbe, 0x38, 0x9e, 0x49, 0xae, 0x1e, 0xb8, 0x9a, 0x46,
+	0x06, 0x48, 0xb8, 0x7a, 0x40, 0x5e, 0x80, 0x71, 0xce, 0x52, 0xbe, 0xcc, 0xf1, 0x33, 0xd6, 0x05,
+	0x08, 0xd9, 0x0e, 0x17, 0x60, 0x94, 0x89, 0x87, 0xf8, 0xd5, 0x15, 0x97, 0x89, 0x87, 0xe6, 0x53,
+	0xfc, 0x35, 0x04, 0x07, 0x66, 0xcb, 0xc8, 0x39, 0x08, 0x8c, 0x0a, 0x70, 0xf9, 0xfd, 0x04, 0x03,
+	0x53, 0x53, 0x99, 0x51, 0x7d, 0x24, 0x64, 0x5e, 0x2d, 0xff, 0x0a, 0x30, 0x99, 0xb3, 0xe2, 0x84,
+	0x4b, 0x51, 0xe4, 0xc6, 0x18, 0xab, 0xcc, 0x9b, 0x98, 0xd9, 0xd9, 0x52, 0x8a, 0x23, 0x9e, 0xb1,
+	0x6a, 0x33, 0xea, 0x38, 0xfe, 0xc9, 0x83, 0xcd, 0x96, 0x54, 0xc8, 0x1d, 0x08, 0xac, 0x82, 0x6c,
+	0xd7, 0xf1, 0xde, 0xd5, 0x0e, 0x9a, 0xb3, 0x65, 0x24, 0x38, 0xdc, 0xec, 0x99, 0x69, 0x2c, 0xb1,
+	0x9c, 0x30, 0x71, 0x91, 0xc9, 0x26, 0x5d, 0x4a, 0xeb, 0xad, 0xb6, 0x1c, 0x2f, 0xa9, 0x63, 0xf2,
+	0x3c, 0x80, 0xd2, 0x54, 0xea, 0x59, 0x4a, 0x35, 0xb3, 0xb5, 0x84, 0x49, 0x68, 0x91, 0x5b, 0x54,
+	0xb3, 0xf8, 0x5b, 0x0f, 0x82, 0x43, 0xc9, 0x8a, 0xd4, 0xac, 0xb7, 0x3b, 0x21, 0x9a, 0xe7, 0xcc,
+	0xcd, 0x00, 0x08, 0x1d, 0xf2, 0x9c, 0x35, 0x34, 0xd6, 0x6b, 0x69, 0xec, 0x3c, 0x0c, 0x8c, 0xaa,
+	0x59, 0x5a, 0x29, 0x16, 0xa3, 0xa6, 0x36, 0xfa, 0xeb, 0xb4, 0x17, 0xb4, 0xb4, 0x97, 0xc0, 0xc0,
+	0xe6, 0xa2, 0xc8, 0xfb, 0x30, 0xd0, 0xb6, 0x15, 0x79, 0xdb, 0x7e, 0xc7, 0x25, 0xb3, 0x14, 0x89,
+	0x1b, 0x1f, 0xff, 0xd8, 0x83, 0xe1, 0x3e, 0x6e, 0x8d, 0xf1, 0x17, 0xb7, 0x4b, 0xb3, 0x8c, 0x2b,
+	0xed, 0xb8, 0xbb, 0xf8, 0x8b, 0x63, 0x32, 0x26, 0x9f, 0x8c, 0xcb, 0x55, 0x40, 0xee, 0xc3, 0x56,
+	0x45, 0xdd, 0x76, 0xaf, 0xb7, 0xba, 0xb3, 0x57, 0x0e, 0x33, 0x29, 0x5b, 0x31, 0xf9, 0x1c, 0x36,
+	0x9d, 0x63, 0xce, 0x50, 0x4e, 0xbe, 0xcd, 0xff, 0xcd, 0xee, 0x16, 0x8c, 0xaa, 0xda, 0x98, 0x37,
+	0xa2, 0xf8, 0x37, 0x0f, 0xc6, 0x8d, 0xf2, 0xcc, 0x75, 0x52, 0xd0, 0x5a, 0x08, 0xb6, 0xed, 0x6e,
+	0xdb, 0x5e, 0x7d, 0xdb, 0x36, 0x0d, 0xce, 0x5f, 0x6b, 0x70, 0xfd, 0x35, 0x06, 0x17, 0xac, 0x37,
+	0xb8, 0xc1, 0x7a, 0x83, 0x1b, 0xae, 0x33, 0xb8, 0x51, 0x4b, 0x64, 0xf1, 0x77, 0x3e, 0x4c, 0xda,
+	0x0b, 0xfa, 0xd8, 0x92, 0xce, 0x41, 0xa0, 0xb9, 0xce, 0x98, 0xab, 0x0a, 0x03, 0x43, 0x7b, 0xc2,
+	0xa4, 0x32, 0x07, 0x0a, 0x8f, 0x4c, 0x15, 0x9a, 0x2f, 0x19, 0x9f, 0xb3, 0x42, 0x31, 0x5b, 0x57,
+	0x98, 0x54, 0x21, 0xb9, 0x0c, 0x60, 0x16, 0x5e, 0x53, 0x5e, 0x30, 0x69, 0x8b, 0x0b, 0x93, 0x06,
+	0x82, 0x4e, 0x5a, 0x9e, 0x4a, 0xbe, 0x38, 0xd6, 0xb6, 0xc0, 0x30, 0x59, 0x01, 0xe4, 0x15, 0xd8,
+	0xaa, 0x83, 0x19, 0xcb, 0x29, 0xcf, 0x6c, 0xa5, 0x61, 0x32, 0xa9, 0xe1, 0xdb, 0x06, 0xb5, 0x15,
+	0x3b, 0x85, 0x8d, 0x30, 0x01, 0x17, 0x92, 0xbb, 0x30, 0x52, 0xcb, 0xd2, 0x9c, 0x57, 0x15, 0x85,
+	0x56, 0x1a, 0x7b, 0x5d, 0x9c, 0x06, 0x87, 0x26, 0x35, 0x07, 0x39, 0xac, 0x6c, 0x0b, 0xac, 0x92,
+	0xdf, 0xf9, 0xd7, 0x4a, 0x6e, 0x9a, 0x58, 0xfc, 0x83, 0x07, 0x67, 0x1f, 0xf3, 0xb9, 0x61, 0x23,
+	0x5e, 0xcb, 0x46, 0xd6, 0xd9, 0x4e, 0x63, 0xe7, 0xfd, 0xb6, 0xbd, 0xbc, 0x08, 0x1b, 0x38, 0x76,
+	0x86, 0xde, 0x8f, 0xfa, 0x1b, 0x23, 0x66, 0xee, 0x36, 0x65, 0xcc, 0x4e, 0x0b, 0x4d, 0x33, 0xd7,
+	0xc3, 0x5d, 0x2e, 0x16, 0xb2, 0x1d, 0xe2, 0xef, 0x3d, 0xd8, 0x68, 0x1e, 0x16, 0x33, 0x9d, 0x3b,
+	0x2e, 0x4e, 0x3e, 0x55, 0xb8, 0x46, 0x41, 0xab, 0xb4, 0xfd, 0x35, 0x6e, 0xd9, 0x5f, 0xe7, 0x96,
+	0xc1, 0x3f, 0xdc, 0x92, 0xe7, 0x25, 0x9d, 0xa3, 0x68, 0x7a, 0x89, 0x8b, 0xe2, 0x3f, 0x3d, 0xf3,
+	0x40, 0xb3, 0x7b, 0x65, 0x46, 0x0b, 0x35, 0x6b, 0x88, 0x7b, 0x28, 0xd4, 0x15, 0x13, 0x1a, 0xd5,
+	0x09, 0x35, 0x3b, 0xa2, 0x39, 0xcf, 0x4e, 0x5d, 0x86, 0xa1, 0x50, 0x57, 0x10, 0x30, 0xe3, 0x24,
+	0xcb, 0x18, 0x55, 0xd5, 0xd5, 0x50, 0x85, 0xe4, 0x65, 0x98, 0xf0, 0x42, 0x95, 0x6c, 0x3e, 0xab,
+	0x0e, 0x02, 0xca, 0x7d, 0x13, 0xd1, 0x8f, 0x11, 0x24, 0x2f, 0xc1, 0x66, 0x75, 0x73, 0xe2, 0xf4,
+	0xa8, 0xfb, 0x1a, 0xc4, 0x24, 0x76, 0x60, 0xab, 0xee, 0xe5, 0x52, 0xc1, 0x03, 0x50, 0xc3, 0x55,
+	0x42, 0xe6, 0x62, 0x75, 0x90, 0xd3, 0x7f, 0x1d, 0xc7, 0x7f, 0xf5, 0x60, 0x74, 0xa7, 0xb2, 0x97,
+	0x8f, 0x1a, 0x37, 0x30, 0xfa, 0x78, 0x17, 0xa7, 0x75, 0x34, 0x95, 0xd3, 0xd6, 0x54, 0xe4, 0x93,
+	0xf6, 0xd3, 0xe0, 0x29, 0x79, 0x57, 0x5c, 0x26, 0xdf, 0xea, 0xb9, 0x1b, 0xf9, 0x4f, 0xcb, 0x5b,
+	0x53, 0x91, 0x2f, 0x1e, 0x79, 0xac, 0xf4, 0x9f, 0x96, 0xba, 0x45, 0x17, 0x7f, 0xe3, 0xc1, 0xa4,
+	0xdd, 0xe1, 0xb1, 0x26, 0xda, 0xbc, 0x07, 0x7a, 0x8f, 0xdc, 0x03, 0x78, 0x67, 0xf8, 0xf5, 0x9d,
+	0x11, 0xc1, 0xd0, 0xad, 0x76, 0x65, 0xa0, 0x2e, 0x5c, 0xf7, 0x2c, 0xd8, 0xfb, 0xc3, 0x87, 0x0d,
+	0x7b, 0x08, 0x0f, 0xf0, 0xff, 0x52, 0xa2, 0x60, 0x9c, 0x30, 0x9a, 0x56, 0x19, 0x5d, 0xed, 0xfa,
+	0xff, 0xd4, 0xc5, 0x6e, 0xbe, 0x88, 0xb3, 0x94, 0x10, 0x9a, 0x49, 0xf1, 0xb1, 0xd4, 0x7d, 0xca,
+	0xd7, 0xba, 0xbe, 0x60, 0x14, 0xd1, 0xb0, 0x61, 0x66, 0xdc, 0xaf, 0xb4, 0xf9, 0xdf, 0xd6, 0xe9,
+	0xa6, 0x21, 0x4b, 0x9c, 0xb5, 0x3e, 0x68, 0xdd, 0x67, 0xbd, 0xd6, 0x5d, 0x7d, 0xea, 0xc6, 0x8d,
+	0xcf, 0xde, 0x5d, 0x70, 0x7d, 0xbc, 0xbc, 0x6f, 0xfa, 0xed, 0x1a, 0x82, 0xdd, 0x8a, 0x60, 0xf7,
+	0x09, 0x7f, 0xa5, 0xb8, 0x3f, 0xb0, 0xbf, 0x2b, 0x5c, 0xfb, 0x7b, 0x00, 0x8c, 0x90, 0x21, 0x17,
+	0xd7, 0x10, 0x00, 0x00,
 }
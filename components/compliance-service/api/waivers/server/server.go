@@ -0,0 +1,73 @@
+package server
+
+import (
+	pb "github.com/golang/protobuf/ptypes/empty"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+	"github.com/chef/automate/components/compliance-service/dao/pgdb"
+	"github.com/chef/automate/components/compliance-service/utils"
+)
+
+// Server implementation for waivers
+type Server struct {
+	db *pgdb.DB
+}
+
+var empty = pb.Empty{}
+
+// New creates a new waivers server
+func New(db *pgdb.DB) *Server {
+	return &Server{db: db}
+}
+
+// Create a new waiver
+func (srv *Server) Create(ctx context.Context, in *waivers.Waiver) (*waivers.Id, error) {
+	logrus.Debugf("Create a new waiver: %+v", in)
+	id, err := srv.db.AddWaiver(in)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, "")
+	}
+	return &waivers.Id{Id: id}, nil
+}
+
+// Read a waiver via ID
+func (srv *Server) Read(ctx context.Context, in *waivers.Id) (*waivers.Waiver, error) {
+	logrus.Debugf("Read waiver with id: %+v", in.Id)
+	waiver, err := srv.db.GetWaiver(in.Id)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, in.Id)
+	}
+	return waiver, nil
+}
+
+// Update one waiver
+func (srv *Server) Update(ctx context.Context, in *waivers.Waiver) (*pb.Empty, error) {
+	logrus.Debugf("Update waiver with id: %+v", in.Id)
+	err := srv.db.UpdateWaiver(in)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, in.Id)
+	}
+	return &empty, nil
+}
+
+// Delete a waiver, which reinstates the failures it waived
+func (srv *Server) Delete(ctx context.Context, in *waivers.Id) (*pb.Empty, error) {
+	logrus.Debugf("Deleting waiver id: %+v", in.Id)
+	err := srv.db.DeleteWaiver(in.Id)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, in.Id)
+	}
+	return &empty, nil
+}
+
+// List the waivers, optionally of one profile and including expired ones
+func (srv *Server) List(ctx context.Context, in *waivers.Query) (*waivers.Waivers, error) {
+	logrus.Debugf("Getting waivers with query: %+v", in)
+	list, err := srv.db.GetWaivers(in.ProfileId, in.IncludeExpired)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, "")
+	}
+	return &waivers.Waivers{Waivers: list}, nil
+}
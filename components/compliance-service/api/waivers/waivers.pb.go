@@ -0,0 +1,503 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: components/compliance-service/api/waivers/waivers.proto
+
+package waivers // import "github.com/chef/automate/components/compliance-service/api/waivers"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import common "github.com/chef/automate/components/compliance-service/api/common"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Id struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Id) Reset()         { *m = Id{} }
+func (m *Id) String() string { return proto.CompactTextString(m) }
+func (*Id) ProtoMessage()    {}
+func (*Id) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_7f91aee9d009a1c1, []int{0}
+}
+func (m *Id) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Id.Unmarshal(m, b)
+}
+func (m *Id) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Id.Marshal(b, m, deterministic)
+}
+func (dst *Id) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Id.Merge(dst, src)
+}
+func (m *Id) XXX_Size() int {
+	return xxx_messageInfo_Id.Size(m)
+}
+func (m *Id) XXX_DiscardUnknown() {
+	xxx_messageInfo_Id.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Id proto.InternalMessageInfo
+
+func (m *Id) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type Query struct {
+	// only list the waivers of this profile
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	// also list the waivers that have expired
+	IncludeExpired       bool     `protobuf:"varint,2,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Query) Reset()         { *m = Query{} }
+func (m *Query) String() string { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()    {}
+func (*Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_7f91aee9d009a1c1, []int{1}
+}
+func (m *Query) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Query.Unmarshal(m, b)
+}
+func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+}
+func (dst *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(dst, src)
+}
+func (m *Query) XXX_Size() int {
+	return xxx_messageInfo_Query.Size(m)
+}
+func (m *Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_Query.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Query proto.InternalMessageInfo
+
+func (m *Query) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *Query) GetIncludeExpired() bool {
+	if m != nil {
+		return m.IncludeExpired
+	}
+	return false
+}
+
+// A Waiver accepts the risk of a control failing on the nodes that its node
+// filters select. Failures of the control are reported as waived instead of
+// failed until the waiver expires.
+type Waiver struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the sha256 of the profile
+	ProfileId string `protobuf:"bytes,2,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	ControlId string `protobuf:"bytes,3,opt,name=control_id,json=controlId,proto3" json:"control_id,omitempty"`
+	// filters by environment, node_id, node_name, platform, role or recipe;
+	// a waiver without filters applies to every node
+	NodeFilters   []*common.Filter `protobuf:"bytes,4,rep,name=node_filters,json=nodeFilters,proto3" json:"node_filters,omitempty"`
+	Justification string           `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	// a waiver without an expiry applies until it is deleted
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Waiver) Reset()         { *m = Waiver{} }
+func (m *Waiver) String() string { return proto.CompactTextString(m) }
+func (*Waiver) ProtoMessage()    {}
+func (*Waiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_7f91aee9d009a1c1, []int{2}
+}
+func (m *Waiver) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Waiver.Unmarshal(m, b)
+}
+func (m *Waiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Waiver.Marshal(b, m, deterministic)
+}
+func (dst *Waiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Waiver.Merge(dst, src)
+}
+func (m *Waiver) XXX_Size() int {
+	return xxx_messageInfo_Waiver.Size(m)
+}
+func (m *Waiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_Waiver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Waiver proto.InternalMessageInfo
+
+func (m *Waiver) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Waiver) GetProfileId() string {
+	if m != nil {
+		return m.ProfileId
+	}
+	return ""
+}
+
+func (m *Waiver) GetControlId() string {
+	if m != nil {
+		return m.ControlId
+	}
+	return ""
+}
+
+func (m *Waiver) GetNodeFilters() []*common.Filter {
+	if m != nil {
+		return m.NodeFilters
+	}
+	return nil
+}
+
+func (m *Waiver) GetJustification() string {
+	if m != nil {
+		return m.Justification
+	}
+	return ""
+}
+
+func (m *Waiver) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Waiver) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Waiver) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type Waivers struct {
+	Waivers              []*Waiver `protobuf:"bytes,1,rep,name=waivers,proto3" json:"waivers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Waivers) Reset()         { *m = Waivers{} }
+func (m *Waivers) String() string { return proto.CompactTextString(m) }
+func (*Waivers) ProtoMessage()    {}
+func (*Waivers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_waivers_7f91aee9d009a1c1, []int{3}
+}
+func (m *Waivers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Waivers.Unmarshal(m, b)
+}
+func (m *Waivers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Waivers.Marshal(b, m, deterministic)
+}
+func (dst *Waivers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Waivers.Merge(dst, src)
+}
+func (m *Waivers) XXX_Size() int {
+	return xxx_messageInfo_Waivers.Size(m)
+}
+func (m *Waivers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Waivers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Waivers proto.InternalMessageInfo
+
+func (m *Waivers) GetWaivers() []*Waiver {
+	if m != nil {
+		return m.Waivers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Id)(nil), "chef.automate.domain.compliance.api.waivers.Id")
+	proto.RegisterType((*Query)(nil), "chef.automate.domain.compliance.api.waivers.Query")
+	proto.RegisterType((*Waiver)(nil), "chef.automate.domain.compliance.api.waivers.Waiver")
+	proto.RegisterType((*Waivers)(nil), "chef.automate.domain.compliance.api.waivers.Waivers")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WaiversServiceClient is the client API for WaiversService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WaiversServiceClient interface {
+	Create(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Id, error)
+	Read(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Waiver, error)
+	Update(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*empty.Empty, error)
+	Delete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error)
+	List(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Waivers, error)
+}
+
+type waiversServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWaiversServiceClient(cc *grpc.ClientConn) WaiversServiceClient {
+	return &waiversServiceClient{cc}
+}
+
+func (c *waiversServiceClient) Create(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*Id, error) {
+	out := new(Id)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.compliance.api.waivers.WaiversService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) Read(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Waiver, error) {
+	out := new(Waiver)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.compliance.api.waivers.WaiversService/Read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) Update(ctx context.Context, in *Waiver, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.compliance.api.waivers.WaiversService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) Delete(ctx context.Context, in *Id, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.compliance.api.waivers.WaiversService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *waiversServiceClient) List(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Waivers, error) {
+	out := new(Waivers)
+	err := c.cc.Invoke(ctx, "/chef.automate.domain.compliance.api.waivers.WaiversService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WaiversServiceServer is the server API for WaiversService service.
+type WaiversServiceServer interface {
+	Create(context.Context, *Waiver) (*Id, error)
+	Read(context.Context, *Id) (*Waiver, error)
+	Update(context.Context, *Waiver) (*empty.Empty, error)
+	Delete(context.Context, *Id) (*empty.Empty, error)
+	List(context.Context, *Query) (*Waivers, error)
+}
+
+func RegisterWaiversServiceServer(s *grpc.Server, srv WaiversServiceServer) {
+	s.RegisterService(&_WaiversService_serviceDesc, srv)
+}
+
+func _WaiversService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Waiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.compliance.api.waivers.WaiversService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Create(ctx, req.(*Waiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.compliance.api.waivers.WaiversService/Read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Read(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Waiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.compliance.api.waivers.WaiversService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Update(ctx, req.(*Waiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.compliance.api.waivers.WaiversService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).Delete(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WaiversService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WaiversServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chef.automate.domain.compliance.api.waivers.WaiversService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WaiversServiceServer).List(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WaiversService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chef.automate.domain.compliance.api.waivers.WaiversService",
+	HandlerType: (*WaiversServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _WaiversService_Create_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _WaiversService_Read_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _WaiversService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _WaiversService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _WaiversService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "components/compliance-service/api/waivers/waivers.proto",
+}
+
+func init() {
+	proto.RegisterFile("components/compliance-service/api/waivers/waivers.proto", fileDescriptor_waivers_7f91aee9d009a1c1)
+}
+
+var fileDescriptor_waivers_7f91aee9d009a1c1 = []byte{
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xd1, 0x8b, 0x13, 0x31,
+	0x10, 0xc6, 0x69, 0xaf, 0xb7, 0xbd, 0x4e, 0xb5, 0x42, 0x10, 0x59, 0x56, 0x0e, 0x4b, 0x11, 0x2c,
+	0x88, 0x59, 0xe8, 0x29, 0xe2, 0xe3, 0x9d, 0x77, 0x42, 0x41, 0x91, 0x5b, 0x2d, 0x8a, 0x2f, 0x25,
+	0xdd, 0x4c, 0x7b, 0x91, 0xdd, 0x24, 0x6c, 0xb2, 0xa7, 0xf7, 0xee, 0x93, 0x7f, 0xb5, 0xec, 0x26,
+	0xab, 0x58, 0x91, 0xde, 0xf6, 0x69, 0xc3, 0x64, 0x7e, 0xdf, 0xe4, 0xcb, 0x4c, 0x16, 0x5e, 0xa6,
+	0x2a, 0xd7, 0x4a, 0xa2, 0xb4, 0x26, 0xae, 0x96, 0x99, 0x60, 0x32, 0xc5, 0x67, 0x06, 0x8b, 0x6b,
+	0x91, 0x62, 0xcc, 0xb4, 0x88, 0xbf, 0x31, 0x71, 0x8d, 0x85, 0x69, 0xbe, 0x54, 0x17, 0xca, 0x2a,
+	0xf2, 0x34, 0xbd, 0xc2, 0x35, 0x65, 0xa5, 0x55, 0x39, 0xb3, 0x48, 0xb9, 0xca, 0x99, 0x90, 0xf4,
+	0x8f, 0x04, 0x65, 0x5a, 0x50, 0x8f, 0x44, 0x8f, 0x36, 0x4a, 0x6d, 0x32, 0x8c, 0x6b, 0x74, 0x55,
+	0xae, 0x63, 0x2b, 0x72, 0x34, 0x96, 0xe5, 0xda, 0xa9, 0x45, 0x0f, 0xb7, 0x13, 0x30, 0xd7, 0xf6,
+	0xc6, 0x6f, 0xbe, 0xd8, 0x7d, 0xc6, 0x54, 0xe5, 0xb9, 0x92, 0xfe, 0xe3, 0xb0, 0xc9, 0x7d, 0xe8,
+	0xce, 0x39, 0x19, 0x41, 0x57, 0xf0, 0xb0, 0x33, 0xee, 0x4c, 0x07, 0x49, 0x57, 0xf0, 0xc9, 0x7b,
+	0x38, 0xbc, 0x2c, 0xb1, 0xb8, 0x21, 0xc7, 0x00, 0xba, 0x50, 0x6b, 0x91, 0xe1, 0xf2, 0x77, 0xc2,
+	0xc0, 0x47, 0xe6, 0x9c, 0x3c, 0x81, 0x7b, 0x42, 0xa6, 0x59, 0xc9, 0x71, 0x89, 0xdf, 0xb5, 0x28,
+	0x90, 0x87, 0xdd, 0x71, 0x67, 0x7a, 0x94, 0x8c, 0x7c, 0xf8, 0xc2, 0x45, 0x27, 0x3f, 0x0e, 0x20,
+	0xf8, 0x54, 0xfb, 0xdc, 0xae, 0xb5, 0x55, 0xa2, 0xbb, 0x5d, 0xe2, 0x18, 0x20, 0x55, 0xd2, 0x16,
+	0x2a, 0xab, 0xb6, 0x0f, 0xdc, 0xb6, 0x8f, 0xcc, 0x39, 0x59, 0xc0, 0x1d, 0xa9, 0x38, 0x2e, 0xd7,
+	0x22, 0xb3, 0x58, 0x98, 0xb0, 0x37, 0x3e, 0x98, 0x0e, 0x67, 0x33, 0x7a, 0x9b, 0x8b, 0xf7, 0x17,
+	0xf1, 0xa6, 0x46, 0x93, 0x61, 0xa5, 0xe3, 0xd6, 0x86, 0x3c, 0x86, 0xbb, 0x5f, 0x4b, 0x63, 0xc5,
+	0x5a, 0xa4, 0xcc, 0x0a, 0x25, 0xc3, 0xc3, 0xba, 0xf0, 0xdf, 0x41, 0xf2, 0x0a, 0xc0, 0xd9, 0x36,
+	0x4b, 0x66, 0xc3, 0x60, 0xdc, 0x99, 0x0e, 0x67, 0x11, 0x75, 0x5d, 0xa2, 0x4d, 0x97, 0xe8, 0xc7,
+	0xa6, 0x8d, 0xc9, 0xc0, 0x67, 0x9f, 0xda, 0x0a, 0x4d, 0x0b, 0x64, 0x16, 0x79, 0x85, 0xf6, 0x77,
+	0xa3, 0x3e, 0xdb, 0xa1, 0xa5, 0xe6, 0x0d, 0x7a, 0xb4, 0x1b, 0xf5, 0xd9, 0xa7, 0x76, 0xf2, 0x19,
+	0xfa, 0xae, 0x0b, 0x86, 0xbc, 0x83, 0xbe, 0x1f, 0xbc, 0xb0, 0x53, 0xdf, 0xd9, 0x09, 0x6d, 0x31,
+	0xac, 0xd4, 0xc9, 0x24, 0x8d, 0xc6, 0xec, 0x67, 0x0f, 0x46, 0x5e, 0xfa, 0x83, 0x1b, 0x39, 0x22,
+	0x21, 0x78, 0x5d, 0x1f, 0x9a, 0xec, 0x23, 0x1d, 0xc5, 0xad, 0xa0, 0x39, 0x27, 0x19, 0xf4, 0x12,
+	0x64, 0x9c, 0xb4, 0x05, 0xa3, 0x7d, 0x8e, 0x47, 0x16, 0x10, 0x2c, 0x34, 0xdf, 0xdb, 0xdd, 0x83,
+	0x7f, 0x1a, 0x76, 0x51, 0x3d, 0x66, 0x72, 0x09, 0xc1, 0x39, 0x66, 0x68, 0xb1, 0xbd, 0x8d, 0xff,
+	0x49, 0x6a, 0xe8, 0xbd, 0x15, 0xc6, 0x92, 0x59, 0x2b, 0xc1, 0xfa, 0xfd, 0x47, 0xcf, 0xf7, 0xf0,
+	0x66, 0xce, 0xce, 0xbf, 0x9c, 0x6d, 0x84, 0xbd, 0x2a, 0x57, 0x55, 0x62, 0x5c, 0x29, 0xc4, 0x8d,
+	0x42, 0x7c, 0xeb, 0x5f, 0xe9, 0x2a, 0xa8, 0x7d, 0x9c, 0xfc, 0x1a, 0x00, 0x3d, 0xec, 0x03, 0x2c,
+	0x7e, 0x05, 0x00, 0x00,
+}
@@ -39,6 +39,8 @@ import (
 	statusserver "github.com/chef/automate/components/compliance-service/api/status/server"
 	"github.com/chef/automate/components/compliance-service/api/version"
 	versionserver "github.com/chef/automate/components/compliance-service/api/version/server"
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+	waiversserver "github.com/chef/automate/components/compliance-service/api/waivers/server"
 	"github.com/chef/automate/components/compliance-service/config"
 	"github.com/chef/automate/components/compliance-service/dao/pgdb"
 	"github.com/chef/automate/components/compliance-service/ingest/ingest"
@@ -178,13 +180,14 @@ func serveGrpc(ctx context.Context, db *pgdb.DB, connFactory *secureconn.Factory
 
 	jobs.RegisterJobsServiceServer(s, jobsserver.New(db, connFactory, eventClient,
 		conf.Service.Endpoint, conf.Secrets.Endpoint, conf.Manager.Endpoint))
-	reporting.RegisterReportingServiceServer(s, reportingserver.New(&esr))
+	reporting.RegisterReportingServiceServer(s, reportingserver.New(&esr, db))
 
 	ps := profilesserver.New(db, &esr, &conf.Profiles, eventClient, statusSrv)
 	profiles.RegisterProfilesServiceServer(s, ps)
 	profiles.RegisterProfilesAdminServiceServer(s, ps)
 
-	stats.RegisterStatsServiceServer(s, statsserver.New(&esr))
+	stats.RegisterStatsServiceServer(s, statsserver.New(&esr, db))
+	waivers.RegisterWaiversServiceServer(s, waiversserver.New(db))
 	version.RegisterVersionServiceServer(s, versionserver.New())
 	feed.RegisterFeedServiceServer(s, feedserver.New(&conf.FeedConfig, &esr))
 	status.RegisterComplianceStatusServer(s, statusSrv)
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS waivers (
+  id            text      NOT NULL,
+  profile_id    text      NOT NULL,
+  control_id    text      NOT NULL,
+  node_filters  json      NOT NULL DEFAULT '[]',
+  justification text      NOT NULL,
+  expires_at    timestamp,
+  created_at    timestamp NOT NULL DEFAULT now(),
+  updated_at    timestamp NOT NULL DEFAULT now(),
+  primary key(id)
+);
+
+CREATE INDEX IF NOT EXISTS waivers_profile_id_idx ON waivers (profile_id);
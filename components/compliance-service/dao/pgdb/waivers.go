@@ -0,0 +1,227 @@
+package pgdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/chef/automate/components/compliance-service/api/common"
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+	"github.com/chef/automate/components/compliance-service/reporting"
+	"github.com/chef/automate/components/compliance-service/utils"
+)
+
+const selectWaivers = `
+SELECT
+  id,
+  profile_id,
+  control_id,
+  node_filters,
+  justification,
+  expires_at,
+  created_at,
+  updated_at
+FROM waivers
+WHERE ($1 = '' OR profile_id = $1)
+  AND ($2 OR expires_at IS NULL OR expires_at > now())
+ORDER BY profile_id, control_id, created_at;
+`
+
+const deleteWaiver = `
+DELETE FROM waivers
+WHERE id = $1;
+`
+
+// waiver used to (de)serialize database access
+type waiver struct {
+	ID            string          `db:"id"`
+	ProfileID     string          `db:"profile_id"`
+	ControlID     string          `db:"control_id"`
+	NodeFilters   json.RawMessage `db:"node_filters"`
+	Justification string          `db:"justification"`
+	ExpiresAt     *time.Time      `db:"expires_at"`
+	CreatedAt     time.Time       `db:"created_at"`
+	UpdatedAt     time.Time       `db:"updated_at"`
+}
+
+func toDBWaiver(in *waivers.Waiver) (waiver, error) {
+	newWaiver := waiver{
+		ID:            in.Id,
+		ProfileID:     in.ProfileId,
+		ControlID:     in.ControlId,
+		Justification: in.Justification,
+	}
+	nodeFilters := in.NodeFilters
+	if nodeFilters == nil {
+		nodeFilters = []*common.Filter{}
+	}
+	jsonFilters, err := json.Marshal(nodeFilters)
+	if err != nil {
+		return newWaiver, errors.Wrap(err, "toDBWaiver unable to marshal node filters")
+	}
+	newWaiver.NodeFilters = jsonFilters
+	if in.ExpiresAt != nil {
+		expiresAt, err := ptypes.Timestamp(in.ExpiresAt)
+		if err != nil {
+			return newWaiver, errors.Wrap(err, "toDBWaiver unable to convert expires_at to time.Time")
+		}
+		newWaiver.ExpiresAt = &expiresAt
+	}
+	return newWaiver, nil
+}
+
+func fromDBWaiver(in *waiver) (*waivers.Waiver, error) {
+	out := &waivers.Waiver{
+		Id:            in.ID,
+		ProfileId:     in.ProfileID,
+		ControlId:     in.ControlID,
+		Justification: in.Justification,
+	}
+	if err := json.Unmarshal(in.NodeFilters, &out.NodeFilters); err != nil {
+		return nil, errors.Wrap(err, "fromDBWaiver unable to unmarshal node filters")
+	}
+	var err error
+	if in.ExpiresAt != nil {
+		out.ExpiresAt, err = ptypes.TimestampProto(*in.ExpiresAt)
+		if err != nil {
+			return nil, errors.Wrap(err, "fromDBWaiver unable to convert expires_at")
+		}
+	}
+	out.CreatedAt, err = ptypes.TimestampProto(in.CreatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "fromDBWaiver unable to convert created_at")
+	}
+	out.UpdatedAt, err = ptypes.TimestampProto(in.UpdatedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, "fromDBWaiver unable to convert updated_at")
+	}
+	return out, nil
+}
+
+// AddWaiver stores a new waiver and returns its id
+func (db *DB) AddWaiver(in *waivers.Waiver) (string, error) {
+	if err := validateWaiver(in); err != nil {
+		return "", utils.ProcessInvalid(err, "AddWaiver error validating waiver")
+	}
+
+	newWaiver, err := toDBWaiver(in)
+	if err != nil {
+		return "", errors.Wrap(err, "AddWaiver unable to translate waiver to db struct")
+	}
+	newWaiver.ID = createUUID()
+	newWaiver.CreatedAt = time.Now().UTC()
+	newWaiver.UpdatedAt = newWaiver.CreatedAt
+
+	if err := db.Insert(&newWaiver); err != nil {
+		return "", errors.Wrap(err, "AddWaiver unable to insert waiver in db")
+	}
+	return newWaiver.ID, nil
+}
+
+// GetWaiver returns the waiver with the given id, expired or not
+func (db *DB) GetWaiver(id string) (*waivers.Waiver, error) {
+	var dbWaiver waiver
+	err := db.SelectOne(&dbWaiver, "SELECT * FROM waivers WHERE id = $1", id)
+	if err != nil {
+		return nil, utils.ProcessSQLNotFound(err, id, "GetWaiver error")
+	}
+	return fromDBWaiver(&dbWaiver)
+}
+
+// UpdateWaiver replaces the waiver with the same id, keeping its creation time
+func (db *DB) UpdateWaiver(in *waivers.Waiver) error {
+	if err := validateWaiver(in); err != nil {
+		return utils.ProcessInvalid(err, "UpdateWaiver error validating waiver")
+	}
+	existing, err := db.GetWaiver(in.Id)
+	if err != nil {
+		return err
+	}
+
+	dbWaiver, err := toDBWaiver(in)
+	if err != nil {
+		return errors.Wrap(err, "UpdateWaiver unable to translate waiver to db struct")
+	}
+	dbWaiver.CreatedAt, err = ptypes.Timestamp(existing.CreatedAt)
+	if err != nil {
+		return errors.Wrap(err, "UpdateWaiver unable to convert created_at")
+	}
+	dbWaiver.UpdatedAt = time.Now().UTC()
+
+	if _, err := db.Update(&dbWaiver); err != nil {
+		return errors.Wrap(err, "UpdateWaiver unable to update waiver")
+	}
+	return nil
+}
+
+// DeleteWaiver removes the waiver with the given id
+func (db *DB) DeleteWaiver(id string) error {
+	res, err := db.Exec(deleteWaiver, id)
+	if err != nil {
+		return errors.Wrap(err, "DeleteWaiver unable to delete waiver")
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "DeleteWaiver unable to count deleted waivers")
+	}
+	if count == 0 {
+		return &utils.NotFoundError{Id: id}
+	}
+	return nil
+}
+
+// GetWaivers returns the waivers of a profile, or of all profiles if
+// profileID is empty. Expired waivers are only included if includeExpired
+// is set.
+func (db *DB) GetWaivers(profileID string, includeExpired bool) ([]*waivers.Waiver, error) {
+	var dbWaivers []*waiver
+	_, err := db.Select(&dbWaivers, selectWaivers, profileID, includeExpired)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetWaivers unable to select waivers")
+	}
+
+	out := make([]*waivers.Waiver, 0, len(dbWaivers))
+	for _, dbWaiver := range dbWaivers {
+		w, err := fromDBWaiver(dbWaiver)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetWaivers")
+		}
+		out = append(out, w)
+	}
+	return out, nil
+}
+
+// GetActiveWaivers returns the waivers that have not expired. They are the
+// waivers that apply to reports.
+func (db *DB) GetActiveWaivers() ([]*waivers.Waiver, error) {
+	return db.GetWaivers("", false)
+}
+
+func validateWaiver(in *waivers.Waiver) error {
+	if in.ProfileId == "" {
+		return &utils.InvalidError{Msg: "Invalid waiver, 'profile_id' is a required parameter"}
+	}
+	if in.ControlId == "" {
+		return &utils.InvalidError{Msg: "Invalid waiver, 'control_id' is a required parameter"}
+	}
+	if in.Justification == "" {
+		return &utils.InvalidError{Msg: "Invalid waiver, 'justification' is a required parameter"}
+	}
+	for _, filter := range in.NodeFilters {
+		if _, ok := reporting.WaiverNodeFilterFields[filter.Key]; !ok {
+			return &utils.InvalidError{Msg: fmt.Sprintf("Invalid waiver, node filter '%s' is not supported", filter.Key)}
+		}
+		if len(filter.Values) == 0 {
+			return &utils.InvalidError{Msg: fmt.Sprintf("Invalid waiver, node filter '%s' has no values", filter.Key)}
+		}
+	}
+	if in.ExpiresAt != nil {
+		if _, err := ptypes.Timestamp(in.ExpiresAt); err != nil {
+			return &utils.InvalidError{Msg: fmt.Sprintf("Invalid waiver, 'expires_at' is not a valid time: %s", err)}
+		}
+	}
+	return nil
+}
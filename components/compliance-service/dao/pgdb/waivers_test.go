@@ -0,0 +1,71 @@
+package pgdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/compliance-service/api/common"
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+)
+
+func TestValidateWaiver(t *testing.T) {
+	valid := waivers.Waiver{
+		ProfileId:     "profile-a",
+		ControlId:     "control-1",
+		Justification: "approved by the security board",
+		NodeFilters:   []*common.Filter{{Key: "environment", Values: []string{"production"}}},
+	}
+	assert.NoError(t, validateWaiver(&valid))
+
+	for name, mutate := range map[string]func(w *waivers.Waiver){
+		"no profile":       func(w *waivers.Waiver) { w.ProfileId = "" },
+		"no control":       func(w *waivers.Waiver) { w.ControlId = "" },
+		"no justification": func(w *waivers.Waiver) { w.Justification = "" },
+		"unknown filter":   func(w *waivers.Waiver) { w.NodeFilters = []*common.Filter{{Key: "job_id", Values: []string{"1"}}} },
+		"empty filter":     func(w *waivers.Waiver) { w.NodeFilters = []*common.Filter{{Key: "role"}} },
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := valid
+			mutate(&w)
+			assert.Error(t, validateWaiver(&w))
+		})
+	}
+}
+
+func TestWaiverDBRoundTrip(t *testing.T) {
+	expiresAt, err := ptypes.TimestampProto(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	in := &waivers.Waiver{
+		Id:            "waiver-1",
+		ProfileId:     "profile-a",
+		ControlId:     "control-1",
+		Justification: "approved by the security board",
+		NodeFilters:   []*common.Filter{{Key: "role", Values: []string{"web"}, Exclude: true}},
+		ExpiresAt:     expiresAt,
+	}
+
+	dbWaiver, err := toDBWaiver(in)
+	require.NoError(t, err)
+	out, err := fromDBWaiver(&dbWaiver)
+	require.NoError(t, err)
+
+	assert.Equal(t, in.Id, out.Id)
+	assert.Equal(t, in.ProfileId, out.ProfileId)
+	assert.Equal(t, in.ControlId, out.ControlId)
+	assert.Equal(t, in.Justification, out.Justification)
+	assert.Equal(t, in.NodeFilters, out.NodeFilters)
+	assert.Equal(t, in.ExpiresAt, out.ExpiresAt)
+
+	dbWaiver, err = toDBWaiver(&waivers.Waiver{Id: "waiver-2"})
+	require.NoError(t, err)
+	assert.Nil(t, dbWaiver.ExpiresAt)
+	assert.Equal(t, "[]", string(dbWaiver.NodeFilters))
+	out, err = fromDBWaiver(&dbWaiver)
+	require.NoError(t, err)
+	assert.Nil(t, out.ExpiresAt)
+	assert.Empty(t, out.NodeFilters)
+}
@@ -13,7 +13,7 @@ import (
 )
 
 func TestListReports(t *testing.T) {
-	server := reportingServer.New(&relaxting.ES2Backend{ESUrl: elasticsearchUrl})
+	server := reportingServer.New(&relaxting.ES2Backend{ESUrl: elasticsearchUrl}, nil)
 	reports := []*relaxting.ESInSpecReport{
 		{
 			Projects: []string{},
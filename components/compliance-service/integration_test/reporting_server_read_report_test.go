@@ -20,7 +20,7 @@ func contextWithProjects(projects []string) context.Context {
 }
 
 func TestReadReport(t *testing.T) {
-	server := reportingServer.New(&relaxting.ES2Backend{ESUrl: elasticsearchUrl})
+	server := reportingServer.New(&relaxting.ES2Backend{ESUrl: elasticsearchUrl}, nil)
 	report := relaxting.ESInSpecReport{Projects: []string{"project1", "project2"}}
 	reportIds := suite.InsertInspecReports([]*relaxting.ESInSpecReport{&report})
 
@@ -1,3 +1,14 @@
 package reporting
 
 const ESize = 999999
+
+// WaiverNodeFilterFields maps the node filters of waivers to the report
+// fields they match
+var WaiverNodeFilterFields = map[string]string{
+	"environment": "environment",
+	"node_id":     "node_uuid",
+	"node_name":   "node_name",
+	"platform":    "platform.name",
+	"recipe":      "recipes",
+	"role":        "roles",
+}
@@ -207,6 +207,56 @@ func (backend ES2Backend) GetReportIds(esIndex string, filters map[string][]stri
 	return reportIds, nil
 }
 
+// GetNodeReportIdBefore returns the id of the latest report of the node that
+// matches the filters and, if before is set, ended before it
+func (backend ES2Backend) GetNodeReportIdBefore(nodeId string, before *time.Time,
+	filters map[string][]string) (string, error) {
+	myName := "GetNodeReportIdBefore"
+
+	client, err := backend.ES2Client()
+	if err != nil {
+		return "", errors.Wrapf(err, "%s cannot connect to elasticsearch", myName)
+	}
+
+	nodeFilters := map[string][]string{
+		"node_id":  {nodeId},
+		"projects": filters["projects"],
+	}
+	boolQuery := backend.getFiltersQuery(nodeFilters, false)
+	if before != nil {
+		boolQuery = boolQuery.Must(elastic.NewRangeQuery("end_time").Lt(before.Format(time.RFC3339)))
+	}
+
+	searchSource := elastic.NewSearchSource().
+		FetchSource(false).
+		Query(boolQuery).
+		Sort("end_time", false).
+		Size(1)
+
+	esIndex := ComplianceDailyRepTwenty
+	source, err := searchSource.Source()
+	if err != nil {
+		return "", errors.Wrapf(err, "%s unable to get Source", myName)
+	}
+	LogQueryPartMin(esIndex, source, fmt.Sprintf("%s query searchSource", myName))
+
+	searchResult, err := client.Search().
+		SearchSource(searchSource).
+		Index(esIndex).
+		FilterPath(
+			"hits.total",
+			"hits.hits._id").
+		Do(context.Background())
+	if err != nil {
+		return "", errors.Wrapf(err, "%s unable to complete search", myName)
+	}
+
+	if searchResult.TotalHits() > 0 && len(searchResult.Hits.Hits) > 0 {
+		return searchResult.Hits.Hits[0].Id, nil
+	}
+	return "", utils.ProcessNotFound(nil, nodeId)
+}
+
 //GetMinScanDate gets the date of the oldest scan in ES
 func (backend ES2Backend) GetMinScanDate() (*time.Time, error) {
 	complianceBirthday := time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC)
@@ -0,0 +1,366 @@
+package relaxting
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/olivere/elastic"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+	"github.com/chef/automate/components/compliance-service/ingest/ingestic/mappings"
+	"github.com/chef/automate/components/compliance-service/reporting"
+	"github.com/chef/automate/lib/stringutils"
+)
+
+// WaivedCount is the number of failed controls that waivers apply to, by impact
+type WaivedCount struct {
+	Total    int32
+	Minor    int32
+	Major    int32
+	Critical int32
+}
+
+// WaivedControl is the number of failures of a control of a profile that
+// waivers apply to
+type WaivedControl struct {
+	ProfileID string
+	ControlID string
+	WaivedCount
+}
+
+// WaivedTotal adds up the waived failures of all controls
+func WaivedTotal(waived []WaivedControl) WaivedCount {
+	var total WaivedCount
+	for _, control := range waived {
+		total.Total += control.Total
+		total.Minor += control.Minor
+		total.Major += control.Major
+		total.Critical += control.Critical
+	}
+	return total
+}
+
+// waiverGroup holds the waivers of one control of a profile. The waivers of a
+// control are queried together so that a failure that several of them apply
+// to is only counted once.
+type waiverGroup struct {
+	profileID string
+	controlID string
+	waivers   []*waivers.Waiver
+}
+
+// groupWaivers groups the waivers by profile and control, leaving out those
+// that the profile_id and control filters exclude
+func groupWaivers(activeWaivers []*waivers.Waiver, filters map[string][]string) []*waiverGroup {
+	groups := make([]*waiverGroup, 0)
+	byControl := make(map[string]*waiverGroup)
+	for _, waiver := range activeWaivers {
+		if len(filters["profile_id"]) > 0 && !stringutils.SliceContains(filters["profile_id"], waiver.ProfileId) {
+			continue
+		}
+		if len(filters["control"]) > 0 && !stringutils.SliceContains(filters["control"], waiver.ControlId) {
+			continue
+		}
+		key := waiver.ProfileId + "|" + waiver.ControlId
+		group, found := byControl[key]
+		if !found {
+			group = &waiverGroup{profileID: waiver.ProfileId, controlID: waiver.ControlId}
+			byControl[key] = group
+			groups = append(groups, group)
+		}
+		group.waivers = append(group.waivers, waiver)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].profileID != groups[j].profileID {
+			return groups[i].profileID < groups[j].profileID
+		}
+		return groups[i].controlID < groups[j].controlID
+	})
+	return groups
+}
+
+// waiverNodeQuery matches the reports of the nodes that the node filters of
+// the waiver select
+func waiverNodeQuery(waiver *waivers.Waiver) elastic.Query {
+	boolQuery := elastic.NewBoolQuery()
+	boolQuery = boolQuery.Must(elastic.NewMatchAllQuery())
+	for _, filter := range waiver.NodeFilters {
+		field, ok := reporting.WaiverNodeFilterFields[filter.Key]
+		if !ok || len(filter.Values) == 0 {
+			continue
+		}
+		termsQuery := elastic.NewTermsQuery(field, stringArrayToInterfaceArray(filter.Values)...)
+		if filter.Exclude {
+			boolQuery = boolQuery.MustNot(termsQuery)
+		} else {
+			boolQuery = boolQuery.Must(termsQuery)
+		}
+	}
+	return boolQuery
+}
+
+// waiverInEffectQuery matches the reports of the nodes that the node filters
+// of the waiver select, that ended while the waiver was in effect: a waiver
+// does not apply to the reports from before it was created, or from after it
+// expired.
+func waiverInEffectQuery(waiver *waivers.Waiver) elastic.Query {
+	inEffect := elastic.NewRangeQuery("end_time")
+	if createdAt, err := ptypes.Timestamp(waiver.CreatedAt); err == nil {
+		inEffect = inEffect.Gte(createdAt.Format(time.RFC3339))
+	}
+	if expiresAt, err := ptypes.Timestamp(waiver.ExpiresAt); err == nil {
+		inEffect = inEffect.Lt(expiresAt.Format(time.RFC3339))
+	}
+	return elastic.NewBoolQuery().Must(waiverNodeQuery(waiver), inEffect)
+}
+
+// aggregation counts the failures of the control of the group on the reports
+// that any of its waivers' queries match, by impact
+func (group *waiverGroup) aggregation(waiverQuery func(*waivers.Waiver) elastic.Query) elastic.Aggregation {
+	nodesQuery := elastic.NewBoolQuery().MinimumNumberShouldMatch(1)
+	for _, waiver := range group.waivers {
+		nodesQuery = nodesQuery.Should(waiverQuery(waiver))
+	}
+
+	failedControl := elastic.NewFilterAggregation().
+		Filter(elastic.NewBoolQuery().
+			Must(elastic.NewTermQuery("profiles.controls.id", group.controlID)).
+			Must(elastic.NewTermQuery("profiles.controls.status", "failed"))).
+		SubAggregation("minor", elastic.NewFilterAggregation().
+			Filter(elastic.NewRangeQuery("profiles.controls.impact").Lt(0.4))).
+		SubAggregation("major", elastic.NewFilterAggregation().
+			Filter(elastic.NewRangeQuery("profiles.controls.impact").Gte(0.4).Lt(0.7))).
+		SubAggregation("critical", elastic.NewFilterAggregation().
+			Filter(elastic.NewRangeQuery("profiles.controls.impact").Gte(0.7)))
+
+	return elastic.NewFilterAggregation().
+		Filter(nodesQuery).
+		SubAggregation("profiles", elastic.NewNestedAggregation().
+			Path("profiles").
+			SubAggregation("profile", elastic.NewFilterAggregation().
+				Filter(elastic.NewTermQuery("profiles.sha256", group.profileID)).
+				SubAggregation("controls", elastic.NewNestedAggregation().
+					Path("profiles.controls").
+					SubAggregation("failed", failedControl))))
+}
+
+// waivedCount reads the result of the aggregation of the group
+func waivedCount(aggs elastic.Aggregations, name string) WaivedCount {
+	var count WaivedCount
+	nodes, found := aggs.Filter(name)
+	if !found {
+		return count
+	}
+	profiles, found := nodes.Aggregations.Nested("profiles")
+	if !found {
+		return count
+	}
+	profile, found := profiles.Aggregations.Filter("profile")
+	if !found {
+		return count
+	}
+	controls, found := profile.Aggregations.Nested("controls")
+	if !found {
+		return count
+	}
+	failed, found := controls.Aggregations.Filter("failed")
+	if !found {
+		return count
+	}
+	count.Total = int32(failed.DocCount)
+	if minor, found := failed.Aggregations.Filter("minor"); found {
+		count.Minor = int32(minor.DocCount)
+	}
+	if major, found := failed.Aggregations.Filter("major"); found {
+		count.Major = int32(major.DocCount)
+	}
+	if critical, found := failed.Aggregations.Filter("critical"); found {
+		count.Critical = int32(critical.DocCount)
+	}
+	return count
+}
+
+func waiverAggName(i int) string {
+	return fmt.Sprintf("waived_%d", i)
+}
+
+// GetWaivedControls returns how many of the failed controls of the reports
+// matching the filters the waivers apply to, by control
+func (backend ES2Backend) GetWaivedControls(filters map[string][]string,
+	activeWaivers []*waivers.Waiver) ([]WaivedControl, error) {
+	myName := "GetWaivedControls"
+
+	groups := groupWaivers(activeWaivers, filters)
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	depth, err := backend.NewDepth(filters, false, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to get depth level for report", myName)
+	}
+	queryInfo := depth.getQueryInfo()
+
+	searchSource := elastic.NewSearchSource().
+		Query(queryInfo.filtQuery).
+		Size(0)
+	for i, group := range groups {
+		searchSource.Aggregation(waiverAggName(i), group.aggregation(waiverNodeQuery))
+	}
+
+	source, err := searchSource.Source()
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to get Source", myName)
+	}
+	LogQueryPartMin(queryInfo.esIndex, source, fmt.Sprintf("%s query", myName))
+
+	searchResult, err := queryInfo.client.Search().
+		SearchSource(searchSource).
+		Index(queryInfo.esIndex).
+		Size(0).
+		Do(context.Background())
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to complete search", myName)
+	}
+
+	waived := make([]WaivedControl, 0, len(groups))
+	for i, group := range groups {
+		count := waivedCount(searchResult.Aggregations, waiverAggName(i))
+		if count.Total > 0 {
+			waived = append(waived, WaivedControl{
+				ProfileID:   group.profileID,
+				ControlID:   group.controlID,
+				WaivedCount: count,
+			})
+		}
+	}
+	return waived, nil
+}
+
+// GetWaivedTrend returns how many of the failed controls the waivers apply
+// to, by the report time of the buckets of the controls trend. Each report is
+// only matched by the waivers that were in effect when it ended, so pass all
+// waivers, including the expired ones: creating a waiver does not change the
+// trend of the days before.
+func (backend ES2Backend) GetWaivedTrend(filters map[string][]string,
+	allWaivers []*waivers.Waiver) (map[string]int32, error) {
+	myName := "GetWaivedTrend"
+
+	groups := groupWaivers(allWaivers, filters)
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	depth, err := backend.NewDepth(filters, true, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to get depth level for report", myName)
+	}
+	queryInfo := depth.getQueryInfo()
+
+	trendBuckets := elastic.NewDateHistogramAggregation().
+		Interval("1d").
+		Field("end_time")
+	for i, group := range groups {
+		trendBuckets.SubAggregation(waiverAggName(i), group.aggregation(waiverInEffectQuery))
+	}
+
+	searchSource := elastic.NewSearchSource().
+		Query(queryInfo.filtQuery).
+		Aggregation("trend_buckets", trendBuckets).
+		Size(0)
+
+	source, err := searchSource.Source()
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to get Source", myName)
+	}
+	LogQueryPartMin(queryInfo.esIndex, source, fmt.Sprintf("%s query", myName))
+
+	searchResult, err := queryInfo.client.Search().
+		SearchSource(searchSource).
+		Index(queryInfo.esIndex).
+		Size(0).
+		Do(context.Background())
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to complete search", myName)
+	}
+
+	waived := make(map[string]int32)
+	histogram, found := searchResult.Aggregations.DateHistogram("trend_buckets")
+	if !found {
+		return waived, nil
+	}
+	for _, bucket := range histogram.Buckets {
+		if bucket.KeyAsString == nil {
+			continue
+		}
+		endTime, err := time.Parse(time.RFC3339, *bucket.KeyAsString)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s could not parse time", myName)
+		}
+		// keyed by the end of the day like the trend buckets
+		reportTime := time.Date(endTime.Year(), endTime.Month(), endTime.Day(),
+			23, 59, 59, 0, time.UTC).Format(time.RFC3339)
+		for i := range groups {
+			waived[reportTime] += waivedCount(bucket.Aggregations, waiverAggName(i)).Total
+		}
+	}
+	return waived, nil
+}
+
+// GetReportWaivers returns the waivers whose node filters select the node of
+// the report
+func (backend ES2Backend) GetReportWaivers(esIndex string, reportId string,
+	activeWaivers []*waivers.Waiver) ([]*waivers.Waiver, error) {
+	myName := "GetReportWaivers"
+
+	if len(activeWaivers) == 0 {
+		return nil, nil
+	}
+
+	client, err := backend.ES2Client()
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s cannot connect to elasticsearch", myName)
+	}
+
+	nodeFilters := elastic.NewFiltersAggregation()
+	for i, waiver := range activeWaivers {
+		nodeFilters = nodeFilters.FilterWithName(waiverAggName(i), waiverNodeQuery(waiver))
+	}
+
+	searchSource := elastic.NewSearchSource().
+		Query(elastic.NewIdsQuery(mappings.DocType).Ids(reportId)).
+		Aggregation("waivers", nodeFilters).
+		Size(0)
+
+	source, err := searchSource.Source()
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to get Source", myName)
+	}
+	LogQueryPartMin(esIndex, source, fmt.Sprintf("%s query", myName))
+
+	searchResult, err := client.Search().
+		SearchSource(searchSource).
+		Index(esIndex).
+		Size(0).
+		Do(context.Background())
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s unable to complete search", myName)
+	}
+
+	matching := make([]*waivers.Waiver, 0)
+	buckets, found := searchResult.Aggregations.Filters("waivers")
+	if !found {
+		return matching, nil
+	}
+	for i, waiver := range activeWaivers {
+		if bucket, ok := buckets.NamedBuckets[waiverAggName(i)]; ok && bucket.DocCount > 0 {
+			matching = append(matching, waiver)
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,111 @@
+package relaxting
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/olivere/elastic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/compliance-service/api/common"
+	"github.com/chef/automate/components/compliance-service/api/waivers"
+)
+
+func TestGroupWaivers(t *testing.T) {
+	activeWaivers := []*waivers.Waiver{
+		{Id: "1", ProfileId: "profile-b", ControlId: "control-1"},
+		{Id: "2", ProfileId: "profile-a", ControlId: "control-2"},
+		{Id: "3", ProfileId: "profile-a", ControlId: "control-1"},
+		{Id: "4", ProfileId: "profile-a", ControlId: "control-2"},
+	}
+
+	groups := groupWaivers(activeWaivers, map[string][]string{})
+	require.Equal(t, 3, len(groups))
+	assert.Equal(t, "profile-a", groups[0].profileID)
+	assert.Equal(t, "control-1", groups[0].controlID)
+	assert.Equal(t, "profile-a", groups[1].profileID)
+	assert.Equal(t, "control-2", groups[1].controlID)
+	assert.Equal(t, []*waivers.Waiver{activeWaivers[1], activeWaivers[3]}, groups[1].waivers)
+	assert.Equal(t, "profile-b", groups[2].profileID)
+
+	groups = groupWaivers(activeWaivers, map[string][]string{"profile_id": {"profile-a"}, "control": {"control-2"}})
+	require.Equal(t, 1, len(groups))
+	assert.Equal(t, "control-2", groups[0].controlID)
+
+	assert.Empty(t, groupWaivers(nil, map[string][]string{}))
+}
+
+func TestWaiverNodeQuery(t *testing.T) {
+	query := waiverNodeQuery(&waivers.Waiver{
+		NodeFilters: []*common.Filter{
+			{Key: "environment", Values: []string{"production"}},
+			{Key: "platform", Values: []string{"windows"}, Exclude: true},
+			{Key: "unknown", Values: []string{"ignored"}},
+		},
+	})
+	source, err := query.Source()
+	require.NoError(t, err)
+	actual, err := json.Marshal(source)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"bool":{
+		"must":[{"match_all":{}},{"terms":{"environment":["production"]}}],
+		"must_not":{"terms":{"platform.name":["windows"]}}
+	}}`, string(actual))
+}
+
+func TestWaiverInEffectQuery(t *testing.T) {
+	createdAt := &timestamp.Timestamp{Seconds: 1561939200} // 2019-07-01T00:00:00Z
+	expiresAt := &timestamp.Timestamp{Seconds: 1564617600} // 2019-08-01T00:00:00Z
+	nodeFilters := []*common.Filter{{Key: "environment", Values: []string{"production"}}}
+
+	cases := map[string]struct {
+		waiver   *waivers.Waiver
+		inEffect string
+	}{
+		"from its creation until it expires": {
+			waiver:   &waivers.Waiver{NodeFilters: nodeFilters, CreatedAt: createdAt, ExpiresAt: expiresAt},
+			inEffect: `{"range":{"end_time":{"from":"2019-07-01T00:00:00Z","include_lower":true,"include_upper":false,"to":"2019-08-01T00:00:00Z"}}}`,
+		},
+		"from its creation on without an expiry": {
+			waiver:   &waivers.Waiver{NodeFilters: nodeFilters, CreatedAt: createdAt},
+			inEffect: `{"range":{"end_time":{"from":"2019-07-01T00:00:00Z","include_lower":true,"include_upper":true,"to":null}}}`,
+		},
+	}
+	for desc, tc := range cases {
+		t.Run(desc, func(t *testing.T) {
+			source, err := waiverInEffectQuery(tc.waiver).Source()
+			require.NoError(t, err)
+			actual, err := json.Marshal(source)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, `{"bool":{"must":[
+				{"bool":{"must":[{"match_all":{}},{"terms":{"environment":["production"]}}]}},
+				`+tc.inEffect+`
+			]}}`, string(actual))
+		})
+	}
+}
+
+func TestWaivedCount(t *testing.T) {
+	var aggs elastic.Aggregations
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"waived_0": {"doc_count": 3, "profiles": {"doc_count": 6, "profile": {"doc_count": 3, "controls": {"doc_count": 30,
+			"failed": {"doc_count": 3, "minor": {"doc_count": 0}, "major": {"doc_count": 1}, "critical": {"doc_count": 2}}}}}},
+		"waived_1": {"doc_count": 0, "profiles": {"doc_count": 0, "profile": {"doc_count": 0, "controls": {"doc_count": 0,
+			"failed": {"doc_count": 0, "minor": {"doc_count": 0}, "major": {"doc_count": 0}, "critical": {"doc_count": 0}}}}}}
+	}`), &aggs))
+
+	assert.Equal(t, WaivedCount{Total: 3, Major: 1, Critical: 2}, waivedCount(aggs, waiverAggName(0)))
+	assert.Equal(t, WaivedCount{}, waivedCount(aggs, waiverAggName(1)))
+	assert.Equal(t, WaivedCount{}, waivedCount(aggs, waiverAggName(2)))
+}
+
+func TestWaivedTotal(t *testing.T) {
+	assert.Equal(t, WaivedCount{Total: 5, Minor: 1, Major: 1, Critical: 3}, WaivedTotal([]WaivedControl{
+		{ProfileID: "a", ControlID: "1", WaivedCount: WaivedCount{Total: 3, Major: 1, Critical: 2}},
+		{ProfileID: "a", ControlID: "2", WaivedCount: WaivedCount{Total: 2, Minor: 1, Critical: 1}},
+	}))
+}
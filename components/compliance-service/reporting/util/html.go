@@ -0,0 +1,147 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strconv"
+
+	reportingapi "github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/inspec"
+)
+
+const (
+	// HTMLHeader starts a self-contained HTML export, before the sections of
+	// the reports
+	HTMLHeader = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Compliance Reports</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #333; }
+section.report { margin-bottom: 3em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 0.4em; text-align: left; vertical-align: top; }
+th { background: #f2f2f2; }
+.failed { color: #b00020; }
+.passed { color: #1b7a33; }
+.skipped { color: #7a6a00; }
+ul.results { margin: 0.3em 0 0 0; padding-left: 1.2em; }
+</style>
+</head>
+<body>
+<h1>Compliance Reports</h1>
+`
+	// HTMLFooter ends an HTML export
+	HTMLFooter = "</body>\n</html>\n"
+)
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<section class="report">
+<h2>{{.NodeName}}</h2>
+<p>
+Status: <span class="{{.Status}}">{{.Status}}</span> &middot;
+End time: {{.EndTime}} &middot;
+Environment: {{.Environment}} &middot;
+Platform: {{.Platform}} &middot;
+Report: {{.ID}}
+</p>
+<p>{{.Passed}} passed, {{.Failed}} failed, {{.Skipped}} skipped</p>
+{{- range .Profiles}}
+<h3>{{.Title}} {{.Version}}</h3>
+<table>
+<thead><tr><th>Control</th><th>Title</th><th>Impact</th><th>Status</th></tr></thead>
+<tbody>
+{{- range .Controls}}
+<tr><td>{{.ID}}</td><td>{{.Title}}{{if .Messages}}<ul class="results">{{range .Messages}}<li>{{.}}</li>{{end}}</ul>{{end}}</td><td>{{.Impact}}</td><td class="{{.Status}}">{{.Status}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+</section>
+`))
+
+type htmlReport struct {
+	ID          string
+	NodeName    string
+	Status      string
+	EndTime     string
+	Environment string
+	Platform    string
+	Passed      int
+	Failed      int
+	Skipped     int
+	Profiles    []htmlProfile
+}
+
+type htmlProfile struct {
+	Title    string
+	Version  string
+	Controls []htmlControl
+}
+
+type htmlControl struct {
+	ID       string
+	Title    string
+	Impact   string
+	Status   string
+	Messages []string
+}
+
+// ReportToHTML converts a report to a section of an HTML document listing the
+// controls of its profiles. The sections go between HTMLHeader and HTMLFooter.
+func ReportToHTML(report *reportingapi.Report) (string, error) {
+	if report == nil {
+		return "", fmt.Errorf("Received an empty report to be converted to HTML.")
+	}
+
+	view := htmlReport{
+		ID:          report.Id,
+		NodeName:    report.NodeName,
+		Status:      report.Status,
+		EndTime:     reportEndTime(report),
+		Environment: report.Environment,
+		Platform:    report.GetPlatform().GetName(),
+		Profiles:    make([]htmlProfile, 0, len(report.Profiles)),
+	}
+	for _, profile := range report.Profiles {
+		viewProfile := htmlProfile{
+			Title:    profileTitle(profile),
+			Version:  profile.Version,
+			Controls: make([]htmlControl, 0, len(profile.Controls)),
+		}
+		for _, control := range profile.Controls {
+			viewControl := htmlControl{
+				ID:     control.Id,
+				Title:  control.Title,
+				Impact: strconv.FormatFloat(float64(control.Impact), 'f', 1, 32),
+				Status: ControlStatus(control),
+			}
+			switch viewControl.Status {
+			case inspec.ResultStatusFailed:
+				view.Failed++
+				for _, result := range control.Results {
+					if result.Status == inspec.ResultStatusFailed {
+						viewControl.Messages = append(viewControl.Messages, result.CodeDesc)
+					}
+				}
+			case inspec.ResultStatusSkipped:
+				view.Skipped++
+				if message := skipMessage(control); message != "" {
+					viewControl.Messages = []string{message}
+				}
+			default:
+				view.Passed++
+			}
+			viewProfile.Controls = append(viewProfile.Controls, viewControl)
+		}
+		view.Profiles = append(view.Profiles, viewProfile)
+	}
+
+	var content bytes.Buffer
+	err := htmlReportTemplate.Execute(&content, view)
+	if err != nil {
+		return "", fmt.Errorf("Failed to render HTML report: %+v", err)
+	}
+	return content.String(), nil
+}
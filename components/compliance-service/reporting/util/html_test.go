@@ -0,0 +1,26 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNilHTMLExport(t *testing.T) {
+	_, err := ReportToHTML(nil)
+	assert.NotNil(t, err)
+}
+
+func TestHTMLExport(t *testing.T) {
+	data, err := ReportToHTML(newTestReport())
+	require.NoError(t, err)
+
+	assert.Contains(t, data, "<h2>web &amp; db</h2>", "the node name is escaped")
+	assert.Contains(t, data, "End time: 2018-02-09T09:18:41Z")
+	assert.Contains(t, data, "<p>1 passed, 1 failed, 1 skipped</p>")
+	assert.Contains(t, data, "<h3>SSH Baseline 1.2.3</h3>")
+	assert.Contains(t, data, `<tr><td>control-2</td><td>control-2 title<ul class="results"><li>control-2 failed</li></ul></td><td>0.8</td><td class="failed">failed</td></tr>`)
+	assert.Contains(t, data, `<li>not on windows</li>`)
+	assert.NotContains(t, data, "<b>", "result messages are left out")
+}
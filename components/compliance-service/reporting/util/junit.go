@@ -0,0 +1,167 @@
+package util
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
+
+	reportingapi "github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/inspec"
+)
+
+const (
+	// JUnitHeader starts a JUnit XML export, before the test suites of the reports
+	JUnitHeader = xml.Header + "<testsuites>\n"
+	// JUnitFooter ends a JUnit XML export
+	JUnitFooter = "</testsuites>\n"
+)
+
+type junitTestSuite struct {
+	XMLName    xml.Name        `xml:"testsuite"`
+	Name       string          `xml:"name,attr"`
+	Package    string          `xml:"package,attr,omitempty"`
+	Hostname   string          `xml:"hostname,attr,omitempty"`
+	Timestamp  string          `xml:"timestamp,attr,omitempty"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// ReportToJUnit converts a report to JUnit XML test suites, one per profile,
+// with a test case per control. The suites go between JUnitHeader and
+// JUnitFooter.
+func ReportToJUnit(report *reportingapi.Report) (string, error) {
+	if report == nil {
+		return "", fmt.Errorf("Received an empty report to be converted to JUnit.")
+	}
+
+	var timestamp string
+	if report.EndTime != nil {
+		endTime, err := ptypes.Timestamp(report.EndTime)
+		if err != nil {
+			return "", fmt.Errorf("Failed to convert the end time of report %s: %+v", report.Id, err)
+		}
+		timestamp = endTime.UTC().Format("2006-01-02T15:04:05")
+	}
+
+	var content strings.Builder
+	for _, profile := range report.Profiles {
+		suite := junitTestSuite{
+			Name:      report.NodeName + " / " + profileTitle(profile),
+			Package:   profile.Name,
+			Hostname:  report.NodeName,
+			Timestamp: timestamp,
+			Tests:     len(profile.Controls),
+			Properties: []junitProperty{
+				{Name: "report_id", Value: report.Id},
+				{Name: "node_id", Value: report.NodeId},
+				{Name: "environment", Value: report.Environment},
+				{Name: "platform", Value: report.GetPlatform().GetName()},
+				{Name: "profile_version", Value: profile.Version},
+				{Name: "profile_sha256", Value: profile.Sha256},
+			},
+			TestCases: make([]junitTestCase, 0, len(profile.Controls)),
+		}
+
+		var suiteTime float64
+		for _, control := range profile.Controls {
+			var controlTime float64
+			for _, result := range control.Results {
+				controlTime += float64(result.RunTime)
+			}
+			suiteTime += controlTime
+
+			testCase := junitTestCase{
+				Name:      control.Id,
+				ClassName: profile.Name,
+				Time:      strconv.FormatFloat(controlTime, 'f', 3, 64),
+			}
+			switch ControlStatus(control) {
+			case inspec.ResultStatusFailed:
+				suite.Failures++
+				testCase.Failure = &junitFailure{
+					Message: control.Title,
+					Type:    impactName(control.Impact),
+					Text:    failedResults(control),
+				}
+			case inspec.ResultStatusSkipped:
+				suite.Skipped++
+				testCase.Skipped = &junitSkipped{Message: skipMessage(control)}
+			}
+			suite.TestCases = append(suite.TestCases, testCase)
+		}
+		suite.Time = strconv.FormatFloat(suiteTime, 'f', 3, 64)
+
+		raw, err := xml.MarshalIndent(suite, "  ", "  ")
+		if err != nil {
+			return "", fmt.Errorf("Failed to marshal JUnit report: %+v", err)
+		}
+		content.WriteString("  ")
+		content.Write(raw)
+		content.WriteString("\n")
+	}
+	return content.String(), nil
+}
+
+func profileTitle(profile *reportingapi.Profile) string {
+	if profile.Title != "" {
+		return profile.Title
+	}
+	return profile.Name
+}
+
+// failedResults describes the failed results of a control, one per line
+func failedResults(control *reportingapi.Control) string {
+	lines := make([]string, 0, len(control.Results))
+	for _, result := range control.Results {
+		if result.Status != inspec.ResultStatusFailed {
+			continue
+		}
+		line := result.CodeDesc
+		if result.Message != "" {
+			line += ": " + result.Message
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// skipMessage returns the first skip message of the results of a control
+func skipMessage(control *reportingapi.Control) string {
+	for _, result := range control.Results {
+		if result.SkipMessage != "" {
+			return result.SkipMessage
+		}
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package util
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reportingapi "github.com/chef/automate/components/compliance-service/api/reporting"
+)
+
+func TestNilJUnitExport(t *testing.T) {
+	_, err := ReportToJUnit(nil)
+	assert.NotNil(t, err)
+}
+
+func TestEmptyJUnitExport(t *testing.T) {
+	data, err := ReportToJUnit(&reportingapi.Report{})
+	require.NoError(t, err)
+	assert.Equal(t, "", data)
+}
+
+func TestJUnitExport(t *testing.T) {
+	data, err := ReportToJUnit(newTestReport())
+	require.NoError(t, err)
+
+	var suites struct {
+		Suites []junitTestSuite `xml:"testsuite"`
+	}
+	err = xml.Unmarshal([]byte(JUnitHeader+data+JUnitFooter), &suites)
+	require.NoError(t, err, "the export is valid XML")
+	require.Len(t, suites.Suites, 1)
+
+	suite := suites.Suites[0]
+	assert.Equal(t, "web & db / SSH Baseline", suite.Name)
+	assert.Equal(t, "ssh-baseline", suite.Package)
+	assert.Equal(t, "2018-02-09T09:18:41", suite.Timestamp)
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 1, suite.Failures)
+	assert.Equal(t, 1, suite.Skipped)
+	assert.Equal(t, "2.000", suite.Time)
+	assert.Contains(t, suite.Properties, junitProperty{Name: "report_id", Value: "report-1"})
+
+	require.Len(t, suite.TestCases, 3)
+	assert.Equal(t, junitTestCase{Name: "control-1", ClassName: "ssh-baseline", Time: "0.500"}, suite.TestCases[0])
+	assert.Equal(t, &junitFailure{
+		Message: "control-2 title",
+		Type:    "critical",
+		Text:    "control-2 failed: expected <b>0</b>",
+	}, suite.TestCases[1].Failure)
+	assert.Equal(t, &junitSkipped{Message: "not on windows"}, suite.TestCases[2].Skipped)
+}
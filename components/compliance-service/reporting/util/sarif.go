@@ -0,0 +1,177 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	reportingapi "github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/inspec"
+)
+
+const (
+	// SARIFHeader starts a SARIF 2.1.0 log, before the runs of the reports
+	SARIFHeader = `{"$schema":"https://json.schemastore.org/sarif-2.1.0.json","version":"2.1.0","runs":[`
+	// SARIFSeparator goes between the runs of two reports
+	SARIFSeparator = ","
+	// SARIFFooter ends a SARIF log
+	SARIFFooter = "]}\n"
+)
+
+type sarifRun struct {
+	Tool              sarifTool              `json:"tool"`
+	AutomationDetails sarifAutomationDetails `json:"automationDetails"`
+	Results           []sarifResult          `json:"results"`
+	Properties        map[string]string      `json:"properties,omitempty"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string              `json:"id"`
+	ShortDescription sarifMessage        `json:"shortDescription"`
+	FullDescription  *sarifMessage       `json:"fullDescription,omitempty"`
+	Properties       sarifRuleProperties `json:"properties"`
+}
+
+type sarifRuleProperties struct {
+	Profile        string  `json:"profile"`
+	ProfileVersion string  `json:"profileVersion,omitempty"`
+	Impact         float32 `json:"impact"`
+}
+
+type sarifAutomationDetails struct {
+	ID string `json:"id"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int32 `json:"startLine"`
+}
+
+// ReportToSARIF converts a report to a SARIF run. Every control is a rule of
+// the run and every failed control a result, its level following the impact
+// of the control. The runs go between SARIFHeader and SARIFFooter, separated
+// by SARIFSeparator.
+func ReportToSARIF(report *reportingapi.Report) (string, error) {
+	if report == nil {
+		return "", fmt.Errorf("Received an empty report to be converted to SARIF.")
+	}
+
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "InSpec",
+			Version:        report.Version,
+			InformationURI: "https://www.inspec.io/",
+			Rules:          make([]sarifRule, 0),
+		}},
+		AutomationDetails: sarifAutomationDetails{ID: report.NodeName + "/" + report.Id},
+		Results:           make([]sarifResult, 0),
+		Properties: map[string]string{
+			"nodeId":      report.NodeId,
+			"nodeName":    report.NodeName,
+			"environment": report.Environment,
+			"platform":    report.GetPlatform().GetName(),
+			"endTime":     reportEndTime(report),
+			"status":      report.Status,
+		},
+	}
+
+	for _, profile := range report.Profiles {
+		for _, control := range profile.Controls {
+			rule := sarifRule{
+				ID:               control.Id,
+				ShortDescription: sarifMessage{Text: controlTitle(control)},
+				Properties: sarifRuleProperties{
+					Profile:        profile.Name,
+					ProfileVersion: profile.Version,
+					Impact:         control.Impact,
+				},
+			}
+			if control.Desc != "" {
+				rule.FullDescription = &sarifMessage{Text: control.Desc}
+			}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+
+			if ControlStatus(control) != inspec.ResultStatusFailed {
+				continue
+			}
+			result := sarifResult{
+				RuleID:    control.Id,
+				RuleIndex: len(run.Tool.Driver.Rules) - 1,
+				Level:     sarifLevel(control.Impact),
+				Message:   sarifMessage{Text: controlTitle(control)},
+			}
+			if failed := failedResults(control); failed != "" {
+				result.Message.Text += "\n" + failed
+			}
+			if control.SourceLocation != nil && control.SourceLocation.Ref != "" {
+				location := sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: control.SourceLocation.Ref},
+				}
+				if control.SourceLocation.Line > 0 {
+					location.Region = &sarifRegion{StartLine: control.SourceLocation.Line}
+				}
+				result.Locations = []sarifLocation{{PhysicalLocation: location}}
+			}
+			run.Results = append(run.Results, result)
+		}
+	}
+
+	raw, err := json.Marshal(run)
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal SARIF report: %+v", err)
+	}
+	return string(raw), nil
+}
+
+// sarifLevel maps the impact of a failed control to a SARIF level
+func sarifLevel(impact float32) string {
+	switch impactName(impact) {
+	case "critical":
+		return "error"
+	case "major":
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func controlTitle(control *reportingapi.Control) string {
+	if control.Title != "" {
+		return control.Title
+	}
+	return control.Id
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	reportingapi "github.com/chef/automate/components/compliance-service/api/reporting"
+)
+
+func TestNilSARIFExport(t *testing.T) {
+	_, err := ReportToSARIF(nil)
+	assert.NotNil(t, err)
+}
+
+func TestSARIFExport(t *testing.T) {
+	report := newTestReport()
+	run, err := ReportToSARIF(report)
+	require.NoError(t, err)
+
+	var log struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+	err = json.Unmarshal([]byte(SARIFHeader+run+SARIFSeparator+run+SARIFFooter), &log)
+	require.NoError(t, err, "the export is valid JSON")
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 2)
+
+	sarif := log.Runs[0]
+	assert.Equal(t, "InSpec", sarif.Tool.Driver.Name)
+	assert.Equal(t, "3.9.0", sarif.Tool.Driver.Version)
+	assert.Equal(t, "web & db/report-1", sarif.AutomationDetails.ID)
+	assert.Equal(t, "node-1", sarif.Properties["nodeId"])
+	require.Len(t, sarif.Tool.Driver.Rules, 3)
+	assert.Equal(t, "control-2", sarif.Tool.Driver.Rules[1].ID)
+	assert.Equal(t, "ssh-baseline", sarif.Tool.Driver.Rules[1].Properties.Profile)
+
+	assert.Equal(t, []sarifResult{{
+		RuleID:    "control-2",
+		RuleIndex: 1,
+		Level:     "error",
+		Message:   sarifMessage{Text: "control-2 title\ncontrol-2 failed: expected <b>0</b>"},
+		Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: "controls/ssh.rb"},
+			Region:           &sarifRegion{StartLine: 12},
+		}}},
+	}}, sarif.Results)
+}
+
+func TestEmptySARIFExport(t *testing.T) {
+	run, err := ReportToSARIF(&reportingapi.Report{})
+	require.NoError(t, err)
+	assert.Contains(t, run, `"results":[]`)
+}
+
+func TestSARIFLevel(t *testing.T) {
+	assert.Equal(t, "note", sarifLevel(0.1))
+	assert.Equal(t, "warning", sarifLevel(0.5))
+	assert.Equal(t, "error", sarifLevel(0.7))
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	reportingapi "github.com/chef/automate/components/compliance-service/api/reporting"
+	"github.com/chef/automate/components/compliance-service/inspec"
+)
+
+// ControlStatus is failed if any result of the control failed, skipped if any
+// was skipped and passed otherwise
+func ControlStatus(control *reportingapi.Control) string {
+	status := inspec.ResultStatusPassed
+	for _, result := range control.Results {
+		if result.Status == inspec.ResultStatusFailed {
+			return inspec.ResultStatusFailed
+		} else if result.Status == inspec.ResultStatusSkipped {
+			status = inspec.ResultStatusSkipped
+		}
+	}
+	return status
+}
+
+// impactName returns a human readable name for the impact of a control
+func impactName(impact float32) string {
+	if impact < 0.4 {
+		return "minor"
+	} else if impact < 0.7 {
+		return "major"
+	}
+	return "critical"
+}
+
+// reportEndTime formats the end time of a report, empty if it has none
+func reportEndTime(report *reportingapi.Report) string {
+	if report.EndTime == nil {
+		return ""
+	}
+	endTime, err := ptypes.Timestamp(report.EndTime)
+	if err != nil {
+		return ""
+	}
+	return endTime.UTC().Format(time.RFC3339)
+}
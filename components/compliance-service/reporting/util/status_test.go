@@ -0,0 +1,63 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+
+	reportingapi "github.com/chef/automate/components/compliance-service/api/reporting"
+)
+
+func newTestControl(id string, impact float32, statuses ...string) *reportingapi.Control {
+	results := make([]*reportingapi.Result, len(statuses))
+	for i, status := range statuses {
+		results[i] = &reportingapi.Result{Status: status, CodeDesc: id + " " + status, RunTime: 0.5}
+	}
+	return &reportingapi.Control{Id: id, Title: id + " title", Impact: impact, Results: results}
+}
+
+// newTestReport returns a report with a passed, failed and skipped control
+func newTestReport() *reportingapi.Report {
+	failed := newTestControl("control-2", 0.8, "passed", "failed")
+	failed.Results[1].Message = "expected <b>0</b>"
+	failed.SourceLocation = &reportingapi.SourceLocation{Ref: "controls/ssh.rb", Line: 12}
+	skipped := newTestControl("control-3", 0.3, "skipped")
+	skipped.Results[0].SkipMessage = "not on windows"
+
+	return &reportingapi.Report{
+		Id:          "report-1",
+		NodeId:      "node-1",
+		NodeName:    "web & db",
+		EndTime:     &timestamp.Timestamp{Seconds: 1518167921},
+		Status:      "failed",
+		Environment: "prod",
+		Version:     "3.9.0",
+		Platform:    &reportingapi.Platform{Name: "ubuntu", Release: "18.04"},
+		Profiles: []*reportingapi.Profile{
+			{
+				Name:    "ssh-baseline",
+				Title:   "SSH Baseline",
+				Version: "1.2.3",
+				Sha256:  "sha-ssh",
+				Controls: []*reportingapi.Control{
+					newTestControl("control-1", 0.5, "passed"),
+					failed,
+					skipped,
+				},
+			},
+		},
+	}
+}
+
+func TestControlStatus(t *testing.T) {
+	assert.Equal(t, "passed", ControlStatus(newTestControl("c", 0)))
+	assert.Equal(t, "passed", ControlStatus(newTestControl("c", 0, "passed")))
+	assert.Equal(t, "skipped", ControlStatus(newTestControl("c", 0, "passed", "skipped")))
+	assert.Equal(t, "failed", ControlStatus(newTestControl("c", 0, "skipped", "failed", "passed")))
+}
+
+func TestReportEndTime(t *testing.T) {
+	assert.Equal(t, "2018-02-09T09:18:41Z", reportEndTime(newTestReport()))
+	assert.Equal(t, "", reportEndTime(&reportingapi.Report{}))
+}
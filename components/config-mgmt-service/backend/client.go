@@ -90,6 +90,7 @@ type InventoryNode struct {
 	LastCCRReceived time.Time `json:"lastCCRReceived"`
 	NodeName        string    `json:"node_name"`
 	Fqdn            string    `json:"fqdn"`
+	LatestRunID     string    `json:"latest_run_id"`
 }
 
 // NodesCounts type
@@ -323,6 +323,7 @@ func backendNodeArrayToInventoryNodeArray(nodes []backend.InventoryNode) []*inte
 			LastCcrReceived: lastCCRTimestamp,
 			Name:            node.NodeName,
 			Fqdn:            node.Fqdn,
+			LatestRunId:     node.LatestRunID,
 		}
 
 	}
@@ -237,6 +237,7 @@ func expectedInventoryNodeListFromNodeArray(nodes []iBackend.Node) []*response.I
 			LastCcrReceived: lastCCRTimestamp,
 			Name:            node.NodeName,
 			Fqdn:            node.Fqdn,
+			LatestRunId:     node.LatestRunID,
 		}
 	}
 
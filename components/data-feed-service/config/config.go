@@ -6,6 +6,7 @@ import (
 	"path"
 	"time"
 
+	"github.com/chef/automate/lib/platform"
 	"github.com/chef/automate/lib/tls/certs"
 
 	log "github.com/sirupsen/logrus"
@@ -25,6 +26,8 @@ type DataFeedConfig struct {
 	NotificationsConfig NotificationsConfig `mapstructure:"notifications"`
 	SecretsConfig       SecretsConfig       `mapstructure:"secrets"`
 	CfgmgmtConfig       CfgmgmtConfig       `mapstructure:"cfgmgmt"`
+	ComplianceConfig    ComplianceConfig    `mapstructure:"compliance"`
+	PostgresConfig      PostgresConfig      `mapstructure:"postgres"`
 	ServiceCerts        *certs.ServiceCerts
 }
 
@@ -37,6 +40,18 @@ type ServiceConfig struct {
 	Host         string        `mapstructure:"host"`
 	Port         uint16        `mapstructure:"port"`
 	FeedInterval time.Duration `mapstructure:"feed_interval"`
+	// Number of attempts made to deliver a feed window before giving up until the next interval
+	RetryAttempts int `mapstructure:"retry_attempts"`
+	// Wait before the first retry, doubled for every following attempt
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+	// Missed feed windows older than this are not replayed
+	MaxReplay time.Duration `mapstructure:"max_replay"`
+	// Number of nodes requested from config-mgmt per page
+	NodePageSize int `mapstructure:"node_page_size"`
+	// Number of nodes whose attributes are fetched at the same time
+	AttributesConcurrency int `mapstructure:"attributes_concurrency"`
+	// Most node messages sent to a destination in one request
+	MaxBatchSize int `mapstructure:"max_batch_size"`
 }
 
 type NotificationsConfig struct {
@@ -51,6 +66,16 @@ type CfgmgmtConfig struct {
 	Target string `mapstructure:"target"`
 }
 
+type ComplianceConfig struct {
+	Target string `mapstructure:"target"`
+}
+
+type PostgresConfig struct {
+	URI        string `mapstructure:"uri"`
+	Database   string `mapstructure:"database"`
+	SchemaPath string `mapstructure:"schema_path"`
+}
+
 //type HandlerConfig struct {
 //	Feed      string `mapstructure:"feed"`
 //	CfgIngest string `mapstructure:"cfgingest"`
@@ -80,6 +105,27 @@ func (c *DataFeedConfig) SetLogLevel() {
 	log.SetLevel(level)
 }
 
+func (c *DataFeedConfig) setDeliveryDefaults() {
+	if c.ServiceConfig.RetryAttempts <= 0 {
+		c.ServiceConfig.RetryAttempts = 3
+	}
+	if c.ServiceConfig.RetryBackoff <= 0 {
+		c.ServiceConfig.RetryBackoff = 10 * time.Second
+	}
+	if c.ServiceConfig.MaxReplay <= 0 {
+		c.ServiceConfig.MaxReplay = 24 * time.Hour
+	}
+	if c.ServiceConfig.NodePageSize <= 0 {
+		c.ServiceConfig.NodePageSize = 100
+	}
+	if c.ServiceConfig.AttributesConcurrency <= 0 {
+		c.ServiceConfig.AttributesConcurrency = 10
+	}
+	if c.ServiceConfig.MaxBatchSize <= 0 {
+		c.ServiceConfig.MaxBatchSize = 500
+	}
+}
+
 func (c *DataFeedConfig) GetCerts() *certs.ServiceCerts {
 	return c.ServiceCerts
 }
@@ -110,6 +156,15 @@ func Configure() (*DataFeedConfig, error) {
 	}
 	// Set log level
 	config.SetLogLevel()
+	config.setDeliveryDefaults()
+
+	if config.PostgresConfig.URI == "" {
+		config.PostgresConfig.URI, err = platform.PGURIFromEnvironment(config.PostgresConfig.Database)
+		if err != nil {
+			log.WithError(err).Error("Failed to get pg uri")
+			return config, err
+		}
+	}
 
 	// Fix any relative paths that might be in the config file
 	config.TLSConfig.FixupRelativeTLSPaths(viper.ConfigFileUsed())
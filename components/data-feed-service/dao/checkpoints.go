@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// Checkpoint records how far delivery to a destination has progressed. The
+// destination is identified by the id of the notifications rule defining it.
+// PendingSince is the start of the first window that failed to be delivered
+// since the last success, so windows are replayed from it even when nothing
+// was ever delivered.
+type Checkpoint struct {
+	ID                  string      `db:"id"`
+	Name                string      `db:"name"`
+	URL                 string      `db:"url"`
+	LastSuccessStart    pq.NullTime `db:"last_success_start"`
+	LastSuccessEnd      pq.NullTime `db:"last_success_end"`
+	LastAttempt         pq.NullTime `db:"last_attempt"`
+	ConsecutiveFailures int64       `db:"consecutive_failures"`
+	TotalFailures       int64       `db:"total_failures"`
+	LastError           string      `db:"last_error"`
+	PendingSince        pq.NullTime `db:"pending_since"`
+}
+
+const selectCheckpoint = `
+SELECT * FROM destination_checkpoints WHERE id = $1;`
+
+const selectCheckpoints = `
+SELECT * FROM destination_checkpoints ORDER BY name;`
+
+const upsertSuccess = `
+INSERT INTO destination_checkpoints (id, name, url, last_success_start, last_success_end, last_attempt)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE
+SET name = $2, url = $3, last_success_start = $4, last_success_end = $5, last_attempt = $6,
+    consecutive_failures = 0, last_error = '', pending_since = NULL;`
+
+const upsertFailure = `
+INSERT INTO destination_checkpoints (id, name, url, last_attempt, consecutive_failures, total_failures, last_error, pending_since)
+VALUES ($1, $2, $3, $4, 1, 1, $5, $6)
+ON CONFLICT (id) DO UPDATE
+SET name = $2, url = $3, last_attempt = $4,
+    consecutive_failures = destination_checkpoints.consecutive_failures + 1,
+    total_failures = destination_checkpoints.total_failures + 1,
+    last_error = $5,
+    pending_since = COALESCE(destination_checkpoints.pending_since, $6);`
+
+// GetCheckpoint returns the checkpoint of the destination, or nil if
+// nothing was ever delivered to it
+func (db *DB) GetCheckpoint(id string) (*Checkpoint, error) {
+	var checkpoint Checkpoint
+	err := db.SelectOne(&checkpoint, selectCheckpoint, id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetCheckpoint unable to select checkpoint for %s", id)
+	}
+	return &checkpoint, nil
+}
+
+// ListCheckpoints returns the checkpoints of all destinations
+func (db *DB) ListCheckpoints() ([]*Checkpoint, error) {
+	var checkpoints []*Checkpoint
+	_, err := db.Select(&checkpoints, selectCheckpoints)
+	if err != nil {
+		return nil, errors.Wrap(err, "ListCheckpoints unable to select checkpoints")
+	}
+	return checkpoints, nil
+}
+
+// RecordSuccess advances the checkpoint of the destination to the
+// delivered feed window
+func (db *DB) RecordSuccess(id, name, url string, windowStart, windowEnd time.Time) error {
+	_, err := db.Exec(upsertSuccess, id, name, url, windowStart, windowEnd, time.Now())
+	return errors.Wrapf(err, "RecordSuccess unable to update checkpoint for %s", id)
+}
+
+// RecordFailure counts a failed delivery, leaving the checkpoint in place so
+// the window is sent again. pendingSince is the start of the first window
+// that wasn't delivered; it is kept until the next success.
+func (db *DB) RecordFailure(id, name, url string, pendingSince time.Time, deliveryErr error) error {
+	_, err := db.Exec(upsertFailure, id, name, url, time.Now(), deliveryErr.Error(), pendingSince)
+	return errors.Wrapf(err, "RecordFailure unable to update checkpoint for %s", id)
+}
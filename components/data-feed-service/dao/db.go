@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"database/sql"
+
+	"github.com/chef/automate/components/data-feed-service/config"
+	"github.com/chef/automate/lib/db/migrator"
+	"github.com/go-gorp/gorp"
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DB is the data feed store, holding the delivery checkpoint of
+// every destination
+type DB struct {
+	*gorp.DbMap
+}
+
+// New connects to the database and runs the schema migrations
+func New(dbConf *config.PostgresConfig) (*DB, error) {
+	log.WithFields(log.Fields{
+		"uri": dbConf.URI,
+	}).Debug("Connecting to PostgreSQL")
+
+	sqlDB, err := sql.Open("postgres", dbConf.URI)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to open database")
+	}
+	if err := sqlDB.Ping(); err != nil {
+		return nil, errors.Wrap(err, "Failed to ping database")
+	}
+
+	if err := migrator.Migrate(dbConf.URI, dbConf.SchemaPath); err != nil {
+		return nil, errors.Wrapf(err, "Unable to create database schema. [path:%s]", dbConf.SchemaPath)
+	}
+
+	db := &DB{DbMap: &gorp.DbMap{Db: sqlDB, Dialect: gorp.PostgresDialect{}}}
+	db.AddTableWithName(Checkpoint{}, "destination_checkpoints").SetKeys(false, "id")
+	return db, nil
+}
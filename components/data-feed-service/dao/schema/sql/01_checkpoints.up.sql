@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS destination_checkpoints (
+  id                   TEXT PRIMARY KEY,
+  name                 TEXT NOT NULL DEFAULT '',
+  url                  TEXT NOT NULL DEFAULT '',
+  last_success_start   TIMESTAMPTZ,
+  last_success_end     TIMESTAMPTZ,
+  last_attempt         TIMESTAMPTZ,
+  consecutive_failures BIGINT NOT NULL DEFAULT 0,
+  total_failures       BIGINT NOT NULL DEFAULT 0,
+  last_error           TEXT NOT NULL DEFAULT ''
+);
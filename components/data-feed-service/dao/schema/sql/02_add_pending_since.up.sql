@@ -0,0 +1 @@
+ALTER TABLE destination_checkpoints ADD COLUMN IF NOT EXISTS pending_since TIMESTAMPTZ;
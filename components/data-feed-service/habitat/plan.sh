@@ -23,10 +23,15 @@ pkg_exposes=(
   port
 )
 pkg_binds=(
+  [automate-pg-gateway]="port"
+  [pg-sidecar-service]="port"
   [notifications-service]="port"
   [secrets-service]="port"
   [config-mgmt-service]="port"
 )
+pkg_binds_optional=(
+  [compliance-service]="port"
+)
 pkg_bin_dirs=(bin)
 pkg_scaffolding=chef/scaffolding-go
 scaffolding_go_base_path=github.com/chef
@@ -37,6 +42,14 @@ scaffolding_go_binary_list=(
   "${scaffolding_go_import_path}/cmd/${pkg_name}"
 )
 
+do_install() {
+  # Go scaffolding install callback
+  scaffolding_go_install
+
+  build_line "Copying schema sql files"
+  cp -r dao/schema/sql "${pkg_prefix}/schema"
+}
+
 do_strip() {
     return 0;
 }
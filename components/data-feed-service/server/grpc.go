@@ -6,7 +6,9 @@ import (
 
 	"fmt"
 
+	datafeed "github.com/chef/automate/api/interservice/data_feed"
 	"github.com/chef/automate/components/data-feed-service/config"
+	"github.com/chef/automate/components/data-feed-service/dao"
 	"github.com/chef/automate/lib/grpc/health"
 	"github.com/chef/automate/lib/grpc/secureconn"
 	"github.com/chef/automate/lib/tracing"
@@ -22,8 +24,13 @@ func NewGRPC(ctx context.Context, config *config.DataFeedConfig) *grpc.Server {
 
 	// Register our API
 	grpcServer := connFactory.NewServer(tracing.GlobalServerInterceptor())
-	srv := New(config)
+	db, err := dao.New(&config.PostgresConfig)
+	if err != nil {
+		log.WithError(err).Fatal("could not initialize data feed storage")
+	}
+	srv := New(config, db)
 	health.RegisterHealthServer(grpcServer, srv.health)
+	datafeed.RegisterDatafeedServiceServer(grpcServer, srv)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcServer)
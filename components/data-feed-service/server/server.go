@@ -1,25 +1,74 @@
 package server
 
 import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	datafeed "github.com/chef/automate/api/interservice/data_feed"
 	"github.com/chef/automate/components/data-feed-service/config"
+	"github.com/chef/automate/components/data-feed-service/dao"
 	"github.com/chef/automate/components/data-feed-service/service"
 	"github.com/chef/automate/lib/grpc/health"
-	log "github.com/sirupsen/logrus"
 )
 
 type Server struct {
 	cfg    *config.DataFeedConfig
 	health *health.Service
+	db     *dao.DB
 }
 
-func New(cfg *config.DataFeedConfig) *Server {
+func New(cfg *config.DataFeedConfig, db *dao.DB) *Server {
 
 	server := Server{
 		cfg:    cfg,
 		health: health.NewService(),
+		db:     db,
 	}
 	log.Debugf("data feed about to start polling service.")
-	go service.Start(cfg)
+	go service.Start(cfg, db)
 
 	return &server
 }
+
+// ListDestinationStatus reports the delivery checkpoint of every destination
+func (s *Server) ListDestinationStatus(ctx context.Context, in *datafeed.ListDestinationStatusRequest) (*datafeed.ListDestinationStatusResponse, error) {
+	checkpoints, err := s.db.ListCheckpoints()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &datafeed.ListDestinationStatusResponse{
+		Destinations: make([]*datafeed.DestinationStatus, len(checkpoints)),
+	}
+	for i, checkpoint := range checkpoints {
+		response.Destinations[i] = &datafeed.DestinationStatus{
+			Id:                  checkpoint.ID,
+			Name:                checkpoint.Name,
+			Url:                 checkpoint.URL,
+			LastSuccessStart:    toTimestamp(checkpoint.LastSuccessStart),
+			LastSuccessEnd:      toTimestamp(checkpoint.LastSuccessEnd),
+			LastAttempt:         toTimestamp(checkpoint.LastAttempt),
+			ConsecutiveFailures: checkpoint.ConsecutiveFailures,
+			TotalFailures:       checkpoint.TotalFailures,
+			LastError:           checkpoint.LastError,
+		}
+	}
+	return response, nil
+}
+
+func toTimestamp(t pq.NullTime) *tspb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	ts, err := ptypes.TimestampProto(t.Time)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chef/automate/components/compliance-service/api/reporting"
+)
+
+// controls with an impact at or above this are critical, as in compliance reporting
+const criticalImpact = 0.7
+
+// complianceSummary is the digest of the latest InSpec report of a node
+type complianceSummary struct {
+	ReportID               string           `json:"report_id"`
+	EndTime                string           `json:"end_time"`
+	Status                 string           `json:"status"`
+	Controls               controlCounts    `json:"controls"`
+	Profiles               []profileSummary `json:"profiles"`
+	FailedCriticalControls []failedControl  `json:"failed_critical_controls"`
+}
+
+type controlCounts struct {
+	Total          int `json:"total"`
+	Passed         int `json:"passed"`
+	Skipped        int `json:"skipped"`
+	Failed         int `json:"failed"`
+	FailedCritical int `json:"failed_critical"`
+}
+
+type profileSummary struct {
+	Name     string        `json:"name"`
+	Version  string        `json:"version"`
+	ID       string        `json:"id"`
+	Controls controlCounts `json:"controls"`
+}
+
+type failedControl struct {
+	ID      string  `json:"id"`
+	Title   string  `json:"title"`
+	Impact  float32 `json:"impact"`
+	Profile string  `json:"profile"`
+}
+
+// getComplianceSummary looks up the latest compliance report of the node,
+// which shares the node UUID with config management. The node carries the
+// control counts of its latest report; the report itself is only read when
+// those can't tell the counts of each profile or when controls failed
+// critically, to list them. Nodes that were never scanned have no summary.
+func getComplianceSummary(serviceClients *serviceClients, nodeId string) *complianceSummary {
+	if serviceClients.reporting == nil {
+		return nil
+	}
+
+	node, err := serviceClients.reporting.ReadNode(context.Background(), &reporting.Id{Id: nodeId})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		log.Errorf("Error getting compliance node %v: %v", nodeId, err)
+		return nil
+	}
+	summary := summarizeNode(node)
+	if summary == nil || !needsReport(summary) {
+		return summary
+	}
+
+	report, err := serviceClients.reporting.ReadReport(context.Background(), &reporting.Query{Id: summary.ReportID})
+	if err != nil {
+		log.Errorf("Error getting compliance report %v for node %v: %v", summary.ReportID, nodeId, err)
+		return summary
+	}
+	addReportDetails(summary, report)
+	return summary
+}
+
+// needsReport tells whether the summary of the node lacks the counts of its
+// profiles or the failed critical controls, which only the report has
+func needsReport(summary *complianceSummary) bool {
+	return len(summary.Profiles) > 1 || summary.Controls.FailedCritical > 0
+}
+
+func summarizeNode(node *reporting.Node) *complianceSummary {
+	report := node.GetLatestReport()
+	if report.GetId() == "" {
+		return nil
+	}
+
+	summary := &complianceSummary{
+		ReportID:               report.Id,
+		Status:                 report.Status,
+		Profiles:               make([]profileSummary, 0, len(node.Profiles)),
+		FailedCriticalControls: make([]failedControl, 0),
+	}
+	if report.EndTime != nil {
+		if endTime, err := ptypes.Timestamp(report.EndTime); err == nil {
+			summary.EndTime = endTime.Format("2006-01-02T15:04:05Z07:00")
+		}
+	}
+	if controls := report.Controls; controls != nil {
+		summary.Controls = controlCounts{
+			Total:          int(controls.Total),
+			Passed:         int(controls.GetPassed().GetTotal()),
+			Skipped:        int(controls.GetSkipped().GetTotal()),
+			Failed:         int(controls.GetFailed().GetTotal()),
+			FailedCritical: int(controls.GetFailed().GetCritical()),
+		}
+	}
+	for _, profile := range node.Profiles {
+		summary.Profiles = append(summary.Profiles, profileSummary{
+			Name:    profile.Name,
+			Version: profile.Version,
+			ID:      profile.Id,
+		})
+	}
+	// the controls of a single profile are all of the report's
+	if len(summary.Profiles) == 1 {
+		summary.Profiles[0].Controls = summary.Controls
+	}
+	return summary
+}
+
+// addReportDetails counts the controls of each profile of the report and
+// lists the ones that failed critically
+func addReportDetails(summary *complianceSummary, report *reporting.Report) {
+	counts := make(map[string]controlCounts, len(report.Profiles))
+	for _, profile := range report.Profiles {
+		var profileCounts controlCounts
+		for _, control := range profile.Controls {
+			profileCounts.add(control)
+			if controlStatus(control) == "failed" && control.Impact >= criticalImpact {
+				summary.FailedCriticalControls = append(summary.FailedCriticalControls, failedControl{
+					ID:      control.Id,
+					Title:   control.Title,
+					Impact:  control.Impact,
+					Profile: profile.Name,
+				})
+			}
+		}
+		counts[profile.Sha256] = profileCounts
+	}
+	for i := range summary.Profiles {
+		summary.Profiles[i].Controls = counts[summary.Profiles[i].ID]
+	}
+}
+
+func (c *controlCounts) add(control *reporting.Control) {
+	c.Total++
+	switch controlStatus(control) {
+	case "failed":
+		c.Failed++
+		if control.Impact >= criticalImpact {
+			c.FailedCritical++
+		}
+	case "skipped":
+		c.Skipped++
+	default:
+		c.Passed++
+	}
+}
+
+// controlStatus follows InSpec: a control fails if any of its results
+// failed and is skipped if all of its results were skipped
+func controlStatus(control *reporting.Control) string {
+	if len(control.Results) == 0 {
+		return "passed"
+	}
+	skipped := 0
+	for _, result := range control.Results {
+		switch result.Status {
+		case "failed":
+			return "failed"
+		case "skipped":
+			skipped++
+		}
+	}
+	if skipped == len(control.Results) {
+		return "skipped"
+	}
+	return "passed"
+}
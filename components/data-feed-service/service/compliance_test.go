@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chef/automate/components/compliance-service/api/reporting"
+)
+
+func testNode(t *testing.T) *reporting.Node {
+	endTime, err := ptypes.TimestampProto(time.Date(2019, 7, 1, 12, 30, 0, 0, time.UTC))
+	require.NoError(t, err)
+	return &reporting.Node{
+		Id: "node-1",
+		LatestReport: &reporting.LatestReportSummary{
+			Id:      "report-1",
+			EndTime: endTime,
+			Status:  "failed",
+			Controls: &reporting.ControlSummary{
+				Total:   10,
+				Passed:  &reporting.Total{Total: 5},
+				Skipped: &reporting.Total{Total: 2},
+				Failed:  &reporting.Failed{Total: 3, Minor: 1, Major: 1, Critical: 1},
+			},
+		},
+		Profiles: []*reporting.ProfileMeta{
+			{Name: "linux-baseline", Version: "2.2.0", Id: "sha-linux"},
+			{Name: "ssh-baseline", Version: "2.3.0", Id: "sha-ssh"},
+		},
+	}
+}
+
+func TestSummarizeNode(t *testing.T) {
+	assert.Equal(t, &complianceSummary{
+		ReportID: "report-1",
+		EndTime:  "2019-07-01T12:30:00Z",
+		Status:   "failed",
+		Controls: controlCounts{Total: 10, Passed: 5, Skipped: 2, Failed: 3, FailedCritical: 1},
+		Profiles: []profileSummary{
+			{Name: "linux-baseline", Version: "2.2.0", ID: "sha-linux"},
+			{Name: "ssh-baseline", Version: "2.3.0", ID: "sha-ssh"},
+		},
+		FailedCriticalControls: []failedControl{},
+	}, summarizeNode(testNode(t)))
+}
+
+func TestSummarizeNodeWithOneProfile(t *testing.T) {
+	node := testNode(t)
+	node.Profiles = node.Profiles[:1]
+	summary := summarizeNode(node)
+	require.NotNil(t, summary)
+	assert.Equal(t, []profileSummary{{
+		Name: "linux-baseline", Version: "2.2.0", ID: "sha-linux",
+		Controls: controlCounts{Total: 10, Passed: 5, Skipped: 2, Failed: 3, FailedCritical: 1},
+	}}, summary.Profiles)
+}
+
+func testReport() *reporting.Report {
+	results := func(statuses ...string) []*reporting.Result {
+		r := make([]*reporting.Result, 0, len(statuses))
+		for _, s := range statuses {
+			r = append(r, &reporting.Result{Status: s})
+		}
+		return r
+	}
+	return &reporting.Report{
+		Id: "report-1",
+		Profiles: []*reporting.Profile{
+			{Name: "linux-baseline", Sha256: "sha-linux", Controls: []*reporting.Control{
+				{Id: "os-01", Title: "Trusted hosts login", Impact: 1.0, Results: results("passed", "failed")},
+				{Id: "os-02", Title: "Check owner of shadow", Impact: 0.5, Results: results("failed")},
+				{Id: "os-03", Impact: 1.0, Results: results("passed")},
+				{Id: "os-04", Impact: 1.0, Results: results("skipped", "skipped")},
+				{Id: "os-05", Impact: 0.3},
+			}},
+			{Name: "ssh-baseline", Sha256: "sha-ssh", Controls: []*reporting.Control{
+				{Id: "ssh-01", Title: "Server: Use protocol 2", Impact: 0.7, Results: results("skipped", "failed")},
+				{Id: "ssh-02", Impact: 0.7, Results: results("passed", "skipped")},
+			}},
+		},
+	}
+}
+
+func TestAddReportDetails(t *testing.T) {
+	summary := summarizeNode(testNode(t))
+	require.NotNil(t, summary)
+	addReportDetails(summary, testReport())
+
+	assert.Equal(t, []profileSummary{
+		{Name: "linux-baseline", Version: "2.2.0", ID: "sha-linux",
+			Controls: controlCounts{Total: 5, Passed: 2, Skipped: 1, Failed: 2, FailedCritical: 1}},
+		{Name: "ssh-baseline", Version: "2.3.0", ID: "sha-ssh",
+			Controls: controlCounts{Total: 2, Passed: 1, Failed: 1, FailedCritical: 1}},
+	}, summary.Profiles)
+	assert.Equal(t, []failedControl{
+		{ID: "os-01", Title: "Trusted hosts login", Impact: 1.0, Profile: "linux-baseline"},
+		{ID: "ssh-01", Title: "Server: Use protocol 2", Impact: 0.7, Profile: "ssh-baseline"},
+	}, summary.FailedCriticalControls)
+}
+
+func TestSummarizeNodeWithoutReport(t *testing.T) {
+	assert.Nil(t, summarizeNode(&reporting.Node{Id: "node-1"}))
+	assert.Nil(t, summarizeNode(&reporting.Node{Id: "node-1", LatestReport: &reporting.LatestReportSummary{}}))
+}
+
+func TestSummarizeNodeWithoutControls(t *testing.T) {
+	node := testNode(t)
+	node.LatestReport.Controls = nil
+	summary := summarizeNode(node)
+	require.NotNil(t, summary)
+	assert.Equal(t, controlCounts{}, summary.Controls)
+}
+
+// fakeReportingClient serves ReadNode from nodes and ReadReport from
+// reports, and errors for other ids
+type fakeReportingClient struct {
+	reporting.ReportingServiceClient
+	nodes       map[string]*reporting.Node
+	reports     map[string]*reporting.Report
+	reportReads int
+	err         error
+}
+
+func (c *fakeReportingClient) ReadNode(ctx context.Context, in *reporting.Id, opts ...grpc.CallOption) (*reporting.Node, error) {
+	if node, ok := c.nodes[in.Id]; ok {
+		return node, nil
+	}
+	return nil, c.err
+}
+
+func (c *fakeReportingClient) ReadReport(ctx context.Context, in *reporting.Query, opts ...grpc.CallOption) (*reporting.Report, error) {
+	c.reportReads++
+	if report, ok := c.reports[in.Id]; ok {
+		return report, nil
+	}
+	return nil, c.err
+}
+
+func TestGetComplianceSummary(t *testing.T) {
+	clients := &serviceClients{reporting: &fakeReportingClient{
+		nodes:   map[string]*reporting.Node{"node-1": testNode(t)},
+		reports: map[string]*reporting.Report{"report-1": testReport()},
+		err:     status.Error(codes.NotFound, "not found"),
+	}}
+	summary := getComplianceSummary(clients, "node-1")
+	require.NotNil(t, summary)
+	assert.Equal(t, "report-1", summary.ReportID)
+	assert.Equal(t, 5, summary.Profiles[0].Controls.Total)
+	assert.Len(t, summary.FailedCriticalControls, 2)
+	assert.Nil(t, getComplianceSummary(clients, "node-2"))
+
+	clients = &serviceClients{reporting: &fakeReportingClient{err: errors.New("unavailable")}}
+	assert.Nil(t, getComplianceSummary(clients, "node-1"))
+
+	t.Run("keeps the node's summary if the report can't be read", func(t *testing.T) {
+		clients := &serviceClients{reporting: &fakeReportingClient{
+			nodes: map[string]*reporting.Node{"node-1": testNode(t)},
+			err:   errors.New("unavailable"),
+		}}
+		summary := getComplianceSummary(clients, "node-1")
+		require.NotNil(t, summary)
+		assert.Equal(t, 10, summary.Controls.Total)
+		assert.Empty(t, summary.FailedCriticalControls)
+	})
+
+	t.Run("reads no report if the node's summary has it all", func(t *testing.T) {
+		node := testNode(t)
+		node.Profiles = node.Profiles[:1]
+		node.LatestReport.Controls.Failed.Critical = 0
+		reportingClient := &fakeReportingClient{nodes: map[string]*reporting.Node{"node-1": node}}
+		summary := getComplianceSummary(&serviceClients{reporting: reportingClient}, "node-1")
+		require.NotNil(t, summary)
+		assert.Equal(t, 0, reportingClient.reportReads)
+		assert.Equal(t, 10, summary.Profiles[0].Controls.Total)
+	})
+
+	assert.Nil(t, getComplianceSummary(&serviceClients{}, "node-1"))
+}
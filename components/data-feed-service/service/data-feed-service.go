@@ -1,40 +1,38 @@
 package service
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"net/http"
+	"sync"
 	"time"
 
 	secrets "github.com/chef/automate/api/external/secrets"
 	cfgmgmtRequest "github.com/chef/automate/api/interservice/cfgmgmt/request"
 	cfgmgmtResponse "github.com/chef/automate/api/interservice/cfgmgmt/response"
 	cfgmgmt "github.com/chef/automate/api/interservice/cfgmgmt/service"
+	"github.com/chef/automate/components/compliance-service/api/reporting"
 	"github.com/chef/automate/components/data-feed-service/config"
+	"github.com/chef/automate/components/data-feed-service/dao"
 	notifications "github.com/chef/automate/components/notifications-client/api"
 	"github.com/chef/automate/lib/grpc/secureconn"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
 
-type assetNotification struct {
-	username   string
-	password   string
-	url        string
-	attributes []attributesMessage
-}
-
 type attributesMessage struct {
-	Automatic string               `json:"automatic"`
-	LastRun   *cfgmgmtResponse.Run `json:"last_run"`
+	Automatic  string               `json:"automatic"`
+	LastRun    *cfgmgmtResponse.Run `json:"last_run"`
+	Compliance *complianceSummary   `json:"compliance,omitempty"`
 }
 
 type serviceClients struct {
 	notifications notifications.NotificationsClient
 	cfgMgmt       cfgmgmt.CfgMgmtClient
 	secrets       secrets.SecretsServiceClient
+	reporting     reporting.ReportingServiceClient
 }
 
 func getConnection(connectionFactory *secureconn.Factory, service string, target string) *grpc.ClientConn {
@@ -59,10 +57,17 @@ func initServiceClients(dataFeedConfig *config.DataFeedConfig) *serviceClients {
 	connection = getConnection(connectionFactory, "secrets-service", dataFeedConfig.SecretsConfig.Target)
 	clients.secrets = secrets.NewSecretsServiceClient(connection)
 	log.Debugf("Secrets created")
+
+	// compliance is optional, without it the feed carries no report summaries
+	if dataFeedConfig.ComplianceConfig.Target != "" {
+		connection = getConnection(connectionFactory, "compliance-service", dataFeedConfig.ComplianceConfig.Target)
+		clients.reporting = reporting.NewReportingServiceClient(connection)
+		log.Debugf("ReportingServiceClient created")
+	}
 	return clients
 }
 
-func Start(dataFeedConfig *config.DataFeedConfig) {
+func Start(dataFeedConfig *config.DataFeedConfig, db *dao.DB) {
 	log.Debugf("data-feed-service start")
 
 	serviceClients := initServiceClients(dataFeedConfig)
@@ -97,29 +102,8 @@ func Start(dataFeedConfig *config.DataFeedConfig) {
 		}
 		now := time.Now()
 		feedStartTime, feedEndTime := getFeedTimes(dataFeedConfig, now)
-		if len(assetRules) > 0 {
-			attributes := buildDatafeed(serviceClients, dataFeedConfig, feedStartTime, feedEndTime)
-			if len(attributes) > 0 {
-				for rule := range assetRules {
-					log.Debugf("Rule id %v", assetRules[rule].Id)
-					log.Debugf("Rule Name %v", assetRules[rule].Name)
-					log.Debugf("Rule Event %v", assetRules[rule].Event)
-					log.Debugf("Rule Action %v", assetRules[rule].Action)
-					switch action := rules[rule].Action.(type) {
-					case *notifications.Rule_ServiceNowAlert:
-						log.Debugf("Service now alert URL  %v", action.ServiceNowAlert.Url)
-						log.Debugf("Service now alert secret  %v", action.ServiceNowAlert.SecretId)
-						username, password, err := getCredentials(serviceClients, dataFeedConfig, action.ServiceNowAlert.SecretId)
-						if err != nil {
-							log.Errorf("Error retrieving credentials, cannot send asset notification: %v", err)
-						} else {
-							dataFeedNotification := &assetNotification{username: username, password: password, url: action.ServiceNowAlert.Url, attributes: attributes}
-							sendNotification(dataFeedConfig, dataFeedNotification)
-						}
-					}
-				}
-			}
-		}
+		current := feedWindow{start: feedStartTime, end: feedEndTime}
+		deliver(serviceClients, dataFeedConfig, db, assetRules, current)
 
 		waitForInterval(dataFeedConfig.ServiceConfig.FeedInterval, feedEndTime, now)
 	}
@@ -179,99 +163,148 @@ func getFeedEndTime(feedInterval time.Duration, now time.Time) time.Time {
 	return feedEndTime
 }
 
-func getCredentials(serviceClients *serviceClients, dataFeedConfig *config.DataFeedConfig, secretId string) (string, string, error) {
+// buildDatafeed streams the feed for the window to send in batches of at
+// most MaxBatchSize messages. Only nodes that checked in during the window are
+// paged through, and their attributes, runs and reports are fetched with at
+// most AttributesConcurrency requests in flight, so neither the service nor
+// config-mgmt holds the whole fleet at once.
+func buildDatafeed(serviceClients *serviceClients, dataFeedConfig *config.DataFeedConfig, window feedWindow, send func(*feedBatch) error) error {
+	log.Info("Building data feed...")
+	serviceConfig := dataFeedConfig.ServiceConfig
+
+	start, err := ptypes.TimestampProto(window.start)
+	if err != nil {
+		return err
+	}
+	end, err := ptypes.TimestampProto(window.end)
+	if err != nil {
+		return err
+	}
 
-	secret, err := serviceClients.secrets.Read(context.Background(), &secrets.Id{Id: secretId})
+	batch := &feedBatch{feedStart: window.start, feedEnd: window.end}
+	flush := func() error {
+		if len(batch.messages) == 0 {
+			return nil
+		}
+		log.Debugf("Sending batch %v with %v node attribute messages", batch.sequence, len(batch.messages))
+		if err := send(batch); err != nil {
+			return err
+		}
+		batch = &feedBatch{feedStart: window.start, feedEnd: window.end, sequence: batch.sequence + 1}
+		return nil
+	}
 
-	username := ""
-	password := ""
-	if err == nil {
-		data := secret.GetData()
+	total := 0
+	request := &cfgmgmtRequest.InventoryNodes{
+		Start:    start,
+		End:      end,
+		PageSize: int32(serviceConfig.NodePageSize),
+	}
+	for {
+		page, err := serviceClients.cfgMgmt.GetInventoryNodes(context.Background(), request)
+		if err != nil {
+			return errors.Wrap(err, "getting cfgmgmt inventory nodes")
+		}
+		nodes := page.GetNodes()
 
-		for kv := range data {
-			if data[kv].Key == "username" {
-				username = data[kv].Value
-			} else if data[kv].Key == "password" {
-				password = data[kv].Value
+		messages, err := getNodeMessages(serviceClients, window, nodes, serviceConfig.AttributesConcurrency)
+		if err != nil {
+			return err
+		}
+		for _, message := range messages {
+			batch.messages = append(batch.messages, *message)
+			total++
+			if len(batch.messages) >= serviceConfig.MaxBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
 			}
 		}
+
+		if len(nodes) < serviceConfig.NodePageSize {
+			break
+		}
+		last := nodes[len(nodes)-1]
+		request.CursorDate = last.Checkin
+		request.CursorId = last.Id
 	}
 
-	return username, password, err
+	log.Debugf("%v node attribute messages retrieved in interval", total)
+	return flush()
 }
 
-func sendNotification(dataFeedConfig *config.DataFeedConfig, notification *assetNotification) {
+// getNodeMessages fetches the messages for a page of nodes with at most
+// concurrency requests in flight, keeping the order of the page. Nodes whose
+// attributes carry no ohai run inside the window are left out. Failing to
+// read a node fails the whole page, so the window is retried rather than
+// delivered without it.
+func getNodeMessages(serviceClients *serviceClients, window feedWindow, nodes []*cfgmgmtResponse.InventoryNode, concurrency int) ([]*attributesMessage, error) {
+	messages := make([]*attributesMessage, len(nodes))
+	errs := make([]error, len(nodes))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, node *cfgmgmtResponse.InventoryNode) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			messages[i], errs[i] = getNodeMessage(serviceClients, window, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	found := make([]*attributesMessage, 0, len(messages))
+	for i, message := range messages {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if message != nil {
+			found = append(found, message)
+		}
+	}
+	return found, nil
+}
 
-	messageBytes, err := json.Marshal(notification.attributes)
-	log.Debugf("sendNotification bytes length %v", len(messageBytes))
+func getNodeMessage(serviceClients *serviceClients, window feedWindow, node *cfgmgmtResponse.InventoryNode) (*attributesMessage, error) {
+	feedStart := float64(window.start.Unix())
+	feedEnd := float64(window.end.Unix())
 
+	nodeAttributes, err := serviceClients.cfgMgmt.GetAttributes(context.Background(), &cfgmgmtRequest.Node{NodeId: node.Id})
 	if err != nil {
-		log.Errorf("Error creating json bytes %v", err)
+		return nil, errors.Wrapf(err, "getting attributes of node %s", node.Id)
 	}
-	request, err := http.NewRequest("POST", notification.url, bytes.NewBuffer(messageBytes))
+	var automaticJson map[string]interface{}
+	err = json.Unmarshal([]byte(nodeAttributes.Automatic), &automaticJson)
 	if err != nil {
-		log.Error("Error creating request")
+		// retrying won't fix attributes that are not json
+		log.Errorf("Could not parse automatic attributes of node %v from json: %v", node.Id, err)
+		return nil, nil
 	}
-	request.SetBasicAuth(notification.username, notification.password)
-	request.Header.Add("Content-Type", "application/json")
-	request.Header.Add("Accept", "application/json")
-	log.Debugf("request %v", request)
-
-	client := http.Client{}
-	response, err := client.Do(request)
-	if err != nil {
-		log.Errorf("Error sending test message %v", err)
-	} else {
-		log.Infof("Asset data posted to %v, Status %v", notification.url, response.Status)
+	ohaiTime, ok := automaticJson["ohai_time"].(float64)
+	if !ok {
+		log.Errorf("No ohai_time in automatic attributes of node %v", node.Id)
+		return nil, nil
+	}
+	log.Debugf("feedStartTime %v, feedEndTime %v, ohai_time %v", feedStart, feedEnd, ohaiTime)
+	if ohaiTime <= feedStart || ohaiTime >= feedEnd {
+		return nil, nil
 	}
-}
-
-func buildDatafeed(serviceClients *serviceClients, dataFeedConfig *config.DataFeedConfig, feedStartTime time.Time, feedEndTime time.Time) []attributesMessage {
-
-	feedStart := float64(feedStartTime.Unix())
-	feedEnd := float64(feedEndTime.Unix())
-	log.Info("Building data feed...")
 
-	nodeFilters := &cfgmgmtRequest.Nodes{}
-	nodes, err := serviceClients.cfgMgmt.GetNodes(context.Background(), nodeFilters)
+	// get the latest node run
+	lastRun, err := serviceClients.cfgMgmt.GetNodeRun(context.Background(), &cfgmgmtRequest.NodeRun{NodeId: node.Id, RunId: node.LatestRunId})
 	if err != nil {
-		log.Errorf("Error getting cfgmgmt/nodes %v", err)
+		return nil, errors.Wrapf(err, "getting run %s of node %s", node.LatestRunId, node.Id)
 	}
+	log.Debugf("Last run\n %v", lastRun)
 
-	messages := make([]attributesMessage, 0)
-
-	for _, node := range nodes.Values {
-		nodeStruct := node.GetStructValue()
-		id := nodeStruct.Fields["id"].GetStringValue()
-		lastRunId := nodeStruct.Fields["latest_run_id"].GetStringValue()
-		nodeAttributes, err := serviceClients.cfgMgmt.GetAttributes(context.Background(), &cfgmgmtRequest.Node{NodeId: id})
-		if err != nil {
-			log.Errorf("Error getting attributes %v", err)
-		}
-		var automaticJson map[string]interface{}
-		err = json.Unmarshal([]byte(nodeAttributes.Automatic), &automaticJson)
-		if err != nil {
-			log.Errorf("Could not parse automatic attributes from json: %v", err)
-			// continue to the next node
-			continue
-		}
-		ohaiTime := automaticJson["ohai_time"].(float64)
-		log.Debugf("feedStartTime %v, feedEndTime %v, ohai_time %v", feedStart, feedEnd, ohaiTime)
-		if ohaiTime > feedStart && ohaiTime < feedEnd {
-			// get the latest node run
-			lastRun, error := serviceClients.cfgMgmt.GetNodeRun(context.Background(), &cfgmgmtRequest.NodeRun{NodeId: id, RunId: lastRunId})
-			// check time? for paranoia's sake?
-			if error != nil {
-				log.Errorf("Error getting node run %v", error)
-			} else {
-				log.Debugf("Last run\n %v", lastRun)
-			}
-
-			message := attributesMessage{Automatic: nodeAttributes.Automatic, LastRun: lastRun}
-			log.Debugf("Message: %v", message)
-			messages = append(messages, message)
-		}
+	message := &attributesMessage{
+		Automatic:  nodeAttributes.Automatic,
+		LastRun:    lastRun,
+		Compliance: getComplianceSummary(serviceClients, node.Id),
 	}
-	log.Debugf("%v node attribute messages retrieved in interval", len(messages))
-	return messages
+	log.Debugf("Message: %v", message)
+	return message, nil
 }
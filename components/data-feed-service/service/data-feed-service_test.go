@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	cfgmgmtRequest "github.com/chef/automate/api/interservice/cfgmgmt/request"
+	cfgmgmtResponse "github.com/chef/automate/api/interservice/cfgmgmt/response"
+	cfgmgmt "github.com/chef/automate/api/interservice/cfgmgmt/service"
+	"github.com/chef/automate/components/data-feed-service/config"
+)
+
+// automaticAttributes returns automatic attributes with an ohai run the given
+// number of seconds after the start of the current window
+func automaticAttributes(nodeId string, offset int64) string {
+	return fmt.Sprintf(`{"fqdn":%q,"ohai_time":%d}`, nodeId, current.start.Unix()+offset)
+}
+
+// expectNode sets up config-mgmt to return the node, with its ohai run the
+// given number of seconds after the start of the current window
+func expectNode(client *cfgmgmt.MockCfgMgmtClient, nodeId string, offset int64) {
+	client.EXPECT().GetAttributes(gomock.Any(), &cfgmgmtRequest.Node{NodeId: nodeId}).
+		Return(&cfgmgmtResponse.NodeAttribute{NodeId: nodeId, Automatic: automaticAttributes(nodeId, offset)}, nil)
+	if offset > 0 && offset < int64(hour.Seconds()) {
+		client.EXPECT().GetNodeRun(gomock.Any(), &cfgmgmtRequest.NodeRun{NodeId: nodeId, RunId: "run-" + nodeId}).
+			Return(&cfgmgmtResponse.Run{Id: "run-" + nodeId}, nil)
+	}
+}
+
+func inventoryNodes(ids ...string) []*cfgmgmtResponse.InventoryNode {
+	nodes := make([]*cfgmgmtResponse.InventoryNode, 0, len(ids))
+	for _, id := range ids {
+		nodes = append(nodes, &cfgmgmtResponse.InventoryNode{Id: id, LatestRunId: "run-" + id})
+	}
+	return nodes
+}
+
+func TestGetNodeMessagesKeepsNodesRunInWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	expectNode(client, "node-1", 60)
+	expectNode(client, "node-2", -60)
+	expectNode(client, "node-3", 120)
+
+	messages, err := getNodeMessages(&serviceClients{cfgMgmt: client}, current, inventoryNodes("node-1", "node-2", "node-3"), 2)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, automaticAttributes("node-1", 60), messages[0].Automatic)
+	assert.Equal(t, "run-node-1", messages[0].LastRun.Id)
+	assert.Equal(t, automaticAttributes("node-3", 120), messages[1].Automatic)
+}
+
+func TestGetNodeMessagesFailsWhenAttributesCantBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	expectNode(client, "node-1", 60)
+	client.EXPECT().GetAttributes(gomock.Any(), &cfgmgmtRequest.Node{NodeId: "node-2"}).
+		Return(nil, errors.New("unavailable"))
+
+	_, err := getNodeMessages(&serviceClients{cfgMgmt: client}, current, inventoryNodes("node-1", "node-2"), 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "node-2")
+}
+
+func TestGetNodeMessagesFailsWhenRunCantBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	client.EXPECT().GetAttributes(gomock.Any(), gomock.Any()).
+		Return(&cfgmgmtResponse.NodeAttribute{NodeId: "node-1", Automatic: automaticAttributes("node-1", 60)}, nil)
+	client.EXPECT().GetNodeRun(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	_, err := getNodeMessages(&serviceClients{cfgMgmt: client}, current, inventoryNodes("node-1"), 1)
+	assert.Error(t, err)
+}
+
+func TestGetNodeMessagesSkipsUnparsableAttributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	client.EXPECT().GetAttributes(gomock.Any(), gomock.Any()).
+		Return(&cfgmgmtResponse.NodeAttribute{NodeId: "node-1", Automatic: "not json"}, nil)
+
+	messages, err := getNodeMessages(&serviceClients{cfgMgmt: client}, current, inventoryNodes("node-1"), 1)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func testConfig(pageSize, batchSize int) *config.DataFeedConfig {
+	return &config.DataFeedConfig{ServiceConfig: config.ServiceConfig{
+		FeedInterval:          hour,
+		NodePageSize:          pageSize,
+		AttributesConcurrency: 2,
+		MaxBatchSize:          batchSize,
+	}}
+}
+
+// expectPages sets up config-mgmt to page through the nodes, checking each
+// request continues from the last node of the previous page
+func expectPages(client *cfgmgmt.MockCfgMgmtClient, pages ...[]*cfgmgmtResponse.InventoryNode) {
+	calls := make([]*gomock.Call, 0, len(pages))
+	cursor := ""
+	for _, page := range pages {
+		page, expectedCursor := page, cursor
+		calls = append(calls, client.EXPECT().GetInventoryNodes(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, in *cfgmgmtRequest.InventoryNodes, opts ...grpc.CallOption) (*cfgmgmtResponse.InventoryNodes, error) {
+				if in.CursorId != expectedCursor {
+					return nil, fmt.Errorf("expected cursor %q, got %q", expectedCursor, in.CursorId)
+				}
+				return &cfgmgmtResponse.InventoryNodes{Nodes: page}, nil
+			}))
+		if len(page) > 0 {
+			cursor = page[len(page)-1].Id
+		}
+	}
+	gomock.InOrder(calls...)
+}
+
+// collectBatches returns a send func recording the node ids of every batch
+func collectBatches(batches *[][]string, sequences *[]int) func(*feedBatch) error {
+	return func(batch *feedBatch) error {
+		ids := make([]string, 0, len(batch.messages))
+		for _, message := range batch.messages {
+			ids = append(ids, message.LastRun.Id)
+		}
+		*batches = append(*batches, ids)
+		*sequences = append(*sequences, batch.sequence)
+		return nil
+	}
+}
+
+func TestBuildDatafeedPagesAndBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	expectPages(client,
+		inventoryNodes("node-1", "node-2", "node-3"),
+		inventoryNodes("node-4", "node-5", "node-6"),
+		inventoryNodes("node-7"))
+	for i := 1; i <= 7; i++ {
+		expectNode(client, fmt.Sprintf("node-%d", i), int64(60*i))
+	}
+
+	var batches [][]string
+	var sequences []int
+	err := buildDatafeed(&serviceClients{cfgMgmt: client}, testConfig(3, 2), current, collectBatches(&batches, &sequences))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"run-node-1", "run-node-2"},
+		{"run-node-3", "run-node-4"},
+		{"run-node-5", "run-node-6"},
+		{"run-node-7"},
+	}, batches)
+	assert.Equal(t, []int{0, 1, 2, 3}, sequences)
+}
+
+func TestBuildDatafeedStopsOnFullLastPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	expectPages(client, inventoryNodes("node-1", "node-2"), inventoryNodes())
+	expectNode(client, "node-1", 60)
+	expectNode(client, "node-2", 120)
+
+	var batches [][]string
+	var sequences []int
+	err := buildDatafeed(&serviceClients{cfgMgmt: client}, testConfig(2, 10), current, collectBatches(&batches, &sequences))
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"run-node-1", "run-node-2"}}, batches)
+}
+
+func TestBuildDatafeedSendsNothingWithoutNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	expectPages(client, inventoryNodes())
+
+	var batches [][]string
+	var sequences []int
+	err := buildDatafeed(&serviceClients{cfgMgmt: client}, testConfig(2, 10), current, collectBatches(&batches, &sequences))
+	require.NoError(t, err)
+	assert.Empty(t, batches)
+}
+
+func TestBuildDatafeedFailsWhenSendFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	expectPages(client, inventoryNodes("node-1", "node-2", "node-3"))
+	for i := 1; i <= 3; i++ {
+		expectNode(client, fmt.Sprintf("node-%d", i), int64(60*i))
+	}
+
+	sends := 0
+	err := buildDatafeed(&serviceClients{cfgMgmt: client}, testConfig(10, 1), current, func(*feedBatch) error {
+		sends++
+		return errors.New("no destination accepted the asset data")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, sends)
+}
+
+func TestBuildDatafeedFailsWhenPageCantBeRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := cfgmgmt.NewMockCfgMgmtClient(ctrl)
+	client.EXPECT().GetInventoryNodes(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	err := buildDatafeed(&serviceClients{cfgMgmt: client}, testConfig(10, 10), current, func(*feedBatch) error {
+		t.Fatal("nothing should be sent")
+		return nil
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/chef/automate/components/data-feed-service/config"
+	"github.com/chef/automate/components/data-feed-service/dao"
+	notifications "github.com/chef/automate/components/notifications-client/api"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+type feedWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// key identifies a window by its unix times, as checkpoints read back from
+// the database carry a different location than the computed windows
+func (w feedWindow) key() [2]int64 {
+	return [2]int64{w.start.Unix(), w.end.Unix()}
+}
+
+// target is the destination of one rule along with the windows it still has
+// to be sent
+type target struct {
+	rule    *notifications.Rule
+	dest    destination
+	windows []feedWindow
+	failed  bool
+}
+
+// pendingWindows returns the feed windows the destination has not accepted
+// yet, oldest first. They start at the end of the last delivered window or,
+// if nothing was ever delivered, at the first window that failed. Without
+// either only the current window is sent; windows ending more than maxReplay
+// ago are dropped.
+func pendingWindows(checkpoint *dao.Checkpoint, current feedWindow, interval time.Duration, maxReplay time.Duration) []feedWindow {
+	var start time.Time
+	switch {
+	case checkpoint == nil:
+		return []feedWindow{current}
+	case checkpoint.LastSuccessEnd.Valid:
+		start = checkpoint.LastSuccessEnd.Time
+	case checkpoint.PendingSince.Valid:
+		start = checkpoint.PendingSince.Time
+	default:
+		return []feedWindow{current}
+	}
+
+	oldest := current.end.Add(-maxReplay)
+	if start.Before(oldest) {
+		log.Warnf("Skipping feed windows between %v and %v, older than max replay %v", start, oldest, maxReplay)
+		start = oldest
+	}
+
+	windows := make([]feedWindow, 0)
+	for start.Before(current.end) {
+		end := start.Add(interval)
+		if end.After(current.end) {
+			end = current.end
+		}
+		windows = append(windows, feedWindow{start: start, end: end})
+		start = end
+	}
+	return windows
+}
+
+// deliver sends every pending window to the destinations of the rules. Each
+// window is built once and streamed, batch by batch, to all the destinations
+// waiting for it. A destination that fails a batch is dropped for the rest of
+// the interval so its windows are retried, in order, on the next one.
+func deliver(serviceClients *serviceClients, dataFeedConfig *config.DataFeedConfig, db *dao.DB, rules []notifications.Rule, current feedWindow) {
+	serviceConfig := dataFeedConfig.ServiceConfig
+
+	windows := make(map[[2]int64]feedWindow)
+	targets := make([]*target, 0, len(rules))
+	for i := range rules {
+		rule := &rules[i]
+		log.Debugf("Rule id %v", rule.Id)
+		log.Debugf("Rule Name %v", rule.Name)
+		log.Debugf("Rule Event %v", rule.Event)
+		log.Debugf("Rule Action %v", rule.Action)
+
+		dest, err := newDestination(serviceClients, rule)
+		if err != nil {
+			// count it against the checkpoint so the windows are replayed
+			// once the destination, or its secret, can be read again
+			log.Errorf("Error creating destination for rule %v, cannot send asset notification: %v", rule.Name, err)
+			err = errors.Wrap(err, "creating destination")
+			if err := db.RecordFailure(rule.Id, rule.Name, ruleURL(rule), current.start, err); err != nil {
+				log.Error(err)
+			}
+			continue
+		}
+
+		checkpoint, err := db.GetCheckpoint(rule.Id)
+		if err != nil {
+			log.Errorf("Error reading checkpoint for rule %v: %v", rule.Name, err)
+			continue
+		}
+
+		t := &target{rule: rule, dest: dest}
+		t.windows = pendingWindows(checkpoint, current, serviceConfig.FeedInterval, serviceConfig.MaxReplay)
+		for _, window := range t.windows {
+			windows[window.key()] = window
+		}
+		targets = append(targets, t)
+	}
+
+	ordered := make([]feedWindow, 0, len(windows))
+	for _, window := range windows {
+		ordered = append(ordered, window)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].start.Equal(ordered[j].start) {
+			return ordered[i].end.Before(ordered[j].end)
+		}
+		return ordered[i].start.Before(ordered[j].start)
+	})
+
+	for _, window := range ordered {
+		waiting := make([]*target, 0, len(targets))
+		for _, t := range targets {
+			if !t.failed && t.waitingFor(window) {
+				waiting = append(waiting, t)
+			}
+		}
+		if len(waiting) > 0 {
+			deliverWindow(serviceClients, dataFeedConfig, db, window, waiting)
+		}
+	}
+}
+
+// deliverWindow streams the window to the targets, recording a checkpoint for
+// those that accepted every batch and a failure for the others
+func deliverWindow(serviceClients *serviceClients, dataFeedConfig *config.DataFeedConfig, db *dao.DB, window feedWindow, targets []*target) {
+	serviceConfig := dataFeedConfig.ServiceConfig
+
+	send := func(batch *feedBatch) error {
+		sent := 0
+		for _, t := range targets {
+			if t.failed {
+				continue
+			}
+			err := sendWithRetry(context.Background(), t.dest, batch, serviceConfig.RetryAttempts, serviceConfig.RetryBackoff)
+			if err != nil {
+				t.fail(db, errors.Wrapf(err, "sending asset data to %v", t.dest.url()))
+				continue
+			}
+			sent++
+		}
+		if sent == 0 {
+			return errors.New("no destination accepted the asset data")
+		}
+		return nil
+	}
+
+	if err := buildDatafeed(serviceClients, dataFeedConfig, window, send); err != nil {
+		for _, t := range targets {
+			if !t.failed {
+				t.fail(db, errors.Wrap(err, "building data feed"))
+			}
+		}
+		return
+	}
+
+	for _, t := range targets {
+		if t.failed {
+			continue
+		}
+		if err := db.RecordSuccess(t.rule.Id, t.rule.Name, t.dest.url(), window.start, window.end); err != nil {
+			// the window was delivered; it will be sent again if the
+			// checkpoint can't be stored, which is the safer outcome
+			log.Error(err)
+			t.failed = true
+		}
+	}
+}
+
+func (t *target) waitingFor(window feedWindow) bool {
+	for _, w := range t.windows {
+		if w.key() == window.key() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *target) fail(db *dao.DB, err error) {
+	log.Errorf("Error delivering asset data for rule %v: %v", t.rule.Name, err)
+	t.failed = true
+	if err := db.RecordFailure(t.rule.Id, t.rule.Name, t.dest.url(), t.pendingSince(), err); err != nil {
+		log.Error(err)
+	}
+}
+
+// pendingSince is the start of the oldest window the target was waiting for.
+// It is only replayed from while nothing was delivered, when that window is
+// the one that failed.
+func (t *target) pendingSince() time.Time {
+	return t.windows[0].start
+}
+
+// sendWithRetry makes up to attempts tries to send the batch, doubling the
+// wait between tries starting at backoff
+func sendWithRetry(ctx context.Context, dest destination, batch *feedBatch, attempts int, backoff time.Duration) error {
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = dest.send(ctx, batch)
+		if err == nil {
+			return nil
+		}
+		if attempt < attempts {
+			log.Warnf("Sending asset data to %v failed on attempt %v, retrying in %v: %v", dest.url(), attempt, backoff, err)
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	return err
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/data-feed-service/dao"
+)
+
+var (
+	hour    = time.Hour
+	current = feedWindow{
+		start: time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC),
+		end:   time.Date(2019, 7, 1, 13, 0, 0, 0, time.UTC),
+	}
+)
+
+func checkpointAt(end time.Time) *dao.Checkpoint {
+	return &dao.Checkpoint{LastSuccessEnd: pq.NullTime{Time: end, Valid: true}}
+}
+
+func TestPendingWindowsWithoutCheckpointIsCurrentWindow(t *testing.T) {
+	assert.Equal(t, []feedWindow{current}, pendingWindows(nil, current, hour, 24*hour))
+	assert.Equal(t, []feedWindow{current}, pendingWindows(&dao.Checkpoint{}, current, hour, 24*hour))
+}
+
+func TestPendingWindowsUpToDateCheckpoint(t *testing.T) {
+	assert.Empty(t, pendingWindows(checkpointAt(current.end), current, hour, 24*hour))
+}
+
+func TestPendingWindowsReplaysMissedWindowsOldestFirst(t *testing.T) {
+	windows := pendingWindows(checkpointAt(current.end.Add(-3*hour)), current, hour, 24*hour)
+	assert.Equal(t, []feedWindow{
+		{start: current.end.Add(-3 * hour), end: current.end.Add(-2 * hour)},
+		{start: current.end.Add(-2 * hour), end: current.end.Add(-1 * hour)},
+		current,
+	}, windows)
+}
+
+func TestPendingWindowsDropsWindowsOlderThanMaxReplay(t *testing.T) {
+	windows := pendingWindows(checkpointAt(current.end.Add(-48*hour)), current, hour, 2*hour)
+	assert.Equal(t, []feedWindow{
+		{start: current.end.Add(-2 * hour), end: current.end.Add(-1 * hour)},
+		current,
+	}, windows)
+}
+
+func TestPendingWindowsTruncatesPartialWindow(t *testing.T) {
+	// a checkpoint off the interval boundary, e.g. after the interval changed
+	windows := pendingWindows(checkpointAt(current.end.Add(-90*time.Minute)), current, hour, 24*hour)
+	assert.Equal(t, []feedWindow{
+		{start: current.end.Add(-90 * time.Minute), end: current.end.Add(-30 * time.Minute)},
+		{start: current.end.Add(-30 * time.Minute), end: current.end},
+	}, windows)
+}
+
+func TestPendingWindowsReplaysFromFirstFailureWithoutSuccess(t *testing.T) {
+	// the first delivery failed; nothing was delivered since
+	previous := feedWindow{start: current.start.Add(-hour), end: current.start}
+	first := &target{windows: pendingWindows(nil, previous, hour, 24*hour)}
+	require.Equal(t, []feedWindow{previous}, first.windows)
+
+	checkpoint := &dao.Checkpoint{PendingSince: pq.NullTime{Time: first.pendingSince(), Valid: true}}
+	assert.Equal(t, []feedWindow{previous, current}, pendingWindows(checkpoint, current, hour, 24*hour))
+}
+
+func TestPendingWindowsBoundsReplayFromFirstFailure(t *testing.T) {
+	checkpoint := &dao.Checkpoint{PendingSince: pq.NullTime{Time: current.end.Add(-48 * hour), Valid: true}}
+	assert.Equal(t, []feedWindow{
+		{start: current.end.Add(-2 * hour), end: current.end.Add(-1 * hour)},
+		current,
+	}, pendingWindows(checkpoint, current, hour, 2*hour))
+}
+
+func TestPendingWindowsPrefersLastSuccessOverFirstFailure(t *testing.T) {
+	checkpoint := checkpointAt(current.start)
+	checkpoint.PendingSince = pq.NullTime{Time: current.end.Add(-5 * hour), Valid: true}
+	assert.Equal(t, []feedWindow{current}, pendingWindows(checkpoint, current, hour, 24*hour))
+}
+
+// fakeDestination fails the first failures sends
+type fakeDestination struct {
+	failures int
+	sends    int
+}
+
+func (d *fakeDestination) send(ctx context.Context, batch *feedBatch) error {
+	d.sends++
+	if d.sends <= d.failures {
+		return errors.New("destination unavailable")
+	}
+	return nil
+}
+
+func (d *fakeDestination) url() string {
+	return "https://fake.example.com"
+}
+
+func TestSendWithRetrySucceedsFirstTime(t *testing.T) {
+	dest := &fakeDestination{}
+	require.NoError(t, sendWithRetry(context.Background(), dest, testBatch(), 3, time.Millisecond))
+	assert.Equal(t, 1, dest.sends)
+}
+
+func TestSendWithRetryRetriesFailedSends(t *testing.T) {
+	dest := &fakeDestination{failures: 2}
+	require.NoError(t, sendWithRetry(context.Background(), dest, testBatch(), 3, time.Millisecond))
+	assert.Equal(t, 3, dest.sends)
+}
+
+func TestSendWithRetryGivesUpAfterAttempts(t *testing.T) {
+	dest := &fakeDestination{failures: 5}
+	err := sendWithRetry(context.Background(), dest, testBatch(), 3, time.Millisecond)
+	assert.EqualError(t, err, "destination unavailable")
+	assert.Equal(t, 3, dest.sends)
+}
+
+func TestSendWithRetryBacksOff(t *testing.T) {
+	dest := &fakeDestination{failures: 2}
+	start := time.Now()
+	require.NoError(t, sendWithRetry(context.Background(), dest, testBatch(), 3, 10*time.Millisecond))
+	// 10ms after the first attempt, 20ms after the second
+	assert.True(t, time.Since(start) >= 30*time.Millisecond)
+}
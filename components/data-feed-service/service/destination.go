@@ -0,0 +1,146 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	secrets "github.com/chef/automate/api/external/secrets"
+	notifications "github.com/chef/automate/components/notifications-client/api"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Keys read from the secret referenced by a data feed rule
+const (
+	secretUsername        = "username"
+	secretPassword        = "password"
+	secretToken           = "token"
+	secretHeaderName      = "header_name"
+	secretHeaderValue     = "header_value"
+	secretHMACKey         = "hmac_key"
+	secretSplunkIndex     = "index"
+	secretAccessKeyID     = "AWS_ACCESS_KEY_ID"
+	secretSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+	secretRegion          = "region"
+)
+
+// feedBatch is the unit of data handed to a destination: a chunk of the
+// messages for the nodes that checked in during the feed window. Large windows
+// are sent as several batches numbered by sequence, starting at 0.
+type feedBatch struct {
+	feedStart time.Time
+	feedEnd   time.Time
+	sequence  int
+	messages  []attributesMessage
+}
+
+// destination is an external system the asset data feed is delivered to.
+// Each notifications Assets rule maps to exactly one destination.
+type destination interface {
+	// send delivers the batch, returning an error if the destination
+	// did not accept it
+	send(ctx context.Context, batch *feedBatch) error
+	// url is the configured location of the destination, used for logging
+	url() string
+}
+
+// newDestination builds the destination for the action of the given rule,
+// resolving the credentials from the rule's secret
+func newDestination(serviceClients *serviceClients, rule *notifications.Rule) (destination, error) {
+	switch action := rule.Action.(type) {
+	case *notifications.Rule_ServiceNowAlert:
+		data, err := getSecretData(serviceClients, action.ServiceNowAlert.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		return &webhookDestination{
+			endpoint: action.ServiceNowAlert.Url,
+			username: data[secretUsername],
+			password: data[secretPassword],
+			client:   &http.Client{},
+		}, nil
+	case *notifications.Rule_DataFeedWebhookAlert:
+		data, err := getSecretData(serviceClients, action.DataFeedWebhookAlert.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		return newWebhookDestination(action.DataFeedWebhookAlert.Url, data), nil
+	case *notifications.Rule_SplunkHecAlert:
+		data, err := getSecretData(serviceClients, action.SplunkHecAlert.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		return newSplunkDestination(action.SplunkHecAlert.Url, data)
+	case *notifications.Rule_S3ObjectAlert:
+		data, err := getSecretData(serviceClients, action.S3ObjectAlert.SecretId)
+		if err != nil {
+			return nil, err
+		}
+		return newS3Destination(action.S3ObjectAlert.Url, data)
+	default:
+		return nil, errors.Errorf("unsupported data feed action %T for rule %s", action, rule.Name)
+	}
+}
+
+// ruleURL returns the location of the destination of the rule, for recording
+// deliveries to destinations that could not be created
+func ruleURL(rule *notifications.Rule) string {
+	switch action := rule.Action.(type) {
+	case *notifications.Rule_ServiceNowAlert:
+		return action.ServiceNowAlert.Url
+	case *notifications.Rule_DataFeedWebhookAlert:
+		return action.DataFeedWebhookAlert.Url
+	case *notifications.Rule_SplunkHecAlert:
+		return action.SplunkHecAlert.Url
+	case *notifications.Rule_S3ObjectAlert:
+		return action.S3ObjectAlert.Url
+	default:
+		return ""
+	}
+}
+
+// getSecretData returns the key/value pairs of the secret. An empty secret id
+// yields no data, allowing unauthenticated destinations.
+func getSecretData(serviceClients *serviceClients, secretId string) (map[string]string, error) {
+	data := make(map[string]string)
+	if secretId == "" {
+		return data, nil
+	}
+
+	secret, err := serviceClients.secrets.Read(context.Background(), &secrets.Id{Id: secretId})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading secret %s", secretId)
+	}
+	for _, kv := range secret.GetData() {
+		data[kv.Key] = kv.Value
+	}
+	return data, nil
+}
+
+// postPayload POSTs the payload and treats any non-2xx response as an error
+func postPayload(ctx context.Context, client *http.Client, request *http.Request) error {
+	response, err := client.Do(request.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "posting to %s", request.URL)
+	}
+	defer response.Body.Close()
+
+	log.Infof("Asset data posted to %v, Status %v", request.URL, response.Status)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("%s responded with %s", request.URL, response.Status)
+	}
+	return nil
+}
+
+func newJSONRequest(url string, payload []byte) (*http.Request, error) {
+	request, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request")
+	}
+	request.Header.Add("Content-Type", "application/json")
+	request.Header.Add("Accept", "application/json")
+	return request, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultS3Region = "us-east-1"
+
+// s3Destination writes each batch as a newline delimited JSON object to an
+// S3 compatible object store. The rule url is the path style location of
+// the bucket and an optional key prefix: https://<endpoint>/<bucket>/<prefix>
+type s3Destination struct {
+	location string
+	bucket   string
+	prefix   string
+	s3Svc    *s3.S3
+}
+
+func newS3Destination(location string, data map[string]string) (*s3Destination, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing s3 url %s", location)
+	}
+	parts := strings.SplitN(strings.Trim(u.Path, "/"), "/", 2)
+	if parts[0] == "" {
+		return nil, errors.Errorf("s3 url %s does not include a bucket", location)
+	}
+
+	region := data[secretRegion]
+	if region == "" {
+		region = defaultS3Region
+	}
+	awsConfig := aws.NewConfig().
+		WithEndpoint(fmt.Sprintf("%s://%s", u.Scheme, u.Host)).
+		WithRegion(region).
+		WithS3ForcePathStyle(true)
+	if data[secretAccessKeyID] != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(
+			data[secretAccessKeyID], data[secretSecretAccessKey], ""))
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating s3 session")
+	}
+
+	d := &s3Destination{
+		location: location,
+		bucket:   parts[0],
+		s3Svc:    s3.New(sess),
+	}
+	if len(parts) == 2 {
+		d.prefix = parts[1]
+	}
+	return d, nil
+}
+
+func (d *s3Destination) url() string {
+	return d.location
+}
+
+func (d *s3Destination) send(ctx context.Context, batch *feedBatch) error {
+	var payload bytes.Buffer
+	encoder := json.NewEncoder(&payload)
+	for _, message := range batch.messages {
+		if err := encoder.Encode(message); err != nil {
+			return errors.Wrap(err, "encoding ndjson line")
+		}
+	}
+
+	key := d.objectKey(batch)
+	_, err := d.s3Svc.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(d.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(payload.Bytes()),
+		ContentType: aws.String("application/x-ndjson"),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "writing s3 object %s/%s", d.bucket, key)
+	}
+	log.Infof("Asset data written to s3 bucket %v, key %v", d.bucket, key)
+	return nil
+}
+
+// objectKey partitions objects by the day of the feed window, naming them by
+// the window and batch sequence so a resent window overwrites rather than
+// duplicates
+func (d *s3Destination) objectKey(batch *feedBatch) string {
+	start := batch.feedStart.UTC()
+	end := batch.feedEnd.UTC()
+	name := fmt.Sprintf("%s_%s_%04d.ndjson", start.Format("20060102T150405Z"), end.Format("20060102T150405Z"), batch.sequence)
+	return path.Join(d.prefix, end.Format("2006/01/02"), name)
+}
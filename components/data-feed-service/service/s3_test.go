@@ -0,0 +1,85 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testS3Credentials = map[string]string{
+	secretAccessKeyID:     "AKIAEXAMPLE",
+	secretSecretAccessKey: "secret",
+}
+
+func TestNewS3DestinationParsesBucketAndPrefix(t *testing.T) {
+	dest, err := newS3Destination("https://s3.example.com/feeds/automate/assets", testS3Credentials)
+	require.NoError(t, err)
+	assert.Equal(t, "feeds", dest.bucket)
+	assert.Equal(t, "automate/assets", dest.prefix)
+
+	dest, err = newS3Destination("https://s3.example.com/feeds/", testS3Credentials)
+	require.NoError(t, err)
+	assert.Equal(t, "feeds", dest.bucket)
+	assert.Equal(t, "", dest.prefix)
+}
+
+func TestNewS3DestinationRequiresBucket(t *testing.T) {
+	_, err := newS3Destination("https://s3.example.com", testS3Credentials)
+	assert.Error(t, err)
+}
+
+func TestS3DestinationObjectKey(t *testing.T) {
+	dest := &s3Destination{prefix: "automate"}
+	assert.Equal(t,
+		"automate/2019/07/01/20190701T120000Z_20190701T130000Z_0002.ndjson",
+		dest.objectKey(testBatch()))
+
+	dest = &s3Destination{}
+	assert.Equal(t,
+		"2019/07/01/20190701T120000Z_20190701T130000Z_0002.ndjson",
+		dest.objectKey(testBatch()))
+}
+
+func TestS3DestinationPutsNDJSONObject(t *testing.T) {
+	server, requests := newCaptureServer(t, http.StatusOK)
+	defer server.Close()
+
+	dest, err := newS3Destination(server.URL+"/feeds/automate", testS3Credentials)
+	require.NoError(t, err)
+	batch := testBatch()
+	require.NoError(t, dest.send(context.Background(), batch))
+
+	require.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "PUT", request.method)
+	assert.Equal(t, "/feeds/"+dest.objectKey(batch), request.path)
+	assert.Equal(t, "application/x-ndjson", request.header.Get("Content-Type"))
+
+	messages := make([]attributesMessage, 0)
+	decoder := json.NewDecoder(bytes.NewReader(request.body))
+	for {
+		var message attributesMessage
+		err := decoder.Decode(&message)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		messages = append(messages, message)
+	}
+	assert.Equal(t, batch.messages, messages)
+}
+
+func TestS3DestinationFailsOnErrorStatus(t *testing.T) {
+	server, _ := newCaptureServer(t, http.StatusForbidden)
+	defer server.Close()
+
+	dest, err := newS3Destination(server.URL+"/feeds", testS3Credentials)
+	require.NoError(t, err)
+	assert.Error(t, dest.send(context.Background(), testBatch()))
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	splunkSource     = "chef-automate"
+	splunkSourcetype = "chef:automate:asset"
+)
+
+// splunkEvent is the envelope for a single event sent to the
+// Splunk HTTP Event Collector
+type splunkEvent struct {
+	Time       int64             `json:"time"`
+	Source     string            `json:"source"`
+	Sourcetype string            `json:"sourcetype"`
+	Index      string            `json:"index,omitempty"`
+	Event      attributesMessage `json:"event"`
+}
+
+// splunkDestination sends each node as an event to a Splunk HTTP Event
+// Collector endpoint, e.g. https://splunk:8088/services/collector/event
+type splunkDestination struct {
+	endpoint string
+	token    string
+	index    string
+	client   *http.Client
+}
+
+func newSplunkDestination(url string, data map[string]string) (*splunkDestination, error) {
+	token := data[secretToken]
+	if token == "" {
+		return nil, errors.New("splunk HEC secret requires a token")
+	}
+	return &splunkDestination{
+		endpoint: url,
+		token:    token,
+		index:    data[secretSplunkIndex],
+		client:   &http.Client{},
+	}, nil
+}
+
+func (d *splunkDestination) url() string {
+	return d.endpoint
+}
+
+func (d *splunkDestination) send(ctx context.Context, batch *feedBatch) error {
+	// HEC accepts multiple events in one request as concatenated JSON objects
+	var payload bytes.Buffer
+	encoder := json.NewEncoder(&payload)
+	for _, message := range batch.messages {
+		event := splunkEvent{
+			Time:       batch.feedEnd.Unix(),
+			Source:     splunkSource,
+			Sourcetype: splunkSourcetype,
+			Index:      d.index,
+			Event:      message,
+		}
+		if err := encoder.Encode(event); err != nil {
+			return errors.Wrap(err, "encoding splunk event")
+		}
+	}
+
+	request, err := newJSONRequest(d.endpoint, payload.Bytes())
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Authorization", "Splunk "+d.token)
+
+	return postPayload(ctx, d.client, request)
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSplunkDestinationRequiresToken(t *testing.T) {
+	_, err := newSplunkDestination("https://splunk:8088/services/collector/event", map[string]string{})
+	assert.Error(t, err)
+}
+
+func TestSplunkDestinationSendsAnEventPerMessage(t *testing.T) {
+	server, requests := newCaptureServer(t, http.StatusOK)
+	defer server.Close()
+
+	dest, err := newSplunkDestination(server.URL+"/services/collector/event",
+		map[string]string{secretToken: "hec-token", secretSplunkIndex: "automate"})
+	require.NoError(t, err)
+	batch := testBatch()
+	require.NoError(t, dest.send(context.Background(), batch))
+
+	require.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "/services/collector/event", request.path)
+	assert.Equal(t, "Splunk hec-token", request.header.Get("Authorization"))
+
+	events := make([]splunkEvent, 0)
+	decoder := json.NewDecoder(bytes.NewReader(request.body))
+	for {
+		var event splunkEvent
+		err := decoder.Decode(&event)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		events = append(events, event)
+	}
+	require.Len(t, events, len(batch.messages))
+	for i, event := range events {
+		assert.Equal(t, batch.feedEnd.Unix(), event.Time)
+		assert.Equal(t, splunkSource, event.Source)
+		assert.Equal(t, splunkSourcetype, event.Sourcetype)
+		assert.Equal(t, "automate", event.Index)
+		assert.Equal(t, batch.messages[i], event.Event)
+	}
+}
+
+func TestSplunkDestinationFailsOnErrorStatus(t *testing.T) {
+	server, _ := newCaptureServer(t, http.StatusForbidden)
+	defer server.Close()
+
+	dest, err := newSplunkDestination(server.URL, map[string]string{secretToken: "hec-token"})
+	require.NoError(t, err)
+	assert.Error(t, dest.send(context.Background(), testBatch()))
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// signatureHeader carries the hex encoded HMAC-SHA256 of the request body
+// when the webhook secret contains an hmac_key
+const signatureHeader = "X-Chef-Automate-Signature"
+
+// webhookDestination POSTs the batch as a JSON array. It is used for
+// ServiceNow (basic auth) as well as generic webhooks, which may authenticate
+// with a bearer token or an arbitrary header, and may sign the payload.
+type webhookDestination struct {
+	endpoint    string
+	username    string
+	password    string
+	token       string
+	headerName  string
+	headerValue string
+	hmacKey     []byte
+	client      *http.Client
+}
+
+func newWebhookDestination(url string, data map[string]string) *webhookDestination {
+	d := &webhookDestination{
+		endpoint:    url,
+		username:    data[secretUsername],
+		password:    data[secretPassword],
+		token:       data[secretToken],
+		headerName:  data[secretHeaderName],
+		headerValue: data[secretHeaderValue],
+		client:      &http.Client{},
+	}
+	if key := data[secretHMACKey]; key != "" {
+		d.hmacKey = []byte(key)
+	}
+	return d
+}
+
+func (d *webhookDestination) url() string {
+	return d.endpoint
+}
+
+func (d *webhookDestination) send(ctx context.Context, batch *feedBatch) error {
+	messageBytes, err := json.Marshal(batch.messages)
+	if err != nil {
+		return errors.Wrap(err, "creating json bytes")
+	}
+	log.Debugf("webhook payload bytes length %v", len(messageBytes))
+
+	request, err := newJSONRequest(d.endpoint, messageBytes)
+	if err != nil {
+		return err
+	}
+	d.authenticate(request)
+	if d.hmacKey != nil {
+		request.Header.Add(signatureHeader, "sha256="+sign(d.hmacKey, messageBytes))
+	}
+
+	return postPayload(ctx, d.client, request)
+}
+
+func (d *webhookDestination) authenticate(request *http.Request) {
+	switch {
+	case d.token != "":
+		request.Header.Add("Authorization", "Bearer "+d.token)
+	case d.headerName != "":
+		request.Header.Add(d.headerName, d.headerValue)
+	case d.username != "" || d.password != "":
+		request.SetBasicAuth(d.username, d.password)
+	}
+}
+
+func sign(key []byte, payload []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload) // nolint: errcheck // hash.Hash writes never error
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capturedRequest is what a test server received
+type capturedRequest struct {
+	method string
+	path   string
+	header http.Header
+	body   []byte
+}
+
+// newCaptureServer starts a server that records the requests it receives
+// and responds with the given status
+func newCaptureServer(t *testing.T, status int) (*httptest.Server, *[]capturedRequest) {
+	requests := make([]capturedRequest, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		requests = append(requests, capturedRequest{
+			method: r.Method,
+			path:   r.URL.Path,
+			header: r.Header,
+			body:   body,
+		})
+		w.WriteHeader(status)
+	}))
+	return server, &requests
+}
+
+func testBatch() *feedBatch {
+	return &feedBatch{
+		feedStart: time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC),
+		feedEnd:   time.Date(2019, 7, 1, 13, 0, 0, 0, time.UTC),
+		sequence:  2,
+		messages: []attributesMessage{
+			{Automatic: `{"fqdn":"node-1"}`},
+			{Automatic: `{"fqdn":"node-2"}`},
+		},
+	}
+}
+
+func TestWebhookDestinationSendsBatchAsJSONArray(t *testing.T) {
+	server, requests := newCaptureServer(t, http.StatusOK)
+	defer server.Close()
+
+	dest := newWebhookDestination(server.URL, map[string]string{})
+	require.NoError(t, dest.send(context.Background(), testBatch()))
+
+	require.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "POST", request.method)
+	assert.Equal(t, "application/json", request.header.Get("Content-Type"))
+	assert.Empty(t, request.header.Get("Authorization"))
+	assert.Empty(t, request.header.Get(signatureHeader))
+
+	var messages []attributesMessage
+	require.NoError(t, json.Unmarshal(request.body, &messages))
+	assert.Equal(t, testBatch().messages, messages)
+}
+
+func TestWebhookDestinationAuthentication(t *testing.T) {
+	cases := map[string]struct {
+		data   map[string]string
+		header string
+		value  string
+	}{
+		"bearer token": {
+			data:   map[string]string{secretToken: "t0k3n", secretUsername: "ignored"},
+			header: "Authorization",
+			value:  "Bearer t0k3n",
+		},
+		"custom header": {
+			data:   map[string]string{secretHeaderName: "X-Api-Key", secretHeaderValue: "k3y"},
+			header: "X-Api-Key",
+			value:  "k3y",
+		},
+		"basic auth": {
+			data:   map[string]string{secretUsername: "admin", secretPassword: "secret"},
+			header: "Authorization",
+			value:  "Basic YWRtaW46c2VjcmV0",
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			server, requests := newCaptureServer(t, http.StatusOK)
+			defer server.Close()
+
+			dest := newWebhookDestination(server.URL, c.data)
+			require.NoError(t, dest.send(context.Background(), testBatch()))
+
+			require.Len(t, *requests, 1)
+			assert.Equal(t, c.value, (*requests)[0].header.Get(c.header))
+		})
+	}
+}
+
+func TestWebhookDestinationSignsPayload(t *testing.T) {
+	server, requests := newCaptureServer(t, http.StatusOK)
+	defer server.Close()
+
+	dest := newWebhookDestination(server.URL, map[string]string{secretHMACKey: "signing-key"})
+	require.NoError(t, dest.send(context.Background(), testBatch()))
+
+	require.Len(t, *requests, 1)
+	request := (*requests)[0]
+	assert.Equal(t, "sha256="+sign([]byte("signing-key"), request.body), request.header.Get(signatureHeader))
+}
+
+func TestWebhookDestinationFailsOnErrorStatus(t *testing.T) {
+	server, _ := newCaptureServer(t, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	dest := newWebhookDestination(server.URL, map[string]string{})
+	err := dest.send(context.Background(), testBatch())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestSign(t *testing.T) {
+	// echo -n 'payload' | openssl dgst -sha256 -hmac key
+	assert.Equal(t,
+		"5d98b45c90a207fa998ce639fea6f02ecc8cc3f36fef81d694fb856b4d0a28ca",
+		sign([]byte("key"), []byte("payload")))
+	assert.NotEqual(t, sign([]byte("key"), []byte("payload")), sign([]byte("other"), []byte("payload")))
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"time"
 
+	"github.com/chef/automate/lib/platform"
 	"github.com/chef/automate/lib/tls/certs"
 
 	log "github.com/sirupsen/logrus"
@@ -16,6 +18,7 @@ const (
 	COMPLIANCE_INGEST_KEY = "compliance_ingest"
 	CFG_KEY               = "cfgingest"
 	AUTHZ                 = "authz"
+	EVENT_HANDLER         = "event_handler"
 )
 
 // Configuration for the Event Service
@@ -28,6 +31,9 @@ type EventConfig struct {
 	TLSConfig         certs.TLSConfig `mapstructure:"tls"`
 	ServiceCerts      *certs.ServiceCerts
 	HandlerEndpoints  HandlerConfig `mapstructure:"handlers"` // use to get an instance of a service's event handler
+	Postgres          Postgres      `mapstructure:"postgres"`
+	Delivery          Delivery      `mapstructure:"delivery"`
+	History           History       `mapstructure:"history"`
 }
 
 type Auth struct {
@@ -59,6 +65,27 @@ type ServiceConfig struct {
 	ListenerLimit int    `mapstructure:"listener_limit"` // number of concurrent listeners supported
 }
 
+// Postgres holds the configuration of the database storing subscriptions
+type Postgres struct {
+	URI        string `mapstructure:"uri"`
+	Database   string `mapstructure:"database"`
+	SchemaPath string `mapstructure:"schema_path"`
+}
+
+// Delivery configures how events are retried before being dead-lettered
+type Delivery struct {
+	RetryAttempts int           `mapstructure:"retry_attempts"` // attempts per handler, including the first
+	RetryBackoff  time.Duration `mapstructure:"retry_backoff"`  // wait before the first retry, doubled on every retry
+	MaxBackoff    time.Duration `mapstructure:"max_backoff"`
+	Timeout       time.Duration `mapstructure:"timeout"` // per attempt, including dialing the handler
+}
+
+// History configures how long published events are kept in the history
+type History struct {
+	Retention     time.Duration `mapstructure:"retention"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
 type HandlerConfig struct {
 	Feed      string `mapstructure:"feed"`
 	CfgIngest string `mapstructure:"cfgingest"`
@@ -89,6 +116,27 @@ func (c *EventConfig) SetLogLevel() {
 	log.SetLevel(level)
 }
 
+func (c *EventConfig) setDeliveryDefaults() {
+	if c.Delivery.RetryAttempts <= 0 {
+		c.Delivery.RetryAttempts = 5
+	}
+	if c.Delivery.RetryBackoff <= 0 {
+		c.Delivery.RetryBackoff = time.Second
+	}
+	if c.Delivery.MaxBackoff <= 0 {
+		c.Delivery.MaxBackoff = time.Minute
+	}
+	if c.Delivery.Timeout <= 0 {
+		c.Delivery.Timeout = 30 * time.Second
+	}
+	if c.History.Retention <= 0 {
+		c.History.Retention = 30 * 24 * time.Hour
+	}
+	if c.History.PurgeInterval <= 0 {
+		c.History.PurgeInterval = time.Hour
+	}
+}
+
 func (c *EventConfig) GetCerts() *certs.ServiceCerts {
 	return c.ServiceCerts
 }
@@ -123,6 +171,15 @@ func Configure() (*EventConfig, error) {
 	}
 	// Set log level
 	config.SetLogLevel()
+	config.setDeliveryDefaults()
+
+	if config.Postgres.URI == "" {
+		config.Postgres.URI, err = platform.PGURIFromEnvironment(config.Postgres.Database)
+		if err != nil {
+			log.WithError(err).Error("Failed to get pg uri")
+			return config, err
+		}
+	}
 
 	// Fix any relative paths that might be in the config file
 	config.TLSConfig.FixupRelativeTLSPaths(viper.ConfigFileUsed())
@@ -0,0 +1,117 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/storage"
+)
+
+// ErrUnknownSubscription is returned when replaying a dead letter whose
+// subscription was removed
+var ErrUnknownSubscription = errors.New("the subscription of the dead letter no longer exists")
+
+// DeliveryError is returned when a replayed dead letter could not be
+// delivered again
+type DeliveryError struct {
+	err error
+}
+
+func (e *DeliveryError) Error() string {
+	return "delivering event: " + e.err.Error()
+}
+
+// deliver hands the event to the handler of the subscription, retrying with
+// backoff. An event that can't be delivered is dead-lettered so it can be
+// inspected and replayed.
+func (svc Events) deliver(sub *api.Subscription, rec *recordedEvent) {
+	event := rec.event
+	attempts, err := svc.deliverWithRetry(sub, event)
+	if err == nil {
+		svc.recordDelivery(rec, sub.ID, storage.DeliveryDelivered, attempts, nil)
+		return
+	}
+	svc.recordDelivery(rec, sub.ID, storage.DeliveryDeadLettered, attempts, err)
+
+	logrus.Errorf("Giving up delivering %s event %s to handler %s after %d attempts: %v",
+		event.GetType().GetName(), event.GetEventID(), sub.ID, attempts, err)
+	deadLetter := &api.DeadLetter{
+		ID:             uuid.Must(uuid.NewV4()).String(),
+		SubscriptionID: sub.ID,
+		Event:          event,
+		Attempts:       int32(attempts),
+		LastError:      err.Error(),
+	}
+	if err := svc.store.StoreDeadLetter(deadLetter); err != nil {
+		logrus.Errorf("Could not dead-letter event %s for handler %s, the event is lost: %v", event.GetEventID(), sub.ID, err)
+	}
+}
+
+// deliverWithRetry makes up to the configured number of attempts, doubling
+// the wait between them up to the max backoff. It returns the number of
+// attempts made.
+func (svc Events) deliverWithRetry(sub *api.Subscription, event *api.EventMsg) (int, error) {
+	delivery := svc.cfg.Delivery
+	backoff := delivery.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := svc.attempt(sub, event)
+		if err == nil {
+			return attempt, nil
+		}
+		if attempt >= delivery.RetryAttempts {
+			return attempt, err
+		}
+
+		logrus.Warnf("Delivering event %s to handler %s failed on attempt %d, retrying in %v: %v",
+			event.GetEventID(), sub.ID, attempt, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > delivery.MaxBackoff {
+			backoff = delivery.MaxBackoff
+		}
+	}
+}
+
+// attempt delivers the event once, bounded by the delivery timeout
+func (svc Events) attempt(sub *api.Subscription, event *api.EventMsg) error {
+	ctx, cancel := context.WithTimeout(context.Background(), svc.cfg.Delivery.Timeout)
+	defer cancel()
+
+	h, err := svc.registry.handler(ctx, sub)
+	if err != nil {
+		return err
+	}
+	return h.HandleEvent(ctx, event)
+}
+
+// Replay delivers the dead letter to the current handler of its subscription,
+// removing it from the store once delivered
+func (svc Events) Replay(id string) error {
+	deadLetter, err := svc.store.GetDeadLetter(id)
+	if err != nil {
+		return err
+	}
+
+	sub, ok := svc.registry.get(deadLetter.SubscriptionID)
+	if !ok {
+		return ErrUnknownSubscription
+	}
+
+	if err := svc.attempt(sub, deadLetter.Event); err != nil {
+		if err := svc.store.UpdateDeadLetter(id, deadLetter.Attempts+1, err.Error()); err != nil {
+			logrus.Error(err)
+		}
+		return &DeliveryError{err: err}
+	}
+
+	logrus.Infof("Replayed dead letter %s to handler %s", id, sub.ID)
+	// the event was recorded when it was first dispatched
+	rec := &recordedEvent{event: deadLetter.Event, recorded: true}
+	svc.recordDelivery(rec, sub.ID, storage.DeliveryDelivered, int(deadLetter.Attempts)+1, nil)
+	return svc.store.DeleteDeadLetter(id)
+}
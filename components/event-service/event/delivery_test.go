@@ -0,0 +1,169 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/config"
+	"github.com/chef/automate/components/event-service/storage"
+)
+
+func testEvents(t *testing.T, store *memStore, dialer *fakeDialer) *Events {
+	cfg := &config.EventConfig{Delivery: config.Delivery{
+		RetryAttempts: 3,
+		RetryBackoff:  time.Millisecond,
+		MaxBackoff:    2 * time.Millisecond,
+		Timeout:       time.Second,
+	}}
+	return NewEvents(cfg, newTestRegistry(t, store, dialer), store)
+}
+
+func testEvent(id string) *api.EventMsg {
+	return &api.EventMsg{EventID: id, Type: &api.EventType{Name: "test.happened"}}
+}
+
+func TestDeliverWithRetrySucceedsFirstTime(t *testing.T) {
+	client := &fakeHandlerClient{}
+	svc := testEvents(t, newMemStore(), &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+
+	attempts, err := svc.deliverWithRetry(sub, testEvent("event-1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.Len(t, client.handled(), 1)
+}
+
+func TestDeliverWithRetryRetriesFailedAttempts(t *testing.T) {
+	client := &fakeHandlerClient{failures: 2}
+	svc := testEvents(t, newMemStore(), &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+
+	attempts, err := svc.deliverWithRetry(sub, testEvent("event-1"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, client.handled(), 1)
+}
+
+func TestDeliverWithRetryGivesUpAfterAttempts(t *testing.T) {
+	client := &fakeHandlerClient{failures: 10}
+	svc := testEvents(t, newMemStore(), &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+
+	attempts, err := svc.deliverWithRetry(sub, testEvent("event-1"))
+	assert.EqualError(t, err, "handler unavailable")
+	assert.Equal(t, 3, attempts)
+	assert.Empty(t, client.handled())
+}
+
+func TestDeliverWithRetryRetriesFailedDials(t *testing.T) {
+	svc := testEvents(t, newMemStore(), &fakeDialer{clients: map[string]*fakeHandlerClient{}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+
+	attempts, err := svc.deliverWithRetry(sub, testEvent("event-1"))
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 3, attempts)
+}
+
+func TestDeliverRecordsDelivery(t *testing.T) {
+	store := newMemStore()
+	client := &fakeHandlerClient{failures: 1}
+	svc := testEvents(t, store, &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	event := testEvent("event-1")
+
+	svc.deliver(sub, svc.record(event))
+	drainHistory(svc)
+
+	assert.Empty(t, store.deadLetters)
+	assert.Equal(t, []*api.Delivery{{
+		SubscriptionID: "sub-1",
+		Status:         storage.DeliveryDelivered,
+		Attempts:       2,
+	}}, store.deliveriesOf("event-1"))
+}
+
+func TestDeliverDeadLettersUndeliverableEvents(t *testing.T) {
+	store := newMemStore()
+	client := &fakeHandlerClient{failures: 10}
+	svc := testEvents(t, store, &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	event := testEvent("event-1")
+
+	svc.deliver(sub, svc.record(event))
+	drainHistory(svc)
+
+	deadLetters, err := store.ListDeadLetters("sub-1", "")
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, event, deadLetters[0].Event)
+	assert.Equal(t, int32(3), deadLetters[0].Attempts)
+	assert.Equal(t, "handler unavailable", deadLetters[0].LastError)
+
+	assert.Equal(t, []*api.Delivery{{
+		SubscriptionID: "sub-1",
+		Status:         storage.DeliveryDeadLettered,
+		Attempts:       3,
+		LastError:      "handler unavailable",
+	}}, store.deliveriesOf("event-1"))
+}
+
+func TestReplayDeliversAndRemovesDeadLetter(t *testing.T) {
+	store := newMemStore()
+	client := &fakeHandlerClient{failures: 3}
+	svc := testEvents(t, store, &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	event := testEvent("event-1")
+	svc.deliver(sub, svc.record(event))
+	deadLetters, err := store.ListDeadLetters("sub-1", "")
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+
+	require.NoError(t, svc.Replay(deadLetters[0].ID))
+
+	assert.Equal(t, []*api.EventMsg{event}, client.handled())
+	_, err = store.GetDeadLetter(deadLetters[0].ID)
+	assert.Equal(t, storage.ErrNotFound, err)
+}
+
+func TestReplayFailureKeepsDeadLetter(t *testing.T) {
+	store := newMemStore()
+	client := &fakeHandlerClient{failures: 10}
+	svc := testEvents(t, store, &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	sub, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	event := testEvent("event-1")
+	svc.deliver(sub, svc.record(event))
+	deadLetters, err := store.ListDeadLetters("sub-1", "")
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+
+	err = svc.Replay(deadLetters[0].ID)
+	assert.IsType(t, &DeliveryError{}, err)
+
+	deadLetter, err := store.GetDeadLetter(deadLetters[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), deadLetter.Attempts)
+}
+
+func TestReplayOfRemovedSubscription(t *testing.T) {
+	store := newMemStore()
+	svc := testEvents(t, store, &fakeDialer{clients: map[string]*fakeHandlerClient{}})
+	require.NoError(t, store.StoreDeadLetter(&api.DeadLetter{
+		ID:             "dead-letter-1",
+		SubscriptionID: "sub-1",
+		Event:          testEvent("event-1"),
+	}))
+
+	assert.Equal(t, ErrUnknownSubscription, svc.Replay("dead-letter-1"))
+}
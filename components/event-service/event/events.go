@@ -2,24 +2,13 @@ package event
 
 import (
 	"context"
-	"time"
-
-	"errors"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
-	iam_v2 "github.com/chef/automate/api/interservice/authz/v2"
 	api "github.com/chef/automate/api/interservice/event"
-	"github.com/chef/automate/api/interservice/ingest"
-	automate_feed "github.com/chef/automate/components/compliance-service/api/automate-feed"
-	compliance_ingest "github.com/chef/automate/components/compliance-service/ingest/ingest"
 	"github.com/chef/automate/components/event-service/config"
-	"github.com/chef/automate/lib/grpc/secureconn"
-)
-
-const (
-	clientTimeout = 60 * time.Second
+	"github.com/chef/automate/components/event-service/storage"
 )
 
 //----------  EVENT HANDLER  ----------//
@@ -30,7 +19,7 @@ type EventHandlerClient interface {
 }
 
 type EventHandler interface {
-	HandleEvent(*api.EventMsg)
+	HandleEvent(context.Context, *api.EventMsg) error
 	GetHandlerType() string
 	GetClient() EventHandlerClient
 }
@@ -47,15 +36,13 @@ func newEventHandler(handlerType string, client EventHandlerClient) EventHandler
 	}
 }
 
-func (e eventHandler) HandleEvent(msg *api.EventMsg) {
-	// TODO: add capabilities (timeout, etc.) to context?
-	response, err := e.client.HandleEvent(context.Background(), msg)
-
+func (e eventHandler) HandleEvent(ctx context.Context, msg *api.EventMsg) error {
+	response, err := e.client.HandleEvent(ctx, msg)
 	if err != nil {
-		logrus.Warnf("Client event handler returned an error... event won't be handled %s", err.Error())
-	} else {
-		logrus.Debugf("Event handler returned with status %s", response)
+		return err
 	}
+	logrus.Debugf("Event handler returned with status %s", response)
+	return nil
 }
 
 func (e eventHandler) GetHandlerType() string {
@@ -68,48 +55,40 @@ func (e eventHandler) GetClient() EventHandlerClient {
 
 //----------  EVENTS  ----------//
 
-// Type Events is responsible for event publishing and processing. Currently, it has a
-// hard-coded map of events to event handlers. When an event is published,
-// Events gets the event off its input channel and matches the event with the correct handler
-// function. Handler functions are started in their own goroutines.
+// Type Events is responsible for event publishing and processing. When an event
+// is published, Events gets the event off its input channel and matches the
+// event with the handlers subscribed to its type in the registry. Every
+// handler is delivered the event in its own goroutine, with retries; events a
+// handler fails to take are dead-lettered in the store. Every event and the
+// outcome of its deliveries is kept in the event history, which is written in
+// the background so a slow store doesn't hold up delivery.
 type Events struct {
-	in          chan *api.EventMsg
-	registry    map[string][]string
-	handlers    map[string]EventHandler // maps an event to an array of pointers to handlers
-	cfg         *config.EventConfig
-	connFactory *secureconn.Factory
+	in       chan *api.EventMsg
+	history  chan historyEntry
+	registry *Registry
+	store    storage.Client
+	cfg      *config.EventConfig
 }
 
-func NewEvents(cfg *config.EventConfig, cf *secureconn.Factory) *Events {
+func NewEvents(cfg *config.EventConfig, registry *Registry, store storage.Client) *Events {
 	return &Events{
-		in:          make(chan *api.EventMsg, cfg.ServiceConfig.EventLimit),
-		handlers:    make(map[string]EventHandler),
-		cfg:         cfg,
-		connFactory: cf,
+		in:       make(chan *api.EventMsg, cfg.ServiceConfig.EventLimit),
+		history:  make(chan historyEntry, historyQueueSize),
+		registry: registry,
+		store:    store,
+		cfg:      cfg,
 	}
 }
 
 // Listen for events on the input channel until event-service is terminated.
-func (svc Events) Start(r map[string][]string) {
+func (svc Events) Start() {
 	logrus.Debug("Starting event listener loop...")
 
-	if r == nil {
-		logrus.Fatalf("Event Service not started... no event handlers in registry")
-		return
-	}
-
-	svc.registry = r
-
 	for event := range svc.in {
 		logrus.Debugf("Processing event %v", event)
-		eventHandlers, err := svc.getHandlers(event)
-		if err != nil {
-			logrus.Warnf("could not get handlers for event %s: %+v", event.GetType().GetName(), err)
-			break
-		}
-
-		for _, eventHandler := range eventHandlers {
-			go eventHandler.HandleEvent(event)
+		rec := svc.record(event)
+		for _, sub := range svc.registry.matching(event.GetType().GetName()) {
+			go svc.deliver(sub, rec)
 		}
 	}
 }
@@ -122,90 +101,4 @@ func (svc Events) Publish(event *api.EventMsg) {
 	logrus.Debugf("Published event %s", event.EventID)
 }
 
-// Should return a CallStatus?
-func (svc Events) getClient(handlerType string) (EventHandlerClient, error) {
-
-	ctx := context.Background()
-	timeoutCtx, cancel := context.WithTimeout(ctx, clientTimeout)
-	defer cancel()
-
-	if handlerType == config.FEED_KEY {
-		conn, err := svc.connFactory.DialContext(timeoutCtx, "compliance-service", svc.cfg.HandlerEndpoints.Feed, grpc.WithBlock())
-		if err != nil {
-			logrus.Errorf("Event service could not get event handler client; error grpc dialing automate-feed's event handler %s", err.Error())
-			return nil, err
-		}
-		feedClient := automate_feed.NewFeedServiceClient(conn)
-		if feedClient == nil {
-			logrus.Errorf("CallHandler could not obtain NewFeedServiceClient")
-			return nil, errors.New("CallHandler could not obtain NewFeedServiceClient")
-		}
-		return feedClient, nil
-	} else if handlerType == config.CFG_KEY {
-		conn, err := svc.connFactory.DialContext(timeoutCtx, "ingest-service", svc.cfg.HandlerEndpoints.CfgIngest, grpc.WithBlock())
-		if err != nil {
-			logrus.Errorf("Event service could not get event handler client; error grpc dialing ingest-service's event handler %s", err.Error())
-			return nil, err
-		}
-		ingestClient := ingest.NewEventHandlerClient(conn)
-		if ingestClient == nil {
-			logrus.Errorf("CallHandler could not obtain NewEventHandlerClient")
-			return nil, errors.New("CallHandler could not obtain NewEventHandlerClient")
-		}
-		return ingestClient, nil
-	} else if handlerType == config.COMPLIANCE_INGEST_KEY {
-		conn, err := svc.connFactory.DialContext(timeoutCtx, "compliance-service", svc.cfg.HandlerEndpoints.Feed, grpc.WithBlock())
-		if err != nil {
-			logrus.Errorf("Event service could not get event handler client; error grpc dialing compliance ingest's event handler %s", err.Error())
-			return nil, err
-		}
-		complianceIngesterClient := compliance_ingest.NewComplianceIngesterClient(conn)
-		if complianceIngesterClient == nil {
-			logrus.Errorf("CallHandler could not obtain NewComplianceIngesterClient")
-			return nil, errors.New("CallHandler could not obtain NewComplianceIngesterClient")
-		}
-		return complianceIngesterClient, nil
-	} else if handlerType == config.AUTHZ {
-		conn, err := svc.connFactory.DialContext(timeoutCtx, "authz-service", svc.cfg.HandlerEndpoints.Authz, grpc.WithBlock())
-		if err != nil {
-			logrus.Errorf("Event service could not get event handler client; error grpc dialing authz handler %s", err.Error())
-			return nil, err
-		}
-		authzProjectsClient := iam_v2.NewProjectsClient(conn)
-		if authzProjectsClient == nil {
-			logrus.Errorf("CallHandler could not obtain NewProjectsClient")
-			return nil, errors.New("CallHandler could not obtain NewProjectsClient")
-		}
-		return authzProjectsClient, nil
-	} else { // TODO: return appropriate error type
-		return nil, errors.New("can't find client event handler for unrecognized event handler type")
-	}
-}
-
-func (svc Events) getHandlers(e *api.EventMsg) ([]EventHandler, error) {
-
-	var eventHandlers []EventHandler
-
-	if keys := svc.registry[e.GetType().GetName()]; keys != nil {
-		// for each key, get the corresponding handlers, lazily initializing if necessary
-		for _, k := range keys {
-			if h := svc.handlers[k]; h != nil {
-				eventHandlers = append(eventHandlers, h)
-			} else {
-				// lazily initialize handler
-				c, err := svc.getClient(k)
-				if err != nil {
-					return nil, err
-				}
-				eh := newEventHandler(k, c)
-				eventHandlers = append(eventHandlers, eh)
-				svc.handlers[k] = eh
-			}
-		}
-	} else {
-		logrus.Warnf("Unable to find event handler for '%s' event", e.GetType().GetName())
-	}
-	return eventHandlers, nil
-}
-
 func (svc Events) Stop() {}
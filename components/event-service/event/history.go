@@ -0,0 +1,109 @@
+package event
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/chef/automate/api/interservice/event"
+)
+
+// historyQueueSize is the number of events and deliveries waiting to be
+// written to the history; past it, history entries are dropped rather than
+// holding up event delivery
+const historyQueueSize = 10000
+
+// recordedEvent tracks whether an event made it into the history. Only the
+// history writer reads or sets recorded.
+type recordedEvent struct {
+	event    *api.EventMsg
+	recorded bool
+}
+
+// historyEntry is either an event or the delivery of an event to be written
+// to the history
+type historyEntry struct {
+	event    *recordedEvent
+	delivery *api.Delivery
+}
+
+// RecordHistory writes the queued events and deliveries to the history until
+// event-service is terminated. A single writer keeps every event ahead of its
+// deliveries, which are skipped if the event couldn't be recorded.
+func (svc Events) RecordHistory() {
+	for entry := range svc.history {
+		if entry.delivery == nil {
+			svc.writeEvent(entry.event)
+		} else {
+			svc.writeDelivery(entry.event, entry.delivery)
+		}
+	}
+}
+
+func (svc Events) writeEvent(rec *recordedEvent) {
+	if err := svc.store.RecordEvent(rec.event); err != nil {
+		logrus.Errorf("Could not record event %s in the history: %v", rec.event.EventID, err)
+		return
+	}
+	rec.recorded = true
+}
+
+func (svc Events) writeDelivery(rec *recordedEvent, delivery *api.Delivery) {
+	eventID := rec.event.GetEventID()
+	if !rec.recorded {
+		logrus.Debugf("Not recording delivery of event %s missing from the history", eventID)
+		return
+	}
+	if err := svc.store.RecordDelivery(eventID, delivery); err != nil {
+		logrus.Errorf("Could not record delivery of event %s in the history: %v", eventID, err)
+	}
+}
+
+// record queues the event to be added to the history. Events published
+// without an ID are given one so their deliveries can be tied to them.
+func (svc Events) record(event *api.EventMsg) *recordedEvent {
+	if event.EventID == "" {
+		event.EventID = uuid.Must(uuid.NewV4()).String()
+	}
+	rec := &recordedEvent{event: event}
+	svc.queueHistory(historyEntry{event: rec})
+	return rec
+}
+
+// recordDelivery queues the outcome of delivering the recorded event
+func (svc Events) recordDelivery(rec *recordedEvent, subscriptionID string, status string, attempts int, err error) {
+	delivery := &api.Delivery{
+		SubscriptionID: subscriptionID,
+		Status:         status,
+		Attempts:       int32(attempts),
+	}
+	if err != nil {
+		delivery.LastError = err.Error()
+	}
+	svc.queueHistory(historyEntry{event: rec, delivery: delivery})
+}
+
+func (svc Events) queueHistory(entry historyEntry) {
+	select {
+	case svc.history <- entry:
+	default:
+		logrus.Warnf("Event history queue is full, not recording event %s", entry.event.event.GetEventID())
+	}
+}
+
+// PurgeHistory removes the events older than the retention period from the
+// history every purge interval, until event-service is terminated
+func (svc Events) PurgeHistory() {
+	ticker := time.NewTicker(svc.cfg.History.PurgeInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		before := time.Now().Add(-svc.cfg.History.Retention)
+		count, err := svc.store.PurgeEvents(before)
+		if err != nil {
+			logrus.Errorf("Could not purge the event history: %v", err)
+			continue
+		}
+		logrus.Debugf("Purged %d events received before %v from the history", count, before)
+	}
+}
@@ -0,0 +1,111 @@
+package event
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/storage"
+)
+
+// drainHistory writes the queued history and stops accepting entries
+func drainHistory(svc *Events) {
+	close(svc.history)
+	svc.RecordHistory()
+}
+
+func TestRecordHistoryWritesEventBeforeDeliveries(t *testing.T) {
+	store := newMemStore()
+	svc := testEvents(t, store, &fakeDialer{})
+
+	rec := svc.record(testEvent("event-1"))
+	svc.recordDelivery(rec, "sub-1", storage.DeliveryDelivered, 1, nil)
+	svc.recordDelivery(rec, "sub-2", storage.DeliveryDeadLettered, 3, errors.New("handler unavailable"))
+	drainHistory(svc)
+
+	assert.Contains(t, store.events, "event-1")
+	assert.Equal(t, []*api.Delivery{
+		{SubscriptionID: "sub-1", Status: storage.DeliveryDelivered, Attempts: 1},
+		{SubscriptionID: "sub-2", Status: storage.DeliveryDeadLettered, Attempts: 3, LastError: "handler unavailable"},
+	}, store.deliveriesOf("event-1"))
+}
+
+func TestRecordGivesEventsAnID(t *testing.T) {
+	store := newMemStore()
+	svc := testEvents(t, store, &fakeDialer{})
+
+	event := testEvent("")
+	svc.record(event)
+	drainHistory(svc)
+
+	require.NotEmpty(t, event.EventID)
+	assert.Contains(t, store.events, event.EventID)
+}
+
+func TestRecordHistorySkipsDeliveriesOfUnrecordedEvents(t *testing.T) {
+	store := newMemStore()
+	store.recordEvent = func(event *api.EventMsg) error {
+		if event.EventID == "event-1" {
+			return errors.New("database unavailable")
+		}
+		return nil
+	}
+	svc := testEvents(t, store, &fakeDialer{})
+
+	failed := svc.record(testEvent("event-1"))
+	recorded := svc.record(testEvent("event-2"))
+	svc.recordDelivery(failed, "sub-1", storage.DeliveryDelivered, 1, nil)
+	svc.recordDelivery(recorded, "sub-1", storage.DeliveryDelivered, 1, nil)
+	drainHistory(svc)
+
+	assert.NotContains(t, store.events, "event-1")
+	assert.Empty(t, store.deliveriesOf("event-1"))
+	assert.Len(t, store.deliveriesOf("event-2"), 1)
+}
+
+func TestQueueHistoryDropsEntriesWhenFull(t *testing.T) {
+	svc := testEvents(t, newMemStore(), &fakeDialer{})
+	svc.history = make(chan historyEntry, 1)
+
+	done := make(chan struct{})
+	go func() {
+		svc.record(testEvent("event-1"))
+		svc.record(testEvent("event-2"))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("recording the history blocked")
+	}
+	assert.Len(t, svc.history, 1)
+}
+
+func TestSlowHistoryDoesNotHoldUpDelivery(t *testing.T) {
+	store := newMemStore()
+	unblock := make(chan struct{})
+	store.recordEvent = func(*api.EventMsg) error {
+		<-unblock
+		return nil
+	}
+	client := &fakeHandlerClient{}
+	svc := testEvents(t, store, &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": client}})
+	_, err := svc.registry.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	go svc.Start()
+	go svc.RecordHistory()
+	defer close(unblock)
+
+	svc.Publish(testEvent("event-1"))
+	svc.Publish(testEvent("event-2"))
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(client.handled()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Len(t, client.handled(), 2)
+}
@@ -0,0 +1,303 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+
+	iam_v2 "github.com/chef/automate/api/interservice/authz/v2"
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/api/interservice/ingest"
+	automate_feed "github.com/chef/automate/components/compliance-service/api/automate-feed"
+	compliance_ingest "github.com/chef/automate/components/compliance-service/ingest/ingest"
+	"github.com/chef/automate/components/event-service/config"
+	"github.com/chef/automate/components/event-service/storage"
+	"github.com/chef/automate/lib/grpc/secureconn"
+)
+
+// defaultServiceNames are the certificate names of the services serving each
+// handler type, used when a subscription doesn't name one
+var defaultServiceNames = map[string]string{
+	config.FEED_KEY:              "compliance-service",
+	config.CFG_KEY:               "ingest-service",
+	config.COMPLIANCE_INGEST_KEY: "compliance-service",
+	config.AUTHZ:                 "authz-service",
+}
+
+// ValidationError is returned for subscriptions that can't be registered
+type ValidationError struct {
+	msg string
+}
+
+func (e *ValidationError) Error() string {
+	return e.msg
+}
+
+func invalid(format string, args ...interface{}) error {
+	return &ValidationError{msg: fmt.Sprintf(format, args...)}
+}
+
+// errHandlerClosed is returned by the handler of a subscription that was
+// replaced or removed; retrying gets the handler of the current subscription
+var errHandlerClosed = errors.New("the event handler connection was closed")
+
+// dialFunc connects to the handler of a subscription, returning its client
+// and the connection to close when the subscription changes
+type dialFunc func(ctx context.Context, sub *api.Subscription) (EventHandlerClient, io.Closer, error)
+
+// Registry maps event types to the handlers subscribed to them. Subscriptions
+// are persisted in the store and kept in memory for dispatching; handler
+// clients are created the first time a subscription receives an event.
+type Registry struct {
+	mu            sync.RWMutex
+	store         storage.Client
+	subscriptions map[string]*api.Subscription
+	handlers      map[string]*handlerConn // by subscription ID
+	dial          dialFunc
+}
+
+// handlerConn is the handler of a subscription and its connection. Dialing
+// holds its lock, so deliveries racing to the handler of a new subscription
+// share a single connection, without blocking the rest of the registry.
+type handlerConn struct {
+	mu      sync.Mutex
+	sub     *api.Subscription
+	handler EventHandler
+	conn    io.Closer
+	closed  bool
+}
+
+// NewRegistry loads the subscriptions persisted in the store
+func NewRegistry(store storage.Client, cf *secureconn.Factory) (*Registry, error) {
+	subs, err := store.ListSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Registry{
+		store:         store,
+		subscriptions: make(map[string]*api.Subscription, len(subs)),
+		handlers:      make(map[string]*handlerConn),
+		dial:          grpcDialer(cf),
+	}
+	for _, sub := range subs {
+		r.subscriptions[sub.ID] = sub
+	}
+	logrus.Debugf("Registry of event handlers initialized with %d subscriptions", len(subs))
+	return r, nil
+}
+
+// Subscribe validates and persists the subscription, replacing any previous
+// subscription with the same ID
+func (r *Registry) Subscribe(sub *api.Subscription) (*api.Subscription, error) {
+	if err := validateSubscription(sub); err != nil {
+		return nil, err
+	}
+	if sub.ServiceName == "" {
+		sub.ServiceName = defaultServiceNames[sub.HandlerType]
+	}
+
+	r.mu.Lock()
+	if err := r.store.StoreSubscription(sub); err != nil {
+		r.mu.Unlock()
+		return nil, err
+	}
+	r.subscriptions[sub.ID] = sub
+	replaced := r.removeHandler(sub.ID)
+	r.mu.Unlock()
+
+	replaced.close()
+	logrus.Infof("Subscribed %s handler %s at %s to %v", sub.HandlerType, sub.ID, sub.Target, sub.EventTypes)
+	return sub, nil
+}
+
+// Unsubscribe removes the subscription, returning storage.ErrNotFound if
+// there is none with the ID
+func (r *Registry) Unsubscribe(id string) error {
+	r.mu.Lock()
+	if err := r.store.DeleteSubscription(id); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	delete(r.subscriptions, id)
+	removed := r.removeHandler(id)
+	r.mu.Unlock()
+
+	removed.close()
+	logrus.Infof("Unsubscribed handler %s", id)
+	return nil
+}
+
+// removeHandler drops the handler of the subscription, returning it to be
+// closed once the registry lock is released. Must be called with the lock
+// held.
+func (r *Registry) removeHandler(id string) *handlerConn {
+	hc := r.handlers[id]
+	delete(r.handlers, id)
+	return hc
+}
+
+// List returns the subscriptions ordered by ID
+func (r *Registry) List() []*api.Subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	subs := make([]*api.Subscription, 0, len(r.subscriptions))
+	for _, sub := range r.subscriptions {
+		subs = append(subs, sub)
+	}
+	sort.Slice(subs, func(i, j int) bool { return subs[i].ID < subs[j].ID })
+	return subs
+}
+
+// matching returns the subscriptions whose event types match the event type
+func (r *Registry) matching(eventType string) []*api.Subscription {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var subs []*api.Subscription
+	for _, sub := range r.subscriptions {
+		if matches(sub, eventType) {
+			subs = append(subs, sub)
+		}
+	}
+	if len(subs) == 0 {
+		logrus.Warnf("Unable to find event handler for '%s' event", eventType)
+	}
+	return subs
+}
+
+// get returns the current subscription with the ID
+func (r *Registry) get(id string) (*api.Subscription, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	sub, ok := r.subscriptions[id]
+	return sub, ok
+}
+
+// handler returns the handler of the current subscription with the ID of
+// sub, connecting to it the first time it is needed. Events dispatched to a
+// subscription that was since replaced go to its replacement.
+func (r *Registry) handler(ctx context.Context, sub *api.Subscription) (EventHandler, error) {
+	r.mu.Lock()
+	current, ok := r.subscriptions[sub.ID]
+	if !ok {
+		r.mu.Unlock()
+		return nil, ErrUnknownSubscription
+	}
+	hc := r.handlers[sub.ID]
+	if hc == nil {
+		hc = &handlerConn{sub: current}
+		r.handlers[sub.ID] = hc
+	}
+	r.mu.Unlock()
+
+	return hc.get(ctx, r.dial)
+}
+
+// get returns the handler, dialing it if it isn't connected yet
+func (hc *handlerConn) get(ctx context.Context, dial dialFunc) (EventHandler, error) {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	if hc.closed {
+		return nil, errHandlerClosed
+	}
+	if hc.handler == nil {
+		c, conn, err := dial(ctx, hc.sub)
+		if err != nil {
+			return nil, err
+		}
+		hc.handler = newEventHandler(hc.sub.HandlerType, c)
+		hc.conn = conn
+	}
+	return hc.handler, nil
+}
+
+// close closes the connection of the handler, waiting for a dial in
+// progress. Deliveries in flight on the connection fail and are retried.
+func (hc *handlerConn) close() {
+	if hc == nil {
+		return
+	}
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	hc.closed = true
+	if hc.conn != nil {
+		if err := hc.conn.Close(); err != nil {
+			logrus.Warnf("Could not close the connection to event handler %s: %v", hc.sub.ID, err)
+		}
+		hc.conn = nil
+	}
+}
+
+func matches(sub *api.Subscription, eventType string) bool {
+	for _, pattern := range sub.EventTypes {
+		if ok, _ := path.Match(pattern, eventType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// grpcDialer returns the dialFunc connecting to handlers over gRPC
+func grpcDialer(cf *secureconn.Factory) dialFunc {
+	return func(ctx context.Context, sub *api.Subscription) (EventHandlerClient, io.Closer, error) {
+		conn, err := cf.DialContext(ctx, sub.ServiceName, sub.Target, grpc.WithBlock())
+		if err != nil {
+			logrus.Errorf("Event service could not get event handler client; error grpc dialing %s's event handler %s", sub.ServiceName, err.Error())
+			return nil, nil, err
+		}
+
+		switch sub.HandlerType {
+		case config.FEED_KEY:
+			return automate_feed.NewFeedServiceClient(conn), conn, nil
+		case config.CFG_KEY:
+			return ingest.NewEventHandlerClient(conn), conn, nil
+		case config.COMPLIANCE_INGEST_KEY:
+			return compliance_ingest.NewComplianceIngesterClient(conn), conn, nil
+		case config.AUTHZ:
+			return iam_v2.NewProjectsClient(conn), conn, nil
+		case config.EVENT_HANDLER:
+			return api.NewEventHandlerServiceClient(conn), conn, nil
+		default: // rejected on subscribe
+			conn.Close() // nolint: errcheck
+			return nil, nil, errors.New("can't find client event handler for unrecognized event handler type")
+		}
+	}
+}
+
+func validateSubscription(sub *api.Subscription) error {
+	if sub == nil {
+		return invalid("subscription is required")
+	}
+	if sub.ID == "" {
+		return invalid("subscription ID is required")
+	}
+	if sub.Target == "" {
+		return invalid("subscription %s: target is required", sub.ID)
+	}
+	if len(sub.EventTypes) == 0 {
+		return invalid("subscription %s: at least one event type is required", sub.ID)
+	}
+	for _, pattern := range sub.EventTypes {
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			return invalid("subscription %s: invalid event type pattern %q", sub.ID, pattern)
+		}
+	}
+	switch sub.HandlerType {
+	case config.FEED_KEY, config.CFG_KEY, config.COMPLIANCE_INGEST_KEY, config.AUTHZ:
+	case config.EVENT_HANDLER:
+		if sub.ServiceName == "" {
+			return invalid("subscription %s: service name is required for %s handlers", sub.ID, config.EVENT_HANDLER)
+		}
+	default:
+		return invalid("subscription %s: unknown handler type %q", sub.ID, sub.HandlerType)
+	}
+	return nil
+}
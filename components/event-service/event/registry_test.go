@@ -0,0 +1,352 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/config"
+	"github.com/chef/automate/components/event-service/storage"
+)
+
+// memStore is an in-memory storage.Client
+type memStore struct {
+	mu            sync.Mutex
+	subscriptions map[string]*api.Subscription
+	deadLetters   map[string]*api.DeadLetter
+	events        map[string]*api.EventMsg
+	deliveries    map[string][]*api.Delivery // by event ID
+	recordEvent   func(*api.EventMsg) error  // called before recording an event
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		subscriptions: make(map[string]*api.Subscription),
+		deadLetters:   make(map[string]*api.DeadLetter),
+		events:        make(map[string]*api.EventMsg),
+		deliveries:    make(map[string][]*api.Delivery),
+	}
+}
+
+func (s *memStore) ListSubscriptions() ([]*api.Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	subs := make([]*api.Subscription, 0, len(s.subscriptions))
+	for _, sub := range s.subscriptions {
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+func (s *memStore) StoreSubscription(sub *api.Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions[sub.ID] = sub
+	return nil
+}
+
+func (s *memStore) DeleteSubscription(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subscriptions[id]; !ok {
+		return storage.ErrNotFound
+	}
+	delete(s.subscriptions, id)
+	return nil
+}
+
+func (s *memStore) StoreDeadLetter(deadLetter *api.DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deadLetters[deadLetter.ID] = deadLetter
+	return nil
+}
+
+func (s *memStore) ListDeadLetters(subscriptionID string, eventType string) ([]*api.DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deadLetters := make([]*api.DeadLetter, 0, len(s.deadLetters))
+	for _, deadLetter := range s.deadLetters {
+		if subscriptionID != "" && deadLetter.SubscriptionID != subscriptionID {
+			continue
+		}
+		if eventType != "" && deadLetter.Event.GetType().GetName() != eventType {
+			continue
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, nil
+}
+
+func (s *memStore) GetDeadLetter(id string) (*api.DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deadLetter, ok := s.deadLetters[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return deadLetter, nil
+}
+
+func (s *memStore) UpdateDeadLetter(id string, attempts int32, lastError string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deadLetter, ok := s.deadLetters[id]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	deadLetter.Attempts = attempts
+	deadLetter.LastError = lastError
+	return nil
+}
+
+func (s *memStore) DeleteDeadLetter(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.deadLetters[id]; !ok {
+		return storage.ErrNotFound
+	}
+	delete(s.deadLetters, id)
+	return nil
+}
+
+func (s *memStore) RecordEvent(event *api.EventMsg) error {
+	if s.recordEvent != nil {
+		if err := s.recordEvent(event); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events[event.EventID] = event
+	return nil
+}
+
+func (s *memStore) RecordDelivery(eventID string, delivery *api.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.events[eventID]; !ok {
+		return errors.New("violates foreign key constraint")
+	}
+	s.deliveries[eventID] = append(s.deliveries[eventID], delivery)
+	return nil
+}
+
+func (s *memStore) ListEvents(storage.EventFilter) ([]*api.EventRecord, int64, error) {
+	return nil, 0, errors.New("not implemented")
+}
+
+func (s *memStore) PurgeEvents(before time.Time) (int64, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (s *memStore) deliveriesOf(eventID string) []*api.Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deliveries[eventID]
+}
+
+// fakeHandlerClient fails the first failures events it is handed
+type fakeHandlerClient struct {
+	mu       sync.Mutex
+	failures int
+	events   []*api.EventMsg
+	attempts int
+}
+
+func (c *fakeHandlerClient) HandleEvent(ctx context.Context, in *api.EventMsg, opts ...grpc.CallOption) (*api.EventResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attempts++
+	if c.attempts <= c.failures {
+		return nil, errors.New("handler unavailable")
+	}
+	c.events = append(c.events, in)
+	return &api.EventResponse{}, nil
+}
+
+func (c *fakeHandlerClient) handled() []*api.EventMsg {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.events
+}
+
+// fakeConn counts how often it is closed
+type fakeConn struct {
+	mu     sync.Mutex
+	target string
+	closes int
+}
+
+func (c *fakeConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closes++
+	return nil
+}
+
+func (c *fakeConn) closed() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closes
+}
+
+// fakeDialer hands out the client for the target of the subscription,
+// recording a connection per dial
+type fakeDialer struct {
+	mu      sync.Mutex
+	clients map[string]*fakeHandlerClient // by target
+	conns   []*fakeConn
+	delay   time.Duration
+}
+
+func (d *fakeDialer) dial(ctx context.Context, sub *api.Subscription) (EventHandlerClient, io.Closer, error) {
+	time.Sleep(d.delay)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	client, ok := d.clients[sub.Target]
+	if !ok {
+		return nil, nil, errors.New("connection refused")
+	}
+	conn := &fakeConn{target: sub.Target}
+	d.conns = append(d.conns, conn)
+	return client, conn, nil
+}
+
+func (d *fakeDialer) dialed() []*fakeConn {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]*fakeConn{}, d.conns...)
+}
+
+func newTestRegistry(t *testing.T, store storage.Client, dialer *fakeDialer) *Registry {
+	r, err := NewRegistry(store, nil)
+	require.NoError(t, err)
+	r.dial = dialer.dial
+	return r
+}
+
+func testSubscription(id, target string) *api.Subscription {
+	return &api.Subscription{
+		ID:          id,
+		HandlerType: config.EVENT_HANDLER,
+		ServiceName: "test-service",
+		Target:      target,
+		EventTypes:  []string{"test.*"},
+	}
+}
+
+func TestRegistryConcurrentFirstDeliveriesShareAConnection(t *testing.T) {
+	dialer := &fakeDialer{
+		clients: map[string]*fakeHandlerClient{"handler:1": {}},
+		delay:   10 * time.Millisecond,
+	}
+	r := newTestRegistry(t, newMemStore(), dialer)
+	sub, err := r.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := r.handler(context.Background(), sub)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, dialer.dialed(), 1)
+}
+
+func TestRegistrySubscribeClosesReplacedConnection(t *testing.T) {
+	replacement := &fakeHandlerClient{}
+	dialer := &fakeDialer{clients: map[string]*fakeHandlerClient{
+		"handler:1": {},
+		"handler:2": replacement,
+	}}
+	r := newTestRegistry(t, newMemStore(), dialer)
+	old, err := r.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	_, err = r.handler(context.Background(), old)
+	require.NoError(t, err)
+
+	_, err = r.Subscribe(testSubscription("sub-1", "handler:2"))
+	require.NoError(t, err)
+
+	conns := dialer.dialed()
+	require.Len(t, conns, 1)
+	assert.Equal(t, 1, conns[0].closed())
+
+	// events dispatched to the old subscription go to its replacement
+	h, err := r.handler(context.Background(), old)
+	require.NoError(t, err)
+	assert.True(t, h.GetClient() == replacement)
+	conns = dialer.dialed()
+	require.Len(t, conns, 2)
+	assert.Equal(t, "handler:2", conns[1].target)
+	assert.Equal(t, 0, conns[1].closed())
+}
+
+func TestRegistryUnsubscribeClosesConnection(t *testing.T) {
+	dialer := &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": {}}}
+	r := newTestRegistry(t, newMemStore(), dialer)
+	sub, err := r.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	_, err = r.handler(context.Background(), sub)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Unsubscribe("sub-1"))
+
+	conns := dialer.dialed()
+	require.Len(t, conns, 1)
+	assert.Equal(t, 1, conns[0].closed())
+
+	_, err = r.handler(context.Background(), sub)
+	assert.Equal(t, ErrUnknownSubscription, err)
+	assert.Len(t, dialer.dialed(), 1)
+}
+
+func TestRegistryUnsubscribeWithoutConnection(t *testing.T) {
+	r := newTestRegistry(t, newMemStore(), &fakeDialer{})
+	_, err := r.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+	assert.NoError(t, r.Unsubscribe("sub-1"))
+	assert.Equal(t, storage.ErrNotFound, r.Unsubscribe("sub-1"))
+}
+
+func TestHandlerConnClosedWhileInUse(t *testing.T) {
+	dialer := &fakeDialer{clients: map[string]*fakeHandlerClient{"handler:1": {}}}
+	hc := &handlerConn{sub: testSubscription("sub-1", "handler:1")}
+	_, err := hc.get(context.Background(), dialer.dial)
+	require.NoError(t, err)
+
+	hc.close()
+	_, err = hc.get(context.Background(), dialer.dial)
+	assert.Equal(t, errHandlerClosed, err)
+	assert.Len(t, dialer.dialed(), 1)
+}
+
+func TestRegistryFailedDialIsRetried(t *testing.T) {
+	dialer := &fakeDialer{clients: map[string]*fakeHandlerClient{}}
+	r := newTestRegistry(t, newMemStore(), dialer)
+	sub, err := r.Subscribe(testSubscription("sub-1", "handler:1"))
+	require.NoError(t, err)
+
+	_, err = r.handler(context.Background(), sub)
+	assert.Error(t, err)
+
+	dialer.mu.Lock()
+	dialer.clients["handler:1"] = &fakeHandlerClient{}
+	dialer.mu.Unlock()
+	_, err = r.handler(context.Background(), sub)
+	assert.NoError(t, err)
+}
@@ -32,6 +32,8 @@ pkg_exposes=(
   internal_messaging_gateway_port
 )
 pkg_binds=(
+  [automate-pg-gateway]="port"
+  [pg-sidecar-service]="port"
 )
 
 pkg_bin_dirs=(bin)
@@ -44,6 +46,14 @@ scaffolding_go_binary_list=(
   "${scaffolding_go_import_path}/cmd/${pkg_name}"
 )
 
+do_install() {
+  # Go scaffolding install callback
+  scaffolding_go_install
+
+  build_line "Copying schema sql files"
+  cp -r storage/postgres/schema/sql "${pkg_prefix}/schema"
+}
+
 do_strip() {
     return 0;
 }
@@ -0,0 +1,82 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/config"
+)
+
+func TestSubscribe(t *testing.T) {
+	ctx := context.Background()
+	sub := &api.Subscription{
+		ID:          "test-subscriber",
+		EventTypes:  []string{"scanJob*", "nodeTerminated"},
+		Target:      "0.0.0.0:10199",
+		HandlerType: config.EVENT_HANDLER,
+		ServiceName: "test-service",
+	}
+
+	t.Run("subscribing registers the subscription", func(t *testing.T) {
+		resp, err := getEventClient().Subscribe(ctx, &api.SubscribeRequest{Subscription: sub})
+		require.NoError(t, err)
+		assert.Equal(t, sub.ID, resp.Subscription.ID)
+
+		list, err := getEventClient().ListSubscriptions(ctx, &api.ListSubscriptionsRequest{})
+		require.NoError(t, err)
+		assert.Contains(t, subscriptionIDs(list.Subscriptions), sub.ID)
+	})
+
+	t.Run("subscribing again replaces the subscription", func(t *testing.T) {
+		updated := *sub
+		updated.EventTypes = []string{"profile*"}
+		_, err := getEventClient().Subscribe(ctx, &api.SubscribeRequest{Subscription: &updated})
+		require.NoError(t, err)
+
+		list, err := getEventClient().ListSubscriptions(ctx, &api.ListSubscriptionsRequest{})
+		require.NoError(t, err)
+		for _, s := range list.Subscriptions {
+			if s.ID == sub.ID {
+				assert.Equal(t, []string{"profile*"}, s.EventTypes)
+			}
+		}
+	})
+
+	t.Run("unsubscribing removes the subscription", func(t *testing.T) {
+		_, err := getEventClient().Unsubscribe(ctx, &api.UnsubscribeRequest{ID: sub.ID})
+		require.NoError(t, err)
+
+		list, err := getEventClient().ListSubscriptions(ctx, &api.ListSubscriptionsRequest{})
+		require.NoError(t, err)
+		assert.NotContains(t, subscriptionIDs(list.Subscriptions), sub.ID)
+
+		_, err = getEventClient().Unsubscribe(ctx, &api.UnsubscribeRequest{ID: sub.ID})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("an unknown handler type is rejected", func(t *testing.T) {
+		invalid := *sub
+		invalid.HandlerType = "carrier-pigeon"
+		_, err := getEventClient().Subscribe(ctx, &api.SubscribeRequest{Subscription: &invalid})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("built in subscriptions can't be removed", func(t *testing.T) {
+		_, err := getEventClient().Unsubscribe(ctx, &api.UnsubscribeRequest{ID: config.CFG_KEY})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func subscriptionIDs(subs []*api.Subscription) []string {
+	ids := make([]string, len(subs))
+	for i, s := range subs {
+		ids[i] = s.ID
+	}
+	return ids
+}
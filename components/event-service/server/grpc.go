@@ -12,6 +12,7 @@ import (
 
 	es "github.com/chef/automate/api/interservice/event"
 	"github.com/chef/automate/components/event-service/config"
+	"github.com/chef/automate/components/event-service/storage/postgres"
 	"github.com/chef/automate/lib/grpc/health"
 	"github.com/chef/automate/lib/grpc/secureconn"
 	"github.com/chef/automate/lib/tracing"
@@ -24,7 +25,14 @@ func NewGRPC(ctx context.Context, config *config.EventConfig) *grpc.Server {
 
 	// Register our API
 	grpcServer := connFactory.NewServer(tracing.GlobalServerInterceptor())
-	srv := New(config)
+	store, err := postgres.New(&config.Postgres)
+	if err != nil {
+		log.WithError(err).Fatal("could not initialize event-service storage")
+	}
+	srv, err := New(config, store)
+	if err != nil {
+		log.WithError(err).Fatal("could not initialize event-service server")
+	}
 	es.RegisterEventServiceServer(grpcServer, srv)
 	health.RegisterHealthServer(grpcServer, srv.health)
 
@@ -3,11 +3,15 @@ package server
 import (
 	"context"
 
-	"github.com/sirupsen/logrus"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	api "github.com/chef/automate/api/interservice/event"
 	"github.com/chef/automate/components/event-service/config"
 	"github.com/chef/automate/components/event-service/event"
+	"github.com/chef/automate/components/event-service/storage"
 	"github.com/chef/automate/lib/grpc/health"
 	"github.com/chef/automate/lib/grpc/secureconn"
 )
@@ -25,46 +29,81 @@ const (
 	ProjectRulesUpdate       = "projectRulesUpdate"
 	ProjectRulesUpdateFailed = "projectRulesUpdateFailed"
 	ProjectRulesUpdateStatus = "projectRulesUpdateStatus"
+	ScheduledBackupFailed    = "scheduledBackupFailed"
 )
 
+// builtinSubscriptions are the handlers of the automate services that
+// consume events without subscribing themselves. They are registered on
+// startup with the endpoints from the config and can't be unsubscribed.
+func builtinSubscriptions(cfg *config.EventConfig) []*api.Subscription {
+	return []*api.Subscription{
+		{
+			ID:          config.FEED_KEY,
+			EventTypes:  []string{ScanJobCreated, ScanJobUpdated, ScanJobDeleted, ProfileCreated, ProfileUpdated, ProfileDeleted},
+			Target:      cfg.HandlerEndpoints.Feed,
+			HandlerType: config.FEED_KEY,
+		},
+		{
+			ID:          config.CFG_KEY,
+			EventTypes:  []string{NodeTerminated, ProjectRulesUpdate},
+			Target:      cfg.HandlerEndpoints.CfgIngest,
+			HandlerType: config.CFG_KEY,
+		},
+		{
+			ID:          config.COMPLIANCE_INGEST_KEY,
+			EventTypes:  []string{ProjectRulesUpdate},
+			Target:      cfg.HandlerEndpoints.Feed,
+			HandlerType: config.COMPLIANCE_INGEST_KEY,
+		},
+		{
+			ID:          config.AUTHZ,
+			EventTypes:  []string{ProjectRulesUpdateStatus},
+			Target:      cfg.HandlerEndpoints.Authz,
+			HandlerType: config.AUTHZ,
+		},
+	}
+}
+
+const defaultEventsPerPage = 100
+
 //----------  SERVER  ----------//
 
 type Server struct {
 	eventsService *event.Events
+	registry      *event.Registry
+	store         storage.Client
 	isStarted     bool
 	cfg           *config.EventConfig
 	health        *health.Service
 }
 
-func New(cfg *config.EventConfig) *Server {
+func New(cfg *config.EventConfig, store storage.Client) (*Server, error) {
 	f := secureconn.NewFactory(*cfg.ServiceCerts)
 
+	registry, err := event.NewRegistry(store, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading event handler registry")
+	}
+	for _, sub := range builtinSubscriptions(cfg) {
+		if _, err := registry.Subscribe(sub); err != nil {
+			return nil, errors.Wrapf(err, "registering %s event handler", sub.ID)
+		}
+	}
+
 	server := Server{
-		eventsService: event.NewEvents(cfg, f),
+		eventsService: event.NewEvents(cfg, registry, store),
+		registry:      registry,
+		store:         store,
 		isStarted:     false,
 		cfg:           cfg,
 		health:        health.NewService(),
 	}
 
-	go server.eventsService.Start(server.initRegistry())
+	go server.eventsService.Start()
+	go server.eventsService.RecordHistory()
+	go server.eventsService.PurgeHistory()
 	server.isStarted = true
-	return &server
-}
-
-func (s *Server) initRegistry() map[string][]string {
-
-	registry := make(map[string][]string)
-	registry[ScanJobCreated] = []string{config.FEED_KEY}
-	registry[ScanJobUpdated] = []string{config.FEED_KEY}
-	registry[ScanJobDeleted] = []string{config.FEED_KEY}
-	registry[ProfileCreated] = []string{config.FEED_KEY}
-	registry[ProfileUpdated] = []string{config.FEED_KEY}
-	registry[ProfileDeleted] = []string{config.FEED_KEY}
-	registry[NodeTerminated] = []string{config.CFG_KEY}
-	registry[ProjectRulesUpdate] = []string{config.CFG_KEY, config.COMPLIANCE_INGEST_KEY}
-	registry[ProjectRulesUpdateStatus] = []string{config.AUTHZ}
-	logrus.Debug("Registry of events to handler types initialized...")
-	return registry
+	return &server, nil
 }
 
 func (s *Server) Publish(ctx context.Context, req *api.PublishRequest) (*api.PublishResponse, error) {
@@ -77,15 +116,129 @@ func (s *Server) Publish(ctx context.Context, req *api.PublishRequest) (*api.Pub
 	return &api.PublishResponse{Success: false}, nil
 }
 
-// Should take a reference to another service's client, which should have a HandleEvent method on it
+// Subscribe registers the handler of another service for the event types of
+// the subscription, replacing its previous subscription with the same ID
 func (s *Server) Subscribe(ctx context.Context, req *api.SubscribeRequest) (*api.SubscribeResponse, error) {
-	return nil, nil
+	sub := req.GetSubscription()
+	if s.isBuiltin(sub.GetID()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "subscription %q is built in", sub.GetID())
+	}
+
+	sub, err := s.registry.Subscribe(sub)
+	if err != nil {
+		if _, ok := err.(*event.ValidationError); ok {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.SubscribeResponse{Subscription: sub}, nil
+}
+
+// Unsubscribe removes the subscription; its handler gets no further events
+func (s *Server) Unsubscribe(ctx context.Context, req *api.UnsubscribeRequest) (*api.UnsubscribeResponse, error) {
+	if s.isBuiltin(req.GetID()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "subscription %q is built in", req.GetID())
+	}
+
+	err := s.registry.Unsubscribe(req.GetID())
+	switch {
+	case err == storage.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "no subscription with ID %q", req.GetID())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.UnsubscribeResponse{}, nil
+}
+
+func (s *Server) ListSubscriptions(ctx context.Context, req *api.ListSubscriptionsRequest) (*api.ListSubscriptionsResponse, error) {
+	return &api.ListSubscriptionsResponse{Subscriptions: s.registry.List()}, nil
+}
+
+// ListDeadLetters returns the events that could not be delivered, newest first
+func (s *Server) ListDeadLetters(ctx context.Context, req *api.ListDeadLettersRequest) (*api.ListDeadLettersResponse, error) {
+	deadLetters, err := s.store.ListDeadLetters(req.GetSubscriptionID(), req.GetEventType())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.ListDeadLettersResponse{DeadLetters: deadLetters}, nil
+}
+
+func (s *Server) GetDeadLetter(ctx context.Context, req *api.GetDeadLetterRequest) (*api.DeadLetter, error) {
+	deadLetter, err := s.store.GetDeadLetter(req.GetID())
+	switch {
+	case err == storage.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "no dead letter with ID %q", req.GetID())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return deadLetter, nil
+}
+
+// ReplayDeadLetter delivers the event of the dead letter again, removing the
+// dead letter if the handler takes it
+func (s *Server) ReplayDeadLetter(ctx context.Context, req *api.ReplayDeadLetterRequest) (*api.ReplayDeadLetterResponse, error) {
+	err := s.eventsService.Replay(req.GetID())
+	if _, ok := err.(*event.DeliveryError); ok {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	switch {
+	case err == storage.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "no dead letter with ID %q", req.GetID())
+	case err == event.ErrUnknownSubscription:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.ReplayDeadLetterResponse{}, nil
+}
+
+// ListEvents queries the history of published events
+func (s *Server) ListEvents(ctx context.Context, req *api.ListEventsRequest) (*api.ListEventsResponse, error) {
+	filter := storage.EventFilter{
+		EventType: req.GetEventType(),
+		Producer:  req.GetProducer(),
+		Page:      int(req.GetPage()),
+		PerPage:   int(req.GetPerPage()),
+	}
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PerPage <= 0 {
+		filter.PerPage = defaultEventsPerPage
+	}
+
+	var err error
+	if req.GetStart() != nil {
+		if filter.Start, err = ptypes.Timestamp(req.GetStart()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start time: %s", err)
+		}
+	}
+	if req.GetEnd() != nil {
+		if filter.End, err = ptypes.Timestamp(req.GetEnd()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end time: %s", err)
+		}
+	}
+
+	events, total, err := s.store.ListEvents(filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.ListEventsResponse{Events: events, Total: total}, nil
+}
+
+func (s *Server) isBuiltin(id string) bool {
+	for _, sub := range builtinSubscriptions(s.cfg) {
+		if sub.ID == id {
+			return true
+		}
+	}
+	return false
 }
 
 // Starts the automate-event event listener loop
 func (s *Server) Start(ctx context.Context, req *api.StartRequest) (*api.StartResponse, error) {
 	if !s.isStarted {
-		s.eventsService.Start(s.initRegistry())
+		s.eventsService.Start()
 		s.isStarted = true
 	}
 	return nil, nil
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"errors"
+	"time"
+
+	api "github.com/chef/automate/api/interservice/event"
+)
+
+// ErrNotFound is returned when a requested record does not exist
+var ErrNotFound = errors.New("not found")
+
+// Delivery statuses recorded in the event history
+const (
+	DeliveryDelivered    = "delivered"
+	DeliveryDeadLettered = "dead_lettered"
+)
+
+// EventFilter selects events from the history; zero fields match everything
+type EventFilter struct {
+	EventType string
+	Producer  string // producer ID or name
+	Start     time.Time
+	End       time.Time
+	Page      int // starting at 1
+	PerPage   int
+}
+
+// Client is the interface to the event-service store, which persists the
+// handler subscriptions across restarts, the events that could not be
+// delivered and the history of published events
+type Client interface {
+	// ListSubscriptions returns every registered subscription
+	ListSubscriptions() ([]*api.Subscription, error)
+	// StoreSubscription creates the subscription or replaces the one with
+	// the same ID
+	StoreSubscription(*api.Subscription) error
+	// DeleteSubscription removes the subscription, returning ErrNotFound if
+	// there is none with the ID
+	DeleteSubscription(id string) error
+
+	// StoreDeadLetter persists an event that exhausted its delivery retries
+	StoreDeadLetter(*api.DeadLetter) error
+	// ListDeadLetters returns the dead letters, newest first, of the
+	// subscription and event type; empty arguments match everything
+	ListDeadLetters(subscriptionID string, eventType string) ([]*api.DeadLetter, error)
+	// GetDeadLetter returns ErrNotFound if there is no dead letter with the ID
+	GetDeadLetter(id string) (*api.DeadLetter, error)
+	// UpdateDeadLetter records another failed delivery of the dead letter
+	UpdateDeadLetter(id string, attempts int32, lastError string) error
+	// DeleteDeadLetter removes the dead letter once it has been delivered
+	DeleteDeadLetter(id string) error
+
+	// RecordEvent adds the published event to the history
+	RecordEvent(*api.EventMsg) error
+	// RecordDelivery stores the outcome of delivering the event to the
+	// handler of the subscription, replacing any previous outcome
+	RecordDelivery(eventID string, delivery *api.Delivery) error
+	// ListEvents returns a page of the events matching the filter, newest
+	// first, and the total number of matching events
+	ListEvents(EventFilter) ([]*api.EventRecord, int64, error)
+	// PurgeEvents removes the events received before the time
+	PurgeEvents(before time.Time) (int64, error)
+}
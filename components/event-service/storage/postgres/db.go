@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	gosql "database/sql"
+
+	"github.com/chef/automate/components/event-service/config"
+	"github.com/chef/automate/lib/db/migrator"
+	"github.com/go-gorp/gorp"
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// postgres holds the database mapping object and the storage config.
+// It implements the storage.Client interface
+type postgres struct {
+	*gorp.DbMap
+	*config.Postgres
+}
+
+// New connects to the database and runs the schema migrations
+func New(dbConf *config.Postgres) (*postgres, error) {
+	pg := &postgres{Postgres: dbConf}
+
+	log.WithFields(log.Fields{
+		"uri": pg.URI,
+	}).Debug("Connecting to PostgreSQL")
+	dbMap, err := gosql.Open("postgres", pg.URI)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to open database with uri: %s", pg.URI)
+	}
+	pg.DbMap = &gorp.DbMap{Db: dbMap, Dialect: gorp.PostgresDialect{}}
+
+	if err := pg.Db.Ping(); err != nil {
+		return nil, errors.Wrapf(err, "Failed to ping database with uri: %s", pg.URI)
+	}
+
+	log.WithFields(log.Fields{
+		"uri":    pg.URI,
+		"schema": pg.SchemaPath,
+	}).Debug("Initializing database")
+	if err := migrator.Migrate(pg.URI, pg.SchemaPath); err != nil {
+		return nil, errors.Wrapf(err, "Unable to create database schema. [path:%s]", pg.SchemaPath)
+	}
+
+	return pg, nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/storage"
+)
+
+type deadLetter struct {
+	ID             string    `db:"id"`
+	SubscriptionID string    `db:"subscription_id"`
+	EventID        string    `db:"event_id"`
+	EventType      string    `db:"event_type"`
+	Event          []byte    `db:"event"`
+	Attempts       int32     `db:"attempts"`
+	LastError      string    `db:"last_error"`
+	CreatedAt      time.Time `db:"created_at"`
+	LastAttemptAt  time.Time `db:"last_attempt_at"`
+}
+
+const insertDeadLetter = `
+INSERT INTO dead_letters (id, subscription_id, event_id, event_type, event, attempts, last_error)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+const selectDeadLetters = `
+SELECT id, subscription_id, event_id, event_type, event, attempts, last_error, created_at, last_attempt_at
+  FROM dead_letters
+ WHERE ($1 = '' OR subscription_id = $1)
+   AND ($2 = '' OR event_type = $2)
+ ORDER BY created_at DESC
+`
+
+const selectDeadLetter = `
+SELECT id, subscription_id, event_id, event_type, event, attempts, last_error, created_at, last_attempt_at
+  FROM dead_letters
+ WHERE id = $1
+`
+
+const updateDeadLetter = `
+UPDATE dead_letters
+   SET attempts = $2, last_error = $3, last_attempt_at = NOW()
+ WHERE id = $1
+`
+
+const deleteDeadLetter = `
+DELETE FROM dead_letters WHERE id = $1
+`
+
+func (db *postgres) StoreDeadLetter(dl *api.DeadLetter) error {
+	event, err := proto.Marshal(dl.Event)
+	if err != nil {
+		return errors.Wrapf(err, "marshaling event %s", dl.Event.GetEventID())
+	}
+
+	_, err = db.Exec(insertDeadLetter, dl.ID, dl.SubscriptionID, dl.Event.GetEventID(),
+		dl.Event.GetType().GetName(), event, dl.Attempts, dl.LastError)
+	return errors.Wrapf(err, "storing dead letter %s", dl.ID)
+}
+
+func (db *postgres) ListDeadLetters(subscriptionID string, eventType string) ([]*api.DeadLetter, error) {
+	var rows []deadLetter
+	if _, err := db.Select(&rows, selectDeadLetters, subscriptionID, eventType); err != nil {
+		return nil, errors.Wrap(err, "listing dead letters")
+	}
+
+	deadLetters := make([]*api.DeadLetter, len(rows))
+	for i, row := range rows {
+		dl, err := row.toAPI()
+		if err != nil {
+			return nil, err
+		}
+		deadLetters[i] = dl
+	}
+	return deadLetters, nil
+}
+
+func (db *postgres) GetDeadLetter(id string) (*api.DeadLetter, error) {
+	var rows []deadLetter
+	if _, err := db.Select(&rows, selectDeadLetter, id); err != nil {
+		return nil, errors.Wrapf(err, "reading dead letter %s", id)
+	}
+	if len(rows) == 0 {
+		return nil, storage.ErrNotFound
+	}
+	return rows[0].toAPI()
+}
+
+func (db *postgres) UpdateDeadLetter(id string, attempts int32, lastError string) error {
+	_, err := db.Exec(updateDeadLetter, id, attempts, lastError)
+	return errors.Wrapf(err, "updating dead letter %s", id)
+}
+
+func (db *postgres) DeleteDeadLetter(id string) error {
+	_, err := db.Exec(deleteDeadLetter, id)
+	return errors.Wrapf(err, "deleting dead letter %s", id)
+}
+
+func (row *deadLetter) toAPI() (*api.DeadLetter, error) {
+	event := &api.EventMsg{}
+	if err := proto.Unmarshal(row.Event, event); err != nil {
+		return nil, errors.Wrapf(err, "unmarshaling event of dead letter %s", row.ID)
+	}
+	created, err := ptypes.TimestampProto(row.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	lastAttempt, err := ptypes.TimestampProto(row.LastAttemptAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DeadLetter{
+		ID:             row.ID,
+		SubscriptionID: row.SubscriptionID,
+		Event:          event,
+		Attempts:       row.Attempts,
+		LastError:      row.LastError,
+		Created:        created,
+		LastAttempt:    lastAttempt,
+	}, nil
+}
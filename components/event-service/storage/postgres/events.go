@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/storage"
+)
+
+type eventRow struct {
+	EventID    string    `db:"event_id"`
+	Event      []byte    `db:"event"`
+	ReceivedAt time.Time `db:"received_at"`
+}
+
+type deliveryRow struct {
+	EventID        string    `db:"event_id"`
+	SubscriptionID string    `db:"subscription_id"`
+	Status         string    `db:"status"`
+	Attempts       int32     `db:"attempts"`
+	LastError      string    `db:"last_error"`
+	CompletedAt    time.Time `db:"completed_at"`
+}
+
+const insertEvent = `
+INSERT INTO events (event_id, event_type, producer_id, producer_name, object_id, object_type, published, event)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (event_id) DO NOTHING
+`
+
+const upsertDelivery = `
+INSERT INTO event_deliveries (event_id, subscription_id, status, attempts, last_error)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (event_id, subscription_id) DO UPDATE
+  SET status = EXCLUDED.status,
+      attempts = EXCLUDED.attempts,
+      last_error = EXCLUDED.last_error,
+      completed_at = NOW()
+`
+
+const eventFilterClause = `
+ WHERE ($1 = '' OR event_type = $1)
+   AND ($2 = '' OR producer_id = $2 OR producer_name = $2)
+   AND ($3::TIMESTAMPTZ IS NULL OR published >= $3)
+   AND ($4::TIMESTAMPTZ IS NULL OR published <= $4)
+`
+
+const selectEvents = `
+SELECT event_id, event, received_at
+  FROM events` + eventFilterClause + `
+ ORDER BY published DESC, event_id
+ LIMIT $5 OFFSET $6
+`
+
+const countEvents = `
+SELECT COUNT(*)
+  FROM events` + eventFilterClause
+
+const selectDeliveries = `
+SELECT event_id, subscription_id, status, attempts, last_error, completed_at
+  FROM event_deliveries
+ WHERE event_id = ANY($1)
+ ORDER BY subscription_id
+`
+
+const deleteEventsBefore = `
+DELETE FROM events WHERE received_at < $1
+`
+
+func (db *postgres) RecordEvent(event *api.EventMsg) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return errors.Wrapf(err, "marshaling event %s", event.EventID)
+	}
+
+	published := time.Now()
+	if event.Published != nil {
+		if published, err = ptypes.Timestamp(event.Published); err != nil {
+			return errors.Wrapf(err, "reading publish time of event %s", event.EventID)
+		}
+	}
+
+	_, err = db.Exec(insertEvent, event.EventID, event.GetType().GetName(),
+		event.GetProducer().GetID(), event.GetProducer().GetProducerName(),
+		event.GetObject().GetID(), event.GetObject().GetObjectType(), published, data)
+	return errors.Wrapf(err, "recording event %s", event.EventID)
+}
+
+func (db *postgres) RecordDelivery(eventID string, delivery *api.Delivery) error {
+	_, err := db.Exec(upsertDelivery, eventID, delivery.SubscriptionID, delivery.Status,
+		delivery.Attempts, delivery.LastError)
+	return errors.Wrapf(err, "recording delivery of event %s to %s", eventID, delivery.SubscriptionID)
+}
+
+func (db *postgres) ListEvents(filter storage.EventFilter) ([]*api.EventRecord, int64, error) {
+	start := pq.NullTime{Time: filter.Start, Valid: !filter.Start.IsZero()}
+	end := pq.NullTime{Time: filter.End, Valid: !filter.End.IsZero()}
+
+	total, err := db.SelectInt(countEvents, filter.EventType, filter.Producer, start, end)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "counting events")
+	}
+
+	var rows []eventRow
+	offset := (filter.Page - 1) * filter.PerPage
+	_, err = db.Select(&rows, selectEvents, filter.EventType, filter.Producer, start, end, filter.PerPage, offset)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "listing events")
+	}
+
+	ids := make([]string, len(rows))
+	records := make(map[string]*api.EventRecord, len(rows))
+	events := make([]*api.EventRecord, len(rows))
+	for i, row := range rows {
+		record, err := row.toAPI()
+		if err != nil {
+			return nil, 0, err
+		}
+		ids[i] = row.EventID
+		records[row.EventID] = record
+		events[i] = record
+	}
+
+	var deliveries []deliveryRow
+	if _, err := db.Select(&deliveries, selectDeliveries, pq.Array(ids)); err != nil {
+		return nil, 0, errors.Wrap(err, "listing event deliveries")
+	}
+	for _, row := range deliveries {
+		completed, err := ptypes.TimestampProto(row.CompletedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+		record := records[row.EventID]
+		record.Deliveries = append(record.Deliveries, &api.Delivery{
+			SubscriptionID: row.SubscriptionID,
+			Status:         row.Status,
+			Attempts:       row.Attempts,
+			LastError:      row.LastError,
+			Completed:      completed,
+		})
+	}
+
+	return events, total, nil
+}
+
+func (db *postgres) PurgeEvents(before time.Time) (int64, error) {
+	res, err := db.Exec(deleteEventsBefore, before)
+	if err != nil {
+		return 0, errors.Wrap(err, "purging event history")
+	}
+	return res.RowsAffected()
+}
+
+func (row *eventRow) toAPI() (*api.EventRecord, error) {
+	event := &api.EventMsg{}
+	if err := proto.Unmarshal(row.Event, event); err != nil {
+		return nil, errors.Wrapf(err, "unmarshaling event %s", row.EventID)
+	}
+	received, err := ptypes.TimestampProto(row.ReceivedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &api.EventRecord{Event: event, Received: received}, nil
+}
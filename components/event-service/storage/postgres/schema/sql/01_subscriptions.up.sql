@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS subscriptions (
+  id           TEXT PRIMARY KEY,
+  event_types  TEXT[] NOT NULL,
+  target       TEXT NOT NULL,
+  handler_type TEXT NOT NULL,
+  service_name TEXT NOT NULL,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
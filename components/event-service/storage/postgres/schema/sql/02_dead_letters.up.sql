@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS dead_letters (
+  id              TEXT PRIMARY KEY,
+  subscription_id TEXT NOT NULL,
+  event_id        TEXT NOT NULL,
+  event_type      TEXT NOT NULL,
+  event           BYTEA NOT NULL,
+  attempts        INTEGER NOT NULL,
+  last_error      TEXT NOT NULL,
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS dead_letters_subscription_id_idx ON dead_letters (subscription_id);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS events (
+  event_id      TEXT PRIMARY KEY,
+  event_type    TEXT NOT NULL,
+  producer_id   TEXT NOT NULL,
+  producer_name TEXT NOT NULL,
+  object_id     TEXT NOT NULL,
+  object_type   TEXT NOT NULL,
+  published     TIMESTAMPTZ NOT NULL,
+  received_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  event         BYTEA NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS events_published_idx ON events (published);
+CREATE INDEX IF NOT EXISTS events_event_type_idx ON events (event_type);
+
+CREATE TABLE IF NOT EXISTS event_deliveries (
+  event_id        TEXT NOT NULL REFERENCES events (event_id) ON DELETE CASCADE,
+  subscription_id TEXT NOT NULL,
+  status          TEXT NOT NULL,
+  attempts        INTEGER NOT NULL,
+  last_error      TEXT NOT NULL,
+  completed_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (event_id, subscription_id)
+);
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	api "github.com/chef/automate/api/interservice/event"
+	"github.com/chef/automate/components/event-service/storage"
+)
+
+type subscription struct {
+	ID          string         `db:"id"`
+	EventTypes  pq.StringArray `db:"event_types"`
+	Target      string         `db:"target"`
+	HandlerType string         `db:"handler_type"`
+	ServiceName string         `db:"service_name"`
+}
+
+const selectSubscriptions = `
+SELECT id, event_types, target, handler_type, service_name
+  FROM subscriptions
+ ORDER BY id
+`
+
+const upsertSubscription = `
+INSERT INTO subscriptions (id, event_types, target, handler_type, service_name)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE
+  SET event_types = EXCLUDED.event_types,
+      target = EXCLUDED.target,
+      handler_type = EXCLUDED.handler_type,
+      service_name = EXCLUDED.service_name,
+      updated_at = NOW()
+`
+
+const deleteSubscription = `
+DELETE FROM subscriptions WHERE id = $1
+`
+
+func (db *postgres) ListSubscriptions() ([]*api.Subscription, error) {
+	var rows []subscription
+	if _, err := db.Select(&rows, selectSubscriptions); err != nil {
+		return nil, errors.Wrap(err, "listing subscriptions")
+	}
+
+	subs := make([]*api.Subscription, len(rows))
+	for i, row := range rows {
+		subs[i] = &api.Subscription{
+			ID:          row.ID,
+			EventTypes:  row.EventTypes,
+			Target:      row.Target,
+			HandlerType: row.HandlerType,
+			ServiceName: row.ServiceName,
+		}
+	}
+	return subs, nil
+}
+
+func (db *postgres) StoreSubscription(sub *api.Subscription) error {
+	_, err := db.Exec(upsertSubscription,
+		sub.ID, pq.StringArray(sub.EventTypes), sub.Target, sub.HandlerType, sub.ServiceName)
+	return errors.Wrapf(err, "storing subscription %s", sub.ID)
+}
+
+func (db *postgres) DeleteSubscription(id string) error {
+	res, err := db.Exec(deleteSubscription, id)
+	if err != nil {
+		return errors.Wrapf(err, "deleting subscription %s", id)
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "deleting subscription %s", id)
+	}
+	if count == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
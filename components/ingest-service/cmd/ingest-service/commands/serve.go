@@ -82,6 +82,8 @@ func readCliParams() *serveropts.Opts {
 		LogLevel:                      viper.GetString("log-level"),
 		PurgeConvergeHistoryAfterDays: int32(viper.GetInt("converge-history-days")),
 		PurgeActionsAfterDays:         int32(viper.GetInt("actions-days")),
+		EnrichmentTablePath:           viper.GetString("enrichment-table"),
+		EnrichmentKey:                 viper.GetString("enrichment-key"),
 		ConnFactory:                   factory,
 	}
 }
@@ -98,6 +100,8 @@ func init() {
 	serveCmd.Flags().String("event-address", "localhost:10132", "address of event (domain:<port>)")
 	serveCmd.Flags().Int32("converge-history-days", -1, "Number of days to keep converge history for. A number less than or equal to 0 means data should never be deleted")
 	serveCmd.Flags().Int32("actions-days", -1, "Number of days to keep actions for. A number less than or equal to 0 means data should never be deleted")
+	serveCmd.Flags().String("enrichment-table", "", "CSV or JSON lookup table of fields to enrich nodes with. Nodes are not enriched if empty")
+	serveCmd.Flags().String("enrichment-key", "node_name", "What the enrichment table is keyed by: node_name or fqdn")
 	serveCmd.Flags().String("key", "key.pem", "SSL Private key for gRPC server")
 	serveCmd.Flags().String("cert", "cert.pem", "SSL Certificate for gRPC server")
 	serveCmd.Flags().String("root-cert", "cacert.pem", "Root SSL CA Certificate for gRPC server")
@@ -0,0 +1,240 @@
+// Package enrichment reads the lookup table that the ingest pipeline
+// enriches nodes with.
+//
+// The table maps the name or FQDN of a node to fields to set on it, like the
+// node's owner or cost center. It is a CSV file with a header row, one
+// column of which is the key, or a JSON object of objects keyed by the key:
+//
+//	node_name,owner,cost_center,chef_tags
+//	web01,alice,cc-42,frontend;public
+//
+//	{"web01": {"owner": "alice", "cost_center": "cc-42", "chef_tags": ["frontend", "public"]}}
+//
+// A CSV cell holds several values separated by semicolons.
+package enrichment
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// KeyNodeName keys the table by node name
+	KeyNodeName = "node_name"
+	// KeyFqdn keys the table by FQDN
+	KeyFqdn = "fqdn"
+)
+
+// refreshInterval is how often the table file is checked for changes
+const refreshInterval = time.Minute
+
+// Fields are the fields to set on a node, by name
+type Fields map[string][]string
+
+// Table is a lookup table of fields by node name or FQDN. It reloads its file
+// when the file changes, so that the table can be updated without restarting
+// the service.
+type Table struct {
+	path string
+	key  string
+
+	mu      sync.RWMutex
+	rows    map[string]Fields
+	modTime time.Time
+	checked time.Time
+}
+
+// Load reads the table at path, a .csv or .json file, keyed by key
+func Load(path string, key string) (*Table, error) {
+	if key != KeyNodeName && key != KeyFqdn {
+		return nil, errors.Errorf("enrichment key must be %s or %s, not %q", KeyNodeName, KeyFqdn, key)
+	}
+	t := &Table{path: path, key: key}
+	if err := t.reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Key returns what the table is keyed by, KeyNodeName or KeyFqdn
+func (t *Table) Key() string {
+	return t.key
+}
+
+// Lookup returns the fields of the node with the given name or FQDN, or nil
+func (t *Table) Lookup(keyValue string) Fields {
+	t.refresh()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.rows[keyValue]
+}
+
+// refresh reloads the table file if it changed since it was last checked,
+// at most every refreshInterval. On failure, the table keeps its rows.
+func (t *Table) refresh() {
+	t.mu.RLock()
+	due := time.Since(t.checked) >= refreshInterval
+	t.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	t.mu.Lock()
+	t.checked = time.Now()
+	modTime := t.modTime
+	t.mu.Unlock()
+
+	info, err := os.Stat(t.path)
+	if err != nil {
+		log.WithError(err).WithField("path", t.path).Warn("Failed to check enrichment table")
+		return
+	}
+	if info.ModTime().Equal(modTime) {
+		return
+	}
+	if err := t.reload(); err != nil {
+		log.WithError(err).WithField("path", t.path).Warn("Failed to reload enrichment table")
+		return
+	}
+	log.WithField("path", t.path).Info("Reloaded enrichment table")
+}
+
+func (t *Table) reload() error {
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read enrichment table %s", t.path)
+	}
+	data, err := ioutil.ReadFile(t.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read enrichment table %s", t.path)
+	}
+
+	var rows map[string]Fields
+	switch strings.ToLower(filepath.Ext(t.path)) {
+	case ".csv":
+		rows, err = parseCSV(data, t.key)
+	case ".json":
+		rows, err = parseJSON(data)
+	default:
+		return errors.Errorf("enrichment table %s must be a .csv or .json file", t.path)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "invalid enrichment table %s", t.path)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.rows = rows
+	t.modTime = info.ModTime()
+	t.checked = time.Now()
+	return nil
+}
+
+func parseCSV(data []byte, key string) (map[string]Fields, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, errors.New("missing header row")
+	}
+	if err != nil {
+		return nil, err
+	}
+	keyColumn := -1
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+		if header[i] == key {
+			keyColumn = i
+		}
+	}
+	if keyColumn < 0 {
+		return nil, errors.Errorf("no %s column", key)
+	}
+
+	rows := map[string]Fields{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		keyValue := strings.TrimSpace(record[keyColumn])
+		if keyValue == "" {
+			continue
+		}
+		if _, exists := rows[keyValue]; exists {
+			return nil, errors.Errorf("duplicate %s %q", key, keyValue)
+		}
+
+		fields := Fields{}
+		for i, cell := range record {
+			if i == keyColumn || header[i] == "" {
+				continue
+			}
+			if values := splitValues(cell); len(values) > 0 {
+				fields[header[i]] = values
+			}
+		}
+		rows[keyValue] = fields
+	}
+	return rows, nil
+}
+
+func parseJSON(data []byte) (map[string]Fields, error) {
+	var raw map[string]map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	rows := make(map[string]Fields, len(raw))
+	for keyValue, rawFields := range raw {
+		fields := Fields{}
+		for name, value := range rawFields {
+			switch v := value.(type) {
+			case string:
+				if v != "" {
+					fields[name] = []string{v}
+				}
+			case []interface{}:
+				for _, item := range v {
+					s, ok := item.(string)
+					if !ok {
+						return nil, errors.Errorf("%s of %q must be a string or an array of strings", name, keyValue)
+					}
+					if s != "" {
+						fields[name] = append(fields[name], s)
+					}
+				}
+			default:
+				return nil, errors.Errorf("%s of %q must be a string or an array of strings", name, keyValue)
+			}
+		}
+		rows[keyValue] = fields
+	}
+	return rows, nil
+}
+
+func splitValues(cell string) []string {
+	var values []string
+	for _, value := range strings.Split(cell, ";") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
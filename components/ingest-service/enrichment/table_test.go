@@ -0,0 +1,116 @@
+package enrichment
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTable(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "enrichment")
+	require.NoError(t, err)
+	return dir
+}
+
+func TestLoadCSV(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := writeTable(t, dir, "owners.csv", `owner, node_name ,cost_center,chef_tags
+alice,web01,cc-42,frontend; public
+bob,db01,,
+,,cc-1,
+`)
+
+	table, err := Load(path, KeyNodeName)
+	require.NoError(t, err)
+	assert.Equal(t, KeyNodeName, table.Key())
+
+	assert.Equal(t, Fields{
+		"owner":       {"alice"},
+		"cost_center": {"cc-42"},
+		"chef_tags":   {"frontend", "public"},
+	}, table.Lookup("web01"))
+	assert.Equal(t, Fields{"owner": {"bob"}}, table.Lookup("db01"))
+	assert.Nil(t, table.Lookup("web02"))
+}
+
+func TestLoadJSON(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := writeTable(t, dir, "owners.json", `{
+  "web01.example.com": {"owner": "alice", "chef_tags": ["frontend", "public"], "cost_center": ""}
+}`)
+
+	table, err := Load(path, KeyFqdn)
+	require.NoError(t, err)
+	assert.Equal(t, Fields{
+		"owner":     {"alice"},
+		"chef_tags": {"frontend", "public"},
+	}, table.Lookup("web01.example.com"))
+}
+
+func TestLoadInvalid(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	for name, test := range map[string]struct {
+		file    string
+		content string
+		key     string
+	}{
+		"unknown key":         {"table.csv", "node_name,owner\nweb01,alice\n", "ipaddress"},
+		"no key column":       {"table.csv", "fqdn,owner\nweb01,alice\n", KeyNodeName},
+		"empty csv":           {"table.csv", "", KeyNodeName},
+		"duplicate key":       {"table.csv", "node_name,owner\nweb01,alice\nweb01,bob\n", KeyNodeName},
+		"ragged csv":          {"table.csv", "node_name,owner\nweb01,alice,extra\n", KeyNodeName},
+		"invalid json":        {"table.json", "[1, 2]", KeyNodeName},
+		"non-string in json":  {"table.json", `{"web01": {"owner": 42}}`, KeyNodeName},
+		"unknown file format": {"table.yml", "web01: {owner: alice}", KeyNodeName},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := writeTable(t, dir, test.file, test.content)
+			_, err := Load(path, test.key)
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := Load(filepath.Join(dir, "missing.csv"), KeyNodeName)
+	assert.Error(t, err)
+}
+
+func TestReloadOnChange(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := writeTable(t, dir, "owners.csv", "node_name,owner\nweb01,alice\n")
+
+	table, err := Load(path, KeyNodeName)
+	require.NoError(t, err)
+
+	writeTable(t, dir, "owners.csv", "node_name,owner\nweb01,bob\n")
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+
+	// the file is not checked again before the refresh interval
+	assert.Equal(t, Fields{"owner": {"alice"}}, table.Lookup("web01"))
+
+	table.checked = time.Now().Add(-refreshInterval)
+	assert.Equal(t, Fields{"owner": {"bob"}}, table.Lookup("web01"))
+
+	// an invalid table keeps the previous rows
+	writeTable(t, dir, "owners.csv", "owner\nbob\n")
+	later = later.Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+	table.checked = time.Now().Add(-refreshInterval)
+	assert.Equal(t, Fields{"owner": {"bob"}}, table.Lookup("web01"))
+}
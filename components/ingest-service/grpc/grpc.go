@@ -15,6 +15,7 @@ import (
 	"github.com/chef/automate/api/interservice/ingest"
 	"github.com/chef/automate/components/ingest-service/backend/elastic"
 	"github.com/chef/automate/components/ingest-service/config"
+	"github.com/chef/automate/components/ingest-service/enrichment"
 	"github.com/chef/automate/components/ingest-service/migration"
 	"github.com/chef/automate/components/ingest-service/server"
 	"github.com/chef/automate/components/ingest-service/serveropts"
@@ -97,8 +98,22 @@ func Spawn(opts *serveropts.Opts) error {
 
 	eventServiceClient := automate_event.NewEventServiceClient(eventConn)
 
+	// Enrichment
+	var enrichmentTable *enrichment.Table
+	if opts.EnrichmentTablePath != "" {
+		enrichmentTable, err = enrichment.Load(opts.EnrichmentTablePath, opts.EnrichmentKey)
+		if err != nil {
+			log.WithFields(log.Fields{"error": err}).Fatal("Failed to load enrichment table")
+			return err
+		}
+		log.WithFields(log.Fields{
+			"path": opts.EnrichmentTablePath,
+			"key":  opts.EnrichmentKey,
+		}).Info("Enriching nodes")
+	}
+
 	// ChefRuns
-	chefIngest := server.NewChefIngestServer(client, authzProjectsClient)
+	chefIngest := server.NewChefIngestServer(client, authzProjectsClient, enrichmentTable)
 	ingest.RegisterChefIngesterServer(grpcServer, chefIngest)
 
 	// Pass the chef ingest server to give status about the pipelines
@@ -297,7 +297,7 @@ func createServices(s *Suite) {
 	// ```
 	// res, err := suite.ChefIngestServer.ProcessChefAction(ctx, &req)
 	// ```
-	s.ChefIngestServer = server.NewChefIngestServer(s.ingest, s.projectsClient)
+	s.ChefIngestServer = server.NewChefIngestServer(s.ingest, s.projectsClient, nil)
 	s.EventHandlerServer = server.NewAutomateEventHandlerServer(iClient, *s.ChefIngestServer,
 		s.projectsClient, s.eventServiceClientMock)
 
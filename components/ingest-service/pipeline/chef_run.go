@@ -7,6 +7,7 @@ import (
 
 	iam_v2 "github.com/chef/automate/api/interservice/authz/v2"
 	"github.com/chef/automate/components/ingest-service/backend"
+	"github.com/chef/automate/components/ingest-service/enrichment"
 	"github.com/chef/automate/components/ingest-service/pipeline/message"
 	"github.com/chef/automate/components/ingest-service/pipeline/processor"
 	"github.com/chef/automate/components/ingest-service/pipeline/publisher"
@@ -19,22 +20,32 @@ type ChefRunPipeline struct {
 	counter *int64 // Number of messages that the pipeline has ran
 }
 
-// NewChefRunPipeline Create a new chef run pipeline
-func NewChefRunPipeline(client backend.Client, authzClient iam_v2.ProjectsClient) ChefRunPipeline {
+// NewChefRunPipeline Create a new chef run pipeline. If there is an
+// enrichment table, nodes are enriched with it before they are tagged with
+// projects, so that project rules can match on the enriched fields.
+func NewChefRunPipeline(client backend.Client, authzClient iam_v2.ProjectsClient,
+	enrichmentTable *enrichment.Table) ChefRunPipeline {
 	var (
 		in            = make(chan message.ChefRun, 100)
 		counter int64 = 0
 	)
 
-	chefRunPipeline(in,
+	pipes := []message.ChefRunPipe{
 		processor.BuildTransmogrify(9),
 		processor.ChefRunCorrections,
+	}
+	if enrichmentTable != nil {
+		pipes = append(pipes, processor.BuildRunEnricher(enrichmentTable))
+	}
+	pipes = append(pipes,
 		processor.BuildRunProjectTagger(authzClient),
 		processor.BuildRunMsgToBulkRequestTransformer(client),
 		publisher.BuildBulkRunPublisher(client),
 		processor.CountRuns(&counter),
 	)
 
+	chefRunPipeline(in, pipes...)
+
 	return ChefRunPipeline{in, &counter}
 }
 
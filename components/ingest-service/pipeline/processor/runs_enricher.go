@@ -0,0 +1,90 @@
+package processor
+
+import (
+	"sort"
+
+	"github.com/chef/automate/components/ingest-service/backend"
+	"github.com/chef/automate/components/ingest-service/enrichment"
+	"github.com/chef/automate/components/ingest-service/pipeline/message"
+	"github.com/chef/automate/lib/stringutils"
+	log "github.com/sirupsen/logrus"
+)
+
+// BuildRunEnricher - Build an enricher that sets the fields of the lookup
+// table on the node and the run of CCRs
+func BuildRunEnricher(table *enrichment.Table) message.ChefRunPipe {
+	return func(in <-chan message.ChefRun) <-chan message.ChefRun {
+		return runEnricher(in, table)
+	}
+}
+
+func runEnricher(in <-chan message.ChefRun, table *enrichment.Table) <-chan message.ChefRun {
+	out := make(chan message.ChefRun, 100)
+	go func() {
+		for msg := range in {
+			keyValue := msg.Node.NodeName
+			if table.Key() == enrichment.KeyFqdn {
+				keyValue = msg.Node.Fqdn
+			}
+
+			if fields := table.Lookup(keyValue); len(fields) > 0 {
+				log.WithFields(log.Fields{
+					"message_id": msg.ID,
+					"key":        keyValue,
+				}).Debug("Enriching ChefRun")
+
+				enrichNodeInfo(&msg.Node.NodeInfo, fields)
+				enrichNodeInfo(&msg.NodeRun.NodeInfo, fields)
+			}
+
+			out <- msg
+		}
+		close(out)
+	}()
+
+	return out
+}
+
+// enrichNodeInfo sets the fields on the node. The fields that project rules
+// and the node filters know about replace or extend the node's own, while
+// any other field, like owner, is added as a "field:value" chef tag so it
+// can be filtered and matched on the same way.
+func enrichNodeInfo(info *backend.NodeInfo, fields enrichment.Fields) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values := fields[name]
+		if len(values) == 0 {
+			continue
+		}
+		switch name {
+		case "environment":
+			info.Environment = values[0]
+		case "policy_group":
+			info.PolicyGroup = values[0]
+		case "policy_name":
+			info.PolicyName = values[0]
+		case "chef_tags":
+			info.ChefTags = appendMissing(info.ChefTags, values...)
+		case "roles":
+			info.Roles = appendMissing(info.Roles, values...)
+		default:
+			for _, value := range values {
+				info.ChefTags = appendMissing(info.ChefTags, name+":"+value)
+			}
+		}
+	}
+}
+
+func appendMissing(slice []string, values ...string) []string {
+	for _, value := range values {
+		if !stringutils.SliceContains(slice, value) {
+			slice = append(slice, value)
+		}
+	}
+	return slice
+}
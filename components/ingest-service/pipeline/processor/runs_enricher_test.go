@@ -0,0 +1,79 @@
+package processor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chef "github.com/chef/automate/api/external/ingest/request"
+	"github.com/chef/automate/components/ingest-service/backend"
+	"github.com/chef/automate/components/ingest-service/enrichment"
+	"github.com/chef/automate/components/ingest-service/pipeline/message"
+)
+
+func TestEnrichNodeInfo(t *testing.T) {
+	info := backend.NodeInfo{
+		Environment: "_default",
+		PolicyGroup: "dev",
+		ChefTags:    []string{"frontend"},
+		Roles:       []string{"web"},
+	}
+
+	enrichNodeInfo(&info, enrichment.Fields{
+		"environment": {"production"},
+		"policy_name": {"webapp"},
+		"chef_tags":   {"frontend", "public"},
+		"roles":       {"base"},
+		"owner":       {"alice"},
+		"cost_center": {"cc-42", "cc-43"},
+	})
+
+	assert.Equal(t, "production", info.Environment)
+	assert.Equal(t, "dev", info.PolicyGroup)
+	assert.Equal(t, "webapp", info.PolicyName)
+	assert.Equal(t, []string{"web", "base"}, info.Roles)
+	assert.Equal(t, []string{"frontend", "public", "cost_center:cc-42", "cost_center:cc-43", "owner:alice"}, info.ChefTags)
+}
+
+func TestRunEnricher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "enrichment")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "owners.csv")
+	require.NoError(t, ioutil.WriteFile(path, []byte("fqdn,owner,environment\nweb01.example.com,alice,production\n"), 0600))
+	table, err := enrichment.Load(path, enrichment.KeyFqdn)
+	require.NoError(t, err)
+
+	enriched := message.NewChefRun(context.Background(), &chef.Run{}, nil)
+	enriched.Node.Fqdn = "web01.example.com"
+	enriched.Node.Environment = "_default"
+	enriched.NodeRun.Fqdn = "web01.example.com"
+	enriched.NodeRun.Environment = "_default"
+	untouched := message.NewChefRun(context.Background(), &chef.Run{}, nil)
+	untouched.Node.Fqdn = "web02.example.com"
+	untouched.Node.Environment = "_default"
+
+	in := make(chan message.ChefRun, 2)
+	in <- enriched
+	in <- untouched
+	close(in)
+	out := BuildRunEnricher(table)(in)
+
+	msg := <-out
+	assert.Equal(t, "production", msg.Node.Environment)
+	assert.Equal(t, []string{"owner:alice"}, msg.Node.ChefTags)
+	assert.Equal(t, "production", msg.NodeRun.Environment)
+	assert.Equal(t, []string{"owner:alice"}, msg.NodeRun.ChefTags)
+
+	msg = <-out
+	assert.Equal(t, "_default", msg.Node.Environment)
+	assert.Empty(t, msg.Node.ChefTags)
+
+	_, open := <-out
+	assert.False(t, open)
+}
@@ -13,6 +13,7 @@ import (
 	iam_v2 "github.com/chef/automate/api/interservice/authz/v2"
 	"github.com/chef/automate/api/interservice/ingest"
 	"github.com/chef/automate/components/ingest-service/backend"
+	"github.com/chef/automate/components/ingest-service/enrichment"
 	"github.com/chef/automate/components/ingest-service/pipeline"
 	"github.com/chef/automate/lib/version"
 )
@@ -26,10 +27,11 @@ type ChefIngestServer struct {
 
 // newServer creates a new server instance and it automatically
 // initializes the ChefRun Pipeline by consuming the provided
-// backend client
-func NewChefIngestServer(client backend.Client, authzClient iam_v2.ProjectsClient) *ChefIngestServer {
+// backend client and, if not nil, enrichment table
+func NewChefIngestServer(client backend.Client, authzClient iam_v2.ProjectsClient,
+	enrichmentTable *enrichment.Table) *ChefIngestServer {
 	return &ChefIngestServer{
-		chefRunPipeline:    pipeline.NewChefRunPipeline(client, authzClient),
+		chefRunPipeline:    pipeline.NewChefRunPipeline(client, authzClient, enrichmentTable),
 		chefActionPipeline: pipeline.NewChefActionPipeline(client),
 		client:             client,
 		authzClient:        authzClient,
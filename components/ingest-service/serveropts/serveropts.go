@@ -20,6 +20,8 @@ type Opts struct {
 	LogLevel                      string
 	PurgeConvergeHistoryAfterDays int32
 	PurgeActionsAfterDays         int32
+	EnrichmentTablePath           string
+	EnrichmentKey                 string
 	ConnFactory                   *secureconn.Factory
 }
 
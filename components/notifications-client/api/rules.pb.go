@@ -47,7 +47,7 @@ func (x Rule_Event) String() string {
 	return proto.EnumName(Rule_Event_name, int32(x))
 }
 func (Rule_Event) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{4, 0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{7, 0}
 }
 
 type URLValidationResponse_Code int32
@@ -79,7 +79,7 @@ func (x URLValidationResponse_Code) String() string {
 	return proto.EnumName(URLValidationResponse_Code_name, int32(x))
 }
 func (URLValidationResponse_Code) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{8, 0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{11, 0}
 }
 
 type RuleUpdateResponse_Code int32
@@ -111,7 +111,7 @@ func (x RuleUpdateResponse_Code) String() string {
 	return proto.EnumName(RuleUpdateResponse_Code_name, int32(x))
 }
 func (RuleUpdateResponse_Code) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{10, 0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{13, 0}
 }
 
 type RuleDeleteResponse_Code int32
@@ -137,7 +137,7 @@ func (x RuleDeleteResponse_Code) String() string {
 	return proto.EnumName(RuleDeleteResponse_Code_name, int32(x))
 }
 func (RuleDeleteResponse_Code) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{11, 0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{14, 0}
 }
 
 type RuleAddResponse_Code int32
@@ -172,7 +172,7 @@ func (x RuleAddResponse_Code) String() string {
 	return proto.EnumName(RuleAddResponse_Code_name, int32(x))
 }
 func (RuleAddResponse_Code) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{12, 0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{15, 0}
 }
 
 type RuleGetResponse_Code int32
@@ -198,7 +198,7 @@ func (x RuleGetResponse_Code) String() string {
 	return proto.EnumName(RuleGetResponse_Code_name, int32(x))
 }
 func (RuleGetResponse_Code) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{13, 0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{16, 0}
 }
 
 type RuleListResponse_Code int32
@@ -221,7 +221,7 @@ func (x RuleListResponse_Code) String() string {
 	return proto.EnumName(RuleListResponse_Code_name, int32(x))
 }
 func (RuleListResponse_Code) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{14, 0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{17, 0}
 }
 
 // //
@@ -237,7 +237,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{0}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{0}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -268,7 +268,7 @@ func (m *SlackAlert) Reset()         { *m = SlackAlert{} }
 func (m *SlackAlert) String() string { return proto.CompactTextString(m) }
 func (*SlackAlert) ProtoMessage()    {}
 func (*SlackAlert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{1}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{1}
 }
 func (m *SlackAlert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SlackAlert.Unmarshal(m, b)
@@ -306,7 +306,7 @@ func (m *WebhookAlert) Reset()         { *m = WebhookAlert{} }
 func (m *WebhookAlert) String() string { return proto.CompactTextString(m) }
 func (*WebhookAlert) ProtoMessage()    {}
 func (*WebhookAlert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{2}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{2}
 }
 func (m *WebhookAlert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_WebhookAlert.Unmarshal(m, b)
@@ -345,7 +345,7 @@ func (m *ServiceNowAlert) Reset()         { *m = ServiceNowAlert{} }
 func (m *ServiceNowAlert) String() string { return proto.CompactTextString(m) }
 func (*ServiceNowAlert) ProtoMessage()    {}
 func (*ServiceNowAlert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{3}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{3}
 }
 func (m *ServiceNowAlert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServiceNowAlert.Unmarshal(m, b)
@@ -379,6 +379,146 @@ func (m *ServiceNowAlert) GetSecretId() string {
 	return ""
 }
 
+// Data feed destinations. These actions are only valid for Assets rules;
+// the secret holds the destination credentials (see data-feed-service).
+type DataFeedWebhookAlert struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SecretId             string   `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DataFeedWebhookAlert) Reset()         { *m = DataFeedWebhookAlert{} }
+func (m *DataFeedWebhookAlert) String() string { return proto.CompactTextString(m) }
+func (*DataFeedWebhookAlert) ProtoMessage()    {}
+func (*DataFeedWebhookAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rules_66d0be631ad635c0, []int{4}
+}
+func (m *DataFeedWebhookAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataFeedWebhookAlert.Unmarshal(m, b)
+}
+func (m *DataFeedWebhookAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataFeedWebhookAlert.Marshal(b, m, deterministic)
+}
+func (dst *DataFeedWebhookAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataFeedWebhookAlert.Merge(dst, src)
+}
+func (m *DataFeedWebhookAlert) XXX_Size() int {
+	return xxx_messageInfo_DataFeedWebhookAlert.Size(m)
+}
+func (m *DataFeedWebhookAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataFeedWebhookAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataFeedWebhookAlert proto.InternalMessageInfo
+
+func (m *DataFeedWebhookAlert) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DataFeedWebhookAlert) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
+type SplunkHecAlert struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SecretId             string   `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SplunkHecAlert) Reset()         { *m = SplunkHecAlert{} }
+func (m *SplunkHecAlert) String() string { return proto.CompactTextString(m) }
+func (*SplunkHecAlert) ProtoMessage()    {}
+func (*SplunkHecAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rules_66d0be631ad635c0, []int{5}
+}
+func (m *SplunkHecAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SplunkHecAlert.Unmarshal(m, b)
+}
+func (m *SplunkHecAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SplunkHecAlert.Marshal(b, m, deterministic)
+}
+func (dst *SplunkHecAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SplunkHecAlert.Merge(dst, src)
+}
+func (m *SplunkHecAlert) XXX_Size() int {
+	return xxx_messageInfo_SplunkHecAlert.Size(m)
+}
+func (m *SplunkHecAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_SplunkHecAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SplunkHecAlert proto.InternalMessageInfo
+
+func (m *SplunkHecAlert) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SplunkHecAlert) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
+type S3ObjectAlert struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	SecretId             string   `protobuf:"bytes,2,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *S3ObjectAlert) Reset()         { *m = S3ObjectAlert{} }
+func (m *S3ObjectAlert) String() string { return proto.CompactTextString(m) }
+func (*S3ObjectAlert) ProtoMessage()    {}
+func (*S3ObjectAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rules_66d0be631ad635c0, []int{6}
+}
+func (m *S3ObjectAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_S3ObjectAlert.Unmarshal(m, b)
+}
+func (m *S3ObjectAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_S3ObjectAlert.Marshal(b, m, deterministic)
+}
+func (dst *S3ObjectAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_S3ObjectAlert.Merge(dst, src)
+}
+func (m *S3ObjectAlert) XXX_Size() int {
+	return xxx_messageInfo_S3ObjectAlert.Size(m)
+}
+func (m *S3ObjectAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_S3ObjectAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_S3ObjectAlert proto.InternalMessageInfo
+
+func (m *S3ObjectAlert) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *S3ObjectAlert) GetSecretId() string {
+	if m != nil {
+		return m.SecretId
+	}
+	return ""
+}
+
 type Rule struct {
 	Id    string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name  string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -387,6 +527,9 @@ type Rule struct {
 	//	*Rule_SlackAlert
 	//	*Rule_WebhookAlert
 	//	*Rule_ServiceNowAlert
+	//	*Rule_DataFeedWebhookAlert
+	//	*Rule_SplunkHecAlert
+	//	*Rule_S3ObjectAlert
 	Action               isRule_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -397,7 +540,7 @@ func (m *Rule) Reset()         { *m = Rule{} }
 func (m *Rule) String() string { return proto.CompactTextString(m) }
 func (*Rule) ProtoMessage()    {}
 func (*Rule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{4}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{7}
 }
 func (m *Rule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Rule.Unmarshal(m, b)
@@ -454,12 +597,30 @@ type Rule_ServiceNowAlert struct {
 	ServiceNowAlert *ServiceNowAlert `protobuf:"bytes,6,opt,name=ServiceNowAlert,proto3,oneof"`
 }
 
+type Rule_DataFeedWebhookAlert struct {
+	DataFeedWebhookAlert *DataFeedWebhookAlert `protobuf:"bytes,7,opt,name=DataFeedWebhookAlert,proto3,oneof"`
+}
+
+type Rule_SplunkHecAlert struct {
+	SplunkHecAlert *SplunkHecAlert `protobuf:"bytes,8,opt,name=SplunkHecAlert,proto3,oneof"`
+}
+
+type Rule_S3ObjectAlert struct {
+	S3ObjectAlert *S3ObjectAlert `protobuf:"bytes,9,opt,name=S3ObjectAlert,proto3,oneof"`
+}
+
 func (*Rule_SlackAlert) isRule_Action() {}
 
 func (*Rule_WebhookAlert) isRule_Action() {}
 
 func (*Rule_ServiceNowAlert) isRule_Action() {}
 
+func (*Rule_DataFeedWebhookAlert) isRule_Action() {}
+
+func (*Rule_SplunkHecAlert) isRule_Action() {}
+
+func (*Rule_S3ObjectAlert) isRule_Action() {}
+
 func (m *Rule) GetAction() isRule_Action {
 	if m != nil {
 		return m.Action
@@ -488,12 +649,36 @@ func (m *Rule) GetServiceNowAlert() *ServiceNowAlert {
 	return nil
 }
 
+func (m *Rule) GetDataFeedWebhookAlert() *DataFeedWebhookAlert {
+	if x, ok := m.GetAction().(*Rule_DataFeedWebhookAlert); ok {
+		return x.DataFeedWebhookAlert
+	}
+	return nil
+}
+
+func (m *Rule) GetSplunkHecAlert() *SplunkHecAlert {
+	if x, ok := m.GetAction().(*Rule_SplunkHecAlert); ok {
+		return x.SplunkHecAlert
+	}
+	return nil
+}
+
+func (m *Rule) GetS3ObjectAlert() *S3ObjectAlert {
+	if x, ok := m.GetAction().(*Rule_S3ObjectAlert); ok {
+		return x.S3ObjectAlert
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Rule) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Rule_OneofMarshaler, _Rule_OneofUnmarshaler, _Rule_OneofSizer, []interface{}{
 		(*Rule_SlackAlert)(nil),
 		(*Rule_WebhookAlert)(nil),
 		(*Rule_ServiceNowAlert)(nil),
+		(*Rule_DataFeedWebhookAlert)(nil),
+		(*Rule_SplunkHecAlert)(nil),
+		(*Rule_S3ObjectAlert)(nil),
 	}
 }
 
@@ -516,6 +701,21 @@ func _Rule_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.ServiceNowAlert); err != nil {
 			return err
 		}
+	case *Rule_DataFeedWebhookAlert:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DataFeedWebhookAlert); err != nil {
+			return err
+		}
+	case *Rule_SplunkHecAlert:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SplunkHecAlert); err != nil {
+			return err
+		}
+	case *Rule_S3ObjectAlert:
+		b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.S3ObjectAlert); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Rule.Action has unexpected type %T", x)
@@ -550,6 +750,30 @@ func _Rule_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (
 		err := b.DecodeMessage(msg)
 		m.Action = &Rule_ServiceNowAlert{msg}
 		return true, err
+	case 7: // action.DataFeedWebhookAlert
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DataFeedWebhookAlert)
+		err := b.DecodeMessage(msg)
+		m.Action = &Rule_DataFeedWebhookAlert{msg}
+		return true, err
+	case 8: // action.SplunkHecAlert
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SplunkHecAlert)
+		err := b.DecodeMessage(msg)
+		m.Action = &Rule_SplunkHecAlert{msg}
+		return true, err
+	case 9: // action.S3ObjectAlert
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(S3ObjectAlert)
+		err := b.DecodeMessage(msg)
+		m.Action = &Rule_S3ObjectAlert{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -574,6 +798,21 @@ func _Rule_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Rule_DataFeedWebhookAlert:
+		s := proto.Size(x.DataFeedWebhookAlert)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Rule_SplunkHecAlert:
+		s := proto.Size(x.SplunkHecAlert)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Rule_S3ObjectAlert:
+		s := proto.Size(x.S3ObjectAlert)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -593,7 +832,7 @@ func (m *UsernamePassword) Reset()         { *m = UsernamePassword{} }
 func (m *UsernamePassword) String() string { return proto.CompactTextString(m) }
 func (*UsernamePassword) ProtoMessage()    {}
 func (*UsernamePassword) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{5}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{8}
 }
 func (m *UsernamePassword) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsernamePassword.Unmarshal(m, b)
@@ -638,7 +877,7 @@ func (m *SecretId) Reset()         { *m = SecretId{} }
 func (m *SecretId) String() string { return proto.CompactTextString(m) }
 func (*SecretId) ProtoMessage()    {}
 func (*SecretId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{6}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{9}
 }
 func (m *SecretId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SecretId.Unmarshal(m, b)
@@ -681,7 +920,7 @@ func (m *URLValidationRequest) Reset()         { *m = URLValidationRequest{} }
 func (m *URLValidationRequest) String() string { return proto.CompactTextString(m) }
 func (*URLValidationRequest) ProtoMessage()    {}
 func (*URLValidationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{7}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{10}
 }
 func (m *URLValidationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_URLValidationRequest.Unmarshal(m, b)
@@ -863,7 +1102,7 @@ func (m *URLValidationResponse) Reset()         { *m = URLValidationResponse{} }
 func (m *URLValidationResponse) String() string { return proto.CompactTextString(m) }
 func (*URLValidationResponse) ProtoMessage()    {}
 func (*URLValidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{8}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{11}
 }
 func (m *URLValidationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_URLValidationResponse.Unmarshal(m, b)
@@ -908,7 +1147,7 @@ func (m *RuleIdentifier) Reset()         { *m = RuleIdentifier{} }
 func (m *RuleIdentifier) String() string { return proto.CompactTextString(m) }
 func (*RuleIdentifier) ProtoMessage()    {}
 func (*RuleIdentifier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{9}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{12}
 }
 func (m *RuleIdentifier) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleIdentifier.Unmarshal(m, b)
@@ -947,7 +1186,7 @@ func (m *RuleUpdateResponse) Reset()         { *m = RuleUpdateResponse{} }
 func (m *RuleUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleUpdateResponse) ProtoMessage()    {}
 func (*RuleUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{10}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{13}
 }
 func (m *RuleUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleUpdateResponse.Unmarshal(m, b)
@@ -993,7 +1232,7 @@ func (m *RuleDeleteResponse) Reset()         { *m = RuleDeleteResponse{} }
 func (m *RuleDeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleDeleteResponse) ProtoMessage()    {}
 func (*RuleDeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{11}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{14}
 }
 func (m *RuleDeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleDeleteResponse.Unmarshal(m, b)
@@ -1040,7 +1279,7 @@ func (m *RuleAddResponse) Reset()         { *m = RuleAddResponse{} }
 func (m *RuleAddResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleAddResponse) ProtoMessage()    {}
 func (*RuleAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{12}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{15}
 }
 func (m *RuleAddResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleAddResponse.Unmarshal(m, b)
@@ -1094,7 +1333,7 @@ func (m *RuleGetResponse) Reset()         { *m = RuleGetResponse{} }
 func (m *RuleGetResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleGetResponse) ProtoMessage()    {}
 func (*RuleGetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{13}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{16}
 }
 func (m *RuleGetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleGetResponse.Unmarshal(m, b)
@@ -1148,7 +1387,7 @@ func (m *RuleListResponse) Reset()         { *m = RuleListResponse{} }
 func (m *RuleListResponse) String() string { return proto.CompactTextString(m) }
 func (*RuleListResponse) ProtoMessage()    {}
 func (*RuleListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rules_5143de3e4d746e29, []int{14}
+	return fileDescriptor_rules_66d0be631ad635c0, []int{17}
 }
 func (m *RuleListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleListResponse.Unmarshal(m, b)
@@ -1194,6 +1433,9 @@ func init() {
 	proto.RegisterType((*SlackAlert)(nil), "notifications.SlackAlert")
 	proto.RegisterType((*WebhookAlert)(nil), "notifications.WebhookAlert")
 	proto.RegisterType((*ServiceNowAlert)(nil), "notifications.ServiceNowAlert")
+	proto.RegisterType((*DataFeedWebhookAlert)(nil), "notifications.DataFeedWebhookAlert")
+	proto.RegisterType((*SplunkHecAlert)(nil), "notifications.SplunkHecAlert")
+	proto.RegisterType((*S3ObjectAlert)(nil), "notifications.S3ObjectAlert")
 	proto.RegisterType((*Rule)(nil), "notifications.Rule")
 	proto.RegisterType((*UsernamePassword)(nil), "notifications.UsernamePassword")
 	proto.RegisterType((*SecretId)(nil), "notifications.SecretId")
@@ -1214,61 +1456,66 @@ func init() {
 	proto.RegisterEnum("notifications.RuleListResponse_Code", RuleListResponse_Code_name, RuleListResponse_Code_value)
 }
 
-func init() { proto.RegisterFile("rules.proto", fileDescriptor_rules_5143de3e4d746e29) }
-
-var fileDescriptor_rules_5143de3e4d746e29 = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xd1, 0x6e, 0xe3, 0x44,
-	0x14, 0x86, 0xed, 0xd8, 0xce, 0x26, 0x27, 0x34, 0x9d, 0x1d, 0x5a, 0xe1, 0xee, 0x8a, 0x25, 0x32,
-	0x08, 0xba, 0x5c, 0x04, 0x51, 0xa4, 0x05, 0x81, 0x90, 0x70, 0x62, 0x67, 0xe3, 0xc5, 0x38, 0xab,
-	0x49, 0x52, 0x24, 0x10, 0x8a, 0x5c, 0x7b, 0x16, 0x2c, 0x1c, 0x3b, 0x78, 0xec, 0x56, 0x7d, 0x0f,
-	0x1e, 0x81, 0x77, 0xe0, 0x9e, 0x3b, 0x2e, 0x79, 0x0e, 0xae, 0x78, 0x03, 0xe4, 0x89, 0x93, 0xc6,
-	0x8e, 0x2b, 0xa5, 0x77, 0x9e, 0xc9, 0x7f, 0xce, 0xfc, 0xdf, 0x39, 0x67, 0x62, 0x43, 0x27, 0xc9,
-	0x42, 0xca, 0xfa, 0xab, 0x24, 0x4e, 0x63, 0x7c, 0x14, 0xc5, 0x69, 0xf0, 0x26, 0xf0, 0xdc, 0x34,
-	0x88, 0x23, 0xa6, 0x3d, 0x02, 0xc5, 0x5c, 0xae, 0xd2, 0x5b, 0xed, 0x19, 0xc0, 0x34, 0x74, 0xbd,
-	0x5f, 0xf5, 0x90, 0x26, 0x29, 0x46, 0x20, 0x65, 0x49, 0xa8, 0x8a, 0x3d, 0xf1, 0xbc, 0x4d, 0xf2,
-	0x47, 0xad, 0x07, 0x6f, 0x7d, 0x4f, 0xaf, 0x7e, 0x89, 0xe3, 0x7b, 0x15, 0xdf, 0xc0, 0xf1, 0x94,
-	0x26, 0xd7, 0x81, 0x47, 0x9d, 0xf8, 0xe6, 0x1e, 0x11, 0x7e, 0x0a, 0x6d, 0x46, 0xbd, 0x84, 0xa6,
-	0x8b, 0xc0, 0x57, 0x1b, 0x7c, 0xbf, 0xb5, 0xde, 0xb0, 0x7c, 0xed, 0x77, 0x09, 0x64, 0x92, 0x85,
-	0x14, 0x77, 0xa1, 0x11, 0xf8, 0x45, 0x58, 0x23, 0xf0, 0x31, 0x06, 0x39, 0x72, 0x97, 0xb4, 0x08,
-	0xe0, 0xcf, 0xf8, 0x13, 0x50, 0xe8, 0x35, 0x8d, 0x52, 0x55, 0xea, 0x89, 0xe7, 0xdd, 0x8b, 0xb3,
-	0x7e, 0x09, 0xac, 0x9f, 0xe7, 0xe9, 0x9b, 0xb9, 0x80, 0xac, 0x75, 0xf8, 0xab, 0x5d, 0x42, 0x55,
-	0xee, 0x89, 0xe7, 0x9d, 0xbd, 0xa8, 0x3b, 0xc1, 0x58, 0x20, 0xbb, 0x05, 0xd1, 0xcb, 0xf8, 0xaa,
-	0xc2, 0xc3, 0x9f, 0x56, 0xc2, 0x77, 0x25, 0x63, 0x81, 0x94, 0x2b, 0xf6, 0x6a, 0xaf, 0x3e, 0x6a,
-	0x93, 0x67, 0x79, 0x56, 0x35, 0x51, 0x56, 0x8d, 0x05, 0x52, 0x0d, 0xd4, 0x5c, 0x50, 0x38, 0x1b,
-	0xee, 0x02, 0x0c, 0x87, 0x64, 0xe4, 0x06, 0x61, 0x96, 0x50, 0x24, 0x14, 0xeb, 0x69, 0xe6, 0x79,
-	0x94, 0x31, 0x24, 0xe2, 0x53, 0x78, 0x3c, 0x8c, 0x97, 0xab, 0x30, 0x70, 0x23, 0x8f, 0x6e, 0x64,
-	0x8d, 0xf2, 0xf6, 0x46, 0x2d, 0x61, 0x80, 0xa6, 0xce, 0x18, 0x4d, 0x19, 0x92, 0x07, 0x2d, 0x68,
-	0xba, 0x5e, 0x6e, 0x48, 0x7b, 0x05, 0x68, 0xce, 0x68, 0x92, 0x57, 0xfd, 0xb5, 0xcb, 0xd8, 0x4d,
-	0x9c, 0xf8, 0xf8, 0x09, 0xb4, 0xb2, 0x62, 0xaf, 0xe8, 0xd3, 0x76, 0x9d, 0xff, 0xb6, 0x2a, 0x74,
-	0x9b, 0x16, 0x6f, 0xd6, 0xda, 0x13, 0x68, 0x4d, 0x8b, 0x76, 0x57, 0xbb, 0xac, 0xfd, 0x2b, 0xc2,
-	0xc9, 0x9c, 0xd8, 0x97, 0x6e, 0x18, 0xf8, 0xbc, 0x14, 0x84, 0xfe, 0x96, 0x51, 0x56, 0x37, 0x46,
-	0x0e, 0x3c, 0xde, 0x1c, 0xb7, 0x28, 0x9d, 0xd5, 0xb9, 0x78, 0xaf, 0x52, 0xcd, 0xaa, 0xf5, 0xb1,
-	0x40, 0x50, 0x56, 0xc5, 0x79, 0xb1, 0x3b, 0x96, 0x12, 0xcf, 0xf3, 0xce, 0x5e, 0x57, 0xd6, 0xb6,
-	0xc7, 0xc2, 0xdd, 0xc4, 0xe2, 0x8f, 0x41, 0x8e, 0xe2, 0x88, 0x16, 0xd3, 0x74, 0x52, 0x09, 0xe1,
-	0x37, 0x6b, 0x2c, 0x10, 0xae, 0x19, 0x1c, 0x41, 0xc7, 0x4b, 0xa8, 0x4f, 0xa3, 0x34, 0x70, 0x43,
-	0xa6, 0xfd, 0x23, 0xc2, 0x69, 0x85, 0x96, 0xad, 0xe2, 0x88, 0x51, 0xfc, 0x35, 0xc8, 0x5e, 0xec,
-	0xaf, 0xeb, 0xda, 0xbd, 0x78, 0x5e, 0xe5, 0xa9, 0x8b, 0xe9, 0x0f, 0x63, 0x9f, 0x12, 0x1e, 0x96,
-	0x97, 0x7f, 0x49, 0x19, 0x73, 0x7f, 0xa6, 0x4c, 0x6d, 0xf4, 0xa4, 0xbc, 0xfc, 0x9b, 0xb5, 0xf6,
-	0x13, 0xc8, 0xb9, 0x12, 0x37, 0xa1, 0x31, 0xf9, 0x16, 0x09, 0xb8, 0x0d, 0x8a, 0x49, 0xc8, 0x84,
-	0x20, 0x11, 0x1f, 0x43, 0xc7, 0x72, 0x2e, 0x75, 0xdb, 0x32, 0x16, 0x73, 0x62, 0x23, 0x19, 0xbf,
-	0x0b, 0x67, 0xce, 0x64, 0x66, 0x8d, 0xac, 0xa1, 0x3e, 0xb3, 0x26, 0xce, 0x74, 0x31, 0x77, 0xf4,
-	0x4b, 0xdd, 0xd2, 0xed, 0x81, 0x6d, 0xa2, 0x2b, 0x8c, 0xa1, 0x6b, 0x39, 0x33, 0x93, 0x38, 0xba,
-	0xbd, 0x58, 0xe7, 0xf0, 0xb4, 0x1e, 0x74, 0xf3, 0x7b, 0x67, 0x71, 0xc8, 0x37, 0x01, 0x4d, 0xf6,
-	0x7a, 0xfc, 0xb7, 0x08, 0x38, 0x97, 0xcc, 0x57, 0xbe, 0x9b, 0xd2, 0x2d, 0xf2, 0x97, 0x25, 0xe4,
-	0x0f, 0x6b, 0xee, 0x72, 0x39, 0xe0, 0x50, 0xde, 0x1f, 0x2b, 0xbc, 0x18, 0xba, 0xc6, 0xfc, 0xb5,
-	0x9d, 0x23, 0x99, 0x0b, 0x47, 0xff, 0xce, 0x44, 0x22, 0x3e, 0x82, 0xb6, 0x33, 0x99, 0x2d, 0x46,
-	0x93, 0xb9, 0x63, 0xa0, 0x06, 0x3e, 0x01, 0xc4, 0xab, 0xc0, 0xa1, 0x0b, 0x32, 0xb9, 0x96, 0xf6,
-	0x8f, 0x82, 0xc5, 0xa0, 0x21, 0x7d, 0x10, 0x4b, 0x39, 0xe0, 0x50, 0x96, 0x17, 0x05, 0x4b, 0x07,
-	0x1e, 0x19, 0xa6, 0x6d, 0xce, 0x4c, 0x03, 0x09, 0x55, 0xf3, 0x75, 0x36, 0xff, 0x13, 0xe1, 0x38,
-	0x3f, 0x55, 0xf7, 0xfd, 0xad, 0xc7, 0xcf, 0x4b, 0x1e, 0xdf, 0xaf, 0xf1, 0xb8, 0xa3, 0x3e, 0xd0,
-	0x60, 0xd1, 0x6b, 0x69, 0xdb, 0xeb, 0xdb, 0xc2, 0x70, 0x1b, 0x14, 0xdd, 0x30, 0xb8, 0xdd, 0x03,
-	0xea, 0x7f, 0x06, 0xa7, 0x9b, 0x39, 0xd4, 0x87, 0xbc, 0x07, 0xc3, 0x89, 0x33, 0xb2, 0x5e, 0x22,
-	0xe9, 0x01, 0xad, 0xf9, 0xab, 0x60, 0x7e, 0x49, 0xd3, 0x07, 0x30, 0xef, 0xa8, 0x0f, 0x65, 0xfe,
-	0x08, 0xe4, 0xfc, 0xed, 0x5a, 0xfc, 0x67, 0xbc, 0x5d, 0x93, 0x94, 0x70, 0x81, 0xf6, 0x69, 0x65,
-	0x12, 0x0f, 0x68, 0xdc, 0x9f, 0x22, 0xa0, 0x3c, 0x83, 0x1d, 0xb0, 0x3b, 0x8a, 0x2f, 0x4a, 0x14,
-	0x1f, 0xd4, 0x1c, 0xb8, 0x2b, 0x3f, 0x14, 0xe3, 0x39, 0x28, 0xfc, 0x23, 0x41, 0x95, 0x7a, 0xd2,
-	0x7d, 0x1c, 0x6b, 0x85, 0xa6, 0xed, 0x5f, 0xa9, 0xaa, 0xf3, 0x81, 0xf2, 0x83, 0xe4, 0xae, 0x82,
-	0xab, 0x26, 0xff, 0xe4, 0xf8, 0xec, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0x42, 0x3b, 0x3e,
-	0x81, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("rules.proto", fileDescriptor_rules_66d0be631ad635c0) }
+
+var fileDescriptor_rules_66d0be631ad635c0 = []byte{
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x18, 0xb5, 0x63, 0x27, 0x9b, 0x7c, 0x61, 0xb3, 0xee, 0xb0, 0x2b, 0xbc, 0x2d, 0x2d, 0x91, 0x8b,
+	0x60, 0xcb, 0x45, 0x10, 0x5b, 0xa9, 0x20, 0x10, 0x3f, 0xde, 0xd8, 0x59, 0xbb, 0x18, 0xa7, 0x9a,
+	0x24, 0x8b, 0x00, 0xa1, 0xc8, 0x6b, 0x4f, 0xc1, 0xd4, 0x6b, 0x07, 0x8f, 0xdd, 0xaa, 0xef, 0xc3,
+	0x3b, 0x70, 0xcf, 0x1d, 0x97, 0x3c, 0x07, 0x57, 0xbc, 0x00, 0xaa, 0x3c, 0x71, 0x76, 0xe3, 0x89,
+	0xb7, 0xca, 0xf6, 0x2e, 0x33, 0x39, 0xdf, 0x37, 0xe7, 0x7c, 0xe7, 0xcc, 0xc8, 0xd0, 0x4d, 0xf3,
+	0x88, 0xd0, 0xc1, 0x22, 0x4d, 0xb2, 0x04, 0xed, 0xc6, 0x49, 0x16, 0x3e, 0x0d, 0x7d, 0x2f, 0x0b,
+	0x93, 0x98, 0x6a, 0x3b, 0xd0, 0x34, 0x2f, 0x16, 0xd9, 0x4b, 0xed, 0x1e, 0xc0, 0x24, 0xf2, 0xfc,
+	0x67, 0x7a, 0x44, 0xd2, 0x0c, 0x29, 0x20, 0xe5, 0x69, 0xa4, 0x8a, 0x7d, 0xf1, 0xa8, 0x83, 0x8b,
+	0x9f, 0x5a, 0x1f, 0xde, 0xfa, 0x9e, 0x9c, 0xff, 0x9a, 0x24, 0xd7, 0x22, 0xbe, 0x81, 0xbd, 0x09,
+	0x49, 0x9f, 0x87, 0x3e, 0x71, 0x93, 0x17, 0xd7, 0x80, 0xd0, 0x1d, 0xe8, 0x50, 0xe2, 0xa7, 0x24,
+	0x9b, 0x87, 0x81, 0xda, 0x60, 0xfb, 0xed, 0xe5, 0x86, 0x1d, 0x68, 0x26, 0xec, 0x1b, 0x5e, 0xe6,
+	0x8d, 0x08, 0x09, 0x5e, 0x7f, 0xd6, 0xeb, 0xdb, 0x7c, 0x0d, 0xbd, 0xc9, 0x22, 0xca, 0xe3, 0x67,
+	0x16, 0xf1, 0xdf, 0xa8, 0xc1, 0x57, 0xb0, 0x3b, 0x79, 0x38, 0x3e, 0xff, 0x8d, 0xf8, 0xd9, 0x1b,
+	0xd5, 0xff, 0x2f, 0x83, 0x8c, 0xf3, 0x88, 0xa0, 0x1e, 0x34, 0xc2, 0xa0, 0x2c, 0x6b, 0x84, 0x01,
+	0x42, 0x20, 0xc7, 0xde, 0x05, 0x29, 0x0b, 0xd8, 0x6f, 0xf4, 0x31, 0x34, 0xc9, 0x73, 0x12, 0x67,
+	0xaa, 0xd4, 0x17, 0x8f, 0x7a, 0xc7, 0x87, 0x83, 0x8a, 0x41, 0x83, 0xa2, 0xcf, 0xc0, 0x2c, 0x00,
+	0x78, 0x89, 0x43, 0x5f, 0xac, 0x3b, 0xa5, 0xca, 0x7d, 0xf1, 0xa8, 0xbb, 0x51, 0x75, 0x05, 0xb0,
+	0x04, 0xbc, 0x6e, 0xac, 0x5e, 0xb5, 0x51, 0x6d, 0xb2, 0xf2, 0x3b, 0x5c, 0xf9, 0x3a, 0xc4, 0x12,
+	0x70, 0xd5, 0xf9, 0xc7, 0x1b, 0x3e, 0xab, 0x2d, 0xd6, 0xe5, 0x1e, 0x4f, 0xa2, 0x8a, 0xb2, 0x04,
+	0xbc, 0x11, 0x90, 0x1f, 0xea, 0x1d, 0x57, 0x77, 0x58, 0xc3, 0xfb, 0x5c, 0xc3, 0x3a, 0xa8, 0x25,
+	0xe0, 0xfa, 0xd0, 0x9c, 0xf2, 0x29, 0x50, 0xdb, 0xac, 0xe9, 0x5d, 0x9e, 0x65, 0x05, 0x64, 0x09,
+	0x98, 0x0f, 0x8f, 0xc1, 0xa5, 0x41, 0xed, 0xb0, 0x3e, 0xef, 0xf2, 0x7d, 0xd6, 0x31, 0x96, 0x80,
+	0xab, 0x45, 0x9a, 0x07, 0x4d, 0xe6, 0x22, 0xea, 0x01, 0x0c, 0x87, 0x78, 0xe4, 0x85, 0x51, 0x9e,
+	0x12, 0x45, 0x28, 0xd7, 0x93, 0xdc, 0xf7, 0x09, 0xa5, 0x8a, 0x88, 0x0e, 0xe0, 0xd6, 0x30, 0xb9,
+	0x58, 0x44, 0xa1, 0x17, 0xfb, 0x64, 0x05, 0x6b, 0x54, 0xb7, 0x57, 0x68, 0x09, 0x01, 0xb4, 0x74,
+	0x4a, 0x49, 0x46, 0x15, 0xf9, 0xa4, 0x0d, 0x2d, 0xcf, 0x2f, 0xc8, 0x68, 0x8f, 0x41, 0x99, 0x51,
+	0x92, 0x16, 0xf9, 0x7a, 0xe2, 0x51, 0xfa, 0x22, 0x49, 0x03, 0x74, 0x1b, 0xda, 0x79, 0xb9, 0x57,
+	0x26, 0xf2, 0x72, 0x5d, 0xfc, 0xb7, 0x28, 0x71, 0xab, 0x30, 0xaf, 0xd6, 0xda, 0x6d, 0x68, 0x4f,
+	0xca, 0x60, 0xf3, 0x79, 0xd6, 0xfe, 0x15, 0x61, 0x7f, 0x86, 0x9d, 0x33, 0x2f, 0x0a, 0x03, 0x36,
+	0x06, 0x4c, 0x7e, 0xcf, 0x09, 0xad, 0xbb, 0x30, 0x2e, 0xdc, 0x5a, 0x1d, 0x37, 0xaf, 0x9c, 0xd5,
+	0x3d, 0x7e, 0x8f, 0x9b, 0x24, 0x4f, 0xdd, 0x12, 0xb0, 0x92, 0xf3, 0x72, 0x1e, 0xad, 0x5f, 0x40,
+	0x89, 0xf5, 0x79, 0x67, 0x23, 0x7f, 0x4b, 0xda, 0x96, 0x70, 0x75, 0x37, 0xd1, 0x47, 0x20, 0xc7,
+	0x49, 0x4c, 0xca, 0x7b, 0xb3, 0xcf, 0x95, 0xb0, 0xb7, 0xd0, 0x12, 0x30, 0xc3, 0x9c, 0xec, 0x42,
+	0xd7, 0x4f, 0x49, 0x40, 0xe2, 0x2c, 0xf4, 0x22, 0xaa, 0xfd, 0x23, 0xc2, 0x01, 0xa7, 0x96, 0x2e,
+	0x92, 0x98, 0x12, 0xf4, 0x25, 0xc8, 0x7e, 0x12, 0x2c, 0xe7, 0xda, 0x3b, 0x7e, 0xc0, 0xeb, 0xa9,
+	0xab, 0x19, 0x0c, 0x93, 0x80, 0x60, 0x56, 0x56, 0x8c, 0xff, 0x82, 0x50, 0xea, 0xfd, 0x42, 0xa8,
+	0xda, 0xe8, 0x4b, 0xc5, 0xf8, 0x57, 0x6b, 0xed, 0x67, 0x90, 0x0b, 0x24, 0x6a, 0x41, 0x63, 0xfc,
+	0xad, 0x22, 0xa0, 0x0e, 0x34, 0x4d, 0x8c, 0xc7, 0x58, 0x11, 0xd1, 0x1e, 0x74, 0x6d, 0xf7, 0x4c,
+	0x77, 0x6c, 0x63, 0x3e, 0xc3, 0x8e, 0x22, 0xa3, 0xbb, 0x70, 0xe8, 0x8e, 0xa7, 0xf6, 0xc8, 0x1e,
+	0xea, 0x53, 0x7b, 0xec, 0x4e, 0xe6, 0x33, 0x57, 0x3f, 0xd3, 0x6d, 0xdd, 0x39, 0x71, 0x4c, 0xe5,
+	0x1c, 0x21, 0xe8, 0xd9, 0xee, 0xd4, 0xc4, 0xae, 0xee, 0xcc, 0x97, 0x3d, 0x7c, 0xad, 0x0f, 0xbd,
+	0xe2, 0x85, 0xb1, 0x99, 0xc8, 0xa7, 0x21, 0x49, 0x37, 0x3c, 0xfe, 0x5b, 0x04, 0x54, 0x40, 0x66,
+	0x8b, 0xc0, 0xcb, 0xc8, 0xa5, 0xe4, 0xcf, 0x2b, 0x92, 0x3f, 0xa8, 0x79, 0xb5, 0xaa, 0x05, 0xdb,
+	0xea, 0xfd, 0x89, 0xd3, 0x8b, 0xa0, 0x67, 0xcc, 0x9e, 0x38, 0x85, 0x24, 0x73, 0xee, 0xea, 0xdf,
+	0x99, 0x8a, 0x88, 0x76, 0xa1, 0xe3, 0x8e, 0xa7, 0xf3, 0xd1, 0x78, 0xe6, 0x1a, 0x4a, 0x03, 0xed,
+	0x83, 0xc2, 0xa6, 0xc0, 0x44, 0x97, 0xca, 0xe4, 0x5a, 0xb5, 0x7f, 0x94, 0x5a, 0x0c, 0x12, 0x91,
+	0x1b, 0x69, 0xa9, 0x16, 0x6c, 0xab, 0xe5, 0x51, 0xa9, 0xa5, 0x0b, 0x3b, 0x86, 0xe9, 0x98, 0x53,
+	0xd3, 0x50, 0x04, 0x9e, 0x7c, 0x1d, 0xcd, 0xff, 0x44, 0xd8, 0x2b, 0x4e, 0xd5, 0x83, 0xe0, 0x92,
+	0xe3, 0xa7, 0x15, 0x8e, 0xf7, 0x6b, 0x38, 0xae, 0xa1, 0xb7, 0x24, 0x58, 0x7a, 0x2d, 0x5d, 0x7a,
+	0xfd, 0xb2, 0x24, 0xdc, 0x81, 0xa6, 0x6e, 0x18, 0xa6, 0xb1, 0xdd, 0xfc, 0x0f, 0xe1, 0x60, 0x95,
+	0x43, 0x7d, 0xc8, 0x3c, 0x18, 0x8e, 0xdd, 0x91, 0x7d, 0xaa, 0x48, 0x37, 0xb0, 0xe6, 0xaf, 0x52,
+	0xf3, 0x29, 0xc9, 0x6e, 0xa0, 0x79, 0x0d, 0xbd, 0xad, 0xe6, 0x0f, 0x41, 0x2e, 0xbe, 0x87, 0xca,
+	0x37, 0xe3, 0xed, 0x9a, 0xa6, 0x98, 0x01, 0xb4, 0x4f, 0xb8, 0x24, 0x6e, 0x61, 0xdc, 0x9f, 0x22,
+	0x28, 0x45, 0x07, 0x27, 0xa4, 0x57, 0x2a, 0x3e, 0xab, 0xa8, 0x78, 0xbf, 0xe6, 0xc0, 0x75, 0xf8,
+	0xb6, 0x32, 0x1e, 0x40, 0xb3, 0x60, 0x49, 0x55, 0xa9, 0x2f, 0x5d, 0xa7, 0x63, 0x89, 0xd0, 0xb4,
+	0xcd, 0x2b, 0xc5, 0x33, 0x3f, 0x69, 0xfe, 0x28, 0x79, 0x8b, 0xf0, 0xbc, 0xc5, 0x3e, 0x12, 0x1f,
+	0xbe, 0x1a, 0x00, 0x22, 0x6d, 0xae, 0x49, 0x33, 0x0a, 0x00, 0x00,
 }
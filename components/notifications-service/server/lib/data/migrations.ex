@@ -51,6 +51,17 @@ defmodule Notifications.Data.Migrator.Migrations do
      #
      %{description: "Add support for Assets event",
        queries: ["ALTER TYPE rule_event ADD VALUE 'Assets'"]
+     },
+     # ALTER TYPE ... ADD VALUE can't run in a transaction, so each new
+     # rule_action gets its own single-query migration.
+     %{description: "Add DataFeedWebhookAlert as a rule_action",
+       queries: ["ALTER TYPE rule_action ADD VALUE 'DataFeedWebhookAlert' AFTER 'ServiceNowAlert';"]
+     },
+     %{description: "Add SplunkHecAlert as a rule_action",
+       queries: ["ALTER TYPE rule_action ADD VALUE 'SplunkHecAlert' AFTER 'DataFeedWebhookAlert';"]
+     },
+     %{description: "Add S3ObjectAlert as a rule_action",
+       queries: ["ALTER TYPE rule_action ADD VALUE 'S3ObjectAlert' AFTER 'SplunkHecAlert';"]
      }
     ]
   end
@@ -30,6 +30,10 @@ defmodule Notifications.TargetBuilder do
   defp formatter(_), do: &Notifications.Target.null_format/1
 
   defp pre_filter(Notifications.ServiceNowAlert, event), do: event != 2
+  # Data feed destinations are delivered by data-feed-service, never by notifications
+  defp pre_filter(Notifications.DataFeedWebhookAlert, _), do: false
+  defp pre_filter(Notifications.SplunkHecAlert, _), do: false
+  defp pre_filter(Notifications.S3ObjectAlert, _), do: false
   defp pre_filter(_, _), do: true
 
   defp get_target_username_password(secret_id) do
@@ -44,6 +44,48 @@ defmodule Notifications.ServiceNowAlert do
   field :secret_id, 2, type: :string
 end
 
+defmodule Notifications.DataFeedWebhookAlert do
+  @moduledoc false
+  use Protobuf, syntax: :proto3
+
+  @type t :: %__MODULE__{
+          url: String.t(),
+          secret_id: String.t()
+        }
+  defstruct [:url, :secret_id]
+
+  field :url, 1, type: :string
+  field :secret_id, 2, type: :string
+end
+
+defmodule Notifications.SplunkHecAlert do
+  @moduledoc false
+  use Protobuf, syntax: :proto3
+
+  @type t :: %__MODULE__{
+          url: String.t(),
+          secret_id: String.t()
+        }
+  defstruct [:url, :secret_id]
+
+  field :url, 1, type: :string
+  field :secret_id, 2, type: :string
+end
+
+defmodule Notifications.S3ObjectAlert do
+  @moduledoc false
+  use Protobuf, syntax: :proto3
+
+  @type t :: %__MODULE__{
+          url: String.t(),
+          secret_id: String.t()
+        }
+  defstruct [:url, :secret_id]
+
+  field :url, 1, type: :string
+  field :secret_id, 2, type: :string
+end
+
 defmodule Notifications.Rule do
   @moduledoc false
   use Protobuf, syntax: :proto3
@@ -63,6 +105,9 @@ defmodule Notifications.Rule do
   field :SlackAlert, 4, type: Notifications.SlackAlert, oneof: 0
   field :WebhookAlert, 5, type: Notifications.WebhookAlert, oneof: 0
   field :ServiceNowAlert, 6, type: Notifications.ServiceNowAlert, oneof: 0
+  field :DataFeedWebhookAlert, 7, type: Notifications.DataFeedWebhookAlert, oneof: 0
+  field :SplunkHecAlert, 8, type: Notifications.SplunkHecAlert, oneof: 0
+  field :S3ObjectAlert, 9, type: Notifications.S3ObjectAlert, oneof: 0
 end
 
 defmodule Notifications.Rule.Event do
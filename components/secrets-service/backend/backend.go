@@ -0,0 +1,20 @@
+// Package backend resolves secrets held outside of secrets-service.
+//
+// A secret with a reference keeps its name, type, and tags in secrets-service
+// while its data stays in the referenced backend, which is read whenever the
+// secret is read.
+package backend
+
+import (
+	"context"
+
+	"github.com/chef/automate/api/external/secrets"
+)
+
+// Backend is an external store of secret data
+type Backend interface {
+	// Name is the name references use for the backend
+	Name() string
+	// Read returns the data of the secret at path
+	Read(ctx context.Context, path string) ([]*secrets.Kv, error)
+}
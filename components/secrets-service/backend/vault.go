@@ -0,0 +1,283 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/config"
+	"github.com/chef/automate/components/secrets-service/utils"
+)
+
+// VaultName is the backend name of references to Vault
+const VaultName = "vault"
+
+const (
+	vaultRequestTimeout = 30 * time.Second
+	// an AppRole token is renewed once this share of its lease has passed
+	vaultTokenRenewal = 0.8
+)
+
+// Vault reads secrets from a KV secrets engine of HashiCorp Vault,
+// authenticating with a token or an AppRole
+type Vault struct {
+	conf   config.Vault
+	client *http.Client
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+type vaultResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Auth   *vaultAuth      `json:"auth"`
+	Errors []string        `json:"errors"`
+}
+
+type vaultAuth struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int    `json:"lease_duration"`
+}
+
+// NewVault returns a Vault backend, filling in the defaults of the
+// configuration
+func NewVault(conf config.Vault) (*Vault, error) {
+	if conf.Address == "" {
+		return nil, errors.New("vault address is required")
+	}
+	conf.Address = strings.TrimSuffix(conf.Address, "/")
+	if conf.Mount == "" {
+		conf.Mount = "secret"
+	}
+	conf.Mount = strings.Trim(conf.Mount, "/")
+	if conf.KVVersion == 0 {
+		conf.KVVersion = 2
+	}
+	if conf.KVVersion != 1 && conf.KVVersion != 2 {
+		return nil, errors.Errorf("unsupported vault kv version %d", conf.KVVersion)
+	}
+	if conf.AuthMethod == "" {
+		conf.AuthMethod = "token"
+	}
+	if conf.AppRoleMount == "" {
+		conf.AppRoleMount = "approle"
+	}
+	switch conf.AuthMethod {
+	case "token":
+		if conf.Token == "" {
+			return nil, errors.New("vault token auth requires a token")
+		}
+	case "approle":
+		if conf.RoleID == "" || conf.SecretID == "" {
+			return nil, errors.New("vault approle auth requires a role ID and a secret ID")
+		}
+	default:
+		return nil, errors.Errorf("unsupported vault auth method %q", conf.AuthMethod)
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if conf.RootCAPath != "" {
+		pem, err := ioutil.ReadFile(conf.RootCAPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read vault root CA")
+		}
+		// an empty file stands for the system's CAs
+		if len(bytes.TrimSpace(pem)) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, errors.Errorf("no certificates in vault root CA %s", conf.RootCAPath)
+			}
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		}
+	}
+
+	return &Vault{
+		conf:   conf,
+		client: &http.Client{Transport: transport, Timeout: vaultRequestTimeout},
+	}, nil
+}
+
+// Name returns VaultName
+func (v *Vault) Name() string {
+	return VaultName
+}
+
+// Read returns the latest version of the KV secret at path, relative to the
+// configured mount. Values that are not strings are returned as JSON.
+func (v *Vault) Read(ctx context.Context, path string) ([]*secrets.Kv, error) {
+	apiPath := v.conf.Mount + "/" + path
+	if v.conf.KVVersion == 2 {
+		apiPath = v.conf.Mount + "/data/" + path
+	}
+
+	resp, status, err := v.authenticatedRequest(ctx, http.MethodGet, apiPath)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case status == http.StatusNotFound:
+		return nil, &utils.NotFoundError{Id: VaultName + ":" + path}
+	case status == http.StatusForbidden:
+		return nil, utils.ProcessUnauthenticated(resp.err(status), fmt.Sprintf("vault denied access to %s", path))
+	case status != http.StatusOK:
+		return nil, errors.Wrapf(resp.err(status), "failed to read %s from vault", path)
+	}
+
+	data := resp.Data
+	if v.conf.KVVersion == 2 {
+		var versioned struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(resp.Data, &versioned); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode %s from vault", path)
+		}
+		data = versioned.Data
+	}
+	return toKvs(data)
+}
+
+// authenticatedRequest sends a request with the backend's token. When an
+// AppRole token is denied, it logs in again and retries once, since the
+// token might have been revoked.
+func (v *Vault) authenticatedRequest(ctx context.Context, method, apiPath string) (*vaultResponse, int, error) {
+	token, err := v.authToken(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, status, err := v.request(ctx, method, apiPath, token, nil)
+	if err != nil || status != http.StatusForbidden || v.conf.AuthMethod != "approle" {
+		return resp, status, err
+	}
+
+	v.mu.Lock()
+	if v.token == token {
+		v.token = ""
+	}
+	v.mu.Unlock()
+
+	token, err = v.authToken(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return v.request(ctx, method, apiPath, token, nil)
+}
+
+func (v *Vault) authToken(ctx context.Context) (string, error) {
+	if v.conf.AuthMethod == "token" {
+		return v.conf.Token, nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.token != "" && (v.tokenExpiry.IsZero() || time.Now().Before(v.tokenExpiry)) {
+		return v.token, nil
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"role_id":   v.conf.RoleID,
+		"secret_id": v.conf.SecretID,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode vault approle login")
+	}
+	resp, status, err := v.request(ctx, http.MethodPost, "auth/"+v.conf.AppRoleMount+"/login", "", body)
+	if err != nil {
+		return "", err
+	}
+	if status != http.StatusOK || resp.Auth == nil || resp.Auth.ClientToken == "" {
+		return "", utils.ProcessUnauthenticated(resp.err(status), "vault approle login failed")
+	}
+
+	v.token = resp.Auth.ClientToken
+	v.tokenExpiry = time.Time{}
+	if resp.Auth.LeaseDuration > 0 {
+		lease := time.Duration(float64(resp.Auth.LeaseDuration)*vaultTokenRenewal) * time.Second
+		v.tokenExpiry = time.Now().Add(lease)
+	}
+	return v.token, nil
+}
+
+func (v *Vault) request(ctx context.Context, method, apiPath, token string, body []byte) (*vaultResponse, int, error) {
+	req, err := http.NewRequest(method, v.conf.Address+"/v1/"+apiPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to create vault request")
+	}
+	req = req.WithContext(ctx)
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if v.conf.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.conf.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := v.client.Do(req)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "vault request failed")
+	}
+	defer httpResp.Body.Close() // nolint: errcheck
+
+	resp := &vaultResponse{}
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to read vault response")
+	}
+	if len(bytes.TrimSpace(respBody)) > 0 {
+		if err := json.Unmarshal(respBody, resp); err != nil {
+			return nil, 0, errors.Wrapf(err, "failed to decode vault response (HTTP %d)", httpResp.StatusCode)
+		}
+	}
+	return resp, httpResp.StatusCode, nil
+}
+
+// err returns the errors reported by vault, or the status if there are none
+func (r *vaultResponse) err(status int) error {
+	if len(r.Errors) == 0 {
+		return errors.Errorf("HTTP %d", status)
+	}
+	return errors.Errorf("HTTP %d: %s", status, strings.Join(r.Errors, "; "))
+}
+
+func toKvs(data json.RawMessage) ([]*secrets.Kv, error) {
+	values := map[string]interface{}{}
+	if len(data) > 0 && string(data) != "null" {
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, errors.Wrap(err, "failed to decode vault secret data")
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	kvs := make([]*secrets.Kv, 0, len(keys))
+	for _, key := range keys {
+		value, ok := values[key].(string)
+		if !ok {
+			encoded, err := json.Marshal(values[key])
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to encode value of %s", key)
+			}
+			value = string(encoded)
+		}
+		kvs = append(kvs, &secrets.Kv{Key: key, Value: value})
+	}
+	return kvs, nil
+}
@@ -0,0 +1,225 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/config"
+	"github.com/chef/automate/components/secrets-service/utils"
+)
+
+// fakeVault serves the parts of the Vault API the backend uses
+type fakeVault struct {
+	kvVersion int
+	tokens    map[string]bool
+	secrets   map[string]map[string]interface{}
+	logins    int
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/v1/auth/approle/login" {
+		var login map[string]string
+		json.NewDecoder(r.Body).Decode(&login) // nolint: errcheck
+		if login["role_id"] != "role" || login["secret_id"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errors":["invalid role or secret ID"]}`)
+			return
+		}
+		f.logins++
+		token := fmt.Sprintf("approle-token-%d", f.logins)
+		f.tokens[token] = true
+		fmt.Fprintf(w, `{"auth":{"client_token":%q,"lease_duration":3600}}`, token)
+		return
+	}
+
+	if !f.tokens[r.Header.Get("X-Vault-Token")] {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errors":["permission denied"]}`)
+		return
+	}
+
+	prefix := "/v1/secret/"
+	if f.kvVersion == 2 {
+		prefix = "/v1/secret/data/"
+	}
+	if len(r.URL.Path) <= len(prefix) || r.URL.Path[:len(prefix)] != prefix {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors":[]}`)
+		return
+	}
+	data, ok := f.secrets[r.URL.Path[len(prefix):]]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors":[]}`)
+		return
+	}
+
+	var body interface{} = map[string]interface{}{"data": data}
+	if f.kvVersion == 2 {
+		body = map[string]interface{}{"data": map[string]interface{}{
+			"data":     data,
+			"metadata": map[string]interface{}{"version": 3},
+		}}
+	}
+	json.NewEncoder(w).Encode(body) // nolint: errcheck
+}
+
+func newFakeVault(kvVersion int) (*fakeVault, *httptest.Server) {
+	f := &fakeVault{
+		kvVersion: kvVersion,
+		tokens:    map[string]bool{"root": true},
+		secrets: map[string]map[string]interface{}{
+			"ssh/production": {"username": "admin", "password": "hunter2", "port": 22},
+		},
+	}
+	return f, httptest.NewServer(f)
+}
+
+func TestVaultReadKV(t *testing.T) {
+	for _, kvVersion := range []int{1, 2} {
+		t.Run(fmt.Sprintf("kv version %d", kvVersion), func(t *testing.T) {
+			_, server := newFakeVault(kvVersion)
+			defer server.Close()
+
+			v, err := NewVault(config.Vault{Address: server.URL, KVVersion: kvVersion, Token: "root"})
+			require.NoError(t, err)
+			assert.Equal(t, VaultName, v.Name())
+
+			kvs, err := v.Read(context.Background(), "ssh/production")
+			require.NoError(t, err)
+			assert.Equal(t, []*secrets.Kv{
+				{Key: "password", Value: "hunter2"},
+				{Key: "port", Value: "22"},
+				{Key: "username", Value: "admin"},
+			}, kvs)
+
+			_, err = v.Read(context.Background(), "ssh/staging")
+			require.Error(t, err)
+			assert.IsType(t, &utils.NotFoundError{}, err)
+		})
+	}
+}
+
+func TestVaultReadDenied(t *testing.T) {
+	_, server := newFakeVault(2)
+	defer server.Close()
+
+	v, err := NewVault(config.Vault{Address: server.URL, Token: "revoked"})
+	require.NoError(t, err)
+
+	_, err = v.Read(context.Background(), "ssh/production")
+	require.Error(t, err)
+	assert.IsType(t, &utils.UnauthenticatedError{}, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
+
+func TestVaultAppRole(t *testing.T) {
+	f, server := newFakeVault(2)
+	defer server.Close()
+
+	v, err := NewVault(config.Vault{
+		Address:    server.URL,
+		AuthMethod: "approle",
+		RoleID:     "role",
+		SecretID:   "secret",
+	})
+	require.NoError(t, err)
+
+	kvs, err := v.Read(context.Background(), "ssh/production")
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(kvs))
+
+	// the token is reused while its lease lasts
+	_, err = v.Read(context.Background(), "ssh/production")
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.logins)
+
+	// a revoked token is replaced
+	f.tokens = map[string]bool{}
+	_, err = v.Read(context.Background(), "ssh/production")
+	require.NoError(t, err)
+	assert.Equal(t, 2, f.logins)
+
+	// an expired token is replaced
+	v.tokenExpiry = time.Now().Add(-time.Second)
+	_, err = v.Read(context.Background(), "ssh/production")
+	require.NoError(t, err)
+	assert.Equal(t, 3, f.logins)
+}
+
+func TestVaultAppRoleLoginFailure(t *testing.T) {
+	_, server := newFakeVault(2)
+	defer server.Close()
+
+	v, err := NewVault(config.Vault{
+		Address:    server.URL,
+		AuthMethod: "approle",
+		RoleID:     "role",
+		SecretID:   "wrong",
+	})
+	require.NoError(t, err)
+
+	_, err = v.Read(context.Background(), "ssh/production")
+	require.Error(t, err)
+	assert.IsType(t, &utils.UnauthenticatedError{}, err)
+	assert.Contains(t, err.Error(), "invalid role or secret ID")
+}
+
+func TestNewVaultInvalidConfig(t *testing.T) {
+	for name, conf := range map[string]config.Vault{
+		"no address":          {Token: "root"},
+		"no token":            {Address: "http://localhost:8200"},
+		"no approle secret":   {Address: "http://localhost:8200", AuthMethod: "approle", RoleID: "role"},
+		"unknown auth method": {Address: "http://localhost:8200", AuthMethod: "userpass"},
+		"unknown kv version":  {Address: "http://localhost:8200", Token: "root", KVVersion: 3},
+		"missing root CA":     {Address: "http://localhost:8200", Token: "root", RootCAPath: "/does/not/exist"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewVault(conf)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestVaultDevServer runs against a Vault dev server, e.g.
+//
+//	vault server -dev -dev-root-token-id=root
+//	VAULT_ADDR=http://127.0.0.1:8200 VAULT_TOKEN=root go test ./backend/
+func TestVaultDevServer(t *testing.T) {
+	addr, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		t.Skip("VAULT_ADDR and VAULT_TOKEN are not set")
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"data": map[string]string{"username": "admin", "password": "hunter2"},
+	})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, addr+"/v1/secret/data/automate-test/ssh", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("X-Vault-Token", token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	v, err := NewVault(config.Vault{Address: addr, Token: token})
+	require.NoError(t, err)
+	kvs, err := v.Read(context.Background(), "automate-test/ssh")
+	require.NoError(t, err)
+	assert.Equal(t, []*secrets.Kv{
+		{Key: "password", Value: "hunter2"},
+		{Key: "username", Value: "admin"},
+	}, kvs)
+}
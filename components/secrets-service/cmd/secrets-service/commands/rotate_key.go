@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/chef/automate/components/secrets-service/dao"
+	"github.com/chef/automate/components/secrets-service/keyring"
+)
+
+const defaultBatchSize = 100
+
+var rotateKeyCmdFlags = struct {
+	batchSize     int
+	reencryptOnly bool
+}{}
+
+func init() {
+	rotateKeyCmd.Flags().IntVar(&rotateKeyCmdFlags.batchSize, "batch-size", defaultBatchSize,
+		"Number of secrets re-encrypted per transaction")
+	rotateKeyCmd.Flags().BoolVar(&rotateKeyCmdFlags.reencryptOnly, "reencrypt-only", false,
+		"Do not add a new key, only re-encrypt the secrets not encrypted with the current key, e.g. to finish an interrupted rotation")
+	RootCmd.AddCommand(rotateKeyCmd)
+}
+
+var rotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Adds a new key to the secrets keyring and re-encrypts every secret with it. The previous keys are kept to decrypt secrets during the rotation",
+	Args:  cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		conf, err := configFromViper()
+		if err != nil {
+			fatalf("failed to load config: %s", err.Error())
+		}
+		keyPath := conf.SecretsKey.File
+		if keyPath == "" {
+			fatalf("rotate-key requires the secrets key to be stored in a file (secretskey.file)")
+		}
+
+		keys, err := keyring.Load(keyPath)
+		if err != nil {
+			fatalf("failed to load keyring: %s", err.Error())
+		}
+
+		if !rotateKeyCmdFlags.reencryptOnly {
+			key, err := generateKey()
+			if err != nil {
+				fatalf("could not generate key: %s", err.Error())
+			}
+			id, err := keys.Add(string(key))
+			if err != nil {
+				fatalf("could not add key: %s", err.Error())
+			}
+			// the keyring is saved before anything is encrypted with the new
+			// key, so that the running service can decrypt it
+			if err := keys.Save(keyPath); err != nil {
+				fatalf("%s", err.Error())
+			}
+			fmt.Printf("Added key %s to the keyring\n", id)
+		}
+
+		db, err := dao.New(&conf.Postgres, keys)
+		if err != nil {
+			fatalf("failed to connect to the database: %s", err.Error())
+		}
+		count, err := db.ReencryptSecrets(rotateKeyCmdFlags.batchSize)
+		if err != nil {
+			fatalf("re-encrypted %d secrets before failing: %s", count, err.Error())
+		}
+
+		id, _ := keys.Current()
+		fmt.Printf("Re-encrypted %d secrets with key %s\n", count, id)
+	},
+}
@@ -11,6 +11,8 @@ type Secrets struct {
 	SecretsKey      `mapstructure:"secretskey"`
 	Service         `mapstructure:"service"`
 	Postgres        `mapstructure:"postgres"`
+	Versions        `mapstructure:"versions"`
+	Vault           `mapstructure:"vault"`
 	certs.TLSConfig `mapstructure:"tls"`
 }
 
@@ -27,6 +29,28 @@ type Postgres struct {
 	MigrationsPath   string `mapstructure:"migrations_path"`
 }
 
+// Versions options
+type Versions struct {
+	// Retention is the number of versions kept of each secret; 0 keeps all
+	Retention int `mapstructure:"retention"`
+}
+
+// Vault options; the Vault backend is disabled unless Address is set
+type Vault struct {
+	Address      string `mapstructure:"address"`
+	Namespace    string `mapstructure:"namespace"`
+	Mount        string `mapstructure:"mount"`
+	KVVersion    int    `mapstructure:"kv_version"`
+	AuthMethod   string `mapstructure:"auth_method"`
+	Token        string `mapstructure:"token"`
+	AppRoleMount string `mapstructure:"approle_mount"`
+	RoleID       string `mapstructure:"role_id"`
+	SecretID     string `mapstructure:"secret_id"`
+	// RootCAPath is a PEM file of the CA to verify Vault with; the system's
+	// CAs are used if it is empty
+	RootCAPath string `mapstructure:"root_ca_path"`
+}
+
 // Service is a base config options struct for all services
 type Service struct {
 	Host     string `mapstructure:"host"`
@@ -12,6 +12,7 @@ import (
 	logs "github.com/sirupsen/logrus"
 
 	"github.com/chef/automate/components/secrets-service/config"
+	"github.com/chef/automate/components/secrets-service/keyring"
 	"github.com/chef/automate/lib/db/migrator"
 )
 
@@ -19,25 +20,26 @@ var validOrderFields = []string{"asc", "desc"}
 
 type DB struct {
 	*gorp.DbMap
-	SecretsKey string
+	// Keyring holds the keys secrets are encrypted with
+	Keyring *keyring.Keyring
+	// VersionRetention is the number of versions kept of each secret; 0 keeps
+	// all of them
+	VersionRetention int
 }
 
 type DBTrans struct {
 	*gorp.Transaction
 }
 
-func New(conf *config.Postgres, secretsKey string) (*DB, error) {
-	if len(secretsKey) != 32 {
-		logs.Fatalf("Configured SECRETS_KEY must be 32 characters in length. This system is incorrectly configured with SECRETS_KEY of size: %d chars", len(secretsKey))
-	}
+func New(conf *config.Postgres, keys *keyring.Keyring) (*DB, error) {
 	db, err := initDB(conf)
 	if err != nil {
 		return nil, err
 	}
 
 	return &DB{
-		DbMap:      db,
-		SecretsKey: secretsKey,
+		DbMap:   db,
+		Keyring: keys,
 	}, nil
 }
 
@@ -107,6 +109,7 @@ func initTables(db *gorp.DbMap) {
 	db.AddTableWithName(secretTag{}, "s_tags").SetKeys(false, "id")
 	db.AddTableWithName(secret{}, "s_secrets").SetKeys(false, "id")
 	db.AddTableWithName(SecretTag{}, "s_secrets_tags")
+	db.AddTableWithName(secretVersion{}, "s_secrets_versions").SetKeys(false, "secret_id", "version")
 }
 
 func createUUID() string {
@@ -0,0 +1,18 @@
+-- Every update of a secret creates a new version. s_secrets holds the latest
+-- one, s_secrets_versions all retained ones, including the latest.
+ALTER TABLE s_secrets ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS s_secrets_versions (
+  secret_id TEXT      NOT NULL REFERENCES s_secrets (id) ON DELETE CASCADE,
+  version   INTEGER   NOT NULL,
+  name      TEXT      NOT NULL DEFAULT '',
+  type      TEXT      NOT NULL DEFAULT '',
+  created   TIMESTAMP NOT NULL DEFAULT NOW(),
+  data      TEXT      NOT NULL,
+  PRIMARY KEY (secret_id, version)
+);
+
+INSERT INTO s_secrets_versions (secret_id, version, name, type, created, data)
+SELECT id, version, name, type, last_modified, data
+FROM s_secrets
+ON CONFLICT DO NOTHING;
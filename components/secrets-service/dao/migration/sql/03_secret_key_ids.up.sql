@@ -0,0 +1,5 @@
+-- Secrets are encrypted with one of the keys of the secrets-service keyring,
+-- key_id is the ID of that key. Everything encrypted so far was encrypted
+-- with the single key that preceded keyrings, whose ID is '1'.
+ALTER TABLE s_secrets ADD COLUMN IF NOT EXISTS key_id TEXT NOT NULL DEFAULT '1';
+ALTER TABLE s_secrets_versions ADD COLUMN IF NOT EXISTS key_id TEXT NOT NULL DEFAULT '1';
@@ -0,0 +1,8 @@
+-- A secret can reference a secret held by an external backend, like Vault,
+-- instead of holding data of its own: backend is the backend's name and
+-- backend_path the path of the secret in it. Both are empty for secrets
+-- holding their own data.
+ALTER TABLE s_secrets ADD COLUMN IF NOT EXISTS backend TEXT NOT NULL DEFAULT '';
+ALTER TABLE s_secrets ADD COLUMN IF NOT EXISTS backend_path TEXT NOT NULL DEFAULT '';
+ALTER TABLE s_secrets_versions ADD COLUMN IF NOT EXISTS backend TEXT NOT NULL DEFAULT '';
+ALTER TABLE s_secrets_versions ADD COLUMN IF NOT EXISTS backend_path TEXT NOT NULL DEFAULT '';
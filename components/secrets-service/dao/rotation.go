@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"github.com/pkg/errors"
+)
+
+// encryptedRow is a row of s_secrets or s_secrets_versions, as far as
+// re-encryption is concerned
+type encryptedRow struct {
+	ID      string `db:"id"`
+	Version int32  `db:"version"`
+	Data    string `db:"data"`
+	KeyID   string `db:"key_id"`
+}
+
+type reencryptQueries struct {
+	table      string
+	selectRows string
+	updateRow  string
+}
+
+var reencryptTables = []reencryptQueries{
+	{
+		table: "s_secrets",
+		selectRows: `
+SELECT id, version, data, key_id
+FROM s_secrets
+WHERE key_id <> $1
+ORDER BY id
+LIMIT $2
+FOR UPDATE;
+`,
+		updateRow: `
+UPDATE s_secrets
+SET data = $3, key_id = $4
+WHERE id = $1 AND version = $2;
+`,
+	},
+	{
+		table: "s_secrets_versions",
+		selectRows: `
+SELECT secret_id AS id, version, data, key_id
+FROM s_secrets_versions
+WHERE key_id <> $1
+ORDER BY secret_id, version
+LIMIT $2
+FOR UPDATE;
+`,
+		updateRow: `
+UPDATE s_secrets_versions
+SET data = $3, key_id = $4
+WHERE secret_id = $1 AND version = $2;
+`,
+	},
+}
+
+// ReencryptSecrets re-encrypts every secret, including the retained
+// versions, that is not encrypted with the current key of the keyring. Rows
+// are re-encrypted in batches of batchSize, each in its own transaction, so
+// an interrupted run leaves every secret readable and can be resumed. It
+// returns the number of rows re-encrypted.
+func (secretsDb *DB) ReencryptSecrets(batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, errors.New("ReencryptSecrets: the batch size must be positive")
+	}
+
+	total := 0
+	for _, queries := range reencryptTables {
+		for {
+			var count int
+			err := Transact(secretsDb, func(tx *DBTrans) error {
+				var err error
+				count, err = tx.reencryptBatch(secretsDb, queries, batchSize)
+				return err
+			})
+			if err != nil {
+				return total, errors.Wrapf(err, "ReencryptSecrets: unable to re-encrypt %s", queries.table)
+			}
+			total += count
+			if count < batchSize {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+func (trans *DBTrans) reencryptBatch(secretsDb *DB, queries reencryptQueries, batchSize int) (int, error) {
+	currentID, _ := secretsDb.Keyring.Current()
+
+	var rows []*encryptedRow
+	_, err := trans.Select(&rows, queries.selectRows, currentID, batchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to select rows")
+	}
+
+	for _, row := range rows {
+		plaintext, err := decryptData(secretsDb.Keyring, row.Data, row.KeyID)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to decrypt %s version %d", row.ID, row.Version)
+		}
+		data, keyID, err := encryptData(secretsDb.Keyring, plaintext)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to encrypt %s version %d", row.ID, row.Version)
+		}
+		_, err = trans.Exec(queries.updateRow, row.ID, row.Version, data, keyID)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to update %s version %d", row.ID, row.Version)
+		}
+	}
+	return len(rows), nil
+}
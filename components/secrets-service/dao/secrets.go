@@ -18,6 +18,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 
 	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/keyring"
 	"github.com/chef/automate/components/secrets-service/utils"
 	"github.com/chef/automate/lib/stringutils"
 )
@@ -28,6 +29,8 @@ SELECT
   s.name,
   s.type,
   s.last_modified,
+  s.backend,
+  s.backend_path,
   COALESCE(('[' || string_agg('{"key":"' || t.key || '"' || ',"value": "' || t.value || '"}', ',') || ']'),
            '[]') :: JSON AS tags,
   COUNT(*)
@@ -48,7 +51,11 @@ SELECT
   s.name,
   s.type,
   s.last_modified,
+  s.version,
   s.data,
+  s.key_id,
+  s.backend,
+  s.backend_path,
   COALESCE(('[' || string_agg('{"key":"' || t.key || '"' || ',"value": "' || t.value || '"}', ',') || ']'),
            '[]') :: JSON AS tags
 FROM s_secrets s
@@ -89,6 +96,10 @@ type secret struct {
 	Type         string    `db:"type"`
 	LastModified time.Time `db:"last_modified"`
 	Data         string    `db:"data"`
+	KeyID        string    `db:"key_id"`
+	Version      int32     `db:"version"`
+	Backend      string    `db:"backend"`
+	BackendPath  string    `db:"backend_path"`
 }
 
 // secretSelect used to read from db
@@ -99,6 +110,10 @@ type secretSelect struct {
 	LastModified time.Time       `db:"last_modified"`
 	Tags         json.RawMessage `db:"tags"`
 	Data         string          `db:"data"`
+	KeyID        string          `db:"key_id"`
+	Version      int32           `db:"version"`
+	Backend      string          `db:"backend"`
+	BackendPath  string          `db:"backend_path"`
 	TotalCount   int64           `db:"total_count"`
 }
 
@@ -119,19 +134,20 @@ func (secretsDb *DB) toDBSecret(inSecret *secrets.Secret) (*secret, error) {
 	newSecret.Name = inSecret.Name
 	newSecret.Type = inSecret.Type
 	newSecret.LastModified = time.Now().UTC()
+	if inSecret.Reference != nil {
+		newSecret.Backend = inSecret.Reference.Backend
+		newSecret.BackendPath = inSecret.Reference.Path
+	}
 
 	jsonData, err := KeyValueToRawMap(inSecret.Data)
 	if err != nil {
 		return nil, errors.Wrap(err, "toDBSecret error")
 	}
-	s, err := encryptString(string(jsonData), secretsDb.SecretsKey)
+	newSecret.Data, newSecret.KeyID, err = encryptData(secretsDb.Keyring, string(jsonData))
 	if err != nil {
 		return nil, errors.Wrap(err, "toDBSecret error")
 	}
 
-	encodedEncryptedData := base64.StdEncoding.EncodeToString([]byte(*s))
-	newSecret.Data = encodedEncryptedData
-
 	return &newSecret, nil
 }
 
@@ -141,6 +157,10 @@ func (secretsDb *DB) fromDBSelectSecret(inSecret *secretSelect) (*secrets.Secret
 	newSecret.Name = inSecret.Name
 	newSecret.Type = inSecret.Type
 	newSecret.LastModified, _ = ptypes.TimestampProto(inSecret.LastModified)
+	newSecret.Version = inSecret.Version
+	if inSecret.Backend != "" {
+		newSecret.Reference = &secrets.Reference{Backend: inSecret.Backend, Path: inSecret.BackendPath}
+	}
 	var tags []*secrets.Kv
 	err := json.Unmarshal(inSecret.Tags, &tags)
 	if err != nil {
@@ -149,16 +169,12 @@ func (secretsDb *DB) fromDBSelectSecret(inSecret *secretSelect) (*secrets.Secret
 	newSecret.Tags = tags
 	var data []*secrets.Kv
 	if inSecret.Data != "" {
-		decodedEncryptedString, err := base64.StdEncoding.DecodeString(inSecret.Data)
-		if err != nil {
-			return nil, errors.Wrap(err, "fromDBSelectSecret decode error")
-		}
-		s, err := decryptString(string(decodedEncryptedString), secretsDb.SecretsKey)
+		s, err := decryptData(secretsDb.Keyring, inSecret.Data, inSecret.KeyID)
 		if err != nil {
-			return nil, errors.Wrap(err, "fromDBSelectSecret error decrypting string")
+			return nil, errors.Wrap(err, "fromDBSelectSecret error")
 		}
 
-		data, err = RawMapToKeyValue(json.RawMessage(*s))
+		data, err = RawMapToKeyValue(json.RawMessage(s))
 		if err != nil {
 			return nil, errors.Wrap(err, "fromDBSelectSecret error processing data")
 		}
@@ -168,6 +184,34 @@ func (secretsDb *DB) fromDBSelectSecret(inSecret *secretSelect) (*secrets.Secret
 	return &newSecret, nil
 }
 
+// encryptData encrypts plaintext with the current key of the keyring. It
+// returns the base64 encoded ciphertext and the ID of the key.
+func encryptData(keys *keyring.Keyring, plaintext string) (string, string, error) {
+	keyID, key := keys.Current()
+	s, err := encryptString(plaintext, key)
+	if err != nil {
+		return "", "", err
+	}
+	return base64.StdEncoding.EncodeToString([]byte(*s)), keyID, nil
+}
+
+// decryptData decrypts data encrypted by encryptData with the key keyID
+func decryptData(keys *keyring.Keyring, data string, keyID string) (string, error) {
+	key, err := keys.Key(keyID)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt data error")
+	}
+	decodedEncryptedString, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt data error decoding")
+	}
+	s, err := decryptString(string(decodedEncryptedString), key)
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt data error decrypting string")
+	}
+	return *s, nil
+}
+
 func encryptString(plaintext string, key string) (*string, error) {
 	c, err := aes.NewCipher([]byte(key))
 	if err != nil {
@@ -211,7 +255,8 @@ func decryptString(ciphertext string, key string) (*string, error) {
 	return &decString, err
 }
 
-//UpdateSecret updates a secret in the db with input values
+//UpdateSecret updates a secret in the db with input values, storing them as
+//its next version
 func (secretsDb *DB) UpdateSecret(inSecret *secrets.Secret) (count int64, err error) {
 	secret, err := secretsDb.toDBSecret(inSecret)
 	if err != nil {
@@ -237,7 +282,7 @@ func (secretsDb *DB) UpdateSecret(inSecret *secrets.Secret) (count int64, err er
 			return errors.Wrap(err, "unable to tag secret")
 		}
 
-		_, err = tx.Update(secret)
+		_, err = tx.addVersion(secret, secretsDb.VersionRetention)
 		if err != nil {
 			return errors.Wrap(err, fmt.Sprintf("UpdateSecret: unable to update secret in db %+v", inSecret))
 		}
@@ -255,12 +300,28 @@ func (secretsDb *DB) AddSecret(inSecret *secrets.Secret) (string, error) {
 	}
 	secret.ID = createUUID()
 	secret.LastModified = timeNowRef()
+	secret.Version = 1
 
 	err = Transact(secretsDb, func(tx *DBTrans) error {
 		if err = tx.Insert(secret); err != nil {
 			return errors.Wrap(err, "AddSecret: unable to insert secret")
 		}
 
+		err = tx.Insert(&secretVersion{
+			SecretID:    secret.ID,
+			Version:     secret.Version,
+			Name:        secret.Name,
+			Type:        secret.Type,
+			Created:     secret.LastModified,
+			Data:        secret.Data,
+			KeyID:       secret.KeyID,
+			Backend:     secret.Backend,
+			BackendPath: secret.BackendPath,
+		})
+		if err != nil {
+			return errors.Wrap(err, "AddSecret: unable to insert secret version")
+		}
+
 		tags, err := tx.addSecretTags(inSecret.Tags)
 		if err != nil {
 			return errors.Wrap(err, "AddSecret: unable to add secret tags to db")
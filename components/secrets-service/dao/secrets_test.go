@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/components/secrets-service/keyring"
+)
+
+func TestEncryptDataUsesCurrentKey(t *testing.T) {
+	keys, err := keyring.Parse([]byte("1 75e79c17ae62445e9771cd13fc4216f4\n2 0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	data, keyID, err := encryptData(keys, `{"password":"secret"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "2", keyID)
+
+	plaintext, err := decryptData(keys, data, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, `{"password":"secret"}`, plaintext)
+
+	_, err = decryptData(keys, data, "1")
+	assert.Error(t, err, "decrypting with the wrong key")
+}
+
+func TestDecryptDataWithOldKey(t *testing.T) {
+	oldKeys, err := keyring.FromKey("75e79c17ae62445e9771cd13fc4216f4")
+	require.NoError(t, err)
+	data, keyID, err := encryptData(oldKeys, `{"password":"secret"}`)
+	require.NoError(t, err)
+	assert.Equal(t, keyring.LegacyKeyID, keyID)
+
+	rotated, err := keyring.Parse([]byte("1 75e79c17ae62445e9771cd13fc4216f4\n2 0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	plaintext, err := decryptData(rotated, data, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, `{"password":"secret"}`, plaintext)
+
+	_, err = decryptData(rotated, data, "3")
+	assert.Error(t, err, "decrypting with an unknown key")
+}
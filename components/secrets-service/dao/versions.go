@@ -0,0 +1,176 @@
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/utils"
+)
+
+const selectSecretVersion = `
+SELECT
+  s.id,
+  v.name,
+  v.type,
+  s.last_modified,
+  v.version,
+  v.data,
+  v.key_id,
+  v.backend,
+  v.backend_path,
+  COALESCE(('[' || string_agg('{"key":"' || t.key || '"' || ',"value": "' || t.value || '"}', ',') || ']'),
+           '[]') :: JSON AS tags
+FROM s_secrets s
+  JOIN s_secrets_versions v ON s.id = v.secret_id
+  LEFT JOIN s_secrets_tags st ON s.id = st.secret_id
+  LEFT JOIN s_tags t ON t.id = st.tag_id
+WHERE s.id = $1 AND v.version = $2
+GROUP BY s.id, v.secret_id, v.version;
+`
+
+const selectSecretVersions = `
+SELECT secret_id, version, name, type, created
+FROM s_secrets_versions
+WHERE secret_id = $1
+ORDER BY version DESC;
+`
+
+const selectLatestVersionForUpdate = `
+SELECT version
+FROM s_secrets
+WHERE id = $1
+FOR UPDATE;
+`
+
+const deleteExpiredVersions = `
+DELETE FROM s_secrets_versions
+WHERE secret_id = $1 AND version <= $2;
+`
+
+// secretVersion is an immutable version of a secret; its data is encrypted
+// like that of secret
+type secretVersion struct {
+	SecretID string    `db:"secret_id"`
+	Version  int32     `db:"version"`
+	Name     string    `db:"name"`
+	Type     string    `db:"type"`
+	Created  time.Time `db:"created"`
+	Data     string    `db:"data"`
+	KeyID    string    `db:"key_id"`
+	// Backend and BackendPath are set for versions referencing an
+	// external backend
+	Backend     string `db:"backend"`
+	BackendPath string `db:"backend_path"`
+}
+
+// addVersion stores the secret as its next version, which becomes its latest
+// one, and deletes the versions exceeding the retention. It returns the new
+// version.
+func (trans *DBTrans) addVersion(s *secret, retention int) (int32, error) {
+	var latest int32
+	err := trans.SelectOne(&latest, selectLatestVersionForUpdate, s.ID)
+	if err != nil {
+		return 0, utils.ProcessSQLNotFound(err, s.ID, "addVersion: unable to lock secret")
+	}
+	s.Version = latest + 1
+
+	_, err = trans.Update(s)
+	if err != nil {
+		return 0, errors.Wrap(err, "addVersion: unable to update secret")
+	}
+
+	err = trans.Insert(&secretVersion{
+		SecretID:    s.ID,
+		Version:     s.Version,
+		Name:        s.Name,
+		Type:        s.Type,
+		Created:     s.LastModified,
+		Data:        s.Data,
+		KeyID:       s.KeyID,
+		Backend:     s.Backend,
+		BackendPath: s.BackendPath,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "addVersion: unable to insert version")
+	}
+
+	if retention > 0 {
+		_, err = trans.Exec(deleteExpiredVersions, s.ID, s.Version-int32(retention))
+		if err != nil {
+			return 0, errors.Wrap(err, "addVersion: unable to delete expired versions")
+		}
+	}
+	return s.Version, nil
+}
+
+// GetSecretVersion is used to read a version of a secret from the db. The
+// tags are always the current ones: they are not versioned.
+func (secretsDb *DB) GetSecretVersion(id string, version int32) (*secrets.Secret, error) {
+	var secret secretSelect
+	err := secretsDb.SelectOne(&secret, selectSecretVersion, id, version)
+	if err != nil {
+		return nil, utils.ProcessSQLNotFound(err, fmt.Sprintf("%s version %d", id, version), "GetSecretVersion")
+	}
+
+	newSecret, err := secretsDb.fromDBSelectSecret(&secret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetSecretVersion: unable to translate secret %s version %d from db struct", id, version)
+	}
+	return newSecret, nil
+}
+
+// ListSecretVersions lists the retained versions of a secret, latest first.
+func (secretsDb *DB) ListSecretVersions(id string) ([]*secrets.SecretVersion, error) {
+	var versions []*secretVersion
+	_, err := secretsDb.Select(&versions, selectSecretVersions, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "ListSecretVersions: unable to list versions of secret %s", id)
+	}
+	if len(versions) == 0 {
+		// every secret has at least its latest version
+		return nil, &utils.NotFoundError{Id: id}
+	}
+
+	list := make([]*secrets.SecretVersion, len(versions))
+	for i, v := range versions {
+		created, _ := ptypes.TimestampProto(v.Created)
+		list[i] = &secrets.SecretVersion{
+			Version: v.Version,
+			Name:    v.Name,
+			Type:    v.Type,
+			Created: created,
+		}
+	}
+	return list, nil
+}
+
+// RollbackSecret makes a previous version of a secret the latest one again,
+// by storing it as a new version. It returns the new version.
+func (secretsDb *DB) RollbackSecret(id string, version int32) (int32, error) {
+	var newVersion int32
+	err := Transact(secretsDb, func(tx *DBTrans) error {
+		var previous secretVersion
+		err := tx.SelectOne(&previous,
+			`SELECT * FROM s_secrets_versions WHERE secret_id = $1 AND version = $2`, id, version)
+		if err != nil {
+			return utils.ProcessSQLNotFound(err, fmt.Sprintf("%s version %d", id, version), "RollbackSecret")
+		}
+
+		newVersion, err = tx.addVersion(&secret{
+			ID:           id,
+			Name:         previous.Name,
+			Type:         previous.Type,
+			LastModified: timeNowRef(),
+			Data:         previous.Data,
+			KeyID:        previous.KeyID,
+			Backend:      previous.Backend,
+			BackendPath:  previous.BackendPath,
+		}, secretsDb.VersionRetention)
+		return err
+	})
+	return newVersion, err
+}
@@ -2,9 +2,7 @@ package grpc
 
 import (
 	"fmt"
-	"io/ioutil"
 	"net"
-	"strings"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -12,8 +10,10 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/backend"
 	"github.com/chef/automate/components/secrets-service/config"
 	"github.com/chef/automate/components/secrets-service/dao"
+	"github.com/chef/automate/components/secrets-service/keyring"
 	"github.com/chef/automate/components/secrets-service/server"
 	"github.com/chef/automate/lib/grpc/health"
 	"github.com/chef/automate/lib/grpc/secureconn"
@@ -22,17 +22,18 @@ import (
 // Spawn starts a grpc server using the provided host and port.
 func Spawn(config *config.Secrets, connFactory *secureconn.Factory) error {
 
-	secretsKey, err := getSecretsKey(&config.SecretsKey)
+	keys, err := getKeyring(&config.SecretsKey)
 	if err != nil {
 		log.WithFields(log.Fields{"error": err}).Fatal("Finding secrets key")
 		return err
 	}
 
-	db, err := dao.New(&config.Postgres, secretsKey)
+	db, err := dao.New(&config.Postgres, keys)
 	if err != nil {
 		log.WithFields(log.Fields{"error": err}).Fatal("Creating postgres connection")
 		return err
 	}
+	db.VersionRetention = config.Versions.Retention
 
 	uri := fmt.Sprintf("%s:%d", config.Service.Host, config.Service.Port)
 	log.WithFields(log.Fields{"uri": uri}).Info("Starting secrets-service gRPC Server")
@@ -42,33 +43,44 @@ func Spawn(config *config.Secrets, connFactory *secureconn.Factory) error {
 		return err
 	}
 
-	grpcServer := NewGRPCServer(db, connFactory)
+	var backends []backend.Backend
+	if config.Vault.Address != "" {
+		vault, err := backend.NewVault(config.Vault)
+		if err != nil {
+			log.WithFields(log.Fields{"error": err}).Fatal("Configuring vault backend")
+			return err
+		}
+		log.WithFields(log.Fields{"address": config.Vault.Address}).Info("Secrets can reference vault")
+		backends = append(backends, vault)
+	}
+
+	grpcServer := NewGRPCServer(db, connFactory, backends...)
 
 	return grpcServer.Serve(conn)
 }
 
-func getSecretsKey(secretsKeyConf *config.SecretsKey) (string, error) {
+// getKeyring returns the keyring of the secrets key file or, if there is no
+// file, of the configured key
+func getKeyring(secretsKeyConf *config.SecretsKey) (*keyring.Keyring, error) {
 	if secretsKeyConf.File != "" {
-		data, err := ioutil.ReadFile(secretsKeyConf.File)
+		keys, err := keyring.Load(secretsKeyConf.File)
 		if err != nil {
-			return "", errors.Wrapf(err, "failed to read secrets key from file %s", secretsKeyConf.File)
+			return nil, errors.Wrapf(err, "failed to read secrets key from file %s", secretsKeyConf.File)
 		}
-		strippedData := strings.TrimSpace(string(data))
-
-		return strippedData, nil
+		return keys, nil
 	} else if secretsKeyConf.Key == "" {
-		return "", errors.Errorf("failed to find the secrets key")
+		return nil, errors.Errorf("failed to find the secrets key")
 	}
 
-	return secretsKeyConf.Key, nil
+	return keyring.FromKey(secretsKeyConf.Key)
 }
 
 // NewGRPCServer returns a server that provides our services: secrets
 // and health.
-func NewGRPCServer(db *dao.DB, connFactory *secureconn.Factory) *grpc.Server {
+func NewGRPCServer(db *dao.DB, connFactory *secureconn.Factory, backends ...backend.Backend) *grpc.Server {
 	grpcServer := connFactory.NewServer()
 
-	secretsServer := server.New(db)
+	secretsServer := server.New(db, backends...)
 	secrets.RegisterSecretsServiceServer(grpcServer, secretsServer)
 
 	health.RegisterHealthServer(grpcServer, secretsServer.Health())
@@ -10,6 +10,7 @@ import (
 
 	"github.com/chef/automate/components/secrets-service/config"
 	"github.com/chef/automate/components/secrets-service/dao"
+	"github.com/chef/automate/components/secrets-service/keyring"
 	"github.com/chef/automate/lib/grpc/grpctest"
 	"github.com/chef/automate/lib/grpc/secureconn"
 	"github.com/chef/automate/lib/tls/test/helpers"
@@ -23,7 +24,9 @@ func TestHealthGRPC(t *testing.T) {
 
 	connFactory := secureconn.NewFactory(*serviceCerts)
 	postgresConfig := config.Postgres{ConnectionString: "", MigrationsPath: ""}
-	db, _ := dao.New(&postgresConfig, "75e79c17ae62445e9771cd13fc4216f4")
+	keys, err := keyring.FromKey("75e79c17ae62445e9771cd13fc4216f4")
+	require.NoError(t, err)
+	db, _ := dao.New(&postgresConfig, keys)
 	grpcServer := NewGRPCServer(db, connFactory)
 
 	g := grpctest.NewServer(grpcServer)
@@ -12,9 +12,12 @@ import (
 
 	"github.com/chef/automate/components/secrets-service/config"
 	"github.com/chef/automate/components/secrets-service/dao"
+	"github.com/chef/automate/components/secrets-service/keyring"
 	"github.com/chef/automate/components/secrets-service/server"
 )
 
+const legacyKey = "75e79c17ae62445e9771cd13fc4216f4"
+
 // Global variables
 var (
 	// The postgresql URL is coming from the environment variable POSTGRESQL_URL
@@ -39,7 +42,12 @@ func createDatabaseObject() *dao.DB {
 	connectionString := "postgresql://secrets@" + postgresqlUrl +
 		"/secrets_service?sslmode=verify-ca&sslcert=/hab/svc/secrets-service/config/service.crt&sslkey=/hab/svc/secrets-service/config/service.key&sslrootcert=/hab/svc/secrets-service/config/root_ca.crt"
 	postgresConfig := config.Postgres{ConnectionString: connectionString, MigrationsPath: "/src/components/secrets-service/dao/migration/sql"}
-	db, err := dao.New(&postgresConfig, "75e79c17ae62445e9771cd13fc4216f4")
+	keys, err := keyring.FromKey(legacyKey)
+	if err != nil {
+		fmt.Printf("Could not create keyring: %s\n", err)
+		os.Exit(1)
+	}
+	db, err := dao.New(&postgresConfig, keys)
 	if err != nil {
 		fmt.Printf("Could not create postgresql client from '%s': %s\n", connectionString, err)
 		os.Exit(1)
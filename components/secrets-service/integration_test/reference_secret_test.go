@@ -0,0 +1,109 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/server"
+	"github.com/chef/automate/components/secrets-service/utils"
+)
+
+// mapBackend is a backend holding its secrets in a map
+type mapBackend map[string][]*secrets.Kv
+
+func (mapBackend) Name() string {
+	return "test"
+}
+
+func (b mapBackend) Read(_ context.Context, path string) ([]*secrets.Kv, error) {
+	data, ok := b[path]
+	if !ok {
+		return nil, &utils.NotFoundError{Id: path}
+	}
+	return data, nil
+}
+
+func TestReferenceSecret(t *testing.T) {
+	ctx := context.Background()
+	backend := mapBackend{
+		"ssh/production": appendKvs(
+			&secrets.Kv{Key: "username", Value: "admin"},
+			&secrets.Kv{Key: "password", Value: "from the backend"}),
+	}
+	referencingServer := server.New(secretsDb, backend)
+
+	id, err := referencingServer.Create(ctx, &secrets.Secret{
+		Name:      "referenced",
+		Type:      "ssh",
+		Reference: &secrets.Reference{Backend: "test", Path: "ssh/production"},
+	})
+	require.NoError(t, err)
+	defer referencingServer.Delete(ctx, id) // nolint: errcheck
+
+	secret, err := referencingServer.Read(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "from the backend", password(secret))
+	assert.Equal(t, &secrets.Reference{Backend: "test", Path: "ssh/production"}, secret.Reference)
+
+	// the backend is read on every read
+	backend["ssh/production"][1].Value = "rotated in the backend"
+	secret, err = referencingServer.Read(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "rotated in the backend", password(secret))
+
+	// renaming keeps the reference
+	_, err = referencingServer.Update(ctx, &secrets.Secret{Id: id.Id, Name: "renamed"})
+	require.NoError(t, err)
+	secret, err = referencingServer.Read(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", secret.Name)
+	assert.Equal(t, "rotated in the backend", password(secret))
+
+	// storing data replaces the reference
+	_, err = referencingServer.Update(ctx, &secrets.Secret{
+		Id: id.Id,
+		Data: appendKvs(
+			&secrets.Kv{Key: "username", Value: "admin"},
+			&secrets.Kv{Key: "password", Value: "stored"}),
+	})
+	require.NoError(t, err)
+	secret, err = referencingServer.Read(ctx, id)
+	require.NoError(t, err)
+	assert.Nil(t, secret.Reference)
+	assert.Equal(t, "stored", password(secret))
+
+	// the referencing version is still there
+	first, err := referencingServer.Read(ctx, &secrets.Id{Id: id.Id, Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "rotated in the backend", password(first))
+}
+
+func TestReferenceSecretErrors(t *testing.T) {
+	ctx := context.Background()
+	referencingServer := server.New(secretsDb, mapBackend{})
+
+	// secretsServer has no backends
+	_, err := secretsServer.Create(ctx, &secrets.Secret{
+		Name:      "referenced",
+		Type:      "ssh",
+		Reference: &secrets.Reference{Backend: "test", Path: "ssh/production"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	id, err := referencingServer.Create(ctx, &secrets.Secret{
+		Name:      "referenced",
+		Type:      "ssh",
+		Reference: &secrets.Reference{Backend: "test", Path: "ssh/missing"},
+	})
+	require.NoError(t, err)
+	defer referencingServer.Delete(ctx, id) // nolint: errcheck
+
+	_, err = referencingServer.Read(ctx, id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
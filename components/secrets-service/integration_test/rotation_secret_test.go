@@ -0,0 +1,53 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/keyring"
+)
+
+func TestReencryptSecrets(t *testing.T) {
+	ctx := context.Background()
+	id := createVersionedSecret(ctx, t, "first", "second", "third")
+	defer secretsServer.Delete(ctx, &secrets.Id{Id: id}) // nolint: errcheck
+
+	legacyKeys := secretsDb.Keyring
+	defer func() { secretsDb.Keyring = legacyKeys }()
+	keys, err := keyring.Parse([]byte("1 " + legacyKey + "\n2 0123456789abcdef0123456789abcdef\n"))
+	require.NoError(t, err)
+	secretsDb.Keyring = keys
+
+	// the secret is readable with the old key before re-encryption
+	latest, err := secretsServer.Read(ctx, &secrets.Id{Id: id})
+	require.NoError(t, err)
+	assert.Equal(t, "third", password(latest))
+
+	count, err := secretsDb.ReencryptSecrets(2)
+	require.NoError(t, err)
+	// the secret and its 3 versions, at least
+	assert.True(t, count >= 4, "re-encrypted %d rows", count)
+
+	var keyIDs []string
+	_, err = secretsDb.Select(&keyIDs, `
+SELECT key_id FROM s_secrets WHERE id = $1
+UNION ALL
+SELECT key_id FROM s_secrets_versions WHERE secret_id = $1`, id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2", "2", "2", "2"}, keyIDs)
+
+	for version, expected := range map[int32]string{0: "third", 1: "first", 2: "second", 3: "third"} {
+		secret, err := secretsServer.Read(ctx, &secrets.Id{Id: id, Version: version})
+		require.NoError(t, err)
+		assert.Equal(t, expected, password(secret))
+	}
+
+	// nothing is left to re-encrypt
+	count, err = secretsDb.ReencryptSecrets(2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,121 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chef/automate/api/external/secrets"
+)
+
+func createVersionedSecret(ctx context.Context, t *testing.T, passwords ...string) string {
+	id, err := secretsServer.Create(ctx, &secrets.Secret{
+		Name: "name",
+		Type: "ssh",
+		Data: appendKvs(
+			&secrets.Kv{Key: "username", Value: "username"},
+			&secrets.Kv{Key: "password", Value: passwords[0]}),
+	})
+	require.NoError(t, err)
+
+	for _, password := range passwords[1:] {
+		_, err = secretsServer.Update(ctx, &secrets.Secret{
+			Id:   id.Id,
+			Data: appendKvs(&secrets.Kv{Key: "password", Value: password}),
+		})
+		require.NoError(t, err)
+	}
+	return id.Id
+}
+
+func password(s *secrets.Secret) string {
+	for _, kv := range s.Data {
+		if kv.Key == "password" {
+			return kv.Value
+		}
+	}
+	return ""
+}
+
+func TestReadSecretVersions(t *testing.T) {
+	ctx := context.Background()
+	id := createVersionedSecret(ctx, t, "first", "second", "third")
+
+	latest, err := secretsServer.Read(ctx, &secrets.Id{Id: id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), latest.Version)
+	assert.Equal(t, "third", password(latest))
+
+	first, err := secretsServer.Read(ctx, &secrets.Id{Id: id, Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), first.Version)
+	assert.Equal(t, "first", password(first))
+
+	_, err = secretsServer.Read(ctx, &secrets.Id{Id: id, Version: 4})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListSecretVersions(t *testing.T) {
+	ctx := context.Background()
+	id := createVersionedSecret(ctx, t, "first", "second")
+
+	resp, err := secretsServer.ListVersions(ctx, &secrets.Id{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Versions))
+	assert.Equal(t, int32(2), resp.Versions[0].Version)
+	assert.Equal(t, int32(1), resp.Versions[1].Version)
+	assert.Equal(t, "name", resp.Versions[0].Name)
+	assert.NotNil(t, resp.Versions[0].Created)
+
+	_, err = secretsServer.ListVersions(ctx, &secrets.Id{Id: "Invalid"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRollbackSecret(t *testing.T) {
+	ctx := context.Background()
+	id := createVersionedSecret(ctx, t, "good", "bad")
+
+	resp, err := secretsServer.Rollback(ctx, &secrets.RollbackRequest{Id: id, Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Version)
+
+	latest, err := secretsServer.Read(ctx, &secrets.Id{Id: id})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), latest.Version)
+	assert.Equal(t, "good", password(latest))
+
+	// the bad version is still there
+	bad, err := secretsServer.Read(ctx, &secrets.Id{Id: id, Version: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "bad", password(bad))
+
+	_, err = secretsServer.Rollback(ctx, &secrets.RollbackRequest{Id: id, Version: 7})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = secretsServer.Rollback(ctx, &secrets.RollbackRequest{Id: id})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSecretVersionRetention(t *testing.T) {
+	ctx := context.Background()
+	secretsDb.VersionRetention = 2
+	defer func() { secretsDb.VersionRetention = 0 }()
+
+	id := createVersionedSecret(ctx, t, "first", "second", "third", "fourth")
+
+	resp, err := secretsServer.ListVersions(ctx, &secrets.Id{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Versions))
+	assert.Equal(t, int32(4), resp.Versions[0].Version)
+	assert.Equal(t, int32(3), resp.Versions[1].Version)
+
+	_, err = secretsServer.Read(ctx, &secrets.Id{Id: id, Version: 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = secretsServer.Rollback(ctx, &secrets.RollbackRequest{Id: id, Version: 2})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,226 @@
+// Package keyring holds the keys secrets-service encrypts secrets with.
+//
+// A keyring file has one key per line, as its ID followed by the key. The
+// last key is the current one: new secrets are encrypted with it, while the
+// older ones are kept to decrypt the secrets that have not been re-encrypted
+// yet. A file holding nothing but a key, the format used before keyrings,
+// is a keyring of that key with LegacyKeyID.
+package keyring
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/chef/automate/lib/io/fileutils"
+)
+
+// LegacyKeyID is the ID of the key of a single key file. Secrets encrypted
+// before keyrings existed were encrypted with that key.
+const LegacyKeyID = "1"
+
+// KeyLength is the length of every key
+const KeyLength = 32
+
+const fileMode = 0600
+
+// Keyring is an ordered set of keys, the last of which is the current one.
+// A keyring loaded from a file reloads it when it changes, so that a running
+// service picks up the keys added by a rotation.
+type Keyring struct {
+	mu      sync.RWMutex
+	path    string
+	modTime time.Time
+	ids     []string
+	keys    map[string]string
+}
+
+// FromKey returns a keyring of the given key only, with LegacyKeyID
+func FromKey(key string) (*Keyring, error) {
+	k := &Keyring{keys: map[string]string{}}
+	if err := k.add(LegacyKeyID, key); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Parse reads a keyring from the contents of a keyring file
+func Parse(data []byte) (*Keyring, error) {
+	content := strings.TrimSpace(string(data))
+	if content == "" {
+		return nil, errors.New("the keyring has no keys")
+	}
+
+	lines := strings.Split(content, "\n")
+	if len(lines) == 1 && len(strings.Fields(lines[0])) == 1 {
+		return FromKey(lines[0])
+	}
+
+	k := &Keyring{keys: map[string]string{}}
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, errors.Errorf("line %d of the keyring is not a key ID followed by a key", i+1)
+		}
+		if err := k.add(fields[0], fields[1]); err != nil {
+			return nil, errors.Wrapf(err, "line %d of the keyring", i+1)
+		}
+	}
+	return k, nil
+}
+
+// Load reads the keyring file at path
+func Load(path string) (*Keyring, error) {
+	k := &Keyring{path: path}
+	if err := k.reload(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Current returns the ID and the key to encrypt with
+func (k *Keyring) Current() (string, string) {
+	k.refresh()
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	id := k.ids[len(k.ids)-1]
+	return id, k.keys[id]
+}
+
+// Key returns the key with the given ID
+func (k *Keyring) Key(id string) (string, error) {
+	if key, ok := k.lookup(id); ok {
+		return key, nil
+	}
+
+	// the key might have been added by a rotation since we loaded the file
+	k.refresh()
+	if key, ok := k.lookup(id); ok {
+		return key, nil
+	}
+	return "", errors.Errorf("no key with ID %q in the keyring", id)
+}
+
+// Add adds a key to the keyring and makes it the current one. It returns the
+// ID of the key, which is one more than the highest numeric ID of the
+// keyring.
+func (k *Keyring) Add(key string) (string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	next := 1
+	for _, id := range k.ids {
+		if n, err := strconv.Atoi(id); err == nil && n >= next {
+			next = n + 1
+		}
+	}
+	id := strconv.Itoa(next)
+	if err := k.add(id, key); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Save writes the keyring to the file at path. If the file exists, its owner
+// and group are kept: rotate-key is run as root, and the keyring must stay
+// readable by the service.
+func (k *Keyring) Save(path string) error {
+	k.mu.RLock()
+	var buf bytes.Buffer
+	for _, id := range k.ids {
+		fmt.Fprintf(&buf, "%s %s\n", id, k.keys[id])
+	}
+	k.mu.RUnlock()
+
+	opts := []fileutils.AtomicWriteOpt{fileutils.WithAtomicWriteFileMode(fileMode)}
+	info, err := os.Stat(path)
+	switch {
+	case err == nil:
+		if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+			opts = append(opts, fileutils.WithAtomicWriteChown(int(stat.Uid), int(stat.Gid)))
+		}
+	case !os.IsNotExist(err):
+		return errors.Wrapf(err, "failed to read keyring %s", path)
+	}
+
+	err = fileutils.AtomicWrite(path, &buf, opts...)
+	return errors.Wrapf(err, "failed to write keyring %s", path)
+}
+
+func (k *Keyring) add(id, key string) error {
+	if len(key) != KeyLength {
+		return errors.Errorf("key %q must be %d characters in length, it has %d", id, KeyLength, len(key))
+	}
+	if _, exists := k.keys[id]; exists {
+		return errors.Errorf("duplicate key ID %q", id)
+	}
+	k.ids = append(k.ids, id)
+	k.keys[id] = key
+	return nil
+}
+
+func (k *Keyring) lookup(id string) (string, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[id]
+	return key, ok
+}
+
+// refresh reloads the keyring file if it changed. On failure, the keyring
+// keeps the keys it has.
+func (k *Keyring) refresh() {
+	if k.path == "" {
+		return
+	}
+	info, err := os.Stat(k.path)
+	if err != nil {
+		log.WithError(err).WithField("path", k.path).Warn("Failed to check keyring file")
+		return
+	}
+
+	k.mu.RLock()
+	unchanged := info.ModTime().Equal(k.modTime)
+	k.mu.RUnlock()
+	if unchanged {
+		return
+	}
+
+	if err := k.reload(); err != nil {
+		log.WithError(err).WithField("path", k.path).Warn("Failed to reload keyring file")
+	}
+}
+
+func (k *Keyring) reload() error {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read keyring %s", k.path)
+	}
+	data, err := ioutil.ReadFile(k.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read keyring %s", k.path)
+	}
+	loaded, err := Parse(data)
+	if err != nil {
+		return errors.Wrapf(err, "invalid keyring %s", k.path)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.modTime = info.ModTime()
+	k.ids = loaded.ids
+	k.keys = loaded.keys
+	return nil
+}
@@ -0,0 +1,191 @@
+package keyring
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	key1 = "75e79c17ae62445e9771cd13fc4216f4"
+	key2 = "0123456789abcdef0123456789abcdef"
+	key3 = "fedcba9876543210fedcba9876543210"
+)
+
+func TestParseLegacyKey(t *testing.T) {
+	k, err := Parse([]byte(key1 + "\n"))
+	require.NoError(t, err)
+
+	id, key := k.Current()
+	assert.Equal(t, LegacyKeyID, id)
+	assert.Equal(t, key1, key)
+}
+
+func TestParseKeyring(t *testing.T) {
+	k, err := Parse([]byte("1 " + key1 + "\n\n2 " + key2 + "\n"))
+	require.NoError(t, err)
+
+	id, key := k.Current()
+	assert.Equal(t, "2", id)
+	assert.Equal(t, key2, key)
+
+	key, err = k.Key("1")
+	require.NoError(t, err)
+	assert.Equal(t, key1, key)
+
+	_, err = k.Key("3")
+	assert.Error(t, err)
+}
+
+func TestParseInvalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"empty":         "  \n",
+		"short key":     "abc",
+		"short ring":    "1 " + key1 + "\n2 abc",
+		"duplicate ID":  "1 " + key1 + "\n1 " + key2,
+		"missing ID":    "1 " + key1 + "\n" + key2,
+		"extra content": "1 " + key1 + " extra",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(content))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFromKey(t *testing.T) {
+	k, err := FromKey(key1)
+	require.NoError(t, err)
+	id, key := k.Current()
+	assert.Equal(t, LegacyKeyID, id)
+	assert.Equal(t, key1, key)
+
+	_, err = FromKey("too short")
+	assert.Error(t, err)
+}
+
+func TestAdd(t *testing.T) {
+	k, err := Parse([]byte("1 " + key1 + "\n7 " + key2 + "\nold " + key3))
+	require.NoError(t, err)
+
+	id, err := k.Add("ffffffffffffffffffffffffffffffff")
+	require.NoError(t, err)
+	assert.Equal(t, "8", id)
+
+	currentID, key := k.Current()
+	assert.Equal(t, "8", currentID)
+	assert.Equal(t, "ffffffffffffffffffffffffffffffff", key)
+
+	_, err = k.Add("too short")
+	assert.Error(t, err)
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "secrets_key")
+
+	// a legacy key file
+	require.NoError(t, ioutil.WriteFile(path, []byte(key1), 0600))
+	k, err := Load(path)
+	require.NoError(t, err)
+
+	id, err := k.Add(key2)
+	require.NoError(t, err)
+	assert.Equal(t, "2", id)
+	require.NoError(t, k.Save(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	id, key := loaded.Current()
+	assert.Equal(t, "2", id)
+	assert.Equal(t, key2, key)
+	key, err = loaded.Key(LegacyKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, key1, key)
+}
+
+func TestSaveKeepsOwner(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("changing the owner of a file requires root")
+	}
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "secrets_key")
+
+	// owned by the service user, saved by root
+	const uid, gid = 4242, 4343
+	require.NoError(t, ioutil.WriteFile(path, []byte(key1), 0600))
+	require.NoError(t, os.Chown(path, uid, gid))
+	k, err := Load(path)
+	require.NoError(t, err)
+	_, err = k.Add(key2)
+	require.NoError(t, err)
+	require.NoError(t, k.Save(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	stat := info.Sys().(*syscall.Stat_t)
+	assert.Equal(t, uint32(uid), stat.Uid)
+	assert.Equal(t, uint32(gid), stat.Gid)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestReloadOnChange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "secrets_key")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(key1), 0600))
+	running, err := Load(path)
+	require.NoError(t, err)
+
+	// another process rotates the key
+	rotating, err := Load(path)
+	require.NoError(t, err)
+	_, err = rotating.Add(key2)
+	require.NoError(t, err)
+	require.NoError(t, rotating.Save(path))
+	// make sure the modification time differs on coarse file systems
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+
+	key, err := running.Key("2")
+	require.NoError(t, err)
+	assert.Equal(t, key2, key)
+
+	id, _ := running.Current()
+	assert.Equal(t, "2", id)
+}
+
+func TestReloadFailureKeepsKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "secrets_key")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(key1), 0600))
+	k, err := Load(path)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("garbage"), 0600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+
+	id, key := k.Current()
+	assert.Equal(t, LegacyKeyID, id)
+	assert.Equal(t, key1, key)
+}
@@ -2,8 +2,10 @@ package server
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/chef/automate/api/external/secrets"
+	"github.com/chef/automate/components/secrets-service/backend"
 	"github.com/chef/automate/components/secrets-service/dao"
 	"github.com/chef/automate/components/secrets-service/utils"
 	"github.com/chef/automate/lib/grpc/health"
@@ -14,15 +16,22 @@ import (
 // SecretsServer is the interface to this component.
 type SecretsServer struct {
 	secretsDb *dao.DB
+	backends  map[string]backend.Backend
 	health    *health.Service
 }
 
-// New creates a new SecretsServer instance.
-func New(secretsDb *dao.DB) *SecretsServer {
-	return &SecretsServer{
+// New creates a new SecretsServer instance. Secrets can reference the given
+// backends.
+func New(secretsDb *dao.DB, backends ...backend.Backend) *SecretsServer {
+	ss := &SecretsServer{
 		secretsDb: secretsDb,
+		backends:  make(map[string]backend.Backend, len(backends)),
 		health:    health.NewService(),
 	}
+	for _, b := range backends {
+		ss.backends[b.Name()] = b
+	}
+	return ss
 }
 
 // Create a new secret
@@ -30,6 +39,9 @@ func (ss *SecretsServer) Create(ctx context.Context, in *secrets.Secret) (*secre
 	if err := in.Validate(); err != nil {
 		return nil, utils.FormatErrorMsg(errors.Wrap(err, "Create: unable to validate secret"), "")
 	}
+	if err := ss.validateReference(in.Reference); err != nil {
+		return nil, utils.FormatErrorMsg(err, "")
+	}
 	sID, err := ss.secretsDb.AddSecret(in)
 	if err != nil {
 		return nil, utils.FormatErrorMsg(err, "")
@@ -37,13 +49,25 @@ func (ss *SecretsServer) Create(ctx context.Context, in *secrets.Secret) (*secre
 	return &secrets.Id{Id: sID}, nil
 }
 
-// Read a secret via ID
+// Read a secret via ID, optionally selecting one of its versions
 func (ss *SecretsServer) Read(ctx context.Context, in *secrets.Id) (*secrets.Secret, error) {
 	logs.Infof("read secret with : %+v", in.Id)
-	secret, err := ss.secretsDb.GetSecret(in.Id)
+	var secret *secrets.Secret
+	var err error
+	if in.Version == 0 {
+		secret, err = ss.secretsDb.GetSecret(in.Id)
+	} else {
+		secret, err = ss.secretsDb.GetSecretVersion(in.Id, in.Version)
+	}
 	if err != nil {
 		return nil, utils.FormatErrorMsg(err, in.Id)
 	}
+	if secret.Reference != nil {
+		secret.Data, err = ss.resolveReference(ctx, secret.Reference)
+		if err != nil {
+			return nil, utils.FormatErrorMsg(err, in.Id)
+		}
+	}
 	return secret, nil
 }
 
@@ -63,6 +87,9 @@ func (ss *SecretsServer) Update(ctx context.Context, in *secrets.Secret) (*secre
 	if err != nil {
 		return nil, utils.FormatErrorMsg(utils.ProcessInvalid(err, "updateSecretValidation: unable to validate secret"), in.Id)
 	}
+	if err := ss.validateReference(in.Reference); err != nil {
+		return nil, utils.FormatErrorMsg(err, in.Id)
+	}
 
 	_, err = ss.secretsDb.UpdateSecret(newSecret)
 	if err != nil {
@@ -91,6 +118,54 @@ func (ss *SecretsServer) List(ctx context.Context, in *secrets.Query) (*secrets.
 	return &secrets.Secrets{Secrets: dbsecrets, Total: int32(totalCount)}, nil
 }
 
+// ListVersions lists the retained versions of a secret
+func (ss *SecretsServer) ListVersions(ctx context.Context, in *secrets.Id) (*secrets.SecretVersions, error) {
+	logs.Debugf("Listing versions of secret id: %+v", in.Id)
+	versions, err := ss.secretsDb.ListSecretVersions(in.Id)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, in.Id)
+	}
+	return &secrets.SecretVersions{Versions: versions}, nil
+}
+
+// Rollback makes a previous version of a secret its latest version again
+func (ss *SecretsServer) Rollback(ctx context.Context, in *secrets.RollbackRequest) (*secrets.RollbackResponse, error) {
+	logs.Infof("Rolling back secret id: %+v to version %d", in.Id, in.Version)
+	if in.Version <= 0 {
+		return nil, utils.FormatErrorMsg(&utils.InvalidError{Msg: "Rollback: a version is required"}, in.Id)
+	}
+	version, err := ss.secretsDb.RollbackSecret(in.Id, in.Version)
+	if err != nil {
+		return nil, utils.FormatErrorMsg(err, in.Id)
+	}
+	return &secrets.RollbackResponse{Version: version}, nil
+}
+
+// validateReference checks that a reference, if any, is to a configured
+// backend
+func (ss *SecretsServer) validateReference(ref *secrets.Reference) error {
+	if ref == nil {
+		return nil
+	}
+	if _, ok := ss.backends[ref.Backend]; !ok {
+		return &utils.InvalidError{Msg: fmt.Sprintf("Invalid reference, backend %q is not configured", ref.Backend)}
+	}
+	return nil
+}
+
+// resolveReference reads the data of a referenced secret from its backend
+func (ss *SecretsServer) resolveReference(ctx context.Context, ref *secrets.Reference) ([]*secrets.Kv, error) {
+	b, ok := ss.backends[ref.Backend]
+	if !ok {
+		return nil, errors.Errorf("secret references backend %q, which is not configured", ref.Backend)
+	}
+	data, err := b.Read(ctx, ref.Path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read %s from backend %s", ref.Path, ref.Backend)
+	}
+	return data, nil
+}
+
 // Health returns the servers embedded health check service
 func (ss *SecretsServer) Health() *health.Service {
 	return ss.health
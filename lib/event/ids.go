@@ -3,4 +3,5 @@ package event
 const (
 	InfraClientRunsProducerID        = "infraClientRuns"
 	ComplianceInspecReportProducerID = "complianceInspecReport"
+	DeploymentServiceProducerID      = "deploymentService"
 )
@@ -0,0 +1,66 @@
+package source_ip
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/chef/automate/lib/grpc/service_authn"
+)
+
+// ForwardedForKey is the metadata key holding the addresses a request was
+// forwarded for. Only automate-gateway is trusted to set it.
+const ForwardedForKey = "x-forwarded-for"
+
+// FromContext returns the address of the client that has sent the request, or
+// "" if it cannot be determined.
+//
+// For requests sent by automate-gateway, that is the first address of the
+// X-Forwarded-For metadata. For requests that came in through grpc-gateway,
+// automate-load-balancer sets it to its client's address, and grpc-gateway
+// appends its own client's address (that of automate-load-balancer). When
+// automate-gateway calls other services on behalf of a request, it replaces
+// the metadata with the address it determined itself. For all other requests,
+// it's the address of the peer: X-Forwarded-For set by anyone else is ignored.
+func FromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if peerIsGateway(p) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if vals := md.Get(ForwardedForKey); len(vals) > 0 {
+				first := strings.TrimSpace(strings.Split(vals[0], ",")[0])
+				if net.ParseIP(first) != nil {
+					return first
+				}
+			}
+		}
+	}
+
+	if p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	return host
+}
+
+// peerIsGateway checks if the peer has authenticated itself with
+// automate-gateway's service certificate; if so, the request came in through
+// grpc-gateway, or was forwarded by automate-gateway.
+func peerIsGateway(p *peer.Peer) bool {
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return false
+	}
+	sub, ok := service_authn.ServiceSubjectFromCert(tlsInfo.State.VerifiedChains[0][0])
+	return ok && strings.HasPrefix(sub, "tls:service:automate-gateway:")
+}
@@ -0,0 +1,80 @@
+package source_ip
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/chef/automate/lib/tls/test/helpers"
+)
+
+// devCertPeer returns a peer that has authenticated with the dev certificate
+// of the service
+func devCertPeer(t *testing.T, service string) *peer.Peer {
+	serviceCerts := helpers.LoadDevCerts(t, service)
+	cert, err := x509.ParseCertificate(serviceCerts.ServiceKeyPair.Certificate[0])
+	require.NoError(t, err)
+	return &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	agPeer := devCertPeer(t, "automate-gateway")
+	agPeer.Addr = &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 43210}
+	otherServicePeer := devCertPeer(t, "deployment-service")
+	otherServicePeer.Addr = &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 43210}
+	plainPeer := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 443}}
+
+	cases := map[string]struct {
+		ctx      context.Context
+		expected string
+	}{
+		"no peer": {
+			ctx:      context.Background(),
+			expected: "",
+		},
+		"through grpc-gateway, first forwarded address": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), agPeer),
+				metadata.Pairs("x-forwarded-for", "192.0.2.10, 172.16.0.2")),
+			expected: "192.0.2.10",
+		},
+		"through grpc-gateway, invalid forwarded address": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), agPeer),
+				metadata.Pairs("x-forwarded-for", "not-an-ip")),
+			expected: "127.0.0.1",
+		},
+		"through grpc-gateway, no forwarded address": {
+			ctx:      peer.NewContext(context.Background(), agPeer),
+			expected: "127.0.0.1",
+		},
+		"other service, forwarded address is ignored": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(context.Background(), otherServicePeer),
+				metadata.Pairs("x-forwarded-for", "192.0.2.10")),
+			expected: "10.0.0.7",
+		},
+		"peer without TLS": {
+			ctx:      peer.NewContext(context.Background(), plainPeer),
+			expected: "2001:db8::1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, FromContext(tc.ctx))
+		})
+	}
+}
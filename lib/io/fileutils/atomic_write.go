@@ -15,6 +15,9 @@ const defaultCreateMode os.FileMode = 0644
 type atomicWriteOpts struct {
 	mode   os.FileMode
 	noSync bool
+	chown  bool
+	uid    int
+	gid    int
 }
 
 // AtomicWriteOpt allows setting options for writing a file
@@ -27,6 +30,15 @@ func WithAtomicWriteFileMode(mode os.FileMode) AtomicWriteOpt {
 	}
 }
 
+// WithAtomicWriteChown specifies the owner and group the file must have
+func WithAtomicWriteChown(uid, gid int) AtomicWriteOpt {
+	return func(opts *atomicWriteOpts) {
+		opts.chown = true
+		opts.uid = uid
+		opts.gid = gid
+	}
+}
+
 // WithAtomicWriterNoSync specifies if sync should be skipped. Skipping
 // sync is not safe.
 func WithAtomicWriteNoSync(noSync bool) AtomicWriteOpt {
@@ -75,6 +87,12 @@ func NewAtomicWriter(p string, opts ...AtomicWriteOpt) (WriteCloserFailer, error
 		return nil, err
 	}
 
+	if writeOpts.chown {
+		if err := f.Chown(writeOpts.uid, writeOpts.gid); err != nil {
+			return nil, multierr.Combine(err, f.Close(), os.Remove(tmpPath))
+		}
+	}
+
 	return &atomicWriter{
 		finalPath: p,
 		tmpPath:   tmpPath,
@@ -6,6 +6,7 @@ import (
 	"os/exec"
 	"path"
 	"strings"
+	"syscall"
 	"testing"
 	"testing/iotest"
 
@@ -59,3 +60,20 @@ func TestAtomicWriteFail(t *testing.T) {
 	_, err = os.Stat(filename)
 	assert.True(t, os.IsNotExist(err), "The file should not have been created because there was an error")
 }
+
+func TestAtomicWriteChownSuccess(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("changing the owner of a file requires root")
+	}
+	dir, err := ioutil.TempDir("", "AtomicWriteTest")
+	require.NoError(t, err, "creating temporary dir")
+	defer os.RemoveAll(dir)
+
+	filename := path.Join(dir, "foo")
+	err = fileutils.AtomicWrite(filename, strings.NewReader("bar"), fileutils.WithAtomicWriteChown(4242, 4343))
+	require.NoError(t, err)
+	info, err := os.Stat(filename)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4242), info.Sys().(*syscall.Stat_t).Uid)
+	assert.Equal(t, uint32(4343), info.Sys().(*syscall.Stat_t).Gid)
+}